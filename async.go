@@ -0,0 +1,252 @@
+package fhir
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// AsyncOption configures the asynchronous requests.
+type AsyncOption func(*asyncOptions)
+
+type asyncOptions struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	started     func(job *AsyncJob)
+}
+
+// WithPollInterval sets the first interval between the status requests, the interval is doubled up to max.
+// The Retry-After header of the status response takes precedence. The non-positive intervals keep the defaults,
+// 1s and 30s.
+func WithPollInterval(interval, max time.Duration) AsyncOption {
+	return func(o *asyncOptions) {
+		if interval > 0 {
+			o.interval = interval
+		}
+		if max > 0 {
+			o.maxInterval = max
+		}
+	}
+}
+
+// OnJobStarted calls f when the server accepts the request for asynchronous processing,
+// for example to save the status URL or to cancel the job.
+func OnJobStarted(f func(job *AsyncJob)) AsyncOption {
+	return func(o *asyncOptions) {
+		o.started = f
+	}
+}
+
+func newAsyncOptions(opts []AsyncOption) asyncOptions {
+	o := asyncOptions{interval: time.Second, maxInterval: 30 * time.Second}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+type asyncKey struct{}
+
+// WithRespondAsync returns the context for the asynchronous requests. The requests made with the context send
+// "Prefer: respond-async" and, when the server responds with 202 Accepted, poll the status endpoint
+// until the final response is available. Any interaction, including the typed ones, may be asynchronous.
+func WithRespondAsync(ctx context.Context, opts ...AsyncOption) context.Context {
+	o := newAsyncOptions(opts)
+	return context.WithValue(ctx, asyncKey{}, &o)
+}
+
+func asyncFromContext(ctx context.Context) *asyncOptions {
+	o, _ := ctx.Value(asyncKey{}).(*asyncOptions)
+	return o
+}
+
+// withoutRespondAsync returns the context for the synchronous requests made on behalf of the asynchronous one.
+func withoutRespondAsync(ctx context.Context) context.Context {
+	if asyncFromContext(ctx) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, asyncKey{}, (*asyncOptions)(nil))
+}
+
+// AsyncJob is the request processed by the server asynchronously.
+type AsyncJob struct {
+	// StatusURL is the URL of the status endpoint from the Content-Location header.
+	StatusURL string
+	// Progress is the X-Progress header of the last status response.
+	Progress string
+
+	client *Client
+	opts   asyncOptions
+}
+
+// AsyncJob returns the job with the status URL, for example to wait for the job started before.
+func (c *Client) AsyncJob(statusURL string, opts ...AsyncOption) *AsyncJob {
+	return &AsyncJob{StatusURL: statusURL, client: c, opts: newAsyncOptions(opts)}
+}
+
+// doAsync sends the request with "Prefer: respond-async" and waits for the final response.
+func (c *Client) doAsync(ctx context.Context, req *http.Request, o *asyncOptions) (*FhirResponse, error) {
+	req.Header.Set("Prefer", "respond-async")
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	location := resp.Header.Get("Content-Location")
+	if resp.StatusCode != http.StatusAccepted || location == "" {
+		return newFhirResponse(resp, c.decoder)
+	}
+	drain(resp.Body)
+
+	job := &AsyncJob{StatusURL: location, client: c, opts: *o}
+	if o.started != nil {
+		o.started(job)
+	}
+	return job.Wait(ctx)
+}
+
+// Poll requests the job status once. It returns true and the final response when the job is completed.
+func (j *AsyncJob) Poll(ctx context.Context) (*FhirResponse, bool, error) {
+	resp, _, err := j.poll(ctx)
+	return resp, resp != nil || err != nil, err
+}
+
+// poll requests the job status. It returns the delay from Retry-After while the job is in progress.
+func (j *AsyncJob) poll(ctx context.Context) (*FhirResponse, time.Duration, error) {
+	req, err := j.client.newRequest(ctx, http.MethodGet, j.StatusURL, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", "application/fhir+json")
+
+	resp, err := j.client.do(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode == http.StatusAccepted {
+		drain(resp.Body)
+		j.Progress = resp.Header.Get("X-Progress")
+		delay, _ := retryAfter(resp.Header)
+		return nil, delay, nil
+	}
+
+	fresp, err := newFhirResponse(resp, j.client.decoder)
+	if err != nil {
+		return fresp, 0, err
+	}
+	// The final response is wrapped into the batch-response Bundle.
+	if bundle := fresp.Bundle; bundle != nil && bundle.Type == models.BundleTypeBatchResponse && len(bundle.Entry) == 1 {
+		fresp, err = entryResponse(bundle.Entry[0], j.client.decoder)
+	}
+	return fresp, 0, err
+}
+
+// Wait polls the job status with backoff until the final response is available or the context is done.
+// The job is not cancelled on the server when the context is done, use Cancel for it.
+func (j *AsyncJob) Wait(ctx context.Context) (*FhirResponse, error) {
+	interval := j.opts.interval
+	for {
+		resp, delay, err := j.poll(ctx)
+		if resp != nil || err != nil {
+			return resp, err
+		}
+		if delay <= 0 {
+			delay = interval
+			if interval *= 2; interval > j.opts.maxInterval {
+				interval = j.opts.maxInterval
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Cancel cancels the job on the server.
+func (j *AsyncJob) Cancel(ctx context.Context) error {
+	req, err := j.client.newRequest(ctx, http.MethodDelete, j.StatusURL, nil, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/fhir+json")
+
+	resp, err := j.client.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		_, err := newFhirResponse(resp, j.client.decoder)
+		return err
+	}
+	drain(resp.Body)
+	return nil
+}
+
+// EntryResponse is the response of the batch or transaction entry.
+type EntryResponse struct {
+	Response *FhirResponse
+	Err      error
+}
+
+// EntryResponses decodes the entries of the batch-response or transaction-response Bundle with the client decoder
+// the same way as the responses to the separate requests.
+func (c *Client) EntryResponses(bundle *models.Bundle) []EntryResponse {
+	if bundle == nil {
+		return nil
+	}
+	responses := make([]EntryResponse, 0, len(bundle.Entry))
+	for _, entry := range bundle.Entry {
+		resp, err := entryResponse(entry, c.decoder)
+		responses = append(responses, EntryResponse{Response: resp, Err: err})
+	}
+	return responses
+}
+
+// entryResponse decodes the Bundle entry with the response as the HTTP response.
+func entryResponse(entry models.BundleEntry, decoder models.Decoder) (*FhirResponse, error) {
+	if entry.Response == nil {
+		return nil, fmt.Errorf("bundle entry has no response")
+	}
+	status, err := strconv.Atoi(strings.SplitN(strings.TrimSpace(entry.Response.Status), " ", 2)[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid entry response status \"%s\": %w", entry.Response.Status, err)
+	}
+
+	body := []byte(entry.Resource)
+	if len(body) == 0 {
+		body = entry.Response.Outcome
+	}
+	header := http.Header{"Content-Type": {"application/fhir+json"}}
+	if entry.Response.Location != nil {
+		header.Set("Location", *entry.Response.Location)
+	}
+	if entry.Response.Etag != nil {
+		header.Set("ETag", *entry.Response.Etag)
+	}
+	if entry.Response.LastModified != nil {
+		header.Set("Last-Modified", entry.Response.LastModified.Time.UTC().Format(http.TimeFormat))
+	}
+	return newFhirResponse(&http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	}, decoder)
+}
+
+// drain reads the rest of the body and closes it, so the connection can be reused.
+func drain(body io.ReadCloser) {
+	_, _ = io.Copy(ioutil.Discard, body)
+	body.Close()
+}
@@ -0,0 +1,81 @@
+package fhir_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestEntryResponses(t *testing.T) {
+	bundle := &models.Bundle{
+		Type: models.BundleTypeBatchResponse,
+		Entry: []models.BundleEntry{
+			{
+				Resource: json.RawMessage(`{"resourceType":"OperationOutcome","issue":[{"severity":"information","code":"future-code"}]}`),
+				Response: &models.BundleEntryResponse{Status: "200 OK"},
+			},
+			{Response: &models.BundleEntryResponse{Status: "404 Not Found"}},
+		},
+	}
+
+	strict, err := fhir.New("http://fhir.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	responses := strict.EntryResponses(bundle)
+	var codeErr models.CodeError
+	if len(responses) != 2 || !errors.As(responses[0].Err, &codeErr) || !errors.Is(responses[1].Err, fhir.ErrNotFound) {
+		t.Errorf("expected CodeError and not found, got %+v", responses)
+	}
+
+	lenient, err := fhir.New("http://fhir.test", fhir.WithDecoder(models.Decoder{Lenient: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	responses = lenient.EntryResponses(bundle)
+	if len(responses) != 2 || responses[0].Err != nil || responses[0].Response.StatusCode != 200 || !errors.Is(responses[1].Err, fhir.ErrNotFound) {
+		t.Errorf("expected the lenient response, got %+v", responses)
+	}
+}
+
+func TestRespondAsync(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var job *fhir.AsyncJob
+	ctx := fhir.WithRespondAsync(context.Background(),
+		fhir.WithPollInterval(time.Millisecond, 10*time.Millisecond),
+		fhir.OnJobStarted(func(j *fhir.AsyncJob) { job = j }),
+	)
+	patient, err := client.CreatePatient(ctx, nil, newPatient("Doe"))
+	if err != nil {
+		t.Fatalf("CreatePatient() error = %v", err)
+	}
+	if models.ToString(patient.ID) == "" || job == nil || job.Progress != "in progress" {
+		t.Errorf("unexpected result: %s, job %+v", mustJSON(patient), job)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("expected the request and 2 polls, got %d requests", n)
+	}
+
+	if _, err := client.GetPatientByID(ctx, "unknown", nil); !fhir.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, got %v", err)
+	}
+
+	if err := job.Cancel(context.Background()); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if _, done, err := job.Poll(context.Background()); !done || !fhir.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError for the cancelled job, got %v", err)
+	}
+}
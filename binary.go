@@ -0,0 +1,331 @@
+package fhir
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// BinaryContent is the content of the Binary resource in its native content type.
+type BinaryContent struct {
+	ContentType string
+	// SecurityContext is the reference from the X-Security-Context header.
+	SecurityContext string
+	// Size is the length of the content, -1 when it is unknown.
+	Size int64
+	// ContentRange is the Content-Range header of the partial content.
+	ContentRange string
+	// Body is the content. It must be closed by the caller.
+	Body io.ReadCloser
+}
+
+// BinaryOption configures the Binary requests.
+type BinaryOption func(*binaryOptions)
+
+type binaryOptions struct {
+	securityContext string
+	rangeHeader     string
+	accept          string
+	json            bool
+}
+
+// WithSecurityContext sets the reference to the resource which defines the access to the Binary.
+func WithSecurityContext(reference string) BinaryOption {
+	return func(o *binaryOptions) {
+		o.securityContext = reference
+	}
+}
+
+// WithRange requests the part of the content from start to end inclusive. A negative end means to the end of the content.
+func WithRange(start, end int64) BinaryOption {
+	return func(o *binaryOptions) {
+		o.rangeHeader = "bytes=" + strconv.FormatInt(start, 10) + "-"
+		if end >= 0 {
+			o.rangeHeader += strconv.FormatInt(end, 10)
+		}
+	}
+}
+
+// WithAccept sets the content type expected from the server, "*/*" by default.
+func WithAccept(contentType string) BinaryOption {
+	return func(o *binaryOptions) {
+		o.accept = contentType
+	}
+}
+
+// WithJSONRepresentation transfers the content as the JSON Binary resource with base64 encoded data.
+// It is used for the servers which don't support the native content types.
+func WithJSONRepresentation() BinaryOption {
+	return func(o *binaryOptions) {
+		o.json = true
+	}
+}
+
+func newBinaryOptions(opts []BinaryOption) binaryOptions {
+	o := binaryOptions{accept: "*/*"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// CreateBinaryContent creates the Binary with the content streamed from the body and returns its ID.
+// When the server rejects the content type with 415 Unsupported Media Type and the body is io.ReaderAt and io.Seeker,
+// such as *os.File or *bytes.Reader, the content is sent again as the JSON Binary resource.
+func (c *Client) CreateBinaryContent(ctx context.Context, contentType string, body io.Reader, opts ...BinaryOption) (string, error) {
+	return c.writeBinary(ctx, http.MethodPost, "", contentType, body, newBinaryOptions(opts))
+}
+
+// UpdateBinaryContent updates the Binary with the content streamed from the body.
+// The fallback to the JSON representation is the same as for CreateBinaryContent.
+func (c *Client) UpdateBinaryContent(ctx context.Context, id string, contentType string, body io.Reader, opts ...BinaryOption) error {
+	_, err := c.writeBinary(ctx, http.MethodPut, id, contentType, body, newBinaryOptions(opts))
+	return withResource(err, BinaryResource, id)
+}
+
+// writeBinary creates the Binary or updates it when the ID is not empty.
+func (c *Client) writeBinary(ctx context.Context, method, id, contentType string, body io.Reader, o binaryOptions) (string, error) {
+	path := string(BinaryResource)
+	if id != "" {
+		path = Path(path, id)
+	}
+	if !o.json {
+		content, retry := newBinaryBody(body)
+		created, err := c.sendBinary(ctx, method, path, contentType, content(), o)
+		if e, ok := AsFhirError(err); !ok || e.Status != http.StatusUnsupportedMediaType || !retry {
+			return created, err
+		}
+		body = content()
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeBinaryJSON(writer, id, contentType, o.securityContext, body))
+	}()
+	defer reader.Close()
+	return c.sendBinary(ctx, method, path, "application/fhir+json", reader, o)
+}
+
+// newBinaryBody returns the function giving the reader of the content from its start for every request and whether
+// the content can be sent again. The http.Client closes the body of the request, possibly after the response,
+// so the content given as io.ReaderAt, such as *os.File, is read by the independent section readers, which the client
+// doesn't close.
+func newBinaryBody(body io.Reader) (func() io.Reader, bool) {
+	readerAt, ok := body.(io.ReaderAt)
+	seeker, isSeeker := body.(io.Seeker)
+	if !ok || !isSeeker {
+		return func() io.Reader { return body }, false
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return func() io.Reader { return body }, false
+	}
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return func() io.Reader { return body }, false
+	}
+	if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+		return func() io.Reader { return body }, false
+	}
+	return func() io.Reader { return io.NewSectionReader(readerAt, start, end-start) }, true
+}
+
+// writeBinaryJSON streams the Binary resource with base64 encoded content.
+func writeBinaryJSON(w io.Writer, id, contentType, securityContext string, body io.Reader) error {
+	header := struct {
+		ResourceType    string            `json:"resourceType"`
+		ID              string            `json:"id,omitempty"`
+		ContentType     string            `json:"contentType"`
+		SecurityContext *models.Reference `json:"securityContext,omitempty"`
+	}{ResourceType: string(BinaryResource), ID: id, ContentType: contentType}
+	if securityContext != "" {
+		header.SecurityContext = &models.Reference{Reference: &securityContext}
+	}
+	data, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	// Open the object to append the data property.
+	if _, err := w.Write(append(data[:len(data)-1], `,"data":"`...)); err != nil {
+		return err
+	}
+	encoder := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(encoder, body); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	_, err = w.Write([]byte(`"}`))
+	return err
+}
+
+func (c *Client) sendBinary(ctx context.Context, method, path, contentType string, body io.Reader, o binaryOptions) (string, error) {
+	req, err := c.newRequest(ctx, method, path, nil, body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/fhir+json")
+	if o.securityContext != "" {
+		req.Header.Set("X-Security-Context", o.securityContext)
+	}
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode >= 400 {
+		_, err := newFhirResponse(resp, c.decoder)
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if _, id, _ := parseLocation(resp.Header.Get("Location")); id != "" {
+		return id, nil
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var binary models.Binary
+	if len(data) != 0 && c.decoder.Unmarshal(data, &binary) == nil {
+		return models.ToString(binary.ID), nil
+	}
+	return "", nil
+}
+
+// GetBinaryContent returns the content of the Binary in its native content type. The caller must close the body.
+// When the server responds with the JSON Binary resource, the content is decoded from its data.
+func (c *Client) GetBinaryContent(ctx context.Context, id string, opts ...BinaryOption) (*BinaryContent, error) {
+	o := newBinaryOptions(opts)
+	if o.json {
+		o.accept = "application/fhir+json"
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, Path(string(BinaryResource), id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", o.accept)
+	if o.rangeHeader != "" {
+		req.Header.Set("Range", o.rangeHeader)
+	}
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		_, err := newFhirResponse(resp, c.decoder)
+		return nil, withResource(err, BinaryResource, id)
+	}
+
+	content := &BinaryContent{
+		ContentType:     resp.Header.Get("Content-Type"),
+		SecurityContext: resp.Header.Get("X-Security-Context"),
+		Size:            resp.ContentLength,
+		ContentRange:    resp.Header.Get("Content-Range"),
+		Body:            resp.Body,
+	}
+	if !strings.Contains(content.ContentType, "json") {
+		return content, nil
+	}
+
+	// The content may be the JSON Binary resource or the native JSON document.
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	content.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if GetDataResourceType(data) != BinaryResource {
+		return content, nil
+	}
+	var binary models.Binary
+	if err := c.decoder.Unmarshal(data, &binary); err != nil {
+		return nil, NewUnmarshalError("response parsing", BinaryResource, data, err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(models.ToString(binary.Data))
+	if err != nil {
+		return nil, NewUnmarshalError("binary data decoding", BinaryResource, data, err)
+	}
+	if content.ContentRange == "" && o.rangeHeader != "" {
+		if decoded, err = applyRange(decoded, o.rangeHeader); err != nil {
+			return nil, err
+		}
+	}
+	content.ContentType = binary.ContentType
+	if binary.SecurityContext != nil {
+		content.SecurityContext = models.ToString(binary.SecurityContext.Reference)
+	}
+	content.Size = int64(len(decoded))
+	content.Body = ioutil.NopCloser(bytes.NewReader(decoded))
+	return content, nil
+}
+
+// DownloadBinary writes the content of the Binary to w. The returned content has no body, its size is the number of written bytes.
+func (c *Client) DownloadBinary(ctx context.Context, id string, w io.Writer, opts ...BinaryOption) (*BinaryContent, error) {
+	content, err := c.GetBinaryContent(ctx, id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Body.Close()
+
+	if content.Size, err = io.Copy(w, content.Body); err != nil {
+		return nil, err
+	}
+	content.Body = nil
+	return content, nil
+}
+
+// applyRange returns the part of the data requested with the Range header, for the servers which ignore it.
+func applyRange(data []byte, rangeHeader string) ([]byte, error) {
+	spec := strings.TrimPrefix(rangeHeader, "bytes=")
+	parts := strings.SplitN(spec, "-", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid range \"%s\"", rangeHeader)
+	}
+	start, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range \"%s\": %w", rangeHeader, err)
+	}
+	end := int64(len(data)) - 1
+	if parts[1] != "" {
+		if end, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid range \"%s\": %w", rangeHeader, err)
+		}
+	}
+	if end >= int64(len(data)) {
+		end = int64(len(data)) - 1
+	}
+	if start > end {
+		return nil, errors.New("range is not satisfiable")
+	}
+	return data[start : end+1], nil
+}
+
+// parseLocation returns the resource type, ID and version from the Location header, such as [base]/Binary/123/_history/1.
+func parseLocation(location string) (resource ResourceType, id, vid string) {
+	parts := strings.Split(strings.Trim(location, "/"), "/")
+	for i := len(parts) - 2; i >= 0; i-- {
+		if parts[i] == "_history" {
+			vid = parts[i+1]
+			continue
+		}
+		if TypeOf(ResourceType(parts[i])) != nil {
+			return ResourceType(parts[i]), parts[i+1], vid
+		}
+	}
+	return "", "", ""
+}
@@ -0,0 +1,121 @@
+package fhir_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestGetBinaryContentDecoder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/fhir+json")
+		_, _ = w.Write([]byte(`{"resourceType":"Binary","id":"1","contentType":"text/plain","data":"dGV4dA==","vendorField":true}`))
+	}))
+	defer srv.Close()
+
+	client, err := fhir.New(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := client.GetBinaryContent(context.Background(), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadAll(content.Body)
+	if content.ContentType != "text/plain" || string(data) != "text" {
+		t.Errorf("unexpected content %s: %q", content.ContentType, data)
+	}
+
+	strict, err := fhir.New(srv.URL, fhir.WithDecoder(models.Decoder{UnknownFields: models.RejectUnknownFields}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = strict.GetBinaryContent(context.Background(), "1")
+	var fieldErr models.UnknownFieldError
+	if !errors.As(err, &fieldErr) {
+		t.Errorf("expected UnknownFieldError from the client decoder, got %v", err)
+	}
+}
+
+func TestBinaryContent(t *testing.T) {
+	content := []byte("%PDF-1.4 binary content")
+	for _, tt := range []struct {
+		name string
+		opts []fhirtest.Option
+	}{
+		{name: "Native"},
+		{name: "JSON fallback", opts: []fhirtest.Option{fhirtest.WithJSONBinary()}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fhirtest.NewServer(tt.opts...)
+			defer srv.Close()
+			client, err := srv.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+
+			id, err := client.CreateBinaryContent(ctx, "application/pdf", bytes.NewReader(content), fhir.WithSecurityContext("Patient/p1"))
+			if err != nil {
+				t.Fatalf("CreateBinaryContent() error = %v", err)
+			}
+			var binary models.Binary
+			if data, ok := srv.Resource(fhir.BinaryResource, id); !ok || data.UnmarshalTo(&binary) != nil || binary.ContentType != "application/pdf" {
+				t.Fatalf("unexpected stored Binary: %s", data)
+			}
+
+			var buf bytes.Buffer
+			downloaded, err := client.DownloadBinary(ctx, id, &buf)
+			if err != nil {
+				t.Fatalf("DownloadBinary() error = %v", err)
+			}
+			if buf.String() != string(content) || downloaded.ContentType != "application/pdf" || downloaded.SecurityContext != "Patient/p1" {
+				t.Errorf("unexpected content %q: %+v", buf.String(), downloaded)
+			}
+
+			buf.Reset()
+			if _, err := client.DownloadBinary(ctx, id, &buf, fhir.WithRange(0, 7)); err != nil {
+				t.Fatalf("DownloadBinary() error = %v", err)
+			}
+			if buf.String() != "%PDF-1.4" {
+				t.Errorf("unexpected partial content %q", buf.String())
+			}
+
+			if err := client.UpdateBinaryContent(ctx, id, "text/plain", strings.NewReader("text")); err != nil {
+				t.Fatalf("UpdateBinaryContent() error = %v", err)
+			}
+			if n := len(srv.Versions(fhir.BinaryResource, id)); n != 2 {
+				t.Errorf("expected 2 versions, got %d", n)
+			}
+
+			// the client closes the body of the rejected request, the file is sent again
+			path := filepath.Join(t.TempDir(), "content.pdf")
+			if err := ioutil.WriteFile(path, content, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			file, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+			if err := client.UpdateBinaryContent(ctx, id, "application/pdf", file); err != nil {
+				t.Fatalf("UpdateBinaryContent() error = %v", err)
+			}
+			buf.Reset()
+			if _, err := client.DownloadBinary(ctx, id, &buf); err != nil || buf.String() != string(content) {
+				t.Errorf("unexpected content %q, error = %v", buf.String(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package fhir
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// Capabilities is the CapabilityStatement of the server with the helpers for its server REST mode.
+type Capabilities struct {
+	Statement *models.CapabilityStatement
+	rest      *models.CapabilityStatementRest
+}
+
+// NewCapabilities returns the capabilities of the CapabilityStatement.
+func NewCapabilities(statement *models.CapabilityStatement) *Capabilities {
+	c := &Capabilities{Statement: statement}
+	for i, rest := range statement.Rest {
+		if rest.Mode == models.RestfulCapabilityModeServer {
+			c.rest = &statement.Rest[i]
+			break
+		}
+	}
+	return c
+}
+
+// capabilitiesFetch is the /metadata request in flight, the concurrent callers wait for its result.
+type capabilitiesFetch struct {
+	done         chan struct{}
+	capabilities *Capabilities
+	err          error
+}
+
+// Capabilities returns the CapabilityStatement of the server from /metadata. It is fetched once and cached,
+// ResetCapabilities makes it fetched again. The concurrent callers share the request, which is always synchronous,
+// even with the WithRespondAsync context.
+func (c *Client) Capabilities(ctx context.Context) (*Capabilities, error) {
+	c.capabilitiesMu.Lock()
+	if c.capabilities != nil {
+		defer c.capabilitiesMu.Unlock()
+		return c.capabilities, nil
+	}
+	fetch := c.capabilitiesFetch
+	if fetch != nil {
+		c.capabilitiesMu.Unlock()
+		select {
+		case <-fetch.done:
+			return fetch.capabilities, fetch.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	fetch = &capabilitiesFetch{done: make(chan struct{})}
+	c.capabilitiesFetch = fetch
+	c.capabilitiesMu.Unlock()
+
+	fetch.capabilities, fetch.err = c.fetchCapabilities(withoutRespondAsync(ctx))
+
+	c.capabilitiesMu.Lock()
+	// The fetch dropped by ResetCapabilities is not cached.
+	if c.capabilitiesFetch == fetch {
+		c.capabilitiesFetch = nil
+		if fetch.err == nil {
+			c.capabilities = fetch.capabilities
+		}
+	}
+	c.capabilitiesMu.Unlock()
+	close(fetch.done)
+	return fetch.capabilities, fetch.err
+}
+
+func (c *Client) fetchCapabilities(ctx context.Context) (*Capabilities, error) {
+	resp, err := c.Request(ctx, http.MethodGet, "metadata", nil)
+	data, err := ExpectedResource(CapabilityStatementResource, resp, err)
+	if err != nil {
+		return nil, err
+	}
+	var statement models.CapabilityStatement
+	if err := c.decoder.Unmarshal(data, &statement); err != nil {
+		return nil, NewUnmarshalError("response parsing", CapabilityStatementResource, data, err)
+	}
+	return NewCapabilities(&statement), nil
+}
+
+// ResetCapabilities drops the cached CapabilityStatement.
+func (c *Client) ResetCapabilities() {
+	c.capabilitiesMu.Lock()
+	defer c.capabilitiesMu.Unlock()
+	c.capabilities = nil
+	c.capabilitiesFetch = nil
+}
+
+// Resource returns the capabilities of the resource type, or nil when the server doesn't support it.
+func (c *Capabilities) Resource(resource ResourceType) *models.CapabilityStatementRestResource {
+	if c.rest == nil {
+		return nil
+	}
+	for i, r := range c.rest.Resource {
+		if r.Type.Code() == string(resource) {
+			return &c.rest.Resource[i]
+		}
+	}
+	return nil
+}
+
+// Resources returns the resource types supported by the server.
+func (c *Capabilities) Resources() []ResourceType {
+	if c.rest == nil {
+		return nil
+	}
+	resources := make([]ResourceType, 0, len(c.rest.Resource))
+	for _, r := range c.rest.Resource {
+		resources = append(resources, ResourceType(r.Type.Code()))
+	}
+	return resources
+}
+
+// SupportsResource reports whether the server supports the resource type.
+func (c *Capabilities) SupportsResource(resource ResourceType) bool {
+	return c.Resource(resource) != nil
+}
+
+// Interactions returns the interactions supported for the resource type.
+func (c *Capabilities) Interactions(resource ResourceType) []models.TypeRestfulInteraction {
+	r := c.Resource(resource)
+	if r == nil {
+		return nil
+	}
+	interactions := make([]models.TypeRestfulInteraction, 0, len(r.Interaction))
+	for _, interaction := range r.Interaction {
+		interactions = append(interactions, interaction.Code)
+	}
+	return interactions
+}
+
+// SupportsInteraction reports whether the interaction is supported for the resource type.
+func (c *Capabilities) SupportsInteraction(resource ResourceType, interaction models.TypeRestfulInteraction) bool {
+	for _, i := range c.Interactions(resource) {
+		if i == interaction {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsSystemInteraction reports whether the system interaction, such as transaction or batch, is supported.
+func (c *Capabilities) SupportsSystemInteraction(interaction models.SystemRestfulInteraction) bool {
+	if c.rest == nil {
+		return false
+	}
+	for _, i := range c.rest.Interaction {
+		if i.Code == interaction {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchParams returns the search parameters supported for the resource type, including the ones for all resources.
+func (c *Capabilities) SearchParams(resource ResourceType) []models.CapabilityStatementRestResourceSearchParam {
+	if c.rest == nil {
+		return nil
+	}
+	params := append([]models.CapabilityStatementRestResourceSearchParam{}, c.rest.SearchParam...)
+	if r := c.Resource(resource); r != nil {
+		params = append(params, r.SearchParam...)
+	}
+	return params
+}
+
+// SupportsSearchParam reports whether the search parameter is supported for the resource type.
+// The modifiers and chains of the name are ignored.
+func (c *Capabilities) SupportsSearchParam(resource ResourceType, name string) bool {
+	if i := strings.IndexAny(name, ":."); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" {
+		return false
+	}
+	for _, param := range c.SearchParams(resource) {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Operations returns the operations supported for the resource type, including the system ones.
+func (c *Capabilities) Operations(resource ResourceType) []models.CapabilityStatementRestResourceOperation {
+	if c.rest == nil {
+		return nil
+	}
+	operations := append([]models.CapabilityStatementRestResourceOperation{}, c.rest.Operation...)
+	if r := c.Resource(resource); r != nil {
+		operations = append(operations, r.Operation...)
+	}
+	return operations
+}
+
+// SupportsOperation reports whether the operation is supported for the resource type. The name may start with "$".
+func (c *Capabilities) SupportsOperation(resource ResourceType, name string) bool {
+	name = strings.TrimPrefix(name, "$")
+	for _, operation := range c.Operations(resource) {
+		if operation.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Formats returns the supported formats, such as json or application/fhir+json.
+func (c *Capabilities) Formats() []string {
+	return models.ToStrings(c.Statement.Format)
+}
+
+// SupportsFormat reports whether the format is supported. The short and MIME type forms are matched, json matches application/fhir+json.
+func (c *Capabilities) SupportsFormat(format string) bool {
+	for _, f := range models.ToStrings(c.Statement.Format) {
+		if f == format || formatName(f) == formatName(format) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatName(format string) string {
+	format = strings.TrimSpace(strings.SplitN(format, ";", 2)[0])
+	if i := strings.LastIndexAny(format, "/+"); i >= 0 {
+		format = format[i+1:]
+	}
+	return format
+}
+
+// PatchFormats returns the supported patch formats, such as application/json-patch+json.
+func (c *Capabilities) PatchFormats() []string {
+	return models.ToStrings(c.Statement.PatchFormat)
+}
+
+// SupportsPatch reports whether the patch interaction is supported for the resource type.
+func (c *Capabilities) SupportsPatch(resource ResourceType) bool {
+	return c.SupportsInteraction(resource, models.TypeRestfulInteractionPatch)
+}
+
+// Versioning returns the versioning policy of the resource type. It returns false when the server doesn't declare it.
+func (c *Capabilities) Versioning(resource ResourceType) (models.ResourceVersionPolicy, bool) {
+	r := c.Resource(resource)
+	if r == nil || r.Versioning == nil {
+		return "", false
+	}
+	return *r.Versioning, true
+}
+
+// CapabilityError is returned in the strict mode for the requests the server doesn't declare in its CapabilityStatement.
+type CapabilityError struct {
+	Resource ResourceType
+	// Interaction is the interaction code, such as read or search-type, or the operation name, such as $everything.
+	Interaction string
+}
+
+func (e CapabilityError) Error() string {
+	if e.Resource == "" {
+		return fmt.Sprintf("server doesn't support \"%s\"", e.Interaction)
+	}
+	return fmt.Sprintf("server doesn't support \"%s\" of \"%s\"", e.Interaction, e.Resource)
+}
+
+// WithStrictCapabilities makes the client check the requests against the CapabilityStatement of the server
+// before sending and fail with CapabilityError on the interactions and operations the server doesn't declare.
+func WithStrictCapabilities() ClientOption {
+	return func(c *Client) error {
+		c.strict = true
+		return nil
+	}
+}
+
+// checkCapabilities checks the request built by the client against the CapabilityStatement in the strict mode.
+// The requests outside the server base and to unknown resource types are not checked.
+func (c *Client) checkCapabilities(ctx context.Context, req *http.Request) error {
+	if !c.strict {
+		return nil
+	}
+	base, err := url.Parse(c.Server)
+	if err != nil {
+		return err
+	}
+	if req.URL.Host != base.Host || !strings.HasPrefix(req.URL.Path, base.Path) {
+		return nil
+	}
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, base.Path), "/")
+	if path == "metadata" {
+		return nil
+	}
+
+	resource, interaction, operation := requestInteraction(req.Method, path)
+	if operation == "" && resource != "" && TypeOf(resource) == nil {
+		// The interactions with the unknown resource types, such as the custom ones, are not checked.
+		return nil
+	}
+	capabilities, err := c.Capabilities(ctx)
+	if err != nil {
+		return err
+	}
+	switch {
+	case operation != "":
+		if !capabilities.SupportsOperation(resource, operation) {
+			return CapabilityError{Resource: resource, Interaction: operation}
+		}
+	case resource == "":
+		if req.Method == http.MethodPost && path == "" &&
+			!capabilities.SupportsSystemInteraction(models.SystemRestfulInteractionTransaction) &&
+			!capabilities.SupportsSystemInteraction(models.SystemRestfulInteractionBatch) {
+			return CapabilityError{Interaction: "transaction"}
+		}
+	case !capabilities.SupportsInteraction(resource, interaction):
+		return CapabilityError{Resource: resource, Interaction: interaction.Code()}
+	}
+	return nil
+}
+
+// requestInteraction returns the resource type and the interaction or operation of the request to the path.
+// The resource type is empty for the system requests.
+func requestInteraction(method, path string) (resource ResourceType, interaction models.TypeRestfulInteraction, operation string) {
+	parts := strings.Split(path, "/")
+	if path == "" {
+		parts = nil
+	}
+	for _, part := range parts {
+		if strings.HasPrefix(part, "$") {
+			operation = part
+		}
+	}
+	if len(parts) == 0 || strings.HasPrefix(parts[0], "$") {
+		return "", "", operation
+	}
+	resource = ResourceType(parts[0])
+	// Compartment search, such as Patient/123/Observation.
+	if len(parts) >= 3 && parts[2] != "_history" && operation == "" {
+		resource = ResourceType(parts[2])
+		parts = parts[2:]
+	}
+
+	switch {
+	case operation != "":
+	case len(parts) == 2 && parts[1] == "_history":
+		interaction = models.TypeRestfulInteractionHistoryType
+	case len(parts) == 3 && parts[2] == "_history":
+		interaction = models.TypeRestfulInteractionHistoryInstance
+	case len(parts) == 4:
+		interaction = models.TypeRestfulInteractionVread
+	case method == http.MethodPut:
+		interaction = models.TypeRestfulInteractionUpdate
+	case method == http.MethodPatch:
+		interaction = models.TypeRestfulInteractionPatch
+	case method == http.MethodDelete:
+		interaction = models.TypeRestfulInteractionDelete
+	case len(parts) == 2 && parts[1] == "_search", len(parts) == 1 && method == http.MethodGet:
+		interaction = models.TypeRestfulInteractionSearchType
+	case len(parts) == 1 && method == http.MethodPost:
+		interaction = models.TypeRestfulInteractionCreate
+	default:
+		interaction = models.TypeRestfulInteractionRead
+	}
+	return resource, interaction, operation
+}
@@ -0,0 +1,156 @@
+package fhir_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestCapabilitiesFetchedOnce(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if prefer := r.Header.Get("Prefer"); prefer != "" {
+			t.Errorf("unexpected Prefer header %q", prefer)
+		}
+		<-release
+		w.Header().Set("Content-Type", "application/fhir+json")
+		_, _ = w.Write([]byte(`{"resourceType":"CapabilityStatement","status":"active","kind":"instance","fhirVersion":"4.0.1","format":["json"],"rest":[{"mode":"server"}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := fhir.New(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := fhir.WithRespondAsync(context.Background())
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Capabilities(ctx); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+	if _, err := client.Capabilities(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected one /metadata request, got %d", n)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	capabilities, err := client.Capabilities(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Capabilities(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("CapabilityStatement is fetched %d times", n)
+	}
+	versioning, ok := capabilities.Versioning(fhir.PatientResource)
+	if !capabilities.SupportsInteraction(fhir.PatientResource, models.TypeRestfulInteractionRead) ||
+		!capabilities.SupportsPatch(fhir.ObservationResource) ||
+		!capabilities.SupportsOperation(fhir.PatientResource, "$everything") ||
+		capabilities.SupportsOperation(fhir.ObservationResource, "$everything") ||
+		!capabilities.SupportsSystemInteraction(models.SystemRestfulInteractionTransaction) ||
+		!capabilities.SupportsFormat("json") ||
+		!ok || versioning != models.ResourceVersionPolicyVersioned {
+		t.Errorf("unexpected capabilities: %s", mustJSON(capabilities.Statement))
+	}
+	for _, name := range []string{"", ":", ".", ":exact"} {
+		if capabilities.SupportsSearchParam(fhir.PatientResource, name) {
+			t.Errorf("expected the search parameter %q unsupported", name)
+		}
+	}
+
+	// The strict client fails before sending the requests the server doesn't declare.
+	srv = fhirtest.NewServer(fhirtest.WithCapabilityStatement(&models.CapabilityStatement{
+		Status:      models.PublicationStatusActive,
+		Kind:        models.CapabilityStatementKindInstance,
+		FhirVersion: models.FHIRVersion4_0_1,
+		Format:      models.NewStrings("json"),
+		Rest: []models.CapabilityStatementRest{{
+			Mode: models.RestfulCapabilityModeServer,
+			Resource: []models.CapabilityStatementRestResource{{
+				Type: models.ResourceTypePatient,
+				Interaction: []models.CapabilityStatementRestResourceInteraction{
+					{Code: models.TypeRestfulInteractionRead},
+					{Code: models.TypeRestfulInteractionSearchType},
+				},
+			}},
+		}},
+	}))
+	defer srv.Close()
+	if err := srv.Seed(&models.Patient{ID: models.NewString("p1")}); err != nil {
+		t.Fatal(err)
+	}
+	strict, err := srv.Client(fhir.WithStrictCapabilities())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := strict.GetPatientByID(ctx, "p1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := strict.GetPatient(ctx, fhir.PatientSearch().Name().Eq("x")); err != nil {
+		t.Fatal(err)
+	}
+	sent := len(srv.Requests())
+
+	tests := []struct {
+		name string
+		call func() error
+		want fhir.CapabilityError
+	}{
+		{
+			name: "create",
+			call: func() error { _, err := strict.CreatePatient(ctx, nil, newPatient("Doe")); return err },
+			want: fhir.CapabilityError{Resource: fhir.PatientResource, Interaction: "create"},
+		},
+		{
+			name: "resource",
+			call: func() error { _, err := strict.GetObservation(ctx, nil); return err },
+			want: fhir.CapabilityError{Resource: fhir.ObservationResource, Interaction: "search-type"},
+		},
+		{
+			name: "operation",
+			call: func() error { _, err := strict.PatientEverything(ctx, "p1", nil); return err },
+			want: fhir.CapabilityError{Resource: fhir.PatientResource, Interaction: "$everything"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e fhir.CapabilityError
+			if err := tt.call(); !errors.As(err, &e) || e != tt.want {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+	if n := len(srv.Requests()); n != sent {
+		t.Errorf("%d requests are sent", n-sent)
+	}
+}
@@ -0,0 +1,123 @@
+package fhir
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryError is returned for the invalid search query, such as the chain to an unknown resource type.
+type QueryError struct {
+	Param   string
+	Message string
+}
+
+func (e QueryError) Error() string {
+	return fmt.Sprintf("invalid search parameter \"%s\": %s", e.Param, e.Message)
+}
+
+// ResourceQuery is the query of the resource type, such as PatientQuery or the query created with NewResourceQuery.
+type ResourceQuery interface {
+	Parameters
+	resourceQuery() *Query
+}
+
+func (q *Query) resourceQuery() *Query {
+	return q
+}
+
+// chainExcluded are the parameters which can't be chained, as they don't filter resources.
+var chainExcluded = map[string]bool{
+	"_count":      true,
+	"_offset":     true,
+	"_sort":       true,
+	"_summary":    true,
+	"_elements":   true,
+	"_total":      true,
+	"_include":    true,
+	"_revinclude": true,
+	"_contained":  true,
+	"_format":     true,
+}
+
+// Chain adds the parameters of the target query chained to the reference parameter with the type modifier,
+// such as subject:Patient.name=peter. The chains may be nested.
+func (q *Query) Chain(reference string, target ResourceQuery) {
+	t := target.resourceQuery()
+	name := reference + ":" + string(t.resource)
+	if err := q.checkReference(q.resource, reference, t.resource); err != nil {
+		q.setErr(QueryError{Param: name, Message: err.Error()})
+		return
+	}
+	q.merge(name+".", t)
+}
+
+// Has adds the parameters of the source query as the reverse chain, such as _has:Observation:patient:code=1234-5.
+// The query resources are matched when the resources found with the source query refer to them by the reference parameter.
+func (q *Query) Has(source ResourceQuery, reference string) {
+	s := source.resourceQuery()
+	name := "_has:" + string(s.resource) + ":" + reference
+	if err := q.checkReference(s.resource, reference, q.resource); err != nil {
+		q.setErr(QueryError{Param: name, Message: err.Error()})
+		return
+	}
+	q.merge(name+":", s)
+}
+
+// checkReference checks that the reference parameter of the resource type may refer to the target type.
+// The types are checked against the search parameter definitions, when they are known.
+func (q *Query) checkReference(resource ResourceType, reference string, target ResourceType) error {
+	for _, t := range []ResourceType{resource, target} {
+		if t == "" {
+			return fmt.Errorf("resource type is required")
+		}
+		if TypeOf(t) == nil {
+			return fmt.Errorf("unknown resource type \"%s\"", t)
+		}
+	}
+	params, ok := referenceTargets[resource]
+	if !ok {
+		return nil
+	}
+	targets, ok := params[reference]
+	if !ok {
+		return fmt.Errorf("\"%s\" is not a reference parameter of \"%s\"", reference, resource)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	for _, t := range targets {
+		if t == target {
+			return nil
+		}
+	}
+	return fmt.Errorf("\"%s\" of \"%s\" can't refer to \"%s\"", reference, resource, target)
+}
+
+// merge adds the parameters of the other query with the prefix.
+func (q *Query) merge(prefix string, other *Query) {
+	if other.err != nil {
+		q.setErr(other.err)
+		return
+	}
+	for name, values := range other.values {
+		if chainExcluded[strings.SplitN(name, ":", 2)[0]] {
+			q.setErr(QueryError{Param: prefix + name, Message: "parameter can't be chained"})
+			return
+		}
+		for _, value := range values {
+			q.Add(prefix+name, value)
+		}
+	}
+}
+
+func (q *Query) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// ReferenceTargets returns the resource types the reference search parameter of the resource may refer to.
+// It returns nil when the parameter may refer to any resource or is unknown.
+func ReferenceTargets(resource ResourceType, reference string) []ResourceType {
+	return referenceTargets[resource][reference]
+}
@@ -2,7 +2,6 @@ package fhir
 
 import (
 	"context"
-	"encoding/json"
 	"io"
 	"net/http"
 
@@ -21,8 +20,234 @@ type ClientInterface interface {
 	UpdateByID(ctx context.Context, resource ResourceType, id string, params Parameters, body interface{}) (*FhirResponse, error)
 	Patch(ctx context.Context, resource ResourceType, params Parameters, body interface{}) (*FhirResponse, error)
 	PatchByID(ctx context.Context, resource ResourceType, id string, params Parameters, body interface{}) (*FhirResponse, error)
+	GetCompartment(ctx context.Context, compartment ResourceType, id string, resource ResourceType, params Parameters) (*FhirResponse, error)
+	Search(ctx context.Context, resource ResourceType, params Parameters) (*SearchResult, error)
+	SearchPage(ctx context.Context, url string) (*SearchResult, error)
+	Count(ctx context.Context, resource ResourceType, params Parameters) (int, error)
+	GetDeviceCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error)
+	GetAccountByDevice(ctx context.Context, id string, params Parameters) ([]*models.Account, error)
+	GetAppointmentByDevice(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error)
+	GetAppointmentResponseByDevice(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error)
+	GetAuditEventByDevice(ctx context.Context, id string, params Parameters) ([]*models.AuditEvent, error)
+	GetChargeItemByDevice(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error)
+	GetClaimByDevice(ctx context.Context, id string, params Parameters) ([]*models.Claim, error)
+	GetCommunicationByDevice(ctx context.Context, id string, params Parameters) ([]*models.Communication, error)
+	GetCommunicationRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error)
+	GetCompositionByDevice(ctx context.Context, id string, params Parameters) ([]*models.Composition, error)
+	GetDetectedIssueByDevice(ctx context.Context, id string, params Parameters) ([]*models.DetectedIssue, error)
+	GetDeviceByDevice(ctx context.Context, id string, params Parameters) ([]*models.Device, error)
+	GetDeviceRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error)
+	GetDeviceUseStatementByDevice(ctx context.Context, id string, params Parameters) ([]*models.DeviceUseStatement, error)
+	GetDiagnosticReportByDevice(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error)
+	GetDocumentManifestByDevice(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error)
+	GetDocumentReferenceByDevice(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error)
+	GetExplanationOfBenefitByDevice(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error)
+	GetFlagByDevice(ctx context.Context, id string, params Parameters) ([]*models.Flag, error)
+	GetGroupByDevice(ctx context.Context, id string, params Parameters) ([]*models.Group, error)
+	GetInvoiceByDevice(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error)
+	GetListByDevice(ctx context.Context, id string, params Parameters) ([]*models.List, error)
+	GetMediaByDevice(ctx context.Context, id string, params Parameters) ([]*models.Media, error)
+	GetMedicationAdministrationByDevice(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error)
+	GetMessageHeaderByDevice(ctx context.Context, id string, params Parameters) ([]*models.MessageHeader, error)
+	GetObservationByDevice(ctx context.Context, id string, params Parameters) ([]*models.Observation, error)
+	GetProvenanceByDevice(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error)
+	GetQuestionnaireResponseByDevice(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error)
+	GetRequestGroupByDevice(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error)
+	GetRiskAssessmentByDevice(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error)
+	GetScheduleByDevice(ctx context.Context, id string, params Parameters) ([]*models.Schedule, error)
+	GetServiceRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error)
+	GetSpecimenByDevice(ctx context.Context, id string, params Parameters) ([]*models.Specimen, error)
+	GetSupplyRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error)
+	GetEncounterCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error)
+	GetCarePlanByEncounter(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error)
+	GetCareTeamByEncounter(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error)
+	GetChargeItemByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error)
+	GetClaimByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Claim, error)
+	GetClinicalImpressionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ClinicalImpression, error)
+	GetCommunicationByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Communication, error)
+	GetCommunicationRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error)
+	GetCompositionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Composition, error)
+	GetConditionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Condition, error)
+	GetDeviceRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error)
+	GetDiagnosticReportByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error)
+	GetDocumentManifestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error)
+	GetDocumentReferenceByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error)
+	GetEncounterByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error)
+	GetExplanationOfBenefitByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error)
+	GetMediaByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Media, error)
+	GetMedicationAdministrationByEncounter(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error)
+	GetMedicationRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.MedicationRequest, error)
+	GetNutritionOrderByEncounter(ctx context.Context, id string, params Parameters) ([]*models.NutritionOrder, error)
+	GetObservationByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Observation, error)
+	GetProcedureByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error)
+	GetQuestionnaireResponseByEncounter(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error)
+	GetRequestGroupByEncounter(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error)
+	GetRiskAssessmentByEncounter(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error)
+	GetServiceRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error)
+	GetVisionPrescriptionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.VisionPrescription, error)
+	GetPatientCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error)
+	GetAccountByPatient(ctx context.Context, id string, params Parameters) ([]*models.Account, error)
+	GetAdverseEventByPatient(ctx context.Context, id string, params Parameters) ([]*models.AdverseEvent, error)
+	GetAllergyIntoleranceByPatient(ctx context.Context, id string, params Parameters) ([]*models.AllergyIntolerance, error)
+	GetAppointmentByPatient(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error)
+	GetAppointmentResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error)
+	GetAuditEventByPatient(ctx context.Context, id string, params Parameters) ([]*models.AuditEvent, error)
+	GetBasicByPatient(ctx context.Context, id string, params Parameters) ([]*models.Basic, error)
+	GetBodyStructureByPatient(ctx context.Context, id string, params Parameters) ([]*models.BodyStructure, error)
+	GetCarePlanByPatient(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error)
+	GetCareTeamByPatient(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error)
+	GetChargeItemByPatient(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error)
+	GetClaimByPatient(ctx context.Context, id string, params Parameters) ([]*models.Claim, error)
+	GetClaimResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.ClaimResponse, error)
+	GetClinicalImpressionByPatient(ctx context.Context, id string, params Parameters) ([]*models.ClinicalImpression, error)
+	GetCommunicationByPatient(ctx context.Context, id string, params Parameters) ([]*models.Communication, error)
+	GetCommunicationRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error)
+	GetCompositionByPatient(ctx context.Context, id string, params Parameters) ([]*models.Composition, error)
+	GetConditionByPatient(ctx context.Context, id string, params Parameters) ([]*models.Condition, error)
+	GetConsentByPatient(ctx context.Context, id string, params Parameters) ([]*models.Consent, error)
+	GetCoverageByPatient(ctx context.Context, id string, params Parameters) ([]*models.Coverage, error)
+	GetCoverageEligibilityRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityRequest, error)
+	GetCoverageEligibilityResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityResponse, error)
+	GetDetectedIssueByPatient(ctx context.Context, id string, params Parameters) ([]*models.DetectedIssue, error)
+	GetDeviceRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error)
+	GetDeviceUseStatementByPatient(ctx context.Context, id string, params Parameters) ([]*models.DeviceUseStatement, error)
+	GetDiagnosticReportByPatient(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error)
+	GetDocumentManifestByPatient(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error)
+	GetDocumentReferenceByPatient(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error)
+	GetEncounterByPatient(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error)
+	GetEnrollmentRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.EnrollmentRequest, error)
+	GetEpisodeOfCareByPatient(ctx context.Context, id string, params Parameters) ([]*models.EpisodeOfCare, error)
+	GetExplanationOfBenefitByPatient(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error)
+	GetFamilyMemberHistoryByPatient(ctx context.Context, id string, params Parameters) ([]*models.FamilyMemberHistory, error)
+	GetFlagByPatient(ctx context.Context, id string, params Parameters) ([]*models.Flag, error)
+	GetGoalByPatient(ctx context.Context, id string, params Parameters) ([]*models.Goal, error)
+	GetGroupByPatient(ctx context.Context, id string, params Parameters) ([]*models.Group, error)
+	GetImagingStudyByPatient(ctx context.Context, id string, params Parameters) ([]*models.ImagingStudy, error)
+	GetImmunizationByPatient(ctx context.Context, id string, params Parameters) ([]*models.Immunization, error)
+	GetImmunizationEvaluationByPatient(ctx context.Context, id string, params Parameters) ([]*models.ImmunizationEvaluation, error)
+	GetImmunizationRecommendationByPatient(ctx context.Context, id string, params Parameters) ([]*models.ImmunizationRecommendation, error)
+	GetInvoiceByPatient(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error)
+	GetListByPatient(ctx context.Context, id string, params Parameters) ([]*models.List, error)
+	GetMeasureReportByPatient(ctx context.Context, id string, params Parameters) ([]*models.MeasureReport, error)
+	GetMediaByPatient(ctx context.Context, id string, params Parameters) ([]*models.Media, error)
+	GetMedicationAdministrationByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error)
+	GetMedicationDispenseByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationDispense, error)
+	GetMedicationRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationRequest, error)
+	GetMedicationStatementByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationStatement, error)
+	GetMolecularSequenceByPatient(ctx context.Context, id string, params Parameters) ([]*models.MolecularSequence, error)
+	GetNutritionOrderByPatient(ctx context.Context, id string, params Parameters) ([]*models.NutritionOrder, error)
+	GetObservationByPatient(ctx context.Context, id string, params Parameters) ([]*models.Observation, error)
+	GetPatientByPatient(ctx context.Context, id string, params Parameters) ([]*models.Patient, error)
+	GetPersonByPatient(ctx context.Context, id string, params Parameters) ([]*models.Person, error)
+	GetProcedureByPatient(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error)
+	GetProvenanceByPatient(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error)
+	GetQuestionnaireResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error)
+	GetRelatedPersonByPatient(ctx context.Context, id string, params Parameters) ([]*models.RelatedPerson, error)
+	GetRequestGroupByPatient(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error)
+	GetResearchSubjectByPatient(ctx context.Context, id string, params Parameters) ([]*models.ResearchSubject, error)
+	GetRiskAssessmentByPatient(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error)
+	GetScheduleByPatient(ctx context.Context, id string, params Parameters) ([]*models.Schedule, error)
+	GetServiceRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error)
+	GetSpecimenByPatient(ctx context.Context, id string, params Parameters) ([]*models.Specimen, error)
+	GetSupplyDeliveryByPatient(ctx context.Context, id string, params Parameters) ([]*models.SupplyDelivery, error)
+	GetSupplyRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error)
+	GetVisionPrescriptionByPatient(ctx context.Context, id string, params Parameters) ([]*models.VisionPrescription, error)
+	GetPractitionerCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error)
+	GetAccountByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Account, error)
+	GetAdverseEventByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AdverseEvent, error)
+	GetAllergyIntoleranceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AllergyIntolerance, error)
+	GetAppointmentByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error)
+	GetAppointmentResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error)
+	GetAuditEventByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AuditEvent, error)
+	GetBasicByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Basic, error)
+	GetCarePlanByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error)
+	GetCareTeamByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error)
+	GetChargeItemByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error)
+	GetClaimByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Claim, error)
+	GetClaimResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ClaimResponse, error)
+	GetClinicalImpressionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ClinicalImpression, error)
+	GetCommunicationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Communication, error)
+	GetCommunicationRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error)
+	GetCompositionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Composition, error)
+	GetConditionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Condition, error)
+	GetCoverageEligibilityRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityRequest, error)
+	GetCoverageEligibilityResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityResponse, error)
+	GetDetectedIssueByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DetectedIssue, error)
+	GetDeviceRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error)
+	GetDiagnosticReportByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error)
+	GetDocumentManifestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error)
+	GetDocumentReferenceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error)
+	GetEncounterByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error)
+	GetEpisodeOfCareByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.EpisodeOfCare, error)
+	GetExplanationOfBenefitByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error)
+	GetFlagByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Flag, error)
+	GetGroupByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Group, error)
+	GetImmunizationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Immunization, error)
+	GetInvoiceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error)
+	GetLinkageByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Linkage, error)
+	GetListByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.List, error)
+	GetMediaByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Media, error)
+	GetMedicationAdministrationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error)
+	GetMedicationDispenseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationDispense, error)
+	GetMedicationRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationRequest, error)
+	GetMedicationStatementByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationStatement, error)
+	GetMessageHeaderByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MessageHeader, error)
+	GetNutritionOrderByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.NutritionOrder, error)
+	GetObservationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Observation, error)
+	GetPatientByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Patient, error)
+	GetPaymentNoticeByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.PaymentNotice, error)
+	GetPaymentReconciliationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.PaymentReconciliation, error)
+	GetPersonByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Person, error)
+	GetPractitionerByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Practitioner, error)
+	GetPractitionerRoleByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.PractitionerRole, error)
+	GetProcedureByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error)
+	GetProvenanceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error)
+	GetQuestionnaireResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error)
+	GetRequestGroupByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error)
+	GetResearchStudyByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ResearchStudy, error)
+	GetRiskAssessmentByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error)
+	GetScheduleByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Schedule, error)
+	GetServiceRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error)
+	GetSpecimenByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Specimen, error)
+	GetSupplyDeliveryByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.SupplyDelivery, error)
+	GetSupplyRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error)
+	GetVisionPrescriptionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.VisionPrescription, error)
+	GetRelatedPersonCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error)
+	GetAdverseEventByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.AdverseEvent, error)
+	GetAllergyIntoleranceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.AllergyIntolerance, error)
+	GetAppointmentByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error)
+	GetAppointmentResponseByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error)
+	GetBasicByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Basic, error)
+	GetCarePlanByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error)
+	GetCareTeamByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error)
+	GetChargeItemByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error)
+	GetClaimByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Claim, error)
+	GetCommunicationByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Communication, error)
+	GetCommunicationRequestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error)
+	GetCompositionByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Composition, error)
+	GetConditionByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Condition, error)
+	GetCoverageByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Coverage, error)
+	GetDocumentManifestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error)
+	GetDocumentReferenceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error)
+	GetEncounterByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error)
+	GetExplanationOfBenefitByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error)
+	GetInvoiceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error)
+	GetMedicationAdministrationByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error)
+	GetMedicationStatementByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.MedicationStatement, error)
+	GetObservationByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Observation, error)
+	GetPatientByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Patient, error)
+	GetPersonByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Person, error)
+	GetProcedureByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error)
+	GetProvenanceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error)
+	GetQuestionnaireResponseByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error)
+	GetRelatedPersonByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.RelatedPerson, error)
+	GetRequestGroupByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error)
+	GetServiceRequestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error)
+	GetSupplyRequestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error)
 	GetAccount(ctx context.Context, params Parameters) ([]*models.Account, error)
 	GetAccountByID(ctx context.Context, id string, params Parameters) (*models.Account, error)
+	SearchAccount(ctx context.Context, params Parameters) (*AccountSearchResult, error)
+	SearchAccountPage(ctx context.Context, url string) (*AccountSearchResult, error)
 	CreateAccount(ctx context.Context, params Parameters, entity *models.Account) (*models.Account, error)
 	UpdateAccount(ctx context.Context, params Parameters, entity *models.Account) (*models.Account, error)
 	UpdateAccountByID(ctx context.Context, id string, params Parameters, entity *models.Account) (*models.Account, error)
@@ -32,6 +257,8 @@ type ClientInterface interface {
 	DeleteAccountByID(ctx context.Context, id string, params Parameters) (*models.Account, error)
 	GetActivityDefinition(ctx context.Context, params Parameters) ([]*models.ActivityDefinition, error)
 	GetActivityDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ActivityDefinition, error)
+	SearchActivityDefinition(ctx context.Context, params Parameters) (*ActivityDefinitionSearchResult, error)
+	SearchActivityDefinitionPage(ctx context.Context, url string) (*ActivityDefinitionSearchResult, error)
 	CreateActivityDefinition(ctx context.Context, params Parameters, entity *models.ActivityDefinition) (*models.ActivityDefinition, error)
 	UpdateActivityDefinition(ctx context.Context, params Parameters, entity *models.ActivityDefinition) (*models.ActivityDefinition, error)
 	UpdateActivityDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.ActivityDefinition) (*models.ActivityDefinition, error)
@@ -41,6 +268,8 @@ type ClientInterface interface {
 	DeleteActivityDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ActivityDefinition, error)
 	GetAdverseEvent(ctx context.Context, params Parameters) ([]*models.AdverseEvent, error)
 	GetAdverseEventByID(ctx context.Context, id string, params Parameters) (*models.AdverseEvent, error)
+	SearchAdverseEvent(ctx context.Context, params Parameters) (*AdverseEventSearchResult, error)
+	SearchAdverseEventPage(ctx context.Context, url string) (*AdverseEventSearchResult, error)
 	CreateAdverseEvent(ctx context.Context, params Parameters, entity *models.AdverseEvent) (*models.AdverseEvent, error)
 	UpdateAdverseEvent(ctx context.Context, params Parameters, entity *models.AdverseEvent) (*models.AdverseEvent, error)
 	UpdateAdverseEventByID(ctx context.Context, id string, params Parameters, entity *models.AdverseEvent) (*models.AdverseEvent, error)
@@ -50,6 +279,8 @@ type ClientInterface interface {
 	DeleteAdverseEventByID(ctx context.Context, id string, params Parameters) (*models.AdverseEvent, error)
 	GetAllergyIntolerance(ctx context.Context, params Parameters) ([]*models.AllergyIntolerance, error)
 	GetAllergyIntoleranceByID(ctx context.Context, id string, params Parameters) (*models.AllergyIntolerance, error)
+	SearchAllergyIntolerance(ctx context.Context, params Parameters) (*AllergyIntoleranceSearchResult, error)
+	SearchAllergyIntolerancePage(ctx context.Context, url string) (*AllergyIntoleranceSearchResult, error)
 	CreateAllergyIntolerance(ctx context.Context, params Parameters, entity *models.AllergyIntolerance) (*models.AllergyIntolerance, error)
 	UpdateAllergyIntolerance(ctx context.Context, params Parameters, entity *models.AllergyIntolerance) (*models.AllergyIntolerance, error)
 	UpdateAllergyIntoleranceByID(ctx context.Context, id string, params Parameters, entity *models.AllergyIntolerance) (*models.AllergyIntolerance, error)
@@ -59,6 +290,8 @@ type ClientInterface interface {
 	DeleteAllergyIntoleranceByID(ctx context.Context, id string, params Parameters) (*models.AllergyIntolerance, error)
 	GetAppointment(ctx context.Context, params Parameters) ([]*models.Appointment, error)
 	GetAppointmentByID(ctx context.Context, id string, params Parameters) (*models.Appointment, error)
+	SearchAppointment(ctx context.Context, params Parameters) (*AppointmentSearchResult, error)
+	SearchAppointmentPage(ctx context.Context, url string) (*AppointmentSearchResult, error)
 	CreateAppointment(ctx context.Context, params Parameters, entity *models.Appointment) (*models.Appointment, error)
 	UpdateAppointment(ctx context.Context, params Parameters, entity *models.Appointment) (*models.Appointment, error)
 	UpdateAppointmentByID(ctx context.Context, id string, params Parameters, entity *models.Appointment) (*models.Appointment, error)
@@ -68,6 +301,8 @@ type ClientInterface interface {
 	DeleteAppointmentByID(ctx context.Context, id string, params Parameters) (*models.Appointment, error)
 	GetAppointmentResponse(ctx context.Context, params Parameters) ([]*models.AppointmentResponse, error)
 	GetAppointmentResponseByID(ctx context.Context, id string, params Parameters) (*models.AppointmentResponse, error)
+	SearchAppointmentResponse(ctx context.Context, params Parameters) (*AppointmentResponseSearchResult, error)
+	SearchAppointmentResponsePage(ctx context.Context, url string) (*AppointmentResponseSearchResult, error)
 	CreateAppointmentResponse(ctx context.Context, params Parameters, entity *models.AppointmentResponse) (*models.AppointmentResponse, error)
 	UpdateAppointmentResponse(ctx context.Context, params Parameters, entity *models.AppointmentResponse) (*models.AppointmentResponse, error)
 	UpdateAppointmentResponseByID(ctx context.Context, id string, params Parameters, entity *models.AppointmentResponse) (*models.AppointmentResponse, error)
@@ -77,6 +312,8 @@ type ClientInterface interface {
 	DeleteAppointmentResponseByID(ctx context.Context, id string, params Parameters) (*models.AppointmentResponse, error)
 	GetAuditEvent(ctx context.Context, params Parameters) ([]*models.AuditEvent, error)
 	GetAuditEventByID(ctx context.Context, id string, params Parameters) (*models.AuditEvent, error)
+	SearchAuditEvent(ctx context.Context, params Parameters) (*AuditEventSearchResult, error)
+	SearchAuditEventPage(ctx context.Context, url string) (*AuditEventSearchResult, error)
 	CreateAuditEvent(ctx context.Context, params Parameters, entity *models.AuditEvent) (*models.AuditEvent, error)
 	UpdateAuditEvent(ctx context.Context, params Parameters, entity *models.AuditEvent) (*models.AuditEvent, error)
 	UpdateAuditEventByID(ctx context.Context, id string, params Parameters, entity *models.AuditEvent) (*models.AuditEvent, error)
@@ -86,6 +323,8 @@ type ClientInterface interface {
 	DeleteAuditEventByID(ctx context.Context, id string, params Parameters) (*models.AuditEvent, error)
 	GetBasic(ctx context.Context, params Parameters) ([]*models.Basic, error)
 	GetBasicByID(ctx context.Context, id string, params Parameters) (*models.Basic, error)
+	SearchBasic(ctx context.Context, params Parameters) (*BasicSearchResult, error)
+	SearchBasicPage(ctx context.Context, url string) (*BasicSearchResult, error)
 	CreateBasic(ctx context.Context, params Parameters, entity *models.Basic) (*models.Basic, error)
 	UpdateBasic(ctx context.Context, params Parameters, entity *models.Basic) (*models.Basic, error)
 	UpdateBasicByID(ctx context.Context, id string, params Parameters, entity *models.Basic) (*models.Basic, error)
@@ -95,6 +334,8 @@ type ClientInterface interface {
 	DeleteBasicByID(ctx context.Context, id string, params Parameters) (*models.Basic, error)
 	GetBinary(ctx context.Context, params Parameters) ([]*models.Binary, error)
 	GetBinaryByID(ctx context.Context, id string, params Parameters) (*models.Binary, error)
+	SearchBinary(ctx context.Context, params Parameters) (*BinarySearchResult, error)
+	SearchBinaryPage(ctx context.Context, url string) (*BinarySearchResult, error)
 	CreateBinary(ctx context.Context, params Parameters, entity *models.Binary) (*models.Binary, error)
 	UpdateBinary(ctx context.Context, params Parameters, entity *models.Binary) (*models.Binary, error)
 	UpdateBinaryByID(ctx context.Context, id string, params Parameters, entity *models.Binary) (*models.Binary, error)
@@ -104,6 +345,8 @@ type ClientInterface interface {
 	DeleteBinaryByID(ctx context.Context, id string, params Parameters) (*models.Binary, error)
 	GetBiologicallyDerivedProduct(ctx context.Context, params Parameters) ([]*models.BiologicallyDerivedProduct, error)
 	GetBiologicallyDerivedProductByID(ctx context.Context, id string, params Parameters) (*models.BiologicallyDerivedProduct, error)
+	SearchBiologicallyDerivedProduct(ctx context.Context, params Parameters) (*BiologicallyDerivedProductSearchResult, error)
+	SearchBiologicallyDerivedProductPage(ctx context.Context, url string) (*BiologicallyDerivedProductSearchResult, error)
 	CreateBiologicallyDerivedProduct(ctx context.Context, params Parameters, entity *models.BiologicallyDerivedProduct) (*models.BiologicallyDerivedProduct, error)
 	UpdateBiologicallyDerivedProduct(ctx context.Context, params Parameters, entity *models.BiologicallyDerivedProduct) (*models.BiologicallyDerivedProduct, error)
 	UpdateBiologicallyDerivedProductByID(ctx context.Context, id string, params Parameters, entity *models.BiologicallyDerivedProduct) (*models.BiologicallyDerivedProduct, error)
@@ -113,6 +356,8 @@ type ClientInterface interface {
 	DeleteBiologicallyDerivedProductByID(ctx context.Context, id string, params Parameters) (*models.BiologicallyDerivedProduct, error)
 	GetBodyStructure(ctx context.Context, params Parameters) ([]*models.BodyStructure, error)
 	GetBodyStructureByID(ctx context.Context, id string, params Parameters) (*models.BodyStructure, error)
+	SearchBodyStructure(ctx context.Context, params Parameters) (*BodyStructureSearchResult, error)
+	SearchBodyStructurePage(ctx context.Context, url string) (*BodyStructureSearchResult, error)
 	CreateBodyStructure(ctx context.Context, params Parameters, entity *models.BodyStructure) (*models.BodyStructure, error)
 	UpdateBodyStructure(ctx context.Context, params Parameters, entity *models.BodyStructure) (*models.BodyStructure, error)
 	UpdateBodyStructureByID(ctx context.Context, id string, params Parameters, entity *models.BodyStructure) (*models.BodyStructure, error)
@@ -122,6 +367,8 @@ type ClientInterface interface {
 	DeleteBodyStructureByID(ctx context.Context, id string, params Parameters) (*models.BodyStructure, error)
 	GetCapabilityStatement(ctx context.Context, params Parameters) ([]*models.CapabilityStatement, error)
 	GetCapabilityStatementByID(ctx context.Context, id string, params Parameters) (*models.CapabilityStatement, error)
+	SearchCapabilityStatement(ctx context.Context, params Parameters) (*CapabilityStatementSearchResult, error)
+	SearchCapabilityStatementPage(ctx context.Context, url string) (*CapabilityStatementSearchResult, error)
 	CreateCapabilityStatement(ctx context.Context, params Parameters, entity *models.CapabilityStatement) (*models.CapabilityStatement, error)
 	UpdateCapabilityStatement(ctx context.Context, params Parameters, entity *models.CapabilityStatement) (*models.CapabilityStatement, error)
 	UpdateCapabilityStatementByID(ctx context.Context, id string, params Parameters, entity *models.CapabilityStatement) (*models.CapabilityStatement, error)
@@ -131,6 +378,8 @@ type ClientInterface interface {
 	DeleteCapabilityStatementByID(ctx context.Context, id string, params Parameters) (*models.CapabilityStatement, error)
 	GetCarePlan(ctx context.Context, params Parameters) ([]*models.CarePlan, error)
 	GetCarePlanByID(ctx context.Context, id string, params Parameters) (*models.CarePlan, error)
+	SearchCarePlan(ctx context.Context, params Parameters) (*CarePlanSearchResult, error)
+	SearchCarePlanPage(ctx context.Context, url string) (*CarePlanSearchResult, error)
 	CreateCarePlan(ctx context.Context, params Parameters, entity *models.CarePlan) (*models.CarePlan, error)
 	UpdateCarePlan(ctx context.Context, params Parameters, entity *models.CarePlan) (*models.CarePlan, error)
 	UpdateCarePlanByID(ctx context.Context, id string, params Parameters, entity *models.CarePlan) (*models.CarePlan, error)
@@ -140,6 +389,8 @@ type ClientInterface interface {
 	DeleteCarePlanByID(ctx context.Context, id string, params Parameters) (*models.CarePlan, error)
 	GetCareTeam(ctx context.Context, params Parameters) ([]*models.CareTeam, error)
 	GetCareTeamByID(ctx context.Context, id string, params Parameters) (*models.CareTeam, error)
+	SearchCareTeam(ctx context.Context, params Parameters) (*CareTeamSearchResult, error)
+	SearchCareTeamPage(ctx context.Context, url string) (*CareTeamSearchResult, error)
 	CreateCareTeam(ctx context.Context, params Parameters, entity *models.CareTeam) (*models.CareTeam, error)
 	UpdateCareTeam(ctx context.Context, params Parameters, entity *models.CareTeam) (*models.CareTeam, error)
 	UpdateCareTeamByID(ctx context.Context, id string, params Parameters, entity *models.CareTeam) (*models.CareTeam, error)
@@ -149,6 +400,8 @@ type ClientInterface interface {
 	DeleteCareTeamByID(ctx context.Context, id string, params Parameters) (*models.CareTeam, error)
 	GetCatalogEntry(ctx context.Context, params Parameters) ([]*models.CatalogEntry, error)
 	GetCatalogEntryByID(ctx context.Context, id string, params Parameters) (*models.CatalogEntry, error)
+	SearchCatalogEntry(ctx context.Context, params Parameters) (*CatalogEntrySearchResult, error)
+	SearchCatalogEntryPage(ctx context.Context, url string) (*CatalogEntrySearchResult, error)
 	CreateCatalogEntry(ctx context.Context, params Parameters, entity *models.CatalogEntry) (*models.CatalogEntry, error)
 	UpdateCatalogEntry(ctx context.Context, params Parameters, entity *models.CatalogEntry) (*models.CatalogEntry, error)
 	UpdateCatalogEntryByID(ctx context.Context, id string, params Parameters, entity *models.CatalogEntry) (*models.CatalogEntry, error)
@@ -158,6 +411,8 @@ type ClientInterface interface {
 	DeleteCatalogEntryByID(ctx context.Context, id string, params Parameters) (*models.CatalogEntry, error)
 	GetChargeItem(ctx context.Context, params Parameters) ([]*models.ChargeItem, error)
 	GetChargeItemByID(ctx context.Context, id string, params Parameters) (*models.ChargeItem, error)
+	SearchChargeItem(ctx context.Context, params Parameters) (*ChargeItemSearchResult, error)
+	SearchChargeItemPage(ctx context.Context, url string) (*ChargeItemSearchResult, error)
 	CreateChargeItem(ctx context.Context, params Parameters, entity *models.ChargeItem) (*models.ChargeItem, error)
 	UpdateChargeItem(ctx context.Context, params Parameters, entity *models.ChargeItem) (*models.ChargeItem, error)
 	UpdateChargeItemByID(ctx context.Context, id string, params Parameters, entity *models.ChargeItem) (*models.ChargeItem, error)
@@ -167,6 +422,8 @@ type ClientInterface interface {
 	DeleteChargeItemByID(ctx context.Context, id string, params Parameters) (*models.ChargeItem, error)
 	GetChargeItemDefinition(ctx context.Context, params Parameters) ([]*models.ChargeItemDefinition, error)
 	GetChargeItemDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ChargeItemDefinition, error)
+	SearchChargeItemDefinition(ctx context.Context, params Parameters) (*ChargeItemDefinitionSearchResult, error)
+	SearchChargeItemDefinitionPage(ctx context.Context, url string) (*ChargeItemDefinitionSearchResult, error)
 	CreateChargeItemDefinition(ctx context.Context, params Parameters, entity *models.ChargeItemDefinition) (*models.ChargeItemDefinition, error)
 	UpdateChargeItemDefinition(ctx context.Context, params Parameters, entity *models.ChargeItemDefinition) (*models.ChargeItemDefinition, error)
 	UpdateChargeItemDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.ChargeItemDefinition) (*models.ChargeItemDefinition, error)
@@ -176,6 +433,8 @@ type ClientInterface interface {
 	DeleteChargeItemDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ChargeItemDefinition, error)
 	GetClaim(ctx context.Context, params Parameters) ([]*models.Claim, error)
 	GetClaimByID(ctx context.Context, id string, params Parameters) (*models.Claim, error)
+	SearchClaim(ctx context.Context, params Parameters) (*ClaimSearchResult, error)
+	SearchClaimPage(ctx context.Context, url string) (*ClaimSearchResult, error)
 	CreateClaim(ctx context.Context, params Parameters, entity *models.Claim) (*models.Claim, error)
 	UpdateClaim(ctx context.Context, params Parameters, entity *models.Claim) (*models.Claim, error)
 	UpdateClaimByID(ctx context.Context, id string, params Parameters, entity *models.Claim) (*models.Claim, error)
@@ -185,6 +444,8 @@ type ClientInterface interface {
 	DeleteClaimByID(ctx context.Context, id string, params Parameters) (*models.Claim, error)
 	GetClaimResponse(ctx context.Context, params Parameters) ([]*models.ClaimResponse, error)
 	GetClaimResponseByID(ctx context.Context, id string, params Parameters) (*models.ClaimResponse, error)
+	SearchClaimResponse(ctx context.Context, params Parameters) (*ClaimResponseSearchResult, error)
+	SearchClaimResponsePage(ctx context.Context, url string) (*ClaimResponseSearchResult, error)
 	CreateClaimResponse(ctx context.Context, params Parameters, entity *models.ClaimResponse) (*models.ClaimResponse, error)
 	UpdateClaimResponse(ctx context.Context, params Parameters, entity *models.ClaimResponse) (*models.ClaimResponse, error)
 	UpdateClaimResponseByID(ctx context.Context, id string, params Parameters, entity *models.ClaimResponse) (*models.ClaimResponse, error)
@@ -194,6 +455,8 @@ type ClientInterface interface {
 	DeleteClaimResponseByID(ctx context.Context, id string, params Parameters) (*models.ClaimResponse, error)
 	GetClinicalImpression(ctx context.Context, params Parameters) ([]*models.ClinicalImpression, error)
 	GetClinicalImpressionByID(ctx context.Context, id string, params Parameters) (*models.ClinicalImpression, error)
+	SearchClinicalImpression(ctx context.Context, params Parameters) (*ClinicalImpressionSearchResult, error)
+	SearchClinicalImpressionPage(ctx context.Context, url string) (*ClinicalImpressionSearchResult, error)
 	CreateClinicalImpression(ctx context.Context, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error)
 	UpdateClinicalImpression(ctx context.Context, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error)
 	UpdateClinicalImpressionByID(ctx context.Context, id string, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error)
@@ -203,6 +466,8 @@ type ClientInterface interface {
 	DeleteClinicalImpressionByID(ctx context.Context, id string, params Parameters) (*models.ClinicalImpression, error)
 	GetCodeSystem(ctx context.Context, params Parameters) ([]*models.CodeSystem, error)
 	GetCodeSystemByID(ctx context.Context, id string, params Parameters) (*models.CodeSystem, error)
+	SearchCodeSystem(ctx context.Context, params Parameters) (*CodeSystemSearchResult, error)
+	SearchCodeSystemPage(ctx context.Context, url string) (*CodeSystemSearchResult, error)
 	CreateCodeSystem(ctx context.Context, params Parameters, entity *models.CodeSystem) (*models.CodeSystem, error)
 	UpdateCodeSystem(ctx context.Context, params Parameters, entity *models.CodeSystem) (*models.CodeSystem, error)
 	UpdateCodeSystemByID(ctx context.Context, id string, params Parameters, entity *models.CodeSystem) (*models.CodeSystem, error)
@@ -212,6 +477,8 @@ type ClientInterface interface {
 	DeleteCodeSystemByID(ctx context.Context, id string, params Parameters) (*models.CodeSystem, error)
 	GetCommunication(ctx context.Context, params Parameters) ([]*models.Communication, error)
 	GetCommunicationByID(ctx context.Context, id string, params Parameters) (*models.Communication, error)
+	SearchCommunication(ctx context.Context, params Parameters) (*CommunicationSearchResult, error)
+	SearchCommunicationPage(ctx context.Context, url string) (*CommunicationSearchResult, error)
 	CreateCommunication(ctx context.Context, params Parameters, entity *models.Communication) (*models.Communication, error)
 	UpdateCommunication(ctx context.Context, params Parameters, entity *models.Communication) (*models.Communication, error)
 	UpdateCommunicationByID(ctx context.Context, id string, params Parameters, entity *models.Communication) (*models.Communication, error)
@@ -221,6 +488,8 @@ type ClientInterface interface {
 	DeleteCommunicationByID(ctx context.Context, id string, params Parameters) (*models.Communication, error)
 	GetCommunicationRequest(ctx context.Context, params Parameters) ([]*models.CommunicationRequest, error)
 	GetCommunicationRequestByID(ctx context.Context, id string, params Parameters) (*models.CommunicationRequest, error)
+	SearchCommunicationRequest(ctx context.Context, params Parameters) (*CommunicationRequestSearchResult, error)
+	SearchCommunicationRequestPage(ctx context.Context, url string) (*CommunicationRequestSearchResult, error)
 	CreateCommunicationRequest(ctx context.Context, params Parameters, entity *models.CommunicationRequest) (*models.CommunicationRequest, error)
 	UpdateCommunicationRequest(ctx context.Context, params Parameters, entity *models.CommunicationRequest) (*models.CommunicationRequest, error)
 	UpdateCommunicationRequestByID(ctx context.Context, id string, params Parameters, entity *models.CommunicationRequest) (*models.CommunicationRequest, error)
@@ -230,6 +499,8 @@ type ClientInterface interface {
 	DeleteCommunicationRequestByID(ctx context.Context, id string, params Parameters) (*models.CommunicationRequest, error)
 	GetCompartmentDefinition(ctx context.Context, params Parameters) ([]*models.CompartmentDefinition, error)
 	GetCompartmentDefinitionByID(ctx context.Context, id string, params Parameters) (*models.CompartmentDefinition, error)
+	SearchCompartmentDefinition(ctx context.Context, params Parameters) (*CompartmentDefinitionSearchResult, error)
+	SearchCompartmentDefinitionPage(ctx context.Context, url string) (*CompartmentDefinitionSearchResult, error)
 	CreateCompartmentDefinition(ctx context.Context, params Parameters, entity *models.CompartmentDefinition) (*models.CompartmentDefinition, error)
 	UpdateCompartmentDefinition(ctx context.Context, params Parameters, entity *models.CompartmentDefinition) (*models.CompartmentDefinition, error)
 	UpdateCompartmentDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.CompartmentDefinition) (*models.CompartmentDefinition, error)
@@ -239,6 +510,8 @@ type ClientInterface interface {
 	DeleteCompartmentDefinitionByID(ctx context.Context, id string, params Parameters) (*models.CompartmentDefinition, error)
 	GetComposition(ctx context.Context, params Parameters) ([]*models.Composition, error)
 	GetCompositionByID(ctx context.Context, id string, params Parameters) (*models.Composition, error)
+	SearchComposition(ctx context.Context, params Parameters) (*CompositionSearchResult, error)
+	SearchCompositionPage(ctx context.Context, url string) (*CompositionSearchResult, error)
 	CreateComposition(ctx context.Context, params Parameters, entity *models.Composition) (*models.Composition, error)
 	UpdateComposition(ctx context.Context, params Parameters, entity *models.Composition) (*models.Composition, error)
 	UpdateCompositionByID(ctx context.Context, id string, params Parameters, entity *models.Composition) (*models.Composition, error)
@@ -248,6 +521,8 @@ type ClientInterface interface {
 	DeleteCompositionByID(ctx context.Context, id string, params Parameters) (*models.Composition, error)
 	GetConceptMap(ctx context.Context, params Parameters) ([]*models.ConceptMap, error)
 	GetConceptMapByID(ctx context.Context, id string, params Parameters) (*models.ConceptMap, error)
+	SearchConceptMap(ctx context.Context, params Parameters) (*ConceptMapSearchResult, error)
+	SearchConceptMapPage(ctx context.Context, url string) (*ConceptMapSearchResult, error)
 	CreateConceptMap(ctx context.Context, params Parameters, entity *models.ConceptMap) (*models.ConceptMap, error)
 	UpdateConceptMap(ctx context.Context, params Parameters, entity *models.ConceptMap) (*models.ConceptMap, error)
 	UpdateConceptMapByID(ctx context.Context, id string, params Parameters, entity *models.ConceptMap) (*models.ConceptMap, error)
@@ -257,6 +532,8 @@ type ClientInterface interface {
 	DeleteConceptMapByID(ctx context.Context, id string, params Parameters) (*models.ConceptMap, error)
 	GetCondition(ctx context.Context, params Parameters) ([]*models.Condition, error)
 	GetConditionByID(ctx context.Context, id string, params Parameters) (*models.Condition, error)
+	SearchCondition(ctx context.Context, params Parameters) (*ConditionSearchResult, error)
+	SearchConditionPage(ctx context.Context, url string) (*ConditionSearchResult, error)
 	CreateCondition(ctx context.Context, params Parameters, entity *models.Condition) (*models.Condition, error)
 	UpdateCondition(ctx context.Context, params Parameters, entity *models.Condition) (*models.Condition, error)
 	UpdateConditionByID(ctx context.Context, id string, params Parameters, entity *models.Condition) (*models.Condition, error)
@@ -266,6 +543,8 @@ type ClientInterface interface {
 	DeleteConditionByID(ctx context.Context, id string, params Parameters) (*models.Condition, error)
 	GetConsent(ctx context.Context, params Parameters) ([]*models.Consent, error)
 	GetConsentByID(ctx context.Context, id string, params Parameters) (*models.Consent, error)
+	SearchConsent(ctx context.Context, params Parameters) (*ConsentSearchResult, error)
+	SearchConsentPage(ctx context.Context, url string) (*ConsentSearchResult, error)
 	CreateConsent(ctx context.Context, params Parameters, entity *models.Consent) (*models.Consent, error)
 	UpdateConsent(ctx context.Context, params Parameters, entity *models.Consent) (*models.Consent, error)
 	UpdateConsentByID(ctx context.Context, id string, params Parameters, entity *models.Consent) (*models.Consent, error)
@@ -275,6 +554,8 @@ type ClientInterface interface {
 	DeleteConsentByID(ctx context.Context, id string, params Parameters) (*models.Consent, error)
 	GetContract(ctx context.Context, params Parameters) ([]*models.Contract, error)
 	GetContractByID(ctx context.Context, id string, params Parameters) (*models.Contract, error)
+	SearchContract(ctx context.Context, params Parameters) (*ContractSearchResult, error)
+	SearchContractPage(ctx context.Context, url string) (*ContractSearchResult, error)
 	CreateContract(ctx context.Context, params Parameters, entity *models.Contract) (*models.Contract, error)
 	UpdateContract(ctx context.Context, params Parameters, entity *models.Contract) (*models.Contract, error)
 	UpdateContractByID(ctx context.Context, id string, params Parameters, entity *models.Contract) (*models.Contract, error)
@@ -284,6 +565,8 @@ type ClientInterface interface {
 	DeleteContractByID(ctx context.Context, id string, params Parameters) (*models.Contract, error)
 	GetCoverage(ctx context.Context, params Parameters) ([]*models.Coverage, error)
 	GetCoverageByID(ctx context.Context, id string, params Parameters) (*models.Coverage, error)
+	SearchCoverage(ctx context.Context, params Parameters) (*CoverageSearchResult, error)
+	SearchCoveragePage(ctx context.Context, url string) (*CoverageSearchResult, error)
 	CreateCoverage(ctx context.Context, params Parameters, entity *models.Coverage) (*models.Coverage, error)
 	UpdateCoverage(ctx context.Context, params Parameters, entity *models.Coverage) (*models.Coverage, error)
 	UpdateCoverageByID(ctx context.Context, id string, params Parameters, entity *models.Coverage) (*models.Coverage, error)
@@ -293,6 +576,8 @@ type ClientInterface interface {
 	DeleteCoverageByID(ctx context.Context, id string, params Parameters) (*models.Coverage, error)
 	GetCoverageEligibilityRequest(ctx context.Context, params Parameters) ([]*models.CoverageEligibilityRequest, error)
 	GetCoverageEligibilityRequestByID(ctx context.Context, id string, params Parameters) (*models.CoverageEligibilityRequest, error)
+	SearchCoverageEligibilityRequest(ctx context.Context, params Parameters) (*CoverageEligibilityRequestSearchResult, error)
+	SearchCoverageEligibilityRequestPage(ctx context.Context, url string) (*CoverageEligibilityRequestSearchResult, error)
 	CreateCoverageEligibilityRequest(ctx context.Context, params Parameters, entity *models.CoverageEligibilityRequest) (*models.CoverageEligibilityRequest, error)
 	UpdateCoverageEligibilityRequest(ctx context.Context, params Parameters, entity *models.CoverageEligibilityRequest) (*models.CoverageEligibilityRequest, error)
 	UpdateCoverageEligibilityRequestByID(ctx context.Context, id string, params Parameters, entity *models.CoverageEligibilityRequest) (*models.CoverageEligibilityRequest, error)
@@ -302,6 +587,8 @@ type ClientInterface interface {
 	DeleteCoverageEligibilityRequestByID(ctx context.Context, id string, params Parameters) (*models.CoverageEligibilityRequest, error)
 	GetCoverageEligibilityResponse(ctx context.Context, params Parameters) ([]*models.CoverageEligibilityResponse, error)
 	GetCoverageEligibilityResponseByID(ctx context.Context, id string, params Parameters) (*models.CoverageEligibilityResponse, error)
+	SearchCoverageEligibilityResponse(ctx context.Context, params Parameters) (*CoverageEligibilityResponseSearchResult, error)
+	SearchCoverageEligibilityResponsePage(ctx context.Context, url string) (*CoverageEligibilityResponseSearchResult, error)
 	CreateCoverageEligibilityResponse(ctx context.Context, params Parameters, entity *models.CoverageEligibilityResponse) (*models.CoverageEligibilityResponse, error)
 	UpdateCoverageEligibilityResponse(ctx context.Context, params Parameters, entity *models.CoverageEligibilityResponse) (*models.CoverageEligibilityResponse, error)
 	UpdateCoverageEligibilityResponseByID(ctx context.Context, id string, params Parameters, entity *models.CoverageEligibilityResponse) (*models.CoverageEligibilityResponse, error)
@@ -311,6 +598,8 @@ type ClientInterface interface {
 	DeleteCoverageEligibilityResponseByID(ctx context.Context, id string, params Parameters) (*models.CoverageEligibilityResponse, error)
 	GetDetectedIssue(ctx context.Context, params Parameters) ([]*models.DetectedIssue, error)
 	GetDetectedIssueByID(ctx context.Context, id string, params Parameters) (*models.DetectedIssue, error)
+	SearchDetectedIssue(ctx context.Context, params Parameters) (*DetectedIssueSearchResult, error)
+	SearchDetectedIssuePage(ctx context.Context, url string) (*DetectedIssueSearchResult, error)
 	CreateDetectedIssue(ctx context.Context, params Parameters, entity *models.DetectedIssue) (*models.DetectedIssue, error)
 	UpdateDetectedIssue(ctx context.Context, params Parameters, entity *models.DetectedIssue) (*models.DetectedIssue, error)
 	UpdateDetectedIssueByID(ctx context.Context, id string, params Parameters, entity *models.DetectedIssue) (*models.DetectedIssue, error)
@@ -320,6 +609,8 @@ type ClientInterface interface {
 	DeleteDetectedIssueByID(ctx context.Context, id string, params Parameters) (*models.DetectedIssue, error)
 	GetDevice(ctx context.Context, params Parameters) ([]*models.Device, error)
 	GetDeviceByID(ctx context.Context, id string, params Parameters) (*models.Device, error)
+	SearchDevice(ctx context.Context, params Parameters) (*DeviceSearchResult, error)
+	SearchDevicePage(ctx context.Context, url string) (*DeviceSearchResult, error)
 	CreateDevice(ctx context.Context, params Parameters, entity *models.Device) (*models.Device, error)
 	UpdateDevice(ctx context.Context, params Parameters, entity *models.Device) (*models.Device, error)
 	UpdateDeviceByID(ctx context.Context, id string, params Parameters, entity *models.Device) (*models.Device, error)
@@ -329,6 +620,8 @@ type ClientInterface interface {
 	DeleteDeviceByID(ctx context.Context, id string, params Parameters) (*models.Device, error)
 	GetDeviceDefinition(ctx context.Context, params Parameters) ([]*models.DeviceDefinition, error)
 	GetDeviceDefinitionByID(ctx context.Context, id string, params Parameters) (*models.DeviceDefinition, error)
+	SearchDeviceDefinition(ctx context.Context, params Parameters) (*DeviceDefinitionSearchResult, error)
+	SearchDeviceDefinitionPage(ctx context.Context, url string) (*DeviceDefinitionSearchResult, error)
 	CreateDeviceDefinition(ctx context.Context, params Parameters, entity *models.DeviceDefinition) (*models.DeviceDefinition, error)
 	UpdateDeviceDefinition(ctx context.Context, params Parameters, entity *models.DeviceDefinition) (*models.DeviceDefinition, error)
 	UpdateDeviceDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.DeviceDefinition) (*models.DeviceDefinition, error)
@@ -338,6 +631,8 @@ type ClientInterface interface {
 	DeleteDeviceDefinitionByID(ctx context.Context, id string, params Parameters) (*models.DeviceDefinition, error)
 	GetDeviceMetric(ctx context.Context, params Parameters) ([]*models.DeviceMetric, error)
 	GetDeviceMetricByID(ctx context.Context, id string, params Parameters) (*models.DeviceMetric, error)
+	SearchDeviceMetric(ctx context.Context, params Parameters) (*DeviceMetricSearchResult, error)
+	SearchDeviceMetricPage(ctx context.Context, url string) (*DeviceMetricSearchResult, error)
 	CreateDeviceMetric(ctx context.Context, params Parameters, entity *models.DeviceMetric) (*models.DeviceMetric, error)
 	UpdateDeviceMetric(ctx context.Context, params Parameters, entity *models.DeviceMetric) (*models.DeviceMetric, error)
 	UpdateDeviceMetricByID(ctx context.Context, id string, params Parameters, entity *models.DeviceMetric) (*models.DeviceMetric, error)
@@ -347,6 +642,8 @@ type ClientInterface interface {
 	DeleteDeviceMetricByID(ctx context.Context, id string, params Parameters) (*models.DeviceMetric, error)
 	GetDeviceRequest(ctx context.Context, params Parameters) ([]*models.DeviceRequest, error)
 	GetDeviceRequestByID(ctx context.Context, id string, params Parameters) (*models.DeviceRequest, error)
+	SearchDeviceRequest(ctx context.Context, params Parameters) (*DeviceRequestSearchResult, error)
+	SearchDeviceRequestPage(ctx context.Context, url string) (*DeviceRequestSearchResult, error)
 	CreateDeviceRequest(ctx context.Context, params Parameters, entity *models.DeviceRequest) (*models.DeviceRequest, error)
 	UpdateDeviceRequest(ctx context.Context, params Parameters, entity *models.DeviceRequest) (*models.DeviceRequest, error)
 	UpdateDeviceRequestByID(ctx context.Context, id string, params Parameters, entity *models.DeviceRequest) (*models.DeviceRequest, error)
@@ -356,6 +653,8 @@ type ClientInterface interface {
 	DeleteDeviceRequestByID(ctx context.Context, id string, params Parameters) (*models.DeviceRequest, error)
 	GetDeviceUseStatement(ctx context.Context, params Parameters) ([]*models.DeviceUseStatement, error)
 	GetDeviceUseStatementByID(ctx context.Context, id string, params Parameters) (*models.DeviceUseStatement, error)
+	SearchDeviceUseStatement(ctx context.Context, params Parameters) (*DeviceUseStatementSearchResult, error)
+	SearchDeviceUseStatementPage(ctx context.Context, url string) (*DeviceUseStatementSearchResult, error)
 	CreateDeviceUseStatement(ctx context.Context, params Parameters, entity *models.DeviceUseStatement) (*models.DeviceUseStatement, error)
 	UpdateDeviceUseStatement(ctx context.Context, params Parameters, entity *models.DeviceUseStatement) (*models.DeviceUseStatement, error)
 	UpdateDeviceUseStatementByID(ctx context.Context, id string, params Parameters, entity *models.DeviceUseStatement) (*models.DeviceUseStatement, error)
@@ -365,6 +664,8 @@ type ClientInterface interface {
 	DeleteDeviceUseStatementByID(ctx context.Context, id string, params Parameters) (*models.DeviceUseStatement, error)
 	GetDiagnosticReport(ctx context.Context, params Parameters) ([]*models.DiagnosticReport, error)
 	GetDiagnosticReportByID(ctx context.Context, id string, params Parameters) (*models.DiagnosticReport, error)
+	SearchDiagnosticReport(ctx context.Context, params Parameters) (*DiagnosticReportSearchResult, error)
+	SearchDiagnosticReportPage(ctx context.Context, url string) (*DiagnosticReportSearchResult, error)
 	CreateDiagnosticReport(ctx context.Context, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error)
 	UpdateDiagnosticReport(ctx context.Context, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error)
 	UpdateDiagnosticReportByID(ctx context.Context, id string, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error)
@@ -374,6 +675,8 @@ type ClientInterface interface {
 	DeleteDiagnosticReportByID(ctx context.Context, id string, params Parameters) (*models.DiagnosticReport, error)
 	GetDocumentManifest(ctx context.Context, params Parameters) ([]*models.DocumentManifest, error)
 	GetDocumentManifestByID(ctx context.Context, id string, params Parameters) (*models.DocumentManifest, error)
+	SearchDocumentManifest(ctx context.Context, params Parameters) (*DocumentManifestSearchResult, error)
+	SearchDocumentManifestPage(ctx context.Context, url string) (*DocumentManifestSearchResult, error)
 	CreateDocumentManifest(ctx context.Context, params Parameters, entity *models.DocumentManifest) (*models.DocumentManifest, error)
 	UpdateDocumentManifest(ctx context.Context, params Parameters, entity *models.DocumentManifest) (*models.DocumentManifest, error)
 	UpdateDocumentManifestByID(ctx context.Context, id string, params Parameters, entity *models.DocumentManifest) (*models.DocumentManifest, error)
@@ -383,6 +686,8 @@ type ClientInterface interface {
 	DeleteDocumentManifestByID(ctx context.Context, id string, params Parameters) (*models.DocumentManifest, error)
 	GetDocumentReference(ctx context.Context, params Parameters) ([]*models.DocumentReference, error)
 	GetDocumentReferenceByID(ctx context.Context, id string, params Parameters) (*models.DocumentReference, error)
+	SearchDocumentReference(ctx context.Context, params Parameters) (*DocumentReferenceSearchResult, error)
+	SearchDocumentReferencePage(ctx context.Context, url string) (*DocumentReferenceSearchResult, error)
 	CreateDocumentReference(ctx context.Context, params Parameters, entity *models.DocumentReference) (*models.DocumentReference, error)
 	UpdateDocumentReference(ctx context.Context, params Parameters, entity *models.DocumentReference) (*models.DocumentReference, error)
 	UpdateDocumentReferenceByID(ctx context.Context, id string, params Parameters, entity *models.DocumentReference) (*models.DocumentReference, error)
@@ -392,6 +697,8 @@ type ClientInterface interface {
 	DeleteDocumentReferenceByID(ctx context.Context, id string, params Parameters) (*models.DocumentReference, error)
 	GetDomainResource(ctx context.Context, params Parameters) ([]*models.DomainResource, error)
 	GetDomainResourceByID(ctx context.Context, id string, params Parameters) (*models.DomainResource, error)
+	SearchDomainResource(ctx context.Context, params Parameters) (*DomainResourceSearchResult, error)
+	SearchDomainResourcePage(ctx context.Context, url string) (*DomainResourceSearchResult, error)
 	CreateDomainResource(ctx context.Context, params Parameters, entity *models.DomainResource) (*models.DomainResource, error)
 	UpdateDomainResource(ctx context.Context, params Parameters, entity *models.DomainResource) (*models.DomainResource, error)
 	UpdateDomainResourceByID(ctx context.Context, id string, params Parameters, entity *models.DomainResource) (*models.DomainResource, error)
@@ -401,6 +708,8 @@ type ClientInterface interface {
 	DeleteDomainResourceByID(ctx context.Context, id string, params Parameters) (*models.DomainResource, error)
 	GetEffectEvidenceSynthesis(ctx context.Context, params Parameters) ([]*models.EffectEvidenceSynthesis, error)
 	GetEffectEvidenceSynthesisByID(ctx context.Context, id string, params Parameters) (*models.EffectEvidenceSynthesis, error)
+	SearchEffectEvidenceSynthesis(ctx context.Context, params Parameters) (*EffectEvidenceSynthesisSearchResult, error)
+	SearchEffectEvidenceSynthesisPage(ctx context.Context, url string) (*EffectEvidenceSynthesisSearchResult, error)
 	CreateEffectEvidenceSynthesis(ctx context.Context, params Parameters, entity *models.EffectEvidenceSynthesis) (*models.EffectEvidenceSynthesis, error)
 	UpdateEffectEvidenceSynthesis(ctx context.Context, params Parameters, entity *models.EffectEvidenceSynthesis) (*models.EffectEvidenceSynthesis, error)
 	UpdateEffectEvidenceSynthesisByID(ctx context.Context, id string, params Parameters, entity *models.EffectEvidenceSynthesis) (*models.EffectEvidenceSynthesis, error)
@@ -410,6 +719,8 @@ type ClientInterface interface {
 	DeleteEffectEvidenceSynthesisByID(ctx context.Context, id string, params Parameters) (*models.EffectEvidenceSynthesis, error)
 	GetEncounter(ctx context.Context, params Parameters) ([]*models.Encounter, error)
 	GetEncounterByID(ctx context.Context, id string, params Parameters) (*models.Encounter, error)
+	SearchEncounter(ctx context.Context, params Parameters) (*EncounterSearchResult, error)
+	SearchEncounterPage(ctx context.Context, url string) (*EncounterSearchResult, error)
 	CreateEncounter(ctx context.Context, params Parameters, entity *models.Encounter) (*models.Encounter, error)
 	UpdateEncounter(ctx context.Context, params Parameters, entity *models.Encounter) (*models.Encounter, error)
 	UpdateEncounterByID(ctx context.Context, id string, params Parameters, entity *models.Encounter) (*models.Encounter, error)
@@ -419,6 +730,8 @@ type ClientInterface interface {
 	DeleteEncounterByID(ctx context.Context, id string, params Parameters) (*models.Encounter, error)
 	GetEndpoint(ctx context.Context, params Parameters) ([]*models.Endpoint, error)
 	GetEndpointByID(ctx context.Context, id string, params Parameters) (*models.Endpoint, error)
+	SearchEndpoint(ctx context.Context, params Parameters) (*EndpointSearchResult, error)
+	SearchEndpointPage(ctx context.Context, url string) (*EndpointSearchResult, error)
 	CreateEndpoint(ctx context.Context, params Parameters, entity *models.Endpoint) (*models.Endpoint, error)
 	UpdateEndpoint(ctx context.Context, params Parameters, entity *models.Endpoint) (*models.Endpoint, error)
 	UpdateEndpointByID(ctx context.Context, id string, params Parameters, entity *models.Endpoint) (*models.Endpoint, error)
@@ -428,6 +741,8 @@ type ClientInterface interface {
 	DeleteEndpointByID(ctx context.Context, id string, params Parameters) (*models.Endpoint, error)
 	GetEnrollmentRequest(ctx context.Context, params Parameters) ([]*models.EnrollmentRequest, error)
 	GetEnrollmentRequestByID(ctx context.Context, id string, params Parameters) (*models.EnrollmentRequest, error)
+	SearchEnrollmentRequest(ctx context.Context, params Parameters) (*EnrollmentRequestSearchResult, error)
+	SearchEnrollmentRequestPage(ctx context.Context, url string) (*EnrollmentRequestSearchResult, error)
 	CreateEnrollmentRequest(ctx context.Context, params Parameters, entity *models.EnrollmentRequest) (*models.EnrollmentRequest, error)
 	UpdateEnrollmentRequest(ctx context.Context, params Parameters, entity *models.EnrollmentRequest) (*models.EnrollmentRequest, error)
 	UpdateEnrollmentRequestByID(ctx context.Context, id string, params Parameters, entity *models.EnrollmentRequest) (*models.EnrollmentRequest, error)
@@ -437,6 +752,8 @@ type ClientInterface interface {
 	DeleteEnrollmentRequestByID(ctx context.Context, id string, params Parameters) (*models.EnrollmentRequest, error)
 	GetEnrollmentResponse(ctx context.Context, params Parameters) ([]*models.EnrollmentResponse, error)
 	GetEnrollmentResponseByID(ctx context.Context, id string, params Parameters) (*models.EnrollmentResponse, error)
+	SearchEnrollmentResponse(ctx context.Context, params Parameters) (*EnrollmentResponseSearchResult, error)
+	SearchEnrollmentResponsePage(ctx context.Context, url string) (*EnrollmentResponseSearchResult, error)
 	CreateEnrollmentResponse(ctx context.Context, params Parameters, entity *models.EnrollmentResponse) (*models.EnrollmentResponse, error)
 	UpdateEnrollmentResponse(ctx context.Context, params Parameters, entity *models.EnrollmentResponse) (*models.EnrollmentResponse, error)
 	UpdateEnrollmentResponseByID(ctx context.Context, id string, params Parameters, entity *models.EnrollmentResponse) (*models.EnrollmentResponse, error)
@@ -446,6 +763,8 @@ type ClientInterface interface {
 	DeleteEnrollmentResponseByID(ctx context.Context, id string, params Parameters) (*models.EnrollmentResponse, error)
 	GetEpisodeOfCare(ctx context.Context, params Parameters) ([]*models.EpisodeOfCare, error)
 	GetEpisodeOfCareByID(ctx context.Context, id string, params Parameters) (*models.EpisodeOfCare, error)
+	SearchEpisodeOfCare(ctx context.Context, params Parameters) (*EpisodeOfCareSearchResult, error)
+	SearchEpisodeOfCarePage(ctx context.Context, url string) (*EpisodeOfCareSearchResult, error)
 	CreateEpisodeOfCare(ctx context.Context, params Parameters, entity *models.EpisodeOfCare) (*models.EpisodeOfCare, error)
 	UpdateEpisodeOfCare(ctx context.Context, params Parameters, entity *models.EpisodeOfCare) (*models.EpisodeOfCare, error)
 	UpdateEpisodeOfCareByID(ctx context.Context, id string, params Parameters, entity *models.EpisodeOfCare) (*models.EpisodeOfCare, error)
@@ -455,6 +774,8 @@ type ClientInterface interface {
 	DeleteEpisodeOfCareByID(ctx context.Context, id string, params Parameters) (*models.EpisodeOfCare, error)
 	GetEventDefinition(ctx context.Context, params Parameters) ([]*models.EventDefinition, error)
 	GetEventDefinitionByID(ctx context.Context, id string, params Parameters) (*models.EventDefinition, error)
+	SearchEventDefinition(ctx context.Context, params Parameters) (*EventDefinitionSearchResult, error)
+	SearchEventDefinitionPage(ctx context.Context, url string) (*EventDefinitionSearchResult, error)
 	CreateEventDefinition(ctx context.Context, params Parameters, entity *models.EventDefinition) (*models.EventDefinition, error)
 	UpdateEventDefinition(ctx context.Context, params Parameters, entity *models.EventDefinition) (*models.EventDefinition, error)
 	UpdateEventDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.EventDefinition) (*models.EventDefinition, error)
@@ -464,6 +785,8 @@ type ClientInterface interface {
 	DeleteEventDefinitionByID(ctx context.Context, id string, params Parameters) (*models.EventDefinition, error)
 	GetEvidence(ctx context.Context, params Parameters) ([]*models.Evidence, error)
 	GetEvidenceByID(ctx context.Context, id string, params Parameters) (*models.Evidence, error)
+	SearchEvidence(ctx context.Context, params Parameters) (*EvidenceSearchResult, error)
+	SearchEvidencePage(ctx context.Context, url string) (*EvidenceSearchResult, error)
 	CreateEvidence(ctx context.Context, params Parameters, entity *models.Evidence) (*models.Evidence, error)
 	UpdateEvidence(ctx context.Context, params Parameters, entity *models.Evidence) (*models.Evidence, error)
 	UpdateEvidenceByID(ctx context.Context, id string, params Parameters, entity *models.Evidence) (*models.Evidence, error)
@@ -473,6 +796,8 @@ type ClientInterface interface {
 	DeleteEvidenceByID(ctx context.Context, id string, params Parameters) (*models.Evidence, error)
 	GetEvidenceVariable(ctx context.Context, params Parameters) ([]*models.EvidenceVariable, error)
 	GetEvidenceVariableByID(ctx context.Context, id string, params Parameters) (*models.EvidenceVariable, error)
+	SearchEvidenceVariable(ctx context.Context, params Parameters) (*EvidenceVariableSearchResult, error)
+	SearchEvidenceVariablePage(ctx context.Context, url string) (*EvidenceVariableSearchResult, error)
 	CreateEvidenceVariable(ctx context.Context, params Parameters, entity *models.EvidenceVariable) (*models.EvidenceVariable, error)
 	UpdateEvidenceVariable(ctx context.Context, params Parameters, entity *models.EvidenceVariable) (*models.EvidenceVariable, error)
 	UpdateEvidenceVariableByID(ctx context.Context, id string, params Parameters, entity *models.EvidenceVariable) (*models.EvidenceVariable, error)
@@ -482,6 +807,8 @@ type ClientInterface interface {
 	DeleteEvidenceVariableByID(ctx context.Context, id string, params Parameters) (*models.EvidenceVariable, error)
 	GetExampleScenario(ctx context.Context, params Parameters) ([]*models.ExampleScenario, error)
 	GetExampleScenarioByID(ctx context.Context, id string, params Parameters) (*models.ExampleScenario, error)
+	SearchExampleScenario(ctx context.Context, params Parameters) (*ExampleScenarioSearchResult, error)
+	SearchExampleScenarioPage(ctx context.Context, url string) (*ExampleScenarioSearchResult, error)
 	CreateExampleScenario(ctx context.Context, params Parameters, entity *models.ExampleScenario) (*models.ExampleScenario, error)
 	UpdateExampleScenario(ctx context.Context, params Parameters, entity *models.ExampleScenario) (*models.ExampleScenario, error)
 	UpdateExampleScenarioByID(ctx context.Context, id string, params Parameters, entity *models.ExampleScenario) (*models.ExampleScenario, error)
@@ -491,6 +818,8 @@ type ClientInterface interface {
 	DeleteExampleScenarioByID(ctx context.Context, id string, params Parameters) (*models.ExampleScenario, error)
 	GetExplanationOfBenefit(ctx context.Context, params Parameters) ([]*models.ExplanationOfBenefit, error)
 	GetExplanationOfBenefitByID(ctx context.Context, id string, params Parameters) (*models.ExplanationOfBenefit, error)
+	SearchExplanationOfBenefit(ctx context.Context, params Parameters) (*ExplanationOfBenefitSearchResult, error)
+	SearchExplanationOfBenefitPage(ctx context.Context, url string) (*ExplanationOfBenefitSearchResult, error)
 	CreateExplanationOfBenefit(ctx context.Context, params Parameters, entity *models.ExplanationOfBenefit) (*models.ExplanationOfBenefit, error)
 	UpdateExplanationOfBenefit(ctx context.Context, params Parameters, entity *models.ExplanationOfBenefit) (*models.ExplanationOfBenefit, error)
 	UpdateExplanationOfBenefitByID(ctx context.Context, id string, params Parameters, entity *models.ExplanationOfBenefit) (*models.ExplanationOfBenefit, error)
@@ -500,6 +829,8 @@ type ClientInterface interface {
 	DeleteExplanationOfBenefitByID(ctx context.Context, id string, params Parameters) (*models.ExplanationOfBenefit, error)
 	GetFamilyMemberHistory(ctx context.Context, params Parameters) ([]*models.FamilyMemberHistory, error)
 	GetFamilyMemberHistoryByID(ctx context.Context, id string, params Parameters) (*models.FamilyMemberHistory, error)
+	SearchFamilyMemberHistory(ctx context.Context, params Parameters) (*FamilyMemberHistorySearchResult, error)
+	SearchFamilyMemberHistoryPage(ctx context.Context, url string) (*FamilyMemberHistorySearchResult, error)
 	CreateFamilyMemberHistory(ctx context.Context, params Parameters, entity *models.FamilyMemberHistory) (*models.FamilyMemberHistory, error)
 	UpdateFamilyMemberHistory(ctx context.Context, params Parameters, entity *models.FamilyMemberHistory) (*models.FamilyMemberHistory, error)
 	UpdateFamilyMemberHistoryByID(ctx context.Context, id string, params Parameters, entity *models.FamilyMemberHistory) (*models.FamilyMemberHistory, error)
@@ -509,6 +840,8 @@ type ClientInterface interface {
 	DeleteFamilyMemberHistoryByID(ctx context.Context, id string, params Parameters) (*models.FamilyMemberHistory, error)
 	GetFlag(ctx context.Context, params Parameters) ([]*models.Flag, error)
 	GetFlagByID(ctx context.Context, id string, params Parameters) (*models.Flag, error)
+	SearchFlag(ctx context.Context, params Parameters) (*FlagSearchResult, error)
+	SearchFlagPage(ctx context.Context, url string) (*FlagSearchResult, error)
 	CreateFlag(ctx context.Context, params Parameters, entity *models.Flag) (*models.Flag, error)
 	UpdateFlag(ctx context.Context, params Parameters, entity *models.Flag) (*models.Flag, error)
 	UpdateFlagByID(ctx context.Context, id string, params Parameters, entity *models.Flag) (*models.Flag, error)
@@ -518,6 +851,8 @@ type ClientInterface interface {
 	DeleteFlagByID(ctx context.Context, id string, params Parameters) (*models.Flag, error)
 	GetGoal(ctx context.Context, params Parameters) ([]*models.Goal, error)
 	GetGoalByID(ctx context.Context, id string, params Parameters) (*models.Goal, error)
+	SearchGoal(ctx context.Context, params Parameters) (*GoalSearchResult, error)
+	SearchGoalPage(ctx context.Context, url string) (*GoalSearchResult, error)
 	CreateGoal(ctx context.Context, params Parameters, entity *models.Goal) (*models.Goal, error)
 	UpdateGoal(ctx context.Context, params Parameters, entity *models.Goal) (*models.Goal, error)
 	UpdateGoalByID(ctx context.Context, id string, params Parameters, entity *models.Goal) (*models.Goal, error)
@@ -527,6 +862,8 @@ type ClientInterface interface {
 	DeleteGoalByID(ctx context.Context, id string, params Parameters) (*models.Goal, error)
 	GetGraphDefinition(ctx context.Context, params Parameters) ([]*models.GraphDefinition, error)
 	GetGraphDefinitionByID(ctx context.Context, id string, params Parameters) (*models.GraphDefinition, error)
+	SearchGraphDefinition(ctx context.Context, params Parameters) (*GraphDefinitionSearchResult, error)
+	SearchGraphDefinitionPage(ctx context.Context, url string) (*GraphDefinitionSearchResult, error)
 	CreateGraphDefinition(ctx context.Context, params Parameters, entity *models.GraphDefinition) (*models.GraphDefinition, error)
 	UpdateGraphDefinition(ctx context.Context, params Parameters, entity *models.GraphDefinition) (*models.GraphDefinition, error)
 	UpdateGraphDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.GraphDefinition) (*models.GraphDefinition, error)
@@ -536,6 +873,8 @@ type ClientInterface interface {
 	DeleteGraphDefinitionByID(ctx context.Context, id string, params Parameters) (*models.GraphDefinition, error)
 	GetGroup(ctx context.Context, params Parameters) ([]*models.Group, error)
 	GetGroupByID(ctx context.Context, id string, params Parameters) (*models.Group, error)
+	SearchGroup(ctx context.Context, params Parameters) (*GroupSearchResult, error)
+	SearchGroupPage(ctx context.Context, url string) (*GroupSearchResult, error)
 	CreateGroup(ctx context.Context, params Parameters, entity *models.Group) (*models.Group, error)
 	UpdateGroup(ctx context.Context, params Parameters, entity *models.Group) (*models.Group, error)
 	UpdateGroupByID(ctx context.Context, id string, params Parameters, entity *models.Group) (*models.Group, error)
@@ -545,6 +884,8 @@ type ClientInterface interface {
 	DeleteGroupByID(ctx context.Context, id string, params Parameters) (*models.Group, error)
 	GetGuidanceResponse(ctx context.Context, params Parameters) ([]*models.GuidanceResponse, error)
 	GetGuidanceResponseByID(ctx context.Context, id string, params Parameters) (*models.GuidanceResponse, error)
+	SearchGuidanceResponse(ctx context.Context, params Parameters) (*GuidanceResponseSearchResult, error)
+	SearchGuidanceResponsePage(ctx context.Context, url string) (*GuidanceResponseSearchResult, error)
 	CreateGuidanceResponse(ctx context.Context, params Parameters, entity *models.GuidanceResponse) (*models.GuidanceResponse, error)
 	UpdateGuidanceResponse(ctx context.Context, params Parameters, entity *models.GuidanceResponse) (*models.GuidanceResponse, error)
 	UpdateGuidanceResponseByID(ctx context.Context, id string, params Parameters, entity *models.GuidanceResponse) (*models.GuidanceResponse, error)
@@ -554,6 +895,8 @@ type ClientInterface interface {
 	DeleteGuidanceResponseByID(ctx context.Context, id string, params Parameters) (*models.GuidanceResponse, error)
 	GetHealthcareService(ctx context.Context, params Parameters) ([]*models.HealthcareService, error)
 	GetHealthcareServiceByID(ctx context.Context, id string, params Parameters) (*models.HealthcareService, error)
+	SearchHealthcareService(ctx context.Context, params Parameters) (*HealthcareServiceSearchResult, error)
+	SearchHealthcareServicePage(ctx context.Context, url string) (*HealthcareServiceSearchResult, error)
 	CreateHealthcareService(ctx context.Context, params Parameters, entity *models.HealthcareService) (*models.HealthcareService, error)
 	UpdateHealthcareService(ctx context.Context, params Parameters, entity *models.HealthcareService) (*models.HealthcareService, error)
 	UpdateHealthcareServiceByID(ctx context.Context, id string, params Parameters, entity *models.HealthcareService) (*models.HealthcareService, error)
@@ -563,6 +906,8 @@ type ClientInterface interface {
 	DeleteHealthcareServiceByID(ctx context.Context, id string, params Parameters) (*models.HealthcareService, error)
 	GetImagingStudy(ctx context.Context, params Parameters) ([]*models.ImagingStudy, error)
 	GetImagingStudyByID(ctx context.Context, id string, params Parameters) (*models.ImagingStudy, error)
+	SearchImagingStudy(ctx context.Context, params Parameters) (*ImagingStudySearchResult, error)
+	SearchImagingStudyPage(ctx context.Context, url string) (*ImagingStudySearchResult, error)
 	CreateImagingStudy(ctx context.Context, params Parameters, entity *models.ImagingStudy) (*models.ImagingStudy, error)
 	UpdateImagingStudy(ctx context.Context, params Parameters, entity *models.ImagingStudy) (*models.ImagingStudy, error)
 	UpdateImagingStudyByID(ctx context.Context, id string, params Parameters, entity *models.ImagingStudy) (*models.ImagingStudy, error)
@@ -572,6 +917,8 @@ type ClientInterface interface {
 	DeleteImagingStudyByID(ctx context.Context, id string, params Parameters) (*models.ImagingStudy, error)
 	GetImmunization(ctx context.Context, params Parameters) ([]*models.Immunization, error)
 	GetImmunizationByID(ctx context.Context, id string, params Parameters) (*models.Immunization, error)
+	SearchImmunization(ctx context.Context, params Parameters) (*ImmunizationSearchResult, error)
+	SearchImmunizationPage(ctx context.Context, url string) (*ImmunizationSearchResult, error)
 	CreateImmunization(ctx context.Context, params Parameters, entity *models.Immunization) (*models.Immunization, error)
 	UpdateImmunization(ctx context.Context, params Parameters, entity *models.Immunization) (*models.Immunization, error)
 	UpdateImmunizationByID(ctx context.Context, id string, params Parameters, entity *models.Immunization) (*models.Immunization, error)
@@ -581,6 +928,8 @@ type ClientInterface interface {
 	DeleteImmunizationByID(ctx context.Context, id string, params Parameters) (*models.Immunization, error)
 	GetImmunizationEvaluation(ctx context.Context, params Parameters) ([]*models.ImmunizationEvaluation, error)
 	GetImmunizationEvaluationByID(ctx context.Context, id string, params Parameters) (*models.ImmunizationEvaluation, error)
+	SearchImmunizationEvaluation(ctx context.Context, params Parameters) (*ImmunizationEvaluationSearchResult, error)
+	SearchImmunizationEvaluationPage(ctx context.Context, url string) (*ImmunizationEvaluationSearchResult, error)
 	CreateImmunizationEvaluation(ctx context.Context, params Parameters, entity *models.ImmunizationEvaluation) (*models.ImmunizationEvaluation, error)
 	UpdateImmunizationEvaluation(ctx context.Context, params Parameters, entity *models.ImmunizationEvaluation) (*models.ImmunizationEvaluation, error)
 	UpdateImmunizationEvaluationByID(ctx context.Context, id string, params Parameters, entity *models.ImmunizationEvaluation) (*models.ImmunizationEvaluation, error)
@@ -590,6 +939,8 @@ type ClientInterface interface {
 	DeleteImmunizationEvaluationByID(ctx context.Context, id string, params Parameters) (*models.ImmunizationEvaluation, error)
 	GetImmunizationRecommendation(ctx context.Context, params Parameters) ([]*models.ImmunizationRecommendation, error)
 	GetImmunizationRecommendationByID(ctx context.Context, id string, params Parameters) (*models.ImmunizationRecommendation, error)
+	SearchImmunizationRecommendation(ctx context.Context, params Parameters) (*ImmunizationRecommendationSearchResult, error)
+	SearchImmunizationRecommendationPage(ctx context.Context, url string) (*ImmunizationRecommendationSearchResult, error)
 	CreateImmunizationRecommendation(ctx context.Context, params Parameters, entity *models.ImmunizationRecommendation) (*models.ImmunizationRecommendation, error)
 	UpdateImmunizationRecommendation(ctx context.Context, params Parameters, entity *models.ImmunizationRecommendation) (*models.ImmunizationRecommendation, error)
 	UpdateImmunizationRecommendationByID(ctx context.Context, id string, params Parameters, entity *models.ImmunizationRecommendation) (*models.ImmunizationRecommendation, error)
@@ -599,6 +950,8 @@ type ClientInterface interface {
 	DeleteImmunizationRecommendationByID(ctx context.Context, id string, params Parameters) (*models.ImmunizationRecommendation, error)
 	GetImplementationGuide(ctx context.Context, params Parameters) ([]*models.ImplementationGuide, error)
 	GetImplementationGuideByID(ctx context.Context, id string, params Parameters) (*models.ImplementationGuide, error)
+	SearchImplementationGuide(ctx context.Context, params Parameters) (*ImplementationGuideSearchResult, error)
+	SearchImplementationGuidePage(ctx context.Context, url string) (*ImplementationGuideSearchResult, error)
 	CreateImplementationGuide(ctx context.Context, params Parameters, entity *models.ImplementationGuide) (*models.ImplementationGuide, error)
 	UpdateImplementationGuide(ctx context.Context, params Parameters, entity *models.ImplementationGuide) (*models.ImplementationGuide, error)
 	UpdateImplementationGuideByID(ctx context.Context, id string, params Parameters, entity *models.ImplementationGuide) (*models.ImplementationGuide, error)
@@ -608,6 +961,8 @@ type ClientInterface interface {
 	DeleteImplementationGuideByID(ctx context.Context, id string, params Parameters) (*models.ImplementationGuide, error)
 	GetInsurancePlan(ctx context.Context, params Parameters) ([]*models.InsurancePlan, error)
 	GetInsurancePlanByID(ctx context.Context, id string, params Parameters) (*models.InsurancePlan, error)
+	SearchInsurancePlan(ctx context.Context, params Parameters) (*InsurancePlanSearchResult, error)
+	SearchInsurancePlanPage(ctx context.Context, url string) (*InsurancePlanSearchResult, error)
 	CreateInsurancePlan(ctx context.Context, params Parameters, entity *models.InsurancePlan) (*models.InsurancePlan, error)
 	UpdateInsurancePlan(ctx context.Context, params Parameters, entity *models.InsurancePlan) (*models.InsurancePlan, error)
 	UpdateInsurancePlanByID(ctx context.Context, id string, params Parameters, entity *models.InsurancePlan) (*models.InsurancePlan, error)
@@ -617,6 +972,8 @@ type ClientInterface interface {
 	DeleteInsurancePlanByID(ctx context.Context, id string, params Parameters) (*models.InsurancePlan, error)
 	GetInvoice(ctx context.Context, params Parameters) ([]*models.Invoice, error)
 	GetInvoiceByID(ctx context.Context, id string, params Parameters) (*models.Invoice, error)
+	SearchInvoice(ctx context.Context, params Parameters) (*InvoiceSearchResult, error)
+	SearchInvoicePage(ctx context.Context, url string) (*InvoiceSearchResult, error)
 	CreateInvoice(ctx context.Context, params Parameters, entity *models.Invoice) (*models.Invoice, error)
 	UpdateInvoice(ctx context.Context, params Parameters, entity *models.Invoice) (*models.Invoice, error)
 	UpdateInvoiceByID(ctx context.Context, id string, params Parameters, entity *models.Invoice) (*models.Invoice, error)
@@ -626,6 +983,8 @@ type ClientInterface interface {
 	DeleteInvoiceByID(ctx context.Context, id string, params Parameters) (*models.Invoice, error)
 	GetLibrary(ctx context.Context, params Parameters) ([]*models.Library, error)
 	GetLibraryByID(ctx context.Context, id string, params Parameters) (*models.Library, error)
+	SearchLibrary(ctx context.Context, params Parameters) (*LibrarySearchResult, error)
+	SearchLibraryPage(ctx context.Context, url string) (*LibrarySearchResult, error)
 	CreateLibrary(ctx context.Context, params Parameters, entity *models.Library) (*models.Library, error)
 	UpdateLibrary(ctx context.Context, params Parameters, entity *models.Library) (*models.Library, error)
 	UpdateLibraryByID(ctx context.Context, id string, params Parameters, entity *models.Library) (*models.Library, error)
@@ -635,6 +994,8 @@ type ClientInterface interface {
 	DeleteLibraryByID(ctx context.Context, id string, params Parameters) (*models.Library, error)
 	GetLinkage(ctx context.Context, params Parameters) ([]*models.Linkage, error)
 	GetLinkageByID(ctx context.Context, id string, params Parameters) (*models.Linkage, error)
+	SearchLinkage(ctx context.Context, params Parameters) (*LinkageSearchResult, error)
+	SearchLinkagePage(ctx context.Context, url string) (*LinkageSearchResult, error)
 	CreateLinkage(ctx context.Context, params Parameters, entity *models.Linkage) (*models.Linkage, error)
 	UpdateLinkage(ctx context.Context, params Parameters, entity *models.Linkage) (*models.Linkage, error)
 	UpdateLinkageByID(ctx context.Context, id string, params Parameters, entity *models.Linkage) (*models.Linkage, error)
@@ -644,6 +1005,8 @@ type ClientInterface interface {
 	DeleteLinkageByID(ctx context.Context, id string, params Parameters) (*models.Linkage, error)
 	GetList(ctx context.Context, params Parameters) ([]*models.List, error)
 	GetListByID(ctx context.Context, id string, params Parameters) (*models.List, error)
+	SearchList(ctx context.Context, params Parameters) (*ListSearchResult, error)
+	SearchListPage(ctx context.Context, url string) (*ListSearchResult, error)
 	CreateList(ctx context.Context, params Parameters, entity *models.List) (*models.List, error)
 	UpdateList(ctx context.Context, params Parameters, entity *models.List) (*models.List, error)
 	UpdateListByID(ctx context.Context, id string, params Parameters, entity *models.List) (*models.List, error)
@@ -653,6 +1016,8 @@ type ClientInterface interface {
 	DeleteListByID(ctx context.Context, id string, params Parameters) (*models.List, error)
 	GetLocation(ctx context.Context, params Parameters) ([]*models.Location, error)
 	GetLocationByID(ctx context.Context, id string, params Parameters) (*models.Location, error)
+	SearchLocation(ctx context.Context, params Parameters) (*LocationSearchResult, error)
+	SearchLocationPage(ctx context.Context, url string) (*LocationSearchResult, error)
 	CreateLocation(ctx context.Context, params Parameters, entity *models.Location) (*models.Location, error)
 	UpdateLocation(ctx context.Context, params Parameters, entity *models.Location) (*models.Location, error)
 	UpdateLocationByID(ctx context.Context, id string, params Parameters, entity *models.Location) (*models.Location, error)
@@ -662,6 +1027,8 @@ type ClientInterface interface {
 	DeleteLocationByID(ctx context.Context, id string, params Parameters) (*models.Location, error)
 	GetMeasure(ctx context.Context, params Parameters) ([]*models.Measure, error)
 	GetMeasureByID(ctx context.Context, id string, params Parameters) (*models.Measure, error)
+	SearchMeasure(ctx context.Context, params Parameters) (*MeasureSearchResult, error)
+	SearchMeasurePage(ctx context.Context, url string) (*MeasureSearchResult, error)
 	CreateMeasure(ctx context.Context, params Parameters, entity *models.Measure) (*models.Measure, error)
 	UpdateMeasure(ctx context.Context, params Parameters, entity *models.Measure) (*models.Measure, error)
 	UpdateMeasureByID(ctx context.Context, id string, params Parameters, entity *models.Measure) (*models.Measure, error)
@@ -671,6 +1038,8 @@ type ClientInterface interface {
 	DeleteMeasureByID(ctx context.Context, id string, params Parameters) (*models.Measure, error)
 	GetMeasureReport(ctx context.Context, params Parameters) ([]*models.MeasureReport, error)
 	GetMeasureReportByID(ctx context.Context, id string, params Parameters) (*models.MeasureReport, error)
+	SearchMeasureReport(ctx context.Context, params Parameters) (*MeasureReportSearchResult, error)
+	SearchMeasureReportPage(ctx context.Context, url string) (*MeasureReportSearchResult, error)
 	CreateMeasureReport(ctx context.Context, params Parameters, entity *models.MeasureReport) (*models.MeasureReport, error)
 	UpdateMeasureReport(ctx context.Context, params Parameters, entity *models.MeasureReport) (*models.MeasureReport, error)
 	UpdateMeasureReportByID(ctx context.Context, id string, params Parameters, entity *models.MeasureReport) (*models.MeasureReport, error)
@@ -680,6 +1049,8 @@ type ClientInterface interface {
 	DeleteMeasureReportByID(ctx context.Context, id string, params Parameters) (*models.MeasureReport, error)
 	GetMedia(ctx context.Context, params Parameters) ([]*models.Media, error)
 	GetMediaByID(ctx context.Context, id string, params Parameters) (*models.Media, error)
+	SearchMedia(ctx context.Context, params Parameters) (*MediaSearchResult, error)
+	SearchMediaPage(ctx context.Context, url string) (*MediaSearchResult, error)
 	CreateMedia(ctx context.Context, params Parameters, entity *models.Media) (*models.Media, error)
 	UpdateMedia(ctx context.Context, params Parameters, entity *models.Media) (*models.Media, error)
 	UpdateMediaByID(ctx context.Context, id string, params Parameters, entity *models.Media) (*models.Media, error)
@@ -689,6 +1060,8 @@ type ClientInterface interface {
 	DeleteMediaByID(ctx context.Context, id string, params Parameters) (*models.Media, error)
 	GetMedication(ctx context.Context, params Parameters) ([]*models.Medication, error)
 	GetMedicationByID(ctx context.Context, id string, params Parameters) (*models.Medication, error)
+	SearchMedication(ctx context.Context, params Parameters) (*MedicationSearchResult, error)
+	SearchMedicationPage(ctx context.Context, url string) (*MedicationSearchResult, error)
 	CreateMedication(ctx context.Context, params Parameters, entity *models.Medication) (*models.Medication, error)
 	UpdateMedication(ctx context.Context, params Parameters, entity *models.Medication) (*models.Medication, error)
 	UpdateMedicationByID(ctx context.Context, id string, params Parameters, entity *models.Medication) (*models.Medication, error)
@@ -698,6 +1071,8 @@ type ClientInterface interface {
 	DeleteMedicationByID(ctx context.Context, id string, params Parameters) (*models.Medication, error)
 	GetMedicationAdministration(ctx context.Context, params Parameters) ([]*models.MedicationAdministration, error)
 	GetMedicationAdministrationByID(ctx context.Context, id string, params Parameters) (*models.MedicationAdministration, error)
+	SearchMedicationAdministration(ctx context.Context, params Parameters) (*MedicationAdministrationSearchResult, error)
+	SearchMedicationAdministrationPage(ctx context.Context, url string) (*MedicationAdministrationSearchResult, error)
 	CreateMedicationAdministration(ctx context.Context, params Parameters, entity *models.MedicationAdministration) (*models.MedicationAdministration, error)
 	UpdateMedicationAdministration(ctx context.Context, params Parameters, entity *models.MedicationAdministration) (*models.MedicationAdministration, error)
 	UpdateMedicationAdministrationByID(ctx context.Context, id string, params Parameters, entity *models.MedicationAdministration) (*models.MedicationAdministration, error)
@@ -707,6 +1082,8 @@ type ClientInterface interface {
 	DeleteMedicationAdministrationByID(ctx context.Context, id string, params Parameters) (*models.MedicationAdministration, error)
 	GetMedicationDispense(ctx context.Context, params Parameters) ([]*models.MedicationDispense, error)
 	GetMedicationDispenseByID(ctx context.Context, id string, params Parameters) (*models.MedicationDispense, error)
+	SearchMedicationDispense(ctx context.Context, params Parameters) (*MedicationDispenseSearchResult, error)
+	SearchMedicationDispensePage(ctx context.Context, url string) (*MedicationDispenseSearchResult, error)
 	CreateMedicationDispense(ctx context.Context, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error)
 	UpdateMedicationDispense(ctx context.Context, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error)
 	UpdateMedicationDispenseByID(ctx context.Context, id string, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error)
@@ -716,6 +1093,8 @@ type ClientInterface interface {
 	DeleteMedicationDispenseByID(ctx context.Context, id string, params Parameters) (*models.MedicationDispense, error)
 	GetMedicationKnowledge(ctx context.Context, params Parameters) ([]*models.MedicationKnowledge, error)
 	GetMedicationKnowledgeByID(ctx context.Context, id string, params Parameters) (*models.MedicationKnowledge, error)
+	SearchMedicationKnowledge(ctx context.Context, params Parameters) (*MedicationKnowledgeSearchResult, error)
+	SearchMedicationKnowledgePage(ctx context.Context, url string) (*MedicationKnowledgeSearchResult, error)
 	CreateMedicationKnowledge(ctx context.Context, params Parameters, entity *models.MedicationKnowledge) (*models.MedicationKnowledge, error)
 	UpdateMedicationKnowledge(ctx context.Context, params Parameters, entity *models.MedicationKnowledge) (*models.MedicationKnowledge, error)
 	UpdateMedicationKnowledgeByID(ctx context.Context, id string, params Parameters, entity *models.MedicationKnowledge) (*models.MedicationKnowledge, error)
@@ -725,6 +1104,8 @@ type ClientInterface interface {
 	DeleteMedicationKnowledgeByID(ctx context.Context, id string, params Parameters) (*models.MedicationKnowledge, error)
 	GetMedicationRequest(ctx context.Context, params Parameters) ([]*models.MedicationRequest, error)
 	GetMedicationRequestByID(ctx context.Context, id string, params Parameters) (*models.MedicationRequest, error)
+	SearchMedicationRequest(ctx context.Context, params Parameters) (*MedicationRequestSearchResult, error)
+	SearchMedicationRequestPage(ctx context.Context, url string) (*MedicationRequestSearchResult, error)
 	CreateMedicationRequest(ctx context.Context, params Parameters, entity *models.MedicationRequest) (*models.MedicationRequest, error)
 	UpdateMedicationRequest(ctx context.Context, params Parameters, entity *models.MedicationRequest) (*models.MedicationRequest, error)
 	UpdateMedicationRequestByID(ctx context.Context, id string, params Parameters, entity *models.MedicationRequest) (*models.MedicationRequest, error)
@@ -734,6 +1115,8 @@ type ClientInterface interface {
 	DeleteMedicationRequestByID(ctx context.Context, id string, params Parameters) (*models.MedicationRequest, error)
 	GetMedicationStatement(ctx context.Context, params Parameters) ([]*models.MedicationStatement, error)
 	GetMedicationStatementByID(ctx context.Context, id string, params Parameters) (*models.MedicationStatement, error)
+	SearchMedicationStatement(ctx context.Context, params Parameters) (*MedicationStatementSearchResult, error)
+	SearchMedicationStatementPage(ctx context.Context, url string) (*MedicationStatementSearchResult, error)
 	CreateMedicationStatement(ctx context.Context, params Parameters, entity *models.MedicationStatement) (*models.MedicationStatement, error)
 	UpdateMedicationStatement(ctx context.Context, params Parameters, entity *models.MedicationStatement) (*models.MedicationStatement, error)
 	UpdateMedicationStatementByID(ctx context.Context, id string, params Parameters, entity *models.MedicationStatement) (*models.MedicationStatement, error)
@@ -743,6 +1126,8 @@ type ClientInterface interface {
 	DeleteMedicationStatementByID(ctx context.Context, id string, params Parameters) (*models.MedicationStatement, error)
 	GetMedicinalProduct(ctx context.Context, params Parameters) ([]*models.MedicinalProduct, error)
 	GetMedicinalProductByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProduct, error)
+	SearchMedicinalProduct(ctx context.Context, params Parameters) (*MedicinalProductSearchResult, error)
+	SearchMedicinalProductPage(ctx context.Context, url string) (*MedicinalProductSearchResult, error)
 	CreateMedicinalProduct(ctx context.Context, params Parameters, entity *models.MedicinalProduct) (*models.MedicinalProduct, error)
 	UpdateMedicinalProduct(ctx context.Context, params Parameters, entity *models.MedicinalProduct) (*models.MedicinalProduct, error)
 	UpdateMedicinalProductByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProduct) (*models.MedicinalProduct, error)
@@ -752,6 +1137,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProduct, error)
 	GetMedicinalProductAuthorization(ctx context.Context, params Parameters) ([]*models.MedicinalProductAuthorization, error)
 	GetMedicinalProductAuthorizationByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductAuthorization, error)
+	SearchMedicinalProductAuthorization(ctx context.Context, params Parameters) (*MedicinalProductAuthorizationSearchResult, error)
+	SearchMedicinalProductAuthorizationPage(ctx context.Context, url string) (*MedicinalProductAuthorizationSearchResult, error)
 	CreateMedicinalProductAuthorization(ctx context.Context, params Parameters, entity *models.MedicinalProductAuthorization) (*models.MedicinalProductAuthorization, error)
 	UpdateMedicinalProductAuthorization(ctx context.Context, params Parameters, entity *models.MedicinalProductAuthorization) (*models.MedicinalProductAuthorization, error)
 	UpdateMedicinalProductAuthorizationByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductAuthorization) (*models.MedicinalProductAuthorization, error)
@@ -761,6 +1148,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductAuthorizationByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductAuthorization, error)
 	GetMedicinalProductContraindication(ctx context.Context, params Parameters) ([]*models.MedicinalProductContraindication, error)
 	GetMedicinalProductContraindicationByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductContraindication, error)
+	SearchMedicinalProductContraindication(ctx context.Context, params Parameters) (*MedicinalProductContraindicationSearchResult, error)
+	SearchMedicinalProductContraindicationPage(ctx context.Context, url string) (*MedicinalProductContraindicationSearchResult, error)
 	CreateMedicinalProductContraindication(ctx context.Context, params Parameters, entity *models.MedicinalProductContraindication) (*models.MedicinalProductContraindication, error)
 	UpdateMedicinalProductContraindication(ctx context.Context, params Parameters, entity *models.MedicinalProductContraindication) (*models.MedicinalProductContraindication, error)
 	UpdateMedicinalProductContraindicationByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductContraindication) (*models.MedicinalProductContraindication, error)
@@ -770,6 +1159,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductContraindicationByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductContraindication, error)
 	GetMedicinalProductIndication(ctx context.Context, params Parameters) ([]*models.MedicinalProductIndication, error)
 	GetMedicinalProductIndicationByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductIndication, error)
+	SearchMedicinalProductIndication(ctx context.Context, params Parameters) (*MedicinalProductIndicationSearchResult, error)
+	SearchMedicinalProductIndicationPage(ctx context.Context, url string) (*MedicinalProductIndicationSearchResult, error)
 	CreateMedicinalProductIndication(ctx context.Context, params Parameters, entity *models.MedicinalProductIndication) (*models.MedicinalProductIndication, error)
 	UpdateMedicinalProductIndication(ctx context.Context, params Parameters, entity *models.MedicinalProductIndication) (*models.MedicinalProductIndication, error)
 	UpdateMedicinalProductIndicationByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductIndication) (*models.MedicinalProductIndication, error)
@@ -779,6 +1170,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductIndicationByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductIndication, error)
 	GetMedicinalProductIngredient(ctx context.Context, params Parameters) ([]*models.MedicinalProductIngredient, error)
 	GetMedicinalProductIngredientByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductIngredient, error)
+	SearchMedicinalProductIngredient(ctx context.Context, params Parameters) (*MedicinalProductIngredientSearchResult, error)
+	SearchMedicinalProductIngredientPage(ctx context.Context, url string) (*MedicinalProductIngredientSearchResult, error)
 	CreateMedicinalProductIngredient(ctx context.Context, params Parameters, entity *models.MedicinalProductIngredient) (*models.MedicinalProductIngredient, error)
 	UpdateMedicinalProductIngredient(ctx context.Context, params Parameters, entity *models.MedicinalProductIngredient) (*models.MedicinalProductIngredient, error)
 	UpdateMedicinalProductIngredientByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductIngredient) (*models.MedicinalProductIngredient, error)
@@ -788,6 +1181,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductIngredientByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductIngredient, error)
 	GetMedicinalProductInteraction(ctx context.Context, params Parameters) ([]*models.MedicinalProductInteraction, error)
 	GetMedicinalProductInteractionByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductInteraction, error)
+	SearchMedicinalProductInteraction(ctx context.Context, params Parameters) (*MedicinalProductInteractionSearchResult, error)
+	SearchMedicinalProductInteractionPage(ctx context.Context, url string) (*MedicinalProductInteractionSearchResult, error)
 	CreateMedicinalProductInteraction(ctx context.Context, params Parameters, entity *models.MedicinalProductInteraction) (*models.MedicinalProductInteraction, error)
 	UpdateMedicinalProductInteraction(ctx context.Context, params Parameters, entity *models.MedicinalProductInteraction) (*models.MedicinalProductInteraction, error)
 	UpdateMedicinalProductInteractionByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductInteraction) (*models.MedicinalProductInteraction, error)
@@ -797,6 +1192,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductInteractionByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductInteraction, error)
 	GetMedicinalProductManufactured(ctx context.Context, params Parameters) ([]*models.MedicinalProductManufactured, error)
 	GetMedicinalProductManufacturedByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductManufactured, error)
+	SearchMedicinalProductManufactured(ctx context.Context, params Parameters) (*MedicinalProductManufacturedSearchResult, error)
+	SearchMedicinalProductManufacturedPage(ctx context.Context, url string) (*MedicinalProductManufacturedSearchResult, error)
 	CreateMedicinalProductManufactured(ctx context.Context, params Parameters, entity *models.MedicinalProductManufactured) (*models.MedicinalProductManufactured, error)
 	UpdateMedicinalProductManufactured(ctx context.Context, params Parameters, entity *models.MedicinalProductManufactured) (*models.MedicinalProductManufactured, error)
 	UpdateMedicinalProductManufacturedByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductManufactured) (*models.MedicinalProductManufactured, error)
@@ -806,6 +1203,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductManufacturedByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductManufactured, error)
 	GetMedicinalProductPackaged(ctx context.Context, params Parameters) ([]*models.MedicinalProductPackaged, error)
 	GetMedicinalProductPackagedByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductPackaged, error)
+	SearchMedicinalProductPackaged(ctx context.Context, params Parameters) (*MedicinalProductPackagedSearchResult, error)
+	SearchMedicinalProductPackagedPage(ctx context.Context, url string) (*MedicinalProductPackagedSearchResult, error)
 	CreateMedicinalProductPackaged(ctx context.Context, params Parameters, entity *models.MedicinalProductPackaged) (*models.MedicinalProductPackaged, error)
 	UpdateMedicinalProductPackaged(ctx context.Context, params Parameters, entity *models.MedicinalProductPackaged) (*models.MedicinalProductPackaged, error)
 	UpdateMedicinalProductPackagedByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductPackaged) (*models.MedicinalProductPackaged, error)
@@ -815,6 +1214,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductPackagedByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductPackaged, error)
 	GetMedicinalProductPharmaceutical(ctx context.Context, params Parameters) ([]*models.MedicinalProductPharmaceutical, error)
 	GetMedicinalProductPharmaceuticalByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductPharmaceutical, error)
+	SearchMedicinalProductPharmaceutical(ctx context.Context, params Parameters) (*MedicinalProductPharmaceuticalSearchResult, error)
+	SearchMedicinalProductPharmaceuticalPage(ctx context.Context, url string) (*MedicinalProductPharmaceuticalSearchResult, error)
 	CreateMedicinalProductPharmaceutical(ctx context.Context, params Parameters, entity *models.MedicinalProductPharmaceutical) (*models.MedicinalProductPharmaceutical, error)
 	UpdateMedicinalProductPharmaceutical(ctx context.Context, params Parameters, entity *models.MedicinalProductPharmaceutical) (*models.MedicinalProductPharmaceutical, error)
 	UpdateMedicinalProductPharmaceuticalByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductPharmaceutical) (*models.MedicinalProductPharmaceutical, error)
@@ -824,6 +1225,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductPharmaceuticalByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductPharmaceutical, error)
 	GetMedicinalProductUndesirableEffect(ctx context.Context, params Parameters) ([]*models.MedicinalProductUndesirableEffect, error)
 	GetMedicinalProductUndesirableEffectByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductUndesirableEffect, error)
+	SearchMedicinalProductUndesirableEffect(ctx context.Context, params Parameters) (*MedicinalProductUndesirableEffectSearchResult, error)
+	SearchMedicinalProductUndesirableEffectPage(ctx context.Context, url string) (*MedicinalProductUndesirableEffectSearchResult, error)
 	CreateMedicinalProductUndesirableEffect(ctx context.Context, params Parameters, entity *models.MedicinalProductUndesirableEffect) (*models.MedicinalProductUndesirableEffect, error)
 	UpdateMedicinalProductUndesirableEffect(ctx context.Context, params Parameters, entity *models.MedicinalProductUndesirableEffect) (*models.MedicinalProductUndesirableEffect, error)
 	UpdateMedicinalProductUndesirableEffectByID(ctx context.Context, id string, params Parameters, entity *models.MedicinalProductUndesirableEffect) (*models.MedicinalProductUndesirableEffect, error)
@@ -833,6 +1236,8 @@ type ClientInterface interface {
 	DeleteMedicinalProductUndesirableEffectByID(ctx context.Context, id string, params Parameters) (*models.MedicinalProductUndesirableEffect, error)
 	GetMessageDefinition(ctx context.Context, params Parameters) ([]*models.MessageDefinition, error)
 	GetMessageDefinitionByID(ctx context.Context, id string, params Parameters) (*models.MessageDefinition, error)
+	SearchMessageDefinition(ctx context.Context, params Parameters) (*MessageDefinitionSearchResult, error)
+	SearchMessageDefinitionPage(ctx context.Context, url string) (*MessageDefinitionSearchResult, error)
 	CreateMessageDefinition(ctx context.Context, params Parameters, entity *models.MessageDefinition) (*models.MessageDefinition, error)
 	UpdateMessageDefinition(ctx context.Context, params Parameters, entity *models.MessageDefinition) (*models.MessageDefinition, error)
 	UpdateMessageDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.MessageDefinition) (*models.MessageDefinition, error)
@@ -842,6 +1247,8 @@ type ClientInterface interface {
 	DeleteMessageDefinitionByID(ctx context.Context, id string, params Parameters) (*models.MessageDefinition, error)
 	GetMessageHeader(ctx context.Context, params Parameters) ([]*models.MessageHeader, error)
 	GetMessageHeaderByID(ctx context.Context, id string, params Parameters) (*models.MessageHeader, error)
+	SearchMessageHeader(ctx context.Context, params Parameters) (*MessageHeaderSearchResult, error)
+	SearchMessageHeaderPage(ctx context.Context, url string) (*MessageHeaderSearchResult, error)
 	CreateMessageHeader(ctx context.Context, params Parameters, entity *models.MessageHeader) (*models.MessageHeader, error)
 	UpdateMessageHeader(ctx context.Context, params Parameters, entity *models.MessageHeader) (*models.MessageHeader, error)
 	UpdateMessageHeaderByID(ctx context.Context, id string, params Parameters, entity *models.MessageHeader) (*models.MessageHeader, error)
@@ -851,6 +1258,8 @@ type ClientInterface interface {
 	DeleteMessageHeaderByID(ctx context.Context, id string, params Parameters) (*models.MessageHeader, error)
 	GetMolecularSequence(ctx context.Context, params Parameters) ([]*models.MolecularSequence, error)
 	GetMolecularSequenceByID(ctx context.Context, id string, params Parameters) (*models.MolecularSequence, error)
+	SearchMolecularSequence(ctx context.Context, params Parameters) (*MolecularSequenceSearchResult, error)
+	SearchMolecularSequencePage(ctx context.Context, url string) (*MolecularSequenceSearchResult, error)
 	CreateMolecularSequence(ctx context.Context, params Parameters, entity *models.MolecularSequence) (*models.MolecularSequence, error)
 	UpdateMolecularSequence(ctx context.Context, params Parameters, entity *models.MolecularSequence) (*models.MolecularSequence, error)
 	UpdateMolecularSequenceByID(ctx context.Context, id string, params Parameters, entity *models.MolecularSequence) (*models.MolecularSequence, error)
@@ -860,6 +1269,8 @@ type ClientInterface interface {
 	DeleteMolecularSequenceByID(ctx context.Context, id string, params Parameters) (*models.MolecularSequence, error)
 	GetNamingSystem(ctx context.Context, params Parameters) ([]*models.NamingSystem, error)
 	GetNamingSystemByID(ctx context.Context, id string, params Parameters) (*models.NamingSystem, error)
+	SearchNamingSystem(ctx context.Context, params Parameters) (*NamingSystemSearchResult, error)
+	SearchNamingSystemPage(ctx context.Context, url string) (*NamingSystemSearchResult, error)
 	CreateNamingSystem(ctx context.Context, params Parameters, entity *models.NamingSystem) (*models.NamingSystem, error)
 	UpdateNamingSystem(ctx context.Context, params Parameters, entity *models.NamingSystem) (*models.NamingSystem, error)
 	UpdateNamingSystemByID(ctx context.Context, id string, params Parameters, entity *models.NamingSystem) (*models.NamingSystem, error)
@@ -869,6 +1280,8 @@ type ClientInterface interface {
 	DeleteNamingSystemByID(ctx context.Context, id string, params Parameters) (*models.NamingSystem, error)
 	GetNutritionOrder(ctx context.Context, params Parameters) ([]*models.NutritionOrder, error)
 	GetNutritionOrderByID(ctx context.Context, id string, params Parameters) (*models.NutritionOrder, error)
+	SearchNutritionOrder(ctx context.Context, params Parameters) (*NutritionOrderSearchResult, error)
+	SearchNutritionOrderPage(ctx context.Context, url string) (*NutritionOrderSearchResult, error)
 	CreateNutritionOrder(ctx context.Context, params Parameters, entity *models.NutritionOrder) (*models.NutritionOrder, error)
 	UpdateNutritionOrder(ctx context.Context, params Parameters, entity *models.NutritionOrder) (*models.NutritionOrder, error)
 	UpdateNutritionOrderByID(ctx context.Context, id string, params Parameters, entity *models.NutritionOrder) (*models.NutritionOrder, error)
@@ -878,6 +1291,8 @@ type ClientInterface interface {
 	DeleteNutritionOrderByID(ctx context.Context, id string, params Parameters) (*models.NutritionOrder, error)
 	GetObservation(ctx context.Context, params Parameters) ([]*models.Observation, error)
 	GetObservationByID(ctx context.Context, id string, params Parameters) (*models.Observation, error)
+	SearchObservation(ctx context.Context, params Parameters) (*ObservationSearchResult, error)
+	SearchObservationPage(ctx context.Context, url string) (*ObservationSearchResult, error)
 	CreateObservation(ctx context.Context, params Parameters, entity *models.Observation) (*models.Observation, error)
 	UpdateObservation(ctx context.Context, params Parameters, entity *models.Observation) (*models.Observation, error)
 	UpdateObservationByID(ctx context.Context, id string, params Parameters, entity *models.Observation) (*models.Observation, error)
@@ -887,6 +1302,8 @@ type ClientInterface interface {
 	DeleteObservationByID(ctx context.Context, id string, params Parameters) (*models.Observation, error)
 	GetObservationDefinition(ctx context.Context, params Parameters) ([]*models.ObservationDefinition, error)
 	GetObservationDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ObservationDefinition, error)
+	SearchObservationDefinition(ctx context.Context, params Parameters) (*ObservationDefinitionSearchResult, error)
+	SearchObservationDefinitionPage(ctx context.Context, url string) (*ObservationDefinitionSearchResult, error)
 	CreateObservationDefinition(ctx context.Context, params Parameters, entity *models.ObservationDefinition) (*models.ObservationDefinition, error)
 	UpdateObservationDefinition(ctx context.Context, params Parameters, entity *models.ObservationDefinition) (*models.ObservationDefinition, error)
 	UpdateObservationDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.ObservationDefinition) (*models.ObservationDefinition, error)
@@ -896,6 +1313,8 @@ type ClientInterface interface {
 	DeleteObservationDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ObservationDefinition, error)
 	GetOperationDefinition(ctx context.Context, params Parameters) ([]*models.OperationDefinition, error)
 	GetOperationDefinitionByID(ctx context.Context, id string, params Parameters) (*models.OperationDefinition, error)
+	SearchOperationDefinition(ctx context.Context, params Parameters) (*OperationDefinitionSearchResult, error)
+	SearchOperationDefinitionPage(ctx context.Context, url string) (*OperationDefinitionSearchResult, error)
 	CreateOperationDefinition(ctx context.Context, params Parameters, entity *models.OperationDefinition) (*models.OperationDefinition, error)
 	UpdateOperationDefinition(ctx context.Context, params Parameters, entity *models.OperationDefinition) (*models.OperationDefinition, error)
 	UpdateOperationDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.OperationDefinition) (*models.OperationDefinition, error)
@@ -905,6 +1324,8 @@ type ClientInterface interface {
 	DeleteOperationDefinitionByID(ctx context.Context, id string, params Parameters) (*models.OperationDefinition, error)
 	GetOperationOutcome(ctx context.Context, params Parameters) ([]*models.OperationOutcome, error)
 	GetOperationOutcomeByID(ctx context.Context, id string, params Parameters) (*models.OperationOutcome, error)
+	SearchOperationOutcome(ctx context.Context, params Parameters) (*OperationOutcomeSearchResult, error)
+	SearchOperationOutcomePage(ctx context.Context, url string) (*OperationOutcomeSearchResult, error)
 	CreateOperationOutcome(ctx context.Context, params Parameters, entity *models.OperationOutcome) (*models.OperationOutcome, error)
 	UpdateOperationOutcome(ctx context.Context, params Parameters, entity *models.OperationOutcome) (*models.OperationOutcome, error)
 	UpdateOperationOutcomeByID(ctx context.Context, id string, params Parameters, entity *models.OperationOutcome) (*models.OperationOutcome, error)
@@ -914,6 +1335,8 @@ type ClientInterface interface {
 	DeleteOperationOutcomeByID(ctx context.Context, id string, params Parameters) (*models.OperationOutcome, error)
 	GetOrganization(ctx context.Context, params Parameters) ([]*models.Organization, error)
 	GetOrganizationByID(ctx context.Context, id string, params Parameters) (*models.Organization, error)
+	SearchOrganization(ctx context.Context, params Parameters) (*OrganizationSearchResult, error)
+	SearchOrganizationPage(ctx context.Context, url string) (*OrganizationSearchResult, error)
 	CreateOrganization(ctx context.Context, params Parameters, entity *models.Organization) (*models.Organization, error)
 	UpdateOrganization(ctx context.Context, params Parameters, entity *models.Organization) (*models.Organization, error)
 	UpdateOrganizationByID(ctx context.Context, id string, params Parameters, entity *models.Organization) (*models.Organization, error)
@@ -923,6 +1346,8 @@ type ClientInterface interface {
 	DeleteOrganizationByID(ctx context.Context, id string, params Parameters) (*models.Organization, error)
 	GetOrganizationAffiliation(ctx context.Context, params Parameters) ([]*models.OrganizationAffiliation, error)
 	GetOrganizationAffiliationByID(ctx context.Context, id string, params Parameters) (*models.OrganizationAffiliation, error)
+	SearchOrganizationAffiliation(ctx context.Context, params Parameters) (*OrganizationAffiliationSearchResult, error)
+	SearchOrganizationAffiliationPage(ctx context.Context, url string) (*OrganizationAffiliationSearchResult, error)
 	CreateOrganizationAffiliation(ctx context.Context, params Parameters, entity *models.OrganizationAffiliation) (*models.OrganizationAffiliation, error)
 	UpdateOrganizationAffiliation(ctx context.Context, params Parameters, entity *models.OrganizationAffiliation) (*models.OrganizationAffiliation, error)
 	UpdateOrganizationAffiliationByID(ctx context.Context, id string, params Parameters, entity *models.OrganizationAffiliation) (*models.OrganizationAffiliation, error)
@@ -932,6 +1357,8 @@ type ClientInterface interface {
 	DeleteOrganizationAffiliationByID(ctx context.Context, id string, params Parameters) (*models.OrganizationAffiliation, error)
 	GetParameters(ctx context.Context, params Parameters) ([]*models.Parameters, error)
 	GetParametersByID(ctx context.Context, id string, params Parameters) (*models.Parameters, error)
+	SearchParameters(ctx context.Context, params Parameters) (*ParametersSearchResult, error)
+	SearchParametersPage(ctx context.Context, url string) (*ParametersSearchResult, error)
 	CreateParameters(ctx context.Context, params Parameters, entity *models.Parameters) (*models.Parameters, error)
 	UpdateParameters(ctx context.Context, params Parameters, entity *models.Parameters) (*models.Parameters, error)
 	UpdateParametersByID(ctx context.Context, id string, params Parameters, entity *models.Parameters) (*models.Parameters, error)
@@ -941,6 +1368,8 @@ type ClientInterface interface {
 	DeleteParametersByID(ctx context.Context, id string, params Parameters) (*models.Parameters, error)
 	GetPatient(ctx context.Context, params Parameters) ([]*models.Patient, error)
 	GetPatientByID(ctx context.Context, id string, params Parameters) (*models.Patient, error)
+	SearchPatient(ctx context.Context, params Parameters) (*PatientSearchResult, error)
+	SearchPatientPage(ctx context.Context, url string) (*PatientSearchResult, error)
 	CreatePatient(ctx context.Context, params Parameters, entity *models.Patient) (*models.Patient, error)
 	UpdatePatient(ctx context.Context, params Parameters, entity *models.Patient) (*models.Patient, error)
 	UpdatePatientByID(ctx context.Context, id string, params Parameters, entity *models.Patient) (*models.Patient, error)
@@ -950,6 +1379,8 @@ type ClientInterface interface {
 	DeletePatientByID(ctx context.Context, id string, params Parameters) (*models.Patient, error)
 	GetPaymentNotice(ctx context.Context, params Parameters) ([]*models.PaymentNotice, error)
 	GetPaymentNoticeByID(ctx context.Context, id string, params Parameters) (*models.PaymentNotice, error)
+	SearchPaymentNotice(ctx context.Context, params Parameters) (*PaymentNoticeSearchResult, error)
+	SearchPaymentNoticePage(ctx context.Context, url string) (*PaymentNoticeSearchResult, error)
 	CreatePaymentNotice(ctx context.Context, params Parameters, entity *models.PaymentNotice) (*models.PaymentNotice, error)
 	UpdatePaymentNotice(ctx context.Context, params Parameters, entity *models.PaymentNotice) (*models.PaymentNotice, error)
 	UpdatePaymentNoticeByID(ctx context.Context, id string, params Parameters, entity *models.PaymentNotice) (*models.PaymentNotice, error)
@@ -959,6 +1390,8 @@ type ClientInterface interface {
 	DeletePaymentNoticeByID(ctx context.Context, id string, params Parameters) (*models.PaymentNotice, error)
 	GetPaymentReconciliation(ctx context.Context, params Parameters) ([]*models.PaymentReconciliation, error)
 	GetPaymentReconciliationByID(ctx context.Context, id string, params Parameters) (*models.PaymentReconciliation, error)
+	SearchPaymentReconciliation(ctx context.Context, params Parameters) (*PaymentReconciliationSearchResult, error)
+	SearchPaymentReconciliationPage(ctx context.Context, url string) (*PaymentReconciliationSearchResult, error)
 	CreatePaymentReconciliation(ctx context.Context, params Parameters, entity *models.PaymentReconciliation) (*models.PaymentReconciliation, error)
 	UpdatePaymentReconciliation(ctx context.Context, params Parameters, entity *models.PaymentReconciliation) (*models.PaymentReconciliation, error)
 	UpdatePaymentReconciliationByID(ctx context.Context, id string, params Parameters, entity *models.PaymentReconciliation) (*models.PaymentReconciliation, error)
@@ -968,6 +1401,8 @@ type ClientInterface interface {
 	DeletePaymentReconciliationByID(ctx context.Context, id string, params Parameters) (*models.PaymentReconciliation, error)
 	GetPerson(ctx context.Context, params Parameters) ([]*models.Person, error)
 	GetPersonByID(ctx context.Context, id string, params Parameters) (*models.Person, error)
+	SearchPerson(ctx context.Context, params Parameters) (*PersonSearchResult, error)
+	SearchPersonPage(ctx context.Context, url string) (*PersonSearchResult, error)
 	CreatePerson(ctx context.Context, params Parameters, entity *models.Person) (*models.Person, error)
 	UpdatePerson(ctx context.Context, params Parameters, entity *models.Person) (*models.Person, error)
 	UpdatePersonByID(ctx context.Context, id string, params Parameters, entity *models.Person) (*models.Person, error)
@@ -977,6 +1412,8 @@ type ClientInterface interface {
 	DeletePersonByID(ctx context.Context, id string, params Parameters) (*models.Person, error)
 	GetPlanDefinition(ctx context.Context, params Parameters) ([]*models.PlanDefinition, error)
 	GetPlanDefinitionByID(ctx context.Context, id string, params Parameters) (*models.PlanDefinition, error)
+	SearchPlanDefinition(ctx context.Context, params Parameters) (*PlanDefinitionSearchResult, error)
+	SearchPlanDefinitionPage(ctx context.Context, url string) (*PlanDefinitionSearchResult, error)
 	CreatePlanDefinition(ctx context.Context, params Parameters, entity *models.PlanDefinition) (*models.PlanDefinition, error)
 	UpdatePlanDefinition(ctx context.Context, params Parameters, entity *models.PlanDefinition) (*models.PlanDefinition, error)
 	UpdatePlanDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.PlanDefinition) (*models.PlanDefinition, error)
@@ -986,6 +1423,8 @@ type ClientInterface interface {
 	DeletePlanDefinitionByID(ctx context.Context, id string, params Parameters) (*models.PlanDefinition, error)
 	GetPractitioner(ctx context.Context, params Parameters) ([]*models.Practitioner, error)
 	GetPractitionerByID(ctx context.Context, id string, params Parameters) (*models.Practitioner, error)
+	SearchPractitioner(ctx context.Context, params Parameters) (*PractitionerSearchResult, error)
+	SearchPractitionerPage(ctx context.Context, url string) (*PractitionerSearchResult, error)
 	CreatePractitioner(ctx context.Context, params Parameters, entity *models.Practitioner) (*models.Practitioner, error)
 	UpdatePractitioner(ctx context.Context, params Parameters, entity *models.Practitioner) (*models.Practitioner, error)
 	UpdatePractitionerByID(ctx context.Context, id string, params Parameters, entity *models.Practitioner) (*models.Practitioner, error)
@@ -995,6 +1434,8 @@ type ClientInterface interface {
 	DeletePractitionerByID(ctx context.Context, id string, params Parameters) (*models.Practitioner, error)
 	GetPractitionerRole(ctx context.Context, params Parameters) ([]*models.PractitionerRole, error)
 	GetPractitionerRoleByID(ctx context.Context, id string, params Parameters) (*models.PractitionerRole, error)
+	SearchPractitionerRole(ctx context.Context, params Parameters) (*PractitionerRoleSearchResult, error)
+	SearchPractitionerRolePage(ctx context.Context, url string) (*PractitionerRoleSearchResult, error)
 	CreatePractitionerRole(ctx context.Context, params Parameters, entity *models.PractitionerRole) (*models.PractitionerRole, error)
 	UpdatePractitionerRole(ctx context.Context, params Parameters, entity *models.PractitionerRole) (*models.PractitionerRole, error)
 	UpdatePractitionerRoleByID(ctx context.Context, id string, params Parameters, entity *models.PractitionerRole) (*models.PractitionerRole, error)
@@ -1004,6 +1445,8 @@ type ClientInterface interface {
 	DeletePractitionerRoleByID(ctx context.Context, id string, params Parameters) (*models.PractitionerRole, error)
 	GetProcedure(ctx context.Context, params Parameters) ([]*models.Procedure, error)
 	GetProcedureByID(ctx context.Context, id string, params Parameters) (*models.Procedure, error)
+	SearchProcedure(ctx context.Context, params Parameters) (*ProcedureSearchResult, error)
+	SearchProcedurePage(ctx context.Context, url string) (*ProcedureSearchResult, error)
 	CreateProcedure(ctx context.Context, params Parameters, entity *models.Procedure) (*models.Procedure, error)
 	UpdateProcedure(ctx context.Context, params Parameters, entity *models.Procedure) (*models.Procedure, error)
 	UpdateProcedureByID(ctx context.Context, id string, params Parameters, entity *models.Procedure) (*models.Procedure, error)
@@ -1013,6 +1456,8 @@ type ClientInterface interface {
 	DeleteProcedureByID(ctx context.Context, id string, params Parameters) (*models.Procedure, error)
 	GetProvenance(ctx context.Context, params Parameters) ([]*models.Provenance, error)
 	GetProvenanceByID(ctx context.Context, id string, params Parameters) (*models.Provenance, error)
+	SearchProvenance(ctx context.Context, params Parameters) (*ProvenanceSearchResult, error)
+	SearchProvenancePage(ctx context.Context, url string) (*ProvenanceSearchResult, error)
 	CreateProvenance(ctx context.Context, params Parameters, entity *models.Provenance) (*models.Provenance, error)
 	UpdateProvenance(ctx context.Context, params Parameters, entity *models.Provenance) (*models.Provenance, error)
 	UpdateProvenanceByID(ctx context.Context, id string, params Parameters, entity *models.Provenance) (*models.Provenance, error)
@@ -1022,6 +1467,8 @@ type ClientInterface interface {
 	DeleteProvenanceByID(ctx context.Context, id string, params Parameters) (*models.Provenance, error)
 	GetQuestionnaire(ctx context.Context, params Parameters) ([]*models.Questionnaire, error)
 	GetQuestionnaireByID(ctx context.Context, id string, params Parameters) (*models.Questionnaire, error)
+	SearchQuestionnaire(ctx context.Context, params Parameters) (*QuestionnaireSearchResult, error)
+	SearchQuestionnairePage(ctx context.Context, url string) (*QuestionnaireSearchResult, error)
 	CreateQuestionnaire(ctx context.Context, params Parameters, entity *models.Questionnaire) (*models.Questionnaire, error)
 	UpdateQuestionnaire(ctx context.Context, params Parameters, entity *models.Questionnaire) (*models.Questionnaire, error)
 	UpdateQuestionnaireByID(ctx context.Context, id string, params Parameters, entity *models.Questionnaire) (*models.Questionnaire, error)
@@ -1031,6 +1478,8 @@ type ClientInterface interface {
 	DeleteQuestionnaireByID(ctx context.Context, id string, params Parameters) (*models.Questionnaire, error)
 	GetQuestionnaireResponse(ctx context.Context, params Parameters) ([]*models.QuestionnaireResponse, error)
 	GetQuestionnaireResponseByID(ctx context.Context, id string, params Parameters) (*models.QuestionnaireResponse, error)
+	SearchQuestionnaireResponse(ctx context.Context, params Parameters) (*QuestionnaireResponseSearchResult, error)
+	SearchQuestionnaireResponsePage(ctx context.Context, url string) (*QuestionnaireResponseSearchResult, error)
 	CreateQuestionnaireResponse(ctx context.Context, params Parameters, entity *models.QuestionnaireResponse) (*models.QuestionnaireResponse, error)
 	UpdateQuestionnaireResponse(ctx context.Context, params Parameters, entity *models.QuestionnaireResponse) (*models.QuestionnaireResponse, error)
 	UpdateQuestionnaireResponseByID(ctx context.Context, id string, params Parameters, entity *models.QuestionnaireResponse) (*models.QuestionnaireResponse, error)
@@ -1040,6 +1489,8 @@ type ClientInterface interface {
 	DeleteQuestionnaireResponseByID(ctx context.Context, id string, params Parameters) (*models.QuestionnaireResponse, error)
 	GetRelatedPerson(ctx context.Context, params Parameters) ([]*models.RelatedPerson, error)
 	GetRelatedPersonByID(ctx context.Context, id string, params Parameters) (*models.RelatedPerson, error)
+	SearchRelatedPerson(ctx context.Context, params Parameters) (*RelatedPersonSearchResult, error)
+	SearchRelatedPersonPage(ctx context.Context, url string) (*RelatedPersonSearchResult, error)
 	CreateRelatedPerson(ctx context.Context, params Parameters, entity *models.RelatedPerson) (*models.RelatedPerson, error)
 	UpdateRelatedPerson(ctx context.Context, params Parameters, entity *models.RelatedPerson) (*models.RelatedPerson, error)
 	UpdateRelatedPersonByID(ctx context.Context, id string, params Parameters, entity *models.RelatedPerson) (*models.RelatedPerson, error)
@@ -1049,6 +1500,8 @@ type ClientInterface interface {
 	DeleteRelatedPersonByID(ctx context.Context, id string, params Parameters) (*models.RelatedPerson, error)
 	GetRequestGroup(ctx context.Context, params Parameters) ([]*models.RequestGroup, error)
 	GetRequestGroupByID(ctx context.Context, id string, params Parameters) (*models.RequestGroup, error)
+	SearchRequestGroup(ctx context.Context, params Parameters) (*RequestGroupSearchResult, error)
+	SearchRequestGroupPage(ctx context.Context, url string) (*RequestGroupSearchResult, error)
 	CreateRequestGroup(ctx context.Context, params Parameters, entity *models.RequestGroup) (*models.RequestGroup, error)
 	UpdateRequestGroup(ctx context.Context, params Parameters, entity *models.RequestGroup) (*models.RequestGroup, error)
 	UpdateRequestGroupByID(ctx context.Context, id string, params Parameters, entity *models.RequestGroup) (*models.RequestGroup, error)
@@ -1058,6 +1511,8 @@ type ClientInterface interface {
 	DeleteRequestGroupByID(ctx context.Context, id string, params Parameters) (*models.RequestGroup, error)
 	GetResearchDefinition(ctx context.Context, params Parameters) ([]*models.ResearchDefinition, error)
 	GetResearchDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ResearchDefinition, error)
+	SearchResearchDefinition(ctx context.Context, params Parameters) (*ResearchDefinitionSearchResult, error)
+	SearchResearchDefinitionPage(ctx context.Context, url string) (*ResearchDefinitionSearchResult, error)
 	CreateResearchDefinition(ctx context.Context, params Parameters, entity *models.ResearchDefinition) (*models.ResearchDefinition, error)
 	UpdateResearchDefinition(ctx context.Context, params Parameters, entity *models.ResearchDefinition) (*models.ResearchDefinition, error)
 	UpdateResearchDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.ResearchDefinition) (*models.ResearchDefinition, error)
@@ -1067,6 +1522,8 @@ type ClientInterface interface {
 	DeleteResearchDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ResearchDefinition, error)
 	GetResearchElementDefinition(ctx context.Context, params Parameters) ([]*models.ResearchElementDefinition, error)
 	GetResearchElementDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ResearchElementDefinition, error)
+	SearchResearchElementDefinition(ctx context.Context, params Parameters) (*ResearchElementDefinitionSearchResult, error)
+	SearchResearchElementDefinitionPage(ctx context.Context, url string) (*ResearchElementDefinitionSearchResult, error)
 	CreateResearchElementDefinition(ctx context.Context, params Parameters, entity *models.ResearchElementDefinition) (*models.ResearchElementDefinition, error)
 	UpdateResearchElementDefinition(ctx context.Context, params Parameters, entity *models.ResearchElementDefinition) (*models.ResearchElementDefinition, error)
 	UpdateResearchElementDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.ResearchElementDefinition) (*models.ResearchElementDefinition, error)
@@ -1076,6 +1533,8 @@ type ClientInterface interface {
 	DeleteResearchElementDefinitionByID(ctx context.Context, id string, params Parameters) (*models.ResearchElementDefinition, error)
 	GetResearchStudy(ctx context.Context, params Parameters) ([]*models.ResearchStudy, error)
 	GetResearchStudyByID(ctx context.Context, id string, params Parameters) (*models.ResearchStudy, error)
+	SearchResearchStudy(ctx context.Context, params Parameters) (*ResearchStudySearchResult, error)
+	SearchResearchStudyPage(ctx context.Context, url string) (*ResearchStudySearchResult, error)
 	CreateResearchStudy(ctx context.Context, params Parameters, entity *models.ResearchStudy) (*models.ResearchStudy, error)
 	UpdateResearchStudy(ctx context.Context, params Parameters, entity *models.ResearchStudy) (*models.ResearchStudy, error)
 	UpdateResearchStudyByID(ctx context.Context, id string, params Parameters, entity *models.ResearchStudy) (*models.ResearchStudy, error)
@@ -1085,6 +1544,8 @@ type ClientInterface interface {
 	DeleteResearchStudyByID(ctx context.Context, id string, params Parameters) (*models.ResearchStudy, error)
 	GetResearchSubject(ctx context.Context, params Parameters) ([]*models.ResearchSubject, error)
 	GetResearchSubjectByID(ctx context.Context, id string, params Parameters) (*models.ResearchSubject, error)
+	SearchResearchSubject(ctx context.Context, params Parameters) (*ResearchSubjectSearchResult, error)
+	SearchResearchSubjectPage(ctx context.Context, url string) (*ResearchSubjectSearchResult, error)
 	CreateResearchSubject(ctx context.Context, params Parameters, entity *models.ResearchSubject) (*models.ResearchSubject, error)
 	UpdateResearchSubject(ctx context.Context, params Parameters, entity *models.ResearchSubject) (*models.ResearchSubject, error)
 	UpdateResearchSubjectByID(ctx context.Context, id string, params Parameters, entity *models.ResearchSubject) (*models.ResearchSubject, error)
@@ -1094,6 +1555,8 @@ type ClientInterface interface {
 	DeleteResearchSubjectByID(ctx context.Context, id string, params Parameters) (*models.ResearchSubject, error)
 	GetResource(ctx context.Context, params Parameters) ([]*models.Resource, error)
 	GetResourceByID(ctx context.Context, id string, params Parameters) (*models.Resource, error)
+	SearchResource(ctx context.Context, params Parameters) (*ResourceSearchResult, error)
+	SearchResourcePage(ctx context.Context, url string) (*ResourceSearchResult, error)
 	CreateResource(ctx context.Context, params Parameters, entity *models.Resource) (*models.Resource, error)
 	UpdateResource(ctx context.Context, params Parameters, entity *models.Resource) (*models.Resource, error)
 	UpdateResourceByID(ctx context.Context, id string, params Parameters, entity *models.Resource) (*models.Resource, error)
@@ -1103,6 +1566,8 @@ type ClientInterface interface {
 	DeleteResourceByID(ctx context.Context, id string, params Parameters) (*models.Resource, error)
 	GetRiskAssessment(ctx context.Context, params Parameters) ([]*models.RiskAssessment, error)
 	GetRiskAssessmentByID(ctx context.Context, id string, params Parameters) (*models.RiskAssessment, error)
+	SearchRiskAssessment(ctx context.Context, params Parameters) (*RiskAssessmentSearchResult, error)
+	SearchRiskAssessmentPage(ctx context.Context, url string) (*RiskAssessmentSearchResult, error)
 	CreateRiskAssessment(ctx context.Context, params Parameters, entity *models.RiskAssessment) (*models.RiskAssessment, error)
 	UpdateRiskAssessment(ctx context.Context, params Parameters, entity *models.RiskAssessment) (*models.RiskAssessment, error)
 	UpdateRiskAssessmentByID(ctx context.Context, id string, params Parameters, entity *models.RiskAssessment) (*models.RiskAssessment, error)
@@ -1112,6 +1577,8 @@ type ClientInterface interface {
 	DeleteRiskAssessmentByID(ctx context.Context, id string, params Parameters) (*models.RiskAssessment, error)
 	GetRiskEvidenceSynthesis(ctx context.Context, params Parameters) ([]*models.RiskEvidenceSynthesis, error)
 	GetRiskEvidenceSynthesisByID(ctx context.Context, id string, params Parameters) (*models.RiskEvidenceSynthesis, error)
+	SearchRiskEvidenceSynthesis(ctx context.Context, params Parameters) (*RiskEvidenceSynthesisSearchResult, error)
+	SearchRiskEvidenceSynthesisPage(ctx context.Context, url string) (*RiskEvidenceSynthesisSearchResult, error)
 	CreateRiskEvidenceSynthesis(ctx context.Context, params Parameters, entity *models.RiskEvidenceSynthesis) (*models.RiskEvidenceSynthesis, error)
 	UpdateRiskEvidenceSynthesis(ctx context.Context, params Parameters, entity *models.RiskEvidenceSynthesis) (*models.RiskEvidenceSynthesis, error)
 	UpdateRiskEvidenceSynthesisByID(ctx context.Context, id string, params Parameters, entity *models.RiskEvidenceSynthesis) (*models.RiskEvidenceSynthesis, error)
@@ -1121,6 +1588,8 @@ type ClientInterface interface {
 	DeleteRiskEvidenceSynthesisByID(ctx context.Context, id string, params Parameters) (*models.RiskEvidenceSynthesis, error)
 	GetSchedule(ctx context.Context, params Parameters) ([]*models.Schedule, error)
 	GetScheduleByID(ctx context.Context, id string, params Parameters) (*models.Schedule, error)
+	SearchSchedule(ctx context.Context, params Parameters) (*ScheduleSearchResult, error)
+	SearchSchedulePage(ctx context.Context, url string) (*ScheduleSearchResult, error)
 	CreateSchedule(ctx context.Context, params Parameters, entity *models.Schedule) (*models.Schedule, error)
 	UpdateSchedule(ctx context.Context, params Parameters, entity *models.Schedule) (*models.Schedule, error)
 	UpdateScheduleByID(ctx context.Context, id string, params Parameters, entity *models.Schedule) (*models.Schedule, error)
@@ -1130,6 +1599,8 @@ type ClientInterface interface {
 	DeleteScheduleByID(ctx context.Context, id string, params Parameters) (*models.Schedule, error)
 	GetSearchParameter(ctx context.Context, params Parameters) ([]*models.SearchParameter, error)
 	GetSearchParameterByID(ctx context.Context, id string, params Parameters) (*models.SearchParameter, error)
+	SearchSearchParameter(ctx context.Context, params Parameters) (*SearchParameterSearchResult, error)
+	SearchSearchParameterPage(ctx context.Context, url string) (*SearchParameterSearchResult, error)
 	CreateSearchParameter(ctx context.Context, params Parameters, entity *models.SearchParameter) (*models.SearchParameter, error)
 	UpdateSearchParameter(ctx context.Context, params Parameters, entity *models.SearchParameter) (*models.SearchParameter, error)
 	UpdateSearchParameterByID(ctx context.Context, id string, params Parameters, entity *models.SearchParameter) (*models.SearchParameter, error)
@@ -1139,6 +1610,8 @@ type ClientInterface interface {
 	DeleteSearchParameterByID(ctx context.Context, id string, params Parameters) (*models.SearchParameter, error)
 	GetServiceRequest(ctx context.Context, params Parameters) ([]*models.ServiceRequest, error)
 	GetServiceRequestByID(ctx context.Context, id string, params Parameters) (*models.ServiceRequest, error)
+	SearchServiceRequest(ctx context.Context, params Parameters) (*ServiceRequestSearchResult, error)
+	SearchServiceRequestPage(ctx context.Context, url string) (*ServiceRequestSearchResult, error)
 	CreateServiceRequest(ctx context.Context, params Parameters, entity *models.ServiceRequest) (*models.ServiceRequest, error)
 	UpdateServiceRequest(ctx context.Context, params Parameters, entity *models.ServiceRequest) (*models.ServiceRequest, error)
 	UpdateServiceRequestByID(ctx context.Context, id string, params Parameters, entity *models.ServiceRequest) (*models.ServiceRequest, error)
@@ -1148,6 +1621,8 @@ type ClientInterface interface {
 	DeleteServiceRequestByID(ctx context.Context, id string, params Parameters) (*models.ServiceRequest, error)
 	GetSlot(ctx context.Context, params Parameters) ([]*models.Slot, error)
 	GetSlotByID(ctx context.Context, id string, params Parameters) (*models.Slot, error)
+	SearchSlot(ctx context.Context, params Parameters) (*SlotSearchResult, error)
+	SearchSlotPage(ctx context.Context, url string) (*SlotSearchResult, error)
 	CreateSlot(ctx context.Context, params Parameters, entity *models.Slot) (*models.Slot, error)
 	UpdateSlot(ctx context.Context, params Parameters, entity *models.Slot) (*models.Slot, error)
 	UpdateSlotByID(ctx context.Context, id string, params Parameters, entity *models.Slot) (*models.Slot, error)
@@ -1157,6 +1632,8 @@ type ClientInterface interface {
 	DeleteSlotByID(ctx context.Context, id string, params Parameters) (*models.Slot, error)
 	GetSpecimen(ctx context.Context, params Parameters) ([]*models.Specimen, error)
 	GetSpecimenByID(ctx context.Context, id string, params Parameters) (*models.Specimen, error)
+	SearchSpecimen(ctx context.Context, params Parameters) (*SpecimenSearchResult, error)
+	SearchSpecimenPage(ctx context.Context, url string) (*SpecimenSearchResult, error)
 	CreateSpecimen(ctx context.Context, params Parameters, entity *models.Specimen) (*models.Specimen, error)
 	UpdateSpecimen(ctx context.Context, params Parameters, entity *models.Specimen) (*models.Specimen, error)
 	UpdateSpecimenByID(ctx context.Context, id string, params Parameters, entity *models.Specimen) (*models.Specimen, error)
@@ -1166,6 +1643,8 @@ type ClientInterface interface {
 	DeleteSpecimenByID(ctx context.Context, id string, params Parameters) (*models.Specimen, error)
 	GetSpecimenDefinition(ctx context.Context, params Parameters) ([]*models.SpecimenDefinition, error)
 	GetSpecimenDefinitionByID(ctx context.Context, id string, params Parameters) (*models.SpecimenDefinition, error)
+	SearchSpecimenDefinition(ctx context.Context, params Parameters) (*SpecimenDefinitionSearchResult, error)
+	SearchSpecimenDefinitionPage(ctx context.Context, url string) (*SpecimenDefinitionSearchResult, error)
 	CreateSpecimenDefinition(ctx context.Context, params Parameters, entity *models.SpecimenDefinition) (*models.SpecimenDefinition, error)
 	UpdateSpecimenDefinition(ctx context.Context, params Parameters, entity *models.SpecimenDefinition) (*models.SpecimenDefinition, error)
 	UpdateSpecimenDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.SpecimenDefinition) (*models.SpecimenDefinition, error)
@@ -1175,6 +1654,8 @@ type ClientInterface interface {
 	DeleteSpecimenDefinitionByID(ctx context.Context, id string, params Parameters) (*models.SpecimenDefinition, error)
 	GetStructureDefinition(ctx context.Context, params Parameters) ([]*models.StructureDefinition, error)
 	GetStructureDefinitionByID(ctx context.Context, id string, params Parameters) (*models.StructureDefinition, error)
+	SearchStructureDefinition(ctx context.Context, params Parameters) (*StructureDefinitionSearchResult, error)
+	SearchStructureDefinitionPage(ctx context.Context, url string) (*StructureDefinitionSearchResult, error)
 	CreateStructureDefinition(ctx context.Context, params Parameters, entity *models.StructureDefinition) (*models.StructureDefinition, error)
 	UpdateStructureDefinition(ctx context.Context, params Parameters, entity *models.StructureDefinition) (*models.StructureDefinition, error)
 	UpdateStructureDefinitionByID(ctx context.Context, id string, params Parameters, entity *models.StructureDefinition) (*models.StructureDefinition, error)
@@ -1184,6 +1665,8 @@ type ClientInterface interface {
 	DeleteStructureDefinitionByID(ctx context.Context, id string, params Parameters) (*models.StructureDefinition, error)
 	GetStructureMap(ctx context.Context, params Parameters) ([]*models.StructureMap, error)
 	GetStructureMapByID(ctx context.Context, id string, params Parameters) (*models.StructureMap, error)
+	SearchStructureMap(ctx context.Context, params Parameters) (*StructureMapSearchResult, error)
+	SearchStructureMapPage(ctx context.Context, url string) (*StructureMapSearchResult, error)
 	CreateStructureMap(ctx context.Context, params Parameters, entity *models.StructureMap) (*models.StructureMap, error)
 	UpdateStructureMap(ctx context.Context, params Parameters, entity *models.StructureMap) (*models.StructureMap, error)
 	UpdateStructureMapByID(ctx context.Context, id string, params Parameters, entity *models.StructureMap) (*models.StructureMap, error)
@@ -1193,6 +1676,8 @@ type ClientInterface interface {
 	DeleteStructureMapByID(ctx context.Context, id string, params Parameters) (*models.StructureMap, error)
 	GetSubscription(ctx context.Context, params Parameters) ([]*models.Subscription, error)
 	GetSubscriptionByID(ctx context.Context, id string, params Parameters) (*models.Subscription, error)
+	SearchSubscription(ctx context.Context, params Parameters) (*SubscriptionSearchResult, error)
+	SearchSubscriptionPage(ctx context.Context, url string) (*SubscriptionSearchResult, error)
 	CreateSubscription(ctx context.Context, params Parameters, entity *models.Subscription) (*models.Subscription, error)
 	UpdateSubscription(ctx context.Context, params Parameters, entity *models.Subscription) (*models.Subscription, error)
 	UpdateSubscriptionByID(ctx context.Context, id string, params Parameters, entity *models.Subscription) (*models.Subscription, error)
@@ -1202,6 +1687,8 @@ type ClientInterface interface {
 	DeleteSubscriptionByID(ctx context.Context, id string, params Parameters) (*models.Subscription, error)
 	GetSubstance(ctx context.Context, params Parameters) ([]*models.Substance, error)
 	GetSubstanceByID(ctx context.Context, id string, params Parameters) (*models.Substance, error)
+	SearchSubstance(ctx context.Context, params Parameters) (*SubstanceSearchResult, error)
+	SearchSubstancePage(ctx context.Context, url string) (*SubstanceSearchResult, error)
 	CreateSubstance(ctx context.Context, params Parameters, entity *models.Substance) (*models.Substance, error)
 	UpdateSubstance(ctx context.Context, params Parameters, entity *models.Substance) (*models.Substance, error)
 	UpdateSubstanceByID(ctx context.Context, id string, params Parameters, entity *models.Substance) (*models.Substance, error)
@@ -1211,6 +1698,8 @@ type ClientInterface interface {
 	DeleteSubstanceByID(ctx context.Context, id string, params Parameters) (*models.Substance, error)
 	GetSubstanceNucleicAcid(ctx context.Context, params Parameters) ([]*models.SubstanceNucleicAcid, error)
 	GetSubstanceNucleicAcidByID(ctx context.Context, id string, params Parameters) (*models.SubstanceNucleicAcid, error)
+	SearchSubstanceNucleicAcid(ctx context.Context, params Parameters) (*SubstanceNucleicAcidSearchResult, error)
+	SearchSubstanceNucleicAcidPage(ctx context.Context, url string) (*SubstanceNucleicAcidSearchResult, error)
 	CreateSubstanceNucleicAcid(ctx context.Context, params Parameters, entity *models.SubstanceNucleicAcid) (*models.SubstanceNucleicAcid, error)
 	UpdateSubstanceNucleicAcid(ctx context.Context, params Parameters, entity *models.SubstanceNucleicAcid) (*models.SubstanceNucleicAcid, error)
 	UpdateSubstanceNucleicAcidByID(ctx context.Context, id string, params Parameters, entity *models.SubstanceNucleicAcid) (*models.SubstanceNucleicAcid, error)
@@ -1220,6 +1709,8 @@ type ClientInterface interface {
 	DeleteSubstanceNucleicAcidByID(ctx context.Context, id string, params Parameters) (*models.SubstanceNucleicAcid, error)
 	GetSubstancePolymer(ctx context.Context, params Parameters) ([]*models.SubstancePolymer, error)
 	GetSubstancePolymerByID(ctx context.Context, id string, params Parameters) (*models.SubstancePolymer, error)
+	SearchSubstancePolymer(ctx context.Context, params Parameters) (*SubstancePolymerSearchResult, error)
+	SearchSubstancePolymerPage(ctx context.Context, url string) (*SubstancePolymerSearchResult, error)
 	CreateSubstancePolymer(ctx context.Context, params Parameters, entity *models.SubstancePolymer) (*models.SubstancePolymer, error)
 	UpdateSubstancePolymer(ctx context.Context, params Parameters, entity *models.SubstancePolymer) (*models.SubstancePolymer, error)
 	UpdateSubstancePolymerByID(ctx context.Context, id string, params Parameters, entity *models.SubstancePolymer) (*models.SubstancePolymer, error)
@@ -1229,6 +1720,8 @@ type ClientInterface interface {
 	DeleteSubstancePolymerByID(ctx context.Context, id string, params Parameters) (*models.SubstancePolymer, error)
 	GetSubstanceProtein(ctx context.Context, params Parameters) ([]*models.SubstanceProtein, error)
 	GetSubstanceProteinByID(ctx context.Context, id string, params Parameters) (*models.SubstanceProtein, error)
+	SearchSubstanceProtein(ctx context.Context, params Parameters) (*SubstanceProteinSearchResult, error)
+	SearchSubstanceProteinPage(ctx context.Context, url string) (*SubstanceProteinSearchResult, error)
 	CreateSubstanceProtein(ctx context.Context, params Parameters, entity *models.SubstanceProtein) (*models.SubstanceProtein, error)
 	UpdateSubstanceProtein(ctx context.Context, params Parameters, entity *models.SubstanceProtein) (*models.SubstanceProtein, error)
 	UpdateSubstanceProteinByID(ctx context.Context, id string, params Parameters, entity *models.SubstanceProtein) (*models.SubstanceProtein, error)
@@ -1238,6 +1731,8 @@ type ClientInterface interface {
 	DeleteSubstanceProteinByID(ctx context.Context, id string, params Parameters) (*models.SubstanceProtein, error)
 	GetSubstanceReferenceInformation(ctx context.Context, params Parameters) ([]*models.SubstanceReferenceInformation, error)
 	GetSubstanceReferenceInformationByID(ctx context.Context, id string, params Parameters) (*models.SubstanceReferenceInformation, error)
+	SearchSubstanceReferenceInformation(ctx context.Context, params Parameters) (*SubstanceReferenceInformationSearchResult, error)
+	SearchSubstanceReferenceInformationPage(ctx context.Context, url string) (*SubstanceReferenceInformationSearchResult, error)
 	CreateSubstanceReferenceInformation(ctx context.Context, params Parameters, entity *models.SubstanceReferenceInformation) (*models.SubstanceReferenceInformation, error)
 	UpdateSubstanceReferenceInformation(ctx context.Context, params Parameters, entity *models.SubstanceReferenceInformation) (*models.SubstanceReferenceInformation, error)
 	UpdateSubstanceReferenceInformationByID(ctx context.Context, id string, params Parameters, entity *models.SubstanceReferenceInformation) (*models.SubstanceReferenceInformation, error)
@@ -1247,6 +1742,8 @@ type ClientInterface interface {
 	DeleteSubstanceReferenceInformationByID(ctx context.Context, id string, params Parameters) (*models.SubstanceReferenceInformation, error)
 	GetSubstanceSourceMaterial(ctx context.Context, params Parameters) ([]*models.SubstanceSourceMaterial, error)
 	GetSubstanceSourceMaterialByID(ctx context.Context, id string, params Parameters) (*models.SubstanceSourceMaterial, error)
+	SearchSubstanceSourceMaterial(ctx context.Context, params Parameters) (*SubstanceSourceMaterialSearchResult, error)
+	SearchSubstanceSourceMaterialPage(ctx context.Context, url string) (*SubstanceSourceMaterialSearchResult, error)
 	CreateSubstanceSourceMaterial(ctx context.Context, params Parameters, entity *models.SubstanceSourceMaterial) (*models.SubstanceSourceMaterial, error)
 	UpdateSubstanceSourceMaterial(ctx context.Context, params Parameters, entity *models.SubstanceSourceMaterial) (*models.SubstanceSourceMaterial, error)
 	UpdateSubstanceSourceMaterialByID(ctx context.Context, id string, params Parameters, entity *models.SubstanceSourceMaterial) (*models.SubstanceSourceMaterial, error)
@@ -1256,6 +1753,8 @@ type ClientInterface interface {
 	DeleteSubstanceSourceMaterialByID(ctx context.Context, id string, params Parameters) (*models.SubstanceSourceMaterial, error)
 	GetSubstanceSpecification(ctx context.Context, params Parameters) ([]*models.SubstanceSpecification, error)
 	GetSubstanceSpecificationByID(ctx context.Context, id string, params Parameters) (*models.SubstanceSpecification, error)
+	SearchSubstanceSpecification(ctx context.Context, params Parameters) (*SubstanceSpecificationSearchResult, error)
+	SearchSubstanceSpecificationPage(ctx context.Context, url string) (*SubstanceSpecificationSearchResult, error)
 	CreateSubstanceSpecification(ctx context.Context, params Parameters, entity *models.SubstanceSpecification) (*models.SubstanceSpecification, error)
 	UpdateSubstanceSpecification(ctx context.Context, params Parameters, entity *models.SubstanceSpecification) (*models.SubstanceSpecification, error)
 	UpdateSubstanceSpecificationByID(ctx context.Context, id string, params Parameters, entity *models.SubstanceSpecification) (*models.SubstanceSpecification, error)
@@ -1265,6 +1764,8 @@ type ClientInterface interface {
 	DeleteSubstanceSpecificationByID(ctx context.Context, id string, params Parameters) (*models.SubstanceSpecification, error)
 	GetSupplyDelivery(ctx context.Context, params Parameters) ([]*models.SupplyDelivery, error)
 	GetSupplyDeliveryByID(ctx context.Context, id string, params Parameters) (*models.SupplyDelivery, error)
+	SearchSupplyDelivery(ctx context.Context, params Parameters) (*SupplyDeliverySearchResult, error)
+	SearchSupplyDeliveryPage(ctx context.Context, url string) (*SupplyDeliverySearchResult, error)
 	CreateSupplyDelivery(ctx context.Context, params Parameters, entity *models.SupplyDelivery) (*models.SupplyDelivery, error)
 	UpdateSupplyDelivery(ctx context.Context, params Parameters, entity *models.SupplyDelivery) (*models.SupplyDelivery, error)
 	UpdateSupplyDeliveryByID(ctx context.Context, id string, params Parameters, entity *models.SupplyDelivery) (*models.SupplyDelivery, error)
@@ -1274,6 +1775,8 @@ type ClientInterface interface {
 	DeleteSupplyDeliveryByID(ctx context.Context, id string, params Parameters) (*models.SupplyDelivery, error)
 	GetSupplyRequest(ctx context.Context, params Parameters) ([]*models.SupplyRequest, error)
 	GetSupplyRequestByID(ctx context.Context, id string, params Parameters) (*models.SupplyRequest, error)
+	SearchSupplyRequest(ctx context.Context, params Parameters) (*SupplyRequestSearchResult, error)
+	SearchSupplyRequestPage(ctx context.Context, url string) (*SupplyRequestSearchResult, error)
 	CreateSupplyRequest(ctx context.Context, params Parameters, entity *models.SupplyRequest) (*models.SupplyRequest, error)
 	UpdateSupplyRequest(ctx context.Context, params Parameters, entity *models.SupplyRequest) (*models.SupplyRequest, error)
 	UpdateSupplyRequestByID(ctx context.Context, id string, params Parameters, entity *models.SupplyRequest) (*models.SupplyRequest, error)
@@ -1283,6 +1786,8 @@ type ClientInterface interface {
 	DeleteSupplyRequestByID(ctx context.Context, id string, params Parameters) (*models.SupplyRequest, error)
 	GetTask(ctx context.Context, params Parameters) ([]*models.Task, error)
 	GetTaskByID(ctx context.Context, id string, params Parameters) (*models.Task, error)
+	SearchTask(ctx context.Context, params Parameters) (*TaskSearchResult, error)
+	SearchTaskPage(ctx context.Context, url string) (*TaskSearchResult, error)
 	CreateTask(ctx context.Context, params Parameters, entity *models.Task) (*models.Task, error)
 	UpdateTask(ctx context.Context, params Parameters, entity *models.Task) (*models.Task, error)
 	UpdateTaskByID(ctx context.Context, id string, params Parameters, entity *models.Task) (*models.Task, error)
@@ -1292,6 +1797,8 @@ type ClientInterface interface {
 	DeleteTaskByID(ctx context.Context, id string, params Parameters) (*models.Task, error)
 	GetTerminologyCapabilities(ctx context.Context, params Parameters) ([]*models.TerminologyCapabilities, error)
 	GetTerminologyCapabilitiesByID(ctx context.Context, id string, params Parameters) (*models.TerminologyCapabilities, error)
+	SearchTerminologyCapabilities(ctx context.Context, params Parameters) (*TerminologyCapabilitiesSearchResult, error)
+	SearchTerminologyCapabilitiesPage(ctx context.Context, url string) (*TerminologyCapabilitiesSearchResult, error)
 	CreateTerminologyCapabilities(ctx context.Context, params Parameters, entity *models.TerminologyCapabilities) (*models.TerminologyCapabilities, error)
 	UpdateTerminologyCapabilities(ctx context.Context, params Parameters, entity *models.TerminologyCapabilities) (*models.TerminologyCapabilities, error)
 	UpdateTerminologyCapabilitiesByID(ctx context.Context, id string, params Parameters, entity *models.TerminologyCapabilities) (*models.TerminologyCapabilities, error)
@@ -1301,6 +1808,8 @@ type ClientInterface interface {
 	DeleteTerminologyCapabilitiesByID(ctx context.Context, id string, params Parameters) (*models.TerminologyCapabilities, error)
 	GetTestReport(ctx context.Context, params Parameters) ([]*models.TestReport, error)
 	GetTestReportByID(ctx context.Context, id string, params Parameters) (*models.TestReport, error)
+	SearchTestReport(ctx context.Context, params Parameters) (*TestReportSearchResult, error)
+	SearchTestReportPage(ctx context.Context, url string) (*TestReportSearchResult, error)
 	CreateTestReport(ctx context.Context, params Parameters, entity *models.TestReport) (*models.TestReport, error)
 	UpdateTestReport(ctx context.Context, params Parameters, entity *models.TestReport) (*models.TestReport, error)
 	UpdateTestReportByID(ctx context.Context, id string, params Parameters, entity *models.TestReport) (*models.TestReport, error)
@@ -1310,6 +1819,8 @@ type ClientInterface interface {
 	DeleteTestReportByID(ctx context.Context, id string, params Parameters) (*models.TestReport, error)
 	GetTestScript(ctx context.Context, params Parameters) ([]*models.TestScript, error)
 	GetTestScriptByID(ctx context.Context, id string, params Parameters) (*models.TestScript, error)
+	SearchTestScript(ctx context.Context, params Parameters) (*TestScriptSearchResult, error)
+	SearchTestScriptPage(ctx context.Context, url string) (*TestScriptSearchResult, error)
 	CreateTestScript(ctx context.Context, params Parameters, entity *models.TestScript) (*models.TestScript, error)
 	UpdateTestScript(ctx context.Context, params Parameters, entity *models.TestScript) (*models.TestScript, error)
 	UpdateTestScriptByID(ctx context.Context, id string, params Parameters, entity *models.TestScript) (*models.TestScript, error)
@@ -1319,6 +1830,8 @@ type ClientInterface interface {
 	DeleteTestScriptByID(ctx context.Context, id string, params Parameters) (*models.TestScript, error)
 	GetValueSet(ctx context.Context, params Parameters) ([]*models.ValueSet, error)
 	GetValueSetByID(ctx context.Context, id string, params Parameters) (*models.ValueSet, error)
+	SearchValueSet(ctx context.Context, params Parameters) (*ValueSetSearchResult, error)
+	SearchValueSetPage(ctx context.Context, url string) (*ValueSetSearchResult, error)
 	CreateValueSet(ctx context.Context, params Parameters, entity *models.ValueSet) (*models.ValueSet, error)
 	UpdateValueSet(ctx context.Context, params Parameters, entity *models.ValueSet) (*models.ValueSet, error)
 	UpdateValueSetByID(ctx context.Context, id string, params Parameters, entity *models.ValueSet) (*models.ValueSet, error)
@@ -1328,6 +1841,8 @@ type ClientInterface interface {
 	DeleteValueSetByID(ctx context.Context, id string, params Parameters) (*models.ValueSet, error)
 	GetVerificationResult(ctx context.Context, params Parameters) ([]*models.VerificationResult, error)
 	GetVerificationResultByID(ctx context.Context, id string, params Parameters) (*models.VerificationResult, error)
+	SearchVerificationResult(ctx context.Context, params Parameters) (*VerificationResultSearchResult, error)
+	SearchVerificationResultPage(ctx context.Context, url string) (*VerificationResultSearchResult, error)
 	CreateVerificationResult(ctx context.Context, params Parameters, entity *models.VerificationResult) (*models.VerificationResult, error)
 	UpdateVerificationResult(ctx context.Context, params Parameters, entity *models.VerificationResult) (*models.VerificationResult, error)
 	UpdateVerificationResultByID(ctx context.Context, id string, params Parameters, entity *models.VerificationResult) (*models.VerificationResult, error)
@@ -1337,6 +1852,8 @@ type ClientInterface interface {
 	DeleteVerificationResultByID(ctx context.Context, id string, params Parameters) (*models.VerificationResult, error)
 	GetVisionPrescription(ctx context.Context, params Parameters) ([]*models.VisionPrescription, error)
 	GetVisionPrescriptionByID(ctx context.Context, id string, params Parameters) (*models.VisionPrescription, error)
+	SearchVisionPrescription(ctx context.Context, params Parameters) (*VisionPrescriptionSearchResult, error)
+	SearchVisionPrescriptionPage(ctx context.Context, url string) (*VisionPrescriptionSearchResult, error)
 	CreateVisionPrescription(ctx context.Context, params Parameters, entity *models.VisionPrescription) (*models.VisionPrescription, error)
 	UpdateVisionPrescription(ctx context.Context, params Parameters, entity *models.VisionPrescription) (*models.VisionPrescription, error)
 	UpdateVisionPrescriptionByID(ctx context.Context, id string, params Parameters, entity *models.VisionPrescription) (*models.VisionPrescription, error)
@@ -1352,11 +1869,11 @@ var _ ClientInterface = (*Client)(nil)
 // Account
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToAccounts(bundle *models.Bundle) ([]*models.Account, error) {
+func bundleToAccounts(bundle *models.Bundle, decoder models.Decoder) ([]*models.Account, error) {
 	var entities []*models.Account
 	err := EnumBundleResources(bundle, "Account", func(resource ResourceData) error {
 		var entity models.Account
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Account", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -1372,10 +1889,10 @@ func bundleToAccounts(bundle *models.Bundle) ([]*models.Account, error) {
 func fhirRespToAccounts(resp *FhirResponse) ([]*models.Account, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToAccounts(resp.Bundle)
+		return bundleToAccounts(resp.Bundle, resp.decoder)
 	case "Account":
 		var entity models.Account
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Account{&entity}, nil
@@ -1414,6 +1931,36 @@ func (c *Client) GetAccountByID(ctx context.Context, id string, params Parameter
 	return fhirRespToAccount(id, resp)
 }
 
+// AccountSearchResult is the page of the Account search with its metadata.
+type AccountSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Account
+}
+
+func newAccountSearchResult(result *SearchResult, err error) (*AccountSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &AccountSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Account); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Account with the total, links, full URLs and scores.
+func (c *Client) SearchAccount(ctx context.Context, params Parameters) (*AccountSearchResult, error) {
+	return newAccountSearchResult(c.Search(ctx, "Account", params))
+}
+
+// Search Account page by its link URL.
+func (c *Client) SearchAccountPage(ctx context.Context, url string) (*AccountSearchResult, error) {
+	return newAccountSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateAccount(ctx context.Context, params Parameters, entity *models.Account) (*models.Account, error) {
 	resp, err := c.Create(ctx, "Account", params, entity)
 	if err != nil {
@@ -1481,11 +2028,11 @@ func (c *Client) DeleteAccountByID(ctx context.Context, id string, params Parame
 // ActivityDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToActivityDefinitions(bundle *models.Bundle) ([]*models.ActivityDefinition, error) {
+func bundleToActivityDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.ActivityDefinition, error) {
 	var entities []*models.ActivityDefinition
 	err := EnumBundleResources(bundle, "ActivityDefinition", func(resource ResourceData) error {
 		var entity models.ActivityDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ActivityDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -1501,10 +2048,10 @@ func bundleToActivityDefinitions(bundle *models.Bundle) ([]*models.ActivityDefin
 func fhirRespToActivityDefinitions(resp *FhirResponse) ([]*models.ActivityDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToActivityDefinitions(resp.Bundle)
+		return bundleToActivityDefinitions(resp.Bundle, resp.decoder)
 	case "ActivityDefinition":
 		var entity models.ActivityDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ActivityDefinition{&entity}, nil
@@ -1543,6 +2090,36 @@ func (c *Client) GetActivityDefinitionByID(ctx context.Context, id string, param
 	return fhirRespToActivityDefinition(id, resp)
 }
 
+// ActivityDefinitionSearchResult is the page of the ActivityDefinition search with its metadata.
+type ActivityDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ActivityDefinition
+}
+
+func newActivityDefinitionSearchResult(result *SearchResult, err error) (*ActivityDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ActivityDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ActivityDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ActivityDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchActivityDefinition(ctx context.Context, params Parameters) (*ActivityDefinitionSearchResult, error) {
+	return newActivityDefinitionSearchResult(c.Search(ctx, "ActivityDefinition", params))
+}
+
+// Search ActivityDefinition page by its link URL.
+func (c *Client) SearchActivityDefinitionPage(ctx context.Context, url string) (*ActivityDefinitionSearchResult, error) {
+	return newActivityDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateActivityDefinition(ctx context.Context, params Parameters, entity *models.ActivityDefinition) (*models.ActivityDefinition, error) {
 	resp, err := c.Create(ctx, "ActivityDefinition", params, entity)
 	if err != nil {
@@ -1610,11 +2187,11 @@ func (c *Client) DeleteActivityDefinitionByID(ctx context.Context, id string, pa
 // AdverseEvent
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToAdverseEvents(bundle *models.Bundle) ([]*models.AdverseEvent, error) {
+func bundleToAdverseEvents(bundle *models.Bundle, decoder models.Decoder) ([]*models.AdverseEvent, error) {
 	var entities []*models.AdverseEvent
 	err := EnumBundleResources(bundle, "AdverseEvent", func(resource ResourceData) error {
 		var entity models.AdverseEvent
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "AdverseEvent", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -1630,10 +2207,10 @@ func bundleToAdverseEvents(bundle *models.Bundle) ([]*models.AdverseEvent, error
 func fhirRespToAdverseEvents(resp *FhirResponse) ([]*models.AdverseEvent, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToAdverseEvents(resp.Bundle)
+		return bundleToAdverseEvents(resp.Bundle, resp.decoder)
 	case "AdverseEvent":
 		var entity models.AdverseEvent
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.AdverseEvent{&entity}, nil
@@ -1672,6 +2249,36 @@ func (c *Client) GetAdverseEventByID(ctx context.Context, id string, params Para
 	return fhirRespToAdverseEvent(id, resp)
 }
 
+// AdverseEventSearchResult is the page of the AdverseEvent search with its metadata.
+type AdverseEventSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.AdverseEvent
+}
+
+func newAdverseEventSearchResult(result *SearchResult, err error) (*AdverseEventSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &AdverseEventSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.AdverseEvent); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search AdverseEvent with the total, links, full URLs and scores.
+func (c *Client) SearchAdverseEvent(ctx context.Context, params Parameters) (*AdverseEventSearchResult, error) {
+	return newAdverseEventSearchResult(c.Search(ctx, "AdverseEvent", params))
+}
+
+// Search AdverseEvent page by its link URL.
+func (c *Client) SearchAdverseEventPage(ctx context.Context, url string) (*AdverseEventSearchResult, error) {
+	return newAdverseEventSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateAdverseEvent(ctx context.Context, params Parameters, entity *models.AdverseEvent) (*models.AdverseEvent, error) {
 	resp, err := c.Create(ctx, "AdverseEvent", params, entity)
 	if err != nil {
@@ -1739,11 +2346,11 @@ func (c *Client) DeleteAdverseEventByID(ctx context.Context, id string, params P
 // AllergyIntolerance
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToAllergyIntolerances(bundle *models.Bundle) ([]*models.AllergyIntolerance, error) {
+func bundleToAllergyIntolerances(bundle *models.Bundle, decoder models.Decoder) ([]*models.AllergyIntolerance, error) {
 	var entities []*models.AllergyIntolerance
 	err := EnumBundleResources(bundle, "AllergyIntolerance", func(resource ResourceData) error {
 		var entity models.AllergyIntolerance
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "AllergyIntolerance", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -1759,10 +2366,10 @@ func bundleToAllergyIntolerances(bundle *models.Bundle) ([]*models.AllergyIntole
 func fhirRespToAllergyIntolerances(resp *FhirResponse) ([]*models.AllergyIntolerance, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToAllergyIntolerances(resp.Bundle)
+		return bundleToAllergyIntolerances(resp.Bundle, resp.decoder)
 	case "AllergyIntolerance":
 		var entity models.AllergyIntolerance
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.AllergyIntolerance{&entity}, nil
@@ -1801,6 +2408,36 @@ func (c *Client) GetAllergyIntoleranceByID(ctx context.Context, id string, param
 	return fhirRespToAllergyIntolerance(id, resp)
 }
 
+// AllergyIntoleranceSearchResult is the page of the AllergyIntolerance search with its metadata.
+type AllergyIntoleranceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.AllergyIntolerance
+}
+
+func newAllergyIntoleranceSearchResult(result *SearchResult, err error) (*AllergyIntoleranceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &AllergyIntoleranceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.AllergyIntolerance); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search AllergyIntolerance with the total, links, full URLs and scores.
+func (c *Client) SearchAllergyIntolerance(ctx context.Context, params Parameters) (*AllergyIntoleranceSearchResult, error) {
+	return newAllergyIntoleranceSearchResult(c.Search(ctx, "AllergyIntolerance", params))
+}
+
+// Search AllergyIntolerance page by its link URL.
+func (c *Client) SearchAllergyIntolerancePage(ctx context.Context, url string) (*AllergyIntoleranceSearchResult, error) {
+	return newAllergyIntoleranceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateAllergyIntolerance(ctx context.Context, params Parameters, entity *models.AllergyIntolerance) (*models.AllergyIntolerance, error) {
 	resp, err := c.Create(ctx, "AllergyIntolerance", params, entity)
 	if err != nil {
@@ -1868,11 +2505,11 @@ func (c *Client) DeleteAllergyIntoleranceByID(ctx context.Context, id string, pa
 // Appointment
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToAppointments(bundle *models.Bundle) ([]*models.Appointment, error) {
+func bundleToAppointments(bundle *models.Bundle, decoder models.Decoder) ([]*models.Appointment, error) {
 	var entities []*models.Appointment
 	err := EnumBundleResources(bundle, "Appointment", func(resource ResourceData) error {
 		var entity models.Appointment
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Appointment", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -1888,10 +2525,10 @@ func bundleToAppointments(bundle *models.Bundle) ([]*models.Appointment, error)
 func fhirRespToAppointments(resp *FhirResponse) ([]*models.Appointment, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToAppointments(resp.Bundle)
+		return bundleToAppointments(resp.Bundle, resp.decoder)
 	case "Appointment":
 		var entity models.Appointment
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Appointment{&entity}, nil
@@ -1930,6 +2567,36 @@ func (c *Client) GetAppointmentByID(ctx context.Context, id string, params Param
 	return fhirRespToAppointment(id, resp)
 }
 
+// AppointmentSearchResult is the page of the Appointment search with its metadata.
+type AppointmentSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Appointment
+}
+
+func newAppointmentSearchResult(result *SearchResult, err error) (*AppointmentSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &AppointmentSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Appointment); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Appointment with the total, links, full URLs and scores.
+func (c *Client) SearchAppointment(ctx context.Context, params Parameters) (*AppointmentSearchResult, error) {
+	return newAppointmentSearchResult(c.Search(ctx, "Appointment", params))
+}
+
+// Search Appointment page by its link URL.
+func (c *Client) SearchAppointmentPage(ctx context.Context, url string) (*AppointmentSearchResult, error) {
+	return newAppointmentSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateAppointment(ctx context.Context, params Parameters, entity *models.Appointment) (*models.Appointment, error) {
 	resp, err := c.Create(ctx, "Appointment", params, entity)
 	if err != nil {
@@ -1997,11 +2664,11 @@ func (c *Client) DeleteAppointmentByID(ctx context.Context, id string, params Pa
 // AppointmentResponse
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToAppointmentResponses(bundle *models.Bundle) ([]*models.AppointmentResponse, error) {
+func bundleToAppointmentResponses(bundle *models.Bundle, decoder models.Decoder) ([]*models.AppointmentResponse, error) {
 	var entities []*models.AppointmentResponse
 	err := EnumBundleResources(bundle, "AppointmentResponse", func(resource ResourceData) error {
 		var entity models.AppointmentResponse
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "AppointmentResponse", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2017,10 +2684,10 @@ func bundleToAppointmentResponses(bundle *models.Bundle) ([]*models.AppointmentR
 func fhirRespToAppointmentResponses(resp *FhirResponse) ([]*models.AppointmentResponse, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToAppointmentResponses(resp.Bundle)
+		return bundleToAppointmentResponses(resp.Bundle, resp.decoder)
 	case "AppointmentResponse":
 		var entity models.AppointmentResponse
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.AppointmentResponse{&entity}, nil
@@ -2059,6 +2726,36 @@ func (c *Client) GetAppointmentResponseByID(ctx context.Context, id string, para
 	return fhirRespToAppointmentResponse(id, resp)
 }
 
+// AppointmentResponseSearchResult is the page of the AppointmentResponse search with its metadata.
+type AppointmentResponseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.AppointmentResponse
+}
+
+func newAppointmentResponseSearchResult(result *SearchResult, err error) (*AppointmentResponseSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &AppointmentResponseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.AppointmentResponse); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search AppointmentResponse with the total, links, full URLs and scores.
+func (c *Client) SearchAppointmentResponse(ctx context.Context, params Parameters) (*AppointmentResponseSearchResult, error) {
+	return newAppointmentResponseSearchResult(c.Search(ctx, "AppointmentResponse", params))
+}
+
+// Search AppointmentResponse page by its link URL.
+func (c *Client) SearchAppointmentResponsePage(ctx context.Context, url string) (*AppointmentResponseSearchResult, error) {
+	return newAppointmentResponseSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateAppointmentResponse(ctx context.Context, params Parameters, entity *models.AppointmentResponse) (*models.AppointmentResponse, error) {
 	resp, err := c.Create(ctx, "AppointmentResponse", params, entity)
 	if err != nil {
@@ -2126,11 +2823,11 @@ func (c *Client) DeleteAppointmentResponseByID(ctx context.Context, id string, p
 // AuditEvent
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToAuditEvents(bundle *models.Bundle) ([]*models.AuditEvent, error) {
+func bundleToAuditEvents(bundle *models.Bundle, decoder models.Decoder) ([]*models.AuditEvent, error) {
 	var entities []*models.AuditEvent
 	err := EnumBundleResources(bundle, "AuditEvent", func(resource ResourceData) error {
 		var entity models.AuditEvent
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "AuditEvent", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2146,10 +2843,10 @@ func bundleToAuditEvents(bundle *models.Bundle) ([]*models.AuditEvent, error) {
 func fhirRespToAuditEvents(resp *FhirResponse) ([]*models.AuditEvent, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToAuditEvents(resp.Bundle)
+		return bundleToAuditEvents(resp.Bundle, resp.decoder)
 	case "AuditEvent":
 		var entity models.AuditEvent
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.AuditEvent{&entity}, nil
@@ -2188,6 +2885,36 @@ func (c *Client) GetAuditEventByID(ctx context.Context, id string, params Parame
 	return fhirRespToAuditEvent(id, resp)
 }
 
+// AuditEventSearchResult is the page of the AuditEvent search with its metadata.
+type AuditEventSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.AuditEvent
+}
+
+func newAuditEventSearchResult(result *SearchResult, err error) (*AuditEventSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &AuditEventSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.AuditEvent); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search AuditEvent with the total, links, full URLs and scores.
+func (c *Client) SearchAuditEvent(ctx context.Context, params Parameters) (*AuditEventSearchResult, error) {
+	return newAuditEventSearchResult(c.Search(ctx, "AuditEvent", params))
+}
+
+// Search AuditEvent page by its link URL.
+func (c *Client) SearchAuditEventPage(ctx context.Context, url string) (*AuditEventSearchResult, error) {
+	return newAuditEventSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateAuditEvent(ctx context.Context, params Parameters, entity *models.AuditEvent) (*models.AuditEvent, error) {
 	resp, err := c.Create(ctx, "AuditEvent", params, entity)
 	if err != nil {
@@ -2255,11 +2982,11 @@ func (c *Client) DeleteAuditEventByID(ctx context.Context, id string, params Par
 // Basic
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToBasics(bundle *models.Bundle) ([]*models.Basic, error) {
+func bundleToBasics(bundle *models.Bundle, decoder models.Decoder) ([]*models.Basic, error) {
 	var entities []*models.Basic
 	err := EnumBundleResources(bundle, "Basic", func(resource ResourceData) error {
 		var entity models.Basic
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Basic", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2275,10 +3002,10 @@ func bundleToBasics(bundle *models.Bundle) ([]*models.Basic, error) {
 func fhirRespToBasics(resp *FhirResponse) ([]*models.Basic, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToBasics(resp.Bundle)
+		return bundleToBasics(resp.Bundle, resp.decoder)
 	case "Basic":
 		var entity models.Basic
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Basic{&entity}, nil
@@ -2317,6 +3044,36 @@ func (c *Client) GetBasicByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToBasic(id, resp)
 }
 
+// BasicSearchResult is the page of the Basic search with its metadata.
+type BasicSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Basic
+}
+
+func newBasicSearchResult(result *SearchResult, err error) (*BasicSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &BasicSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Basic); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Basic with the total, links, full URLs and scores.
+func (c *Client) SearchBasic(ctx context.Context, params Parameters) (*BasicSearchResult, error) {
+	return newBasicSearchResult(c.Search(ctx, "Basic", params))
+}
+
+// Search Basic page by its link URL.
+func (c *Client) SearchBasicPage(ctx context.Context, url string) (*BasicSearchResult, error) {
+	return newBasicSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateBasic(ctx context.Context, params Parameters, entity *models.Basic) (*models.Basic, error) {
 	resp, err := c.Create(ctx, "Basic", params, entity)
 	if err != nil {
@@ -2384,11 +3141,11 @@ func (c *Client) DeleteBasicByID(ctx context.Context, id string, params Paramete
 // Binary
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToBinarys(bundle *models.Bundle) ([]*models.Binary, error) {
+func bundleToBinarys(bundle *models.Bundle, decoder models.Decoder) ([]*models.Binary, error) {
 	var entities []*models.Binary
 	err := EnumBundleResources(bundle, "Binary", func(resource ResourceData) error {
 		var entity models.Binary
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Binary", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2404,10 +3161,10 @@ func bundleToBinarys(bundle *models.Bundle) ([]*models.Binary, error) {
 func fhirRespToBinarys(resp *FhirResponse) ([]*models.Binary, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToBinarys(resp.Bundle)
+		return bundleToBinarys(resp.Bundle, resp.decoder)
 	case "Binary":
 		var entity models.Binary
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Binary{&entity}, nil
@@ -2446,6 +3203,36 @@ func (c *Client) GetBinaryByID(ctx context.Context, id string, params Parameters
 	return fhirRespToBinary(id, resp)
 }
 
+// BinarySearchResult is the page of the Binary search with its metadata.
+type BinarySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Binary
+}
+
+func newBinarySearchResult(result *SearchResult, err error) (*BinarySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &BinarySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Binary); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Binary with the total, links, full URLs and scores.
+func (c *Client) SearchBinary(ctx context.Context, params Parameters) (*BinarySearchResult, error) {
+	return newBinarySearchResult(c.Search(ctx, "Binary", params))
+}
+
+// Search Binary page by its link URL.
+func (c *Client) SearchBinaryPage(ctx context.Context, url string) (*BinarySearchResult, error) {
+	return newBinarySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateBinary(ctx context.Context, params Parameters, entity *models.Binary) (*models.Binary, error) {
 	resp, err := c.Create(ctx, "Binary", params, entity)
 	if err != nil {
@@ -2513,11 +3300,11 @@ func (c *Client) DeleteBinaryByID(ctx context.Context, id string, params Paramet
 // BiologicallyDerivedProduct
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToBiologicallyDerivedProducts(bundle *models.Bundle) ([]*models.BiologicallyDerivedProduct, error) {
+func bundleToBiologicallyDerivedProducts(bundle *models.Bundle, decoder models.Decoder) ([]*models.BiologicallyDerivedProduct, error) {
 	var entities []*models.BiologicallyDerivedProduct
 	err := EnumBundleResources(bundle, "BiologicallyDerivedProduct", func(resource ResourceData) error {
 		var entity models.BiologicallyDerivedProduct
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "BiologicallyDerivedProduct", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2533,10 +3320,10 @@ func bundleToBiologicallyDerivedProducts(bundle *models.Bundle) ([]*models.Biolo
 func fhirRespToBiologicallyDerivedProducts(resp *FhirResponse) ([]*models.BiologicallyDerivedProduct, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToBiologicallyDerivedProducts(resp.Bundle)
+		return bundleToBiologicallyDerivedProducts(resp.Bundle, resp.decoder)
 	case "BiologicallyDerivedProduct":
 		var entity models.BiologicallyDerivedProduct
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.BiologicallyDerivedProduct{&entity}, nil
@@ -2575,6 +3362,36 @@ func (c *Client) GetBiologicallyDerivedProductByID(ctx context.Context, id strin
 	return fhirRespToBiologicallyDerivedProduct(id, resp)
 }
 
+// BiologicallyDerivedProductSearchResult is the page of the BiologicallyDerivedProduct search with its metadata.
+type BiologicallyDerivedProductSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.BiologicallyDerivedProduct
+}
+
+func newBiologicallyDerivedProductSearchResult(result *SearchResult, err error) (*BiologicallyDerivedProductSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &BiologicallyDerivedProductSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.BiologicallyDerivedProduct); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search BiologicallyDerivedProduct with the total, links, full URLs and scores.
+func (c *Client) SearchBiologicallyDerivedProduct(ctx context.Context, params Parameters) (*BiologicallyDerivedProductSearchResult, error) {
+	return newBiologicallyDerivedProductSearchResult(c.Search(ctx, "BiologicallyDerivedProduct", params))
+}
+
+// Search BiologicallyDerivedProduct page by its link URL.
+func (c *Client) SearchBiologicallyDerivedProductPage(ctx context.Context, url string) (*BiologicallyDerivedProductSearchResult, error) {
+	return newBiologicallyDerivedProductSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateBiologicallyDerivedProduct(ctx context.Context, params Parameters, entity *models.BiologicallyDerivedProduct) (*models.BiologicallyDerivedProduct, error) {
 	resp, err := c.Create(ctx, "BiologicallyDerivedProduct", params, entity)
 	if err != nil {
@@ -2642,11 +3459,11 @@ func (c *Client) DeleteBiologicallyDerivedProductByID(ctx context.Context, id st
 // BodyStructure
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToBodyStructures(bundle *models.Bundle) ([]*models.BodyStructure, error) {
+func bundleToBodyStructures(bundle *models.Bundle, decoder models.Decoder) ([]*models.BodyStructure, error) {
 	var entities []*models.BodyStructure
 	err := EnumBundleResources(bundle, "BodyStructure", func(resource ResourceData) error {
 		var entity models.BodyStructure
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "BodyStructure", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2662,10 +3479,10 @@ func bundleToBodyStructures(bundle *models.Bundle) ([]*models.BodyStructure, err
 func fhirRespToBodyStructures(resp *FhirResponse) ([]*models.BodyStructure, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToBodyStructures(resp.Bundle)
+		return bundleToBodyStructures(resp.Bundle, resp.decoder)
 	case "BodyStructure":
 		var entity models.BodyStructure
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.BodyStructure{&entity}, nil
@@ -2704,6 +3521,36 @@ func (c *Client) GetBodyStructureByID(ctx context.Context, id string, params Par
 	return fhirRespToBodyStructure(id, resp)
 }
 
+// BodyStructureSearchResult is the page of the BodyStructure search with its metadata.
+type BodyStructureSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.BodyStructure
+}
+
+func newBodyStructureSearchResult(result *SearchResult, err error) (*BodyStructureSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &BodyStructureSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.BodyStructure); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search BodyStructure with the total, links, full URLs and scores.
+func (c *Client) SearchBodyStructure(ctx context.Context, params Parameters) (*BodyStructureSearchResult, error) {
+	return newBodyStructureSearchResult(c.Search(ctx, "BodyStructure", params))
+}
+
+// Search BodyStructure page by its link URL.
+func (c *Client) SearchBodyStructurePage(ctx context.Context, url string) (*BodyStructureSearchResult, error) {
+	return newBodyStructureSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateBodyStructure(ctx context.Context, params Parameters, entity *models.BodyStructure) (*models.BodyStructure, error) {
 	resp, err := c.Create(ctx, "BodyStructure", params, entity)
 	if err != nil {
@@ -2771,11 +3618,11 @@ func (c *Client) DeleteBodyStructureByID(ctx context.Context, id string, params
 // CapabilityStatement
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCapabilityStatements(bundle *models.Bundle) ([]*models.CapabilityStatement, error) {
+func bundleToCapabilityStatements(bundle *models.Bundle, decoder models.Decoder) ([]*models.CapabilityStatement, error) {
 	var entities []*models.CapabilityStatement
 	err := EnumBundleResources(bundle, "CapabilityStatement", func(resource ResourceData) error {
 		var entity models.CapabilityStatement
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CapabilityStatement", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2791,10 +3638,10 @@ func bundleToCapabilityStatements(bundle *models.Bundle) ([]*models.CapabilitySt
 func fhirRespToCapabilityStatements(resp *FhirResponse) ([]*models.CapabilityStatement, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCapabilityStatements(resp.Bundle)
+		return bundleToCapabilityStatements(resp.Bundle, resp.decoder)
 	case "CapabilityStatement":
 		var entity models.CapabilityStatement
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CapabilityStatement{&entity}, nil
@@ -2833,6 +3680,36 @@ func (c *Client) GetCapabilityStatementByID(ctx context.Context, id string, para
 	return fhirRespToCapabilityStatement(id, resp)
 }
 
+// CapabilityStatementSearchResult is the page of the CapabilityStatement search with its metadata.
+type CapabilityStatementSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CapabilityStatement
+}
+
+func newCapabilityStatementSearchResult(result *SearchResult, err error) (*CapabilityStatementSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CapabilityStatementSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CapabilityStatement); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CapabilityStatement with the total, links, full URLs and scores.
+func (c *Client) SearchCapabilityStatement(ctx context.Context, params Parameters) (*CapabilityStatementSearchResult, error) {
+	return newCapabilityStatementSearchResult(c.Search(ctx, "CapabilityStatement", params))
+}
+
+// Search CapabilityStatement page by its link URL.
+func (c *Client) SearchCapabilityStatementPage(ctx context.Context, url string) (*CapabilityStatementSearchResult, error) {
+	return newCapabilityStatementSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCapabilityStatement(ctx context.Context, params Parameters, entity *models.CapabilityStatement) (*models.CapabilityStatement, error) {
 	resp, err := c.Create(ctx, "CapabilityStatement", params, entity)
 	if err != nil {
@@ -2900,11 +3777,11 @@ func (c *Client) DeleteCapabilityStatementByID(ctx context.Context, id string, p
 // CarePlan
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCarePlans(bundle *models.Bundle) ([]*models.CarePlan, error) {
+func bundleToCarePlans(bundle *models.Bundle, decoder models.Decoder) ([]*models.CarePlan, error) {
 	var entities []*models.CarePlan
 	err := EnumBundleResources(bundle, "CarePlan", func(resource ResourceData) error {
 		var entity models.CarePlan
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CarePlan", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -2920,10 +3797,10 @@ func bundleToCarePlans(bundle *models.Bundle) ([]*models.CarePlan, error) {
 func fhirRespToCarePlans(resp *FhirResponse) ([]*models.CarePlan, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCarePlans(resp.Bundle)
+		return bundleToCarePlans(resp.Bundle, resp.decoder)
 	case "CarePlan":
 		var entity models.CarePlan
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CarePlan{&entity}, nil
@@ -2962,6 +3839,36 @@ func (c *Client) GetCarePlanByID(ctx context.Context, id string, params Paramete
 	return fhirRespToCarePlan(id, resp)
 }
 
+// CarePlanSearchResult is the page of the CarePlan search with its metadata.
+type CarePlanSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CarePlan
+}
+
+func newCarePlanSearchResult(result *SearchResult, err error) (*CarePlanSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CarePlanSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CarePlan); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CarePlan with the total, links, full URLs and scores.
+func (c *Client) SearchCarePlan(ctx context.Context, params Parameters) (*CarePlanSearchResult, error) {
+	return newCarePlanSearchResult(c.Search(ctx, "CarePlan", params))
+}
+
+// Search CarePlan page by its link URL.
+func (c *Client) SearchCarePlanPage(ctx context.Context, url string) (*CarePlanSearchResult, error) {
+	return newCarePlanSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCarePlan(ctx context.Context, params Parameters, entity *models.CarePlan) (*models.CarePlan, error) {
 	resp, err := c.Create(ctx, "CarePlan", params, entity)
 	if err != nil {
@@ -3029,11 +3936,11 @@ func (c *Client) DeleteCarePlanByID(ctx context.Context, id string, params Param
 // CareTeam
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCareTeams(bundle *models.Bundle) ([]*models.CareTeam, error) {
+func bundleToCareTeams(bundle *models.Bundle, decoder models.Decoder) ([]*models.CareTeam, error) {
 	var entities []*models.CareTeam
 	err := EnumBundleResources(bundle, "CareTeam", func(resource ResourceData) error {
 		var entity models.CareTeam
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CareTeam", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3049,10 +3956,10 @@ func bundleToCareTeams(bundle *models.Bundle) ([]*models.CareTeam, error) {
 func fhirRespToCareTeams(resp *FhirResponse) ([]*models.CareTeam, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCareTeams(resp.Bundle)
+		return bundleToCareTeams(resp.Bundle, resp.decoder)
 	case "CareTeam":
 		var entity models.CareTeam
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CareTeam{&entity}, nil
@@ -3091,6 +3998,36 @@ func (c *Client) GetCareTeamByID(ctx context.Context, id string, params Paramete
 	return fhirRespToCareTeam(id, resp)
 }
 
+// CareTeamSearchResult is the page of the CareTeam search with its metadata.
+type CareTeamSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CareTeam
+}
+
+func newCareTeamSearchResult(result *SearchResult, err error) (*CareTeamSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CareTeamSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CareTeam); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CareTeam with the total, links, full URLs and scores.
+func (c *Client) SearchCareTeam(ctx context.Context, params Parameters) (*CareTeamSearchResult, error) {
+	return newCareTeamSearchResult(c.Search(ctx, "CareTeam", params))
+}
+
+// Search CareTeam page by its link URL.
+func (c *Client) SearchCareTeamPage(ctx context.Context, url string) (*CareTeamSearchResult, error) {
+	return newCareTeamSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCareTeam(ctx context.Context, params Parameters, entity *models.CareTeam) (*models.CareTeam, error) {
 	resp, err := c.Create(ctx, "CareTeam", params, entity)
 	if err != nil {
@@ -3158,11 +4095,11 @@ func (c *Client) DeleteCareTeamByID(ctx context.Context, id string, params Param
 // CatalogEntry
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCatalogEntrys(bundle *models.Bundle) ([]*models.CatalogEntry, error) {
+func bundleToCatalogEntrys(bundle *models.Bundle, decoder models.Decoder) ([]*models.CatalogEntry, error) {
 	var entities []*models.CatalogEntry
 	err := EnumBundleResources(bundle, "CatalogEntry", func(resource ResourceData) error {
 		var entity models.CatalogEntry
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CatalogEntry", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3178,10 +4115,10 @@ func bundleToCatalogEntrys(bundle *models.Bundle) ([]*models.CatalogEntry, error
 func fhirRespToCatalogEntrys(resp *FhirResponse) ([]*models.CatalogEntry, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCatalogEntrys(resp.Bundle)
+		return bundleToCatalogEntrys(resp.Bundle, resp.decoder)
 	case "CatalogEntry":
 		var entity models.CatalogEntry
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CatalogEntry{&entity}, nil
@@ -3220,6 +4157,36 @@ func (c *Client) GetCatalogEntryByID(ctx context.Context, id string, params Para
 	return fhirRespToCatalogEntry(id, resp)
 }
 
+// CatalogEntrySearchResult is the page of the CatalogEntry search with its metadata.
+type CatalogEntrySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CatalogEntry
+}
+
+func newCatalogEntrySearchResult(result *SearchResult, err error) (*CatalogEntrySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CatalogEntrySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CatalogEntry); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CatalogEntry with the total, links, full URLs and scores.
+func (c *Client) SearchCatalogEntry(ctx context.Context, params Parameters) (*CatalogEntrySearchResult, error) {
+	return newCatalogEntrySearchResult(c.Search(ctx, "CatalogEntry", params))
+}
+
+// Search CatalogEntry page by its link URL.
+func (c *Client) SearchCatalogEntryPage(ctx context.Context, url string) (*CatalogEntrySearchResult, error) {
+	return newCatalogEntrySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCatalogEntry(ctx context.Context, params Parameters, entity *models.CatalogEntry) (*models.CatalogEntry, error) {
 	resp, err := c.Create(ctx, "CatalogEntry", params, entity)
 	if err != nil {
@@ -3287,11 +4254,11 @@ func (c *Client) DeleteCatalogEntryByID(ctx context.Context, id string, params P
 // ChargeItem
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToChargeItems(bundle *models.Bundle) ([]*models.ChargeItem, error) {
+func bundleToChargeItems(bundle *models.Bundle, decoder models.Decoder) ([]*models.ChargeItem, error) {
 	var entities []*models.ChargeItem
 	err := EnumBundleResources(bundle, "ChargeItem", func(resource ResourceData) error {
 		var entity models.ChargeItem
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ChargeItem", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3307,10 +4274,10 @@ func bundleToChargeItems(bundle *models.Bundle) ([]*models.ChargeItem, error) {
 func fhirRespToChargeItems(resp *FhirResponse) ([]*models.ChargeItem, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToChargeItems(resp.Bundle)
+		return bundleToChargeItems(resp.Bundle, resp.decoder)
 	case "ChargeItem":
 		var entity models.ChargeItem
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ChargeItem{&entity}, nil
@@ -3349,6 +4316,36 @@ func (c *Client) GetChargeItemByID(ctx context.Context, id string, params Parame
 	return fhirRespToChargeItem(id, resp)
 }
 
+// ChargeItemSearchResult is the page of the ChargeItem search with its metadata.
+type ChargeItemSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ChargeItem
+}
+
+func newChargeItemSearchResult(result *SearchResult, err error) (*ChargeItemSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ChargeItemSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ChargeItem); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ChargeItem with the total, links, full URLs and scores.
+func (c *Client) SearchChargeItem(ctx context.Context, params Parameters) (*ChargeItemSearchResult, error) {
+	return newChargeItemSearchResult(c.Search(ctx, "ChargeItem", params))
+}
+
+// Search ChargeItem page by its link URL.
+func (c *Client) SearchChargeItemPage(ctx context.Context, url string) (*ChargeItemSearchResult, error) {
+	return newChargeItemSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateChargeItem(ctx context.Context, params Parameters, entity *models.ChargeItem) (*models.ChargeItem, error) {
 	resp, err := c.Create(ctx, "ChargeItem", params, entity)
 	if err != nil {
@@ -3416,11 +4413,11 @@ func (c *Client) DeleteChargeItemByID(ctx context.Context, id string, params Par
 // ChargeItemDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToChargeItemDefinitions(bundle *models.Bundle) ([]*models.ChargeItemDefinition, error) {
+func bundleToChargeItemDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.ChargeItemDefinition, error) {
 	var entities []*models.ChargeItemDefinition
 	err := EnumBundleResources(bundle, "ChargeItemDefinition", func(resource ResourceData) error {
 		var entity models.ChargeItemDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ChargeItemDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3436,10 +4433,10 @@ func bundleToChargeItemDefinitions(bundle *models.Bundle) ([]*models.ChargeItemD
 func fhirRespToChargeItemDefinitions(resp *FhirResponse) ([]*models.ChargeItemDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToChargeItemDefinitions(resp.Bundle)
+		return bundleToChargeItemDefinitions(resp.Bundle, resp.decoder)
 	case "ChargeItemDefinition":
 		var entity models.ChargeItemDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ChargeItemDefinition{&entity}, nil
@@ -3478,6 +4475,36 @@ func (c *Client) GetChargeItemDefinitionByID(ctx context.Context, id string, par
 	return fhirRespToChargeItemDefinition(id, resp)
 }
 
+// ChargeItemDefinitionSearchResult is the page of the ChargeItemDefinition search with its metadata.
+type ChargeItemDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ChargeItemDefinition
+}
+
+func newChargeItemDefinitionSearchResult(result *SearchResult, err error) (*ChargeItemDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ChargeItemDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ChargeItemDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ChargeItemDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchChargeItemDefinition(ctx context.Context, params Parameters) (*ChargeItemDefinitionSearchResult, error) {
+	return newChargeItemDefinitionSearchResult(c.Search(ctx, "ChargeItemDefinition", params))
+}
+
+// Search ChargeItemDefinition page by its link URL.
+func (c *Client) SearchChargeItemDefinitionPage(ctx context.Context, url string) (*ChargeItemDefinitionSearchResult, error) {
+	return newChargeItemDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateChargeItemDefinition(ctx context.Context, params Parameters, entity *models.ChargeItemDefinition) (*models.ChargeItemDefinition, error) {
 	resp, err := c.Create(ctx, "ChargeItemDefinition", params, entity)
 	if err != nil {
@@ -3545,11 +4572,11 @@ func (c *Client) DeleteChargeItemDefinitionByID(ctx context.Context, id string,
 // Claim
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToClaims(bundle *models.Bundle) ([]*models.Claim, error) {
+func bundleToClaims(bundle *models.Bundle, decoder models.Decoder) ([]*models.Claim, error) {
 	var entities []*models.Claim
 	err := EnumBundleResources(bundle, "Claim", func(resource ResourceData) error {
 		var entity models.Claim
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Claim", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3565,10 +4592,10 @@ func bundleToClaims(bundle *models.Bundle) ([]*models.Claim, error) {
 func fhirRespToClaims(resp *FhirResponse) ([]*models.Claim, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToClaims(resp.Bundle)
+		return bundleToClaims(resp.Bundle, resp.decoder)
 	case "Claim":
 		var entity models.Claim
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Claim{&entity}, nil
@@ -3607,6 +4634,36 @@ func (c *Client) GetClaimByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToClaim(id, resp)
 }
 
+// ClaimSearchResult is the page of the Claim search with its metadata.
+type ClaimSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Claim
+}
+
+func newClaimSearchResult(result *SearchResult, err error) (*ClaimSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ClaimSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Claim); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Claim with the total, links, full URLs and scores.
+func (c *Client) SearchClaim(ctx context.Context, params Parameters) (*ClaimSearchResult, error) {
+	return newClaimSearchResult(c.Search(ctx, "Claim", params))
+}
+
+// Search Claim page by its link URL.
+func (c *Client) SearchClaimPage(ctx context.Context, url string) (*ClaimSearchResult, error) {
+	return newClaimSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateClaim(ctx context.Context, params Parameters, entity *models.Claim) (*models.Claim, error) {
 	resp, err := c.Create(ctx, "Claim", params, entity)
 	if err != nil {
@@ -3674,11 +4731,11 @@ func (c *Client) DeleteClaimByID(ctx context.Context, id string, params Paramete
 // ClaimResponse
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToClaimResponses(bundle *models.Bundle) ([]*models.ClaimResponse, error) {
+func bundleToClaimResponses(bundle *models.Bundle, decoder models.Decoder) ([]*models.ClaimResponse, error) {
 	var entities []*models.ClaimResponse
 	err := EnumBundleResources(bundle, "ClaimResponse", func(resource ResourceData) error {
 		var entity models.ClaimResponse
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ClaimResponse", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3694,10 +4751,10 @@ func bundleToClaimResponses(bundle *models.Bundle) ([]*models.ClaimResponse, err
 func fhirRespToClaimResponses(resp *FhirResponse) ([]*models.ClaimResponse, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToClaimResponses(resp.Bundle)
+		return bundleToClaimResponses(resp.Bundle, resp.decoder)
 	case "ClaimResponse":
 		var entity models.ClaimResponse
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ClaimResponse{&entity}, nil
@@ -3736,6 +4793,36 @@ func (c *Client) GetClaimResponseByID(ctx context.Context, id string, params Par
 	return fhirRespToClaimResponse(id, resp)
 }
 
+// ClaimResponseSearchResult is the page of the ClaimResponse search with its metadata.
+type ClaimResponseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ClaimResponse
+}
+
+func newClaimResponseSearchResult(result *SearchResult, err error) (*ClaimResponseSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ClaimResponseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ClaimResponse); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ClaimResponse with the total, links, full URLs and scores.
+func (c *Client) SearchClaimResponse(ctx context.Context, params Parameters) (*ClaimResponseSearchResult, error) {
+	return newClaimResponseSearchResult(c.Search(ctx, "ClaimResponse", params))
+}
+
+// Search ClaimResponse page by its link URL.
+func (c *Client) SearchClaimResponsePage(ctx context.Context, url string) (*ClaimResponseSearchResult, error) {
+	return newClaimResponseSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateClaimResponse(ctx context.Context, params Parameters, entity *models.ClaimResponse) (*models.ClaimResponse, error) {
 	resp, err := c.Create(ctx, "ClaimResponse", params, entity)
 	if err != nil {
@@ -3803,11 +4890,11 @@ func (c *Client) DeleteClaimResponseByID(ctx context.Context, id string, params
 // ClinicalImpression
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToClinicalImpressions(bundle *models.Bundle) ([]*models.ClinicalImpression, error) {
+func bundleToClinicalImpressions(bundle *models.Bundle, decoder models.Decoder) ([]*models.ClinicalImpression, error) {
 	var entities []*models.ClinicalImpression
 	err := EnumBundleResources(bundle, "ClinicalImpression", func(resource ResourceData) error {
 		var entity models.ClinicalImpression
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ClinicalImpression", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3823,10 +4910,10 @@ func bundleToClinicalImpressions(bundle *models.Bundle) ([]*models.ClinicalImpre
 func fhirRespToClinicalImpressions(resp *FhirResponse) ([]*models.ClinicalImpression, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToClinicalImpressions(resp.Bundle)
+		return bundleToClinicalImpressions(resp.Bundle, resp.decoder)
 	case "ClinicalImpression":
 		var entity models.ClinicalImpression
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ClinicalImpression{&entity}, nil
@@ -3865,16 +4952,46 @@ func (c *Client) GetClinicalImpressionByID(ctx context.Context, id string, param
 	return fhirRespToClinicalImpression(id, resp)
 }
 
-func (c *Client) CreateClinicalImpression(ctx context.Context, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error) {
-	resp, err := c.Create(ctx, "ClinicalImpression", params, entity)
+// ClinicalImpressionSearchResult is the page of the ClinicalImpression search with its metadata.
+type ClinicalImpressionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ClinicalImpression
+}
+
+func newClinicalImpressionSearchResult(result *SearchResult, err error) (*ClinicalImpressionSearchResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	typed := &ClinicalImpressionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ClinicalImpression); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
 
-	return fhirRespToClinicalImpression(StrPtrToStr(entity.ID), resp)
+// Search ClinicalImpression with the total, links, full URLs and scores.
+func (c *Client) SearchClinicalImpression(ctx context.Context, params Parameters) (*ClinicalImpressionSearchResult, error) {
+	return newClinicalImpressionSearchResult(c.Search(ctx, "ClinicalImpression", params))
 }
 
-func (c *Client) UpdateClinicalImpression(ctx context.Context, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error) {
+// Search ClinicalImpression page by its link URL.
+func (c *Client) SearchClinicalImpressionPage(ctx context.Context, url string) (*ClinicalImpressionSearchResult, error) {
+	return newClinicalImpressionSearchResult(c.SearchPage(ctx, url))
+}
+
+func (c *Client) CreateClinicalImpression(ctx context.Context, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error) {
+	resp, err := c.Create(ctx, "ClinicalImpression", params, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClinicalImpression(StrPtrToStr(entity.ID), resp)
+}
+
+func (c *Client) UpdateClinicalImpression(ctx context.Context, params Parameters, entity *models.ClinicalImpression) (*models.ClinicalImpression, error) {
 	resp, err := c.Update(ctx, "ClinicalImpression", params, entity)
 	if err != nil {
 		return nil, err
@@ -3932,11 +5049,11 @@ func (c *Client) DeleteClinicalImpressionByID(ctx context.Context, id string, pa
 // CodeSystem
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCodeSystems(bundle *models.Bundle) ([]*models.CodeSystem, error) {
+func bundleToCodeSystems(bundle *models.Bundle, decoder models.Decoder) ([]*models.CodeSystem, error) {
 	var entities []*models.CodeSystem
 	err := EnumBundleResources(bundle, "CodeSystem", func(resource ResourceData) error {
 		var entity models.CodeSystem
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CodeSystem", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -3952,10 +5069,10 @@ func bundleToCodeSystems(bundle *models.Bundle) ([]*models.CodeSystem, error) {
 func fhirRespToCodeSystems(resp *FhirResponse) ([]*models.CodeSystem, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCodeSystems(resp.Bundle)
+		return bundleToCodeSystems(resp.Bundle, resp.decoder)
 	case "CodeSystem":
 		var entity models.CodeSystem
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CodeSystem{&entity}, nil
@@ -3994,6 +5111,36 @@ func (c *Client) GetCodeSystemByID(ctx context.Context, id string, params Parame
 	return fhirRespToCodeSystem(id, resp)
 }
 
+// CodeSystemSearchResult is the page of the CodeSystem search with its metadata.
+type CodeSystemSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CodeSystem
+}
+
+func newCodeSystemSearchResult(result *SearchResult, err error) (*CodeSystemSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CodeSystemSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CodeSystem); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CodeSystem with the total, links, full URLs and scores.
+func (c *Client) SearchCodeSystem(ctx context.Context, params Parameters) (*CodeSystemSearchResult, error) {
+	return newCodeSystemSearchResult(c.Search(ctx, "CodeSystem", params))
+}
+
+// Search CodeSystem page by its link URL.
+func (c *Client) SearchCodeSystemPage(ctx context.Context, url string) (*CodeSystemSearchResult, error) {
+	return newCodeSystemSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCodeSystem(ctx context.Context, params Parameters, entity *models.CodeSystem) (*models.CodeSystem, error) {
 	resp, err := c.Create(ctx, "CodeSystem", params, entity)
 	if err != nil {
@@ -4061,11 +5208,11 @@ func (c *Client) DeleteCodeSystemByID(ctx context.Context, id string, params Par
 // Communication
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCommunications(bundle *models.Bundle) ([]*models.Communication, error) {
+func bundleToCommunications(bundle *models.Bundle, decoder models.Decoder) ([]*models.Communication, error) {
 	var entities []*models.Communication
 	err := EnumBundleResources(bundle, "Communication", func(resource ResourceData) error {
 		var entity models.Communication
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Communication", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4081,10 +5228,10 @@ func bundleToCommunications(bundle *models.Bundle) ([]*models.Communication, err
 func fhirRespToCommunications(resp *FhirResponse) ([]*models.Communication, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCommunications(resp.Bundle)
+		return bundleToCommunications(resp.Bundle, resp.decoder)
 	case "Communication":
 		var entity models.Communication
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Communication{&entity}, nil
@@ -4123,6 +5270,36 @@ func (c *Client) GetCommunicationByID(ctx context.Context, id string, params Par
 	return fhirRespToCommunication(id, resp)
 }
 
+// CommunicationSearchResult is the page of the Communication search with its metadata.
+type CommunicationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Communication
+}
+
+func newCommunicationSearchResult(result *SearchResult, err error) (*CommunicationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CommunicationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Communication); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Communication with the total, links, full URLs and scores.
+func (c *Client) SearchCommunication(ctx context.Context, params Parameters) (*CommunicationSearchResult, error) {
+	return newCommunicationSearchResult(c.Search(ctx, "Communication", params))
+}
+
+// Search Communication page by its link URL.
+func (c *Client) SearchCommunicationPage(ctx context.Context, url string) (*CommunicationSearchResult, error) {
+	return newCommunicationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCommunication(ctx context.Context, params Parameters, entity *models.Communication) (*models.Communication, error) {
 	resp, err := c.Create(ctx, "Communication", params, entity)
 	if err != nil {
@@ -4190,11 +5367,11 @@ func (c *Client) DeleteCommunicationByID(ctx context.Context, id string, params
 // CommunicationRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCommunicationRequests(bundle *models.Bundle) ([]*models.CommunicationRequest, error) {
+func bundleToCommunicationRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.CommunicationRequest, error) {
 	var entities []*models.CommunicationRequest
 	err := EnumBundleResources(bundle, "CommunicationRequest", func(resource ResourceData) error {
 		var entity models.CommunicationRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CommunicationRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4210,10 +5387,10 @@ func bundleToCommunicationRequests(bundle *models.Bundle) ([]*models.Communicati
 func fhirRespToCommunicationRequests(resp *FhirResponse) ([]*models.CommunicationRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCommunicationRequests(resp.Bundle)
+		return bundleToCommunicationRequests(resp.Bundle, resp.decoder)
 	case "CommunicationRequest":
 		var entity models.CommunicationRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CommunicationRequest{&entity}, nil
@@ -4252,6 +5429,36 @@ func (c *Client) GetCommunicationRequestByID(ctx context.Context, id string, par
 	return fhirRespToCommunicationRequest(id, resp)
 }
 
+// CommunicationRequestSearchResult is the page of the CommunicationRequest search with its metadata.
+type CommunicationRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CommunicationRequest
+}
+
+func newCommunicationRequestSearchResult(result *SearchResult, err error) (*CommunicationRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CommunicationRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CommunicationRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CommunicationRequest with the total, links, full URLs and scores.
+func (c *Client) SearchCommunicationRequest(ctx context.Context, params Parameters) (*CommunicationRequestSearchResult, error) {
+	return newCommunicationRequestSearchResult(c.Search(ctx, "CommunicationRequest", params))
+}
+
+// Search CommunicationRequest page by its link URL.
+func (c *Client) SearchCommunicationRequestPage(ctx context.Context, url string) (*CommunicationRequestSearchResult, error) {
+	return newCommunicationRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCommunicationRequest(ctx context.Context, params Parameters, entity *models.CommunicationRequest) (*models.CommunicationRequest, error) {
 	resp, err := c.Create(ctx, "CommunicationRequest", params, entity)
 	if err != nil {
@@ -4319,11 +5526,11 @@ func (c *Client) DeleteCommunicationRequestByID(ctx context.Context, id string,
 // CompartmentDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCompartmentDefinitions(bundle *models.Bundle) ([]*models.CompartmentDefinition, error) {
+func bundleToCompartmentDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.CompartmentDefinition, error) {
 	var entities []*models.CompartmentDefinition
 	err := EnumBundleResources(bundle, "CompartmentDefinition", func(resource ResourceData) error {
 		var entity models.CompartmentDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CompartmentDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4339,10 +5546,10 @@ func bundleToCompartmentDefinitions(bundle *models.Bundle) ([]*models.Compartmen
 func fhirRespToCompartmentDefinitions(resp *FhirResponse) ([]*models.CompartmentDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCompartmentDefinitions(resp.Bundle)
+		return bundleToCompartmentDefinitions(resp.Bundle, resp.decoder)
 	case "CompartmentDefinition":
 		var entity models.CompartmentDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CompartmentDefinition{&entity}, nil
@@ -4381,6 +5588,36 @@ func (c *Client) GetCompartmentDefinitionByID(ctx context.Context, id string, pa
 	return fhirRespToCompartmentDefinition(id, resp)
 }
 
+// CompartmentDefinitionSearchResult is the page of the CompartmentDefinition search with its metadata.
+type CompartmentDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CompartmentDefinition
+}
+
+func newCompartmentDefinitionSearchResult(result *SearchResult, err error) (*CompartmentDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CompartmentDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CompartmentDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CompartmentDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchCompartmentDefinition(ctx context.Context, params Parameters) (*CompartmentDefinitionSearchResult, error) {
+	return newCompartmentDefinitionSearchResult(c.Search(ctx, "CompartmentDefinition", params))
+}
+
+// Search CompartmentDefinition page by its link URL.
+func (c *Client) SearchCompartmentDefinitionPage(ctx context.Context, url string) (*CompartmentDefinitionSearchResult, error) {
+	return newCompartmentDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCompartmentDefinition(ctx context.Context, params Parameters, entity *models.CompartmentDefinition) (*models.CompartmentDefinition, error) {
 	resp, err := c.Create(ctx, "CompartmentDefinition", params, entity)
 	if err != nil {
@@ -4448,11 +5685,11 @@ func (c *Client) DeleteCompartmentDefinitionByID(ctx context.Context, id string,
 // Composition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCompositions(bundle *models.Bundle) ([]*models.Composition, error) {
+func bundleToCompositions(bundle *models.Bundle, decoder models.Decoder) ([]*models.Composition, error) {
 	var entities []*models.Composition
 	err := EnumBundleResources(bundle, "Composition", func(resource ResourceData) error {
 		var entity models.Composition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Composition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4468,10 +5705,10 @@ func bundleToCompositions(bundle *models.Bundle) ([]*models.Composition, error)
 func fhirRespToCompositions(resp *FhirResponse) ([]*models.Composition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCompositions(resp.Bundle)
+		return bundleToCompositions(resp.Bundle, resp.decoder)
 	case "Composition":
 		var entity models.Composition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Composition{&entity}, nil
@@ -4510,6 +5747,36 @@ func (c *Client) GetCompositionByID(ctx context.Context, id string, params Param
 	return fhirRespToComposition(id, resp)
 }
 
+// CompositionSearchResult is the page of the Composition search with its metadata.
+type CompositionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Composition
+}
+
+func newCompositionSearchResult(result *SearchResult, err error) (*CompositionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CompositionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Composition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Composition with the total, links, full URLs and scores.
+func (c *Client) SearchComposition(ctx context.Context, params Parameters) (*CompositionSearchResult, error) {
+	return newCompositionSearchResult(c.Search(ctx, "Composition", params))
+}
+
+// Search Composition page by its link URL.
+func (c *Client) SearchCompositionPage(ctx context.Context, url string) (*CompositionSearchResult, error) {
+	return newCompositionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateComposition(ctx context.Context, params Parameters, entity *models.Composition) (*models.Composition, error) {
 	resp, err := c.Create(ctx, "Composition", params, entity)
 	if err != nil {
@@ -4577,11 +5844,11 @@ func (c *Client) DeleteCompositionByID(ctx context.Context, id string, params Pa
 // ConceptMap
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToConceptMaps(bundle *models.Bundle) ([]*models.ConceptMap, error) {
+func bundleToConceptMaps(bundle *models.Bundle, decoder models.Decoder) ([]*models.ConceptMap, error) {
 	var entities []*models.ConceptMap
 	err := EnumBundleResources(bundle, "ConceptMap", func(resource ResourceData) error {
 		var entity models.ConceptMap
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ConceptMap", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4597,10 +5864,10 @@ func bundleToConceptMaps(bundle *models.Bundle) ([]*models.ConceptMap, error) {
 func fhirRespToConceptMaps(resp *FhirResponse) ([]*models.ConceptMap, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToConceptMaps(resp.Bundle)
+		return bundleToConceptMaps(resp.Bundle, resp.decoder)
 	case "ConceptMap":
 		var entity models.ConceptMap
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ConceptMap{&entity}, nil
@@ -4639,6 +5906,36 @@ func (c *Client) GetConceptMapByID(ctx context.Context, id string, params Parame
 	return fhirRespToConceptMap(id, resp)
 }
 
+// ConceptMapSearchResult is the page of the ConceptMap search with its metadata.
+type ConceptMapSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ConceptMap
+}
+
+func newConceptMapSearchResult(result *SearchResult, err error) (*ConceptMapSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ConceptMapSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ConceptMap); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ConceptMap with the total, links, full URLs and scores.
+func (c *Client) SearchConceptMap(ctx context.Context, params Parameters) (*ConceptMapSearchResult, error) {
+	return newConceptMapSearchResult(c.Search(ctx, "ConceptMap", params))
+}
+
+// Search ConceptMap page by its link URL.
+func (c *Client) SearchConceptMapPage(ctx context.Context, url string) (*ConceptMapSearchResult, error) {
+	return newConceptMapSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateConceptMap(ctx context.Context, params Parameters, entity *models.ConceptMap) (*models.ConceptMap, error) {
 	resp, err := c.Create(ctx, "ConceptMap", params, entity)
 	if err != nil {
@@ -4706,11 +6003,11 @@ func (c *Client) DeleteConceptMapByID(ctx context.Context, id string, params Par
 // Condition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToConditions(bundle *models.Bundle) ([]*models.Condition, error) {
+func bundleToConditions(bundle *models.Bundle, decoder models.Decoder) ([]*models.Condition, error) {
 	var entities []*models.Condition
 	err := EnumBundleResources(bundle, "Condition", func(resource ResourceData) error {
 		var entity models.Condition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Condition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4726,10 +6023,10 @@ func bundleToConditions(bundle *models.Bundle) ([]*models.Condition, error) {
 func fhirRespToConditions(resp *FhirResponse) ([]*models.Condition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToConditions(resp.Bundle)
+		return bundleToConditions(resp.Bundle, resp.decoder)
 	case "Condition":
 		var entity models.Condition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Condition{&entity}, nil
@@ -4768,6 +6065,36 @@ func (c *Client) GetConditionByID(ctx context.Context, id string, params Paramet
 	return fhirRespToCondition(id, resp)
 }
 
+// ConditionSearchResult is the page of the Condition search with its metadata.
+type ConditionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Condition
+}
+
+func newConditionSearchResult(result *SearchResult, err error) (*ConditionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ConditionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Condition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Condition with the total, links, full URLs and scores.
+func (c *Client) SearchCondition(ctx context.Context, params Parameters) (*ConditionSearchResult, error) {
+	return newConditionSearchResult(c.Search(ctx, "Condition", params))
+}
+
+// Search Condition page by its link URL.
+func (c *Client) SearchConditionPage(ctx context.Context, url string) (*ConditionSearchResult, error) {
+	return newConditionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCondition(ctx context.Context, params Parameters, entity *models.Condition) (*models.Condition, error) {
 	resp, err := c.Create(ctx, "Condition", params, entity)
 	if err != nil {
@@ -4835,11 +6162,11 @@ func (c *Client) DeleteConditionByID(ctx context.Context, id string, params Para
 // Consent
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToConsents(bundle *models.Bundle) ([]*models.Consent, error) {
+func bundleToConsents(bundle *models.Bundle, decoder models.Decoder) ([]*models.Consent, error) {
 	var entities []*models.Consent
 	err := EnumBundleResources(bundle, "Consent", func(resource ResourceData) error {
 		var entity models.Consent
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Consent", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4855,10 +6182,10 @@ func bundleToConsents(bundle *models.Bundle) ([]*models.Consent, error) {
 func fhirRespToConsents(resp *FhirResponse) ([]*models.Consent, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToConsents(resp.Bundle)
+		return bundleToConsents(resp.Bundle, resp.decoder)
 	case "Consent":
 		var entity models.Consent
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Consent{&entity}, nil
@@ -4897,6 +6224,36 @@ func (c *Client) GetConsentByID(ctx context.Context, id string, params Parameter
 	return fhirRespToConsent(id, resp)
 }
 
+// ConsentSearchResult is the page of the Consent search with its metadata.
+type ConsentSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Consent
+}
+
+func newConsentSearchResult(result *SearchResult, err error) (*ConsentSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ConsentSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Consent); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Consent with the total, links, full URLs and scores.
+func (c *Client) SearchConsent(ctx context.Context, params Parameters) (*ConsentSearchResult, error) {
+	return newConsentSearchResult(c.Search(ctx, "Consent", params))
+}
+
+// Search Consent page by its link URL.
+func (c *Client) SearchConsentPage(ctx context.Context, url string) (*ConsentSearchResult, error) {
+	return newConsentSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateConsent(ctx context.Context, params Parameters, entity *models.Consent) (*models.Consent, error) {
 	resp, err := c.Create(ctx, "Consent", params, entity)
 	if err != nil {
@@ -4964,11 +6321,11 @@ func (c *Client) DeleteConsentByID(ctx context.Context, id string, params Parame
 // Contract
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToContracts(bundle *models.Bundle) ([]*models.Contract, error) {
+func bundleToContracts(bundle *models.Bundle, decoder models.Decoder) ([]*models.Contract, error) {
 	var entities []*models.Contract
 	err := EnumBundleResources(bundle, "Contract", func(resource ResourceData) error {
 		var entity models.Contract
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Contract", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -4984,10 +6341,10 @@ func bundleToContracts(bundle *models.Bundle) ([]*models.Contract, error) {
 func fhirRespToContracts(resp *FhirResponse) ([]*models.Contract, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToContracts(resp.Bundle)
+		return bundleToContracts(resp.Bundle, resp.decoder)
 	case "Contract":
 		var entity models.Contract
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Contract{&entity}, nil
@@ -5026,6 +6383,36 @@ func (c *Client) GetContractByID(ctx context.Context, id string, params Paramete
 	return fhirRespToContract(id, resp)
 }
 
+// ContractSearchResult is the page of the Contract search with its metadata.
+type ContractSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Contract
+}
+
+func newContractSearchResult(result *SearchResult, err error) (*ContractSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ContractSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Contract); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Contract with the total, links, full URLs and scores.
+func (c *Client) SearchContract(ctx context.Context, params Parameters) (*ContractSearchResult, error) {
+	return newContractSearchResult(c.Search(ctx, "Contract", params))
+}
+
+// Search Contract page by its link URL.
+func (c *Client) SearchContractPage(ctx context.Context, url string) (*ContractSearchResult, error) {
+	return newContractSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateContract(ctx context.Context, params Parameters, entity *models.Contract) (*models.Contract, error) {
 	resp, err := c.Create(ctx, "Contract", params, entity)
 	if err != nil {
@@ -5093,11 +6480,11 @@ func (c *Client) DeleteContractByID(ctx context.Context, id string, params Param
 // Coverage
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCoverages(bundle *models.Bundle) ([]*models.Coverage, error) {
+func bundleToCoverages(bundle *models.Bundle, decoder models.Decoder) ([]*models.Coverage, error) {
 	var entities []*models.Coverage
 	err := EnumBundleResources(bundle, "Coverage", func(resource ResourceData) error {
 		var entity models.Coverage
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Coverage", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5113,10 +6500,10 @@ func bundleToCoverages(bundle *models.Bundle) ([]*models.Coverage, error) {
 func fhirRespToCoverages(resp *FhirResponse) ([]*models.Coverage, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCoverages(resp.Bundle)
+		return bundleToCoverages(resp.Bundle, resp.decoder)
 	case "Coverage":
 		var entity models.Coverage
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Coverage{&entity}, nil
@@ -5155,6 +6542,36 @@ func (c *Client) GetCoverageByID(ctx context.Context, id string, params Paramete
 	return fhirRespToCoverage(id, resp)
 }
 
+// CoverageSearchResult is the page of the Coverage search with its metadata.
+type CoverageSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Coverage
+}
+
+func newCoverageSearchResult(result *SearchResult, err error) (*CoverageSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CoverageSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Coverage); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Coverage with the total, links, full URLs and scores.
+func (c *Client) SearchCoverage(ctx context.Context, params Parameters) (*CoverageSearchResult, error) {
+	return newCoverageSearchResult(c.Search(ctx, "Coverage", params))
+}
+
+// Search Coverage page by its link URL.
+func (c *Client) SearchCoveragePage(ctx context.Context, url string) (*CoverageSearchResult, error) {
+	return newCoverageSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCoverage(ctx context.Context, params Parameters, entity *models.Coverage) (*models.Coverage, error) {
 	resp, err := c.Create(ctx, "Coverage", params, entity)
 	if err != nil {
@@ -5222,11 +6639,11 @@ func (c *Client) DeleteCoverageByID(ctx context.Context, id string, params Param
 // CoverageEligibilityRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCoverageEligibilityRequests(bundle *models.Bundle) ([]*models.CoverageEligibilityRequest, error) {
+func bundleToCoverageEligibilityRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.CoverageEligibilityRequest, error) {
 	var entities []*models.CoverageEligibilityRequest
 	err := EnumBundleResources(bundle, "CoverageEligibilityRequest", func(resource ResourceData) error {
 		var entity models.CoverageEligibilityRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CoverageEligibilityRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5242,10 +6659,10 @@ func bundleToCoverageEligibilityRequests(bundle *models.Bundle) ([]*models.Cover
 func fhirRespToCoverageEligibilityRequests(resp *FhirResponse) ([]*models.CoverageEligibilityRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCoverageEligibilityRequests(resp.Bundle)
+		return bundleToCoverageEligibilityRequests(resp.Bundle, resp.decoder)
 	case "CoverageEligibilityRequest":
 		var entity models.CoverageEligibilityRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CoverageEligibilityRequest{&entity}, nil
@@ -5284,6 +6701,36 @@ func (c *Client) GetCoverageEligibilityRequestByID(ctx context.Context, id strin
 	return fhirRespToCoverageEligibilityRequest(id, resp)
 }
 
+// CoverageEligibilityRequestSearchResult is the page of the CoverageEligibilityRequest search with its metadata.
+type CoverageEligibilityRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CoverageEligibilityRequest
+}
+
+func newCoverageEligibilityRequestSearchResult(result *SearchResult, err error) (*CoverageEligibilityRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CoverageEligibilityRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CoverageEligibilityRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CoverageEligibilityRequest with the total, links, full URLs and scores.
+func (c *Client) SearchCoverageEligibilityRequest(ctx context.Context, params Parameters) (*CoverageEligibilityRequestSearchResult, error) {
+	return newCoverageEligibilityRequestSearchResult(c.Search(ctx, "CoverageEligibilityRequest", params))
+}
+
+// Search CoverageEligibilityRequest page by its link URL.
+func (c *Client) SearchCoverageEligibilityRequestPage(ctx context.Context, url string) (*CoverageEligibilityRequestSearchResult, error) {
+	return newCoverageEligibilityRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCoverageEligibilityRequest(ctx context.Context, params Parameters, entity *models.CoverageEligibilityRequest) (*models.CoverageEligibilityRequest, error) {
 	resp, err := c.Create(ctx, "CoverageEligibilityRequest", params, entity)
 	if err != nil {
@@ -5351,11 +6798,11 @@ func (c *Client) DeleteCoverageEligibilityRequestByID(ctx context.Context, id st
 // CoverageEligibilityResponse
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToCoverageEligibilityResponses(bundle *models.Bundle) ([]*models.CoverageEligibilityResponse, error) {
+func bundleToCoverageEligibilityResponses(bundle *models.Bundle, decoder models.Decoder) ([]*models.CoverageEligibilityResponse, error) {
 	var entities []*models.CoverageEligibilityResponse
 	err := EnumBundleResources(bundle, "CoverageEligibilityResponse", func(resource ResourceData) error {
 		var entity models.CoverageEligibilityResponse
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "CoverageEligibilityResponse", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5371,10 +6818,10 @@ func bundleToCoverageEligibilityResponses(bundle *models.Bundle) ([]*models.Cove
 func fhirRespToCoverageEligibilityResponses(resp *FhirResponse) ([]*models.CoverageEligibilityResponse, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToCoverageEligibilityResponses(resp.Bundle)
+		return bundleToCoverageEligibilityResponses(resp.Bundle, resp.decoder)
 	case "CoverageEligibilityResponse":
 		var entity models.CoverageEligibilityResponse
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.CoverageEligibilityResponse{&entity}, nil
@@ -5413,6 +6860,36 @@ func (c *Client) GetCoverageEligibilityResponseByID(ctx context.Context, id stri
 	return fhirRespToCoverageEligibilityResponse(id, resp)
 }
 
+// CoverageEligibilityResponseSearchResult is the page of the CoverageEligibilityResponse search with its metadata.
+type CoverageEligibilityResponseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.CoverageEligibilityResponse
+}
+
+func newCoverageEligibilityResponseSearchResult(result *SearchResult, err error) (*CoverageEligibilityResponseSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &CoverageEligibilityResponseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.CoverageEligibilityResponse); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search CoverageEligibilityResponse with the total, links, full URLs and scores.
+func (c *Client) SearchCoverageEligibilityResponse(ctx context.Context, params Parameters) (*CoverageEligibilityResponseSearchResult, error) {
+	return newCoverageEligibilityResponseSearchResult(c.Search(ctx, "CoverageEligibilityResponse", params))
+}
+
+// Search CoverageEligibilityResponse page by its link URL.
+func (c *Client) SearchCoverageEligibilityResponsePage(ctx context.Context, url string) (*CoverageEligibilityResponseSearchResult, error) {
+	return newCoverageEligibilityResponseSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateCoverageEligibilityResponse(ctx context.Context, params Parameters, entity *models.CoverageEligibilityResponse) (*models.CoverageEligibilityResponse, error) {
 	resp, err := c.Create(ctx, "CoverageEligibilityResponse", params, entity)
 	if err != nil {
@@ -5480,11 +6957,11 @@ func (c *Client) DeleteCoverageEligibilityResponseByID(ctx context.Context, id s
 // DetectedIssue
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDetectedIssues(bundle *models.Bundle) ([]*models.DetectedIssue, error) {
+func bundleToDetectedIssues(bundle *models.Bundle, decoder models.Decoder) ([]*models.DetectedIssue, error) {
 	var entities []*models.DetectedIssue
 	err := EnumBundleResources(bundle, "DetectedIssue", func(resource ResourceData) error {
 		var entity models.DetectedIssue
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DetectedIssue", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5500,10 +6977,10 @@ func bundleToDetectedIssues(bundle *models.Bundle) ([]*models.DetectedIssue, err
 func fhirRespToDetectedIssues(resp *FhirResponse) ([]*models.DetectedIssue, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDetectedIssues(resp.Bundle)
+		return bundleToDetectedIssues(resp.Bundle, resp.decoder)
 	case "DetectedIssue":
 		var entity models.DetectedIssue
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DetectedIssue{&entity}, nil
@@ -5542,6 +7019,36 @@ func (c *Client) GetDetectedIssueByID(ctx context.Context, id string, params Par
 	return fhirRespToDetectedIssue(id, resp)
 }
 
+// DetectedIssueSearchResult is the page of the DetectedIssue search with its metadata.
+type DetectedIssueSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DetectedIssue
+}
+
+func newDetectedIssueSearchResult(result *SearchResult, err error) (*DetectedIssueSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DetectedIssueSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DetectedIssue); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DetectedIssue with the total, links, full URLs and scores.
+func (c *Client) SearchDetectedIssue(ctx context.Context, params Parameters) (*DetectedIssueSearchResult, error) {
+	return newDetectedIssueSearchResult(c.Search(ctx, "DetectedIssue", params))
+}
+
+// Search DetectedIssue page by its link URL.
+func (c *Client) SearchDetectedIssuePage(ctx context.Context, url string) (*DetectedIssueSearchResult, error) {
+	return newDetectedIssueSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDetectedIssue(ctx context.Context, params Parameters, entity *models.DetectedIssue) (*models.DetectedIssue, error) {
 	resp, err := c.Create(ctx, "DetectedIssue", params, entity)
 	if err != nil {
@@ -5609,11 +7116,11 @@ func (c *Client) DeleteDetectedIssueByID(ctx context.Context, id string, params
 // Device
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDevices(bundle *models.Bundle) ([]*models.Device, error) {
+func bundleToDevices(bundle *models.Bundle, decoder models.Decoder) ([]*models.Device, error) {
 	var entities []*models.Device
 	err := EnumBundleResources(bundle, "Device", func(resource ResourceData) error {
 		var entity models.Device
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Device", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5629,10 +7136,10 @@ func bundleToDevices(bundle *models.Bundle) ([]*models.Device, error) {
 func fhirRespToDevices(resp *FhirResponse) ([]*models.Device, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDevices(resp.Bundle)
+		return bundleToDevices(resp.Bundle, resp.decoder)
 	case "Device":
 		var entity models.Device
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Device{&entity}, nil
@@ -5671,6 +7178,36 @@ func (c *Client) GetDeviceByID(ctx context.Context, id string, params Parameters
 	return fhirRespToDevice(id, resp)
 }
 
+// DeviceSearchResult is the page of the Device search with its metadata.
+type DeviceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Device
+}
+
+func newDeviceSearchResult(result *SearchResult, err error) (*DeviceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DeviceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Device); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Device with the total, links, full URLs and scores.
+func (c *Client) SearchDevice(ctx context.Context, params Parameters) (*DeviceSearchResult, error) {
+	return newDeviceSearchResult(c.Search(ctx, "Device", params))
+}
+
+// Search Device page by its link URL.
+func (c *Client) SearchDevicePage(ctx context.Context, url string) (*DeviceSearchResult, error) {
+	return newDeviceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDevice(ctx context.Context, params Parameters, entity *models.Device) (*models.Device, error) {
 	resp, err := c.Create(ctx, "Device", params, entity)
 	if err != nil {
@@ -5738,11 +7275,11 @@ func (c *Client) DeleteDeviceByID(ctx context.Context, id string, params Paramet
 // DeviceDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDeviceDefinitions(bundle *models.Bundle) ([]*models.DeviceDefinition, error) {
+func bundleToDeviceDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.DeviceDefinition, error) {
 	var entities []*models.DeviceDefinition
 	err := EnumBundleResources(bundle, "DeviceDefinition", func(resource ResourceData) error {
 		var entity models.DeviceDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DeviceDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5758,10 +7295,10 @@ func bundleToDeviceDefinitions(bundle *models.Bundle) ([]*models.DeviceDefinitio
 func fhirRespToDeviceDefinitions(resp *FhirResponse) ([]*models.DeviceDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDeviceDefinitions(resp.Bundle)
+		return bundleToDeviceDefinitions(resp.Bundle, resp.decoder)
 	case "DeviceDefinition":
 		var entity models.DeviceDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DeviceDefinition{&entity}, nil
@@ -5800,6 +7337,36 @@ func (c *Client) GetDeviceDefinitionByID(ctx context.Context, id string, params
 	return fhirRespToDeviceDefinition(id, resp)
 }
 
+// DeviceDefinitionSearchResult is the page of the DeviceDefinition search with its metadata.
+type DeviceDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DeviceDefinition
+}
+
+func newDeviceDefinitionSearchResult(result *SearchResult, err error) (*DeviceDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DeviceDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DeviceDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DeviceDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchDeviceDefinition(ctx context.Context, params Parameters) (*DeviceDefinitionSearchResult, error) {
+	return newDeviceDefinitionSearchResult(c.Search(ctx, "DeviceDefinition", params))
+}
+
+// Search DeviceDefinition page by its link URL.
+func (c *Client) SearchDeviceDefinitionPage(ctx context.Context, url string) (*DeviceDefinitionSearchResult, error) {
+	return newDeviceDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDeviceDefinition(ctx context.Context, params Parameters, entity *models.DeviceDefinition) (*models.DeviceDefinition, error) {
 	resp, err := c.Create(ctx, "DeviceDefinition", params, entity)
 	if err != nil {
@@ -5867,11 +7434,11 @@ func (c *Client) DeleteDeviceDefinitionByID(ctx context.Context, id string, para
 // DeviceMetric
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDeviceMetrics(bundle *models.Bundle) ([]*models.DeviceMetric, error) {
+func bundleToDeviceMetrics(bundle *models.Bundle, decoder models.Decoder) ([]*models.DeviceMetric, error) {
 	var entities []*models.DeviceMetric
 	err := EnumBundleResources(bundle, "DeviceMetric", func(resource ResourceData) error {
 		var entity models.DeviceMetric
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DeviceMetric", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -5887,10 +7454,10 @@ func bundleToDeviceMetrics(bundle *models.Bundle) ([]*models.DeviceMetric, error
 func fhirRespToDeviceMetrics(resp *FhirResponse) ([]*models.DeviceMetric, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDeviceMetrics(resp.Bundle)
+		return bundleToDeviceMetrics(resp.Bundle, resp.decoder)
 	case "DeviceMetric":
 		var entity models.DeviceMetric
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DeviceMetric{&entity}, nil
@@ -5929,6 +7496,36 @@ func (c *Client) GetDeviceMetricByID(ctx context.Context, id string, params Para
 	return fhirRespToDeviceMetric(id, resp)
 }
 
+// DeviceMetricSearchResult is the page of the DeviceMetric search with its metadata.
+type DeviceMetricSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DeviceMetric
+}
+
+func newDeviceMetricSearchResult(result *SearchResult, err error) (*DeviceMetricSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DeviceMetricSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DeviceMetric); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DeviceMetric with the total, links, full URLs and scores.
+func (c *Client) SearchDeviceMetric(ctx context.Context, params Parameters) (*DeviceMetricSearchResult, error) {
+	return newDeviceMetricSearchResult(c.Search(ctx, "DeviceMetric", params))
+}
+
+// Search DeviceMetric page by its link URL.
+func (c *Client) SearchDeviceMetricPage(ctx context.Context, url string) (*DeviceMetricSearchResult, error) {
+	return newDeviceMetricSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDeviceMetric(ctx context.Context, params Parameters, entity *models.DeviceMetric) (*models.DeviceMetric, error) {
 	resp, err := c.Create(ctx, "DeviceMetric", params, entity)
 	if err != nil {
@@ -5996,11 +7593,11 @@ func (c *Client) DeleteDeviceMetricByID(ctx context.Context, id string, params P
 // DeviceRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDeviceRequests(bundle *models.Bundle) ([]*models.DeviceRequest, error) {
+func bundleToDeviceRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.DeviceRequest, error) {
 	var entities []*models.DeviceRequest
 	err := EnumBundleResources(bundle, "DeviceRequest", func(resource ResourceData) error {
 		var entity models.DeviceRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DeviceRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6016,10 +7613,10 @@ func bundleToDeviceRequests(bundle *models.Bundle) ([]*models.DeviceRequest, err
 func fhirRespToDeviceRequests(resp *FhirResponse) ([]*models.DeviceRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDeviceRequests(resp.Bundle)
+		return bundleToDeviceRequests(resp.Bundle, resp.decoder)
 	case "DeviceRequest":
 		var entity models.DeviceRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DeviceRequest{&entity}, nil
@@ -6058,6 +7655,36 @@ func (c *Client) GetDeviceRequestByID(ctx context.Context, id string, params Par
 	return fhirRespToDeviceRequest(id, resp)
 }
 
+// DeviceRequestSearchResult is the page of the DeviceRequest search with its metadata.
+type DeviceRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DeviceRequest
+}
+
+func newDeviceRequestSearchResult(result *SearchResult, err error) (*DeviceRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DeviceRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DeviceRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DeviceRequest with the total, links, full URLs and scores.
+func (c *Client) SearchDeviceRequest(ctx context.Context, params Parameters) (*DeviceRequestSearchResult, error) {
+	return newDeviceRequestSearchResult(c.Search(ctx, "DeviceRequest", params))
+}
+
+// Search DeviceRequest page by its link URL.
+func (c *Client) SearchDeviceRequestPage(ctx context.Context, url string) (*DeviceRequestSearchResult, error) {
+	return newDeviceRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDeviceRequest(ctx context.Context, params Parameters, entity *models.DeviceRequest) (*models.DeviceRequest, error) {
 	resp, err := c.Create(ctx, "DeviceRequest", params, entity)
 	if err != nil {
@@ -6125,11 +7752,11 @@ func (c *Client) DeleteDeviceRequestByID(ctx context.Context, id string, params
 // DeviceUseStatement
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDeviceUseStatements(bundle *models.Bundle) ([]*models.DeviceUseStatement, error) {
+func bundleToDeviceUseStatements(bundle *models.Bundle, decoder models.Decoder) ([]*models.DeviceUseStatement, error) {
 	var entities []*models.DeviceUseStatement
 	err := EnumBundleResources(bundle, "DeviceUseStatement", func(resource ResourceData) error {
 		var entity models.DeviceUseStatement
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DeviceUseStatement", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6145,10 +7772,10 @@ func bundleToDeviceUseStatements(bundle *models.Bundle) ([]*models.DeviceUseStat
 func fhirRespToDeviceUseStatements(resp *FhirResponse) ([]*models.DeviceUseStatement, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDeviceUseStatements(resp.Bundle)
+		return bundleToDeviceUseStatements(resp.Bundle, resp.decoder)
 	case "DeviceUseStatement":
 		var entity models.DeviceUseStatement
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DeviceUseStatement{&entity}, nil
@@ -6187,6 +7814,36 @@ func (c *Client) GetDeviceUseStatementByID(ctx context.Context, id string, param
 	return fhirRespToDeviceUseStatement(id, resp)
 }
 
+// DeviceUseStatementSearchResult is the page of the DeviceUseStatement search with its metadata.
+type DeviceUseStatementSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DeviceUseStatement
+}
+
+func newDeviceUseStatementSearchResult(result *SearchResult, err error) (*DeviceUseStatementSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DeviceUseStatementSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DeviceUseStatement); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DeviceUseStatement with the total, links, full URLs and scores.
+func (c *Client) SearchDeviceUseStatement(ctx context.Context, params Parameters) (*DeviceUseStatementSearchResult, error) {
+	return newDeviceUseStatementSearchResult(c.Search(ctx, "DeviceUseStatement", params))
+}
+
+// Search DeviceUseStatement page by its link URL.
+func (c *Client) SearchDeviceUseStatementPage(ctx context.Context, url string) (*DeviceUseStatementSearchResult, error) {
+	return newDeviceUseStatementSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDeviceUseStatement(ctx context.Context, params Parameters, entity *models.DeviceUseStatement) (*models.DeviceUseStatement, error) {
 	resp, err := c.Create(ctx, "DeviceUseStatement", params, entity)
 	if err != nil {
@@ -6254,11 +7911,11 @@ func (c *Client) DeleteDeviceUseStatementByID(ctx context.Context, id string, pa
 // DiagnosticReport
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDiagnosticReports(bundle *models.Bundle) ([]*models.DiagnosticReport, error) {
+func bundleToDiagnosticReports(bundle *models.Bundle, decoder models.Decoder) ([]*models.DiagnosticReport, error) {
 	var entities []*models.DiagnosticReport
 	err := EnumBundleResources(bundle, "DiagnosticReport", func(resource ResourceData) error {
 		var entity models.DiagnosticReport
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DiagnosticReport", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6274,10 +7931,10 @@ func bundleToDiagnosticReports(bundle *models.Bundle) ([]*models.DiagnosticRepor
 func fhirRespToDiagnosticReports(resp *FhirResponse) ([]*models.DiagnosticReport, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDiagnosticReports(resp.Bundle)
+		return bundleToDiagnosticReports(resp.Bundle, resp.decoder)
 	case "DiagnosticReport":
 		var entity models.DiagnosticReport
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DiagnosticReport{&entity}, nil
@@ -6316,17 +7973,47 @@ func (c *Client) GetDiagnosticReportByID(ctx context.Context, id string, params
 	return fhirRespToDiagnosticReport(id, resp)
 }
 
-func (c *Client) CreateDiagnosticReport(ctx context.Context, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error) {
-	resp, err := c.Create(ctx, "DiagnosticReport", params, entity)
+// DiagnosticReportSearchResult is the page of the DiagnosticReport search with its metadata.
+type DiagnosticReportSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DiagnosticReport
+}
+
+func newDiagnosticReportSearchResult(result *SearchResult, err error) (*DiagnosticReportSearchResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	typed := &DiagnosticReportSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DiagnosticReport); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
 
-	return fhirRespToDiagnosticReport(StrPtrToStr(entity.ID), resp)
+// Search DiagnosticReport with the total, links, full URLs and scores.
+func (c *Client) SearchDiagnosticReport(ctx context.Context, params Parameters) (*DiagnosticReportSearchResult, error) {
+	return newDiagnosticReportSearchResult(c.Search(ctx, "DiagnosticReport", params))
 }
 
-func (c *Client) UpdateDiagnosticReport(ctx context.Context, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error) {
-	resp, err := c.Update(ctx, "DiagnosticReport", params, entity)
+// Search DiagnosticReport page by its link URL.
+func (c *Client) SearchDiagnosticReportPage(ctx context.Context, url string) (*DiagnosticReportSearchResult, error) {
+	return newDiagnosticReportSearchResult(c.SearchPage(ctx, url))
+}
+
+func (c *Client) CreateDiagnosticReport(ctx context.Context, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error) {
+	resp, err := c.Create(ctx, "DiagnosticReport", params, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDiagnosticReport(StrPtrToStr(entity.ID), resp)
+}
+
+func (c *Client) UpdateDiagnosticReport(ctx context.Context, params Parameters, entity *models.DiagnosticReport) (*models.DiagnosticReport, error) {
+	resp, err := c.Update(ctx, "DiagnosticReport", params, entity)
 	if err != nil {
 		return nil, err
 	}
@@ -6383,11 +8070,11 @@ func (c *Client) DeleteDiagnosticReportByID(ctx context.Context, id string, para
 // DocumentManifest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDocumentManifests(bundle *models.Bundle) ([]*models.DocumentManifest, error) {
+func bundleToDocumentManifests(bundle *models.Bundle, decoder models.Decoder) ([]*models.DocumentManifest, error) {
 	var entities []*models.DocumentManifest
 	err := EnumBundleResources(bundle, "DocumentManifest", func(resource ResourceData) error {
 		var entity models.DocumentManifest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DocumentManifest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6403,10 +8090,10 @@ func bundleToDocumentManifests(bundle *models.Bundle) ([]*models.DocumentManifes
 func fhirRespToDocumentManifests(resp *FhirResponse) ([]*models.DocumentManifest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDocumentManifests(resp.Bundle)
+		return bundleToDocumentManifests(resp.Bundle, resp.decoder)
 	case "DocumentManifest":
 		var entity models.DocumentManifest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DocumentManifest{&entity}, nil
@@ -6445,6 +8132,36 @@ func (c *Client) GetDocumentManifestByID(ctx context.Context, id string, params
 	return fhirRespToDocumentManifest(id, resp)
 }
 
+// DocumentManifestSearchResult is the page of the DocumentManifest search with its metadata.
+type DocumentManifestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DocumentManifest
+}
+
+func newDocumentManifestSearchResult(result *SearchResult, err error) (*DocumentManifestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DocumentManifestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DocumentManifest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DocumentManifest with the total, links, full URLs and scores.
+func (c *Client) SearchDocumentManifest(ctx context.Context, params Parameters) (*DocumentManifestSearchResult, error) {
+	return newDocumentManifestSearchResult(c.Search(ctx, "DocumentManifest", params))
+}
+
+// Search DocumentManifest page by its link URL.
+func (c *Client) SearchDocumentManifestPage(ctx context.Context, url string) (*DocumentManifestSearchResult, error) {
+	return newDocumentManifestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDocumentManifest(ctx context.Context, params Parameters, entity *models.DocumentManifest) (*models.DocumentManifest, error) {
 	resp, err := c.Create(ctx, "DocumentManifest", params, entity)
 	if err != nil {
@@ -6512,11 +8229,11 @@ func (c *Client) DeleteDocumentManifestByID(ctx context.Context, id string, para
 // DocumentReference
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDocumentReferences(bundle *models.Bundle) ([]*models.DocumentReference, error) {
+func bundleToDocumentReferences(bundle *models.Bundle, decoder models.Decoder) ([]*models.DocumentReference, error) {
 	var entities []*models.DocumentReference
 	err := EnumBundleResources(bundle, "DocumentReference", func(resource ResourceData) error {
 		var entity models.DocumentReference
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DocumentReference", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6532,10 +8249,10 @@ func bundleToDocumentReferences(bundle *models.Bundle) ([]*models.DocumentRefere
 func fhirRespToDocumentReferences(resp *FhirResponse) ([]*models.DocumentReference, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDocumentReferences(resp.Bundle)
+		return bundleToDocumentReferences(resp.Bundle, resp.decoder)
 	case "DocumentReference":
 		var entity models.DocumentReference
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DocumentReference{&entity}, nil
@@ -6574,6 +8291,36 @@ func (c *Client) GetDocumentReferenceByID(ctx context.Context, id string, params
 	return fhirRespToDocumentReference(id, resp)
 }
 
+// DocumentReferenceSearchResult is the page of the DocumentReference search with its metadata.
+type DocumentReferenceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DocumentReference
+}
+
+func newDocumentReferenceSearchResult(result *SearchResult, err error) (*DocumentReferenceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DocumentReferenceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DocumentReference); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DocumentReference with the total, links, full URLs and scores.
+func (c *Client) SearchDocumentReference(ctx context.Context, params Parameters) (*DocumentReferenceSearchResult, error) {
+	return newDocumentReferenceSearchResult(c.Search(ctx, "DocumentReference", params))
+}
+
+// Search DocumentReference page by its link URL.
+func (c *Client) SearchDocumentReferencePage(ctx context.Context, url string) (*DocumentReferenceSearchResult, error) {
+	return newDocumentReferenceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDocumentReference(ctx context.Context, params Parameters, entity *models.DocumentReference) (*models.DocumentReference, error) {
 	resp, err := c.Create(ctx, "DocumentReference", params, entity)
 	if err != nil {
@@ -6641,11 +8388,11 @@ func (c *Client) DeleteDocumentReferenceByID(ctx context.Context, id string, par
 // DomainResource
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToDomainResources(bundle *models.Bundle) ([]*models.DomainResource, error) {
+func bundleToDomainResources(bundle *models.Bundle, decoder models.Decoder) ([]*models.DomainResource, error) {
 	var entities []*models.DomainResource
 	err := EnumBundleResources(bundle, "DomainResource", func(resource ResourceData) error {
 		var entity models.DomainResource
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "DomainResource", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6661,10 +8408,10 @@ func bundleToDomainResources(bundle *models.Bundle) ([]*models.DomainResource, e
 func fhirRespToDomainResources(resp *FhirResponse) ([]*models.DomainResource, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToDomainResources(resp.Bundle)
+		return bundleToDomainResources(resp.Bundle, resp.decoder)
 	case "DomainResource":
 		var entity models.DomainResource
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.DomainResource{&entity}, nil
@@ -6703,6 +8450,36 @@ func (c *Client) GetDomainResourceByID(ctx context.Context, id string, params Pa
 	return fhirRespToDomainResource(id, resp)
 }
 
+// DomainResourceSearchResult is the page of the DomainResource search with its metadata.
+type DomainResourceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.DomainResource
+}
+
+func newDomainResourceSearchResult(result *SearchResult, err error) (*DomainResourceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &DomainResourceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.DomainResource); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search DomainResource with the total, links, full URLs and scores.
+func (c *Client) SearchDomainResource(ctx context.Context, params Parameters) (*DomainResourceSearchResult, error) {
+	return newDomainResourceSearchResult(c.Search(ctx, "DomainResource", params))
+}
+
+// Search DomainResource page by its link URL.
+func (c *Client) SearchDomainResourcePage(ctx context.Context, url string) (*DomainResourceSearchResult, error) {
+	return newDomainResourceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateDomainResource(ctx context.Context, params Parameters, entity *models.DomainResource) (*models.DomainResource, error) {
 	resp, err := c.Create(ctx, "DomainResource", params, entity)
 	if err != nil {
@@ -6770,11 +8547,11 @@ func (c *Client) DeleteDomainResourceByID(ctx context.Context, id string, params
 // EffectEvidenceSynthesis
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEffectEvidenceSynthesiss(bundle *models.Bundle) ([]*models.EffectEvidenceSynthesis, error) {
+func bundleToEffectEvidenceSynthesiss(bundle *models.Bundle, decoder models.Decoder) ([]*models.EffectEvidenceSynthesis, error) {
 	var entities []*models.EffectEvidenceSynthesis
 	err := EnumBundleResources(bundle, "EffectEvidenceSynthesis", func(resource ResourceData) error {
 		var entity models.EffectEvidenceSynthesis
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "EffectEvidenceSynthesis", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6790,10 +8567,10 @@ func bundleToEffectEvidenceSynthesiss(bundle *models.Bundle) ([]*models.EffectEv
 func fhirRespToEffectEvidenceSynthesiss(resp *FhirResponse) ([]*models.EffectEvidenceSynthesis, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEffectEvidenceSynthesiss(resp.Bundle)
+		return bundleToEffectEvidenceSynthesiss(resp.Bundle, resp.decoder)
 	case "EffectEvidenceSynthesis":
 		var entity models.EffectEvidenceSynthesis
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.EffectEvidenceSynthesis{&entity}, nil
@@ -6832,6 +8609,36 @@ func (c *Client) GetEffectEvidenceSynthesisByID(ctx context.Context, id string,
 	return fhirRespToEffectEvidenceSynthesis(id, resp)
 }
 
+// EffectEvidenceSynthesisSearchResult is the page of the EffectEvidenceSynthesis search with its metadata.
+type EffectEvidenceSynthesisSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.EffectEvidenceSynthesis
+}
+
+func newEffectEvidenceSynthesisSearchResult(result *SearchResult, err error) (*EffectEvidenceSynthesisSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EffectEvidenceSynthesisSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.EffectEvidenceSynthesis); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search EffectEvidenceSynthesis with the total, links, full URLs and scores.
+func (c *Client) SearchEffectEvidenceSynthesis(ctx context.Context, params Parameters) (*EffectEvidenceSynthesisSearchResult, error) {
+	return newEffectEvidenceSynthesisSearchResult(c.Search(ctx, "EffectEvidenceSynthesis", params))
+}
+
+// Search EffectEvidenceSynthesis page by its link URL.
+func (c *Client) SearchEffectEvidenceSynthesisPage(ctx context.Context, url string) (*EffectEvidenceSynthesisSearchResult, error) {
+	return newEffectEvidenceSynthesisSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEffectEvidenceSynthesis(ctx context.Context, params Parameters, entity *models.EffectEvidenceSynthesis) (*models.EffectEvidenceSynthesis, error) {
 	resp, err := c.Create(ctx, "EffectEvidenceSynthesis", params, entity)
 	if err != nil {
@@ -6899,11 +8706,11 @@ func (c *Client) DeleteEffectEvidenceSynthesisByID(ctx context.Context, id strin
 // Encounter
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEncounters(bundle *models.Bundle) ([]*models.Encounter, error) {
+func bundleToEncounters(bundle *models.Bundle, decoder models.Decoder) ([]*models.Encounter, error) {
 	var entities []*models.Encounter
 	err := EnumBundleResources(bundle, "Encounter", func(resource ResourceData) error {
 		var entity models.Encounter
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Encounter", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -6919,10 +8726,10 @@ func bundleToEncounters(bundle *models.Bundle) ([]*models.Encounter, error) {
 func fhirRespToEncounters(resp *FhirResponse) ([]*models.Encounter, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEncounters(resp.Bundle)
+		return bundleToEncounters(resp.Bundle, resp.decoder)
 	case "Encounter":
 		var entity models.Encounter
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Encounter{&entity}, nil
@@ -6961,6 +8768,36 @@ func (c *Client) GetEncounterByID(ctx context.Context, id string, params Paramet
 	return fhirRespToEncounter(id, resp)
 }
 
+// EncounterSearchResult is the page of the Encounter search with its metadata.
+type EncounterSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Encounter
+}
+
+func newEncounterSearchResult(result *SearchResult, err error) (*EncounterSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EncounterSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Encounter); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Encounter with the total, links, full URLs and scores.
+func (c *Client) SearchEncounter(ctx context.Context, params Parameters) (*EncounterSearchResult, error) {
+	return newEncounterSearchResult(c.Search(ctx, "Encounter", params))
+}
+
+// Search Encounter page by its link URL.
+func (c *Client) SearchEncounterPage(ctx context.Context, url string) (*EncounterSearchResult, error) {
+	return newEncounterSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEncounter(ctx context.Context, params Parameters, entity *models.Encounter) (*models.Encounter, error) {
 	resp, err := c.Create(ctx, "Encounter", params, entity)
 	if err != nil {
@@ -7028,11 +8865,11 @@ func (c *Client) DeleteEncounterByID(ctx context.Context, id string, params Para
 // Endpoint
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEndpoints(bundle *models.Bundle) ([]*models.Endpoint, error) {
+func bundleToEndpoints(bundle *models.Bundle, decoder models.Decoder) ([]*models.Endpoint, error) {
 	var entities []*models.Endpoint
 	err := EnumBundleResources(bundle, "Endpoint", func(resource ResourceData) error {
 		var entity models.Endpoint
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Endpoint", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7048,10 +8885,10 @@ func bundleToEndpoints(bundle *models.Bundle) ([]*models.Endpoint, error) {
 func fhirRespToEndpoints(resp *FhirResponse) ([]*models.Endpoint, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEndpoints(resp.Bundle)
+		return bundleToEndpoints(resp.Bundle, resp.decoder)
 	case "Endpoint":
 		var entity models.Endpoint
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Endpoint{&entity}, nil
@@ -7090,6 +8927,36 @@ func (c *Client) GetEndpointByID(ctx context.Context, id string, params Paramete
 	return fhirRespToEndpoint(id, resp)
 }
 
+// EndpointSearchResult is the page of the Endpoint search with its metadata.
+type EndpointSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Endpoint
+}
+
+func newEndpointSearchResult(result *SearchResult, err error) (*EndpointSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EndpointSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Endpoint); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Endpoint with the total, links, full URLs and scores.
+func (c *Client) SearchEndpoint(ctx context.Context, params Parameters) (*EndpointSearchResult, error) {
+	return newEndpointSearchResult(c.Search(ctx, "Endpoint", params))
+}
+
+// Search Endpoint page by its link URL.
+func (c *Client) SearchEndpointPage(ctx context.Context, url string) (*EndpointSearchResult, error) {
+	return newEndpointSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEndpoint(ctx context.Context, params Parameters, entity *models.Endpoint) (*models.Endpoint, error) {
 	resp, err := c.Create(ctx, "Endpoint", params, entity)
 	if err != nil {
@@ -7157,11 +9024,11 @@ func (c *Client) DeleteEndpointByID(ctx context.Context, id string, params Param
 // EnrollmentRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEnrollmentRequests(bundle *models.Bundle) ([]*models.EnrollmentRequest, error) {
+func bundleToEnrollmentRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.EnrollmentRequest, error) {
 	var entities []*models.EnrollmentRequest
 	err := EnumBundleResources(bundle, "EnrollmentRequest", func(resource ResourceData) error {
 		var entity models.EnrollmentRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "EnrollmentRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7177,10 +9044,10 @@ func bundleToEnrollmentRequests(bundle *models.Bundle) ([]*models.EnrollmentRequ
 func fhirRespToEnrollmentRequests(resp *FhirResponse) ([]*models.EnrollmentRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEnrollmentRequests(resp.Bundle)
+		return bundleToEnrollmentRequests(resp.Bundle, resp.decoder)
 	case "EnrollmentRequest":
 		var entity models.EnrollmentRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.EnrollmentRequest{&entity}, nil
@@ -7219,6 +9086,36 @@ func (c *Client) GetEnrollmentRequestByID(ctx context.Context, id string, params
 	return fhirRespToEnrollmentRequest(id, resp)
 }
 
+// EnrollmentRequestSearchResult is the page of the EnrollmentRequest search with its metadata.
+type EnrollmentRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.EnrollmentRequest
+}
+
+func newEnrollmentRequestSearchResult(result *SearchResult, err error) (*EnrollmentRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EnrollmentRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.EnrollmentRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search EnrollmentRequest with the total, links, full URLs and scores.
+func (c *Client) SearchEnrollmentRequest(ctx context.Context, params Parameters) (*EnrollmentRequestSearchResult, error) {
+	return newEnrollmentRequestSearchResult(c.Search(ctx, "EnrollmentRequest", params))
+}
+
+// Search EnrollmentRequest page by its link URL.
+func (c *Client) SearchEnrollmentRequestPage(ctx context.Context, url string) (*EnrollmentRequestSearchResult, error) {
+	return newEnrollmentRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEnrollmentRequest(ctx context.Context, params Parameters, entity *models.EnrollmentRequest) (*models.EnrollmentRequest, error) {
 	resp, err := c.Create(ctx, "EnrollmentRequest", params, entity)
 	if err != nil {
@@ -7286,11 +9183,11 @@ func (c *Client) DeleteEnrollmentRequestByID(ctx context.Context, id string, par
 // EnrollmentResponse
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEnrollmentResponses(bundle *models.Bundle) ([]*models.EnrollmentResponse, error) {
+func bundleToEnrollmentResponses(bundle *models.Bundle, decoder models.Decoder) ([]*models.EnrollmentResponse, error) {
 	var entities []*models.EnrollmentResponse
 	err := EnumBundleResources(bundle, "EnrollmentResponse", func(resource ResourceData) error {
 		var entity models.EnrollmentResponse
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "EnrollmentResponse", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7306,10 +9203,10 @@ func bundleToEnrollmentResponses(bundle *models.Bundle) ([]*models.EnrollmentRes
 func fhirRespToEnrollmentResponses(resp *FhirResponse) ([]*models.EnrollmentResponse, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEnrollmentResponses(resp.Bundle)
+		return bundleToEnrollmentResponses(resp.Bundle, resp.decoder)
 	case "EnrollmentResponse":
 		var entity models.EnrollmentResponse
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.EnrollmentResponse{&entity}, nil
@@ -7348,6 +9245,36 @@ func (c *Client) GetEnrollmentResponseByID(ctx context.Context, id string, param
 	return fhirRespToEnrollmentResponse(id, resp)
 }
 
+// EnrollmentResponseSearchResult is the page of the EnrollmentResponse search with its metadata.
+type EnrollmentResponseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.EnrollmentResponse
+}
+
+func newEnrollmentResponseSearchResult(result *SearchResult, err error) (*EnrollmentResponseSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EnrollmentResponseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.EnrollmentResponse); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search EnrollmentResponse with the total, links, full URLs and scores.
+func (c *Client) SearchEnrollmentResponse(ctx context.Context, params Parameters) (*EnrollmentResponseSearchResult, error) {
+	return newEnrollmentResponseSearchResult(c.Search(ctx, "EnrollmentResponse", params))
+}
+
+// Search EnrollmentResponse page by its link URL.
+func (c *Client) SearchEnrollmentResponsePage(ctx context.Context, url string) (*EnrollmentResponseSearchResult, error) {
+	return newEnrollmentResponseSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEnrollmentResponse(ctx context.Context, params Parameters, entity *models.EnrollmentResponse) (*models.EnrollmentResponse, error) {
 	resp, err := c.Create(ctx, "EnrollmentResponse", params, entity)
 	if err != nil {
@@ -7415,11 +9342,11 @@ func (c *Client) DeleteEnrollmentResponseByID(ctx context.Context, id string, pa
 // EpisodeOfCare
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEpisodeOfCares(bundle *models.Bundle) ([]*models.EpisodeOfCare, error) {
+func bundleToEpisodeOfCares(bundle *models.Bundle, decoder models.Decoder) ([]*models.EpisodeOfCare, error) {
 	var entities []*models.EpisodeOfCare
 	err := EnumBundleResources(bundle, "EpisodeOfCare", func(resource ResourceData) error {
 		var entity models.EpisodeOfCare
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "EpisodeOfCare", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7435,10 +9362,10 @@ func bundleToEpisodeOfCares(bundle *models.Bundle) ([]*models.EpisodeOfCare, err
 func fhirRespToEpisodeOfCares(resp *FhirResponse) ([]*models.EpisodeOfCare, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEpisodeOfCares(resp.Bundle)
+		return bundleToEpisodeOfCares(resp.Bundle, resp.decoder)
 	case "EpisodeOfCare":
 		var entity models.EpisodeOfCare
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.EpisodeOfCare{&entity}, nil
@@ -7477,6 +9404,36 @@ func (c *Client) GetEpisodeOfCareByID(ctx context.Context, id string, params Par
 	return fhirRespToEpisodeOfCare(id, resp)
 }
 
+// EpisodeOfCareSearchResult is the page of the EpisodeOfCare search with its metadata.
+type EpisodeOfCareSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.EpisodeOfCare
+}
+
+func newEpisodeOfCareSearchResult(result *SearchResult, err error) (*EpisodeOfCareSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EpisodeOfCareSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.EpisodeOfCare); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search EpisodeOfCare with the total, links, full URLs and scores.
+func (c *Client) SearchEpisodeOfCare(ctx context.Context, params Parameters) (*EpisodeOfCareSearchResult, error) {
+	return newEpisodeOfCareSearchResult(c.Search(ctx, "EpisodeOfCare", params))
+}
+
+// Search EpisodeOfCare page by its link URL.
+func (c *Client) SearchEpisodeOfCarePage(ctx context.Context, url string) (*EpisodeOfCareSearchResult, error) {
+	return newEpisodeOfCareSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEpisodeOfCare(ctx context.Context, params Parameters, entity *models.EpisodeOfCare) (*models.EpisodeOfCare, error) {
 	resp, err := c.Create(ctx, "EpisodeOfCare", params, entity)
 	if err != nil {
@@ -7544,11 +9501,11 @@ func (c *Client) DeleteEpisodeOfCareByID(ctx context.Context, id string, params
 // EventDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEventDefinitions(bundle *models.Bundle) ([]*models.EventDefinition, error) {
+func bundleToEventDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.EventDefinition, error) {
 	var entities []*models.EventDefinition
 	err := EnumBundleResources(bundle, "EventDefinition", func(resource ResourceData) error {
 		var entity models.EventDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "EventDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7564,10 +9521,10 @@ func bundleToEventDefinitions(bundle *models.Bundle) ([]*models.EventDefinition,
 func fhirRespToEventDefinitions(resp *FhirResponse) ([]*models.EventDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEventDefinitions(resp.Bundle)
+		return bundleToEventDefinitions(resp.Bundle, resp.decoder)
 	case "EventDefinition":
 		var entity models.EventDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.EventDefinition{&entity}, nil
@@ -7606,6 +9563,36 @@ func (c *Client) GetEventDefinitionByID(ctx context.Context, id string, params P
 	return fhirRespToEventDefinition(id, resp)
 }
 
+// EventDefinitionSearchResult is the page of the EventDefinition search with its metadata.
+type EventDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.EventDefinition
+}
+
+func newEventDefinitionSearchResult(result *SearchResult, err error) (*EventDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EventDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.EventDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search EventDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchEventDefinition(ctx context.Context, params Parameters) (*EventDefinitionSearchResult, error) {
+	return newEventDefinitionSearchResult(c.Search(ctx, "EventDefinition", params))
+}
+
+// Search EventDefinition page by its link URL.
+func (c *Client) SearchEventDefinitionPage(ctx context.Context, url string) (*EventDefinitionSearchResult, error) {
+	return newEventDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEventDefinition(ctx context.Context, params Parameters, entity *models.EventDefinition) (*models.EventDefinition, error) {
 	resp, err := c.Create(ctx, "EventDefinition", params, entity)
 	if err != nil {
@@ -7673,11 +9660,11 @@ func (c *Client) DeleteEventDefinitionByID(ctx context.Context, id string, param
 // Evidence
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEvidences(bundle *models.Bundle) ([]*models.Evidence, error) {
+func bundleToEvidences(bundle *models.Bundle, decoder models.Decoder) ([]*models.Evidence, error) {
 	var entities []*models.Evidence
 	err := EnumBundleResources(bundle, "Evidence", func(resource ResourceData) error {
 		var entity models.Evidence
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Evidence", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7693,10 +9680,10 @@ func bundleToEvidences(bundle *models.Bundle) ([]*models.Evidence, error) {
 func fhirRespToEvidences(resp *FhirResponse) ([]*models.Evidence, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEvidences(resp.Bundle)
+		return bundleToEvidences(resp.Bundle, resp.decoder)
 	case "Evidence":
 		var entity models.Evidence
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Evidence{&entity}, nil
@@ -7735,6 +9722,36 @@ func (c *Client) GetEvidenceByID(ctx context.Context, id string, params Paramete
 	return fhirRespToEvidence(id, resp)
 }
 
+// EvidenceSearchResult is the page of the Evidence search with its metadata.
+type EvidenceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Evidence
+}
+
+func newEvidenceSearchResult(result *SearchResult, err error) (*EvidenceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EvidenceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Evidence); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Evidence with the total, links, full URLs and scores.
+func (c *Client) SearchEvidence(ctx context.Context, params Parameters) (*EvidenceSearchResult, error) {
+	return newEvidenceSearchResult(c.Search(ctx, "Evidence", params))
+}
+
+// Search Evidence page by its link URL.
+func (c *Client) SearchEvidencePage(ctx context.Context, url string) (*EvidenceSearchResult, error) {
+	return newEvidenceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEvidence(ctx context.Context, params Parameters, entity *models.Evidence) (*models.Evidence, error) {
 	resp, err := c.Create(ctx, "Evidence", params, entity)
 	if err != nil {
@@ -7802,11 +9819,11 @@ func (c *Client) DeleteEvidenceByID(ctx context.Context, id string, params Param
 // EvidenceVariable
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToEvidenceVariables(bundle *models.Bundle) ([]*models.EvidenceVariable, error) {
+func bundleToEvidenceVariables(bundle *models.Bundle, decoder models.Decoder) ([]*models.EvidenceVariable, error) {
 	var entities []*models.EvidenceVariable
 	err := EnumBundleResources(bundle, "EvidenceVariable", func(resource ResourceData) error {
 		var entity models.EvidenceVariable
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "EvidenceVariable", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7822,10 +9839,10 @@ func bundleToEvidenceVariables(bundle *models.Bundle) ([]*models.EvidenceVariabl
 func fhirRespToEvidenceVariables(resp *FhirResponse) ([]*models.EvidenceVariable, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToEvidenceVariables(resp.Bundle)
+		return bundleToEvidenceVariables(resp.Bundle, resp.decoder)
 	case "EvidenceVariable":
 		var entity models.EvidenceVariable
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.EvidenceVariable{&entity}, nil
@@ -7864,6 +9881,36 @@ func (c *Client) GetEvidenceVariableByID(ctx context.Context, id string, params
 	return fhirRespToEvidenceVariable(id, resp)
 }
 
+// EvidenceVariableSearchResult is the page of the EvidenceVariable search with its metadata.
+type EvidenceVariableSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.EvidenceVariable
+}
+
+func newEvidenceVariableSearchResult(result *SearchResult, err error) (*EvidenceVariableSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &EvidenceVariableSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.EvidenceVariable); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search EvidenceVariable with the total, links, full URLs and scores.
+func (c *Client) SearchEvidenceVariable(ctx context.Context, params Parameters) (*EvidenceVariableSearchResult, error) {
+	return newEvidenceVariableSearchResult(c.Search(ctx, "EvidenceVariable", params))
+}
+
+// Search EvidenceVariable page by its link URL.
+func (c *Client) SearchEvidenceVariablePage(ctx context.Context, url string) (*EvidenceVariableSearchResult, error) {
+	return newEvidenceVariableSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateEvidenceVariable(ctx context.Context, params Parameters, entity *models.EvidenceVariable) (*models.EvidenceVariable, error) {
 	resp, err := c.Create(ctx, "EvidenceVariable", params, entity)
 	if err != nil {
@@ -7931,11 +9978,11 @@ func (c *Client) DeleteEvidenceVariableByID(ctx context.Context, id string, para
 // ExampleScenario
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToExampleScenarios(bundle *models.Bundle) ([]*models.ExampleScenario, error) {
+func bundleToExampleScenarios(bundle *models.Bundle, decoder models.Decoder) ([]*models.ExampleScenario, error) {
 	var entities []*models.ExampleScenario
 	err := EnumBundleResources(bundle, "ExampleScenario", func(resource ResourceData) error {
 		var entity models.ExampleScenario
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ExampleScenario", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -7951,10 +9998,10 @@ func bundleToExampleScenarios(bundle *models.Bundle) ([]*models.ExampleScenario,
 func fhirRespToExampleScenarios(resp *FhirResponse) ([]*models.ExampleScenario, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToExampleScenarios(resp.Bundle)
+		return bundleToExampleScenarios(resp.Bundle, resp.decoder)
 	case "ExampleScenario":
 		var entity models.ExampleScenario
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ExampleScenario{&entity}, nil
@@ -7993,6 +10040,36 @@ func (c *Client) GetExampleScenarioByID(ctx context.Context, id string, params P
 	return fhirRespToExampleScenario(id, resp)
 }
 
+// ExampleScenarioSearchResult is the page of the ExampleScenario search with its metadata.
+type ExampleScenarioSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ExampleScenario
+}
+
+func newExampleScenarioSearchResult(result *SearchResult, err error) (*ExampleScenarioSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ExampleScenarioSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ExampleScenario); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ExampleScenario with the total, links, full URLs and scores.
+func (c *Client) SearchExampleScenario(ctx context.Context, params Parameters) (*ExampleScenarioSearchResult, error) {
+	return newExampleScenarioSearchResult(c.Search(ctx, "ExampleScenario", params))
+}
+
+// Search ExampleScenario page by its link URL.
+func (c *Client) SearchExampleScenarioPage(ctx context.Context, url string) (*ExampleScenarioSearchResult, error) {
+	return newExampleScenarioSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateExampleScenario(ctx context.Context, params Parameters, entity *models.ExampleScenario) (*models.ExampleScenario, error) {
 	resp, err := c.Create(ctx, "ExampleScenario", params, entity)
 	if err != nil {
@@ -8060,11 +10137,11 @@ func (c *Client) DeleteExampleScenarioByID(ctx context.Context, id string, param
 // ExplanationOfBenefit
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToExplanationOfBenefits(bundle *models.Bundle) ([]*models.ExplanationOfBenefit, error) {
+func bundleToExplanationOfBenefits(bundle *models.Bundle, decoder models.Decoder) ([]*models.ExplanationOfBenefit, error) {
 	var entities []*models.ExplanationOfBenefit
 	err := EnumBundleResources(bundle, "ExplanationOfBenefit", func(resource ResourceData) error {
 		var entity models.ExplanationOfBenefit
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ExplanationOfBenefit", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8080,10 +10157,10 @@ func bundleToExplanationOfBenefits(bundle *models.Bundle) ([]*models.Explanation
 func fhirRespToExplanationOfBenefits(resp *FhirResponse) ([]*models.ExplanationOfBenefit, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToExplanationOfBenefits(resp.Bundle)
+		return bundleToExplanationOfBenefits(resp.Bundle, resp.decoder)
 	case "ExplanationOfBenefit":
 		var entity models.ExplanationOfBenefit
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ExplanationOfBenefit{&entity}, nil
@@ -8122,6 +10199,36 @@ func (c *Client) GetExplanationOfBenefitByID(ctx context.Context, id string, par
 	return fhirRespToExplanationOfBenefit(id, resp)
 }
 
+// ExplanationOfBenefitSearchResult is the page of the ExplanationOfBenefit search with its metadata.
+type ExplanationOfBenefitSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ExplanationOfBenefit
+}
+
+func newExplanationOfBenefitSearchResult(result *SearchResult, err error) (*ExplanationOfBenefitSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ExplanationOfBenefitSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ExplanationOfBenefit); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ExplanationOfBenefit with the total, links, full URLs and scores.
+func (c *Client) SearchExplanationOfBenefit(ctx context.Context, params Parameters) (*ExplanationOfBenefitSearchResult, error) {
+	return newExplanationOfBenefitSearchResult(c.Search(ctx, "ExplanationOfBenefit", params))
+}
+
+// Search ExplanationOfBenefit page by its link URL.
+func (c *Client) SearchExplanationOfBenefitPage(ctx context.Context, url string) (*ExplanationOfBenefitSearchResult, error) {
+	return newExplanationOfBenefitSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateExplanationOfBenefit(ctx context.Context, params Parameters, entity *models.ExplanationOfBenefit) (*models.ExplanationOfBenefit, error) {
 	resp, err := c.Create(ctx, "ExplanationOfBenefit", params, entity)
 	if err != nil {
@@ -8189,11 +10296,11 @@ func (c *Client) DeleteExplanationOfBenefitByID(ctx context.Context, id string,
 // FamilyMemberHistory
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToFamilyMemberHistorys(bundle *models.Bundle) ([]*models.FamilyMemberHistory, error) {
+func bundleToFamilyMemberHistorys(bundle *models.Bundle, decoder models.Decoder) ([]*models.FamilyMemberHistory, error) {
 	var entities []*models.FamilyMemberHistory
 	err := EnumBundleResources(bundle, "FamilyMemberHistory", func(resource ResourceData) error {
 		var entity models.FamilyMemberHistory
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "FamilyMemberHistory", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8209,10 +10316,10 @@ func bundleToFamilyMemberHistorys(bundle *models.Bundle) ([]*models.FamilyMember
 func fhirRespToFamilyMemberHistorys(resp *FhirResponse) ([]*models.FamilyMemberHistory, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToFamilyMemberHistorys(resp.Bundle)
+		return bundleToFamilyMemberHistorys(resp.Bundle, resp.decoder)
 	case "FamilyMemberHistory":
 		var entity models.FamilyMemberHistory
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.FamilyMemberHistory{&entity}, nil
@@ -8251,6 +10358,36 @@ func (c *Client) GetFamilyMemberHistoryByID(ctx context.Context, id string, para
 	return fhirRespToFamilyMemberHistory(id, resp)
 }
 
+// FamilyMemberHistorySearchResult is the page of the FamilyMemberHistory search with its metadata.
+type FamilyMemberHistorySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.FamilyMemberHistory
+}
+
+func newFamilyMemberHistorySearchResult(result *SearchResult, err error) (*FamilyMemberHistorySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &FamilyMemberHistorySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.FamilyMemberHistory); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search FamilyMemberHistory with the total, links, full URLs and scores.
+func (c *Client) SearchFamilyMemberHistory(ctx context.Context, params Parameters) (*FamilyMemberHistorySearchResult, error) {
+	return newFamilyMemberHistorySearchResult(c.Search(ctx, "FamilyMemberHistory", params))
+}
+
+// Search FamilyMemberHistory page by its link URL.
+func (c *Client) SearchFamilyMemberHistoryPage(ctx context.Context, url string) (*FamilyMemberHistorySearchResult, error) {
+	return newFamilyMemberHistorySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateFamilyMemberHistory(ctx context.Context, params Parameters, entity *models.FamilyMemberHistory) (*models.FamilyMemberHistory, error) {
 	resp, err := c.Create(ctx, "FamilyMemberHistory", params, entity)
 	if err != nil {
@@ -8318,11 +10455,11 @@ func (c *Client) DeleteFamilyMemberHistoryByID(ctx context.Context, id string, p
 // Flag
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToFlags(bundle *models.Bundle) ([]*models.Flag, error) {
+func bundleToFlags(bundle *models.Bundle, decoder models.Decoder) ([]*models.Flag, error) {
 	var entities []*models.Flag
 	err := EnumBundleResources(bundle, "Flag", func(resource ResourceData) error {
 		var entity models.Flag
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Flag", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8338,10 +10475,10 @@ func bundleToFlags(bundle *models.Bundle) ([]*models.Flag, error) {
 func fhirRespToFlags(resp *FhirResponse) ([]*models.Flag, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToFlags(resp.Bundle)
+		return bundleToFlags(resp.Bundle, resp.decoder)
 	case "Flag":
 		var entity models.Flag
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Flag{&entity}, nil
@@ -8380,6 +10517,36 @@ func (c *Client) GetFlagByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToFlag(id, resp)
 }
 
+// FlagSearchResult is the page of the Flag search with its metadata.
+type FlagSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Flag
+}
+
+func newFlagSearchResult(result *SearchResult, err error) (*FlagSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &FlagSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Flag); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Flag with the total, links, full URLs and scores.
+func (c *Client) SearchFlag(ctx context.Context, params Parameters) (*FlagSearchResult, error) {
+	return newFlagSearchResult(c.Search(ctx, "Flag", params))
+}
+
+// Search Flag page by its link URL.
+func (c *Client) SearchFlagPage(ctx context.Context, url string) (*FlagSearchResult, error) {
+	return newFlagSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateFlag(ctx context.Context, params Parameters, entity *models.Flag) (*models.Flag, error) {
 	resp, err := c.Create(ctx, "Flag", params, entity)
 	if err != nil {
@@ -8447,11 +10614,11 @@ func (c *Client) DeleteFlagByID(ctx context.Context, id string, params Parameter
 // Goal
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToGoals(bundle *models.Bundle) ([]*models.Goal, error) {
+func bundleToGoals(bundle *models.Bundle, decoder models.Decoder) ([]*models.Goal, error) {
 	var entities []*models.Goal
 	err := EnumBundleResources(bundle, "Goal", func(resource ResourceData) error {
 		var entity models.Goal
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Goal", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8467,10 +10634,10 @@ func bundleToGoals(bundle *models.Bundle) ([]*models.Goal, error) {
 func fhirRespToGoals(resp *FhirResponse) ([]*models.Goal, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToGoals(resp.Bundle)
+		return bundleToGoals(resp.Bundle, resp.decoder)
 	case "Goal":
 		var entity models.Goal
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Goal{&entity}, nil
@@ -8509,6 +10676,36 @@ func (c *Client) GetGoalByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToGoal(id, resp)
 }
 
+// GoalSearchResult is the page of the Goal search with its metadata.
+type GoalSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Goal
+}
+
+func newGoalSearchResult(result *SearchResult, err error) (*GoalSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &GoalSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Goal); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Goal with the total, links, full URLs and scores.
+func (c *Client) SearchGoal(ctx context.Context, params Parameters) (*GoalSearchResult, error) {
+	return newGoalSearchResult(c.Search(ctx, "Goal", params))
+}
+
+// Search Goal page by its link URL.
+func (c *Client) SearchGoalPage(ctx context.Context, url string) (*GoalSearchResult, error) {
+	return newGoalSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateGoal(ctx context.Context, params Parameters, entity *models.Goal) (*models.Goal, error) {
 	resp, err := c.Create(ctx, "Goal", params, entity)
 	if err != nil {
@@ -8576,11 +10773,11 @@ func (c *Client) DeleteGoalByID(ctx context.Context, id string, params Parameter
 // GraphDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToGraphDefinitions(bundle *models.Bundle) ([]*models.GraphDefinition, error) {
+func bundleToGraphDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.GraphDefinition, error) {
 	var entities []*models.GraphDefinition
 	err := EnumBundleResources(bundle, "GraphDefinition", func(resource ResourceData) error {
 		var entity models.GraphDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "GraphDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8596,10 +10793,10 @@ func bundleToGraphDefinitions(bundle *models.Bundle) ([]*models.GraphDefinition,
 func fhirRespToGraphDefinitions(resp *FhirResponse) ([]*models.GraphDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToGraphDefinitions(resp.Bundle)
+		return bundleToGraphDefinitions(resp.Bundle, resp.decoder)
 	case "GraphDefinition":
 		var entity models.GraphDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.GraphDefinition{&entity}, nil
@@ -8638,6 +10835,36 @@ func (c *Client) GetGraphDefinitionByID(ctx context.Context, id string, params P
 	return fhirRespToGraphDefinition(id, resp)
 }
 
+// GraphDefinitionSearchResult is the page of the GraphDefinition search with its metadata.
+type GraphDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.GraphDefinition
+}
+
+func newGraphDefinitionSearchResult(result *SearchResult, err error) (*GraphDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &GraphDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.GraphDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search GraphDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchGraphDefinition(ctx context.Context, params Parameters) (*GraphDefinitionSearchResult, error) {
+	return newGraphDefinitionSearchResult(c.Search(ctx, "GraphDefinition", params))
+}
+
+// Search GraphDefinition page by its link URL.
+func (c *Client) SearchGraphDefinitionPage(ctx context.Context, url string) (*GraphDefinitionSearchResult, error) {
+	return newGraphDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateGraphDefinition(ctx context.Context, params Parameters, entity *models.GraphDefinition) (*models.GraphDefinition, error) {
 	resp, err := c.Create(ctx, "GraphDefinition", params, entity)
 	if err != nil {
@@ -8705,11 +10932,11 @@ func (c *Client) DeleteGraphDefinitionByID(ctx context.Context, id string, param
 // Group
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToGroups(bundle *models.Bundle) ([]*models.Group, error) {
+func bundleToGroups(bundle *models.Bundle, decoder models.Decoder) ([]*models.Group, error) {
 	var entities []*models.Group
 	err := EnumBundleResources(bundle, "Group", func(resource ResourceData) error {
 		var entity models.Group
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Group", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8725,10 +10952,10 @@ func bundleToGroups(bundle *models.Bundle) ([]*models.Group, error) {
 func fhirRespToGroups(resp *FhirResponse) ([]*models.Group, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToGroups(resp.Bundle)
+		return bundleToGroups(resp.Bundle, resp.decoder)
 	case "Group":
 		var entity models.Group
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Group{&entity}, nil
@@ -8767,16 +10994,46 @@ func (c *Client) GetGroupByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToGroup(id, resp)
 }
 
-func (c *Client) CreateGroup(ctx context.Context, params Parameters, entity *models.Group) (*models.Group, error) {
-	resp, err := c.Create(ctx, "Group", params, entity)
+// GroupSearchResult is the page of the Group search with its metadata.
+type GroupSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Group
+}
+
+func newGroupSearchResult(result *SearchResult, err error) (*GroupSearchResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	typed := &GroupSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Group); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
 
-	return fhirRespToGroup(StrPtrToStr(entity.ID), resp)
+// Search Group with the total, links, full URLs and scores.
+func (c *Client) SearchGroup(ctx context.Context, params Parameters) (*GroupSearchResult, error) {
+	return newGroupSearchResult(c.Search(ctx, "Group", params))
 }
 
-func (c *Client) UpdateGroup(ctx context.Context, params Parameters, entity *models.Group) (*models.Group, error) {
+// Search Group page by its link URL.
+func (c *Client) SearchGroupPage(ctx context.Context, url string) (*GroupSearchResult, error) {
+	return newGroupSearchResult(c.SearchPage(ctx, url))
+}
+
+func (c *Client) CreateGroup(ctx context.Context, params Parameters, entity *models.Group) (*models.Group, error) {
+	resp, err := c.Create(ctx, "Group", params, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToGroup(StrPtrToStr(entity.ID), resp)
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, params Parameters, entity *models.Group) (*models.Group, error) {
 	resp, err := c.Update(ctx, "Group", params, entity)
 	if err != nil {
 		return nil, err
@@ -8834,11 +11091,11 @@ func (c *Client) DeleteGroupByID(ctx context.Context, id string, params Paramete
 // GuidanceResponse
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToGuidanceResponses(bundle *models.Bundle) ([]*models.GuidanceResponse, error) {
+func bundleToGuidanceResponses(bundle *models.Bundle, decoder models.Decoder) ([]*models.GuidanceResponse, error) {
 	var entities []*models.GuidanceResponse
 	err := EnumBundleResources(bundle, "GuidanceResponse", func(resource ResourceData) error {
 		var entity models.GuidanceResponse
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "GuidanceResponse", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8854,10 +11111,10 @@ func bundleToGuidanceResponses(bundle *models.Bundle) ([]*models.GuidanceRespons
 func fhirRespToGuidanceResponses(resp *FhirResponse) ([]*models.GuidanceResponse, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToGuidanceResponses(resp.Bundle)
+		return bundleToGuidanceResponses(resp.Bundle, resp.decoder)
 	case "GuidanceResponse":
 		var entity models.GuidanceResponse
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.GuidanceResponse{&entity}, nil
@@ -8896,6 +11153,36 @@ func (c *Client) GetGuidanceResponseByID(ctx context.Context, id string, params
 	return fhirRespToGuidanceResponse(id, resp)
 }
 
+// GuidanceResponseSearchResult is the page of the GuidanceResponse search with its metadata.
+type GuidanceResponseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.GuidanceResponse
+}
+
+func newGuidanceResponseSearchResult(result *SearchResult, err error) (*GuidanceResponseSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &GuidanceResponseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.GuidanceResponse); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search GuidanceResponse with the total, links, full URLs and scores.
+func (c *Client) SearchGuidanceResponse(ctx context.Context, params Parameters) (*GuidanceResponseSearchResult, error) {
+	return newGuidanceResponseSearchResult(c.Search(ctx, "GuidanceResponse", params))
+}
+
+// Search GuidanceResponse page by its link URL.
+func (c *Client) SearchGuidanceResponsePage(ctx context.Context, url string) (*GuidanceResponseSearchResult, error) {
+	return newGuidanceResponseSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateGuidanceResponse(ctx context.Context, params Parameters, entity *models.GuidanceResponse) (*models.GuidanceResponse, error) {
 	resp, err := c.Create(ctx, "GuidanceResponse", params, entity)
 	if err != nil {
@@ -8963,11 +11250,11 @@ func (c *Client) DeleteGuidanceResponseByID(ctx context.Context, id string, para
 // HealthcareService
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToHealthcareServices(bundle *models.Bundle) ([]*models.HealthcareService, error) {
+func bundleToHealthcareServices(bundle *models.Bundle, decoder models.Decoder) ([]*models.HealthcareService, error) {
 	var entities []*models.HealthcareService
 	err := EnumBundleResources(bundle, "HealthcareService", func(resource ResourceData) error {
 		var entity models.HealthcareService
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "HealthcareService", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -8983,10 +11270,10 @@ func bundleToHealthcareServices(bundle *models.Bundle) ([]*models.HealthcareServ
 func fhirRespToHealthcareServices(resp *FhirResponse) ([]*models.HealthcareService, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToHealthcareServices(resp.Bundle)
+		return bundleToHealthcareServices(resp.Bundle, resp.decoder)
 	case "HealthcareService":
 		var entity models.HealthcareService
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.HealthcareService{&entity}, nil
@@ -9025,6 +11312,36 @@ func (c *Client) GetHealthcareServiceByID(ctx context.Context, id string, params
 	return fhirRespToHealthcareService(id, resp)
 }
 
+// HealthcareServiceSearchResult is the page of the HealthcareService search with its metadata.
+type HealthcareServiceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.HealthcareService
+}
+
+func newHealthcareServiceSearchResult(result *SearchResult, err error) (*HealthcareServiceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &HealthcareServiceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.HealthcareService); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search HealthcareService with the total, links, full URLs and scores.
+func (c *Client) SearchHealthcareService(ctx context.Context, params Parameters) (*HealthcareServiceSearchResult, error) {
+	return newHealthcareServiceSearchResult(c.Search(ctx, "HealthcareService", params))
+}
+
+// Search HealthcareService page by its link URL.
+func (c *Client) SearchHealthcareServicePage(ctx context.Context, url string) (*HealthcareServiceSearchResult, error) {
+	return newHealthcareServiceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateHealthcareService(ctx context.Context, params Parameters, entity *models.HealthcareService) (*models.HealthcareService, error) {
 	resp, err := c.Create(ctx, "HealthcareService", params, entity)
 	if err != nil {
@@ -9092,11 +11409,11 @@ func (c *Client) DeleteHealthcareServiceByID(ctx context.Context, id string, par
 // ImagingStudy
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToImagingStudys(bundle *models.Bundle) ([]*models.ImagingStudy, error) {
+func bundleToImagingStudys(bundle *models.Bundle, decoder models.Decoder) ([]*models.ImagingStudy, error) {
 	var entities []*models.ImagingStudy
 	err := EnumBundleResources(bundle, "ImagingStudy", func(resource ResourceData) error {
 		var entity models.ImagingStudy
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ImagingStudy", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9112,10 +11429,10 @@ func bundleToImagingStudys(bundle *models.Bundle) ([]*models.ImagingStudy, error
 func fhirRespToImagingStudys(resp *FhirResponse) ([]*models.ImagingStudy, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToImagingStudys(resp.Bundle)
+		return bundleToImagingStudys(resp.Bundle, resp.decoder)
 	case "ImagingStudy":
 		var entity models.ImagingStudy
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ImagingStudy{&entity}, nil
@@ -9154,6 +11471,36 @@ func (c *Client) GetImagingStudyByID(ctx context.Context, id string, params Para
 	return fhirRespToImagingStudy(id, resp)
 }
 
+// ImagingStudySearchResult is the page of the ImagingStudy search with its metadata.
+type ImagingStudySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ImagingStudy
+}
+
+func newImagingStudySearchResult(result *SearchResult, err error) (*ImagingStudySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ImagingStudySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ImagingStudy); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ImagingStudy with the total, links, full URLs and scores.
+func (c *Client) SearchImagingStudy(ctx context.Context, params Parameters) (*ImagingStudySearchResult, error) {
+	return newImagingStudySearchResult(c.Search(ctx, "ImagingStudy", params))
+}
+
+// Search ImagingStudy page by its link URL.
+func (c *Client) SearchImagingStudyPage(ctx context.Context, url string) (*ImagingStudySearchResult, error) {
+	return newImagingStudySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateImagingStudy(ctx context.Context, params Parameters, entity *models.ImagingStudy) (*models.ImagingStudy, error) {
 	resp, err := c.Create(ctx, "ImagingStudy", params, entity)
 	if err != nil {
@@ -9221,11 +11568,11 @@ func (c *Client) DeleteImagingStudyByID(ctx context.Context, id string, params P
 // Immunization
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToImmunizations(bundle *models.Bundle) ([]*models.Immunization, error) {
+func bundleToImmunizations(bundle *models.Bundle, decoder models.Decoder) ([]*models.Immunization, error) {
 	var entities []*models.Immunization
 	err := EnumBundleResources(bundle, "Immunization", func(resource ResourceData) error {
 		var entity models.Immunization
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Immunization", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9241,10 +11588,10 @@ func bundleToImmunizations(bundle *models.Bundle) ([]*models.Immunization, error
 func fhirRespToImmunizations(resp *FhirResponse) ([]*models.Immunization, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToImmunizations(resp.Bundle)
+		return bundleToImmunizations(resp.Bundle, resp.decoder)
 	case "Immunization":
 		var entity models.Immunization
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Immunization{&entity}, nil
@@ -9283,6 +11630,36 @@ func (c *Client) GetImmunizationByID(ctx context.Context, id string, params Para
 	return fhirRespToImmunization(id, resp)
 }
 
+// ImmunizationSearchResult is the page of the Immunization search with its metadata.
+type ImmunizationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Immunization
+}
+
+func newImmunizationSearchResult(result *SearchResult, err error) (*ImmunizationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ImmunizationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Immunization); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Immunization with the total, links, full URLs and scores.
+func (c *Client) SearchImmunization(ctx context.Context, params Parameters) (*ImmunizationSearchResult, error) {
+	return newImmunizationSearchResult(c.Search(ctx, "Immunization", params))
+}
+
+// Search Immunization page by its link URL.
+func (c *Client) SearchImmunizationPage(ctx context.Context, url string) (*ImmunizationSearchResult, error) {
+	return newImmunizationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateImmunization(ctx context.Context, params Parameters, entity *models.Immunization) (*models.Immunization, error) {
 	resp, err := c.Create(ctx, "Immunization", params, entity)
 	if err != nil {
@@ -9350,11 +11727,11 @@ func (c *Client) DeleteImmunizationByID(ctx context.Context, id string, params P
 // ImmunizationEvaluation
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToImmunizationEvaluations(bundle *models.Bundle) ([]*models.ImmunizationEvaluation, error) {
+func bundleToImmunizationEvaluations(bundle *models.Bundle, decoder models.Decoder) ([]*models.ImmunizationEvaluation, error) {
 	var entities []*models.ImmunizationEvaluation
 	err := EnumBundleResources(bundle, "ImmunizationEvaluation", func(resource ResourceData) error {
 		var entity models.ImmunizationEvaluation
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ImmunizationEvaluation", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9370,10 +11747,10 @@ func bundleToImmunizationEvaluations(bundle *models.Bundle) ([]*models.Immunizat
 func fhirRespToImmunizationEvaluations(resp *FhirResponse) ([]*models.ImmunizationEvaluation, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToImmunizationEvaluations(resp.Bundle)
+		return bundleToImmunizationEvaluations(resp.Bundle, resp.decoder)
 	case "ImmunizationEvaluation":
 		var entity models.ImmunizationEvaluation
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ImmunizationEvaluation{&entity}, nil
@@ -9412,6 +11789,36 @@ func (c *Client) GetImmunizationEvaluationByID(ctx context.Context, id string, p
 	return fhirRespToImmunizationEvaluation(id, resp)
 }
 
+// ImmunizationEvaluationSearchResult is the page of the ImmunizationEvaluation search with its metadata.
+type ImmunizationEvaluationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ImmunizationEvaluation
+}
+
+func newImmunizationEvaluationSearchResult(result *SearchResult, err error) (*ImmunizationEvaluationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ImmunizationEvaluationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ImmunizationEvaluation); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ImmunizationEvaluation with the total, links, full URLs and scores.
+func (c *Client) SearchImmunizationEvaluation(ctx context.Context, params Parameters) (*ImmunizationEvaluationSearchResult, error) {
+	return newImmunizationEvaluationSearchResult(c.Search(ctx, "ImmunizationEvaluation", params))
+}
+
+// Search ImmunizationEvaluation page by its link URL.
+func (c *Client) SearchImmunizationEvaluationPage(ctx context.Context, url string) (*ImmunizationEvaluationSearchResult, error) {
+	return newImmunizationEvaluationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateImmunizationEvaluation(ctx context.Context, params Parameters, entity *models.ImmunizationEvaluation) (*models.ImmunizationEvaluation, error) {
 	resp, err := c.Create(ctx, "ImmunizationEvaluation", params, entity)
 	if err != nil {
@@ -9479,11 +11886,11 @@ func (c *Client) DeleteImmunizationEvaluationByID(ctx context.Context, id string
 // ImmunizationRecommendation
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToImmunizationRecommendations(bundle *models.Bundle) ([]*models.ImmunizationRecommendation, error) {
+func bundleToImmunizationRecommendations(bundle *models.Bundle, decoder models.Decoder) ([]*models.ImmunizationRecommendation, error) {
 	var entities []*models.ImmunizationRecommendation
 	err := EnumBundleResources(bundle, "ImmunizationRecommendation", func(resource ResourceData) error {
 		var entity models.ImmunizationRecommendation
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ImmunizationRecommendation", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9499,10 +11906,10 @@ func bundleToImmunizationRecommendations(bundle *models.Bundle) ([]*models.Immun
 func fhirRespToImmunizationRecommendations(resp *FhirResponse) ([]*models.ImmunizationRecommendation, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToImmunizationRecommendations(resp.Bundle)
+		return bundleToImmunizationRecommendations(resp.Bundle, resp.decoder)
 	case "ImmunizationRecommendation":
 		var entity models.ImmunizationRecommendation
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ImmunizationRecommendation{&entity}, nil
@@ -9541,6 +11948,36 @@ func (c *Client) GetImmunizationRecommendationByID(ctx context.Context, id strin
 	return fhirRespToImmunizationRecommendation(id, resp)
 }
 
+// ImmunizationRecommendationSearchResult is the page of the ImmunizationRecommendation search with its metadata.
+type ImmunizationRecommendationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ImmunizationRecommendation
+}
+
+func newImmunizationRecommendationSearchResult(result *SearchResult, err error) (*ImmunizationRecommendationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ImmunizationRecommendationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ImmunizationRecommendation); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ImmunizationRecommendation with the total, links, full URLs and scores.
+func (c *Client) SearchImmunizationRecommendation(ctx context.Context, params Parameters) (*ImmunizationRecommendationSearchResult, error) {
+	return newImmunizationRecommendationSearchResult(c.Search(ctx, "ImmunizationRecommendation", params))
+}
+
+// Search ImmunizationRecommendation page by its link URL.
+func (c *Client) SearchImmunizationRecommendationPage(ctx context.Context, url string) (*ImmunizationRecommendationSearchResult, error) {
+	return newImmunizationRecommendationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateImmunizationRecommendation(ctx context.Context, params Parameters, entity *models.ImmunizationRecommendation) (*models.ImmunizationRecommendation, error) {
 	resp, err := c.Create(ctx, "ImmunizationRecommendation", params, entity)
 	if err != nil {
@@ -9608,11 +12045,11 @@ func (c *Client) DeleteImmunizationRecommendationByID(ctx context.Context, id st
 // ImplementationGuide
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToImplementationGuides(bundle *models.Bundle) ([]*models.ImplementationGuide, error) {
+func bundleToImplementationGuides(bundle *models.Bundle, decoder models.Decoder) ([]*models.ImplementationGuide, error) {
 	var entities []*models.ImplementationGuide
 	err := EnumBundleResources(bundle, "ImplementationGuide", func(resource ResourceData) error {
 		var entity models.ImplementationGuide
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ImplementationGuide", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9628,10 +12065,10 @@ func bundleToImplementationGuides(bundle *models.Bundle) ([]*models.Implementati
 func fhirRespToImplementationGuides(resp *FhirResponse) ([]*models.ImplementationGuide, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToImplementationGuides(resp.Bundle)
+		return bundleToImplementationGuides(resp.Bundle, resp.decoder)
 	case "ImplementationGuide":
 		var entity models.ImplementationGuide
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ImplementationGuide{&entity}, nil
@@ -9670,6 +12107,36 @@ func (c *Client) GetImplementationGuideByID(ctx context.Context, id string, para
 	return fhirRespToImplementationGuide(id, resp)
 }
 
+// ImplementationGuideSearchResult is the page of the ImplementationGuide search with its metadata.
+type ImplementationGuideSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ImplementationGuide
+}
+
+func newImplementationGuideSearchResult(result *SearchResult, err error) (*ImplementationGuideSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ImplementationGuideSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ImplementationGuide); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ImplementationGuide with the total, links, full URLs and scores.
+func (c *Client) SearchImplementationGuide(ctx context.Context, params Parameters) (*ImplementationGuideSearchResult, error) {
+	return newImplementationGuideSearchResult(c.Search(ctx, "ImplementationGuide", params))
+}
+
+// Search ImplementationGuide page by its link URL.
+func (c *Client) SearchImplementationGuidePage(ctx context.Context, url string) (*ImplementationGuideSearchResult, error) {
+	return newImplementationGuideSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateImplementationGuide(ctx context.Context, params Parameters, entity *models.ImplementationGuide) (*models.ImplementationGuide, error) {
 	resp, err := c.Create(ctx, "ImplementationGuide", params, entity)
 	if err != nil {
@@ -9737,11 +12204,11 @@ func (c *Client) DeleteImplementationGuideByID(ctx context.Context, id string, p
 // InsurancePlan
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToInsurancePlans(bundle *models.Bundle) ([]*models.InsurancePlan, error) {
+func bundleToInsurancePlans(bundle *models.Bundle, decoder models.Decoder) ([]*models.InsurancePlan, error) {
 	var entities []*models.InsurancePlan
 	err := EnumBundleResources(bundle, "InsurancePlan", func(resource ResourceData) error {
 		var entity models.InsurancePlan
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "InsurancePlan", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9757,10 +12224,10 @@ func bundleToInsurancePlans(bundle *models.Bundle) ([]*models.InsurancePlan, err
 func fhirRespToInsurancePlans(resp *FhirResponse) ([]*models.InsurancePlan, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToInsurancePlans(resp.Bundle)
+		return bundleToInsurancePlans(resp.Bundle, resp.decoder)
 	case "InsurancePlan":
 		var entity models.InsurancePlan
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.InsurancePlan{&entity}, nil
@@ -9799,6 +12266,36 @@ func (c *Client) GetInsurancePlanByID(ctx context.Context, id string, params Par
 	return fhirRespToInsurancePlan(id, resp)
 }
 
+// InsurancePlanSearchResult is the page of the InsurancePlan search with its metadata.
+type InsurancePlanSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.InsurancePlan
+}
+
+func newInsurancePlanSearchResult(result *SearchResult, err error) (*InsurancePlanSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &InsurancePlanSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.InsurancePlan); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search InsurancePlan with the total, links, full URLs and scores.
+func (c *Client) SearchInsurancePlan(ctx context.Context, params Parameters) (*InsurancePlanSearchResult, error) {
+	return newInsurancePlanSearchResult(c.Search(ctx, "InsurancePlan", params))
+}
+
+// Search InsurancePlan page by its link URL.
+func (c *Client) SearchInsurancePlanPage(ctx context.Context, url string) (*InsurancePlanSearchResult, error) {
+	return newInsurancePlanSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateInsurancePlan(ctx context.Context, params Parameters, entity *models.InsurancePlan) (*models.InsurancePlan, error) {
 	resp, err := c.Create(ctx, "InsurancePlan", params, entity)
 	if err != nil {
@@ -9866,11 +12363,11 @@ func (c *Client) DeleteInsurancePlanByID(ctx context.Context, id string, params
 // Invoice
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToInvoices(bundle *models.Bundle) ([]*models.Invoice, error) {
+func bundleToInvoices(bundle *models.Bundle, decoder models.Decoder) ([]*models.Invoice, error) {
 	var entities []*models.Invoice
 	err := EnumBundleResources(bundle, "Invoice", func(resource ResourceData) error {
 		var entity models.Invoice
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Invoice", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -9886,10 +12383,10 @@ func bundleToInvoices(bundle *models.Bundle) ([]*models.Invoice, error) {
 func fhirRespToInvoices(resp *FhirResponse) ([]*models.Invoice, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToInvoices(resp.Bundle)
+		return bundleToInvoices(resp.Bundle, resp.decoder)
 	case "Invoice":
 		var entity models.Invoice
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Invoice{&entity}, nil
@@ -9928,6 +12425,36 @@ func (c *Client) GetInvoiceByID(ctx context.Context, id string, params Parameter
 	return fhirRespToInvoice(id, resp)
 }
 
+// InvoiceSearchResult is the page of the Invoice search with its metadata.
+type InvoiceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Invoice
+}
+
+func newInvoiceSearchResult(result *SearchResult, err error) (*InvoiceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &InvoiceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Invoice); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Invoice with the total, links, full URLs and scores.
+func (c *Client) SearchInvoice(ctx context.Context, params Parameters) (*InvoiceSearchResult, error) {
+	return newInvoiceSearchResult(c.Search(ctx, "Invoice", params))
+}
+
+// Search Invoice page by its link URL.
+func (c *Client) SearchInvoicePage(ctx context.Context, url string) (*InvoiceSearchResult, error) {
+	return newInvoiceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateInvoice(ctx context.Context, params Parameters, entity *models.Invoice) (*models.Invoice, error) {
 	resp, err := c.Create(ctx, "Invoice", params, entity)
 	if err != nil {
@@ -9995,11 +12522,11 @@ func (c *Client) DeleteInvoiceByID(ctx context.Context, id string, params Parame
 // Library
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToLibrarys(bundle *models.Bundle) ([]*models.Library, error) {
+func bundleToLibrarys(bundle *models.Bundle, decoder models.Decoder) ([]*models.Library, error) {
 	var entities []*models.Library
 	err := EnumBundleResources(bundle, "Library", func(resource ResourceData) error {
 		var entity models.Library
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Library", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10015,10 +12542,10 @@ func bundleToLibrarys(bundle *models.Bundle) ([]*models.Library, error) {
 func fhirRespToLibrarys(resp *FhirResponse) ([]*models.Library, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToLibrarys(resp.Bundle)
+		return bundleToLibrarys(resp.Bundle, resp.decoder)
 	case "Library":
 		var entity models.Library
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Library{&entity}, nil
@@ -10057,6 +12584,36 @@ func (c *Client) GetLibraryByID(ctx context.Context, id string, params Parameter
 	return fhirRespToLibrary(id, resp)
 }
 
+// LibrarySearchResult is the page of the Library search with its metadata.
+type LibrarySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Library
+}
+
+func newLibrarySearchResult(result *SearchResult, err error) (*LibrarySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &LibrarySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Library); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Library with the total, links, full URLs and scores.
+func (c *Client) SearchLibrary(ctx context.Context, params Parameters) (*LibrarySearchResult, error) {
+	return newLibrarySearchResult(c.Search(ctx, "Library", params))
+}
+
+// Search Library page by its link URL.
+func (c *Client) SearchLibraryPage(ctx context.Context, url string) (*LibrarySearchResult, error) {
+	return newLibrarySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateLibrary(ctx context.Context, params Parameters, entity *models.Library) (*models.Library, error) {
 	resp, err := c.Create(ctx, "Library", params, entity)
 	if err != nil {
@@ -10124,11 +12681,11 @@ func (c *Client) DeleteLibraryByID(ctx context.Context, id string, params Parame
 // Linkage
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToLinkages(bundle *models.Bundle) ([]*models.Linkage, error) {
+func bundleToLinkages(bundle *models.Bundle, decoder models.Decoder) ([]*models.Linkage, error) {
 	var entities []*models.Linkage
 	err := EnumBundleResources(bundle, "Linkage", func(resource ResourceData) error {
 		var entity models.Linkage
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Linkage", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10144,10 +12701,10 @@ func bundleToLinkages(bundle *models.Bundle) ([]*models.Linkage, error) {
 func fhirRespToLinkages(resp *FhirResponse) ([]*models.Linkage, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToLinkages(resp.Bundle)
+		return bundleToLinkages(resp.Bundle, resp.decoder)
 	case "Linkage":
 		var entity models.Linkage
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Linkage{&entity}, nil
@@ -10186,6 +12743,36 @@ func (c *Client) GetLinkageByID(ctx context.Context, id string, params Parameter
 	return fhirRespToLinkage(id, resp)
 }
 
+// LinkageSearchResult is the page of the Linkage search with its metadata.
+type LinkageSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Linkage
+}
+
+func newLinkageSearchResult(result *SearchResult, err error) (*LinkageSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &LinkageSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Linkage); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Linkage with the total, links, full URLs and scores.
+func (c *Client) SearchLinkage(ctx context.Context, params Parameters) (*LinkageSearchResult, error) {
+	return newLinkageSearchResult(c.Search(ctx, "Linkage", params))
+}
+
+// Search Linkage page by its link URL.
+func (c *Client) SearchLinkagePage(ctx context.Context, url string) (*LinkageSearchResult, error) {
+	return newLinkageSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateLinkage(ctx context.Context, params Parameters, entity *models.Linkage) (*models.Linkage, error) {
 	resp, err := c.Create(ctx, "Linkage", params, entity)
 	if err != nil {
@@ -10253,11 +12840,11 @@ func (c *Client) DeleteLinkageByID(ctx context.Context, id string, params Parame
 // List
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToLists(bundle *models.Bundle) ([]*models.List, error) {
+func bundleToLists(bundle *models.Bundle, decoder models.Decoder) ([]*models.List, error) {
 	var entities []*models.List
 	err := EnumBundleResources(bundle, "List", func(resource ResourceData) error {
 		var entity models.List
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "List", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10273,10 +12860,10 @@ func bundleToLists(bundle *models.Bundle) ([]*models.List, error) {
 func fhirRespToLists(resp *FhirResponse) ([]*models.List, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToLists(resp.Bundle)
+		return bundleToLists(resp.Bundle, resp.decoder)
 	case "List":
 		var entity models.List
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.List{&entity}, nil
@@ -10315,6 +12902,36 @@ func (c *Client) GetListByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToList(id, resp)
 }
 
+// ListSearchResult is the page of the List search with its metadata.
+type ListSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.List
+}
+
+func newListSearchResult(result *SearchResult, err error) (*ListSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ListSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.List); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search List with the total, links, full URLs and scores.
+func (c *Client) SearchList(ctx context.Context, params Parameters) (*ListSearchResult, error) {
+	return newListSearchResult(c.Search(ctx, "List", params))
+}
+
+// Search List page by its link URL.
+func (c *Client) SearchListPage(ctx context.Context, url string) (*ListSearchResult, error) {
+	return newListSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateList(ctx context.Context, params Parameters, entity *models.List) (*models.List, error) {
 	resp, err := c.Create(ctx, "List", params, entity)
 	if err != nil {
@@ -10382,11 +12999,11 @@ func (c *Client) DeleteListByID(ctx context.Context, id string, params Parameter
 // Location
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToLocations(bundle *models.Bundle) ([]*models.Location, error) {
+func bundleToLocations(bundle *models.Bundle, decoder models.Decoder) ([]*models.Location, error) {
 	var entities []*models.Location
 	err := EnumBundleResources(bundle, "Location", func(resource ResourceData) error {
 		var entity models.Location
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Location", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10402,10 +13019,10 @@ func bundleToLocations(bundle *models.Bundle) ([]*models.Location, error) {
 func fhirRespToLocations(resp *FhirResponse) ([]*models.Location, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToLocations(resp.Bundle)
+		return bundleToLocations(resp.Bundle, resp.decoder)
 	case "Location":
 		var entity models.Location
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Location{&entity}, nil
@@ -10444,6 +13061,36 @@ func (c *Client) GetLocationByID(ctx context.Context, id string, params Paramete
 	return fhirRespToLocation(id, resp)
 }
 
+// LocationSearchResult is the page of the Location search with its metadata.
+type LocationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Location
+}
+
+func newLocationSearchResult(result *SearchResult, err error) (*LocationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &LocationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Location); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Location with the total, links, full URLs and scores.
+func (c *Client) SearchLocation(ctx context.Context, params Parameters) (*LocationSearchResult, error) {
+	return newLocationSearchResult(c.Search(ctx, "Location", params))
+}
+
+// Search Location page by its link URL.
+func (c *Client) SearchLocationPage(ctx context.Context, url string) (*LocationSearchResult, error) {
+	return newLocationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateLocation(ctx context.Context, params Parameters, entity *models.Location) (*models.Location, error) {
 	resp, err := c.Create(ctx, "Location", params, entity)
 	if err != nil {
@@ -10511,11 +13158,11 @@ func (c *Client) DeleteLocationByID(ctx context.Context, id string, params Param
 // Measure
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMeasures(bundle *models.Bundle) ([]*models.Measure, error) {
+func bundleToMeasures(bundle *models.Bundle, decoder models.Decoder) ([]*models.Measure, error) {
 	var entities []*models.Measure
 	err := EnumBundleResources(bundle, "Measure", func(resource ResourceData) error {
 		var entity models.Measure
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Measure", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10531,10 +13178,10 @@ func bundleToMeasures(bundle *models.Bundle) ([]*models.Measure, error) {
 func fhirRespToMeasures(resp *FhirResponse) ([]*models.Measure, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMeasures(resp.Bundle)
+		return bundleToMeasures(resp.Bundle, resp.decoder)
 	case "Measure":
 		var entity models.Measure
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Measure{&entity}, nil
@@ -10573,6 +13220,36 @@ func (c *Client) GetMeasureByID(ctx context.Context, id string, params Parameter
 	return fhirRespToMeasure(id, resp)
 }
 
+// MeasureSearchResult is the page of the Measure search with its metadata.
+type MeasureSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Measure
+}
+
+func newMeasureSearchResult(result *SearchResult, err error) (*MeasureSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MeasureSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Measure); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Measure with the total, links, full URLs and scores.
+func (c *Client) SearchMeasure(ctx context.Context, params Parameters) (*MeasureSearchResult, error) {
+	return newMeasureSearchResult(c.Search(ctx, "Measure", params))
+}
+
+// Search Measure page by its link URL.
+func (c *Client) SearchMeasurePage(ctx context.Context, url string) (*MeasureSearchResult, error) {
+	return newMeasureSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMeasure(ctx context.Context, params Parameters, entity *models.Measure) (*models.Measure, error) {
 	resp, err := c.Create(ctx, "Measure", params, entity)
 	if err != nil {
@@ -10640,11 +13317,11 @@ func (c *Client) DeleteMeasureByID(ctx context.Context, id string, params Parame
 // MeasureReport
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMeasureReports(bundle *models.Bundle) ([]*models.MeasureReport, error) {
+func bundleToMeasureReports(bundle *models.Bundle, decoder models.Decoder) ([]*models.MeasureReport, error) {
 	var entities []*models.MeasureReport
 	err := EnumBundleResources(bundle, "MeasureReport", func(resource ResourceData) error {
 		var entity models.MeasureReport
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MeasureReport", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10660,10 +13337,10 @@ func bundleToMeasureReports(bundle *models.Bundle) ([]*models.MeasureReport, err
 func fhirRespToMeasureReports(resp *FhirResponse) ([]*models.MeasureReport, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMeasureReports(resp.Bundle)
+		return bundleToMeasureReports(resp.Bundle, resp.decoder)
 	case "MeasureReport":
 		var entity models.MeasureReport
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MeasureReport{&entity}, nil
@@ -10702,6 +13379,36 @@ func (c *Client) GetMeasureReportByID(ctx context.Context, id string, params Par
 	return fhirRespToMeasureReport(id, resp)
 }
 
+// MeasureReportSearchResult is the page of the MeasureReport search with its metadata.
+type MeasureReportSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MeasureReport
+}
+
+func newMeasureReportSearchResult(result *SearchResult, err error) (*MeasureReportSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MeasureReportSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MeasureReport); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MeasureReport with the total, links, full URLs and scores.
+func (c *Client) SearchMeasureReport(ctx context.Context, params Parameters) (*MeasureReportSearchResult, error) {
+	return newMeasureReportSearchResult(c.Search(ctx, "MeasureReport", params))
+}
+
+// Search MeasureReport page by its link URL.
+func (c *Client) SearchMeasureReportPage(ctx context.Context, url string) (*MeasureReportSearchResult, error) {
+	return newMeasureReportSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMeasureReport(ctx context.Context, params Parameters, entity *models.MeasureReport) (*models.MeasureReport, error) {
 	resp, err := c.Create(ctx, "MeasureReport", params, entity)
 	if err != nil {
@@ -10769,11 +13476,11 @@ func (c *Client) DeleteMeasureReportByID(ctx context.Context, id string, params
 // Media
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedias(bundle *models.Bundle) ([]*models.Media, error) {
+func bundleToMedias(bundle *models.Bundle, decoder models.Decoder) ([]*models.Media, error) {
 	var entities []*models.Media
 	err := EnumBundleResources(bundle, "Media", func(resource ResourceData) error {
 		var entity models.Media
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Media", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10789,10 +13496,10 @@ func bundleToMedias(bundle *models.Bundle) ([]*models.Media, error) {
 func fhirRespToMedias(resp *FhirResponse) ([]*models.Media, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedias(resp.Bundle)
+		return bundleToMedias(resp.Bundle, resp.decoder)
 	case "Media":
 		var entity models.Media
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Media{&entity}, nil
@@ -10831,6 +13538,36 @@ func (c *Client) GetMediaByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToMedia(id, resp)
 }
 
+// MediaSearchResult is the page of the Media search with its metadata.
+type MediaSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Media
+}
+
+func newMediaSearchResult(result *SearchResult, err error) (*MediaSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MediaSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Media); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Media with the total, links, full URLs and scores.
+func (c *Client) SearchMedia(ctx context.Context, params Parameters) (*MediaSearchResult, error) {
+	return newMediaSearchResult(c.Search(ctx, "Media", params))
+}
+
+// Search Media page by its link URL.
+func (c *Client) SearchMediaPage(ctx context.Context, url string) (*MediaSearchResult, error) {
+	return newMediaSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedia(ctx context.Context, params Parameters, entity *models.Media) (*models.Media, error) {
 	resp, err := c.Create(ctx, "Media", params, entity)
 	if err != nil {
@@ -10898,11 +13635,11 @@ func (c *Client) DeleteMediaByID(ctx context.Context, id string, params Paramete
 // Medication
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedications(bundle *models.Bundle) ([]*models.Medication, error) {
+func bundleToMedications(bundle *models.Bundle, decoder models.Decoder) ([]*models.Medication, error) {
 	var entities []*models.Medication
 	err := EnumBundleResources(bundle, "Medication", func(resource ResourceData) error {
 		var entity models.Medication
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Medication", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -10918,10 +13655,10 @@ func bundleToMedications(bundle *models.Bundle) ([]*models.Medication, error) {
 func fhirRespToMedications(resp *FhirResponse) ([]*models.Medication, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedications(resp.Bundle)
+		return bundleToMedications(resp.Bundle, resp.decoder)
 	case "Medication":
 		var entity models.Medication
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Medication{&entity}, nil
@@ -10960,6 +13697,36 @@ func (c *Client) GetMedicationByID(ctx context.Context, id string, params Parame
 	return fhirRespToMedication(id, resp)
 }
 
+// MedicationSearchResult is the page of the Medication search with its metadata.
+type MedicationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Medication
+}
+
+func newMedicationSearchResult(result *SearchResult, err error) (*MedicationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Medication); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Medication with the total, links, full URLs and scores.
+func (c *Client) SearchMedication(ctx context.Context, params Parameters) (*MedicationSearchResult, error) {
+	return newMedicationSearchResult(c.Search(ctx, "Medication", params))
+}
+
+// Search Medication page by its link URL.
+func (c *Client) SearchMedicationPage(ctx context.Context, url string) (*MedicationSearchResult, error) {
+	return newMedicationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedication(ctx context.Context, params Parameters, entity *models.Medication) (*models.Medication, error) {
 	resp, err := c.Create(ctx, "Medication", params, entity)
 	if err != nil {
@@ -11027,11 +13794,11 @@ func (c *Client) DeleteMedicationByID(ctx context.Context, id string, params Par
 // MedicationAdministration
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicationAdministrations(bundle *models.Bundle) ([]*models.MedicationAdministration, error) {
+func bundleToMedicationAdministrations(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicationAdministration, error) {
 	var entities []*models.MedicationAdministration
 	err := EnumBundleResources(bundle, "MedicationAdministration", func(resource ResourceData) error {
 		var entity models.MedicationAdministration
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicationAdministration", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11047,10 +13814,10 @@ func bundleToMedicationAdministrations(bundle *models.Bundle) ([]*models.Medicat
 func fhirRespToMedicationAdministrations(resp *FhirResponse) ([]*models.MedicationAdministration, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicationAdministrations(resp.Bundle)
+		return bundleToMedicationAdministrations(resp.Bundle, resp.decoder)
 	case "MedicationAdministration":
 		var entity models.MedicationAdministration
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicationAdministration{&entity}, nil
@@ -11089,6 +13856,36 @@ func (c *Client) GetMedicationAdministrationByID(ctx context.Context, id string,
 	return fhirRespToMedicationAdministration(id, resp)
 }
 
+// MedicationAdministrationSearchResult is the page of the MedicationAdministration search with its metadata.
+type MedicationAdministrationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicationAdministration
+}
+
+func newMedicationAdministrationSearchResult(result *SearchResult, err error) (*MedicationAdministrationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicationAdministrationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicationAdministration); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicationAdministration with the total, links, full URLs and scores.
+func (c *Client) SearchMedicationAdministration(ctx context.Context, params Parameters) (*MedicationAdministrationSearchResult, error) {
+	return newMedicationAdministrationSearchResult(c.Search(ctx, "MedicationAdministration", params))
+}
+
+// Search MedicationAdministration page by its link URL.
+func (c *Client) SearchMedicationAdministrationPage(ctx context.Context, url string) (*MedicationAdministrationSearchResult, error) {
+	return newMedicationAdministrationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicationAdministration(ctx context.Context, params Parameters, entity *models.MedicationAdministration) (*models.MedicationAdministration, error) {
 	resp, err := c.Create(ctx, "MedicationAdministration", params, entity)
 	if err != nil {
@@ -11156,11 +13953,11 @@ func (c *Client) DeleteMedicationAdministrationByID(ctx context.Context, id stri
 // MedicationDispense
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicationDispenses(bundle *models.Bundle) ([]*models.MedicationDispense, error) {
+func bundleToMedicationDispenses(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicationDispense, error) {
 	var entities []*models.MedicationDispense
 	err := EnumBundleResources(bundle, "MedicationDispense", func(resource ResourceData) error {
 		var entity models.MedicationDispense
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicationDispense", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11176,10 +13973,10 @@ func bundleToMedicationDispenses(bundle *models.Bundle) ([]*models.MedicationDis
 func fhirRespToMedicationDispenses(resp *FhirResponse) ([]*models.MedicationDispense, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicationDispenses(resp.Bundle)
+		return bundleToMedicationDispenses(resp.Bundle, resp.decoder)
 	case "MedicationDispense":
 		var entity models.MedicationDispense
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicationDispense{&entity}, nil
@@ -11218,17 +14015,47 @@ func (c *Client) GetMedicationDispenseByID(ctx context.Context, id string, param
 	return fhirRespToMedicationDispense(id, resp)
 }
 
-func (c *Client) CreateMedicationDispense(ctx context.Context, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error) {
-	resp, err := c.Create(ctx, "MedicationDispense", params, entity)
+// MedicationDispenseSearchResult is the page of the MedicationDispense search with its metadata.
+type MedicationDispenseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicationDispense
+}
+
+func newMedicationDispenseSearchResult(result *SearchResult, err error) (*MedicationDispenseSearchResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	typed := &MedicationDispenseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicationDispense); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
 
-	return fhirRespToMedicationDispense(StrPtrToStr(entity.ID), resp)
+// Search MedicationDispense with the total, links, full URLs and scores.
+func (c *Client) SearchMedicationDispense(ctx context.Context, params Parameters) (*MedicationDispenseSearchResult, error) {
+	return newMedicationDispenseSearchResult(c.Search(ctx, "MedicationDispense", params))
 }
 
-func (c *Client) UpdateMedicationDispense(ctx context.Context, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error) {
-	resp, err := c.Update(ctx, "MedicationDispense", params, entity)
+// Search MedicationDispense page by its link URL.
+func (c *Client) SearchMedicationDispensePage(ctx context.Context, url string) (*MedicationDispenseSearchResult, error) {
+	return newMedicationDispenseSearchResult(c.SearchPage(ctx, url))
+}
+
+func (c *Client) CreateMedicationDispense(ctx context.Context, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error) {
+	resp, err := c.Create(ctx, "MedicationDispense", params, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationDispense(StrPtrToStr(entity.ID), resp)
+}
+
+func (c *Client) UpdateMedicationDispense(ctx context.Context, params Parameters, entity *models.MedicationDispense) (*models.MedicationDispense, error) {
+	resp, err := c.Update(ctx, "MedicationDispense", params, entity)
 	if err != nil {
 		return nil, err
 	}
@@ -11285,11 +14112,11 @@ func (c *Client) DeleteMedicationDispenseByID(ctx context.Context, id string, pa
 // MedicationKnowledge
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicationKnowledges(bundle *models.Bundle) ([]*models.MedicationKnowledge, error) {
+func bundleToMedicationKnowledges(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicationKnowledge, error) {
 	var entities []*models.MedicationKnowledge
 	err := EnumBundleResources(bundle, "MedicationKnowledge", func(resource ResourceData) error {
 		var entity models.MedicationKnowledge
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicationKnowledge", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11305,10 +14132,10 @@ func bundleToMedicationKnowledges(bundle *models.Bundle) ([]*models.MedicationKn
 func fhirRespToMedicationKnowledges(resp *FhirResponse) ([]*models.MedicationKnowledge, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicationKnowledges(resp.Bundle)
+		return bundleToMedicationKnowledges(resp.Bundle, resp.decoder)
 	case "MedicationKnowledge":
 		var entity models.MedicationKnowledge
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicationKnowledge{&entity}, nil
@@ -11347,6 +14174,36 @@ func (c *Client) GetMedicationKnowledgeByID(ctx context.Context, id string, para
 	return fhirRespToMedicationKnowledge(id, resp)
 }
 
+// MedicationKnowledgeSearchResult is the page of the MedicationKnowledge search with its metadata.
+type MedicationKnowledgeSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicationKnowledge
+}
+
+func newMedicationKnowledgeSearchResult(result *SearchResult, err error) (*MedicationKnowledgeSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicationKnowledgeSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicationKnowledge); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicationKnowledge with the total, links, full URLs and scores.
+func (c *Client) SearchMedicationKnowledge(ctx context.Context, params Parameters) (*MedicationKnowledgeSearchResult, error) {
+	return newMedicationKnowledgeSearchResult(c.Search(ctx, "MedicationKnowledge", params))
+}
+
+// Search MedicationKnowledge page by its link URL.
+func (c *Client) SearchMedicationKnowledgePage(ctx context.Context, url string) (*MedicationKnowledgeSearchResult, error) {
+	return newMedicationKnowledgeSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicationKnowledge(ctx context.Context, params Parameters, entity *models.MedicationKnowledge) (*models.MedicationKnowledge, error) {
 	resp, err := c.Create(ctx, "MedicationKnowledge", params, entity)
 	if err != nil {
@@ -11414,11 +14271,11 @@ func (c *Client) DeleteMedicationKnowledgeByID(ctx context.Context, id string, p
 // MedicationRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicationRequests(bundle *models.Bundle) ([]*models.MedicationRequest, error) {
+func bundleToMedicationRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicationRequest, error) {
 	var entities []*models.MedicationRequest
 	err := EnumBundleResources(bundle, "MedicationRequest", func(resource ResourceData) error {
 		var entity models.MedicationRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicationRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11434,10 +14291,10 @@ func bundleToMedicationRequests(bundle *models.Bundle) ([]*models.MedicationRequ
 func fhirRespToMedicationRequests(resp *FhirResponse) ([]*models.MedicationRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicationRequests(resp.Bundle)
+		return bundleToMedicationRequests(resp.Bundle, resp.decoder)
 	case "MedicationRequest":
 		var entity models.MedicationRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicationRequest{&entity}, nil
@@ -11476,6 +14333,36 @@ func (c *Client) GetMedicationRequestByID(ctx context.Context, id string, params
 	return fhirRespToMedicationRequest(id, resp)
 }
 
+// MedicationRequestSearchResult is the page of the MedicationRequest search with its metadata.
+type MedicationRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicationRequest
+}
+
+func newMedicationRequestSearchResult(result *SearchResult, err error) (*MedicationRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicationRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicationRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicationRequest with the total, links, full URLs and scores.
+func (c *Client) SearchMedicationRequest(ctx context.Context, params Parameters) (*MedicationRequestSearchResult, error) {
+	return newMedicationRequestSearchResult(c.Search(ctx, "MedicationRequest", params))
+}
+
+// Search MedicationRequest page by its link URL.
+func (c *Client) SearchMedicationRequestPage(ctx context.Context, url string) (*MedicationRequestSearchResult, error) {
+	return newMedicationRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicationRequest(ctx context.Context, params Parameters, entity *models.MedicationRequest) (*models.MedicationRequest, error) {
 	resp, err := c.Create(ctx, "MedicationRequest", params, entity)
 	if err != nil {
@@ -11543,11 +14430,11 @@ func (c *Client) DeleteMedicationRequestByID(ctx context.Context, id string, par
 // MedicationStatement
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicationStatements(bundle *models.Bundle) ([]*models.MedicationStatement, error) {
+func bundleToMedicationStatements(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicationStatement, error) {
 	var entities []*models.MedicationStatement
 	err := EnumBundleResources(bundle, "MedicationStatement", func(resource ResourceData) error {
 		var entity models.MedicationStatement
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicationStatement", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11563,10 +14450,10 @@ func bundleToMedicationStatements(bundle *models.Bundle) ([]*models.MedicationSt
 func fhirRespToMedicationStatements(resp *FhirResponse) ([]*models.MedicationStatement, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicationStatements(resp.Bundle)
+		return bundleToMedicationStatements(resp.Bundle, resp.decoder)
 	case "MedicationStatement":
 		var entity models.MedicationStatement
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicationStatement{&entity}, nil
@@ -11605,6 +14492,36 @@ func (c *Client) GetMedicationStatementByID(ctx context.Context, id string, para
 	return fhirRespToMedicationStatement(id, resp)
 }
 
+// MedicationStatementSearchResult is the page of the MedicationStatement search with its metadata.
+type MedicationStatementSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicationStatement
+}
+
+func newMedicationStatementSearchResult(result *SearchResult, err error) (*MedicationStatementSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicationStatementSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicationStatement); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicationStatement with the total, links, full URLs and scores.
+func (c *Client) SearchMedicationStatement(ctx context.Context, params Parameters) (*MedicationStatementSearchResult, error) {
+	return newMedicationStatementSearchResult(c.Search(ctx, "MedicationStatement", params))
+}
+
+// Search MedicationStatement page by its link URL.
+func (c *Client) SearchMedicationStatementPage(ctx context.Context, url string) (*MedicationStatementSearchResult, error) {
+	return newMedicationStatementSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicationStatement(ctx context.Context, params Parameters, entity *models.MedicationStatement) (*models.MedicationStatement, error) {
 	resp, err := c.Create(ctx, "MedicationStatement", params, entity)
 	if err != nil {
@@ -11672,11 +14589,11 @@ func (c *Client) DeleteMedicationStatementByID(ctx context.Context, id string, p
 // MedicinalProduct
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProducts(bundle *models.Bundle) ([]*models.MedicinalProduct, error) {
+func bundleToMedicinalProducts(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProduct, error) {
 	var entities []*models.MedicinalProduct
 	err := EnumBundleResources(bundle, "MedicinalProduct", func(resource ResourceData) error {
 		var entity models.MedicinalProduct
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProduct", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11692,10 +14609,10 @@ func bundleToMedicinalProducts(bundle *models.Bundle) ([]*models.MedicinalProduc
 func fhirRespToMedicinalProducts(resp *FhirResponse) ([]*models.MedicinalProduct, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProducts(resp.Bundle)
+		return bundleToMedicinalProducts(resp.Bundle, resp.decoder)
 	case "MedicinalProduct":
 		var entity models.MedicinalProduct
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProduct{&entity}, nil
@@ -11734,6 +14651,36 @@ func (c *Client) GetMedicinalProductByID(ctx context.Context, id string, params
 	return fhirRespToMedicinalProduct(id, resp)
 }
 
+// MedicinalProductSearchResult is the page of the MedicinalProduct search with its metadata.
+type MedicinalProductSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProduct
+}
+
+func newMedicinalProductSearchResult(result *SearchResult, err error) (*MedicinalProductSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProduct); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProduct with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProduct(ctx context.Context, params Parameters) (*MedicinalProductSearchResult, error) {
+	return newMedicinalProductSearchResult(c.Search(ctx, "MedicinalProduct", params))
+}
+
+// Search MedicinalProduct page by its link URL.
+func (c *Client) SearchMedicinalProductPage(ctx context.Context, url string) (*MedicinalProductSearchResult, error) {
+	return newMedicinalProductSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProduct(ctx context.Context, params Parameters, entity *models.MedicinalProduct) (*models.MedicinalProduct, error) {
 	resp, err := c.Create(ctx, "MedicinalProduct", params, entity)
 	if err != nil {
@@ -11801,11 +14748,11 @@ func (c *Client) DeleteMedicinalProductByID(ctx context.Context, id string, para
 // MedicinalProductAuthorization
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductAuthorizations(bundle *models.Bundle) ([]*models.MedicinalProductAuthorization, error) {
+func bundleToMedicinalProductAuthorizations(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductAuthorization, error) {
 	var entities []*models.MedicinalProductAuthorization
 	err := EnumBundleResources(bundle, "MedicinalProductAuthorization", func(resource ResourceData) error {
 		var entity models.MedicinalProductAuthorization
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductAuthorization", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11821,10 +14768,10 @@ func bundleToMedicinalProductAuthorizations(bundle *models.Bundle) ([]*models.Me
 func fhirRespToMedicinalProductAuthorizations(resp *FhirResponse) ([]*models.MedicinalProductAuthorization, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductAuthorizations(resp.Bundle)
+		return bundleToMedicinalProductAuthorizations(resp.Bundle, resp.decoder)
 	case "MedicinalProductAuthorization":
 		var entity models.MedicinalProductAuthorization
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductAuthorization{&entity}, nil
@@ -11863,6 +14810,36 @@ func (c *Client) GetMedicinalProductAuthorizationByID(ctx context.Context, id st
 	return fhirRespToMedicinalProductAuthorization(id, resp)
 }
 
+// MedicinalProductAuthorizationSearchResult is the page of the MedicinalProductAuthorization search with its metadata.
+type MedicinalProductAuthorizationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductAuthorization
+}
+
+func newMedicinalProductAuthorizationSearchResult(result *SearchResult, err error) (*MedicinalProductAuthorizationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductAuthorizationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductAuthorization); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductAuthorization with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductAuthorization(ctx context.Context, params Parameters) (*MedicinalProductAuthorizationSearchResult, error) {
+	return newMedicinalProductAuthorizationSearchResult(c.Search(ctx, "MedicinalProductAuthorization", params))
+}
+
+// Search MedicinalProductAuthorization page by its link URL.
+func (c *Client) SearchMedicinalProductAuthorizationPage(ctx context.Context, url string) (*MedicinalProductAuthorizationSearchResult, error) {
+	return newMedicinalProductAuthorizationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductAuthorization(ctx context.Context, params Parameters, entity *models.MedicinalProductAuthorization) (*models.MedicinalProductAuthorization, error) {
 	resp, err := c.Create(ctx, "MedicinalProductAuthorization", params, entity)
 	if err != nil {
@@ -11930,11 +14907,11 @@ func (c *Client) DeleteMedicinalProductAuthorizationByID(ctx context.Context, id
 // MedicinalProductContraindication
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductContraindications(bundle *models.Bundle) ([]*models.MedicinalProductContraindication, error) {
+func bundleToMedicinalProductContraindications(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductContraindication, error) {
 	var entities []*models.MedicinalProductContraindication
 	err := EnumBundleResources(bundle, "MedicinalProductContraindication", func(resource ResourceData) error {
 		var entity models.MedicinalProductContraindication
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductContraindication", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -11950,10 +14927,10 @@ func bundleToMedicinalProductContraindications(bundle *models.Bundle) ([]*models
 func fhirRespToMedicinalProductContraindications(resp *FhirResponse) ([]*models.MedicinalProductContraindication, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductContraindications(resp.Bundle)
+		return bundleToMedicinalProductContraindications(resp.Bundle, resp.decoder)
 	case "MedicinalProductContraindication":
 		var entity models.MedicinalProductContraindication
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductContraindication{&entity}, nil
@@ -11992,6 +14969,36 @@ func (c *Client) GetMedicinalProductContraindicationByID(ctx context.Context, id
 	return fhirRespToMedicinalProductContraindication(id, resp)
 }
 
+// MedicinalProductContraindicationSearchResult is the page of the MedicinalProductContraindication search with its metadata.
+type MedicinalProductContraindicationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductContraindication
+}
+
+func newMedicinalProductContraindicationSearchResult(result *SearchResult, err error) (*MedicinalProductContraindicationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductContraindicationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductContraindication); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductContraindication with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductContraindication(ctx context.Context, params Parameters) (*MedicinalProductContraindicationSearchResult, error) {
+	return newMedicinalProductContraindicationSearchResult(c.Search(ctx, "MedicinalProductContraindication", params))
+}
+
+// Search MedicinalProductContraindication page by its link URL.
+func (c *Client) SearchMedicinalProductContraindicationPage(ctx context.Context, url string) (*MedicinalProductContraindicationSearchResult, error) {
+	return newMedicinalProductContraindicationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductContraindication(ctx context.Context, params Parameters, entity *models.MedicinalProductContraindication) (*models.MedicinalProductContraindication, error) {
 	resp, err := c.Create(ctx, "MedicinalProductContraindication", params, entity)
 	if err != nil {
@@ -12059,11 +15066,11 @@ func (c *Client) DeleteMedicinalProductContraindicationByID(ctx context.Context,
 // MedicinalProductIndication
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductIndications(bundle *models.Bundle) ([]*models.MedicinalProductIndication, error) {
+func bundleToMedicinalProductIndications(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductIndication, error) {
 	var entities []*models.MedicinalProductIndication
 	err := EnumBundleResources(bundle, "MedicinalProductIndication", func(resource ResourceData) error {
 		var entity models.MedicinalProductIndication
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductIndication", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12079,10 +15086,10 @@ func bundleToMedicinalProductIndications(bundle *models.Bundle) ([]*models.Medic
 func fhirRespToMedicinalProductIndications(resp *FhirResponse) ([]*models.MedicinalProductIndication, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductIndications(resp.Bundle)
+		return bundleToMedicinalProductIndications(resp.Bundle, resp.decoder)
 	case "MedicinalProductIndication":
 		var entity models.MedicinalProductIndication
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductIndication{&entity}, nil
@@ -12121,6 +15128,36 @@ func (c *Client) GetMedicinalProductIndicationByID(ctx context.Context, id strin
 	return fhirRespToMedicinalProductIndication(id, resp)
 }
 
+// MedicinalProductIndicationSearchResult is the page of the MedicinalProductIndication search with its metadata.
+type MedicinalProductIndicationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductIndication
+}
+
+func newMedicinalProductIndicationSearchResult(result *SearchResult, err error) (*MedicinalProductIndicationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductIndicationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductIndication); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductIndication with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductIndication(ctx context.Context, params Parameters) (*MedicinalProductIndicationSearchResult, error) {
+	return newMedicinalProductIndicationSearchResult(c.Search(ctx, "MedicinalProductIndication", params))
+}
+
+// Search MedicinalProductIndication page by its link URL.
+func (c *Client) SearchMedicinalProductIndicationPage(ctx context.Context, url string) (*MedicinalProductIndicationSearchResult, error) {
+	return newMedicinalProductIndicationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductIndication(ctx context.Context, params Parameters, entity *models.MedicinalProductIndication) (*models.MedicinalProductIndication, error) {
 	resp, err := c.Create(ctx, "MedicinalProductIndication", params, entity)
 	if err != nil {
@@ -12188,11 +15225,11 @@ func (c *Client) DeleteMedicinalProductIndicationByID(ctx context.Context, id st
 // MedicinalProductIngredient
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductIngredients(bundle *models.Bundle) ([]*models.MedicinalProductIngredient, error) {
+func bundleToMedicinalProductIngredients(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductIngredient, error) {
 	var entities []*models.MedicinalProductIngredient
 	err := EnumBundleResources(bundle, "MedicinalProductIngredient", func(resource ResourceData) error {
 		var entity models.MedicinalProductIngredient
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductIngredient", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12208,10 +15245,10 @@ func bundleToMedicinalProductIngredients(bundle *models.Bundle) ([]*models.Medic
 func fhirRespToMedicinalProductIngredients(resp *FhirResponse) ([]*models.MedicinalProductIngredient, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductIngredients(resp.Bundle)
+		return bundleToMedicinalProductIngredients(resp.Bundle, resp.decoder)
 	case "MedicinalProductIngredient":
 		var entity models.MedicinalProductIngredient
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductIngredient{&entity}, nil
@@ -12250,6 +15287,36 @@ func (c *Client) GetMedicinalProductIngredientByID(ctx context.Context, id strin
 	return fhirRespToMedicinalProductIngredient(id, resp)
 }
 
+// MedicinalProductIngredientSearchResult is the page of the MedicinalProductIngredient search with its metadata.
+type MedicinalProductIngredientSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductIngredient
+}
+
+func newMedicinalProductIngredientSearchResult(result *SearchResult, err error) (*MedicinalProductIngredientSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductIngredientSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductIngredient); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductIngredient with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductIngredient(ctx context.Context, params Parameters) (*MedicinalProductIngredientSearchResult, error) {
+	return newMedicinalProductIngredientSearchResult(c.Search(ctx, "MedicinalProductIngredient", params))
+}
+
+// Search MedicinalProductIngredient page by its link URL.
+func (c *Client) SearchMedicinalProductIngredientPage(ctx context.Context, url string) (*MedicinalProductIngredientSearchResult, error) {
+	return newMedicinalProductIngredientSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductIngredient(ctx context.Context, params Parameters, entity *models.MedicinalProductIngredient) (*models.MedicinalProductIngredient, error) {
 	resp, err := c.Create(ctx, "MedicinalProductIngredient", params, entity)
 	if err != nil {
@@ -12317,11 +15384,11 @@ func (c *Client) DeleteMedicinalProductIngredientByID(ctx context.Context, id st
 // MedicinalProductInteraction
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductInteractions(bundle *models.Bundle) ([]*models.MedicinalProductInteraction, error) {
+func bundleToMedicinalProductInteractions(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductInteraction, error) {
 	var entities []*models.MedicinalProductInteraction
 	err := EnumBundleResources(bundle, "MedicinalProductInteraction", func(resource ResourceData) error {
 		var entity models.MedicinalProductInteraction
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductInteraction", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12337,10 +15404,10 @@ func bundleToMedicinalProductInteractions(bundle *models.Bundle) ([]*models.Medi
 func fhirRespToMedicinalProductInteractions(resp *FhirResponse) ([]*models.MedicinalProductInteraction, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductInteractions(resp.Bundle)
+		return bundleToMedicinalProductInteractions(resp.Bundle, resp.decoder)
 	case "MedicinalProductInteraction":
 		var entity models.MedicinalProductInteraction
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductInteraction{&entity}, nil
@@ -12379,6 +15446,36 @@ func (c *Client) GetMedicinalProductInteractionByID(ctx context.Context, id stri
 	return fhirRespToMedicinalProductInteraction(id, resp)
 }
 
+// MedicinalProductInteractionSearchResult is the page of the MedicinalProductInteraction search with its metadata.
+type MedicinalProductInteractionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductInteraction
+}
+
+func newMedicinalProductInteractionSearchResult(result *SearchResult, err error) (*MedicinalProductInteractionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductInteractionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductInteraction); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductInteraction with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductInteraction(ctx context.Context, params Parameters) (*MedicinalProductInteractionSearchResult, error) {
+	return newMedicinalProductInteractionSearchResult(c.Search(ctx, "MedicinalProductInteraction", params))
+}
+
+// Search MedicinalProductInteraction page by its link URL.
+func (c *Client) SearchMedicinalProductInteractionPage(ctx context.Context, url string) (*MedicinalProductInteractionSearchResult, error) {
+	return newMedicinalProductInteractionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductInteraction(ctx context.Context, params Parameters, entity *models.MedicinalProductInteraction) (*models.MedicinalProductInteraction, error) {
 	resp, err := c.Create(ctx, "MedicinalProductInteraction", params, entity)
 	if err != nil {
@@ -12446,11 +15543,11 @@ func (c *Client) DeleteMedicinalProductInteractionByID(ctx context.Context, id s
 // MedicinalProductManufactured
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductManufactureds(bundle *models.Bundle) ([]*models.MedicinalProductManufactured, error) {
+func bundleToMedicinalProductManufactureds(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductManufactured, error) {
 	var entities []*models.MedicinalProductManufactured
 	err := EnumBundleResources(bundle, "MedicinalProductManufactured", func(resource ResourceData) error {
 		var entity models.MedicinalProductManufactured
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductManufactured", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12466,10 +15563,10 @@ func bundleToMedicinalProductManufactureds(bundle *models.Bundle) ([]*models.Med
 func fhirRespToMedicinalProductManufactureds(resp *FhirResponse) ([]*models.MedicinalProductManufactured, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductManufactureds(resp.Bundle)
+		return bundleToMedicinalProductManufactureds(resp.Bundle, resp.decoder)
 	case "MedicinalProductManufactured":
 		var entity models.MedicinalProductManufactured
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductManufactured{&entity}, nil
@@ -12508,6 +15605,36 @@ func (c *Client) GetMedicinalProductManufacturedByID(ctx context.Context, id str
 	return fhirRespToMedicinalProductManufactured(id, resp)
 }
 
+// MedicinalProductManufacturedSearchResult is the page of the MedicinalProductManufactured search with its metadata.
+type MedicinalProductManufacturedSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductManufactured
+}
+
+func newMedicinalProductManufacturedSearchResult(result *SearchResult, err error) (*MedicinalProductManufacturedSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductManufacturedSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductManufactured); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductManufactured with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductManufactured(ctx context.Context, params Parameters) (*MedicinalProductManufacturedSearchResult, error) {
+	return newMedicinalProductManufacturedSearchResult(c.Search(ctx, "MedicinalProductManufactured", params))
+}
+
+// Search MedicinalProductManufactured page by its link URL.
+func (c *Client) SearchMedicinalProductManufacturedPage(ctx context.Context, url string) (*MedicinalProductManufacturedSearchResult, error) {
+	return newMedicinalProductManufacturedSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductManufactured(ctx context.Context, params Parameters, entity *models.MedicinalProductManufactured) (*models.MedicinalProductManufactured, error) {
 	resp, err := c.Create(ctx, "MedicinalProductManufactured", params, entity)
 	if err != nil {
@@ -12575,11 +15702,11 @@ func (c *Client) DeleteMedicinalProductManufacturedByID(ctx context.Context, id
 // MedicinalProductPackaged
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductPackageds(bundle *models.Bundle) ([]*models.MedicinalProductPackaged, error) {
+func bundleToMedicinalProductPackageds(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductPackaged, error) {
 	var entities []*models.MedicinalProductPackaged
 	err := EnumBundleResources(bundle, "MedicinalProductPackaged", func(resource ResourceData) error {
 		var entity models.MedicinalProductPackaged
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductPackaged", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12595,10 +15722,10 @@ func bundleToMedicinalProductPackageds(bundle *models.Bundle) ([]*models.Medicin
 func fhirRespToMedicinalProductPackageds(resp *FhirResponse) ([]*models.MedicinalProductPackaged, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductPackageds(resp.Bundle)
+		return bundleToMedicinalProductPackageds(resp.Bundle, resp.decoder)
 	case "MedicinalProductPackaged":
 		var entity models.MedicinalProductPackaged
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductPackaged{&entity}, nil
@@ -12637,6 +15764,36 @@ func (c *Client) GetMedicinalProductPackagedByID(ctx context.Context, id string,
 	return fhirRespToMedicinalProductPackaged(id, resp)
 }
 
+// MedicinalProductPackagedSearchResult is the page of the MedicinalProductPackaged search with its metadata.
+type MedicinalProductPackagedSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductPackaged
+}
+
+func newMedicinalProductPackagedSearchResult(result *SearchResult, err error) (*MedicinalProductPackagedSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductPackagedSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductPackaged); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductPackaged with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductPackaged(ctx context.Context, params Parameters) (*MedicinalProductPackagedSearchResult, error) {
+	return newMedicinalProductPackagedSearchResult(c.Search(ctx, "MedicinalProductPackaged", params))
+}
+
+// Search MedicinalProductPackaged page by its link URL.
+func (c *Client) SearchMedicinalProductPackagedPage(ctx context.Context, url string) (*MedicinalProductPackagedSearchResult, error) {
+	return newMedicinalProductPackagedSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductPackaged(ctx context.Context, params Parameters, entity *models.MedicinalProductPackaged) (*models.MedicinalProductPackaged, error) {
 	resp, err := c.Create(ctx, "MedicinalProductPackaged", params, entity)
 	if err != nil {
@@ -12704,11 +15861,11 @@ func (c *Client) DeleteMedicinalProductPackagedByID(ctx context.Context, id stri
 // MedicinalProductPharmaceutical
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductPharmaceuticals(bundle *models.Bundle) ([]*models.MedicinalProductPharmaceutical, error) {
+func bundleToMedicinalProductPharmaceuticals(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductPharmaceutical, error) {
 	var entities []*models.MedicinalProductPharmaceutical
 	err := EnumBundleResources(bundle, "MedicinalProductPharmaceutical", func(resource ResourceData) error {
 		var entity models.MedicinalProductPharmaceutical
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductPharmaceutical", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12724,10 +15881,10 @@ func bundleToMedicinalProductPharmaceuticals(bundle *models.Bundle) ([]*models.M
 func fhirRespToMedicinalProductPharmaceuticals(resp *FhirResponse) ([]*models.MedicinalProductPharmaceutical, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductPharmaceuticals(resp.Bundle)
+		return bundleToMedicinalProductPharmaceuticals(resp.Bundle, resp.decoder)
 	case "MedicinalProductPharmaceutical":
 		var entity models.MedicinalProductPharmaceutical
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductPharmaceutical{&entity}, nil
@@ -12766,6 +15923,36 @@ func (c *Client) GetMedicinalProductPharmaceuticalByID(ctx context.Context, id s
 	return fhirRespToMedicinalProductPharmaceutical(id, resp)
 }
 
+// MedicinalProductPharmaceuticalSearchResult is the page of the MedicinalProductPharmaceutical search with its metadata.
+type MedicinalProductPharmaceuticalSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductPharmaceutical
+}
+
+func newMedicinalProductPharmaceuticalSearchResult(result *SearchResult, err error) (*MedicinalProductPharmaceuticalSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductPharmaceuticalSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductPharmaceutical); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductPharmaceutical with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductPharmaceutical(ctx context.Context, params Parameters) (*MedicinalProductPharmaceuticalSearchResult, error) {
+	return newMedicinalProductPharmaceuticalSearchResult(c.Search(ctx, "MedicinalProductPharmaceutical", params))
+}
+
+// Search MedicinalProductPharmaceutical page by its link URL.
+func (c *Client) SearchMedicinalProductPharmaceuticalPage(ctx context.Context, url string) (*MedicinalProductPharmaceuticalSearchResult, error) {
+	return newMedicinalProductPharmaceuticalSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductPharmaceutical(ctx context.Context, params Parameters, entity *models.MedicinalProductPharmaceutical) (*models.MedicinalProductPharmaceutical, error) {
 	resp, err := c.Create(ctx, "MedicinalProductPharmaceutical", params, entity)
 	if err != nil {
@@ -12833,11 +16020,11 @@ func (c *Client) DeleteMedicinalProductPharmaceuticalByID(ctx context.Context, i
 // MedicinalProductUndesirableEffect
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMedicinalProductUndesirableEffects(bundle *models.Bundle) ([]*models.MedicinalProductUndesirableEffect, error) {
+func bundleToMedicinalProductUndesirableEffects(bundle *models.Bundle, decoder models.Decoder) ([]*models.MedicinalProductUndesirableEffect, error) {
 	var entities []*models.MedicinalProductUndesirableEffect
 	err := EnumBundleResources(bundle, "MedicinalProductUndesirableEffect", func(resource ResourceData) error {
 		var entity models.MedicinalProductUndesirableEffect
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MedicinalProductUndesirableEffect", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12853,10 +16040,10 @@ func bundleToMedicinalProductUndesirableEffects(bundle *models.Bundle) ([]*model
 func fhirRespToMedicinalProductUndesirableEffects(resp *FhirResponse) ([]*models.MedicinalProductUndesirableEffect, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMedicinalProductUndesirableEffects(resp.Bundle)
+		return bundleToMedicinalProductUndesirableEffects(resp.Bundle, resp.decoder)
 	case "MedicinalProductUndesirableEffect":
 		var entity models.MedicinalProductUndesirableEffect
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MedicinalProductUndesirableEffect{&entity}, nil
@@ -12895,6 +16082,36 @@ func (c *Client) GetMedicinalProductUndesirableEffectByID(ctx context.Context, i
 	return fhirRespToMedicinalProductUndesirableEffect(id, resp)
 }
 
+// MedicinalProductUndesirableEffectSearchResult is the page of the MedicinalProductUndesirableEffect search with its metadata.
+type MedicinalProductUndesirableEffectSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MedicinalProductUndesirableEffect
+}
+
+func newMedicinalProductUndesirableEffectSearchResult(result *SearchResult, err error) (*MedicinalProductUndesirableEffectSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MedicinalProductUndesirableEffectSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MedicinalProductUndesirableEffect); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MedicinalProductUndesirableEffect with the total, links, full URLs and scores.
+func (c *Client) SearchMedicinalProductUndesirableEffect(ctx context.Context, params Parameters) (*MedicinalProductUndesirableEffectSearchResult, error) {
+	return newMedicinalProductUndesirableEffectSearchResult(c.Search(ctx, "MedicinalProductUndesirableEffect", params))
+}
+
+// Search MedicinalProductUndesirableEffect page by its link URL.
+func (c *Client) SearchMedicinalProductUndesirableEffectPage(ctx context.Context, url string) (*MedicinalProductUndesirableEffectSearchResult, error) {
+	return newMedicinalProductUndesirableEffectSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMedicinalProductUndesirableEffect(ctx context.Context, params Parameters, entity *models.MedicinalProductUndesirableEffect) (*models.MedicinalProductUndesirableEffect, error) {
 	resp, err := c.Create(ctx, "MedicinalProductUndesirableEffect", params, entity)
 	if err != nil {
@@ -12962,11 +16179,11 @@ func (c *Client) DeleteMedicinalProductUndesirableEffectByID(ctx context.Context
 // MessageDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMessageDefinitions(bundle *models.Bundle) ([]*models.MessageDefinition, error) {
+func bundleToMessageDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.MessageDefinition, error) {
 	var entities []*models.MessageDefinition
 	err := EnumBundleResources(bundle, "MessageDefinition", func(resource ResourceData) error {
 		var entity models.MessageDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MessageDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -12982,10 +16199,10 @@ func bundleToMessageDefinitions(bundle *models.Bundle) ([]*models.MessageDefinit
 func fhirRespToMessageDefinitions(resp *FhirResponse) ([]*models.MessageDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMessageDefinitions(resp.Bundle)
+		return bundleToMessageDefinitions(resp.Bundle, resp.decoder)
 	case "MessageDefinition":
 		var entity models.MessageDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MessageDefinition{&entity}, nil
@@ -13024,6 +16241,36 @@ func (c *Client) GetMessageDefinitionByID(ctx context.Context, id string, params
 	return fhirRespToMessageDefinition(id, resp)
 }
 
+// MessageDefinitionSearchResult is the page of the MessageDefinition search with its metadata.
+type MessageDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MessageDefinition
+}
+
+func newMessageDefinitionSearchResult(result *SearchResult, err error) (*MessageDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MessageDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MessageDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MessageDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchMessageDefinition(ctx context.Context, params Parameters) (*MessageDefinitionSearchResult, error) {
+	return newMessageDefinitionSearchResult(c.Search(ctx, "MessageDefinition", params))
+}
+
+// Search MessageDefinition page by its link URL.
+func (c *Client) SearchMessageDefinitionPage(ctx context.Context, url string) (*MessageDefinitionSearchResult, error) {
+	return newMessageDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMessageDefinition(ctx context.Context, params Parameters, entity *models.MessageDefinition) (*models.MessageDefinition, error) {
 	resp, err := c.Create(ctx, "MessageDefinition", params, entity)
 	if err != nil {
@@ -13091,11 +16338,11 @@ func (c *Client) DeleteMessageDefinitionByID(ctx context.Context, id string, par
 // MessageHeader
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMessageHeaders(bundle *models.Bundle) ([]*models.MessageHeader, error) {
+func bundleToMessageHeaders(bundle *models.Bundle, decoder models.Decoder) ([]*models.MessageHeader, error) {
 	var entities []*models.MessageHeader
 	err := EnumBundleResources(bundle, "MessageHeader", func(resource ResourceData) error {
 		var entity models.MessageHeader
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MessageHeader", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13111,10 +16358,10 @@ func bundleToMessageHeaders(bundle *models.Bundle) ([]*models.MessageHeader, err
 func fhirRespToMessageHeaders(resp *FhirResponse) ([]*models.MessageHeader, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMessageHeaders(resp.Bundle)
+		return bundleToMessageHeaders(resp.Bundle, resp.decoder)
 	case "MessageHeader":
 		var entity models.MessageHeader
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MessageHeader{&entity}, nil
@@ -13153,6 +16400,36 @@ func (c *Client) GetMessageHeaderByID(ctx context.Context, id string, params Par
 	return fhirRespToMessageHeader(id, resp)
 }
 
+// MessageHeaderSearchResult is the page of the MessageHeader search with its metadata.
+type MessageHeaderSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MessageHeader
+}
+
+func newMessageHeaderSearchResult(result *SearchResult, err error) (*MessageHeaderSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MessageHeaderSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MessageHeader); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MessageHeader with the total, links, full URLs and scores.
+func (c *Client) SearchMessageHeader(ctx context.Context, params Parameters) (*MessageHeaderSearchResult, error) {
+	return newMessageHeaderSearchResult(c.Search(ctx, "MessageHeader", params))
+}
+
+// Search MessageHeader page by its link URL.
+func (c *Client) SearchMessageHeaderPage(ctx context.Context, url string) (*MessageHeaderSearchResult, error) {
+	return newMessageHeaderSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMessageHeader(ctx context.Context, params Parameters, entity *models.MessageHeader) (*models.MessageHeader, error) {
 	resp, err := c.Create(ctx, "MessageHeader", params, entity)
 	if err != nil {
@@ -13220,11 +16497,11 @@ func (c *Client) DeleteMessageHeaderByID(ctx context.Context, id string, params
 // MolecularSequence
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToMolecularSequences(bundle *models.Bundle) ([]*models.MolecularSequence, error) {
+func bundleToMolecularSequences(bundle *models.Bundle, decoder models.Decoder) ([]*models.MolecularSequence, error) {
 	var entities []*models.MolecularSequence
 	err := EnumBundleResources(bundle, "MolecularSequence", func(resource ResourceData) error {
 		var entity models.MolecularSequence
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "MolecularSequence", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13240,10 +16517,10 @@ func bundleToMolecularSequences(bundle *models.Bundle) ([]*models.MolecularSeque
 func fhirRespToMolecularSequences(resp *FhirResponse) ([]*models.MolecularSequence, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToMolecularSequences(resp.Bundle)
+		return bundleToMolecularSequences(resp.Bundle, resp.decoder)
 	case "MolecularSequence":
 		var entity models.MolecularSequence
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.MolecularSequence{&entity}, nil
@@ -13282,6 +16559,36 @@ func (c *Client) GetMolecularSequenceByID(ctx context.Context, id string, params
 	return fhirRespToMolecularSequence(id, resp)
 }
 
+// MolecularSequenceSearchResult is the page of the MolecularSequence search with its metadata.
+type MolecularSequenceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.MolecularSequence
+}
+
+func newMolecularSequenceSearchResult(result *SearchResult, err error) (*MolecularSequenceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &MolecularSequenceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.MolecularSequence); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search MolecularSequence with the total, links, full URLs and scores.
+func (c *Client) SearchMolecularSequence(ctx context.Context, params Parameters) (*MolecularSequenceSearchResult, error) {
+	return newMolecularSequenceSearchResult(c.Search(ctx, "MolecularSequence", params))
+}
+
+// Search MolecularSequence page by its link URL.
+func (c *Client) SearchMolecularSequencePage(ctx context.Context, url string) (*MolecularSequenceSearchResult, error) {
+	return newMolecularSequenceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateMolecularSequence(ctx context.Context, params Parameters, entity *models.MolecularSequence) (*models.MolecularSequence, error) {
 	resp, err := c.Create(ctx, "MolecularSequence", params, entity)
 	if err != nil {
@@ -13349,11 +16656,11 @@ func (c *Client) DeleteMolecularSequenceByID(ctx context.Context, id string, par
 // NamingSystem
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToNamingSystems(bundle *models.Bundle) ([]*models.NamingSystem, error) {
+func bundleToNamingSystems(bundle *models.Bundle, decoder models.Decoder) ([]*models.NamingSystem, error) {
 	var entities []*models.NamingSystem
 	err := EnumBundleResources(bundle, "NamingSystem", func(resource ResourceData) error {
 		var entity models.NamingSystem
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "NamingSystem", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13369,10 +16676,10 @@ func bundleToNamingSystems(bundle *models.Bundle) ([]*models.NamingSystem, error
 func fhirRespToNamingSystems(resp *FhirResponse) ([]*models.NamingSystem, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToNamingSystems(resp.Bundle)
+		return bundleToNamingSystems(resp.Bundle, resp.decoder)
 	case "NamingSystem":
 		var entity models.NamingSystem
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.NamingSystem{&entity}, nil
@@ -13411,6 +16718,36 @@ func (c *Client) GetNamingSystemByID(ctx context.Context, id string, params Para
 	return fhirRespToNamingSystem(id, resp)
 }
 
+// NamingSystemSearchResult is the page of the NamingSystem search with its metadata.
+type NamingSystemSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.NamingSystem
+}
+
+func newNamingSystemSearchResult(result *SearchResult, err error) (*NamingSystemSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &NamingSystemSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.NamingSystem); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search NamingSystem with the total, links, full URLs and scores.
+func (c *Client) SearchNamingSystem(ctx context.Context, params Parameters) (*NamingSystemSearchResult, error) {
+	return newNamingSystemSearchResult(c.Search(ctx, "NamingSystem", params))
+}
+
+// Search NamingSystem page by its link URL.
+func (c *Client) SearchNamingSystemPage(ctx context.Context, url string) (*NamingSystemSearchResult, error) {
+	return newNamingSystemSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateNamingSystem(ctx context.Context, params Parameters, entity *models.NamingSystem) (*models.NamingSystem, error) {
 	resp, err := c.Create(ctx, "NamingSystem", params, entity)
 	if err != nil {
@@ -13478,11 +16815,11 @@ func (c *Client) DeleteNamingSystemByID(ctx context.Context, id string, params P
 // NutritionOrder
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToNutritionOrders(bundle *models.Bundle) ([]*models.NutritionOrder, error) {
+func bundleToNutritionOrders(bundle *models.Bundle, decoder models.Decoder) ([]*models.NutritionOrder, error) {
 	var entities []*models.NutritionOrder
 	err := EnumBundleResources(bundle, "NutritionOrder", func(resource ResourceData) error {
 		var entity models.NutritionOrder
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "NutritionOrder", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13498,10 +16835,10 @@ func bundleToNutritionOrders(bundle *models.Bundle) ([]*models.NutritionOrder, e
 func fhirRespToNutritionOrders(resp *FhirResponse) ([]*models.NutritionOrder, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToNutritionOrders(resp.Bundle)
+		return bundleToNutritionOrders(resp.Bundle, resp.decoder)
 	case "NutritionOrder":
 		var entity models.NutritionOrder
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.NutritionOrder{&entity}, nil
@@ -13540,6 +16877,36 @@ func (c *Client) GetNutritionOrderByID(ctx context.Context, id string, params Pa
 	return fhirRespToNutritionOrder(id, resp)
 }
 
+// NutritionOrderSearchResult is the page of the NutritionOrder search with its metadata.
+type NutritionOrderSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.NutritionOrder
+}
+
+func newNutritionOrderSearchResult(result *SearchResult, err error) (*NutritionOrderSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &NutritionOrderSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.NutritionOrder); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search NutritionOrder with the total, links, full URLs and scores.
+func (c *Client) SearchNutritionOrder(ctx context.Context, params Parameters) (*NutritionOrderSearchResult, error) {
+	return newNutritionOrderSearchResult(c.Search(ctx, "NutritionOrder", params))
+}
+
+// Search NutritionOrder page by its link URL.
+func (c *Client) SearchNutritionOrderPage(ctx context.Context, url string) (*NutritionOrderSearchResult, error) {
+	return newNutritionOrderSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateNutritionOrder(ctx context.Context, params Parameters, entity *models.NutritionOrder) (*models.NutritionOrder, error) {
 	resp, err := c.Create(ctx, "NutritionOrder", params, entity)
 	if err != nil {
@@ -13607,11 +16974,11 @@ func (c *Client) DeleteNutritionOrderByID(ctx context.Context, id string, params
 // Observation
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToObservations(bundle *models.Bundle) ([]*models.Observation, error) {
+func bundleToObservations(bundle *models.Bundle, decoder models.Decoder) ([]*models.Observation, error) {
 	var entities []*models.Observation
 	err := EnumBundleResources(bundle, "Observation", func(resource ResourceData) error {
 		var entity models.Observation
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Observation", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13627,10 +16994,10 @@ func bundleToObservations(bundle *models.Bundle) ([]*models.Observation, error)
 func fhirRespToObservations(resp *FhirResponse) ([]*models.Observation, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToObservations(resp.Bundle)
+		return bundleToObservations(resp.Bundle, resp.decoder)
 	case "Observation":
 		var entity models.Observation
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Observation{&entity}, nil
@@ -13669,6 +17036,36 @@ func (c *Client) GetObservationByID(ctx context.Context, id string, params Param
 	return fhirRespToObservation(id, resp)
 }
 
+// ObservationSearchResult is the page of the Observation search with its metadata.
+type ObservationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Observation
+}
+
+func newObservationSearchResult(result *SearchResult, err error) (*ObservationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ObservationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Observation); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Observation with the total, links, full URLs and scores.
+func (c *Client) SearchObservation(ctx context.Context, params Parameters) (*ObservationSearchResult, error) {
+	return newObservationSearchResult(c.Search(ctx, "Observation", params))
+}
+
+// Search Observation page by its link URL.
+func (c *Client) SearchObservationPage(ctx context.Context, url string) (*ObservationSearchResult, error) {
+	return newObservationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateObservation(ctx context.Context, params Parameters, entity *models.Observation) (*models.Observation, error) {
 	resp, err := c.Create(ctx, "Observation", params, entity)
 	if err != nil {
@@ -13736,11 +17133,11 @@ func (c *Client) DeleteObservationByID(ctx context.Context, id string, params Pa
 // ObservationDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToObservationDefinitions(bundle *models.Bundle) ([]*models.ObservationDefinition, error) {
+func bundleToObservationDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.ObservationDefinition, error) {
 	var entities []*models.ObservationDefinition
 	err := EnumBundleResources(bundle, "ObservationDefinition", func(resource ResourceData) error {
 		var entity models.ObservationDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ObservationDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13756,10 +17153,10 @@ func bundleToObservationDefinitions(bundle *models.Bundle) ([]*models.Observatio
 func fhirRespToObservationDefinitions(resp *FhirResponse) ([]*models.ObservationDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToObservationDefinitions(resp.Bundle)
+		return bundleToObservationDefinitions(resp.Bundle, resp.decoder)
 	case "ObservationDefinition":
 		var entity models.ObservationDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ObservationDefinition{&entity}, nil
@@ -13798,6 +17195,36 @@ func (c *Client) GetObservationDefinitionByID(ctx context.Context, id string, pa
 	return fhirRespToObservationDefinition(id, resp)
 }
 
+// ObservationDefinitionSearchResult is the page of the ObservationDefinition search with its metadata.
+type ObservationDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ObservationDefinition
+}
+
+func newObservationDefinitionSearchResult(result *SearchResult, err error) (*ObservationDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ObservationDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ObservationDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ObservationDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchObservationDefinition(ctx context.Context, params Parameters) (*ObservationDefinitionSearchResult, error) {
+	return newObservationDefinitionSearchResult(c.Search(ctx, "ObservationDefinition", params))
+}
+
+// Search ObservationDefinition page by its link URL.
+func (c *Client) SearchObservationDefinitionPage(ctx context.Context, url string) (*ObservationDefinitionSearchResult, error) {
+	return newObservationDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateObservationDefinition(ctx context.Context, params Parameters, entity *models.ObservationDefinition) (*models.ObservationDefinition, error) {
 	resp, err := c.Create(ctx, "ObservationDefinition", params, entity)
 	if err != nil {
@@ -13865,11 +17292,11 @@ func (c *Client) DeleteObservationDefinitionByID(ctx context.Context, id string,
 // OperationDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToOperationDefinitions(bundle *models.Bundle) ([]*models.OperationDefinition, error) {
+func bundleToOperationDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.OperationDefinition, error) {
 	var entities []*models.OperationDefinition
 	err := EnumBundleResources(bundle, "OperationDefinition", func(resource ResourceData) error {
 		var entity models.OperationDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "OperationDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -13885,10 +17312,10 @@ func bundleToOperationDefinitions(bundle *models.Bundle) ([]*models.OperationDef
 func fhirRespToOperationDefinitions(resp *FhirResponse) ([]*models.OperationDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToOperationDefinitions(resp.Bundle)
+		return bundleToOperationDefinitions(resp.Bundle, resp.decoder)
 	case "OperationDefinition":
 		var entity models.OperationDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.OperationDefinition{&entity}, nil
@@ -13927,6 +17354,36 @@ func (c *Client) GetOperationDefinitionByID(ctx context.Context, id string, para
 	return fhirRespToOperationDefinition(id, resp)
 }
 
+// OperationDefinitionSearchResult is the page of the OperationDefinition search with its metadata.
+type OperationDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.OperationDefinition
+}
+
+func newOperationDefinitionSearchResult(result *SearchResult, err error) (*OperationDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &OperationDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.OperationDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search OperationDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchOperationDefinition(ctx context.Context, params Parameters) (*OperationDefinitionSearchResult, error) {
+	return newOperationDefinitionSearchResult(c.Search(ctx, "OperationDefinition", params))
+}
+
+// Search OperationDefinition page by its link URL.
+func (c *Client) SearchOperationDefinitionPage(ctx context.Context, url string) (*OperationDefinitionSearchResult, error) {
+	return newOperationDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateOperationDefinition(ctx context.Context, params Parameters, entity *models.OperationDefinition) (*models.OperationDefinition, error) {
 	resp, err := c.Create(ctx, "OperationDefinition", params, entity)
 	if err != nil {
@@ -13994,11 +17451,11 @@ func (c *Client) DeleteOperationDefinitionByID(ctx context.Context, id string, p
 // OperationOutcome
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToOperationOutcomes(bundle *models.Bundle) ([]*models.OperationOutcome, error) {
+func bundleToOperationOutcomes(bundle *models.Bundle, decoder models.Decoder) ([]*models.OperationOutcome, error) {
 	var entities []*models.OperationOutcome
 	err := EnumBundleResources(bundle, "OperationOutcome", func(resource ResourceData) error {
 		var entity models.OperationOutcome
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "OperationOutcome", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14014,10 +17471,10 @@ func bundleToOperationOutcomes(bundle *models.Bundle) ([]*models.OperationOutcom
 func fhirRespToOperationOutcomes(resp *FhirResponse) ([]*models.OperationOutcome, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToOperationOutcomes(resp.Bundle)
+		return bundleToOperationOutcomes(resp.Bundle, resp.decoder)
 	case "OperationOutcome":
 		var entity models.OperationOutcome
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.OperationOutcome{&entity}, nil
@@ -14056,6 +17513,36 @@ func (c *Client) GetOperationOutcomeByID(ctx context.Context, id string, params
 	return fhirRespToOperationOutcome(id, resp)
 }
 
+// OperationOutcomeSearchResult is the page of the OperationOutcome search with its metadata.
+type OperationOutcomeSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.OperationOutcome
+}
+
+func newOperationOutcomeSearchResult(result *SearchResult, err error) (*OperationOutcomeSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &OperationOutcomeSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.OperationOutcome); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search OperationOutcome with the total, links, full URLs and scores.
+func (c *Client) SearchOperationOutcome(ctx context.Context, params Parameters) (*OperationOutcomeSearchResult, error) {
+	return newOperationOutcomeSearchResult(c.Search(ctx, "OperationOutcome", params))
+}
+
+// Search OperationOutcome page by its link URL.
+func (c *Client) SearchOperationOutcomePage(ctx context.Context, url string) (*OperationOutcomeSearchResult, error) {
+	return newOperationOutcomeSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateOperationOutcome(ctx context.Context, params Parameters, entity *models.OperationOutcome) (*models.OperationOutcome, error) {
 	resp, err := c.Create(ctx, "OperationOutcome", params, entity)
 	if err != nil {
@@ -14123,11 +17610,11 @@ func (c *Client) DeleteOperationOutcomeByID(ctx context.Context, id string, para
 // Organization
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToOrganizations(bundle *models.Bundle) ([]*models.Organization, error) {
+func bundleToOrganizations(bundle *models.Bundle, decoder models.Decoder) ([]*models.Organization, error) {
 	var entities []*models.Organization
 	err := EnumBundleResources(bundle, "Organization", func(resource ResourceData) error {
 		var entity models.Organization
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Organization", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14143,10 +17630,10 @@ func bundleToOrganizations(bundle *models.Bundle) ([]*models.Organization, error
 func fhirRespToOrganizations(resp *FhirResponse) ([]*models.Organization, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToOrganizations(resp.Bundle)
+		return bundleToOrganizations(resp.Bundle, resp.decoder)
 	case "Organization":
 		var entity models.Organization
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Organization{&entity}, nil
@@ -14185,6 +17672,36 @@ func (c *Client) GetOrganizationByID(ctx context.Context, id string, params Para
 	return fhirRespToOrganization(id, resp)
 }
 
+// OrganizationSearchResult is the page of the Organization search with its metadata.
+type OrganizationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Organization
+}
+
+func newOrganizationSearchResult(result *SearchResult, err error) (*OrganizationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &OrganizationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Organization); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Organization with the total, links, full URLs and scores.
+func (c *Client) SearchOrganization(ctx context.Context, params Parameters) (*OrganizationSearchResult, error) {
+	return newOrganizationSearchResult(c.Search(ctx, "Organization", params))
+}
+
+// Search Organization page by its link URL.
+func (c *Client) SearchOrganizationPage(ctx context.Context, url string) (*OrganizationSearchResult, error) {
+	return newOrganizationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateOrganization(ctx context.Context, params Parameters, entity *models.Organization) (*models.Organization, error) {
 	resp, err := c.Create(ctx, "Organization", params, entity)
 	if err != nil {
@@ -14252,11 +17769,11 @@ func (c *Client) DeleteOrganizationByID(ctx context.Context, id string, params P
 // OrganizationAffiliation
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToOrganizationAffiliations(bundle *models.Bundle) ([]*models.OrganizationAffiliation, error) {
+func bundleToOrganizationAffiliations(bundle *models.Bundle, decoder models.Decoder) ([]*models.OrganizationAffiliation, error) {
 	var entities []*models.OrganizationAffiliation
 	err := EnumBundleResources(bundle, "OrganizationAffiliation", func(resource ResourceData) error {
 		var entity models.OrganizationAffiliation
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "OrganizationAffiliation", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14272,10 +17789,10 @@ func bundleToOrganizationAffiliations(bundle *models.Bundle) ([]*models.Organiza
 func fhirRespToOrganizationAffiliations(resp *FhirResponse) ([]*models.OrganizationAffiliation, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToOrganizationAffiliations(resp.Bundle)
+		return bundleToOrganizationAffiliations(resp.Bundle, resp.decoder)
 	case "OrganizationAffiliation":
 		var entity models.OrganizationAffiliation
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.OrganizationAffiliation{&entity}, nil
@@ -14314,6 +17831,36 @@ func (c *Client) GetOrganizationAffiliationByID(ctx context.Context, id string,
 	return fhirRespToOrganizationAffiliation(id, resp)
 }
 
+// OrganizationAffiliationSearchResult is the page of the OrganizationAffiliation search with its metadata.
+type OrganizationAffiliationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.OrganizationAffiliation
+}
+
+func newOrganizationAffiliationSearchResult(result *SearchResult, err error) (*OrganizationAffiliationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &OrganizationAffiliationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.OrganizationAffiliation); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search OrganizationAffiliation with the total, links, full URLs and scores.
+func (c *Client) SearchOrganizationAffiliation(ctx context.Context, params Parameters) (*OrganizationAffiliationSearchResult, error) {
+	return newOrganizationAffiliationSearchResult(c.Search(ctx, "OrganizationAffiliation", params))
+}
+
+// Search OrganizationAffiliation page by its link URL.
+func (c *Client) SearchOrganizationAffiliationPage(ctx context.Context, url string) (*OrganizationAffiliationSearchResult, error) {
+	return newOrganizationAffiliationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateOrganizationAffiliation(ctx context.Context, params Parameters, entity *models.OrganizationAffiliation) (*models.OrganizationAffiliation, error) {
 	resp, err := c.Create(ctx, "OrganizationAffiliation", params, entity)
 	if err != nil {
@@ -14381,11 +17928,11 @@ func (c *Client) DeleteOrganizationAffiliationByID(ctx context.Context, id strin
 // Parameters
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToParameterss(bundle *models.Bundle) ([]*models.Parameters, error) {
+func bundleToParameterss(bundle *models.Bundle, decoder models.Decoder) ([]*models.Parameters, error) {
 	var entities []*models.Parameters
 	err := EnumBundleResources(bundle, "Parameters", func(resource ResourceData) error {
 		var entity models.Parameters
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Parameters", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14401,10 +17948,10 @@ func bundleToParameterss(bundle *models.Bundle) ([]*models.Parameters, error) {
 func fhirRespToParameterss(resp *FhirResponse) ([]*models.Parameters, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToParameterss(resp.Bundle)
+		return bundleToParameterss(resp.Bundle, resp.decoder)
 	case "Parameters":
 		var entity models.Parameters
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Parameters{&entity}, nil
@@ -14443,6 +17990,36 @@ func (c *Client) GetParametersByID(ctx context.Context, id string, params Parame
 	return fhirRespToParameters(id, resp)
 }
 
+// ParametersSearchResult is the page of the Parameters search with its metadata.
+type ParametersSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Parameters
+}
+
+func newParametersSearchResult(result *SearchResult, err error) (*ParametersSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ParametersSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Parameters); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Parameters with the total, links, full URLs and scores.
+func (c *Client) SearchParameters(ctx context.Context, params Parameters) (*ParametersSearchResult, error) {
+	return newParametersSearchResult(c.Search(ctx, "Parameters", params))
+}
+
+// Search Parameters page by its link URL.
+func (c *Client) SearchParametersPage(ctx context.Context, url string) (*ParametersSearchResult, error) {
+	return newParametersSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateParameters(ctx context.Context, params Parameters, entity *models.Parameters) (*models.Parameters, error) {
 	resp, err := c.Create(ctx, "Parameters", params, entity)
 	if err != nil {
@@ -14510,11 +18087,11 @@ func (c *Client) DeleteParametersByID(ctx context.Context, id string, params Par
 // Patient
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPatients(bundle *models.Bundle) ([]*models.Patient, error) {
+func bundleToPatients(bundle *models.Bundle, decoder models.Decoder) ([]*models.Patient, error) {
 	var entities []*models.Patient
 	err := EnumBundleResources(bundle, "Patient", func(resource ResourceData) error {
 		var entity models.Patient
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Patient", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14530,10 +18107,10 @@ func bundleToPatients(bundle *models.Bundle) ([]*models.Patient, error) {
 func fhirRespToPatients(resp *FhirResponse) ([]*models.Patient, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPatients(resp.Bundle)
+		return bundleToPatients(resp.Bundle, resp.decoder)
 	case "Patient":
 		var entity models.Patient
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Patient{&entity}, nil
@@ -14572,6 +18149,36 @@ func (c *Client) GetPatientByID(ctx context.Context, id string, params Parameter
 	return fhirRespToPatient(id, resp)
 }
 
+// PatientSearchResult is the page of the Patient search with its metadata.
+type PatientSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Patient
+}
+
+func newPatientSearchResult(result *SearchResult, err error) (*PatientSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &PatientSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Patient); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Patient with the total, links, full URLs and scores.
+func (c *Client) SearchPatient(ctx context.Context, params Parameters) (*PatientSearchResult, error) {
+	return newPatientSearchResult(c.Search(ctx, "Patient", params))
+}
+
+// Search Patient page by its link URL.
+func (c *Client) SearchPatientPage(ctx context.Context, url string) (*PatientSearchResult, error) {
+	return newPatientSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreatePatient(ctx context.Context, params Parameters, entity *models.Patient) (*models.Patient, error) {
 	resp, err := c.Create(ctx, "Patient", params, entity)
 	if err != nil {
@@ -14639,11 +18246,11 @@ func (c *Client) DeletePatientByID(ctx context.Context, id string, params Parame
 // PaymentNotice
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPaymentNotices(bundle *models.Bundle) ([]*models.PaymentNotice, error) {
+func bundleToPaymentNotices(bundle *models.Bundle, decoder models.Decoder) ([]*models.PaymentNotice, error) {
 	var entities []*models.PaymentNotice
 	err := EnumBundleResources(bundle, "PaymentNotice", func(resource ResourceData) error {
 		var entity models.PaymentNotice
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "PaymentNotice", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14659,10 +18266,10 @@ func bundleToPaymentNotices(bundle *models.Bundle) ([]*models.PaymentNotice, err
 func fhirRespToPaymentNotices(resp *FhirResponse) ([]*models.PaymentNotice, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPaymentNotices(resp.Bundle)
+		return bundleToPaymentNotices(resp.Bundle, resp.decoder)
 	case "PaymentNotice":
 		var entity models.PaymentNotice
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.PaymentNotice{&entity}, nil
@@ -14701,6 +18308,36 @@ func (c *Client) GetPaymentNoticeByID(ctx context.Context, id string, params Par
 	return fhirRespToPaymentNotice(id, resp)
 }
 
+// PaymentNoticeSearchResult is the page of the PaymentNotice search with its metadata.
+type PaymentNoticeSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.PaymentNotice
+}
+
+func newPaymentNoticeSearchResult(result *SearchResult, err error) (*PaymentNoticeSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &PaymentNoticeSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.PaymentNotice); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search PaymentNotice with the total, links, full URLs and scores.
+func (c *Client) SearchPaymentNotice(ctx context.Context, params Parameters) (*PaymentNoticeSearchResult, error) {
+	return newPaymentNoticeSearchResult(c.Search(ctx, "PaymentNotice", params))
+}
+
+// Search PaymentNotice page by its link URL.
+func (c *Client) SearchPaymentNoticePage(ctx context.Context, url string) (*PaymentNoticeSearchResult, error) {
+	return newPaymentNoticeSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreatePaymentNotice(ctx context.Context, params Parameters, entity *models.PaymentNotice) (*models.PaymentNotice, error) {
 	resp, err := c.Create(ctx, "PaymentNotice", params, entity)
 	if err != nil {
@@ -14768,11 +18405,11 @@ func (c *Client) DeletePaymentNoticeByID(ctx context.Context, id string, params
 // PaymentReconciliation
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPaymentReconciliations(bundle *models.Bundle) ([]*models.PaymentReconciliation, error) {
+func bundleToPaymentReconciliations(bundle *models.Bundle, decoder models.Decoder) ([]*models.PaymentReconciliation, error) {
 	var entities []*models.PaymentReconciliation
 	err := EnumBundleResources(bundle, "PaymentReconciliation", func(resource ResourceData) error {
 		var entity models.PaymentReconciliation
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "PaymentReconciliation", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14788,10 +18425,10 @@ func bundleToPaymentReconciliations(bundle *models.Bundle) ([]*models.PaymentRec
 func fhirRespToPaymentReconciliations(resp *FhirResponse) ([]*models.PaymentReconciliation, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPaymentReconciliations(resp.Bundle)
+		return bundleToPaymentReconciliations(resp.Bundle, resp.decoder)
 	case "PaymentReconciliation":
 		var entity models.PaymentReconciliation
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.PaymentReconciliation{&entity}, nil
@@ -14830,6 +18467,36 @@ func (c *Client) GetPaymentReconciliationByID(ctx context.Context, id string, pa
 	return fhirRespToPaymentReconciliation(id, resp)
 }
 
+// PaymentReconciliationSearchResult is the page of the PaymentReconciliation search with its metadata.
+type PaymentReconciliationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.PaymentReconciliation
+}
+
+func newPaymentReconciliationSearchResult(result *SearchResult, err error) (*PaymentReconciliationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &PaymentReconciliationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.PaymentReconciliation); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search PaymentReconciliation with the total, links, full URLs and scores.
+func (c *Client) SearchPaymentReconciliation(ctx context.Context, params Parameters) (*PaymentReconciliationSearchResult, error) {
+	return newPaymentReconciliationSearchResult(c.Search(ctx, "PaymentReconciliation", params))
+}
+
+// Search PaymentReconciliation page by its link URL.
+func (c *Client) SearchPaymentReconciliationPage(ctx context.Context, url string) (*PaymentReconciliationSearchResult, error) {
+	return newPaymentReconciliationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreatePaymentReconciliation(ctx context.Context, params Parameters, entity *models.PaymentReconciliation) (*models.PaymentReconciliation, error) {
 	resp, err := c.Create(ctx, "PaymentReconciliation", params, entity)
 	if err != nil {
@@ -14897,11 +18564,11 @@ func (c *Client) DeletePaymentReconciliationByID(ctx context.Context, id string,
 // Person
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPersons(bundle *models.Bundle) ([]*models.Person, error) {
+func bundleToPersons(bundle *models.Bundle, decoder models.Decoder) ([]*models.Person, error) {
 	var entities []*models.Person
 	err := EnumBundleResources(bundle, "Person", func(resource ResourceData) error {
 		var entity models.Person
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Person", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -14917,10 +18584,10 @@ func bundleToPersons(bundle *models.Bundle) ([]*models.Person, error) {
 func fhirRespToPersons(resp *FhirResponse) ([]*models.Person, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPersons(resp.Bundle)
+		return bundleToPersons(resp.Bundle, resp.decoder)
 	case "Person":
 		var entity models.Person
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Person{&entity}, nil
@@ -14959,6 +18626,36 @@ func (c *Client) GetPersonByID(ctx context.Context, id string, params Parameters
 	return fhirRespToPerson(id, resp)
 }
 
+// PersonSearchResult is the page of the Person search with its metadata.
+type PersonSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Person
+}
+
+func newPersonSearchResult(result *SearchResult, err error) (*PersonSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &PersonSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Person); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Person with the total, links, full URLs and scores.
+func (c *Client) SearchPerson(ctx context.Context, params Parameters) (*PersonSearchResult, error) {
+	return newPersonSearchResult(c.Search(ctx, "Person", params))
+}
+
+// Search Person page by its link URL.
+func (c *Client) SearchPersonPage(ctx context.Context, url string) (*PersonSearchResult, error) {
+	return newPersonSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreatePerson(ctx context.Context, params Parameters, entity *models.Person) (*models.Person, error) {
 	resp, err := c.Create(ctx, "Person", params, entity)
 	if err != nil {
@@ -15026,11 +18723,11 @@ func (c *Client) DeletePersonByID(ctx context.Context, id string, params Paramet
 // PlanDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPlanDefinitions(bundle *models.Bundle) ([]*models.PlanDefinition, error) {
+func bundleToPlanDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.PlanDefinition, error) {
 	var entities []*models.PlanDefinition
 	err := EnumBundleResources(bundle, "PlanDefinition", func(resource ResourceData) error {
 		var entity models.PlanDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "PlanDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15046,10 +18743,10 @@ func bundleToPlanDefinitions(bundle *models.Bundle) ([]*models.PlanDefinition, e
 func fhirRespToPlanDefinitions(resp *FhirResponse) ([]*models.PlanDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPlanDefinitions(resp.Bundle)
+		return bundleToPlanDefinitions(resp.Bundle, resp.decoder)
 	case "PlanDefinition":
 		var entity models.PlanDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.PlanDefinition{&entity}, nil
@@ -15088,6 +18785,36 @@ func (c *Client) GetPlanDefinitionByID(ctx context.Context, id string, params Pa
 	return fhirRespToPlanDefinition(id, resp)
 }
 
+// PlanDefinitionSearchResult is the page of the PlanDefinition search with its metadata.
+type PlanDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.PlanDefinition
+}
+
+func newPlanDefinitionSearchResult(result *SearchResult, err error) (*PlanDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &PlanDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.PlanDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search PlanDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchPlanDefinition(ctx context.Context, params Parameters) (*PlanDefinitionSearchResult, error) {
+	return newPlanDefinitionSearchResult(c.Search(ctx, "PlanDefinition", params))
+}
+
+// Search PlanDefinition page by its link URL.
+func (c *Client) SearchPlanDefinitionPage(ctx context.Context, url string) (*PlanDefinitionSearchResult, error) {
+	return newPlanDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreatePlanDefinition(ctx context.Context, params Parameters, entity *models.PlanDefinition) (*models.PlanDefinition, error) {
 	resp, err := c.Create(ctx, "PlanDefinition", params, entity)
 	if err != nil {
@@ -15155,11 +18882,11 @@ func (c *Client) DeletePlanDefinitionByID(ctx context.Context, id string, params
 // Practitioner
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPractitioners(bundle *models.Bundle) ([]*models.Practitioner, error) {
+func bundleToPractitioners(bundle *models.Bundle, decoder models.Decoder) ([]*models.Practitioner, error) {
 	var entities []*models.Practitioner
 	err := EnumBundleResources(bundle, "Practitioner", func(resource ResourceData) error {
 		var entity models.Practitioner
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Practitioner", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15175,10 +18902,10 @@ func bundleToPractitioners(bundle *models.Bundle) ([]*models.Practitioner, error
 func fhirRespToPractitioners(resp *FhirResponse) ([]*models.Practitioner, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPractitioners(resp.Bundle)
+		return bundleToPractitioners(resp.Bundle, resp.decoder)
 	case "Practitioner":
 		var entity models.Practitioner
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Practitioner{&entity}, nil
@@ -15217,6 +18944,36 @@ func (c *Client) GetPractitionerByID(ctx context.Context, id string, params Para
 	return fhirRespToPractitioner(id, resp)
 }
 
+// PractitionerSearchResult is the page of the Practitioner search with its metadata.
+type PractitionerSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Practitioner
+}
+
+func newPractitionerSearchResult(result *SearchResult, err error) (*PractitionerSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &PractitionerSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Practitioner); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Practitioner with the total, links, full URLs and scores.
+func (c *Client) SearchPractitioner(ctx context.Context, params Parameters) (*PractitionerSearchResult, error) {
+	return newPractitionerSearchResult(c.Search(ctx, "Practitioner", params))
+}
+
+// Search Practitioner page by its link URL.
+func (c *Client) SearchPractitionerPage(ctx context.Context, url string) (*PractitionerSearchResult, error) {
+	return newPractitionerSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreatePractitioner(ctx context.Context, params Parameters, entity *models.Practitioner) (*models.Practitioner, error) {
 	resp, err := c.Create(ctx, "Practitioner", params, entity)
 	if err != nil {
@@ -15284,11 +19041,11 @@ func (c *Client) DeletePractitionerByID(ctx context.Context, id string, params P
 // PractitionerRole
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToPractitionerRoles(bundle *models.Bundle) ([]*models.PractitionerRole, error) {
+func bundleToPractitionerRoles(bundle *models.Bundle, decoder models.Decoder) ([]*models.PractitionerRole, error) {
 	var entities []*models.PractitionerRole
 	err := EnumBundleResources(bundle, "PractitionerRole", func(resource ResourceData) error {
 		var entity models.PractitionerRole
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "PractitionerRole", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15304,10 +19061,10 @@ func bundleToPractitionerRoles(bundle *models.Bundle) ([]*models.PractitionerRol
 func fhirRespToPractitionerRoles(resp *FhirResponse) ([]*models.PractitionerRole, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToPractitionerRoles(resp.Bundle)
+		return bundleToPractitionerRoles(resp.Bundle, resp.decoder)
 	case "PractitionerRole":
 		var entity models.PractitionerRole
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.PractitionerRole{&entity}, nil
@@ -15333,17 +19090,47 @@ func (c *Client) GetPractitionerRole(ctx context.Context, params Parameters) ([]
 		return nil, err
 	}
 
-	return fhirRespToPractitionerRoles(resp)
+	return fhirRespToPractitionerRoles(resp)
+}
+
+// Get PractitionerRole by ID.
+func (c *Client) GetPractitionerRoleByID(ctx context.Context, id string, params Parameters) (*models.PractitionerRole, error) {
+	resp, err := c.GetByID(ctx, "PractitionerRole", id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPractitionerRole(id, resp)
+}
+
+// PractitionerRoleSearchResult is the page of the PractitionerRole search with its metadata.
+type PractitionerRoleSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.PractitionerRole
 }
 
-// Get PractitionerRole by ID.
-func (c *Client) GetPractitionerRoleByID(ctx context.Context, id string, params Parameters) (*models.PractitionerRole, error) {
-	resp, err := c.GetByID(ctx, "PractitionerRole", id, params)
+func newPractitionerRoleSearchResult(result *SearchResult, err error) (*PractitionerRoleSearchResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	typed := &PractitionerRoleSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.PractitionerRole); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
 
-	return fhirRespToPractitionerRole(id, resp)
+// Search PractitionerRole with the total, links, full URLs and scores.
+func (c *Client) SearchPractitionerRole(ctx context.Context, params Parameters) (*PractitionerRoleSearchResult, error) {
+	return newPractitionerRoleSearchResult(c.Search(ctx, "PractitionerRole", params))
+}
+
+// Search PractitionerRole page by its link URL.
+func (c *Client) SearchPractitionerRolePage(ctx context.Context, url string) (*PractitionerRoleSearchResult, error) {
+	return newPractitionerRoleSearchResult(c.SearchPage(ctx, url))
 }
 
 func (c *Client) CreatePractitionerRole(ctx context.Context, params Parameters, entity *models.PractitionerRole) (*models.PractitionerRole, error) {
@@ -15413,11 +19200,11 @@ func (c *Client) DeletePractitionerRoleByID(ctx context.Context, id string, para
 // Procedure
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToProcedures(bundle *models.Bundle) ([]*models.Procedure, error) {
+func bundleToProcedures(bundle *models.Bundle, decoder models.Decoder) ([]*models.Procedure, error) {
 	var entities []*models.Procedure
 	err := EnumBundleResources(bundle, "Procedure", func(resource ResourceData) error {
 		var entity models.Procedure
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Procedure", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15433,10 +19220,10 @@ func bundleToProcedures(bundle *models.Bundle) ([]*models.Procedure, error) {
 func fhirRespToProcedures(resp *FhirResponse) ([]*models.Procedure, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToProcedures(resp.Bundle)
+		return bundleToProcedures(resp.Bundle, resp.decoder)
 	case "Procedure":
 		var entity models.Procedure
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Procedure{&entity}, nil
@@ -15475,6 +19262,36 @@ func (c *Client) GetProcedureByID(ctx context.Context, id string, params Paramet
 	return fhirRespToProcedure(id, resp)
 }
 
+// ProcedureSearchResult is the page of the Procedure search with its metadata.
+type ProcedureSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Procedure
+}
+
+func newProcedureSearchResult(result *SearchResult, err error) (*ProcedureSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ProcedureSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Procedure); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Procedure with the total, links, full URLs and scores.
+func (c *Client) SearchProcedure(ctx context.Context, params Parameters) (*ProcedureSearchResult, error) {
+	return newProcedureSearchResult(c.Search(ctx, "Procedure", params))
+}
+
+// Search Procedure page by its link URL.
+func (c *Client) SearchProcedurePage(ctx context.Context, url string) (*ProcedureSearchResult, error) {
+	return newProcedureSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateProcedure(ctx context.Context, params Parameters, entity *models.Procedure) (*models.Procedure, error) {
 	resp, err := c.Create(ctx, "Procedure", params, entity)
 	if err != nil {
@@ -15542,11 +19359,11 @@ func (c *Client) DeleteProcedureByID(ctx context.Context, id string, params Para
 // Provenance
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToProvenances(bundle *models.Bundle) ([]*models.Provenance, error) {
+func bundleToProvenances(bundle *models.Bundle, decoder models.Decoder) ([]*models.Provenance, error) {
 	var entities []*models.Provenance
 	err := EnumBundleResources(bundle, "Provenance", func(resource ResourceData) error {
 		var entity models.Provenance
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Provenance", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15562,10 +19379,10 @@ func bundleToProvenances(bundle *models.Bundle) ([]*models.Provenance, error) {
 func fhirRespToProvenances(resp *FhirResponse) ([]*models.Provenance, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToProvenances(resp.Bundle)
+		return bundleToProvenances(resp.Bundle, resp.decoder)
 	case "Provenance":
 		var entity models.Provenance
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Provenance{&entity}, nil
@@ -15604,6 +19421,36 @@ func (c *Client) GetProvenanceByID(ctx context.Context, id string, params Parame
 	return fhirRespToProvenance(id, resp)
 }
 
+// ProvenanceSearchResult is the page of the Provenance search with its metadata.
+type ProvenanceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Provenance
+}
+
+func newProvenanceSearchResult(result *SearchResult, err error) (*ProvenanceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ProvenanceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Provenance); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Provenance with the total, links, full URLs and scores.
+func (c *Client) SearchProvenance(ctx context.Context, params Parameters) (*ProvenanceSearchResult, error) {
+	return newProvenanceSearchResult(c.Search(ctx, "Provenance", params))
+}
+
+// Search Provenance page by its link URL.
+func (c *Client) SearchProvenancePage(ctx context.Context, url string) (*ProvenanceSearchResult, error) {
+	return newProvenanceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateProvenance(ctx context.Context, params Parameters, entity *models.Provenance) (*models.Provenance, error) {
 	resp, err := c.Create(ctx, "Provenance", params, entity)
 	if err != nil {
@@ -15671,11 +19518,11 @@ func (c *Client) DeleteProvenanceByID(ctx context.Context, id string, params Par
 // Questionnaire
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToQuestionnaires(bundle *models.Bundle) ([]*models.Questionnaire, error) {
+func bundleToQuestionnaires(bundle *models.Bundle, decoder models.Decoder) ([]*models.Questionnaire, error) {
 	var entities []*models.Questionnaire
 	err := EnumBundleResources(bundle, "Questionnaire", func(resource ResourceData) error {
 		var entity models.Questionnaire
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Questionnaire", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15691,10 +19538,10 @@ func bundleToQuestionnaires(bundle *models.Bundle) ([]*models.Questionnaire, err
 func fhirRespToQuestionnaires(resp *FhirResponse) ([]*models.Questionnaire, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToQuestionnaires(resp.Bundle)
+		return bundleToQuestionnaires(resp.Bundle, resp.decoder)
 	case "Questionnaire":
 		var entity models.Questionnaire
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Questionnaire{&entity}, nil
@@ -15733,6 +19580,36 @@ func (c *Client) GetQuestionnaireByID(ctx context.Context, id string, params Par
 	return fhirRespToQuestionnaire(id, resp)
 }
 
+// QuestionnaireSearchResult is the page of the Questionnaire search with its metadata.
+type QuestionnaireSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Questionnaire
+}
+
+func newQuestionnaireSearchResult(result *SearchResult, err error) (*QuestionnaireSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &QuestionnaireSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Questionnaire); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Questionnaire with the total, links, full URLs and scores.
+func (c *Client) SearchQuestionnaire(ctx context.Context, params Parameters) (*QuestionnaireSearchResult, error) {
+	return newQuestionnaireSearchResult(c.Search(ctx, "Questionnaire", params))
+}
+
+// Search Questionnaire page by its link URL.
+func (c *Client) SearchQuestionnairePage(ctx context.Context, url string) (*QuestionnaireSearchResult, error) {
+	return newQuestionnaireSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateQuestionnaire(ctx context.Context, params Parameters, entity *models.Questionnaire) (*models.Questionnaire, error) {
 	resp, err := c.Create(ctx, "Questionnaire", params, entity)
 	if err != nil {
@@ -15800,11 +19677,11 @@ func (c *Client) DeleteQuestionnaireByID(ctx context.Context, id string, params
 // QuestionnaireResponse
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToQuestionnaireResponses(bundle *models.Bundle) ([]*models.QuestionnaireResponse, error) {
+func bundleToQuestionnaireResponses(bundle *models.Bundle, decoder models.Decoder) ([]*models.QuestionnaireResponse, error) {
 	var entities []*models.QuestionnaireResponse
 	err := EnumBundleResources(bundle, "QuestionnaireResponse", func(resource ResourceData) error {
 		var entity models.QuestionnaireResponse
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "QuestionnaireResponse", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15820,10 +19697,10 @@ func bundleToQuestionnaireResponses(bundle *models.Bundle) ([]*models.Questionna
 func fhirRespToQuestionnaireResponses(resp *FhirResponse) ([]*models.QuestionnaireResponse, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToQuestionnaireResponses(resp.Bundle)
+		return bundleToQuestionnaireResponses(resp.Bundle, resp.decoder)
 	case "QuestionnaireResponse":
 		var entity models.QuestionnaireResponse
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.QuestionnaireResponse{&entity}, nil
@@ -15862,6 +19739,36 @@ func (c *Client) GetQuestionnaireResponseByID(ctx context.Context, id string, pa
 	return fhirRespToQuestionnaireResponse(id, resp)
 }
 
+// QuestionnaireResponseSearchResult is the page of the QuestionnaireResponse search with its metadata.
+type QuestionnaireResponseSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.QuestionnaireResponse
+}
+
+func newQuestionnaireResponseSearchResult(result *SearchResult, err error) (*QuestionnaireResponseSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &QuestionnaireResponseSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.QuestionnaireResponse); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search QuestionnaireResponse with the total, links, full URLs and scores.
+func (c *Client) SearchQuestionnaireResponse(ctx context.Context, params Parameters) (*QuestionnaireResponseSearchResult, error) {
+	return newQuestionnaireResponseSearchResult(c.Search(ctx, "QuestionnaireResponse", params))
+}
+
+// Search QuestionnaireResponse page by its link URL.
+func (c *Client) SearchQuestionnaireResponsePage(ctx context.Context, url string) (*QuestionnaireResponseSearchResult, error) {
+	return newQuestionnaireResponseSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateQuestionnaireResponse(ctx context.Context, params Parameters, entity *models.QuestionnaireResponse) (*models.QuestionnaireResponse, error) {
 	resp, err := c.Create(ctx, "QuestionnaireResponse", params, entity)
 	if err != nil {
@@ -15929,11 +19836,11 @@ func (c *Client) DeleteQuestionnaireResponseByID(ctx context.Context, id string,
 // RelatedPerson
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToRelatedPersons(bundle *models.Bundle) ([]*models.RelatedPerson, error) {
+func bundleToRelatedPersons(bundle *models.Bundle, decoder models.Decoder) ([]*models.RelatedPerson, error) {
 	var entities []*models.RelatedPerson
 	err := EnumBundleResources(bundle, "RelatedPerson", func(resource ResourceData) error {
 		var entity models.RelatedPerson
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "RelatedPerson", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -15949,10 +19856,10 @@ func bundleToRelatedPersons(bundle *models.Bundle) ([]*models.RelatedPerson, err
 func fhirRespToRelatedPersons(resp *FhirResponse) ([]*models.RelatedPerson, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToRelatedPersons(resp.Bundle)
+		return bundleToRelatedPersons(resp.Bundle, resp.decoder)
 	case "RelatedPerson":
 		var entity models.RelatedPerson
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.RelatedPerson{&entity}, nil
@@ -15991,6 +19898,36 @@ func (c *Client) GetRelatedPersonByID(ctx context.Context, id string, params Par
 	return fhirRespToRelatedPerson(id, resp)
 }
 
+// RelatedPersonSearchResult is the page of the RelatedPerson search with its metadata.
+type RelatedPersonSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.RelatedPerson
+}
+
+func newRelatedPersonSearchResult(result *SearchResult, err error) (*RelatedPersonSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &RelatedPersonSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.RelatedPerson); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search RelatedPerson with the total, links, full URLs and scores.
+func (c *Client) SearchRelatedPerson(ctx context.Context, params Parameters) (*RelatedPersonSearchResult, error) {
+	return newRelatedPersonSearchResult(c.Search(ctx, "RelatedPerson", params))
+}
+
+// Search RelatedPerson page by its link URL.
+func (c *Client) SearchRelatedPersonPage(ctx context.Context, url string) (*RelatedPersonSearchResult, error) {
+	return newRelatedPersonSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateRelatedPerson(ctx context.Context, params Parameters, entity *models.RelatedPerson) (*models.RelatedPerson, error) {
 	resp, err := c.Create(ctx, "RelatedPerson", params, entity)
 	if err != nil {
@@ -16058,11 +19995,11 @@ func (c *Client) DeleteRelatedPersonByID(ctx context.Context, id string, params
 // RequestGroup
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToRequestGroups(bundle *models.Bundle) ([]*models.RequestGroup, error) {
+func bundleToRequestGroups(bundle *models.Bundle, decoder models.Decoder) ([]*models.RequestGroup, error) {
 	var entities []*models.RequestGroup
 	err := EnumBundleResources(bundle, "RequestGroup", func(resource ResourceData) error {
 		var entity models.RequestGroup
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "RequestGroup", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16078,10 +20015,10 @@ func bundleToRequestGroups(bundle *models.Bundle) ([]*models.RequestGroup, error
 func fhirRespToRequestGroups(resp *FhirResponse) ([]*models.RequestGroup, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToRequestGroups(resp.Bundle)
+		return bundleToRequestGroups(resp.Bundle, resp.decoder)
 	case "RequestGroup":
 		var entity models.RequestGroup
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.RequestGroup{&entity}, nil
@@ -16120,6 +20057,36 @@ func (c *Client) GetRequestGroupByID(ctx context.Context, id string, params Para
 	return fhirRespToRequestGroup(id, resp)
 }
 
+// RequestGroupSearchResult is the page of the RequestGroup search with its metadata.
+type RequestGroupSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.RequestGroup
+}
+
+func newRequestGroupSearchResult(result *SearchResult, err error) (*RequestGroupSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &RequestGroupSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.RequestGroup); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search RequestGroup with the total, links, full URLs and scores.
+func (c *Client) SearchRequestGroup(ctx context.Context, params Parameters) (*RequestGroupSearchResult, error) {
+	return newRequestGroupSearchResult(c.Search(ctx, "RequestGroup", params))
+}
+
+// Search RequestGroup page by its link URL.
+func (c *Client) SearchRequestGroupPage(ctx context.Context, url string) (*RequestGroupSearchResult, error) {
+	return newRequestGroupSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateRequestGroup(ctx context.Context, params Parameters, entity *models.RequestGroup) (*models.RequestGroup, error) {
 	resp, err := c.Create(ctx, "RequestGroup", params, entity)
 	if err != nil {
@@ -16187,11 +20154,11 @@ func (c *Client) DeleteRequestGroupByID(ctx context.Context, id string, params P
 // ResearchDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToResearchDefinitions(bundle *models.Bundle) ([]*models.ResearchDefinition, error) {
+func bundleToResearchDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.ResearchDefinition, error) {
 	var entities []*models.ResearchDefinition
 	err := EnumBundleResources(bundle, "ResearchDefinition", func(resource ResourceData) error {
 		var entity models.ResearchDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ResearchDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16207,10 +20174,10 @@ func bundleToResearchDefinitions(bundle *models.Bundle) ([]*models.ResearchDefin
 func fhirRespToResearchDefinitions(resp *FhirResponse) ([]*models.ResearchDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToResearchDefinitions(resp.Bundle)
+		return bundleToResearchDefinitions(resp.Bundle, resp.decoder)
 	case "ResearchDefinition":
 		var entity models.ResearchDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ResearchDefinition{&entity}, nil
@@ -16249,6 +20216,36 @@ func (c *Client) GetResearchDefinitionByID(ctx context.Context, id string, param
 	return fhirRespToResearchDefinition(id, resp)
 }
 
+// ResearchDefinitionSearchResult is the page of the ResearchDefinition search with its metadata.
+type ResearchDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ResearchDefinition
+}
+
+func newResearchDefinitionSearchResult(result *SearchResult, err error) (*ResearchDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ResearchDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ResearchDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ResearchDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchResearchDefinition(ctx context.Context, params Parameters) (*ResearchDefinitionSearchResult, error) {
+	return newResearchDefinitionSearchResult(c.Search(ctx, "ResearchDefinition", params))
+}
+
+// Search ResearchDefinition page by its link URL.
+func (c *Client) SearchResearchDefinitionPage(ctx context.Context, url string) (*ResearchDefinitionSearchResult, error) {
+	return newResearchDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateResearchDefinition(ctx context.Context, params Parameters, entity *models.ResearchDefinition) (*models.ResearchDefinition, error) {
 	resp, err := c.Create(ctx, "ResearchDefinition", params, entity)
 	if err != nil {
@@ -16316,11 +20313,11 @@ func (c *Client) DeleteResearchDefinitionByID(ctx context.Context, id string, pa
 // ResearchElementDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToResearchElementDefinitions(bundle *models.Bundle) ([]*models.ResearchElementDefinition, error) {
+func bundleToResearchElementDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.ResearchElementDefinition, error) {
 	var entities []*models.ResearchElementDefinition
 	err := EnumBundleResources(bundle, "ResearchElementDefinition", func(resource ResourceData) error {
 		var entity models.ResearchElementDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ResearchElementDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16336,10 +20333,10 @@ func bundleToResearchElementDefinitions(bundle *models.Bundle) ([]*models.Resear
 func fhirRespToResearchElementDefinitions(resp *FhirResponse) ([]*models.ResearchElementDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToResearchElementDefinitions(resp.Bundle)
+		return bundleToResearchElementDefinitions(resp.Bundle, resp.decoder)
 	case "ResearchElementDefinition":
 		var entity models.ResearchElementDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ResearchElementDefinition{&entity}, nil
@@ -16378,6 +20375,36 @@ func (c *Client) GetResearchElementDefinitionByID(ctx context.Context, id string
 	return fhirRespToResearchElementDefinition(id, resp)
 }
 
+// ResearchElementDefinitionSearchResult is the page of the ResearchElementDefinition search with its metadata.
+type ResearchElementDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ResearchElementDefinition
+}
+
+func newResearchElementDefinitionSearchResult(result *SearchResult, err error) (*ResearchElementDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ResearchElementDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ResearchElementDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ResearchElementDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchResearchElementDefinition(ctx context.Context, params Parameters) (*ResearchElementDefinitionSearchResult, error) {
+	return newResearchElementDefinitionSearchResult(c.Search(ctx, "ResearchElementDefinition", params))
+}
+
+// Search ResearchElementDefinition page by its link URL.
+func (c *Client) SearchResearchElementDefinitionPage(ctx context.Context, url string) (*ResearchElementDefinitionSearchResult, error) {
+	return newResearchElementDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateResearchElementDefinition(ctx context.Context, params Parameters, entity *models.ResearchElementDefinition) (*models.ResearchElementDefinition, error) {
 	resp, err := c.Create(ctx, "ResearchElementDefinition", params, entity)
 	if err != nil {
@@ -16445,11 +20472,11 @@ func (c *Client) DeleteResearchElementDefinitionByID(ctx context.Context, id str
 // ResearchStudy
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToResearchStudys(bundle *models.Bundle) ([]*models.ResearchStudy, error) {
+func bundleToResearchStudys(bundle *models.Bundle, decoder models.Decoder) ([]*models.ResearchStudy, error) {
 	var entities []*models.ResearchStudy
 	err := EnumBundleResources(bundle, "ResearchStudy", func(resource ResourceData) error {
 		var entity models.ResearchStudy
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ResearchStudy", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16465,10 +20492,10 @@ func bundleToResearchStudys(bundle *models.Bundle) ([]*models.ResearchStudy, err
 func fhirRespToResearchStudys(resp *FhirResponse) ([]*models.ResearchStudy, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToResearchStudys(resp.Bundle)
+		return bundleToResearchStudys(resp.Bundle, resp.decoder)
 	case "ResearchStudy":
 		var entity models.ResearchStudy
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ResearchStudy{&entity}, nil
@@ -16507,6 +20534,36 @@ func (c *Client) GetResearchStudyByID(ctx context.Context, id string, params Par
 	return fhirRespToResearchStudy(id, resp)
 }
 
+// ResearchStudySearchResult is the page of the ResearchStudy search with its metadata.
+type ResearchStudySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ResearchStudy
+}
+
+func newResearchStudySearchResult(result *SearchResult, err error) (*ResearchStudySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ResearchStudySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ResearchStudy); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ResearchStudy with the total, links, full URLs and scores.
+func (c *Client) SearchResearchStudy(ctx context.Context, params Parameters) (*ResearchStudySearchResult, error) {
+	return newResearchStudySearchResult(c.Search(ctx, "ResearchStudy", params))
+}
+
+// Search ResearchStudy page by its link URL.
+func (c *Client) SearchResearchStudyPage(ctx context.Context, url string) (*ResearchStudySearchResult, error) {
+	return newResearchStudySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateResearchStudy(ctx context.Context, params Parameters, entity *models.ResearchStudy) (*models.ResearchStudy, error) {
 	resp, err := c.Create(ctx, "ResearchStudy", params, entity)
 	if err != nil {
@@ -16574,11 +20631,11 @@ func (c *Client) DeleteResearchStudyByID(ctx context.Context, id string, params
 // ResearchSubject
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToResearchSubjects(bundle *models.Bundle) ([]*models.ResearchSubject, error) {
+func bundleToResearchSubjects(bundle *models.Bundle, decoder models.Decoder) ([]*models.ResearchSubject, error) {
 	var entities []*models.ResearchSubject
 	err := EnumBundleResources(bundle, "ResearchSubject", func(resource ResourceData) error {
 		var entity models.ResearchSubject
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ResearchSubject", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16594,10 +20651,10 @@ func bundleToResearchSubjects(bundle *models.Bundle) ([]*models.ResearchSubject,
 func fhirRespToResearchSubjects(resp *FhirResponse) ([]*models.ResearchSubject, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToResearchSubjects(resp.Bundle)
+		return bundleToResearchSubjects(resp.Bundle, resp.decoder)
 	case "ResearchSubject":
 		var entity models.ResearchSubject
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ResearchSubject{&entity}, nil
@@ -16636,6 +20693,36 @@ func (c *Client) GetResearchSubjectByID(ctx context.Context, id string, params P
 	return fhirRespToResearchSubject(id, resp)
 }
 
+// ResearchSubjectSearchResult is the page of the ResearchSubject search with its metadata.
+type ResearchSubjectSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ResearchSubject
+}
+
+func newResearchSubjectSearchResult(result *SearchResult, err error) (*ResearchSubjectSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ResearchSubjectSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ResearchSubject); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ResearchSubject with the total, links, full URLs and scores.
+func (c *Client) SearchResearchSubject(ctx context.Context, params Parameters) (*ResearchSubjectSearchResult, error) {
+	return newResearchSubjectSearchResult(c.Search(ctx, "ResearchSubject", params))
+}
+
+// Search ResearchSubject page by its link URL.
+func (c *Client) SearchResearchSubjectPage(ctx context.Context, url string) (*ResearchSubjectSearchResult, error) {
+	return newResearchSubjectSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateResearchSubject(ctx context.Context, params Parameters, entity *models.ResearchSubject) (*models.ResearchSubject, error) {
 	resp, err := c.Create(ctx, "ResearchSubject", params, entity)
 	if err != nil {
@@ -16703,11 +20790,11 @@ func (c *Client) DeleteResearchSubjectByID(ctx context.Context, id string, param
 // Resource
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToResources(bundle *models.Bundle) ([]*models.Resource, error) {
+func bundleToResources(bundle *models.Bundle, decoder models.Decoder) ([]*models.Resource, error) {
 	var entities []*models.Resource
 	err := EnumBundleResources(bundle, "Resource", func(resource ResourceData) error {
 		var entity models.Resource
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Resource", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16723,10 +20810,10 @@ func bundleToResources(bundle *models.Bundle) ([]*models.Resource, error) {
 func fhirRespToResources(resp *FhirResponse) ([]*models.Resource, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToResources(resp.Bundle)
+		return bundleToResources(resp.Bundle, resp.decoder)
 	case "Resource":
 		var entity models.Resource
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Resource{&entity}, nil
@@ -16765,6 +20852,36 @@ func (c *Client) GetResourceByID(ctx context.Context, id string, params Paramete
 	return fhirRespToResource(id, resp)
 }
 
+// ResourceSearchResult is the page of the Resource search with its metadata.
+type ResourceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Resource
+}
+
+func newResourceSearchResult(result *SearchResult, err error) (*ResourceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ResourceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Resource); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Resource with the total, links, full URLs and scores.
+func (c *Client) SearchResource(ctx context.Context, params Parameters) (*ResourceSearchResult, error) {
+	return newResourceSearchResult(c.Search(ctx, "Resource", params))
+}
+
+// Search Resource page by its link URL.
+func (c *Client) SearchResourcePage(ctx context.Context, url string) (*ResourceSearchResult, error) {
+	return newResourceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateResource(ctx context.Context, params Parameters, entity *models.Resource) (*models.Resource, error) {
 	resp, err := c.Create(ctx, "Resource", params, entity)
 	if err != nil {
@@ -16832,11 +20949,11 @@ func (c *Client) DeleteResourceByID(ctx context.Context, id string, params Param
 // RiskAssessment
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToRiskAssessments(bundle *models.Bundle) ([]*models.RiskAssessment, error) {
+func bundleToRiskAssessments(bundle *models.Bundle, decoder models.Decoder) ([]*models.RiskAssessment, error) {
 	var entities []*models.RiskAssessment
 	err := EnumBundleResources(bundle, "RiskAssessment", func(resource ResourceData) error {
 		var entity models.RiskAssessment
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "RiskAssessment", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16852,10 +20969,10 @@ func bundleToRiskAssessments(bundle *models.Bundle) ([]*models.RiskAssessment, e
 func fhirRespToRiskAssessments(resp *FhirResponse) ([]*models.RiskAssessment, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToRiskAssessments(resp.Bundle)
+		return bundleToRiskAssessments(resp.Bundle, resp.decoder)
 	case "RiskAssessment":
 		var entity models.RiskAssessment
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.RiskAssessment{&entity}, nil
@@ -16894,6 +21011,36 @@ func (c *Client) GetRiskAssessmentByID(ctx context.Context, id string, params Pa
 	return fhirRespToRiskAssessment(id, resp)
 }
 
+// RiskAssessmentSearchResult is the page of the RiskAssessment search with its metadata.
+type RiskAssessmentSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.RiskAssessment
+}
+
+func newRiskAssessmentSearchResult(result *SearchResult, err error) (*RiskAssessmentSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &RiskAssessmentSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.RiskAssessment); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search RiskAssessment with the total, links, full URLs and scores.
+func (c *Client) SearchRiskAssessment(ctx context.Context, params Parameters) (*RiskAssessmentSearchResult, error) {
+	return newRiskAssessmentSearchResult(c.Search(ctx, "RiskAssessment", params))
+}
+
+// Search RiskAssessment page by its link URL.
+func (c *Client) SearchRiskAssessmentPage(ctx context.Context, url string) (*RiskAssessmentSearchResult, error) {
+	return newRiskAssessmentSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateRiskAssessment(ctx context.Context, params Parameters, entity *models.RiskAssessment) (*models.RiskAssessment, error) {
 	resp, err := c.Create(ctx, "RiskAssessment", params, entity)
 	if err != nil {
@@ -16961,11 +21108,11 @@ func (c *Client) DeleteRiskAssessmentByID(ctx context.Context, id string, params
 // RiskEvidenceSynthesis
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToRiskEvidenceSynthesiss(bundle *models.Bundle) ([]*models.RiskEvidenceSynthesis, error) {
+func bundleToRiskEvidenceSynthesiss(bundle *models.Bundle, decoder models.Decoder) ([]*models.RiskEvidenceSynthesis, error) {
 	var entities []*models.RiskEvidenceSynthesis
 	err := EnumBundleResources(bundle, "RiskEvidenceSynthesis", func(resource ResourceData) error {
 		var entity models.RiskEvidenceSynthesis
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "RiskEvidenceSynthesis", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -16981,10 +21128,10 @@ func bundleToRiskEvidenceSynthesiss(bundle *models.Bundle) ([]*models.RiskEviden
 func fhirRespToRiskEvidenceSynthesiss(resp *FhirResponse) ([]*models.RiskEvidenceSynthesis, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToRiskEvidenceSynthesiss(resp.Bundle)
+		return bundleToRiskEvidenceSynthesiss(resp.Bundle, resp.decoder)
 	case "RiskEvidenceSynthesis":
 		var entity models.RiskEvidenceSynthesis
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.RiskEvidenceSynthesis{&entity}, nil
@@ -17023,6 +21170,36 @@ func (c *Client) GetRiskEvidenceSynthesisByID(ctx context.Context, id string, pa
 	return fhirRespToRiskEvidenceSynthesis(id, resp)
 }
 
+// RiskEvidenceSynthesisSearchResult is the page of the RiskEvidenceSynthesis search with its metadata.
+type RiskEvidenceSynthesisSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.RiskEvidenceSynthesis
+}
+
+func newRiskEvidenceSynthesisSearchResult(result *SearchResult, err error) (*RiskEvidenceSynthesisSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &RiskEvidenceSynthesisSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.RiskEvidenceSynthesis); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search RiskEvidenceSynthesis with the total, links, full URLs and scores.
+func (c *Client) SearchRiskEvidenceSynthesis(ctx context.Context, params Parameters) (*RiskEvidenceSynthesisSearchResult, error) {
+	return newRiskEvidenceSynthesisSearchResult(c.Search(ctx, "RiskEvidenceSynthesis", params))
+}
+
+// Search RiskEvidenceSynthesis page by its link URL.
+func (c *Client) SearchRiskEvidenceSynthesisPage(ctx context.Context, url string) (*RiskEvidenceSynthesisSearchResult, error) {
+	return newRiskEvidenceSynthesisSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateRiskEvidenceSynthesis(ctx context.Context, params Parameters, entity *models.RiskEvidenceSynthesis) (*models.RiskEvidenceSynthesis, error) {
 	resp, err := c.Create(ctx, "RiskEvidenceSynthesis", params, entity)
 	if err != nil {
@@ -17090,11 +21267,11 @@ func (c *Client) DeleteRiskEvidenceSynthesisByID(ctx context.Context, id string,
 // Schedule
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSchedules(bundle *models.Bundle) ([]*models.Schedule, error) {
+func bundleToSchedules(bundle *models.Bundle, decoder models.Decoder) ([]*models.Schedule, error) {
 	var entities []*models.Schedule
 	err := EnumBundleResources(bundle, "Schedule", func(resource ResourceData) error {
 		var entity models.Schedule
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Schedule", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17110,10 +21287,10 @@ func bundleToSchedules(bundle *models.Bundle) ([]*models.Schedule, error) {
 func fhirRespToSchedules(resp *FhirResponse) ([]*models.Schedule, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSchedules(resp.Bundle)
+		return bundleToSchedules(resp.Bundle, resp.decoder)
 	case "Schedule":
 		var entity models.Schedule
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Schedule{&entity}, nil
@@ -17152,6 +21329,36 @@ func (c *Client) GetScheduleByID(ctx context.Context, id string, params Paramete
 	return fhirRespToSchedule(id, resp)
 }
 
+// ScheduleSearchResult is the page of the Schedule search with its metadata.
+type ScheduleSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Schedule
+}
+
+func newScheduleSearchResult(result *SearchResult, err error) (*ScheduleSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ScheduleSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Schedule); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Schedule with the total, links, full URLs and scores.
+func (c *Client) SearchSchedule(ctx context.Context, params Parameters) (*ScheduleSearchResult, error) {
+	return newScheduleSearchResult(c.Search(ctx, "Schedule", params))
+}
+
+// Search Schedule page by its link URL.
+func (c *Client) SearchSchedulePage(ctx context.Context, url string) (*ScheduleSearchResult, error) {
+	return newScheduleSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSchedule(ctx context.Context, params Parameters, entity *models.Schedule) (*models.Schedule, error) {
 	resp, err := c.Create(ctx, "Schedule", params, entity)
 	if err != nil {
@@ -17219,11 +21426,11 @@ func (c *Client) DeleteScheduleByID(ctx context.Context, id string, params Param
 // SearchParameter
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSearchParameters(bundle *models.Bundle) ([]*models.SearchParameter, error) {
+func bundleToSearchParameters(bundle *models.Bundle, decoder models.Decoder) ([]*models.SearchParameter, error) {
 	var entities []*models.SearchParameter
 	err := EnumBundleResources(bundle, "SearchParameter", func(resource ResourceData) error {
 		var entity models.SearchParameter
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SearchParameter", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17239,10 +21446,10 @@ func bundleToSearchParameters(bundle *models.Bundle) ([]*models.SearchParameter,
 func fhirRespToSearchParameters(resp *FhirResponse) ([]*models.SearchParameter, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSearchParameters(resp.Bundle)
+		return bundleToSearchParameters(resp.Bundle, resp.decoder)
 	case "SearchParameter":
 		var entity models.SearchParameter
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SearchParameter{&entity}, nil
@@ -17281,6 +21488,36 @@ func (c *Client) GetSearchParameterByID(ctx context.Context, id string, params P
 	return fhirRespToSearchParameter(id, resp)
 }
 
+// SearchParameterSearchResult is the page of the SearchParameter search with its metadata.
+type SearchParameterSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SearchParameter
+}
+
+func newSearchParameterSearchResult(result *SearchResult, err error) (*SearchParameterSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SearchParameterSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SearchParameter); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SearchParameter with the total, links, full URLs and scores.
+func (c *Client) SearchSearchParameter(ctx context.Context, params Parameters) (*SearchParameterSearchResult, error) {
+	return newSearchParameterSearchResult(c.Search(ctx, "SearchParameter", params))
+}
+
+// Search SearchParameter page by its link URL.
+func (c *Client) SearchSearchParameterPage(ctx context.Context, url string) (*SearchParameterSearchResult, error) {
+	return newSearchParameterSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSearchParameter(ctx context.Context, params Parameters, entity *models.SearchParameter) (*models.SearchParameter, error) {
 	resp, err := c.Create(ctx, "SearchParameter", params, entity)
 	if err != nil {
@@ -17348,11 +21585,11 @@ func (c *Client) DeleteSearchParameterByID(ctx context.Context, id string, param
 // ServiceRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToServiceRequests(bundle *models.Bundle) ([]*models.ServiceRequest, error) {
+func bundleToServiceRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.ServiceRequest, error) {
 	var entities []*models.ServiceRequest
 	err := EnumBundleResources(bundle, "ServiceRequest", func(resource ResourceData) error {
 		var entity models.ServiceRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ServiceRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17368,10 +21605,10 @@ func bundleToServiceRequests(bundle *models.Bundle) ([]*models.ServiceRequest, e
 func fhirRespToServiceRequests(resp *FhirResponse) ([]*models.ServiceRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToServiceRequests(resp.Bundle)
+		return bundleToServiceRequests(resp.Bundle, resp.decoder)
 	case "ServiceRequest":
 		var entity models.ServiceRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ServiceRequest{&entity}, nil
@@ -17410,6 +21647,36 @@ func (c *Client) GetServiceRequestByID(ctx context.Context, id string, params Pa
 	return fhirRespToServiceRequest(id, resp)
 }
 
+// ServiceRequestSearchResult is the page of the ServiceRequest search with its metadata.
+type ServiceRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ServiceRequest
+}
+
+func newServiceRequestSearchResult(result *SearchResult, err error) (*ServiceRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ServiceRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ServiceRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ServiceRequest with the total, links, full URLs and scores.
+func (c *Client) SearchServiceRequest(ctx context.Context, params Parameters) (*ServiceRequestSearchResult, error) {
+	return newServiceRequestSearchResult(c.Search(ctx, "ServiceRequest", params))
+}
+
+// Search ServiceRequest page by its link URL.
+func (c *Client) SearchServiceRequestPage(ctx context.Context, url string) (*ServiceRequestSearchResult, error) {
+	return newServiceRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateServiceRequest(ctx context.Context, params Parameters, entity *models.ServiceRequest) (*models.ServiceRequest, error) {
 	resp, err := c.Create(ctx, "ServiceRequest", params, entity)
 	if err != nil {
@@ -17477,11 +21744,11 @@ func (c *Client) DeleteServiceRequestByID(ctx context.Context, id string, params
 // Slot
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSlots(bundle *models.Bundle) ([]*models.Slot, error) {
+func bundleToSlots(bundle *models.Bundle, decoder models.Decoder) ([]*models.Slot, error) {
 	var entities []*models.Slot
 	err := EnumBundleResources(bundle, "Slot", func(resource ResourceData) error {
 		var entity models.Slot
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Slot", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17497,10 +21764,10 @@ func bundleToSlots(bundle *models.Bundle) ([]*models.Slot, error) {
 func fhirRespToSlots(resp *FhirResponse) ([]*models.Slot, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSlots(resp.Bundle)
+		return bundleToSlots(resp.Bundle, resp.decoder)
 	case "Slot":
 		var entity models.Slot
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Slot{&entity}, nil
@@ -17539,6 +21806,36 @@ func (c *Client) GetSlotByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToSlot(id, resp)
 }
 
+// SlotSearchResult is the page of the Slot search with its metadata.
+type SlotSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Slot
+}
+
+func newSlotSearchResult(result *SearchResult, err error) (*SlotSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SlotSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Slot); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Slot with the total, links, full URLs and scores.
+func (c *Client) SearchSlot(ctx context.Context, params Parameters) (*SlotSearchResult, error) {
+	return newSlotSearchResult(c.Search(ctx, "Slot", params))
+}
+
+// Search Slot page by its link URL.
+func (c *Client) SearchSlotPage(ctx context.Context, url string) (*SlotSearchResult, error) {
+	return newSlotSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSlot(ctx context.Context, params Parameters, entity *models.Slot) (*models.Slot, error) {
 	resp, err := c.Create(ctx, "Slot", params, entity)
 	if err != nil {
@@ -17606,11 +21903,11 @@ func (c *Client) DeleteSlotByID(ctx context.Context, id string, params Parameter
 // Specimen
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSpecimens(bundle *models.Bundle) ([]*models.Specimen, error) {
+func bundleToSpecimens(bundle *models.Bundle, decoder models.Decoder) ([]*models.Specimen, error) {
 	var entities []*models.Specimen
 	err := EnumBundleResources(bundle, "Specimen", func(resource ResourceData) error {
 		var entity models.Specimen
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Specimen", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17626,10 +21923,10 @@ func bundleToSpecimens(bundle *models.Bundle) ([]*models.Specimen, error) {
 func fhirRespToSpecimens(resp *FhirResponse) ([]*models.Specimen, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSpecimens(resp.Bundle)
+		return bundleToSpecimens(resp.Bundle, resp.decoder)
 	case "Specimen":
 		var entity models.Specimen
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Specimen{&entity}, nil
@@ -17668,6 +21965,36 @@ func (c *Client) GetSpecimenByID(ctx context.Context, id string, params Paramete
 	return fhirRespToSpecimen(id, resp)
 }
 
+// SpecimenSearchResult is the page of the Specimen search with its metadata.
+type SpecimenSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Specimen
+}
+
+func newSpecimenSearchResult(result *SearchResult, err error) (*SpecimenSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SpecimenSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Specimen); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Specimen with the total, links, full URLs and scores.
+func (c *Client) SearchSpecimen(ctx context.Context, params Parameters) (*SpecimenSearchResult, error) {
+	return newSpecimenSearchResult(c.Search(ctx, "Specimen", params))
+}
+
+// Search Specimen page by its link URL.
+func (c *Client) SearchSpecimenPage(ctx context.Context, url string) (*SpecimenSearchResult, error) {
+	return newSpecimenSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSpecimen(ctx context.Context, params Parameters, entity *models.Specimen) (*models.Specimen, error) {
 	resp, err := c.Create(ctx, "Specimen", params, entity)
 	if err != nil {
@@ -17735,11 +22062,11 @@ func (c *Client) DeleteSpecimenByID(ctx context.Context, id string, params Param
 // SpecimenDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSpecimenDefinitions(bundle *models.Bundle) ([]*models.SpecimenDefinition, error) {
+func bundleToSpecimenDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.SpecimenDefinition, error) {
 	var entities []*models.SpecimenDefinition
 	err := EnumBundleResources(bundle, "SpecimenDefinition", func(resource ResourceData) error {
 		var entity models.SpecimenDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SpecimenDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17755,10 +22082,10 @@ func bundleToSpecimenDefinitions(bundle *models.Bundle) ([]*models.SpecimenDefin
 func fhirRespToSpecimenDefinitions(resp *FhirResponse) ([]*models.SpecimenDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSpecimenDefinitions(resp.Bundle)
+		return bundleToSpecimenDefinitions(resp.Bundle, resp.decoder)
 	case "SpecimenDefinition":
 		var entity models.SpecimenDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SpecimenDefinition{&entity}, nil
@@ -17787,14 +22114,44 @@ func (c *Client) GetSpecimenDefinition(ctx context.Context, params Parameters) (
 	return fhirRespToSpecimenDefinitions(resp)
 }
 
-// Get SpecimenDefinition by ID.
-func (c *Client) GetSpecimenDefinitionByID(ctx context.Context, id string, params Parameters) (*models.SpecimenDefinition, error) {
-	resp, err := c.GetByID(ctx, "SpecimenDefinition", id, params)
+// Get SpecimenDefinition by ID.
+func (c *Client) GetSpecimenDefinitionByID(ctx context.Context, id string, params Parameters) (*models.SpecimenDefinition, error) {
+	resp, err := c.GetByID(ctx, "SpecimenDefinition", id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSpecimenDefinition(id, resp)
+}
+
+// SpecimenDefinitionSearchResult is the page of the SpecimenDefinition search with its metadata.
+type SpecimenDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SpecimenDefinition
+}
+
+func newSpecimenDefinitionSearchResult(result *SearchResult, err error) (*SpecimenDefinitionSearchResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	typed := &SpecimenDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SpecimenDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
 
-	return fhirRespToSpecimenDefinition(id, resp)
+// Search SpecimenDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchSpecimenDefinition(ctx context.Context, params Parameters) (*SpecimenDefinitionSearchResult, error) {
+	return newSpecimenDefinitionSearchResult(c.Search(ctx, "SpecimenDefinition", params))
+}
+
+// Search SpecimenDefinition page by its link URL.
+func (c *Client) SearchSpecimenDefinitionPage(ctx context.Context, url string) (*SpecimenDefinitionSearchResult, error) {
+	return newSpecimenDefinitionSearchResult(c.SearchPage(ctx, url))
 }
 
 func (c *Client) CreateSpecimenDefinition(ctx context.Context, params Parameters, entity *models.SpecimenDefinition) (*models.SpecimenDefinition, error) {
@@ -17864,11 +22221,11 @@ func (c *Client) DeleteSpecimenDefinitionByID(ctx context.Context, id string, pa
 // StructureDefinition
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToStructureDefinitions(bundle *models.Bundle) ([]*models.StructureDefinition, error) {
+func bundleToStructureDefinitions(bundle *models.Bundle, decoder models.Decoder) ([]*models.StructureDefinition, error) {
 	var entities []*models.StructureDefinition
 	err := EnumBundleResources(bundle, "StructureDefinition", func(resource ResourceData) error {
 		var entity models.StructureDefinition
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "StructureDefinition", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -17884,10 +22241,10 @@ func bundleToStructureDefinitions(bundle *models.Bundle) ([]*models.StructureDef
 func fhirRespToStructureDefinitions(resp *FhirResponse) ([]*models.StructureDefinition, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToStructureDefinitions(resp.Bundle)
+		return bundleToStructureDefinitions(resp.Bundle, resp.decoder)
 	case "StructureDefinition":
 		var entity models.StructureDefinition
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.StructureDefinition{&entity}, nil
@@ -17926,6 +22283,36 @@ func (c *Client) GetStructureDefinitionByID(ctx context.Context, id string, para
 	return fhirRespToStructureDefinition(id, resp)
 }
 
+// StructureDefinitionSearchResult is the page of the StructureDefinition search with its metadata.
+type StructureDefinitionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.StructureDefinition
+}
+
+func newStructureDefinitionSearchResult(result *SearchResult, err error) (*StructureDefinitionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &StructureDefinitionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.StructureDefinition); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search StructureDefinition with the total, links, full URLs and scores.
+func (c *Client) SearchStructureDefinition(ctx context.Context, params Parameters) (*StructureDefinitionSearchResult, error) {
+	return newStructureDefinitionSearchResult(c.Search(ctx, "StructureDefinition", params))
+}
+
+// Search StructureDefinition page by its link URL.
+func (c *Client) SearchStructureDefinitionPage(ctx context.Context, url string) (*StructureDefinitionSearchResult, error) {
+	return newStructureDefinitionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateStructureDefinition(ctx context.Context, params Parameters, entity *models.StructureDefinition) (*models.StructureDefinition, error) {
 	resp, err := c.Create(ctx, "StructureDefinition", params, entity)
 	if err != nil {
@@ -17993,11 +22380,11 @@ func (c *Client) DeleteStructureDefinitionByID(ctx context.Context, id string, p
 // StructureMap
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToStructureMaps(bundle *models.Bundle) ([]*models.StructureMap, error) {
+func bundleToStructureMaps(bundle *models.Bundle, decoder models.Decoder) ([]*models.StructureMap, error) {
 	var entities []*models.StructureMap
 	err := EnumBundleResources(bundle, "StructureMap", func(resource ResourceData) error {
 		var entity models.StructureMap
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "StructureMap", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18013,10 +22400,10 @@ func bundleToStructureMaps(bundle *models.Bundle) ([]*models.StructureMap, error
 func fhirRespToStructureMaps(resp *FhirResponse) ([]*models.StructureMap, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToStructureMaps(resp.Bundle)
+		return bundleToStructureMaps(resp.Bundle, resp.decoder)
 	case "StructureMap":
 		var entity models.StructureMap
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.StructureMap{&entity}, nil
@@ -18055,6 +22442,36 @@ func (c *Client) GetStructureMapByID(ctx context.Context, id string, params Para
 	return fhirRespToStructureMap(id, resp)
 }
 
+// StructureMapSearchResult is the page of the StructureMap search with its metadata.
+type StructureMapSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.StructureMap
+}
+
+func newStructureMapSearchResult(result *SearchResult, err error) (*StructureMapSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &StructureMapSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.StructureMap); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search StructureMap with the total, links, full URLs and scores.
+func (c *Client) SearchStructureMap(ctx context.Context, params Parameters) (*StructureMapSearchResult, error) {
+	return newStructureMapSearchResult(c.Search(ctx, "StructureMap", params))
+}
+
+// Search StructureMap page by its link URL.
+func (c *Client) SearchStructureMapPage(ctx context.Context, url string) (*StructureMapSearchResult, error) {
+	return newStructureMapSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateStructureMap(ctx context.Context, params Parameters, entity *models.StructureMap) (*models.StructureMap, error) {
 	resp, err := c.Create(ctx, "StructureMap", params, entity)
 	if err != nil {
@@ -18122,11 +22539,11 @@ func (c *Client) DeleteStructureMapByID(ctx context.Context, id string, params P
 // Subscription
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubscriptions(bundle *models.Bundle) ([]*models.Subscription, error) {
+func bundleToSubscriptions(bundle *models.Bundle, decoder models.Decoder) ([]*models.Subscription, error) {
 	var entities []*models.Subscription
 	err := EnumBundleResources(bundle, "Subscription", func(resource ResourceData) error {
 		var entity models.Subscription
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Subscription", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18142,10 +22559,10 @@ func bundleToSubscriptions(bundle *models.Bundle) ([]*models.Subscription, error
 func fhirRespToSubscriptions(resp *FhirResponse) ([]*models.Subscription, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubscriptions(resp.Bundle)
+		return bundleToSubscriptions(resp.Bundle, resp.decoder)
 	case "Subscription":
 		var entity models.Subscription
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Subscription{&entity}, nil
@@ -18184,6 +22601,36 @@ func (c *Client) GetSubscriptionByID(ctx context.Context, id string, params Para
 	return fhirRespToSubscription(id, resp)
 }
 
+// SubscriptionSearchResult is the page of the Subscription search with its metadata.
+type SubscriptionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Subscription
+}
+
+func newSubscriptionSearchResult(result *SearchResult, err error) (*SubscriptionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubscriptionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Subscription); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Subscription with the total, links, full URLs and scores.
+func (c *Client) SearchSubscription(ctx context.Context, params Parameters) (*SubscriptionSearchResult, error) {
+	return newSubscriptionSearchResult(c.Search(ctx, "Subscription", params))
+}
+
+// Search Subscription page by its link URL.
+func (c *Client) SearchSubscriptionPage(ctx context.Context, url string) (*SubscriptionSearchResult, error) {
+	return newSubscriptionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubscription(ctx context.Context, params Parameters, entity *models.Subscription) (*models.Subscription, error) {
 	resp, err := c.Create(ctx, "Subscription", params, entity)
 	if err != nil {
@@ -18251,11 +22698,11 @@ func (c *Client) DeleteSubscriptionByID(ctx context.Context, id string, params P
 // Substance
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstances(bundle *models.Bundle) ([]*models.Substance, error) {
+func bundleToSubstances(bundle *models.Bundle, decoder models.Decoder) ([]*models.Substance, error) {
 	var entities []*models.Substance
 	err := EnumBundleResources(bundle, "Substance", func(resource ResourceData) error {
 		var entity models.Substance
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Substance", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18271,10 +22718,10 @@ func bundleToSubstances(bundle *models.Bundle) ([]*models.Substance, error) {
 func fhirRespToSubstances(resp *FhirResponse) ([]*models.Substance, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstances(resp.Bundle)
+		return bundleToSubstances(resp.Bundle, resp.decoder)
 	case "Substance":
 		var entity models.Substance
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Substance{&entity}, nil
@@ -18313,6 +22760,36 @@ func (c *Client) GetSubstanceByID(ctx context.Context, id string, params Paramet
 	return fhirRespToSubstance(id, resp)
 }
 
+// SubstanceSearchResult is the page of the Substance search with its metadata.
+type SubstanceSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Substance
+}
+
+func newSubstanceSearchResult(result *SearchResult, err error) (*SubstanceSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstanceSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Substance); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Substance with the total, links, full URLs and scores.
+func (c *Client) SearchSubstance(ctx context.Context, params Parameters) (*SubstanceSearchResult, error) {
+	return newSubstanceSearchResult(c.Search(ctx, "Substance", params))
+}
+
+// Search Substance page by its link URL.
+func (c *Client) SearchSubstancePage(ctx context.Context, url string) (*SubstanceSearchResult, error) {
+	return newSubstanceSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstance(ctx context.Context, params Parameters, entity *models.Substance) (*models.Substance, error) {
 	resp, err := c.Create(ctx, "Substance", params, entity)
 	if err != nil {
@@ -18380,11 +22857,11 @@ func (c *Client) DeleteSubstanceByID(ctx context.Context, id string, params Para
 // SubstanceNucleicAcid
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstanceNucleicAcids(bundle *models.Bundle) ([]*models.SubstanceNucleicAcid, error) {
+func bundleToSubstanceNucleicAcids(bundle *models.Bundle, decoder models.Decoder) ([]*models.SubstanceNucleicAcid, error) {
 	var entities []*models.SubstanceNucleicAcid
 	err := EnumBundleResources(bundle, "SubstanceNucleicAcid", func(resource ResourceData) error {
 		var entity models.SubstanceNucleicAcid
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SubstanceNucleicAcid", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18400,10 +22877,10 @@ func bundleToSubstanceNucleicAcids(bundle *models.Bundle) ([]*models.SubstanceNu
 func fhirRespToSubstanceNucleicAcids(resp *FhirResponse) ([]*models.SubstanceNucleicAcid, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstanceNucleicAcids(resp.Bundle)
+		return bundleToSubstanceNucleicAcids(resp.Bundle, resp.decoder)
 	case "SubstanceNucleicAcid":
 		var entity models.SubstanceNucleicAcid
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SubstanceNucleicAcid{&entity}, nil
@@ -18442,6 +22919,36 @@ func (c *Client) GetSubstanceNucleicAcidByID(ctx context.Context, id string, par
 	return fhirRespToSubstanceNucleicAcid(id, resp)
 }
 
+// SubstanceNucleicAcidSearchResult is the page of the SubstanceNucleicAcid search with its metadata.
+type SubstanceNucleicAcidSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SubstanceNucleicAcid
+}
+
+func newSubstanceNucleicAcidSearchResult(result *SearchResult, err error) (*SubstanceNucleicAcidSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstanceNucleicAcidSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SubstanceNucleicAcid); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SubstanceNucleicAcid with the total, links, full URLs and scores.
+func (c *Client) SearchSubstanceNucleicAcid(ctx context.Context, params Parameters) (*SubstanceNucleicAcidSearchResult, error) {
+	return newSubstanceNucleicAcidSearchResult(c.Search(ctx, "SubstanceNucleicAcid", params))
+}
+
+// Search SubstanceNucleicAcid page by its link URL.
+func (c *Client) SearchSubstanceNucleicAcidPage(ctx context.Context, url string) (*SubstanceNucleicAcidSearchResult, error) {
+	return newSubstanceNucleicAcidSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstanceNucleicAcid(ctx context.Context, params Parameters, entity *models.SubstanceNucleicAcid) (*models.SubstanceNucleicAcid, error) {
 	resp, err := c.Create(ctx, "SubstanceNucleicAcid", params, entity)
 	if err != nil {
@@ -18509,11 +23016,11 @@ func (c *Client) DeleteSubstanceNucleicAcidByID(ctx context.Context, id string,
 // SubstancePolymer
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstancePolymers(bundle *models.Bundle) ([]*models.SubstancePolymer, error) {
+func bundleToSubstancePolymers(bundle *models.Bundle, decoder models.Decoder) ([]*models.SubstancePolymer, error) {
 	var entities []*models.SubstancePolymer
 	err := EnumBundleResources(bundle, "SubstancePolymer", func(resource ResourceData) error {
 		var entity models.SubstancePolymer
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SubstancePolymer", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18529,10 +23036,10 @@ func bundleToSubstancePolymers(bundle *models.Bundle) ([]*models.SubstancePolyme
 func fhirRespToSubstancePolymers(resp *FhirResponse) ([]*models.SubstancePolymer, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstancePolymers(resp.Bundle)
+		return bundleToSubstancePolymers(resp.Bundle, resp.decoder)
 	case "SubstancePolymer":
 		var entity models.SubstancePolymer
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SubstancePolymer{&entity}, nil
@@ -18571,6 +23078,36 @@ func (c *Client) GetSubstancePolymerByID(ctx context.Context, id string, params
 	return fhirRespToSubstancePolymer(id, resp)
 }
 
+// SubstancePolymerSearchResult is the page of the SubstancePolymer search with its metadata.
+type SubstancePolymerSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SubstancePolymer
+}
+
+func newSubstancePolymerSearchResult(result *SearchResult, err error) (*SubstancePolymerSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstancePolymerSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SubstancePolymer); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SubstancePolymer with the total, links, full URLs and scores.
+func (c *Client) SearchSubstancePolymer(ctx context.Context, params Parameters) (*SubstancePolymerSearchResult, error) {
+	return newSubstancePolymerSearchResult(c.Search(ctx, "SubstancePolymer", params))
+}
+
+// Search SubstancePolymer page by its link URL.
+func (c *Client) SearchSubstancePolymerPage(ctx context.Context, url string) (*SubstancePolymerSearchResult, error) {
+	return newSubstancePolymerSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstancePolymer(ctx context.Context, params Parameters, entity *models.SubstancePolymer) (*models.SubstancePolymer, error) {
 	resp, err := c.Create(ctx, "SubstancePolymer", params, entity)
 	if err != nil {
@@ -18638,11 +23175,11 @@ func (c *Client) DeleteSubstancePolymerByID(ctx context.Context, id string, para
 // SubstanceProtein
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstanceProteins(bundle *models.Bundle) ([]*models.SubstanceProtein, error) {
+func bundleToSubstanceProteins(bundle *models.Bundle, decoder models.Decoder) ([]*models.SubstanceProtein, error) {
 	var entities []*models.SubstanceProtein
 	err := EnumBundleResources(bundle, "SubstanceProtein", func(resource ResourceData) error {
 		var entity models.SubstanceProtein
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SubstanceProtein", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18658,10 +23195,10 @@ func bundleToSubstanceProteins(bundle *models.Bundle) ([]*models.SubstanceProtei
 func fhirRespToSubstanceProteins(resp *FhirResponse) ([]*models.SubstanceProtein, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstanceProteins(resp.Bundle)
+		return bundleToSubstanceProteins(resp.Bundle, resp.decoder)
 	case "SubstanceProtein":
 		var entity models.SubstanceProtein
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SubstanceProtein{&entity}, nil
@@ -18700,6 +23237,36 @@ func (c *Client) GetSubstanceProteinByID(ctx context.Context, id string, params
 	return fhirRespToSubstanceProtein(id, resp)
 }
 
+// SubstanceProteinSearchResult is the page of the SubstanceProtein search with its metadata.
+type SubstanceProteinSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SubstanceProtein
+}
+
+func newSubstanceProteinSearchResult(result *SearchResult, err error) (*SubstanceProteinSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstanceProteinSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SubstanceProtein); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SubstanceProtein with the total, links, full URLs and scores.
+func (c *Client) SearchSubstanceProtein(ctx context.Context, params Parameters) (*SubstanceProteinSearchResult, error) {
+	return newSubstanceProteinSearchResult(c.Search(ctx, "SubstanceProtein", params))
+}
+
+// Search SubstanceProtein page by its link URL.
+func (c *Client) SearchSubstanceProteinPage(ctx context.Context, url string) (*SubstanceProteinSearchResult, error) {
+	return newSubstanceProteinSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstanceProtein(ctx context.Context, params Parameters, entity *models.SubstanceProtein) (*models.SubstanceProtein, error) {
 	resp, err := c.Create(ctx, "SubstanceProtein", params, entity)
 	if err != nil {
@@ -18767,11 +23334,11 @@ func (c *Client) DeleteSubstanceProteinByID(ctx context.Context, id string, para
 // SubstanceReferenceInformation
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstanceReferenceInformations(bundle *models.Bundle) ([]*models.SubstanceReferenceInformation, error) {
+func bundleToSubstanceReferenceInformations(bundle *models.Bundle, decoder models.Decoder) ([]*models.SubstanceReferenceInformation, error) {
 	var entities []*models.SubstanceReferenceInformation
 	err := EnumBundleResources(bundle, "SubstanceReferenceInformation", func(resource ResourceData) error {
 		var entity models.SubstanceReferenceInformation
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SubstanceReferenceInformation", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18787,10 +23354,10 @@ func bundleToSubstanceReferenceInformations(bundle *models.Bundle) ([]*models.Su
 func fhirRespToSubstanceReferenceInformations(resp *FhirResponse) ([]*models.SubstanceReferenceInformation, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstanceReferenceInformations(resp.Bundle)
+		return bundleToSubstanceReferenceInformations(resp.Bundle, resp.decoder)
 	case "SubstanceReferenceInformation":
 		var entity models.SubstanceReferenceInformation
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SubstanceReferenceInformation{&entity}, nil
@@ -18829,6 +23396,36 @@ func (c *Client) GetSubstanceReferenceInformationByID(ctx context.Context, id st
 	return fhirRespToSubstanceReferenceInformation(id, resp)
 }
 
+// SubstanceReferenceInformationSearchResult is the page of the SubstanceReferenceInformation search with its metadata.
+type SubstanceReferenceInformationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SubstanceReferenceInformation
+}
+
+func newSubstanceReferenceInformationSearchResult(result *SearchResult, err error) (*SubstanceReferenceInformationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstanceReferenceInformationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SubstanceReferenceInformation); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SubstanceReferenceInformation with the total, links, full URLs and scores.
+func (c *Client) SearchSubstanceReferenceInformation(ctx context.Context, params Parameters) (*SubstanceReferenceInformationSearchResult, error) {
+	return newSubstanceReferenceInformationSearchResult(c.Search(ctx, "SubstanceReferenceInformation", params))
+}
+
+// Search SubstanceReferenceInformation page by its link URL.
+func (c *Client) SearchSubstanceReferenceInformationPage(ctx context.Context, url string) (*SubstanceReferenceInformationSearchResult, error) {
+	return newSubstanceReferenceInformationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstanceReferenceInformation(ctx context.Context, params Parameters, entity *models.SubstanceReferenceInformation) (*models.SubstanceReferenceInformation, error) {
 	resp, err := c.Create(ctx, "SubstanceReferenceInformation", params, entity)
 	if err != nil {
@@ -18896,11 +23493,11 @@ func (c *Client) DeleteSubstanceReferenceInformationByID(ctx context.Context, id
 // SubstanceSourceMaterial
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstanceSourceMaterials(bundle *models.Bundle) ([]*models.SubstanceSourceMaterial, error) {
+func bundleToSubstanceSourceMaterials(bundle *models.Bundle, decoder models.Decoder) ([]*models.SubstanceSourceMaterial, error) {
 	var entities []*models.SubstanceSourceMaterial
 	err := EnumBundleResources(bundle, "SubstanceSourceMaterial", func(resource ResourceData) error {
 		var entity models.SubstanceSourceMaterial
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SubstanceSourceMaterial", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -18916,10 +23513,10 @@ func bundleToSubstanceSourceMaterials(bundle *models.Bundle) ([]*models.Substanc
 func fhirRespToSubstanceSourceMaterials(resp *FhirResponse) ([]*models.SubstanceSourceMaterial, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstanceSourceMaterials(resp.Bundle)
+		return bundleToSubstanceSourceMaterials(resp.Bundle, resp.decoder)
 	case "SubstanceSourceMaterial":
 		var entity models.SubstanceSourceMaterial
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SubstanceSourceMaterial{&entity}, nil
@@ -18958,6 +23555,36 @@ func (c *Client) GetSubstanceSourceMaterialByID(ctx context.Context, id string,
 	return fhirRespToSubstanceSourceMaterial(id, resp)
 }
 
+// SubstanceSourceMaterialSearchResult is the page of the SubstanceSourceMaterial search with its metadata.
+type SubstanceSourceMaterialSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SubstanceSourceMaterial
+}
+
+func newSubstanceSourceMaterialSearchResult(result *SearchResult, err error) (*SubstanceSourceMaterialSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstanceSourceMaterialSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SubstanceSourceMaterial); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SubstanceSourceMaterial with the total, links, full URLs and scores.
+func (c *Client) SearchSubstanceSourceMaterial(ctx context.Context, params Parameters) (*SubstanceSourceMaterialSearchResult, error) {
+	return newSubstanceSourceMaterialSearchResult(c.Search(ctx, "SubstanceSourceMaterial", params))
+}
+
+// Search SubstanceSourceMaterial page by its link URL.
+func (c *Client) SearchSubstanceSourceMaterialPage(ctx context.Context, url string) (*SubstanceSourceMaterialSearchResult, error) {
+	return newSubstanceSourceMaterialSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstanceSourceMaterial(ctx context.Context, params Parameters, entity *models.SubstanceSourceMaterial) (*models.SubstanceSourceMaterial, error) {
 	resp, err := c.Create(ctx, "SubstanceSourceMaterial", params, entity)
 	if err != nil {
@@ -19025,11 +23652,11 @@ func (c *Client) DeleteSubstanceSourceMaterialByID(ctx context.Context, id strin
 // SubstanceSpecification
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSubstanceSpecifications(bundle *models.Bundle) ([]*models.SubstanceSpecification, error) {
+func bundleToSubstanceSpecifications(bundle *models.Bundle, decoder models.Decoder) ([]*models.SubstanceSpecification, error) {
 	var entities []*models.SubstanceSpecification
 	err := EnumBundleResources(bundle, "SubstanceSpecification", func(resource ResourceData) error {
 		var entity models.SubstanceSpecification
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SubstanceSpecification", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19045,10 +23672,10 @@ func bundleToSubstanceSpecifications(bundle *models.Bundle) ([]*models.Substance
 func fhirRespToSubstanceSpecifications(resp *FhirResponse) ([]*models.SubstanceSpecification, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSubstanceSpecifications(resp.Bundle)
+		return bundleToSubstanceSpecifications(resp.Bundle, resp.decoder)
 	case "SubstanceSpecification":
 		var entity models.SubstanceSpecification
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SubstanceSpecification{&entity}, nil
@@ -19087,6 +23714,36 @@ func (c *Client) GetSubstanceSpecificationByID(ctx context.Context, id string, p
 	return fhirRespToSubstanceSpecification(id, resp)
 }
 
+// SubstanceSpecificationSearchResult is the page of the SubstanceSpecification search with its metadata.
+type SubstanceSpecificationSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SubstanceSpecification
+}
+
+func newSubstanceSpecificationSearchResult(result *SearchResult, err error) (*SubstanceSpecificationSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SubstanceSpecificationSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SubstanceSpecification); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SubstanceSpecification with the total, links, full URLs and scores.
+func (c *Client) SearchSubstanceSpecification(ctx context.Context, params Parameters) (*SubstanceSpecificationSearchResult, error) {
+	return newSubstanceSpecificationSearchResult(c.Search(ctx, "SubstanceSpecification", params))
+}
+
+// Search SubstanceSpecification page by its link URL.
+func (c *Client) SearchSubstanceSpecificationPage(ctx context.Context, url string) (*SubstanceSpecificationSearchResult, error) {
+	return newSubstanceSpecificationSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSubstanceSpecification(ctx context.Context, params Parameters, entity *models.SubstanceSpecification) (*models.SubstanceSpecification, error) {
 	resp, err := c.Create(ctx, "SubstanceSpecification", params, entity)
 	if err != nil {
@@ -19154,11 +23811,11 @@ func (c *Client) DeleteSubstanceSpecificationByID(ctx context.Context, id string
 // SupplyDelivery
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSupplyDeliverys(bundle *models.Bundle) ([]*models.SupplyDelivery, error) {
+func bundleToSupplyDeliverys(bundle *models.Bundle, decoder models.Decoder) ([]*models.SupplyDelivery, error) {
 	var entities []*models.SupplyDelivery
 	err := EnumBundleResources(bundle, "SupplyDelivery", func(resource ResourceData) error {
 		var entity models.SupplyDelivery
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SupplyDelivery", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19174,10 +23831,10 @@ func bundleToSupplyDeliverys(bundle *models.Bundle) ([]*models.SupplyDelivery, e
 func fhirRespToSupplyDeliverys(resp *FhirResponse) ([]*models.SupplyDelivery, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSupplyDeliverys(resp.Bundle)
+		return bundleToSupplyDeliverys(resp.Bundle, resp.decoder)
 	case "SupplyDelivery":
 		var entity models.SupplyDelivery
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SupplyDelivery{&entity}, nil
@@ -19216,6 +23873,36 @@ func (c *Client) GetSupplyDeliveryByID(ctx context.Context, id string, params Pa
 	return fhirRespToSupplyDelivery(id, resp)
 }
 
+// SupplyDeliverySearchResult is the page of the SupplyDelivery search with its metadata.
+type SupplyDeliverySearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SupplyDelivery
+}
+
+func newSupplyDeliverySearchResult(result *SearchResult, err error) (*SupplyDeliverySearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SupplyDeliverySearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SupplyDelivery); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SupplyDelivery with the total, links, full URLs and scores.
+func (c *Client) SearchSupplyDelivery(ctx context.Context, params Parameters) (*SupplyDeliverySearchResult, error) {
+	return newSupplyDeliverySearchResult(c.Search(ctx, "SupplyDelivery", params))
+}
+
+// Search SupplyDelivery page by its link URL.
+func (c *Client) SearchSupplyDeliveryPage(ctx context.Context, url string) (*SupplyDeliverySearchResult, error) {
+	return newSupplyDeliverySearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSupplyDelivery(ctx context.Context, params Parameters, entity *models.SupplyDelivery) (*models.SupplyDelivery, error) {
 	resp, err := c.Create(ctx, "SupplyDelivery", params, entity)
 	if err != nil {
@@ -19283,11 +23970,11 @@ func (c *Client) DeleteSupplyDeliveryByID(ctx context.Context, id string, params
 // SupplyRequest
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToSupplyRequests(bundle *models.Bundle) ([]*models.SupplyRequest, error) {
+func bundleToSupplyRequests(bundle *models.Bundle, decoder models.Decoder) ([]*models.SupplyRequest, error) {
 	var entities []*models.SupplyRequest
 	err := EnumBundleResources(bundle, "SupplyRequest", func(resource ResourceData) error {
 		var entity models.SupplyRequest
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "SupplyRequest", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19303,10 +23990,10 @@ func bundleToSupplyRequests(bundle *models.Bundle) ([]*models.SupplyRequest, err
 func fhirRespToSupplyRequests(resp *FhirResponse) ([]*models.SupplyRequest, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToSupplyRequests(resp.Bundle)
+		return bundleToSupplyRequests(resp.Bundle, resp.decoder)
 	case "SupplyRequest":
 		var entity models.SupplyRequest
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.SupplyRequest{&entity}, nil
@@ -19345,6 +24032,36 @@ func (c *Client) GetSupplyRequestByID(ctx context.Context, id string, params Par
 	return fhirRespToSupplyRequest(id, resp)
 }
 
+// SupplyRequestSearchResult is the page of the SupplyRequest search with its metadata.
+type SupplyRequestSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.SupplyRequest
+}
+
+func newSupplyRequestSearchResult(result *SearchResult, err error) (*SupplyRequestSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &SupplyRequestSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.SupplyRequest); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search SupplyRequest with the total, links, full URLs and scores.
+func (c *Client) SearchSupplyRequest(ctx context.Context, params Parameters) (*SupplyRequestSearchResult, error) {
+	return newSupplyRequestSearchResult(c.Search(ctx, "SupplyRequest", params))
+}
+
+// Search SupplyRequest page by its link URL.
+func (c *Client) SearchSupplyRequestPage(ctx context.Context, url string) (*SupplyRequestSearchResult, error) {
+	return newSupplyRequestSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateSupplyRequest(ctx context.Context, params Parameters, entity *models.SupplyRequest) (*models.SupplyRequest, error) {
 	resp, err := c.Create(ctx, "SupplyRequest", params, entity)
 	if err != nil {
@@ -19412,11 +24129,11 @@ func (c *Client) DeleteSupplyRequestByID(ctx context.Context, id string, params
 // Task
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToTasks(bundle *models.Bundle) ([]*models.Task, error) {
+func bundleToTasks(bundle *models.Bundle, decoder models.Decoder) ([]*models.Task, error) {
 	var entities []*models.Task
 	err := EnumBundleResources(bundle, "Task", func(resource ResourceData) error {
 		var entity models.Task
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "Task", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19432,10 +24149,10 @@ func bundleToTasks(bundle *models.Bundle) ([]*models.Task, error) {
 func fhirRespToTasks(resp *FhirResponse) ([]*models.Task, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToTasks(resp.Bundle)
+		return bundleToTasks(resp.Bundle, resp.decoder)
 	case "Task":
 		var entity models.Task
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.Task{&entity}, nil
@@ -19474,6 +24191,36 @@ func (c *Client) GetTaskByID(ctx context.Context, id string, params Parameters)
 	return fhirRespToTask(id, resp)
 }
 
+// TaskSearchResult is the page of the Task search with its metadata.
+type TaskSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.Task
+}
+
+func newTaskSearchResult(result *SearchResult, err error) (*TaskSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &TaskSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.Task); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search Task with the total, links, full URLs and scores.
+func (c *Client) SearchTask(ctx context.Context, params Parameters) (*TaskSearchResult, error) {
+	return newTaskSearchResult(c.Search(ctx, "Task", params))
+}
+
+// Search Task page by its link URL.
+func (c *Client) SearchTaskPage(ctx context.Context, url string) (*TaskSearchResult, error) {
+	return newTaskSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateTask(ctx context.Context, params Parameters, entity *models.Task) (*models.Task, error) {
 	resp, err := c.Create(ctx, "Task", params, entity)
 	if err != nil {
@@ -19541,11 +24288,11 @@ func (c *Client) DeleteTaskByID(ctx context.Context, id string, params Parameter
 // TerminologyCapabilities
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToTerminologyCapabilitiess(bundle *models.Bundle) ([]*models.TerminologyCapabilities, error) {
+func bundleToTerminologyCapabilitiess(bundle *models.Bundle, decoder models.Decoder) ([]*models.TerminologyCapabilities, error) {
 	var entities []*models.TerminologyCapabilities
 	err := EnumBundleResources(bundle, "TerminologyCapabilities", func(resource ResourceData) error {
 		var entity models.TerminologyCapabilities
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "TerminologyCapabilities", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19561,10 +24308,10 @@ func bundleToTerminologyCapabilitiess(bundle *models.Bundle) ([]*models.Terminol
 func fhirRespToTerminologyCapabilitiess(resp *FhirResponse) ([]*models.TerminologyCapabilities, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToTerminologyCapabilitiess(resp.Bundle)
+		return bundleToTerminologyCapabilitiess(resp.Bundle, resp.decoder)
 	case "TerminologyCapabilities":
 		var entity models.TerminologyCapabilities
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.TerminologyCapabilities{&entity}, nil
@@ -19603,6 +24350,36 @@ func (c *Client) GetTerminologyCapabilitiesByID(ctx context.Context, id string,
 	return fhirRespToTerminologyCapabilities(id, resp)
 }
 
+// TerminologyCapabilitiesSearchResult is the page of the TerminologyCapabilities search with its metadata.
+type TerminologyCapabilitiesSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.TerminologyCapabilities
+}
+
+func newTerminologyCapabilitiesSearchResult(result *SearchResult, err error) (*TerminologyCapabilitiesSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &TerminologyCapabilitiesSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.TerminologyCapabilities); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search TerminologyCapabilities with the total, links, full URLs and scores.
+func (c *Client) SearchTerminologyCapabilities(ctx context.Context, params Parameters) (*TerminologyCapabilitiesSearchResult, error) {
+	return newTerminologyCapabilitiesSearchResult(c.Search(ctx, "TerminologyCapabilities", params))
+}
+
+// Search TerminologyCapabilities page by its link URL.
+func (c *Client) SearchTerminologyCapabilitiesPage(ctx context.Context, url string) (*TerminologyCapabilitiesSearchResult, error) {
+	return newTerminologyCapabilitiesSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateTerminologyCapabilities(ctx context.Context, params Parameters, entity *models.TerminologyCapabilities) (*models.TerminologyCapabilities, error) {
 	resp, err := c.Create(ctx, "TerminologyCapabilities", params, entity)
 	if err != nil {
@@ -19670,11 +24447,11 @@ func (c *Client) DeleteTerminologyCapabilitiesByID(ctx context.Context, id strin
 // TestReport
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToTestReports(bundle *models.Bundle) ([]*models.TestReport, error) {
+func bundleToTestReports(bundle *models.Bundle, decoder models.Decoder) ([]*models.TestReport, error) {
 	var entities []*models.TestReport
 	err := EnumBundleResources(bundle, "TestReport", func(resource ResourceData) error {
 		var entity models.TestReport
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "TestReport", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19690,10 +24467,10 @@ func bundleToTestReports(bundle *models.Bundle) ([]*models.TestReport, error) {
 func fhirRespToTestReports(resp *FhirResponse) ([]*models.TestReport, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToTestReports(resp.Bundle)
+		return bundleToTestReports(resp.Bundle, resp.decoder)
 	case "TestReport":
 		var entity models.TestReport
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.TestReport{&entity}, nil
@@ -19732,6 +24509,36 @@ func (c *Client) GetTestReportByID(ctx context.Context, id string, params Parame
 	return fhirRespToTestReport(id, resp)
 }
 
+// TestReportSearchResult is the page of the TestReport search with its metadata.
+type TestReportSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.TestReport
+}
+
+func newTestReportSearchResult(result *SearchResult, err error) (*TestReportSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &TestReportSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.TestReport); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search TestReport with the total, links, full URLs and scores.
+func (c *Client) SearchTestReport(ctx context.Context, params Parameters) (*TestReportSearchResult, error) {
+	return newTestReportSearchResult(c.Search(ctx, "TestReport", params))
+}
+
+// Search TestReport page by its link URL.
+func (c *Client) SearchTestReportPage(ctx context.Context, url string) (*TestReportSearchResult, error) {
+	return newTestReportSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateTestReport(ctx context.Context, params Parameters, entity *models.TestReport) (*models.TestReport, error) {
 	resp, err := c.Create(ctx, "TestReport", params, entity)
 	if err != nil {
@@ -19799,11 +24606,11 @@ func (c *Client) DeleteTestReportByID(ctx context.Context, id string, params Par
 // TestScript
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToTestScripts(bundle *models.Bundle) ([]*models.TestScript, error) {
+func bundleToTestScripts(bundle *models.Bundle, decoder models.Decoder) ([]*models.TestScript, error) {
 	var entities []*models.TestScript
 	err := EnumBundleResources(bundle, "TestScript", func(resource ResourceData) error {
 		var entity models.TestScript
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "TestScript", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19819,10 +24626,10 @@ func bundleToTestScripts(bundle *models.Bundle) ([]*models.TestScript, error) {
 func fhirRespToTestScripts(resp *FhirResponse) ([]*models.TestScript, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToTestScripts(resp.Bundle)
+		return bundleToTestScripts(resp.Bundle, resp.decoder)
 	case "TestScript":
 		var entity models.TestScript
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.TestScript{&entity}, nil
@@ -19861,6 +24668,36 @@ func (c *Client) GetTestScriptByID(ctx context.Context, id string, params Parame
 	return fhirRespToTestScript(id, resp)
 }
 
+// TestScriptSearchResult is the page of the TestScript search with its metadata.
+type TestScriptSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.TestScript
+}
+
+func newTestScriptSearchResult(result *SearchResult, err error) (*TestScriptSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &TestScriptSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.TestScript); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search TestScript with the total, links, full URLs and scores.
+func (c *Client) SearchTestScript(ctx context.Context, params Parameters) (*TestScriptSearchResult, error) {
+	return newTestScriptSearchResult(c.Search(ctx, "TestScript", params))
+}
+
+// Search TestScript page by its link URL.
+func (c *Client) SearchTestScriptPage(ctx context.Context, url string) (*TestScriptSearchResult, error) {
+	return newTestScriptSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateTestScript(ctx context.Context, params Parameters, entity *models.TestScript) (*models.TestScript, error) {
 	resp, err := c.Create(ctx, "TestScript", params, entity)
 	if err != nil {
@@ -19928,11 +24765,11 @@ func (c *Client) DeleteTestScriptByID(ctx context.Context, id string, params Par
 // ValueSet
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToValueSets(bundle *models.Bundle) ([]*models.ValueSet, error) {
+func bundleToValueSets(bundle *models.Bundle, decoder models.Decoder) ([]*models.ValueSet, error) {
 	var entities []*models.ValueSet
 	err := EnumBundleResources(bundle, "ValueSet", func(resource ResourceData) error {
 		var entity models.ValueSet
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "ValueSet", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -19948,10 +24785,10 @@ func bundleToValueSets(bundle *models.Bundle) ([]*models.ValueSet, error) {
 func fhirRespToValueSets(resp *FhirResponse) ([]*models.ValueSet, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToValueSets(resp.Bundle)
+		return bundleToValueSets(resp.Bundle, resp.decoder)
 	case "ValueSet":
 		var entity models.ValueSet
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.ValueSet{&entity}, nil
@@ -19990,6 +24827,36 @@ func (c *Client) GetValueSetByID(ctx context.Context, id string, params Paramete
 	return fhirRespToValueSet(id, resp)
 }
 
+// ValueSetSearchResult is the page of the ValueSet search with its metadata.
+type ValueSetSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.ValueSet
+}
+
+func newValueSetSearchResult(result *SearchResult, err error) (*ValueSetSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &ValueSetSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.ValueSet); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search ValueSet with the total, links, full URLs and scores.
+func (c *Client) SearchValueSet(ctx context.Context, params Parameters) (*ValueSetSearchResult, error) {
+	return newValueSetSearchResult(c.Search(ctx, "ValueSet", params))
+}
+
+// Search ValueSet page by its link URL.
+func (c *Client) SearchValueSetPage(ctx context.Context, url string) (*ValueSetSearchResult, error) {
+	return newValueSetSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateValueSet(ctx context.Context, params Parameters, entity *models.ValueSet) (*models.ValueSet, error) {
 	resp, err := c.Create(ctx, "ValueSet", params, entity)
 	if err != nil {
@@ -20057,11 +24924,11 @@ func (c *Client) DeleteValueSetByID(ctx context.Context, id string, params Param
 // VerificationResult
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToVerificationResults(bundle *models.Bundle) ([]*models.VerificationResult, error) {
+func bundleToVerificationResults(bundle *models.Bundle, decoder models.Decoder) ([]*models.VerificationResult, error) {
 	var entities []*models.VerificationResult
 	err := EnumBundleResources(bundle, "VerificationResult", func(resource ResourceData) error {
 		var entity models.VerificationResult
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "VerificationResult", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -20077,10 +24944,10 @@ func bundleToVerificationResults(bundle *models.Bundle) ([]*models.VerificationR
 func fhirRespToVerificationResults(resp *FhirResponse) ([]*models.VerificationResult, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToVerificationResults(resp.Bundle)
+		return bundleToVerificationResults(resp.Bundle, resp.decoder)
 	case "VerificationResult":
 		var entity models.VerificationResult
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.VerificationResult{&entity}, nil
@@ -20119,6 +24986,36 @@ func (c *Client) GetVerificationResultByID(ctx context.Context, id string, param
 	return fhirRespToVerificationResult(id, resp)
 }
 
+// VerificationResultSearchResult is the page of the VerificationResult search with its metadata.
+type VerificationResultSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.VerificationResult
+}
+
+func newVerificationResultSearchResult(result *SearchResult, err error) (*VerificationResultSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &VerificationResultSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.VerificationResult); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search VerificationResult with the total, links, full URLs and scores.
+func (c *Client) SearchVerificationResult(ctx context.Context, params Parameters) (*VerificationResultSearchResult, error) {
+	return newVerificationResultSearchResult(c.Search(ctx, "VerificationResult", params))
+}
+
+// Search VerificationResult page by its link URL.
+func (c *Client) SearchVerificationResultPage(ctx context.Context, url string) (*VerificationResultSearchResult, error) {
+	return newVerificationResultSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateVerificationResult(ctx context.Context, params Parameters, entity *models.VerificationResult) (*models.VerificationResult, error) {
 	resp, err := c.Create(ctx, "VerificationResult", params, entity)
 	if err != nil {
@@ -20186,11 +25083,11 @@ func (c *Client) DeleteVerificationResultByID(ctx context.Context, id string, pa
 // VisionPrescription
 // ---------------------------------------------------------------------------------------------------------------------------
 
-func bundleToVisionPrescriptions(bundle *models.Bundle) ([]*models.VisionPrescription, error) {
+func bundleToVisionPrescriptions(bundle *models.Bundle, decoder models.Decoder) ([]*models.VisionPrescription, error) {
 	var entities []*models.VisionPrescription
 	err := EnumBundleResources(bundle, "VisionPrescription", func(resource ResourceData) error {
 		var entity models.VisionPrescription
-		if err := resource.UnmarshalTo(&entity); err != nil {
+		if err := decoder.Unmarshal(resource, &entity); err != nil {
 			return NewUnmarshalError("resource parsing", "VisionPrescription", []byte(resource), err)
 		}
 		entities = append(entities, &entity)
@@ -20206,10 +25103,10 @@ func bundleToVisionPrescriptions(bundle *models.Bundle) ([]*models.VisionPrescri
 func fhirRespToVisionPrescriptions(resp *FhirResponse) ([]*models.VisionPrescription, error) {
 	switch resp.ResourceType {
 	case "Bundle":
-		return bundleToVisionPrescriptions(resp.Bundle)
+		return bundleToVisionPrescriptions(resp.Bundle, resp.decoder)
 	case "VisionPrescription":
 		var entity models.VisionPrescription
-		if err := json.Unmarshal(resp.Body, &entity); err != nil {
+		if err := resp.decoder.Unmarshal(resp.Body, &entity); err != nil {
 			return nil, NewUnmarshalError("resource parsing", resp.ResourceType, resp.Body, err)
 		}
 		return []*models.VisionPrescription{&entity}, nil
@@ -20248,6 +25145,36 @@ func (c *Client) GetVisionPrescriptionByID(ctx context.Context, id string, param
 	return fhirRespToVisionPrescription(id, resp)
 }
 
+// VisionPrescriptionSearchResult is the page of the VisionPrescription search with its metadata.
+type VisionPrescriptionSearchResult struct {
+	*SearchResult
+	// Resources are the matching resources, the included resources are in Entries.
+	Resources []*models.VisionPrescription
+}
+
+func newVisionPrescriptionSearchResult(result *SearchResult, err error) (*VisionPrescriptionSearchResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	typed := &VisionPrescriptionSearchResult{SearchResult: result}
+	for _, entry := range result.Matches() {
+		if entity, ok := entry.Resource.(*models.VisionPrescription); ok {
+			typed.Resources = append(typed.Resources, entity)
+		}
+	}
+	return typed, nil
+}
+
+// Search VisionPrescription with the total, links, full URLs and scores.
+func (c *Client) SearchVisionPrescription(ctx context.Context, params Parameters) (*VisionPrescriptionSearchResult, error) {
+	return newVisionPrescriptionSearchResult(c.Search(ctx, "VisionPrescription", params))
+}
+
+// Search VisionPrescription page by its link URL.
+func (c *Client) SearchVisionPrescriptionPage(ctx context.Context, url string) (*VisionPrescriptionSearchResult, error) {
+	return newVisionPrescriptionSearchResult(c.SearchPage(ctx, url))
+}
+
 func (c *Client) CreateVisionPrescription(ctx context.Context, params Parameters, entity *models.VisionPrescription) (*models.VisionPrescription, error) {
 	resp, err := c.Create(ctx, "VisionPrescription", params, entity)
 	if err != nil {
@@ -9,6 +9,9 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+
+	"github.com/gotidy/fhir-client/models"
 )
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function.
@@ -36,6 +39,20 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// MaxQueryLength is the length of the encoded search query after which the search
+	// is sent with POST to _search. Zero disables it.
+	MaxQueryLength int
+
+	// decoder unmarshals the resources of the responses.
+	decoder models.Decoder
+
+	// strict enables checking the requests against the CapabilityStatement.
+	strict bool
+	// capabilities is the cached CapabilityStatement, capabilitiesFetch is its request in flight.
+	capabilities      *Capabilities
+	capabilitiesFetch *capabilitiesFetch
+	capabilitiesMu    sync.Mutex
 }
 
 // ClientOption allows setting custom parameters during construction.
@@ -45,7 +62,8 @@ type ClientOption func(*Client) error
 func New(server string, opts ...ClientOption) (*Client, error) {
 	// create a client with sane default values
 	client := Client{
-		Server: server,
+		Server:         server,
+		MaxQueryLength: DefaultMaxQueryLength,
 	}
 	// mutate client and add all optional params
 	for _, o := range opts {
@@ -73,6 +91,15 @@ func WithHTTPClient(doer HTTPRequestDoer) ClientOption {
 	}
 }
 
+// WithMaxQueryLength sets the length of the encoded search query after which the search
+// is sent with POST to _search. Zero disables it.
+func WithMaxQueryLength(n int) ClientOption {
+	return func(c *Client) error {
+		c.MaxQueryLength = n
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -82,6 +109,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithDecoder sets the decoder of the resources, the lenient decoder keeps the unknown codes of the enums
+// and reports them as warnings instead of failing the response, the decoder with KeepUnknownFields keeps
+// the unknown properties, so they survive the read-modify-write.
+func WithDecoder(decoder models.Decoder) ClientOption {
+	return func(c *Client) error {
+		c.decoder = decoder
+		return nil
+	}
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	req = req.WithContext(ctx)
 	for _, r := range c.RequestEditors {
@@ -98,6 +135,18 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 }
 
 func (c *Client) DoRequest(ctx context.Context, req *http.Request) (*FhirResponse, error) {
+	if o := asyncFromContext(ctx); o != nil {
+		return c.doAsync(ctx, req, o)
+	}
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return newFhirResponse(resp, c.decoder)
+}
+
+// do applies the editors and sends the request.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	if err := c.applyEditors(ctx, req, nil); err != nil {
 		return nil, err
 	}
@@ -105,7 +154,7 @@ func (c *Client) DoRequest(ctx context.Context, req *http.Request) (*FhirRespons
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
-	return NewFhirResponse(resp)
+	return resp, nil
 }
 
 func BodyReader(body interface{}) (reader io.Reader, err error) {
@@ -118,7 +167,8 @@ func BodyReader(body interface{}) (reader io.Reader, err error) {
 	return bodyReader, nil
 }
 
-func (c *Client) RequestWithBodyReader(ctx context.Context, method string, path string, params Parameters, body io.Reader) (*FhirResponse, error) {
+// newRequest creates the request to the path relative to the server.
+func (c *Client) newRequest(ctx context.Context, method string, path string, params Parameters, body io.Reader) (*http.Request, error) {
 	queryURL, err := url.Parse(c.Server)
 	if err != nil {
 		return nil, err
@@ -137,6 +187,21 @@ func (c *Client) RequestWithBodyReader(ctx context.Context, method string, path
 	if err != nil {
 		return nil, err
 	}
+	// The absolute URLs given by the server, such as the next pages and the status of the async requests,
+	// are not the interactions built by the client.
+	if u, _ := url.Parse(path); !u.IsAbs() {
+		if err := c.checkCapabilities(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+func (c *Client) RequestWithBodyReader(ctx context.Context, method string, path string, params Parameters, body io.Reader) (*FhirResponse, error) {
+	req, err := c.newRequest(ctx, method, path, params, body)
+	if err != nil {
+		return nil, err
+	}
 
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
@@ -157,15 +222,25 @@ func (c *Client) Request(ctx context.Context, method string, path string, params
 }
 
 func (c *Client) Get(ctx context.Context, resource ResourceType, params Parameters) (*FhirResponse, error) {
-	return c.Request(ctx, http.MethodGet, string(resource), params)
+	return c.search(ctx, string(resource), params)
 }
 
 func (c *Client) GetByID(ctx context.Context, resource ResourceType, id string, params Parameters) (*FhirResponse, error) {
 	resp, err := c.Request(ctx, http.MethodGet, Path(string(resource), id), params)
-	if resp != nil && resp.StatusCode == 404 {
-		return resp, NewNotFoundError(id, string(resource))
+	return resp, withResource(err, resource, id)
+}
+
+// GetCompartment searches the resources of the type in the compartment with the ID, such as Patient/123/Observation.
+// All member types are searched when the resource is empty, such as Encounter/456/*.
+func (c *Client) GetCompartment(ctx context.Context, compartment ResourceType, id string, resource ResourceType, params Parameters) (*FhirResponse, error) {
+	if err := CheckCompartmentMember(compartment, resource); err != nil {
+		return nil, err
 	}
-	return resp, err
+	if resource == "" {
+		resource = "*"
+	}
+	resp, err := c.search(ctx, Path(string(compartment), id, string(resource)), params)
+	return resp, withResource(err, compartment, id)
 }
 
 func (c *Client) Create(ctx context.Context, resource ResourceType, params Parameters, body interface{}) (*FhirResponse, error) {
@@ -173,15 +248,12 @@ func (c *Client) Create(ctx context.Context, resource ResourceType, params Param
 }
 
 func (c *Client) Update(ctx context.Context, resource ResourceType, params Parameters, body interface{}) (*FhirResponse, error) {
-	return c.RequestWithBody(ctx, http.MethodPut, string(resource), params, body)
+	return c.update(ctx, resource, "", params, body)
 }
 
 func (c *Client) UpdateByID(ctx context.Context, resource ResourceType, id string, params Parameters, body interface{}) (*FhirResponse, error) {
-	resp, err := c.RequestWithBody(ctx, http.MethodPut, Path(string(resource), id), params, body)
-	if resp != nil && resp.StatusCode == 404 {
-		return resp, NewNotFoundError(id, string(resource))
-	}
-	return resp, err
+	resp, err := c.update(ctx, resource, id, params, body)
+	return resp, withResource(err, resource, id)
 }
 
 func (c *Client) Patch(ctx context.Context, resource ResourceType, params Parameters, body interface{}) (*FhirResponse, error) {
@@ -190,10 +262,7 @@ func (c *Client) Patch(ctx context.Context, resource ResourceType, params Parame
 
 func (c *Client) PatchByID(ctx context.Context, resource ResourceType, id string, params Parameters, body interface{}) (*FhirResponse, error) {
 	resp, err := c.RequestWithBody(ctx, http.MethodPatch, Path(string(resource), id), params, body)
-	if resp != nil && resp.StatusCode == 404 {
-		return resp, NewNotFoundError(id, string(resource))
-	}
-	return resp, err
+	return resp, withResource(err, resource, id)
 }
 
 func (c *Client) Delete(ctx context.Context, resource ResourceType, params Parameters) (*FhirResponse, error) {
@@ -202,8 +271,5 @@ func (c *Client) Delete(ctx context.Context, resource ResourceType, params Param
 
 func (c *Client) DeleteByID(ctx context.Context, resource ResourceType, id string, params Parameters) (*FhirResponse, error) {
 	resp, err := c.Request(ctx, http.MethodDelete, Path(string(resource), id), params)
-	if resp != nil && resp.StatusCode == 404 {
-		return resp, NewNotFoundError(id, string(resource))
-	}
-	return resp, err
+	return resp, withResource(err, resource, id)
 }
@@ -0,0 +1,52 @@
+package fhir
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestClientDecoder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/fhir+json")
+		_, _ = w.Write([]byte(`{"resourceType": "Bundle", "type": "searchset", "entry": [
+			{"resource": {"resourceType": "Patient", "id": "1", "gender": "female"}},
+			{"resource": {"resourceType": "Patient", "id": "2", "gender": "robot"}}
+		]}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := New(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetPatient(ctx, nil)
+	var codeErr models.CodeError
+	if !errors.As(err, &codeErr) || codeErr.Path != "Patient.gender" {
+		t.Errorf("expected unknown code at Patient.gender, got %v", err)
+	}
+
+	var warnings []models.CodeError
+	client, err = New(srv.URL, WithDecoder(models.Decoder{
+		Lenient: true,
+		Warn:    func(warning models.CodeError) { warnings = append(warnings, warning) },
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	patients, err := client.GetPatient(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patients) != 2 || patients[1].Gender.Code() != "robot" {
+		t.Errorf("unexpected patients: %+v", patients)
+	}
+	if len(warnings) != 1 || warnings[0].Path != "Patient.gender" || warnings[0].Code != "robot" {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
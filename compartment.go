@@ -0,0 +1,56 @@
+package fhir
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CompartmentError is returned when the resource type is not a member of the compartment.
+type CompartmentError struct {
+	Compartment ResourceType
+	Resource    ResourceType
+}
+
+func (e CompartmentError) Error() string {
+	if _, ok := compartments[e.Compartment]; !ok {
+		return fmt.Sprintf("unknown compartment \"%s\"", e.Compartment)
+	}
+	return fmt.Sprintf("resource \"%s\" is not a member of the \"%s\" compartment", e.Resource, e.Compartment)
+}
+
+// IsCompartment reports whether the resource type defines a compartment.
+func IsCompartment(compartment ResourceType) bool {
+	_, ok := compartments[compartment]
+	return ok
+}
+
+// IsCompartmentMember reports whether the resource type may be a member of the compartment.
+func IsCompartmentMember(compartment, resource ResourceType) bool {
+	_, ok := compartments[compartment][resource]
+	return ok
+}
+
+// CompartmentMembers returns the sorted resource types which may be members of the compartment.
+func CompartmentMembers(compartment ResourceType) []ResourceType {
+	members := make([]ResourceType, 0, len(compartments[compartment]))
+	for resource := range compartments[compartment] {
+		members = append(members, resource)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+	return members
+}
+
+// CompartmentParams returns the search parameters which link the resource to the compartment.
+// "{def}" means the compartment resource itself.
+func CompartmentParams(compartment, resource ResourceType) []string {
+	return compartments[compartment][resource]
+}
+
+// CheckCompartmentMember returns CompartmentError when the resource type is not a member of the compartment.
+// The empty resource type means all members.
+func CheckCompartmentMember(compartment, resource ResourceType) error {
+	if !IsCompartment(compartment) || resource != "" && !IsCompartmentMember(compartment, resource) {
+		return CompartmentError{Compartment: compartment, Resource: resource}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package fhir_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestGetCompartment(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	err := srv.Seed(
+		&models.Patient{ID: models.NewString("p1")},
+		&models.Patient{ID: models.NewString("p2")},
+		&models.Observation{ID: models.NewString("o1"), Status: models.ObservationStatusFinal, Subject: &models.Reference{Reference: models.NewString("Patient/p1")}},
+		&models.Observation{ID: models.NewString("o2"), Status: models.ObservationStatusFinal, Subject: &models.Reference{Reference: models.NewString("Patient/p2")}},
+		&models.Encounter{ID: models.NewString("e1"), Status: models.EncounterStatusFinished, Subject: &models.Reference{Reference: models.NewString("Patient/p1")}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	observations, err := client.GetObservationByPatient(ctx, "p1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(observations) != 1 || models.ToString(observations[0].ID) != "o1" {
+		t.Errorf("unexpected observations: %s", mustJSON(observations))
+	}
+
+	bundle, err := fhir.ExpectedBundle(client.GetPatientCompartment(ctx, "p1", "", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, entry := range bundle.Entry {
+		got = append(got, models.ToString(entry.FullUrl)[len(srv.URL)+1:])
+	}
+	if want := "Encounter/e1,Observation/o1"; strings.Join(got, ",") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+
+	var compartmentErr fhir.CompartmentError
+	if _, err := client.GetCompartment(ctx, fhir.PatientResource, "p1", fhir.OrganizationResource, nil); !errors.As(err, &compartmentErr) {
+		t.Errorf("expected CompartmentError, got %v", err)
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("expected 2 recorded requests, got %d", n)
+	}
+}
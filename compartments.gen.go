@@ -0,0 +1,3029 @@
+package fhir
+
+import (
+	"context"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// compartments are the resource types which may be members of the compartments, with the search parameters linking them to the compartment.
+var compartments = map[ResourceType]map[ResourceType][]string{
+	DeviceResource: {
+		AccountResource:                  {"subject"},
+		AppointmentResource:              {"actor"},
+		AppointmentResponseResource:      {"actor"},
+		AuditEventResource:               {"agent"},
+		ChargeItemResource:               {"enterer", "performer-actor"},
+		ClaimResource:                    {"procedure-udi", "item-udi", "detail-udi", "subdetail-udi"},
+		CommunicationResource:            {"sender", "recipient"},
+		CommunicationRequestResource:     {"sender", "recipient"},
+		CompositionResource:              {"author"},
+		DetectedIssueResource:            {"author"},
+		DeviceResource:                   {"{def}"},
+		DeviceRequestResource:            {"device", "subject", "requester", "performer"},
+		DeviceUseStatementResource:       {"device"},
+		DiagnosticReportResource:         {"subject"},
+		DocumentManifestResource:         {"subject", "author"},
+		DocumentReferenceResource:        {"subject", "author"},
+		ExplanationOfBenefitResource:     {"procedure-udi", "item-udi", "detail-udi", "subdetail-udi"},
+		FlagResource:                     {"author"},
+		GroupResource:                    {"member"},
+		InvoiceResource:                  {"participant"},
+		ListResource:                     {"subject", "source"},
+		MediaResource:                    {"subject"},
+		MedicationAdministrationResource: {"device"},
+		MessageHeaderResource:            {"target"},
+		ObservationResource:              {"subject", "device"},
+		ProvenanceResource:               {"agent"},
+		QuestionnaireResponseResource:    {"author"},
+		RequestGroupResource:             {"author"},
+		RiskAssessmentResource:           {"performer"},
+		ScheduleResource:                 {"actor"},
+		ServiceRequestResource:           {"performer", "requester"},
+		SpecimenResource:                 {"subject"},
+		SupplyRequestResource:            {"requester"},
+	},
+	EncounterResource: {
+		CarePlanResource:                 {"encounter"},
+		CareTeamResource:                 {"encounter"},
+		ChargeItemResource:               {"context"},
+		ClaimResource:                    {"encounter"},
+		ClinicalImpressionResource:       {"encounter"},
+		CommunicationResource:            {"encounter"},
+		CommunicationRequestResource:     {"encounter"},
+		CompositionResource:              {"encounter"},
+		ConditionResource:                {"encounter"},
+		DeviceRequestResource:            {"encounter"},
+		DiagnosticReportResource:         {"encounter"},
+		DocumentManifestResource:         {"related-ref"},
+		DocumentReferenceResource:        {"encounter"},
+		EncounterResource:                {"{def}"},
+		ExplanationOfBenefitResource:     {"encounter"},
+		MediaResource:                    {"encounter"},
+		MedicationAdministrationResource: {"context"},
+		MedicationRequestResource:        {"encounter"},
+		NutritionOrderResource:           {"encounter"},
+		ObservationResource:              {"encounter"},
+		ProcedureResource:                {"encounter"},
+		QuestionnaireResponseResource:    {"encounter"},
+		RequestGroupResource:             {"encounter"},
+		RiskAssessmentResource:           {"encounter"},
+		ServiceRequestResource:           {"encounter"},
+		VisionPrescriptionResource:       {"encounter"},
+	},
+	PatientResource: {
+		AccountResource:                     {"subject"},
+		AdverseEventResource:                {"subject"},
+		AllergyIntoleranceResource:          {"patient", "recorder", "asserter"},
+		AppointmentResource:                 {"actor"},
+		AppointmentResponseResource:         {"actor"},
+		AuditEventResource:                  {"patient"},
+		BasicResource:                       {"patient", "author"},
+		BodyStructureResource:               {"patient"},
+		CarePlanResource:                    {"patient", "performer"},
+		CareTeamResource:                    {"patient", "participant"},
+		ChargeItemResource:                  {"subject"},
+		ClaimResource:                       {"patient", "payee"},
+		ClaimResponseResource:               {"patient"},
+		ClinicalImpressionResource:          {"subject"},
+		CommunicationResource:               {"subject", "sender", "recipient"},
+		CommunicationRequestResource:        {"subject", "sender", "recipient", "requester"},
+		CompositionResource:                 {"subject", "author", "attester"},
+		ConditionResource:                   {"patient", "asserter"},
+		ConsentResource:                     {"patient"},
+		CoverageResource:                    {"policy-holder", "subscriber", "beneficiary", "payor"},
+		CoverageEligibilityRequestResource:  {"patient"},
+		CoverageEligibilityResponseResource: {"patient"},
+		DetectedIssueResource:               {"patient"},
+		DeviceRequestResource:               {"subject", "performer"},
+		DeviceUseStatementResource:          {"subject"},
+		DiagnosticReportResource:            {"subject"},
+		DocumentManifestResource:            {"subject", "author", "recipient"},
+		DocumentReferenceResource:           {"subject", "author"},
+		EncounterResource:                   {"patient"},
+		EnrollmentRequestResource:           {"subject"},
+		EpisodeOfCareResource:               {"patient"},
+		ExplanationOfBenefitResource:        {"patient", "payee"},
+		FamilyMemberHistoryResource:         {"patient"},
+		FlagResource:                        {"patient"},
+		GoalResource:                        {"patient"},
+		GroupResource:                       {"member"},
+		ImagingStudyResource:                {"patient"},
+		ImmunizationResource:                {"patient"},
+		ImmunizationEvaluationResource:      {"patient"},
+		ImmunizationRecommendationResource:  {"patient"},
+		InvoiceResource:                     {"subject", "patient", "recipient"},
+		ListResource:                        {"subject", "source"},
+		MeasureReportResource:               {"patient"},
+		MediaResource:                       {"subject"},
+		MedicationAdministrationResource:    {"patient", "performer", "subject"},
+		MedicationDispenseResource:          {"subject", "patient", "receiver"},
+		MedicationRequestResource:           {"subject"},
+		MedicationStatementResource:         {"subject"},
+		MolecularSequenceResource:           {"patient"},
+		NutritionOrderResource:              {"patient"},
+		ObservationResource:                 {"subject", "performer"},
+		PatientResource:                     {"link"},
+		PersonResource:                      {"patient"},
+		ProcedureResource:                   {"patient", "performer"},
+		ProvenanceResource:                  {"patient"},
+		QuestionnaireResponseResource:       {"subject", "author"},
+		RelatedPersonResource:               {"patient"},
+		RequestGroupResource:                {"subject", "participant"},
+		ResearchSubjectResource:             {"individual"},
+		RiskAssessmentResource:              {"subject"},
+		ScheduleResource:                    {"actor"},
+		ServiceRequestResource:              {"subject", "performer"},
+		SpecimenResource:                    {"subject"},
+		SupplyDeliveryResource:              {"patient"},
+		SupplyRequestResource:               {"subject"},
+		VisionPrescriptionResource:          {"patient"},
+	},
+	PractitionerResource: {
+		AccountResource:                     {"subject"},
+		AdverseEventResource:                {"recorder"},
+		AllergyIntoleranceResource:          {"recorder", "asserter"},
+		AppointmentResource:                 {"actor"},
+		AppointmentResponseResource:         {"actor"},
+		AuditEventResource:                  {"agent"},
+		BasicResource:                       {"author"},
+		CarePlanResource:                    {"performer"},
+		CareTeamResource:                    {"participant"},
+		ChargeItemResource:                  {"enterer", "performer-actor"},
+		ClaimResource:                       {"enterer", "provider", "payee", "care-team"},
+		ClaimResponseResource:               {"requestor"},
+		ClinicalImpressionResource:          {"assessor"},
+		CommunicationResource:               {"sender", "recipient"},
+		CommunicationRequestResource:        {"sender", "recipient", "requester"},
+		CompositionResource:                 {"subject", "author", "attester"},
+		ConditionResource:                   {"asserter"},
+		CoverageEligibilityRequestResource:  {"enterer", "provider"},
+		CoverageEligibilityResponseResource: {"requestor"},
+		DetectedIssueResource:               {"author"},
+		DeviceRequestResource:               {"requester", "performer"},
+		DiagnosticReportResource:            {"performer"},
+		DocumentManifestResource:            {"subject", "author", "recipient"},
+		DocumentReferenceResource:           {"subject", "author", "authenticator"},
+		EncounterResource:                   {"practitioner", "participant"},
+		EpisodeOfCareResource:               {"care-manager"},
+		ExplanationOfBenefitResource:        {"enterer", "provider", "payee", "care-team"},
+		FlagResource:                        {"author"},
+		GroupResource:                       {"member"},
+		ImmunizationResource:                {"performer"},
+		InvoiceResource:                     {"participant"},
+		LinkageResource:                     {"author"},
+		ListResource:                        {"source"},
+		MediaResource:                       {"subject", "operator"},
+		MedicationAdministrationResource:    {"performer"},
+		MedicationDispenseResource:          {"performer", "receiver"},
+		MedicationRequestResource:           {"requester"},
+		MedicationStatementResource:         {"source"},
+		MessageHeaderResource:               {"receiver", "author", "responsible", "enterer"},
+		NutritionOrderResource:              {"provider"},
+		ObservationResource:                 {"performer"},
+		PatientResource:                     {"general-practitioner"},
+		PaymentNoticeResource:               {"provider"},
+		PaymentReconciliationResource:       {"requestor"},
+		PersonResource:                      {"practitioner"},
+		PractitionerResource:                {"{def}"},
+		PractitionerRoleResource:            {"practitioner"},
+		ProcedureResource:                   {"performer"},
+		ProvenanceResource:                  {"agent"},
+		QuestionnaireResponseResource:       {"author", "source"},
+		RequestGroupResource:                {"participant", "author"},
+		ResearchStudyResource:               {"principalinvestigator"},
+		RiskAssessmentResource:              {"performer"},
+		ScheduleResource:                    {"actor"},
+		ServiceRequestResource:              {"performer", "requester"},
+		SpecimenResource:                    {"collector"},
+		SupplyDeliveryResource:              {"supplier", "receiver"},
+		SupplyRequestResource:               {"requester"},
+		VisionPrescriptionResource:          {"prescriber"},
+	},
+	RelatedPersonResource: {
+		AdverseEventResource:             {"recorder"},
+		AllergyIntoleranceResource:       {"asserter"},
+		AppointmentResource:              {"actor"},
+		AppointmentResponseResource:      {"actor"},
+		BasicResource:                    {"author"},
+		CarePlanResource:                 {"performer"},
+		CareTeamResource:                 {"participant"},
+		ChargeItemResource:               {"enterer", "performer-actor"},
+		ClaimResource:                    {"payee"},
+		CommunicationResource:            {"sender", "recipient"},
+		CommunicationRequestResource:     {"sender", "recipient", "requester"},
+		CompositionResource:              {"author"},
+		ConditionResource:                {"asserter"},
+		CoverageResource:                 {"policy-holder", "subscriber", "payor"},
+		DocumentManifestResource:         {"author", "recipient"},
+		DocumentReferenceResource:        {"author"},
+		EncounterResource:                {"participant"},
+		ExplanationOfBenefitResource:     {"payee"},
+		InvoiceResource:                  {"recipient"},
+		MedicationAdministrationResource: {"performer"},
+		MedicationStatementResource:      {"source"},
+		ObservationResource:              {"performer"},
+		PatientResource:                  {"link"},
+		PersonResource:                   {"link"},
+		ProcedureResource:                {"performer"},
+		ProvenanceResource:               {"agent"},
+		QuestionnaireResponseResource:    {"author", "source"},
+		RelatedPersonResource:            {"{def}"},
+		RequestGroupResource:             {"participant"},
+		ServiceRequestResource:           {"performer"},
+		SupplyRequestResource:            {"requester"},
+	},
+}
+
+// ---------------------------------------------------------------------------------------------------------------------------
+// Device compartment
+// ---------------------------------------------------------------------------------------------------------------------------
+
+// GetDeviceCompartment searches the resources of the type in the Device compartment. All member types are searched when the resource is empty.
+func (c *Client) GetDeviceCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error) {
+	return c.GetCompartment(ctx, DeviceResource, id, resource, params)
+}
+
+// GetAccountByDevice searches Account in the Device compartment.
+func (c *Client) GetAccountByDevice(ctx context.Context, id string, params Parameters) ([]*models.Account, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, AccountResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAccounts(resp)
+}
+
+// GetAppointmentByDevice searches Appointment in the Device compartment.
+func (c *Client) GetAppointmentByDevice(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, AppointmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointments(resp)
+}
+
+// GetAppointmentResponseByDevice searches AppointmentResponse in the Device compartment.
+func (c *Client) GetAppointmentResponseByDevice(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, AppointmentResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointmentResponses(resp)
+}
+
+// GetAuditEventByDevice searches AuditEvent in the Device compartment.
+func (c *Client) GetAuditEventByDevice(ctx context.Context, id string, params Parameters) ([]*models.AuditEvent, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, AuditEventResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAuditEvents(resp)
+}
+
+// GetChargeItemByDevice searches ChargeItem in the Device compartment.
+func (c *Client) GetChargeItemByDevice(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ChargeItemResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToChargeItems(resp)
+}
+
+// GetClaimByDevice searches Claim in the Device compartment.
+func (c *Client) GetClaimByDevice(ctx context.Context, id string, params Parameters) ([]*models.Claim, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ClaimResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaims(resp)
+}
+
+// GetCommunicationByDevice searches Communication in the Device compartment.
+func (c *Client) GetCommunicationByDevice(ctx context.Context, id string, params Parameters) ([]*models.Communication, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, CommunicationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunications(resp)
+}
+
+// GetCommunicationRequestByDevice searches CommunicationRequest in the Device compartment.
+func (c *Client) GetCommunicationRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, CommunicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunicationRequests(resp)
+}
+
+// GetCompositionByDevice searches Composition in the Device compartment.
+func (c *Client) GetCompositionByDevice(ctx context.Context, id string, params Parameters) ([]*models.Composition, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, CompositionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCompositions(resp)
+}
+
+// GetDetectedIssueByDevice searches DetectedIssue in the Device compartment.
+func (c *Client) GetDetectedIssueByDevice(ctx context.Context, id string, params Parameters) ([]*models.DetectedIssue, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DetectedIssueResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDetectedIssues(resp)
+}
+
+// GetDeviceByDevice searches Device in the Device compartment.
+func (c *Client) GetDeviceByDevice(ctx context.Context, id string, params Parameters) ([]*models.Device, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DeviceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDevices(resp)
+}
+
+// GetDeviceRequestByDevice searches DeviceRequest in the Device compartment.
+func (c *Client) GetDeviceRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DeviceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDeviceRequests(resp)
+}
+
+// GetDeviceUseStatementByDevice searches DeviceUseStatement in the Device compartment.
+func (c *Client) GetDeviceUseStatementByDevice(ctx context.Context, id string, params Parameters) ([]*models.DeviceUseStatement, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DeviceUseStatementResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDeviceUseStatements(resp)
+}
+
+// GetDiagnosticReportByDevice searches DiagnosticReport in the Device compartment.
+func (c *Client) GetDiagnosticReportByDevice(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DiagnosticReportResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDiagnosticReports(resp)
+}
+
+// GetDocumentManifestByDevice searches DocumentManifest in the Device compartment.
+func (c *Client) GetDocumentManifestByDevice(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DocumentManifestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentManifests(resp)
+}
+
+// GetDocumentReferenceByDevice searches DocumentReference in the Device compartment.
+func (c *Client) GetDocumentReferenceByDevice(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, DocumentReferenceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentReferences(resp)
+}
+
+// GetExplanationOfBenefitByDevice searches ExplanationOfBenefit in the Device compartment.
+func (c *Client) GetExplanationOfBenefitByDevice(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ExplanationOfBenefitResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToExplanationOfBenefits(resp)
+}
+
+// GetFlagByDevice searches Flag in the Device compartment.
+func (c *Client) GetFlagByDevice(ctx context.Context, id string, params Parameters) ([]*models.Flag, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, FlagResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToFlags(resp)
+}
+
+// GetGroupByDevice searches Group in the Device compartment.
+func (c *Client) GetGroupByDevice(ctx context.Context, id string, params Parameters) ([]*models.Group, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, GroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToGroups(resp)
+}
+
+// GetInvoiceByDevice searches Invoice in the Device compartment.
+func (c *Client) GetInvoiceByDevice(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, InvoiceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToInvoices(resp)
+}
+
+// GetListByDevice searches List in the Device compartment.
+func (c *Client) GetListByDevice(ctx context.Context, id string, params Parameters) ([]*models.List, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ListResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToLists(resp)
+}
+
+// GetMediaByDevice searches Media in the Device compartment.
+func (c *Client) GetMediaByDevice(ctx context.Context, id string, params Parameters) ([]*models.Media, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, MediaResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedias(resp)
+}
+
+// GetMedicationAdministrationByDevice searches MedicationAdministration in the Device compartment.
+func (c *Client) GetMedicationAdministrationByDevice(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, MedicationAdministrationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationAdministrations(resp)
+}
+
+// GetMessageHeaderByDevice searches MessageHeader in the Device compartment.
+func (c *Client) GetMessageHeaderByDevice(ctx context.Context, id string, params Parameters) ([]*models.MessageHeader, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, MessageHeaderResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMessageHeaders(resp)
+}
+
+// GetObservationByDevice searches Observation in the Device compartment.
+func (c *Client) GetObservationByDevice(ctx context.Context, id string, params Parameters) ([]*models.Observation, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ObservationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToObservations(resp)
+}
+
+// GetProvenanceByDevice searches Provenance in the Device compartment.
+func (c *Client) GetProvenanceByDevice(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ProvenanceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProvenances(resp)
+}
+
+// GetQuestionnaireResponseByDevice searches QuestionnaireResponse in the Device compartment.
+func (c *Client) GetQuestionnaireResponseByDevice(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, QuestionnaireResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToQuestionnaireResponses(resp)
+}
+
+// GetRequestGroupByDevice searches RequestGroup in the Device compartment.
+func (c *Client) GetRequestGroupByDevice(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, RequestGroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRequestGroups(resp)
+}
+
+// GetRiskAssessmentByDevice searches RiskAssessment in the Device compartment.
+func (c *Client) GetRiskAssessmentByDevice(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, RiskAssessmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRiskAssessments(resp)
+}
+
+// GetScheduleByDevice searches Schedule in the Device compartment.
+func (c *Client) GetScheduleByDevice(ctx context.Context, id string, params Parameters) ([]*models.Schedule, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ScheduleResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSchedules(resp)
+}
+
+// GetServiceRequestByDevice searches ServiceRequest in the Device compartment.
+func (c *Client) GetServiceRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, ServiceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToServiceRequests(resp)
+}
+
+// GetSpecimenByDevice searches Specimen in the Device compartment.
+func (c *Client) GetSpecimenByDevice(ctx context.Context, id string, params Parameters) ([]*models.Specimen, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, SpecimenResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSpecimens(resp)
+}
+
+// GetSupplyRequestByDevice searches SupplyRequest in the Device compartment.
+func (c *Client) GetSupplyRequestByDevice(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error) {
+	resp, err := c.GetCompartment(ctx, DeviceResource, id, SupplyRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSupplyRequests(resp)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------------
+// Encounter compartment
+// ---------------------------------------------------------------------------------------------------------------------------
+
+// GetEncounterCompartment searches the resources of the type in the Encounter compartment. All member types are searched when the resource is empty.
+func (c *Client) GetEncounterCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error) {
+	return c.GetCompartment(ctx, EncounterResource, id, resource, params)
+}
+
+// GetCarePlanByEncounter searches CarePlan in the Encounter compartment.
+func (c *Client) GetCarePlanByEncounter(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, CarePlanResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCarePlans(resp)
+}
+
+// GetCareTeamByEncounter searches CareTeam in the Encounter compartment.
+func (c *Client) GetCareTeamByEncounter(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, CareTeamResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCareTeams(resp)
+}
+
+// GetChargeItemByEncounter searches ChargeItem in the Encounter compartment.
+func (c *Client) GetChargeItemByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ChargeItemResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToChargeItems(resp)
+}
+
+// GetClaimByEncounter searches Claim in the Encounter compartment.
+func (c *Client) GetClaimByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Claim, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ClaimResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaims(resp)
+}
+
+// GetClinicalImpressionByEncounter searches ClinicalImpression in the Encounter compartment.
+func (c *Client) GetClinicalImpressionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ClinicalImpression, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ClinicalImpressionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClinicalImpressions(resp)
+}
+
+// GetCommunicationByEncounter searches Communication in the Encounter compartment.
+func (c *Client) GetCommunicationByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Communication, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, CommunicationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunications(resp)
+}
+
+// GetCommunicationRequestByEncounter searches CommunicationRequest in the Encounter compartment.
+func (c *Client) GetCommunicationRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, CommunicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunicationRequests(resp)
+}
+
+// GetCompositionByEncounter searches Composition in the Encounter compartment.
+func (c *Client) GetCompositionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Composition, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, CompositionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCompositions(resp)
+}
+
+// GetConditionByEncounter searches Condition in the Encounter compartment.
+func (c *Client) GetConditionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Condition, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ConditionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToConditions(resp)
+}
+
+// GetDeviceRequestByEncounter searches DeviceRequest in the Encounter compartment.
+func (c *Client) GetDeviceRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, DeviceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDeviceRequests(resp)
+}
+
+// GetDiagnosticReportByEncounter searches DiagnosticReport in the Encounter compartment.
+func (c *Client) GetDiagnosticReportByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, DiagnosticReportResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDiagnosticReports(resp)
+}
+
+// GetDocumentManifestByEncounter searches DocumentManifest in the Encounter compartment.
+func (c *Client) GetDocumentManifestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, DocumentManifestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentManifests(resp)
+}
+
+// GetDocumentReferenceByEncounter searches DocumentReference in the Encounter compartment.
+func (c *Client) GetDocumentReferenceByEncounter(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, DocumentReferenceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentReferences(resp)
+}
+
+// GetEncounterByEncounter searches Encounter in the Encounter compartment.
+func (c *Client) GetEncounterByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, EncounterResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEncounters(resp)
+}
+
+// GetExplanationOfBenefitByEncounter searches ExplanationOfBenefit in the Encounter compartment.
+func (c *Client) GetExplanationOfBenefitByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ExplanationOfBenefitResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToExplanationOfBenefits(resp)
+}
+
+// GetMediaByEncounter searches Media in the Encounter compartment.
+func (c *Client) GetMediaByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Media, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, MediaResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedias(resp)
+}
+
+// GetMedicationAdministrationByEncounter searches MedicationAdministration in the Encounter compartment.
+func (c *Client) GetMedicationAdministrationByEncounter(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, MedicationAdministrationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationAdministrations(resp)
+}
+
+// GetMedicationRequestByEncounter searches MedicationRequest in the Encounter compartment.
+func (c *Client) GetMedicationRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.MedicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, MedicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationRequests(resp)
+}
+
+// GetNutritionOrderByEncounter searches NutritionOrder in the Encounter compartment.
+func (c *Client) GetNutritionOrderByEncounter(ctx context.Context, id string, params Parameters) ([]*models.NutritionOrder, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, NutritionOrderResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToNutritionOrders(resp)
+}
+
+// GetObservationByEncounter searches Observation in the Encounter compartment.
+func (c *Client) GetObservationByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Observation, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ObservationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToObservations(resp)
+}
+
+// GetProcedureByEncounter searches Procedure in the Encounter compartment.
+func (c *Client) GetProcedureByEncounter(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ProcedureResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProcedures(resp)
+}
+
+// GetQuestionnaireResponseByEncounter searches QuestionnaireResponse in the Encounter compartment.
+func (c *Client) GetQuestionnaireResponseByEncounter(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, QuestionnaireResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToQuestionnaireResponses(resp)
+}
+
+// GetRequestGroupByEncounter searches RequestGroup in the Encounter compartment.
+func (c *Client) GetRequestGroupByEncounter(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, RequestGroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRequestGroups(resp)
+}
+
+// GetRiskAssessmentByEncounter searches RiskAssessment in the Encounter compartment.
+func (c *Client) GetRiskAssessmentByEncounter(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, RiskAssessmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRiskAssessments(resp)
+}
+
+// GetServiceRequestByEncounter searches ServiceRequest in the Encounter compartment.
+func (c *Client) GetServiceRequestByEncounter(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, ServiceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToServiceRequests(resp)
+}
+
+// GetVisionPrescriptionByEncounter searches VisionPrescription in the Encounter compartment.
+func (c *Client) GetVisionPrescriptionByEncounter(ctx context.Context, id string, params Parameters) ([]*models.VisionPrescription, error) {
+	resp, err := c.GetCompartment(ctx, EncounterResource, id, VisionPrescriptionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToVisionPrescriptions(resp)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------------
+// Patient compartment
+// ---------------------------------------------------------------------------------------------------------------------------
+
+// GetPatientCompartment searches the resources of the type in the Patient compartment. All member types are searched when the resource is empty.
+func (c *Client) GetPatientCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error) {
+	return c.GetCompartment(ctx, PatientResource, id, resource, params)
+}
+
+// GetAccountByPatient searches Account in the Patient compartment.
+func (c *Client) GetAccountByPatient(ctx context.Context, id string, params Parameters) ([]*models.Account, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, AccountResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAccounts(resp)
+}
+
+// GetAdverseEventByPatient searches AdverseEvent in the Patient compartment.
+func (c *Client) GetAdverseEventByPatient(ctx context.Context, id string, params Parameters) ([]*models.AdverseEvent, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, AdverseEventResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAdverseEvents(resp)
+}
+
+// GetAllergyIntoleranceByPatient searches AllergyIntolerance in the Patient compartment.
+func (c *Client) GetAllergyIntoleranceByPatient(ctx context.Context, id string, params Parameters) ([]*models.AllergyIntolerance, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, AllergyIntoleranceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAllergyIntolerances(resp)
+}
+
+// GetAppointmentByPatient searches Appointment in the Patient compartment.
+func (c *Client) GetAppointmentByPatient(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, AppointmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointments(resp)
+}
+
+// GetAppointmentResponseByPatient searches AppointmentResponse in the Patient compartment.
+func (c *Client) GetAppointmentResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, AppointmentResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointmentResponses(resp)
+}
+
+// GetAuditEventByPatient searches AuditEvent in the Patient compartment.
+func (c *Client) GetAuditEventByPatient(ctx context.Context, id string, params Parameters) ([]*models.AuditEvent, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, AuditEventResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAuditEvents(resp)
+}
+
+// GetBasicByPatient searches Basic in the Patient compartment.
+func (c *Client) GetBasicByPatient(ctx context.Context, id string, params Parameters) ([]*models.Basic, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, BasicResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToBasics(resp)
+}
+
+// GetBodyStructureByPatient searches BodyStructure in the Patient compartment.
+func (c *Client) GetBodyStructureByPatient(ctx context.Context, id string, params Parameters) ([]*models.BodyStructure, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, BodyStructureResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToBodyStructures(resp)
+}
+
+// GetCarePlanByPatient searches CarePlan in the Patient compartment.
+func (c *Client) GetCarePlanByPatient(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CarePlanResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCarePlans(resp)
+}
+
+// GetCareTeamByPatient searches CareTeam in the Patient compartment.
+func (c *Client) GetCareTeamByPatient(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CareTeamResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCareTeams(resp)
+}
+
+// GetChargeItemByPatient searches ChargeItem in the Patient compartment.
+func (c *Client) GetChargeItemByPatient(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ChargeItemResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToChargeItems(resp)
+}
+
+// GetClaimByPatient searches Claim in the Patient compartment.
+func (c *Client) GetClaimByPatient(ctx context.Context, id string, params Parameters) ([]*models.Claim, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ClaimResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaims(resp)
+}
+
+// GetClaimResponseByPatient searches ClaimResponse in the Patient compartment.
+func (c *Client) GetClaimResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.ClaimResponse, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ClaimResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaimResponses(resp)
+}
+
+// GetClinicalImpressionByPatient searches ClinicalImpression in the Patient compartment.
+func (c *Client) GetClinicalImpressionByPatient(ctx context.Context, id string, params Parameters) ([]*models.ClinicalImpression, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ClinicalImpressionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClinicalImpressions(resp)
+}
+
+// GetCommunicationByPatient searches Communication in the Patient compartment.
+func (c *Client) GetCommunicationByPatient(ctx context.Context, id string, params Parameters) ([]*models.Communication, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CommunicationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunications(resp)
+}
+
+// GetCommunicationRequestByPatient searches CommunicationRequest in the Patient compartment.
+func (c *Client) GetCommunicationRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CommunicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunicationRequests(resp)
+}
+
+// GetCompositionByPatient searches Composition in the Patient compartment.
+func (c *Client) GetCompositionByPatient(ctx context.Context, id string, params Parameters) ([]*models.Composition, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CompositionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCompositions(resp)
+}
+
+// GetConditionByPatient searches Condition in the Patient compartment.
+func (c *Client) GetConditionByPatient(ctx context.Context, id string, params Parameters) ([]*models.Condition, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ConditionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToConditions(resp)
+}
+
+// GetConsentByPatient searches Consent in the Patient compartment.
+func (c *Client) GetConsentByPatient(ctx context.Context, id string, params Parameters) ([]*models.Consent, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ConsentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToConsents(resp)
+}
+
+// GetCoverageByPatient searches Coverage in the Patient compartment.
+func (c *Client) GetCoverageByPatient(ctx context.Context, id string, params Parameters) ([]*models.Coverage, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CoverageResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCoverages(resp)
+}
+
+// GetCoverageEligibilityRequestByPatient searches CoverageEligibilityRequest in the Patient compartment.
+func (c *Client) GetCoverageEligibilityRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CoverageEligibilityRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCoverageEligibilityRequests(resp)
+}
+
+// GetCoverageEligibilityResponseByPatient searches CoverageEligibilityResponse in the Patient compartment.
+func (c *Client) GetCoverageEligibilityResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityResponse, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, CoverageEligibilityResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCoverageEligibilityResponses(resp)
+}
+
+// GetDetectedIssueByPatient searches DetectedIssue in the Patient compartment.
+func (c *Client) GetDetectedIssueByPatient(ctx context.Context, id string, params Parameters) ([]*models.DetectedIssue, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, DetectedIssueResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDetectedIssues(resp)
+}
+
+// GetDeviceRequestByPatient searches DeviceRequest in the Patient compartment.
+func (c *Client) GetDeviceRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, DeviceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDeviceRequests(resp)
+}
+
+// GetDeviceUseStatementByPatient searches DeviceUseStatement in the Patient compartment.
+func (c *Client) GetDeviceUseStatementByPatient(ctx context.Context, id string, params Parameters) ([]*models.DeviceUseStatement, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, DeviceUseStatementResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDeviceUseStatements(resp)
+}
+
+// GetDiagnosticReportByPatient searches DiagnosticReport in the Patient compartment.
+func (c *Client) GetDiagnosticReportByPatient(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, DiagnosticReportResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDiagnosticReports(resp)
+}
+
+// GetDocumentManifestByPatient searches DocumentManifest in the Patient compartment.
+func (c *Client) GetDocumentManifestByPatient(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, DocumentManifestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentManifests(resp)
+}
+
+// GetDocumentReferenceByPatient searches DocumentReference in the Patient compartment.
+func (c *Client) GetDocumentReferenceByPatient(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, DocumentReferenceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentReferences(resp)
+}
+
+// GetEncounterByPatient searches Encounter in the Patient compartment.
+func (c *Client) GetEncounterByPatient(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, EncounterResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEncounters(resp)
+}
+
+// GetEnrollmentRequestByPatient searches EnrollmentRequest in the Patient compartment.
+func (c *Client) GetEnrollmentRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.EnrollmentRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, EnrollmentRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEnrollmentRequests(resp)
+}
+
+// GetEpisodeOfCareByPatient searches EpisodeOfCare in the Patient compartment.
+func (c *Client) GetEpisodeOfCareByPatient(ctx context.Context, id string, params Parameters) ([]*models.EpisodeOfCare, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, EpisodeOfCareResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEpisodeOfCares(resp)
+}
+
+// GetExplanationOfBenefitByPatient searches ExplanationOfBenefit in the Patient compartment.
+func (c *Client) GetExplanationOfBenefitByPatient(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ExplanationOfBenefitResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToExplanationOfBenefits(resp)
+}
+
+// GetFamilyMemberHistoryByPatient searches FamilyMemberHistory in the Patient compartment.
+func (c *Client) GetFamilyMemberHistoryByPatient(ctx context.Context, id string, params Parameters) ([]*models.FamilyMemberHistory, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, FamilyMemberHistoryResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToFamilyMemberHistorys(resp)
+}
+
+// GetFlagByPatient searches Flag in the Patient compartment.
+func (c *Client) GetFlagByPatient(ctx context.Context, id string, params Parameters) ([]*models.Flag, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, FlagResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToFlags(resp)
+}
+
+// GetGoalByPatient searches Goal in the Patient compartment.
+func (c *Client) GetGoalByPatient(ctx context.Context, id string, params Parameters) ([]*models.Goal, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, GoalResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToGoals(resp)
+}
+
+// GetGroupByPatient searches Group in the Patient compartment.
+func (c *Client) GetGroupByPatient(ctx context.Context, id string, params Parameters) ([]*models.Group, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, GroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToGroups(resp)
+}
+
+// GetImagingStudyByPatient searches ImagingStudy in the Patient compartment.
+func (c *Client) GetImagingStudyByPatient(ctx context.Context, id string, params Parameters) ([]*models.ImagingStudy, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ImagingStudyResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToImagingStudys(resp)
+}
+
+// GetImmunizationByPatient searches Immunization in the Patient compartment.
+func (c *Client) GetImmunizationByPatient(ctx context.Context, id string, params Parameters) ([]*models.Immunization, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ImmunizationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToImmunizations(resp)
+}
+
+// GetImmunizationEvaluationByPatient searches ImmunizationEvaluation in the Patient compartment.
+func (c *Client) GetImmunizationEvaluationByPatient(ctx context.Context, id string, params Parameters) ([]*models.ImmunizationEvaluation, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ImmunizationEvaluationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToImmunizationEvaluations(resp)
+}
+
+// GetImmunizationRecommendationByPatient searches ImmunizationRecommendation in the Patient compartment.
+func (c *Client) GetImmunizationRecommendationByPatient(ctx context.Context, id string, params Parameters) ([]*models.ImmunizationRecommendation, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ImmunizationRecommendationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToImmunizationRecommendations(resp)
+}
+
+// GetInvoiceByPatient searches Invoice in the Patient compartment.
+func (c *Client) GetInvoiceByPatient(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, InvoiceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToInvoices(resp)
+}
+
+// GetListByPatient searches List in the Patient compartment.
+func (c *Client) GetListByPatient(ctx context.Context, id string, params Parameters) ([]*models.List, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ListResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToLists(resp)
+}
+
+// GetMeasureReportByPatient searches MeasureReport in the Patient compartment.
+func (c *Client) GetMeasureReportByPatient(ctx context.Context, id string, params Parameters) ([]*models.MeasureReport, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MeasureReportResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMeasureReports(resp)
+}
+
+// GetMediaByPatient searches Media in the Patient compartment.
+func (c *Client) GetMediaByPatient(ctx context.Context, id string, params Parameters) ([]*models.Media, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MediaResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedias(resp)
+}
+
+// GetMedicationAdministrationByPatient searches MedicationAdministration in the Patient compartment.
+func (c *Client) GetMedicationAdministrationByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MedicationAdministrationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationAdministrations(resp)
+}
+
+// GetMedicationDispenseByPatient searches MedicationDispense in the Patient compartment.
+func (c *Client) GetMedicationDispenseByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationDispense, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MedicationDispenseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationDispenses(resp)
+}
+
+// GetMedicationRequestByPatient searches MedicationRequest in the Patient compartment.
+func (c *Client) GetMedicationRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MedicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationRequests(resp)
+}
+
+// GetMedicationStatementByPatient searches MedicationStatement in the Patient compartment.
+func (c *Client) GetMedicationStatementByPatient(ctx context.Context, id string, params Parameters) ([]*models.MedicationStatement, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MedicationStatementResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationStatements(resp)
+}
+
+// GetMolecularSequenceByPatient searches MolecularSequence in the Patient compartment.
+func (c *Client) GetMolecularSequenceByPatient(ctx context.Context, id string, params Parameters) ([]*models.MolecularSequence, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, MolecularSequenceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMolecularSequences(resp)
+}
+
+// GetNutritionOrderByPatient searches NutritionOrder in the Patient compartment.
+func (c *Client) GetNutritionOrderByPatient(ctx context.Context, id string, params Parameters) ([]*models.NutritionOrder, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, NutritionOrderResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToNutritionOrders(resp)
+}
+
+// GetObservationByPatient searches Observation in the Patient compartment.
+func (c *Client) GetObservationByPatient(ctx context.Context, id string, params Parameters) ([]*models.Observation, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ObservationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToObservations(resp)
+}
+
+// GetPatientByPatient searches Patient in the Patient compartment.
+func (c *Client) GetPatientByPatient(ctx context.Context, id string, params Parameters) ([]*models.Patient, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, PatientResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPatients(resp)
+}
+
+// GetPersonByPatient searches Person in the Patient compartment.
+func (c *Client) GetPersonByPatient(ctx context.Context, id string, params Parameters) ([]*models.Person, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, PersonResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPersons(resp)
+}
+
+// GetProcedureByPatient searches Procedure in the Patient compartment.
+func (c *Client) GetProcedureByPatient(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ProcedureResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProcedures(resp)
+}
+
+// GetProvenanceByPatient searches Provenance in the Patient compartment.
+func (c *Client) GetProvenanceByPatient(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ProvenanceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProvenances(resp)
+}
+
+// GetQuestionnaireResponseByPatient searches QuestionnaireResponse in the Patient compartment.
+func (c *Client) GetQuestionnaireResponseByPatient(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, QuestionnaireResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToQuestionnaireResponses(resp)
+}
+
+// GetRelatedPersonByPatient searches RelatedPerson in the Patient compartment.
+func (c *Client) GetRelatedPersonByPatient(ctx context.Context, id string, params Parameters) ([]*models.RelatedPerson, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, RelatedPersonResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRelatedPersons(resp)
+}
+
+// GetRequestGroupByPatient searches RequestGroup in the Patient compartment.
+func (c *Client) GetRequestGroupByPatient(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, RequestGroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRequestGroups(resp)
+}
+
+// GetResearchSubjectByPatient searches ResearchSubject in the Patient compartment.
+func (c *Client) GetResearchSubjectByPatient(ctx context.Context, id string, params Parameters) ([]*models.ResearchSubject, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ResearchSubjectResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToResearchSubjects(resp)
+}
+
+// GetRiskAssessmentByPatient searches RiskAssessment in the Patient compartment.
+func (c *Client) GetRiskAssessmentByPatient(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, RiskAssessmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRiskAssessments(resp)
+}
+
+// GetScheduleByPatient searches Schedule in the Patient compartment.
+func (c *Client) GetScheduleByPatient(ctx context.Context, id string, params Parameters) ([]*models.Schedule, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ScheduleResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSchedules(resp)
+}
+
+// GetServiceRequestByPatient searches ServiceRequest in the Patient compartment.
+func (c *Client) GetServiceRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, ServiceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToServiceRequests(resp)
+}
+
+// GetSpecimenByPatient searches Specimen in the Patient compartment.
+func (c *Client) GetSpecimenByPatient(ctx context.Context, id string, params Parameters) ([]*models.Specimen, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, SpecimenResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSpecimens(resp)
+}
+
+// GetSupplyDeliveryByPatient searches SupplyDelivery in the Patient compartment.
+func (c *Client) GetSupplyDeliveryByPatient(ctx context.Context, id string, params Parameters) ([]*models.SupplyDelivery, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, SupplyDeliveryResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSupplyDeliverys(resp)
+}
+
+// GetSupplyRequestByPatient searches SupplyRequest in the Patient compartment.
+func (c *Client) GetSupplyRequestByPatient(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, SupplyRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSupplyRequests(resp)
+}
+
+// GetVisionPrescriptionByPatient searches VisionPrescription in the Patient compartment.
+func (c *Client) GetVisionPrescriptionByPatient(ctx context.Context, id string, params Parameters) ([]*models.VisionPrescription, error) {
+	resp, err := c.GetCompartment(ctx, PatientResource, id, VisionPrescriptionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToVisionPrescriptions(resp)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------------
+// Practitioner compartment
+// ---------------------------------------------------------------------------------------------------------------------------
+
+// GetPractitionerCompartment searches the resources of the type in the Practitioner compartment. All member types are searched when the resource is empty.
+func (c *Client) GetPractitionerCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error) {
+	return c.GetCompartment(ctx, PractitionerResource, id, resource, params)
+}
+
+// GetAccountByPractitioner searches Account in the Practitioner compartment.
+func (c *Client) GetAccountByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Account, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, AccountResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAccounts(resp)
+}
+
+// GetAdverseEventByPractitioner searches AdverseEvent in the Practitioner compartment.
+func (c *Client) GetAdverseEventByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AdverseEvent, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, AdverseEventResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAdverseEvents(resp)
+}
+
+// GetAllergyIntoleranceByPractitioner searches AllergyIntolerance in the Practitioner compartment.
+func (c *Client) GetAllergyIntoleranceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AllergyIntolerance, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, AllergyIntoleranceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAllergyIntolerances(resp)
+}
+
+// GetAppointmentByPractitioner searches Appointment in the Practitioner compartment.
+func (c *Client) GetAppointmentByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, AppointmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointments(resp)
+}
+
+// GetAppointmentResponseByPractitioner searches AppointmentResponse in the Practitioner compartment.
+func (c *Client) GetAppointmentResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, AppointmentResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointmentResponses(resp)
+}
+
+// GetAuditEventByPractitioner searches AuditEvent in the Practitioner compartment.
+func (c *Client) GetAuditEventByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.AuditEvent, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, AuditEventResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAuditEvents(resp)
+}
+
+// GetBasicByPractitioner searches Basic in the Practitioner compartment.
+func (c *Client) GetBasicByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Basic, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, BasicResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToBasics(resp)
+}
+
+// GetCarePlanByPractitioner searches CarePlan in the Practitioner compartment.
+func (c *Client) GetCarePlanByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CarePlanResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCarePlans(resp)
+}
+
+// GetCareTeamByPractitioner searches CareTeam in the Practitioner compartment.
+func (c *Client) GetCareTeamByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CareTeamResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCareTeams(resp)
+}
+
+// GetChargeItemByPractitioner searches ChargeItem in the Practitioner compartment.
+func (c *Client) GetChargeItemByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ChargeItemResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToChargeItems(resp)
+}
+
+// GetClaimByPractitioner searches Claim in the Practitioner compartment.
+func (c *Client) GetClaimByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Claim, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ClaimResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaims(resp)
+}
+
+// GetClaimResponseByPractitioner searches ClaimResponse in the Practitioner compartment.
+func (c *Client) GetClaimResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ClaimResponse, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ClaimResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaimResponses(resp)
+}
+
+// GetClinicalImpressionByPractitioner searches ClinicalImpression in the Practitioner compartment.
+func (c *Client) GetClinicalImpressionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ClinicalImpression, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ClinicalImpressionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClinicalImpressions(resp)
+}
+
+// GetCommunicationByPractitioner searches Communication in the Practitioner compartment.
+func (c *Client) GetCommunicationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Communication, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CommunicationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunications(resp)
+}
+
+// GetCommunicationRequestByPractitioner searches CommunicationRequest in the Practitioner compartment.
+func (c *Client) GetCommunicationRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CommunicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunicationRequests(resp)
+}
+
+// GetCompositionByPractitioner searches Composition in the Practitioner compartment.
+func (c *Client) GetCompositionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Composition, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CompositionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCompositions(resp)
+}
+
+// GetConditionByPractitioner searches Condition in the Practitioner compartment.
+func (c *Client) GetConditionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Condition, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ConditionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToConditions(resp)
+}
+
+// GetCoverageEligibilityRequestByPractitioner searches CoverageEligibilityRequest in the Practitioner compartment.
+func (c *Client) GetCoverageEligibilityRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityRequest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CoverageEligibilityRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCoverageEligibilityRequests(resp)
+}
+
+// GetCoverageEligibilityResponseByPractitioner searches CoverageEligibilityResponse in the Practitioner compartment.
+func (c *Client) GetCoverageEligibilityResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.CoverageEligibilityResponse, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, CoverageEligibilityResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCoverageEligibilityResponses(resp)
+}
+
+// GetDetectedIssueByPractitioner searches DetectedIssue in the Practitioner compartment.
+func (c *Client) GetDetectedIssueByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DetectedIssue, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, DetectedIssueResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDetectedIssues(resp)
+}
+
+// GetDeviceRequestByPractitioner searches DeviceRequest in the Practitioner compartment.
+func (c *Client) GetDeviceRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DeviceRequest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, DeviceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDeviceRequests(resp)
+}
+
+// GetDiagnosticReportByPractitioner searches DiagnosticReport in the Practitioner compartment.
+func (c *Client) GetDiagnosticReportByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DiagnosticReport, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, DiagnosticReportResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDiagnosticReports(resp)
+}
+
+// GetDocumentManifestByPractitioner searches DocumentManifest in the Practitioner compartment.
+func (c *Client) GetDocumentManifestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, DocumentManifestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentManifests(resp)
+}
+
+// GetDocumentReferenceByPractitioner searches DocumentReference in the Practitioner compartment.
+func (c *Client) GetDocumentReferenceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, DocumentReferenceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentReferences(resp)
+}
+
+// GetEncounterByPractitioner searches Encounter in the Practitioner compartment.
+func (c *Client) GetEncounterByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, EncounterResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEncounters(resp)
+}
+
+// GetEpisodeOfCareByPractitioner searches EpisodeOfCare in the Practitioner compartment.
+func (c *Client) GetEpisodeOfCareByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.EpisodeOfCare, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, EpisodeOfCareResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEpisodeOfCares(resp)
+}
+
+// GetExplanationOfBenefitByPractitioner searches ExplanationOfBenefit in the Practitioner compartment.
+func (c *Client) GetExplanationOfBenefitByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ExplanationOfBenefitResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToExplanationOfBenefits(resp)
+}
+
+// GetFlagByPractitioner searches Flag in the Practitioner compartment.
+func (c *Client) GetFlagByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Flag, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, FlagResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToFlags(resp)
+}
+
+// GetGroupByPractitioner searches Group in the Practitioner compartment.
+func (c *Client) GetGroupByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Group, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, GroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToGroups(resp)
+}
+
+// GetImmunizationByPractitioner searches Immunization in the Practitioner compartment.
+func (c *Client) GetImmunizationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Immunization, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ImmunizationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToImmunizations(resp)
+}
+
+// GetInvoiceByPractitioner searches Invoice in the Practitioner compartment.
+func (c *Client) GetInvoiceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, InvoiceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToInvoices(resp)
+}
+
+// GetLinkageByPractitioner searches Linkage in the Practitioner compartment.
+func (c *Client) GetLinkageByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Linkage, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, LinkageResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToLinkages(resp)
+}
+
+// GetListByPractitioner searches List in the Practitioner compartment.
+func (c *Client) GetListByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.List, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ListResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToLists(resp)
+}
+
+// GetMediaByPractitioner searches Media in the Practitioner compartment.
+func (c *Client) GetMediaByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Media, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, MediaResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedias(resp)
+}
+
+// GetMedicationAdministrationByPractitioner searches MedicationAdministration in the Practitioner compartment.
+func (c *Client) GetMedicationAdministrationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, MedicationAdministrationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationAdministrations(resp)
+}
+
+// GetMedicationDispenseByPractitioner searches MedicationDispense in the Practitioner compartment.
+func (c *Client) GetMedicationDispenseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationDispense, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, MedicationDispenseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationDispenses(resp)
+}
+
+// GetMedicationRequestByPractitioner searches MedicationRequest in the Practitioner compartment.
+func (c *Client) GetMedicationRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, MedicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationRequests(resp)
+}
+
+// GetMedicationStatementByPractitioner searches MedicationStatement in the Practitioner compartment.
+func (c *Client) GetMedicationStatementByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MedicationStatement, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, MedicationStatementResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationStatements(resp)
+}
+
+// GetMessageHeaderByPractitioner searches MessageHeader in the Practitioner compartment.
+func (c *Client) GetMessageHeaderByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.MessageHeader, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, MessageHeaderResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMessageHeaders(resp)
+}
+
+// GetNutritionOrderByPractitioner searches NutritionOrder in the Practitioner compartment.
+func (c *Client) GetNutritionOrderByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.NutritionOrder, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, NutritionOrderResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToNutritionOrders(resp)
+}
+
+// GetObservationByPractitioner searches Observation in the Practitioner compartment.
+func (c *Client) GetObservationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Observation, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ObservationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToObservations(resp)
+}
+
+// GetPatientByPractitioner searches Patient in the Practitioner compartment.
+func (c *Client) GetPatientByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Patient, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, PatientResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPatients(resp)
+}
+
+// GetPaymentNoticeByPractitioner searches PaymentNotice in the Practitioner compartment.
+func (c *Client) GetPaymentNoticeByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.PaymentNotice, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, PaymentNoticeResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPaymentNotices(resp)
+}
+
+// GetPaymentReconciliationByPractitioner searches PaymentReconciliation in the Practitioner compartment.
+func (c *Client) GetPaymentReconciliationByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.PaymentReconciliation, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, PaymentReconciliationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPaymentReconciliations(resp)
+}
+
+// GetPersonByPractitioner searches Person in the Practitioner compartment.
+func (c *Client) GetPersonByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Person, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, PersonResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPersons(resp)
+}
+
+// GetPractitionerByPractitioner searches Practitioner in the Practitioner compartment.
+func (c *Client) GetPractitionerByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Practitioner, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, PractitionerResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPractitioners(resp)
+}
+
+// GetPractitionerRoleByPractitioner searches PractitionerRole in the Practitioner compartment.
+func (c *Client) GetPractitionerRoleByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.PractitionerRole, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, PractitionerRoleResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPractitionerRoles(resp)
+}
+
+// GetProcedureByPractitioner searches Procedure in the Practitioner compartment.
+func (c *Client) GetProcedureByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ProcedureResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProcedures(resp)
+}
+
+// GetProvenanceByPractitioner searches Provenance in the Practitioner compartment.
+func (c *Client) GetProvenanceByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ProvenanceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProvenances(resp)
+}
+
+// GetQuestionnaireResponseByPractitioner searches QuestionnaireResponse in the Practitioner compartment.
+func (c *Client) GetQuestionnaireResponseByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, QuestionnaireResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToQuestionnaireResponses(resp)
+}
+
+// GetRequestGroupByPractitioner searches RequestGroup in the Practitioner compartment.
+func (c *Client) GetRequestGroupByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, RequestGroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRequestGroups(resp)
+}
+
+// GetResearchStudyByPractitioner searches ResearchStudy in the Practitioner compartment.
+func (c *Client) GetResearchStudyByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ResearchStudy, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ResearchStudyResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToResearchStudys(resp)
+}
+
+// GetRiskAssessmentByPractitioner searches RiskAssessment in the Practitioner compartment.
+func (c *Client) GetRiskAssessmentByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.RiskAssessment, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, RiskAssessmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRiskAssessments(resp)
+}
+
+// GetScheduleByPractitioner searches Schedule in the Practitioner compartment.
+func (c *Client) GetScheduleByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Schedule, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ScheduleResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSchedules(resp)
+}
+
+// GetServiceRequestByPractitioner searches ServiceRequest in the Practitioner compartment.
+func (c *Client) GetServiceRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, ServiceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToServiceRequests(resp)
+}
+
+// GetSpecimenByPractitioner searches Specimen in the Practitioner compartment.
+func (c *Client) GetSpecimenByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.Specimen, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, SpecimenResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSpecimens(resp)
+}
+
+// GetSupplyDeliveryByPractitioner searches SupplyDelivery in the Practitioner compartment.
+func (c *Client) GetSupplyDeliveryByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.SupplyDelivery, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, SupplyDeliveryResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSupplyDeliverys(resp)
+}
+
+// GetSupplyRequestByPractitioner searches SupplyRequest in the Practitioner compartment.
+func (c *Client) GetSupplyRequestByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, SupplyRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSupplyRequests(resp)
+}
+
+// GetVisionPrescriptionByPractitioner searches VisionPrescription in the Practitioner compartment.
+func (c *Client) GetVisionPrescriptionByPractitioner(ctx context.Context, id string, params Parameters) ([]*models.VisionPrescription, error) {
+	resp, err := c.GetCompartment(ctx, PractitionerResource, id, VisionPrescriptionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToVisionPrescriptions(resp)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------------
+// RelatedPerson compartment
+// ---------------------------------------------------------------------------------------------------------------------------
+
+// GetRelatedPersonCompartment searches the resources of the type in the RelatedPerson compartment. All member types are searched when the resource is empty.
+func (c *Client) GetRelatedPersonCompartment(ctx context.Context, id string, resource ResourceType, params Parameters) (*FhirResponse, error) {
+	return c.GetCompartment(ctx, RelatedPersonResource, id, resource, params)
+}
+
+// GetAdverseEventByRelatedPerson searches AdverseEvent in the RelatedPerson compartment.
+func (c *Client) GetAdverseEventByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.AdverseEvent, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, AdverseEventResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAdverseEvents(resp)
+}
+
+// GetAllergyIntoleranceByRelatedPerson searches AllergyIntolerance in the RelatedPerson compartment.
+func (c *Client) GetAllergyIntoleranceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.AllergyIntolerance, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, AllergyIntoleranceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAllergyIntolerances(resp)
+}
+
+// GetAppointmentByRelatedPerson searches Appointment in the RelatedPerson compartment.
+func (c *Client) GetAppointmentByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Appointment, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, AppointmentResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointments(resp)
+}
+
+// GetAppointmentResponseByRelatedPerson searches AppointmentResponse in the RelatedPerson compartment.
+func (c *Client) GetAppointmentResponseByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.AppointmentResponse, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, AppointmentResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToAppointmentResponses(resp)
+}
+
+// GetBasicByRelatedPerson searches Basic in the RelatedPerson compartment.
+func (c *Client) GetBasicByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Basic, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, BasicResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToBasics(resp)
+}
+
+// GetCarePlanByRelatedPerson searches CarePlan in the RelatedPerson compartment.
+func (c *Client) GetCarePlanByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.CarePlan, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, CarePlanResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCarePlans(resp)
+}
+
+// GetCareTeamByRelatedPerson searches CareTeam in the RelatedPerson compartment.
+func (c *Client) GetCareTeamByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.CareTeam, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, CareTeamResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCareTeams(resp)
+}
+
+// GetChargeItemByRelatedPerson searches ChargeItem in the RelatedPerson compartment.
+func (c *Client) GetChargeItemByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.ChargeItem, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ChargeItemResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToChargeItems(resp)
+}
+
+// GetClaimByRelatedPerson searches Claim in the RelatedPerson compartment.
+func (c *Client) GetClaimByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Claim, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ClaimResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToClaims(resp)
+}
+
+// GetCommunicationByRelatedPerson searches Communication in the RelatedPerson compartment.
+func (c *Client) GetCommunicationByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Communication, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, CommunicationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunications(resp)
+}
+
+// GetCommunicationRequestByRelatedPerson searches CommunicationRequest in the RelatedPerson compartment.
+func (c *Client) GetCommunicationRequestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.CommunicationRequest, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, CommunicationRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCommunicationRequests(resp)
+}
+
+// GetCompositionByRelatedPerson searches Composition in the RelatedPerson compartment.
+func (c *Client) GetCompositionByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Composition, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, CompositionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCompositions(resp)
+}
+
+// GetConditionByRelatedPerson searches Condition in the RelatedPerson compartment.
+func (c *Client) GetConditionByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Condition, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ConditionResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToConditions(resp)
+}
+
+// GetCoverageByRelatedPerson searches Coverage in the RelatedPerson compartment.
+func (c *Client) GetCoverageByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Coverage, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, CoverageResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToCoverages(resp)
+}
+
+// GetDocumentManifestByRelatedPerson searches DocumentManifest in the RelatedPerson compartment.
+func (c *Client) GetDocumentManifestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.DocumentManifest, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, DocumentManifestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentManifests(resp)
+}
+
+// GetDocumentReferenceByRelatedPerson searches DocumentReference in the RelatedPerson compartment.
+func (c *Client) GetDocumentReferenceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.DocumentReference, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, DocumentReferenceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToDocumentReferences(resp)
+}
+
+// GetEncounterByRelatedPerson searches Encounter in the RelatedPerson compartment.
+func (c *Client) GetEncounterByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Encounter, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, EncounterResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToEncounters(resp)
+}
+
+// GetExplanationOfBenefitByRelatedPerson searches ExplanationOfBenefit in the RelatedPerson compartment.
+func (c *Client) GetExplanationOfBenefitByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.ExplanationOfBenefit, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ExplanationOfBenefitResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToExplanationOfBenefits(resp)
+}
+
+// GetInvoiceByRelatedPerson searches Invoice in the RelatedPerson compartment.
+func (c *Client) GetInvoiceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Invoice, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, InvoiceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToInvoices(resp)
+}
+
+// GetMedicationAdministrationByRelatedPerson searches MedicationAdministration in the RelatedPerson compartment.
+func (c *Client) GetMedicationAdministrationByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.MedicationAdministration, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, MedicationAdministrationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationAdministrations(resp)
+}
+
+// GetMedicationStatementByRelatedPerson searches MedicationStatement in the RelatedPerson compartment.
+func (c *Client) GetMedicationStatementByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.MedicationStatement, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, MedicationStatementResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToMedicationStatements(resp)
+}
+
+// GetObservationByRelatedPerson searches Observation in the RelatedPerson compartment.
+func (c *Client) GetObservationByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Observation, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ObservationResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToObservations(resp)
+}
+
+// GetPatientByRelatedPerson searches Patient in the RelatedPerson compartment.
+func (c *Client) GetPatientByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Patient, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, PatientResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPatients(resp)
+}
+
+// GetPersonByRelatedPerson searches Person in the RelatedPerson compartment.
+func (c *Client) GetPersonByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Person, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, PersonResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToPersons(resp)
+}
+
+// GetProcedureByRelatedPerson searches Procedure in the RelatedPerson compartment.
+func (c *Client) GetProcedureByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Procedure, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ProcedureResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProcedures(resp)
+}
+
+// GetProvenanceByRelatedPerson searches Provenance in the RelatedPerson compartment.
+func (c *Client) GetProvenanceByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.Provenance, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ProvenanceResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToProvenances(resp)
+}
+
+// GetQuestionnaireResponseByRelatedPerson searches QuestionnaireResponse in the RelatedPerson compartment.
+func (c *Client) GetQuestionnaireResponseByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.QuestionnaireResponse, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, QuestionnaireResponseResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToQuestionnaireResponses(resp)
+}
+
+// GetRelatedPersonByRelatedPerson searches RelatedPerson in the RelatedPerson compartment.
+func (c *Client) GetRelatedPersonByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.RelatedPerson, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, RelatedPersonResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRelatedPersons(resp)
+}
+
+// GetRequestGroupByRelatedPerson searches RequestGroup in the RelatedPerson compartment.
+func (c *Client) GetRequestGroupByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.RequestGroup, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, RequestGroupResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToRequestGroups(resp)
+}
+
+// GetServiceRequestByRelatedPerson searches ServiceRequest in the RelatedPerson compartment.
+func (c *Client) GetServiceRequestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.ServiceRequest, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, ServiceRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToServiceRequests(resp)
+}
+
+// GetSupplyRequestByRelatedPerson searches SupplyRequest in the RelatedPerson compartment.
+func (c *Client) GetSupplyRequestByRelatedPerson(ctx context.Context, id string, params Parameters) ([]*models.SupplyRequest, error) {
+	resp, err := c.GetCompartment(ctx, RelatedPersonResource, id, SupplyRequestResource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fhirRespToSupplyRequests(resp)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------------
+// Patient $everything
+// ---------------------------------------------------------------------------------------------------------------------------
+
+// Accounts returns Account resources.
+func (e *Everything) Accounts() []*models.Account {
+	entities := make([]*models.Account, 0, len(e.Resources[AccountResource]))
+	for _, entity := range e.Resources[AccountResource] {
+		entities = append(entities, entity.(*models.Account))
+	}
+	return entities
+}
+
+// AdverseEvents returns AdverseEvent resources.
+func (e *Everything) AdverseEvents() []*models.AdverseEvent {
+	entities := make([]*models.AdverseEvent, 0, len(e.Resources[AdverseEventResource]))
+	for _, entity := range e.Resources[AdverseEventResource] {
+		entities = append(entities, entity.(*models.AdverseEvent))
+	}
+	return entities
+}
+
+// AllergyIntolerances returns AllergyIntolerance resources.
+func (e *Everything) AllergyIntolerances() []*models.AllergyIntolerance {
+	entities := make([]*models.AllergyIntolerance, 0, len(e.Resources[AllergyIntoleranceResource]))
+	for _, entity := range e.Resources[AllergyIntoleranceResource] {
+		entities = append(entities, entity.(*models.AllergyIntolerance))
+	}
+	return entities
+}
+
+// Appointments returns Appointment resources.
+func (e *Everything) Appointments() []*models.Appointment {
+	entities := make([]*models.Appointment, 0, len(e.Resources[AppointmentResource]))
+	for _, entity := range e.Resources[AppointmentResource] {
+		entities = append(entities, entity.(*models.Appointment))
+	}
+	return entities
+}
+
+// AppointmentResponses returns AppointmentResponse resources.
+func (e *Everything) AppointmentResponses() []*models.AppointmentResponse {
+	entities := make([]*models.AppointmentResponse, 0, len(e.Resources[AppointmentResponseResource]))
+	for _, entity := range e.Resources[AppointmentResponseResource] {
+		entities = append(entities, entity.(*models.AppointmentResponse))
+	}
+	return entities
+}
+
+// AuditEvents returns AuditEvent resources.
+func (e *Everything) AuditEvents() []*models.AuditEvent {
+	entities := make([]*models.AuditEvent, 0, len(e.Resources[AuditEventResource]))
+	for _, entity := range e.Resources[AuditEventResource] {
+		entities = append(entities, entity.(*models.AuditEvent))
+	}
+	return entities
+}
+
+// Basics returns Basic resources.
+func (e *Everything) Basics() []*models.Basic {
+	entities := make([]*models.Basic, 0, len(e.Resources[BasicResource]))
+	for _, entity := range e.Resources[BasicResource] {
+		entities = append(entities, entity.(*models.Basic))
+	}
+	return entities
+}
+
+// BodyStructures returns BodyStructure resources.
+func (e *Everything) BodyStructures() []*models.BodyStructure {
+	entities := make([]*models.BodyStructure, 0, len(e.Resources[BodyStructureResource]))
+	for _, entity := range e.Resources[BodyStructureResource] {
+		entities = append(entities, entity.(*models.BodyStructure))
+	}
+	return entities
+}
+
+// CarePlans returns CarePlan resources.
+func (e *Everything) CarePlans() []*models.CarePlan {
+	entities := make([]*models.CarePlan, 0, len(e.Resources[CarePlanResource]))
+	for _, entity := range e.Resources[CarePlanResource] {
+		entities = append(entities, entity.(*models.CarePlan))
+	}
+	return entities
+}
+
+// CareTeams returns CareTeam resources.
+func (e *Everything) CareTeams() []*models.CareTeam {
+	entities := make([]*models.CareTeam, 0, len(e.Resources[CareTeamResource]))
+	for _, entity := range e.Resources[CareTeamResource] {
+		entities = append(entities, entity.(*models.CareTeam))
+	}
+	return entities
+}
+
+// ChargeItems returns ChargeItem resources.
+func (e *Everything) ChargeItems() []*models.ChargeItem {
+	entities := make([]*models.ChargeItem, 0, len(e.Resources[ChargeItemResource]))
+	for _, entity := range e.Resources[ChargeItemResource] {
+		entities = append(entities, entity.(*models.ChargeItem))
+	}
+	return entities
+}
+
+// Claims returns Claim resources.
+func (e *Everything) Claims() []*models.Claim {
+	entities := make([]*models.Claim, 0, len(e.Resources[ClaimResource]))
+	for _, entity := range e.Resources[ClaimResource] {
+		entities = append(entities, entity.(*models.Claim))
+	}
+	return entities
+}
+
+// ClaimResponses returns ClaimResponse resources.
+func (e *Everything) ClaimResponses() []*models.ClaimResponse {
+	entities := make([]*models.ClaimResponse, 0, len(e.Resources[ClaimResponseResource]))
+	for _, entity := range e.Resources[ClaimResponseResource] {
+		entities = append(entities, entity.(*models.ClaimResponse))
+	}
+	return entities
+}
+
+// ClinicalImpressions returns ClinicalImpression resources.
+func (e *Everything) ClinicalImpressions() []*models.ClinicalImpression {
+	entities := make([]*models.ClinicalImpression, 0, len(e.Resources[ClinicalImpressionResource]))
+	for _, entity := range e.Resources[ClinicalImpressionResource] {
+		entities = append(entities, entity.(*models.ClinicalImpression))
+	}
+	return entities
+}
+
+// Communications returns Communication resources.
+func (e *Everything) Communications() []*models.Communication {
+	entities := make([]*models.Communication, 0, len(e.Resources[CommunicationResource]))
+	for _, entity := range e.Resources[CommunicationResource] {
+		entities = append(entities, entity.(*models.Communication))
+	}
+	return entities
+}
+
+// CommunicationRequests returns CommunicationRequest resources.
+func (e *Everything) CommunicationRequests() []*models.CommunicationRequest {
+	entities := make([]*models.CommunicationRequest, 0, len(e.Resources[CommunicationRequestResource]))
+	for _, entity := range e.Resources[CommunicationRequestResource] {
+		entities = append(entities, entity.(*models.CommunicationRequest))
+	}
+	return entities
+}
+
+// Compositions returns Composition resources.
+func (e *Everything) Compositions() []*models.Composition {
+	entities := make([]*models.Composition, 0, len(e.Resources[CompositionResource]))
+	for _, entity := range e.Resources[CompositionResource] {
+		entities = append(entities, entity.(*models.Composition))
+	}
+	return entities
+}
+
+// Conditions returns Condition resources.
+func (e *Everything) Conditions() []*models.Condition {
+	entities := make([]*models.Condition, 0, len(e.Resources[ConditionResource]))
+	for _, entity := range e.Resources[ConditionResource] {
+		entities = append(entities, entity.(*models.Condition))
+	}
+	return entities
+}
+
+// Consents returns Consent resources.
+func (e *Everything) Consents() []*models.Consent {
+	entities := make([]*models.Consent, 0, len(e.Resources[ConsentResource]))
+	for _, entity := range e.Resources[ConsentResource] {
+		entities = append(entities, entity.(*models.Consent))
+	}
+	return entities
+}
+
+// Coverages returns Coverage resources.
+func (e *Everything) Coverages() []*models.Coverage {
+	entities := make([]*models.Coverage, 0, len(e.Resources[CoverageResource]))
+	for _, entity := range e.Resources[CoverageResource] {
+		entities = append(entities, entity.(*models.Coverage))
+	}
+	return entities
+}
+
+// CoverageEligibilityRequests returns CoverageEligibilityRequest resources.
+func (e *Everything) CoverageEligibilityRequests() []*models.CoverageEligibilityRequest {
+	entities := make([]*models.CoverageEligibilityRequest, 0, len(e.Resources[CoverageEligibilityRequestResource]))
+	for _, entity := range e.Resources[CoverageEligibilityRequestResource] {
+		entities = append(entities, entity.(*models.CoverageEligibilityRequest))
+	}
+	return entities
+}
+
+// CoverageEligibilityResponses returns CoverageEligibilityResponse resources.
+func (e *Everything) CoverageEligibilityResponses() []*models.CoverageEligibilityResponse {
+	entities := make([]*models.CoverageEligibilityResponse, 0, len(e.Resources[CoverageEligibilityResponseResource]))
+	for _, entity := range e.Resources[CoverageEligibilityResponseResource] {
+		entities = append(entities, entity.(*models.CoverageEligibilityResponse))
+	}
+	return entities
+}
+
+// DetectedIssues returns DetectedIssue resources.
+func (e *Everything) DetectedIssues() []*models.DetectedIssue {
+	entities := make([]*models.DetectedIssue, 0, len(e.Resources[DetectedIssueResource]))
+	for _, entity := range e.Resources[DetectedIssueResource] {
+		entities = append(entities, entity.(*models.DetectedIssue))
+	}
+	return entities
+}
+
+// DeviceRequests returns DeviceRequest resources.
+func (e *Everything) DeviceRequests() []*models.DeviceRequest {
+	entities := make([]*models.DeviceRequest, 0, len(e.Resources[DeviceRequestResource]))
+	for _, entity := range e.Resources[DeviceRequestResource] {
+		entities = append(entities, entity.(*models.DeviceRequest))
+	}
+	return entities
+}
+
+// DeviceUseStatements returns DeviceUseStatement resources.
+func (e *Everything) DeviceUseStatements() []*models.DeviceUseStatement {
+	entities := make([]*models.DeviceUseStatement, 0, len(e.Resources[DeviceUseStatementResource]))
+	for _, entity := range e.Resources[DeviceUseStatementResource] {
+		entities = append(entities, entity.(*models.DeviceUseStatement))
+	}
+	return entities
+}
+
+// DiagnosticReports returns DiagnosticReport resources.
+func (e *Everything) DiagnosticReports() []*models.DiagnosticReport {
+	entities := make([]*models.DiagnosticReport, 0, len(e.Resources[DiagnosticReportResource]))
+	for _, entity := range e.Resources[DiagnosticReportResource] {
+		entities = append(entities, entity.(*models.DiagnosticReport))
+	}
+	return entities
+}
+
+// DocumentManifests returns DocumentManifest resources.
+func (e *Everything) DocumentManifests() []*models.DocumentManifest {
+	entities := make([]*models.DocumentManifest, 0, len(e.Resources[DocumentManifestResource]))
+	for _, entity := range e.Resources[DocumentManifestResource] {
+		entities = append(entities, entity.(*models.DocumentManifest))
+	}
+	return entities
+}
+
+// DocumentReferences returns DocumentReference resources.
+func (e *Everything) DocumentReferences() []*models.DocumentReference {
+	entities := make([]*models.DocumentReference, 0, len(e.Resources[DocumentReferenceResource]))
+	for _, entity := range e.Resources[DocumentReferenceResource] {
+		entities = append(entities, entity.(*models.DocumentReference))
+	}
+	return entities
+}
+
+// Encounters returns Encounter resources.
+func (e *Everything) Encounters() []*models.Encounter {
+	entities := make([]*models.Encounter, 0, len(e.Resources[EncounterResource]))
+	for _, entity := range e.Resources[EncounterResource] {
+		entities = append(entities, entity.(*models.Encounter))
+	}
+	return entities
+}
+
+// EnrollmentRequests returns EnrollmentRequest resources.
+func (e *Everything) EnrollmentRequests() []*models.EnrollmentRequest {
+	entities := make([]*models.EnrollmentRequest, 0, len(e.Resources[EnrollmentRequestResource]))
+	for _, entity := range e.Resources[EnrollmentRequestResource] {
+		entities = append(entities, entity.(*models.EnrollmentRequest))
+	}
+	return entities
+}
+
+// EpisodeOfCares returns EpisodeOfCare resources.
+func (e *Everything) EpisodeOfCares() []*models.EpisodeOfCare {
+	entities := make([]*models.EpisodeOfCare, 0, len(e.Resources[EpisodeOfCareResource]))
+	for _, entity := range e.Resources[EpisodeOfCareResource] {
+		entities = append(entities, entity.(*models.EpisodeOfCare))
+	}
+	return entities
+}
+
+// ExplanationOfBenefits returns ExplanationOfBenefit resources.
+func (e *Everything) ExplanationOfBenefits() []*models.ExplanationOfBenefit {
+	entities := make([]*models.ExplanationOfBenefit, 0, len(e.Resources[ExplanationOfBenefitResource]))
+	for _, entity := range e.Resources[ExplanationOfBenefitResource] {
+		entities = append(entities, entity.(*models.ExplanationOfBenefit))
+	}
+	return entities
+}
+
+// FamilyMemberHistorys returns FamilyMemberHistory resources.
+func (e *Everything) FamilyMemberHistorys() []*models.FamilyMemberHistory {
+	entities := make([]*models.FamilyMemberHistory, 0, len(e.Resources[FamilyMemberHistoryResource]))
+	for _, entity := range e.Resources[FamilyMemberHistoryResource] {
+		entities = append(entities, entity.(*models.FamilyMemberHistory))
+	}
+	return entities
+}
+
+// Flags returns Flag resources.
+func (e *Everything) Flags() []*models.Flag {
+	entities := make([]*models.Flag, 0, len(e.Resources[FlagResource]))
+	for _, entity := range e.Resources[FlagResource] {
+		entities = append(entities, entity.(*models.Flag))
+	}
+	return entities
+}
+
+// Goals returns Goal resources.
+func (e *Everything) Goals() []*models.Goal {
+	entities := make([]*models.Goal, 0, len(e.Resources[GoalResource]))
+	for _, entity := range e.Resources[GoalResource] {
+		entities = append(entities, entity.(*models.Goal))
+	}
+	return entities
+}
+
+// Groups returns Group resources.
+func (e *Everything) Groups() []*models.Group {
+	entities := make([]*models.Group, 0, len(e.Resources[GroupResource]))
+	for _, entity := range e.Resources[GroupResource] {
+		entities = append(entities, entity.(*models.Group))
+	}
+	return entities
+}
+
+// ImagingStudys returns ImagingStudy resources.
+func (e *Everything) ImagingStudys() []*models.ImagingStudy {
+	entities := make([]*models.ImagingStudy, 0, len(e.Resources[ImagingStudyResource]))
+	for _, entity := range e.Resources[ImagingStudyResource] {
+		entities = append(entities, entity.(*models.ImagingStudy))
+	}
+	return entities
+}
+
+// Immunizations returns Immunization resources.
+func (e *Everything) Immunizations() []*models.Immunization {
+	entities := make([]*models.Immunization, 0, len(e.Resources[ImmunizationResource]))
+	for _, entity := range e.Resources[ImmunizationResource] {
+		entities = append(entities, entity.(*models.Immunization))
+	}
+	return entities
+}
+
+// ImmunizationEvaluations returns ImmunizationEvaluation resources.
+func (e *Everything) ImmunizationEvaluations() []*models.ImmunizationEvaluation {
+	entities := make([]*models.ImmunizationEvaluation, 0, len(e.Resources[ImmunizationEvaluationResource]))
+	for _, entity := range e.Resources[ImmunizationEvaluationResource] {
+		entities = append(entities, entity.(*models.ImmunizationEvaluation))
+	}
+	return entities
+}
+
+// ImmunizationRecommendations returns ImmunizationRecommendation resources.
+func (e *Everything) ImmunizationRecommendations() []*models.ImmunizationRecommendation {
+	entities := make([]*models.ImmunizationRecommendation, 0, len(e.Resources[ImmunizationRecommendationResource]))
+	for _, entity := range e.Resources[ImmunizationRecommendationResource] {
+		entities = append(entities, entity.(*models.ImmunizationRecommendation))
+	}
+	return entities
+}
+
+// Invoices returns Invoice resources.
+func (e *Everything) Invoices() []*models.Invoice {
+	entities := make([]*models.Invoice, 0, len(e.Resources[InvoiceResource]))
+	for _, entity := range e.Resources[InvoiceResource] {
+		entities = append(entities, entity.(*models.Invoice))
+	}
+	return entities
+}
+
+// Lists returns List resources.
+func (e *Everything) Lists() []*models.List {
+	entities := make([]*models.List, 0, len(e.Resources[ListResource]))
+	for _, entity := range e.Resources[ListResource] {
+		entities = append(entities, entity.(*models.List))
+	}
+	return entities
+}
+
+// MeasureReports returns MeasureReport resources.
+func (e *Everything) MeasureReports() []*models.MeasureReport {
+	entities := make([]*models.MeasureReport, 0, len(e.Resources[MeasureReportResource]))
+	for _, entity := range e.Resources[MeasureReportResource] {
+		entities = append(entities, entity.(*models.MeasureReport))
+	}
+	return entities
+}
+
+// Medias returns Media resources.
+func (e *Everything) Medias() []*models.Media {
+	entities := make([]*models.Media, 0, len(e.Resources[MediaResource]))
+	for _, entity := range e.Resources[MediaResource] {
+		entities = append(entities, entity.(*models.Media))
+	}
+	return entities
+}
+
+// MedicationAdministrations returns MedicationAdministration resources.
+func (e *Everything) MedicationAdministrations() []*models.MedicationAdministration {
+	entities := make([]*models.MedicationAdministration, 0, len(e.Resources[MedicationAdministrationResource]))
+	for _, entity := range e.Resources[MedicationAdministrationResource] {
+		entities = append(entities, entity.(*models.MedicationAdministration))
+	}
+	return entities
+}
+
+// MedicationDispenses returns MedicationDispense resources.
+func (e *Everything) MedicationDispenses() []*models.MedicationDispense {
+	entities := make([]*models.MedicationDispense, 0, len(e.Resources[MedicationDispenseResource]))
+	for _, entity := range e.Resources[MedicationDispenseResource] {
+		entities = append(entities, entity.(*models.MedicationDispense))
+	}
+	return entities
+}
+
+// MedicationRequests returns MedicationRequest resources.
+func (e *Everything) MedicationRequests() []*models.MedicationRequest {
+	entities := make([]*models.MedicationRequest, 0, len(e.Resources[MedicationRequestResource]))
+	for _, entity := range e.Resources[MedicationRequestResource] {
+		entities = append(entities, entity.(*models.MedicationRequest))
+	}
+	return entities
+}
+
+// MedicationStatements returns MedicationStatement resources.
+func (e *Everything) MedicationStatements() []*models.MedicationStatement {
+	entities := make([]*models.MedicationStatement, 0, len(e.Resources[MedicationStatementResource]))
+	for _, entity := range e.Resources[MedicationStatementResource] {
+		entities = append(entities, entity.(*models.MedicationStatement))
+	}
+	return entities
+}
+
+// MolecularSequences returns MolecularSequence resources.
+func (e *Everything) MolecularSequences() []*models.MolecularSequence {
+	entities := make([]*models.MolecularSequence, 0, len(e.Resources[MolecularSequenceResource]))
+	for _, entity := range e.Resources[MolecularSequenceResource] {
+		entities = append(entities, entity.(*models.MolecularSequence))
+	}
+	return entities
+}
+
+// NutritionOrders returns NutritionOrder resources.
+func (e *Everything) NutritionOrders() []*models.NutritionOrder {
+	entities := make([]*models.NutritionOrder, 0, len(e.Resources[NutritionOrderResource]))
+	for _, entity := range e.Resources[NutritionOrderResource] {
+		entities = append(entities, entity.(*models.NutritionOrder))
+	}
+	return entities
+}
+
+// Observations returns Observation resources.
+func (e *Everything) Observations() []*models.Observation {
+	entities := make([]*models.Observation, 0, len(e.Resources[ObservationResource]))
+	for _, entity := range e.Resources[ObservationResource] {
+		entities = append(entities, entity.(*models.Observation))
+	}
+	return entities
+}
+
+// Patients returns Patient resources.
+func (e *Everything) Patients() []*models.Patient {
+	entities := make([]*models.Patient, 0, len(e.Resources[PatientResource]))
+	for _, entity := range e.Resources[PatientResource] {
+		entities = append(entities, entity.(*models.Patient))
+	}
+	return entities
+}
+
+// Persons returns Person resources.
+func (e *Everything) Persons() []*models.Person {
+	entities := make([]*models.Person, 0, len(e.Resources[PersonResource]))
+	for _, entity := range e.Resources[PersonResource] {
+		entities = append(entities, entity.(*models.Person))
+	}
+	return entities
+}
+
+// Procedures returns Procedure resources.
+func (e *Everything) Procedures() []*models.Procedure {
+	entities := make([]*models.Procedure, 0, len(e.Resources[ProcedureResource]))
+	for _, entity := range e.Resources[ProcedureResource] {
+		entities = append(entities, entity.(*models.Procedure))
+	}
+	return entities
+}
+
+// Provenances returns Provenance resources.
+func (e *Everything) Provenances() []*models.Provenance {
+	entities := make([]*models.Provenance, 0, len(e.Resources[ProvenanceResource]))
+	for _, entity := range e.Resources[ProvenanceResource] {
+		entities = append(entities, entity.(*models.Provenance))
+	}
+	return entities
+}
+
+// QuestionnaireResponses returns QuestionnaireResponse resources.
+func (e *Everything) QuestionnaireResponses() []*models.QuestionnaireResponse {
+	entities := make([]*models.QuestionnaireResponse, 0, len(e.Resources[QuestionnaireResponseResource]))
+	for _, entity := range e.Resources[QuestionnaireResponseResource] {
+		entities = append(entities, entity.(*models.QuestionnaireResponse))
+	}
+	return entities
+}
+
+// RelatedPersons returns RelatedPerson resources.
+func (e *Everything) RelatedPersons() []*models.RelatedPerson {
+	entities := make([]*models.RelatedPerson, 0, len(e.Resources[RelatedPersonResource]))
+	for _, entity := range e.Resources[RelatedPersonResource] {
+		entities = append(entities, entity.(*models.RelatedPerson))
+	}
+	return entities
+}
+
+// RequestGroups returns RequestGroup resources.
+func (e *Everything) RequestGroups() []*models.RequestGroup {
+	entities := make([]*models.RequestGroup, 0, len(e.Resources[RequestGroupResource]))
+	for _, entity := range e.Resources[RequestGroupResource] {
+		entities = append(entities, entity.(*models.RequestGroup))
+	}
+	return entities
+}
+
+// ResearchSubjects returns ResearchSubject resources.
+func (e *Everything) ResearchSubjects() []*models.ResearchSubject {
+	entities := make([]*models.ResearchSubject, 0, len(e.Resources[ResearchSubjectResource]))
+	for _, entity := range e.Resources[ResearchSubjectResource] {
+		entities = append(entities, entity.(*models.ResearchSubject))
+	}
+	return entities
+}
+
+// RiskAssessments returns RiskAssessment resources.
+func (e *Everything) RiskAssessments() []*models.RiskAssessment {
+	entities := make([]*models.RiskAssessment, 0, len(e.Resources[RiskAssessmentResource]))
+	for _, entity := range e.Resources[RiskAssessmentResource] {
+		entities = append(entities, entity.(*models.RiskAssessment))
+	}
+	return entities
+}
+
+// Schedules returns Schedule resources.
+func (e *Everything) Schedules() []*models.Schedule {
+	entities := make([]*models.Schedule, 0, len(e.Resources[ScheduleResource]))
+	for _, entity := range e.Resources[ScheduleResource] {
+		entities = append(entities, entity.(*models.Schedule))
+	}
+	return entities
+}
+
+// ServiceRequests returns ServiceRequest resources.
+func (e *Everything) ServiceRequests() []*models.ServiceRequest {
+	entities := make([]*models.ServiceRequest, 0, len(e.Resources[ServiceRequestResource]))
+	for _, entity := range e.Resources[ServiceRequestResource] {
+		entities = append(entities, entity.(*models.ServiceRequest))
+	}
+	return entities
+}
+
+// Specimens returns Specimen resources.
+func (e *Everything) Specimens() []*models.Specimen {
+	entities := make([]*models.Specimen, 0, len(e.Resources[SpecimenResource]))
+	for _, entity := range e.Resources[SpecimenResource] {
+		entities = append(entities, entity.(*models.Specimen))
+	}
+	return entities
+}
+
+// SupplyDeliverys returns SupplyDelivery resources.
+func (e *Everything) SupplyDeliverys() []*models.SupplyDelivery {
+	entities := make([]*models.SupplyDelivery, 0, len(e.Resources[SupplyDeliveryResource]))
+	for _, entity := range e.Resources[SupplyDeliveryResource] {
+		entities = append(entities, entity.(*models.SupplyDelivery))
+	}
+	return entities
+}
+
+// SupplyRequests returns SupplyRequest resources.
+func (e *Everything) SupplyRequests() []*models.SupplyRequest {
+	entities := make([]*models.SupplyRequest, 0, len(e.Resources[SupplyRequestResource]))
+	for _, entity := range e.Resources[SupplyRequestResource] {
+		entities = append(entities, entity.(*models.SupplyRequest))
+	}
+	return entities
+}
+
+// VisionPrescriptions returns VisionPrescription resources.
+func (e *Everything) VisionPrescriptions() []*models.VisionPrescription {
+	entities := make([]*models.VisionPrescription, 0, len(e.Resources[VisionPrescriptionResource]))
+	for _, entity := range e.Resources[VisionPrescriptionResource] {
+		entities = append(entities, entity.(*models.VisionPrescription))
+	}
+	return entities
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gotidy/fhir-client/models"
 )
@@ -26,24 +28,39 @@ func (e ResponseError) Error() string {
 	return e.Message
 }
 
+// FhirError is returned for responses with an error status, errors.Is tells the statuses with the sentinels,
+// such as ErrGone. The requests for a resource by ID report 404 Not Found as NotFoundError embedding FhirError.
 type FhirError struct {
 	Status           int
 	OperationOutcome *models.OperationOutcome
 	Message          string
+	Header           http.Header
+	Method           string
+	URL              string
 }
 
 func NewFhirError(resp *http.Response, operationOutcome *models.OperationOutcome, msg string) FhirError {
-	return FhirError{
+	e := FhirError{
 		Status:           resp.StatusCode,
 		OperationOutcome: operationOutcome,
 		Message:          msg,
+		Header:           resp.Header,
 	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.URL = resp.Request.URL.String()
+	}
+	return e
 }
 
 func (e FhirError) Error() string {
+	var outcome string
+	if e.OperationOutcome != nil {
+		outcome = e.OperationOutcome.String()
+	}
 	switch {
-	case e.OperationOutcome != nil && e.OperationOutcome.Text.Div != "":
-		return e.OperationOutcome.Text.Div
+	case outcome != "":
+		return outcome
 	case e.Message != "":
 		return e.Message
 	default:
@@ -51,11 +68,148 @@ func (e FhirError) Error() string {
 	}
 }
 
+// RetryAfter returns the delay from the Retry-After header.
+func (e FhirError) RetryAfter() (time.Duration, bool) {
+	return retryAfter(e.Header)
+}
+
+// IssueCode returns the issue type matching the status, so the error can be reported with models.NewOperationOutcome.
+func (e FhirError) IssueCode() models.IssueType {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return models.IssueTypeInvalid
+	case http.StatusUnauthorized:
+		return models.IssueTypeLogin
+	case http.StatusForbidden:
+		return models.IssueTypeForbidden
+	case http.StatusNotFound:
+		return models.IssueTypeNotFound
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return models.IssueTypeNotSupported
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return models.IssueTypeConflict
+	case http.StatusGone:
+		return models.IssueTypeDeleted
+	case http.StatusUnprocessableEntity:
+		return models.IssueTypeProcessing
+	case http.StatusTooManyRequests:
+		return models.IssueTypeThrottled
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return models.IssueTypeTransient
+	case http.StatusGatewayTimeout:
+		return models.IssueTypeTimeout
+	}
+	return models.IssueTypeException
+}
+
 func AsFhirError(err error) (FhirError, bool) {
 	var e FhirError
 	return e, errors.As(err, &e)
 }
 
+// The sentinels of the statuses, errors.Is(err, ErrConflict) reports whether the server responded with 409 Conflict.
+var (
+	ErrBadRequest          = errors.New("bad request")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrNotFound            = errors.New("not found")
+	ErrMethodNotAllowed    = errors.New("method not allowed")
+	ErrConflict            = errors.New("conflict")
+	ErrGone                = errors.New("gone")
+	ErrPreconditionFailed  = errors.New("precondition failed")
+	ErrUnprocessableEntity = errors.New("unprocessable entity")
+	ErrTooManyRequests     = errors.New("too many requests")
+	// ErrServer matches all the 5xx statuses.
+	ErrServer = errors.New("server error")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:          ErrBadRequest,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusMethodNotAllowed:    ErrMethodNotAllowed,
+	http.StatusConflict:            ErrConflict,
+	http.StatusGone:                ErrGone,
+	http.StatusPreconditionFailed:  ErrPreconditionFailed,
+	http.StatusUnprocessableEntity: ErrUnprocessableEntity,
+	http.StatusTooManyRequests:     ErrTooManyRequests,
+}
+
+// Is reports whether the status matches the sentinel, such as ErrGone for 410 Gone or ErrServer for 503.
+func (e FhirError) Is(target error) bool {
+	if target == ErrServer {
+		return e.Status >= 500
+	}
+	return target != nil && statusErrors[e.Status] == target
+}
+
+// IsGone reports whether the resource was deleted.
+func IsGone(err error) bool {
+	return errors.Is(err, ErrGone)
+}
+
+// IsConflict reports whether the request conflicts with the current state of the resource.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// retryableIssues are the issue types which report temporary conditions.
+var retryableIssues = map[models.IssueType]bool{
+	models.IssueTypeTransient: true,
+	models.IssueTypeLockError: true,
+	models.IssueTypeTimeout:   true,
+	models.IssueTypeThrottled: true,
+}
+
+// IsRetryable reports whether the request may succeed if retried: on 429, 502, 503 and 504 statuses,
+// or when the OperationOutcome reports a transient issue.
+func IsRetryable(err error) bool {
+	e, ok := AsFhirError(err)
+	if !ok {
+		return false
+	}
+	switch e.Status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	if e.Status == http.StatusNotImplemented || e.OperationOutcome == nil {
+		return false
+	}
+	for _, issue := range e.OperationOutcome.Issue {
+		if retryableIssues[issue.Code] {
+			return true
+		}
+	}
+	return false
+}
+
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		// The date in the past allows retrying at once.
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// withResource reports 404 Not Found for a resource as NotFoundError with the resource type and ID.
+func withResource(err error, resource ResourceType, id string) error {
+	if e, ok := err.(FhirError); ok && e.Status == http.StatusNotFound {
+		return NotFoundError{FhirError: e, ID: id, Resource: string(resource)}
+	}
+	return err
+}
+
 type UnmarshalError struct {
 	Message  string
 	Resource ResourceType
@@ -85,7 +239,9 @@ func AsUnmarshalError(err error) (UnmarshalError, bool) {
 	return e, errors.As(err, &e)
 }
 
+// NotFoundError is returned for 404 Not Found and when the response doesn't contain the requested resource.
 type NotFoundError struct {
+	FhirError
 	ID       string
 	Resource string
 }
@@ -98,12 +254,32 @@ func NewNotFoundError(id, resource string) NotFoundError {
 }
 
 func (e NotFoundError) Error() string {
+	if e.Resource == "" && e.Status != 0 {
+		return e.FhirError.Error()
+	}
 	return fmt.Sprintf("resource \"%s\" with ID \"%s\" not found", e.Resource, e.ID)
 }
 
+// IssueCode implements models.IssueCoder.
+func (e NotFoundError) IssueCode() models.IssueType {
+	return models.IssueTypeNotFound
+}
+
+// Is reports whether the target is ErrNotFound, also when the error doesn't come from the server.
+func (e NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+func (e NotFoundError) Unwrap() error {
+	if e.Status == 0 {
+		return nil
+	}
+	return e.FhirError
+}
+
+// IsNotFoundError reports whether the resource is not found, by NotFoundError or the status 404 Not Found.
 func IsNotFoundError(err error) bool {
-	var e NotFoundError
-	return errors.As(err, &e)
+	return errors.Is(err, ErrNotFound)
 }
 
 func AsNotFoundError(err error) (NotFoundError, bool) {
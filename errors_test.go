@@ -1,8 +1,16 @@
 package fhir
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/gotidy/fhir-client/models"
 )
 
 func TestIsNotFound(t *testing.T) {
@@ -33,3 +41,96 @@ func TestIsNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestFhirErrorStatus(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		retryAfter string
+		issue      models.IssueType
+		gone       bool
+		conflict   bool
+		retryable  bool
+		sentinel   error
+	}{
+		{name: "Bad request", status: http.StatusBadRequest, issue: models.IssueTypeInvalid, sentinel: ErrBadRequest},
+		{name: "Conflict", status: http.StatusConflict, issue: models.IssueTypeConflict, conflict: true, sentinel: ErrConflict},
+		{name: "Gone", status: http.StatusGone, issue: models.IssueTypeDeleted, gone: true, sentinel: ErrGone},
+		{name: "Too many requests", status: http.StatusTooManyRequests, issue: models.IssueTypeThrottled, retryable: true, sentinel: ErrTooManyRequests},
+		{name: "Internal server error", status: http.StatusInternalServerError, issue: models.IssueTypeException, sentinel: ErrServer},
+		{name: "Transient server error", status: http.StatusInternalServerError, issue: models.IssueTypeTransient, retryable: true, sentinel: ErrServer},
+		{name: "Service unavailable", status: http.StatusServiceUnavailable, issue: models.IssueTypeException, retryable: true, sentinel: ErrServer},
+		{name: "Retry after the past date", status: http.StatusServiceUnavailable, retryAfter: past, issue: models.IssueTypeException, retryable: true, sentinel: ErrServer},
+		{
+			name:     "Unknown issue code",
+			status:   http.StatusUnprocessableEntity,
+			body:     `{"resourceType":"OperationOutcome","issue":[{"severity":"error","code":"future-code"}]}`,
+			sentinel: ErrUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://fhir.test/Patient/1", nil)
+			body := tt.body
+			if body == "" {
+				body = `{"resourceType":"OperationOutcome","issue":[{"severity":"error","code":"` + string(tt.issue) + `"}]}`
+			}
+			header := http.Header{"Content-Type": {"application/fhir+json"}, "Retry-After": {"2"}}
+			if tt.retryAfter != "" {
+				header.Set("Retry-After", tt.retryAfter)
+			}
+			resp := &http.Response{StatusCode: tt.status, Header: header, Body: ioutil.NopCloser(strings.NewReader(body)), Request: req}
+			_, err := newFhirResponse(resp, models.Decoder{})
+			err = withResource(err, PatientResource, "1")
+
+			e, ok := err.(FhirError)
+			if !ok {
+				t.Fatalf("expected FhirError, got %T", err)
+			}
+			if !errors.Is(err, tt.sentinel) || errors.Is(err, ErrNotFound) {
+				t.Errorf("expected the error matching only %v", tt.sentinel)
+			}
+			if e.Status != tt.status || e.Method != http.MethodGet || e.URL != req.URL.String() {
+				t.Errorf("unexpected FhirError: %+v", e)
+			}
+			if tt.issue != "" && (e.OperationOutcome == nil || e.OperationOutcome.Issue[0].Code != tt.issue) {
+				t.Errorf("expected the OperationOutcome with the issue %s, got %v", tt.issue, e.OperationOutcome)
+			}
+			if tt.issue == "" && e.OperationOutcome != nil {
+				t.Errorf("expected the undecodable OperationOutcome dropped, got %v", e.OperationOutcome)
+			}
+			delay := 2 * time.Second
+			if tt.retryAfter != "" {
+				delay = 0
+			}
+			if d, ok := e.RetryAfter(); !ok || d != delay {
+				t.Errorf("RetryAfter() = %v, %v", d, ok)
+			}
+			if got := IsGone(err); got != tt.gone {
+				t.Errorf("IsGone() = %v, want %v", got, tt.gone)
+			}
+			if got := IsConflict(err); got != tt.conflict {
+				t.Errorf("IsConflict() = %v, want %v", got, tt.conflict)
+			}
+			if got := IsRetryable(err); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.retryable)
+			}
+		})
+	}
+}
+
+func TestFhirErrorMessage(t *testing.T) {
+	outcome := &models.OperationOutcome{
+		Text:  &models.Narrative{Div: `<div xmlns="http://www.w3.org/1999/xhtml">Resource Patient/1 is not known</div>`},
+		Issue: []models.OperationOutcomeIssue{{Severity: models.IssueSeverityError, Code: models.IssueTypeNotFound, Diagnostics: models.NewString("Resource Patient/1 is not known")}},
+	}
+	err := NewFhirError(&http.Response{StatusCode: http.StatusNotFound}, outcome, "")
+	if got, want := err.Error(), "error [not-found] Resource Patient/1 is not known"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if got := models.NewOperationOutcome(err).Issue[0].Code; got != models.IssueTypeNotFound {
+		t.Errorf("NewOperationOutcome() issue code = %s, want not-found", got)
+	}
+}
@@ -0,0 +1,123 @@
+package fhir
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// EverythingParams are the parameters of the Patient $everything operation.
+type EverythingParams struct {
+	// Start and End limit the care dates of the returned resources.
+	Start *models.DateTime
+	End   *models.DateTime
+	// Since returns only the resources updated after the instant.
+	Since *time.Time
+	// Types limits the returned resource types.
+	Types []ResourceType
+	// Count is the page size.
+	Count int
+}
+
+// Encode implements Parameters.
+func (p EverythingParams) Encode() string {
+	values := url.Values{}
+	if p.Start != nil {
+		values.Set("start", p.Start.String())
+	}
+	if p.End != nil {
+		values.Set("end", p.End.String())
+	}
+	if p.Since != nil {
+		values.Set("_since", models.NewInstant(*p.Since).String())
+	}
+	if len(p.Types) > 0 {
+		types := make([]string, 0, len(p.Types))
+		for _, t := range p.Types {
+			types = append(types, string(t))
+		}
+		values.Set("_type", strings.Join(types, ","))
+	}
+	if p.Count > 0 {
+		values.Set("_count", strconv.Itoa(p.Count))
+	}
+	return values.Encode()
+}
+
+// Everything is the result of the Patient $everything operation grouped by resource type.
+// The resources of known types are *models.<Type>, of unknown types ResourceData.
+type Everything struct {
+	Patient   *models.Patient
+	Resources map[ResourceType][]interface{}
+}
+
+func (e *Everything) add(id string, data ResourceData, decoder models.Decoder) error {
+	resource := GetDataResourceType(data)
+	entity, err := decodeResource(data, decoder)
+	if err != nil {
+		return err
+	}
+	if patient, ok := entity.(*models.Patient); ok && models.ToString(patient.ID) == id {
+		e.Patient = patient
+	}
+	e.Resources[resource] = append(e.Resources[resource], entity)
+	return nil
+}
+
+// Types returns the sorted types of the returned resources.
+func (e *Everything) Types() []ResourceType {
+	types := make([]ResourceType, 0, len(e.Resources))
+	for resource := range e.Resources {
+		types = append(types, resource)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// PatientEverything calls the Patient $everything operation and follows the next links until all pages are read.
+func (c *Client) PatientEverything(ctx context.Context, id string, params *EverythingParams) (*Everything, error) {
+	var p Parameters
+	if params != nil {
+		p = params
+	}
+	resp, err := c.Request(ctx, http.MethodGet, Path(string(PatientResource), id, "$everything"), p)
+	if err != nil {
+		return nil, withResource(err, PatientResource, id)
+	}
+
+	everything := &Everything{Resources: make(map[ResourceType][]interface{})}
+	visited := make(map[string]bool)
+	for {
+		if err := resp.MustBundle(); err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.Bundle.Entry {
+			if len(entry.Resource) == 0 {
+				continue
+			}
+			if err := everything.add(id, ResourceData(entry.Resource), resp.decoder); err != nil {
+				return nil, err
+			}
+		}
+
+		next := NextLink(resp.Bundle)
+		if next == "" {
+			break
+		}
+		if visited[next] {
+			return nil, fmt.Errorf("paging loop at \"%s\"", next)
+		}
+		visited[next] = true
+		if resp, err = c.Request(ctx, http.MethodGet, next, nil); err != nil {
+			return nil, err
+		}
+	}
+	return everything, nil
+}
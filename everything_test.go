@@ -0,0 +1,63 @@
+package fhir_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestPatientEverything(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	err := srv.Seed(
+		&models.Patient{ID: models.NewString("p1")},
+		&models.Patient{ID: models.NewString("p2")},
+		&models.Condition{ID: models.NewString("c1"), Subject: models.Reference{Reference: models.NewString("Patient/p1")}},
+		&models.Observation{ID: models.NewString("o1"), Status: models.ObservationStatusFinal, Subject: &models.Reference{Reference: models.NewString("Patient/p1")}},
+		&models.Observation{ID: models.NewString("o2"), Status: models.ObservationStatusFinal, Subject: &models.Reference{Reference: models.NewString("Patient/p1")}},
+		&models.Observation{ID: models.NewString("o3"), Status: models.ObservationStatusFinal, Subject: &models.Reference{Reference: models.NewString("Patient/p2")}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	everything, err := client.PatientEverything(context.Background(), "p1", &fhir.EverythingParams{Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if models.ToString(everything.Patient.ID) != "p1" || len(everything.Conditions()) != 1 || len(everything.Observations()) != 2 {
+		t.Errorf("unexpected result: %s", mustJSON(everything.Resources))
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("expected 2 pages, got %d", n)
+	}
+	if types := fmt.Sprint(everything.Types()); types != "[Condition Observation Patient]" {
+		t.Errorf("expected sorted types, got %s", types)
+	}
+
+	since := time.Date(2020, 5, 11, 10, 0, 0, 250000000, time.UTC)
+	if query := (fhir.EverythingParams{Since: &since}).Encode(); query != "_since=2020-05-11T10%3A00%3A00.25Z" {
+		t.Errorf("expected _since with the fractional seconds, got %s", query)
+	}
+
+	everything, err = client.PatientEverything(context.Background(), "p1", &fhir.EverythingParams{Types: []fhir.ResourceType{fhir.ConditionResource}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if everything.Patient != nil || len(everything.Conditions()) != 1 || len(everything.Resources) != 1 {
+		t.Errorf("unexpected result: %s", mustJSON(everything.Resources))
+	}
+
+	if _, err := client.PatientEverything(context.Background(), "unknown", nil); !fhir.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, got %v", err)
+	}
+}
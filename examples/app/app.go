@@ -26,7 +26,7 @@ func (a *Application) Run(ctx context.Context) error {
 		ID: ptr.String(uuid.NewString()),
 		Name: []models.HumanName{
 			{
-				Given:  []string{"John", "Joshua"},
+				Given:  models.NewStrings("John", "Joshua"),
 				Family: ptr.String("Jonson"),
 			},
 		},
@@ -36,7 +36,7 @@ func (a *Application) Run(ctx context.Context) error {
 		ID: ptr.String(uuid.NewString()),
 		Name: []models.HumanName{
 			{
-				Given:  []string{"Jane", "Jan"},
+				Given:  models.NewStrings("Jane", "Jan"),
 				Family: ptr.String("Jack"),
 			},
 		},
@@ -0,0 +1,55 @@
+package fhirtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// asyncPath is the path of the status endpoints of the asynchronous requests.
+const asyncPath = "_async"
+
+// job is the asynchronous request. The request is processed at once, the response is returned
+// after the status was polled the configured number of times.
+type job struct {
+	resp  response
+	polls int
+}
+
+// startJob stores the response of the asynchronous request and returns 202 Accepted with the status endpoint.
+func (s *Server) startJob(resp response) response {
+	s.lastJob++
+	id := strconv.Itoa(s.lastJob)
+	s.jobs[id] = &job{resp: resp}
+	return response{
+		status: http.StatusAccepted,
+		header: http.Header{"Content-Location": {s.base() + asyncPath + "/" + id}},
+	}
+}
+
+// jobStatus reports the status of the asynchronous request, returns its response wrapped into the batch-response Bundle
+// or cancels it.
+func (s *Server) jobStatus(method string, parts []string) response {
+	if len(parts) != 2 {
+		return errorResponse(http.StatusNotFound, models.IssueTypeNotFound, "unknown status endpoint")
+	}
+	j, ok := s.jobs[parts[1]]
+	if !ok {
+		return errorResponse(http.StatusNotFound, models.IssueTypeNotFound, "job %s is not known", parts[1])
+	}
+
+	switch method {
+	case http.MethodGet:
+		if j.polls < s.asyncPolls {
+			j.polls++
+			return response{status: http.StatusAccepted, header: http.Header{"X-Progress": {"in progress"}}}
+		}
+		bundle := models.Bundle{Type: models.BundleTypeBatchResponse, Entry: []models.BundleEntry{responseEntry(j.resp)}}
+		return response{status: http.StatusOK, body: encodeObject(bundle)}
+	case http.MethodDelete:
+		delete(s.jobs, parts[1])
+		return response{status: http.StatusAccepted}
+	}
+	return errorResponse(http.StatusMethodNotAllowed, models.IssueTypeNotSupported, "%s is not supported at the status endpoint", method)
+}
@@ -0,0 +1,95 @@
+package fhirtest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+// binary handles Binary in the native content type. Uploaded content is wrapped into the Binary resource and the body is replaced,
+// a read with not JSON Accept header returns the content. It returns true when the request is handled.
+func (s *Server) binary(method string, parts []string, header http.Header, body []byte) (response, []byte, bool) {
+	switch {
+	case (method == http.MethodPost && len(parts) == 1 || method == http.MethodPut && len(parts) == 2) && isNative(header.Get("Content-Type")):
+		if s.jsonBinary {
+			return errorResponse(http.StatusUnsupportedMediaType, models.IssueTypeNotSupported, "Binary is supported only in JSON"), nil, true
+		}
+		binary := models.Binary{ContentType: header.Get("Content-Type"), Data: models.NewString(base64.StdEncoding.EncodeToString(body))}
+		if len(parts) == 2 {
+			binary.ID = &parts[1]
+		}
+		if reference := header.Get("X-Security-Context"); reference != "" {
+			binary.SecurityContext = &models.Reference{Reference: &reference}
+		}
+		return response{}, encodeObject(binary), false
+	case method == http.MethodGet && len(parts) == 2 && isNative(header.Get("Accept")) && !s.jsonBinary:
+		resp := s.read(fhir.BinaryResource, parts[1])
+		if resp.status != http.StatusOK {
+			return resp, nil, true
+		}
+		return nativeResponse(resp, header.Get("Range")), nil, true
+	}
+	return response{}, body, false
+}
+
+func isNative(contentType string) bool {
+	return contentType != "" && !strings.Contains(contentType, "json")
+}
+
+// nativeResponse returns the content of the Binary resource, or its part requested with the Range header.
+func nativeResponse(resp response, rangeHeader string) response {
+	var binary models.Binary
+	if err := fhir.ResourceData(resp.body).UnmarshalTo(&binary); err != nil {
+		return errorResponse(http.StatusInternalServerError, models.IssueTypeException, "decoding stored resource: %s", err)
+	}
+	data, err := base64.StdEncoding.DecodeString(models.ToString(binary.Data))
+	if err != nil {
+		return errorResponse(http.StatusInternalServerError, models.IssueTypeException, "decoding stored data: %s", err)
+	}
+
+	header := resp.header.Clone()
+	header.Set("Content-Type", binary.ContentType)
+	header.Set("Accept-Ranges", "bytes")
+	if binary.SecurityContext != nil {
+		header.Set("X-Security-Context", models.ToString(binary.SecurityContext.Reference))
+	}
+	if rangeHeader == "" {
+		return response{status: http.StatusOK, header: header, body: data}
+	}
+
+	start, end, ok := parseRange(rangeHeader, len(data))
+	if !ok {
+		header.Set("Content-Range", fmt.Sprintf("bytes */%d", len(data)))
+		return response{status: http.StatusRequestedRangeNotSatisfiable, header: header}
+	}
+	header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+	return response{status: http.StatusPartialContent, header: header, body: data[start : end+1]}
+}
+
+// parseRange parses the single range "bytes=start-end", the end is optional.
+func parseRange(rangeHeader string, size int) (start, end int, ok bool) {
+	spec := strings.TrimPrefix(rangeHeader, "bytes=")
+	parts := strings.SplitN(spec, "-", 2)
+	if spec == rangeHeader || len(parts) != 2 {
+		return 0, 0, false
+	}
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	end = size - 1
+	if parts[1] != "" {
+		if end, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0, false
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end, start <= end
+}
@@ -0,0 +1,71 @@
+package fhirtest
+
+import (
+	"net/http"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+// WithCapabilityStatement sets the CapabilityStatement returned from /metadata.
+// By default the statement declares all resource types with all the interactions the server supports.
+// The server doesn't enforce the statement.
+func WithCapabilityStatement(statement *models.CapabilityStatement) Option {
+	return func(s *Server) {
+		s.capabilities = statement
+	}
+}
+
+// capabilityStatement returns the statement set with WithCapabilityStatement or the default one.
+func (s *Server) capabilityStatement() response {
+	statement := s.capabilities
+	if statement == nil {
+		statement = defaultCapabilityStatement()
+	}
+	return response{status: http.StatusOK, body: encodeObject(statement)}
+}
+
+var resourceInteractions = []models.TypeRestfulInteraction{
+	models.TypeRestfulInteractionRead,
+	models.TypeRestfulInteractionVread,
+	models.TypeRestfulInteractionUpdate,
+	models.TypeRestfulInteractionPatch,
+	models.TypeRestfulInteractionDelete,
+	models.TypeRestfulInteractionHistoryInstance,
+	models.TypeRestfulInteractionHistoryType,
+	models.TypeRestfulInteractionCreate,
+	models.TypeRestfulInteractionSearchType,
+}
+
+func defaultCapabilityStatement() *models.CapabilityStatement {
+	versioning := models.ResourceVersionPolicyVersioned
+	rest := models.CapabilityStatementRest{
+		Mode: models.RestfulCapabilityModeServer,
+		Interaction: []models.CapabilityStatementRestInteraction{
+			{Code: models.SystemRestfulInteractionTransaction},
+			{Code: models.SystemRestfulInteractionBatch},
+		},
+	}
+	interactions := make([]models.CapabilityStatementRestResourceInteraction, 0, len(resourceInteractions))
+	for _, interaction := range resourceInteractions {
+		interactions = append(interactions, models.CapabilityStatementRestResourceInteraction{Code: interaction})
+	}
+	for _, t := range fhir.ResourceTypes() {
+		resource := models.CapabilityStatementRestResource{Type: models.ResourceType(t), Interaction: interactions, Versioning: &versioning}
+		if t == fhir.PatientResource {
+			resource.Operation = []models.CapabilityStatementRestResourceOperation{
+				{Name: "everything", Definition: "http://hl7.org/fhir/OperationDefinition/Patient-everything"},
+			}
+		}
+		rest.Resource = append(rest.Resource, resource)
+	}
+	return &models.CapabilityStatement{
+		Status:      models.PublicationStatusActive,
+		Date:        models.Date(2021, 1, 1),
+		Kind:        models.CapabilityStatementKindInstance,
+		FhirVersion: models.FHIRVersion4_0_1,
+		Format:      models.NewStrings("application/fhir+json"),
+		PatchFormat: models.NewStrings("application/json-patch+json"),
+		Rest:        []models.CapabilityStatementRest{rest},
+	}
+}
@@ -0,0 +1,291 @@
+package fhirtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces scrubbed header values and redacted body fields.
+const Redacted = "REDACTED"
+
+// DefaultScrubbedHeaders are headers whose values are never written to fixtures.
+var DefaultScrubbedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// DefaultPHIFields are JSON properties and query parameters which contain protected health information.
+// The name and text properties cover the HumanName and its text, the name search parameter and the narrative.
+var DefaultPHIFields = []string{
+	"name", "text", "family", "given", "prefix", "suffix", "birthDate", "birthdate",
+	"telecom", "email", "phone", "address", "address-city", "address-postalcode",
+	"identifier", "photo",
+}
+
+// codeFields are the coded properties of the redacted elements, such as HumanName.use and Narrative.status,
+// their values are kept, so the redacted resources still unmarshal.
+var codeFields = map[string]bool{"use": true, "status": true, "system": true, "type": true}
+
+// Interaction is a recorded request and response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded request.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is written to fixtures as is when it contains a JSON object or array, other bodies, such as the Binary content,
+// are written as {"base64": "..."}, so their bytes are replayed unchanged.
+type Body []byte
+
+// encodedBody is the fixture form of the body which is not a JSON object or array.
+type encodedBody struct {
+	Base64 []byte `json:"base64"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if len(b) == 0 {
+		return []byte("null"), nil
+	}
+	if isJSONDocument(b) && !isEncodedBody(b) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return json.Marshal(encodedBody{Base64: b})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	switch {
+	case string(data) == "null":
+		*b = nil
+	case isEncodedBody(data):
+		var encoded encodedBody
+		if err := json.Unmarshal(data, &encoded); err != nil {
+			return err
+		}
+		*b = encoded.Base64
+	case len(data) != 0 && data[0] == '"':
+		// The fixtures written before the base64 form kept the text bodies as strings.
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*b = Body(s)
+	default:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			return err
+		}
+		*b = buf.Bytes()
+	}
+	return nil
+}
+
+// isJSONDocument reports whether the data is a JSON object or array.
+func isJSONDocument(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) != 0 && (data[0] == '{' || data[0] == '[') && json.Valid(data)
+}
+
+// isEncodedBody reports whether the data is the JSON object with the only "base64" property.
+func isEncodedBody(data []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["base64"]
+	return ok && len(fields) == 1
+}
+
+type cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+func loadCassette(path string) ([]Interaction, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return c.Interactions, nil
+}
+
+func saveCassette(path string, interactions []Interaction) error {
+	data, err := json.MarshalIndent(cassette{Interactions: interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0o644)
+}
+
+// CassetteOption configures recording and replaying.
+type CassetteOption func(*cassetteConfig)
+
+type cassetteConfig struct {
+	scrubbedHeaders map[string]bool
+	phiFields       map[string]bool
+}
+
+func newCassetteConfig(opts []CassetteOption) *cassetteConfig {
+	c := &cassetteConfig{
+		scrubbedHeaders: make(map[string]bool),
+		phiFields:       make(map[string]bool),
+	}
+	WithScrubbedHeaders(DefaultScrubbedHeaders...)(c)
+	WithRedactedFields(DefaultPHIFields...)(c)
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// WithScrubbedHeaders adds headers whose values are replaced with Redacted.
+func WithScrubbedHeaders(names ...string) CassetteOption {
+	return func(c *cassetteConfig) {
+		for _, name := range names {
+			c.scrubbedHeaders[http.CanonicalHeaderKey(name)] = true
+		}
+	}
+}
+
+// WithRedactedFields adds JSON properties and query parameters whose string values are replaced with Redacted.
+func WithRedactedFields(names ...string) CassetteOption {
+	return func(c *cassetteConfig) {
+		for _, name := range names {
+			c.phiFields[name] = true
+		}
+	}
+}
+
+// WithoutRedaction disables redaction of bodies and query parameters. Headers are still scrubbed.
+func WithoutRedaction() CassetteOption {
+	return func(c *cassetteConfig) {
+		c.phiFields = make(map[string]bool)
+	}
+}
+
+func (c *cassetteConfig) header(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	result := header.Clone()
+	for name := range result {
+		if c.scrubbedHeaders[name] {
+			result[name] = []string{Redacted}
+		}
+	}
+	return result
+}
+
+// query returns the redacted query with sorted keys and values.
+func (c *cassetteConfig) query(query url.Values) string {
+	normalized := make(url.Values, len(query))
+	for key, values := range query {
+		name := key
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[:i]
+		}
+		values = append([]string(nil), values...)
+		if c.phiFields[name] {
+			for i := range values {
+				values[i] = Redacted
+			}
+		}
+		sort.Strings(values)
+		normalized[key] = values
+	}
+	return normalized.Encode()
+}
+
+// body returns the redacted JSON body in the compact form with sorted keys. Not JSON bodies are returned as is.
+func (c *cassetteConfig) body(body []byte) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	return encodeObject(c.redact(v, false))
+}
+
+func (c *cassetteConfig) redact(node interface{}, redacted bool) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			if _, ok := value.(string); ok && redacted && codeFields[key] {
+				continue
+			}
+			n[key] = c.redact(value, redacted || c.phiFields[key])
+		}
+	case []interface{}:
+		for i, value := range n {
+			n[i] = c.redact(value, redacted)
+		}
+	case string:
+		if redacted {
+			return Redacted
+		}
+		// Links contain search parameters.
+		if strings.Contains(n, "?") {
+			if u, err := url.Parse(n); err == nil && u.RawQuery != "" {
+				u.RawQuery = c.query(u.Query())
+				return u.String()
+			}
+		}
+	}
+	return node
+}
+
+func (c *cassetteConfig) request(req *http.Request, body []byte) RecordedRequest {
+	return RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  c.query(req.URL.Query()),
+		Header: c.header(req.Header),
+		Body:   c.body(body),
+	}
+}
+
+// readBody reads the body and replaces it with a new reader, so it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+	return data, err
+}
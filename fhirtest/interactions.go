@@ -0,0 +1,242 @@
+package fhirtest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func (s *Server) base() string {
+	if s.Server == nil {
+		return ""
+	}
+	return s.URL + "/"
+}
+
+func (s *Server) read(resource fhir.ResourceType, id string) response {
+	v, ok := s.store.current(resource, id)
+	switch {
+	case !ok:
+		return errorResponse(http.StatusNotFound, models.IssueTypeNotFound, "resource %s/%s is not known", resource, id)
+	case v.deleted:
+		return errorResponse(http.StatusGone, models.IssueTypeDeleted, "resource %s/%s was deleted", resource, id)
+	}
+	return resourceResponse(http.StatusOK, v)
+}
+
+func (s *Server) vread(resource fhir.ResourceType, id, vid string) response {
+	for _, v := range s.store.versions(resource, id) {
+		if v.vid != vid {
+			continue
+		}
+		if v.deleted {
+			return errorResponse(http.StatusGone, models.IssueTypeDeleted, "version %s of resource %s/%s was deleted", vid, resource, id)
+		}
+		return resourceResponse(http.StatusOK, v)
+	}
+	return errorResponse(http.StatusNotFound, models.IssueTypeNotFound, "version %s of resource %s/%s is not known", vid, resource, id)
+}
+
+func (s *Server) create(resource fhir.ResourceType, header http.Header, body []byte) response {
+	return s.createWithID(resource, "", header, body)
+}
+
+// createWithID creates the resource with the given ID, or with a new one when the ID is empty.
+func (s *Server) createWithID(resource fhir.ResourceType, id string, header http.Header, body []byte) response {
+	obj, resp, ok := s.parseResource(resource, body)
+	if !ok {
+		return resp
+	}
+
+	if query := header.Get("If-None-Exist"); query != "" {
+		params, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+		if err != nil {
+			return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "invalid If-None-Exist: %s", err)
+		}
+		found, resp, ok := s.find(resource, params)
+		switch {
+		case !ok:
+			return resp
+		case len(found) == 1:
+			return resourceResponse(http.StatusOK, found[0])
+		case len(found) > 1:
+			return errorResponse(http.StatusPreconditionFailed, models.IssueTypeMultipleMatches, "If-None-Exist matches %d resources", len(found))
+		}
+	}
+
+	if id == "" {
+		id = s.store.newID()
+	}
+	v := s.store.put(resource, id, obj, http.MethodPost, s.now())
+	resp = resourceResponse(http.StatusCreated, v)
+	resp.header.Set("Location", location(s.base(), string(resource), v))
+	return resp
+}
+
+func (s *Server) update(resource fhir.ResourceType, id string, header http.Header, body []byte) response {
+	obj, resp, ok := s.parseResource(resource, body)
+	if !ok {
+		return resp
+	}
+	if bodyID := stringValue(obj["id"]); bodyID != id {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "resource id %q does not match the URL id %q", bodyID, id)
+	}
+	return s.write(resource, id, header, http.MethodPut, obj)
+}
+
+func (s *Server) conditionalUpdate(resource fhir.ResourceType, id string, header http.Header, body []byte) response {
+	obj, resp, ok := s.parseResource(resource, body)
+	if !ok {
+		return resp
+	}
+	if bodyID := stringValue(obj["id"]); bodyID != "" && bodyID != id {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "resource id %q does not match the found resource id %q", bodyID, id)
+	}
+	return s.write(resource, id, header, http.MethodPut, obj)
+}
+
+func (s *Server) patch(resource fhir.ResourceType, id string, header http.Header, body []byte) response {
+	current, ok := s.store.current(resource, id)
+	switch {
+	case !ok:
+		return errorResponse(http.StatusNotFound, models.IssueTypeNotFound, "resource %s/%s is not known", resource, id)
+	case current.deleted:
+		return errorResponse(http.StatusGone, models.IssueTypeDeleted, "resource %s/%s was deleted", resource, id)
+	}
+
+	obj, err := decodeObject(current.data)
+	if err != nil {
+		return errorResponse(http.StatusInternalServerError, models.IssueTypeException, "decoding stored resource: %s", err)
+	}
+	if obj, err = applyPatch(obj, header.Get("Content-Type"), body); err != nil {
+		return errorResponse(http.StatusUnprocessableEntity, models.IssueTypeProcessing, "applying patch: %s", err)
+	}
+	if stringValue(obj["id"]) != id || stringValue(obj["resourceType"]) != string(resource) {
+		return errorResponse(http.StatusUnprocessableEntity, models.IssueTypeProcessing, "patch must not change the resource type or id")
+	}
+	return s.write(resource, id, header, http.MethodPatch, obj)
+}
+
+// write stores the new version of an existing or a new resource, checking the If-Match precondition.
+func (s *Server) write(resource fhir.ResourceType, id string, header http.Header, method string, obj map[string]interface{}) response {
+	current, exists := s.store.current(resource, id)
+	if ifMatch := header.Get("If-Match"); ifMatch != "" && (!exists || etag(current.vid) != ifMatch && current.vid != ifMatch) {
+		return errorResponse(http.StatusPreconditionFailed, models.IssueTypeConflict, "version of resource %s/%s does not match %s", resource, id, ifMatch)
+	}
+
+	v := s.store.put(resource, id, obj, method, s.now())
+	status := http.StatusOK
+	if !exists || current.deleted {
+		status = http.StatusCreated
+	}
+	resp := resourceResponse(status, v)
+	resp.header.Set("Location", location(s.base(), string(resource), v))
+	return resp
+}
+
+func (s *Server) delete(resource fhir.ResourceType, id string) response {
+	if current, ok := s.store.current(resource, id); ok && !current.deleted {
+		s.store.put(resource, id, nil, http.MethodDelete, s.now())
+	}
+	return outcomeResponse(http.StatusOK, models.IssueSeverityInformation, models.IssueTypeInformational, "resource "+string(resource)+"/"+id+" deleted")
+}
+
+// conditional executes the interaction on the single resource matching the query.
+// When nothing matches, the interaction receives a new ID.
+func (s *Server) conditional(resource fhir.ResourceType, query url.Values, f func(id string) response) response {
+	if len(query) == 0 {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "conditional interaction on %s requires search parameters", resource)
+	}
+	found, resp, ok := s.find(resource, query)
+	switch {
+	case !ok:
+		return resp
+	case len(found) > 1:
+		return errorResponse(http.StatusPreconditionFailed, models.IssueTypeMultipleMatches, "criteria match %d resources", len(found))
+	case len(found) == 1:
+		return f(found[0].id)
+	}
+	return f(s.store.newID())
+}
+
+func (s *Server) conditionalDelete(resource fhir.ResourceType, query url.Values) response {
+	if len(query) == 0 {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "conditional delete on %s requires search parameters", resource)
+	}
+	found, resp, ok := s.find(resource, query)
+	if !ok {
+		return resp
+	}
+	for _, v := range found {
+		s.store.put(resource, v.id, nil, http.MethodDelete, s.now())
+	}
+	return outcomeResponse(http.StatusOK, models.IssueSeverityInformation, models.IssueTypeInformational, "deleted resources: "+strconv.Itoa(len(found)))
+}
+
+func (s *Server) history(resource fhir.ResourceType, id string) response {
+	var versions []version
+	if id != "" {
+		versions = s.store.versions(resource, id)
+		if len(versions) == 0 {
+			return errorResponse(http.StatusNotFound, models.IssueTypeNotFound, "resource %s/%s is not known", resource, id)
+		}
+	} else {
+		for _, id := range s.store.order[resource] {
+			versions = append(versions, s.store.versions(resource, id)...)
+		}
+		sortVersions(versions)
+	}
+
+	bundle := models.Bundle{
+		Type:  models.BundleTypeHistory,
+		Total: models.NewInt(len(versions)),
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		entry := models.BundleEntry{
+			FullUrl: models.NewString(s.base() + string(resource) + "/" + v.id),
+			Request: &models.BundleEntryRequest{
+				URL: string(resource) + "/" + v.id,
+			},
+			Response: &models.BundleEntryResponse{
+				Status:       "200 OK",
+				Etag:         models.NewString(etag(v.vid)),
+				LastModified: models.NewInstant(v.updated.UTC()),
+			},
+		}
+		switch v.method {
+		case http.MethodPost:
+			entry.Request.Method = models.HTTPVerbPOST
+			entry.Request.URL = string(resource)
+			entry.Response.Status = "201 Created"
+		case http.MethodPatch:
+			entry.Request.Method = models.HTTPVerbPATCH
+		case http.MethodDelete:
+			entry.Request.Method = models.HTTPVerbDELETE
+			entry.Response.Status = "204 No Content"
+		default:
+			entry.Request.Method = models.HTTPVerbPUT
+		}
+		if !v.deleted {
+			entry.Resource = v.data
+		}
+		bundle.Entry = append(bundle.Entry, entry)
+	}
+	return response{status: http.StatusOK, body: encodeObject(bundle)}
+}
+
+// parseResource decodes the body and checks that it contains the resource of the expected type.
+func (s *Server) parseResource(resource fhir.ResourceType, body []byte) (map[string]interface{}, response, bool) {
+	obj, err := decodeObject(body)
+	if err != nil {
+		return nil, errorResponse(http.StatusBadRequest, models.IssueTypeStructure, "parsing body: %s", err), false
+	}
+	if rt := stringValue(obj["resourceType"]); rt != string(resource) {
+		return nil, errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "expected resource type %s but got %q", resource, rt), false
+	}
+	return obj, response{}, true
+}
@@ -0,0 +1,211 @@
+package fhirtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// applyPatch applies JSON Patch (RFC 6902) when the content type is application/json-patch+json
+// and JSON Merge Patch (RFC 7386) otherwise.
+func applyPatch(obj map[string]interface{}, contentType string, body []byte) (map[string]interface{}, error) {
+	if strings.Contains(contentType, "json-patch") {
+		return applyJSONPatch(obj, body)
+	}
+
+	patch, err := decodeObject(body)
+	if err != nil {
+		return nil, err
+	}
+	if stringValue(patch["resourceType"]) == "Parameters" {
+		return nil, errors.New("FHIRPath Patch is not supported")
+	}
+	return mergePatch(obj, patch), nil
+}
+
+func mergePatch(target, patch map[string]interface{}) map[string]interface{} {
+	for key, value := range patch {
+		switch v := value.(type) {
+		case nil:
+			delete(target, key)
+		case map[string]interface{}:
+			t, _ := target[key].(map[string]interface{})
+			if t == nil {
+				t = make(map[string]interface{})
+			}
+			target[key] = mergePatch(t, v)
+		default:
+			target[key] = v
+		}
+	}
+	return target
+}
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from"`
+	Value interface{} `json:"value"`
+}
+
+func applyJSONPatch(obj map[string]interface{}, body []byte) (map[string]interface{}, error) {
+	var ops []patchOperation
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&ops); err != nil {
+		return nil, err
+	}
+
+	var doc interface{} = obj
+	for _, op := range ops {
+		var err error
+		switch op.Op {
+		case "add":
+			doc, err = pointerSet(doc, op.Path, op.Value, true)
+		case "replace":
+			doc, err = pointerSet(doc, op.Path, op.Value, false)
+		case "remove":
+			doc, _, err = pointerRemove(doc, op.Path)
+		case "move", "copy":
+			var value interface{}
+			if op.Op == "move" {
+				doc, value, err = pointerRemove(doc, op.From)
+			} else {
+				value, err = pointerGet(doc, op.From)
+			}
+			if err == nil {
+				doc, err = pointerSet(doc, op.Path, value, true)
+			}
+		case "test":
+			var value interface{}
+			if value, err = pointerGet(doc, op.Path); err == nil && !reflect.DeepEqual(value, op.Value) {
+				err = fmt.Errorf("test failed at %q", op.Path)
+			}
+		default:
+			err = fmt.Errorf("unknown operation %q", op.Op)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("patch result is not an object")
+	}
+	return result, nil
+}
+
+func splitPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid pointer %q", pointer)
+	}
+	parts := strings.Split(pointer[1:], "/")
+	for i, part := range parts {
+		parts[i] = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+	}
+	return parts, nil
+}
+
+func pointerGet(doc interface{}, pointer string) (interface{}, error) {
+	parts, err := splitPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range parts {
+		switch d := doc.(type) {
+		case map[string]interface{}:
+			v, ok := d[part]
+			if !ok {
+				return nil, fmt.Errorf("path %q not found", pointer)
+			}
+			doc = v
+		case []interface{}:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(d) {
+				return nil, fmt.Errorf("path %q not found", pointer)
+			}
+			doc = d[i]
+		default:
+			return nil, fmt.Errorf("path %q not found", pointer)
+		}
+	}
+	return doc, nil
+}
+
+// pointerSet sets the value and returns the updated document. When insert is true, values are inserted into arrays.
+func pointerSet(doc interface{}, pointer string, value interface{}, insert bool) (interface{}, error) {
+	parts, err := splitPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return value, nil
+	}
+	parent, err := pointerGet(doc, pointer[:strings.LastIndex(pointer, "/")])
+	if err != nil {
+		return nil, err
+	}
+	last := parts[len(parts)-1]
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := p[last]; !ok && !insert {
+			return nil, fmt.Errorf("path %q not found", pointer)
+		}
+		p[last] = value
+		return doc, nil
+	case []interface{}:
+		i := len(p)
+		if last != "-" {
+			if i, err = strconv.Atoi(last); err != nil || i < 0 || i > len(p) || !insert && i == len(p) {
+				return nil, fmt.Errorf("invalid index in %q", pointer)
+			}
+		}
+		if insert {
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+		}
+		p[i] = value
+		return pointerReplaceArray(doc, pointer[:strings.LastIndex(pointer, "/")], p)
+	}
+	return nil, fmt.Errorf("path %q not found", pointer)
+}
+
+func pointerRemove(doc interface{}, pointer string) (interface{}, interface{}, error) {
+	value, err := pointerGet(doc, pointer)
+	if err != nil {
+		return nil, nil, err
+	}
+	parentPointer := pointer[:strings.LastIndex(pointer, "/")]
+	parent, _ := pointerGet(doc, parentPointer)
+	parts, _ := splitPointer(pointer)
+	last := parts[len(parts)-1]
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		delete(p, last)
+		return doc, value, nil
+	case []interface{}:
+		i, _ := strconv.Atoi(last)
+		p = append(p[:i:i], p[i+1:]...)
+		doc, err = pointerReplaceArray(doc, parentPointer, p)
+		return doc, value, err
+	}
+	return nil, nil, fmt.Errorf("path %q not found", pointer)
+}
+
+// pointerReplaceArray stores the resized array back into its parent.
+func pointerReplaceArray(doc interface{}, pointer string, array []interface{}) (interface{}, error) {
+	if pointer == "" {
+		return array, nil
+	}
+	return pointerSet(doc, pointer, array, false)
+}
@@ -0,0 +1,59 @@
+package fhirtest
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// alwaysElements are returned with any _elements and _summary.
+var alwaysElements = map[string]bool{"resourceType": true, "id": true, "meta": true}
+
+// project applies _elements and _summary to the resource and tags it as SUBSETTED.
+// The server doesn't know the summary elements of the definitions, so _summary=true removes the text,
+// contained resources and extensions, and _summary=text keeps the text and status.
+// It returns the data as is when the resource is not subsetted.
+func project(data []byte, query url.Values) ([]byte, error) {
+	elements := query.Get("_elements")
+	summary := query.Get("_summary")
+	if elements == "" && (summary == "" || summary == "false") {
+		return data, nil
+	}
+	obj, err := decodeObject(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var keep func(name string) bool
+	switch {
+	case elements != "":
+		names := make(map[string]bool)
+		for _, name := range strings.Split(elements, ",") {
+			names[strings.TrimSpace(name)] = true
+		}
+		keep = func(name string) bool { return names[name] }
+	case summary == "text":
+		keep = func(name string) bool { return name == "text" || name == "status" }
+	case summary == "data":
+		keep = func(name string) bool { return name != "text" }
+	default:
+		keep = func(name string) bool {
+			return name != "text" && name != "contained" && name != "extension" && name != "modifierExtension"
+		}
+	}
+	for name := range obj {
+		if !alwaysElements[name] && !keep(name) {
+			delete(obj, name)
+		}
+	}
+
+	meta, _ := obj["meta"].(map[string]interface{})
+	if meta == nil {
+		meta = make(map[string]interface{})
+		obj["meta"] = meta
+	}
+	tags, _ := meta["tag"].([]interface{})
+	meta["tag"] = append(tags, map[string]interface{}{"system": models.SubsettedSystem, "code": models.SubsettedCode})
+	return encodeObject(obj), nil
+}
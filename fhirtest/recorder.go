@@ -0,0 +1,75 @@
+package fhirtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	fhir "github.com/gotidy/fhir-client"
+)
+
+// Recorder is an HTTPRequestDoer which captures requests and responses of the wrapped doer.
+// Sensitive headers are scrubbed and PHI fields are redacted before interactions are saved.
+type Recorder struct {
+	doer   fhir.HTTPRequestDoer
+	path   string
+	config *cassetteConfig
+
+	mu           sync.Mutex
+	interactions []Interaction
+}
+
+var _ fhir.HTTPRequestDoer = (*Recorder)(nil)
+
+// NewRecorder creates a Recorder which saves interactions to the fixture file at the path.
+func NewRecorder(doer fhir.HTTPRequestDoer, path string, opts ...CassetteOption) *Recorder {
+	return &Recorder{
+		doer:   doer,
+		path:   path,
+		config: newCassetteConfig(opts),
+	}
+}
+
+// Do performs the request with the wrapped doer and records the interaction.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.doer.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		return resp, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: r.config.request(req, reqBody),
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.config.header(resp.Header),
+			Body:       r.config.body(respBody),
+		},
+	})
+	return resp, nil
+}
+
+// Interactions returns the recorded interactions.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Save writes the recorded interactions to the fixture file.
+func (r *Recorder) Save() error {
+	return saveCassette(r.path, r.Interactions())
+}
@@ -0,0 +1,118 @@
+package fhirtest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	fhir "github.com/gotidy/fhir-client"
+)
+
+// UnmatchedRequestError is returned by Replayer when no recorded interaction matches the request.
+type UnmatchedRequestError struct {
+	Request RecordedRequest
+	// Candidates are the recorded requests with the same method and path.
+	Candidates []RecordedRequest
+}
+
+func (e UnmatchedRequestError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "no recorded interaction matches %s %s", e.Request.Method, e.Request.Path)
+	if e.Request.Query != "" {
+		b.WriteString("?" + e.Request.Query)
+	}
+	if len(e.Request.Body) != 0 {
+		fmt.Fprintf(&b, " with body %s", e.Request.Body)
+	}
+	for _, c := range e.Candidates {
+		fmt.Fprintf(&b, "\n\tcandidate: %s %s?%s", c.Method, c.Path, c.Query)
+		if len(c.Body) != 0 {
+			fmt.Fprintf(&b, " with body %s", c.Body)
+		}
+	}
+	return b.String()
+}
+
+// Replayer is an HTTPRequestDoer which responds with the interactions recorded by Recorder.
+//
+// Requests are matched by method, path, normalized query and body. Every interaction is used once,
+// in the order of recording.
+type Replayer struct {
+	config *cassetteConfig
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+var _ fhir.HTTPRequestDoer = (*Replayer)(nil)
+
+// NewReplayer loads the interactions from the fixture file at the path.
+// The options must be the same as the ones the fixture was recorded with.
+func NewReplayer(path string, opts ...CassetteOption) (*Replayer, error) {
+	interactions, err := loadCassette(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading fixture: %w", err)
+	}
+	return &Replayer{
+		config:       newCassetteConfig(opts),
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+	}, nil
+}
+
+// Do responds with the first not used interaction matching the request.
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	recorded := r.config.request(req, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var candidates []RecordedRequest
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request.Method != recorded.Method || interaction.Request.Path != recorded.Path {
+			continue
+		}
+		if interaction.Request.Query != recorded.Query || !bytes.Equal(interaction.Request.Body, recorded.Body) {
+			candidates = append(candidates, interaction.Request)
+			continue
+		}
+		r.used[i] = true
+		// The recorded body is redacted and compacted, so the recorded length doesn't match it.
+		header := interaction.Response.Header.Clone()
+		header.Del("Content-Length")
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, UnmatchedRequestError{Request: recorded, Candidates: candidates}
+}
+
+// Unused returns the interactions which were not requested.
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []Interaction
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			result = append(result, interaction)
+		}
+	}
+	return result
+}
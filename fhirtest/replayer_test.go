@@ -0,0 +1,182 @@
+package fhirtest
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+	"github.com/gotidy/fhir-client/security"
+)
+
+func TestRecordReplay(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "patient.json")
+	ctx := context.Background()
+
+	recorder := NewRecorder(srv.Server.Client(), path)
+	client, err := fhir.New(srv.URL, fhir.WithHTTPClient(recorder), fhir.WithRequestEditorFn(security.BearerToken("secret")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.CreatePatient(ctx, nil, newPatient("Doe", "Jane"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetPatient(ctx, url.Values{"family": {"Doe"}, "_count": {"10"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	interactions := recorder.Interactions()
+	if len(interactions) != 2 {
+		t.Fatalf("expected 2 interactions, got %d", len(interactions))
+	}
+	if auth := interactions[0].Request.Header.Get("Authorization"); auth != Redacted {
+		t.Errorf("Authorization header is not scrubbed: %s", auth)
+	}
+	if strings.Contains(string(interactions[1].Response.Body), "Doe") || strings.Contains(interactions[1].Request.Query, "Doe") {
+		t.Errorf("PHI is not redacted: %s", interactions[1].Response.Body)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = fhir.New("http://replay.invalid", fhir.WithHTTPClient(replayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	patient, err := client.CreatePatient(ctx, nil, newPatient("Doe", "Jane"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if models.ToString(patient.ID) != models.ToString(created.ID) {
+		t.Errorf("expected replayed ID %s, got %s", models.ToString(created.ID), models.ToString(patient.ID))
+	}
+	// The query is normalized, so the order of parameters doesn't matter.
+	if _, err := client.GetPatient(ctx, url.Values{"_count": {"10"}, "family": {"Doe"}}); err != nil {
+		t.Fatal(err)
+	}
+	if unused := replayer.Unused(); len(unused) != 0 {
+		t.Errorf("expected all interactions to be used, got %d unused", len(unused))
+	}
+
+	_, err = client.GetPatient(ctx, url.Values{"family": {"Doe"}})
+	if !strings.Contains(err.Error(), "no recorded interaction matches GET /Patient") {
+		t.Errorf("expected unmatched request error, got %v", err)
+	}
+}
+
+func TestRecordNameSearch(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "name.json")
+	ctx := context.Background()
+
+	recorder := NewRecorder(srv.Server.Client(), path)
+	client, err := fhir.New(srv.URL, fhir.WithHTTPClient(recorder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	patient := newPatient("Doe", "Jane")
+	use := models.NameUseOfficial
+	patient.Name[0].Use = &use
+	patient.Name[0].Text = models.NewString("Jane Doe")
+	patient.Text = &models.Narrative{Status: models.NarrativeStatusGenerated, Div: "<div xmlns=\"http://www.w3.org/1999/xhtml\">Jane Doe</div>"}
+	if _, err := client.CreatePatient(ctx, nil, patient); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetPatient(ctx, url.Values{"name": {"Doe"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	search := recorder.Interactions()[1]
+	if search.Request.Query != "name="+Redacted {
+		t.Errorf("expected the redacted name parameter, got %s", search.Request.Query)
+	}
+	if body := string(search.Response.Body); strings.Contains(body, "Jane") || strings.Contains(body, "Doe") {
+		t.Errorf("PHI is not redacted: %s", body)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = fhir.New("http://replay.invalid", fhir.WithHTTPClient(replayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreatePatient(ctx, nil, patient); err != nil {
+		t.Fatal(err)
+	}
+	patients, err := client.GetPatient(ctx, url.Values{"name": {"Doe"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patients) != 1 || patients[0].Name[0].Use == nil || *patients[0].Name[0].Use != models.NameUseOfficial || patients[0].Text.Status != models.NarrativeStatusGenerated {
+		t.Errorf("expected the codes of the redacted elements kept, got %+v", patients)
+	}
+}
+
+func TestRecordBinary(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "binary.json")
+	ctx := context.Background()
+	content := []byte{0x25, 0x50, 0x44, 0x46, 0x00, 0xff, 0xfe, 0x80, '\n'}
+
+	recorder := NewRecorder(srv.Server.Client(), path)
+	client, err := fhir.New(srv.URL, fhir.WithHTTPClient(recorder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := client.CreateBinaryContent(ctx, "application/pdf", bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetBinaryContent(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = fhir.New("http://replay.invalid", fhir.WithHTTPClient(replayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateBinaryContent(ctx, "application/pdf", bytes.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+	binary, err := client.GetBinaryContent(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer binary.Body.Close()
+	data, err := ioutil.ReadAll(binary.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, content) {
+		t.Errorf("expected the replayed content %q, got %q", content, data)
+	}
+	if binary.Size != int64(len(content)) {
+		t.Errorf("expected the size %d, got %d", len(content), binary.Size)
+	}
+}
@@ -0,0 +1,73 @@
+package fhirtest
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (r response) write(w http.ResponseWriter) {
+	for key, values := range r.header {
+		w.Header()[key] = values
+	}
+	if len(r.body) != 0 && w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body)
+}
+
+// statusLine returns the status as it is written into Bundle.entry.response.status.
+func (r response) statusLine() string {
+	return strconv.Itoa(r.status) + " " + http.StatusText(r.status)
+}
+
+func resourceResponse(status int, v version) response {
+	return response{
+		status: status,
+		header: http.Header{
+			"Etag":          {etag(v.vid)},
+			"Last-Modified": {v.updated.Format(http.TimeFormat)},
+		},
+		body: v.data,
+	}
+}
+
+func etag(vid string) string {
+	return `W/"` + vid + `"`
+}
+
+func location(base string, resource string, v version) string {
+	return base + resource + "/" + v.id + "/_history/" + v.vid
+}
+
+func outcomeResponse(status int, severity models.IssueSeverity, code models.IssueType, diagnostics string) response {
+	return response{status: status, body: encodeObject(outcome(severity, code, diagnostics))}
+}
+
+func errorResponse(status int, code models.IssueType, format string, args ...interface{}) response {
+	return outcomeResponse(status, models.IssueSeverityError, code, fmt.Sprintf(format, args...))
+}
+
+func outcome(severity models.IssueSeverity, code models.IssueType, diagnostics string) models.OperationOutcome {
+	return models.OperationOutcome{
+		Text: &models.Narrative{
+			Status: models.NarrativeStatusGenerated,
+			Div:    `<div xmlns="http://www.w3.org/1999/xhtml">` + html.EscapeString(diagnostics) + `</div>`,
+		},
+		Issue: []models.OperationOutcomeIssue{{
+			Severity:    severity,
+			Code:        code,
+			Diagnostics: &diagnostics,
+		}},
+	}
+}
@@ -0,0 +1,665 @@
+package fhirtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+// resultParameters are the search parameters which don't filter resources.
+var resultParameters = map[string]bool{
+	"_count":      true,
+	"_offset":     true,
+	"_sort":       true,
+	"_format":     true,
+	"_pretty":     true,
+	"_summary":    true,
+	"_elements":   true,
+	"_total":      true,
+	"_include":    true,
+	"_revinclude": true,
+	"_contained":  true,
+}
+
+// aliases maps search parameters to element paths whose names differ from the parameter names.
+var aliases = map[string][]string{
+	"family":             {"name.family"},
+	"given":              {"name.given"},
+	"patient":            {"subject", "patient"},
+	"email":              {"telecom"},
+	"phone":              {"telecom"},
+	"address-city":       {"address.city"},
+	"address-country":    {"address.country"},
+	"address-postalcode": {"address.postalCode"},
+	"address-state":      {"address.state"},
+	"location":           {"location.location", "location"},
+	"date":               {"date", "effectiveDateTime", "effectivePeriod", "period", "authoredOn", "recordedDate"},
+}
+
+// paramKind is how the values of a search parameter are matched.
+type paramKind int
+
+const (
+	// tokenParam values are matched exactly.
+	tokenParam paramKind = iota
+	// stringParam values are matched by a case-insensitive prefix.
+	stringParam
+	// dateParam values are compared with the precision of the shortest value, with the optional prefix.
+	dateParam
+)
+
+// paramKinds are the kinds of the search parameters, other parameters are tokens.
+var paramKinds = map[string]paramKind{
+	"name":               stringParam,
+	"family":             stringParam,
+	"given":              stringParam,
+	"address":            stringParam,
+	"address-city":       stringParam,
+	"address-country":    stringParam,
+	"address-postalcode": stringParam,
+	"address-state":      stringParam,
+	"title":              stringParam,
+	"description":        stringParam,
+	"_lastUpdated":       dateParam,
+	"date":               dateParam,
+	"birthdate":          dateParam,
+	"death-date":         dateParam,
+	"issued":             dateParam,
+	"authored":           dateParam,
+	"recorded-date":      dateParam,
+	"onset-date":         dateParam,
+	"created":            dateParam,
+	"period":             dateParam,
+}
+
+var prefixPattern = regexp.MustCompile(`^(eq|ne|gt|lt|ge|le)(\d.*)$`)
+
+// match is the resource found by the search.
+type match struct {
+	resource fhir.ResourceType
+	version
+}
+
+func (s *Server) search(resource fhir.ResourceType, query url.Values) response {
+	found, resp, ok := s.find(resource, query)
+	if !ok {
+		return resp
+	}
+	matches := make([]match, 0, len(found))
+	for _, v := range found {
+		matches = append(matches, match{resource: resource, version: v})
+	}
+	return s.searchset(string(resource), query, matches)
+}
+
+// compartment searches the resources of the target type in the compartment, all member types when the target is "*".
+func (s *Server) compartment(compartment fhir.ResourceType, id, target string, query url.Values) response {
+	members := fhir.CompartmentMembers(compartment)
+	if target != "*" {
+		if err := fhir.CheckCompartmentMember(compartment, fhir.ResourceType(target)); err != nil {
+			return errorResponse(http.StatusBadRequest, models.IssueTypeNotSupported, "%s", err)
+		}
+		members = []fhir.ResourceType{fhir.ResourceType(target)}
+	}
+	if len(members) == 0 {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeNotSupported, "%s", fhir.CompartmentError{Compartment: compartment})
+	}
+
+	var matches []match
+	for _, member := range members {
+		found, resp, ok := s.find(member, query)
+		if !ok {
+			return resp
+		}
+		for _, v := range found {
+			if inCompartment(compartment, id, member, v) {
+				matches = append(matches, match{resource: member, version: v})
+			}
+		}
+	}
+	return s.searchset(string(compartment)+"/"+id+"/"+target, query, matches)
+}
+
+// everything returns the patient and the resources in its compartment. The start and end parameters are not applied.
+func (s *Server) everything(id string, query url.Values) response {
+	if resp := s.read(fhir.PatientResource, id); resp.status != http.StatusOK {
+		return resp
+	}
+
+	types := make(map[fhir.ResourceType]bool)
+	for _, value := range query["_type"] {
+		for _, t := range strings.Split(value, ",") {
+			types[fhir.ResourceType(t)] = true
+		}
+	}
+	var since time.Time
+	if value := query.Get("_since"); value != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, value); err != nil {
+			return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "invalid _since: %s", err)
+		}
+	}
+
+	var matches []match
+	for _, member := range fhir.CompartmentMembers(fhir.PatientResource) {
+		if len(types) > 0 && !types[member] {
+			continue
+		}
+		for _, v := range s.store.all(member) {
+			if v.updated.Before(since) {
+				continue
+			}
+			if member == fhir.PatientResource && v.id == id || inCompartment(fhir.PatientResource, id, member, v) {
+				matches = append(matches, match{resource: member, version: v})
+			}
+		}
+	}
+	return s.searchset("Patient/"+id+"/$everything", query, matches)
+}
+
+// inCompartment reports whether any of the compartment parameters of the resource refers to the compartment.
+func inCompartment(compartment fhir.ResourceType, id string, resource fhir.ResourceType, v version) bool {
+	obj, err := decodeObject(v.data)
+	if err != nil {
+		return false
+	}
+	for _, param := range fhir.CompartmentParams(compartment, resource) {
+		if param == "{def}" {
+			if resource == compartment && v.id == id {
+				return true
+			}
+			continue
+		}
+		if matchParams(obj, url.Values{param + ":" + string(compartment): {id}}) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchset returns the page of the found resources.
+func (s *Server) searchset(path string, query url.Values, found []match) response {
+	offset, err := intParam(query, "_offset", 0)
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "%s", err)
+	}
+	count, err := intParam(query, "_count", len(found))
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "%s", err)
+	}
+
+	bundle := models.Bundle{
+		Type:  models.BundleTypeSearchset,
+		Total: models.NewInt(len(found)),
+		Link:  []models.BundleLink{{Relation: "self", URL: s.base() + path + "?" + query.Encode()}},
+	}
+	if query.Get("_total") == "none" {
+		bundle.Total = nil
+	}
+	// _summary=count returns only the total.
+	if query.Get("_summary") == "count" {
+		bundle.Total = models.NewInt(len(found))
+		return response{status: http.StatusOK, body: encodeObject(bundle)}
+	}
+	if offset+count < len(found) && count > 0 {
+		next := cloneValues(query)
+		next.Set("_offset", strconv.Itoa(offset+count))
+		next.Set("_count", strconv.Itoa(count))
+		bundle.Link = append(bundle.Link, models.BundleLink{Relation: "next", URL: s.base() + path + "?" + next.Encode()})
+	}
+	if offset > 0 && count > 0 {
+		prev := cloneValues(query)
+		prev.Set("_offset", strconv.Itoa(max(offset-count, 0)))
+		prev.Set("_count", strconv.Itoa(count))
+		bundle.Link = append(bundle.Link, models.BundleLink{Relation: "previous", URL: s.base() + path + "?" + prev.Encode()})
+	}
+
+	for i := offset; i < len(found) && i < offset+count; i++ {
+		data, err := project(found[i].data, query)
+		if err != nil {
+			return errorResponse(http.StatusInternalServerError, models.IssueTypeException, "decoding stored resource: %s", err)
+		}
+		bundle.Entry = append(bundle.Entry, models.BundleEntry{
+			FullUrl:  models.NewString(s.base() + string(found[i].resource) + "/" + found[i].id),
+			Resource: data,
+			Search:   &models.BundleEntrySearch{Mode: searchEntryModeMatch()},
+		})
+	}
+	return response{status: http.StatusOK, body: encodeObject(bundle)}
+}
+
+func searchEntryModeMatch() *models.SearchEntryMode {
+	mode := models.SearchEntryModeMatch
+	return &mode
+}
+
+// searchForm returns the parameters of the search sent with POST: the form body joined with the URL query.
+func searchForm(query url.Values, header http.Header, body []byte) (url.Values, response, bool) {
+	if contentType := header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return nil, errorResponse(http.StatusUnsupportedMediaType, models.IssueTypeNotSupported, "expected form body but got %q", contentType), false
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "parsing form: %s", err), false
+	}
+	params := cloneValues(query)
+	for name, values := range form {
+		params[name] = append(params[name], values...)
+	}
+	return params, response{}, true
+}
+
+// find returns the resources matching the search parameters. It returns the error response when the parameters are invalid.
+func (s *Server) find(resource fhir.ResourceType, params url.Values) ([]version, response, bool) {
+	plain := url.Values{}
+	chained := url.Values{}
+	for name, values := range params {
+		if strings.HasPrefix(name, "_has:") || strings.Contains(name, ".") {
+			chained[name] = values
+		} else {
+			plain[name] = values
+		}
+	}
+	conditions, resp, ok := s.resolveChains(chained)
+	if !ok {
+		return nil, resp, false
+	}
+
+	var found []version
+	for _, v := range s.store.all(resource) {
+		obj, err := decodeObject(v.data)
+		if err != nil {
+			return nil, errorResponse(http.StatusInternalServerError, models.IssueTypeException, "decoding stored resource: %s", err), false
+		}
+		if matchParams(obj, plain) && matchChains(resource, obj, conditions) {
+			found = append(found, v)
+		}
+	}
+	return found, response{}, true
+}
+
+// chainCondition is the chained or reverse chained (_has) parameter resolved to the references it matches.
+type chainCondition struct {
+	// param is the reference parameter of the chained parameter, it is empty for _has.
+	param string
+	refs  map[string]bool
+}
+
+// resolveChains searches the resources referenced by the chained parameters and referencing by _has.
+func (s *Server) resolveChains(params url.Values) ([]chainCondition, response, bool) {
+	var conditions []chainCondition
+	for name, values := range params {
+		// Repeated parameters are joined with AND.
+		for _, value := range values {
+			condition, resp, ok := s.resolveChain(name, value)
+			if !ok {
+				return nil, resp, false
+			}
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions, response{}, true
+}
+
+func (s *Server) resolveChain(name, value string) (chainCondition, response, bool) {
+	if strings.HasPrefix(name, "_has:") {
+		// _has:[type]:[reference]:[parameter]
+		parts := strings.SplitN(name, ":", 4)
+		if len(parts) != 4 {
+			return chainCondition{}, errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "invalid _has parameter %q", name), false
+		}
+		found, resp, ok := s.find(fhir.ResourceType(parts[1]), url.Values{parts[3]: {value}})
+		if !ok {
+			return chainCondition{}, resp, false
+		}
+		refs := make(map[string]bool)
+		for _, v := range found {
+			obj, err := decodeObject(v.data)
+			if err != nil {
+				return chainCondition{}, errorResponse(http.StatusInternalServerError, models.IssueTypeException, "decoding stored resource: %s", err), false
+			}
+			for _, ref := range references(obj, parts[2]) {
+				refs[ref] = true
+			}
+		}
+		return chainCondition{refs: refs}, response{}, true
+	}
+
+	// [reference]:[type].[parameter]
+	i := strings.Index(name, ".")
+	param, target := name[:i], ""
+	if j := strings.Index(param, ":"); j >= 0 {
+		param, target = param[:j], param[j+1:]
+	}
+	if target == "" {
+		return chainCondition{}, errorResponse(http.StatusBadRequest, models.IssueTypeNotSupported, "chained parameter %q without the type modifier is not supported", name), false
+	}
+	found, resp, ok := s.find(fhir.ResourceType(target), url.Values{name[i+1:]: {value}})
+	if !ok {
+		return chainCondition{}, resp, false
+	}
+	refs := make(map[string]bool, len(found))
+	for _, v := range found {
+		refs[target+"/"+v.id] = true
+	}
+	return chainCondition{param: param, refs: refs}, response{}, true
+}
+
+func matchChains(resource fhir.ResourceType, obj map[string]interface{}, conditions []chainCondition) bool {
+	for _, condition := range conditions {
+		matched := false
+		if condition.param == "" {
+			matched = condition.refs[string(resource)+"/"+stringValue(obj["id"])]
+		} else {
+			for _, ref := range references(obj, condition.param) {
+				if condition.refs[ref] {
+					matched = true
+					break
+				}
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// references returns the references of the reference parameter as [type]/[id].
+func references(obj map[string]interface{}, param string) []string {
+	var refs []string
+	for _, node := range collect(obj, elementPaths(obj, param)) {
+		if n, ok := node.(map[string]interface{}); ok {
+			if ref, ok := n["reference"].(string); ok {
+				parts := strings.Split(ref, "/")
+				if len(parts) > 2 {
+					ref = strings.Join(parts[len(parts)-2:], "/")
+				}
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+func matchParams(obj map[string]interface{}, params url.Values) bool {
+	for key, values := range params {
+		name, modifier := key, ""
+		if i := strings.Index(key, ":"); i >= 0 {
+			name, modifier = key[:i], key[i+1:]
+		}
+		if resultParameters[name] {
+			continue
+		}
+
+		var nodes []interface{}
+		switch name {
+		case "_id":
+			nodes = []interface{}{obj["id"]}
+		case "_lastUpdated":
+			if meta, ok := obj["meta"].(map[string]interface{}); ok {
+				nodes = []interface{}{meta["lastUpdated"]}
+			}
+		default:
+			nodes = collect(obj, elementPaths(obj, name))
+		}
+
+		// Repeated parameters are joined with AND, comma separated values with OR.
+		for _, value := range values {
+			if !matchAny(nodes, strings.Split(value, ","), modifier, paramKinds[name]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchAny reports whether any of the values matches any of the nodes, with the "not" modifier whether none matches.
+func matchAny(nodes []interface{}, values []string, modifier string, kind paramKind) bool {
+	if modifier == "missing" {
+		return (len(nodes) == 0) == (values[0] == "true")
+	}
+	matched := false
+	for _, value := range values {
+		for _, node := range nodes {
+			if matchNode(node, value, modifier, kind) {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			break
+		}
+	}
+	if modifier == "not" {
+		return !matched
+	}
+	return matched
+}
+
+// elementPaths returns element paths for the search parameter.
+func elementPaths(obj map[string]interface{}, name string) []string {
+	if paths, ok := aliases[name]; ok {
+		return paths
+	}
+	normalized := strings.ReplaceAll(name, "-", "")
+	for key := range obj {
+		if strings.EqualFold(key, normalized) {
+			return []string{key}
+		}
+	}
+	return nil
+}
+
+// collect returns all values at the paths, arrays are flattened.
+func collect(obj map[string]interface{}, paths []string) []interface{} {
+	var result []interface{}
+	for _, path := range paths {
+		result = append(result, collectPath(obj, strings.Split(path, "."))...)
+	}
+	return result
+}
+
+func collectPath(node interface{}, path []string) []interface{} {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var result []interface{}
+		for _, item := range n {
+			result = append(result, collectPath(item, path)...)
+		}
+		return result
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return []interface{}{n}
+		}
+		return collectPath(n[path[0]], path[1:])
+	default:
+		if len(path) == 0 {
+			return []interface{}{n}
+		}
+		return nil
+	}
+}
+
+func matchNode(node interface{}, value, modifier string, kind paramKind) bool {
+	switch n := node.(type) {
+	case []interface{}:
+		for _, item := range n {
+			if matchNode(item, value, modifier, kind) {
+				return true
+			}
+		}
+	case string:
+		return matchString(n, value, modifier, kind)
+	case json.Number:
+		return matchNumber(n.String(), value)
+	case bool:
+		return strconv.FormatBool(n) == value
+	case map[string]interface{}:
+		return matchComplex(n, value, modifier, kind)
+	}
+	return false
+}
+
+func matchComplex(n map[string]interface{}, value, modifier string, kind paramKind) bool {
+	// Reference
+	if ref, ok := n["reference"].(string); ok {
+		if modifier != "" && modifier != "not" && !strings.HasPrefix(ref, modifier+"/") {
+			return false
+		}
+		return ref == value || strings.HasSuffix(ref, "/"+value)
+	}
+	// CodeableConcept
+	if coding, ok := n["coding"]; ok {
+		if matchNode(coding, value, modifier, kind) {
+			return true
+		}
+		return modifier == "text" && matchString(stringValue(n["text"]), value, "", stringParam)
+	}
+	// Coding
+	if code, ok := n["code"].(string); ok && !isNumber(n["value"]) {
+		return matchToken(stringValue(n["system"]), code, value)
+	}
+	// Identifier, ContactPoint
+	if v, ok := n["value"].(string); ok {
+		return matchToken(stringValue(n["system"]), v, value)
+	}
+	// Quantity
+	if v, ok := n["value"].(json.Number); ok {
+		return matchNumber(v.String(), strings.SplitN(value, "|", 2)[0])
+	}
+	// Period
+	if start, end := stringValue(n["start"]), stringValue(n["end"]); start != "" || end != "" {
+		return start != "" && matchString(start, value, modifier, dateParam) || end != "" && matchString(end, value, modifier, dateParam)
+	}
+	// HumanName, Address and other elements are matched by any string value.
+	for _, item := range n {
+		if s, ok := item.(string); ok && matchString(s, value, modifier, stringParam) {
+			return true
+		}
+		if items, ok := item.([]interface{}); ok && matchNode(items, value, modifier, stringParam) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchToken(system, code, value string) bool {
+	i := strings.Index(value, "|")
+	if i < 0 {
+		return code == value
+	}
+	if value[:i] != system {
+		return false
+	}
+	return value[i+1:] == "" || value[i+1:] == code
+}
+
+func matchString(s, value, modifier string, kind paramKind) bool {
+	switch {
+	case kind == dateParam:
+		prefix := "eq"
+		if m := prefixPattern.FindStringSubmatch(value); m != nil {
+			prefix, value = m[1], m[2]
+		}
+		return compare(prefix, compareStrings(s, value))
+	case modifier == "exact":
+		return s == value
+	case modifier == "contains":
+		return strings.Contains(strings.ToLower(s), strings.ToLower(value))
+	case kind == stringParam:
+		return strings.HasPrefix(strings.ToLower(s), strings.ToLower(value))
+	default:
+		return s == value
+	}
+}
+
+// compareStrings compares the values with the precision of the shortest one.
+func compareStrings(a, b string) int {
+	if len(a) > len(b) {
+		a = a[:len(b)]
+	} else {
+		b = b[:len(a)]
+	}
+	return strings.Compare(a, b)
+}
+
+func matchNumber(n, value string) bool {
+	prefix := "eq"
+	if m := prefixPattern.FindStringSubmatch(value); m != nil {
+		prefix, value = m[1], m[2]
+	}
+	a, err := strconv.ParseFloat(n, 64)
+	if err != nil {
+		return false
+	}
+	b, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	switch {
+	case a < b:
+		return compare(prefix, -1)
+	case a > b:
+		return compare(prefix, 1)
+	}
+	return compare(prefix, 0)
+}
+
+func compare(prefix string, result int) bool {
+	switch prefix {
+	case "ne":
+		return result != 0
+	case "gt":
+		return result > 0
+	case "lt":
+		return result < 0
+	case "ge":
+		return result >= 0
+	case "le":
+		return result <= 0
+	}
+	return result == 0
+}
+
+func isNumber(v interface{}) bool {
+	_, ok := v.(json.Number)
+	return ok
+}
+
+func intParam(query url.Values, name string, def int) (int, error) {
+	s := query.Get(name)
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s value %q", name, s)
+	}
+	return n, nil
+}
+
+func cloneValues(values url.Values) url.Values {
+	result := make(url.Values, len(values))
+	for key, v := range values {
+		result[key] = append([]string(nil), v...)
+	}
+	return result
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
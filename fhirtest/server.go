@@ -0,0 +1,371 @@
+// Package fhirtest provides an in-memory FHIR server for testing code that
+// uses the FHIR client.
+package fhirtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+const contentType = "application/fhir+json; charset=utf-8"
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Body   []byte
+}
+
+// Server is an in-memory FHIR server backed by httptest.Server.
+//
+// It supports read, vread, create, update, patch, delete, history, basic search
+// and transaction/batch bundles. The CapabilityStatement is returned from /metadata. Binary is also read and written in its native content type.
+// Errors are reported with an OperationOutcome.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	store    *store
+	requests []Request
+	now      func() time.Time
+	// jsonBinary disables the native content types of Binary.
+	jsonBinary bool
+	jobs       map[string]*job
+	lastJob    int
+	asyncPolls int
+	// capabilities is returned from /metadata, the default statement is used when it is nil.
+	capabilities *models.CapabilityStatement
+}
+
+// Option allows setting custom parameters during construction.
+type Option func(*Server)
+
+// WithClock sets the clock used for Meta.lastUpdated.
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// WithJSONBinary makes the server support only the JSON representation of Binary,
+// native content is rejected with 415 Unsupported Media Type.
+func WithJSONBinary() Option {
+	return func(s *Server) {
+		s.jsonBinary = true
+	}
+}
+
+// WithAsyncPolls sets how many times the status of the asynchronous request is reported as in progress, 1 by default.
+func WithAsyncPolls(n int) Option {
+	return func(s *Server) {
+		s.asyncPolls = n
+	}
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		store:      newStore(),
+		now:        time.Now,
+		jobs:       make(map[string]*job),
+		asyncPolls: 1,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Client returns a FHIR client configured for the server.
+func (s *Server) Client(opts ...fhir.ClientOption) (*fhir.Client, error) {
+	return fhir.New(s.URL, append([]fhir.ClientOption{fhir.WithHTTPClient(s.Server.Client())}, opts...)...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "reading body: %s", err).write(w)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := *r.URL
+	s.requests = append(s.requests, Request{Method: r.Method, URL: &u, Header: r.Header.Clone(), Body: body})
+	resp := s.handle(r.Method, r.URL.Path, r.URL.Query(), r.Header, body)
+	if strings.Contains(r.Header.Get("Prefer"), "respond-async") {
+		resp = s.startJob(resp)
+	}
+	resp.write(w)
+}
+
+// Seed stores the resources keeping their IDs. A resource may be a model, ResourceData, json.RawMessage or []byte.
+// Transaction and batch bundles are executed, collection and searchset bundles are unpacked.
+func (s *Server) Seed(resources ...interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, resource := range resources {
+		var data []byte
+		switch r := resource.(type) {
+		case fhir.ResourceData:
+			data = r
+		case json.RawMessage:
+			data = r
+		case []byte:
+			data = r
+		default:
+			var err error
+			if data, err = json.Marshal(resource); err != nil {
+				return err
+			}
+		}
+		if err := s.seed(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SeedFiles seeds the server with the JSON files matching the patterns.
+func (s *Server) SeedFiles(patterns ...string) error {
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			return fmt.Errorf("no fixtures match %q", pattern)
+		}
+		for _, path := range paths {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if err := s.Seed(data); err != nil {
+				return fmt.Errorf("seeding %s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) seed(data []byte) error {
+	obj, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	resource := fhir.ResourceType(stringValue(obj["resourceType"]))
+	if fhir.TypeOf(resource) == nil {
+		return fmt.Errorf("unknown resource type %q", resource)
+	}
+
+	if resource == fhir.BundleResource {
+		switch stringValue(obj["type"]) {
+		case "transaction", "batch":
+			resp := s.transaction(obj)
+			if resp.status >= 400 {
+				return fmt.Errorf("seeding transaction: %s", resp.body)
+			}
+			return nil
+		case "collection", "searchset":
+			entries, _ := obj["entry"].([]interface{})
+			for _, entry := range entries {
+				entry, _ := entry.(map[string]interface{})
+				if data, ok := entry["resource"]; ok {
+					b, err := json.Marshal(data)
+					if err != nil {
+						return err
+					}
+					if err := s.seed(b); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+	}
+
+	id := stringValue(obj["id"])
+	if id == "" {
+		id = s.store.newID()
+	}
+	s.store.put(resource, id, obj, http.MethodPost, s.now())
+	return nil
+}
+
+// Requests returns the requests received by the server.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// Resource returns the current version of the resource.
+func (s *Server) Resource(resource fhir.ResourceType, id string) (fhir.ResourceData, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.store.current(resource, id)
+	if !ok || v.deleted {
+		return nil, false
+	}
+	return v.data, true
+}
+
+// Resources returns the current versions of all not deleted resources of the type.
+func (s *Server) Resources(resource fhir.ResourceType) []fhir.ResourceData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []fhir.ResourceData
+	for _, v := range s.store.all(resource) {
+		result = append(result, v.data)
+	}
+	return result
+}
+
+// Versions returns all stored versions of the resource, oldest first. Deleted versions are skipped.
+func (s *Server) Versions(resource fhir.ResourceType, id string) []fhir.ResourceData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []fhir.ResourceData
+	for _, v := range s.store.versions(resource, id) {
+		if !v.deleted {
+			result = append(result, v.data)
+		}
+	}
+	return result
+}
+
+// Reset removes all resources and recorded requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store = newStore()
+	s.requests = nil
+	s.jobs = make(map[string]*job)
+}
+
+func (s *Server) handle(method, path string, query url.Values, header http.Header, body []byte) response {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		if method != http.MethodPost {
+			return errorResponse(http.StatusMethodNotAllowed, models.IssueTypeNotSupported, "method %s is not supported at the base", method)
+		}
+		obj, err := decodeObject(body)
+		if err != nil {
+			return errorResponse(http.StatusBadRequest, models.IssueTypeStructure, "parsing body: %s", err)
+		}
+		if stringValue(obj["resourceType"]) != "Bundle" {
+			return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "expected Bundle at the base")
+		}
+		return s.transaction(obj)
+	}
+
+	if path == "metadata" && method == http.MethodGet {
+		return s.capabilityStatement()
+	}
+
+	parts := strings.Split(path, "/")
+	if parts[0] == asyncPath {
+		return s.jobStatus(method, parts)
+	}
+	resource := fhir.ResourceType(parts[0])
+	if fhir.TypeOf(resource) == nil {
+		return errorResponse(http.StatusNotFound, models.IssueTypeNotSupported, "unknown resource type %q", resource)
+	}
+
+	if resource == fhir.BinaryResource {
+		var resp response
+		var done bool
+		if resp, body, done = s.binary(method, parts, header, body); done {
+			return resp
+		}
+	}
+
+	switch len(parts) {
+	case 1:
+		switch method {
+		case http.MethodGet:
+			return s.search(resource, query)
+		case http.MethodPost:
+			return s.create(resource, header, body)
+		case http.MethodPut:
+			return s.conditional(resource, query, func(id string) response { return s.conditionalUpdate(resource, id, header, body) })
+		case http.MethodPatch:
+			return s.conditional(resource, query, func(id string) response { return s.patch(resource, id, header, body) })
+		case http.MethodDelete:
+			return s.conditionalDelete(resource, query)
+		}
+	case 2:
+		if parts[1] == "_search" {
+			if method != http.MethodPost {
+				break
+			}
+			params, resp, ok := searchForm(query, header, body)
+			if !ok {
+				return resp
+			}
+			return s.search(resource, params)
+		}
+		if parts[1] == "_history" {
+			if method == http.MethodGet {
+				return s.history(resource, "")
+			}
+			break
+		}
+		switch method {
+		case http.MethodGet:
+			return s.read(resource, parts[1])
+		case http.MethodPut:
+			return s.update(resource, parts[1], header, body)
+		case http.MethodPatch:
+			return s.patch(resource, parts[1], header, body)
+		case http.MethodDelete:
+			return s.delete(resource, parts[1])
+		}
+	case 3:
+		if method != http.MethodGet {
+			break
+		}
+		if parts[2] == "_history" {
+			return s.history(resource, parts[1])
+		}
+		if parts[2] == "$everything" && resource == fhir.PatientResource {
+			return s.everything(parts[1], query)
+		}
+		return s.compartment(resource, parts[1], parts[2], query)
+	case 4:
+		if parts[2] == "_history" && method == http.MethodGet {
+			return s.vread(resource, parts[1], parts[3])
+		}
+		if parts[3] == "_search" && method == http.MethodPost {
+			params, resp, ok := searchForm(query, header, body)
+			if !ok {
+				return resp
+			}
+			return s.compartment(resource, parts[1], parts[2], params)
+		}
+	}
+
+	return errorResponse(http.StatusMethodNotAllowed, models.IssueTypeNotSupported, "%s %s is not supported", method, path)
+}
@@ -0,0 +1,275 @@
+package fhirtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func newPatient(family string, given ...string) *models.Patient {
+	return &models.Patient{
+		Name: []models.HumanName{{Family: models.NewString(family), Given: models.NewStrings(given...)}},
+	}
+}
+
+func TestServerCRUD(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	patient, err := client.CreatePatient(ctx, nil, newPatient("Jonson", "John"))
+	if err != nil {
+		t.Fatalf("CreatePatient() error = %v", err)
+	}
+	id := models.ToString(patient.ID)
+	if id == "" || patient.Meta == nil || models.ToString(patient.Meta.VersionId) != "1" {
+		t.Fatalf("CreatePatient() returned unexpected identity: %s", mustJSON(patient))
+	}
+
+	patient.Gender = genderPtr(models.AdministrativeGenderMale)
+	if patient, err = client.UpdatePatientByID(ctx, id, nil, patient); err != nil {
+		t.Fatalf("UpdatePatientByID() error = %v", err)
+	}
+	if v := models.ToString(patient.Meta.VersionId); v != "2" {
+		t.Errorf("expected version 2, got %s", v)
+	}
+
+	if _, err := client.PatchPatientByID(ctx, id, nil, &models.Patient{ID: &id, Active: models.NewBool(true)}); err != nil {
+		t.Fatalf("PatchPatientByID() error = %v", err)
+	}
+	if patient, err = client.GetPatientByID(ctx, id, nil); err != nil {
+		t.Fatalf("GetPatientByID() error = %v", err)
+	}
+	if !models.ToBool(patient.Active) || models.ToString(patient.Name[0].Family) != "Jonson" {
+		t.Errorf("patch is not merged: %s", mustJSON(patient))
+	}
+
+	if _, err := client.DeleteByID(ctx, fhir.PatientResource, id, nil); err != nil {
+		t.Fatalf("DeleteByID() error = %v", err)
+	}
+	if _, ok := srv.Resource(fhir.PatientResource, id); ok {
+		t.Error("deleted resource is still stored")
+	}
+	if _, err := client.GetPatientByID(ctx, id, nil); !fhir.IsGone(err) {
+		t.Errorf("expected GoneError, got %v", err)
+	}
+	if _, err := client.GetPatientByID(ctx, "unknown", nil); !fhir.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, got %v", err)
+	}
+
+	if n := len(srv.Versions(fhir.PatientResource, id)); n != 3 {
+		t.Errorf("expected 3 stored versions, got %d", n)
+	}
+	bundle, err := fhir.ExpectedBundle(client.Request(ctx, http.MethodGet, fhir.Path("Patient", id, "_history"), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bundle.Entry) != 4 || bundle.Entry[0].Request.Method != models.HTTPVerbDELETE {
+		t.Errorf("unexpected history: %s", mustJSON(bundle))
+	}
+}
+
+func TestServerSearch(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	err := srv.Seed(
+		&models.Patient{ID: models.NewString("a"), Name: []models.HumanName{{Family: models.NewString("Smith")}}, Gender: genderPtr(models.AdministrativeGenderFemale)},
+		&models.Patient{ID: models.NewString("b"), Name: []models.HumanName{{Family: models.NewString("Smithson")}}, Gender: genderPtr(models.AdministrativeGenderMale),
+			Address: []models.Address{{PostalCode: models.NewString("1980")}}},
+		&models.Patient{ID: models.NewString("c"), Name: []models.HumanName{{Family: models.NewString("Doe")}}, BirthDate: models.NewDate(1980, 2, 3)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "family=smi", want: []string{"a", "b"}},
+		{query: "family:exact=Smith", want: []string{"a"}},
+		{query: "gender=male,female", want: []string{"a", "b"}},
+		{query: "gender=male&family=smi", want: []string{"b"}},
+		{query: "_id=a,c", want: []string{"a", "c"}},
+		{query: "birthdate=ge1980-01", want: []string{"c"}},
+		{query: "birthdate=lt1980", want: nil},
+		{query: "gender:missing=true", want: []string{"c"}},
+		{query: "gender:not=male,female", want: []string{"c"}},
+		{query: "address-postalcode=1980", want: []string{"b"}},
+		{query: "address-postalcode=1980-02", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			params, _ := url.ParseQuery(tt.query)
+			patients, err := client.GetPatient(context.Background(), params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range patients {
+				got = append(got, models.ToString(p.ID))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Typed search builders.
+	patients, err := client.GetPatient(context.Background(), fhir.PatientSearch().Family().Eq("smi").Gender().Eq("male"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patients) != 1 || models.ToString(patients[0].ID) != "b" {
+		t.Errorf("unexpected patients: %s", mustJSON(patients))
+	}
+
+	bundle, err := fhir.ExpectedBundle(client.Get(context.Background(), fhir.PatientResource, url.Values{"_count": {"2"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if models.ToInt(bundle.Total) != 3 || len(bundle.Entry) != 2 || len(bundle.Link) != 2 || bundle.Link[1].Relation != "next" {
+		t.Errorf("unexpected page: %s", mustJSON(bundle))
+	}
+
+}
+
+func TestServerTransaction(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transaction := []byte(`{
+		"resourceType": "Bundle",
+		"type": "transaction",
+		"entry": [
+			{
+				"fullUrl": "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a",
+				"resource": {"resourceType": "Patient", "name": [{"family": "Doe"}]},
+				"request": {"method": "POST", "url": "Patient"}
+			},
+			{
+				"resource": {"resourceType": "Observation", "status": "final", "code": {"text": "x"}, "subject": {"reference": "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a"}},
+				"request": {"method": "POST", "url": "Observation"}
+			}
+		]
+	}`)
+	bundle, err := fhir.ExpectedBundle(client.RequestWithBodyReader(context.Background(), http.MethodPost, "", nil, strings.NewReader(string(transaction))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bundle.Entry) != 2 || bundle.Entry[0].Response.Status != "201 Created" {
+		t.Fatalf("unexpected response: %s", mustJSON(bundle))
+	}
+
+	observations := srv.Resources(fhir.ObservationResource)
+	if len(observations) != 1 {
+		t.Fatalf("expected 1 observation, got %d", len(observations))
+	}
+	var observation models.Observation
+	if err := observations[0].UnmarshalTo(&observation); err != nil {
+		t.Fatal(err)
+	}
+	patients := srv.Resources(fhir.PatientResource)
+	var patient models.Patient
+	if err := patients[0].UnmarshalTo(&patient); err != nil {
+		t.Fatal(err)
+	}
+	if ref := models.ToString(observation.Subject.Reference); ref != "Patient/"+models.ToString(patient.ID) {
+		t.Errorf("reference is not resolved: %s", ref)
+	}
+
+	// A failed transaction is rolled back.
+	failed := []byte(`{
+		"resourceType": "Bundle",
+		"type": "transaction",
+		"entry": [
+			{"resource": {"resourceType": "Patient"}, "request": {"method": "POST", "url": "Patient"}},
+			{"request": {"method": "GET", "url": "Patient/unknown"}}
+		]
+	}`)
+	_, err = client.RequestWithBodyReader(context.Background(), http.MethodPost, "", nil, strings.NewReader(string(failed)))
+	if e, ok := fhir.AsFhirError(err); !ok || e.Status != http.StatusNotFound {
+		t.Errorf("expected 404 FhirError, got %v", err)
+	}
+	if n := len(srv.Resources(fhir.PatientResource)); n != 1 {
+		t.Errorf("expected the transaction to be rolled back, got %d patients", n)
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("expected 2 recorded requests, got %d", n)
+	}
+}
+
+func TestServerChain(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	code := models.CodeableConcept{Coding: []models.Coding{{System: models.NewString("http://loinc.org"), Code: models.NewString("1234-5")}}}
+	err := srv.Seed(
+		&models.Patient{ID: models.NewString("p1"), Name: []models.HumanName{{Given: models.NewStrings("Peter")}}},
+		&models.Patient{ID: models.NewString("p2"), Name: []models.HumanName{{Given: models.NewStrings("Mary")}}},
+		&models.Observation{ID: models.NewString("o1"), Status: models.ObservationStatusFinal, Code: code, Subject: &models.Reference{Reference: models.NewString("Patient/p1")}},
+		&models.Encounter{ID: models.NewString("e1"), Status: models.EncounterStatusFinished, Subject: &models.Reference{Reference: models.NewString("Patient/p1")},
+			Location: []models.EncounterLocation{{Location: models.Reference{Reference: models.NewString("Location/y")}}}},
+		&models.Encounter{ID: models.NewString("e2"), Status: models.EncounterStatusFinished, Subject: &models.Reference{Reference: models.NewString("Patient/p2")},
+			Location: []models.EncounterLocation{{Location: models.Reference{Reference: models.NewString("Location/z")}}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	observations, err := client.GetObservation(ctx, fhir.ObservationSearch().Subject().Where(fhir.PatientSearch().Name().Eq("peter")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(observations) != 1 || models.ToString(observations[0].ID) != "o1" {
+		t.Errorf("unexpected observations: %s", mustJSON(observations))
+	}
+
+	for _, query := range []*fhir.PatientQuery{
+		fhir.PatientSearch().Has(fhir.EncounterSearch().Location().Eq("Location/y"), "patient"),
+		fhir.PatientSearch().Has(fhir.ObservationSearch().Code().Code("http://loinc.org", "1234-5"), "subject"),
+	} {
+		patients, err := client.GetPatient(ctx, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(patients) != 1 || models.ToString(patients[0].ID) != "p1" {
+			t.Errorf("unexpected patients for %s: %s", query.Encode(), mustJSON(patients))
+		}
+	}
+
+	if _, err := client.GetObservation(ctx, url.Values{"subject.name": {"peter"}}); err == nil {
+		t.Error("chain without the type modifier is accepted")
+	}
+}
+
+func genderPtr(g models.AdministrativeGender) *models.AdministrativeGender {
+	return &g
+}
+
+func mustJSON(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,140 @@
+package fhirtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	fhir "github.com/gotidy/fhir-client"
+)
+
+type version struct {
+	id      string
+	vid     string
+	data    []byte
+	deleted bool
+	method  string
+	updated time.Time
+}
+
+type store struct {
+	resources map[fhir.ResourceType]map[string][]version
+	// order keeps IDs in the order of creation to make searches deterministic.
+	order  map[fhir.ResourceType][]string
+	lastID int
+}
+
+func newStore() *store {
+	return &store{
+		resources: make(map[fhir.ResourceType]map[string][]version),
+		order:     make(map[fhir.ResourceType][]string),
+	}
+}
+
+func (s *store) newID() string {
+	s.lastID++
+	return strconv.Itoa(s.lastID)
+}
+
+// put stores the new version of the resource and returns it.
+func (s *store) put(resource fhir.ResourceType, id string, obj map[string]interface{}, method string, now time.Time) version {
+	ids, ok := s.resources[resource]
+	if !ok {
+		ids = make(map[string][]version)
+		s.resources[resource] = ids
+	}
+	if _, ok := ids[id]; !ok {
+		s.order[resource] = append(s.order[resource], id)
+	}
+
+	v := version{id: id, vid: strconv.Itoa(len(ids[id]) + 1), method: method, updated: now.UTC()}
+	if obj == nil {
+		v.deleted = true
+	} else {
+		meta, _ := obj["meta"].(map[string]interface{})
+		if meta == nil {
+			meta = make(map[string]interface{})
+		}
+		meta["versionId"] = v.vid
+		meta["lastUpdated"] = v.updated.Format(time.RFC3339Nano)
+		obj["meta"] = meta
+		obj["id"] = id
+		obj["resourceType"] = string(resource)
+		v.data = encodeObject(obj)
+	}
+	ids[id] = append(ids[id], v)
+	return v
+}
+
+func (s *store) versions(resource fhir.ResourceType, id string) []version {
+	return s.resources[resource][id]
+}
+
+func (s *store) current(resource fhir.ResourceType, id string) (version, bool) {
+	versions := s.versions(resource, id)
+	if len(versions) == 0 {
+		return version{}, false
+	}
+	return versions[len(versions)-1], true
+}
+
+// all returns current not deleted versions of the resources in the order of creation.
+func (s *store) all(resource fhir.ResourceType) []version {
+	var result []version
+	for _, id := range s.order[resource] {
+		if v, ok := s.current(resource, id); ok && !v.deleted {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// snapshot returns a copy of the store. Versions are immutable, so slices are shared.
+func (s *store) snapshot() *store {
+	c := newStore()
+	c.lastID = s.lastID
+	for resource, ids := range s.resources {
+		m := make(map[string][]version, len(ids))
+		for id, versions := range ids {
+			m[id] = versions[:len(versions):len(versions)]
+		}
+		c.resources[resource] = m
+	}
+	for resource, order := range s.order {
+		c.order[resource] = order[:len(order):len(order)]
+	}
+	return c
+}
+
+// sortVersions sorts the versions by the time of update.
+func sortVersions(versions []version) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].updated.Before(versions[j].updated)
+	})
+}
+
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	var obj map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func encodeObject(obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		// Objects are decoded from JSON, so they are always encodable.
+		panic(err)
+	}
+	return data
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
@@ -0,0 +1,182 @@
+package fhirtest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/models"
+)
+
+// methodOrder is the order of processing the entries defined by the specification.
+var methodOrder = map[string]int{
+	http.MethodDelete: 0,
+	http.MethodPost:   1,
+	http.MethodPut:    2,
+	http.MethodPatch:  2,
+	http.MethodGet:    3,
+	http.MethodHead:   3,
+}
+
+type transactionEntry struct {
+	index    int
+	method   string
+	url      *url.URL
+	header   http.Header
+	fullURL  string
+	resource map[string]interface{}
+	body     []byte
+	id       string
+}
+
+// transaction processes the transaction or batch bundle. The transaction is rolled back when any entry fails.
+func (s *Server) transaction(bundle map[string]interface{}) response {
+	bundleType := stringValue(bundle["type"])
+	if bundleType != "transaction" && bundleType != "batch" {
+		return errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "expected transaction or batch Bundle but got %q", bundleType)
+	}
+	isTransaction := bundleType == "transaction"
+
+	rawEntries, _ := bundle["entry"].([]interface{})
+	entries := make([]*transactionEntry, 0, len(rawEntries))
+	responses := make([]response, len(rawEntries))
+	// References to the entries created in the transaction, fullUrl -> Type/id.
+	references := make(map[string]string)
+
+	for i, raw := range rawEntries {
+		entry, resp, ok := parseEntry(i, raw)
+		if !ok {
+			if isTransaction {
+				return resp
+			}
+			responses[i] = resp
+			continue
+		}
+		if isTransaction && entry.method == http.MethodPost && entry.resource != nil {
+			entry.id = s.store.newID()
+			if entry.fullURL != "" {
+				references[entry.fullURL] = stringValue(entry.resource["resourceType"]) + "/" + entry.id
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return methodOrder[entries[i].method] < methodOrder[entries[j].method]
+	})
+
+	snapshot := s.store.snapshot()
+	for _, entry := range entries {
+		body := entry.body
+		if entry.resource != nil {
+			resolveReferences(entry.resource, references)
+			body = encodeObject(entry.resource)
+		}
+
+		var resp response
+		if entry.method == http.MethodPost && entry.url.Path == stringValue(entry.resource["resourceType"]) {
+			resp = s.createWithID(fhir.ResourceType(entry.url.Path), entry.id, entry.header, body)
+		} else {
+			resp = s.handle(entry.method, entry.url.Path, entry.url.Query(), entry.header, body)
+		}
+		if isTransaction && resp.status >= 400 {
+			s.store = snapshot
+			return resp
+		}
+		responses[entry.index] = resp
+	}
+
+	result := models.Bundle{Type: models.BundleTypeTransactionResponse}
+	if !isTransaction {
+		result.Type = models.BundleTypeBatchResponse
+	}
+	for _, resp := range responses {
+		result.Entry = append(result.Entry, responseEntry(resp))
+	}
+	return response{status: http.StatusOK, body: encodeObject(result)}
+}
+
+// responseEntry returns the response as the entry of the batch-response or transaction-response Bundle.
+func responseEntry(resp response) models.BundleEntry {
+	entry := models.BundleEntry{Response: &models.BundleEntryResponse{Status: resp.statusLine()}}
+	if location := resp.header.Get("Location"); location != "" {
+		entry.Response.Location = &location
+	}
+	if etag := resp.header.Get("Etag"); etag != "" {
+		entry.Response.Etag = &etag
+	}
+	if resp.status >= 400 {
+		entry.Response.Outcome = resp.body
+	} else if len(resp.body) != 0 {
+		entry.Resource = resp.body
+	}
+	return entry
+}
+
+func parseEntry(index int, raw interface{}) (*transactionEntry, response, bool) {
+	entry, _ := raw.(map[string]interface{})
+	request, _ := entry["request"].(map[string]interface{})
+	if request == nil {
+		return nil, errorResponse(http.StatusBadRequest, models.IssueTypeRequired, "entry %d has no request", index), false
+	}
+	u, err := url.Parse(stringValue(request["url"]))
+	if err != nil {
+		return nil, errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "entry %d has invalid url: %s", index, err), false
+	}
+
+	header := make(http.Header)
+	for field, name := range map[string]string{
+		"ifMatch":         "If-Match",
+		"ifNoneMatch":     "If-None-Match",
+		"ifNoneExist":     "If-None-Exist",
+		"ifModifiedSince": "If-Modified-Since",
+	} {
+		if v := stringValue(request[field]); v != "" {
+			header.Set(name, v)
+		}
+	}
+
+	resource, _ := entry["resource"].(map[string]interface{})
+	method := strings.ToUpper(stringValue(request["method"]))
+	if method == http.MethodPatch && stringValue(resource["resourceType"]) == "Binary" {
+		// JSON Patch is transferred as the Binary resource.
+		data, err := base64.StdEncoding.DecodeString(stringValue(resource["data"]))
+		if err != nil {
+			return nil, errorResponse(http.StatusBadRequest, models.IssueTypeInvalid, "entry %d has invalid patch: %s", index, err), false
+		}
+		header.Set("Content-Type", stringValue(resource["contentType"]))
+		return &transactionEntry{index: index, method: method, url: u, header: header, body: data}, response{}, true
+	}
+
+	return &transactionEntry{
+		index:    index,
+		method:   method,
+		url:      u,
+		header:   header,
+		fullURL:  stringValue(entry["fullUrl"]),
+		resource: resource,
+	}, response{}, true
+}
+
+// resolveReferences replaces references to the entries created in the transaction.
+func resolveReferences(node interface{}, references map[string]string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			if s, ok := value.(string); ok && key == "reference" {
+				if ref, ok := references[s]; ok {
+					n[key] = ref
+				}
+				continue
+			}
+			resolveReferences(value, references)
+		}
+	case []interface{}:
+		for _, item := range n {
+			resolveReferences(item, references)
+		}
+	}
+}
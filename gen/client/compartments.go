@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+)
+
+// Compartment is the compartment with the resource types which may be its members.
+type Compartment struct {
+	Code    string
+	Members []Member
+}
+
+// Member is the resource type in the compartment with the search parameters which link it to the compartment.
+type Member struct {
+	Code   string
+	Params []string
+}
+
+type compartmentDefinition struct {
+	Code     string `json:"code"`
+	Resource []struct {
+		Code  string   `json:"code"`
+		Param []string `json:"param"`
+	} `json:"resource"`
+}
+
+// LoadCompartments loads the compartmentdefinition-*.json files from the dir.
+// The resource types without params are not members of the compartment and are skipped.
+func LoadCompartments(dir string, entities []string) ([]Compartment, error) {
+	known := make(map[string]bool, len(entities))
+	for _, entity := range entities {
+		known[entity] = true
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "compartmentdefinition-*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var compartments []Compartment
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var def compartmentDefinition
+		if err := json.Unmarshal(data, &def); err != nil {
+			return nil, err
+		}
+		compartment := Compartment{Code: def.Code}
+		for _, resource := range def.Resource {
+			if len(resource.Param) == 0 || !known[resource.Code] {
+				continue
+			}
+			compartment.Members = append(compartment.Members, Member{Code: resource.Code, Params: resource.Param})
+		}
+		sort.Slice(compartment.Members, func(i, j int) bool { return compartment.Members[i].Code < compartment.Members[j].Code })
+		compartments = append(compartments, compartment)
+	}
+	sort.Slice(compartments, func(i, j int) bool { return compartments[i].Code < compartments[j].Code })
+	return compartments, nil
+}
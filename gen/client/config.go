@@ -2,4 +2,5 @@ package main
 
 type Config struct {
 	Output string
+	Input  string
 }
@@ -5,6 +5,7 @@ import "flag"
 func ParseFlags() Config {
 	config := Config{}
 	flag.StringVar(&(config.Output), "o", "", "Output file, else output to STDOUT")
+	flag.StringVar(&(config.Input), "i", "", "Input dir with compartment definitions")
 	flag.Parse()
 	return config
 }
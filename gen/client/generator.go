@@ -13,6 +13,12 @@ var clientTemplate string
 //go:embed templates/types.go.tmpl
 var typesTemplate string
 
+//go:embed templates/compartments.go.tmpl
+var compartmentsTemplate string
+
+//go:embed templates/searchparams.go.tmpl
+var searchParamsTemplate string
+
 type Generator struct {
 	config Config
 }
@@ -22,46 +28,47 @@ func NewGenerator(config Config) *Generator {
 }
 
 type Data struct {
-	Entities []string
+	Entities     []string
+	Compartments []Compartment
+	Searches     []SearchResource
 }
 
 func (g *Generator) Run() error {
-	tmpl := template.Must(template.New("client").Parse(clientTemplate))
+	data := Data{Entities: Definitions}
+	if g.config.Input != "" {
+		compartments, err := LoadCompartments(g.config.Input, Definitions)
+		if err != nil {
+			return err
+		}
+		data.Compartments = compartments
 
-	file, err := os.Create(filepath.Join(g.config.Output, "client.gen.go"))
-	if err != nil {
-		return err
+		searches, err := LoadSearchParameters(g.config.Input, Definitions)
+		if err != nil {
+			return err
+		}
+		data.Searches = searches
 	}
-	defer file.Close()
 
-	if err := tmpl.Execute(file, Data{Entities: Definitions}); err != nil {
+	if err := g.execute("client", clientTemplate, "client.gen.go", data); err != nil {
+		return err
+	}
+	if err := g.execute("types", typesTemplate, "types.gen.go", data); err != nil {
 		return err
 	}
+	if err := g.execute("compartments", compartmentsTemplate, "compartments.gen.go", data); err != nil {
+		return err
+	}
+	return g.execute("searchparams", searchParamsTemplate, "searchparams.gen.go", data)
+}
 
-	tmpl = template.Must(template.New("types").Parse(typesTemplate))
+func (g *Generator) execute(name, text, fileName string, data Data) error {
+	tmpl := template.Must(template.New(name).Parse(text))
 
-	file, err = os.Create(filepath.Join(g.config.Output, "types.gen.go"))
+	file, err := os.Create(filepath.Join(g.config.Output, fileName))
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	return tmpl.Execute(file, Data{Entities: Definitions})
-
-	// for _, def := range Definitions {
-	// 	err := func() error {
-	// 		file, err := os.Create(filepath.Join(g.config.Output, def+".go"))
-	// 		if err != nil {
-	// 			return err
-	// 		}
-	// 		defer file.Close()
-
-	// 		return tmpl.Execute(file, Data{Entity: def})
-	// 	}()
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// }
-
-	return nil
+	return tmpl.Execute(file, data)
 }
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SearchResource is the resource type with its search parameters.
+type SearchResource struct {
+	Code   string
+	Params []SearchParam
+	Kinds  []SearchKind
+}
+
+// SearchParam is the search parameter of the resource type.
+type SearchParam struct {
+	Code        string
+	Name        string
+	Kind        string
+	Description string
+	// Targets are the resource types the reference parameter may refer to, empty for any resource.
+	Targets []string
+}
+
+// SearchKind is the type of search parameters with the methods of the typed builder.
+type SearchKind struct {
+	Name    string
+	Methods []SearchMethod
+}
+
+// SearchMethod is the method of the search parameter builder.
+type SearchMethod struct {
+	Name   string
+	Params string
+	Args   string
+	Doc    string
+}
+
+var searchKinds = map[string]SearchKind{
+	"token": {Name: "Token", Methods: []SearchMethod{
+		{"Eq", "codes ...string", "codes...", "matches any of the codes."},
+		{"Code", "system, code string", "system, code", "matches the code in the system."},
+		{"System", "system string", "system", "matches any code in the system."},
+		{"Not", "codes ...string", "codes...", "matches the resources without any of the codes."},
+		{"Text", "text string", "text", "matches the text or display of the code."},
+		{"In", "valueSet string", "valueSet", "matches the codes in the value set."},
+		{"NotIn", "valueSet string", "valueSet", "matches the codes not in the value set."},
+		{"Above", "codes ...string", "codes...", "matches the codes subsuming the codes."},
+		{"Below", "codes ...string", "codes...", "matches the codes subsumed by the codes."},
+		missingMethod,
+	}},
+	"string": {Name: "String", Methods: []SearchMethod{
+		{"Eq", "values ...string", "values...", "matches the strings starting with any of the values, case and accent insensitive."},
+		{"Exact", "values ...string", "values...", "matches the strings equal to any of the values."},
+		{"Contains", "values ...string", "values...", "matches the strings containing any of the values."},
+		missingMethod,
+	}},
+	"date": {Name: "Date", Methods: []SearchMethod{
+		{"Eq", "dates ...models.DateTime", "dates...", "matches the dates within any of the dates."},
+		{"Ne", "dates ...models.DateTime", "dates...", "matches the dates not within the dates."},
+		{"Gt", "dates ...models.DateTime", "dates...", "matches the dates after the dates."},
+		{"Lt", "dates ...models.DateTime", "dates...", "matches the dates before the dates."},
+		{"Ge", "dates ...models.DateTime", "dates...", "matches the dates within or after the dates."},
+		{"Le", "dates ...models.DateTime", "dates...", "matches the dates within or before the dates."},
+		{"Sa", "dates ...models.DateTime", "dates...", "matches the periods starting after the dates."},
+		{"Eb", "dates ...models.DateTime", "dates...", "matches the periods ending before the dates."},
+		{"Ap", "dates ...models.DateTime", "dates...", "matches the dates approximately equal to the dates."},
+		missingMethod,
+	}},
+	"number": {Name: "Number", Methods: []SearchMethod{
+		{"Eq", "values ...models.Decimal", "values...", "matches any of the values."},
+		{"Compare", "prefix Prefix, value models.Decimal", "prefix, value", "matches the numbers compared to the value with the prefix."},
+		missingMethod,
+	}},
+	"quantity": {Name: "Quantity", Methods: []SearchMethod{
+		{"Eq", "value models.Decimal, system, code string", "value, system, code", "matches the quantities equal to the value."},
+		{"Gt", "value models.Decimal, system, code string", "value, system, code", "matches the quantities greater than the value."},
+		{"Lt", "value models.Decimal, system, code string", "value, system, code", "matches the quantities less than the value."},
+		{"Ge", "value models.Decimal, system, code string", "value, system, code", "matches the quantities greater than or equal to the value."},
+		{"Le", "value models.Decimal, system, code string", "value, system, code", "matches the quantities less than or equal to the value."},
+		{"Compare", "prefix Prefix, value models.Decimal, system, code string", "prefix, value, system, code", "matches the quantities compared to the value with the prefix."},
+		missingMethod,
+	}},
+	"reference": {Name: "Reference", Methods: []SearchMethod{
+		{"Eq", "references ...string", "references...", "matches any of the references."},
+		{"ID", "resource ResourceType, ids ...string", "resource, ids...", "matches the references to the resources of the type with any of the IDs."},
+		{"Identifier", "system, value string", "system, value", "matches the references by the identifier of the referenced resource."},
+		{"Where", "target ResourceQuery", "target", "matches the references to the resources found with the target query, such as subject:Patient.name=peter."},
+		missingMethod,
+	}},
+	"uri": {Name: "URI", Methods: []SearchMethod{
+		{"Eq", "uris ...string", "uris...", "matches any of the URIs."},
+		{"Above", "uri string", "uri", "matches the URIs which are the prefixes of the URI."},
+		{"Below", "uri string", "uri", "matches the URIs starting with the URI."},
+		missingMethod,
+	}},
+	"composite": {Name: "Composite", Methods: []SearchMethod{
+		{"Eq", "components ...string", "components...", "matches the components joined with \"$\"."},
+	}},
+	"special": {Name: "Special", Methods: []SearchMethod{
+		{"Eq", "values ...string", "values...", "matches any of the values."},
+	}},
+}
+
+var missingMethod = SearchMethod{"Missing", "value bool", "value", "matches the resources with the element missing or present."}
+
+// reservedSearchNames are the names of the query methods which can't be used for the parameters.
+var reservedSearchNames = map[string]bool{
+	"Query": true, "Add": true, "Count": true, "Sort": true, "Encode": true, "Values": true,
+	"Resource": true, "Err": true, "Chain": true, "Has": true, "Total": true,
+	"Summary": true, "Elements": true,
+}
+
+// noDomainResource are the resource types which are not DomainResource.
+var noDomainResource = map[string]bool{"Binary": true, "Bundle": true, "Parameters": true}
+
+type searchParameterBundle struct {
+	Entry []struct {
+		Resource searchParameter `json:"resource"`
+	} `json:"entry"`
+}
+
+type searchParameter struct {
+	ResourceType string   `json:"resourceType"`
+	Code         string   `json:"code"`
+	Base         []string `json:"base"`
+	Type         string   `json:"type"`
+	Description  string   `json:"description"`
+	Target       []string `json:"target"`
+}
+
+// LoadSearchParameters loads the search parameters from the search-parameters.json Bundle in the dir.
+// Only the resource types with their own parameters are returned, the parameters of Resource and DomainResource
+// are added to each of them.
+func LoadSearchParameters(dir string, entities []string) ([]SearchResource, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "search-parameters.json"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var bundle searchParameterBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(entities))
+	for _, entity := range entities {
+		known[entity] = true
+	}
+
+	var common, domain []searchParameter
+	own := make(map[string][]searchParameter)
+	for _, entry := range bundle.Entry {
+		param := entry.Resource
+		if param.ResourceType != "SearchParameter" || searchKinds[param.Type].Name == "" {
+			continue
+		}
+		for _, base := range param.Base {
+			switch {
+			case base == "Resource":
+				common = append(common, param)
+			case base == "DomainResource":
+				domain = append(domain, param)
+			case known[base]:
+				own[base] = append(own[base], param)
+			}
+		}
+	}
+
+	resources := make([]SearchResource, 0, len(own))
+	for code, params := range own {
+		all := append([]searchParameter{}, common...)
+		if !noDomainResource[code] {
+			all = append(all, domain...)
+		}
+		resources = append(resources, newSearchResource(code, append(all, params...)))
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Code < resources[j].Code })
+	return resources, nil
+}
+
+func newSearchResource(code string, params []searchParameter) SearchResource {
+	resource := SearchResource{Code: code}
+	seen := make(map[string]bool)
+	kinds := make(map[string]bool)
+	// own are the names of the parameters without "_", the common parameter of the same name, such as _source
+	// for source, gets the "Param" suffix.
+	own := make(map[string]bool)
+	for _, param := range params {
+		if !strings.HasPrefix(param.Code, "_") {
+			own[searchParamName(param.Code)] = true
+		}
+	}
+	for _, param := range params {
+		if seen[param.Code] {
+			continue
+		}
+		seen[param.Code] = true
+		kinds[param.Type] = true
+
+		name := searchParamName(param.Code)
+		if reservedSearchNames[name] || strings.HasPrefix(param.Code, "_") && own[name] {
+			name += "Param"
+		}
+		resource.Params = append(resource.Params, SearchParam{
+			Code:        param.Code,
+			Name:        name,
+			Kind:        searchKinds[param.Type].Name,
+			Description: searchParamDescription(code, param.Description),
+			Targets:     referenceTargets(param.Target),
+		})
+	}
+	sort.Slice(resource.Params, func(i, j int) bool { return resource.Params[i].Name < resource.Params[j].Name })
+	for kind := range kinds {
+		resource.Kinds = append(resource.Kinds, searchKinds[kind])
+	}
+	sort.Slice(resource.Kinds, func(i, j int) bool { return resource.Kinds[i].Name < resource.Kinds[j].Name })
+	return resource
+}
+
+// searchParamName returns the Go name of the parameter, such as AddressCity for address-city and LastUpdated for _lastUpdated.
+func searchParamName(code string) string {
+	if code == "_id" {
+		return "ID"
+	}
+	parts := strings.FieldsFunc(code, func(r rune) bool { return r == '-' || r == '_' })
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// searchParamDescription returns the single line description of the parameter for the resource type.
+// The parameters shared by several resources list their descriptions as "* [Patient](patient.html): ...".
+func searchParamDescription(resource, description string) string {
+	lines := strings.Split(strings.ReplaceAll(description, "\r", ""), "\n")
+	result := lines[0]
+	prefix := "* [" + resource + "]("
+	for _, line := range lines {
+		if strings.HasPrefix(line, prefix) {
+			if i := strings.Index(line, "): "); i >= 0 {
+				result = line[i+3:]
+			}
+			break
+		}
+	}
+	return strings.TrimSuffix(strings.TrimSpace(result), ".")
+}
+
+// referenceTargets returns the sorted targets of the reference parameter, or nil when it may refer to any resource.
+func referenceTargets(targets []string) []string {
+	var result []string
+	for _, target := range targets {
+		if target == "Resource" {
+			return nil
+		}
+		result = append(result, target)
+	}
+	sort.Strings(result)
+	return result
+}
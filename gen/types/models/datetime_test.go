@@ -14,34 +14,53 @@ func TestDateTime(t *testing.T) {
 	}{
 		{
 			name:     "timestamp",
-			expected: DateTime{Time: time.Date(1955, 4, 11, 20, 45, 33, 0, time.Local), Precision: TimestampPrecision},
-			data:     `"` + time.Date(1955, 4, 11, 20, 45, 33, 0, time.Local).Format(time.RFC3339) + `"`,
+			expected: DateTime{Time: time.Date(1955, 4, 11, 20, 45, 33, 0, time.FixedZone("", 3*60*60)), Precision: TimestampPrecision},
+			data:     `"1955-04-11T20:45:33+03:00"`,
+			wantErr:  false,
+		},
+		{
+			name:     "timestamp with fractional seconds",
+			expected: DateTime{Time: time.Date(1955, 4, 11, 20, 45, 33, 120000000, time.FixedZone("", -9*60*60-30*60)), Precision: TimestampPrecision},
+			data:     `"1955-04-11T20:45:33.12-09:30"`,
 			wantErr:  false,
 		},
 		{
 			name:     "year",
-			expected: DateTime{Time: time.Date(1955, 1, 1, 0, 0, 0, 0, time.Local), Precision: YearPrecision},
+			expected: DateTime{Time: time.Date(1955, 1, 1, 0, 0, 0, 0, time.UTC), Precision: YearPrecision},
 			data:     `"1955"`,
 			wantErr:  false,
 		},
 		{
 			name:     "year-month",
-			expected: DateTime{Time: time.Date(1955, 4, 1, 0, 0, 0, 0, time.Local), Precision: YearMonthPrecision},
+			expected: DateTime{Time: time.Date(1955, 4, 1, 0, 0, 0, 0, time.UTC), Precision: YearMonthPrecision},
 			data:     `"1955-04"`,
 			wantErr:  false,
 		},
 		{
 			name:     "date",
-			expected: DateTime{Time: time.Date(1955, 4, 11, 0, 0, 0, 0, time.Local), Precision: DatePrecision},
+			expected: DateTime{Time: time.Date(1955, 4, 11, 0, 0, 0, 0, time.UTC), Precision: DatePrecision},
 			data:     `"1955-04-11"`,
 			wantErr:  false,
 		},
+		{
+			name:     "leap day",
+			expected: DateTime{Time: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Precision: DatePrecision},
+			data:     `"2020-02-29"`,
+			wantErr:  false,
+		},
 		{
 			name:     "not valid",
 			expected: DateTime{Time: time.Time{}, Precision: TimestampPrecision},
 			data:     `"XXXX"`,
 			wantErr:  true,
 		},
+		{name: "no leap day", data: `"2021-02-29"`, wantErr: true},
+		{name: "day out of month", data: `"2020-04-31"`, wantErr: true},
+		{name: "timestamp without time zone", data: `"1955-04-11T20:45:33"`, wantErr: true},
+		{name: "timestamp without seconds", data: `"1955-04-11T20:45Z"`, wantErr: true},
+		{name: "date with time zone", data: `"1955-04-11Z"`, wantErr: true},
+		{name: "offset out of range", data: `"1955-04-11T20:45:33+14:30"`, wantErr: true},
+		{name: "year zero", data: `"0000"`, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -54,7 +73,7 @@ func TestDateTime(t *testing.T) {
 				t.Errorf("expected Precision: %v; actual: %v", tt.expected.Precision, d.Precision)
 				return
 			}
-			if d.Time != tt.expected.Time {
+			if !d.Time.Equal(tt.expected.Time) || d.Time.Location().String() != tt.expected.Time.Location().String() {
 				t.Errorf("expected Time: %v; actual: %v", tt.expected.Time, d.Time)
 				return
 			}
@@ -71,6 +90,94 @@ func TestDateTime(t *testing.T) {
 			}
 		})
 	}
+
+	leap, err := ParseDateTime("2016-12-31T23:59:60Z")
+	if err != nil {
+		t.Fatalf("ParseDateTime() error = %v", err)
+	}
+	if expected := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC); !leap.Time.Equal(expected) {
+		t.Errorf("expected the leap second folded into %s, got %s", expected, leap.Time)
+	}
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	local, err := ParseDateTimeInLocation("2020-05", tokyo)
+	if err != nil {
+		t.Fatalf("ParseDateTimeInLocation() error = %v", err)
+	}
+	if expected := time.Date(2020, 5, 1, 0, 0, 0, 0, tokyo); !local.Start().Equal(expected) || local.String() != "2020-05" {
+		t.Errorf("expected %s starting at %s, got %s starting at %s", "2020-05", expected, local, local.Start())
+	}
+}
+
+func TestDateTimeRange(t *testing.T) {
+	parse := func(s string) DateTime {
+		d, err := ParseDateTime(s)
+		if err != nil {
+			t.Fatalf("ParseDateTime() error = %v", err)
+		}
+		return d
+	}
+	ranges := []struct {
+		value string
+		start string
+		end   string
+	}{
+		{value: "2020", start: "2020-01-01T00:00:00Z", end: "2021-01-01T00:00:00Z"},
+		{value: "2020-02", start: "2020-02-01T00:00:00Z", end: "2020-03-01T00:00:00Z"},
+		{value: "2020-12-31", start: "2020-12-31T00:00:00Z", end: "2021-01-01T00:00:00Z"},
+		{value: "2020-05-11T10:00:00+02:00", start: "2020-05-11T10:00:00+02:00", end: "2020-05-11T10:00:01+02:00"},
+		{value: "2020-05-11T10:00:00.25Z", start: "2020-05-11T10:00:00.25Z", end: "2020-05-11T10:00:00.26Z"},
+		{value: "2020-05-11T10:00:00.250Z", start: "2020-05-11T10:00:00.25Z", end: "2020-05-11T10:00:00.251Z"},
+		{value: "2020-05-11T10:00:00.0Z", start: "2020-05-11T10:00:00Z", end: "2020-05-11T10:00:00.1Z"},
+	}
+	for _, r := range ranges {
+		d := parse(r.value)
+		if start := formatTimestamp(d.Start(), 0); start != r.start {
+			t.Errorf("%s: expected start %s, got %s", r.value, r.start, start)
+		}
+		if end := formatTimestamp(d.End(), 0); end != r.end {
+			t.Errorf("%s: expected end %s, got %s", r.value, r.end, end)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		d, u     string
+		overlaps bool
+		contains bool
+		before   bool
+		after    bool
+		equal    bool
+	}{
+		{name: "month contains date", d: "2020-05", u: "2020-05-11", overlaps: true, contains: true},
+		{name: "date within year", d: "2020-05-11", u: "2020", overlaps: true},
+		{name: "month before date", d: "2020-04", u: "2020-05-01", before: true},
+		{name: "date after month", d: "2020-05-01", u: "2020-04", after: true},
+		{name: "date contains timestamp", d: "2020-05-11", u: "2020-05-11T23:59:59Z", overlaps: true, contains: true},
+		{name: "date and timestamp in other zone", d: "2020-05-11", u: "2020-05-11T23:59:59-01:00", before: true},
+		{name: "same moment in other zones", d: "2020-05-11T10:00:00Z", u: "2020-05-11T12:00:00+02:00", overlaps: true, contains: true, equal: true},
+		{name: "month is not the first day", d: "2020-05", u: "2020-05-01", overlaps: true, contains: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, u := parse(tt.d), parse(tt.u)
+			if overlaps := d.Overlaps(u); overlaps != tt.overlaps {
+				t.Errorf("%s.Overlaps(%s) = %v, expected %v", d, u, overlaps, tt.overlaps)
+			}
+			if contains := d.Contains(u); contains != tt.contains {
+				t.Errorf("%s.Contains(%s) = %v, expected %v", d, u, contains, tt.contains)
+			}
+			if before := d.Before(u); before != tt.before {
+				t.Errorf("%s.Before(%s) = %v, expected %v", d, u, before, tt.before)
+			}
+			if after := d.After(u); after != tt.after {
+				t.Errorf("%s.After(%s) = %v, expected %v", d, u, after, tt.after)
+			}
+			if equal := d.Equal(u); equal != tt.equal {
+				t.Errorf("%s.Equal(%s) = %v, expected %v", d, u, equal, tt.equal)
+			}
+		})
+	}
 }
 
 func TestTime(t *testing.T) {
@@ -86,6 +193,24 @@ func TestTime(t *testing.T) {
 			data:     `"12:24:48"`,
 			wantErr:  false,
 		},
+		{
+			name:     "fractional seconds",
+			expected: &Time{time: time.Date(0, 1, 1, 12, 24, 48, 50000000, time.UTC)},
+			data:     `"12:24:48.05"`,
+			wantErr:  false,
+		},
+		{
+			name:     "trailing zeros",
+			expected: &Time{time: time.Date(0, 1, 1, 12, 24, 48, 50000000, time.UTC), digits: 3},
+			data:     `"12:24:48.050"`,
+			wantErr:  false,
+		},
+		{
+			name:     "with time zone",
+			expected: NewTime(0, 0, 0),
+			data:     `"12:24:48Z"`,
+			wantErr:  true,
+		},
 		{
 			name:     "not valid",
 			expected: NewTime(0, 0, 0),
@@ -118,3 +243,59 @@ func TestTime(t *testing.T) {
 		})
 	}
 }
+
+func TestInstant(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		offset  int
+		nanos   int
+		wantErr bool
+	}{
+		{name: "milliseconds with offset", data: `"2015-02-07T13:28:17.239+02:00"`, offset: 2 * 60 * 60, nanos: 239000000},
+		{name: "nanoseconds in UTC", data: `"2015-02-07T13:28:17.123456789Z"`, nanos: 123456789},
+		{name: "trailing zeros", data: `"2015-02-07T13:28:17.100Z"`, nanos: 100000000},
+		{name: "zero fraction", data: `"2015-02-07T13:28:17.000+01:00"`, offset: 60 * 60},
+		{name: "seconds with negative offset", data: `"2015-02-07T13:28:17-05:00"`, offset: -5 * 60 * 60},
+		{name: "date", data: `"2015-02-07"`, wantErr: true},
+		{name: "without time zone", data: `"2015-02-07T13:28:17"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Instant
+			if err := i.UnmarshalJSON([]byte(tt.data)); (err != nil) != tt.wantErr {
+				t.Fatalf("Instant.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if _, offset := i.Time.Zone(); offset != tt.offset || i.Time.Nanosecond() != tt.nanos {
+				t.Errorf("expected offset %d and nanoseconds %d, got %s", tt.offset, tt.nanos, i.Time)
+			}
+			data, err := i.MarshalJSON()
+			if err != nil {
+				t.Fatalf("Instant.MarshalJSON() error = %v", err)
+			}
+			if string(data) != tt.data {
+				t.Errorf("expected: %v; actual: %v", tt.data, string(data))
+			}
+
+			var d DateTime
+			if err := d.UnmarshalJSON([]byte(tt.data)); err != nil {
+				t.Fatalf("DateTime.UnmarshalJSON() error = %v", err)
+			}
+			if data, _ := d.MarshalJSON(); string(data) != tt.data {
+				t.Errorf("expected DateTime: %v; actual: %v", tt.data, string(data))
+			}
+		})
+	}
+
+	earlier, _ := ParseInstant("2015-02-07T13:28:17.239+02:00")
+	later, _ := ParseInstant("2015-02-07T11:28:17.240Z")
+	if !earlier.Before(later) || !later.After(earlier) || earlier.Equal(later) {
+		t.Errorf("expected %s before %s", earlier, later)
+	}
+	if s := NewInstant(later.Time.Add(5 * time.Nanosecond)).String(); s != "2015-02-07T11:28:17.240000005Z" {
+		t.Errorf("expected the nanoseconds of the new instant, got %s", s)
+	}
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Decimal is the FHIR decimal, the arbitrary-precision number kept as it is written, so 0.10 is marshaled back as 0.10
+// and 1.000000000000001 is not rounded to float64. The zero value is 0.
+// Use Cmp or Equal to compare the values, 0.1 and 0.10 are equal, but their Decimals are not, as their precisions differ.
+type Decimal struct {
+	text string
+}
+
+// decimalRegexp is the regular expression of the FHIR decimal.
+var decimalRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// maxDecimalExponent is the largest absolute exponent accepted by ParseDecimal. The arithmetic and the comparison scale
+// the decimals to the same exponent, so the unbounded exponent, such as 1e400000000, would make them build huge numbers.
+const maxDecimalExponent = 1000
+
+// ParseDecimal parses the decimal, such as 0.10, -7 or 1.5e-3. The exponent beyond ±1000 is an error.
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalRegexp.MatchString(s) {
+		return Decimal{}, fmt.Errorf("unable to parse Decimal: %s", s)
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(s[i+1:])
+		if err != nil || exponent > maxDecimalExponent || exponent < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("unable to parse Decimal: %s: exponent out of range", s)
+		}
+	}
+	return Decimal{text: s}, nil
+}
+
+// DecimalFromFloat64 returns the shortest decimal converted back to v, for example 0.1 for 0.1.
+// NaN and the infinities are not decimals, they become 0.
+func DecimalFromFloat64(v float64) Decimal {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Decimal{}
+	}
+	return Decimal{text: strconv.FormatFloat(v, 'f', -1, 64)}
+}
+
+// Float64 returns the nearest float64 to the decimal.
+func (d Decimal) Float64() float64 {
+	v, _ := strconv.ParseFloat(d.String(), 64)
+	return v
+}
+
+func (d Decimal) String() string {
+	if d.text == "" {
+		return "0"
+	}
+	return d.text
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) (err error) {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	*d, err = ParseDecimal(string(data))
+	return err
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Scale returns the number of the digits after the decimal point, 2 for 0.10 and 1 for 1.25e1,
+// it is negative when the exponent goes beyond the digits, -2 for 1e2.
+func (d Decimal) Scale() int {
+	_, scale := d.unscaled()
+	return scale
+}
+
+// SignificantFigures returns the number of the significant figures: 2 for 0.10, 3 for 100 and 2 for 1.0e2.
+// The zero has one significant figure.
+func (d Decimal) SignificantFigures() int {
+	coefficient := strings.TrimLeft(strings.Replace(d.coefficient(), ".", "", 1), "-0")
+	if coefficient == "" {
+		return 1
+	}
+	return len(coefficient)
+}
+
+// Sign returns -1, 0 or +1 when the decimal is negative, zero or positive.
+func (d Decimal) Sign() int {
+	unscaled, _ := d.unscaled()
+	return unscaled.Sign()
+}
+
+// Cmp compares the values of the decimals and returns -1, 0 or +1 when d is less than, equal to or greater than u.
+func (d Decimal) Cmp(u Decimal) int {
+	x, y := alignDecimals(d, u)
+	return x.Cmp(y)
+}
+
+// Equal reports whether the decimals have the same value, in any precision.
+func (d Decimal) Equal(u Decimal) bool {
+	return d.Cmp(u) == 0
+}
+
+// Add returns d+u with the scale of the more precise operand, 0.10+1 is 1.10.
+func (d Decimal) Add(u Decimal) Decimal {
+	x, y := alignDecimals(d, u)
+	return newDecimal(x.Add(x, y), maxInt(d.Scale(), u.Scale()))
+}
+
+// Sub returns d-u with the scale of the more precise operand.
+func (d Decimal) Sub(u Decimal) Decimal {
+	x, y := alignDecimals(d, u)
+	return newDecimal(x.Sub(x, y), maxInt(d.Scale(), u.Scale()))
+}
+
+// Mul returns d*u with the sum of the scales, 1.5*0.20 is 0.300.
+func (d Decimal) Mul(u Decimal) Decimal {
+	x, xScale := d.unscaled()
+	y, yScale := u.unscaled()
+	return newDecimal(x.Mul(x, y), xScale+yScale)
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	unscaled, scale := d.unscaled()
+	return newDecimal(unscaled.Neg(unscaled), scale)
+}
+
+// coefficient returns the decimal without the exponent.
+func (d Decimal) coefficient() string {
+	s := d.String()
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// unscaled returns the integer and the scale, such as the decimal is unscaled * 10^-scale.
+// The text is valid and its exponent is within the bounds, as checked by ParseDecimal.
+func (d Decimal) unscaled() (*big.Int, int) {
+	s, exponent := d.String(), 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exponent, _ = strconv.Atoi(s[i+1:])
+		s = s[:i]
+	}
+	scale := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = len(s) - i - 1
+		s = s[:i] + s[i+1:]
+	}
+	unscaled, _ := new(big.Int).SetString(s, 10)
+	return unscaled, scale - exponent
+}
+
+// alignDecimals returns the integers of the decimals scaled to the same scale.
+func alignDecimals(d, u Decimal) (*big.Int, *big.Int) {
+	x, xScale := d.unscaled()
+	y, yScale := u.unscaled()
+	if xScale < yScale {
+		x.Mul(x, pow10(yScale-xScale))
+	} else {
+		y.Mul(y, pow10(xScale-yScale))
+	}
+	return x, y
+}
+
+// newDecimal returns the decimal unscaled * 10^-scale written without the exponent.
+func newDecimal(unscaled *big.Int, scale int) Decimal {
+	if scale <= 0 {
+		return Decimal{text: unscaled.Mul(unscaled, pow10(-scale)).String()}
+	}
+	digits := new(big.Int).Abs(unscaled).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	s := digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	if unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return Decimal{text: s}
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -224,9 +224,30 @@ func (g *Generator) Run() {
 		}
 	}
 
+	if err := g.generateResourceRegistry(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
 	g.generateDefinitionsList()
 }
 
+// generateResourceRegistry generates the constructors of the resources by type, which are used to unmarshal the contained resources.
+func (g *Generator) generateResourceRegistry() error {
+	file := jen.NewFile(packageName)
+	g.setHeader(file)
+
+	sort.Strings(g.definitions)
+	constructors := jen.Dict{}
+	for _, def := range g.definitions {
+		constructors[jen.Lit(def)] = jen.Func().Params().Interface().Block(jen.Return(jen.Op("&").Id(def).Values()))
+	}
+	file.Comment("resources creates the resources by type, the contained resources are unmarshaled with it.")
+	file.Var().Id("resources").Op("=").Map(jen.String()).Func().Params().Interface().Values(constructors)
+
+	return file.Save(filepath.Join(g.config.Output, "resources.gen.go"))
+}
+
 func (g *Generator) generateDefinitionsList() {
 	fmt.Println("Definitions list saving", g.config.Definitions, len(g.definitions))
 	if len(g.definitions) == 0 || g.config.Definitions == "" {
@@ -304,7 +325,8 @@ func (g *Generator) generateResourceOrType(resources ResourceMap, requiredTypes
 	file.Commentf("%s is documented here %s", definition.Name, definition.URL)
 	var err error
 	file.Type().Id(definition.Name).StructFunc(func(rootStruct *jen.Group) {
-		_, err = g.appendFields(resources, requiredTypes, requiredValueSetBindings, file, rootStruct, definition.Name, elementDefinitions, 1, 1)
+		keepUnknown := definition.Kind == models.StructureDefinitionKindResource
+		_, err = g.appendFields(resources, requiredTypes, requiredValueSetBindings, file, rootStruct, definition.Name, elementDefinitions, 1, 1, keepUnknown)
 	})
 	if err != nil {
 		return nil, err
@@ -316,13 +338,15 @@ func (g *Generator) generateResourceOrType(resources ResourceMap, requiredTypes
 		file.Commentf("MarshalJSON marshals the given %s as JSON into a byte slice", definition.Name)
 		file.Func().Params(jen.Id("r").Id(definition.Name)).Id("MarshalJSON").Params().
 			Params(jen.Op("[]").Byte(), jen.Error()).Block(
-			jen.Return().Qual("encoding/json", "Marshal").Call(jen.Struct(
+			jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Struct(
 				jen.Id("Other"+definition.Name),
 				jen.Id("ResourceType").String().Tag(map[string]string{"json": "resourceType"}),
 			).Values(jen.Dict{
 				jen.Id("Other" + definition.Name): jen.Id("Other" + definition.Name).Call(jen.Id("r")),
 				jen.Id("ResourceType"):            jen.Lit(definition.Name),
 			})),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+			jen.Return(jen.Id("marshalUnknownFields").Call(jen.Id("b"), jen.Id("r").Dot("UnknownFields"))),
 		)
 	}
 
@@ -368,8 +392,10 @@ func (g *Generator) setHeader(file *jen.File) {
 	g.setGeneratorComment(file)
 }
 
-func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string]bool, requiredValueSetBindings map[string]bool, file *jen.File, fields *jen.Group, parentName string, elementDefinitions []models.ElementDefinition, start, level int) (int, error) {
+func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string]bool, requiredValueSetBindings map[string]bool, file *jen.File, fields *jen.Group, parentName string, elementDefinitions []models.ElementDefinition, start, level int, keepUnknown bool) (int, error) {
 	//fmt.Printf("appendFields parentName=%s, start=%d, level=%d\n", parentName, start, level)
+	var choices []choiceElement
+	var repeated []primitiveElement
 	for i := start; i < len(elementDefinitions); i++ {
 		element := elementDefinitions[i]
 		pathParts := strings.Split(element.Path, ".")
@@ -377,8 +403,9 @@ func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string
 			// direct childs
 			name := normalizeName(pathParts[level])
 
-			// support contained resources later
-			if name != "Contained" {
+			if name == "Contained" {
+				fields.Id(name).Id("ContainedResources").Tag(map[string]string{"json": pathParts[level] + ",omitempty", "bson": pathParts[level] + ",omitempty"})
+			} else {
 				switch len(element.Type) {
 				case 0:
 					if element.ContentReference != nil && (*element.ContentReference)[:1] == "#" {
@@ -396,14 +423,14 @@ func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string
 						}
 						statement.Id(typeIdentifier).Tag(map[string]string{"json": pathParts[level] + ",omitempty", "bson": pathParts[level] + ",omitempty"})
 					}
-				// support polymorphic elements later
 				case 1:
 					statement := fields.Id(name)
 
 					switch element.Type[0].Code {
 					case "code":
 						if *element.Max == "*" {
-							statement.Op("[]")
+							// the values of the repeating primitives are null when only their extensions are given
+							statement.Op("[]*")
 						} else if *element.Min == 0 {
 							statement.Op("*")
 						}
@@ -418,11 +445,8 @@ func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string
 									if !namePattern.MatchString(*name) {
 										fmt.Printf("Skip generating an enum for a ValueSet binding to `%s` because the ValueSet has a non-conforming name.\n", *name)
 										statement.Id("string")
-									} else if len(valueSet.Compose.Include) > 1 {
-										fmt.Printf("Skip generating an enum for a ValueSet binding to `%s` because the ValueSet includes more than one CodeSystem.\n", *valueSet.Name)
-										statement.Id("string")
-									} else if codeSystemURL := canonical(valueSet.Compose.Include[0]); resources["CodeSystem"][codeSystemURL] == nil {
-										fmt.Printf("Skip generating an enum for a ValueSet binding to `%s` because the ValueSet includes the non-existing CodeSystem with canonical URL `%s`.\n", *valueSet.Name, codeSystemURL)
+									} else if _, err := codesOfValueSet(resources, valueSet); err != nil {
+										fmt.Printf("Skip generating an enum for a ValueSet binding to `%s` because %s.\n", *name, err)
 										statement.Id("string")
 									} else {
 										requiredValueSetBindings[*url] = true
@@ -440,7 +464,9 @@ func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string
 					case "Resource":
 						statement.Qual("encoding/json", "RawMessage")
 					default:
-						if *element.Max == "*" {
+						if *element.Max == "*" && isPrimitive(element.Type[0].Code) {
+							statement.Op("[]*")
+						} else if *element.Max == "*" {
 							statement.Op("[]")
 						} else if *element.Min == 0 {
 							statement.Op("*")
@@ -459,7 +485,7 @@ func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string
 							var err error
 							file.Type().Id(backboneElementName).StructFunc(func(childFields *jen.Group) {
 								//var err error
-								i, err = g.appendFields(resources, requiredTypes, requiredValueSetBindings, file, childFields, backboneElementName, elementDefinitions, i+1, level+1)
+								i, err = g.appendFields(resources, requiredTypes, requiredValueSetBindings, file, childFields, backboneElementName, elementDefinitions, i+1, level+1, keepUnknown)
 							})
 							if err != nil {
 								return 0, err
@@ -478,16 +504,202 @@ func (g *Generator) appendFields(resources ResourceMap, requiredTypes map[string
 					} else {
 						statement.Tag(map[string]string{"json": pathParts[level], "bson": pathParts[level]})
 					}
+
+					if isPrimitive(element.Type[0].Code) {
+						// the id and extensions of the primitive, such as _birthDate
+						elementStatement := fields.Id(name + "Element")
+						if *element.Max == "*" {
+							elementStatement.Op("[]*")
+							repeated = append(repeated, primitiveElement{Path: element.Path, Field: name})
+						} else {
+							elementStatement.Op("*")
+						}
+						elementStatement.Id("Element").Tag(map[string]string{"json": "_" + pathParts[level] + ",omitempty", "bson": "_" + pathParts[level] + ",omitempty"})
+						requiredTypes["Element"] = true
+					}
+				default:
+					// polymorphic element, such as value[x], has a field per type, such as valueQuantity and valueString
+					choice := g.appendChoiceFields(requiredTypes, fields, element, pathParts[level])
+					choices = append(choices, choice)
 				}
 			}
 		} else {
 			// index of the next parent sibling
+			g.appendUnknownFields(fields, keepUnknown)
+			g.appendChoiceMethods(file, parentName, choices)
+			g.appendUnmarshalJSON(file, parentName, choices, repeated, keepUnknown && level == 1)
+			g.appendMarshalJSON(file, parentName, keepUnknown, level)
 			return i, nil
 		}
 	}
+	g.appendUnknownFields(fields, keepUnknown)
+	g.appendChoiceMethods(file, parentName, choices)
+	g.appendUnmarshalJSON(file, parentName, choices, repeated, keepUnknown && level == 1)
+	g.appendMarshalJSON(file, parentName, keepUnknown, level)
 	return 0, nil
 }
 
+// choiceElement is the polymorphic element, such as Observation.value[x].
+type choiceElement struct {
+	Path  string
+	Name  string
+	Types []choiceType
+}
+
+// choiceType is the type of the polymorphic element with its field, such as ValueQuantity *Quantity.
+// The primitive types have the field of the id and extensions, such as ValueStringElement.
+type choiceType struct {
+	Code      string
+	Field     string
+	Type      string
+	Primitive bool
+}
+
+// primitiveElement is the repeating primitive element, such as HumanName.given, which extensions are aligned with its values.
+type primitiveElement struct {
+	Path  string
+	Field string
+}
+
+// isPrimitive reports whether the type is the FHIR primitive type, which may have the id and extensions in the _ property.
+func isPrimitive(typeCode string) bool {
+	return typeCode != "xhtml" && unicode.IsLower(rune(typeCode[0])) && !strings.Contains(typeCode, "/")
+}
+
+func (g *Generator) appendChoiceFields(requiredTypes map[string]bool, fields *jen.Group, element models.ElementDefinition, pathPart string) choiceElement {
+	base := strings.TrimSuffix(pathPart, "[x]")
+	choice := choiceElement{Path: element.Path, Name: normalizeName(base)}
+	for _, elementType := range element.Type {
+		typeIdentifier := typeCodeToTypeIdentifier(elementType.Code)
+		if unicode.IsUpper(rune(typeIdentifier[0])) && !isPredefinedType(typeIdentifier) {
+			requiredTypes[typeIdentifier] = true
+		}
+		jsonName := base + upperFirst(elementType.Code)
+		field := choiceType{Code: elementType.Code, Field: normalizeName(jsonName), Type: typeIdentifier, Primitive: isPrimitive(elementType.Code)}
+		fields.Id(field.Field).Op("*").Id(field.Type).Tag(map[string]string{"json": jsonName + ",omitempty", "bson": jsonName + ",omitempty"})
+		if field.Primitive {
+			fields.Id(field.Field + "Element").Op("*").Id("Element").Tag(map[string]string{"json": "_" + jsonName + ",omitempty", "bson": "_" + jsonName + ",omitempty"})
+			requiredTypes["Element"] = true
+		}
+		choice.Types = append(choice.Types, field)
+	}
+	return choice
+}
+
+// appendChoiceMethods generates the accessor and setters of the polymorphic elements.
+func (g *Generator) appendChoiceMethods(file *jen.File, structName string, choices []choiceElement) {
+	if len(choices) == 0 {
+		return
+	}
+	receiver := func() *jen.Statement { return jen.Id("r").Op("*").Id(structName) }
+
+	for _, choice := range choices {
+		file.Commentf("%s returns %s of the set type, such as *%s, or nil when it is not set.", choice.Name, choice.Path, choice.Types[0].Type)
+		file.Func().Params(receiver()).Id(choice.Name).Params().Interface().Block(
+			jen.Switch().BlockFunc(func(cases *jen.Group) {
+				for _, t := range choice.Types {
+					cases.Case(jen.Id("r").Dot(t.Field).Op("!=").Nil()).Block(jen.Return(jen.Id("r").Dot(t.Field)))
+				}
+			}),
+			jen.Return(jen.Nil()),
+		)
+		for _, t := range choice.Types {
+			file.Commentf("Set%s sets %s to the %s and clears the other types.", t.Field, choice.Path, t.Code)
+			file.Func().Params(receiver()).Id("Set"+t.Field).Params(jen.Id("v").Id(t.Type)).Block(
+				jen.Id("r").Dot("clear"+choice.Name).Call(),
+				jen.Id("r").Dot(t.Field).Op("=").Op("&").Id("v"),
+			)
+		}
+		file.Func().Params(receiver()).Id("clear" + choice.Name).Params().BlockFunc(func(body *jen.Group) {
+			for _, t := range choice.Types {
+				body.Id("r").Dot(t.Field).Op("=").Nil()
+				if t.Primitive {
+					body.Id("r").Dot(t.Field + "Element").Op("=").Nil()
+				}
+			}
+		})
+	}
+}
+
+// appendUnmarshalJSON generates UnmarshalJSON, which fails when a polymorphic element has more than one type
+// or the extensions of a repeating primitive element are not aligned with its values. The resources also fail
+// on the unknown codes of their enums and their backbone elements.
+func (g *Generator) appendUnmarshalJSON(file *jen.File, structName string, choices []choiceElement, repeated []primitiveElement, resource bool) {
+	var failures []string
+	if len(choices) > 0 {
+		failures = append(failures, "with ChoiceError when a polymorphic element has more than one type")
+	}
+	if len(repeated) > 0 {
+		failures = append(failures, "with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values")
+	}
+	if resource {
+		failures = append(failures, "with CodeError when a coded element has an unknown code")
+	}
+	if len(failures) == 0 {
+		return
+	}
+	multiLine := jen.Options{Close: ")", Multi: true, Open: "(", Separator: ","}
+
+	file.Commentf("UnmarshalJSON unmarshals the %s and fails %s.", structName, strings.Join(failures, " and "))
+	file.Func().Params(jen.Id("r").Op("*").Id(structName)).Id("UnmarshalJSON").Params(jen.Id("b").Op("[]").Byte()).Error().BlockFunc(func(body *jen.Group) {
+		body.Type().Id("other").Id(structName)
+		body.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Parens(jen.Op("*").Id("other")).Call(jen.Id("r"))),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Err()))
+		for _, choice := range choices {
+			args := []jen.Code{jen.Lit(choice.Path)}
+			for _, t := range choice.Types {
+				set := jen.Id("r").Dot(t.Field).Op("!=").Nil()
+				if t.Primitive {
+					set.Op("||").Id("r").Dot(t.Field + "Element").Op("!=").Nil()
+				}
+				args = append(args, set)
+			}
+			body.If(
+				jen.Err().Op(":=").Id("checkChoice").Custom(multiLine, args...),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Err()))
+		}
+		for _, element := range repeated {
+			body.If(
+				jen.Err().Op(":=").Id("checkElements").Call(jen.Lit(element.Path), jen.Len(jen.Id("r").Dot(element.Field)), jen.Len(jen.Id("r").Dot(element.Field+"Element"))),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Err()))
+		}
+		if resource {
+			// the codes are checked last, so the lenient Decoder gets the resource checked otherwise
+			body.Return(jen.Id("checkCodes").Call(jen.Lit(structName), jen.Id("r")))
+			return
+		}
+		body.Return(jen.Nil())
+	})
+}
+
+// appendUnknownFields generates the field of the unknown properties kept by models.Decoder with KeepUnknownFields
+// in the resources and their backbone elements.
+func (g *Generator) appendUnknownFields(fields *jen.Group, keepUnknown bool) {
+	if !keepUnknown {
+		return
+	}
+	fields.Id("UnknownFields").Map(jen.String()).Qual("encoding/json", "RawMessage").Tag(map[string]string{"json": "-", "bson": "-"})
+}
+
+// appendMarshalJSON generates MarshalJSON of the backbone elements of the resources, which writes back the unknown
+// properties. The resources have their own MarshalJSON adding resourceType.
+func (g *Generator) appendMarshalJSON(file *jen.File, structName string, keepUnknown bool, level int) {
+	if !keepUnknown || level == 1 {
+		return
+	}
+	file.Commentf("MarshalJSON marshals the %s with its unknown fields.", structName)
+	file.Func().Params(jen.Id("r").Id(structName)).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Type().Id("other").Id(structName),
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("other").Call(jen.Id("r"))),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Return(jen.Id("marshalUnknownFields").Call(jen.Id("b"), jen.Id("r").Dot("UnknownFields"))),
+	)
+}
+
 func (g *Generator) requiredValueSetBinding(elementDefinition models.ElementDefinition) *string {
 	if elementDefinition.Binding != nil {
 		binding := *elementDefinition.Binding
@@ -508,11 +720,18 @@ func normalizeName(name string) string {
 	if name, ok := nameMapping[name]; ok {
 		return name
 	}
-	return strings.Title(name)
+	return upperFirst(name)
+}
+
+func upperFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
 }
 
 func isPredefinedType(t string) bool {
-	return t == "DateTime" || t == "Time" || t == "Decimal"
+	return t == "DateTime" || t == "Instant" || t == "Time" || t == "Decimal"
 }
 
 func typeCodeToTypeIdentifier(typeCode string) string {
@@ -534,7 +753,7 @@ func typeCodeToTypeIdentifier(typeCode string) string {
 	case "id":
 		return "string"
 	case "instant":
-		return "string"
+		return "Instant"
 	case "integer":
 		return "int"
 	case "markdown":
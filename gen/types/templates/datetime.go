@@ -3,6 +3,8 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -33,6 +35,8 @@ const (
 type DateTime struct {
 	Time      time.Time
 	Precision Precision
+	// digits is the number of the digits of the fractional seconds of the parsed timestamp.
+	digits int
 }
 
 func NewDateTime(time time.Time, precision Precision) *DateTime {
@@ -69,7 +73,7 @@ func NewYear(year int) *DateTime {
 func (d DateTime) String() string {
 	switch d.Precision {
 	case TimestampPrecision:
-		return d.Time.Format(time.RFC3339)
+		return formatTimestamp(d.Time, d.digits)
 	case YearMonthPrecision:
 		return d.Time.Format("2006-01")
 	case YearPrecision:
@@ -82,28 +86,6 @@ func (d DateTime) String() string {
 }
 
 func (d *DateTime) UnmarshalJSON(data []byte) (err error) {
-	// strData := string(data)
-	// if len(data) <= 12 {
-	// 	d.Precision = DatePrecision
-	// 	d.Time, err = time.ParseInLocation("\"2006-01-02\"", strData, time.Local)
-	// 	if err != nil {
-	// 		d.Precision = YearMonthPrecision
-	// 		d.Time, err = time.ParseInLocation("\"2006-01\"", strData, time.Local)
-	// 	}
-	// 	if err != nil {
-	// 		d.Precision = YearPrecision
-	// 		d.Time, err = time.ParseInLocation("\"2006\"", strData, time.Local)
-	// 	}
-	// 	if err != nil {
-	// 		err = fmt.Errorf("unable to parse DateTime: %s", strData)
-	// 		d.Precision = TimestampPrecision // DateTimeUnknown
-	// 	}
-	// } else {
-	// 	d.Precision = TimestampPrecision
-	// 	d.Time = time.Time{}
-	// 	err = d.Time.UnmarshalJSON(data)
-	// }
-
 	// Ignore null, like in the main JSON package.
 	if string(data) == "null" {
 		return nil
@@ -116,33 +98,264 @@ func (d DateTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.String())
 }
 
-func ParseDateTime(s string) (d DateTime, err error) {
+// Start returns the start of the range implied by the precision, for example 2020-05-01T00:00:00 for 2020-05.
+func (d DateTime) Start() time.Time {
+	t := d.Time
+	switch d.Precision {
+	case YearPrecision:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	case YearMonthPrecision:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case DatePrecision:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+	return t
+}
+
+// End returns the end of the range implied by the precision, the first moment after it, for example 2020-06-01T00:00:00
+// for 2020-05, so the range is [Start, End). The timestamp covers its last given digit of the seconds, with
+// the trailing zeros, 10:00:00 covers the second, 10:00:00.25 the hundredth and 10:00:00.250 the thousandth of the second.
+func (d DateTime) End() time.Time {
+	start := d.Start()
+	switch d.Precision {
+	case YearPrecision:
+		return start.AddDate(1, 0, 0)
+	case YearMonthPrecision:
+		return start.AddDate(0, 1, 0)
+	case DatePrecision:
+		return start.AddDate(0, 0, 1)
+	}
+	digits := d.digits
+	if n := fractionDigits(start.Nanosecond()); n > digits {
+		digits = n
+	}
+	unit := time.Second
+	for ; digits > 0; digits-- {
+		unit /= 10
+	}
+	return start.Add(unit)
+}
+
+// Overlaps reports whether the ranges of the date times intersect, for example 2020 and 2020-05-11.
+func (d DateTime) Overlaps(u DateTime) bool {
+	return d.Start().Before(u.End()) && u.Start().Before(d.End())
+}
+
+// Contains reports whether the range of the date time fully contains the range of u, it is the FHIR search "eq",
+// for example 2020-05 contains 2020-05-11 and 2020-05-11T10:00:00Z, but not 2020.
+func (d DateTime) Contains(u DateTime) bool {
+	return !u.Start().Before(d.Start()) && !u.End().After(d.End())
+}
+
+// Before reports whether the range of the date time ends before the range of u starts, it is the FHIR search "eb",
+// for example 2020-04 is before 2020-05-01, but 2020 is not.
+func (d DateTime) Before(u DateTime) bool {
+	return !d.End().After(u.Start())
+}
+
+// After reports whether the range of the date time starts after the range of u ends, it is the FHIR search "sa".
+func (d DateTime) After(u DateTime) bool {
+	return !d.Start().Before(u.End())
+}
+
+// Equal reports whether the date times have the same range, in any time zone, so 2020-05 is not equal to 2020-05-01.
+func (d DateTime) Equal(u DateTime) bool {
+	return d.Start().Equal(u.Start()) && d.End().Equal(u.End())
+}
+
+// ParseDateTime parses the FHIR dateTime: the year, the year and month, the date, or the timestamp with
+// the optional fractional seconds and the required time zone, whose offset is kept. The partial date times have
+// no time zone and are parsed in UTC, use ParseDateTimeInLocation to get their ranges in the other time zone.
+func ParseDateTime(s string) (DateTime, error) {
+	return ParseDateTimeInLocation(s, time.UTC)
+}
+
+// ParseDateTimeInLocation is like ParseDateTime, but the partial date times are in the location.
+func ParseDateTimeInLocation(s string, loc *time.Location) (DateTime, error) {
 	s = strings.Trim(s, `"'`)
+	d, ok := parseDateTime(s, loc)
+	if !ok {
+		return DateTime{}, fmt.Errorf("unable to parse DateTime: %s", s)
+	}
+	return d, nil
+}
+
+// dateTimeRegexp is the regular expression of the FHIR dateTime.
+var dateTimeRegexp = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)` +
+	`(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])` +
+	`(T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]+)?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00)))?)?)?$`)
+
+func parseDateTime(s string, loc *time.Location) (d DateTime, ok bool) {
+	if !dateTimeRegexp.MatchString(s) {
+		return d, false
+	}
+	year, month, day := atoi(s[0:4]), 1, 1
+	switch len(s) {
+	case 4:
+		d.Precision = YearPrecision
+	case 7:
+		d.Precision, month = YearMonthPrecision, atoi(s[5:7])
+	default:
+		d.Precision, month, day = DatePrecision, atoi(s[5:7]), atoi(s[8:10])
+	}
+	// The day must exist in the month, 29 February only in the leap years.
+	if day > daysIn(year, month) {
+		return d, false
+	}
 	if len(s) <= 10 {
-		d.Precision = DatePrecision
-		d.Time, err = time.ParseInLocation("2006-01-02", s, time.Local)
-		if err != nil {
-			d.Precision = YearMonthPrecision
-			d.Time, err = time.ParseInLocation("2006-01", s, time.Local)
-		}
-		if err != nil {
-			d.Precision = YearPrecision
-			d.Time, err = time.ParseInLocation("2006", s, time.Local)
-		}
-		if err != nil {
-			err = fmt.Errorf("unable to parse DateTime: %s", s)
-			d.Precision = TimestampPrecision // DateTimeUnknown
+		d.Time = time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+		return d, true
+	}
+	d.Precision = TimestampPrecision
+	clock := parseClock(s[11:])
+	zone := s[11+len(clock.text):]
+	if zone != "Z" {
+		offset := (atoi(zone[1:3])*60 + atoi(zone[4:6])) * 60
+		if zone[0] == '-' {
+			offset = -offset
 		}
+		loc = time.FixedZone("", offset)
 	} else {
-		d.Precision = TimestampPrecision
-		d.Time, err = time.Parse(time.RFC3339, s)
+		loc = time.UTC
+	}
+	d.Time = time.Date(year, time.Month(month), day, clock.hour, clock.minute, clock.second, clock.nanos, loc)
+	d.digits = clock.digits
+	return d, true
+}
+
+// clock is the time of the day as written, with the fractional seconds.
+type clock struct {
+	text                 string
+	hour, minute, second int
+	nanos                int
+	// digits is the number of the digits of the fractional seconds, up to the nanoseconds.
+	digits int
+}
+
+// parseClock parses the time of the day "hh:mm:ss" with the optional fractional seconds at the start of the matched s.
+// The leap second 60 is folded into the next second as time.Time has no leap seconds. The digits of the fractional
+// seconds beyond the nanoseconds are dropped.
+func parseClock(s string) (c clock) {
+	c.hour, c.minute, c.second = atoi(s[0:2]), atoi(s[3:5]), atoi(s[6:8])
+	n := 8
+	if n < len(s) && s[n] == '.' {
+		n++
+		for scale := 100000000; n < len(s) && s[n] >= '0' && s[n] <= '9'; n++ {
+			if scale > 0 {
+				c.nanos += int(s[n]-'0') * scale
+				c.digits++
+			}
+			scale /= 10
+		}
+	}
+	c.text = s[:n]
+	return c
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func daysIn(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Instant is the FHIR instant, the time known at least to the second with the time zone, such as Meta.lastUpdated.
+// It keeps the nanoseconds with the number of their digits and the zone offset, so it is marshaled back as it was
+// unmarshaled, 13:28:17.100Z is not shortened to 13:28:17.1Z.
+type Instant struct {
+	Time time.Time
+	// digits is the number of the digits of the fractional seconds of the parsed instant.
+	digits int
+}
+
+func NewInstant(time time.Time) *Instant {
+	return &Instant{Time: time}
+}
+
+// Before reports whether the instant is before u.
+func (i Instant) Before(u Instant) bool {
+	return i.Time.Before(u.Time)
+}
+
+// After reports whether the instant is after u.
+func (i Instant) After(u Instant) bool {
+	return i.Time.After(u.Time)
+}
+
+// Equal reports whether the instants are the same moment, in any time zone.
+func (i Instant) Equal(u Instant) bool {
+	return i.Time.Equal(u.Time)
+}
+
+func (i Instant) String() string {
+	return formatTimestamp(i.Time, i.digits)
+}
+
+func (i *Instant) UnmarshalJSON(data []byte) (err error) {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
 	}
-	return d, err
+	*i, err = ParseInstant(string(data))
+	return err
+}
+
+func (i Instant) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+func ParseInstant(s string) (Instant, error) {
+	s = strings.Trim(s, `"'`)
+	d, err := parseTimestamp(s)
+	if err != nil {
+		return Instant{}, fmt.Errorf("unable to parse Instant: %s", s)
+	}
+	return Instant{Time: d.Time, digits: d.digits}, nil
+}
+
+// parseTimestamp parses the FHIR instant, the timestamp with the optional fractional seconds and the time zone,
+// the zone offset is kept.
+func parseTimestamp(s string) (DateTime, error) {
+	d, ok := parseDateTime(s, time.UTC)
+	if !ok || d.Precision != TimestampPrecision {
+		return DateTime{}, fmt.Errorf("unable to parse timestamp: %s", s)
+	}
+	return d, nil
+}
+
+// formatTimestamp formats the time in its time zone with at least the digits of the fractional seconds, so the trailing
+// zeros as written are kept, and with more digits if the nanoseconds need them.
+func formatTimestamp(t time.Time, digits int) string {
+	return t.Format("2006-01-02T15:04:05" + fraction(t.Nanosecond(), digits) + "Z07:00")
+}
+
+// fraction returns the layout of the fractional seconds with at least the digits, or more if the nanoseconds need them.
+func fraction(nanos, digits int) string {
+	if n := fractionDigits(nanos); n > digits {
+		digits = n
+	}
+	if digits == 0 {
+		return ""
+	}
+	return "." + strings.Repeat("0", digits)
+}
+
+// fractionDigits returns the number of the significant digits of the fractional seconds in the nanoseconds.
+func fractionDigits(nanos int) int {
+	digits := 9
+	for ; digits > 0 && nanos%10 == 0; digits-- {
+		nanos /= 10
+	}
+	return digits
 }
 
 // Time.
 type Time struct {
 	time time.Time
+	// digits is the number of the digits of the fractional seconds of the parsed time.
+	digits int
 }
 
 func NewTime(hour, minute, second int) *Time {
@@ -150,7 +363,7 @@ func NewTime(hour, minute, second int) *Time {
 }
 
 func (t *Time) Set(hour, minute, second int) {
-	t.time = time.Date(0, 1, 1, hour, minute, second, 0, time.UTC)
+	*t = Time{time: time.Date(0, 1, 1, hour, minute, second, 0, time.UTC)}
 }
 
 func (t Time) Hour() int {
@@ -165,8 +378,13 @@ func (t Time) Second() int {
 	return t.time.Second()
 }
 
+// Nanosecond returns the fractional seconds in nanoseconds.
+func (t Time) Nanosecond() int {
+	return t.time.Nanosecond()
+}
+
 func (t Time) String() string {
-	return t.time.Format("15:04:05")
+	return t.time.Format("15:04:05" + fraction(t.time.Nanosecond(), t.digits))
 }
 
 func (t *Time) UnmarshalJSON(data []byte) (err error) {
@@ -182,10 +400,15 @@ func (t Time) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.String())
 }
 
-func ParseTime(s string) (t Time, err error) {
-	time, err := time.ParseInLocation("15:04:05", strings.Trim(s, `"'`), time.UTC)
-	if err != nil {
-		return t, err
+// timeRegexp is the regular expression of the FHIR time.
+var timeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?$`)
+
+// ParseTime parses the FHIR time, the time of the day with the optional fractional seconds and without time zone.
+func ParseTime(s string) (Time, error) {
+	s = strings.Trim(s, `"'`)
+	if !timeRegexp.MatchString(s) {
+		return Time{}, fmt.Errorf("unable to parse Time: %s", s)
 	}
-	return Time{time: time}, nil
+	c := parseClock(s)
+	return Time{time: time.Date(0, 1, 1, c.hour, c.minute, c.second, c.nanos, time.UTC), digits: c.digits}, nil
 }
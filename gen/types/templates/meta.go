@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package models
+
+const (
+	// SubsettedSystem is the code system of the SUBSETTED tag.
+	SubsettedSystem = "http://terminology.hl7.org/CodeSystem/v3-ObservationValue"
+	// SubsettedCode is the code of the tag which servers add to the resources returned with _summary or _elements.
+	SubsettedCode = "SUBSETTED"
+)
+
+// SubsettedTag returns the SUBSETTED tag.
+func SubsettedTag() Coding {
+	return Coding{System: NewString(SubsettedSystem), Code: NewString(SubsettedCode)}
+}
+
+// IsSubsetted reports whether the resource is tagged as SUBSETTED, so it is incomplete.
+func (m *Meta) IsSubsetted() bool {
+	if m == nil {
+		return false
+	}
+	for _, tag := range m.Tag {
+		if ToString(tag.Code) == SubsettedCode && ToString(tag.System) == SubsettedSystem {
+			return true
+		}
+	}
+	return false
+}
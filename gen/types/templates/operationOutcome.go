@@ -0,0 +1,221 @@
+//go:build ignore
+// +build ignore
+
+package models
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// String renders the issue as plain text: severity, code, details, diagnostics and expression or location.
+func (i OperationOutcomeIssue) String() string {
+	var b strings.Builder
+	b.WriteString(i.Severity.Code())
+	b.WriteString(" [")
+	b.WriteString(i.Code.Code())
+	b.WriteString("]")
+
+	var messages []string
+	if details := i.DetailsText(); details != "" {
+		messages = append(messages, details)
+	}
+	if i.Diagnostics != nil && *i.Diagnostics != "" {
+		messages = append(messages, *i.Diagnostics)
+	}
+	if len(messages) > 0 {
+		b.WriteString(" ")
+		b.WriteString(strings.Join(messages, ": "))
+	}
+
+	path := i.Expression
+	if len(path) == 0 {
+		path = i.Location
+	}
+	if len(path) > 0 {
+		b.WriteString(" (at ")
+		b.WriteString(strings.Join(ToStrings(path), ", "))
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// DetailsText returns the text of the details or the display or code of its first coding.
+func (i OperationOutcomeIssue) DetailsText() string {
+	if i.Details == nil {
+		return ""
+	}
+	if i.Details.Text != nil && *i.Details.Text != "" {
+		return *i.Details.Text
+	}
+	for _, coding := range i.Details.Coding {
+		if coding.Display != nil && *coding.Display != "" {
+			return *coding.Display
+		}
+		if coding.Code != nil && *coding.Code != "" {
+			return *coding.Code
+		}
+	}
+	return ""
+}
+
+// IsError reports whether the issue is fatal or an error.
+func (i OperationOutcomeIssue) IsError() bool {
+	return i.Severity == IssueSeverityFatal || i.Severity == IssueSeverityError
+}
+
+// Err returns the issue as an error.
+func (i OperationOutcomeIssue) Err() error {
+	return IssueError{Issue: i}
+}
+
+// String renders the issues as plain text separated by "; ". If there are no issues, the narrative without markup is returned.
+func (o OperationOutcome) String() string {
+	if len(o.Issue) == 0 {
+		if o.Text == nil {
+			return ""
+		}
+		return strings.TrimSpace(html.UnescapeString(tagPattern.ReplaceAllString(o.Text.Div, " ")))
+	}
+	issues := make([]string, 0, len(o.Issue))
+	for _, issue := range o.Issue {
+		issues = append(issues, issue.String())
+	}
+	return strings.Join(issues, "; ")
+}
+
+// IssuesWith returns the issues with the given severities.
+func (o OperationOutcome) IssuesWith(severities ...IssueSeverity) []OperationOutcomeIssue {
+	var result []OperationOutcomeIssue
+	for _, issue := range o.Issue {
+		for _, severity := range severities {
+			if issue.Severity == severity {
+				result = append(result, issue)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// Errors returns the fatal and error issues.
+func (o OperationOutcome) Errors() []OperationOutcomeIssue {
+	return o.IssuesWith(IssueSeverityFatal, IssueSeverityError)
+}
+
+// Warnings returns the warning issues.
+func (o OperationOutcome) Warnings() []OperationOutcomeIssue {
+	return o.IssuesWith(IssueSeverityWarning)
+}
+
+// HasErrors reports whether the outcome contains fatal or error issues.
+func (o OperationOutcome) HasErrors() bool {
+	return len(o.Errors()) > 0
+}
+
+// Err returns the fatal and error issues as an error, or nil when there are no such issues.
+// A single issue is returned as IssueError, several issues as IssueErrors.
+func (o OperationOutcome) Err() error {
+	issues := o.Errors()
+	switch len(issues) {
+	case 0:
+		return nil
+	case 1:
+		return issues[0].Err()
+	}
+	errs := make(IssueErrors, 0, len(issues))
+	for _, issue := range issues {
+		errs = append(errs, IssueError{Issue: issue})
+	}
+	return errs
+}
+
+// IssueError is an OperationOutcome issue as an error.
+type IssueError struct {
+	Issue OperationOutcomeIssue
+}
+
+func (e IssueError) Error() string {
+	return e.Issue.String()
+}
+
+// IssueCode implements IssueCoder.
+func (e IssueError) IssueCode() IssueType {
+	return e.Issue.Code
+}
+
+// IssueErrors are several OperationOutcome issues as an error.
+type IssueErrors []IssueError
+
+func (e IssueErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the issue errors matches the target.
+func (e IssueErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first issue error matching the target, for example *IssueError or IssueCoder.
+func (e IssueErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// IssueCoder is implemented by errors which know the issue type they are reported with.
+type IssueCoder interface {
+	IssueCode() IssueType
+}
+
+// NewOperationOutcome builds the OperationOutcome from errors. IssueError and IssueErrors keep their issues,
+// other errors become error issues with the error message as diagnostics and the code from IssueCoder,
+// or exception when the error doesn't implement it. Nil errors are skipped.
+func NewOperationOutcome(errs ...error) *OperationOutcome {
+	outcome := &OperationOutcome{}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var issueErrors IssueErrors
+		if errors.As(err, &issueErrors) {
+			for _, e := range issueErrors {
+				outcome.Issue = append(outcome.Issue, e.Issue)
+			}
+			continue
+		}
+		var issueError IssueError
+		if errors.As(err, &issueError) {
+			outcome.Issue = append(outcome.Issue, issueError.Issue)
+			continue
+		}
+
+		code := IssueTypeException
+		var coder IssueCoder
+		if errors.As(err, &coder) {
+			code = coder.IssueCode()
+		}
+		outcome.Issue = append(outcome.Issue, OperationOutcomeIssue{
+			Severity:    IssueSeverityError,
+			Code:        code,
+			Diagnostics: NewString(err.Error()),
+		})
+	}
+	return outcome
+}
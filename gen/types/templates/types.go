@@ -11,6 +11,22 @@ func ToString(v *string) string {
 	return *v
 }
 
+func NewStrings(v ...string) []*string {
+	strings := make([]*string, len(v))
+	for i := range v {
+		strings[i] = &v[i]
+	}
+	return strings
+}
+
+func ToStrings(v []*string) []string {
+	strings := make([]string, len(v))
+	for i := range v {
+		strings[i] = ToString(v[i])
+	}
+	return strings
+}
+
 func NewInt(v int) *int {
 	return &v
 }
@@ -33,15 +49,14 @@ func ToBool(v *bool) bool {
 	return *v
 }
 
-type Decimal = float64
-
 func NewDecimal(v float64) *Decimal {
-	return &v
+	d := DecimalFromFloat64(v)
+	return &d
 }
 
 func ToDecimal(v *Decimal) Decimal {
 	if v == nil {
-		return 0
+		return Decimal{}
 	}
 	return *v
 }
@@ -50,5 +65,5 @@ func ToFloat64(v *Decimal) float64 {
 	if v == nil {
 		return 0
 	}
-	return *v
+	return v.Float64()
 }
@@ -0,0 +1,70 @@
+//go:build ignore
+// +build ignore
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// UnknownFieldError is returned by Decoder with RejectUnknownFields for the JSON property the models don't know.
+type UnknownFieldError struct {
+	Path string
+}
+
+func (e UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %s", e.Path)
+}
+
+// unknownFields returns the sorted names of the properties of the object which are not the fields of the struct type.
+// The resources may have resourceType.
+func unknownFields(object map[string]interface{}, t reflect.Type) []string {
+	var names []string
+	for name := range object {
+		if _, ok := fieldByJSONName(t, name); ok {
+			continue
+		}
+		if _, ok := resources[t.Name()]; ok && name == "resourceType" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// marshalUnknownFields appends the unknown fields kept by Decoder to the marshaled JSON object, the known fields win.
+func marshalUnknownFields(b []byte, fields map[string]json.RawMessage) ([]byte, error) {
+	if len(fields) == 0 {
+		return b, nil
+	}
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(b, &known); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		if _, ok := known[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.Write(bytes.TrimSuffix(bytes.TrimSpace(b), []byte("}")))
+	for _, name := range names {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(fields[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
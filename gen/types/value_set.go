@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/dave/jennifer/jen"
@@ -14,41 +15,21 @@ func (g *Generator) generateValueSet(resources ResourceMap, valueSet models.Valu
 		return nil, errors.New("ValueSet without name")
 	}
 
-	if valueSet.Compose == nil || len(valueSet.Compose.Include) == 0 {
-		return nil, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
-	}
-
-	if len(valueSet.Compose.Include) > 1 {
-		return nil, fmt.Errorf("the ValueSet `%s` includes more than one CodeSystem", *valueSet.Name)
-	}
-
-	url := canonical(valueSet.Compose.Include[0])
-	if url == "" {
-		return nil, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
-	}
-
-	bytes := resources["CodeSystem"][url]
-	if bytes == nil {
-		return nil, fmt.Errorf("missing CodeSystem with canonical URL `%s` in ValueSet `%s`", url, *valueSet.Name)
-	}
-
-	codeSystem, err := models.UnmarshalCodeSystem(bytes)
+	valueSetCodes, err := codesOfValueSet(resources, valueSet)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(codeSystem.Concept) == 0 {
-		return nil, fmt.Errorf("the CodeSystem with canonical URL `%s` has no codes", url)
-	}
-
 	fmt.Printf("Generate Go sources for ValueSet: %s\n", *valueSet.Name)
 	file := jen.NewFile(packageName)
 	g.setHeader(file)
 
 	// type
 	file.Commentf("%s is documented here %s", *valueSet.Name, *valueSet.URL)
-	file.Type().Id(*valueSet.Name).Int()
-	file.Const().DefsFunc(constsRoot(*valueSet.Name, codeSystem.Concept))
+	file.Commentf("The empty %s is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.", *valueSet.Name)
+	file.Comment("UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.")
+	file.Type().Id(*valueSet.Name).String()
+	file.Const().DefsFunc(consts(*valueSet.Name, valueSetCodes))
 
 	// MarshalJSON function
 	file.Func().
@@ -57,18 +38,26 @@ func (g *Generator) generateValueSet(resources ResourceMap, valueSet models.Valu
 		Params().
 		Params(jen.Op("[]").Byte(), jen.Error()).
 		Block(
+			jen.If(jen.Id("code").Op("==").Lit("")).Block(
+				jen.Return(jen.Nil(), jen.Id("UnsetCodeError").Values(jen.Dict{jen.Id("Type"): jen.Lit(*valueSet.Name)})),
+			),
 			jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("code").Op(".").Id("Code").Call())),
 		)
 
-	// UnmarshalJSON function
+	// UnmarshalJSON function, the unknown codes are kept, the resources report them with CodeError
 	file.Func().
 		Params(jen.Id("code").Op("*").Id(*valueSet.Name)).
 		Id("UnmarshalJSON").
-		Params(jen.Id("json").Op("[]").Byte()).
+		Params(jen.Id("b").Op("[]").Byte()).
 		Error().
 		Block(
-			jen.Id("s").Op(":=").Qual("strings", "Trim").Call(jen.String().Call(jen.Id("json")), jen.Lit(`"`)),
-			jen.Switch(jen.Id("s")).BlockFunc(unmarshalRoot(*valueSet.Name, codeSystem.Concept)),
+			jen.If(jen.String().Call(jen.Id("b")).Op("==").Lit("null")).Block(jen.Return(jen.Nil())),
+			jen.Var().Id("s").String(),
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("s")),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Err())),
+			jen.Op("*").Id("code").Op("=").Id(*valueSet.Name).Call(jen.Id("s")),
 			jen.Return(jen.Nil()),
 		)
 
@@ -82,17 +71,45 @@ func (g *Generator) generateValueSet(resources ResourceMap, valueSet models.Valu
 			jen.Return(jen.Id("code").Op(".").Id("Code").Call()),
 		)
 
-	// Code function
+	// Code function, only the codes duplicated across the CodeSystems differ from their values
 	file.Func().
 		Params(jen.Id("code").Id(*valueSet.Name)).
 		Id("Code").
 		Params().
 		String().
+		BlockFunc(func(body *jen.Group) {
+			if duplicated := duplicatedCodes(valueSetCodes); len(duplicated) > 0 {
+				body.Switch(jen.Id("code")).BlockFunc(codes(duplicated))
+			}
+			body.Return(jen.String().Call(jen.Id("code")))
+		})
+
+	// Known function
+	file.Func().
+		Params(jen.Id("code").Id(*valueSet.Name)).
+		Id("Known").
+		Params().
+		Bool().
 		Block(
-			jen.Switch(jen.Id("code")).BlockFunc(codes(*valueSet.Name, codeSystem.Concept)),
-			jen.Return(jen.Lit("<unknown>")),
+			jen.Switch(jen.Id("code")).Block(
+				jen.Case(identifiers(valueSetCodes)...).Block(jen.Return(jen.True())),
+			),
+			jen.Return(jen.False()),
 		)
 
+	// System function, only the codes of the ValueSets including more than one CodeSystem need it
+	if systems(valueSetCodes) > 1 {
+		file.Func().
+			Params(jen.Id("code").Id(*valueSet.Name)).
+			Id("System").
+			Params().
+			String().
+			Block(
+				jen.Switch(jen.Id("code")).BlockFunc(codeSystems(valueSetCodes)),
+				jen.Return(jen.Lit("<unknown>")),
+			)
+	}
+
 	// Display function
 	file.Func().
 		Params(jen.Id("code").Id(*valueSet.Name)).
@@ -100,7 +117,7 @@ func (g *Generator) generateValueSet(resources ResourceMap, valueSet models.Valu
 		Params().
 		String().
 		Block(
-			jen.Switch(jen.Id("code")).BlockFunc(displays(*valueSet.Name, codeSystem.Concept)),
+			jen.Switch(jen.Id("code")).BlockFunc(displays(valueSetCodes)),
 			jen.Return(jen.Lit("<unknown>")),
 		)
 
@@ -111,13 +128,172 @@ func (g *Generator) generateValueSet(resources ResourceMap, valueSet models.Valu
 		Params().
 		String().
 		Block(
-			jen.Switch(jen.Id("code")).BlockFunc(definitions(*valueSet.Name, codeSystem.Concept)),
+			jen.Switch(jen.Id("code")).BlockFunc(definitions(valueSetCodes)),
 			jen.Return(jen.Lit("<unknown>")),
 		)
 
 	return file, nil
 }
 
+// valueSetCode is the code of the ValueSet with the CodeSystem it comes from.
+type valueSetCode struct {
+	Identifier string
+	Value      string
+	System     string
+	Concept    models.CodeSystemConcept
+}
+
+// codesOfValueSet returns the codes of the ValueSet in the order of the includes. The codes duplicated across
+// the CodeSystems are named with the name of the CodeSystem, such as ValueSetNameCodeSystemNameCode, their values
+// are the codes qualified with the CodeSystem, such as http://hl7.org/fhir/abstract-types#Any.
+func codesOfValueSet(resources ResourceMap, valueSet models.ValueSet) ([]valueSetCode, error) {
+	if valueSet.Compose == nil || len(valueSet.Compose.Include) == 0 {
+		return nil, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
+	}
+
+	excluded := make(map[string]bool)
+	for _, exclude := range valueSet.Compose.Exclude {
+		for _, concept := range exclude.Concept {
+			excluded[canonical(exclude)+"#"+concept.Code] = true
+		}
+	}
+
+	var valueSetCodes []valueSetCode
+	identifiers := make(map[string]bool)
+	for _, include := range valueSet.Compose.Include {
+		url := canonical(include)
+		if url == "" {
+			return nil, fmt.Errorf("the ValueSet `%s` includes other ValueSets instead of CodeSystems", *valueSet.Name)
+		}
+
+		bytes := resources["CodeSystem"][url]
+		if bytes == nil {
+			return nil, fmt.Errorf("the ValueSet `%s` includes the non-existing CodeSystem with canonical URL `%s`", *valueSet.Name, url)
+		}
+
+		codeSystem, err := models.UnmarshalCodeSystem(bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		concepts, err := includedConcepts(codeSystem.Concept, include)
+		if err != nil {
+			return nil, fmt.Errorf("the ValueSet `%s` %s", *valueSet.Name, err)
+		}
+		if len(concepts) == 0 {
+			return nil, fmt.Errorf("the CodeSystem with canonical URL `%s` has no codes", url)
+		}
+
+		for _, concept := range concepts {
+			if excluded[url+"#"+concept.Code] {
+				continue
+			}
+			identifier, value := codeIdentifier(*valueSet.Name, concept.Code), concept.Code
+			if identifiers[identifier] {
+				identifier = codeIdentifier(*valueSet.Name+codeSystemName(codeSystem), concept.Code)
+				value = *include.System + "#" + concept.Code
+				fmt.Printf("The code `%s` of the ValueSet `%s` is duplicated in the CodeSystem `%s`, it is named `%s`.\n", concept.Code, *valueSet.Name, *include.System, identifier)
+			}
+			identifiers[identifier] = true
+			valueSetCodes = append(valueSetCodes, valueSetCode{Identifier: identifier, Value: value, System: *include.System, Concept: concept})
+		}
+	}
+	return valueSetCodes, nil
+}
+
+// includedConcepts returns the concepts of the include listed explicitly or selected by the filters,
+// the hierarchy of the concepts is flattened.
+func includedConcepts(concepts []models.CodeSystemConcept, include models.ValueSetComposeInclude) ([]models.CodeSystemConcept, error) {
+	if len(include.Concept) > 0 {
+		all := flatten(concepts)
+		listed := make([]models.CodeSystemConcept, 0, len(include.Concept))
+		for _, includeConcept := range include.Concept {
+			concept := models.CodeSystemConcept{Code: includeConcept.Code, Display: includeConcept.Display}
+			for _, c := range all {
+				if c.Code == includeConcept.Code {
+					concept = c
+					break
+				}
+			}
+			listed = append(listed, concept)
+		}
+		return listed, nil
+	}
+
+	for _, filter := range include.Filter {
+		if filter.Property != "concept" {
+			return nil, fmt.Errorf("filters the codes by the property `%s`, which is not enumerable", filter.Property)
+		}
+		switch filter.Op {
+		case models.FilterOperatorIsA:
+			concepts = subtree(concepts, filter.Value, true)
+		case models.FilterOperatorDescendentOf:
+			concepts = subtree(concepts, filter.Value, false)
+		case models.FilterOperatorIsNotA:
+			concepts = prune(concepts, filter.Value)
+		default:
+			return nil, fmt.Errorf("filters the codes with the operation `%s`, which is not enumerable", filter.Op.Code())
+		}
+	}
+	return flatten(concepts), nil
+}
+
+// subtree returns the concept with the code and its descendants or only the descendants.
+func subtree(concepts []models.CodeSystemConcept, code string, self bool) []models.CodeSystemConcept {
+	for _, concept := range concepts {
+		if concept.Code == code {
+			if self {
+				return []models.CodeSystemConcept{concept}
+			}
+			return concept.Concept
+		}
+		if found := subtree(concept.Concept, code, self); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// prune returns the concepts without the concept with the code and its descendants.
+func prune(concepts []models.CodeSystemConcept, code string) []models.CodeSystemConcept {
+	var pruned []models.CodeSystemConcept
+	for _, concept := range concepts {
+		if concept.Code == code {
+			continue
+		}
+		concept.Concept = prune(concept.Concept, code)
+		pruned = append(pruned, concept)
+	}
+	return pruned
+}
+
+// flatten returns the concepts and their descendants in the depth-first order.
+func flatten(concepts []models.CodeSystemConcept) []models.CodeSystemConcept {
+	var flat []models.CodeSystemConcept
+	for _, concept := range concepts {
+		children := concept.Concept
+		concept.Concept = nil
+		flat = append(flat, concept)
+		flat = append(flat, flatten(children)...)
+	}
+	return flat
+}
+
+func codeSystemName(codeSystem models.CodeSystem) string {
+	if codeSystem.Name != nil && namePattern.MatchString(*codeSystem.Name) {
+		return *codeSystem.Name
+	}
+	return codeIdentifier("", path.Base(*codeSystem.URL))
+}
+
+func systems(valueSetCodes []valueSetCode) int {
+	systems := make(map[string]bool)
+	for _, code := range valueSetCodes {
+		systems[code.System] = true
+	}
+	return len(systems)
+}
+
 func canonical(include models.ValueSetComposeInclude) string {
 	if system := include.System; system != nil {
 		if version := include.Version; version != nil {
@@ -128,25 +304,31 @@ func canonical(include models.ValueSetComposeInclude) string {
 	return ""
 }
 
-func constsRoot(valueSetName string, concepts []models.CodeSystemConcept) func(*jen.Group) {
+func consts(valueSetName string, valueSetCodes []valueSetCode) func(*jen.Group) {
 	return func(group *jen.Group) {
-		group.Id(codeIdentifier(valueSetName, concepts[0].Code)).Id(valueSetName).Op("=").Iota()
-		if len(concepts[0].Concept) > 0 {
-			consts(valueSetName, concepts[0].Concept)(group)
+		for _, code := range valueSetCodes {
+			group.Id(code.Identifier).Id(valueSetName).Op("=").Lit(code.Value)
 		}
-		consts(valueSetName, concepts[1:])(group)
 	}
 }
 
-func consts(valueSetName string, concepts []models.CodeSystemConcept) func(*jen.Group) {
-	return func(group *jen.Group) {
-		for _, concept := range concepts {
-			group.Id(codeIdentifier(valueSetName, concept.Code))
-			if len(concept.Concept) > 0 {
-				consts(valueSetName, concept.Concept)(group)
-			}
+// duplicatedCodes returns the codes duplicated across the CodeSystems, which values are qualified with the CodeSystem.
+func duplicatedCodes(valueSetCodes []valueSetCode) []valueSetCode {
+	var duplicated []valueSetCode
+	for _, code := range valueSetCodes {
+		if code.Value != code.Concept.Code {
+			duplicated = append(duplicated, code)
 		}
 	}
+	return duplicated
+}
+
+func identifiers(valueSetCodes []valueSetCode) []jen.Code {
+	identifiers := make([]jen.Code, 0, len(valueSetCodes))
+	for _, code := range valueSetCodes {
+		identifiers = append(identifiers, jen.Id(code.Identifier))
+	}
+	return identifiers
 }
 
 func codeIdentifier(valueSetName, s string) string {
@@ -168,58 +350,37 @@ func codeIdentifier(valueSetName, s string) string {
 	}
 }
 
-func unmarshalRoot(valueSetName string, concepts []models.CodeSystemConcept) func(group *jen.Group) {
+func codes(valueSetCodes []valueSetCode) func(group *jen.Group) {
 	return func(group *jen.Group) {
-		unmarshal(valueSetName, concepts)(group)
-		group.Default().Block(
-			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+valueSetName+" code `%s`"), jen.Id("s"))),
-		)
-	}
-}
-
-func unmarshal(valueSetName string, concepts []models.CodeSystemConcept) func(group *jen.Group) {
-	return func(group *jen.Group) {
-		for _, concept := range concepts {
-			group.Case(jen.Lit(concept.Code)).Block(jen.Op("*").Id("code").Op("=").Id(codeIdentifier(valueSetName, concept.Code)))
-			if len(concept.Concept) > 0 {
-				unmarshal(valueSetName, concept.Concept)(group)
-			}
+		for _, code := range valueSetCodes {
+			group.Case(jen.Id(code.Identifier)).Block(jen.Return(jen.Lit(code.Concept.Code)))
 		}
 	}
 }
 
-func codes(valueSetName string, concepts []models.CodeSystemConcept) func(group *jen.Group) {
+func codeSystems(valueSetCodes []valueSetCode) func(group *jen.Group) {
 	return func(group *jen.Group) {
-		for _, concept := range concepts {
-			group.Case(jen.Id(codeIdentifier(valueSetName, concept.Code))).Block(jen.Return(jen.Lit(concept.Code)))
-			if len(concept.Concept) > 0 {
-				codes(valueSetName, concept.Concept)(group)
-			}
+		for _, code := range valueSetCodes {
+			group.Case(jen.Id(code.Identifier)).Block(jen.Return(jen.Lit(code.System)))
 		}
 	}
 }
 
-func displays(valueSetName string, concepts []models.CodeSystemConcept) func(group *jen.Group) {
+func displays(valueSetCodes []valueSetCode) func(group *jen.Group) {
 	return func(group *jen.Group) {
-		for _, concept := range concepts {
-			if concept.Display != nil {
-				group.Case(jen.Id(codeIdentifier(valueSetName, concept.Code))).Block(jen.Return(jen.Lit(*concept.Display)))
-			}
-			if len(concept.Concept) > 0 {
-				displays(valueSetName, concept.Concept)(group)
+		for _, code := range valueSetCodes {
+			if code.Concept.Display != nil {
+				group.Case(jen.Id(code.Identifier)).Block(jen.Return(jen.Lit(*code.Concept.Display)))
 			}
 		}
 	}
 }
 
-func definitions(valueSetName string, concepts []models.CodeSystemConcept) func(group *jen.Group) {
+func definitions(valueSetCodes []valueSetCode) func(group *jen.Group) {
 	return func(group *jen.Group) {
-		for _, concept := range concepts {
-			if concept.Definition != nil {
-				group.Case(jen.Id(codeIdentifier(valueSetName, concept.Code))).Block(jen.Return(jen.Lit(*concept.Definition)))
-			}
-			if len(concept.Concept) > 0 {
-				definitions(valueSetName, concept.Concept)(group)
+		for _, code := range valueSetCodes {
+			if code.Concept.Definition != nil {
+				group.Case(jen.Id(code.Identifier)).Block(jen.Return(jen.Lit(*code.Concept.Definition)))
 			}
 		}
 	}
@@ -64,9 +64,9 @@ func EnumBundleResources(bundle *models.Bundle, resource ResourceType, f func(re
 func checkSingular(id string, resource ResourceType, count int) error {
 	switch {
 	case count == 0:
-		return NewNotFoundError(id, "Account")
+		return NewNotFoundError(id, string(resource))
 	case count > 1:
-		return NewTooManyEntitiesError(id, "Account", count)
+		return NewTooManyEntitiesError(id, string(resource), count)
 	}
 	return nil
 }
@@ -77,3 +77,16 @@ func StrPtrToStr(s *string) string {
 	}
 	return *s
 }
+
+// NextLink returns the URL of the next page of the bundle or the empty string on the last page.
+func NextLink(bundle *models.Bundle) string {
+	if bundle == nil {
+		return ""
+	}
+	for _, link := range bundle.Link {
+		if link.Relation == "next" {
+			return link.URL
+		}
+	}
+	return ""
+}
@@ -0,0 +1,22 @@
+package fhir_test
+
+import (
+	"encoding/json"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+func newPatient(family string, given ...string) *models.Patient {
+	return &models.Patient{
+		Name: []models.HumanName{{Family: models.NewString(family), Given: models.NewStrings(given...)}},
+	}
+}
+
+func genderPtr(g models.AdministrativeGender) *models.AdministrativeGender {
+	return &g
+}
+
+func mustJSON(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -22,51 +22,97 @@ import "encoding/json"
 
 // Account is documented here http://hl7.org/fhir/StructureDefinition/Account
 type Account struct {
-	ID                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            AccountStatus      `bson:"status" json:"status"`
-	Type              *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string            `bson:"name,omitempty" json:"name,omitempty"`
-	Subject           []Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
-	ServicePeriod     *Period            `bson:"servicePeriod,omitempty" json:"servicePeriod,omitempty"`
-	Coverage          []AccountCoverage  `bson:"coverage,omitempty" json:"coverage,omitempty"`
-	Owner             *Reference         `bson:"owner,omitempty" json:"owner,omitempty"`
-	Description       *string            `bson:"description,omitempty" json:"description,omitempty"`
-	Guarantor         []AccountGuarantor `bson:"guarantor,omitempty" json:"guarantor,omitempty"`
-	PartOf            *Reference         `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               AccountStatus              `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Subject              []Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
+	ServicePeriod        *Period                    `bson:"servicePeriod,omitempty" json:"servicePeriod,omitempty"`
+	Coverage             []AccountCoverage          `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	Owner                *Reference                 `bson:"owner,omitempty" json:"owner,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Guarantor            []AccountGuarantor         `bson:"guarantor,omitempty" json:"guarantor,omitempty"`
+	PartOf               *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type AccountCoverage struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Coverage          Reference   `bson:"coverage" json:"coverage"`
-	Priority          *int        `bson:"priority,omitempty" json:"priority,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Coverage          Reference                  `bson:"coverage" json:"coverage"`
+	Priority          *int                       `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement   *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the AccountCoverage with its unknown fields.
+func (r AccountCoverage) MarshalJSON() ([]byte, error) {
+	type other AccountCoverage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type AccountGuarantor struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Party             Reference   `bson:"party" json:"party"`
-	OnHold            *bool       `bson:"onHold,omitempty" json:"onHold,omitempty"`
-	Period            *Period     `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Party             Reference                  `bson:"party" json:"party"`
+	OnHold            *bool                      `bson:"onHold,omitempty" json:"onHold,omitempty"`
+	OnHoldElement     *Element                   `bson:"_onHold,omitempty" json:"_onHold,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the AccountGuarantor with its unknown fields.
+func (r AccountGuarantor) MarshalJSON() ([]byte, error) {
+	type other AccountGuarantor
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Account and fails with CodeError when a coded element has an unknown code.
+func (r *Account) UnmarshalJSON(b []byte) error {
+	type other Account
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Account", r)
 }
+
 type OtherAccount Account
 
 // MarshalJSON marshals the given Account as JSON into a byte slice
 func (r Account) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherAccount
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherAccount: OtherAccount(r),
 		ResourceType: "Account",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalAccount unmarshals a Account.
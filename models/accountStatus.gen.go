@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.221443 +0000 UTC
+// 2026-10-19 02:55:18.678087954 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AccountStatus is documented here http://hl7.org/fhir/ValueSet/account-status
-type AccountStatus int
+// The empty AccountStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AccountStatus string
 
 const (
-	AccountStatusActive AccountStatus = iota
-	AccountStatusInactive
-	AccountStatusEnteredInError
-	AccountStatusOnHold
-	AccountStatusUnknown
+	AccountStatusActive         AccountStatus = "active"
+	AccountStatusInactive       AccountStatus = "inactive"
+	AccountStatusEnteredInError AccountStatus = "entered-in-error"
+	AccountStatusOnHold         AccountStatus = "on-hold"
+	AccountStatusUnknown        AccountStatus = "unknown"
 )
 
 func (code AccountStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AccountStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AccountStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = AccountStatusActive
-	case "inactive":
-		*code = AccountStatusInactive
-	case "entered-in-error":
-		*code = AccountStatusEnteredInError
-	case "on-hold":
-		*code = AccountStatusOnHold
-	case "unknown":
-		*code = AccountStatusUnknown
-	default:
-		return fmt.Errorf("unknown AccountStatus code `%s`", s)
+func (code *AccountStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AccountStatus(s)
 	return nil
 }
 func (code AccountStatus) String() string {
 	return code.Code()
 }
 func (code AccountStatus) Code() string {
+	return string(code)
+}
+func (code AccountStatus) Known() bool {
 	switch code {
-	case AccountStatusActive:
-		return "active"
-	case AccountStatusInactive:
-		return "inactive"
-	case AccountStatusEnteredInError:
-		return "entered-in-error"
-	case AccountStatusOnHold:
-		return "on-hold"
-	case AccountStatusUnknown:
-		return "unknown"
+	case AccountStatusActive, AccountStatusInactive, AccountStatusEnteredInError, AccountStatusOnHold, AccountStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AccountStatus) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.068223 +0000 UTC
+// 2026-10-19 02:55:18.679206262 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionCardinalityBehavior is documented here http://hl7.org/fhir/ValueSet/action-cardinality-behavior
-type ActionCardinalityBehavior int
+// The empty ActionCardinalityBehavior is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionCardinalityBehavior string
 
 const (
-	ActionCardinalityBehaviorSingle ActionCardinalityBehavior = iota
-	ActionCardinalityBehaviorMultiple
+	ActionCardinalityBehaviorSingle   ActionCardinalityBehavior = "single"
+	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
 func (code ActionCardinalityBehavior) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionCardinalityBehavior"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionCardinalityBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "single":
-		*code = ActionCardinalityBehaviorSingle
-	case "multiple":
-		*code = ActionCardinalityBehaviorMultiple
-	default:
-		return fmt.Errorf("unknown ActionCardinalityBehavior code `%s`", s)
+func (code *ActionCardinalityBehavior) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ActionCardinalityBehavior(s)
 	return nil
 }
 func (code ActionCardinalityBehavior) String() string {
 	return code.Code()
 }
 func (code ActionCardinalityBehavior) Code() string {
+	return string(code)
+}
+func (code ActionCardinalityBehavior) Known() bool {
 	switch code {
-	case ActionCardinalityBehaviorSingle:
-		return "single"
-	case ActionCardinalityBehaviorMultiple:
-		return "multiple"
+	case ActionCardinalityBehaviorSingle, ActionCardinalityBehaviorMultiple:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionCardinalityBehavior) Display() string {
 	switch code {
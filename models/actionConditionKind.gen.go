@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.291253 +0000 UTC
+// 2026-10-19 02:55:18.680293865 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionConditionKind is documented here http://hl7.org/fhir/ValueSet/action-condition-kind
-type ActionConditionKind int
+// The empty ActionConditionKind is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionConditionKind string
 
 const (
-	ActionConditionKindApplicability ActionConditionKind = iota
-	ActionConditionKindStart
-	ActionConditionKindStop
+	ActionConditionKindApplicability ActionConditionKind = "applicability"
+	ActionConditionKindStart         ActionConditionKind = "start"
+	ActionConditionKindStop          ActionConditionKind = "stop"
 )
 
 func (code ActionConditionKind) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionConditionKind"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionConditionKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "applicability":
-		*code = ActionConditionKindApplicability
-	case "start":
-		*code = ActionConditionKindStart
-	case "stop":
-		*code = ActionConditionKindStop
-	default:
-		return fmt.Errorf("unknown ActionConditionKind code `%s`", s)
+func (code *ActionConditionKind) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ActionConditionKind(s)
 	return nil
 }
 func (code ActionConditionKind) String() string {
 	return code.Code()
 }
 func (code ActionConditionKind) Code() string {
+	return string(code)
+}
+func (code ActionConditionKind) Known() bool {
 	switch code {
-	case ActionConditionKindApplicability:
-		return "applicability"
-	case ActionConditionKindStart:
-		return "start"
-	case ActionConditionKindStop:
-		return "stop"
+	case ActionConditionKindApplicability, ActionConditionKindStart, ActionConditionKindStop:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionConditionKind) Display() string {
 	switch code {
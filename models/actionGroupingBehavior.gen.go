@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.031209 +0000 UTC
+// 2026-10-19 02:55:18.681680913 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionGroupingBehavior is documented here http://hl7.org/fhir/ValueSet/action-grouping-behavior
-type ActionGroupingBehavior int
+// The empty ActionGroupingBehavior is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionGroupingBehavior string
 
 const (
-	ActionGroupingBehaviorVisualGroup ActionGroupingBehavior = iota
-	ActionGroupingBehaviorLogicalGroup
-	ActionGroupingBehaviorSentenceGroup
+	ActionGroupingBehaviorVisualGroup   ActionGroupingBehavior = "visual-group"
+	ActionGroupingBehaviorLogicalGroup  ActionGroupingBehavior = "logical-group"
+	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
 func (code ActionGroupingBehavior) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionGroupingBehavior"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionGroupingBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "visual-group":
-		*code = ActionGroupingBehaviorVisualGroup
-	case "logical-group":
-		*code = ActionGroupingBehaviorLogicalGroup
-	case "sentence-group":
-		*code = ActionGroupingBehaviorSentenceGroup
-	default:
-		return fmt.Errorf("unknown ActionGroupingBehavior code `%s`", s)
+func (code *ActionGroupingBehavior) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ActionGroupingBehavior(s)
 	return nil
 }
 func (code ActionGroupingBehavior) String() string {
 	return code.Code()
 }
 func (code ActionGroupingBehavior) Code() string {
+	return string(code)
+}
+func (code ActionGroupingBehavior) Known() bool {
 	switch code {
-	case ActionGroupingBehaviorVisualGroup:
-		return "visual-group"
-	case ActionGroupingBehaviorLogicalGroup:
-		return "logical-group"
-	case ActionGroupingBehaviorSentenceGroup:
-		return "sentence-group"
+	case ActionGroupingBehaviorVisualGroup, ActionGroupingBehaviorLogicalGroup, ActionGroupingBehaviorSentenceGroup:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionGroupingBehavior) Display() string {
 	switch code {
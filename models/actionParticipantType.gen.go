@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.245387 +0000 UTC
+// 2026-10-19 02:55:18.683273614 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionParticipantType is documented here http://hl7.org/fhir/ValueSet/action-participant-type
-type ActionParticipantType int
+// The empty ActionParticipantType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionParticipantType string
 
 const (
-	ActionParticipantTypePatient ActionParticipantType = iota
-	ActionParticipantTypePractitioner
-	ActionParticipantTypeRelatedPerson
-	ActionParticipantTypeDevice
+	ActionParticipantTypePatient       ActionParticipantType = "patient"
+	ActionParticipantTypePractitioner  ActionParticipantType = "practitioner"
+	ActionParticipantTypeRelatedPerson ActionParticipantType = "related-person"
+	ActionParticipantTypeDevice        ActionParticipantType = "device"
 )
 
 func (code ActionParticipantType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionParticipantType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionParticipantType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "patient":
-		*code = ActionParticipantTypePatient
-	case "practitioner":
-		*code = ActionParticipantTypePractitioner
-	case "related-person":
-		*code = ActionParticipantTypeRelatedPerson
-	case "device":
-		*code = ActionParticipantTypeDevice
-	default:
-		return fmt.Errorf("unknown ActionParticipantType code `%s`", s)
+func (code *ActionParticipantType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ActionParticipantType(s)
 	return nil
 }
 func (code ActionParticipantType) String() string {
 	return code.Code()
 }
 func (code ActionParticipantType) Code() string {
+	return string(code)
+}
+func (code ActionParticipantType) Known() bool {
 	switch code {
-	case ActionParticipantTypePatient:
-		return "patient"
-	case ActionParticipantTypePractitioner:
-		return "practitioner"
-	case ActionParticipantTypeRelatedPerson:
-		return "related-person"
-	case ActionParticipantTypeDevice:
-		return "device"
+	case ActionParticipantTypePatient, ActionParticipantTypePractitioner, ActionParticipantTypeRelatedPerson, ActionParticipantTypeDevice:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionParticipantType) Display() string {
 	switch code {
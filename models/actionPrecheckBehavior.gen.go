@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.195663 +0000 UTC
+// 2026-10-19 02:55:18.684406941 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionPrecheckBehavior is documented here http://hl7.org/fhir/ValueSet/action-precheck-behavior
-type ActionPrecheckBehavior int
+// The empty ActionPrecheckBehavior is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionPrecheckBehavior string
 
 const (
-	ActionPrecheckBehaviorYes ActionPrecheckBehavior = iota
-	ActionPrecheckBehaviorNo
+	ActionPrecheckBehaviorYes ActionPrecheckBehavior = "yes"
+	ActionPrecheckBehaviorNo  ActionPrecheckBehavior = "no"
 )
 
 func (code ActionPrecheckBehavior) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionPrecheckBehavior"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionPrecheckBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "yes":
-		*code = ActionPrecheckBehaviorYes
-	case "no":
-		*code = ActionPrecheckBehaviorNo
-	default:
-		return fmt.Errorf("unknown ActionPrecheckBehavior code `%s`", s)
+func (code *ActionPrecheckBehavior) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ActionPrecheckBehavior(s)
 	return nil
 }
 func (code ActionPrecheckBehavior) String() string {
 	return code.Code()
 }
 func (code ActionPrecheckBehavior) Code() string {
+	return string(code)
+}
+func (code ActionPrecheckBehavior) Known() bool {
 	switch code {
-	case ActionPrecheckBehaviorYes:
-		return "yes"
-	case ActionPrecheckBehaviorNo:
-		return "no"
+	case ActionPrecheckBehaviorYes, ActionPrecheckBehaviorNo:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionPrecheckBehavior) Display() string {
 	switch code {
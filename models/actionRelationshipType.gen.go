@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.187244 +0000 UTC
+// 2026-10-19 02:55:18.685683134 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionRelationshipType is documented here http://hl7.org/fhir/ValueSet/action-relationship-type
-type ActionRelationshipType int
+// The empty ActionRelationshipType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionRelationshipType string
 
 const (
-	ActionRelationshipTypeBeforeStart ActionRelationshipType = iota
-	ActionRelationshipTypeBefore
-	ActionRelationshipTypeBeforeEnd
-	ActionRelationshipTypeConcurrentWithStart
-	ActionRelationshipTypeConcurrent
-	ActionRelationshipTypeConcurrentWithEnd
-	ActionRelationshipTypeAfterStart
-	ActionRelationshipTypeAfter
-	ActionRelationshipTypeAfterEnd
+	ActionRelationshipTypeBeforeStart         ActionRelationshipType = "before-start"
+	ActionRelationshipTypeBefore              ActionRelationshipType = "before"
+	ActionRelationshipTypeBeforeEnd           ActionRelationshipType = "before-end"
+	ActionRelationshipTypeConcurrentWithStart ActionRelationshipType = "concurrent-with-start"
+	ActionRelationshipTypeConcurrent          ActionRelationshipType = "concurrent"
+	ActionRelationshipTypeConcurrentWithEnd   ActionRelationshipType = "concurrent-with-end"
+	ActionRelationshipTypeAfterStart          ActionRelationshipType = "after-start"
+	ActionRelationshipTypeAfter               ActionRelationshipType = "after"
+	ActionRelationshipTypeAfterEnd            ActionRelationshipType = "after-end"
 )
 
 func (code ActionRelationshipType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionRelationshipType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionRelationshipType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "before-start":
-		*code = ActionRelationshipTypeBeforeStart
-	case "before":
-		*code = ActionRelationshipTypeBefore
-	case "before-end":
-		*code = ActionRelationshipTypeBeforeEnd
-	case "concurrent-with-start":
-		*code = ActionRelationshipTypeConcurrentWithStart
-	case "concurrent":
-		*code = ActionRelationshipTypeConcurrent
-	case "concurrent-with-end":
-		*code = ActionRelationshipTypeConcurrentWithEnd
-	case "after-start":
-		*code = ActionRelationshipTypeAfterStart
-	case "after":
-		*code = ActionRelationshipTypeAfter
-	case "after-end":
-		*code = ActionRelationshipTypeAfterEnd
-	default:
-		return fmt.Errorf("unknown ActionRelationshipType code `%s`", s)
+func (code *ActionRelationshipType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ActionRelationshipType(s)
 	return nil
 }
 func (code ActionRelationshipType) String() string {
 	return code.Code()
 }
 func (code ActionRelationshipType) Code() string {
+	return string(code)
+}
+func (code ActionRelationshipType) Known() bool {
 	switch code {
-	case ActionRelationshipTypeBeforeStart:
-		return "before-start"
-	case ActionRelationshipTypeBefore:
-		return "before"
-	case ActionRelationshipTypeBeforeEnd:
-		return "before-end"
-	case ActionRelationshipTypeConcurrentWithStart:
-		return "concurrent-with-start"
-	case ActionRelationshipTypeConcurrent:
-		return "concurrent"
-	case ActionRelationshipTypeConcurrentWithEnd:
-		return "concurrent-with-end"
-	case ActionRelationshipTypeAfterStart:
-		return "after-start"
-	case ActionRelationshipTypeAfter:
-		return "after"
-	case ActionRelationshipTypeAfterEnd:
-		return "after-end"
+	case ActionRelationshipTypeBeforeStart, ActionRelationshipTypeBefore, ActionRelationshipTypeBeforeEnd, ActionRelationshipTypeConcurrentWithStart, ActionRelationshipTypeConcurrent, ActionRelationshipTypeConcurrentWithEnd, ActionRelationshipTypeAfterStart, ActionRelationshipTypeAfter, ActionRelationshipTypeAfterEnd:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionRelationshipType) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.203966 +0000 UTC
+// 2026-10-19 02:55:18.687036471 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionRequiredBehavior is documented here http://hl7.org/fhir/ValueSet/action-required-behavior
-type ActionRequiredBehavior int
+// The empty ActionRequiredBehavior is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionRequiredBehavior string
 
 const (
-	ActionRequiredBehaviorMust ActionRequiredBehavior = iota
-	ActionRequiredBehaviorCould
-	ActionRequiredBehaviorMustUnlessDocumented
+	ActionRequiredBehaviorMust                 ActionRequiredBehavior = "must"
+	ActionRequiredBehaviorCould                ActionRequiredBehavior = "could"
+	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
 func (code ActionRequiredBehavior) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionRequiredBehavior"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionRequiredBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "must":
-		*code = ActionRequiredBehaviorMust
-	case "could":
-		*code = ActionRequiredBehaviorCould
-	case "must-unless-documented":
-		*code = ActionRequiredBehaviorMustUnlessDocumented
-	default:
-		return fmt.Errorf("unknown ActionRequiredBehavior code `%s`", s)
+func (code *ActionRequiredBehavior) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ActionRequiredBehavior(s)
 	return nil
 }
 func (code ActionRequiredBehavior) String() string {
 	return code.Code()
 }
 func (code ActionRequiredBehavior) Code() string {
+	return string(code)
+}
+func (code ActionRequiredBehavior) Known() bool {
 	switch code {
-	case ActionRequiredBehaviorMust:
-		return "must"
-	case ActionRequiredBehaviorCould:
-		return "could"
-	case ActionRequiredBehaviorMustUnlessDocumented:
-		return "must-unless-documented"
+	case ActionRequiredBehaviorMust, ActionRequiredBehaviorCould, ActionRequiredBehaviorMustUnlessDocumented:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionRequiredBehavior) Display() string {
 	switch code {
@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.228024 +0000 UTC
+// 2026-10-19 02:55:18.688120045 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ActionSelectionBehavior is documented here http://hl7.org/fhir/ValueSet/action-selection-behavior
-type ActionSelectionBehavior int
+// The empty ActionSelectionBehavior is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ActionSelectionBehavior string
 
 const (
-	ActionSelectionBehaviorAny ActionSelectionBehavior = iota
-	ActionSelectionBehaviorAll
-	ActionSelectionBehaviorAllOrNone
-	ActionSelectionBehaviorExactlyOne
-	ActionSelectionBehaviorAtMostOne
-	ActionSelectionBehaviorOneOrMore
+	ActionSelectionBehaviorAny        ActionSelectionBehavior = "any"
+	ActionSelectionBehaviorAll        ActionSelectionBehavior = "all"
+	ActionSelectionBehaviorAllOrNone  ActionSelectionBehavior = "all-or-none"
+	ActionSelectionBehaviorExactlyOne ActionSelectionBehavior = "exactly-one"
+	ActionSelectionBehaviorAtMostOne  ActionSelectionBehavior = "at-most-one"
+	ActionSelectionBehaviorOneOrMore  ActionSelectionBehavior = "one-or-more"
 )
 
 func (code ActionSelectionBehavior) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ActionSelectionBehavior"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ActionSelectionBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "any":
-		*code = ActionSelectionBehaviorAny
-	case "all":
-		*code = ActionSelectionBehaviorAll
-	case "all-or-none":
-		*code = ActionSelectionBehaviorAllOrNone
-	case "exactly-one":
-		*code = ActionSelectionBehaviorExactlyOne
-	case "at-most-one":
-		*code = ActionSelectionBehaviorAtMostOne
-	case "one-or-more":
-		*code = ActionSelectionBehaviorOneOrMore
-	default:
-		return fmt.Errorf("unknown ActionSelectionBehavior code `%s`", s)
+func (code *ActionSelectionBehavior) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ActionSelectionBehavior(s)
 	return nil
 }
 func (code ActionSelectionBehavior) String() string {
 	return code.Code()
 }
 func (code ActionSelectionBehavior) Code() string {
+	return string(code)
+}
+func (code ActionSelectionBehavior) Known() bool {
 	switch code {
-	case ActionSelectionBehaviorAny:
-		return "any"
-	case ActionSelectionBehaviorAll:
-		return "all"
-	case ActionSelectionBehaviorAllOrNone:
-		return "all-or-none"
-	case ActionSelectionBehaviorExactlyOne:
-		return "exactly-one"
-	case ActionSelectionBehaviorAtMostOne:
-		return "at-most-one"
-	case ActionSelectionBehaviorOneOrMore:
-		return "one-or-more"
+	case ActionSelectionBehaviorAny, ActionSelectionBehaviorAll, ActionSelectionBehaviorAllOrNone, ActionSelectionBehaviorExactlyOne, ActionSelectionBehaviorAtMostOne, ActionSelectionBehaviorOneOrMore:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ActionSelectionBehavior) Display() string {
 	switch code {
@@ -25,29 +25,49 @@ type ActivityDefinition struct {
 	ID                           *string                          `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                         *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules                *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                     *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                         *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources               `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                    []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension            []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	URL                          *string                          `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement                   *Element                         `bson:"_url,omitempty" json:"_url,omitempty"`
 	Identifier                   []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Version                      *string                          `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement               *Element                         `bson:"_version,omitempty" json:"_version,omitempty"`
 	Name                         *string                          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                  *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title                        *string                          `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement                 *Element                         `bson:"_title,omitempty" json:"_title,omitempty"`
 	Subtitle                     *string                          `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement              *Element                         `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
 	Status                       PublicationStatus                `bson:"status" json:"status"`
+	StatusElement                *Element                         `bson:"_status,omitempty" json:"_status,omitempty"`
 	Experimental                 *bool                            `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement          *Element                         `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectCodeableConcept       *CodeableConcept                 `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference             *Reference                       `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
 	Date                         *DateTime                        `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                  *Element                         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Publisher                    *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement             *Element                         `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
 	Contact                      []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
 	Description                  *string                          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                         `bson:"_description,omitempty" json:"_description,omitempty"`
 	UseContext                   []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
 	Jurisdiction                 []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
 	Purpose                      *string                          `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement               *Element                         `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
 	Usage                        *string                          `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement                 *Element                         `bson:"_usage,omitempty" json:"_usage,omitempty"`
 	Copyright                    *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement             *Element                         `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
 	ApprovalDate                 *DateTime                        `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement          *Element                         `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
 	LastReviewDate               *DateTime                        `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement        *Element                         `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
 	EffectivePeriod              *Period                          `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Topic                        []CodeableConcept                `bson:"topic,omitempty" json:"topic,omitempty"`
 	Author                       []ContactDetail                  `bson:"author,omitempty" json:"author,omitempty"`
@@ -55,15 +75,30 @@ type ActivityDefinition struct {
 	Reviewer                     []ContactDetail                  `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
 	Endorser                     []ContactDetail                  `bson:"endorser,omitempty" json:"endorser,omitempty"`
 	RelatedArtifact              []RelatedArtifact                `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Library                      []string                         `bson:"library,omitempty" json:"library,omitempty"`
+	Library                      []*string                        `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement               []*Element                       `bson:"_library,omitempty" json:"_library,omitempty"`
 	Kind                         *RequestResourceType             `bson:"kind,omitempty" json:"kind,omitempty"`
+	KindElement                  *Element                         `bson:"_kind,omitempty" json:"_kind,omitempty"`
 	Profile                      *string                          `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement               *Element                         `bson:"_profile,omitempty" json:"_profile,omitempty"`
 	Code                         *CodeableConcept                 `bson:"code,omitempty" json:"code,omitempty"`
 	Intent                       *RequestIntent                   `bson:"intent,omitempty" json:"intent,omitempty"`
+	IntentElement                *Element                         `bson:"_intent,omitempty" json:"_intent,omitempty"`
 	Priority                     *RequestPriority                 `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                         `bson:"_priority,omitempty" json:"_priority,omitempty"`
 	DoNotPerform                 *bool                            `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element                         `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	TimingTiming                 *Timing                          `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingDateTime               *DateTime                        `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement        *Element                         `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	TimingAge                    *Age                             `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
+	TimingPeriod                 *Period                          `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingRange                  *Range                           `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
+	TimingDuration               *Duration                        `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
 	Location                     *Reference                       `bson:"location,omitempty" json:"location,omitempty"`
 	Participant                  []ActivityDefinitionParticipant  `bson:"participant,omitempty" json:"participant,omitempty"`
+	ProductReference             *Reference                       `bson:"productReference,omitempty" json:"productReference,omitempty"`
+	ProductCodeableConcept       *CodeableConcept                 `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
 	Quantity                     *Quantity                        `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	Dosage                       []Dosage                         `bson:"dosage,omitempty" json:"dosage,omitempty"`
 	BodySite                     []CodeableConcept                `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
@@ -71,33 +106,220 @@ type ActivityDefinition struct {
 	ObservationRequirement       []Reference                      `bson:"observationRequirement,omitempty" json:"observationRequirement,omitempty"`
 	ObservationResultRequirement []Reference                      `bson:"observationResultRequirement,omitempty" json:"observationResultRequirement,omitempty"`
 	Transform                    *string                          `bson:"transform,omitempty" json:"transform,omitempty"`
+	TransformElement             *Element                         `bson:"_transform,omitempty" json:"_transform,omitempty"`
 	DynamicValue                 []ActivityDefinitionDynamicValue `bson:"dynamicValue,omitempty" json:"dynamicValue,omitempty"`
+	UnknownFields                map[string]json.RawMessage       `bson:"-" json:"-"`
 }
 type ActivityDefinitionParticipant struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              ActionParticipantType `bson:"type" json:"type"`
-	Role              *CodeableConcept      `bson:"role,omitempty" json:"role,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              ActionParticipantType      `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Role              *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ActivityDefinitionParticipant with its unknown fields.
+func (r ActivityDefinitionParticipant) MarshalJSON() ([]byte, error) {
+	type other ActivityDefinitionParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ActivityDefinitionDynamicValue struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Path              string      `bson:"path" json:"path"`
-	Expression        Expression  `bson:"expression" json:"expression"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Path              string                     `bson:"path" json:"path"`
+	PathElement       *Element                   `bson:"_path,omitempty" json:"_path,omitempty"`
+	Expression        Expression                 `bson:"expression" json:"expression"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ActivityDefinitionDynamicValue with its unknown fields.
+func (r ActivityDefinitionDynamicValue) MarshalJSON() ([]byte, error) {
+	type other ActivityDefinitionDynamicValue
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Subject returns ActivityDefinition.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ActivityDefinition) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets ActivityDefinition.subject[x] to the CodeableConcept and clears the other types.
+func (r *ActivityDefinition) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets ActivityDefinition.subject[x] to the Reference and clears the other types.
+func (r *ActivityDefinition) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *ActivityDefinition) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// Timing returns ActivityDefinition.timing[x] of the set type, such as *Timing, or nil when it is not set.
+func (r *ActivityDefinition) Timing() interface{} {
+	switch {
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	case r.TimingAge != nil:
+		return r.TimingAge
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	case r.TimingRange != nil:
+		return r.TimingRange
+	case r.TimingDuration != nil:
+		return r.TimingDuration
+	}
+	return nil
+}
+
+// SetTimingTiming sets ActivityDefinition.timing[x] to the Timing and clears the other types.
+func (r *ActivityDefinition) SetTimingTiming(v Timing) {
+	r.clearTiming()
+	r.TimingTiming = &v
+}
+
+// SetTimingDateTime sets ActivityDefinition.timing[x] to the dateTime and clears the other types.
+func (r *ActivityDefinition) SetTimingDateTime(v DateTime) {
+	r.clearTiming()
+	r.TimingDateTime = &v
+}
+
+// SetTimingAge sets ActivityDefinition.timing[x] to the Age and clears the other types.
+func (r *ActivityDefinition) SetTimingAge(v Age) {
+	r.clearTiming()
+	r.TimingAge = &v
 }
+
+// SetTimingPeriod sets ActivityDefinition.timing[x] to the Period and clears the other types.
+func (r *ActivityDefinition) SetTimingPeriod(v Period) {
+	r.clearTiming()
+	r.TimingPeriod = &v
+}
+
+// SetTimingRange sets ActivityDefinition.timing[x] to the Range and clears the other types.
+func (r *ActivityDefinition) SetTimingRange(v Range) {
+	r.clearTiming()
+	r.TimingRange = &v
+}
+
+// SetTimingDuration sets ActivityDefinition.timing[x] to the Duration and clears the other types.
+func (r *ActivityDefinition) SetTimingDuration(v Duration) {
+	r.clearTiming()
+	r.TimingDuration = &v
+}
+func (r *ActivityDefinition) clearTiming() {
+	r.TimingTiming = nil
+	r.TimingDateTime = nil
+	r.TimingDateTimeElement = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingRange = nil
+	r.TimingDuration = nil
+}
+
+// Product returns ActivityDefinition.product[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *ActivityDefinition) Product() interface{} {
+	switch {
+	case r.ProductReference != nil:
+		return r.ProductReference
+	case r.ProductCodeableConcept != nil:
+		return r.ProductCodeableConcept
+	}
+	return nil
+}
+
+// SetProductReference sets ActivityDefinition.product[x] to the Reference and clears the other types.
+func (r *ActivityDefinition) SetProductReference(v Reference) {
+	r.clearProduct()
+	r.ProductReference = &v
+}
+
+// SetProductCodeableConcept sets ActivityDefinition.product[x] to the CodeableConcept and clears the other types.
+func (r *ActivityDefinition) SetProductCodeableConcept(v CodeableConcept) {
+	r.clearProduct()
+	r.ProductCodeableConcept = &v
+}
+func (r *ActivityDefinition) clearProduct() {
+	r.ProductReference = nil
+	r.ProductCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the ActivityDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ActivityDefinition) UnmarshalJSON(b []byte) error {
+	type other ActivityDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ActivityDefinition.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ActivityDefinition.timing[x]",
+		r.TimingTiming != nil,
+		r.TimingDateTime != nil || r.TimingDateTimeElement != nil,
+		r.TimingAge != nil,
+		r.TimingPeriod != nil,
+		r.TimingRange != nil,
+		r.TimingDuration != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ActivityDefinition.product[x]",
+		r.ProductReference != nil,
+		r.ProductCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ActivityDefinition.library", len(r.Library), len(r.LibraryElement)); err != nil {
+		return err
+	}
+	return checkCodes("ActivityDefinition", r)
+}
+
 type OtherActivityDefinition ActivityDefinition
 
 // MarshalJSON marshals the given ActivityDefinition as JSON into a byte slice
 func (r ActivityDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherActivityDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherActivityDefinition: OtherActivityDefinition(r),
 		ResourceType:            "ActivityDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalActivityDefinition unmarshals a ActivityDefinition.
@@ -18,18 +18,41 @@
 
 package models
 
+import "encoding/json"
+
 // Address is documented here http://hl7.org/fhir/StructureDefinition/Address
 type Address struct {
-	ID         *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	Use        *AddressUse  `bson:"use,omitempty" json:"use,omitempty"`
-	Type       *AddressType `bson:"type,omitempty" json:"type,omitempty"`
-	Text       *string      `bson:"text,omitempty" json:"text,omitempty"`
-	Line       []string     `bson:"line,omitempty" json:"line,omitempty"`
-	City       *string      `bson:"city,omitempty" json:"city,omitempty"`
-	District   *string      `bson:"district,omitempty" json:"district,omitempty"`
-	State      *string      `bson:"state,omitempty" json:"state,omitempty"`
-	PostalCode *string      `bson:"postalCode,omitempty" json:"postalCode,omitempty"`
-	Country    *string      `bson:"country,omitempty" json:"country,omitempty"`
-	Period     *Period      `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	Use               *AddressUse  `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement        *Element     `bson:"_use,omitempty" json:"_use,omitempty"`
+	Type              *AddressType `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element     `bson:"_type,omitempty" json:"_type,omitempty"`
+	Text              *string      `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element     `bson:"_text,omitempty" json:"_text,omitempty"`
+	Line              []*string    `bson:"line,omitempty" json:"line,omitempty"`
+	LineElement       []*Element   `bson:"_line,omitempty" json:"_line,omitempty"`
+	City              *string      `bson:"city,omitempty" json:"city,omitempty"`
+	CityElement       *Element     `bson:"_city,omitempty" json:"_city,omitempty"`
+	District          *string      `bson:"district,omitempty" json:"district,omitempty"`
+	DistrictElement   *Element     `bson:"_district,omitempty" json:"_district,omitempty"`
+	State             *string      `bson:"state,omitempty" json:"state,omitempty"`
+	StateElement      *Element     `bson:"_state,omitempty" json:"_state,omitempty"`
+	PostalCode        *string      `bson:"postalCode,omitempty" json:"postalCode,omitempty"`
+	PostalCodeElement *Element     `bson:"_postalCode,omitempty" json:"_postalCode,omitempty"`
+	Country           *string      `bson:"country,omitempty" json:"country,omitempty"`
+	CountryElement    *Element     `bson:"_country,omitempty" json:"_country,omitempty"`
+	Period            *Period      `bson:"period,omitempty" json:"period,omitempty"`
+}
+
+// UnmarshalJSON unmarshals the Address and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *Address) UnmarshalJSON(b []byte) error {
+	type other Address
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Address.line", len(r.Line), len(r.LineElement)); err != nil {
+		return err
+	}
+	return nil
 }
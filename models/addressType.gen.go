@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.028166 +0000 UTC
+// 2026-10-19 02:55:18.689321871 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AddressType is documented here http://hl7.org/fhir/ValueSet/address-type
-type AddressType int
+// The empty AddressType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AddressType string
 
 const (
-	AddressTypePostal AddressType = iota
-	AddressTypePhysical
-	AddressTypeBoth
+	AddressTypePostal   AddressType = "postal"
+	AddressTypePhysical AddressType = "physical"
+	AddressTypeBoth     AddressType = "both"
 )
 
 func (code AddressType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AddressType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AddressType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "postal":
-		*code = AddressTypePostal
-	case "physical":
-		*code = AddressTypePhysical
-	case "both":
-		*code = AddressTypeBoth
-	default:
-		return fmt.Errorf("unknown AddressType code `%s`", s)
+func (code *AddressType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AddressType(s)
 	return nil
 }
 func (code AddressType) String() string {
 	return code.Code()
 }
 func (code AddressType) Code() string {
+	return string(code)
+}
+func (code AddressType) Known() bool {
 	switch code {
-	case AddressTypePostal:
-		return "postal"
-	case AddressTypePhysical:
-		return "physical"
-	case AddressTypeBoth:
-		return "both"
+	case AddressTypePostal, AddressTypePhysical, AddressTypeBoth:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AddressType) Display() string {
 	switch code {
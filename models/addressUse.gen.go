@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.056178 +0000 UTC
+// 2026-10-19 02:55:18.69039744 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AddressUse is documented here http://hl7.org/fhir/ValueSet/address-use
-type AddressUse int
+// The empty AddressUse is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AddressUse string
 
 const (
-	AddressUseHome AddressUse = iota
-	AddressUseWork
-	AddressUseTemp
-	AddressUseOld
-	AddressUseBilling
+	AddressUseHome    AddressUse = "home"
+	AddressUseWork    AddressUse = "work"
+	AddressUseTemp    AddressUse = "temp"
+	AddressUseOld     AddressUse = "old"
+	AddressUseBilling AddressUse = "billing"
 )
 
 func (code AddressUse) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AddressUse"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AddressUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "home":
-		*code = AddressUseHome
-	case "work":
-		*code = AddressUseWork
-	case "temp":
-		*code = AddressUseTemp
-	case "old":
-		*code = AddressUseOld
-	case "billing":
-		*code = AddressUseBilling
-	default:
-		return fmt.Errorf("unknown AddressUse code `%s`", s)
+func (code *AddressUse) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AddressUse(s)
 	return nil
 }
 func (code AddressUse) String() string {
 	return code.Code()
 }
 func (code AddressUse) Code() string {
+	return string(code)
+}
+func (code AddressUse) Known() bool {
 	switch code {
-	case AddressUseHome:
-		return "home"
-	case AddressUseWork:
-		return "work"
-	case AddressUseTemp:
-		return "temp"
-	case AddressUseOld:
-		return "old"
-	case AddressUseBilling:
-		return "billing"
+	case AddressUseHome, AddressUseWork, AddressUseTemp, AddressUseOld, AddressUseBilling:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AddressUse) Display() string {
 	switch code {
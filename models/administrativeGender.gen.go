@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.288745 +0000 UTC
+// 2026-10-19 02:55:18.691431377 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AdministrativeGender is documented here http://hl7.org/fhir/ValueSet/administrative-gender
-type AdministrativeGender int
+// The empty AdministrativeGender is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AdministrativeGender string
 
 const (
-	AdministrativeGenderMale AdministrativeGender = iota
-	AdministrativeGenderFemale
-	AdministrativeGenderOther
-	AdministrativeGenderUnknown
+	AdministrativeGenderMale    AdministrativeGender = "male"
+	AdministrativeGenderFemale  AdministrativeGender = "female"
+	AdministrativeGenderOther   AdministrativeGender = "other"
+	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
 func (code AdministrativeGender) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AdministrativeGender"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AdministrativeGender) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "male":
-		*code = AdministrativeGenderMale
-	case "female":
-		*code = AdministrativeGenderFemale
-	case "other":
-		*code = AdministrativeGenderOther
-	case "unknown":
-		*code = AdministrativeGenderUnknown
-	default:
-		return fmt.Errorf("unknown AdministrativeGender code `%s`", s)
+func (code *AdministrativeGender) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AdministrativeGender(s)
 	return nil
 }
 func (code AdministrativeGender) String() string {
 	return code.Code()
 }
 func (code AdministrativeGender) Code() string {
+	return string(code)
+}
+func (code AdministrativeGender) Known() bool {
 	switch code {
-	case AdministrativeGenderMale:
-		return "male"
-	case AdministrativeGenderFemale:
-		return "female"
-	case AdministrativeGenderOther:
-		return "other"
-	case AdministrativeGenderUnknown:
-		return "unknown"
+	case AdministrativeGenderMale, AdministrativeGenderFemale, AdministrativeGenderOther, AdministrativeGenderUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AdministrativeGender) Display() string {
 	switch code {
@@ -25,19 +25,26 @@ type AdverseEvent struct {
 	ID                    *string                     `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                  *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Actuality             AdverseEventActuality       `bson:"actuality" json:"actuality"`
+	ActualityElement      *Element                    `bson:"_actuality,omitempty" json:"_actuality,omitempty"`
 	Category              []CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
 	Event                 *CodeableConcept            `bson:"event,omitempty" json:"event,omitempty"`
 	Subject               Reference                   `bson:"subject" json:"subject"`
 	Encounter             *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
 	Date                  *DateTime                   `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                    `bson:"_date,omitempty" json:"_date,omitempty"`
 	Detected              *DateTime                   `bson:"detected,omitempty" json:"detected,omitempty"`
+	DetectedElement       *Element                    `bson:"_detected,omitempty" json:"_detected,omitempty"`
 	RecordedDate          *DateTime                   `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	RecordedDateElement   *Element                    `bson:"_recordedDate,omitempty" json:"_recordedDate,omitempty"`
 	ResultingCondition    []Reference                 `bson:"resultingCondition,omitempty" json:"resultingCondition,omitempty"`
 	Location              *Reference                  `bson:"location,omitempty" json:"location,omitempty"`
 	Seriousness           *CodeableConcept            `bson:"seriousness,omitempty" json:"seriousness,omitempty"`
@@ -49,6 +56,7 @@ type AdverseEvent struct {
 	SubjectMedicalHistory []Reference                 `bson:"subjectMedicalHistory,omitempty" json:"subjectMedicalHistory,omitempty"`
 	ReferenceDocument     []Reference                 `bson:"referenceDocument,omitempty" json:"referenceDocument,omitempty"`
 	Study                 []Reference                 `bson:"study,omitempty" json:"study,omitempty"`
+	UnknownFields         map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type AdverseEventSuspectEntity struct {
 	ID                *string                              `bson:"id,omitempty" json:"id,omitempty"`
@@ -56,27 +64,64 @@ type AdverseEventSuspectEntity struct {
 	ModifierExtension []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Instance          Reference                            `bson:"instance" json:"instance"`
 	Causality         []AdverseEventSuspectEntityCausality `bson:"causality,omitempty" json:"causality,omitempty"`
+	UnknownFields     map[string]json.RawMessage           `bson:"-" json:"-"`
 }
 type AdverseEventSuspectEntityCausality struct {
-	ID                 *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Assessment         *CodeableConcept `bson:"assessment,omitempty" json:"assessment,omitempty"`
-	ProductRelatedness *string          `bson:"productRelatedness,omitempty" json:"productRelatedness,omitempty"`
-	Author             *Reference       `bson:"author,omitempty" json:"author,omitempty"`
-	Method             *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Assessment                *CodeableConcept           `bson:"assessment,omitempty" json:"assessment,omitempty"`
+	ProductRelatedness        *string                    `bson:"productRelatedness,omitempty" json:"productRelatedness,omitempty"`
+	ProductRelatednessElement *Element                   `bson:"_productRelatedness,omitempty" json:"_productRelatedness,omitempty"`
+	Author                    *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	Method                    *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the AdverseEventSuspectEntityCausality with its unknown fields.
+func (r AdverseEventSuspectEntityCausality) MarshalJSON() ([]byte, error) {
+	type other AdverseEventSuspectEntityCausality
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the AdverseEventSuspectEntity with its unknown fields.
+func (r AdverseEventSuspectEntity) MarshalJSON() ([]byte, error) {
+	type other AdverseEventSuspectEntity
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the AdverseEvent and fails with CodeError when a coded element has an unknown code.
+func (r *AdverseEvent) UnmarshalJSON(b []byte) error {
+	type other AdverseEvent
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("AdverseEvent", r)
+}
+
 type OtherAdverseEvent AdverseEvent
 
 // MarshalJSON marshals the given AdverseEvent as JSON into a byte slice
 func (r AdverseEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherAdverseEvent
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherAdverseEvent: OtherAdverseEvent(r),
 		ResourceType:      "AdverseEvent",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalAdverseEvent unmarshals a AdverseEvent.
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.132179 +0000 UTC
+// 2026-10-19 02:55:18.692645158 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AdverseEventActuality is documented here http://hl7.org/fhir/ValueSet/adverse-event-actuality
-type AdverseEventActuality int
+// The empty AdverseEventActuality is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AdverseEventActuality string
 
 const (
-	AdverseEventActualityActual AdverseEventActuality = iota
-	AdverseEventActualityPotential
+	AdverseEventActualityActual    AdverseEventActuality = "actual"
+	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
 func (code AdverseEventActuality) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AdverseEventActuality"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AdverseEventActuality) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "actual":
-		*code = AdverseEventActualityActual
-	case "potential":
-		*code = AdverseEventActualityPotential
-	default:
-		return fmt.Errorf("unknown AdverseEventActuality code `%s`", s)
+func (code *AdverseEventActuality) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AdverseEventActuality(s)
 	return nil
 }
 func (code AdverseEventActuality) String() string {
 	return code.Code()
 }
 func (code AdverseEventActuality) Code() string {
+	return string(code)
+}
+func (code AdverseEventActuality) Known() bool {
 	switch code {
-	case AdverseEventActualityActual:
-		return "actual"
-	case AdverseEventActualityPotential:
-		return "potential"
+	case AdverseEventActualityActual, AdverseEventActualityPotential:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AdverseEventActuality) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.294191 +0000 UTC
+// 2026-10-19 02:55:18.693623226 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AggregationMode is documented here http://hl7.org/fhir/ValueSet/resource-aggregation-mode
-type AggregationMode int
+// The empty AggregationMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AggregationMode string
 
 const (
-	AggregationModeContained AggregationMode = iota
-	AggregationModeReferenced
-	AggregationModeBundled
+	AggregationModeContained  AggregationMode = "contained"
+	AggregationModeReferenced AggregationMode = "referenced"
+	AggregationModeBundled    AggregationMode = "bundled"
 )
 
 func (code AggregationMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AggregationMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AggregationMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "contained":
-		*code = AggregationModeContained
-	case "referenced":
-		*code = AggregationModeReferenced
-	case "bundled":
-		*code = AggregationModeBundled
-	default:
-		return fmt.Errorf("unknown AggregationMode code `%s`", s)
+func (code *AggregationMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AggregationMode(s)
 	return nil
 }
 func (code AggregationMode) String() string {
 	return code.Code()
 }
 func (code AggregationMode) Code() string {
+	return string(code)
+}
+func (code AggregationMode) Known() bool {
 	switch code {
-	case AggregationModeContained:
-		return "contained"
-	case AggregationModeReferenced:
-		return "referenced"
-	case AggregationModeBundled:
-		return "bundled"
+	case AggregationModeContained, AggregationModeReferenced, AggregationModeBundled:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AggregationMode) Display() string {
 	switch code {
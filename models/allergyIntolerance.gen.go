@@ -22,52 +22,165 @@ import "encoding/json"
 
 // AllergyIntolerance is documented here http://hl7.org/fhir/StructureDefinition/AllergyIntolerance
 type AllergyIntolerance struct {
-	ID                 *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ClinicalStatus     *CodeableConcept               `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
-	VerificationStatus *CodeableConcept               `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
-	Type               *AllergyIntoleranceType        `bson:"type,omitempty" json:"type,omitempty"`
-	Category           []AllergyIntoleranceCategory   `bson:"category,omitempty" json:"category,omitempty"`
-	Criticality        *AllergyIntoleranceCriticality `bson:"criticality,omitempty" json:"criticality,omitempty"`
-	Code               *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
-	Patient            Reference                      `bson:"patient" json:"patient"`
-	Encounter          *Reference                     `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	RecordedDate       *DateTime                      `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
-	Recorder           *Reference                     `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Asserter           *Reference                     `bson:"asserter,omitempty" json:"asserter,omitempty"`
-	LastOccurrence     *DateTime                      `bson:"lastOccurrence,omitempty" json:"lastOccurrence,omitempty"`
-	Note               []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
-	Reaction           []AllergyIntoleranceReaction   `bson:"reaction,omitempty" json:"reaction,omitempty"`
+	ID                    *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier            []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ClinicalStatus        *CodeableConcept               `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
+	VerificationStatus    *CodeableConcept               `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
+	Type                  *AllergyIntoleranceType        `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement           *Element                       `bson:"_type,omitempty" json:"_type,omitempty"`
+	Category              []*AllergyIntoleranceCategory  `bson:"category,omitempty" json:"category,omitempty"`
+	CategoryElement       []*Element                     `bson:"_category,omitempty" json:"_category,omitempty"`
+	Criticality           *AllergyIntoleranceCriticality `bson:"criticality,omitempty" json:"criticality,omitempty"`
+	CriticalityElement    *Element                       `bson:"_criticality,omitempty" json:"_criticality,omitempty"`
+	Code                  *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
+	Patient               Reference                      `bson:"patient" json:"patient"`
+	Encounter             *Reference                     `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OnsetDateTime         *DateTime                      `bson:"onsetDateTime,omitempty" json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement  *Element                       `bson:"_onsetDateTime,omitempty" json:"_onsetDateTime,omitempty"`
+	OnsetAge              *Age                           `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
+	OnsetPeriod           *Period                        `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
+	OnsetRange            *Range                         `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
+	OnsetString           *string                        `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
+	OnsetStringElement    *Element                       `bson:"_onsetString,omitempty" json:"_onsetString,omitempty"`
+	RecordedDate          *DateTime                      `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	RecordedDateElement   *Element                       `bson:"_recordedDate,omitempty" json:"_recordedDate,omitempty"`
+	Recorder              *Reference                     `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Asserter              *Reference                     `bson:"asserter,omitempty" json:"asserter,omitempty"`
+	LastOccurrence        *DateTime                      `bson:"lastOccurrence,omitempty" json:"lastOccurrence,omitempty"`
+	LastOccurrenceElement *Element                       `bson:"_lastOccurrence,omitempty" json:"_lastOccurrence,omitempty"`
+	Note                  []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
+	Reaction              []AllergyIntoleranceReaction   `bson:"reaction,omitempty" json:"reaction,omitempty"`
+	UnknownFields         map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type AllergyIntoleranceReaction struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Substance         *CodeableConcept            `bson:"substance,omitempty" json:"substance,omitempty"`
-	Manifestation     []CodeableConcept           `bson:"manifestation" json:"manifestation"`
-	Description       *string                     `bson:"description,omitempty" json:"description,omitempty"`
-	Onset             *DateTime                   `bson:"onset,omitempty" json:"onset,omitempty"`
-	Severity          *AllergyIntoleranceSeverity `bson:"severity,omitempty" json:"severity,omitempty"`
-	ExposureRoute     *CodeableConcept            `bson:"exposureRoute,omitempty" json:"exposureRoute,omitempty"`
-	Note              []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+	ID                 *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Substance          *CodeableConcept            `bson:"substance,omitempty" json:"substance,omitempty"`
+	Manifestation      []CodeableConcept           `bson:"manifestation" json:"manifestation"`
+	Description        *string                     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Onset              *DateTime                   `bson:"onset,omitempty" json:"onset,omitempty"`
+	OnsetElement       *Element                    `bson:"_onset,omitempty" json:"_onset,omitempty"`
+	Severity           *AllergyIntoleranceSeverity `bson:"severity,omitempty" json:"severity,omitempty"`
+	SeverityElement    *Element                    `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	ExposureRoute      *CodeableConcept            `bson:"exposureRoute,omitempty" json:"exposureRoute,omitempty"`
+	Note               []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields      map[string]json.RawMessage  `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the AllergyIntoleranceReaction with its unknown fields.
+func (r AllergyIntoleranceReaction) MarshalJSON() ([]byte, error) {
+	type other AllergyIntoleranceReaction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Onset returns AllergyIntolerance.onset[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *AllergyIntolerance) Onset() interface{} {
+	switch {
+	case r.OnsetDateTime != nil:
+		return r.OnsetDateTime
+	case r.OnsetAge != nil:
+		return r.OnsetAge
+	case r.OnsetPeriod != nil:
+		return r.OnsetPeriod
+	case r.OnsetRange != nil:
+		return r.OnsetRange
+	case r.OnsetString != nil:
+		return r.OnsetString
+	}
+	return nil
+}
+
+// SetOnsetDateTime sets AllergyIntolerance.onset[x] to the dateTime and clears the other types.
+func (r *AllergyIntolerance) SetOnsetDateTime(v DateTime) {
+	r.clearOnset()
+	r.OnsetDateTime = &v
+}
+
+// SetOnsetAge sets AllergyIntolerance.onset[x] to the Age and clears the other types.
+func (r *AllergyIntolerance) SetOnsetAge(v Age) {
+	r.clearOnset()
+	r.OnsetAge = &v
+}
+
+// SetOnsetPeriod sets AllergyIntolerance.onset[x] to the Period and clears the other types.
+func (r *AllergyIntolerance) SetOnsetPeriod(v Period) {
+	r.clearOnset()
+	r.OnsetPeriod = &v
+}
+
+// SetOnsetRange sets AllergyIntolerance.onset[x] to the Range and clears the other types.
+func (r *AllergyIntolerance) SetOnsetRange(v Range) {
+	r.clearOnset()
+	r.OnsetRange = &v
+}
+
+// SetOnsetString sets AllergyIntolerance.onset[x] to the string and clears the other types.
+func (r *AllergyIntolerance) SetOnsetString(v string) {
+	r.clearOnset()
+	r.OnsetString = &v
+}
+func (r *AllergyIntolerance) clearOnset() {
+	r.OnsetDateTime = nil
+	r.OnsetDateTimeElement = nil
+	r.OnsetAge = nil
+	r.OnsetPeriod = nil
+	r.OnsetRange = nil
+	r.OnsetString = nil
+	r.OnsetStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the AllergyIntolerance and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *AllergyIntolerance) UnmarshalJSON(b []byte) error {
+	type other AllergyIntolerance
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"AllergyIntolerance.onset[x]",
+		r.OnsetDateTime != nil || r.OnsetDateTimeElement != nil,
+		r.OnsetAge != nil,
+		r.OnsetPeriod != nil,
+		r.OnsetRange != nil,
+		r.OnsetString != nil || r.OnsetStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("AllergyIntolerance.category", len(r.Category), len(r.CategoryElement)); err != nil {
+		return err
+	}
+	return checkCodes("AllergyIntolerance", r)
+}
+
 type OtherAllergyIntolerance AllergyIntolerance
 
 // MarshalJSON marshals the given AllergyIntolerance as JSON into a byte slice
 func (r AllergyIntolerance) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherAllergyIntolerance
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherAllergyIntolerance: OtherAllergyIntolerance(r),
 		ResourceType:            "AllergyIntolerance",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalAllergyIntolerance unmarshals a AllergyIntolerance.
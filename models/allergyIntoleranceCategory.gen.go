@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.102991 +0000 UTC
+// 2026-10-19 02:55:18.694580171 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AllergyIntoleranceCategory is documented here http://hl7.org/fhir/ValueSet/allergy-intolerance-category
-type AllergyIntoleranceCategory int
+// The empty AllergyIntoleranceCategory is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AllergyIntoleranceCategory string
 
 const (
-	AllergyIntoleranceCategoryFood AllergyIntoleranceCategory = iota
-	AllergyIntoleranceCategoryMedication
-	AllergyIntoleranceCategoryEnvironment
-	AllergyIntoleranceCategoryBiologic
+	AllergyIntoleranceCategoryFood        AllergyIntoleranceCategory = "food"
+	AllergyIntoleranceCategoryMedication  AllergyIntoleranceCategory = "medication"
+	AllergyIntoleranceCategoryEnvironment AllergyIntoleranceCategory = "environment"
+	AllergyIntoleranceCategoryBiologic    AllergyIntoleranceCategory = "biologic"
 )
 
 func (code AllergyIntoleranceCategory) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AllergyIntoleranceCategory"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "food":
-		*code = AllergyIntoleranceCategoryFood
-	case "medication":
-		*code = AllergyIntoleranceCategoryMedication
-	case "environment":
-		*code = AllergyIntoleranceCategoryEnvironment
-	case "biologic":
-		*code = AllergyIntoleranceCategoryBiologic
-	default:
-		return fmt.Errorf("unknown AllergyIntoleranceCategory code `%s`", s)
+func (code *AllergyIntoleranceCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AllergyIntoleranceCategory(s)
 	return nil
 }
 func (code AllergyIntoleranceCategory) String() string {
 	return code.Code()
 }
 func (code AllergyIntoleranceCategory) Code() string {
+	return string(code)
+}
+func (code AllergyIntoleranceCategory) Known() bool {
 	switch code {
-	case AllergyIntoleranceCategoryFood:
-		return "food"
-	case AllergyIntoleranceCategoryMedication:
-		return "medication"
-	case AllergyIntoleranceCategoryEnvironment:
-		return "environment"
-	case AllergyIntoleranceCategoryBiologic:
-		return "biologic"
+	case AllergyIntoleranceCategoryFood, AllergyIntoleranceCategoryMedication, AllergyIntoleranceCategoryEnvironment, AllergyIntoleranceCategoryBiologic:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AllergyIntoleranceCategory) Display() string {
 	switch code {
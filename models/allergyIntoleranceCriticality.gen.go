@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.298513 +0000 UTC
+// 2026-10-19 02:55:18.695741428 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AllergyIntoleranceCriticality is documented here http://hl7.org/fhir/ValueSet/allergy-intolerance-criticality
-type AllergyIntoleranceCriticality int
+// The empty AllergyIntoleranceCriticality is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AllergyIntoleranceCriticality string
 
 const (
-	AllergyIntoleranceCriticalityLow AllergyIntoleranceCriticality = iota
-	AllergyIntoleranceCriticalityHigh
-	AllergyIntoleranceCriticalityUnableToAssess
+	AllergyIntoleranceCriticalityLow            AllergyIntoleranceCriticality = "low"
+	AllergyIntoleranceCriticalityHigh           AllergyIntoleranceCriticality = "high"
+	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
 func (code AllergyIntoleranceCriticality) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AllergyIntoleranceCriticality"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceCriticality) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "low":
-		*code = AllergyIntoleranceCriticalityLow
-	case "high":
-		*code = AllergyIntoleranceCriticalityHigh
-	case "unable-to-assess":
-		*code = AllergyIntoleranceCriticalityUnableToAssess
-	default:
-		return fmt.Errorf("unknown AllergyIntoleranceCriticality code `%s`", s)
+func (code *AllergyIntoleranceCriticality) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AllergyIntoleranceCriticality(s)
 	return nil
 }
 func (code AllergyIntoleranceCriticality) String() string {
 	return code.Code()
 }
 func (code AllergyIntoleranceCriticality) Code() string {
+	return string(code)
+}
+func (code AllergyIntoleranceCriticality) Known() bool {
 	switch code {
-	case AllergyIntoleranceCriticalityLow:
-		return "low"
-	case AllergyIntoleranceCriticalityHigh:
-		return "high"
-	case AllergyIntoleranceCriticalityUnableToAssess:
-		return "unable-to-assess"
+	case AllergyIntoleranceCriticalityLow, AllergyIntoleranceCriticalityHigh, AllergyIntoleranceCriticalityUnableToAssess:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AllergyIntoleranceCriticality) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.28367 +0000 UTC
+// 2026-10-19 02:55:18.69666923 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AllergyIntoleranceSeverity is documented here http://hl7.org/fhir/ValueSet/reaction-event-severity
-type AllergyIntoleranceSeverity int
+// The empty AllergyIntoleranceSeverity is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AllergyIntoleranceSeverity string
 
 const (
-	AllergyIntoleranceSeverityMild AllergyIntoleranceSeverity = iota
-	AllergyIntoleranceSeverityModerate
-	AllergyIntoleranceSeveritySevere
+	AllergyIntoleranceSeverityMild     AllergyIntoleranceSeverity = "mild"
+	AllergyIntoleranceSeverityModerate AllergyIntoleranceSeverity = "moderate"
+	AllergyIntoleranceSeveritySevere   AllergyIntoleranceSeverity = "severe"
 )
 
 func (code AllergyIntoleranceSeverity) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AllergyIntoleranceSeverity"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "mild":
-		*code = AllergyIntoleranceSeverityMild
-	case "moderate":
-		*code = AllergyIntoleranceSeverityModerate
-	case "severe":
-		*code = AllergyIntoleranceSeveritySevere
-	default:
-		return fmt.Errorf("unknown AllergyIntoleranceSeverity code `%s`", s)
+func (code *AllergyIntoleranceSeverity) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AllergyIntoleranceSeverity(s)
 	return nil
 }
 func (code AllergyIntoleranceSeverity) String() string {
 	return code.Code()
 }
 func (code AllergyIntoleranceSeverity) Code() string {
+	return string(code)
+}
+func (code AllergyIntoleranceSeverity) Known() bool {
 	switch code {
-	case AllergyIntoleranceSeverityMild:
-		return "mild"
-	case AllergyIntoleranceSeverityModerate:
-		return "moderate"
-	case AllergyIntoleranceSeveritySevere:
-		return "severe"
+	case AllergyIntoleranceSeverityMild, AllergyIntoleranceSeverityModerate, AllergyIntoleranceSeveritySevere:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AllergyIntoleranceSeverity) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:02.992767 +0000 UTC
+// 2026-10-19 02:55:18.697782066 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AllergyIntoleranceType is documented here http://hl7.org/fhir/ValueSet/allergy-intolerance-type
-type AllergyIntoleranceType int
+// The empty AllergyIntoleranceType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AllergyIntoleranceType string
 
 const (
-	AllergyIntoleranceTypeAllergy AllergyIntoleranceType = iota
-	AllergyIntoleranceTypeIntolerance
+	AllergyIntoleranceTypeAllergy     AllergyIntoleranceType = "allergy"
+	AllergyIntoleranceTypeIntolerance AllergyIntoleranceType = "intolerance"
 )
 
 func (code AllergyIntoleranceType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AllergyIntoleranceType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "allergy":
-		*code = AllergyIntoleranceTypeAllergy
-	case "intolerance":
-		*code = AllergyIntoleranceTypeIntolerance
-	default:
-		return fmt.Errorf("unknown AllergyIntoleranceType code `%s`", s)
+func (code *AllergyIntoleranceType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AllergyIntoleranceType(s)
 	return nil
 }
 func (code AllergyIntoleranceType) String() string {
 	return code.Code()
 }
 func (code AllergyIntoleranceType) Code() string {
+	return string(code)
+}
+func (code AllergyIntoleranceType) Known() bool {
 	switch code {
-	case AllergyIntoleranceTypeAllergy:
-		return "allergy"
-	case AllergyIntoleranceTypeIntolerance:
-		return "intolerance"
+	case AllergyIntoleranceTypeAllergy, AllergyIntoleranceTypeIntolerance:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AllergyIntoleranceType) Display() string {
 	switch code {
@@ -18,10 +18,61 @@
 
 package models
 
+import "encoding/json"
+
 // Annotation is documented here http://hl7.org/fhir/StructureDefinition/Annotation
 type Annotation struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Time      *DateTime   `bson:"time,omitempty" json:"time,omitempty"`
-	Text      string      `bson:"text" json:"text"`
+	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	AuthorReference     *Reference  `bson:"authorReference,omitempty" json:"authorReference,omitempty"`
+	AuthorString        *string     `bson:"authorString,omitempty" json:"authorString,omitempty"`
+	AuthorStringElement *Element    `bson:"_authorString,omitempty" json:"_authorString,omitempty"`
+	Time                *DateTime   `bson:"time,omitempty" json:"time,omitempty"`
+	TimeElement         *Element    `bson:"_time,omitempty" json:"_time,omitempty"`
+	Text                string      `bson:"text" json:"text"`
+	TextElement         *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
+}
+
+// Author returns Annotation.author[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *Annotation) Author() interface{} {
+	switch {
+	case r.AuthorReference != nil:
+		return r.AuthorReference
+	case r.AuthorString != nil:
+		return r.AuthorString
+	}
+	return nil
+}
+
+// SetAuthorReference sets Annotation.author[x] to the Reference and clears the other types.
+func (r *Annotation) SetAuthorReference(v Reference) {
+	r.clearAuthor()
+	r.AuthorReference = &v
+}
+
+// SetAuthorString sets Annotation.author[x] to the string and clears the other types.
+func (r *Annotation) SetAuthorString(v string) {
+	r.clearAuthor()
+	r.AuthorString = &v
+}
+func (r *Annotation) clearAuthor() {
+	r.AuthorReference = nil
+	r.AuthorString = nil
+	r.AuthorStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the Annotation and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *Annotation) UnmarshalJSON(b []byte) error {
+	type other Annotation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Annotation.author[x]",
+		r.AuthorReference != nil,
+		r.AuthorString != nil || r.AuthorStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
@@ -22,57 +22,97 @@ import "encoding/json"
 
 // Appointment is documented here http://hl7.org/fhir/StructureDefinition/Appointment
 type Appointment struct {
-	ID                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                AppointmentStatus        `bson:"status" json:"status"`
-	CancelationReason     *CodeableConcept         `bson:"cancelationReason,omitempty" json:"cancelationReason,omitempty"`
-	ServiceCategory       []CodeableConcept        `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
-	ServiceType           []CodeableConcept        `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
-	Specialty             []CodeableConcept        `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	AppointmentType       *CodeableConcept         `bson:"appointmentType,omitempty" json:"appointmentType,omitempty"`
-	ReasonCode            []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Priority              *int                     `bson:"priority,omitempty" json:"priority,omitempty"`
-	Description           *string                  `bson:"description,omitempty" json:"description,omitempty"`
-	SupportingInformation []Reference              `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	Start                 *string                  `bson:"start,omitempty" json:"start,omitempty"`
-	End                   *string                  `bson:"end,omitempty" json:"end,omitempty"`
-	MinutesDuration       *int                     `bson:"minutesDuration,omitempty" json:"minutesDuration,omitempty"`
-	Slot                  []Reference              `bson:"slot,omitempty" json:"slot,omitempty"`
-	Created               *DateTime                `bson:"created,omitempty" json:"created,omitempty"`
-	Comment               *string                  `bson:"comment,omitempty" json:"comment,omitempty"`
-	PatientInstruction    *string                  `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
-	BasedOn               []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Participant           []AppointmentParticipant `bson:"participant" json:"participant"`
-	RequestedPeriod       []Period                 `bson:"requestedPeriod,omitempty" json:"requestedPeriod,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    AppointmentStatus          `bson:"status" json:"status"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	CancelationReason         *CodeableConcept           `bson:"cancelationReason,omitempty" json:"cancelationReason,omitempty"`
+	ServiceCategory           []CodeableConcept          `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
+	ServiceType               []CodeableConcept          `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
+	Specialty                 []CodeableConcept          `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	AppointmentType           *CodeableConcept           `bson:"appointmentType,omitempty" json:"appointmentType,omitempty"`
+	ReasonCode                []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Priority                  *int                       `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement           *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Description               *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement        *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	SupportingInformation     []Reference                `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	Start                     *Instant                   `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement              *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                       *Instant                   `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement                *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	MinutesDuration           *int                       `bson:"minutesDuration,omitempty" json:"minutesDuration,omitempty"`
+	MinutesDurationElement    *Element                   `bson:"_minutesDuration,omitempty" json:"_minutesDuration,omitempty"`
+	Slot                      []Reference                `bson:"slot,omitempty" json:"slot,omitempty"`
+	Created                   *DateTime                  `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement            *Element                   `bson:"_created,omitempty" json:"_created,omitempty"`
+	Comment                   *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement            *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	PatientInstruction        *string                    `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
+	PatientInstructionElement *Element                   `bson:"_patientInstruction,omitempty" json:"_patientInstruction,omitempty"`
+	BasedOn                   []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Participant               []AppointmentParticipant   `bson:"participant" json:"participant"`
+	RequestedPeriod           []Period                   `bson:"requestedPeriod,omitempty" json:"requestedPeriod,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type AppointmentParticipant struct {
-	ID                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              []CodeableConcept    `bson:"type,omitempty" json:"type,omitempty"`
-	Actor             *Reference           `bson:"actor,omitempty" json:"actor,omitempty"`
-	Required          *ParticipantRequired `bson:"required,omitempty" json:"required,omitempty"`
-	Status            ParticipationStatus  `bson:"status" json:"status"`
-	Period            *Period              `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Actor             *Reference                 `bson:"actor,omitempty" json:"actor,omitempty"`
+	Required          *ParticipantRequired       `bson:"required,omitempty" json:"required,omitempty"`
+	RequiredElement   *Element                   `bson:"_required,omitempty" json:"_required,omitempty"`
+	Status            ParticipationStatus        `bson:"status" json:"status"`
+	StatusElement     *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the AppointmentParticipant with its unknown fields.
+func (r AppointmentParticipant) MarshalJSON() ([]byte, error) {
+	type other AppointmentParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Appointment and fails with CodeError when a coded element has an unknown code.
+func (r *Appointment) UnmarshalJSON(b []byte) error {
+	type other Appointment
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Appointment", r)
+}
+
 type OtherAppointment Appointment
 
 // MarshalJSON marshals the given Appointment as JSON into a byte slice
 func (r Appointment) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherAppointment
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherAppointment: OtherAppointment(r),
 		ResourceType:     "Appointment",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalAppointment unmarshals a Appointment.
@@ -22,33 +22,55 @@ import "encoding/json"
 
 // AppointmentResponse is documented here http://hl7.org/fhir/StructureDefinition/AppointmentResponse
 type AppointmentResponse struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Appointment       Reference           `bson:"appointment" json:"appointment"`
-	Start             *string             `bson:"start,omitempty" json:"start,omitempty"`
-	End               *string             `bson:"end,omitempty" json:"end,omitempty"`
-	ParticipantType   []CodeableConcept   `bson:"participantType,omitempty" json:"participantType,omitempty"`
-	Actor             *Reference          `bson:"actor,omitempty" json:"actor,omitempty"`
-	ParticipantStatus ParticipationStatus `bson:"participantStatus" json:"participantStatus"`
-	Comment           *string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Appointment              Reference                  `bson:"appointment" json:"appointment"`
+	Start                    *Instant                   `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement             *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                      *Instant                   `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement               *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	ParticipantType          []CodeableConcept          `bson:"participantType,omitempty" json:"participantType,omitempty"`
+	Actor                    *Reference                 `bson:"actor,omitempty" json:"actor,omitempty"`
+	ParticipantStatus        ParticipationStatus        `bson:"participantStatus" json:"participantStatus"`
+	ParticipantStatusElement *Element                   `bson:"_participantStatus,omitempty" json:"_participantStatus,omitempty"`
+	Comment                  *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement           *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the AppointmentResponse and fails with CodeError when a coded element has an unknown code.
+func (r *AppointmentResponse) UnmarshalJSON(b []byte) error {
+	type other AppointmentResponse
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("AppointmentResponse", r)
+}
+
 type OtherAppointmentResponse AppointmentResponse
 
 // MarshalJSON marshals the given AppointmentResponse as JSON into a byte slice
 func (r AppointmentResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherAppointmentResponse
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherAppointmentResponse: OtherAppointmentResponse(r),
 		ResourceType:             "AppointmentResponse",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalAppointmentResponse unmarshals a AppointmentResponse.
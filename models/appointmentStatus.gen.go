@@ -14,90 +14,59 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.26939 +0000 UTC
+// 2026-10-19 02:55:18.699222032 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AppointmentStatus is documented here http://hl7.org/fhir/ValueSet/appointmentstatus
-type AppointmentStatus int
+// The empty AppointmentStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AppointmentStatus string
 
 const (
-	AppointmentStatusProposed AppointmentStatus = iota
-	AppointmentStatusPending
-	AppointmentStatusBooked
-	AppointmentStatusArrived
-	AppointmentStatusFulfilled
-	AppointmentStatusCancelled
-	AppointmentStatusNoshow
-	AppointmentStatusEnteredInError
-	AppointmentStatusCheckedIn
-	AppointmentStatusWaitlist
+	AppointmentStatusProposed       AppointmentStatus = "proposed"
+	AppointmentStatusPending        AppointmentStatus = "pending"
+	AppointmentStatusBooked         AppointmentStatus = "booked"
+	AppointmentStatusArrived        AppointmentStatus = "arrived"
+	AppointmentStatusFulfilled      AppointmentStatus = "fulfilled"
+	AppointmentStatusCancelled      AppointmentStatus = "cancelled"
+	AppointmentStatusNoshow         AppointmentStatus = "noshow"
+	AppointmentStatusEnteredInError AppointmentStatus = "entered-in-error"
+	AppointmentStatusCheckedIn      AppointmentStatus = "checked-in"
+	AppointmentStatusWaitlist       AppointmentStatus = "waitlist"
 )
 
 func (code AppointmentStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AppointmentStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AppointmentStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "proposed":
-		*code = AppointmentStatusProposed
-	case "pending":
-		*code = AppointmentStatusPending
-	case "booked":
-		*code = AppointmentStatusBooked
-	case "arrived":
-		*code = AppointmentStatusArrived
-	case "fulfilled":
-		*code = AppointmentStatusFulfilled
-	case "cancelled":
-		*code = AppointmentStatusCancelled
-	case "noshow":
-		*code = AppointmentStatusNoshow
-	case "entered-in-error":
-		*code = AppointmentStatusEnteredInError
-	case "checked-in":
-		*code = AppointmentStatusCheckedIn
-	case "waitlist":
-		*code = AppointmentStatusWaitlist
-	default:
-		return fmt.Errorf("unknown AppointmentStatus code `%s`", s)
+func (code *AppointmentStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AppointmentStatus(s)
 	return nil
 }
 func (code AppointmentStatus) String() string {
 	return code.Code()
 }
 func (code AppointmentStatus) Code() string {
+	return string(code)
+}
+func (code AppointmentStatus) Known() bool {
 	switch code {
-	case AppointmentStatusProposed:
-		return "proposed"
-	case AppointmentStatusPending:
-		return "pending"
-	case AppointmentStatusBooked:
-		return "booked"
-	case AppointmentStatusArrived:
-		return "arrived"
-	case AppointmentStatusFulfilled:
-		return "fulfilled"
-	case AppointmentStatusCancelled:
-		return "cancelled"
-	case AppointmentStatusNoshow:
-		return "noshow"
-	case AppointmentStatusEnteredInError:
-		return "entered-in-error"
-	case AppointmentStatusCheckedIn:
-		return "checked-in"
-	case AppointmentStatusWaitlist:
-		return "waitlist"
+	case AppointmentStatusProposed, AppointmentStatusPending, AppointmentStatusBooked, AppointmentStatusArrived, AppointmentStatusFulfilled, AppointmentStatusCancelled, AppointmentStatusNoshow, AppointmentStatusEnteredInError, AppointmentStatusCheckedIn, AppointmentStatusWaitlist:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AppointmentStatus) Display() string {
 	switch code {
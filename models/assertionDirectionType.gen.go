@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.270365 +0000 UTC
+// 2026-10-19 02:55:18.701246401 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AssertionDirectionType is documented here http://hl7.org/fhir/ValueSet/assert-direction-codes
-type AssertionDirectionType int
+// The empty AssertionDirectionType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AssertionDirectionType string
 
 const (
-	AssertionDirectionTypeResponse AssertionDirectionType = iota
-	AssertionDirectionTypeRequest
+	AssertionDirectionTypeResponse AssertionDirectionType = "response"
+	AssertionDirectionTypeRequest  AssertionDirectionType = "request"
 )
 
 func (code AssertionDirectionType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AssertionDirectionType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AssertionDirectionType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "response":
-		*code = AssertionDirectionTypeResponse
-	case "request":
-		*code = AssertionDirectionTypeRequest
-	default:
-		return fmt.Errorf("unknown AssertionDirectionType code `%s`", s)
+func (code *AssertionDirectionType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AssertionDirectionType(s)
 	return nil
 }
 func (code AssertionDirectionType) String() string {
 	return code.Code()
 }
 func (code AssertionDirectionType) Code() string {
+	return string(code)
+}
+func (code AssertionDirectionType) Known() bool {
 	switch code {
-	case AssertionDirectionTypeResponse:
-		return "response"
-	case AssertionDirectionTypeRequest:
-		return "request"
+	case AssertionDirectionTypeResponse, AssertionDirectionTypeRequest:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AssertionDirectionType) Display() string {
 	switch code {
@@ -14,95 +14,60 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.241339 +0000 UTC
+// 2026-10-19 02:55:18.702837783 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AssertionOperatorType is documented here http://hl7.org/fhir/ValueSet/assert-operator-codes
-type AssertionOperatorType int
+// The empty AssertionOperatorType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AssertionOperatorType string
 
 const (
-	AssertionOperatorTypeEquals AssertionOperatorType = iota
-	AssertionOperatorTypeNotEquals
-	AssertionOperatorTypeIn
-	AssertionOperatorTypeNotIn
-	AssertionOperatorTypeGreaterThan
-	AssertionOperatorTypeLessThan
-	AssertionOperatorTypeEmpty
-	AssertionOperatorTypeNotEmpty
-	AssertionOperatorTypeContains
-	AssertionOperatorTypeNotContains
-	AssertionOperatorTypeEval
+	AssertionOperatorTypeEquals      AssertionOperatorType = "equals"
+	AssertionOperatorTypeNotEquals   AssertionOperatorType = "notEquals"
+	AssertionOperatorTypeIn          AssertionOperatorType = "in"
+	AssertionOperatorTypeNotIn       AssertionOperatorType = "notIn"
+	AssertionOperatorTypeGreaterThan AssertionOperatorType = "greaterThan"
+	AssertionOperatorTypeLessThan    AssertionOperatorType = "lessThan"
+	AssertionOperatorTypeEmpty       AssertionOperatorType = "empty"
+	AssertionOperatorTypeNotEmpty    AssertionOperatorType = "notEmpty"
+	AssertionOperatorTypeContains    AssertionOperatorType = "contains"
+	AssertionOperatorTypeNotContains AssertionOperatorType = "notContains"
+	AssertionOperatorTypeEval        AssertionOperatorType = "eval"
 )
 
 func (code AssertionOperatorType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AssertionOperatorType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AssertionOperatorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "equals":
-		*code = AssertionOperatorTypeEquals
-	case "notEquals":
-		*code = AssertionOperatorTypeNotEquals
-	case "in":
-		*code = AssertionOperatorTypeIn
-	case "notIn":
-		*code = AssertionOperatorTypeNotIn
-	case "greaterThan":
-		*code = AssertionOperatorTypeGreaterThan
-	case "lessThan":
-		*code = AssertionOperatorTypeLessThan
-	case "empty":
-		*code = AssertionOperatorTypeEmpty
-	case "notEmpty":
-		*code = AssertionOperatorTypeNotEmpty
-	case "contains":
-		*code = AssertionOperatorTypeContains
-	case "notContains":
-		*code = AssertionOperatorTypeNotContains
-	case "eval":
-		*code = AssertionOperatorTypeEval
-	default:
-		return fmt.Errorf("unknown AssertionOperatorType code `%s`", s)
+func (code *AssertionOperatorType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AssertionOperatorType(s)
 	return nil
 }
 func (code AssertionOperatorType) String() string {
 	return code.Code()
 }
 func (code AssertionOperatorType) Code() string {
+	return string(code)
+}
+func (code AssertionOperatorType) Known() bool {
 	switch code {
-	case AssertionOperatorTypeEquals:
-		return "equals"
-	case AssertionOperatorTypeNotEquals:
-		return "notEquals"
-	case AssertionOperatorTypeIn:
-		return "in"
-	case AssertionOperatorTypeNotIn:
-		return "notIn"
-	case AssertionOperatorTypeGreaterThan:
-		return "greaterThan"
-	case AssertionOperatorTypeLessThan:
-		return "lessThan"
-	case AssertionOperatorTypeEmpty:
-		return "empty"
-	case AssertionOperatorTypeNotEmpty:
-		return "notEmpty"
-	case AssertionOperatorTypeContains:
-		return "contains"
-	case AssertionOperatorTypeNotContains:
-		return "notContains"
-	case AssertionOperatorTypeEval:
-		return "eval"
+	case AssertionOperatorTypeEquals, AssertionOperatorTypeNotEquals, AssertionOperatorTypeIn, AssertionOperatorTypeNotIn, AssertionOperatorTypeGreaterThan, AssertionOperatorTypeLessThan, AssertionOperatorTypeEmpty, AssertionOperatorTypeNotEmpty, AssertionOperatorTypeContains, AssertionOperatorTypeNotContains, AssertionOperatorTypeEval:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AssertionOperatorType) Display() string {
 	switch code {
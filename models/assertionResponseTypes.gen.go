@@ -14,100 +14,61 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.142245 +0000 UTC
+// 2026-10-19 02:55:18.704712072 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AssertionResponseTypes is documented here http://hl7.org/fhir/ValueSet/assert-response-code-types
-type AssertionResponseTypes int
+// The empty AssertionResponseTypes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AssertionResponseTypes string
 
 const (
-	AssertionResponseTypesOkay AssertionResponseTypes = iota
-	AssertionResponseTypesCreated
-	AssertionResponseTypesNoContent
-	AssertionResponseTypesNotModified
-	AssertionResponseTypesBad
-	AssertionResponseTypesForbidden
-	AssertionResponseTypesNotFound
-	AssertionResponseTypesMethodNotAllowed
-	AssertionResponseTypesConflict
-	AssertionResponseTypesGone
-	AssertionResponseTypesPreconditionFailed
-	AssertionResponseTypesUnprocessable
+	AssertionResponseTypesOkay               AssertionResponseTypes = "okay"
+	AssertionResponseTypesCreated            AssertionResponseTypes = "created"
+	AssertionResponseTypesNoContent          AssertionResponseTypes = "noContent"
+	AssertionResponseTypesNotModified        AssertionResponseTypes = "notModified"
+	AssertionResponseTypesBad                AssertionResponseTypes = "bad"
+	AssertionResponseTypesForbidden          AssertionResponseTypes = "forbidden"
+	AssertionResponseTypesNotFound           AssertionResponseTypes = "notFound"
+	AssertionResponseTypesMethodNotAllowed   AssertionResponseTypes = "methodNotAllowed"
+	AssertionResponseTypesConflict           AssertionResponseTypes = "conflict"
+	AssertionResponseTypesGone               AssertionResponseTypes = "gone"
+	AssertionResponseTypesPreconditionFailed AssertionResponseTypes = "preconditionFailed"
+	AssertionResponseTypesUnprocessable      AssertionResponseTypes = "unprocessable"
 )
 
 func (code AssertionResponseTypes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AssertionResponseTypes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AssertionResponseTypes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "okay":
-		*code = AssertionResponseTypesOkay
-	case "created":
-		*code = AssertionResponseTypesCreated
-	case "noContent":
-		*code = AssertionResponseTypesNoContent
-	case "notModified":
-		*code = AssertionResponseTypesNotModified
-	case "bad":
-		*code = AssertionResponseTypesBad
-	case "forbidden":
-		*code = AssertionResponseTypesForbidden
-	case "notFound":
-		*code = AssertionResponseTypesNotFound
-	case "methodNotAllowed":
-		*code = AssertionResponseTypesMethodNotAllowed
-	case "conflict":
-		*code = AssertionResponseTypesConflict
-	case "gone":
-		*code = AssertionResponseTypesGone
-	case "preconditionFailed":
-		*code = AssertionResponseTypesPreconditionFailed
-	case "unprocessable":
-		*code = AssertionResponseTypesUnprocessable
-	default:
-		return fmt.Errorf("unknown AssertionResponseTypes code `%s`", s)
+func (code *AssertionResponseTypes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = AssertionResponseTypes(s)
 	return nil
 }
 func (code AssertionResponseTypes) String() string {
 	return code.Code()
 }
 func (code AssertionResponseTypes) Code() string {
+	return string(code)
+}
+func (code AssertionResponseTypes) Known() bool {
 	switch code {
-	case AssertionResponseTypesOkay:
-		return "okay"
-	case AssertionResponseTypesCreated:
-		return "created"
-	case AssertionResponseTypesNoContent:
-		return "noContent"
-	case AssertionResponseTypesNotModified:
-		return "notModified"
-	case AssertionResponseTypesBad:
-		return "bad"
-	case AssertionResponseTypesForbidden:
-		return "forbidden"
-	case AssertionResponseTypesNotFound:
-		return "notFound"
-	case AssertionResponseTypesMethodNotAllowed:
-		return "methodNotAllowed"
-	case AssertionResponseTypesConflict:
-		return "conflict"
-	case AssertionResponseTypesGone:
-		return "gone"
-	case AssertionResponseTypesPreconditionFailed:
-		return "preconditionFailed"
-	case AssertionResponseTypesUnprocessable:
-		return "unprocessable"
+	case AssertionResponseTypesOkay, AssertionResponseTypesCreated, AssertionResponseTypesNoContent, AssertionResponseTypesNotModified, AssertionResponseTypesBad, AssertionResponseTypesForbidden, AssertionResponseTypesNotFound, AssertionResponseTypesMethodNotAllowed, AssertionResponseTypesConflict, AssertionResponseTypesGone, AssertionResponseTypesPreconditionFailed, AssertionResponseTypesUnprocessable:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AssertionResponseTypes) Display() string {
 	switch code {
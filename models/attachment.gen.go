@@ -20,14 +20,22 @@ package models
 
 // Attachment is documented here http://hl7.org/fhir/StructureDefinition/Attachment
 type Attachment struct {
-	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ContentType *string     `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Language    *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Data        *string     `bson:"data,omitempty" json:"data,omitempty"`
-	URL         *string     `bson:"url,omitempty" json:"url,omitempty"`
-	Size        *int        `bson:"size,omitempty" json:"size,omitempty"`
-	Hash        *string     `bson:"hash,omitempty" json:"hash,omitempty"`
-	Title       *string     `bson:"title,omitempty" json:"title,omitempty"`
-	Creation    *DateTime   `bson:"creation,omitempty" json:"creation,omitempty"`
+	ID                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ContentType        *string     `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	ContentTypeElement *Element    `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	Language           *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement    *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Data               *string     `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement        *Element    `bson:"_data,omitempty" json:"_data,omitempty"`
+	URL                *string     `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement         *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Size               *int        `bson:"size,omitempty" json:"size,omitempty"`
+	SizeElement        *Element    `bson:"_size,omitempty" json:"_size,omitempty"`
+	Hash               *string     `bson:"hash,omitempty" json:"hash,omitempty"`
+	HashElement        *Element    `bson:"_hash,omitempty" json:"_hash,omitempty"`
+	Title              *string     `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement       *Element    `bson:"_title,omitempty" json:"_title,omitempty"`
+	Creation           *DateTime   `bson:"creation,omitempty" json:"creation,omitempty"`
+	CreationElement    *Element    `bson:"_creation,omitempty" json:"_creation,omitempty"`
 }
@@ -22,87 +22,238 @@ import "encoding/json"
 
 // AuditEvent is documented here http://hl7.org/fhir/StructureDefinition/AuditEvent
 type AuditEvent struct {
-	ID                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              Coding             `bson:"type" json:"type"`
-	Subtype           []Coding           `bson:"subtype,omitempty" json:"subtype,omitempty"`
-	Action            *AuditEventAction  `bson:"action,omitempty" json:"action,omitempty"`
-	Period            *Period            `bson:"period,omitempty" json:"period,omitempty"`
-	Recorded          string             `bson:"recorded" json:"recorded"`
-	Outcome           *AuditEventOutcome `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	OutcomeDesc       *string            `bson:"outcomeDesc,omitempty" json:"outcomeDesc,omitempty"`
-	PurposeOfEvent    []CodeableConcept  `bson:"purposeOfEvent,omitempty" json:"purposeOfEvent,omitempty"`
-	Agent             []AuditEventAgent  `bson:"agent" json:"agent"`
-	Source            AuditEventSource   `bson:"source" json:"source"`
-	Entity            []AuditEventEntity `bson:"entity,omitempty" json:"entity,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                 Coding                     `bson:"type" json:"type"`
+	Subtype              []Coding                   `bson:"subtype,omitempty" json:"subtype,omitempty"`
+	Action               *AuditEventAction          `bson:"action,omitempty" json:"action,omitempty"`
+	ActionElement        *Element                   `bson:"_action,omitempty" json:"_action,omitempty"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Recorded             Instant                    `bson:"recorded" json:"recorded"`
+	RecordedElement      *Element                   `bson:"_recorded,omitempty" json:"_recorded,omitempty"`
+	Outcome              *AuditEventOutcome         `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	OutcomeElement       *Element                   `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	OutcomeDesc          *string                    `bson:"outcomeDesc,omitempty" json:"outcomeDesc,omitempty"`
+	OutcomeDescElement   *Element                   `bson:"_outcomeDesc,omitempty" json:"_outcomeDesc,omitempty"`
+	PurposeOfEvent       []CodeableConcept          `bson:"purposeOfEvent,omitempty" json:"purposeOfEvent,omitempty"`
+	Agent                []AuditEventAgent          `bson:"agent" json:"agent"`
+	Source               AuditEventSource           `bson:"source" json:"source"`
+	Entity               []AuditEventEntity         `bson:"entity,omitempty" json:"entity,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type AuditEventAgent struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept        `bson:"type,omitempty" json:"type,omitempty"`
-	Role              []CodeableConcept       `bson:"role,omitempty" json:"role,omitempty"`
-	Who               *Reference              `bson:"who,omitempty" json:"who,omitempty"`
-	AltId             *string                 `bson:"altId,omitempty" json:"altId,omitempty"`
-	Name              *string                 `bson:"name,omitempty" json:"name,omitempty"`
-	Requestor         bool                    `bson:"requestor" json:"requestor"`
-	Location          *Reference              `bson:"location,omitempty" json:"location,omitempty"`
-	Policy            []string                `bson:"policy,omitempty" json:"policy,omitempty"`
-	Media             *Coding                 `bson:"media,omitempty" json:"media,omitempty"`
-	Network           *AuditEventAgentNetwork `bson:"network,omitempty" json:"network,omitempty"`
-	PurposeOfUse      []CodeableConcept       `bson:"purposeOfUse,omitempty" json:"purposeOfUse,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Role              []CodeableConcept          `bson:"role,omitempty" json:"role,omitempty"`
+	Who               *Reference                 `bson:"who,omitempty" json:"who,omitempty"`
+	AltId             *string                    `bson:"altId,omitempty" json:"altId,omitempty"`
+	AltIdElement      *Element                   `bson:"_altId,omitempty" json:"_altId,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Requestor         bool                       `bson:"requestor" json:"requestor"`
+	RequestorElement  *Element                   `bson:"_requestor,omitempty" json:"_requestor,omitempty"`
+	Location          *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	Policy            []*string                  `bson:"policy,omitempty" json:"policy,omitempty"`
+	PolicyElement     []*Element                 `bson:"_policy,omitempty" json:"_policy,omitempty"`
+	Media             *Coding                    `bson:"media,omitempty" json:"media,omitempty"`
+	Network           *AuditEventAgentNetwork    `bson:"network,omitempty" json:"network,omitempty"`
+	PurposeOfUse      []CodeableConcept          `bson:"purposeOfUse,omitempty" json:"purposeOfUse,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type AuditEventAgentNetwork struct {
 	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Address           *string                     `bson:"address,omitempty" json:"address,omitempty"`
+	AddressElement    *Element                    `bson:"_address,omitempty" json:"_address,omitempty"`
 	Type              *AuditEventAgentNetworkType `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                    `bson:"_type,omitempty" json:"_type,omitempty"`
+	UnknownFields     map[string]json.RawMessage  `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the AuditEventAgentNetwork with its unknown fields.
+func (r AuditEventAgentNetwork) MarshalJSON() ([]byte, error) {
+	type other AuditEventAgentNetwork
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the AuditEventAgent and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *AuditEventAgent) UnmarshalJSON(b []byte) error {
+	type other AuditEventAgent
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("AuditEvent.agent.policy", len(r.Policy), len(r.PolicyElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the AuditEventAgent with its unknown fields.
+func (r AuditEventAgent) MarshalJSON() ([]byte, error) {
+	type other AuditEventAgent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type AuditEventSource struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Site              *string     `bson:"site,omitempty" json:"site,omitempty"`
-	Observer          Reference   `bson:"observer" json:"observer"`
-	Type              []Coding    `bson:"type,omitempty" json:"type,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Site              *string                    `bson:"site,omitempty" json:"site,omitempty"`
+	SiteElement       *Element                   `bson:"_site,omitempty" json:"_site,omitempty"`
+	Observer          Reference                  `bson:"observer" json:"observer"`
+	Type              []Coding                   `bson:"type,omitempty" json:"type,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the AuditEventSource with its unknown fields.
+func (r AuditEventSource) MarshalJSON() ([]byte, error) {
+	type other AuditEventSource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type AuditEventEntity struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	What              *Reference               `bson:"what,omitempty" json:"what,omitempty"`
-	Type              *Coding                  `bson:"type,omitempty" json:"type,omitempty"`
-	Role              *Coding                  `bson:"role,omitempty" json:"role,omitempty"`
-	Lifecycle         *Coding                  `bson:"lifecycle,omitempty" json:"lifecycle,omitempty"`
-	SecurityLabel     []Coding                 `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Name              *string                  `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                  `bson:"description,omitempty" json:"description,omitempty"`
-	Query             *string                  `bson:"query,omitempty" json:"query,omitempty"`
-	Detail            []AuditEventEntityDetail `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	What               *Reference                 `bson:"what,omitempty" json:"what,omitempty"`
+	Type               *Coding                    `bson:"type,omitempty" json:"type,omitempty"`
+	Role               *Coding                    `bson:"role,omitempty" json:"role,omitempty"`
+	Lifecycle          *Coding                    `bson:"lifecycle,omitempty" json:"lifecycle,omitempty"`
+	SecurityLabel      []Coding                   `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Name               *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Query              *string                    `bson:"query,omitempty" json:"query,omitempty"`
+	QueryElement       *Element                   `bson:"_query,omitempty" json:"_query,omitempty"`
+	Detail             []AuditEventEntityDetail   `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type AuditEventEntityDetail struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              string      `bson:"type" json:"type"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     string                     `bson:"type" json:"type"`
+	TypeElement              *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	ValueString              *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBase64Binary        *string                    `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element                   `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns AuditEvent.entity.detail.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *AuditEventEntityDetail) Value() interface{} {
+	switch {
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	}
+	return nil
+}
+
+// SetValueString sets AuditEvent.entity.detail.value[x] to the string and clears the other types.
+func (r *AuditEventEntityDetail) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueBase64Binary sets AuditEvent.entity.detail.value[x] to the base64Binary and clears the other types.
+func (r *AuditEventEntityDetail) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+func (r *AuditEventEntityDetail) clearValue() {
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+}
+
+// UnmarshalJSON unmarshals the AuditEventEntityDetail and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *AuditEventEntityDetail) UnmarshalJSON(b []byte) error {
+	type other AuditEventEntityDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"AuditEvent.entity.detail.value[x]",
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the AuditEventEntityDetail with its unknown fields.
+func (r AuditEventEntityDetail) MarshalJSON() ([]byte, error) {
+	type other AuditEventEntityDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the AuditEventEntity with its unknown fields.
+func (r AuditEventEntity) MarshalJSON() ([]byte, error) {
+	type other AuditEventEntity
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the AuditEvent and fails with CodeError when a coded element has an unknown code.
+func (r *AuditEvent) UnmarshalJSON(b []byte) error {
+	type other AuditEvent
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("AuditEvent", r)
+}
+
 type OtherAuditEvent AuditEvent
 
 // MarshalJSON marshals the given AuditEvent as JSON into a byte slice
 func (r AuditEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherAuditEvent
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherAuditEvent: OtherAuditEvent(r),
 		ResourceType:    "AuditEvent",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalAuditEvent unmarshals a AuditEvent.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.189298 +0000 UTC
+// 2026-10-19 02:55:18.707361413 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AuditEventAction is documented here http://hl7.org/fhir/ValueSet/audit-event-action
-type AuditEventAction int
+// The empty AuditEventAction is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AuditEventAction string
 
 const (
-	AuditEventActionC AuditEventAction = iota
-	AuditEventActionR
-	AuditEventActionU
-	AuditEventActionD
-	AuditEventActionE
+	AuditEventActionC AuditEventAction = "C"
+	AuditEventActionR AuditEventAction = "R"
+	AuditEventActionU AuditEventAction = "U"
+	AuditEventActionD AuditEventAction = "D"
+	AuditEventActionE AuditEventAction = "E"
 )
 
 func (code AuditEventAction) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AuditEventAction"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AuditEventAction) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "C":
-		*code = AuditEventActionC
-	case "R":
-		*code = AuditEventActionR
-	case "U":
-		*code = AuditEventActionU
-	case "D":
-		*code = AuditEventActionD
-	case "E":
-		*code = AuditEventActionE
-	default:
-		return fmt.Errorf("unknown AuditEventAction code `%s`", s)
+func (code *AuditEventAction) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AuditEventAction(s)
 	return nil
 }
 func (code AuditEventAction) String() string {
 	return code.Code()
 }
 func (code AuditEventAction) Code() string {
+	return string(code)
+}
+func (code AuditEventAction) Known() bool {
 	switch code {
-	case AuditEventActionC:
-		return "C"
-	case AuditEventActionR:
-		return "R"
-	case AuditEventActionU:
-		return "U"
-	case AuditEventActionD:
-		return "D"
-	case AuditEventActionE:
-		return "E"
+	case AuditEventActionC, AuditEventActionR, AuditEventActionU, AuditEventActionD, AuditEventActionE:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AuditEventAction) Display() string {
 	switch code {
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.088651 +0000 UTC
+// 2026-10-19 02:55:18.709188725 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AuditEventAgentNetworkType is documented here http://hl7.org/fhir/ValueSet/network-type
-type AuditEventAgentNetworkType int
+// The empty AuditEventAgentNetworkType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AuditEventAgentNetworkType string
 
 const (
-	AuditEventAgentNetworkType1 AuditEventAgentNetworkType = iota
-	AuditEventAgentNetworkType2
-	AuditEventAgentNetworkType3
-	AuditEventAgentNetworkType4
-	AuditEventAgentNetworkType5
+	AuditEventAgentNetworkType1 AuditEventAgentNetworkType = "1"
+	AuditEventAgentNetworkType2 AuditEventAgentNetworkType = "2"
+	AuditEventAgentNetworkType3 AuditEventAgentNetworkType = "3"
+	AuditEventAgentNetworkType4 AuditEventAgentNetworkType = "4"
+	AuditEventAgentNetworkType5 AuditEventAgentNetworkType = "5"
 )
 
 func (code AuditEventAgentNetworkType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AuditEventAgentNetworkType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AuditEventAgentNetworkType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "1":
-		*code = AuditEventAgentNetworkType1
-	case "2":
-		*code = AuditEventAgentNetworkType2
-	case "3":
-		*code = AuditEventAgentNetworkType3
-	case "4":
-		*code = AuditEventAgentNetworkType4
-	case "5":
-		*code = AuditEventAgentNetworkType5
-	default:
-		return fmt.Errorf("unknown AuditEventAgentNetworkType code `%s`", s)
+func (code *AuditEventAgentNetworkType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AuditEventAgentNetworkType(s)
 	return nil
 }
 func (code AuditEventAgentNetworkType) String() string {
 	return code.Code()
 }
 func (code AuditEventAgentNetworkType) Code() string {
+	return string(code)
+}
+func (code AuditEventAgentNetworkType) Known() bool {
 	switch code {
-	case AuditEventAgentNetworkType1:
-		return "1"
-	case AuditEventAgentNetworkType2:
-		return "2"
-	case AuditEventAgentNetworkType3:
-		return "3"
-	case AuditEventAgentNetworkType4:
-		return "4"
-	case AuditEventAgentNetworkType5:
-		return "5"
+	case AuditEventAgentNetworkType1, AuditEventAgentNetworkType2, AuditEventAgentNetworkType3, AuditEventAgentNetworkType4, AuditEventAgentNetworkType5:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AuditEventAgentNetworkType) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.250725 +0000 UTC
+// 2026-10-19 02:55:18.710280022 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // AuditEventOutcome is documented here http://hl7.org/fhir/ValueSet/audit-event-outcome
-type AuditEventOutcome int
+// The empty AuditEventOutcome is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type AuditEventOutcome string
 
 const (
-	AuditEventOutcome0 AuditEventOutcome = iota
-	AuditEventOutcome4
-	AuditEventOutcome8
-	AuditEventOutcome12
+	AuditEventOutcome0  AuditEventOutcome = "0"
+	AuditEventOutcome4  AuditEventOutcome = "4"
+	AuditEventOutcome8  AuditEventOutcome = "8"
+	AuditEventOutcome12 AuditEventOutcome = "12"
 )
 
 func (code AuditEventOutcome) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "AuditEventOutcome"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *AuditEventOutcome) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "0":
-		*code = AuditEventOutcome0
-	case "4":
-		*code = AuditEventOutcome4
-	case "8":
-		*code = AuditEventOutcome8
-	case "12":
-		*code = AuditEventOutcome12
-	default:
-		return fmt.Errorf("unknown AuditEventOutcome code `%s`", s)
+func (code *AuditEventOutcome) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = AuditEventOutcome(s)
 	return nil
 }
 func (code AuditEventOutcome) String() string {
 	return code.Code()
 }
 func (code AuditEventOutcome) Code() string {
+	return string(code)
+}
+func (code AuditEventOutcome) Known() bool {
 	switch code {
-	case AuditEventOutcome0:
-		return "0"
-	case AuditEventOutcome4:
-		return "4"
-	case AuditEventOutcome8:
-		return "8"
-	case AuditEventOutcome12:
-		return "12"
+	case AuditEventOutcome0, AuditEventOutcome4, AuditEventOutcome8, AuditEventOutcome12:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code AuditEventOutcome) Display() string {
 	switch code {
@@ -22,30 +22,49 @@ import "encoding/json"
 
 // Basic is documented here http://hl7.org/fhir/StructureDefinition/Basic
 type Basic struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string         `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
-	Subject           *Reference      `bson:"subject,omitempty" json:"subject,omitempty"`
-	Created           *DateTime       `bson:"created,omitempty" json:"created,omitempty"`
-	Author            *Reference      `bson:"author,omitempty" json:"author,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Code                 CodeableConcept            `bson:"code" json:"code"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Created              *DateTime                  `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                   `bson:"_created,omitempty" json:"_created,omitempty"`
+	Author               *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Basic and fails with CodeError when a coded element has an unknown code.
+func (r *Basic) UnmarshalJSON(b []byte) error {
+	type other Basic
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Basic", r)
+}
+
 type OtherBasic Basic
 
 // MarshalJSON marshals the given Basic as JSON into a byte slice
 func (r Basic) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherBasic
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherBasic:   OtherBasic(r),
 		ResourceType: "Basic",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalBasic unmarshals a Basic.
@@ -22,25 +22,44 @@ import "encoding/json"
 
 // Binary is documented here http://hl7.org/fhir/StructureDefinition/Binary
 type Binary struct {
-	ID              *string    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta            *Meta      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules   *string    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language        *string    `bson:"language,omitempty" json:"language,omitempty"`
-	ContentType     string     `bson:"contentType" json:"contentType"`
-	SecurityContext *Reference `bson:"securityContext,omitempty" json:"securityContext,omitempty"`
-	Data            *string    `bson:"data,omitempty" json:"data,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	ContentType          string                     `bson:"contentType" json:"contentType"`
+	ContentTypeElement   *Element                   `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	SecurityContext      *Reference                 `bson:"securityContext,omitempty" json:"securityContext,omitempty"`
+	Data                 *string                    `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement          *Element                   `bson:"_data,omitempty" json:"_data,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Binary and fails with CodeError when a coded element has an unknown code.
+func (r *Binary) UnmarshalJSON(b []byte) error {
+	type other Binary
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Binary", r)
+}
+
 type OtherBinary Binary
 
 // MarshalJSON marshals the given Binary as JSON into a byte slice
 func (r Binary) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherBinary
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherBinary:  OtherBinary(r),
 		ResourceType: "Binary",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalBinary unmarshals a Binary.
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.295995 +0000 UTC
+// 2026-10-19 02:55:18.711244387 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // BindingStrength is documented here http://hl7.org/fhir/ValueSet/binding-strength
-type BindingStrength int
+// The empty BindingStrength is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type BindingStrength string
 
 const (
-	BindingStrengthRequired BindingStrength = iota
-	BindingStrengthExtensible
-	BindingStrengthPreferred
-	BindingStrengthExample
+	BindingStrengthRequired   BindingStrength = "required"
+	BindingStrengthExtensible BindingStrength = "extensible"
+	BindingStrengthPreferred  BindingStrength = "preferred"
+	BindingStrengthExample    BindingStrength = "example"
 )
 
 func (code BindingStrength) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "BindingStrength"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *BindingStrength) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "required":
-		*code = BindingStrengthRequired
-	case "extensible":
-		*code = BindingStrengthExtensible
-	case "preferred":
-		*code = BindingStrengthPreferred
-	case "example":
-		*code = BindingStrengthExample
-	default:
-		return fmt.Errorf("unknown BindingStrength code `%s`", s)
+func (code *BindingStrength) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = BindingStrength(s)
 	return nil
 }
 func (code BindingStrength) String() string {
 	return code.Code()
 }
 func (code BindingStrength) Code() string {
+	return string(code)
+}
+func (code BindingStrength) Known() bool {
 	switch code {
-	case BindingStrengthRequired:
-		return "required"
-	case BindingStrengthExtensible:
-		return "extensible"
-	case BindingStrengthPreferred:
-		return "preferred"
-	case BindingStrengthExample:
-		return "example"
+	case BindingStrengthRequired, BindingStrengthExtensible, BindingStrengthPreferred, BindingStrengthExample:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code BindingStrength) Display() string {
 	switch code {
@@ -22,66 +22,280 @@ import "encoding/json"
 
 // BiologicallyDerivedProduct is documented here http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct
 type BiologicallyDerivedProduct struct {
-	ID                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ProductCategory   *BiologicallyDerivedProductCategory     `bson:"productCategory,omitempty" json:"productCategory,omitempty"`
-	ProductCode       *CodeableConcept                        `bson:"productCode,omitempty" json:"productCode,omitempty"`
-	Status            *BiologicallyDerivedProductStatus       `bson:"status,omitempty" json:"status,omitempty"`
-	Request           []Reference                             `bson:"request,omitempty" json:"request,omitempty"`
-	Quantity          *int                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Parent            []Reference                             `bson:"parent,omitempty" json:"parent,omitempty"`
-	Collection        *BiologicallyDerivedProductCollection   `bson:"collection,omitempty" json:"collection,omitempty"`
-	Processing        []BiologicallyDerivedProductProcessing  `bson:"processing,omitempty" json:"processing,omitempty"`
-	Manipulation      *BiologicallyDerivedProductManipulation `bson:"manipulation,omitempty" json:"manipulation,omitempty"`
-	Storage           []BiologicallyDerivedProductStorage     `bson:"storage,omitempty" json:"storage,omitempty"`
+	ID                     *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources                      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ProductCategory        *BiologicallyDerivedProductCategory     `bson:"productCategory,omitempty" json:"productCategory,omitempty"`
+	ProductCategoryElement *Element                                `bson:"_productCategory,omitempty" json:"_productCategory,omitempty"`
+	ProductCode            *CodeableConcept                        `bson:"productCode,omitempty" json:"productCode,omitempty"`
+	Status                 *BiologicallyDerivedProductStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement          *Element                                `bson:"_status,omitempty" json:"_status,omitempty"`
+	Request                []Reference                             `bson:"request,omitempty" json:"request,omitempty"`
+	Quantity               *int                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	QuantityElement        *Element                                `bson:"_quantity,omitempty" json:"_quantity,omitempty"`
+	Parent                 []Reference                             `bson:"parent,omitempty" json:"parent,omitempty"`
+	Collection             *BiologicallyDerivedProductCollection   `bson:"collection,omitempty" json:"collection,omitempty"`
+	Processing             []BiologicallyDerivedProductProcessing  `bson:"processing,omitempty" json:"processing,omitempty"`
+	Manipulation           *BiologicallyDerivedProductManipulation `bson:"manipulation,omitempty" json:"manipulation,omitempty"`
+	Storage                []BiologicallyDerivedProductStorage     `bson:"storage,omitempty" json:"storage,omitempty"`
+	UnknownFields          map[string]json.RawMessage              `bson:"-" json:"-"`
 }
 type BiologicallyDerivedProductCollection struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Collector         *Reference  `bson:"collector,omitempty" json:"collector,omitempty"`
-	Source            *Reference  `bson:"source,omitempty" json:"source,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Collector                *Reference                 `bson:"collector,omitempty" json:"collector,omitempty"`
+	Source                   *Reference                 `bson:"source,omitempty" json:"source,omitempty"`
+	CollectedDateTime        *DateTime                  `bson:"collectedDateTime,omitempty" json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *Element                   `bson:"_collectedDateTime,omitempty" json:"_collectedDateTime,omitempty"`
+	CollectedPeriod          *Period                    `bson:"collectedPeriod,omitempty" json:"collectedPeriod,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Collected returns BiologicallyDerivedProduct.collection.collected[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *BiologicallyDerivedProductCollection) Collected() interface{} {
+	switch {
+	case r.CollectedDateTime != nil:
+		return r.CollectedDateTime
+	case r.CollectedPeriod != nil:
+		return r.CollectedPeriod
+	}
+	return nil
+}
+
+// SetCollectedDateTime sets BiologicallyDerivedProduct.collection.collected[x] to the dateTime and clears the other types.
+func (r *BiologicallyDerivedProductCollection) SetCollectedDateTime(v DateTime) {
+	r.clearCollected()
+	r.CollectedDateTime = &v
+}
+
+// SetCollectedPeriod sets BiologicallyDerivedProduct.collection.collected[x] to the Period and clears the other types.
+func (r *BiologicallyDerivedProductCollection) SetCollectedPeriod(v Period) {
+	r.clearCollected()
+	r.CollectedPeriod = &v
+}
+func (r *BiologicallyDerivedProductCollection) clearCollected() {
+	r.CollectedDateTime = nil
+	r.CollectedDateTimeElement = nil
+	r.CollectedPeriod = nil
+}
+
+// UnmarshalJSON unmarshals the BiologicallyDerivedProductCollection and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *BiologicallyDerivedProductCollection) UnmarshalJSON(b []byte) error {
+	type other BiologicallyDerivedProductCollection
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"BiologicallyDerivedProduct.collection.collected[x]",
+		r.CollectedDateTime != nil || r.CollectedDateTimeElement != nil,
+		r.CollectedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the BiologicallyDerivedProductCollection with its unknown fields.
+func (r BiologicallyDerivedProductCollection) MarshalJSON() ([]byte, error) {
+	type other BiologicallyDerivedProductCollection
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type BiologicallyDerivedProductProcessing struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Procedure         *CodeableConcept `bson:"procedure,omitempty" json:"procedure,omitempty"`
-	Additive          *Reference       `bson:"additive,omitempty" json:"additive,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description         *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Procedure           *CodeableConcept           `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	Additive            *Reference                 `bson:"additive,omitempty" json:"additive,omitempty"`
+	TimeDateTime        *DateTime                  `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *Element                   `bson:"_timeDateTime,omitempty" json:"_timeDateTime,omitempty"`
+	TimePeriod          *Period                    `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Time returns BiologicallyDerivedProduct.processing.time[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *BiologicallyDerivedProductProcessing) Time() interface{} {
+	switch {
+	case r.TimeDateTime != nil:
+		return r.TimeDateTime
+	case r.TimePeriod != nil:
+		return r.TimePeriod
+	}
+	return nil
+}
+
+// SetTimeDateTime sets BiologicallyDerivedProduct.processing.time[x] to the dateTime and clears the other types.
+func (r *BiologicallyDerivedProductProcessing) SetTimeDateTime(v DateTime) {
+	r.clearTime()
+	r.TimeDateTime = &v
+}
+
+// SetTimePeriod sets BiologicallyDerivedProduct.processing.time[x] to the Period and clears the other types.
+func (r *BiologicallyDerivedProductProcessing) SetTimePeriod(v Period) {
+	r.clearTime()
+	r.TimePeriod = &v
+}
+func (r *BiologicallyDerivedProductProcessing) clearTime() {
+	r.TimeDateTime = nil
+	r.TimeDateTimeElement = nil
+	r.TimePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the BiologicallyDerivedProductProcessing and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *BiologicallyDerivedProductProcessing) UnmarshalJSON(b []byte) error {
+	type other BiologicallyDerivedProductProcessing
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"BiologicallyDerivedProduct.processing.time[x]",
+		r.TimeDateTime != nil || r.TimeDateTimeElement != nil,
+		r.TimePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the BiologicallyDerivedProductProcessing with its unknown fields.
+func (r BiologicallyDerivedProductProcessing) MarshalJSON() ([]byte, error) {
+	type other BiologicallyDerivedProductProcessing
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type BiologicallyDerivedProductManipulation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description         *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	TimeDateTime        *DateTime                  `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *Element                   `bson:"_timeDateTime,omitempty" json:"_timeDateTime,omitempty"`
+	TimePeriod          *Period                    `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Time returns BiologicallyDerivedProduct.manipulation.time[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *BiologicallyDerivedProductManipulation) Time() interface{} {
+	switch {
+	case r.TimeDateTime != nil:
+		return r.TimeDateTime
+	case r.TimePeriod != nil:
+		return r.TimePeriod
+	}
+	return nil
+}
+
+// SetTimeDateTime sets BiologicallyDerivedProduct.manipulation.time[x] to the dateTime and clears the other types.
+func (r *BiologicallyDerivedProductManipulation) SetTimeDateTime(v DateTime) {
+	r.clearTime()
+	r.TimeDateTime = &v
+}
+
+// SetTimePeriod sets BiologicallyDerivedProduct.manipulation.time[x] to the Period and clears the other types.
+func (r *BiologicallyDerivedProductManipulation) SetTimePeriod(v Period) {
+	r.clearTime()
+	r.TimePeriod = &v
+}
+func (r *BiologicallyDerivedProductManipulation) clearTime() {
+	r.TimeDateTime = nil
+	r.TimeDateTimeElement = nil
+	r.TimePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the BiologicallyDerivedProductManipulation and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *BiologicallyDerivedProductManipulation) UnmarshalJSON(b []byte) error {
+	type other BiologicallyDerivedProductManipulation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"BiologicallyDerivedProduct.manipulation.time[x]",
+		r.TimeDateTime != nil || r.TimeDateTimeElement != nil,
+		r.TimePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the BiologicallyDerivedProductManipulation with its unknown fields.
+func (r BiologicallyDerivedProductManipulation) MarshalJSON() ([]byte, error) {
+	type other BiologicallyDerivedProductManipulation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type BiologicallyDerivedProductStorage struct {
-	ID                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Temperature       *Decimal                                `bson:"temperature,omitempty" json:"temperature,omitempty"`
-	Scale             *BiologicallyDerivedProductStorageScale `bson:"scale,omitempty" json:"scale,omitempty"`
-	Duration          *Period                                 `bson:"duration,omitempty" json:"duration,omitempty"`
+	ID                 *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        *string                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                `bson:"_description,omitempty" json:"_description,omitempty"`
+	Temperature        *Decimal                                `bson:"temperature,omitempty" json:"temperature,omitempty"`
+	TemperatureElement *Element                                `bson:"_temperature,omitempty" json:"_temperature,omitempty"`
+	Scale              *BiologicallyDerivedProductStorageScale `bson:"scale,omitempty" json:"scale,omitempty"`
+	ScaleElement       *Element                                `bson:"_scale,omitempty" json:"_scale,omitempty"`
+	Duration           *Period                                 `bson:"duration,omitempty" json:"duration,omitempty"`
+	UnknownFields      map[string]json.RawMessage              `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the BiologicallyDerivedProductStorage with its unknown fields.
+func (r BiologicallyDerivedProductStorage) MarshalJSON() ([]byte, error) {
+	type other BiologicallyDerivedProductStorage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the BiologicallyDerivedProduct and fails with CodeError when a coded element has an unknown code.
+func (r *BiologicallyDerivedProduct) UnmarshalJSON(b []byte) error {
+	type other BiologicallyDerivedProduct
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("BiologicallyDerivedProduct", r)
+}
+
 type OtherBiologicallyDerivedProduct BiologicallyDerivedProduct
 
 // MarshalJSON marshals the given BiologicallyDerivedProduct as JSON into a byte slice
 func (r BiologicallyDerivedProduct) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherBiologicallyDerivedProduct
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherBiologicallyDerivedProduct: OtherBiologicallyDerivedProduct(r),
 		ResourceType:                    "BiologicallyDerivedProduct",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalBiologicallyDerivedProduct unmarshals a BiologicallyDerivedProduct.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.232372 +0000 UTC
+// 2026-10-19 02:55:18.712540268 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // BiologicallyDerivedProductCategory is documented here http://hl7.org/fhir/ValueSet/product-category
-type BiologicallyDerivedProductCategory int
+// The empty BiologicallyDerivedProductCategory is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type BiologicallyDerivedProductCategory string
 
 const (
-	BiologicallyDerivedProductCategoryOrgan BiologicallyDerivedProductCategory = iota
-	BiologicallyDerivedProductCategoryTissue
-	BiologicallyDerivedProductCategoryFluid
-	BiologicallyDerivedProductCategoryCells
-	BiologicallyDerivedProductCategoryBiologicalAgent
+	BiologicallyDerivedProductCategoryOrgan           BiologicallyDerivedProductCategory = "organ"
+	BiologicallyDerivedProductCategoryTissue          BiologicallyDerivedProductCategory = "tissue"
+	BiologicallyDerivedProductCategoryFluid           BiologicallyDerivedProductCategory = "fluid"
+	BiologicallyDerivedProductCategoryCells           BiologicallyDerivedProductCategory = "cells"
+	BiologicallyDerivedProductCategoryBiologicalAgent BiologicallyDerivedProductCategory = "biologicalAgent"
 )
 
 func (code BiologicallyDerivedProductCategory) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "BiologicallyDerivedProductCategory"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *BiologicallyDerivedProductCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "organ":
-		*code = BiologicallyDerivedProductCategoryOrgan
-	case "tissue":
-		*code = BiologicallyDerivedProductCategoryTissue
-	case "fluid":
-		*code = BiologicallyDerivedProductCategoryFluid
-	case "cells":
-		*code = BiologicallyDerivedProductCategoryCells
-	case "biologicalAgent":
-		*code = BiologicallyDerivedProductCategoryBiologicalAgent
-	default:
-		return fmt.Errorf("unknown BiologicallyDerivedProductCategory code `%s`", s)
+func (code *BiologicallyDerivedProductCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = BiologicallyDerivedProductCategory(s)
 	return nil
 }
 func (code BiologicallyDerivedProductCategory) String() string {
 	return code.Code()
 }
 func (code BiologicallyDerivedProductCategory) Code() string {
+	return string(code)
+}
+func (code BiologicallyDerivedProductCategory) Known() bool {
 	switch code {
-	case BiologicallyDerivedProductCategoryOrgan:
-		return "organ"
-	case BiologicallyDerivedProductCategoryTissue:
-		return "tissue"
-	case BiologicallyDerivedProductCategoryFluid:
-		return "fluid"
-	case BiologicallyDerivedProductCategoryCells:
-		return "cells"
-	case BiologicallyDerivedProductCategoryBiologicalAgent:
-		return "biologicalAgent"
+	case BiologicallyDerivedProductCategoryOrgan, BiologicallyDerivedProductCategoryTissue, BiologicallyDerivedProductCategoryFluid, BiologicallyDerivedProductCategoryCells, BiologicallyDerivedProductCategoryBiologicalAgent:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code BiologicallyDerivedProductCategory) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.14442 +0000 UTC
+// 2026-10-19 02:55:18.713720947 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // BiologicallyDerivedProductStatus is documented here http://hl7.org/fhir/ValueSet/product-status
-type BiologicallyDerivedProductStatus int
+// The empty BiologicallyDerivedProductStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type BiologicallyDerivedProductStatus string
 
 const (
-	BiologicallyDerivedProductStatusAvailable BiologicallyDerivedProductStatus = iota
-	BiologicallyDerivedProductStatusUnavailable
+	BiologicallyDerivedProductStatusAvailable   BiologicallyDerivedProductStatus = "available"
+	BiologicallyDerivedProductStatusUnavailable BiologicallyDerivedProductStatus = "unavailable"
 )
 
 func (code BiologicallyDerivedProductStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "BiologicallyDerivedProductStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *BiologicallyDerivedProductStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "available":
-		*code = BiologicallyDerivedProductStatusAvailable
-	case "unavailable":
-		*code = BiologicallyDerivedProductStatusUnavailable
-	default:
-		return fmt.Errorf("unknown BiologicallyDerivedProductStatus code `%s`", s)
+func (code *BiologicallyDerivedProductStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = BiologicallyDerivedProductStatus(s)
 	return nil
 }
 func (code BiologicallyDerivedProductStatus) String() string {
 	return code.Code()
 }
 func (code BiologicallyDerivedProductStatus) Code() string {
+	return string(code)
+}
+func (code BiologicallyDerivedProductStatus) Known() bool {
 	switch code {
-	case BiologicallyDerivedProductStatusAvailable:
-		return "available"
-	case BiologicallyDerivedProductStatusUnavailable:
-		return "unavailable"
+	case BiologicallyDerivedProductStatusAvailable, BiologicallyDerivedProductStatusUnavailable:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code BiologicallyDerivedProductStatus) Display() string {
 	switch code {
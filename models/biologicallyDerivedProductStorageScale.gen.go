@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.174268 +0000 UTC
+// 2026-10-19 02:55:18.71465012 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // BiologicallyDerivedProductStorageScale is documented here http://hl7.org/fhir/ValueSet/product-storage-scale
-type BiologicallyDerivedProductStorageScale int
+// The empty BiologicallyDerivedProductStorageScale is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type BiologicallyDerivedProductStorageScale string
 
 const (
-	BiologicallyDerivedProductStorageScaleFarenheit BiologicallyDerivedProductStorageScale = iota
-	BiologicallyDerivedProductStorageScaleCelsius
-	BiologicallyDerivedProductStorageScaleKelvin
+	BiologicallyDerivedProductStorageScaleFarenheit BiologicallyDerivedProductStorageScale = "farenheit"
+	BiologicallyDerivedProductStorageScaleCelsius   BiologicallyDerivedProductStorageScale = "celsius"
+	BiologicallyDerivedProductStorageScaleKelvin    BiologicallyDerivedProductStorageScale = "kelvin"
 )
 
 func (code BiologicallyDerivedProductStorageScale) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "BiologicallyDerivedProductStorageScale"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *BiologicallyDerivedProductStorageScale) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "farenheit":
-		*code = BiologicallyDerivedProductStorageScaleFarenheit
-	case "celsius":
-		*code = BiologicallyDerivedProductStorageScaleCelsius
-	case "kelvin":
-		*code = BiologicallyDerivedProductStorageScaleKelvin
-	default:
-		return fmt.Errorf("unknown BiologicallyDerivedProductStorageScale code `%s`", s)
+func (code *BiologicallyDerivedProductStorageScale) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = BiologicallyDerivedProductStorageScale(s)
 	return nil
 }
 func (code BiologicallyDerivedProductStorageScale) String() string {
 	return code.Code()
 }
 func (code BiologicallyDerivedProductStorageScale) Code() string {
+	return string(code)
+}
+func (code BiologicallyDerivedProductStorageScale) Known() bool {
 	switch code {
-	case BiologicallyDerivedProductStorageScaleFarenheit:
-		return "farenheit"
-	case BiologicallyDerivedProductStorageScaleCelsius:
-		return "celsius"
-	case BiologicallyDerivedProductStorageScaleKelvin:
-		return "kelvin"
+	case BiologicallyDerivedProductStorageScaleFarenheit, BiologicallyDerivedProductStorageScaleCelsius, BiologicallyDerivedProductStorageScaleKelvin:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code BiologicallyDerivedProductStorageScale) Display() string {
 	switch code {
@@ -22,33 +22,53 @@ import "encoding/json"
 
 // BodyStructure is documented here http://hl7.org/fhir/StructureDefinition/BodyStructure
 type BodyStructure struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool             `bson:"active,omitempty" json:"active,omitempty"`
-	Morphology        *CodeableConcept  `bson:"morphology,omitempty" json:"morphology,omitempty"`
-	Location          *CodeableConcept  `bson:"location,omitempty" json:"location,omitempty"`
-	LocationQualifier []CodeableConcept `bson:"locationQualifier,omitempty" json:"locationQualifier,omitempty"`
-	Description       *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Image             []Attachment      `bson:"image,omitempty" json:"image,omitempty"`
-	Patient           Reference         `bson:"patient" json:"patient"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Morphology           *CodeableConcept           `bson:"morphology,omitempty" json:"morphology,omitempty"`
+	Location             *CodeableConcept           `bson:"location,omitempty" json:"location,omitempty"`
+	LocationQualifier    []CodeableConcept          `bson:"locationQualifier,omitempty" json:"locationQualifier,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Image                []Attachment               `bson:"image,omitempty" json:"image,omitempty"`
+	Patient              Reference                  `bson:"patient" json:"patient"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the BodyStructure and fails with CodeError when a coded element has an unknown code.
+func (r *BodyStructure) UnmarshalJSON(b []byte) error {
+	type other BodyStructure
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("BodyStructure", r)
+}
+
 type OtherBodyStructure BodyStructure
 
 // MarshalJSON marshals the given BodyStructure as JSON into a byte slice
 func (r BodyStructure) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherBodyStructure
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherBodyStructure: OtherBodyStructure(r),
 		ResourceType:       "BodyStructure",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalBodyStructure unmarshals a BodyStructure.
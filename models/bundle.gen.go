@@ -22,75 +22,168 @@ import "encoding/json"
 
 // Bundle is documented here http://hl7.org/fhir/StructureDefinition/Bundle
 type Bundle struct {
-	ID            *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta          *Meta         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules *string       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language      *string       `bson:"language,omitempty" json:"language,omitempty"`
-	Identifier    *Identifier   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type          BundleType    `bson:"type" json:"type"`
-	Timestamp     *string       `bson:"timestamp,omitempty" json:"timestamp,omitempty"`
-	Total         *int          `bson:"total,omitempty" json:"total,omitempty"`
-	Link          []BundleLink  `bson:"link,omitempty" json:"link,omitempty"`
-	Entry         []BundleEntry `bson:"entry,omitempty" json:"entry,omitempty"`
-	Signature     *Signature    `bson:"signature,omitempty" json:"signature,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Identifier           *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                 BundleType                 `bson:"type" json:"type"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Timestamp            *Instant                   `bson:"timestamp,omitempty" json:"timestamp,omitempty"`
+	TimestampElement     *Element                   `bson:"_timestamp,omitempty" json:"_timestamp,omitempty"`
+	Total                *int                       `bson:"total,omitempty" json:"total,omitempty"`
+	TotalElement         *Element                   `bson:"_total,omitempty" json:"_total,omitempty"`
+	Link                 []BundleLink               `bson:"link,omitempty" json:"link,omitempty"`
+	Entry                []BundleEntry              `bson:"entry,omitempty" json:"entry,omitempty"`
+	Signature            *Signature                 `bson:"signature,omitempty" json:"signature,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type BundleLink struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Relation          string      `bson:"relation" json:"relation"`
-	URL               string      `bson:"url" json:"url"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Relation          string                     `bson:"relation" json:"relation"`
+	RelationElement   *Element                   `bson:"_relation,omitempty" json:"_relation,omitempty"`
+	URL               string                     `bson:"url" json:"url"`
+	URLElement        *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the BundleLink with its unknown fields.
+func (r BundleLink) MarshalJSON() ([]byte, error) {
+	type other BundleLink
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type BundleEntry struct {
-	ID                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Link              []BundleLink         `bson:"link,omitempty" json:"link,omitempty"`
-	FullUrl           *string              `bson:"fullUrl,omitempty" json:"fullUrl,omitempty"`
-	Resource          json.RawMessage      `bson:"resource,omitempty" json:"resource,omitempty"`
-	Search            *BundleEntrySearch   `bson:"search,omitempty" json:"search,omitempty"`
-	Request           *BundleEntryRequest  `bson:"request,omitempty" json:"request,omitempty"`
-	Response          *BundleEntryResponse `bson:"response,omitempty" json:"response,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Link              []BundleLink               `bson:"link,omitempty" json:"link,omitempty"`
+	FullUrl           *string                    `bson:"fullUrl,omitempty" json:"fullUrl,omitempty"`
+	FullUrlElement    *Element                   `bson:"_fullUrl,omitempty" json:"_fullUrl,omitempty"`
+	Resource          json.RawMessage            `bson:"resource,omitempty" json:"resource,omitempty"`
+	Search            *BundleEntrySearch         `bson:"search,omitempty" json:"search,omitempty"`
+	Request           *BundleEntryRequest        `bson:"request,omitempty" json:"request,omitempty"`
+	Response          *BundleEntryResponse       `bson:"response,omitempty" json:"response,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type BundleEntrySearch struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Mode              *SearchEntryMode `bson:"mode,omitempty" json:"mode,omitempty"`
-	Score             *Decimal         `bson:"score,omitempty" json:"score,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode              *SearchEntryMode           `bson:"mode,omitempty" json:"mode,omitempty"`
+	ModeElement       *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Score             *Decimal                   `bson:"score,omitempty" json:"score,omitempty"`
+	ScoreElement      *Element                   `bson:"_score,omitempty" json:"_score,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the BundleEntrySearch with its unknown fields.
+func (r BundleEntrySearch) MarshalJSON() ([]byte, error) {
+	type other BundleEntrySearch
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type BundleEntryRequest struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Method            HTTPVerb    `bson:"method" json:"method"`
-	URL               string      `bson:"url" json:"url"`
-	IfNoneMatch       *string     `bson:"ifNoneMatch,omitempty" json:"ifNoneMatch,omitempty"`
-	IfModifiedSince   *string     `bson:"ifModifiedSince,omitempty" json:"ifModifiedSince,omitempty"`
-	IfMatch           *string     `bson:"ifMatch,omitempty" json:"ifMatch,omitempty"`
-	IfNoneExist       *string     `bson:"ifNoneExist,omitempty" json:"ifNoneExist,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Method                 HTTPVerb                   `bson:"method" json:"method"`
+	MethodElement          *Element                   `bson:"_method,omitempty" json:"_method,omitempty"`
+	URL                    string                     `bson:"url" json:"url"`
+	URLElement             *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	IfNoneMatch            *string                    `bson:"ifNoneMatch,omitempty" json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement     *Element                   `bson:"_ifNoneMatch,omitempty" json:"_ifNoneMatch,omitempty"`
+	IfModifiedSince        *Instant                   `bson:"ifModifiedSince,omitempty" json:"ifModifiedSince,omitempty"`
+	IfModifiedSinceElement *Element                   `bson:"_ifModifiedSince,omitempty" json:"_ifModifiedSince,omitempty"`
+	IfMatch                *string                    `bson:"ifMatch,omitempty" json:"ifMatch,omitempty"`
+	IfMatchElement         *Element                   `bson:"_ifMatch,omitempty" json:"_ifMatch,omitempty"`
+	IfNoneExist            *string                    `bson:"ifNoneExist,omitempty" json:"ifNoneExist,omitempty"`
+	IfNoneExistElement     *Element                   `bson:"_ifNoneExist,omitempty" json:"_ifNoneExist,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the BundleEntryRequest with its unknown fields.
+func (r BundleEntryRequest) MarshalJSON() ([]byte, error) {
+	type other BundleEntryRequest
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type BundleEntryResponse struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Status            string          `bson:"status" json:"status"`
-	Location          *string         `bson:"location,omitempty" json:"location,omitempty"`
-	Etag              *string         `bson:"etag,omitempty" json:"etag,omitempty"`
-	LastModified      *string         `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
-	Outcome           json.RawMessage `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status              string                     `bson:"status" json:"status"`
+	StatusElement       *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Location            *string                    `bson:"location,omitempty" json:"location,omitempty"`
+	LocationElement     *Element                   `bson:"_location,omitempty" json:"_location,omitempty"`
+	Etag                *string                    `bson:"etag,omitempty" json:"etag,omitempty"`
+	EtagElement         *Element                   `bson:"_etag,omitempty" json:"_etag,omitempty"`
+	LastModified        *Instant                   `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
+	LastModifiedElement *Element                   `bson:"_lastModified,omitempty" json:"_lastModified,omitempty"`
+	Outcome             json.RawMessage            `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the BundleEntryResponse with its unknown fields.
+func (r BundleEntryResponse) MarshalJSON() ([]byte, error) {
+	type other BundleEntryResponse
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the BundleEntry with its unknown fields.
+func (r BundleEntry) MarshalJSON() ([]byte, error) {
+	type other BundleEntry
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Bundle and fails with CodeError when a coded element has an unknown code.
+func (r *Bundle) UnmarshalJSON(b []byte) error {
+	type other Bundle
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Bundle", r)
+}
+
 type OtherBundle Bundle
 
 // MarshalJSON marshals the given Bundle as JSON into a byte slice
 func (r Bundle) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherBundle
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherBundle:  OtherBundle(r),
 		ResourceType: "Bundle",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalBundle unmarshals a Bundle.
@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.196658 +0000 UTC
+// 2026-10-19 02:55:18.715818472 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // BundleType is documented here http://hl7.org/fhir/ValueSet/bundle-type
-type BundleType int
+// The empty BundleType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type BundleType string
 
 const (
-	BundleTypeDocument BundleType = iota
-	BundleTypeMessage
-	BundleTypeTransaction
-	BundleTypeTransactionResponse
-	BundleTypeBatch
-	BundleTypeBatchResponse
-	BundleTypeHistory
-	BundleTypeSearchset
-	BundleTypeCollection
+	BundleTypeDocument            BundleType = "document"
+	BundleTypeMessage             BundleType = "message"
+	BundleTypeTransaction         BundleType = "transaction"
+	BundleTypeTransactionResponse BundleType = "transaction-response"
+	BundleTypeBatch               BundleType = "batch"
+	BundleTypeBatchResponse       BundleType = "batch-response"
+	BundleTypeHistory             BundleType = "history"
+	BundleTypeSearchset           BundleType = "searchset"
+	BundleTypeCollection          BundleType = "collection"
 )
 
 func (code BundleType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "BundleType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *BundleType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "document":
-		*code = BundleTypeDocument
-	case "message":
-		*code = BundleTypeMessage
-	case "transaction":
-		*code = BundleTypeTransaction
-	case "transaction-response":
-		*code = BundleTypeTransactionResponse
-	case "batch":
-		*code = BundleTypeBatch
-	case "batch-response":
-		*code = BundleTypeBatchResponse
-	case "history":
-		*code = BundleTypeHistory
-	case "searchset":
-		*code = BundleTypeSearchset
-	case "collection":
-		*code = BundleTypeCollection
-	default:
-		return fmt.Errorf("unknown BundleType code `%s`", s)
+func (code *BundleType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = BundleType(s)
 	return nil
 }
 func (code BundleType) String() string {
 	return code.Code()
 }
 func (code BundleType) Code() string {
+	return string(code)
+}
+func (code BundleType) Known() bool {
 	switch code {
-	case BundleTypeDocument:
-		return "document"
-	case BundleTypeMessage:
-		return "message"
-	case BundleTypeTransaction:
-		return "transaction"
-	case BundleTypeTransactionResponse:
-		return "transaction-response"
-	case BundleTypeBatch:
-		return "batch"
-	case BundleTypeBatchResponse:
-		return "batch-response"
-	case BundleTypeHistory:
-		return "history"
-	case BundleTypeSearchset:
-		return "searchset"
-	case BundleTypeCollection:
-		return "collection"
+	case BundleTypeDocument, BundleTypeMessage, BundleTypeTransaction, BundleTypeTransactionResponse, BundleTypeBatch, BundleTypeBatchResponse, BundleTypeHistory, BundleTypeSearchset, BundleTypeCollection:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code BundleType) Display() string {
 	switch code {
@@ -22,172 +22,451 @@ import "encoding/json"
 
 // CapabilityStatement is documented here http://hl7.org/fhir/StructureDefinition/CapabilityStatement
 type CapabilityStatement struct {
-	ID                  *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL                 *string                            `bson:"url,omitempty" json:"url,omitempty"`
-	Version             *string                            `bson:"version,omitempty" json:"version,omitempty"`
-	Name                *string                            `bson:"name,omitempty" json:"name,omitempty"`
-	Title               *string                            `bson:"title,omitempty" json:"title,omitempty"`
-	Status              PublicationStatus                  `bson:"status" json:"status"`
-	Experimental        *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date                DateTime                           `bson:"date" json:"date"`
-	Publisher           *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact             []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description         *string                            `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext          []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction        []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose             *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright           *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Kind                CapabilityStatementKind            `bson:"kind" json:"kind"`
-	Instantiates        []string                           `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
-	Imports             []string                           `bson:"imports,omitempty" json:"imports,omitempty"`
-	Software            *CapabilityStatementSoftware       `bson:"software,omitempty" json:"software,omitempty"`
-	Implementation      *CapabilityStatementImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
-	FhirVersion         FHIRVersion                        `bson:"fhirVersion" json:"fhirVersion"`
-	Format              []string                           `bson:"format" json:"format"`
-	PatchFormat         []string                           `bson:"patchFormat,omitempty" json:"patchFormat,omitempty"`
-	ImplementationGuide []string                           `bson:"implementationGuide,omitempty" json:"implementationGuide,omitempty"`
-	Rest                []CapabilityStatementRest          `bson:"rest,omitempty" json:"rest,omitempty"`
-	Messaging           []CapabilityStatementMessaging     `bson:"messaging,omitempty" json:"messaging,omitempty"`
-	Document            []CapabilityStatementDocument      `bson:"document,omitempty" json:"document,omitempty"`
+	ID                         *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                       *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                        *string                            `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement                 *Element                           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version                    *string                            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement             *Element                           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                       *string                            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                *Element                           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                      *string                            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement               *Element                           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                     PublicationStatus                  `bson:"status" json:"status"`
+	StatusElement              *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental               *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement        *Element                           `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                       DateTime                           `bson:"date" json:"date"`
+	DateElement                *Element                           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher                  *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement           *Element                           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                    []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description                *string                            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                           `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext                 []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction               []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                    *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement             *Element                           `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright                  *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement           *Element                           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Kind                       CapabilityStatementKind            `bson:"kind" json:"kind"`
+	KindElement                *Element                           `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Instantiates               []*string                          `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
+	InstantiatesElement        []*Element                         `bson:"_instantiates,omitempty" json:"_instantiates,omitempty"`
+	Imports                    []*string                          `bson:"imports,omitempty" json:"imports,omitempty"`
+	ImportsElement             []*Element                         `bson:"_imports,omitempty" json:"_imports,omitempty"`
+	Software                   *CapabilityStatementSoftware       `bson:"software,omitempty" json:"software,omitempty"`
+	Implementation             *CapabilityStatementImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
+	FhirVersion                FHIRVersion                        `bson:"fhirVersion" json:"fhirVersion"`
+	FhirVersionElement         *Element                           `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	Format                     []*string                          `bson:"format" json:"format"`
+	FormatElement              []*Element                         `bson:"_format,omitempty" json:"_format,omitempty"`
+	PatchFormat                []*string                          `bson:"patchFormat,omitempty" json:"patchFormat,omitempty"`
+	PatchFormatElement         []*Element                         `bson:"_patchFormat,omitempty" json:"_patchFormat,omitempty"`
+	ImplementationGuide        []*string                          `bson:"implementationGuide,omitempty" json:"implementationGuide,omitempty"`
+	ImplementationGuideElement []*Element                         `bson:"_implementationGuide,omitempty" json:"_implementationGuide,omitempty"`
+	Rest                       []CapabilityStatementRest          `bson:"rest,omitempty" json:"rest,omitempty"`
+	Messaging                  []CapabilityStatementMessaging     `bson:"messaging,omitempty" json:"messaging,omitempty"`
+	Document                   []CapabilityStatementDocument      `bson:"document,omitempty" json:"document,omitempty"`
+	UnknownFields              map[string]json.RawMessage         `bson:"-" json:"-"`
 }
 type CapabilityStatementSoftware struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
-	ReleaseDate       *DateTime   `bson:"releaseDate,omitempty" json:"releaseDate,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               string                     `bson:"name" json:"name"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Version            *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement     *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	ReleaseDate        *DateTime                  `bson:"releaseDate,omitempty" json:"releaseDate,omitempty"`
+	ReleaseDateElement *Element                   `bson:"_releaseDate,omitempty" json:"_releaseDate,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CapabilityStatementSoftware with its unknown fields.
+func (r CapabilityStatementSoftware) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementSoftware
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementImplementation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	URL               *string     `bson:"url,omitempty" json:"url,omitempty"`
-	Custodian         *Reference  `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string                     `bson:"description" json:"description"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	URL                *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement         *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Custodian          *Reference                 `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CapabilityStatementImplementation with its unknown fields.
+func (r CapabilityStatementImplementation) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementImplementation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementRest struct {
-	ID                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Mode              RestfulCapabilityMode                        `bson:"mode" json:"mode"`
-	Documentation     *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Security          *CapabilityStatementRestSecurity             `bson:"security,omitempty" json:"security,omitempty"`
-	Resource          []CapabilityStatementRestResource            `bson:"resource,omitempty" json:"resource,omitempty"`
-	Interaction       []CapabilityStatementRestInteraction         `bson:"interaction,omitempty" json:"interaction,omitempty"`
-	SearchParam       []CapabilityStatementRestResourceSearchParam `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
-	Operation         []CapabilityStatementRestResourceOperation   `bson:"operation,omitempty" json:"operation,omitempty"`
-	Compartment       []string                                     `bson:"compartment,omitempty" json:"compartment,omitempty"`
+	ID                   *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode                 RestfulCapabilityMode                        `bson:"mode" json:"mode"`
+	ModeElement          *Element                                     `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Documentation        *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                                     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Security             *CapabilityStatementRestSecurity             `bson:"security,omitempty" json:"security,omitempty"`
+	Resource             []CapabilityStatementRestResource            `bson:"resource,omitempty" json:"resource,omitempty"`
+	Interaction          []CapabilityStatementRestInteraction         `bson:"interaction,omitempty" json:"interaction,omitempty"`
+	SearchParam          []CapabilityStatementRestResourceSearchParam `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	Operation            []CapabilityStatementRestResourceOperation   `bson:"operation,omitempty" json:"operation,omitempty"`
+	Compartment          []*string                                    `bson:"compartment,omitempty" json:"compartment,omitempty"`
+	CompartmentElement   []*Element                                   `bson:"_compartment,omitempty" json:"_compartment,omitempty"`
+	UnknownFields        map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type CapabilityStatementRestSecurity struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Cors              *bool             `bson:"cors,omitempty" json:"cors,omitempty"`
-	Service           []CodeableConcept `bson:"service,omitempty" json:"service,omitempty"`
-	Description       *string           `bson:"description,omitempty" json:"description,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Cors               *bool                      `bson:"cors,omitempty" json:"cors,omitempty"`
+	CorsElement        *Element                   `bson:"_cors,omitempty" json:"_cors,omitempty"`
+	Service            []CodeableConcept          `bson:"service,omitempty" json:"service,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CapabilityStatementRestSecurity with its unknown fields.
+func (r CapabilityStatementRestSecurity) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRestSecurity
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type CapabilityStatementRestResource struct {
-	ID                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              ResourceType                                 `bson:"type" json:"type"`
-	Profile           *string                                      `bson:"profile,omitempty" json:"profile,omitempty"`
-	SupportedProfile  []string                                     `bson:"supportedProfile,omitempty" json:"supportedProfile,omitempty"`
-	Documentation     *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Interaction       []CapabilityStatementRestResourceInteraction `bson:"interaction,omitempty" json:"interaction,omitempty"`
-	Versioning        *ResourceVersionPolicy                       `bson:"versioning,omitempty" json:"versioning,omitempty"`
-	ReadHistory       *bool                                        `bson:"readHistory,omitempty" json:"readHistory,omitempty"`
-	UpdateCreate      *bool                                        `bson:"updateCreate,omitempty" json:"updateCreate,omitempty"`
-	ConditionalCreate *bool                                        `bson:"conditionalCreate,omitempty" json:"conditionalCreate,omitempty"`
-	ConditionalRead   *ConditionalReadStatus                       `bson:"conditionalRead,omitempty" json:"conditionalRead,omitempty"`
-	ConditionalUpdate *bool                                        `bson:"conditionalUpdate,omitempty" json:"conditionalUpdate,omitempty"`
-	ConditionalDelete *ConditionalDeleteStatus                     `bson:"conditionalDelete,omitempty" json:"conditionalDelete,omitempty"`
-	ReferencePolicy   []ReferenceHandlingPolicy                    `bson:"referencePolicy,omitempty" json:"referencePolicy,omitempty"`
-	SearchInclude     []string                                     `bson:"searchInclude,omitempty" json:"searchInclude,omitempty"`
-	SearchRevInclude  []string                                     `bson:"searchRevInclude,omitempty" json:"searchRevInclude,omitempty"`
-	SearchParam       []CapabilityStatementRestResourceSearchParam `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
-	Operation         []CapabilityStatementRestResourceOperation   `bson:"operation,omitempty" json:"operation,omitempty"`
+	ID                       *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     ResourceType                                 `bson:"type" json:"type"`
+	TypeElement              *Element                                     `bson:"_type,omitempty" json:"_type,omitempty"`
+	Profile                  *string                                      `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement           *Element                                     `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	SupportedProfile         []*string                                    `bson:"supportedProfile,omitempty" json:"supportedProfile,omitempty"`
+	SupportedProfileElement  []*Element                                   `bson:"_supportedProfile,omitempty" json:"_supportedProfile,omitempty"`
+	Documentation            *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement     *Element                                     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Interaction              []CapabilityStatementRestResourceInteraction `bson:"interaction,omitempty" json:"interaction,omitempty"`
+	Versioning               *ResourceVersionPolicy                       `bson:"versioning,omitempty" json:"versioning,omitempty"`
+	VersioningElement        *Element                                     `bson:"_versioning,omitempty" json:"_versioning,omitempty"`
+	ReadHistory              *bool                                        `bson:"readHistory,omitempty" json:"readHistory,omitempty"`
+	ReadHistoryElement       *Element                                     `bson:"_readHistory,omitempty" json:"_readHistory,omitempty"`
+	UpdateCreate             *bool                                        `bson:"updateCreate,omitempty" json:"updateCreate,omitempty"`
+	UpdateCreateElement      *Element                                     `bson:"_updateCreate,omitempty" json:"_updateCreate,omitempty"`
+	ConditionalCreate        *bool                                        `bson:"conditionalCreate,omitempty" json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *Element                                     `bson:"_conditionalCreate,omitempty" json:"_conditionalCreate,omitempty"`
+	ConditionalRead          *ConditionalReadStatus                       `bson:"conditionalRead,omitempty" json:"conditionalRead,omitempty"`
+	ConditionalReadElement   *Element                                     `bson:"_conditionalRead,omitempty" json:"_conditionalRead,omitempty"`
+	ConditionalUpdate        *bool                                        `bson:"conditionalUpdate,omitempty" json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *Element                                     `bson:"_conditionalUpdate,omitempty" json:"_conditionalUpdate,omitempty"`
+	ConditionalDelete        *ConditionalDeleteStatus                     `bson:"conditionalDelete,omitempty" json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *Element                                     `bson:"_conditionalDelete,omitempty" json:"_conditionalDelete,omitempty"`
+	ReferencePolicy          []*ReferenceHandlingPolicy                   `bson:"referencePolicy,omitempty" json:"referencePolicy,omitempty"`
+	ReferencePolicyElement   []*Element                                   `bson:"_referencePolicy,omitempty" json:"_referencePolicy,omitempty"`
+	SearchInclude            []*string                                    `bson:"searchInclude,omitempty" json:"searchInclude,omitempty"`
+	SearchIncludeElement     []*Element                                   `bson:"_searchInclude,omitempty" json:"_searchInclude,omitempty"`
+	SearchRevInclude         []*string                                    `bson:"searchRevInclude,omitempty" json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement  []*Element                                   `bson:"_searchRevInclude,omitempty" json:"_searchRevInclude,omitempty"`
+	SearchParam              []CapabilityStatementRestResourceSearchParam `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	Operation                []CapabilityStatementRestResourceOperation   `bson:"operation,omitempty" json:"operation,omitempty"`
+	UnknownFields            map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type CapabilityStatementRestResourceInteraction struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              TypeRestfulInteraction `bson:"code" json:"code"`
-	Documentation     *string                `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 TypeRestfulInteraction     `bson:"code" json:"code"`
+	CodeElement          *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CapabilityStatementRestResourceInteraction with its unknown fields.
+func (r CapabilityStatementRestResourceInteraction) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRestResourceInteraction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementRestResourceSearchParam struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string          `bson:"name" json:"name"`
-	Definition        *string         `bson:"definition,omitempty" json:"definition,omitempty"`
-	Type              SearchParamType `bson:"type" json:"type"`
-	Documentation     *string         `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Definition           *string                    `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement    *Element                   `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Type                 SearchParamType            `bson:"type" json:"type"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CapabilityStatementRestResourceSearchParam with its unknown fields.
+func (r CapabilityStatementRestResourceSearchParam) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRestResourceSearchParam
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementRestResourceOperation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Definition        string      `bson:"definition" json:"definition"`
-	Documentation     *string     `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Definition           string                     `bson:"definition" json:"definition"`
+	DefinitionElement    *Element                   `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CapabilityStatementRestResourceOperation with its unknown fields.
+func (r CapabilityStatementRestResourceOperation) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRestResourceOperation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the CapabilityStatementRestResource and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *CapabilityStatementRestResource) UnmarshalJSON(b []byte) error {
+	type other CapabilityStatementRestResource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.rest.resource.supportedProfile", len(r.SupportedProfile), len(r.SupportedProfileElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.rest.resource.referencePolicy", len(r.ReferencePolicy), len(r.ReferencePolicyElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.rest.resource.searchInclude", len(r.SearchInclude), len(r.SearchIncludeElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.rest.resource.searchRevInclude", len(r.SearchRevInclude), len(r.SearchRevIncludeElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CapabilityStatementRestResource with its unknown fields.
+func (r CapabilityStatementRestResource) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRestResource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementRestInteraction struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              SystemRestfulInteraction `bson:"code" json:"code"`
-	Documentation     *string                  `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 SystemRestfulInteraction   `bson:"code" json:"code"`
+	CodeElement          *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CapabilityStatementRestInteraction with its unknown fields.
+func (r CapabilityStatementRestInteraction) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRestInteraction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CapabilityStatementRest and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *CapabilityStatementRest) UnmarshalJSON(b []byte) error {
+	type other CapabilityStatementRest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.rest.compartment", len(r.Compartment), len(r.CompartmentElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CapabilityStatementRest with its unknown fields.
+func (r CapabilityStatementRest) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementRest
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementMessaging struct {
-	ID                *string                                        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Endpoint          []CapabilityStatementMessagingEndpoint         `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	ReliableCache     *int                                           `bson:"reliableCache,omitempty" json:"reliableCache,omitempty"`
-	Documentation     *string                                        `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	SupportedMessage  []CapabilityStatementMessagingSupportedMessage `bson:"supportedMessage,omitempty" json:"supportedMessage,omitempty"`
+	ID                   *string                                        `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Endpoint             []CapabilityStatementMessagingEndpoint         `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ReliableCache        *int                                           `bson:"reliableCache,omitempty" json:"reliableCache,omitempty"`
+	ReliableCacheElement *Element                                       `bson:"_reliableCache,omitempty" json:"_reliableCache,omitempty"`
+	Documentation        *string                                        `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                                       `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	SupportedMessage     []CapabilityStatementMessagingSupportedMessage `bson:"supportedMessage,omitempty" json:"supportedMessage,omitempty"`
+	UnknownFields        map[string]json.RawMessage                     `bson:"-" json:"-"`
 }
 type CapabilityStatementMessagingEndpoint struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Protocol          Coding      `bson:"protocol" json:"protocol"`
-	Address           string      `bson:"address" json:"address"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Protocol          Coding                     `bson:"protocol" json:"protocol"`
+	Address           string                     `bson:"address" json:"address"`
+	AddressElement    *Element                   `bson:"_address,omitempty" json:"_address,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CapabilityStatementMessagingEndpoint with its unknown fields.
+func (r CapabilityStatementMessagingEndpoint) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementMessagingEndpoint
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type CapabilityStatementMessagingSupportedMessage struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Mode              EventCapabilityMode `bson:"mode" json:"mode"`
-	Definition        string              `bson:"definition" json:"definition"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode              EventCapabilityMode        `bson:"mode" json:"mode"`
+	ModeElement       *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Definition        string                     `bson:"definition" json:"definition"`
+	DefinitionElement *Element                   `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CapabilityStatementMessagingSupportedMessage with its unknown fields.
+func (r CapabilityStatementMessagingSupportedMessage) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementMessagingSupportedMessage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the CapabilityStatementMessaging with its unknown fields.
+func (r CapabilityStatementMessaging) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementMessaging
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CapabilityStatementDocument struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Mode              DocumentMode `bson:"mode" json:"mode"`
-	Documentation     *string      `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Profile           string       `bson:"profile" json:"profile"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode                 DocumentMode               `bson:"mode" json:"mode"`
+	ModeElement          *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Profile              string                     `bson:"profile" json:"profile"`
+	ProfileElement       *Element                   `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CapabilityStatementDocument with its unknown fields.
+func (r CapabilityStatementDocument) MarshalJSON() ([]byte, error) {
+	type other CapabilityStatementDocument
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CapabilityStatement and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *CapabilityStatement) UnmarshalJSON(b []byte) error {
+	type other CapabilityStatement
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.instantiates", len(r.Instantiates), len(r.InstantiatesElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.imports", len(r.Imports), len(r.ImportsElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.format", len(r.Format), len(r.FormatElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.patchFormat", len(r.PatchFormat), len(r.PatchFormatElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CapabilityStatement.implementationGuide", len(r.ImplementationGuide), len(r.ImplementationGuideElement)); err != nil {
+		return err
+	}
+	return checkCodes("CapabilityStatement", r)
+}
+
 type OtherCapabilityStatement CapabilityStatement
 
 // MarshalJSON marshals the given CapabilityStatement as JSON into a byte slice
 func (r CapabilityStatement) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCapabilityStatement
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCapabilityStatement: OtherCapabilityStatement(r),
 		ResourceType:             "CapabilityStatement",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCapabilityStatement unmarshals a CapabilityStatement.
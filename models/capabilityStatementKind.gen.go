@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.100767 +0000 UTC
+// 2026-10-19 02:55:18.717164217 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CapabilityStatementKind is documented here http://hl7.org/fhir/ValueSet/capability-statement-kind
-type CapabilityStatementKind int
+// The empty CapabilityStatementKind is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CapabilityStatementKind string
 
 const (
-	CapabilityStatementKindInstance CapabilityStatementKind = iota
-	CapabilityStatementKindCapability
-	CapabilityStatementKindRequirements
+	CapabilityStatementKindInstance     CapabilityStatementKind = "instance"
+	CapabilityStatementKindCapability   CapabilityStatementKind = "capability"
+	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
 func (code CapabilityStatementKind) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CapabilityStatementKind"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CapabilityStatementKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "instance":
-		*code = CapabilityStatementKindInstance
-	case "capability":
-		*code = CapabilityStatementKindCapability
-	case "requirements":
-		*code = CapabilityStatementKindRequirements
-	default:
-		return fmt.Errorf("unknown CapabilityStatementKind code `%s`", s)
+func (code *CapabilityStatementKind) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = CapabilityStatementKind(s)
 	return nil
 }
 func (code CapabilityStatementKind) String() string {
 	return code.Code()
 }
 func (code CapabilityStatementKind) Code() string {
+	return string(code)
+}
+func (code CapabilityStatementKind) Known() bool {
 	switch code {
-	case CapabilityStatementKindInstance:
-		return "instance"
-	case CapabilityStatementKindCapability:
-		return "capability"
-	case CapabilityStatementKindRequirements:
-		return "requirements"
+	case CapabilityStatementKindInstance, CapabilityStatementKindCapability, CapabilityStatementKindRequirements:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CapabilityStatementKind) Display() string {
 	switch code {
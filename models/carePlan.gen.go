@@ -22,78 +22,237 @@ import "encoding/json"
 
 // CarePlan is documented here http://hl7.org/fhir/StructureDefinition/CarePlan
 type CarePlan struct {
-	ID                    *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string           `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string           `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference        `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces              []Reference        `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	PartOf                []Reference        `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                RequestStatus      `bson:"status" json:"status"`
-	Intent                CarePlanIntent     `bson:"intent" json:"intent"`
-	Category              []CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	Title                 *string            `bson:"title,omitempty" json:"title,omitempty"`
-	Description           *string            `bson:"description,omitempty" json:"description,omitempty"`
-	Subject               Reference          `bson:"subject" json:"subject"`
-	Encounter             *Reference         `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Period                *Period            `bson:"period,omitempty" json:"period,omitempty"`
-	Created               *DateTime          `bson:"created,omitempty" json:"created,omitempty"`
-	Author                *Reference         `bson:"author,omitempty" json:"author,omitempty"`
-	Contributor           []Reference        `bson:"contributor,omitempty" json:"contributor,omitempty"`
-	CareTeam              []Reference        `bson:"careTeam,omitempty" json:"careTeam,omitempty"`
-	Addresses             []Reference        `bson:"addresses,omitempty" json:"addresses,omitempty"`
-	SupportingInfo        []Reference        `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Goal                  []Reference        `bson:"goal,omitempty" json:"goal,omitempty"`
-	Activity              []CarePlanActivity `bson:"activity,omitempty" json:"activity,omitempty"`
-	Note                  []Annotation       `bson:"note,omitempty" json:"note,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                     []Reference                `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	PartOf                       []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                       RequestStatus              `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       CarePlanIntent             `bson:"intent" json:"intent"`
+	IntentElement                *Element                   `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Category                     []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Title                        *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement                 *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description                  *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Subject                      Reference                  `bson:"subject" json:"subject"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Period                       *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Created                      *DateTime                  `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement               *Element                   `bson:"_created,omitempty" json:"_created,omitempty"`
+	Author                       *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	Contributor                  []Reference                `bson:"contributor,omitempty" json:"contributor,omitempty"`
+	CareTeam                     []Reference                `bson:"careTeam,omitempty" json:"careTeam,omitempty"`
+	Addresses                    []Reference                `bson:"addresses,omitempty" json:"addresses,omitempty"`
+	SupportingInfo               []Reference                `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Goal                         []Reference                `bson:"goal,omitempty" json:"goal,omitempty"`
+	Activity                     []CarePlanActivity         `bson:"activity,omitempty" json:"activity,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type CarePlanActivity struct {
-	ID                     *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	OutcomeCodeableConcept []CodeableConcept       `bson:"outcomeCodeableConcept,omitempty" json:"outcomeCodeableConcept,omitempty"`
-	OutcomeReference       []Reference             `bson:"outcomeReference,omitempty" json:"outcomeReference,omitempty"`
-	Progress               []Annotation            `bson:"progress,omitempty" json:"progress,omitempty"`
-	Reference              *Reference              `bson:"reference,omitempty" json:"reference,omitempty"`
-	Detail                 *CarePlanActivityDetail `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	OutcomeCodeableConcept []CodeableConcept          `bson:"outcomeCodeableConcept,omitempty" json:"outcomeCodeableConcept,omitempty"`
+	OutcomeReference       []Reference                `bson:"outcomeReference,omitempty" json:"outcomeReference,omitempty"`
+	Progress               []Annotation               `bson:"progress,omitempty" json:"progress,omitempty"`
+	Reference              *Reference                 `bson:"reference,omitempty" json:"reference,omitempty"`
+	Detail                 *CarePlanActivityDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type CarePlanActivityDetail struct {
-	ID                    *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Kind                  *CarePlanActivityKind  `bson:"kind,omitempty" json:"kind,omitempty"`
-	InstantiatesCanonical []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	Code                  *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	ReasonCode            []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Goal                  []Reference            `bson:"goal,omitempty" json:"goal,omitempty"`
-	Status                CarePlanActivityStatus `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	DoNotPerform          *bool                  `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Location              *Reference             `bson:"location,omitempty" json:"location,omitempty"`
-	Performer             []Reference            `bson:"performer,omitempty" json:"performer,omitempty"`
-	DailyAmount           *Quantity              `bson:"dailyAmount,omitempty" json:"dailyAmount,omitempty"`
-	Quantity              *Quantity              `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Description           *string                `bson:"description,omitempty" json:"description,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Kind                         *CarePlanActivityKind      `bson:"kind,omitempty" json:"kind,omitempty"`
+	KindElement                  *Element                   `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	Code                         *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	ReasonCode                   []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Goal                         []Reference                `bson:"goal,omitempty" json:"goal,omitempty"`
+	Status                       CarePlanActivityStatus     `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept           `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	DoNotPerform                 *bool                      `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element                   `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	ScheduledTiming              *Timing                    `bson:"scheduledTiming,omitempty" json:"scheduledTiming,omitempty"`
+	ScheduledPeriod              *Period                    `bson:"scheduledPeriod,omitempty" json:"scheduledPeriod,omitempty"`
+	ScheduledString              *string                    `bson:"scheduledString,omitempty" json:"scheduledString,omitempty"`
+	ScheduledStringElement       *Element                   `bson:"_scheduledString,omitempty" json:"_scheduledString,omitempty"`
+	Location                     *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	Performer                    []Reference                `bson:"performer,omitempty" json:"performer,omitempty"`
+	ProductCodeableConcept       *CodeableConcept           `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
+	ProductReference             *Reference                 `bson:"productReference,omitempty" json:"productReference,omitempty"`
+	DailyAmount                  *Quantity                  `bson:"dailyAmount,omitempty" json:"dailyAmount,omitempty"`
+	Quantity                     *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Description                  *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Scheduled returns CarePlan.activity.detail.scheduled[x] of the set type, such as *Timing, or nil when it is not set.
+func (r *CarePlanActivityDetail) Scheduled() interface{} {
+	switch {
+	case r.ScheduledTiming != nil:
+		return r.ScheduledTiming
+	case r.ScheduledPeriod != nil:
+		return r.ScheduledPeriod
+	case r.ScheduledString != nil:
+		return r.ScheduledString
+	}
+	return nil
+}
+
+// SetScheduledTiming sets CarePlan.activity.detail.scheduled[x] to the Timing and clears the other types.
+func (r *CarePlanActivityDetail) SetScheduledTiming(v Timing) {
+	r.clearScheduled()
+	r.ScheduledTiming = &v
+}
+
+// SetScheduledPeriod sets CarePlan.activity.detail.scheduled[x] to the Period and clears the other types.
+func (r *CarePlanActivityDetail) SetScheduledPeriod(v Period) {
+	r.clearScheduled()
+	r.ScheduledPeriod = &v
+}
+
+// SetScheduledString sets CarePlan.activity.detail.scheduled[x] to the string and clears the other types.
+func (r *CarePlanActivityDetail) SetScheduledString(v string) {
+	r.clearScheduled()
+	r.ScheduledString = &v
+}
+func (r *CarePlanActivityDetail) clearScheduled() {
+	r.ScheduledTiming = nil
+	r.ScheduledPeriod = nil
+	r.ScheduledString = nil
+	r.ScheduledStringElement = nil
+}
+
+// Product returns CarePlan.activity.detail.product[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *CarePlanActivityDetail) Product() interface{} {
+	switch {
+	case r.ProductCodeableConcept != nil:
+		return r.ProductCodeableConcept
+	case r.ProductReference != nil:
+		return r.ProductReference
+	}
+	return nil
+}
+
+// SetProductCodeableConcept sets CarePlan.activity.detail.product[x] to the CodeableConcept and clears the other types.
+func (r *CarePlanActivityDetail) SetProductCodeableConcept(v CodeableConcept) {
+	r.clearProduct()
+	r.ProductCodeableConcept = &v
+}
+
+// SetProductReference sets CarePlan.activity.detail.product[x] to the Reference and clears the other types.
+func (r *CarePlanActivityDetail) SetProductReference(v Reference) {
+	r.clearProduct()
+	r.ProductReference = &v
+}
+func (r *CarePlanActivityDetail) clearProduct() {
+	r.ProductCodeableConcept = nil
+	r.ProductReference = nil
+}
+
+// UnmarshalJSON unmarshals the CarePlanActivityDetail and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *CarePlanActivityDetail) UnmarshalJSON(b []byte) error {
+	type other CarePlanActivityDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CarePlan.activity.detail.scheduled[x]",
+		r.ScheduledTiming != nil,
+		r.ScheduledPeriod != nil,
+		r.ScheduledString != nil || r.ScheduledStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CarePlan.activity.detail.product[x]",
+		r.ProductCodeableConcept != nil,
+		r.ProductReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("CarePlan.activity.detail.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CarePlan.activity.detail.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CarePlanActivityDetail with its unknown fields.
+func (r CarePlanActivityDetail) MarshalJSON() ([]byte, error) {
+	type other CarePlanActivityDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the CarePlanActivity with its unknown fields.
+func (r CarePlanActivity) MarshalJSON() ([]byte, error) {
+	type other CarePlanActivity
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CarePlan and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *CarePlan) UnmarshalJSON(b []byte) error {
+	type other CarePlan
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CarePlan.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("CarePlan.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("CarePlan", r)
+}
+
 type OtherCarePlan CarePlan
 
 // MarshalJSON marshals the given CarePlan as JSON into a byte slice
 func (r CarePlan) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCarePlan
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCarePlan: OtherCarePlan(r),
 		ResourceType:  "CarePlan",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCarePlan unmarshals a CarePlan.
@@ -14,780 +14,197 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.160332 +0000 UTC
+// 2026-10-19 02:55:18.719042725 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CarePlanActivityKind is documented here http://hl7.org/fhir/ValueSet/care-plan-activity-kind
-type CarePlanActivityKind int
+// The empty CarePlanActivityKind is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CarePlanActivityKind string
 
 const (
-	CarePlanActivityKindAccount CarePlanActivityKind = iota
-	CarePlanActivityKindActivityDefinition
-	CarePlanActivityKindAdverseEvent
-	CarePlanActivityKindAllergyIntolerance
-	CarePlanActivityKindAppointment
-	CarePlanActivityKindAppointmentResponse
-	CarePlanActivityKindAuditEvent
-	CarePlanActivityKindBasic
-	CarePlanActivityKindBinary
-	CarePlanActivityKindBiologicallyDerivedProduct
-	CarePlanActivityKindBodyStructure
-	CarePlanActivityKindBundle
-	CarePlanActivityKindCapabilityStatement
-	CarePlanActivityKindCarePlan
-	CarePlanActivityKindCareTeam
-	CarePlanActivityKindCatalogEntry
-	CarePlanActivityKindChargeItem
-	CarePlanActivityKindChargeItemDefinition
-	CarePlanActivityKindClaim
-	CarePlanActivityKindClaimResponse
-	CarePlanActivityKindClinicalImpression
-	CarePlanActivityKindCodeSystem
-	CarePlanActivityKindCommunication
-	CarePlanActivityKindCommunicationRequest
-	CarePlanActivityKindCompartmentDefinition
-	CarePlanActivityKindComposition
-	CarePlanActivityKindConceptMap
-	CarePlanActivityKindCondition
-	CarePlanActivityKindConsent
-	CarePlanActivityKindContract
-	CarePlanActivityKindCoverage
-	CarePlanActivityKindCoverageEligibilityRequest
-	CarePlanActivityKindCoverageEligibilityResponse
-	CarePlanActivityKindDetectedIssue
-	CarePlanActivityKindDevice
-	CarePlanActivityKindDeviceDefinition
-	CarePlanActivityKindDeviceMetric
-	CarePlanActivityKindDeviceRequest
-	CarePlanActivityKindDeviceUseStatement
-	CarePlanActivityKindDiagnosticReport
-	CarePlanActivityKindDocumentManifest
-	CarePlanActivityKindDocumentReference
-	CarePlanActivityKindDomainResource
-	CarePlanActivityKindEffectEvidenceSynthesis
-	CarePlanActivityKindEncounter
-	CarePlanActivityKindEndpoint
-	CarePlanActivityKindEnrollmentRequest
-	CarePlanActivityKindEnrollmentResponse
-	CarePlanActivityKindEpisodeOfCare
-	CarePlanActivityKindEventDefinition
-	CarePlanActivityKindEvidence
-	CarePlanActivityKindEvidenceVariable
-	CarePlanActivityKindExampleScenario
-	CarePlanActivityKindExplanationOfBenefit
-	CarePlanActivityKindFamilyMemberHistory
-	CarePlanActivityKindFlag
-	CarePlanActivityKindGoal
-	CarePlanActivityKindGraphDefinition
-	CarePlanActivityKindGroup
-	CarePlanActivityKindGuidanceResponse
-	CarePlanActivityKindHealthcareService
-	CarePlanActivityKindImagingStudy
-	CarePlanActivityKindImmunization
-	CarePlanActivityKindImmunizationEvaluation
-	CarePlanActivityKindImmunizationRecommendation
-	CarePlanActivityKindImplementationGuide
-	CarePlanActivityKindInsurancePlan
-	CarePlanActivityKindInvoice
-	CarePlanActivityKindLibrary
-	CarePlanActivityKindLinkage
-	CarePlanActivityKindList
-	CarePlanActivityKindLocation
-	CarePlanActivityKindMeasure
-	CarePlanActivityKindMeasureReport
-	CarePlanActivityKindMedia
-	CarePlanActivityKindMedication
-	CarePlanActivityKindMedicationAdministration
-	CarePlanActivityKindMedicationDispense
-	CarePlanActivityKindMedicationKnowledge
-	CarePlanActivityKindMedicationRequest
-	CarePlanActivityKindMedicationStatement
-	CarePlanActivityKindMedicinalProduct
-	CarePlanActivityKindMedicinalProductAuthorization
-	CarePlanActivityKindMedicinalProductContraindication
-	CarePlanActivityKindMedicinalProductIndication
-	CarePlanActivityKindMedicinalProductIngredient
-	CarePlanActivityKindMedicinalProductInteraction
-	CarePlanActivityKindMedicinalProductManufactured
-	CarePlanActivityKindMedicinalProductPackaged
-	CarePlanActivityKindMedicinalProductPharmaceutical
-	CarePlanActivityKindMedicinalProductUndesirableEffect
-	CarePlanActivityKindMessageDefinition
-	CarePlanActivityKindMessageHeader
-	CarePlanActivityKindMolecularSequence
-	CarePlanActivityKindNamingSystem
-	CarePlanActivityKindNutritionOrder
-	CarePlanActivityKindObservation
-	CarePlanActivityKindObservationDefinition
-	CarePlanActivityKindOperationDefinition
-	CarePlanActivityKindOperationOutcome
-	CarePlanActivityKindOrganization
-	CarePlanActivityKindOrganizationAffiliation
-	CarePlanActivityKindParameters
-	CarePlanActivityKindPatient
-	CarePlanActivityKindPaymentNotice
-	CarePlanActivityKindPaymentReconciliation
-	CarePlanActivityKindPerson
-	CarePlanActivityKindPlanDefinition
-	CarePlanActivityKindPractitioner
-	CarePlanActivityKindPractitionerRole
-	CarePlanActivityKindProcedure
-	CarePlanActivityKindProvenance
-	CarePlanActivityKindQuestionnaire
-	CarePlanActivityKindQuestionnaireResponse
-	CarePlanActivityKindRelatedPerson
-	CarePlanActivityKindRequestGroup
-	CarePlanActivityKindResearchDefinition
-	CarePlanActivityKindResearchElementDefinition
-	CarePlanActivityKindResearchStudy
-	CarePlanActivityKindResearchSubject
-	CarePlanActivityKindResource
-	CarePlanActivityKindRiskAssessment
-	CarePlanActivityKindRiskEvidenceSynthesis
-	CarePlanActivityKindSchedule
-	CarePlanActivityKindSearchParameter
-	CarePlanActivityKindServiceRequest
-	CarePlanActivityKindSlot
-	CarePlanActivityKindSpecimen
-	CarePlanActivityKindSpecimenDefinition
-	CarePlanActivityKindStructureDefinition
-	CarePlanActivityKindStructureMap
-	CarePlanActivityKindSubscription
-	CarePlanActivityKindSubstance
-	CarePlanActivityKindSubstanceNucleicAcid
-	CarePlanActivityKindSubstancePolymer
-	CarePlanActivityKindSubstanceProtein
-	CarePlanActivityKindSubstanceReferenceInformation
-	CarePlanActivityKindSubstanceSourceMaterial
-	CarePlanActivityKindSubstanceSpecification
-	CarePlanActivityKindSupplyDelivery
-	CarePlanActivityKindSupplyRequest
-	CarePlanActivityKindTask
-	CarePlanActivityKindTerminologyCapabilities
-	CarePlanActivityKindTestReport
-	CarePlanActivityKindTestScript
-	CarePlanActivityKindValueSet
-	CarePlanActivityKindVerificationResult
-	CarePlanActivityKindVisionPrescription
+	CarePlanActivityKindAccount                           CarePlanActivityKind = "Account"
+	CarePlanActivityKindActivityDefinition                CarePlanActivityKind = "ActivityDefinition"
+	CarePlanActivityKindAdverseEvent                      CarePlanActivityKind = "AdverseEvent"
+	CarePlanActivityKindAllergyIntolerance                CarePlanActivityKind = "AllergyIntolerance"
+	CarePlanActivityKindAppointment                       CarePlanActivityKind = "Appointment"
+	CarePlanActivityKindAppointmentResponse               CarePlanActivityKind = "AppointmentResponse"
+	CarePlanActivityKindAuditEvent                        CarePlanActivityKind = "AuditEvent"
+	CarePlanActivityKindBasic                             CarePlanActivityKind = "Basic"
+	CarePlanActivityKindBinary                            CarePlanActivityKind = "Binary"
+	CarePlanActivityKindBiologicallyDerivedProduct        CarePlanActivityKind = "BiologicallyDerivedProduct"
+	CarePlanActivityKindBodyStructure                     CarePlanActivityKind = "BodyStructure"
+	CarePlanActivityKindBundle                            CarePlanActivityKind = "Bundle"
+	CarePlanActivityKindCapabilityStatement               CarePlanActivityKind = "CapabilityStatement"
+	CarePlanActivityKindCarePlan                          CarePlanActivityKind = "CarePlan"
+	CarePlanActivityKindCareTeam                          CarePlanActivityKind = "CareTeam"
+	CarePlanActivityKindCatalogEntry                      CarePlanActivityKind = "CatalogEntry"
+	CarePlanActivityKindChargeItem                        CarePlanActivityKind = "ChargeItem"
+	CarePlanActivityKindChargeItemDefinition              CarePlanActivityKind = "ChargeItemDefinition"
+	CarePlanActivityKindClaim                             CarePlanActivityKind = "Claim"
+	CarePlanActivityKindClaimResponse                     CarePlanActivityKind = "ClaimResponse"
+	CarePlanActivityKindClinicalImpression                CarePlanActivityKind = "ClinicalImpression"
+	CarePlanActivityKindCodeSystem                        CarePlanActivityKind = "CodeSystem"
+	CarePlanActivityKindCommunication                     CarePlanActivityKind = "Communication"
+	CarePlanActivityKindCommunicationRequest              CarePlanActivityKind = "CommunicationRequest"
+	CarePlanActivityKindCompartmentDefinition             CarePlanActivityKind = "CompartmentDefinition"
+	CarePlanActivityKindComposition                       CarePlanActivityKind = "Composition"
+	CarePlanActivityKindConceptMap                        CarePlanActivityKind = "ConceptMap"
+	CarePlanActivityKindCondition                         CarePlanActivityKind = "Condition"
+	CarePlanActivityKindConsent                           CarePlanActivityKind = "Consent"
+	CarePlanActivityKindContract                          CarePlanActivityKind = "Contract"
+	CarePlanActivityKindCoverage                          CarePlanActivityKind = "Coverage"
+	CarePlanActivityKindCoverageEligibilityRequest        CarePlanActivityKind = "CoverageEligibilityRequest"
+	CarePlanActivityKindCoverageEligibilityResponse       CarePlanActivityKind = "CoverageEligibilityResponse"
+	CarePlanActivityKindDetectedIssue                     CarePlanActivityKind = "DetectedIssue"
+	CarePlanActivityKindDevice                            CarePlanActivityKind = "Device"
+	CarePlanActivityKindDeviceDefinition                  CarePlanActivityKind = "DeviceDefinition"
+	CarePlanActivityKindDeviceMetric                      CarePlanActivityKind = "DeviceMetric"
+	CarePlanActivityKindDeviceRequest                     CarePlanActivityKind = "DeviceRequest"
+	CarePlanActivityKindDeviceUseStatement                CarePlanActivityKind = "DeviceUseStatement"
+	CarePlanActivityKindDiagnosticReport                  CarePlanActivityKind = "DiagnosticReport"
+	CarePlanActivityKindDocumentManifest                  CarePlanActivityKind = "DocumentManifest"
+	CarePlanActivityKindDocumentReference                 CarePlanActivityKind = "DocumentReference"
+	CarePlanActivityKindDomainResource                    CarePlanActivityKind = "DomainResource"
+	CarePlanActivityKindEffectEvidenceSynthesis           CarePlanActivityKind = "EffectEvidenceSynthesis"
+	CarePlanActivityKindEncounter                         CarePlanActivityKind = "Encounter"
+	CarePlanActivityKindEndpoint                          CarePlanActivityKind = "Endpoint"
+	CarePlanActivityKindEnrollmentRequest                 CarePlanActivityKind = "EnrollmentRequest"
+	CarePlanActivityKindEnrollmentResponse                CarePlanActivityKind = "EnrollmentResponse"
+	CarePlanActivityKindEpisodeOfCare                     CarePlanActivityKind = "EpisodeOfCare"
+	CarePlanActivityKindEventDefinition                   CarePlanActivityKind = "EventDefinition"
+	CarePlanActivityKindEvidence                          CarePlanActivityKind = "Evidence"
+	CarePlanActivityKindEvidenceVariable                  CarePlanActivityKind = "EvidenceVariable"
+	CarePlanActivityKindExampleScenario                   CarePlanActivityKind = "ExampleScenario"
+	CarePlanActivityKindExplanationOfBenefit              CarePlanActivityKind = "ExplanationOfBenefit"
+	CarePlanActivityKindFamilyMemberHistory               CarePlanActivityKind = "FamilyMemberHistory"
+	CarePlanActivityKindFlag                              CarePlanActivityKind = "Flag"
+	CarePlanActivityKindGoal                              CarePlanActivityKind = "Goal"
+	CarePlanActivityKindGraphDefinition                   CarePlanActivityKind = "GraphDefinition"
+	CarePlanActivityKindGroup                             CarePlanActivityKind = "Group"
+	CarePlanActivityKindGuidanceResponse                  CarePlanActivityKind = "GuidanceResponse"
+	CarePlanActivityKindHealthcareService                 CarePlanActivityKind = "HealthcareService"
+	CarePlanActivityKindImagingStudy                      CarePlanActivityKind = "ImagingStudy"
+	CarePlanActivityKindImmunization                      CarePlanActivityKind = "Immunization"
+	CarePlanActivityKindImmunizationEvaluation            CarePlanActivityKind = "ImmunizationEvaluation"
+	CarePlanActivityKindImmunizationRecommendation        CarePlanActivityKind = "ImmunizationRecommendation"
+	CarePlanActivityKindImplementationGuide               CarePlanActivityKind = "ImplementationGuide"
+	CarePlanActivityKindInsurancePlan                     CarePlanActivityKind = "InsurancePlan"
+	CarePlanActivityKindInvoice                           CarePlanActivityKind = "Invoice"
+	CarePlanActivityKindLibrary                           CarePlanActivityKind = "Library"
+	CarePlanActivityKindLinkage                           CarePlanActivityKind = "Linkage"
+	CarePlanActivityKindList                              CarePlanActivityKind = "List"
+	CarePlanActivityKindLocation                          CarePlanActivityKind = "Location"
+	CarePlanActivityKindMeasure                           CarePlanActivityKind = "Measure"
+	CarePlanActivityKindMeasureReport                     CarePlanActivityKind = "MeasureReport"
+	CarePlanActivityKindMedia                             CarePlanActivityKind = "Media"
+	CarePlanActivityKindMedication                        CarePlanActivityKind = "Medication"
+	CarePlanActivityKindMedicationAdministration          CarePlanActivityKind = "MedicationAdministration"
+	CarePlanActivityKindMedicationDispense                CarePlanActivityKind = "MedicationDispense"
+	CarePlanActivityKindMedicationKnowledge               CarePlanActivityKind = "MedicationKnowledge"
+	CarePlanActivityKindMedicationRequest                 CarePlanActivityKind = "MedicationRequest"
+	CarePlanActivityKindMedicationStatement               CarePlanActivityKind = "MedicationStatement"
+	CarePlanActivityKindMedicinalProduct                  CarePlanActivityKind = "MedicinalProduct"
+	CarePlanActivityKindMedicinalProductAuthorization     CarePlanActivityKind = "MedicinalProductAuthorization"
+	CarePlanActivityKindMedicinalProductContraindication  CarePlanActivityKind = "MedicinalProductContraindication"
+	CarePlanActivityKindMedicinalProductIndication        CarePlanActivityKind = "MedicinalProductIndication"
+	CarePlanActivityKindMedicinalProductIngredient        CarePlanActivityKind = "MedicinalProductIngredient"
+	CarePlanActivityKindMedicinalProductInteraction       CarePlanActivityKind = "MedicinalProductInteraction"
+	CarePlanActivityKindMedicinalProductManufactured      CarePlanActivityKind = "MedicinalProductManufactured"
+	CarePlanActivityKindMedicinalProductPackaged          CarePlanActivityKind = "MedicinalProductPackaged"
+	CarePlanActivityKindMedicinalProductPharmaceutical    CarePlanActivityKind = "MedicinalProductPharmaceutical"
+	CarePlanActivityKindMedicinalProductUndesirableEffect CarePlanActivityKind = "MedicinalProductUndesirableEffect"
+	CarePlanActivityKindMessageDefinition                 CarePlanActivityKind = "MessageDefinition"
+	CarePlanActivityKindMessageHeader                     CarePlanActivityKind = "MessageHeader"
+	CarePlanActivityKindMolecularSequence                 CarePlanActivityKind = "MolecularSequence"
+	CarePlanActivityKindNamingSystem                      CarePlanActivityKind = "NamingSystem"
+	CarePlanActivityKindNutritionOrder                    CarePlanActivityKind = "NutritionOrder"
+	CarePlanActivityKindObservation                       CarePlanActivityKind = "Observation"
+	CarePlanActivityKindObservationDefinition             CarePlanActivityKind = "ObservationDefinition"
+	CarePlanActivityKindOperationDefinition               CarePlanActivityKind = "OperationDefinition"
+	CarePlanActivityKindOperationOutcome                  CarePlanActivityKind = "OperationOutcome"
+	CarePlanActivityKindOrganization                      CarePlanActivityKind = "Organization"
+	CarePlanActivityKindOrganizationAffiliation           CarePlanActivityKind = "OrganizationAffiliation"
+	CarePlanActivityKindParameters                        CarePlanActivityKind = "Parameters"
+	CarePlanActivityKindPatient                           CarePlanActivityKind = "Patient"
+	CarePlanActivityKindPaymentNotice                     CarePlanActivityKind = "PaymentNotice"
+	CarePlanActivityKindPaymentReconciliation             CarePlanActivityKind = "PaymentReconciliation"
+	CarePlanActivityKindPerson                            CarePlanActivityKind = "Person"
+	CarePlanActivityKindPlanDefinition                    CarePlanActivityKind = "PlanDefinition"
+	CarePlanActivityKindPractitioner                      CarePlanActivityKind = "Practitioner"
+	CarePlanActivityKindPractitionerRole                  CarePlanActivityKind = "PractitionerRole"
+	CarePlanActivityKindProcedure                         CarePlanActivityKind = "Procedure"
+	CarePlanActivityKindProvenance                        CarePlanActivityKind = "Provenance"
+	CarePlanActivityKindQuestionnaire                     CarePlanActivityKind = "Questionnaire"
+	CarePlanActivityKindQuestionnaireResponse             CarePlanActivityKind = "QuestionnaireResponse"
+	CarePlanActivityKindRelatedPerson                     CarePlanActivityKind = "RelatedPerson"
+	CarePlanActivityKindRequestGroup                      CarePlanActivityKind = "RequestGroup"
+	CarePlanActivityKindResearchDefinition                CarePlanActivityKind = "ResearchDefinition"
+	CarePlanActivityKindResearchElementDefinition         CarePlanActivityKind = "ResearchElementDefinition"
+	CarePlanActivityKindResearchStudy                     CarePlanActivityKind = "ResearchStudy"
+	CarePlanActivityKindResearchSubject                   CarePlanActivityKind = "ResearchSubject"
+	CarePlanActivityKindResource                          CarePlanActivityKind = "Resource"
+	CarePlanActivityKindRiskAssessment                    CarePlanActivityKind = "RiskAssessment"
+	CarePlanActivityKindRiskEvidenceSynthesis             CarePlanActivityKind = "RiskEvidenceSynthesis"
+	CarePlanActivityKindSchedule                          CarePlanActivityKind = "Schedule"
+	CarePlanActivityKindSearchParameter                   CarePlanActivityKind = "SearchParameter"
+	CarePlanActivityKindServiceRequest                    CarePlanActivityKind = "ServiceRequest"
+	CarePlanActivityKindSlot                              CarePlanActivityKind = "Slot"
+	CarePlanActivityKindSpecimen                          CarePlanActivityKind = "Specimen"
+	CarePlanActivityKindSpecimenDefinition                CarePlanActivityKind = "SpecimenDefinition"
+	CarePlanActivityKindStructureDefinition               CarePlanActivityKind = "StructureDefinition"
+	CarePlanActivityKindStructureMap                      CarePlanActivityKind = "StructureMap"
+	CarePlanActivityKindSubscription                      CarePlanActivityKind = "Subscription"
+	CarePlanActivityKindSubstance                         CarePlanActivityKind = "Substance"
+	CarePlanActivityKindSubstanceNucleicAcid              CarePlanActivityKind = "SubstanceNucleicAcid"
+	CarePlanActivityKindSubstancePolymer                  CarePlanActivityKind = "SubstancePolymer"
+	CarePlanActivityKindSubstanceProtein                  CarePlanActivityKind = "SubstanceProtein"
+	CarePlanActivityKindSubstanceReferenceInformation     CarePlanActivityKind = "SubstanceReferenceInformation"
+	CarePlanActivityKindSubstanceSourceMaterial           CarePlanActivityKind = "SubstanceSourceMaterial"
+	CarePlanActivityKindSubstanceSpecification            CarePlanActivityKind = "SubstanceSpecification"
+	CarePlanActivityKindSupplyDelivery                    CarePlanActivityKind = "SupplyDelivery"
+	CarePlanActivityKindSupplyRequest                     CarePlanActivityKind = "SupplyRequest"
+	CarePlanActivityKindTask                              CarePlanActivityKind = "Task"
+	CarePlanActivityKindTerminologyCapabilities           CarePlanActivityKind = "TerminologyCapabilities"
+	CarePlanActivityKindTestReport                        CarePlanActivityKind = "TestReport"
+	CarePlanActivityKindTestScript                        CarePlanActivityKind = "TestScript"
+	CarePlanActivityKindValueSet                          CarePlanActivityKind = "ValueSet"
+	CarePlanActivityKindVerificationResult                CarePlanActivityKind = "VerificationResult"
+	CarePlanActivityKindVisionPrescription                CarePlanActivityKind = "VisionPrescription"
 )
 
 func (code CarePlanActivityKind) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CarePlanActivityKind"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CarePlanActivityKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "Account":
-		*code = CarePlanActivityKindAccount
-	case "ActivityDefinition":
-		*code = CarePlanActivityKindActivityDefinition
-	case "AdverseEvent":
-		*code = CarePlanActivityKindAdverseEvent
-	case "AllergyIntolerance":
-		*code = CarePlanActivityKindAllergyIntolerance
-	case "Appointment":
-		*code = CarePlanActivityKindAppointment
-	case "AppointmentResponse":
-		*code = CarePlanActivityKindAppointmentResponse
-	case "AuditEvent":
-		*code = CarePlanActivityKindAuditEvent
-	case "Basic":
-		*code = CarePlanActivityKindBasic
-	case "Binary":
-		*code = CarePlanActivityKindBinary
-	case "BiologicallyDerivedProduct":
-		*code = CarePlanActivityKindBiologicallyDerivedProduct
-	case "BodyStructure":
-		*code = CarePlanActivityKindBodyStructure
-	case "Bundle":
-		*code = CarePlanActivityKindBundle
-	case "CapabilityStatement":
-		*code = CarePlanActivityKindCapabilityStatement
-	case "CarePlan":
-		*code = CarePlanActivityKindCarePlan
-	case "CareTeam":
-		*code = CarePlanActivityKindCareTeam
-	case "CatalogEntry":
-		*code = CarePlanActivityKindCatalogEntry
-	case "ChargeItem":
-		*code = CarePlanActivityKindChargeItem
-	case "ChargeItemDefinition":
-		*code = CarePlanActivityKindChargeItemDefinition
-	case "Claim":
-		*code = CarePlanActivityKindClaim
-	case "ClaimResponse":
-		*code = CarePlanActivityKindClaimResponse
-	case "ClinicalImpression":
-		*code = CarePlanActivityKindClinicalImpression
-	case "CodeSystem":
-		*code = CarePlanActivityKindCodeSystem
-	case "Communication":
-		*code = CarePlanActivityKindCommunication
-	case "CommunicationRequest":
-		*code = CarePlanActivityKindCommunicationRequest
-	case "CompartmentDefinition":
-		*code = CarePlanActivityKindCompartmentDefinition
-	case "Composition":
-		*code = CarePlanActivityKindComposition
-	case "ConceptMap":
-		*code = CarePlanActivityKindConceptMap
-	case "Condition":
-		*code = CarePlanActivityKindCondition
-	case "Consent":
-		*code = CarePlanActivityKindConsent
-	case "Contract":
-		*code = CarePlanActivityKindContract
-	case "Coverage":
-		*code = CarePlanActivityKindCoverage
-	case "CoverageEligibilityRequest":
-		*code = CarePlanActivityKindCoverageEligibilityRequest
-	case "CoverageEligibilityResponse":
-		*code = CarePlanActivityKindCoverageEligibilityResponse
-	case "DetectedIssue":
-		*code = CarePlanActivityKindDetectedIssue
-	case "Device":
-		*code = CarePlanActivityKindDevice
-	case "DeviceDefinition":
-		*code = CarePlanActivityKindDeviceDefinition
-	case "DeviceMetric":
-		*code = CarePlanActivityKindDeviceMetric
-	case "DeviceRequest":
-		*code = CarePlanActivityKindDeviceRequest
-	case "DeviceUseStatement":
-		*code = CarePlanActivityKindDeviceUseStatement
-	case "DiagnosticReport":
-		*code = CarePlanActivityKindDiagnosticReport
-	case "DocumentManifest":
-		*code = CarePlanActivityKindDocumentManifest
-	case "DocumentReference":
-		*code = CarePlanActivityKindDocumentReference
-	case "DomainResource":
-		*code = CarePlanActivityKindDomainResource
-	case "EffectEvidenceSynthesis":
-		*code = CarePlanActivityKindEffectEvidenceSynthesis
-	case "Encounter":
-		*code = CarePlanActivityKindEncounter
-	case "Endpoint":
-		*code = CarePlanActivityKindEndpoint
-	case "EnrollmentRequest":
-		*code = CarePlanActivityKindEnrollmentRequest
-	case "EnrollmentResponse":
-		*code = CarePlanActivityKindEnrollmentResponse
-	case "EpisodeOfCare":
-		*code = CarePlanActivityKindEpisodeOfCare
-	case "EventDefinition":
-		*code = CarePlanActivityKindEventDefinition
-	case "Evidence":
-		*code = CarePlanActivityKindEvidence
-	case "EvidenceVariable":
-		*code = CarePlanActivityKindEvidenceVariable
-	case "ExampleScenario":
-		*code = CarePlanActivityKindExampleScenario
-	case "ExplanationOfBenefit":
-		*code = CarePlanActivityKindExplanationOfBenefit
-	case "FamilyMemberHistory":
-		*code = CarePlanActivityKindFamilyMemberHistory
-	case "Flag":
-		*code = CarePlanActivityKindFlag
-	case "Goal":
-		*code = CarePlanActivityKindGoal
-	case "GraphDefinition":
-		*code = CarePlanActivityKindGraphDefinition
-	case "Group":
-		*code = CarePlanActivityKindGroup
-	case "GuidanceResponse":
-		*code = CarePlanActivityKindGuidanceResponse
-	case "HealthcareService":
-		*code = CarePlanActivityKindHealthcareService
-	case "ImagingStudy":
-		*code = CarePlanActivityKindImagingStudy
-	case "Immunization":
-		*code = CarePlanActivityKindImmunization
-	case "ImmunizationEvaluation":
-		*code = CarePlanActivityKindImmunizationEvaluation
-	case "ImmunizationRecommendation":
-		*code = CarePlanActivityKindImmunizationRecommendation
-	case "ImplementationGuide":
-		*code = CarePlanActivityKindImplementationGuide
-	case "InsurancePlan":
-		*code = CarePlanActivityKindInsurancePlan
-	case "Invoice":
-		*code = CarePlanActivityKindInvoice
-	case "Library":
-		*code = CarePlanActivityKindLibrary
-	case "Linkage":
-		*code = CarePlanActivityKindLinkage
-	case "List":
-		*code = CarePlanActivityKindList
-	case "Location":
-		*code = CarePlanActivityKindLocation
-	case "Measure":
-		*code = CarePlanActivityKindMeasure
-	case "MeasureReport":
-		*code = CarePlanActivityKindMeasureReport
-	case "Media":
-		*code = CarePlanActivityKindMedia
-	case "Medication":
-		*code = CarePlanActivityKindMedication
-	case "MedicationAdministration":
-		*code = CarePlanActivityKindMedicationAdministration
-	case "MedicationDispense":
-		*code = CarePlanActivityKindMedicationDispense
-	case "MedicationKnowledge":
-		*code = CarePlanActivityKindMedicationKnowledge
-	case "MedicationRequest":
-		*code = CarePlanActivityKindMedicationRequest
-	case "MedicationStatement":
-		*code = CarePlanActivityKindMedicationStatement
-	case "MedicinalProduct":
-		*code = CarePlanActivityKindMedicinalProduct
-	case "MedicinalProductAuthorization":
-		*code = CarePlanActivityKindMedicinalProductAuthorization
-	case "MedicinalProductContraindication":
-		*code = CarePlanActivityKindMedicinalProductContraindication
-	case "MedicinalProductIndication":
-		*code = CarePlanActivityKindMedicinalProductIndication
-	case "MedicinalProductIngredient":
-		*code = CarePlanActivityKindMedicinalProductIngredient
-	case "MedicinalProductInteraction":
-		*code = CarePlanActivityKindMedicinalProductInteraction
-	case "MedicinalProductManufactured":
-		*code = CarePlanActivityKindMedicinalProductManufactured
-	case "MedicinalProductPackaged":
-		*code = CarePlanActivityKindMedicinalProductPackaged
-	case "MedicinalProductPharmaceutical":
-		*code = CarePlanActivityKindMedicinalProductPharmaceutical
-	case "MedicinalProductUndesirableEffect":
-		*code = CarePlanActivityKindMedicinalProductUndesirableEffect
-	case "MessageDefinition":
-		*code = CarePlanActivityKindMessageDefinition
-	case "MessageHeader":
-		*code = CarePlanActivityKindMessageHeader
-	case "MolecularSequence":
-		*code = CarePlanActivityKindMolecularSequence
-	case "NamingSystem":
-		*code = CarePlanActivityKindNamingSystem
-	case "NutritionOrder":
-		*code = CarePlanActivityKindNutritionOrder
-	case "Observation":
-		*code = CarePlanActivityKindObservation
-	case "ObservationDefinition":
-		*code = CarePlanActivityKindObservationDefinition
-	case "OperationDefinition":
-		*code = CarePlanActivityKindOperationDefinition
-	case "OperationOutcome":
-		*code = CarePlanActivityKindOperationOutcome
-	case "Organization":
-		*code = CarePlanActivityKindOrganization
-	case "OrganizationAffiliation":
-		*code = CarePlanActivityKindOrganizationAffiliation
-	case "Parameters":
-		*code = CarePlanActivityKindParameters
-	case "Patient":
-		*code = CarePlanActivityKindPatient
-	case "PaymentNotice":
-		*code = CarePlanActivityKindPaymentNotice
-	case "PaymentReconciliation":
-		*code = CarePlanActivityKindPaymentReconciliation
-	case "Person":
-		*code = CarePlanActivityKindPerson
-	case "PlanDefinition":
-		*code = CarePlanActivityKindPlanDefinition
-	case "Practitioner":
-		*code = CarePlanActivityKindPractitioner
-	case "PractitionerRole":
-		*code = CarePlanActivityKindPractitionerRole
-	case "Procedure":
-		*code = CarePlanActivityKindProcedure
-	case "Provenance":
-		*code = CarePlanActivityKindProvenance
-	case "Questionnaire":
-		*code = CarePlanActivityKindQuestionnaire
-	case "QuestionnaireResponse":
-		*code = CarePlanActivityKindQuestionnaireResponse
-	case "RelatedPerson":
-		*code = CarePlanActivityKindRelatedPerson
-	case "RequestGroup":
-		*code = CarePlanActivityKindRequestGroup
-	case "ResearchDefinition":
-		*code = CarePlanActivityKindResearchDefinition
-	case "ResearchElementDefinition":
-		*code = CarePlanActivityKindResearchElementDefinition
-	case "ResearchStudy":
-		*code = CarePlanActivityKindResearchStudy
-	case "ResearchSubject":
-		*code = CarePlanActivityKindResearchSubject
-	case "Resource":
-		*code = CarePlanActivityKindResource
-	case "RiskAssessment":
-		*code = CarePlanActivityKindRiskAssessment
-	case "RiskEvidenceSynthesis":
-		*code = CarePlanActivityKindRiskEvidenceSynthesis
-	case "Schedule":
-		*code = CarePlanActivityKindSchedule
-	case "SearchParameter":
-		*code = CarePlanActivityKindSearchParameter
-	case "ServiceRequest":
-		*code = CarePlanActivityKindServiceRequest
-	case "Slot":
-		*code = CarePlanActivityKindSlot
-	case "Specimen":
-		*code = CarePlanActivityKindSpecimen
-	case "SpecimenDefinition":
-		*code = CarePlanActivityKindSpecimenDefinition
-	case "StructureDefinition":
-		*code = CarePlanActivityKindStructureDefinition
-	case "StructureMap":
-		*code = CarePlanActivityKindStructureMap
-	case "Subscription":
-		*code = CarePlanActivityKindSubscription
-	case "Substance":
-		*code = CarePlanActivityKindSubstance
-	case "SubstanceNucleicAcid":
-		*code = CarePlanActivityKindSubstanceNucleicAcid
-	case "SubstancePolymer":
-		*code = CarePlanActivityKindSubstancePolymer
-	case "SubstanceProtein":
-		*code = CarePlanActivityKindSubstanceProtein
-	case "SubstanceReferenceInformation":
-		*code = CarePlanActivityKindSubstanceReferenceInformation
-	case "SubstanceSourceMaterial":
-		*code = CarePlanActivityKindSubstanceSourceMaterial
-	case "SubstanceSpecification":
-		*code = CarePlanActivityKindSubstanceSpecification
-	case "SupplyDelivery":
-		*code = CarePlanActivityKindSupplyDelivery
-	case "SupplyRequest":
-		*code = CarePlanActivityKindSupplyRequest
-	case "Task":
-		*code = CarePlanActivityKindTask
-	case "TerminologyCapabilities":
-		*code = CarePlanActivityKindTerminologyCapabilities
-	case "TestReport":
-		*code = CarePlanActivityKindTestReport
-	case "TestScript":
-		*code = CarePlanActivityKindTestScript
-	case "ValueSet":
-		*code = CarePlanActivityKindValueSet
-	case "VerificationResult":
-		*code = CarePlanActivityKindVerificationResult
-	case "VisionPrescription":
-		*code = CarePlanActivityKindVisionPrescription
-	default:
-		return fmt.Errorf("unknown CarePlanActivityKind code `%s`", s)
+func (code *CarePlanActivityKind) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = CarePlanActivityKind(s)
 	return nil
 }
 func (code CarePlanActivityKind) String() string {
 	return code.Code()
 }
 func (code CarePlanActivityKind) Code() string {
+	return string(code)
+}
+func (code CarePlanActivityKind) Known() bool {
 	switch code {
-	case CarePlanActivityKindAccount:
-		return "Account"
-	case CarePlanActivityKindActivityDefinition:
-		return "ActivityDefinition"
-	case CarePlanActivityKindAdverseEvent:
-		return "AdverseEvent"
-	case CarePlanActivityKindAllergyIntolerance:
-		return "AllergyIntolerance"
-	case CarePlanActivityKindAppointment:
-		return "Appointment"
-	case CarePlanActivityKindAppointmentResponse:
-		return "AppointmentResponse"
-	case CarePlanActivityKindAuditEvent:
-		return "AuditEvent"
-	case CarePlanActivityKindBasic:
-		return "Basic"
-	case CarePlanActivityKindBinary:
-		return "Binary"
-	case CarePlanActivityKindBiologicallyDerivedProduct:
-		return "BiologicallyDerivedProduct"
-	case CarePlanActivityKindBodyStructure:
-		return "BodyStructure"
-	case CarePlanActivityKindBundle:
-		return "Bundle"
-	case CarePlanActivityKindCapabilityStatement:
-		return "CapabilityStatement"
-	case CarePlanActivityKindCarePlan:
-		return "CarePlan"
-	case CarePlanActivityKindCareTeam:
-		return "CareTeam"
-	case CarePlanActivityKindCatalogEntry:
-		return "CatalogEntry"
-	case CarePlanActivityKindChargeItem:
-		return "ChargeItem"
-	case CarePlanActivityKindChargeItemDefinition:
-		return "ChargeItemDefinition"
-	case CarePlanActivityKindClaim:
-		return "Claim"
-	case CarePlanActivityKindClaimResponse:
-		return "ClaimResponse"
-	case CarePlanActivityKindClinicalImpression:
-		return "ClinicalImpression"
-	case CarePlanActivityKindCodeSystem:
-		return "CodeSystem"
-	case CarePlanActivityKindCommunication:
-		return "Communication"
-	case CarePlanActivityKindCommunicationRequest:
-		return "CommunicationRequest"
-	case CarePlanActivityKindCompartmentDefinition:
-		return "CompartmentDefinition"
-	case CarePlanActivityKindComposition:
-		return "Composition"
-	case CarePlanActivityKindConceptMap:
-		return "ConceptMap"
-	case CarePlanActivityKindCondition:
-		return "Condition"
-	case CarePlanActivityKindConsent:
-		return "Consent"
-	case CarePlanActivityKindContract:
-		return "Contract"
-	case CarePlanActivityKindCoverage:
-		return "Coverage"
-	case CarePlanActivityKindCoverageEligibilityRequest:
-		return "CoverageEligibilityRequest"
-	case CarePlanActivityKindCoverageEligibilityResponse:
-		return "CoverageEligibilityResponse"
-	case CarePlanActivityKindDetectedIssue:
-		return "DetectedIssue"
-	case CarePlanActivityKindDevice:
-		return "Device"
-	case CarePlanActivityKindDeviceDefinition:
-		return "DeviceDefinition"
-	case CarePlanActivityKindDeviceMetric:
-		return "DeviceMetric"
-	case CarePlanActivityKindDeviceRequest:
-		return "DeviceRequest"
-	case CarePlanActivityKindDeviceUseStatement:
-		return "DeviceUseStatement"
-	case CarePlanActivityKindDiagnosticReport:
-		return "DiagnosticReport"
-	case CarePlanActivityKindDocumentManifest:
-		return "DocumentManifest"
-	case CarePlanActivityKindDocumentReference:
-		return "DocumentReference"
-	case CarePlanActivityKindDomainResource:
-		return "DomainResource"
-	case CarePlanActivityKindEffectEvidenceSynthesis:
-		return "EffectEvidenceSynthesis"
-	case CarePlanActivityKindEncounter:
-		return "Encounter"
-	case CarePlanActivityKindEndpoint:
-		return "Endpoint"
-	case CarePlanActivityKindEnrollmentRequest:
-		return "EnrollmentRequest"
-	case CarePlanActivityKindEnrollmentResponse:
-		return "EnrollmentResponse"
-	case CarePlanActivityKindEpisodeOfCare:
-		return "EpisodeOfCare"
-	case CarePlanActivityKindEventDefinition:
-		return "EventDefinition"
-	case CarePlanActivityKindEvidence:
-		return "Evidence"
-	case CarePlanActivityKindEvidenceVariable:
-		return "EvidenceVariable"
-	case CarePlanActivityKindExampleScenario:
-		return "ExampleScenario"
-	case CarePlanActivityKindExplanationOfBenefit:
-		return "ExplanationOfBenefit"
-	case CarePlanActivityKindFamilyMemberHistory:
-		return "FamilyMemberHistory"
-	case CarePlanActivityKindFlag:
-		return "Flag"
-	case CarePlanActivityKindGoal:
-		return "Goal"
-	case CarePlanActivityKindGraphDefinition:
-		return "GraphDefinition"
-	case CarePlanActivityKindGroup:
-		return "Group"
-	case CarePlanActivityKindGuidanceResponse:
-		return "GuidanceResponse"
-	case CarePlanActivityKindHealthcareService:
-		return "HealthcareService"
-	case CarePlanActivityKindImagingStudy:
-		return "ImagingStudy"
-	case CarePlanActivityKindImmunization:
-		return "Immunization"
-	case CarePlanActivityKindImmunizationEvaluation:
-		return "ImmunizationEvaluation"
-	case CarePlanActivityKindImmunizationRecommendation:
-		return "ImmunizationRecommendation"
-	case CarePlanActivityKindImplementationGuide:
-		return "ImplementationGuide"
-	case CarePlanActivityKindInsurancePlan:
-		return "InsurancePlan"
-	case CarePlanActivityKindInvoice:
-		return "Invoice"
-	case CarePlanActivityKindLibrary:
-		return "Library"
-	case CarePlanActivityKindLinkage:
-		return "Linkage"
-	case CarePlanActivityKindList:
-		return "List"
-	case CarePlanActivityKindLocation:
-		return "Location"
-	case CarePlanActivityKindMeasure:
-		return "Measure"
-	case CarePlanActivityKindMeasureReport:
-		return "MeasureReport"
-	case CarePlanActivityKindMedia:
-		return "Media"
-	case CarePlanActivityKindMedication:
-		return "Medication"
-	case CarePlanActivityKindMedicationAdministration:
-		return "MedicationAdministration"
-	case CarePlanActivityKindMedicationDispense:
-		return "MedicationDispense"
-	case CarePlanActivityKindMedicationKnowledge:
-		return "MedicationKnowledge"
-	case CarePlanActivityKindMedicationRequest:
-		return "MedicationRequest"
-	case CarePlanActivityKindMedicationStatement:
-		return "MedicationStatement"
-	case CarePlanActivityKindMedicinalProduct:
-		return "MedicinalProduct"
-	case CarePlanActivityKindMedicinalProductAuthorization:
-		return "MedicinalProductAuthorization"
-	case CarePlanActivityKindMedicinalProductContraindication:
-		return "MedicinalProductContraindication"
-	case CarePlanActivityKindMedicinalProductIndication:
-		return "MedicinalProductIndication"
-	case CarePlanActivityKindMedicinalProductIngredient:
-		return "MedicinalProductIngredient"
-	case CarePlanActivityKindMedicinalProductInteraction:
-		return "MedicinalProductInteraction"
-	case CarePlanActivityKindMedicinalProductManufactured:
-		return "MedicinalProductManufactured"
-	case CarePlanActivityKindMedicinalProductPackaged:
-		return "MedicinalProductPackaged"
-	case CarePlanActivityKindMedicinalProductPharmaceutical:
-		return "MedicinalProductPharmaceutical"
-	case CarePlanActivityKindMedicinalProductUndesirableEffect:
-		return "MedicinalProductUndesirableEffect"
-	case CarePlanActivityKindMessageDefinition:
-		return "MessageDefinition"
-	case CarePlanActivityKindMessageHeader:
-		return "MessageHeader"
-	case CarePlanActivityKindMolecularSequence:
-		return "MolecularSequence"
-	case CarePlanActivityKindNamingSystem:
-		return "NamingSystem"
-	case CarePlanActivityKindNutritionOrder:
-		return "NutritionOrder"
-	case CarePlanActivityKindObservation:
-		return "Observation"
-	case CarePlanActivityKindObservationDefinition:
-		return "ObservationDefinition"
-	case CarePlanActivityKindOperationDefinition:
-		return "OperationDefinition"
-	case CarePlanActivityKindOperationOutcome:
-		return "OperationOutcome"
-	case CarePlanActivityKindOrganization:
-		return "Organization"
-	case CarePlanActivityKindOrganizationAffiliation:
-		return "OrganizationAffiliation"
-	case CarePlanActivityKindParameters:
-		return "Parameters"
-	case CarePlanActivityKindPatient:
-		return "Patient"
-	case CarePlanActivityKindPaymentNotice:
-		return "PaymentNotice"
-	case CarePlanActivityKindPaymentReconciliation:
-		return "PaymentReconciliation"
-	case CarePlanActivityKindPerson:
-		return "Person"
-	case CarePlanActivityKindPlanDefinition:
-		return "PlanDefinition"
-	case CarePlanActivityKindPractitioner:
-		return "Practitioner"
-	case CarePlanActivityKindPractitionerRole:
-		return "PractitionerRole"
-	case CarePlanActivityKindProcedure:
-		return "Procedure"
-	case CarePlanActivityKindProvenance:
-		return "Provenance"
-	case CarePlanActivityKindQuestionnaire:
-		return "Questionnaire"
-	case CarePlanActivityKindQuestionnaireResponse:
-		return "QuestionnaireResponse"
-	case CarePlanActivityKindRelatedPerson:
-		return "RelatedPerson"
-	case CarePlanActivityKindRequestGroup:
-		return "RequestGroup"
-	case CarePlanActivityKindResearchDefinition:
-		return "ResearchDefinition"
-	case CarePlanActivityKindResearchElementDefinition:
-		return "ResearchElementDefinition"
-	case CarePlanActivityKindResearchStudy:
-		return "ResearchStudy"
-	case CarePlanActivityKindResearchSubject:
-		return "ResearchSubject"
-	case CarePlanActivityKindResource:
-		return "Resource"
-	case CarePlanActivityKindRiskAssessment:
-		return "RiskAssessment"
-	case CarePlanActivityKindRiskEvidenceSynthesis:
-		return "RiskEvidenceSynthesis"
-	case CarePlanActivityKindSchedule:
-		return "Schedule"
-	case CarePlanActivityKindSearchParameter:
-		return "SearchParameter"
-	case CarePlanActivityKindServiceRequest:
-		return "ServiceRequest"
-	case CarePlanActivityKindSlot:
-		return "Slot"
-	case CarePlanActivityKindSpecimen:
-		return "Specimen"
-	case CarePlanActivityKindSpecimenDefinition:
-		return "SpecimenDefinition"
-	case CarePlanActivityKindStructureDefinition:
-		return "StructureDefinition"
-	case CarePlanActivityKindStructureMap:
-		return "StructureMap"
-	case CarePlanActivityKindSubscription:
-		return "Subscription"
-	case CarePlanActivityKindSubstance:
-		return "Substance"
-	case CarePlanActivityKindSubstanceNucleicAcid:
-		return "SubstanceNucleicAcid"
-	case CarePlanActivityKindSubstancePolymer:
-		return "SubstancePolymer"
-	case CarePlanActivityKindSubstanceProtein:
-		return "SubstanceProtein"
-	case CarePlanActivityKindSubstanceReferenceInformation:
-		return "SubstanceReferenceInformation"
-	case CarePlanActivityKindSubstanceSourceMaterial:
-		return "SubstanceSourceMaterial"
-	case CarePlanActivityKindSubstanceSpecification:
-		return "SubstanceSpecification"
-	case CarePlanActivityKindSupplyDelivery:
-		return "SupplyDelivery"
-	case CarePlanActivityKindSupplyRequest:
-		return "SupplyRequest"
-	case CarePlanActivityKindTask:
-		return "Task"
-	case CarePlanActivityKindTerminologyCapabilities:
-		return "TerminologyCapabilities"
-	case CarePlanActivityKindTestReport:
-		return "TestReport"
-	case CarePlanActivityKindTestScript:
-		return "TestScript"
-	case CarePlanActivityKindValueSet:
-		return "ValueSet"
-	case CarePlanActivityKindVerificationResult:
-		return "VerificationResult"
-	case CarePlanActivityKindVisionPrescription:
-		return "VisionPrescription"
+	case CarePlanActivityKindAccount, CarePlanActivityKindActivityDefinition, CarePlanActivityKindAdverseEvent, CarePlanActivityKindAllergyIntolerance, CarePlanActivityKindAppointment, CarePlanActivityKindAppointmentResponse, CarePlanActivityKindAuditEvent, CarePlanActivityKindBasic, CarePlanActivityKindBinary, CarePlanActivityKindBiologicallyDerivedProduct, CarePlanActivityKindBodyStructure, CarePlanActivityKindBundle, CarePlanActivityKindCapabilityStatement, CarePlanActivityKindCarePlan, CarePlanActivityKindCareTeam, CarePlanActivityKindCatalogEntry, CarePlanActivityKindChargeItem, CarePlanActivityKindChargeItemDefinition, CarePlanActivityKindClaim, CarePlanActivityKindClaimResponse, CarePlanActivityKindClinicalImpression, CarePlanActivityKindCodeSystem, CarePlanActivityKindCommunication, CarePlanActivityKindCommunicationRequest, CarePlanActivityKindCompartmentDefinition, CarePlanActivityKindComposition, CarePlanActivityKindConceptMap, CarePlanActivityKindCondition, CarePlanActivityKindConsent, CarePlanActivityKindContract, CarePlanActivityKindCoverage, CarePlanActivityKindCoverageEligibilityRequest, CarePlanActivityKindCoverageEligibilityResponse, CarePlanActivityKindDetectedIssue, CarePlanActivityKindDevice, CarePlanActivityKindDeviceDefinition, CarePlanActivityKindDeviceMetric, CarePlanActivityKindDeviceRequest, CarePlanActivityKindDeviceUseStatement, CarePlanActivityKindDiagnosticReport, CarePlanActivityKindDocumentManifest, CarePlanActivityKindDocumentReference, CarePlanActivityKindDomainResource, CarePlanActivityKindEffectEvidenceSynthesis, CarePlanActivityKindEncounter, CarePlanActivityKindEndpoint, CarePlanActivityKindEnrollmentRequest, CarePlanActivityKindEnrollmentResponse, CarePlanActivityKindEpisodeOfCare, CarePlanActivityKindEventDefinition, CarePlanActivityKindEvidence, CarePlanActivityKindEvidenceVariable, CarePlanActivityKindExampleScenario, CarePlanActivityKindExplanationOfBenefit, CarePlanActivityKindFamilyMemberHistory, CarePlanActivityKindFlag, CarePlanActivityKindGoal, CarePlanActivityKindGraphDefinition, CarePlanActivityKindGroup, CarePlanActivityKindGuidanceResponse, CarePlanActivityKindHealthcareService, CarePlanActivityKindImagingStudy, CarePlanActivityKindImmunization, CarePlanActivityKindImmunizationEvaluation, CarePlanActivityKindImmunizationRecommendation, CarePlanActivityKindImplementationGuide, CarePlanActivityKindInsurancePlan, CarePlanActivityKindInvoice, CarePlanActivityKindLibrary, CarePlanActivityKindLinkage, CarePlanActivityKindList, CarePlanActivityKindLocation, CarePlanActivityKindMeasure, CarePlanActivityKindMeasureReport, CarePlanActivityKindMedia, CarePlanActivityKindMedication, CarePlanActivityKindMedicationAdministration, CarePlanActivityKindMedicationDispense, CarePlanActivityKindMedicationKnowledge, CarePlanActivityKindMedicationRequest, CarePlanActivityKindMedicationStatement, CarePlanActivityKindMedicinalProduct, CarePlanActivityKindMedicinalProductAuthorization, CarePlanActivityKindMedicinalProductContraindication, CarePlanActivityKindMedicinalProductIndication, CarePlanActivityKindMedicinalProductIngredient, CarePlanActivityKindMedicinalProductInteraction, CarePlanActivityKindMedicinalProductManufactured, CarePlanActivityKindMedicinalProductPackaged, CarePlanActivityKindMedicinalProductPharmaceutical, CarePlanActivityKindMedicinalProductUndesirableEffect, CarePlanActivityKindMessageDefinition, CarePlanActivityKindMessageHeader, CarePlanActivityKindMolecularSequence, CarePlanActivityKindNamingSystem, CarePlanActivityKindNutritionOrder, CarePlanActivityKindObservation, CarePlanActivityKindObservationDefinition, CarePlanActivityKindOperationDefinition, CarePlanActivityKindOperationOutcome, CarePlanActivityKindOrganization, CarePlanActivityKindOrganizationAffiliation, CarePlanActivityKindParameters, CarePlanActivityKindPatient, CarePlanActivityKindPaymentNotice, CarePlanActivityKindPaymentReconciliation, CarePlanActivityKindPerson, CarePlanActivityKindPlanDefinition, CarePlanActivityKindPractitioner, CarePlanActivityKindPractitionerRole, CarePlanActivityKindProcedure, CarePlanActivityKindProvenance, CarePlanActivityKindQuestionnaire, CarePlanActivityKindQuestionnaireResponse, CarePlanActivityKindRelatedPerson, CarePlanActivityKindRequestGroup, CarePlanActivityKindResearchDefinition, CarePlanActivityKindResearchElementDefinition, CarePlanActivityKindResearchStudy, CarePlanActivityKindResearchSubject, CarePlanActivityKindResource, CarePlanActivityKindRiskAssessment, CarePlanActivityKindRiskEvidenceSynthesis, CarePlanActivityKindSchedule, CarePlanActivityKindSearchParameter, CarePlanActivityKindServiceRequest, CarePlanActivityKindSlot, CarePlanActivityKindSpecimen, CarePlanActivityKindSpecimenDefinition, CarePlanActivityKindStructureDefinition, CarePlanActivityKindStructureMap, CarePlanActivityKindSubscription, CarePlanActivityKindSubstance, CarePlanActivityKindSubstanceNucleicAcid, CarePlanActivityKindSubstancePolymer, CarePlanActivityKindSubstanceProtein, CarePlanActivityKindSubstanceReferenceInformation, CarePlanActivityKindSubstanceSourceMaterial, CarePlanActivityKindSubstanceSpecification, CarePlanActivityKindSupplyDelivery, CarePlanActivityKindSupplyRequest, CarePlanActivityKindTask, CarePlanActivityKindTerminologyCapabilities, CarePlanActivityKindTestReport, CarePlanActivityKindTestScript, CarePlanActivityKindValueSet, CarePlanActivityKindVerificationResult, CarePlanActivityKindVisionPrescription:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CarePlanActivityKind) Display() string {
 	switch code {
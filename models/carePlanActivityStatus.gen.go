@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.026265 +0000 UTC
+// 2026-10-19 02:55:18.729646567 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CarePlanActivityStatus is documented here http://hl7.org/fhir/ValueSet/care-plan-activity-status
-type CarePlanActivityStatus int
+// The empty CarePlanActivityStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CarePlanActivityStatus string
 
 const (
-	CarePlanActivityStatusNotStarted CarePlanActivityStatus = iota
-	CarePlanActivityStatusScheduled
-	CarePlanActivityStatusInProgress
-	CarePlanActivityStatusOnHold
-	CarePlanActivityStatusCompleted
-	CarePlanActivityStatusCancelled
-	CarePlanActivityStatusStopped
-	CarePlanActivityStatusUnknown
-	CarePlanActivityStatusEnteredInError
+	CarePlanActivityStatusNotStarted     CarePlanActivityStatus = "not-started"
+	CarePlanActivityStatusScheduled      CarePlanActivityStatus = "scheduled"
+	CarePlanActivityStatusInProgress     CarePlanActivityStatus = "in-progress"
+	CarePlanActivityStatusOnHold         CarePlanActivityStatus = "on-hold"
+	CarePlanActivityStatusCompleted      CarePlanActivityStatus = "completed"
+	CarePlanActivityStatusCancelled      CarePlanActivityStatus = "cancelled"
+	CarePlanActivityStatusStopped        CarePlanActivityStatus = "stopped"
+	CarePlanActivityStatusUnknown        CarePlanActivityStatus = "unknown"
+	CarePlanActivityStatusEnteredInError CarePlanActivityStatus = "entered-in-error"
 )
 
 func (code CarePlanActivityStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CarePlanActivityStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CarePlanActivityStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "not-started":
-		*code = CarePlanActivityStatusNotStarted
-	case "scheduled":
-		*code = CarePlanActivityStatusScheduled
-	case "in-progress":
-		*code = CarePlanActivityStatusInProgress
-	case "on-hold":
-		*code = CarePlanActivityStatusOnHold
-	case "completed":
-		*code = CarePlanActivityStatusCompleted
-	case "cancelled":
-		*code = CarePlanActivityStatusCancelled
-	case "stopped":
-		*code = CarePlanActivityStatusStopped
-	case "unknown":
-		*code = CarePlanActivityStatusUnknown
-	case "entered-in-error":
-		*code = CarePlanActivityStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown CarePlanActivityStatus code `%s`", s)
+func (code *CarePlanActivityStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = CarePlanActivityStatus(s)
 	return nil
 }
 func (code CarePlanActivityStatus) String() string {
 	return code.Code()
 }
 func (code CarePlanActivityStatus) Code() string {
+	return string(code)
+}
+func (code CarePlanActivityStatus) Known() bool {
 	switch code {
-	case CarePlanActivityStatusNotStarted:
-		return "not-started"
-	case CarePlanActivityStatusScheduled:
-		return "scheduled"
-	case CarePlanActivityStatusInProgress:
-		return "in-progress"
-	case CarePlanActivityStatusOnHold:
-		return "on-hold"
-	case CarePlanActivityStatusCompleted:
-		return "completed"
-	case CarePlanActivityStatusCancelled:
-		return "cancelled"
-	case CarePlanActivityStatusStopped:
-		return "stopped"
-	case CarePlanActivityStatusUnknown:
-		return "unknown"
-	case CarePlanActivityStatusEnteredInError:
-		return "entered-in-error"
+	case CarePlanActivityStatusNotStarted, CarePlanActivityStatusScheduled, CarePlanActivityStatusInProgress, CarePlanActivityStatusOnHold, CarePlanActivityStatusCompleted, CarePlanActivityStatusCancelled, CarePlanActivityStatusStopped, CarePlanActivityStatusUnknown, CarePlanActivityStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CarePlanActivityStatus) Display() string {
 	switch code {
@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.154953 +0000 UTC
+// 2026-10-19 02:55:18.731220365 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CarePlanIntent is documented here http://hl7.org/fhir/ValueSet/care-plan-intent
-type CarePlanIntent int
+// The empty CarePlanIntent is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CarePlanIntent string
 
 const (
-	CarePlanIntentProposal CarePlanIntent = iota
-	CarePlanIntentPlan
-	CarePlanIntentDirective
-	CarePlanIntentOrder
-	CarePlanIntentOriginalOrder
-	CarePlanIntentReflexOrder
-	CarePlanIntentFillerOrder
-	CarePlanIntentInstanceOrder
-	CarePlanIntentOption
+	CarePlanIntentProposal      CarePlanIntent = "proposal"
+	CarePlanIntentPlan          CarePlanIntent = "plan"
+	CarePlanIntentDirective     CarePlanIntent = "directive"
+	CarePlanIntentOrder         CarePlanIntent = "order"
+	CarePlanIntentOriginalOrder CarePlanIntent = "original-order"
+	CarePlanIntentReflexOrder   CarePlanIntent = "reflex-order"
+	CarePlanIntentFillerOrder   CarePlanIntent = "filler-order"
+	CarePlanIntentInstanceOrder CarePlanIntent = "instance-order"
+	CarePlanIntentOption        CarePlanIntent = "option"
 )
 
 func (code CarePlanIntent) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CarePlanIntent"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CarePlanIntent) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "proposal":
-		*code = CarePlanIntentProposal
-	case "plan":
-		*code = CarePlanIntentPlan
-	case "directive":
-		*code = CarePlanIntentDirective
-	case "order":
-		*code = CarePlanIntentOrder
-	case "original-order":
-		*code = CarePlanIntentOriginalOrder
-	case "reflex-order":
-		*code = CarePlanIntentReflexOrder
-	case "filler-order":
-		*code = CarePlanIntentFillerOrder
-	case "instance-order":
-		*code = CarePlanIntentInstanceOrder
-	case "option":
-		*code = CarePlanIntentOption
-	default:
-		return fmt.Errorf("unknown CarePlanIntent code `%s`", s)
+func (code *CarePlanIntent) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = CarePlanIntent(s)
 	return nil
 }
 func (code CarePlanIntent) String() string {
 	return code.Code()
 }
 func (code CarePlanIntent) Code() string {
+	return string(code)
+}
+func (code CarePlanIntent) Known() bool {
 	switch code {
-	case CarePlanIntentProposal:
-		return "proposal"
-	case CarePlanIntentPlan:
-		return "plan"
-	case CarePlanIntentDirective:
-		return "directive"
-	case CarePlanIntentOrder:
-		return "order"
-	case CarePlanIntentOriginalOrder:
-		return "original-order"
-	case CarePlanIntentReflexOrder:
-		return "reflex-order"
-	case CarePlanIntentFillerOrder:
-		return "filler-order"
-	case CarePlanIntentInstanceOrder:
-		return "instance-order"
-	case CarePlanIntentOption:
-		return "option"
+	case CarePlanIntentProposal, CarePlanIntentPlan, CarePlanIntentDirective, CarePlanIntentOrder, CarePlanIntentOriginalOrder, CarePlanIntentReflexOrder, CarePlanIntentFillerOrder, CarePlanIntentInstanceOrder, CarePlanIntentOption:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CarePlanIntent) Display() string {
 	switch code {
@@ -22,47 +22,78 @@ import "encoding/json"
 
 // CareTeam is documented here http://hl7.org/fhir/StructureDefinition/CareTeam
 type CareTeam struct {
-	ID                   *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status               *CareTeamStatus       `bson:"status,omitempty" json:"status,omitempty"`
-	Category             []CodeableConcept     `bson:"category,omitempty" json:"category,omitempty"`
-	Name                 *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Subject              *Reference            `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter            *Reference            `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Period               *Period               `bson:"period,omitempty" json:"period,omitempty"`
-	Participant          []CareTeamParticipant `bson:"participant,omitempty" json:"participant,omitempty"`
-	ReasonCode           []CodeableConcept     `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference      []Reference           `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	ManagingOrganization []Reference           `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	Telecom              []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Note                 []Annotation          `bson:"note,omitempty" json:"note,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *CareTeamStatus            `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category             []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter            *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Participant          []CareTeamParticipant      `bson:"participant,omitempty" json:"participant,omitempty"`
+	ReasonCode           []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference      []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	ManagingOrganization []Reference                `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	Telecom              []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Note                 []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type CareTeamParticipant struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Role              []CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Member            *Reference        `bson:"member,omitempty" json:"member,omitempty"`
-	OnBehalfOf        *Reference        `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
-	Period            *Period           `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Role              []CodeableConcept          `bson:"role,omitempty" json:"role,omitempty"`
+	Member            *Reference                 `bson:"member,omitempty" json:"member,omitempty"`
+	OnBehalfOf        *Reference                 `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CareTeamParticipant with its unknown fields.
+func (r CareTeamParticipant) MarshalJSON() ([]byte, error) {
+	type other CareTeamParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CareTeam and fails with CodeError when a coded element has an unknown code.
+func (r *CareTeam) UnmarshalJSON(b []byte) error {
+	type other CareTeam
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("CareTeam", r)
+}
+
 type OtherCareTeam CareTeam
 
 // MarshalJSON marshals the given CareTeam as JSON into a byte slice
 func (r CareTeam) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCareTeam
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCareTeam: OtherCareTeam(r),
 		ResourceType:  "CareTeam",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCareTeam unmarshals a CareTeam.
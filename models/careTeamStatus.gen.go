@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.062922 +0000 UTC
+// 2026-10-19 02:55:18.732722006 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CareTeamStatus is documented here http://hl7.org/fhir/ValueSet/care-team-status
-type CareTeamStatus int
+// The empty CareTeamStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CareTeamStatus string
 
 const (
-	CareTeamStatusProposed CareTeamStatus = iota
-	CareTeamStatusActive
-	CareTeamStatusSuspended
-	CareTeamStatusInactive
-	CareTeamStatusEnteredInError
+	CareTeamStatusProposed       CareTeamStatus = "proposed"
+	CareTeamStatusActive         CareTeamStatus = "active"
+	CareTeamStatusSuspended      CareTeamStatus = "suspended"
+	CareTeamStatusInactive       CareTeamStatus = "inactive"
+	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
 func (code CareTeamStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CareTeamStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CareTeamStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "proposed":
-		*code = CareTeamStatusProposed
-	case "active":
-		*code = CareTeamStatusActive
-	case "suspended":
-		*code = CareTeamStatusSuspended
-	case "inactive":
-		*code = CareTeamStatusInactive
-	case "entered-in-error":
-		*code = CareTeamStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown CareTeamStatus code `%s`", s)
+func (code *CareTeamStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = CareTeamStatus(s)
 	return nil
 }
 func (code CareTeamStatus) String() string {
 	return code.Code()
 }
 func (code CareTeamStatus) Code() string {
+	return string(code)
+}
+func (code CareTeamStatus) Known() bool {
 	switch code {
-	case CareTeamStatusProposed:
-		return "proposed"
-	case CareTeamStatusActive:
-		return "active"
-	case CareTeamStatusSuspended:
-		return "suspended"
-	case CareTeamStatusInactive:
-		return "inactive"
-	case CareTeamStatusEnteredInError:
-		return "entered-in-error"
+	case CareTeamStatusProposed, CareTeamStatusActive, CareTeamStatusSuspended, CareTeamStatusInactive, CareTeamStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CareTeamStatus) Display() string {
 	switch code {
@@ -25,42 +25,76 @@ type CatalogEntry struct {
 	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                     *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules            *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                 *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                     *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier               []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Type                     *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
 	Orderable                bool                       `bson:"orderable" json:"orderable"`
+	OrderableElement         *Element                   `bson:"_orderable,omitempty" json:"_orderable,omitempty"`
 	ReferencedItem           Reference                  `bson:"referencedItem" json:"referencedItem"`
 	AdditionalIdentifier     []Identifier               `bson:"additionalIdentifier,omitempty" json:"additionalIdentifier,omitempty"`
 	Classification           []CodeableConcept          `bson:"classification,omitempty" json:"classification,omitempty"`
 	Status                   *PublicationStatus         `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement            *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
 	ValidityPeriod           *Period                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
 	ValidTo                  *DateTime                  `bson:"validTo,omitempty" json:"validTo,omitempty"`
+	ValidToElement           *Element                   `bson:"_validTo,omitempty" json:"_validTo,omitempty"`
 	LastUpdated              *DateTime                  `bson:"lastUpdated,omitempty" json:"lastUpdated,omitempty"`
+	LastUpdatedElement       *Element                   `bson:"_lastUpdated,omitempty" json:"_lastUpdated,omitempty"`
 	AdditionalCharacteristic []CodeableConcept          `bson:"additionalCharacteristic,omitempty" json:"additionalCharacteristic,omitempty"`
 	AdditionalClassification []CodeableConcept          `bson:"additionalClassification,omitempty" json:"additionalClassification,omitempty"`
 	RelatedEntry             []CatalogEntryRelatedEntry `bson:"relatedEntry,omitempty" json:"relatedEntry,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type CatalogEntryRelatedEntry struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Relationtype      CatalogEntryRelationType `bson:"relationtype" json:"relationtype"`
-	Item              Reference                `bson:"item" json:"item"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Relationtype        CatalogEntryRelationType   `bson:"relationtype" json:"relationtype"`
+	RelationtypeElement *Element                   `bson:"_relationtype,omitempty" json:"_relationtype,omitempty"`
+	Item                Reference                  `bson:"item" json:"item"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CatalogEntryRelatedEntry with its unknown fields.
+func (r CatalogEntryRelatedEntry) MarshalJSON() ([]byte, error) {
+	type other CatalogEntryRelatedEntry
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CatalogEntry and fails with CodeError when a coded element has an unknown code.
+func (r *CatalogEntry) UnmarshalJSON(b []byte) error {
+	type other CatalogEntry
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("CatalogEntry", r)
+}
+
 type OtherCatalogEntry CatalogEntry
 
 // MarshalJSON marshals the given CatalogEntry as JSON into a byte slice
 func (r CatalogEntry) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCatalogEntry
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCatalogEntry: OtherCatalogEntry(r),
 		ResourceType:      "CatalogEntry",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCatalogEntry unmarshals a CatalogEntry.
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:02.993685 +0000 UTC
+// 2026-10-19 02:55:18.733910886 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CatalogEntryRelationType is documented here http://hl7.org/fhir/ValueSet/relation-type
-type CatalogEntryRelationType int
+// The empty CatalogEntryRelationType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CatalogEntryRelationType string
 
 const (
-	CatalogEntryRelationTypeTriggers CatalogEntryRelationType = iota
-	CatalogEntryRelationTypeIsReplacedBy
+	CatalogEntryRelationTypeTriggers     CatalogEntryRelationType = "triggers"
+	CatalogEntryRelationTypeIsReplacedBy CatalogEntryRelationType = "is-replaced-by"
 )
 
 func (code CatalogEntryRelationType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CatalogEntryRelationType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CatalogEntryRelationType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "triggers":
-		*code = CatalogEntryRelationTypeTriggers
-	case "is-replaced-by":
-		*code = CatalogEntryRelationTypeIsReplacedBy
-	default:
-		return fmt.Errorf("unknown CatalogEntryRelationType code `%s`", s)
+func (code *CatalogEntryRelationType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = CatalogEntryRelationType(s)
 	return nil
 }
 func (code CatalogEntryRelationType) String() string {
 	return code.Code()
 }
 func (code CatalogEntryRelationType) Code() string {
+	return string(code)
+}
+func (code CatalogEntryRelationType) Known() bool {
 	switch code {
-	case CatalogEntryRelationTypeTriggers:
-		return "triggers"
-	case CatalogEntryRelationTypeIsReplacedBy:
-		return "is-replaced-by"
+	case CatalogEntryRelationTypeTriggers, CatalogEntryRelationTypeIsReplacedBy:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CatalogEntryRelationType) Display() string {
 	switch code {
@@ -22,56 +22,182 @@ import "encoding/json"
 
 // ChargeItem is documented here http://hl7.org/fhir/StructureDefinition/ChargeItem
 type ChargeItem struct {
-	ID                     *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	DefinitionUri          []string              `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
-	DefinitionCanonical    []string              `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
-	Status                 ChargeItemStatus      `bson:"status" json:"status"`
-	PartOf                 []Reference           `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Code                   CodeableConcept       `bson:"code" json:"code"`
-	Subject                Reference             `bson:"subject" json:"subject"`
-	Context                *Reference            `bson:"context,omitempty" json:"context,omitempty"`
-	Performer              []ChargeItemPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
-	PerformingOrganization *Reference            `bson:"performingOrganization,omitempty" json:"performingOrganization,omitempty"`
-	RequestingOrganization *Reference            `bson:"requestingOrganization,omitempty" json:"requestingOrganization,omitempty"`
-	CostCenter             *Reference            `bson:"costCenter,omitempty" json:"costCenter,omitempty"`
-	Quantity               *Quantity             `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Bodysite               []CodeableConcept     `bson:"bodysite,omitempty" json:"bodysite,omitempty"`
-	FactorOverride         *Decimal              `bson:"factorOverride,omitempty" json:"factorOverride,omitempty"`
-	PriceOverride          *Money                `bson:"priceOverride,omitempty" json:"priceOverride,omitempty"`
-	OverrideReason         *string               `bson:"overrideReason,omitempty" json:"overrideReason,omitempty"`
-	Enterer                *Reference            `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	EnteredDate            *DateTime             `bson:"enteredDate,omitempty" json:"enteredDate,omitempty"`
-	Reason                 []CodeableConcept     `bson:"reason,omitempty" json:"reason,omitempty"`
-	Service                []Reference           `bson:"service,omitempty" json:"service,omitempty"`
-	Account                []Reference           `bson:"account,omitempty" json:"account,omitempty"`
-	Note                   []Annotation          `bson:"note,omitempty" json:"note,omitempty"`
-	SupportingInformation  []Reference           `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                       *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                 []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	DefinitionUri              []*string                  `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
+	DefinitionUriElement       []*Element                 `bson:"_definitionUri,omitempty" json:"_definitionUri,omitempty"`
+	DefinitionCanonical        []*string                  `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement []*Element                 `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	Status                     ChargeItemStatus           `bson:"status" json:"status"`
+	StatusElement              *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	PartOf                     []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Code                       CodeableConcept            `bson:"code" json:"code"`
+	Subject                    Reference                  `bson:"subject" json:"subject"`
+	Context                    *Reference                 `bson:"context,omitempty" json:"context,omitempty"`
+	OccurrenceDateTime         *DateTime                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement  *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod           *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming           *Timing                    `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	Performer                  []ChargeItemPerformer      `bson:"performer,omitempty" json:"performer,omitempty"`
+	PerformingOrganization     *Reference                 `bson:"performingOrganization,omitempty" json:"performingOrganization,omitempty"`
+	RequestingOrganization     *Reference                 `bson:"requestingOrganization,omitempty" json:"requestingOrganization,omitempty"`
+	CostCenter                 *Reference                 `bson:"costCenter,omitempty" json:"costCenter,omitempty"`
+	Quantity                   *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Bodysite                   []CodeableConcept          `bson:"bodysite,omitempty" json:"bodysite,omitempty"`
+	FactorOverride             *Decimal                   `bson:"factorOverride,omitempty" json:"factorOverride,omitempty"`
+	FactorOverrideElement      *Element                   `bson:"_factorOverride,omitempty" json:"_factorOverride,omitempty"`
+	PriceOverride              *Money                     `bson:"priceOverride,omitempty" json:"priceOverride,omitempty"`
+	OverrideReason             *string                    `bson:"overrideReason,omitempty" json:"overrideReason,omitempty"`
+	OverrideReasonElement      *Element                   `bson:"_overrideReason,omitempty" json:"_overrideReason,omitempty"`
+	Enterer                    *Reference                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	EnteredDate                *DateTime                  `bson:"enteredDate,omitempty" json:"enteredDate,omitempty"`
+	EnteredDateElement         *Element                   `bson:"_enteredDate,omitempty" json:"_enteredDate,omitempty"`
+	Reason                     []CodeableConcept          `bson:"reason,omitempty" json:"reason,omitempty"`
+	Service                    []Reference                `bson:"service,omitempty" json:"service,omitempty"`
+	ProductReference           *Reference                 `bson:"productReference,omitempty" json:"productReference,omitempty"`
+	ProductCodeableConcept     *CodeableConcept           `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
+	Account                    []Reference                `bson:"account,omitempty" json:"account,omitempty"`
+	Note                       []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	SupportingInformation      []Reference                `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ChargeItemPerformer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Function          *CodeableConcept           `bson:"function,omitempty" json:"function,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ChargeItemPerformer with its unknown fields.
+func (r ChargeItemPerformer) MarshalJSON() ([]byte, error) {
+	type other ChargeItemPerformer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurrence returns ChargeItem.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ChargeItem) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	case r.OccurrenceTiming != nil:
+		return r.OccurrenceTiming
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets ChargeItem.occurrence[x] to the dateTime and clears the other types.
+func (r *ChargeItem) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets ChargeItem.occurrence[x] to the Period and clears the other types.
+func (r *ChargeItem) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+
+// SetOccurrenceTiming sets ChargeItem.occurrence[x] to the Timing and clears the other types.
+func (r *ChargeItem) SetOccurrenceTiming(v Timing) {
+	r.clearOccurrence()
+	r.OccurrenceTiming = &v
+}
+func (r *ChargeItem) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+}
+
+// Product returns ChargeItem.product[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *ChargeItem) Product() interface{} {
+	switch {
+	case r.ProductReference != nil:
+		return r.ProductReference
+	case r.ProductCodeableConcept != nil:
+		return r.ProductCodeableConcept
+	}
+	return nil
+}
+
+// SetProductReference sets ChargeItem.product[x] to the Reference and clears the other types.
+func (r *ChargeItem) SetProductReference(v Reference) {
+	r.clearProduct()
+	r.ProductReference = &v
+}
+
+// SetProductCodeableConcept sets ChargeItem.product[x] to the CodeableConcept and clears the other types.
+func (r *ChargeItem) SetProductCodeableConcept(v CodeableConcept) {
+	r.clearProduct()
+	r.ProductCodeableConcept = &v
+}
+func (r *ChargeItem) clearProduct() {
+	r.ProductReference = nil
+	r.ProductCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the ChargeItem and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ChargeItem) UnmarshalJSON(b []byte) error {
+	type other ChargeItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ChargeItem.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+		r.OccurrenceTiming != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ChargeItem.product[x]",
+		r.ProductReference != nil,
+		r.ProductCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ChargeItem.definitionUri", len(r.DefinitionUri), len(r.DefinitionUriElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ChargeItem.definitionCanonical", len(r.DefinitionCanonical), len(r.DefinitionCanonicalElement)); err != nil {
+		return err
+	}
+	return checkCodes("ChargeItem", r)
+}
+
 type OtherChargeItem ChargeItem
 
 // MarshalJSON marshals the given ChargeItem as JSON into a byte slice
 func (r ChargeItem) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherChargeItem
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherChargeItem: OtherChargeItem(r),
 		ResourceType:    "ChargeItem",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalChargeItem unmarshals a ChargeItem.
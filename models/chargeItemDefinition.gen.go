@@ -22,72 +22,152 @@ import "encoding/json"
 
 // ChargeItemDefinition is documented here http://hl7.org/fhir/StructureDefinition/ChargeItemDefinition
 type ChargeItemDefinition struct {
-	ID                *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                              `bson:"url" json:"url"`
-	Identifier        []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                             `bson:"version,omitempty" json:"version,omitempty"`
-	Title             *string                             `bson:"title,omitempty" json:"title,omitempty"`
-	DerivedFromUri    []string                            `bson:"derivedFromUri,omitempty" json:"derivedFromUri,omitempty"`
-	PartOf            []string                            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Replaces          []string                            `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	Status            PublicationStatus                   `bson:"status" json:"status"`
-	Experimental      *bool                               `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                           `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                     `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                             `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                      `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                             `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime                           `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime                           `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Code              *CodeableConcept                    `bson:"code,omitempty" json:"code,omitempty"`
-	Instance          []Reference                         `bson:"instance,omitempty" json:"instance,omitempty"`
-	Applicability     []ChargeItemDefinitionApplicability `bson:"applicability,omitempty" json:"applicability,omitempty"`
-	PropertyGroup     []ChargeItemDefinitionPropertyGroup `bson:"propertyGroup,omitempty" json:"propertyGroup,omitempty"`
+	ID                    *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                   string                              `bson:"url" json:"url"`
+	URLElement            *Element                            `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                             `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                            `bson:"_version,omitempty" json:"_version,omitempty"`
+	Title                 *string                             `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                            `bson:"_title,omitempty" json:"_title,omitempty"`
+	DerivedFromUri        []*string                           `bson:"derivedFromUri,omitempty" json:"derivedFromUri,omitempty"`
+	DerivedFromUriElement []*Element                          `bson:"_derivedFromUri,omitempty" json:"_derivedFromUri,omitempty"`
+	PartOf                []*string                           `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	PartOfElement         []*Element                          `bson:"_partOf,omitempty" json:"_partOf,omitempty"`
+	Replaces              []*string                           `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	ReplacesElement       []*Element                          `bson:"_replaces,omitempty" json:"_replaces,omitempty"`
+	Status                PublicationStatus                   `bson:"status" json:"status"`
+	StatusElement         *Element                            `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental          *bool                               `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement   *Element                            `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                  *DateTime                           `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                            `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                            `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                     `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                             `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                            `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext            []UsageContext                      `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                             `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                            `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *DateTime                           `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                            `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *DateTime                           `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                            `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Code                  *CodeableConcept                    `bson:"code,omitempty" json:"code,omitempty"`
+	Instance              []Reference                         `bson:"instance,omitempty" json:"instance,omitempty"`
+	Applicability         []ChargeItemDefinitionApplicability `bson:"applicability,omitempty" json:"applicability,omitempty"`
+	PropertyGroup         []ChargeItemDefinitionPropertyGroup `bson:"propertyGroup,omitempty" json:"propertyGroup,omitempty"`
+	UnknownFields         map[string]json.RawMessage          `bson:"-" json:"-"`
 }
 type ChargeItemDefinitionApplicability struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Expression        *string     `bson:"expression,omitempty" json:"expression,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Language           *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement    *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Expression         *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ChargeItemDefinitionApplicability with its unknown fields.
+func (r ChargeItemDefinitionApplicability) MarshalJSON() ([]byte, error) {
+	type other ChargeItemDefinitionApplicability
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ChargeItemDefinitionPropertyGroup struct {
 	ID                *string                                           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Applicability     []ChargeItemDefinitionApplicability               `bson:"applicability,omitempty" json:"applicability,omitempty"`
 	PriceComponent    []ChargeItemDefinitionPropertyGroupPriceComponent `bson:"priceComponent,omitempty" json:"priceComponent,omitempty"`
+	UnknownFields     map[string]json.RawMessage                        `bson:"-" json:"-"`
 }
 type ChargeItemDefinitionPropertyGroupPriceComponent struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              InvoicePriceComponentType `bson:"type" json:"type"`
-	Code              *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
-	Factor            *Decimal                  `bson:"factor,omitempty" json:"factor,omitempty"`
-	Amount            *Money                    `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              InvoicePriceComponentType  `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Factor            *Decimal                   `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Amount            *Money                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ChargeItemDefinitionPropertyGroupPriceComponent with its unknown fields.
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) MarshalJSON() ([]byte, error) {
+	type other ChargeItemDefinitionPropertyGroupPriceComponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ChargeItemDefinitionPropertyGroup with its unknown fields.
+func (r ChargeItemDefinitionPropertyGroup) MarshalJSON() ([]byte, error) {
+	type other ChargeItemDefinitionPropertyGroup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the ChargeItemDefinition and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ChargeItemDefinition) UnmarshalJSON(b []byte) error {
+	type other ChargeItemDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ChargeItemDefinition.derivedFromUri", len(r.DerivedFromUri), len(r.DerivedFromUriElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ChargeItemDefinition.partOf", len(r.PartOf), len(r.PartOfElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ChargeItemDefinition.replaces", len(r.Replaces), len(r.ReplacesElement)); err != nil {
+		return err
+	}
+	return checkCodes("ChargeItemDefinition", r)
+}
+
 type OtherChargeItemDefinition ChargeItemDefinition
 
 // MarshalJSON marshals the given ChargeItemDefinition as JSON into a byte slice
 func (r ChargeItemDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherChargeItemDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherChargeItemDefinition: OtherChargeItemDefinition(r),
 		ResourceType:              "ChargeItemDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalChargeItemDefinition unmarshals a ChargeItemDefinition.
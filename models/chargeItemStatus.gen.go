@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.199611 +0000 UTC
+// 2026-10-19 02:55:18.735379561 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ChargeItemStatus is documented here http://hl7.org/fhir/ValueSet/chargeitem-status
-type ChargeItemStatus int
+// The empty ChargeItemStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ChargeItemStatus string
 
 const (
-	ChargeItemStatusPlanned ChargeItemStatus = iota
-	ChargeItemStatusBillable
-	ChargeItemStatusNotBillable
-	ChargeItemStatusAborted
-	ChargeItemStatusBilled
-	ChargeItemStatusEnteredInError
-	ChargeItemStatusUnknown
+	ChargeItemStatusPlanned        ChargeItemStatus = "planned"
+	ChargeItemStatusBillable       ChargeItemStatus = "billable"
+	ChargeItemStatusNotBillable    ChargeItemStatus = "not-billable"
+	ChargeItemStatusAborted        ChargeItemStatus = "aborted"
+	ChargeItemStatusBilled         ChargeItemStatus = "billed"
+	ChargeItemStatusEnteredInError ChargeItemStatus = "entered-in-error"
+	ChargeItemStatusUnknown        ChargeItemStatus = "unknown"
 )
 
 func (code ChargeItemStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ChargeItemStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ChargeItemStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "planned":
-		*code = ChargeItemStatusPlanned
-	case "billable":
-		*code = ChargeItemStatusBillable
-	case "not-billable":
-		*code = ChargeItemStatusNotBillable
-	case "aborted":
-		*code = ChargeItemStatusAborted
-	case "billed":
-		*code = ChargeItemStatusBilled
-	case "entered-in-error":
-		*code = ChargeItemStatusEnteredInError
-	case "unknown":
-		*code = ChargeItemStatusUnknown
-	default:
-		return fmt.Errorf("unknown ChargeItemStatus code `%s`", s)
+func (code *ChargeItemStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ChargeItemStatus(s)
 	return nil
 }
 func (code ChargeItemStatus) String() string {
 	return code.Code()
 }
 func (code ChargeItemStatus) Code() string {
+	return string(code)
+}
+func (code ChargeItemStatus) Known() bool {
 	switch code {
-	case ChargeItemStatusPlanned:
-		return "planned"
-	case ChargeItemStatusBillable:
-		return "billable"
-	case ChargeItemStatusNotBillable:
-		return "not-billable"
-	case ChargeItemStatusAborted:
-		return "aborted"
-	case ChargeItemStatusBilled:
-		return "billed"
-	case ChargeItemStatusEnteredInError:
-		return "entered-in-error"
-	case ChargeItemStatusUnknown:
-		return "unknown"
+	case ChargeItemStatusPlanned, ChargeItemStatusBillable, ChargeItemStatusNotBillable, ChargeItemStatusAborted, ChargeItemStatusBilled, ChargeItemStatusEnteredInError, ChargeItemStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ChargeItemStatus) Display() string {
 	switch code {
@@ -0,0 +1,27 @@
+package models
+
+import "fmt"
+
+// ChoiceError is returned by UnmarshalJSON when the polymorphic element, such as Observation.value[x],
+// has more than one type, for example both valueQuantity and valueString.
+type ChoiceError struct {
+	Element string
+}
+
+func (e ChoiceError) Error() string {
+	return fmt.Sprintf("polymorphic element \"%s\" has more than one type", e.Element)
+}
+
+// checkChoice returns ChoiceError when more than one type of the element is set.
+func checkChoice(element string, set ...bool) error {
+	n := 0
+	for _, s := range set {
+		if s {
+			n++
+		}
+	}
+	if n > 1 {
+		return ChoiceError{Element: element}
+	}
+	return nil
+}
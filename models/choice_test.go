@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestChoice(t *testing.T) {
+	observation := Observation{Status: ObservationStatusFinal}
+	observation.SetValueString("positive")
+	observation.SetValueQuantity(Quantity{Value: NewDecimal(7.2), Unit: NewString("mmol/L")})
+	if observation.ValueString != nil {
+		t.Fatal("setter must clear the other types")
+	}
+
+	b, err := json.Marshal(observation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UnmarshalObservation(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Value(), observation.ValueQuantity) {
+		t.Errorf("expected value %+v, got %+v", observation.ValueQuantity, got.Value())
+	}
+	if got.Effective() != nil {
+		t.Errorf("expected nil effective, got %+v", got.Effective())
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected error
+	}{
+		{
+			name: "one type",
+			data: `{"resourceType":"Observation","valueBoolean":true}`,
+		},
+		{
+			name:     "two types",
+			data:     `{"resourceType":"Observation","valueBoolean":true,"valueString":"yes"}`,
+			expected: ChoiceError{Element: "Observation.value[x]"},
+		},
+		{
+			name:     "two types in backbone element",
+			data:     `{"resourceType":"Observation","component":[{"valueInteger":1,"valueString":"one"}]}`,
+			expected: ChoiceError{Element: "Observation.component.value[x]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalObservation([]byte(tt.data))
+			if tt.expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			var choiceErr ChoiceError
+			if !errors.As(err, &choiceErr) || choiceErr != tt.expected {
+				t.Errorf("expected error %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -25,18 +25,24 @@ type Claim struct {
 	ID                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               FinancialResourceStatusCodes `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
 	Type                 CodeableConcept              `bson:"type" json:"type"`
 	SubType              *CodeableConcept             `bson:"subType,omitempty" json:"subType,omitempty"`
 	Use                  Use                          `bson:"use" json:"use"`
+	UseElement           *Element                     `bson:"_use,omitempty" json:"_use,omitempty"`
 	Patient              Reference                    `bson:"patient" json:"patient"`
 	BillablePeriod       *Period                      `bson:"billablePeriod,omitempty" json:"billablePeriod,omitempty"`
 	Created              DateTime                     `bson:"created" json:"created"`
+	CreatedElement       *Element                     `bson:"_created,omitempty" json:"_created,omitempty"`
 	Enterer              *Reference                   `bson:"enterer,omitempty" json:"enterer,omitempty"`
 	Insurer              *Reference                   `bson:"insurer,omitempty" json:"insurer,omitempty"`
 	Provider             Reference                    `bson:"provider" json:"provider"`
@@ -56,107 +62,496 @@ type Claim struct {
 	Accident             *ClaimAccident               `bson:"accident,omitempty" json:"accident,omitempty"`
 	Item                 []ClaimItem                  `bson:"item,omitempty" json:"item,omitempty"`
 	Total                *Money                       `bson:"total,omitempty" json:"total,omitempty"`
+	UnknownFields        map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type ClaimRelated struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Claim             *Reference       `bson:"claim,omitempty" json:"claim,omitempty"`
-	Relationship      *CodeableConcept `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Reference         *Identifier      `bson:"reference,omitempty" json:"reference,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Claim             *Reference                 `bson:"claim,omitempty" json:"claim,omitempty"`
+	Relationship      *CodeableConcept           `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Reference         *Identifier                `bson:"reference,omitempty" json:"reference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClaimRelated with its unknown fields.
+func (r ClaimRelated) MarshalJSON() ([]byte, error) {
+	type other ClaimRelated
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ClaimPayee struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Party             *Reference      `bson:"party,omitempty" json:"party,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Party             *Reference                 `bson:"party,omitempty" json:"party,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ClaimPayee with its unknown fields.
+func (r ClaimPayee) MarshalJSON() ([]byte, error) {
+	type other ClaimPayee
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimCareTeam struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Provider          Reference        `bson:"provider" json:"provider"`
-	Responsible       *bool            `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Qualification     *CodeableConcept `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence           int                        `bson:"sequence" json:"sequence"`
+	SequenceElement    *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Provider           Reference                  `bson:"provider" json:"provider"`
+	Responsible        *bool                      `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	ResponsibleElement *Element                   `bson:"_responsible,omitempty" json:"_responsible,omitempty"`
+	Role               *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	Qualification      *CodeableConcept           `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClaimCareTeam with its unknown fields.
+func (r ClaimCareTeam) MarshalJSON() ([]byte, error) {
+	type other ClaimCareTeam
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ClaimSupportingInfo struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Category          CodeableConcept  `bson:"category" json:"category"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence            int                        `bson:"sequence" json:"sequence"`
+	SequenceElement     *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Category            CodeableConcept            `bson:"category" json:"category"`
+	Code                *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	TimingDate          *DateTime                  `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
+	TimingDateElement   *Element                   `bson:"_timingDate,omitempty" json:"_timingDate,omitempty"`
+	TimingPeriod        *Period                    `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	ValueBoolean        *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueString         *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement  *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueQuantity       *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueAttachment     *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueReference      *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Reason              *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Timing returns Claim.supportingInfo.timing[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ClaimSupportingInfo) Timing() interface{} {
+	switch {
+	case r.TimingDate != nil:
+		return r.TimingDate
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	}
+	return nil
+}
+
+// SetTimingDate sets Claim.supportingInfo.timing[x] to the date and clears the other types.
+func (r *ClaimSupportingInfo) SetTimingDate(v DateTime) {
+	r.clearTiming()
+	r.TimingDate = &v
+}
+
+// SetTimingPeriod sets Claim.supportingInfo.timing[x] to the Period and clears the other types.
+func (r *ClaimSupportingInfo) SetTimingPeriod(v Period) {
+	r.clearTiming()
+	r.TimingPeriod = &v
+}
+func (r *ClaimSupportingInfo) clearTiming() {
+	r.TimingDate = nil
+	r.TimingDateElement = nil
+	r.TimingPeriod = nil
+}
+
+// Value returns Claim.supportingInfo.value[x] of the set type, such as *bool, or nil when it is not set.
+func (r *ClaimSupportingInfo) Value() interface{} {
+	switch {
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueBoolean sets Claim.supportingInfo.value[x] to the boolean and clears the other types.
+func (r *ClaimSupportingInfo) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueString sets Claim.supportingInfo.value[x] to the string and clears the other types.
+func (r *ClaimSupportingInfo) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueQuantity sets Claim.supportingInfo.value[x] to the Quantity and clears the other types.
+func (r *ClaimSupportingInfo) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueAttachment sets Claim.supportingInfo.value[x] to the Attachment and clears the other types.
+func (r *ClaimSupportingInfo) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueReference sets Claim.supportingInfo.value[x] to the Reference and clears the other types.
+func (r *ClaimSupportingInfo) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *ClaimSupportingInfo) clearValue() {
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueQuantity = nil
+	r.ValueAttachment = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the ClaimSupportingInfo and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ClaimSupportingInfo) UnmarshalJSON(b []byte) error {
+	type other ClaimSupportingInfo
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.supportingInfo.timing[x]",
+		r.TimingDate != nil || r.TimingDateElement != nil,
+		r.TimingPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.supportingInfo.value[x]",
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueQuantity != nil,
+		r.ValueAttachment != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the ClaimSupportingInfo with its unknown fields.
+func (r ClaimSupportingInfo) MarshalJSON() ([]byte, error) {
+	type other ClaimSupportingInfo
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimDiagnosis struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int               `bson:"sequence" json:"sequence"`
-	Type              []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	OnAdmission       *CodeableConcept  `bson:"onAdmission,omitempty" json:"onAdmission,omitempty"`
-	PackageCode       *CodeableConcept  `bson:"packageCode,omitempty" json:"packageCode,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                 int                        `bson:"sequence" json:"sequence"`
+	SequenceElement          *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	DiagnosisCodeableConcept *CodeableConcept           `bson:"diagnosisCodeableConcept,omitempty" json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *Reference                 `bson:"diagnosisReference,omitempty" json:"diagnosisReference,omitempty"`
+	Type                     []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	OnAdmission              *CodeableConcept           `bson:"onAdmission,omitempty" json:"onAdmission,omitempty"`
+	PackageCode              *CodeableConcept           `bson:"packageCode,omitempty" json:"packageCode,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Diagnosis returns Claim.diagnosis.diagnosis[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ClaimDiagnosis) Diagnosis() interface{} {
+	switch {
+	case r.DiagnosisCodeableConcept != nil:
+		return r.DiagnosisCodeableConcept
+	case r.DiagnosisReference != nil:
+		return r.DiagnosisReference
+	}
+	return nil
 }
+
+// SetDiagnosisCodeableConcept sets Claim.diagnosis.diagnosis[x] to the CodeableConcept and clears the other types.
+func (r *ClaimDiagnosis) SetDiagnosisCodeableConcept(v CodeableConcept) {
+	r.clearDiagnosis()
+	r.DiagnosisCodeableConcept = &v
+}
+
+// SetDiagnosisReference sets Claim.diagnosis.diagnosis[x] to the Reference and clears the other types.
+func (r *ClaimDiagnosis) SetDiagnosisReference(v Reference) {
+	r.clearDiagnosis()
+	r.DiagnosisReference = &v
+}
+func (r *ClaimDiagnosis) clearDiagnosis() {
+	r.DiagnosisCodeableConcept = nil
+	r.DiagnosisReference = nil
+}
+
+// UnmarshalJSON unmarshals the ClaimDiagnosis and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ClaimDiagnosis) UnmarshalJSON(b []byte) error {
+	type other ClaimDiagnosis
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.diagnosis.diagnosis[x]",
+		r.DiagnosisCodeableConcept != nil,
+		r.DiagnosisReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimDiagnosis with its unknown fields.
+func (r ClaimDiagnosis) MarshalJSON() ([]byte, error) {
+	type other ClaimDiagnosis
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimProcedure struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int               `bson:"sequence" json:"sequence"`
-	Type              []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Date              *DateTime         `bson:"date,omitempty" json:"date,omitempty"`
-	Udi               []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                 int                        `bson:"sequence" json:"sequence"`
+	SequenceElement          *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Type                     []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Date                     *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement              *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	ProcedureCodeableConcept *CodeableConcept           `bson:"procedureCodeableConcept,omitempty" json:"procedureCodeableConcept,omitempty"`
+	ProcedureReference       *Reference                 `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
+	Udi                      []Reference                `bson:"udi,omitempty" json:"udi,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Procedure returns Claim.procedure.procedure[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ClaimProcedure) Procedure() interface{} {
+	switch {
+	case r.ProcedureCodeableConcept != nil:
+		return r.ProcedureCodeableConcept
+	case r.ProcedureReference != nil:
+		return r.ProcedureReference
+	}
+	return nil
+}
+
+// SetProcedureCodeableConcept sets Claim.procedure.procedure[x] to the CodeableConcept and clears the other types.
+func (r *ClaimProcedure) SetProcedureCodeableConcept(v CodeableConcept) {
+	r.clearProcedure()
+	r.ProcedureCodeableConcept = &v
+}
+
+// SetProcedureReference sets Claim.procedure.procedure[x] to the Reference and clears the other types.
+func (r *ClaimProcedure) SetProcedureReference(v Reference) {
+	r.clearProcedure()
+	r.ProcedureReference = &v
 }
+func (r *ClaimProcedure) clearProcedure() {
+	r.ProcedureCodeableConcept = nil
+	r.ProcedureReference = nil
+}
+
+// UnmarshalJSON unmarshals the ClaimProcedure and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ClaimProcedure) UnmarshalJSON(b []byte) error {
+	type other ClaimProcedure
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.procedure.procedure[x]",
+		r.ProcedureCodeableConcept != nil,
+		r.ProcedureReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimProcedure with its unknown fields.
+func (r ClaimProcedure) MarshalJSON() ([]byte, error) {
+	type other ClaimProcedure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimInsurance struct {
-	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence            int         `bson:"sequence" json:"sequence"`
-	Focal               bool        `bson:"focal" json:"focal"`
-	Identifier          *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Coverage            Reference   `bson:"coverage" json:"coverage"`
-	BusinessArrangement *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
-	PreAuthRef          []string    `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
-	ClaimResponse       *Reference  `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int                        `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Focal                      bool                       `bson:"focal" json:"focal"`
+	FocalElement               *Element                   `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Identifier                 *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Coverage                   Reference                  `bson:"coverage" json:"coverage"`
+	BusinessArrangement        *string                    `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *Element                   `bson:"_businessArrangement,omitempty" json:"_businessArrangement,omitempty"`
+	PreAuthRef                 []*string                  `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement          []*Element                 `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
+	ClaimResponse              *Reference                 `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the ClaimInsurance and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimInsurance) UnmarshalJSON(b []byte) error {
+	type other ClaimInsurance
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Claim.insurance.preAuthRef", len(r.PreAuthRef), len(r.PreAuthRefElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimInsurance with its unknown fields.
+func (r ClaimInsurance) MarshalJSON() ([]byte, error) {
+	type other ClaimInsurance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimAccident struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Date              DateTime         `bson:"date" json:"date"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Date              DateTime                   `bson:"date" json:"date"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	LocationAddress   *Address                   `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference *Reference                 `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Location returns Claim.accident.location[x] of the set type, such as *Address, or nil when it is not set.
+func (r *ClaimAccident) Location() interface{} {
+	switch {
+	case r.LocationAddress != nil:
+		return r.LocationAddress
+	case r.LocationReference != nil:
+		return r.LocationReference
+	}
+	return nil
+}
+
+// SetLocationAddress sets Claim.accident.location[x] to the Address and clears the other types.
+func (r *ClaimAccident) SetLocationAddress(v Address) {
+	r.clearLocation()
+	r.LocationAddress = &v
+}
+
+// SetLocationReference sets Claim.accident.location[x] to the Reference and clears the other types.
+func (r *ClaimAccident) SetLocationReference(v Reference) {
+	r.clearLocation()
+	r.LocationReference = &v
+}
+func (r *ClaimAccident) clearLocation() {
+	r.LocationAddress = nil
+	r.LocationReference = nil
+}
+
+// UnmarshalJSON unmarshals the ClaimAccident and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ClaimAccident) UnmarshalJSON(b []byte) error {
+	type other ClaimAccident
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.accident.location[x]",
+		r.LocationAddress != nil,
+		r.LocationReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimAccident with its unknown fields.
+func (r ClaimAccident) MarshalJSON() ([]byte, error) {
+	type other ClaimAccident
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimItem struct {
-	ID                  *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence            int               `bson:"sequence" json:"sequence"`
-	CareTeamSequence    []int             `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
-	DiagnosisSequence   []int             `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
-	ProcedureSequence   []int             `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
-	InformationSequence []int             `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
-	Revenue             *CodeableConcept  `bson:"revenue,omitempty" json:"revenue,omitempty"`
-	Category            *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService    CodeableConcept   `bson:"productOrService" json:"productOrService"`
-	Modifier            []CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode         []CodeableConcept `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	Quantity            *Quantity         `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice           *Money            `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor              *Decimal          `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net                 *Money            `bson:"net,omitempty" json:"net,omitempty"`
-	Udi                 []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
-	BodySite            *CodeableConcept  `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite             []CodeableConcept `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	Encounter           []Reference       `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Detail              []ClaimItemDetail `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int                        `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	CareTeamSequence           []*int                     `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement    []*Element                 `bson:"_careTeamSequence,omitempty" json:"_careTeamSequence,omitempty"`
+	DiagnosisSequence          []*int                     `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement   []*Element                 `bson:"_diagnosisSequence,omitempty" json:"_diagnosisSequence,omitempty"`
+	ProcedureSequence          []*int                     `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement   []*Element                 `bson:"_procedureSequence,omitempty" json:"_procedureSequence,omitempty"`
+	InformationSequence        []*int                     `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
+	InformationSequenceElement []*Element                 `bson:"_informationSequence,omitempty" json:"_informationSequence,omitempty"`
+	Revenue                    *CodeableConcept           `bson:"revenue,omitempty" json:"revenue,omitempty"`
+	Category                   *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService           CodeableConcept            `bson:"productOrService" json:"productOrService"`
+	Modifier                   []CodeableConcept          `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode                []CodeableConcept          `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate               *DateTime                  `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement        *Element                   `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod             *Period                    `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept    *CodeableConcept           `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress            *Address                   `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference          *Reference                 `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                   *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                  *Money                     `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                     *Decimal                   `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement              *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                        *Money                     `bson:"net,omitempty" json:"net,omitempty"`
+	Udi                        []Reference                `bson:"udi,omitempty" json:"udi,omitempty"`
+	BodySite                   *CodeableConcept           `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                    []CodeableConcept          `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	Encounter                  []Reference                `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Detail                     []ClaimItemDetail          `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ClaimItemDetail struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int                        `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept           `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept            `bson:"productOrService" json:"productOrService"`
@@ -165,37 +560,186 @@ type ClaimItemDetail struct {
 	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                     `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                   `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                     `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference                `bson:"udi,omitempty" json:"udi,omitempty"`
 	SubDetail         []ClaimItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ClaimItemDetailSubDetail struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int               `bson:"sequence" json:"sequence"`
-	Revenue           *CodeableConcept  `bson:"revenue,omitempty" json:"revenue,omitempty"`
-	Category          *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService  CodeableConcept   `bson:"productOrService" json:"productOrService"`
-	Modifier          []CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode       []CodeableConcept `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	Quantity          *Quantity         `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice         *Money            `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor            *Decimal          `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net               *Money            `bson:"net,omitempty" json:"net,omitempty"`
-	Udi               []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence          int                        `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Revenue           *CodeableConcept           `bson:"revenue,omitempty" json:"revenue,omitempty"`
+	Category          *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService  CodeableConcept            `bson:"productOrService" json:"productOrService"`
+	Modifier          []CodeableConcept          `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode       []CodeableConcept          `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice         *Money                     `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor            *Decimal                   `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net               *Money                     `bson:"net,omitempty" json:"net,omitempty"`
+	Udi               []Reference                `bson:"udi,omitempty" json:"udi,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ClaimItemDetailSubDetail with its unknown fields.
+func (r ClaimItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type other ClaimItemDetailSubDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ClaimItemDetail with its unknown fields.
+func (r ClaimItemDetail) MarshalJSON() ([]byte, error) {
+	type other ClaimItemDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Serviced returns Claim.item.serviced[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ClaimItem) Serviced() interface{} {
+	switch {
+	case r.ServicedDate != nil:
+		return r.ServicedDate
+	case r.ServicedPeriod != nil:
+		return r.ServicedPeriod
+	}
+	return nil
+}
+
+// SetServicedDate sets Claim.item.serviced[x] to the date and clears the other types.
+func (r *ClaimItem) SetServicedDate(v DateTime) {
+	r.clearServiced()
+	r.ServicedDate = &v
+}
+
+// SetServicedPeriod sets Claim.item.serviced[x] to the Period and clears the other types.
+func (r *ClaimItem) SetServicedPeriod(v Period) {
+	r.clearServiced()
+	r.ServicedPeriod = &v
+}
+func (r *ClaimItem) clearServiced() {
+	r.ServicedDate = nil
+	r.ServicedDateElement = nil
+	r.ServicedPeriod = nil
+}
+
+// Location returns Claim.item.location[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ClaimItem) Location() interface{} {
+	switch {
+	case r.LocationCodeableConcept != nil:
+		return r.LocationCodeableConcept
+	case r.LocationAddress != nil:
+		return r.LocationAddress
+	case r.LocationReference != nil:
+		return r.LocationReference
+	}
+	return nil
+}
+
+// SetLocationCodeableConcept sets Claim.item.location[x] to the CodeableConcept and clears the other types.
+func (r *ClaimItem) SetLocationCodeableConcept(v CodeableConcept) {
+	r.clearLocation()
+	r.LocationCodeableConcept = &v
+}
+
+// SetLocationAddress sets Claim.item.location[x] to the Address and clears the other types.
+func (r *ClaimItem) SetLocationAddress(v Address) {
+	r.clearLocation()
+	r.LocationAddress = &v
+}
+
+// SetLocationReference sets Claim.item.location[x] to the Reference and clears the other types.
+func (r *ClaimItem) SetLocationReference(v Reference) {
+	r.clearLocation()
+	r.LocationReference = &v
+}
+func (r *ClaimItem) clearLocation() {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+}
+
+// UnmarshalJSON unmarshals the ClaimItem and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimItem) UnmarshalJSON(b []byte) error {
+	type other ClaimItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.item.serviced[x]",
+		r.ServicedDate != nil || r.ServicedDateElement != nil,
+		r.ServicedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Claim.item.location[x]",
+		r.LocationCodeableConcept != nil,
+		r.LocationAddress != nil,
+		r.LocationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Claim.item.careTeamSequence", len(r.CareTeamSequence), len(r.CareTeamSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Claim.item.diagnosisSequence", len(r.DiagnosisSequence), len(r.DiagnosisSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Claim.item.procedureSequence", len(r.ProcedureSequence), len(r.ProcedureSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Claim.item.informationSequence", len(r.InformationSequence), len(r.InformationSequenceElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimItem with its unknown fields.
+func (r ClaimItem) MarshalJSON() ([]byte, error) {
+	type other ClaimItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Claim and fails with CodeError when a coded element has an unknown code.
+func (r *Claim) UnmarshalJSON(b []byte) error {
+	type other Claim
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Claim", r)
+}
+
 type OtherClaim Claim
 
 // MarshalJSON marshals the given Claim as JSON into a byte slice
 func (r Claim) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherClaim
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherClaim:   OtherClaim(r),
 		ResourceType: "Claim",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalClaim unmarshals a Claim.
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.066631 +0000 UTC
+// 2026-10-19 02:55:18.736645618 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ClaimProcessingCodes is documented here http://hl7.org/fhir/ValueSet/remittance-outcome
-type ClaimProcessingCodes int
+// The empty ClaimProcessingCodes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ClaimProcessingCodes string
 
 const (
-	ClaimProcessingCodesQueued ClaimProcessingCodes = iota
-	ClaimProcessingCodesComplete
-	ClaimProcessingCodesError
-	ClaimProcessingCodesPartial
+	ClaimProcessingCodesQueued   ClaimProcessingCodes = "queued"
+	ClaimProcessingCodesComplete ClaimProcessingCodes = "complete"
+	ClaimProcessingCodesError    ClaimProcessingCodes = "error"
+	ClaimProcessingCodesPartial  ClaimProcessingCodes = "partial"
 )
 
 func (code ClaimProcessingCodes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ClaimProcessingCodes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ClaimProcessingCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "queued":
-		*code = ClaimProcessingCodesQueued
-	case "complete":
-		*code = ClaimProcessingCodesComplete
-	case "error":
-		*code = ClaimProcessingCodesError
-	case "partial":
-		*code = ClaimProcessingCodesPartial
-	default:
-		return fmt.Errorf("unknown ClaimProcessingCodes code `%s`", s)
+func (code *ClaimProcessingCodes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ClaimProcessingCodes(s)
 	return nil
 }
 func (code ClaimProcessingCodes) String() string {
 	return code.Code()
 }
 func (code ClaimProcessingCodes) Code() string {
+	return string(code)
+}
+func (code ClaimProcessingCodes) Known() bool {
 	switch code {
-	case ClaimProcessingCodesQueued:
-		return "queued"
-	case ClaimProcessingCodesComplete:
-		return "complete"
-	case ClaimProcessingCodesError:
-		return "error"
-	case ClaimProcessingCodesPartial:
-		return "partial"
+	case ClaimProcessingCodesQueued, ClaimProcessingCodesComplete, ClaimProcessingCodesError, ClaimProcessingCodesPartial:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ClaimProcessingCodes) Display() string {
 	switch code {
@@ -25,23 +25,32 @@ type ClaimResponse struct {
 	ID                   *string                         `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                 *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               FinancialResourceStatusCodes    `bson:"status" json:"status"`
+	StatusElement        *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
 	Type                 CodeableConcept                 `bson:"type" json:"type"`
 	SubType              *CodeableConcept                `bson:"subType,omitempty" json:"subType,omitempty"`
 	Use                  Use                             `bson:"use" json:"use"`
+	UseElement           *Element                        `bson:"_use,omitempty" json:"_use,omitempty"`
 	Patient              Reference                       `bson:"patient" json:"patient"`
 	Created              DateTime                        `bson:"created" json:"created"`
+	CreatedElement       *Element                        `bson:"_created,omitempty" json:"_created,omitempty"`
 	Insurer              Reference                       `bson:"insurer" json:"insurer"`
 	Requestor            *Reference                      `bson:"requestor,omitempty" json:"requestor,omitempty"`
 	Request              *Reference                      `bson:"request,omitempty" json:"request,omitempty"`
 	Outcome              ClaimProcessingCodes            `bson:"outcome" json:"outcome"`
+	OutcomeElement       *Element                        `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
 	Disposition          *string                         `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                        `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
 	PreAuthRef           *string                         `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement    *Element                        `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
 	PreAuthPeriod        *Period                         `bson:"preAuthPeriod,omitempty" json:"preAuthPeriod,omitempty"`
 	PayeeType            *CodeableConcept                `bson:"payeeType,omitempty" json:"payeeType,omitempty"`
 	Item                 []ClaimResponseItem             `bson:"item,omitempty" json:"item,omitempty"`
@@ -56,62 +65,164 @@ type ClaimResponse struct {
 	CommunicationRequest []Reference                     `bson:"communicationRequest,omitempty" json:"communicationRequest,omitempty"`
 	Insurance            []ClaimResponseInsurance        `bson:"insurance,omitempty" json:"insurance,omitempty"`
 	Error                []ClaimResponseError            `bson:"error,omitempty" json:"error,omitempty"`
+	UnknownFields        map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type ClaimResponseItem struct {
-	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence      int                             `bson:"itemSequence" json:"itemSequence"`
-	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication" json:"adjudication"`
-	Detail            []ClaimResponseItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                  *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence        int                             `bson:"itemSequence" json:"itemSequence"`
+	ItemSequenceElement *Element                        `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	NoteNumber          []*int                          `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement   []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication        []ClaimResponseItemAdjudication `bson:"adjudication" json:"adjudication"`
+	Detail              []ClaimResponseItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields       map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type ClaimResponseItemAdjudication struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          CodeableConcept  `bson:"category" json:"category"`
-	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
-	Amount            *Money           `bson:"amount,omitempty" json:"amount,omitempty"`
-	Value             *Decimal         `bson:"value,omitempty" json:"value,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category          CodeableConcept            `bson:"category" json:"category"`
+	Reason            *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	Amount            *Money                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	Value             *Decimal                   `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ClaimResponseItemAdjudication with its unknown fields.
+func (r ClaimResponseItemAdjudication) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseItemAdjudication
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimResponseItemDetail struct {
-	ID                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DetailSequence    int                                `bson:"detailSequence" json:"detailSequence"`
-	NoteNumber        []int                              `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication    `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	SubDetail         []ClaimResponseItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	ID                    *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DetailSequence        int                                `bson:"detailSequence" json:"detailSequence"`
+	DetailSequenceElement *Element                           `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	NoteNumber            []*int                             `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement     []*Element                         `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication          []ClaimResponseItemAdjudication    `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	SubDetail             []ClaimResponseItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	UnknownFields         map[string]json.RawMessage         `bson:"-" json:"-"`
 }
 type ClaimResponseItemDetailSubDetail struct {
-	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SubDetailSequence int                             `bson:"subDetailSequence" json:"subDetailSequence"`
-	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	ID                       *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SubDetailSequence        int                             `bson:"subDetailSequence" json:"subDetailSequence"`
+	SubDetailSequenceElement *Element                        `bson:"_subDetailSequence,omitempty" json:"_subDetailSequence,omitempty"`
+	NoteNumber               []*int                          `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement        []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication             []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	UnknownFields            map[string]json.RawMessage      `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the ClaimResponseItemDetailSubDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	type other ClaimResponseItemDetailSubDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.item.detail.subDetail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimResponseItemDetailSubDetail with its unknown fields.
+func (r ClaimResponseItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseItemDetailSubDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ClaimResponseItemDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimResponseItemDetail) UnmarshalJSON(b []byte) error {
+	type other ClaimResponseItemDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.item.detail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimResponseItemDetail with its unknown fields.
+func (r ClaimResponseItemDetail) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseItemDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ClaimResponseItem and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimResponseItem) UnmarshalJSON(b []byte) error {
+	type other ClaimResponseItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.item.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimResponseItem with its unknown fields.
+func (r ClaimResponseItem) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ClaimResponseAddItem struct {
-	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence      []int                           `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
-	DetailSequence    []int                           `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
-	SubdetailSequence []int                           `bson:"subdetailSequence,omitempty" json:"subdetailSequence,omitempty"`
-	Provider          []Reference                     `bson:"provider,omitempty" json:"provider,omitempty"`
-	ProductOrService  CodeableConcept                 `bson:"productOrService" json:"productOrService"`
-	Modifier          []CodeableConcept               `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode       []CodeableConcept               `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	Quantity          *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice         *Money                          `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor            *Decimal                        `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net               *Money                          `bson:"net,omitempty" json:"net,omitempty"`
-	BodySite          *CodeableConcept                `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite           []CodeableConcept               `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	Detail            []ClaimResponseAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                       *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence             []*int                          `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
+	ItemSequenceElement      []*Element                      `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	DetailSequence           []*int                          `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
+	DetailSequenceElement    []*Element                      `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	SubdetailSequence        []*int                          `bson:"subdetailSequence,omitempty" json:"subdetailSequence,omitempty"`
+	SubdetailSequenceElement []*Element                      `bson:"_subdetailSequence,omitempty" json:"_subdetailSequence,omitempty"`
+	Provider                 []Reference                     `bson:"provider,omitempty" json:"provider,omitempty"`
+	ProductOrService         CodeableConcept                 `bson:"productOrService" json:"productOrService"`
+	Modifier                 []CodeableConcept               `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode              []CodeableConcept               `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate             *DateTime                       `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement      *Element                        `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod           *Period                         `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept  *CodeableConcept                `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress          *Address                        `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference        *Reference                      `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                 *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                *Money                          `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                   *Decimal                        `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement            *Element                        `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                      *Money                          `bson:"net,omitempty" json:"net,omitempty"`
+	BodySite                 *CodeableConcept                `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                  []CodeableConcept               `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	NoteNumber               []*int                          `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement        []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication             []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Detail                   []ClaimResponseAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields            map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type ClaimResponseAddItemDetail struct {
 	ID                *string                               `bson:"id,omitempty" json:"id,omitempty"`
@@ -122,10 +233,13 @@ type ClaimResponseAddItemDetail struct {
 	Quantity          *Quantity                             `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                              `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                              `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                `bson:"net,omitempty" json:"net,omitempty"`
-	NoteNumber        []int                                 `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumber        []*int                                `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                            `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ClaimResponseItemAdjudication       `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 	SubDetail         []ClaimResponseAddItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	UnknownFields     map[string]json.RawMessage            `bson:"-" json:"-"`
 }
 type ClaimResponseAddItemDetailSubDetail struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -136,67 +250,308 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	Quantity          *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                          `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                        `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                        `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                          `bson:"net,omitempty" json:"net,omitempty"`
-	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumber        []*int                          `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the ClaimResponseAddItemDetailSubDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	type other ClaimResponseAddItemDetailSubDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.addItem.detail.subDetail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimResponseAddItemDetailSubDetail with its unknown fields.
+func (r ClaimResponseAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseAddItemDetailSubDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ClaimResponseAddItemDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimResponseAddItemDetail) UnmarshalJSON(b []byte) error {
+	type other ClaimResponseAddItemDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.addItem.detail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ClaimResponseAddItemDetail with its unknown fields.
+func (r ClaimResponseAddItemDetail) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseAddItemDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Serviced returns ClaimResponse.addItem.serviced[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ClaimResponseAddItem) Serviced() interface{} {
+	switch {
+	case r.ServicedDate != nil:
+		return r.ServicedDate
+	case r.ServicedPeriod != nil:
+		return r.ServicedPeriod
+	}
+	return nil
+}
+
+// SetServicedDate sets ClaimResponse.addItem.serviced[x] to the date and clears the other types.
+func (r *ClaimResponseAddItem) SetServicedDate(v DateTime) {
+	r.clearServiced()
+	r.ServicedDate = &v
+}
+
+// SetServicedPeriod sets ClaimResponse.addItem.serviced[x] to the Period and clears the other types.
+func (r *ClaimResponseAddItem) SetServicedPeriod(v Period) {
+	r.clearServiced()
+	r.ServicedPeriod = &v
+}
+func (r *ClaimResponseAddItem) clearServiced() {
+	r.ServicedDate = nil
+	r.ServicedDateElement = nil
+	r.ServicedPeriod = nil
+}
+
+// Location returns ClaimResponse.addItem.location[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ClaimResponseAddItem) Location() interface{} {
+	switch {
+	case r.LocationCodeableConcept != nil:
+		return r.LocationCodeableConcept
+	case r.LocationAddress != nil:
+		return r.LocationAddress
+	case r.LocationReference != nil:
+		return r.LocationReference
+	}
+	return nil
+}
+
+// SetLocationCodeableConcept sets ClaimResponse.addItem.location[x] to the CodeableConcept and clears the other types.
+func (r *ClaimResponseAddItem) SetLocationCodeableConcept(v CodeableConcept) {
+	r.clearLocation()
+	r.LocationCodeableConcept = &v
+}
+
+// SetLocationAddress sets ClaimResponse.addItem.location[x] to the Address and clears the other types.
+func (r *ClaimResponseAddItem) SetLocationAddress(v Address) {
+	r.clearLocation()
+	r.LocationAddress = &v
+}
+
+// SetLocationReference sets ClaimResponse.addItem.location[x] to the Reference and clears the other types.
+func (r *ClaimResponseAddItem) SetLocationReference(v Reference) {
+	r.clearLocation()
+	r.LocationReference = &v
+}
+func (r *ClaimResponseAddItem) clearLocation() {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+}
+
+// UnmarshalJSON unmarshals the ClaimResponseAddItem and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ClaimResponseAddItem) UnmarshalJSON(b []byte) error {
+	type other ClaimResponseAddItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ClaimResponse.addItem.serviced[x]",
+		r.ServicedDate != nil || r.ServicedDateElement != nil,
+		r.ServicedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ClaimResponse.addItem.location[x]",
+		r.LocationCodeableConcept != nil,
+		r.LocationAddress != nil,
+		r.LocationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.addItem.itemSequence", len(r.ItemSequence), len(r.ItemSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.addItem.detailSequence", len(r.DetailSequence), len(r.DetailSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.addItem.subdetailSequence", len(r.SubdetailSequence), len(r.SubdetailSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ClaimResponse.addItem.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the ClaimResponseAddItem with its unknown fields.
+func (r ClaimResponseAddItem) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseAddItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimResponseTotal struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          CodeableConcept `bson:"category" json:"category"`
-	Amount            Money           `bson:"amount" json:"amount"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category          CodeableConcept            `bson:"category" json:"category"`
+	Amount            Money                      `bson:"amount" json:"amount"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClaimResponseTotal with its unknown fields.
+func (r ClaimResponseTotal) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseTotal
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ClaimResponsePayment struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept  `bson:"type" json:"type"`
-	Adjustment        *Money           `bson:"adjustment,omitempty" json:"adjustment,omitempty"`
-	AdjustmentReason  *CodeableConcept `bson:"adjustmentReason,omitempty" json:"adjustmentReason,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	Amount            Money            `bson:"amount" json:"amount"`
-	Identifier        *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Adjustment        *Money                     `bson:"adjustment,omitempty" json:"adjustment,omitempty"`
+	AdjustmentReason  *CodeableConcept           `bson:"adjustmentReason,omitempty" json:"adjustmentReason,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Amount            Money                      `bson:"amount" json:"amount"`
+	Identifier        *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClaimResponsePayment with its unknown fields.
+func (r ClaimResponsePayment) MarshalJSON() ([]byte, error) {
+	type other ClaimResponsePayment
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ClaimResponseProcessNote struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Number            *int             `bson:"number,omitempty" json:"number,omitempty"`
-	Type              *NoteType        `bson:"type,omitempty" json:"type,omitempty"`
-	Text              string           `bson:"text" json:"text"`
-	Language          *CodeableConcept `bson:"language,omitempty" json:"language,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Number            *int                       `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     *Element                   `bson:"_number,omitempty" json:"_number,omitempty"`
+	Type              *NoteType                  `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Text              string                     `bson:"text" json:"text"`
+	TextElement       *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	Language          *CodeableConcept           `bson:"language,omitempty" json:"language,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClaimResponseProcessNote with its unknown fields.
+func (r ClaimResponseProcessNote) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseProcessNote
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ClaimResponseInsurance struct {
-	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence            int         `bson:"sequence" json:"sequence"`
-	Focal               bool        `bson:"focal" json:"focal"`
-	Coverage            Reference   `bson:"coverage" json:"coverage"`
-	BusinessArrangement *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
-	ClaimResponse       *Reference  `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int                        `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Focal                      bool                       `bson:"focal" json:"focal"`
+	FocalElement               *Element                   `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Coverage                   Reference                  `bson:"coverage" json:"coverage"`
+	BusinessArrangement        *string                    `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *Element                   `bson:"_businessArrangement,omitempty" json:"_businessArrangement,omitempty"`
+	ClaimResponse              *Reference                 `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ClaimResponseInsurance with its unknown fields.
+func (r ClaimResponseInsurance) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseInsurance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClaimResponseError struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence      *int            `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
-	DetailSequence    *int            `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
-	SubDetailSequence *int            `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence             *int                       `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
+	ItemSequenceElement      *Element                   `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	DetailSequence           *int                       `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
+	DetailSequenceElement    *Element                   `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	SubDetailSequence        *int                       `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement *Element                   `bson:"_subDetailSequence,omitempty" json:"_subDetailSequence,omitempty"`
+	Code                     CodeableConcept            `bson:"code" json:"code"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClaimResponseError with its unknown fields.
+func (r ClaimResponseError) MarshalJSON() ([]byte, error) {
+	type other ClaimResponseError
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the ClaimResponse and fails with CodeError when a coded element has an unknown code.
+func (r *ClaimResponse) UnmarshalJSON(b []byte) error {
+	type other ClaimResponse
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("ClaimResponse", r)
+}
+
 type OtherClaimResponse ClaimResponse
 
 // MarshalJSON marshals the given ClaimResponse as JSON into a byte slice
 func (r ClaimResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherClaimResponse
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherClaimResponse: OtherClaimResponse(r),
 		ResourceType:       "ClaimResponse",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalClaimResponse unmarshals a ClaimResponse.
@@ -25,56 +25,144 @@ type ClinicalImpression struct {
 	ID                       *string                           `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                     *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules            *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                 *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                     *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources                `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier               []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status                   ClinicalImpressionStatus          `bson:"status" json:"status"`
+	StatusElement            *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusReason             *CodeableConcept                  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
 	Code                     *CodeableConcept                  `bson:"code,omitempty" json:"code,omitempty"`
 	Description              *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement       *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
 	Subject                  Reference                         `bson:"subject" json:"subject"`
 	Encounter                *Reference                        `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	EffectiveDateTime        *DateTime                         `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *Element                          `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *Period                           `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Date                     *DateTime                         `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement              *Element                          `bson:"_date,omitempty" json:"_date,omitempty"`
 	Assessor                 *Reference                        `bson:"assessor,omitempty" json:"assessor,omitempty"`
 	Previous                 *Reference                        `bson:"previous,omitempty" json:"previous,omitempty"`
 	Problem                  []Reference                       `bson:"problem,omitempty" json:"problem,omitempty"`
 	Investigation            []ClinicalImpressionInvestigation `bson:"investigation,omitempty" json:"investigation,omitempty"`
-	Protocol                 []string                          `bson:"protocol,omitempty" json:"protocol,omitempty"`
+	Protocol                 []*string                         `bson:"protocol,omitempty" json:"protocol,omitempty"`
+	ProtocolElement          []*Element                        `bson:"_protocol,omitempty" json:"_protocol,omitempty"`
 	Summary                  *string                           `bson:"summary,omitempty" json:"summary,omitempty"`
+	SummaryElement           *Element                          `bson:"_summary,omitempty" json:"_summary,omitempty"`
 	Finding                  []ClinicalImpressionFinding       `bson:"finding,omitempty" json:"finding,omitempty"`
 	PrognosisCodeableConcept []CodeableConcept                 `bson:"prognosisCodeableConcept,omitempty" json:"prognosisCodeableConcept,omitempty"`
 	PrognosisReference       []Reference                       `bson:"prognosisReference,omitempty" json:"prognosisReference,omitempty"`
 	SupportingInfo           []Reference                       `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
 	Note                     []Annotation                      `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields            map[string]json.RawMessage        `bson:"-" json:"-"`
 }
 type ClinicalImpressionInvestigation struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
-	Item              []Reference     `bson:"item,omitempty" json:"item,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              CodeableConcept            `bson:"code" json:"code"`
+	Item              []Reference                `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ClinicalImpressionInvestigation with its unknown fields.
+func (r ClinicalImpressionInvestigation) MarshalJSON() ([]byte, error) {
+	type other ClinicalImpressionInvestigation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ClinicalImpressionFinding struct {
-	ID                  *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemCodeableConcept *CodeableConcept `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
-	ItemReference       *Reference       `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
-	Basis               *string          `bson:"basis,omitempty" json:"basis,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemCodeableConcept *CodeableConcept           `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference       *Reference                 `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	Basis               *string                    `bson:"basis,omitempty" json:"basis,omitempty"`
+	BasisElement        *Element                   `bson:"_basis,omitempty" json:"_basis,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ClinicalImpressionFinding with its unknown fields.
+func (r ClinicalImpressionFinding) MarshalJSON() ([]byte, error) {
+	type other ClinicalImpressionFinding
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Effective returns ClinicalImpression.effective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ClinicalImpression) Effective() interface{} {
+	switch {
+	case r.EffectiveDateTime != nil:
+		return r.EffectiveDateTime
+	case r.EffectivePeriod != nil:
+		return r.EffectivePeriod
+	}
+	return nil
+}
+
+// SetEffectiveDateTime sets ClinicalImpression.effective[x] to the dateTime and clears the other types.
+func (r *ClinicalImpression) SetEffectiveDateTime(v DateTime) {
+	r.clearEffective()
+	r.EffectiveDateTime = &v
+}
+
+// SetEffectivePeriod sets ClinicalImpression.effective[x] to the Period and clears the other types.
+func (r *ClinicalImpression) SetEffectivePeriod(v Period) {
+	r.clearEffective()
+	r.EffectivePeriod = &v
 }
+func (r *ClinicalImpression) clearEffective() {
+	r.EffectiveDateTime = nil
+	r.EffectiveDateTimeElement = nil
+	r.EffectivePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the ClinicalImpression and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ClinicalImpression) UnmarshalJSON(b []byte) error {
+	type other ClinicalImpression
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ClinicalImpression.effective[x]",
+		r.EffectiveDateTime != nil || r.EffectiveDateTimeElement != nil,
+		r.EffectivePeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ClinicalImpression.protocol", len(r.Protocol), len(r.ProtocolElement)); err != nil {
+		return err
+	}
+	return checkCodes("ClinicalImpression", r)
+}
+
 type OtherClinicalImpression ClinicalImpression
 
 // MarshalJSON marshals the given ClinicalImpression as JSON into a byte slice
 func (r ClinicalImpression) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherClinicalImpression
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherClinicalImpression: OtherClinicalImpression(r),
 		ResourceType:            "ClinicalImpression",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalClinicalImpression unmarshals a ClinicalImpression.
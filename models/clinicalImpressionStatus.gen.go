@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.246156 +0000 UTC
+// 2026-10-19 02:55:18.737662344 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ClinicalImpressionStatus is documented here http://hl7.org/fhir/ValueSet/clinicalimpression-status
-type ClinicalImpressionStatus int
+// The empty ClinicalImpressionStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ClinicalImpressionStatus string
 
 const (
-	ClinicalImpressionStatusPreparation ClinicalImpressionStatus = iota
-	ClinicalImpressionStatusInProgress
-	ClinicalImpressionStatusNotDone
-	ClinicalImpressionStatusOnHold
-	ClinicalImpressionStatusStopped
-	ClinicalImpressionStatusCompleted
-	ClinicalImpressionStatusEnteredInError
-	ClinicalImpressionStatusUnknown
+	ClinicalImpressionStatusPreparation    ClinicalImpressionStatus = "preparation"
+	ClinicalImpressionStatusInProgress     ClinicalImpressionStatus = "in-progress"
+	ClinicalImpressionStatusNotDone        ClinicalImpressionStatus = "not-done"
+	ClinicalImpressionStatusOnHold         ClinicalImpressionStatus = "on-hold"
+	ClinicalImpressionStatusStopped        ClinicalImpressionStatus = "stopped"
+	ClinicalImpressionStatusCompleted      ClinicalImpressionStatus = "completed"
+	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
+	ClinicalImpressionStatusUnknown        ClinicalImpressionStatus = "unknown"
 )
 
 func (code ClinicalImpressionStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ClinicalImpressionStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ClinicalImpressionStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "preparation":
-		*code = ClinicalImpressionStatusPreparation
-	case "in-progress":
-		*code = ClinicalImpressionStatusInProgress
-	case "not-done":
-		*code = ClinicalImpressionStatusNotDone
-	case "on-hold":
-		*code = ClinicalImpressionStatusOnHold
-	case "stopped":
-		*code = ClinicalImpressionStatusStopped
-	case "completed":
-		*code = ClinicalImpressionStatusCompleted
-	case "entered-in-error":
-		*code = ClinicalImpressionStatusEnteredInError
-	case "unknown":
-		*code = ClinicalImpressionStatusUnknown
-	default:
-		return fmt.Errorf("unknown ClinicalImpressionStatus code `%s`", s)
+func (code *ClinicalImpressionStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ClinicalImpressionStatus(s)
 	return nil
 }
 func (code ClinicalImpressionStatus) String() string {
 	return code.Code()
 }
 func (code ClinicalImpressionStatus) Code() string {
+	return string(code)
+}
+func (code ClinicalImpressionStatus) Known() bool {
 	switch code {
-	case ClinicalImpressionStatusPreparation:
-		return "preparation"
-	case ClinicalImpressionStatusInProgress:
-		return "in-progress"
-	case ClinicalImpressionStatusNotDone:
-		return "not-done"
-	case ClinicalImpressionStatusOnHold:
-		return "on-hold"
-	case ClinicalImpressionStatusStopped:
-		return "stopped"
-	case ClinicalImpressionStatusCompleted:
-		return "completed"
-	case ClinicalImpressionStatusEnteredInError:
-		return "entered-in-error"
-	case ClinicalImpressionStatusUnknown:
-		return "unknown"
+	case ClinicalImpressionStatusPreparation, ClinicalImpressionStatusInProgress, ClinicalImpressionStatusNotDone, ClinicalImpressionStatusOnHold, ClinicalImpressionStatusStopped, ClinicalImpressionStatusCompleted, ClinicalImpressionStatusEnteredInError, ClinicalImpressionStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ClinicalImpressionStatus) Display() string {
 	switch code {
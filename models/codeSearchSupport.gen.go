@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.00967 +0000 UTC
+// 2026-10-19 02:55:18.738813698 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CodeSearchSupport is documented here http://hl7.org/fhir/ValueSet/code-search-support
-type CodeSearchSupport int
+// The empty CodeSearchSupport is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CodeSearchSupport string
 
 const (
-	CodeSearchSupportExplicit CodeSearchSupport = iota
-	CodeSearchSupportAll
+	CodeSearchSupportExplicit CodeSearchSupport = "explicit"
+	CodeSearchSupportAll      CodeSearchSupport = "all"
 )
 
 func (code CodeSearchSupport) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CodeSearchSupport"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CodeSearchSupport) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "explicit":
-		*code = CodeSearchSupportExplicit
-	case "all":
-		*code = CodeSearchSupportAll
-	default:
-		return fmt.Errorf("unknown CodeSearchSupport code `%s`", s)
+func (code *CodeSearchSupport) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = CodeSearchSupport(s)
 	return nil
 }
 func (code CodeSearchSupport) String() string {
 	return code.Code()
 }
 func (code CodeSearchSupport) Code() string {
+	return string(code)
+}
+func (code CodeSearchSupport) Known() bool {
 	switch code {
-	case CodeSearchSupportExplicit:
-		return "explicit"
-	case CodeSearchSupportAll:
-		return "all"
+	case CodeSearchSupportExplicit, CodeSearchSupportAll:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CodeSearchSupport) Display() string {
 	switch code {
@@ -22,94 +22,327 @@ import "encoding/json"
 
 // CodeSystem is documented here http://hl7.org/fhir/StructureDefinition/CodeSystem
 type CodeSystem struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                     `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                     `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                     `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                     `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus           `bson:"status" json:"status"`
-	Experimental      *bool                       `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                   `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                     `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail             `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                     `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext              `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept           `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                     `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                     `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	CaseSensitive     *bool                       `bson:"caseSensitive,omitempty" json:"caseSensitive,omitempty"`
-	ValueSet          *string                     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
-	HierarchyMeaning  *CodeSystemHierarchyMeaning `bson:"hierarchyMeaning,omitempty" json:"hierarchyMeaning,omitempty"`
-	Compositional     *bool                       `bson:"compositional,omitempty" json:"compositional,omitempty"`
-	VersionNeeded     *bool                       `bson:"versionNeeded,omitempty" json:"versionNeeded,omitempty"`
-	Content           CodeSystemContentMode       `bson:"content" json:"content"`
-	Supplements       *string                     `bson:"supplements,omitempty" json:"supplements,omitempty"`
-	Count             *int                        `bson:"count,omitempty" json:"count,omitempty"`
-	Filter            []CodeSystemFilter          `bson:"filter,omitempty" json:"filter,omitempty"`
-	Property          []CodeSystemProperty        `bson:"property,omitempty" json:"property,omitempty"`
-	Concept           []CodeSystemConcept         `bson:"concept,omitempty" json:"concept,omitempty"`
+	ID                      *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                     *string                     `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement              *Element                    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier              []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                 *string                     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement          *Element                    `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                    *string                     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element                    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                   *string                     `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement            *Element                    `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                  PublicationStatus           `bson:"status" json:"status"`
+	StatusElement           *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental            *bool                       `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement     *Element                    `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                    *DateTime                   `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement             *Element                    `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher               *string                     `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement        *Element                    `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                 []ContactDetail             `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description             *string                     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                    `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext              []UsageContext              `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction            []CodeableConcept           `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                 *string                     `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement          *Element                    `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright               *string                     `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement        *Element                    `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	CaseSensitive           *bool                       `bson:"caseSensitive,omitempty" json:"caseSensitive,omitempty"`
+	CaseSensitiveElement    *Element                    `bson:"_caseSensitive,omitempty" json:"_caseSensitive,omitempty"`
+	ValueSet                *string                     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement         *Element                    `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
+	HierarchyMeaning        *CodeSystemHierarchyMeaning `bson:"hierarchyMeaning,omitempty" json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaningElement *Element                    `bson:"_hierarchyMeaning,omitempty" json:"_hierarchyMeaning,omitempty"`
+	Compositional           *bool                       `bson:"compositional,omitempty" json:"compositional,omitempty"`
+	CompositionalElement    *Element                    `bson:"_compositional,omitempty" json:"_compositional,omitempty"`
+	VersionNeeded           *bool                       `bson:"versionNeeded,omitempty" json:"versionNeeded,omitempty"`
+	VersionNeededElement    *Element                    `bson:"_versionNeeded,omitempty" json:"_versionNeeded,omitempty"`
+	Content                 CodeSystemContentMode       `bson:"content" json:"content"`
+	ContentElement          *Element                    `bson:"_content,omitempty" json:"_content,omitempty"`
+	Supplements             *string                     `bson:"supplements,omitempty" json:"supplements,omitempty"`
+	SupplementsElement      *Element                    `bson:"_supplements,omitempty" json:"_supplements,omitempty"`
+	Count                   *int                        `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement            *Element                    `bson:"_count,omitempty" json:"_count,omitempty"`
+	Filter                  []CodeSystemFilter          `bson:"filter,omitempty" json:"filter,omitempty"`
+	Property                []CodeSystemProperty        `bson:"property,omitempty" json:"property,omitempty"`
+	Concept                 []CodeSystemConcept         `bson:"concept,omitempty" json:"concept,omitempty"`
+	UnknownFields           map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type CodeSystemFilter struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string           `bson:"code" json:"code"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Operator          []FilterOperator `bson:"operator" json:"operator"`
-	Value             string           `bson:"value" json:"value"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               string                     `bson:"code" json:"code"`
+	CodeElement        *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Operator           []*FilterOperator          `bson:"operator" json:"operator"`
+	OperatorElement    []*Element                 `bson:"_operator,omitempty" json:"_operator,omitempty"`
+	Value              string                     `bson:"value" json:"value"`
+	ValueElement       *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the CodeSystemFilter and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *CodeSystemFilter) UnmarshalJSON(b []byte) error {
+	type other CodeSystemFilter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CodeSystem.filter.operator", len(r.Operator), len(r.OperatorElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CodeSystemFilter with its unknown fields.
+func (r CodeSystemFilter) MarshalJSON() ([]byte, error) {
+	type other CodeSystemFilter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CodeSystemProperty struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string       `bson:"code" json:"code"`
-	URI               *string      `bson:"uri,omitempty" json:"uri,omitempty"`
-	Description       *string      `bson:"description,omitempty" json:"description,omitempty"`
-	Type              PropertyType `bson:"type" json:"type"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               string                     `bson:"code" json:"code"`
+	CodeElement        *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	URI                *string                    `bson:"uri,omitempty" json:"uri,omitempty"`
+	URIElement         *Element                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Type               PropertyType               `bson:"type" json:"type"`
+	TypeElement        *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CodeSystemProperty with its unknown fields.
+func (r CodeSystemProperty) MarshalJSON() ([]byte, error) {
+	type other CodeSystemProperty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type CodeSystemConcept struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              string                         `bson:"code" json:"code"`
+	CodeElement       *Element                       `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                        `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                       `bson:"_display,omitempty" json:"_display,omitempty"`
 	Definition        *string                        `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement *Element                       `bson:"_definition,omitempty" json:"_definition,omitempty"`
 	Designation       []CodeSystemConceptDesignation `bson:"designation,omitempty" json:"designation,omitempty"`
 	Property          []CodeSystemConceptProperty    `bson:"property,omitempty" json:"property,omitempty"`
 	Concept           []CodeSystemConcept            `bson:"concept,omitempty" json:"concept,omitempty"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type CodeSystemConceptDesignation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Use               *Coding     `bson:"use,omitempty" json:"use,omitempty"`
-	Value             string      `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement   *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Use               *Coding                    `bson:"use,omitempty" json:"use,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CodeSystemConceptDesignation with its unknown fields.
+func (r CodeSystemConceptDesignation) MarshalJSON() ([]byte, error) {
+	type other CodeSystemConceptDesignation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CodeSystemConceptProperty struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string      `bson:"code" json:"code"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 string                     `bson:"code" json:"code"`
+	CodeElement          *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	ValueCode            *string                    `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement     *Element                   `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueCoding          *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueString          *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueInteger         *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDateTime        *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal         *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns CodeSystem.concept.property.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *CodeSystemConceptProperty) Value() interface{} {
+	switch {
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	}
+	return nil
+}
+
+// SetValueCode sets CodeSystem.concept.property.value[x] to the code and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
+}
+
+// SetValueCoding sets CodeSystem.concept.property.value[x] to the Coding and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueString sets CodeSystem.concept.property.value[x] to the string and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
 }
+
+// SetValueInteger sets CodeSystem.concept.property.value[x] to the integer and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueBoolean sets CodeSystem.concept.property.value[x] to the boolean and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueDateTime sets CodeSystem.concept.property.value[x] to the dateTime and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueDecimal sets CodeSystem.concept.property.value[x] to the decimal and clears the other types.
+func (r *CodeSystemConceptProperty) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+func (r *CodeSystemConceptProperty) clearValue() {
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueCoding = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+}
+
+// UnmarshalJSON unmarshals the CodeSystemConceptProperty and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CodeSystemConceptProperty) UnmarshalJSON(b []byte) error {
+	type other CodeSystemConceptProperty
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CodeSystem.concept.property.value[x]",
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueCoding != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CodeSystemConceptProperty with its unknown fields.
+func (r CodeSystemConceptProperty) MarshalJSON() ([]byte, error) {
+	type other CodeSystemConceptProperty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the CodeSystemConcept with its unknown fields.
+func (r CodeSystemConcept) MarshalJSON() ([]byte, error) {
+	type other CodeSystemConcept
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CodeSystem and fails with CodeError when a coded element has an unknown code.
+func (r *CodeSystem) UnmarshalJSON(b []byte) error {
+	type other CodeSystem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("CodeSystem", r)
+}
+
 type OtherCodeSystem CodeSystem
 
 // MarshalJSON marshals the given CodeSystem as JSON into a byte slice
 func (r CodeSystem) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCodeSystem
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCodeSystem: OtherCodeSystem(r),
 		ResourceType:    "CodeSystem",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCodeSystem unmarshals a CodeSystem.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.11916 +0000 UTC
+// 2026-10-19 02:55:18.740002687 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CodeSystemContentMode is documented here http://hl7.org/fhir/ValueSet/codesystem-content-mode
-type CodeSystemContentMode int
+// The empty CodeSystemContentMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CodeSystemContentMode string
 
 const (
-	CodeSystemContentModeNotPresent CodeSystemContentMode = iota
-	CodeSystemContentModeExample
-	CodeSystemContentModeFragment
-	CodeSystemContentModeComplete
-	CodeSystemContentModeSupplement
+	CodeSystemContentModeNotPresent CodeSystemContentMode = "not-present"
+	CodeSystemContentModeExample    CodeSystemContentMode = "example"
+	CodeSystemContentModeFragment   CodeSystemContentMode = "fragment"
+	CodeSystemContentModeComplete   CodeSystemContentMode = "complete"
+	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
 func (code CodeSystemContentMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CodeSystemContentMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CodeSystemContentMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "not-present":
-		*code = CodeSystemContentModeNotPresent
-	case "example":
-		*code = CodeSystemContentModeExample
-	case "fragment":
-		*code = CodeSystemContentModeFragment
-	case "complete":
-		*code = CodeSystemContentModeComplete
-	case "supplement":
-		*code = CodeSystemContentModeSupplement
-	default:
-		return fmt.Errorf("unknown CodeSystemContentMode code `%s`", s)
+func (code *CodeSystemContentMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = CodeSystemContentMode(s)
 	return nil
 }
 func (code CodeSystemContentMode) String() string {
 	return code.Code()
 }
 func (code CodeSystemContentMode) Code() string {
+	return string(code)
+}
+func (code CodeSystemContentMode) Known() bool {
 	switch code {
-	case CodeSystemContentModeNotPresent:
-		return "not-present"
-	case CodeSystemContentModeExample:
-		return "example"
-	case CodeSystemContentModeFragment:
-		return "fragment"
-	case CodeSystemContentModeComplete:
-		return "complete"
-	case CodeSystemContentModeSupplement:
-		return "supplement"
+	case CodeSystemContentModeNotPresent, CodeSystemContentModeExample, CodeSystemContentModeFragment, CodeSystemContentModeComplete, CodeSystemContentModeSupplement:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CodeSystemContentMode) Display() string {
 	switch code {
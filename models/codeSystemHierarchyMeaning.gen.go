@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.296937 +0000 UTC
+// 2026-10-19 02:55:18.741443846 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CodeSystemHierarchyMeaning is documented here http://hl7.org/fhir/ValueSet/codesystem-hierarchy-meaning
-type CodeSystemHierarchyMeaning int
+// The empty CodeSystemHierarchyMeaning is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CodeSystemHierarchyMeaning string
 
 const (
-	CodeSystemHierarchyMeaningGroupedBy CodeSystemHierarchyMeaning = iota
-	CodeSystemHierarchyMeaningIsA
-	CodeSystemHierarchyMeaningPartOf
-	CodeSystemHierarchyMeaningClassifiedWith
+	CodeSystemHierarchyMeaningGroupedBy      CodeSystemHierarchyMeaning = "grouped-by"
+	CodeSystemHierarchyMeaningIsA            CodeSystemHierarchyMeaning = "is-a"
+	CodeSystemHierarchyMeaningPartOf         CodeSystemHierarchyMeaning = "part-of"
+	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
 func (code CodeSystemHierarchyMeaning) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CodeSystemHierarchyMeaning"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CodeSystemHierarchyMeaning) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "grouped-by":
-		*code = CodeSystemHierarchyMeaningGroupedBy
-	case "is-a":
-		*code = CodeSystemHierarchyMeaningIsA
-	case "part-of":
-		*code = CodeSystemHierarchyMeaningPartOf
-	case "classified-with":
-		*code = CodeSystemHierarchyMeaningClassifiedWith
-	default:
-		return fmt.Errorf("unknown CodeSystemHierarchyMeaning code `%s`", s)
+func (code *CodeSystemHierarchyMeaning) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = CodeSystemHierarchyMeaning(s)
 	return nil
 }
 func (code CodeSystemHierarchyMeaning) String() string {
 	return code.Code()
 }
 func (code CodeSystemHierarchyMeaning) Code() string {
+	return string(code)
+}
+func (code CodeSystemHierarchyMeaning) Known() bool {
 	switch code {
-	case CodeSystemHierarchyMeaningGroupedBy:
-		return "grouped-by"
-	case CodeSystemHierarchyMeaningIsA:
-		return "is-a"
-	case CodeSystemHierarchyMeaningPartOf:
-		return "part-of"
-	case CodeSystemHierarchyMeaningClassifiedWith:
-		return "classified-with"
+	case CodeSystemHierarchyMeaningGroupedBy, CodeSystemHierarchyMeaningIsA, CodeSystemHierarchyMeaningPartOf, CodeSystemHierarchyMeaningClassifiedWith:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CodeSystemHierarchyMeaning) Display() string {
 	switch code {
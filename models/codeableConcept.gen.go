@@ -20,8 +20,9 @@ package models
 
 // CodeableConcept is documented here http://hl7.org/fhir/StructureDefinition/CodeableConcept
 type CodeableConcept struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Coding    []Coding    `bson:"coding,omitempty" json:"coding,omitempty"`
-	Text      *string     `bson:"text,omitempty" json:"text,omitempty"`
+	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Coding      []Coding    `bson:"coding,omitempty" json:"coding,omitempty"`
+	Text        *string     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
 }
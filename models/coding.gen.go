@@ -20,11 +20,16 @@ package models
 
 // Coding is documented here http://hl7.org/fhir/StructureDefinition/Coding
 type Coding struct {
-	ID           *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	System       *string     `bson:"system,omitempty" json:"system,omitempty"`
-	Version      *string     `bson:"version,omitempty" json:"version,omitempty"`
-	Code         *string     `bson:"code,omitempty" json:"code,omitempty"`
-	Display      *string     `bson:"display,omitempty" json:"display,omitempty"`
-	UserSelected *bool       `bson:"userSelected,omitempty" json:"userSelected,omitempty"`
+	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	System              *string     `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement       *Element    `bson:"_system,omitempty" json:"_system,omitempty"`
+	Version             *string     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement      *Element    `bson:"_version,omitempty" json:"_version,omitempty"`
+	Code                *string     `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement         *Element    `bson:"_code,omitempty" json:"_code,omitempty"`
+	Display             *string     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement      *Element    `bson:"_display,omitempty" json:"_display,omitempty"`
+	UserSelected        *bool       `bson:"userSelected,omitempty" json:"userSelected,omitempty"`
+	UserSelectedElement *Element    `bson:"_userSelected,omitempty" json:"_userSelected,omitempty"`
 }
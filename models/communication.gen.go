@@ -22,53 +22,152 @@ import "encoding/json"
 
 // Communication is documented here http://hl7.org/fhir/StructureDefinition/Communication
 type Communication struct {
-	ID                    *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference            `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf                []Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	InResponseTo          []Reference            `bson:"inResponseTo,omitempty" json:"inResponseTo,omitempty"`
-	Status                EventStatus            `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category              []CodeableConcept      `bson:"category,omitempty" json:"category,omitempty"`
-	Priority              *RequestPriority       `bson:"priority,omitempty" json:"priority,omitempty"`
-	Medium                []CodeableConcept      `bson:"medium,omitempty" json:"medium,omitempty"`
-	Subject               *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
-	Topic                 *CodeableConcept       `bson:"topic,omitempty" json:"topic,omitempty"`
-	About                 []Reference            `bson:"about,omitempty" json:"about,omitempty"`
-	Encounter             *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Sent                  *DateTime              `bson:"sent,omitempty" json:"sent,omitempty"`
-	Received              *DateTime              `bson:"received,omitempty" json:"received,omitempty"`
-	Recipient             []Reference            `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Sender                *Reference             `bson:"sender,omitempty" json:"sender,omitempty"`
-	ReasonCode            []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Payload               []CommunicationPayload `bson:"payload,omitempty" json:"payload,omitempty"`
-	Note                  []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                       []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	InResponseTo                 []Reference                `bson:"inResponseTo,omitempty" json:"inResponseTo,omitempty"`
+	Status                       EventStatus                `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept           `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                     []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                     *RequestPriority           `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Medium                       []CodeableConcept          `bson:"medium,omitempty" json:"medium,omitempty"`
+	Subject                      *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Topic                        *CodeableConcept           `bson:"topic,omitempty" json:"topic,omitempty"`
+	About                        []Reference                `bson:"about,omitempty" json:"about,omitempty"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Sent                         *DateTime                  `bson:"sent,omitempty" json:"sent,omitempty"`
+	SentElement                  *Element                   `bson:"_sent,omitempty" json:"_sent,omitempty"`
+	Received                     *DateTime                  `bson:"received,omitempty" json:"received,omitempty"`
+	ReceivedElement              *Element                   `bson:"_received,omitempty" json:"_received,omitempty"`
+	Recipient                    []Reference                `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Sender                       *Reference                 `bson:"sender,omitempty" json:"sender,omitempty"`
+	ReasonCode                   []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Payload                      []CommunicationPayload     `bson:"payload,omitempty" json:"payload,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type CommunicationPayload struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentString        *string                    `bson:"contentString,omitempty" json:"contentString,omitempty"`
+	ContentStringElement *Element                   `bson:"_contentString,omitempty" json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment                `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference     *Reference                 `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Content returns Communication.payload.content[x] of the set type, such as *string, or nil when it is not set.
+func (r *CommunicationPayload) Content() interface{} {
+	switch {
+	case r.ContentString != nil:
+		return r.ContentString
+	case r.ContentAttachment != nil:
+		return r.ContentAttachment
+	case r.ContentReference != nil:
+		return r.ContentReference
+	}
+	return nil
+}
+
+// SetContentString sets Communication.payload.content[x] to the string and clears the other types.
+func (r *CommunicationPayload) SetContentString(v string) {
+	r.clearContent()
+	r.ContentString = &v
+}
+
+// SetContentAttachment sets Communication.payload.content[x] to the Attachment and clears the other types.
+func (r *CommunicationPayload) SetContentAttachment(v Attachment) {
+	r.clearContent()
+	r.ContentAttachment = &v
+}
+
+// SetContentReference sets Communication.payload.content[x] to the Reference and clears the other types.
+func (r *CommunicationPayload) SetContentReference(v Reference) {
+	r.clearContent()
+	r.ContentReference = &v
+}
+func (r *CommunicationPayload) clearContent() {
+	r.ContentString = nil
+	r.ContentStringElement = nil
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+}
+
+// UnmarshalJSON unmarshals the CommunicationPayload and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CommunicationPayload) UnmarshalJSON(b []byte) error {
+	type other CommunicationPayload
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Communication.payload.content[x]",
+		r.ContentString != nil || r.ContentStringElement != nil,
+		r.ContentAttachment != nil,
+		r.ContentReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CommunicationPayload with its unknown fields.
+func (r CommunicationPayload) MarshalJSON() ([]byte, error) {
+	type other CommunicationPayload
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Communication and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Communication) UnmarshalJSON(b []byte) error {
+	type other Communication
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Communication.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Communication.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("Communication", r)
+}
+
 type OtherCommunication Communication
 
 // MarshalJSON marshals the given Communication as JSON into a byte slice
 func (r Communication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCommunication
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCommunication: OtherCommunication(r),
 		ResourceType:       "Communication",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCommunication unmarshals a Communication.
@@ -22,51 +22,180 @@ import "encoding/json"
 
 // CommunicationRequest is documented here http://hl7.org/fhir/StructureDefinition/CommunicationRequest
 type CommunicationRequest struct {
-	ID                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference                   `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces          []Reference                   `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	GroupIdentifier   *Identifier                   `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	Status            RequestStatus                 `bson:"status" json:"status"`
-	StatusReason      *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category          []CodeableConcept             `bson:"category,omitempty" json:"category,omitempty"`
-	Priority          *RequestPriority              `bson:"priority,omitempty" json:"priority,omitempty"`
-	DoNotPerform      *bool                         `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Medium            []CodeableConcept             `bson:"medium,omitempty" json:"medium,omitempty"`
-	Subject           *Reference                    `bson:"subject,omitempty" json:"subject,omitempty"`
-	About             []Reference                   `bson:"about,omitempty" json:"about,omitempty"`
-	Encounter         *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Payload           []CommunicationRequestPayload `bson:"payload,omitempty" json:"payload,omitempty"`
-	AuthoredOn        *DateTime                     `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester         *Reference                    `bson:"requester,omitempty" json:"requester,omitempty"`
-	Recipient         []Reference                   `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Sender            *Reference                    `bson:"sender,omitempty" json:"sender,omitempty"`
-	ReasonCode        []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note              []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	ID                        *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   []Reference                   `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                  []Reference                   `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	GroupIdentifier           *Identifier                   `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	Status                    RequestStatus                 `bson:"status" json:"status"`
+	StatusElement             *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                  []CodeableConcept             `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                  *RequestPriority              `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement           *Element                      `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	DoNotPerform              *bool                         `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement       *Element                      `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	Medium                    []CodeableConcept             `bson:"medium,omitempty" json:"medium,omitempty"`
+	Subject                   *Reference                    `bson:"subject,omitempty" json:"subject,omitempty"`
+	About                     []Reference                   `bson:"about,omitempty" json:"about,omitempty"`
+	Encounter                 *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Payload                   []CommunicationRequestPayload `bson:"payload,omitempty" json:"payload,omitempty"`
+	OccurrenceDateTime        *DateTime                     `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                      `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                       `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	AuthoredOn                *DateTime                     `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement         *Element                      `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                 *Reference                    `bson:"requester,omitempty" json:"requester,omitempty"`
+	Recipient                 []Reference                   `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Sender                    *Reference                    `bson:"sender,omitempty" json:"sender,omitempty"`
+	ReasonCode                []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                      []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields             map[string]json.RawMessage    `bson:"-" json:"-"`
 }
 type CommunicationRequestPayload struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentString        *string                    `bson:"contentString,omitempty" json:"contentString,omitempty"`
+	ContentStringElement *Element                   `bson:"_contentString,omitempty" json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment                `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference     *Reference                 `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Content returns CommunicationRequest.payload.content[x] of the set type, such as *string, or nil when it is not set.
+func (r *CommunicationRequestPayload) Content() interface{} {
+	switch {
+	case r.ContentString != nil:
+		return r.ContentString
+	case r.ContentAttachment != nil:
+		return r.ContentAttachment
+	case r.ContentReference != nil:
+		return r.ContentReference
+	}
+	return nil
+}
+
+// SetContentString sets CommunicationRequest.payload.content[x] to the string and clears the other types.
+func (r *CommunicationRequestPayload) SetContentString(v string) {
+	r.clearContent()
+	r.ContentString = &v
+}
+
+// SetContentAttachment sets CommunicationRequest.payload.content[x] to the Attachment and clears the other types.
+func (r *CommunicationRequestPayload) SetContentAttachment(v Attachment) {
+	r.clearContent()
+	r.ContentAttachment = &v
+}
+
+// SetContentReference sets CommunicationRequest.payload.content[x] to the Reference and clears the other types.
+func (r *CommunicationRequestPayload) SetContentReference(v Reference) {
+	r.clearContent()
+	r.ContentReference = &v
+}
+func (r *CommunicationRequestPayload) clearContent() {
+	r.ContentString = nil
+	r.ContentStringElement = nil
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+}
+
+// UnmarshalJSON unmarshals the CommunicationRequestPayload and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CommunicationRequestPayload) UnmarshalJSON(b []byte) error {
+	type other CommunicationRequestPayload
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CommunicationRequest.payload.content[x]",
+		r.ContentString != nil || r.ContentStringElement != nil,
+		r.ContentAttachment != nil,
+		r.ContentReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CommunicationRequestPayload with its unknown fields.
+func (r CommunicationRequestPayload) MarshalJSON() ([]byte, error) {
+	type other CommunicationRequestPayload
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurrence returns CommunicationRequest.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *CommunicationRequest) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets CommunicationRequest.occurrence[x] to the dateTime and clears the other types.
+func (r *CommunicationRequest) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets CommunicationRequest.occurrence[x] to the Period and clears the other types.
+func (r *CommunicationRequest) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+func (r *CommunicationRequest) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the CommunicationRequest and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *CommunicationRequest) UnmarshalJSON(b []byte) error {
+	type other CommunicationRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CommunicationRequest.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("CommunicationRequest", r)
+}
+
 type OtherCommunicationRequest CommunicationRequest
 
 // MarshalJSON marshals the given CommunicationRequest as JSON into a byte slice
 func (r CommunicationRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCommunicationRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCommunicationRequest: OtherCommunicationRequest(r),
 		ResourceType:              "CommunicationRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCommunicationRequest unmarshals a CommunicationRequest.
@@ -22,47 +22,102 @@ import "encoding/json"
 
 // CompartmentDefinition is documented here http://hl7.org/fhir/StructureDefinition/CompartmentDefinition
 type CompartmentDefinition struct {
-	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                          `bson:"url" json:"url"`
-	Version           *string                         `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                          `bson:"name" json:"name"`
-	Status            PublicationStatus               `bson:"status" json:"status"`
-	Experimental      *bool                           `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                       `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                         `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                 `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                         `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                  `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Purpose           *string                         `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Code              CompartmentType                 `bson:"code" json:"code"`
-	Search            bool                            `bson:"search" json:"search"`
-	Resource          []CompartmentDefinitionResource `bson:"resource,omitempty" json:"resource,omitempty"`
+	ID                   *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  string                          `bson:"url" json:"url"`
+	URLElement           *Element                        `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                         `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                        `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                          `bson:"name" json:"name"`
+	NameElement          *Element                        `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus               `bson:"status" json:"status"`
+	StatusElement        *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                           `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                        `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                       `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                        `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                         `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                        `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                 `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                         `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                        `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                  `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Purpose              *string                         `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                        `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Code                 CompartmentType                 `bson:"code" json:"code"`
+	CodeElement          *Element                        `bson:"_code,omitempty" json:"_code,omitempty"`
+	Search               bool                            `bson:"search" json:"search"`
+	SearchElement        *Element                        `bson:"_search,omitempty" json:"_search,omitempty"`
+	Resource             []CompartmentDefinitionResource `bson:"resource,omitempty" json:"resource,omitempty"`
+	UnknownFields        map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type CompartmentDefinitionResource struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              ResourceType `bson:"code" json:"code"`
-	Param             []string     `bson:"param,omitempty" json:"param,omitempty"`
-	Documentation     *string      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 ResourceType               `bson:"code" json:"code"`
+	CodeElement          *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Param                []*string                  `bson:"param,omitempty" json:"param,omitempty"`
+	ParamElement         []*Element                 `bson:"_param,omitempty" json:"_param,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the CompartmentDefinitionResource and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *CompartmentDefinitionResource) UnmarshalJSON(b []byte) error {
+	type other CompartmentDefinitionResource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CompartmentDefinition.resource.param", len(r.Param), len(r.ParamElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CompartmentDefinitionResource with its unknown fields.
+func (r CompartmentDefinitionResource) MarshalJSON() ([]byte, error) {
+	type other CompartmentDefinitionResource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the CompartmentDefinition and fails with CodeError when a coded element has an unknown code.
+func (r *CompartmentDefinition) UnmarshalJSON(b []byte) error {
+	type other CompartmentDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("CompartmentDefinition", r)
+}
+
 type OtherCompartmentDefinition CompartmentDefinition
 
 // MarshalJSON marshals the given CompartmentDefinition as JSON into a byte slice
 func (r CompartmentDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCompartmentDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCompartmentDefinition: OtherCompartmentDefinition(r),
 		ResourceType:               "CompartmentDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCompartmentDefinition unmarshals a CompartmentDefinition.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.230199 +0000 UTC
+// 2026-10-19 02:55:18.74292732 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CompartmentType is documented here http://hl7.org/fhir/ValueSet/compartment-type
-type CompartmentType int
+// The empty CompartmentType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CompartmentType string
 
 const (
-	CompartmentTypePatient CompartmentType = iota
-	CompartmentTypeEncounter
-	CompartmentTypeRelatedPerson
-	CompartmentTypePractitioner
-	CompartmentTypeDevice
+	CompartmentTypePatient       CompartmentType = "Patient"
+	CompartmentTypeEncounter     CompartmentType = "Encounter"
+	CompartmentTypeRelatedPerson CompartmentType = "RelatedPerson"
+	CompartmentTypePractitioner  CompartmentType = "Practitioner"
+	CompartmentTypeDevice        CompartmentType = "Device"
 )
 
 func (code CompartmentType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CompartmentType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CompartmentType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "Patient":
-		*code = CompartmentTypePatient
-	case "Encounter":
-		*code = CompartmentTypeEncounter
-	case "RelatedPerson":
-		*code = CompartmentTypeRelatedPerson
-	case "Practitioner":
-		*code = CompartmentTypePractitioner
-	case "Device":
-		*code = CompartmentTypeDevice
-	default:
-		return fmt.Errorf("unknown CompartmentType code `%s`", s)
+func (code *CompartmentType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = CompartmentType(s)
 	return nil
 }
 func (code CompartmentType) String() string {
 	return code.Code()
 }
 func (code CompartmentType) Code() string {
+	return string(code)
+}
+func (code CompartmentType) Known() bool {
 	switch code {
-	case CompartmentTypePatient:
-		return "Patient"
-	case CompartmentTypeEncounter:
-		return "Encounter"
-	case CompartmentTypeRelatedPerson:
-		return "RelatedPerson"
-	case CompartmentTypePractitioner:
-		return "Practitioner"
-	case CompartmentTypeDevice:
-		return "Device"
+	case CompartmentTypePatient, CompartmentTypeEncounter, CompartmentTypeRelatedPerson, CompartmentTypePractitioner, CompartmentTypeDevice:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CompartmentType) Display() string {
 	switch code {
@@ -22,77 +22,196 @@ import "encoding/json"
 
 // Composition is documented here http://hl7.org/fhir/StructureDefinition/Composition
 type Composition struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            CompositionStatus      `bson:"status" json:"status"`
-	Type              CodeableConcept        `bson:"type" json:"type"`
-	Category          []CodeableConcept      `bson:"category,omitempty" json:"category,omitempty"`
-	Subject           *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter         *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Date              DateTime               `bson:"date" json:"date"`
-	Author            []Reference            `bson:"author" json:"author"`
-	Title             string                 `bson:"title" json:"title"`
-	Confidentiality   *string                `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
-	Attester          []CompositionAttester  `bson:"attester,omitempty" json:"attester,omitempty"`
-	Custodian         *Reference             `bson:"custodian,omitempty" json:"custodian,omitempty"`
-	RelatesTo         []CompositionRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
-	Event             []CompositionEvent     `bson:"event,omitempty" json:"event,omitempty"`
-	Section           []CompositionSection   `bson:"section,omitempty" json:"section,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                 CompositionStatus          `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                   CodeableConcept            `bson:"type" json:"type"`
+	Category               []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Subject                *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter              *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Date                   DateTime                   `bson:"date" json:"date"`
+	DateElement            *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Author                 []Reference                `bson:"author" json:"author"`
+	Title                  string                     `bson:"title" json:"title"`
+	TitleElement           *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Confidentiality        *string                    `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
+	ConfidentialityElement *Element                   `bson:"_confidentiality,omitempty" json:"_confidentiality,omitempty"`
+	Attester               []CompositionAttester      `bson:"attester,omitempty" json:"attester,omitempty"`
+	Custodian              *Reference                 `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	RelatesTo              []CompositionRelatesTo     `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
+	Event                  []CompositionEvent         `bson:"event,omitempty" json:"event,omitempty"`
+	Section                []CompositionSection       `bson:"section,omitempty" json:"section,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type CompositionAttester struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Mode              CompositionAttestationMode `bson:"mode" json:"mode"`
+	ModeElement       *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
 	Time              *DateTime                  `bson:"time,omitempty" json:"time,omitempty"`
+	TimeElement       *Element                   `bson:"_time,omitempty" json:"_time,omitempty"`
 	Party             *Reference                 `bson:"party,omitempty" json:"party,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CompositionAttester with its unknown fields.
+func (r CompositionAttester) MarshalJSON() ([]byte, error) {
+	type other CompositionAttester
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CompositionRelatesTo struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              DocumentRelationshipType `bson:"code" json:"code"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              DocumentRelationshipType   `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	TargetIdentifier  *Identifier                `bson:"targetIdentifier,omitempty" json:"targetIdentifier,omitempty"`
+	TargetReference   *Reference                 `bson:"targetReference,omitempty" json:"targetReference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Target returns Composition.relatesTo.target[x] of the set type, such as *Identifier, or nil when it is not set.
+func (r *CompositionRelatesTo) Target() interface{} {
+	switch {
+	case r.TargetIdentifier != nil:
+		return r.TargetIdentifier
+	case r.TargetReference != nil:
+		return r.TargetReference
+	}
+	return nil
+}
+
+// SetTargetIdentifier sets Composition.relatesTo.target[x] to the Identifier and clears the other types.
+func (r *CompositionRelatesTo) SetTargetIdentifier(v Identifier) {
+	r.clearTarget()
+	r.TargetIdentifier = &v
+}
+
+// SetTargetReference sets Composition.relatesTo.target[x] to the Reference and clears the other types.
+func (r *CompositionRelatesTo) SetTargetReference(v Reference) {
+	r.clearTarget()
+	r.TargetReference = &v
+}
+func (r *CompositionRelatesTo) clearTarget() {
+	r.TargetIdentifier = nil
+	r.TargetReference = nil
+}
+
+// UnmarshalJSON unmarshals the CompositionRelatesTo and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CompositionRelatesTo) UnmarshalJSON(b []byte) error {
+	type other CompositionRelatesTo
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Composition.relatesTo.target[x]",
+		r.TargetIdentifier != nil,
+		r.TargetReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CompositionRelatesTo with its unknown fields.
+func (r CompositionRelatesTo) MarshalJSON() ([]byte, error) {
+	type other CompositionRelatesTo
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CompositionEvent struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Period            *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	Detail            []Reference       `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              []CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Detail            []Reference                `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CompositionEvent with its unknown fields.
+func (r CompositionEvent) MarshalJSON() ([]byte, error) {
+	type other CompositionEvent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CompositionSection struct {
-	ID                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Title             *string              `bson:"title,omitempty" json:"title,omitempty"`
-	Code              *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
-	Author            []Reference          `bson:"author,omitempty" json:"author,omitempty"`
-	Focus             *Reference           `bson:"focus,omitempty" json:"focus,omitempty"`
-	Text              *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Mode              *ListMode            `bson:"mode,omitempty" json:"mode,omitempty"`
-	OrderedBy         *CodeableConcept     `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
-	Entry             []Reference          `bson:"entry,omitempty" json:"entry,omitempty"`
-	EmptyReason       *CodeableConcept     `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
-	Section           []CompositionSection `bson:"section,omitempty" json:"section,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Title             *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement      *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Author            []Reference                `bson:"author,omitempty" json:"author,omitempty"`
+	Focus             *Reference                 `bson:"focus,omitempty" json:"focus,omitempty"`
+	Text              *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Mode              *ListMode                  `bson:"mode,omitempty" json:"mode,omitempty"`
+	ModeElement       *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	OrderedBy         *CodeableConcept           `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
+	Entry             []Reference                `bson:"entry,omitempty" json:"entry,omitempty"`
+	EmptyReason       *CodeableConcept           `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
+	Section           []CompositionSection       `bson:"section,omitempty" json:"section,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CompositionSection with its unknown fields.
+func (r CompositionSection) MarshalJSON() ([]byte, error) {
+	type other CompositionSection
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the Composition and fails with CodeError when a coded element has an unknown code.
+func (r *Composition) UnmarshalJSON(b []byte) error {
+	type other Composition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Composition", r)
+}
+
 type OtherComposition Composition
 
 // MarshalJSON marshals the given Composition as JSON into a byte slice
 func (r Composition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherComposition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherComposition: OtherComposition(r),
 		ResourceType:     "Composition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalComposition unmarshals a Composition.
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.070919 +0000 UTC
+// 2026-10-19 02:55:18.744158819 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CompositionAttestationMode is documented here http://hl7.org/fhir/ValueSet/composition-attestation-mode
-type CompositionAttestationMode int
+// The empty CompositionAttestationMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CompositionAttestationMode string
 
 const (
-	CompositionAttestationModePersonal CompositionAttestationMode = iota
-	CompositionAttestationModeProfessional
-	CompositionAttestationModeLegal
-	CompositionAttestationModeOfficial
+	CompositionAttestationModePersonal     CompositionAttestationMode = "personal"
+	CompositionAttestationModeProfessional CompositionAttestationMode = "professional"
+	CompositionAttestationModeLegal        CompositionAttestationMode = "legal"
+	CompositionAttestationModeOfficial     CompositionAttestationMode = "official"
 )
 
 func (code CompositionAttestationMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CompositionAttestationMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CompositionAttestationMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "personal":
-		*code = CompositionAttestationModePersonal
-	case "professional":
-		*code = CompositionAttestationModeProfessional
-	case "legal":
-		*code = CompositionAttestationModeLegal
-	case "official":
-		*code = CompositionAttestationModeOfficial
-	default:
-		return fmt.Errorf("unknown CompositionAttestationMode code `%s`", s)
+func (code *CompositionAttestationMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = CompositionAttestationMode(s)
 	return nil
 }
 func (code CompositionAttestationMode) String() string {
 	return code.Code()
 }
 func (code CompositionAttestationMode) Code() string {
+	return string(code)
+}
+func (code CompositionAttestationMode) Known() bool {
 	switch code {
-	case CompositionAttestationModePersonal:
-		return "personal"
-	case CompositionAttestationModeProfessional:
-		return "professional"
-	case CompositionAttestationModeLegal:
-		return "legal"
-	case CompositionAttestationModeOfficial:
-		return "official"
+	case CompositionAttestationModePersonal, CompositionAttestationModeProfessional, CompositionAttestationModeLegal, CompositionAttestationModeOfficial:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CompositionAttestationMode) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.216074 +0000 UTC
+// 2026-10-19 02:55:18.745573034 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // CompositionStatus is documented here http://hl7.org/fhir/ValueSet/composition-status
-type CompositionStatus int
+// The empty CompositionStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type CompositionStatus string
 
 const (
-	CompositionStatusPreliminary CompositionStatus = iota
-	CompositionStatusFinal
-	CompositionStatusAmended
-	CompositionStatusEnteredInError
+	CompositionStatusPreliminary    CompositionStatus = "preliminary"
+	CompositionStatusFinal          CompositionStatus = "final"
+	CompositionStatusAmended        CompositionStatus = "amended"
+	CompositionStatusEnteredInError CompositionStatus = "entered-in-error"
 )
 
 func (code CompositionStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "CompositionStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *CompositionStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "preliminary":
-		*code = CompositionStatusPreliminary
-	case "final":
-		*code = CompositionStatusFinal
-	case "amended":
-		*code = CompositionStatusAmended
-	case "entered-in-error":
-		*code = CompositionStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown CompositionStatus code `%s`", s)
+func (code *CompositionStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = CompositionStatus(s)
 	return nil
 }
 func (code CompositionStatus) String() string {
 	return code.Code()
 }
 func (code CompositionStatus) Code() string {
+	return string(code)
+}
+func (code CompositionStatus) Known() bool {
 	switch code {
-	case CompositionStatusPreliminary:
-		return "preliminary"
-	case CompositionStatusFinal:
-		return "final"
-	case CompositionStatusAmended:
-		return "amended"
-	case CompositionStatusEnteredInError:
-		return "entered-in-error"
+	case CompositionStatusPreliminary, CompositionStatusFinal, CompositionStatusAmended, CompositionStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code CompositionStatus) Display() string {
 	switch code {
@@ -22,89 +22,272 @@ import "encoding/json"
 
 // ConceptMap is documented here http://hl7.org/fhir/StructureDefinition/ConceptMap
 type ConceptMap struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string           `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        *Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string           `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string           `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string           `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus `bson:"status" json:"status"`
-	Experimental      *bool             `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime         `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string           `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string           `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext    `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string           `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string           `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Group             []ConceptMapGroup `bson:"group,omitempty" json:"group,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                    *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement             *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier             *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                  *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                 PublicationStatus          `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental           *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                   *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher              *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description            *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext             []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction           []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright              *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	SourceUri              *string                    `bson:"sourceUri,omitempty" json:"sourceUri,omitempty"`
+	SourceUriElement       *Element                   `bson:"_sourceUri,omitempty" json:"_sourceUri,omitempty"`
+	SourceCanonical        *string                    `bson:"sourceCanonical,omitempty" json:"sourceCanonical,omitempty"`
+	SourceCanonicalElement *Element                   `bson:"_sourceCanonical,omitempty" json:"_sourceCanonical,omitempty"`
+	TargetUri              *string                    `bson:"targetUri,omitempty" json:"targetUri,omitempty"`
+	TargetUriElement       *Element                   `bson:"_targetUri,omitempty" json:"_targetUri,omitempty"`
+	TargetCanonical        *string                    `bson:"targetCanonical,omitempty" json:"targetCanonical,omitempty"`
+	TargetCanonicalElement *Element                   `bson:"_targetCanonical,omitempty" json:"_targetCanonical,omitempty"`
+	Group                  []ConceptMapGroup          `bson:"group,omitempty" json:"group,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ConceptMapGroup struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Source            *string                  `bson:"source,omitempty" json:"source,omitempty"`
-	SourceVersion     *string                  `bson:"sourceVersion,omitempty" json:"sourceVersion,omitempty"`
-	Target            *string                  `bson:"target,omitempty" json:"target,omitempty"`
-	TargetVersion     *string                  `bson:"targetVersion,omitempty" json:"targetVersion,omitempty"`
-	Element           []ConceptMapGroupElement `bson:"element" json:"element"`
-	Unmapped          *ConceptMapGroupUnmapped `bson:"unmapped,omitempty" json:"unmapped,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Source               *string                    `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement        *Element                   `bson:"_source,omitempty" json:"_source,omitempty"`
+	SourceVersion        *string                    `bson:"sourceVersion,omitempty" json:"sourceVersion,omitempty"`
+	SourceVersionElement *Element                   `bson:"_sourceVersion,omitempty" json:"_sourceVersion,omitempty"`
+	Target               *string                    `bson:"target,omitempty" json:"target,omitempty"`
+	TargetElement        *Element                   `bson:"_target,omitempty" json:"_target,omitempty"`
+	TargetVersion        *string                    `bson:"targetVersion,omitempty" json:"targetVersion,omitempty"`
+	TargetVersionElement *Element                   `bson:"_targetVersion,omitempty" json:"_targetVersion,omitempty"`
+	Element              []ConceptMapGroupElement   `bson:"element" json:"element"`
+	Unmapped             *ConceptMapGroupUnmapped   `bson:"unmapped,omitempty" json:"unmapped,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ConceptMapGroupElement struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              *string                        `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element                       `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                        `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                       `bson:"_display,omitempty" json:"_display,omitempty"`
 	Target            []ConceptMapGroupElementTarget `bson:"target,omitempty" json:"target,omitempty"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type ConceptMapGroupElementTarget struct {
-	ID                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *string                                 `bson:"code,omitempty" json:"code,omitempty"`
-	Display           *string                                 `bson:"display,omitempty" json:"display,omitempty"`
-	Equivalence       ConceptMapEquivalence                   `bson:"equivalence" json:"equivalence"`
-	Comment           *string                                 `bson:"comment,omitempty" json:"comment,omitempty"`
-	DependsOn         []ConceptMapGroupElementTargetDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
-	Product           []ConceptMapGroupElementTargetDependsOn `bson:"product,omitempty" json:"product,omitempty"`
+	ID                 *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *string                                 `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement        *Element                                `bson:"_code,omitempty" json:"_code,omitempty"`
+	Display            *string                                 `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement     *Element                                `bson:"_display,omitempty" json:"_display,omitempty"`
+	Equivalence        ConceptMapEquivalence                   `bson:"equivalence" json:"equivalence"`
+	EquivalenceElement *Element                                `bson:"_equivalence,omitempty" json:"_equivalence,omitempty"`
+	Comment            *string                                 `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement     *Element                                `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	DependsOn          []ConceptMapGroupElementTargetDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+	Product            []ConceptMapGroupElementTargetDependsOn `bson:"product,omitempty" json:"product,omitempty"`
+	UnknownFields      map[string]json.RawMessage              `bson:"-" json:"-"`
 }
 type ConceptMapGroupElementTargetDependsOn struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Property          string      `bson:"property" json:"property"`
-	System            *string     `bson:"system,omitempty" json:"system,omitempty"`
-	Value             string      `bson:"value" json:"value"`
-	Display           *string     `bson:"display,omitempty" json:"display,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Property          string                     `bson:"property" json:"property"`
+	PropertyElement   *Element                   `bson:"_property,omitempty" json:"_property,omitempty"`
+	System            *string                    `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element                   `bson:"_system,omitempty" json:"_system,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	Display           *string                    `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                   `bson:"_display,omitempty" json:"_display,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ConceptMapGroupElementTargetDependsOn with its unknown fields.
+func (r ConceptMapGroupElementTargetDependsOn) MarshalJSON() ([]byte, error) {
+	type other ConceptMapGroupElementTargetDependsOn
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ConceptMapGroupElementTarget with its unknown fields.
+func (r ConceptMapGroupElementTarget) MarshalJSON() ([]byte, error) {
+	type other ConceptMapGroupElementTarget
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ConceptMapGroupElement with its unknown fields.
+func (r ConceptMapGroupElement) MarshalJSON() ([]byte, error) {
+	type other ConceptMapGroupElement
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ConceptMapGroupUnmapped struct {
 	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Mode              ConceptMapGroupUnmappedMode `bson:"mode" json:"mode"`
+	ModeElement       *Element                    `bson:"_mode,omitempty" json:"_mode,omitempty"`
 	Code              *string                     `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element                    `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                    `bson:"_display,omitempty" json:"_display,omitempty"`
 	URL               *string                     `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement        *Element                    `bson:"_url,omitempty" json:"_url,omitempty"`
+	UnknownFields     map[string]json.RawMessage  `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ConceptMapGroupUnmapped with its unknown fields.
+func (r ConceptMapGroupUnmapped) MarshalJSON() ([]byte, error) {
+	type other ConceptMapGroupUnmapped
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ConceptMapGroup with its unknown fields.
+func (r ConceptMapGroup) MarshalJSON() ([]byte, error) {
+	type other ConceptMapGroup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Source returns ConceptMap.source[x] of the set type, such as *string, or nil when it is not set.
+func (r *ConceptMap) Source() interface{} {
+	switch {
+	case r.SourceUri != nil:
+		return r.SourceUri
+	case r.SourceCanonical != nil:
+		return r.SourceCanonical
+	}
+	return nil
+}
+
+// SetSourceUri sets ConceptMap.source[x] to the uri and clears the other types.
+func (r *ConceptMap) SetSourceUri(v string) {
+	r.clearSource()
+	r.SourceUri = &v
+}
+
+// SetSourceCanonical sets ConceptMap.source[x] to the canonical and clears the other types.
+func (r *ConceptMap) SetSourceCanonical(v string) {
+	r.clearSource()
+	r.SourceCanonical = &v
+}
+func (r *ConceptMap) clearSource() {
+	r.SourceUri = nil
+	r.SourceUriElement = nil
+	r.SourceCanonical = nil
+	r.SourceCanonicalElement = nil
+}
+
+// Target returns ConceptMap.target[x] of the set type, such as *string, or nil when it is not set.
+func (r *ConceptMap) Target() interface{} {
+	switch {
+	case r.TargetUri != nil:
+		return r.TargetUri
+	case r.TargetCanonical != nil:
+		return r.TargetCanonical
+	}
+	return nil
+}
+
+// SetTargetUri sets ConceptMap.target[x] to the uri and clears the other types.
+func (r *ConceptMap) SetTargetUri(v string) {
+	r.clearTarget()
+	r.TargetUri = &v
+}
+
+// SetTargetCanonical sets ConceptMap.target[x] to the canonical and clears the other types.
+func (r *ConceptMap) SetTargetCanonical(v string) {
+	r.clearTarget()
+	r.TargetCanonical = &v
+}
+func (r *ConceptMap) clearTarget() {
+	r.TargetUri = nil
+	r.TargetUriElement = nil
+	r.TargetCanonical = nil
+	r.TargetCanonicalElement = nil
+}
+
+// UnmarshalJSON unmarshals the ConceptMap and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *ConceptMap) UnmarshalJSON(b []byte) error {
+	type other ConceptMap
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ConceptMap.source[x]",
+		r.SourceUri != nil || r.SourceUriElement != nil,
+		r.SourceCanonical != nil || r.SourceCanonicalElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ConceptMap.target[x]",
+		r.TargetUri != nil || r.TargetUriElement != nil,
+		r.TargetCanonical != nil || r.TargetCanonicalElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("ConceptMap", r)
+}
+
 type OtherConceptMap ConceptMap
 
 // MarshalJSON marshals the given ConceptMap as JSON into a byte slice
 func (r ConceptMap) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherConceptMap
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherConceptMap: OtherConceptMap(r),
 		ResourceType:    "ConceptMap",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalConceptMap unmarshals a ConceptMap.
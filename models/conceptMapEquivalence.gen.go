@@ -14,90 +14,59 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.150663 +0000 UTC
+// 2026-10-19 02:55:18.74706019 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConceptMapEquivalence is documented here http://hl7.org/fhir/ValueSet/concept-map-equivalence
-type ConceptMapEquivalence int
+// The empty ConceptMapEquivalence is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConceptMapEquivalence string
 
 const (
-	ConceptMapEquivalenceRelatedto ConceptMapEquivalence = iota
-	ConceptMapEquivalenceEquivalent
-	ConceptMapEquivalenceEqual
-	ConceptMapEquivalenceWider
-	ConceptMapEquivalenceSubsumes
-	ConceptMapEquivalenceNarrower
-	ConceptMapEquivalenceSpecializes
-	ConceptMapEquivalenceInexact
-	ConceptMapEquivalenceUnmatched
-	ConceptMapEquivalenceDisjoint
+	ConceptMapEquivalenceRelatedto   ConceptMapEquivalence = "relatedto"
+	ConceptMapEquivalenceEquivalent  ConceptMapEquivalence = "equivalent"
+	ConceptMapEquivalenceEqual       ConceptMapEquivalence = "equal"
+	ConceptMapEquivalenceWider       ConceptMapEquivalence = "wider"
+	ConceptMapEquivalenceSubsumes    ConceptMapEquivalence = "subsumes"
+	ConceptMapEquivalenceNarrower    ConceptMapEquivalence = "narrower"
+	ConceptMapEquivalenceSpecializes ConceptMapEquivalence = "specializes"
+	ConceptMapEquivalenceInexact     ConceptMapEquivalence = "inexact"
+	ConceptMapEquivalenceUnmatched   ConceptMapEquivalence = "unmatched"
+	ConceptMapEquivalenceDisjoint    ConceptMapEquivalence = "disjoint"
 )
 
 func (code ConceptMapEquivalence) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConceptMapEquivalence"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConceptMapEquivalence) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "relatedto":
-		*code = ConceptMapEquivalenceRelatedto
-	case "equivalent":
-		*code = ConceptMapEquivalenceEquivalent
-	case "equal":
-		*code = ConceptMapEquivalenceEqual
-	case "wider":
-		*code = ConceptMapEquivalenceWider
-	case "subsumes":
-		*code = ConceptMapEquivalenceSubsumes
-	case "narrower":
-		*code = ConceptMapEquivalenceNarrower
-	case "specializes":
-		*code = ConceptMapEquivalenceSpecializes
-	case "inexact":
-		*code = ConceptMapEquivalenceInexact
-	case "unmatched":
-		*code = ConceptMapEquivalenceUnmatched
-	case "disjoint":
-		*code = ConceptMapEquivalenceDisjoint
-	default:
-		return fmt.Errorf("unknown ConceptMapEquivalence code `%s`", s)
+func (code *ConceptMapEquivalence) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ConceptMapEquivalence(s)
 	return nil
 }
 func (code ConceptMapEquivalence) String() string {
 	return code.Code()
 }
 func (code ConceptMapEquivalence) Code() string {
+	return string(code)
+}
+func (code ConceptMapEquivalence) Known() bool {
 	switch code {
-	case ConceptMapEquivalenceRelatedto:
-		return "relatedto"
-	case ConceptMapEquivalenceEquivalent:
-		return "equivalent"
-	case ConceptMapEquivalenceEqual:
-		return "equal"
-	case ConceptMapEquivalenceWider:
-		return "wider"
-	case ConceptMapEquivalenceSubsumes:
-		return "subsumes"
-	case ConceptMapEquivalenceNarrower:
-		return "narrower"
-	case ConceptMapEquivalenceSpecializes:
-		return "specializes"
-	case ConceptMapEquivalenceInexact:
-		return "inexact"
-	case ConceptMapEquivalenceUnmatched:
-		return "unmatched"
-	case ConceptMapEquivalenceDisjoint:
-		return "disjoint"
+	case ConceptMapEquivalenceRelatedto, ConceptMapEquivalenceEquivalent, ConceptMapEquivalenceEqual, ConceptMapEquivalenceWider, ConceptMapEquivalenceSubsumes, ConceptMapEquivalenceNarrower, ConceptMapEquivalenceSpecializes, ConceptMapEquivalenceInexact, ConceptMapEquivalenceUnmatched, ConceptMapEquivalenceDisjoint:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConceptMapEquivalence) Display() string {
 	switch code {
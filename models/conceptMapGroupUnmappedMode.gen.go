@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.287919 +0000 UTC
+// 2026-10-19 02:55:18.748970823 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConceptMapGroupUnmappedMode is documented here http://hl7.org/fhir/ValueSet/conceptmap-unmapped-mode
-type ConceptMapGroupUnmappedMode int
+// The empty ConceptMapGroupUnmappedMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConceptMapGroupUnmappedMode string
 
 const (
-	ConceptMapGroupUnmappedModeProvided ConceptMapGroupUnmappedMode = iota
-	ConceptMapGroupUnmappedModeFixed
-	ConceptMapGroupUnmappedModeOtherMap
+	ConceptMapGroupUnmappedModeProvided ConceptMapGroupUnmappedMode = "provided"
+	ConceptMapGroupUnmappedModeFixed    ConceptMapGroupUnmappedMode = "fixed"
+	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
 )
 
 func (code ConceptMapGroupUnmappedMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConceptMapGroupUnmappedMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConceptMapGroupUnmappedMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "provided":
-		*code = ConceptMapGroupUnmappedModeProvided
-	case "fixed":
-		*code = ConceptMapGroupUnmappedModeFixed
-	case "other-map":
-		*code = ConceptMapGroupUnmappedModeOtherMap
-	default:
-		return fmt.Errorf("unknown ConceptMapGroupUnmappedMode code `%s`", s)
+func (code *ConceptMapGroupUnmappedMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ConceptMapGroupUnmappedMode(s)
 	return nil
 }
 func (code ConceptMapGroupUnmappedMode) String() string {
 	return code.Code()
 }
 func (code ConceptMapGroupUnmappedMode) Code() string {
+	return string(code)
+}
+func (code ConceptMapGroupUnmappedMode) Known() bool {
 	switch code {
-	case ConceptMapGroupUnmappedModeProvided:
-		return "provided"
-	case ConceptMapGroupUnmappedModeFixed:
-		return "fixed"
-	case ConceptMapGroupUnmappedModeOtherMap:
-		return "other-map"
+	case ConceptMapGroupUnmappedModeProvided, ConceptMapGroupUnmappedModeFixed, ConceptMapGroupUnmappedModeOtherMap:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConceptMapGroupUnmappedMode) Display() string {
 	switch code {
@@ -22,55 +22,243 @@ import "encoding/json"
 
 // Condition is documented here http://hl7.org/fhir/StructureDefinition/Condition
 type Condition struct {
-	ID                 *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ClinicalStatus     *CodeableConcept    `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
-	VerificationStatus *CodeableConcept    `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
-	Category           []CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
-	Severity           *CodeableConcept    `bson:"severity,omitempty" json:"severity,omitempty"`
-	Code               *CodeableConcept    `bson:"code,omitempty" json:"code,omitempty"`
-	BodySite           []CodeableConcept   `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Subject            Reference           `bson:"subject" json:"subject"`
-	Encounter          *Reference          `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	RecordedDate       *DateTime           `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
-	Recorder           *Reference          `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Asserter           *Reference          `bson:"asserter,omitempty" json:"asserter,omitempty"`
-	Stage              []ConditionStage    `bson:"stage,omitempty" json:"stage,omitempty"`
-	Evidence           []ConditionEvidence `bson:"evidence,omitempty" json:"evidence,omitempty"`
-	Note               []Annotation        `bson:"note,omitempty" json:"note,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ClinicalStatus           *CodeableConcept           `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
+	VerificationStatus       *CodeableConcept           `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
+	Category                 []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Severity                 *CodeableConcept           `bson:"severity,omitempty" json:"severity,omitempty"`
+	Code                     *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	BodySite                 []CodeableConcept          `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Subject                  Reference                  `bson:"subject" json:"subject"`
+	Encounter                *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OnsetDateTime            *DateTime                  `bson:"onsetDateTime,omitempty" json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement     *Element                   `bson:"_onsetDateTime,omitempty" json:"_onsetDateTime,omitempty"`
+	OnsetAge                 *Age                       `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
+	OnsetPeriod              *Period                    `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
+	OnsetRange               *Range                     `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
+	OnsetString              *string                    `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
+	OnsetStringElement       *Element                   `bson:"_onsetString,omitempty" json:"_onsetString,omitempty"`
+	AbatementDateTime        *DateTime                  `bson:"abatementDateTime,omitempty" json:"abatementDateTime,omitempty"`
+	AbatementDateTimeElement *Element                   `bson:"_abatementDateTime,omitempty" json:"_abatementDateTime,omitempty"`
+	AbatementAge             *Age                       `bson:"abatementAge,omitempty" json:"abatementAge,omitempty"`
+	AbatementPeriod          *Period                    `bson:"abatementPeriod,omitempty" json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range                     `bson:"abatementRange,omitempty" json:"abatementRange,omitempty"`
+	AbatementString          *string                    `bson:"abatementString,omitempty" json:"abatementString,omitempty"`
+	AbatementStringElement   *Element                   `bson:"_abatementString,omitempty" json:"_abatementString,omitempty"`
+	RecordedDate             *DateTime                  `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	RecordedDateElement      *Element                   `bson:"_recordedDate,omitempty" json:"_recordedDate,omitempty"`
+	Recorder                 *Reference                 `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Asserter                 *Reference                 `bson:"asserter,omitempty" json:"asserter,omitempty"`
+	Stage                    []ConditionStage           `bson:"stage,omitempty" json:"stage,omitempty"`
+	Evidence                 []ConditionEvidence        `bson:"evidence,omitempty" json:"evidence,omitempty"`
+	Note                     []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ConditionStage struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Summary           *CodeableConcept `bson:"summary,omitempty" json:"summary,omitempty"`
-	Assessment        []Reference      `bson:"assessment,omitempty" json:"assessment,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Summary           *CodeableConcept           `bson:"summary,omitempty" json:"summary,omitempty"`
+	Assessment        []Reference                `bson:"assessment,omitempty" json:"assessment,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ConditionStage with its unknown fields.
+func (r ConditionStage) MarshalJSON() ([]byte, error) {
+	type other ConditionStage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ConditionEvidence struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Detail            []Reference       `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              []CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	Detail            []Reference                `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ConditionEvidence with its unknown fields.
+func (r ConditionEvidence) MarshalJSON() ([]byte, error) {
+	type other ConditionEvidence
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Onset returns Condition.onset[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Condition) Onset() interface{} {
+	switch {
+	case r.OnsetDateTime != nil:
+		return r.OnsetDateTime
+	case r.OnsetAge != nil:
+		return r.OnsetAge
+	case r.OnsetPeriod != nil:
+		return r.OnsetPeriod
+	case r.OnsetRange != nil:
+		return r.OnsetRange
+	case r.OnsetString != nil:
+		return r.OnsetString
+	}
+	return nil
+}
+
+// SetOnsetDateTime sets Condition.onset[x] to the dateTime and clears the other types.
+func (r *Condition) SetOnsetDateTime(v DateTime) {
+	r.clearOnset()
+	r.OnsetDateTime = &v
+}
+
+// SetOnsetAge sets Condition.onset[x] to the Age and clears the other types.
+func (r *Condition) SetOnsetAge(v Age) {
+	r.clearOnset()
+	r.OnsetAge = &v
+}
+
+// SetOnsetPeriod sets Condition.onset[x] to the Period and clears the other types.
+func (r *Condition) SetOnsetPeriod(v Period) {
+	r.clearOnset()
+	r.OnsetPeriod = &v
+}
+
+// SetOnsetRange sets Condition.onset[x] to the Range and clears the other types.
+func (r *Condition) SetOnsetRange(v Range) {
+	r.clearOnset()
+	r.OnsetRange = &v
+}
+
+// SetOnsetString sets Condition.onset[x] to the string and clears the other types.
+func (r *Condition) SetOnsetString(v string) {
+	r.clearOnset()
+	r.OnsetString = &v
+}
+func (r *Condition) clearOnset() {
+	r.OnsetDateTime = nil
+	r.OnsetDateTimeElement = nil
+	r.OnsetAge = nil
+	r.OnsetPeriod = nil
+	r.OnsetRange = nil
+	r.OnsetString = nil
+	r.OnsetStringElement = nil
 }
+
+// Abatement returns Condition.abatement[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Condition) Abatement() interface{} {
+	switch {
+	case r.AbatementDateTime != nil:
+		return r.AbatementDateTime
+	case r.AbatementAge != nil:
+		return r.AbatementAge
+	case r.AbatementPeriod != nil:
+		return r.AbatementPeriod
+	case r.AbatementRange != nil:
+		return r.AbatementRange
+	case r.AbatementString != nil:
+		return r.AbatementString
+	}
+	return nil
+}
+
+// SetAbatementDateTime sets Condition.abatement[x] to the dateTime and clears the other types.
+func (r *Condition) SetAbatementDateTime(v DateTime) {
+	r.clearAbatement()
+	r.AbatementDateTime = &v
+}
+
+// SetAbatementAge sets Condition.abatement[x] to the Age and clears the other types.
+func (r *Condition) SetAbatementAge(v Age) {
+	r.clearAbatement()
+	r.AbatementAge = &v
+}
+
+// SetAbatementPeriod sets Condition.abatement[x] to the Period and clears the other types.
+func (r *Condition) SetAbatementPeriod(v Period) {
+	r.clearAbatement()
+	r.AbatementPeriod = &v
+}
+
+// SetAbatementRange sets Condition.abatement[x] to the Range and clears the other types.
+func (r *Condition) SetAbatementRange(v Range) {
+	r.clearAbatement()
+	r.AbatementRange = &v
+}
+
+// SetAbatementString sets Condition.abatement[x] to the string and clears the other types.
+func (r *Condition) SetAbatementString(v string) {
+	r.clearAbatement()
+	r.AbatementString = &v
+}
+func (r *Condition) clearAbatement() {
+	r.AbatementDateTime = nil
+	r.AbatementDateTimeElement = nil
+	r.AbatementAge = nil
+	r.AbatementPeriod = nil
+	r.AbatementRange = nil
+	r.AbatementString = nil
+	r.AbatementStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the Condition and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Condition) UnmarshalJSON(b []byte) error {
+	type other Condition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Condition.onset[x]",
+		r.OnsetDateTime != nil || r.OnsetDateTimeElement != nil,
+		r.OnsetAge != nil,
+		r.OnsetPeriod != nil,
+		r.OnsetRange != nil,
+		r.OnsetString != nil || r.OnsetStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Condition.abatement[x]",
+		r.AbatementDateTime != nil || r.AbatementDateTimeElement != nil,
+		r.AbatementAge != nil,
+		r.AbatementPeriod != nil,
+		r.AbatementRange != nil,
+		r.AbatementString != nil || r.AbatementStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Condition", r)
+}
+
 type OtherCondition Condition
 
 // MarshalJSON marshals the given Condition as JSON into a byte slice
 func (r Condition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCondition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCondition: OtherCondition(r),
 		ResourceType:   "Condition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCondition unmarshals a Condition.
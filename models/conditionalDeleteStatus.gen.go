@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.242813 +0000 UTC
+// 2026-10-19 02:55:18.750435006 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConditionalDeleteStatus is documented here http://hl7.org/fhir/ValueSet/conditional-delete-status
-type ConditionalDeleteStatus int
+// The empty ConditionalDeleteStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConditionalDeleteStatus string
 
 const (
-	ConditionalDeleteStatusNotSupported ConditionalDeleteStatus = iota
-	ConditionalDeleteStatusSingle
-	ConditionalDeleteStatusMultiple
+	ConditionalDeleteStatusNotSupported ConditionalDeleteStatus = "not-supported"
+	ConditionalDeleteStatusSingle       ConditionalDeleteStatus = "single"
+	ConditionalDeleteStatusMultiple     ConditionalDeleteStatus = "multiple"
 )
 
 func (code ConditionalDeleteStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConditionalDeleteStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConditionalDeleteStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "not-supported":
-		*code = ConditionalDeleteStatusNotSupported
-	case "single":
-		*code = ConditionalDeleteStatusSingle
-	case "multiple":
-		*code = ConditionalDeleteStatusMultiple
-	default:
-		return fmt.Errorf("unknown ConditionalDeleteStatus code `%s`", s)
+func (code *ConditionalDeleteStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ConditionalDeleteStatus(s)
 	return nil
 }
 func (code ConditionalDeleteStatus) String() string {
 	return code.Code()
 }
 func (code ConditionalDeleteStatus) Code() string {
+	return string(code)
+}
+func (code ConditionalDeleteStatus) Known() bool {
 	switch code {
-	case ConditionalDeleteStatusNotSupported:
-		return "not-supported"
-	case ConditionalDeleteStatusSingle:
-		return "single"
-	case ConditionalDeleteStatusMultiple:
-		return "multiple"
+	case ConditionalDeleteStatusNotSupported, ConditionalDeleteStatusSingle, ConditionalDeleteStatusMultiple:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConditionalDeleteStatus) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.076983 +0000 UTC
+// 2026-10-19 02:55:18.751461912 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConditionalReadStatus is documented here http://hl7.org/fhir/ValueSet/conditional-read-status
-type ConditionalReadStatus int
+// The empty ConditionalReadStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConditionalReadStatus string
 
 const (
-	ConditionalReadStatusNotSupported ConditionalReadStatus = iota
-	ConditionalReadStatusModifiedSince
-	ConditionalReadStatusNotMatch
-	ConditionalReadStatusFullSupport
+	ConditionalReadStatusNotSupported  ConditionalReadStatus = "not-supported"
+	ConditionalReadStatusModifiedSince ConditionalReadStatus = "modified-since"
+	ConditionalReadStatusNotMatch      ConditionalReadStatus = "not-match"
+	ConditionalReadStatusFullSupport   ConditionalReadStatus = "full-support"
 )
 
 func (code ConditionalReadStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConditionalReadStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConditionalReadStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "not-supported":
-		*code = ConditionalReadStatusNotSupported
-	case "modified-since":
-		*code = ConditionalReadStatusModifiedSince
-	case "not-match":
-		*code = ConditionalReadStatusNotMatch
-	case "full-support":
-		*code = ConditionalReadStatusFullSupport
-	default:
-		return fmt.Errorf("unknown ConditionalReadStatus code `%s`", s)
+func (code *ConditionalReadStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ConditionalReadStatus(s)
 	return nil
 }
 func (code ConditionalReadStatus) String() string {
 	return code.Code()
 }
 func (code ConditionalReadStatus) Code() string {
+	return string(code)
+}
+func (code ConditionalReadStatus) Known() bool {
 	switch code {
-	case ConditionalReadStatusNotSupported:
-		return "not-supported"
-	case ConditionalReadStatusModifiedSince:
-		return "modified-since"
-	case ConditionalReadStatusNotMatch:
-		return "not-match"
-	case ConditionalReadStatusFullSupport:
-		return "full-support"
+	case ConditionalReadStatusNotSupported, ConditionalReadStatusModifiedSince, ConditionalReadStatusNotMatch, ConditionalReadStatusFullSupport:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConditionalReadStatus) Display() string {
 	switch code {
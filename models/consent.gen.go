@@ -22,82 +22,202 @@ import "encoding/json"
 
 // Consent is documented here http://hl7.org/fhir/StructureDefinition/Consent
 type Consent struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ConsentState          `bson:"status" json:"status"`
-	Scope             CodeableConcept       `bson:"scope" json:"scope"`
-	Category          []CodeableConcept     `bson:"category" json:"category"`
-	Patient           *Reference            `bson:"patient,omitempty" json:"patient,omitempty"`
-	DateTime          *DateTime             `bson:"dateTime,omitempty" json:"dateTime,omitempty"`
-	Performer         []Reference           `bson:"performer,omitempty" json:"performer,omitempty"`
-	Organization      []Reference           `bson:"organization,omitempty" json:"organization,omitempty"`
-	Policy            []ConsentPolicy       `bson:"policy,omitempty" json:"policy,omitempty"`
-	PolicyRule        *CodeableConcept      `bson:"policyRule,omitempty" json:"policyRule,omitempty"`
-	Verification      []ConsentVerification `bson:"verification,omitempty" json:"verification,omitempty"`
-	Provision         *ConsentProvision     `bson:"provision,omitempty" json:"provision,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               ConsentState               `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Scope                CodeableConcept            `bson:"scope" json:"scope"`
+	Category             []CodeableConcept          `bson:"category" json:"category"`
+	Patient              *Reference                 `bson:"patient,omitempty" json:"patient,omitempty"`
+	DateTime             *DateTime                  `bson:"dateTime,omitempty" json:"dateTime,omitempty"`
+	DateTimeElement      *Element                   `bson:"_dateTime,omitempty" json:"_dateTime,omitempty"`
+	Performer            []Reference                `bson:"performer,omitempty" json:"performer,omitempty"`
+	Organization         []Reference                `bson:"organization,omitempty" json:"organization,omitempty"`
+	SourceAttachment     *Attachment                `bson:"sourceAttachment,omitempty" json:"sourceAttachment,omitempty"`
+	SourceReference      *Reference                 `bson:"sourceReference,omitempty" json:"sourceReference,omitempty"`
+	Policy               []ConsentPolicy            `bson:"policy,omitempty" json:"policy,omitempty"`
+	PolicyRule           *CodeableConcept           `bson:"policyRule,omitempty" json:"policyRule,omitempty"`
+	Verification         []ConsentVerification      `bson:"verification,omitempty" json:"verification,omitempty"`
+	Provision            *ConsentProvision          `bson:"provision,omitempty" json:"provision,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ConsentPolicy struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Authority         *string     `bson:"authority,omitempty" json:"authority,omitempty"`
-	URI               *string     `bson:"uri,omitempty" json:"uri,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Authority         *string                    `bson:"authority,omitempty" json:"authority,omitempty"`
+	AuthorityElement  *Element                   `bson:"_authority,omitempty" json:"_authority,omitempty"`
+	URI               *string                    `bson:"uri,omitempty" json:"uri,omitempty"`
+	URIElement        *Element                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ConsentPolicy with its unknown fields.
+func (r ConsentPolicy) MarshalJSON() ([]byte, error) {
+	type other ConsentPolicy
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ConsentVerification struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Verified          bool        `bson:"verified" json:"verified"`
-	VerifiedWith      *Reference  `bson:"verifiedWith,omitempty" json:"verifiedWith,omitempty"`
-	VerificationDate  *DateTime   `bson:"verificationDate,omitempty" json:"verificationDate,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Verified                bool                       `bson:"verified" json:"verified"`
+	VerifiedElement         *Element                   `bson:"_verified,omitempty" json:"_verified,omitempty"`
+	VerifiedWith            *Reference                 `bson:"verifiedWith,omitempty" json:"verifiedWith,omitempty"`
+	VerificationDate        *DateTime                  `bson:"verificationDate,omitempty" json:"verificationDate,omitempty"`
+	VerificationDateElement *Element                   `bson:"_verificationDate,omitempty" json:"_verificationDate,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ConsentVerification with its unknown fields.
+func (r ConsentVerification) MarshalJSON() ([]byte, error) {
+	type other ConsentVerification
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ConsentProvision struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *ConsentProvisionType   `bson:"type,omitempty" json:"type,omitempty"`
-	Period            *Period                 `bson:"period,omitempty" json:"period,omitempty"`
-	Actor             []ConsentProvisionActor `bson:"actor,omitempty" json:"actor,omitempty"`
-	Action            []CodeableConcept       `bson:"action,omitempty" json:"action,omitempty"`
-	SecurityLabel     []Coding                `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Purpose           []Coding                `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Class             []Coding                `bson:"class,omitempty" json:"class,omitempty"`
-	Code              []CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	DataPeriod        *Period                 `bson:"dataPeriod,omitempty" json:"dataPeriod,omitempty"`
-	Data              []ConsentProvisionData  `bson:"data,omitempty" json:"data,omitempty"`
-	Provision         []ConsentProvision      `bson:"provision,omitempty" json:"provision,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *ConsentProvisionType      `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Actor             []ConsentProvisionActor    `bson:"actor,omitempty" json:"actor,omitempty"`
+	Action            []CodeableConcept          `bson:"action,omitempty" json:"action,omitempty"`
+	SecurityLabel     []Coding                   `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Purpose           []Coding                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	Class             []Coding                   `bson:"class,omitempty" json:"class,omitempty"`
+	Code              []CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	DataPeriod        *Period                    `bson:"dataPeriod,omitempty" json:"dataPeriod,omitempty"`
+	Data              []ConsentProvisionData     `bson:"data,omitempty" json:"data,omitempty"`
+	Provision         []ConsentProvision         `bson:"provision,omitempty" json:"provision,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ConsentProvisionActor struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Role              CodeableConcept `bson:"role" json:"role"`
-	Reference         Reference       `bson:"reference" json:"reference"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Role              CodeableConcept            `bson:"role" json:"role"`
+	Reference         Reference                  `bson:"reference" json:"reference"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ConsentProvisionActor with its unknown fields.
+func (r ConsentProvisionActor) MarshalJSON() ([]byte, error) {
+	type other ConsentProvisionActor
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ConsentProvisionData struct {
-	ID                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Meaning           ConsentDataMeaning `bson:"meaning" json:"meaning"`
-	Reference         Reference          `bson:"reference" json:"reference"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Meaning           ConsentDataMeaning         `bson:"meaning" json:"meaning"`
+	MeaningElement    *Element                   `bson:"_meaning,omitempty" json:"_meaning,omitempty"`
+	Reference         Reference                  `bson:"reference" json:"reference"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ConsentProvisionData with its unknown fields.
+func (r ConsentProvisionData) MarshalJSON() ([]byte, error) {
+	type other ConsentProvisionData
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ConsentProvision with its unknown fields.
+func (r ConsentProvision) MarshalJSON() ([]byte, error) {
+	type other ConsentProvision
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Source returns Consent.source[x] of the set type, such as *Attachment, or nil when it is not set.
+func (r *Consent) Source() interface{} {
+	switch {
+	case r.SourceAttachment != nil:
+		return r.SourceAttachment
+	case r.SourceReference != nil:
+		return r.SourceReference
+	}
+	return nil
+}
+
+// SetSourceAttachment sets Consent.source[x] to the Attachment and clears the other types.
+func (r *Consent) SetSourceAttachment(v Attachment) {
+	r.clearSource()
+	r.SourceAttachment = &v
+}
+
+// SetSourceReference sets Consent.source[x] to the Reference and clears the other types.
+func (r *Consent) SetSourceReference(v Reference) {
+	r.clearSource()
+	r.SourceReference = &v
+}
+func (r *Consent) clearSource() {
+	r.SourceAttachment = nil
+	r.SourceReference = nil
+}
+
+// UnmarshalJSON unmarshals the Consent and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Consent) UnmarshalJSON(b []byte) error {
+	type other Consent
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Consent.source[x]",
+		r.SourceAttachment != nil,
+		r.SourceReference != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Consent", r)
 }
+
 type OtherConsent Consent
 
 // MarshalJSON marshals the given Consent as JSON into a byte slice
 func (r Consent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherConsent
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherConsent: OtherConsent(r),
 		ResourceType: "Consent",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalConsent unmarshals a Consent.
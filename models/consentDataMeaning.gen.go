@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.177222 +0000 UTC
+// 2026-10-19 02:55:18.752592617 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConsentDataMeaning is documented here http://hl7.org/fhir/ValueSet/consent-data-meaning
-type ConsentDataMeaning int
+// The empty ConsentDataMeaning is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConsentDataMeaning string
 
 const (
-	ConsentDataMeaningInstance ConsentDataMeaning = iota
-	ConsentDataMeaningRelated
-	ConsentDataMeaningDependents
-	ConsentDataMeaningAuthoredby
+	ConsentDataMeaningInstance   ConsentDataMeaning = "instance"
+	ConsentDataMeaningRelated    ConsentDataMeaning = "related"
+	ConsentDataMeaningDependents ConsentDataMeaning = "dependents"
+	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
 func (code ConsentDataMeaning) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConsentDataMeaning"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConsentDataMeaning) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "instance":
-		*code = ConsentDataMeaningInstance
-	case "related":
-		*code = ConsentDataMeaningRelated
-	case "dependents":
-		*code = ConsentDataMeaningDependents
-	case "authoredby":
-		*code = ConsentDataMeaningAuthoredby
-	default:
-		return fmt.Errorf("unknown ConsentDataMeaning code `%s`", s)
+func (code *ConsentDataMeaning) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ConsentDataMeaning(s)
 	return nil
 }
 func (code ConsentDataMeaning) String() string {
 	return code.Code()
 }
 func (code ConsentDataMeaning) Code() string {
+	return string(code)
+}
+func (code ConsentDataMeaning) Known() bool {
 	switch code {
-	case ConsentDataMeaningInstance:
-		return "instance"
-	case ConsentDataMeaningRelated:
-		return "related"
-	case ConsentDataMeaningDependents:
-		return "dependents"
-	case ConsentDataMeaningAuthoredby:
-		return "authoredby"
+	case ConsentDataMeaningInstance, ConsentDataMeaningRelated, ConsentDataMeaningDependents, ConsentDataMeaningAuthoredby:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConsentDataMeaning) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.292031 +0000 UTC
+// 2026-10-19 02:55:18.753705683 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConsentProvisionType is documented here http://hl7.org/fhir/ValueSet/consent-provision-type
-type ConsentProvisionType int
+// The empty ConsentProvisionType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConsentProvisionType string
 
 const (
-	ConsentProvisionTypeDeny ConsentProvisionType = iota
-	ConsentProvisionTypePermit
+	ConsentProvisionTypeDeny   ConsentProvisionType = "deny"
+	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
 func (code ConsentProvisionType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConsentProvisionType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConsentProvisionType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "deny":
-		*code = ConsentProvisionTypeDeny
-	case "permit":
-		*code = ConsentProvisionTypePermit
-	default:
-		return fmt.Errorf("unknown ConsentProvisionType code `%s`", s)
+func (code *ConsentProvisionType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ConsentProvisionType(s)
 	return nil
 }
 func (code ConsentProvisionType) String() string {
 	return code.Code()
 }
 func (code ConsentProvisionType) Code() string {
+	return string(code)
+}
+func (code ConsentProvisionType) Known() bool {
 	switch code {
-	case ConsentProvisionTypeDeny:
-		return "deny"
-	case ConsentProvisionTypePermit:
-		return "permit"
+	case ConsentProvisionTypeDeny, ConsentProvisionTypePermit:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConsentProvisionType) Display() string {
 	switch code {
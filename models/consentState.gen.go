@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.083359 +0000 UTC
+// 2026-10-19 02:55:18.754688063 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConsentState is documented here http://hl7.org/fhir/ValueSet/consent-state-codes
-type ConsentState int
+// The empty ConsentState is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConsentState string
 
 const (
-	ConsentStateDraft ConsentState = iota
-	ConsentStateProposed
-	ConsentStateActive
-	ConsentStateRejected
-	ConsentStateInactive
-	ConsentStateEnteredInError
+	ConsentStateDraft          ConsentState = "draft"
+	ConsentStateProposed       ConsentState = "proposed"
+	ConsentStateActive         ConsentState = "active"
+	ConsentStateRejected       ConsentState = "rejected"
+	ConsentStateInactive       ConsentState = "inactive"
+	ConsentStateEnteredInError ConsentState = "entered-in-error"
 )
 
 func (code ConsentState) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConsentState"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConsentState) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "draft":
-		*code = ConsentStateDraft
-	case "proposed":
-		*code = ConsentStateProposed
-	case "active":
-		*code = ConsentStateActive
-	case "rejected":
-		*code = ConsentStateRejected
-	case "inactive":
-		*code = ConsentStateInactive
-	case "entered-in-error":
-		*code = ConsentStateEnteredInError
-	default:
-		return fmt.Errorf("unknown ConsentState code `%s`", s)
+func (code *ConsentState) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ConsentState(s)
 	return nil
 }
 func (code ConsentState) String() string {
 	return code.Code()
 }
 func (code ConsentState) Code() string {
+	return string(code)
+}
+func (code ConsentState) Known() bool {
 	switch code {
-	case ConsentStateDraft:
-		return "draft"
-	case ConsentStateProposed:
-		return "proposed"
-	case ConsentStateActive:
-		return "active"
-	case ConsentStateRejected:
-		return "rejected"
-	case ConsentStateInactive:
-		return "inactive"
-	case ConsentStateEnteredInError:
-		return "entered-in-error"
+	case ConsentStateDraft, ConsentStateProposed, ConsentStateActive, ConsentStateRejected, ConsentStateInactive, ConsentStateEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConsentState) Display() string {
 	switch code {
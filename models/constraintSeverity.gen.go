@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.090958 +0000 UTC
+// 2026-10-19 02:55:18.75581671 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ConstraintSeverity is documented here http://hl7.org/fhir/ValueSet/constraint-severity
-type ConstraintSeverity int
+// The empty ConstraintSeverity is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ConstraintSeverity string
 
 const (
-	ConstraintSeverityError ConstraintSeverity = iota
-	ConstraintSeverityWarning
+	ConstraintSeverityError   ConstraintSeverity = "error"
+	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
 func (code ConstraintSeverity) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ConstraintSeverity"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ConstraintSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "error":
-		*code = ConstraintSeverityError
-	case "warning":
-		*code = ConstraintSeverityWarning
-	default:
-		return fmt.Errorf("unknown ConstraintSeverity code `%s`", s)
+func (code *ConstraintSeverity) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ConstraintSeverity(s)
 	return nil
 }
 func (code ConstraintSeverity) String() string {
 	return code.Code()
 }
 func (code ConstraintSeverity) Code() string {
+	return string(code)
+}
+func (code ConstraintSeverity) Known() bool {
 	switch code {
-	case ConstraintSeverityError:
-		return "error"
-	case ConstraintSeverityWarning:
-		return "warning"
+	case ConstraintSeverityError, ConstraintSeverityWarning:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ConstraintSeverity) Display() string {
 	switch code {
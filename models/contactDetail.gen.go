@@ -20,8 +20,9 @@ package models
 
 // ContactDetail is documented here http://hl7.org/fhir/StructureDefinition/ContactDetail
 type ContactDetail struct {
-	ID        *string        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
-	Name      *string        `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom   []ContactPoint `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	ID          *string        `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
+	Name        *string        `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement *Element       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Telecom     []ContactPoint `bson:"telecom,omitempty" json:"telecom,omitempty"`
 }
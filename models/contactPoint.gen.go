@@ -20,11 +20,15 @@ package models
 
 // ContactPoint is documented here http://hl7.org/fhir/StructureDefinition/ContactPoint
 type ContactPoint struct {
-	ID        *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	System    *ContactPointSystem `bson:"system,omitempty" json:"system,omitempty"`
-	Value     *string             `bson:"value,omitempty" json:"value,omitempty"`
-	Use       *ContactPointUse    `bson:"use,omitempty" json:"use,omitempty"`
-	Rank      *int                `bson:"rank,omitempty" json:"rank,omitempty"`
-	Period    *Period             `bson:"period,omitempty" json:"period,omitempty"`
+	ID            *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension     []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	System        *ContactPointSystem `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement *Element            `bson:"_system,omitempty" json:"_system,omitempty"`
+	Value         *string             `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement  *Element            `bson:"_value,omitempty" json:"_value,omitempty"`
+	Use           *ContactPointUse    `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement    *Element            `bson:"_use,omitempty" json:"_use,omitempty"`
+	Rank          *int                `bson:"rank,omitempty" json:"rank,omitempty"`
+	RankElement   *Element            `bson:"_rank,omitempty" json:"_rank,omitempty"`
+	Period        *Period             `bson:"period,omitempty" json:"period,omitempty"`
 }
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.133455 +0000 UTC
+// 2026-10-19 02:55:18.757238626 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ContactPointSystem is documented here http://hl7.org/fhir/ValueSet/contact-point-system
-type ContactPointSystem int
+// The empty ContactPointSystem is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ContactPointSystem string
 
 const (
-	ContactPointSystemPhone ContactPointSystem = iota
-	ContactPointSystemFax
-	ContactPointSystemEmail
-	ContactPointSystemPager
-	ContactPointSystemUrl
-	ContactPointSystemSms
-	ContactPointSystemOther
+	ContactPointSystemPhone ContactPointSystem = "phone"
+	ContactPointSystemFax   ContactPointSystem = "fax"
+	ContactPointSystemEmail ContactPointSystem = "email"
+	ContactPointSystemPager ContactPointSystem = "pager"
+	ContactPointSystemUrl   ContactPointSystem = "url"
+	ContactPointSystemSms   ContactPointSystem = "sms"
+	ContactPointSystemOther ContactPointSystem = "other"
 )
 
 func (code ContactPointSystem) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ContactPointSystem"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ContactPointSystem) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "phone":
-		*code = ContactPointSystemPhone
-	case "fax":
-		*code = ContactPointSystemFax
-	case "email":
-		*code = ContactPointSystemEmail
-	case "pager":
-		*code = ContactPointSystemPager
-	case "url":
-		*code = ContactPointSystemUrl
-	case "sms":
-		*code = ContactPointSystemSms
-	case "other":
-		*code = ContactPointSystemOther
-	default:
-		return fmt.Errorf("unknown ContactPointSystem code `%s`", s)
+func (code *ContactPointSystem) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ContactPointSystem(s)
 	return nil
 }
 func (code ContactPointSystem) String() string {
 	return code.Code()
 }
 func (code ContactPointSystem) Code() string {
+	return string(code)
+}
+func (code ContactPointSystem) Known() bool {
 	switch code {
-	case ContactPointSystemPhone:
-		return "phone"
-	case ContactPointSystemFax:
-		return "fax"
-	case ContactPointSystemEmail:
-		return "email"
-	case ContactPointSystemPager:
-		return "pager"
-	case ContactPointSystemUrl:
-		return "url"
-	case ContactPointSystemSms:
-		return "sms"
-	case ContactPointSystemOther:
-		return "other"
+	case ContactPointSystemPhone, ContactPointSystemFax, ContactPointSystemEmail, ContactPointSystemPager, ContactPointSystemUrl, ContactPointSystemSms, ContactPointSystemOther:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ContactPointSystem) Display() string {
 	switch code {
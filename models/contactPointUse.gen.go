@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.256542 +0000 UTC
+// 2026-10-19 02:55:18.758586748 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ContactPointUse is documented here http://hl7.org/fhir/ValueSet/contact-point-use
-type ContactPointUse int
+// The empty ContactPointUse is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ContactPointUse string
 
 const (
-	ContactPointUseHome ContactPointUse = iota
-	ContactPointUseWork
-	ContactPointUseTemp
-	ContactPointUseOld
-	ContactPointUseMobile
+	ContactPointUseHome   ContactPointUse = "home"
+	ContactPointUseWork   ContactPointUse = "work"
+	ContactPointUseTemp   ContactPointUse = "temp"
+	ContactPointUseOld    ContactPointUse = "old"
+	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
 func (code ContactPointUse) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ContactPointUse"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ContactPointUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "home":
-		*code = ContactPointUseHome
-	case "work":
-		*code = ContactPointUseWork
-	case "temp":
-		*code = ContactPointUseTemp
-	case "old":
-		*code = ContactPointUseOld
-	case "mobile":
-		*code = ContactPointUseMobile
-	default:
-		return fmt.Errorf("unknown ContactPointUse code `%s`", s)
+func (code *ContactPointUse) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ContactPointUse(s)
 	return nil
 }
 func (code ContactPointUse) String() string {
 	return code.Code()
 }
 func (code ContactPointUse) Code() string {
+	return string(code)
+}
+func (code ContactPointUse) Known() bool {
 	switch code {
-	case ContactPointUseHome:
-		return "home"
-	case ContactPointUseWork:
-		return "work"
-	case ContactPointUseTemp:
-		return "temp"
-	case ContactPointUseOld:
-		return "old"
-	case ContactPointUseMobile:
-		return "mobile"
+	case ContactPointUseHome, ContactPointUseWork, ContactPointUseTemp, ContactPointUseOld, ContactPointUseMobile:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ContactPointUse) Display() string {
 	switch code {
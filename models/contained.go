@@ -0,0 +1,216 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ContainedResources are the resources contained in the resource, such as the Medication inlined in the MedicationRequest.
+// The items are pointers to the resources, for example *Medication, the resources of the unknown types are kept as json.RawMessage.
+type ContainedResources []interface{}
+
+// UnmarshalJSON unmarshals the contained resources into the types given by their resourceType.
+func (c *ContainedResources) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	contained := make(ContainedResources, 0, len(items))
+	for _, item := range items {
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(item, &header); err != nil {
+			return err
+		}
+		newResource, ok := resources[header.ResourceType]
+		if !ok {
+			contained = append(contained, item)
+			continue
+		}
+		resource := newResource()
+		// the unknown codes are reported by the container with the paths from it
+		if err := json.Unmarshal(item, resource); err != nil && !errors.As(err, new(CodeError)) {
+			return err
+		}
+		contained = append(contained, resource)
+	}
+	*c = contained
+	return nil
+}
+
+// Find returns the contained resource with the id, which may be given as the local reference "#id", or nil when it is not found.
+func (c ContainedResources) Find(id string) interface{} {
+	if i := c.index(strings.TrimPrefix(id, "#")); i >= 0 {
+		return c[i]
+	}
+	return nil
+}
+
+func (c ContainedResources) index(id string) int {
+	if id == "" {
+		return -1
+	}
+	for i, resource := range c {
+		if resourceID(resource) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainedError is returned when the change would make the contained resources and the local references inconsistent.
+type ContainedError struct {
+	ID     string
+	Reason string
+}
+
+func (e ContainedError) Error() string {
+	return fmt.Sprintf("contained resource \"#%s\" %s", e.ID, e.Reason)
+}
+
+// AddContained adds the resource to the contained resources of the container, such as *MedicationRequest, and returns
+// the local reference to it. The resource without id gets the first free number as id.
+func AddContained(container, resource interface{}) (Reference, error) {
+	contained, err := containedOf(container)
+	if err != nil {
+		return Reference{}, err
+	}
+	v := reflect.ValueOf(resource)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return Reference{}, fmt.Errorf("%T is not a pointer to a resource", resource)
+	}
+	field := v.Elem().FieldByName("ID")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*string)(nil)) {
+		return Reference{}, fmt.Errorf("%T is not a pointer to a resource", resource)
+	}
+	id := resourceID(resource)
+	if id == "" {
+		for n := 1; id == ""; n++ {
+			if contained.index(strconv.Itoa(n)) < 0 {
+				id = strconv.Itoa(n)
+			}
+		}
+		field.Set(reflect.ValueOf(NewString(id)))
+	} else if contained.index(id) >= 0 {
+		return Reference{}, ContainedError{ID: id, Reason: "already exists"}
+	}
+	*contained = append(*contained, resource)
+	return Reference{Reference: NewString("#" + id)}, nil
+}
+
+// FindContained returns the resource contained in the container by the local reference "#id" or nil when it is not found.
+func FindContained(container interface{}, reference string) interface{} {
+	contained, err := containedOf(container)
+	if err != nil {
+		return nil
+	}
+	return contained.Find(reference)
+}
+
+// RemoveContained removes the contained resource by the local reference "#id". It fails with ContainedError
+// when the resource is not found or the container or the other contained resources still refer to it.
+func RemoveContained(container interface{}, reference string) error {
+	contained, err := containedOf(container)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimPrefix(reference, "#")
+	i := contained.index(id)
+	if i < 0 {
+		return ContainedError{ID: id, Reason: "is not found"}
+	}
+	all := *contained
+	*contained = append(append(ContainedResources{}, all[:i]...), all[i+1:]...)
+	refs := make(map[string]bool)
+	collectLocalReferences(reflect.ValueOf(container), refs)
+	if refs[id] {
+		*contained = all
+		return ContainedError{ID: id, Reason: "is referenced"}
+	}
+	return nil
+}
+
+// ValidateContained checks that every local reference of the container refers to the contained resource and
+// every contained resource is referred to, otherwise it returns ContainedError.
+func ValidateContained(container interface{}) error {
+	contained, err := containedOf(container)
+	if err != nil {
+		return err
+	}
+	refs := make(map[string]bool)
+	collectLocalReferences(reflect.ValueOf(container), refs)
+	for id := range refs {
+		if id != "" && contained.index(id) < 0 {
+			return ContainedError{ID: id, Reason: "is not found"}
+		}
+	}
+	for _, resource := range *contained {
+		if id := resourceID(resource); !refs[id] {
+			return ContainedError{ID: id, Reason: "is not referenced"}
+		}
+	}
+	return nil
+}
+
+func containedOf(container interface{}) (*ContainedResources, error) {
+	v := reflect.ValueOf(container)
+	if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+		if field := v.Elem().FieldByName("Contained"); field.IsValid() && field.Type() == reflect.TypeOf(ContainedResources{}) {
+			return field.Addr().Interface().(*ContainedResources), nil
+		}
+	}
+	return nil, fmt.Errorf("%T is not a pointer to a resource with contained resources", container)
+}
+
+func resourceID(resource interface{}) string {
+	if raw, ok := resource.(json.RawMessage); ok {
+		var header struct {
+			ID string `json:"id"`
+		}
+		_ = json.Unmarshal(raw, &header)
+		return header.ID
+	}
+	v := reflect.Indirect(reflect.ValueOf(resource))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	if field := v.FieldByName("ID"); field.IsValid() {
+		if id, ok := field.Interface().(*string); ok && id != nil {
+			return *id
+		}
+	}
+	return ""
+}
+
+// collectLocalReferences collects the ids of the local references "#id" found in the value, "#" refers to the container.
+func collectLocalReferences(v reflect.Value, refs map[string]bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectLocalReferences(v.Elem(), refs)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			collectLocalReferences(v.Index(i), refs)
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(Reference{}) {
+			if ref, _ := v.FieldByName("Reference").Interface().(*string); ref != nil && strings.HasPrefix(*ref, "#") {
+				refs[(*ref)[1:]] = true
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				collectLocalReferences(v.Field(i), refs)
+			}
+		}
+	}
+}
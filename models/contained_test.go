@@ -0,0 +1,91 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestContained(t *testing.T) {
+	data := `{"resourceType":"MedicationRequest","contained":[` +
+		`{"resourceType":"Medication","id":"med","code":{"text":"Aspirin"}},` +
+		`{"resourceType":"FutureResource","id":"future"}],` +
+		`"status":"active","intent":"order","medicationReference":{"reference":"#med"},"subject":{"reference":"#future"}}`
+
+	request, err := UnmarshalMedicationRequest([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	medication, ok := request.Contained.Find("#med").(*Medication)
+	if !ok || ToString(medication.Code.Text) != "Aspirin" {
+		t.Fatalf("expected contained Medication, got %#v", request.Contained.Find("#med"))
+	}
+	if _, ok := request.Contained.Find("future").(json.RawMessage); !ok {
+		t.Errorf("expected unknown resource kept as json.RawMessage, got %#v", request.Contained.Find("future"))
+	}
+	if err := ValidateContained(&request); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := UnmarshalMedicationRequest(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Contained) != 2 || FindContained(&again, "#med") == nil {
+		t.Errorf("contained resources are lost: %s", b)
+	}
+
+	ref, err := AddContained(&request, &Medication{Code: &CodeableConcept{Text: NewString("Ibuprofen")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ToString(ref.Reference) != "#1" {
+		t.Errorf("expected reference #1, got %s", ToString(ref.Reference))
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected ContainedError
+	}{
+		{
+			name:     "add existing id",
+			err:      func() error { _, err := AddContained(&request, &Medication{ID: NewString("med")}); return err }(),
+			expected: ContainedError{ID: "med", Reason: "already exists"},
+		},
+		{
+			name:     "not referenced",
+			err:      ValidateContained(&request),
+			expected: ContainedError{ID: "1", Reason: "is not referenced"},
+		},
+		{
+			name:     "remove referenced",
+			err:      RemoveContained(&request, "#med"),
+			expected: ContainedError{ID: "med", Reason: "is referenced"},
+		},
+		{
+			name:     "remove missing",
+			err:      RemoveContained(&request, "#missing"),
+			expected: ContainedError{ID: "missing", Reason: "is not found"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var containedErr ContainedError
+			if !errors.As(tt.err, &containedErr) || containedErr != tt.expected {
+				t.Errorf("expected error %v, got %v", tt.expected, tt.err)
+			}
+		})
+	}
+
+	if err := RemoveContained(&request, "#1"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if len(request.Contained) != 2 || request.Contained.Find("#med") == nil {
+		t.Errorf("expected the referenced resources kept, got %#v", request.Contained)
+	}
+}
@@ -22,220 +22,1049 @@ import "encoding/json"
 
 // Contract is documented here http://hl7.org/fhir/StructureDefinition/Contract
 type Contract struct {
-	ID                    *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	URL                   *string                      `bson:"url,omitempty" json:"url,omitempty"`
-	Version               *string                      `bson:"version,omitempty" json:"version,omitempty"`
-	Status                *ContractResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
-	LegalState            *CodeableConcept             `bson:"legalState,omitempty" json:"legalState,omitempty"`
-	InstantiatesCanonical *Reference                   `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       *string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	ContentDerivative     *CodeableConcept             `bson:"contentDerivative,omitempty" json:"contentDerivative,omitempty"`
-	Issued                *DateTime                    `bson:"issued,omitempty" json:"issued,omitempty"`
-	Applies               *Period                      `bson:"applies,omitempty" json:"applies,omitempty"`
-	ExpirationType        *CodeableConcept             `bson:"expirationType,omitempty" json:"expirationType,omitempty"`
-	Subject               []Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
-	Authority             []Reference                  `bson:"authority,omitempty" json:"authority,omitempty"`
-	Domain                []Reference                  `bson:"domain,omitempty" json:"domain,omitempty"`
-	Site                  []Reference                  `bson:"site,omitempty" json:"site,omitempty"`
-	Name                  *string                      `bson:"name,omitempty" json:"name,omitempty"`
-	Title                 *string                      `bson:"title,omitempty" json:"title,omitempty"`
-	Subtitle              *string                      `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Alias                 []string                     `bson:"alias,omitempty" json:"alias,omitempty"`
-	Author                *Reference                   `bson:"author,omitempty" json:"author,omitempty"`
-	Scope                 *CodeableConcept             `bson:"scope,omitempty" json:"scope,omitempty"`
-	Type                  *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	SubType               []CodeableConcept            `bson:"subType,omitempty" json:"subType,omitempty"`
-	ContentDefinition     *ContractContentDefinition   `bson:"contentDefinition,omitempty" json:"contentDefinition,omitempty"`
-	Term                  []ContractTerm               `bson:"term,omitempty" json:"term,omitempty"`
-	SupportingInfo        []Reference                  `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	RelevantHistory       []Reference                  `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
-	Signer                []ContractSigner             `bson:"signer,omitempty" json:"signer,omitempty"`
-	Friendly              []ContractFriendly           `bson:"friendly,omitempty" json:"friendly,omitempty"`
-	Legal                 []ContractLegal              `bson:"legal,omitempty" json:"legal,omitempty"`
-	Rule                  []ContractRule               `bson:"rule,omitempty" json:"rule,omitempty"`
+	ID                       *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	URL                      *string                      `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement               *Element                     `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version                  *string                      `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement           *Element                     `bson:"_version,omitempty" json:"_version,omitempty"`
+	Status                   *ContractResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement            *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	LegalState               *CodeableConcept             `bson:"legalState,omitempty" json:"legalState,omitempty"`
+	InstantiatesCanonical    *Reference                   `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesUri          *string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement   *Element                     `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	ContentDerivative        *CodeableConcept             `bson:"contentDerivative,omitempty" json:"contentDerivative,omitempty"`
+	Issued                   *DateTime                    `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement            *Element                     `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Applies                  *Period                      `bson:"applies,omitempty" json:"applies,omitempty"`
+	ExpirationType           *CodeableConcept             `bson:"expirationType,omitempty" json:"expirationType,omitempty"`
+	Subject                  []Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
+	Authority                []Reference                  `bson:"authority,omitempty" json:"authority,omitempty"`
+	Domain                   []Reference                  `bson:"domain,omitempty" json:"domain,omitempty"`
+	Site                     []Reference                  `bson:"site,omitempty" json:"site,omitempty"`
+	Name                     *string                      `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement              *Element                     `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                    *string                      `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement             *Element                     `bson:"_title,omitempty" json:"_title,omitempty"`
+	Subtitle                 *string                      `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement          *Element                     `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Alias                    []*string                    `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement             []*Element                   `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Author                   *Reference                   `bson:"author,omitempty" json:"author,omitempty"`
+	Scope                    *CodeableConcept             `bson:"scope,omitempty" json:"scope,omitempty"`
+	TopicCodeableConcept     *CodeableConcept             `bson:"topicCodeableConcept,omitempty" json:"topicCodeableConcept,omitempty"`
+	TopicReference           *Reference                   `bson:"topicReference,omitempty" json:"topicReference,omitempty"`
+	Type                     *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	SubType                  []CodeableConcept            `bson:"subType,omitempty" json:"subType,omitempty"`
+	ContentDefinition        *ContractContentDefinition   `bson:"contentDefinition,omitempty" json:"contentDefinition,omitempty"`
+	Term                     []ContractTerm               `bson:"term,omitempty" json:"term,omitempty"`
+	SupportingInfo           []Reference                  `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	RelevantHistory          []Reference                  `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	Signer                   []ContractSigner             `bson:"signer,omitempty" json:"signer,omitempty"`
+	Friendly                 []ContractFriendly           `bson:"friendly,omitempty" json:"friendly,omitempty"`
+	Legal                    []ContractLegal              `bson:"legal,omitempty" json:"legal,omitempty"`
+	Rule                     []ContractRule               `bson:"rule,omitempty" json:"rule,omitempty"`
+	LegallyBindingAttachment *Attachment                  `bson:"legallyBindingAttachment,omitempty" json:"legallyBindingAttachment,omitempty"`
+	LegallyBindingReference  *Reference                   `bson:"legallyBindingReference,omitempty" json:"legallyBindingReference,omitempty"`
+	UnknownFields            map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type ContractContentDefinition struct {
-	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept                        `bson:"type" json:"type"`
-	SubType           *CodeableConcept                       `bson:"subType,omitempty" json:"subType,omitempty"`
-	Publisher         *Reference                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	PublicationDate   *DateTime                              `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
-	PublicationStatus ContractResourcePublicationStatusCodes `bson:"publicationStatus" json:"publicationStatus"`
-	Copyright         *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	ID                       *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     CodeableConcept                        `bson:"type" json:"type"`
+	SubType                  *CodeableConcept                       `bson:"subType,omitempty" json:"subType,omitempty"`
+	Publisher                *Reference                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublicationDate          *DateTime                              `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
+	PublicationDateElement   *Element                               `bson:"_publicationDate,omitempty" json:"_publicationDate,omitempty"`
+	PublicationStatus        ContractResourcePublicationStatusCodes `bson:"publicationStatus" json:"publicationStatus"`
+	PublicationStatusElement *Element                               `bson:"_publicationStatus,omitempty" json:"_publicationStatus,omitempty"`
+	Copyright                *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement         *Element                               `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	UnknownFields            map[string]json.RawMessage             `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ContractContentDefinition with its unknown fields.
+func (r ContractContentDefinition) MarshalJSON() ([]byte, error) {
+	type other ContractContentDefinition
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ContractTerm struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Issued            *DateTime                   `bson:"issued,omitempty" json:"issued,omitempty"`
-	Applies           *Period                     `bson:"applies,omitempty" json:"applies,omitempty"`
-	Type              *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
-	SubType           *CodeableConcept            `bson:"subType,omitempty" json:"subType,omitempty"`
-	Text              *string                     `bson:"text,omitempty" json:"text,omitempty"`
-	SecurityLabel     []ContractTermSecurityLabel `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Offer             ContractTermOffer           `bson:"offer" json:"offer"`
-	Asset             []ContractTermAsset         `bson:"asset,omitempty" json:"asset,omitempty"`
-	Action            []ContractTermAction        `bson:"action,omitempty" json:"action,omitempty"`
-	Group             []ContractTerm              `bson:"group,omitempty" json:"group,omitempty"`
+	ID                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Issued               *DateTime                   `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement        *Element                    `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Applies              *Period                     `bson:"applies,omitempty" json:"applies,omitempty"`
+	TopicCodeableConcept *CodeableConcept            `bson:"topicCodeableConcept,omitempty" json:"topicCodeableConcept,omitempty"`
+	TopicReference       *Reference                  `bson:"topicReference,omitempty" json:"topicReference,omitempty"`
+	Type                 *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
+	SubType              *CodeableConcept            `bson:"subType,omitempty" json:"subType,omitempty"`
+	Text                 *string                     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement          *Element                    `bson:"_text,omitempty" json:"_text,omitempty"`
+	SecurityLabel        []ContractTermSecurityLabel `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Offer                ContractTermOffer           `bson:"offer" json:"offer"`
+	Asset                []ContractTermAsset         `bson:"asset,omitempty" json:"asset,omitempty"`
+	Action               []ContractTermAction        `bson:"action,omitempty" json:"action,omitempty"`
+	Group                []ContractTerm              `bson:"group,omitempty" json:"group,omitempty"`
+	UnknownFields        map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type ContractTermSecurityLabel struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Number            []int       `bson:"number,omitempty" json:"number,omitempty"`
-	Classification    Coding      `bson:"classification" json:"classification"`
-	Category          []Coding    `bson:"category,omitempty" json:"category,omitempty"`
-	Control           []Coding    `bson:"control,omitempty" json:"control,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Number            []*int                     `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     []*Element                 `bson:"_number,omitempty" json:"_number,omitempty"`
+	Classification    Coding                     `bson:"classification" json:"classification"`
+	Category          []Coding                   `bson:"category,omitempty" json:"category,omitempty"`
+	Control           []Coding                   `bson:"control,omitempty" json:"control,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the ContractTermSecurityLabel and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ContractTermSecurityLabel) UnmarshalJSON(b []byte) error {
+	type other ContractTermSecurityLabel
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.securityLabel.number", len(r.Number), len(r.NumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractTermSecurityLabel with its unknown fields.
+func (r ContractTermSecurityLabel) MarshalJSON() ([]byte, error) {
+	type other ContractTermSecurityLabel
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ContractTermOffer struct {
-	ID                  *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Party               []ContractTermOfferParty  `bson:"party,omitempty" json:"party,omitempty"`
-	Topic               *Reference                `bson:"topic,omitempty" json:"topic,omitempty"`
-	Type                *CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
-	Decision            *CodeableConcept          `bson:"decision,omitempty" json:"decision,omitempty"`
-	DecisionMode        []CodeableConcept         `bson:"decisionMode,omitempty" json:"decisionMode,omitempty"`
-	Answer              []ContractTermOfferAnswer `bson:"answer,omitempty" json:"answer,omitempty"`
-	Text                *string                   `bson:"text,omitempty" json:"text,omitempty"`
-	LinkId              []string                  `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	SecurityLabelNumber []int                     `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                 []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Party                      []ContractTermOfferParty   `bson:"party,omitempty" json:"party,omitempty"`
+	Topic                      *Reference                 `bson:"topic,omitempty" json:"topic,omitempty"`
+	Type                       *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Decision                   *CodeableConcept           `bson:"decision,omitempty" json:"decision,omitempty"`
+	DecisionMode               []CodeableConcept          `bson:"decisionMode,omitempty" json:"decisionMode,omitempty"`
+	Answer                     []ContractTermOfferAnswer  `bson:"answer,omitempty" json:"answer,omitempty"`
+	Text                       *string                    `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement                *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	LinkId                     []*string                  `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                 `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	SecurityLabelNumber        []*int                     `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                 `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ContractTermOfferParty struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         []Reference     `bson:"reference" json:"reference"`
-	Role              CodeableConcept `bson:"role" json:"role"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference         []Reference                `bson:"reference" json:"reference"`
+	Role              CodeableConcept            `bson:"role" json:"role"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ContractTermOfferParty with its unknown fields.
+func (r ContractTermOfferParty) MarshalJSON() ([]byte, error) {
+	type other ContractTermOfferParty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ContractTermOfferAnswer struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDecimal         *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueInteger         *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate            *DateTime                  `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement     *Element                   `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime        *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueTime            *Time                      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element                   `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString          *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueUri             *string                    `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element                   `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCoding          *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueQuantity        *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueReference       *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns Contract.term.offer.answer.value[x] of the set type, such as *bool, or nil when it is not set.
+func (r *ContractTermOfferAnswer) Value() interface{} {
+	switch {
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueBoolean sets Contract.term.offer.answer.value[x] to the boolean and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueDecimal sets Contract.term.offer.answer.value[x] to the decimal and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueInteger sets Contract.term.offer.answer.value[x] to the integer and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueDate sets Contract.term.offer.answer.value[x] to the date and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets Contract.term.offer.answer.value[x] to the dateTime and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueTime sets Contract.term.offer.answer.value[x] to the time and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueString sets Contract.term.offer.answer.value[x] to the string and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueUri sets Contract.term.offer.answer.value[x] to the uri and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueAttachment sets Contract.term.offer.answer.value[x] to the Attachment and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCoding sets Contract.term.offer.answer.value[x] to the Coding and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueQuantity sets Contract.term.offer.answer.value[x] to the Quantity and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueReference sets Contract.term.offer.answer.value[x] to the Reference and clears the other types.
+func (r *ContractTermOfferAnswer) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *ContractTermOfferAnswer) clearValue() {
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueAttachment = nil
+	r.ValueCoding = nil
+	r.ValueQuantity = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the ContractTermOfferAnswer and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ContractTermOfferAnswer) UnmarshalJSON(b []byte) error {
+	type other ContractTermOfferAnswer
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.term.offer.answer.value[x]",
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueAttachment != nil,
+		r.ValueCoding != nil,
+		r.ValueQuantity != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractTermOfferAnswer with its unknown fields.
+func (r ContractTermOfferAnswer) MarshalJSON() ([]byte, error) {
+	type other ContractTermOfferAnswer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ContractTermOffer and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ContractTermOffer) UnmarshalJSON(b []byte) error {
+	type other ContractTermOffer
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.offer.linkId", len(r.LinkId), len(r.LinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.offer.securityLabelNumber", len(r.SecurityLabelNumber), len(r.SecurityLabelNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractTermOffer with its unknown fields.
+func (r ContractTermOffer) MarshalJSON() ([]byte, error) {
+	type other ContractTermOffer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ContractTermAsset struct {
-	ID                  *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Scope               *CodeableConcept              `bson:"scope,omitempty" json:"scope,omitempty"`
-	Type                []CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	TypeReference       []Reference                   `bson:"typeReference,omitempty" json:"typeReference,omitempty"`
-	Subtype             []CodeableConcept             `bson:"subtype,omitempty" json:"subtype,omitempty"`
-	Relationship        *Coding                       `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Context             []ContractTermAssetContext    `bson:"context,omitempty" json:"context,omitempty"`
-	Condition           *string                       `bson:"condition,omitempty" json:"condition,omitempty"`
-	PeriodType          []CodeableConcept             `bson:"periodType,omitempty" json:"periodType,omitempty"`
-	Period              []Period                      `bson:"period,omitempty" json:"period,omitempty"`
-	UsePeriod           []Period                      `bson:"usePeriod,omitempty" json:"usePeriod,omitempty"`
-	Text                *string                       `bson:"text,omitempty" json:"text,omitempty"`
-	LinkId              []string                      `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	Answer              []ContractTermOfferAnswer     `bson:"answer,omitempty" json:"answer,omitempty"`
-	SecurityLabelNumber []int                         `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
-	ValuedItem          []ContractTermAssetValuedItem `bson:"valuedItem,omitempty" json:"valuedItem,omitempty"`
+	ID                         *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Scope                      *CodeableConcept              `bson:"scope,omitempty" json:"scope,omitempty"`
+	Type                       []CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	TypeReference              []Reference                   `bson:"typeReference,omitempty" json:"typeReference,omitempty"`
+	Subtype                    []CodeableConcept             `bson:"subtype,omitempty" json:"subtype,omitempty"`
+	Relationship               *Coding                       `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Context                    []ContractTermAssetContext    `bson:"context,omitempty" json:"context,omitempty"`
+	Condition                  *string                       `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement           *Element                      `bson:"_condition,omitempty" json:"_condition,omitempty"`
+	PeriodType                 []CodeableConcept             `bson:"periodType,omitempty" json:"periodType,omitempty"`
+	Period                     []Period                      `bson:"period,omitempty" json:"period,omitempty"`
+	UsePeriod                  []Period                      `bson:"usePeriod,omitempty" json:"usePeriod,omitempty"`
+	Text                       *string                       `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement                *Element                      `bson:"_text,omitempty" json:"_text,omitempty"`
+	LinkId                     []*string                     `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                    `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	Answer                     []ContractTermOfferAnswer     `bson:"answer,omitempty" json:"answer,omitempty"`
+	SecurityLabelNumber        []*int                        `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                    `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
+	ValuedItem                 []ContractTermAssetValuedItem `bson:"valuedItem,omitempty" json:"valuedItem,omitempty"`
+	UnknownFields              map[string]json.RawMessage    `bson:"-" json:"-"`
 }
 type ContractTermAssetContext struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         *Reference        `bson:"reference,omitempty" json:"reference,omitempty"`
-	Code              []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Text              *string           `bson:"text,omitempty" json:"text,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference         *Reference                 `bson:"reference,omitempty" json:"reference,omitempty"`
+	Code              []CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	Text              *string                    `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ContractTermAssetContext with its unknown fields.
+func (r ContractTermAssetContext) MarshalJSON() ([]byte, error) {
+	type other ContractTermAssetContext
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ContractTermAssetValuedItem struct {
-	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	EffectiveTime       *DateTime   `bson:"effectiveTime,omitempty" json:"effectiveTime,omitempty"`
-	Quantity            *Quantity   `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice           *Money      `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor              *Decimal    `bson:"factor,omitempty" json:"factor,omitempty"`
-	Points              *Decimal    `bson:"points,omitempty" json:"points,omitempty"`
-	Net                 *Money      `bson:"net,omitempty" json:"net,omitempty"`
-	Payment             *string     `bson:"payment,omitempty" json:"payment,omitempty"`
-	PaymentDate         *DateTime   `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
-	Responsible         *Reference  `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Recipient           *Reference  `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	LinkId              []string    `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	SecurityLabelNumber []int       `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	EntityCodeableConcept      *CodeableConcept           `bson:"entityCodeableConcept,omitempty" json:"entityCodeableConcept,omitempty"`
+	EntityReference            *Reference                 `bson:"entityReference,omitempty" json:"entityReference,omitempty"`
+	Identifier                 *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	EffectiveTime              *DateTime                  `bson:"effectiveTime,omitempty" json:"effectiveTime,omitempty"`
+	EffectiveTimeElement       *Element                   `bson:"_effectiveTime,omitempty" json:"_effectiveTime,omitempty"`
+	Quantity                   *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                  *Money                     `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                     *Decimal                   `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement              *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Points                     *Decimal                   `bson:"points,omitempty" json:"points,omitempty"`
+	PointsElement              *Element                   `bson:"_points,omitempty" json:"_points,omitempty"`
+	Net                        *Money                     `bson:"net,omitempty" json:"net,omitempty"`
+	Payment                    *string                    `bson:"payment,omitempty" json:"payment,omitempty"`
+	PaymentElement             *Element                   `bson:"_payment,omitempty" json:"_payment,omitempty"`
+	PaymentDate                *DateTime                  `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
+	PaymentDateElement         *Element                   `bson:"_paymentDate,omitempty" json:"_paymentDate,omitempty"`
+	Responsible                *Reference                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Recipient                  *Reference                 `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	LinkId                     []*string                  `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                 `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	SecurityLabelNumber        []*int                     `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                 `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Entity returns Contract.term.asset.valuedItem.entity[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ContractTermAssetValuedItem) Entity() interface{} {
+	switch {
+	case r.EntityCodeableConcept != nil:
+		return r.EntityCodeableConcept
+	case r.EntityReference != nil:
+		return r.EntityReference
+	}
+	return nil
+}
+
+// SetEntityCodeableConcept sets Contract.term.asset.valuedItem.entity[x] to the CodeableConcept and clears the other types.
+func (r *ContractTermAssetValuedItem) SetEntityCodeableConcept(v CodeableConcept) {
+	r.clearEntity()
+	r.EntityCodeableConcept = &v
+}
+
+// SetEntityReference sets Contract.term.asset.valuedItem.entity[x] to the Reference and clears the other types.
+func (r *ContractTermAssetValuedItem) SetEntityReference(v Reference) {
+	r.clearEntity()
+	r.EntityReference = &v
+}
+func (r *ContractTermAssetValuedItem) clearEntity() {
+	r.EntityCodeableConcept = nil
+	r.EntityReference = nil
+}
+
+// UnmarshalJSON unmarshals the ContractTermAssetValuedItem and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ContractTermAssetValuedItem) UnmarshalJSON(b []byte) error {
+	type other ContractTermAssetValuedItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.term.asset.valuedItem.entity[x]",
+		r.EntityCodeableConcept != nil,
+		r.EntityReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.asset.valuedItem.linkId", len(r.LinkId), len(r.LinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.asset.valuedItem.securityLabelNumber", len(r.SecurityLabelNumber), len(r.SecurityLabelNumberElement)); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the ContractTermAssetValuedItem with its unknown fields.
+func (r ContractTermAssetValuedItem) MarshalJSON() ([]byte, error) {
+	type other ContractTermAssetValuedItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ContractTermAsset and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ContractTermAsset) UnmarshalJSON(b []byte) error {
+	type other ContractTermAsset
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.asset.linkId", len(r.LinkId), len(r.LinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.asset.securityLabelNumber", len(r.SecurityLabelNumber), len(r.SecurityLabelNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractTermAsset with its unknown fields.
+func (r ContractTermAsset) MarshalJSON() ([]byte, error) {
+	type other ContractTermAsset
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ContractTermAction struct {
-	ID                  *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DoNotPerform        *bool                       `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Type                CodeableConcept             `bson:"type" json:"type"`
-	Subject             []ContractTermActionSubject `bson:"subject,omitempty" json:"subject,omitempty"`
-	Intent              CodeableConcept             `bson:"intent" json:"intent"`
-	LinkId              []string                    `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	Status              CodeableConcept             `bson:"status" json:"status"`
-	Context             *Reference                  `bson:"context,omitempty" json:"context,omitempty"`
-	ContextLinkId       []string                    `bson:"contextLinkId,omitempty" json:"contextLinkId,omitempty"`
-	Requester           []Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
-	RequesterLinkId     []string                    `bson:"requesterLinkId,omitempty" json:"requesterLinkId,omitempty"`
-	PerformerType       []CodeableConcept           `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	PerformerRole       *CodeableConcept            `bson:"performerRole,omitempty" json:"performerRole,omitempty"`
-	Performer           *Reference                  `bson:"performer,omitempty" json:"performer,omitempty"`
-	PerformerLinkId     []string                    `bson:"performerLinkId,omitempty" json:"performerLinkId,omitempty"`
-	ReasonCode          []CodeableConcept           `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference     []Reference                 `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Reason              []string                    `bson:"reason,omitempty" json:"reason,omitempty"`
-	ReasonLinkId        []string                    `bson:"reasonLinkId,omitempty" json:"reasonLinkId,omitempty"`
-	Note                []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
-	SecurityLabelNumber []int                       `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	ID                         *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DoNotPerform               *bool                       `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement        *Element                    `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	Type                       CodeableConcept             `bson:"type" json:"type"`
+	Subject                    []ContractTermActionSubject `bson:"subject,omitempty" json:"subject,omitempty"`
+	Intent                     CodeableConcept             `bson:"intent" json:"intent"`
+	LinkId                     []*string                   `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                  `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	Status                     CodeableConcept             `bson:"status" json:"status"`
+	Context                    *Reference                  `bson:"context,omitempty" json:"context,omitempty"`
+	ContextLinkId              []*string                   `bson:"contextLinkId,omitempty" json:"contextLinkId,omitempty"`
+	ContextLinkIdElement       []*Element                  `bson:"_contextLinkId,omitempty" json:"_contextLinkId,omitempty"`
+	OccurrenceDateTime         *DateTime                   `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement  *Element                    `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod           *Period                     `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming           *Timing                     `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	Requester                  []Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
+	RequesterLinkId            []*string                   `bson:"requesterLinkId,omitempty" json:"requesterLinkId,omitempty"`
+	RequesterLinkIdElement     []*Element                  `bson:"_requesterLinkId,omitempty" json:"_requesterLinkId,omitempty"`
+	PerformerType              []CodeableConcept           `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	PerformerRole              *CodeableConcept            `bson:"performerRole,omitempty" json:"performerRole,omitempty"`
+	Performer                  *Reference                  `bson:"performer,omitempty" json:"performer,omitempty"`
+	PerformerLinkId            []*string                   `bson:"performerLinkId,omitempty" json:"performerLinkId,omitempty"`
+	PerformerLinkIdElement     []*Element                  `bson:"_performerLinkId,omitempty" json:"_performerLinkId,omitempty"`
+	ReasonCode                 []CodeableConcept           `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference            []Reference                 `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Reason                     []*string                   `bson:"reason,omitempty" json:"reason,omitempty"`
+	ReasonElement              []*Element                  `bson:"_reason,omitempty" json:"_reason,omitempty"`
+	ReasonLinkId               []*string                   `bson:"reasonLinkId,omitempty" json:"reasonLinkId,omitempty"`
+	ReasonLinkIdElement        []*Element                  `bson:"_reasonLinkId,omitempty" json:"_reasonLinkId,omitempty"`
+	Note                       []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+	SecurityLabelNumber        []*int                      `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                  `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
+	UnknownFields              map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type ContractTermActionSubject struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         []Reference      `bson:"reference" json:"reference"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference         []Reference                `bson:"reference" json:"reference"`
+	Role              *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ContractTermActionSubject with its unknown fields.
+func (r ContractTermActionSubject) MarshalJSON() ([]byte, error) {
+	type other ContractTermActionSubject
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurrence returns Contract.term.action.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ContractTermAction) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	case r.OccurrenceTiming != nil:
+		return r.OccurrenceTiming
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets Contract.term.action.occurrence[x] to the dateTime and clears the other types.
+func (r *ContractTermAction) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets Contract.term.action.occurrence[x] to the Period and clears the other types.
+func (r *ContractTermAction) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+
+// SetOccurrenceTiming sets Contract.term.action.occurrence[x] to the Timing and clears the other types.
+func (r *ContractTermAction) SetOccurrenceTiming(v Timing) {
+	r.clearOccurrence()
+	r.OccurrenceTiming = &v
+}
+func (r *ContractTermAction) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+}
+
+// UnmarshalJSON unmarshals the ContractTermAction and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ContractTermAction) UnmarshalJSON(b []byte) error {
+	type other ContractTermAction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.term.action.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+		r.OccurrenceTiming != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.linkId", len(r.LinkId), len(r.LinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.contextLinkId", len(r.ContextLinkId), len(r.ContextLinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.requesterLinkId", len(r.RequesterLinkId), len(r.RequesterLinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.performerLinkId", len(r.PerformerLinkId), len(r.PerformerLinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.reason", len(r.Reason), len(r.ReasonElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.reasonLinkId", len(r.ReasonLinkId), len(r.ReasonLinkIdElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.term.action.securityLabelNumber", len(r.SecurityLabelNumber), len(r.SecurityLabelNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractTermAction with its unknown fields.
+func (r ContractTermAction) MarshalJSON() ([]byte, error) {
+	type other ContractTermAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Topic returns Contract.term.topic[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ContractTerm) Topic() interface{} {
+	switch {
+	case r.TopicCodeableConcept != nil:
+		return r.TopicCodeableConcept
+	case r.TopicReference != nil:
+		return r.TopicReference
+	}
+	return nil
+}
+
+// SetTopicCodeableConcept sets Contract.term.topic[x] to the CodeableConcept and clears the other types.
+func (r *ContractTerm) SetTopicCodeableConcept(v CodeableConcept) {
+	r.clearTopic()
+	r.TopicCodeableConcept = &v
+}
+
+// SetTopicReference sets Contract.term.topic[x] to the Reference and clears the other types.
+func (r *ContractTerm) SetTopicReference(v Reference) {
+	r.clearTopic()
+	r.TopicReference = &v
+}
+func (r *ContractTerm) clearTopic() {
+	r.TopicCodeableConcept = nil
+	r.TopicReference = nil
+}
+
+// UnmarshalJSON unmarshals the ContractTerm and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ContractTerm) UnmarshalJSON(b []byte) error {
+	type other ContractTerm
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.term.topic[x]",
+		r.TopicCodeableConcept != nil,
+		r.TopicReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractTerm with its unknown fields.
+func (r ContractTerm) MarshalJSON() ([]byte, error) {
+	type other ContractTerm
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ContractSigner struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              Coding      `bson:"type" json:"type"`
-	Party             Reference   `bson:"party" json:"party"`
-	Signature         []Signature `bson:"signature" json:"signature"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              Coding                     `bson:"type" json:"type"`
+	Party             Reference                  `bson:"party" json:"party"`
+	Signature         []Signature                `bson:"signature" json:"signature"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ContractSigner with its unknown fields.
+func (r ContractSigner) MarshalJSON() ([]byte, error) {
+	type other ContractSigner
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ContractFriendly struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentAttachment *Attachment                `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference  *Reference                 `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Content returns Contract.friendly.content[x] of the set type, such as *Attachment, or nil when it is not set.
+func (r *ContractFriendly) Content() interface{} {
+	switch {
+	case r.ContentAttachment != nil:
+		return r.ContentAttachment
+	case r.ContentReference != nil:
+		return r.ContentReference
+	}
+	return nil
+}
+
+// SetContentAttachment sets Contract.friendly.content[x] to the Attachment and clears the other types.
+func (r *ContractFriendly) SetContentAttachment(v Attachment) {
+	r.clearContent()
+	r.ContentAttachment = &v
+}
+
+// SetContentReference sets Contract.friendly.content[x] to the Reference and clears the other types.
+func (r *ContractFriendly) SetContentReference(v Reference) {
+	r.clearContent()
+	r.ContentReference = &v
+}
+func (r *ContractFriendly) clearContent() {
+	r.ContentAttachment = nil
+	r.ContentReference = nil
 }
+
+// UnmarshalJSON unmarshals the ContractFriendly and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ContractFriendly) UnmarshalJSON(b []byte) error {
+	type other ContractFriendly
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.friendly.content[x]",
+		r.ContentAttachment != nil,
+		r.ContentReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractFriendly with its unknown fields.
+func (r ContractFriendly) MarshalJSON() ([]byte, error) {
+	type other ContractFriendly
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ContractLegal struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentAttachment *Attachment                `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference  *Reference                 `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Content returns Contract.legal.content[x] of the set type, such as *Attachment, or nil when it is not set.
+func (r *ContractLegal) Content() interface{} {
+	switch {
+	case r.ContentAttachment != nil:
+		return r.ContentAttachment
+	case r.ContentReference != nil:
+		return r.ContentReference
+	}
+	return nil
 }
+
+// SetContentAttachment sets Contract.legal.content[x] to the Attachment and clears the other types.
+func (r *ContractLegal) SetContentAttachment(v Attachment) {
+	r.clearContent()
+	r.ContentAttachment = &v
+}
+
+// SetContentReference sets Contract.legal.content[x] to the Reference and clears the other types.
+func (r *ContractLegal) SetContentReference(v Reference) {
+	r.clearContent()
+	r.ContentReference = &v
+}
+func (r *ContractLegal) clearContent() {
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+}
+
+// UnmarshalJSON unmarshals the ContractLegal and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ContractLegal) UnmarshalJSON(b []byte) error {
+	type other ContractLegal
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.legal.content[x]",
+		r.ContentAttachment != nil,
+		r.ContentReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractLegal with its unknown fields.
+func (r ContractLegal) MarshalJSON() ([]byte, error) {
+	type other ContractLegal
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ContractRule struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentAttachment *Attachment                `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference  *Reference                 `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Content returns Contract.rule.content[x] of the set type, such as *Attachment, or nil when it is not set.
+func (r *ContractRule) Content() interface{} {
+	switch {
+	case r.ContentAttachment != nil:
+		return r.ContentAttachment
+	case r.ContentReference != nil:
+		return r.ContentReference
+	}
+	return nil
+}
+
+// SetContentAttachment sets Contract.rule.content[x] to the Attachment and clears the other types.
+func (r *ContractRule) SetContentAttachment(v Attachment) {
+	r.clearContent()
+	r.ContentAttachment = &v
+}
+
+// SetContentReference sets Contract.rule.content[x] to the Reference and clears the other types.
+func (r *ContractRule) SetContentReference(v Reference) {
+	r.clearContent()
+	r.ContentReference = &v
+}
+func (r *ContractRule) clearContent() {
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+}
+
+// UnmarshalJSON unmarshals the ContractRule and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ContractRule) UnmarshalJSON(b []byte) error {
+	type other ContractRule
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.rule.content[x]",
+		r.ContentAttachment != nil,
+		r.ContentReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ContractRule with its unknown fields.
+func (r ContractRule) MarshalJSON() ([]byte, error) {
+	type other ContractRule
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Topic returns Contract.topic[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *Contract) Topic() interface{} {
+	switch {
+	case r.TopicCodeableConcept != nil:
+		return r.TopicCodeableConcept
+	case r.TopicReference != nil:
+		return r.TopicReference
+	}
+	return nil
+}
+
+// SetTopicCodeableConcept sets Contract.topic[x] to the CodeableConcept and clears the other types.
+func (r *Contract) SetTopicCodeableConcept(v CodeableConcept) {
+	r.clearTopic()
+	r.TopicCodeableConcept = &v
+}
+
+// SetTopicReference sets Contract.topic[x] to the Reference and clears the other types.
+func (r *Contract) SetTopicReference(v Reference) {
+	r.clearTopic()
+	r.TopicReference = &v
+}
+func (r *Contract) clearTopic() {
+	r.TopicCodeableConcept = nil
+	r.TopicReference = nil
+}
+
+// LegallyBinding returns Contract.legallyBinding[x] of the set type, such as *Attachment, or nil when it is not set.
+func (r *Contract) LegallyBinding() interface{} {
+	switch {
+	case r.LegallyBindingAttachment != nil:
+		return r.LegallyBindingAttachment
+	case r.LegallyBindingReference != nil:
+		return r.LegallyBindingReference
+	}
+	return nil
 }
+
+// SetLegallyBindingAttachment sets Contract.legallyBinding[x] to the Attachment and clears the other types.
+func (r *Contract) SetLegallyBindingAttachment(v Attachment) {
+	r.clearLegallyBinding()
+	r.LegallyBindingAttachment = &v
+}
+
+// SetLegallyBindingReference sets Contract.legallyBinding[x] to the Reference and clears the other types.
+func (r *Contract) SetLegallyBindingReference(v Reference) {
+	r.clearLegallyBinding()
+	r.LegallyBindingReference = &v
+}
+func (r *Contract) clearLegallyBinding() {
+	r.LegallyBindingAttachment = nil
+	r.LegallyBindingReference = nil
+}
+
+// UnmarshalJSON unmarshals the Contract and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Contract) UnmarshalJSON(b []byte) error {
+	type other Contract
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.topic[x]",
+		r.TopicCodeableConcept != nil,
+		r.TopicReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Contract.legallyBinding[x]",
+		r.LegallyBindingAttachment != nil,
+		r.LegallyBindingReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Contract.alias", len(r.Alias), len(r.AliasElement)); err != nil {
+		return err
+	}
+	return checkCodes("Contract", r)
+}
+
 type OtherContract Contract
 
 // MarshalJSON marshals the given Contract as JSON into a byte slice
 func (r Contract) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherContract
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherContract: OtherContract(r),
 		ResourceType:  "Contract",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalContract unmarshals a Contract.
@@ -14,115 +14,64 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.239719 +0000 UTC
+// 2026-10-19 02:55:18.760070477 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ContractResourcePublicationStatusCodes is documented here http://hl7.org/fhir/ValueSet/contract-publicationstatus
-type ContractResourcePublicationStatusCodes int
+// The empty ContractResourcePublicationStatusCodes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ContractResourcePublicationStatusCodes string
 
 const (
-	ContractResourcePublicationStatusCodesAmended ContractResourcePublicationStatusCodes = iota
-	ContractResourcePublicationStatusCodesAppended
-	ContractResourcePublicationStatusCodesCancelled
-	ContractResourcePublicationStatusCodesDisputed
-	ContractResourcePublicationStatusCodesEnteredInError
-	ContractResourcePublicationStatusCodesExecutable
-	ContractResourcePublicationStatusCodesExecuted
-	ContractResourcePublicationStatusCodesNegotiable
-	ContractResourcePublicationStatusCodesOffered
-	ContractResourcePublicationStatusCodesPolicy
-	ContractResourcePublicationStatusCodesRejected
-	ContractResourcePublicationStatusCodesRenewed
-	ContractResourcePublicationStatusCodesRevoked
-	ContractResourcePublicationStatusCodesResolved
-	ContractResourcePublicationStatusCodesTerminated
+	ContractResourcePublicationStatusCodesAmended        ContractResourcePublicationStatusCodes = "amended"
+	ContractResourcePublicationStatusCodesAppended       ContractResourcePublicationStatusCodes = "appended"
+	ContractResourcePublicationStatusCodesCancelled      ContractResourcePublicationStatusCodes = "cancelled"
+	ContractResourcePublicationStatusCodesDisputed       ContractResourcePublicationStatusCodes = "disputed"
+	ContractResourcePublicationStatusCodesEnteredInError ContractResourcePublicationStatusCodes = "entered-in-error"
+	ContractResourcePublicationStatusCodesExecutable     ContractResourcePublicationStatusCodes = "executable"
+	ContractResourcePublicationStatusCodesExecuted       ContractResourcePublicationStatusCodes = "executed"
+	ContractResourcePublicationStatusCodesNegotiable     ContractResourcePublicationStatusCodes = "negotiable"
+	ContractResourcePublicationStatusCodesOffered        ContractResourcePublicationStatusCodes = "offered"
+	ContractResourcePublicationStatusCodesPolicy         ContractResourcePublicationStatusCodes = "policy"
+	ContractResourcePublicationStatusCodesRejected       ContractResourcePublicationStatusCodes = "rejected"
+	ContractResourcePublicationStatusCodesRenewed        ContractResourcePublicationStatusCodes = "renewed"
+	ContractResourcePublicationStatusCodesRevoked        ContractResourcePublicationStatusCodes = "revoked"
+	ContractResourcePublicationStatusCodesResolved       ContractResourcePublicationStatusCodes = "resolved"
+	ContractResourcePublicationStatusCodesTerminated     ContractResourcePublicationStatusCodes = "terminated"
 )
 
 func (code ContractResourcePublicationStatusCodes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ContractResourcePublicationStatusCodes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ContractResourcePublicationStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "amended":
-		*code = ContractResourcePublicationStatusCodesAmended
-	case "appended":
-		*code = ContractResourcePublicationStatusCodesAppended
-	case "cancelled":
-		*code = ContractResourcePublicationStatusCodesCancelled
-	case "disputed":
-		*code = ContractResourcePublicationStatusCodesDisputed
-	case "entered-in-error":
-		*code = ContractResourcePublicationStatusCodesEnteredInError
-	case "executable":
-		*code = ContractResourcePublicationStatusCodesExecutable
-	case "executed":
-		*code = ContractResourcePublicationStatusCodesExecuted
-	case "negotiable":
-		*code = ContractResourcePublicationStatusCodesNegotiable
-	case "offered":
-		*code = ContractResourcePublicationStatusCodesOffered
-	case "policy":
-		*code = ContractResourcePublicationStatusCodesPolicy
-	case "rejected":
-		*code = ContractResourcePublicationStatusCodesRejected
-	case "renewed":
-		*code = ContractResourcePublicationStatusCodesRenewed
-	case "revoked":
-		*code = ContractResourcePublicationStatusCodesRevoked
-	case "resolved":
-		*code = ContractResourcePublicationStatusCodesResolved
-	case "terminated":
-		*code = ContractResourcePublicationStatusCodesTerminated
-	default:
-		return fmt.Errorf("unknown ContractResourcePublicationStatusCodes code `%s`", s)
+func (code *ContractResourcePublicationStatusCodes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ContractResourcePublicationStatusCodes(s)
 	return nil
 }
 func (code ContractResourcePublicationStatusCodes) String() string {
 	return code.Code()
 }
 func (code ContractResourcePublicationStatusCodes) Code() string {
+	return string(code)
+}
+func (code ContractResourcePublicationStatusCodes) Known() bool {
 	switch code {
-	case ContractResourcePublicationStatusCodesAmended:
-		return "amended"
-	case ContractResourcePublicationStatusCodesAppended:
-		return "appended"
-	case ContractResourcePublicationStatusCodesCancelled:
-		return "cancelled"
-	case ContractResourcePublicationStatusCodesDisputed:
-		return "disputed"
-	case ContractResourcePublicationStatusCodesEnteredInError:
-		return "entered-in-error"
-	case ContractResourcePublicationStatusCodesExecutable:
-		return "executable"
-	case ContractResourcePublicationStatusCodesExecuted:
-		return "executed"
-	case ContractResourcePublicationStatusCodesNegotiable:
-		return "negotiable"
-	case ContractResourcePublicationStatusCodesOffered:
-		return "offered"
-	case ContractResourcePublicationStatusCodesPolicy:
-		return "policy"
-	case ContractResourcePublicationStatusCodesRejected:
-		return "rejected"
-	case ContractResourcePublicationStatusCodesRenewed:
-		return "renewed"
-	case ContractResourcePublicationStatusCodesRevoked:
-		return "revoked"
-	case ContractResourcePublicationStatusCodesResolved:
-		return "resolved"
-	case ContractResourcePublicationStatusCodesTerminated:
-		return "terminated"
+	case ContractResourcePublicationStatusCodesAmended, ContractResourcePublicationStatusCodesAppended, ContractResourcePublicationStatusCodesCancelled, ContractResourcePublicationStatusCodesDisputed, ContractResourcePublicationStatusCodesEnteredInError, ContractResourcePublicationStatusCodesExecutable, ContractResourcePublicationStatusCodesExecuted, ContractResourcePublicationStatusCodesNegotiable, ContractResourcePublicationStatusCodesOffered, ContractResourcePublicationStatusCodesPolicy, ContractResourcePublicationStatusCodesRejected, ContractResourcePublicationStatusCodesRenewed, ContractResourcePublicationStatusCodesRevoked, ContractResourcePublicationStatusCodesResolved, ContractResourcePublicationStatusCodesTerminated:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ContractResourcePublicationStatusCodes) Display() string {
 	switch code {
@@ -14,115 +14,64 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.07819 +0000 UTC
+// 2026-10-19 02:55:18.762296492 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ContractResourceStatusCodes is documented here http://hl7.org/fhir/ValueSet/contract-status
-type ContractResourceStatusCodes int
+// The empty ContractResourceStatusCodes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ContractResourceStatusCodes string
 
 const (
-	ContractResourceStatusCodesAmended ContractResourceStatusCodes = iota
-	ContractResourceStatusCodesAppended
-	ContractResourceStatusCodesCancelled
-	ContractResourceStatusCodesDisputed
-	ContractResourceStatusCodesEnteredInError
-	ContractResourceStatusCodesExecutable
-	ContractResourceStatusCodesExecuted
-	ContractResourceStatusCodesNegotiable
-	ContractResourceStatusCodesOffered
-	ContractResourceStatusCodesPolicy
-	ContractResourceStatusCodesRejected
-	ContractResourceStatusCodesRenewed
-	ContractResourceStatusCodesRevoked
-	ContractResourceStatusCodesResolved
-	ContractResourceStatusCodesTerminated
+	ContractResourceStatusCodesAmended        ContractResourceStatusCodes = "amended"
+	ContractResourceStatusCodesAppended       ContractResourceStatusCodes = "appended"
+	ContractResourceStatusCodesCancelled      ContractResourceStatusCodes = "cancelled"
+	ContractResourceStatusCodesDisputed       ContractResourceStatusCodes = "disputed"
+	ContractResourceStatusCodesEnteredInError ContractResourceStatusCodes = "entered-in-error"
+	ContractResourceStatusCodesExecutable     ContractResourceStatusCodes = "executable"
+	ContractResourceStatusCodesExecuted       ContractResourceStatusCodes = "executed"
+	ContractResourceStatusCodesNegotiable     ContractResourceStatusCodes = "negotiable"
+	ContractResourceStatusCodesOffered        ContractResourceStatusCodes = "offered"
+	ContractResourceStatusCodesPolicy         ContractResourceStatusCodes = "policy"
+	ContractResourceStatusCodesRejected       ContractResourceStatusCodes = "rejected"
+	ContractResourceStatusCodesRenewed        ContractResourceStatusCodes = "renewed"
+	ContractResourceStatusCodesRevoked        ContractResourceStatusCodes = "revoked"
+	ContractResourceStatusCodesResolved       ContractResourceStatusCodes = "resolved"
+	ContractResourceStatusCodesTerminated     ContractResourceStatusCodes = "terminated"
 )
 
 func (code ContractResourceStatusCodes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ContractResourceStatusCodes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ContractResourceStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "amended":
-		*code = ContractResourceStatusCodesAmended
-	case "appended":
-		*code = ContractResourceStatusCodesAppended
-	case "cancelled":
-		*code = ContractResourceStatusCodesCancelled
-	case "disputed":
-		*code = ContractResourceStatusCodesDisputed
-	case "entered-in-error":
-		*code = ContractResourceStatusCodesEnteredInError
-	case "executable":
-		*code = ContractResourceStatusCodesExecutable
-	case "executed":
-		*code = ContractResourceStatusCodesExecuted
-	case "negotiable":
-		*code = ContractResourceStatusCodesNegotiable
-	case "offered":
-		*code = ContractResourceStatusCodesOffered
-	case "policy":
-		*code = ContractResourceStatusCodesPolicy
-	case "rejected":
-		*code = ContractResourceStatusCodesRejected
-	case "renewed":
-		*code = ContractResourceStatusCodesRenewed
-	case "revoked":
-		*code = ContractResourceStatusCodesRevoked
-	case "resolved":
-		*code = ContractResourceStatusCodesResolved
-	case "terminated":
-		*code = ContractResourceStatusCodesTerminated
-	default:
-		return fmt.Errorf("unknown ContractResourceStatusCodes code `%s`", s)
+func (code *ContractResourceStatusCodes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ContractResourceStatusCodes(s)
 	return nil
 }
 func (code ContractResourceStatusCodes) String() string {
 	return code.Code()
 }
 func (code ContractResourceStatusCodes) Code() string {
+	return string(code)
+}
+func (code ContractResourceStatusCodes) Known() bool {
 	switch code {
-	case ContractResourceStatusCodesAmended:
-		return "amended"
-	case ContractResourceStatusCodesAppended:
-		return "appended"
-	case ContractResourceStatusCodesCancelled:
-		return "cancelled"
-	case ContractResourceStatusCodesDisputed:
-		return "disputed"
-	case ContractResourceStatusCodesEnteredInError:
-		return "entered-in-error"
-	case ContractResourceStatusCodesExecutable:
-		return "executable"
-	case ContractResourceStatusCodesExecuted:
-		return "executed"
-	case ContractResourceStatusCodesNegotiable:
-		return "negotiable"
-	case ContractResourceStatusCodesOffered:
-		return "offered"
-	case ContractResourceStatusCodesPolicy:
-		return "policy"
-	case ContractResourceStatusCodesRejected:
-		return "rejected"
-	case ContractResourceStatusCodesRenewed:
-		return "renewed"
-	case ContractResourceStatusCodesRevoked:
-		return "revoked"
-	case ContractResourceStatusCodesResolved:
-		return "resolved"
-	case ContractResourceStatusCodesTerminated:
-		return "terminated"
+	case ContractResourceStatusCodesAmended, ContractResourceStatusCodesAppended, ContractResourceStatusCodesCancelled, ContractResourceStatusCodesDisputed, ContractResourceStatusCodesEnteredInError, ContractResourceStatusCodesExecutable, ContractResourceStatusCodesExecuted, ContractResourceStatusCodesNegotiable, ContractResourceStatusCodesOffered, ContractResourceStatusCodesPolicy, ContractResourceStatusCodesRejected, ContractResourceStatusCodesRenewed, ContractResourceStatusCodesRevoked, ContractResourceStatusCodesResolved, ContractResourceStatusCodesTerminated:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ContractResourceStatusCodes) Display() string {
 	switch code {
@@ -22,64 +22,169 @@ import "encoding/json"
 
 // Coverage is documented here http://hl7.org/fhir/StructureDefinition/Coverage
 type Coverage struct {
-	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes `bson:"status" json:"status"`
-	Type              *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	PolicyHolder      *Reference                   `bson:"policyHolder,omitempty" json:"policyHolder,omitempty"`
-	Subscriber        *Reference                   `bson:"subscriber,omitempty" json:"subscriber,omitempty"`
-	SubscriberId      *string                      `bson:"subscriberId,omitempty" json:"subscriberId,omitempty"`
-	Beneficiary       Reference                    `bson:"beneficiary" json:"beneficiary"`
-	Dependent         *string                      `bson:"dependent,omitempty" json:"dependent,omitempty"`
-	Relationship      *CodeableConcept             `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Period            *Period                      `bson:"period,omitempty" json:"period,omitempty"`
-	Payor             []Reference                  `bson:"payor" json:"payor"`
-	Class             []CoverageClass              `bson:"class,omitempty" json:"class,omitempty"`
-	Order             *int                         `bson:"order,omitempty" json:"order,omitempty"`
-	Network           *string                      `bson:"network,omitempty" json:"network,omitempty"`
-	CostToBeneficiary []CoverageCostToBeneficiary  `bson:"costToBeneficiary,omitempty" json:"costToBeneficiary,omitempty"`
-	Subrogation       *bool                        `bson:"subrogation,omitempty" json:"subrogation,omitempty"`
-	Contract          []Reference                  `bson:"contract,omitempty" json:"contract,omitempty"`
+	ID                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	PolicyHolder         *Reference                   `bson:"policyHolder,omitempty" json:"policyHolder,omitempty"`
+	Subscriber           *Reference                   `bson:"subscriber,omitempty" json:"subscriber,omitempty"`
+	SubscriberId         *string                      `bson:"subscriberId,omitempty" json:"subscriberId,omitempty"`
+	SubscriberIdElement  *Element                     `bson:"_subscriberId,omitempty" json:"_subscriberId,omitempty"`
+	Beneficiary          Reference                    `bson:"beneficiary" json:"beneficiary"`
+	Dependent            *string                      `bson:"dependent,omitempty" json:"dependent,omitempty"`
+	DependentElement     *Element                     `bson:"_dependent,omitempty" json:"_dependent,omitempty"`
+	Relationship         *CodeableConcept             `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Period               *Period                      `bson:"period,omitempty" json:"period,omitempty"`
+	Payor                []Reference                  `bson:"payor" json:"payor"`
+	Class                []CoverageClass              `bson:"class,omitempty" json:"class,omitempty"`
+	Order                *int                         `bson:"order,omitempty" json:"order,omitempty"`
+	OrderElement         *Element                     `bson:"_order,omitempty" json:"_order,omitempty"`
+	Network              *string                      `bson:"network,omitempty" json:"network,omitempty"`
+	NetworkElement       *Element                     `bson:"_network,omitempty" json:"_network,omitempty"`
+	CostToBeneficiary    []CoverageCostToBeneficiary  `bson:"costToBeneficiary,omitempty" json:"costToBeneficiary,omitempty"`
+	Subrogation          *bool                        `bson:"subrogation,omitempty" json:"subrogation,omitempty"`
+	SubrogationElement   *Element                     `bson:"_subrogation,omitempty" json:"_subrogation,omitempty"`
+	Contract             []Reference                  `bson:"contract,omitempty" json:"contract,omitempty"`
+	UnknownFields        map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type CoverageClass struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Value             string          `bson:"value" json:"value"`
-	Name              *string         `bson:"name,omitempty" json:"name,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CoverageClass with its unknown fields.
+func (r CoverageClass) MarshalJSON() ([]byte, error) {
+	type other CoverageClass
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CoverageCostToBeneficiary struct {
 	ID                *string                              `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *CodeableConcept                     `bson:"type,omitempty" json:"type,omitempty"`
+	ValueQuantity     *Quantity                            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueMoney        *Money                               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
 	Exception         []CoverageCostToBeneficiaryException `bson:"exception,omitempty" json:"exception,omitempty"`
+	UnknownFields     map[string]json.RawMessage           `bson:"-" json:"-"`
 }
 type CoverageCostToBeneficiaryException struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Period            *Period         `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CoverageCostToBeneficiaryException with its unknown fields.
+func (r CoverageCostToBeneficiaryException) MarshalJSON() ([]byte, error) {
+	type other CoverageCostToBeneficiaryException
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Value returns Coverage.costToBeneficiary.value[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *CoverageCostToBeneficiary) Value() interface{} {
+	switch {
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueMoney != nil:
+		return r.ValueMoney
+	}
+	return nil
+}
+
+// SetValueQuantity sets Coverage.costToBeneficiary.value[x] to the Quantity and clears the other types.
+func (r *CoverageCostToBeneficiary) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueMoney sets Coverage.costToBeneficiary.value[x] to the Money and clears the other types.
+func (r *CoverageCostToBeneficiary) SetValueMoney(v Money) {
+	r.clearValue()
+	r.ValueMoney = &v
 }
+func (r *CoverageCostToBeneficiary) clearValue() {
+	r.ValueQuantity = nil
+	r.ValueMoney = nil
+}
+
+// UnmarshalJSON unmarshals the CoverageCostToBeneficiary and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CoverageCostToBeneficiary) UnmarshalJSON(b []byte) error {
+	type other CoverageCostToBeneficiary
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Coverage.costToBeneficiary.value[x]",
+		r.ValueQuantity != nil,
+		r.ValueMoney != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CoverageCostToBeneficiary with its unknown fields.
+func (r CoverageCostToBeneficiary) MarshalJSON() ([]byte, error) {
+	type other CoverageCostToBeneficiary
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Coverage and fails with CodeError when a coded element has an unknown code.
+func (r *Coverage) UnmarshalJSON(b []byte) error {
+	type other Coverage
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Coverage", r)
+}
+
 type OtherCoverage Coverage
 
 // MarshalJSON marshals the given Coverage as JSON into a byte slice
 func (r Coverage) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCoverage
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCoverage: OtherCoverage(r),
 		ResourceType:  "Coverage",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCoverage unmarshals a Coverage.
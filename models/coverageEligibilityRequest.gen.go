@@ -22,74 +22,244 @@ import "encoding/json"
 
 // CoverageEligibilityRequest is documented here http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest
 type CoverageEligibilityRequest struct {
-	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes               `bson:"status" json:"status"`
-	Priority          *CodeableConcept                           `bson:"priority,omitempty" json:"priority,omitempty"`
-	Purpose           []EligibilityRequestPurpose                `bson:"purpose" json:"purpose"`
-	Patient           Reference                                  `bson:"patient" json:"patient"`
-	Created           DateTime                                   `bson:"created" json:"created"`
-	Enterer           *Reference                                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	Provider          *Reference                                 `bson:"provider,omitempty" json:"provider,omitempty"`
-	Insurer           Reference                                  `bson:"insurer" json:"insurer"`
-	Facility          *Reference                                 `bson:"facility,omitempty" json:"facility,omitempty"`
-	SupportingInfo    []CoverageEligibilityRequestSupportingInfo `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Insurance         []CoverageEligibilityRequestInsurance      `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	Item              []CoverageEligibilityRequestItem           `bson:"item,omitempty" json:"item,omitempty"`
+	ID                   *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes               `bson:"status" json:"status"`
+	StatusElement        *Element                                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Priority             *CodeableConcept                           `bson:"priority,omitempty" json:"priority,omitempty"`
+	Purpose              []*EligibilityRequestPurpose               `bson:"purpose" json:"purpose"`
+	PurposeElement       []*Element                                 `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Patient              Reference                                  `bson:"patient" json:"patient"`
+	ServicedDate         *DateTime                                  `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement  *Element                                   `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod       *Period                                    `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	Created              DateTime                                   `bson:"created" json:"created"`
+	CreatedElement       *Element                                   `bson:"_created,omitempty" json:"_created,omitempty"`
+	Enterer              *Reference                                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	Provider             *Reference                                 `bson:"provider,omitempty" json:"provider,omitempty"`
+	Insurer              Reference                                  `bson:"insurer" json:"insurer"`
+	Facility             *Reference                                 `bson:"facility,omitempty" json:"facility,omitempty"`
+	SupportingInfo       []CoverageEligibilityRequestSupportingInfo `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Insurance            []CoverageEligibilityRequestInsurance      `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	Item                 []CoverageEligibilityRequestItem           `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields        map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type CoverageEligibilityRequestSupportingInfo struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int         `bson:"sequence" json:"sequence"`
-	Information       Reference   `bson:"information" json:"information"`
-	AppliesToAll      *bool       `bson:"appliesToAll,omitempty" json:"appliesToAll,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence            int                        `bson:"sequence" json:"sequence"`
+	SequenceElement     *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Information         Reference                  `bson:"information" json:"information"`
+	AppliesToAll        *bool                      `bson:"appliesToAll,omitempty" json:"appliesToAll,omitempty"`
+	AppliesToAllElement *Element                   `bson:"_appliesToAll,omitempty" json:"_appliesToAll,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the CoverageEligibilityRequestSupportingInfo with its unknown fields.
+func (r CoverageEligibilityRequestSupportingInfo) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityRequestSupportingInfo
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CoverageEligibilityRequestInsurance struct {
-	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Focal               *bool       `bson:"focal,omitempty" json:"focal,omitempty"`
-	Coverage            Reference   `bson:"coverage" json:"coverage"`
-	BusinessArrangement *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Focal                      *bool                      `bson:"focal,omitempty" json:"focal,omitempty"`
+	FocalElement               *Element                   `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Coverage                   Reference                  `bson:"coverage" json:"coverage"`
+	BusinessArrangement        *string                    `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *Element                   `bson:"_businessArrangement,omitempty" json:"_businessArrangement,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CoverageEligibilityRequestInsurance with its unknown fields.
+func (r CoverageEligibilityRequestInsurance) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityRequestInsurance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type CoverageEligibilityRequestItem struct {
-	ID                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SupportingInfoSequence []int                                     `bson:"supportingInfoSequence,omitempty" json:"supportingInfoSequence,omitempty"`
-	Category               *CodeableConcept                          `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService       *CodeableConcept                          `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
-	Modifier               []CodeableConcept                         `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Provider               *Reference                                `bson:"provider,omitempty" json:"provider,omitempty"`
-	Quantity               *Quantity                                 `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice              *Money                                    `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Facility               *Reference                                `bson:"facility,omitempty" json:"facility,omitempty"`
-	Diagnosis              []CoverageEligibilityRequestItemDiagnosis `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
-	Detail                 []Reference                               `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                            *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SupportingInfoSequence        []*int                                    `bson:"supportingInfoSequence,omitempty" json:"supportingInfoSequence,omitempty"`
+	SupportingInfoSequenceElement []*Element                                `bson:"_supportingInfoSequence,omitempty" json:"_supportingInfoSequence,omitempty"`
+	Category                      *CodeableConcept                          `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService              *CodeableConcept                          `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
+	Modifier                      []CodeableConcept                         `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	Provider                      *Reference                                `bson:"provider,omitempty" json:"provider,omitempty"`
+	Quantity                      *Quantity                                 `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                     *Money                                    `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Facility                      *Reference                                `bson:"facility,omitempty" json:"facility,omitempty"`
+	Diagnosis                     []CoverageEligibilityRequestItemDiagnosis `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
+	Detail                        []Reference                               `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields                 map[string]json.RawMessage                `bson:"-" json:"-"`
 }
 type CoverageEligibilityRequestItemDiagnosis struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DiagnosisCodeableConcept *CodeableConcept           `bson:"diagnosisCodeableConcept,omitempty" json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *Reference                 `bson:"diagnosisReference,omitempty" json:"diagnosisReference,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Diagnosis returns CoverageEligibilityRequest.item.diagnosis.diagnosis[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *CoverageEligibilityRequestItemDiagnosis) Diagnosis() interface{} {
+	switch {
+	case r.DiagnosisCodeableConcept != nil:
+		return r.DiagnosisCodeableConcept
+	case r.DiagnosisReference != nil:
+		return r.DiagnosisReference
+	}
+	return nil
+}
+
+// SetDiagnosisCodeableConcept sets CoverageEligibilityRequest.item.diagnosis.diagnosis[x] to the CodeableConcept and clears the other types.
+func (r *CoverageEligibilityRequestItemDiagnosis) SetDiagnosisCodeableConcept(v CodeableConcept) {
+	r.clearDiagnosis()
+	r.DiagnosisCodeableConcept = &v
+}
+
+// SetDiagnosisReference sets CoverageEligibilityRequest.item.diagnosis.diagnosis[x] to the Reference and clears the other types.
+func (r *CoverageEligibilityRequestItemDiagnosis) SetDiagnosisReference(v Reference) {
+	r.clearDiagnosis()
+	r.DiagnosisReference = &v
+}
+func (r *CoverageEligibilityRequestItemDiagnosis) clearDiagnosis() {
+	r.DiagnosisCodeableConcept = nil
+	r.DiagnosisReference = nil
+}
+
+// UnmarshalJSON unmarshals the CoverageEligibilityRequestItemDiagnosis and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalJSON(b []byte) error {
+	type other CoverageEligibilityRequestItemDiagnosis
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CoverageEligibilityRequest.item.diagnosis.diagnosis[x]",
+		r.DiagnosisCodeableConcept != nil,
+		r.DiagnosisReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CoverageEligibilityRequestItemDiagnosis with its unknown fields.
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityRequestItemDiagnosis
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the CoverageEligibilityRequestItem and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *CoverageEligibilityRequestItem) UnmarshalJSON(b []byte) error {
+	type other CoverageEligibilityRequestItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("CoverageEligibilityRequest.item.supportingInfoSequence", len(r.SupportingInfoSequence), len(r.SupportingInfoSequenceElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CoverageEligibilityRequestItem with its unknown fields.
+func (r CoverageEligibilityRequestItem) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityRequestItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Serviced returns CoverageEligibilityRequest.serviced[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *CoverageEligibilityRequest) Serviced() interface{} {
+	switch {
+	case r.ServicedDate != nil:
+		return r.ServicedDate
+	case r.ServicedPeriod != nil:
+		return r.ServicedPeriod
+	}
+	return nil
+}
+
+// SetServicedDate sets CoverageEligibilityRequest.serviced[x] to the date and clears the other types.
+func (r *CoverageEligibilityRequest) SetServicedDate(v DateTime) {
+	r.clearServiced()
+	r.ServicedDate = &v
+}
+
+// SetServicedPeriod sets CoverageEligibilityRequest.serviced[x] to the Period and clears the other types.
+func (r *CoverageEligibilityRequest) SetServicedPeriod(v Period) {
+	r.clearServiced()
+	r.ServicedPeriod = &v
+}
+func (r *CoverageEligibilityRequest) clearServiced() {
+	r.ServicedDate = nil
+	r.ServicedDateElement = nil
+	r.ServicedPeriod = nil
+}
+
+// UnmarshalJSON unmarshals the CoverageEligibilityRequest and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *CoverageEligibilityRequest) UnmarshalJSON(b []byte) error {
+	type other CoverageEligibilityRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CoverageEligibilityRequest.serviced[x]",
+		r.ServicedDate != nil || r.ServicedDateElement != nil,
+		r.ServicedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("CoverageEligibilityRequest.purpose", len(r.Purpose), len(r.PurposeElement)); err != nil {
+		return err
+	}
+	return checkCodes("CoverageEligibilityRequest", r)
+}
+
 type OtherCoverageEligibilityRequest CoverageEligibilityRequest
 
 // MarshalJSON marshals the given CoverageEligibilityRequest as JSON into a byte slice
 func (r CoverageEligibilityRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCoverageEligibilityRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCoverageEligibilityRequest: OtherCoverageEligibilityRequest(r),
 		ResourceType:                    "CoverageEligibilityRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCoverageEligibilityRequest unmarshals a CoverageEligibilityRequest.
@@ -22,27 +22,40 @@ import "encoding/json"
 
 // CoverageEligibilityResponse is documented here http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse
 type CoverageEligibilityResponse struct {
-	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes           `bson:"status" json:"status"`
-	Purpose           []EligibilityResponsePurpose           `bson:"purpose" json:"purpose"`
-	Patient           Reference                              `bson:"patient" json:"patient"`
-	Created           DateTime                               `bson:"created" json:"created"`
-	Requestor         *Reference                             `bson:"requestor,omitempty" json:"requestor,omitempty"`
-	Request           Reference                              `bson:"request" json:"request"`
-	Outcome           ClaimProcessingCodes                   `bson:"outcome" json:"outcome"`
-	Disposition       *string                                `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	Insurer           Reference                              `bson:"insurer" json:"insurer"`
-	Insurance         []CoverageEligibilityResponseInsurance `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	PreAuthRef        *string                                `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
-	Form              *CodeableConcept                       `bson:"form,omitempty" json:"form,omitempty"`
-	Error             []CoverageEligibilityResponseError     `bson:"error,omitempty" json:"error,omitempty"`
+	ID                   *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes           `bson:"status" json:"status"`
+	StatusElement        *Element                               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Purpose              []*EligibilityResponsePurpose          `bson:"purpose" json:"purpose"`
+	PurposeElement       []*Element                             `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Patient              Reference                              `bson:"patient" json:"patient"`
+	ServicedDate         *DateTime                              `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement  *Element                               `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod       *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	Created              DateTime                               `bson:"created" json:"created"`
+	CreatedElement       *Element                               `bson:"_created,omitempty" json:"_created,omitempty"`
+	Requestor            *Reference                             `bson:"requestor,omitempty" json:"requestor,omitempty"`
+	Request              Reference                              `bson:"request" json:"request"`
+	Outcome              ClaimProcessingCodes                   `bson:"outcome" json:"outcome"`
+	OutcomeElement       *Element                               `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	Disposition          *string                                `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                               `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	Insurer              Reference                              `bson:"insurer" json:"insurer"`
+	Insurance            []CoverageEligibilityResponseInsurance `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	PreAuthRef           *string                                `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement    *Element                               `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
+	Form                 *CodeableConcept                       `bson:"form,omitempty" json:"form,omitempty"`
+	Error                []CoverageEligibilityResponseError     `bson:"error,omitempty" json:"error,omitempty"`
+	UnknownFields        map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type CoverageEligibilityResponseInsurance struct {
 	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -50,51 +63,265 @@ type CoverageEligibilityResponseInsurance struct {
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Coverage          Reference                                  `bson:"coverage" json:"coverage"`
 	Inforce           *bool                                      `bson:"inforce,omitempty" json:"inforce,omitempty"`
+	InforceElement    *Element                                   `bson:"_inforce,omitempty" json:"_inforce,omitempty"`
 	BenefitPeriod     *Period                                    `bson:"benefitPeriod,omitempty" json:"benefitPeriod,omitempty"`
 	Item              []CoverageEligibilityResponseInsuranceItem `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields     map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type CoverageEligibilityResponseInsuranceItem struct {
-	ID                      *string                                           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category                *CodeableConcept                                  `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService        *CodeableConcept                                  `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
-	Modifier                []CodeableConcept                                 `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Provider                *Reference                                        `bson:"provider,omitempty" json:"provider,omitempty"`
-	Excluded                *bool                                             `bson:"excluded,omitempty" json:"excluded,omitempty"`
-	Name                    *string                                           `bson:"name,omitempty" json:"name,omitempty"`
-	Description             *string                                           `bson:"description,omitempty" json:"description,omitempty"`
-	Network                 *CodeableConcept                                  `bson:"network,omitempty" json:"network,omitempty"`
-	Unit                    *CodeableConcept                                  `bson:"unit,omitempty" json:"unit,omitempty"`
-	Term                    *CodeableConcept                                  `bson:"term,omitempty" json:"term,omitempty"`
-	Benefit                 []CoverageEligibilityResponseInsuranceItemBenefit `bson:"benefit,omitempty" json:"benefit,omitempty"`
-	AuthorizationRequired   *bool                                             `bson:"authorizationRequired,omitempty" json:"authorizationRequired,omitempty"`
-	AuthorizationSupporting []CodeableConcept                                 `bson:"authorizationSupporting,omitempty" json:"authorizationSupporting,omitempty"`
-	AuthorizationUrl        *string                                           `bson:"authorizationUrl,omitempty" json:"authorizationUrl,omitempty"`
+	ID                           *string                                           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category                     *CodeableConcept                                  `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService             *CodeableConcept                                  `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
+	Modifier                     []CodeableConcept                                 `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	Provider                     *Reference                                        `bson:"provider,omitempty" json:"provider,omitempty"`
+	Excluded                     *bool                                             `bson:"excluded,omitempty" json:"excluded,omitempty"`
+	ExcludedElement              *Element                                          `bson:"_excluded,omitempty" json:"_excluded,omitempty"`
+	Name                         *string                                           `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                  *Element                                          `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description                  *string                                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Network                      *CodeableConcept                                  `bson:"network,omitempty" json:"network,omitempty"`
+	Unit                         *CodeableConcept                                  `bson:"unit,omitempty" json:"unit,omitempty"`
+	Term                         *CodeableConcept                                  `bson:"term,omitempty" json:"term,omitempty"`
+	Benefit                      []CoverageEligibilityResponseInsuranceItemBenefit `bson:"benefit,omitempty" json:"benefit,omitempty"`
+	AuthorizationRequired        *bool                                             `bson:"authorizationRequired,omitempty" json:"authorizationRequired,omitempty"`
+	AuthorizationRequiredElement *Element                                          `bson:"_authorizationRequired,omitempty" json:"_authorizationRequired,omitempty"`
+	AuthorizationSupporting      []CodeableConcept                                 `bson:"authorizationSupporting,omitempty" json:"authorizationSupporting,omitempty"`
+	AuthorizationUrl             *string                                           `bson:"authorizationUrl,omitempty" json:"authorizationUrl,omitempty"`
+	AuthorizationUrlElement      *Element                                          `bson:"_authorizationUrl,omitempty" json:"_authorizationUrl,omitempty"`
+	UnknownFields                map[string]json.RawMessage                        `bson:"-" json:"-"`
 }
 type CoverageEligibilityResponseInsuranceItemBenefit struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                      CodeableConcept            `bson:"type" json:"type"`
+	AllowedUnsignedInt        *int                       `bson:"allowedUnsignedInt,omitempty" json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *Element                   `bson:"_allowedUnsignedInt,omitempty" json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string                    `bson:"allowedString,omitempty" json:"allowedString,omitempty"`
+	AllowedStringElement      *Element                   `bson:"_allowedString,omitempty" json:"_allowedString,omitempty"`
+	AllowedMoney              *Money                     `bson:"allowedMoney,omitempty" json:"allowedMoney,omitempty"`
+	UsedUnsignedInt           *int                       `bson:"usedUnsignedInt,omitempty" json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement    *Element                   `bson:"_usedUnsignedInt,omitempty" json:"_usedUnsignedInt,omitempty"`
+	UsedString                *string                    `bson:"usedString,omitempty" json:"usedString,omitempty"`
+	UsedStringElement         *Element                   `bson:"_usedString,omitempty" json:"_usedString,omitempty"`
+	UsedMoney                 *Money                     `bson:"usedMoney,omitempty" json:"usedMoney,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Allowed returns CoverageEligibilityResponse.insurance.item.benefit.allowed[x] of the set type, such as *int, or nil when it is not set.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) Allowed() interface{} {
+	switch {
+	case r.AllowedUnsignedInt != nil:
+		return r.AllowedUnsignedInt
+	case r.AllowedString != nil:
+		return r.AllowedString
+	case r.AllowedMoney != nil:
+		return r.AllowedMoney
+	}
+	return nil
+}
+
+// SetAllowedUnsignedInt sets CoverageEligibilityResponse.insurance.item.benefit.allowed[x] to the unsignedInt and clears the other types.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedUnsignedInt(v int) {
+	r.clearAllowed()
+	r.AllowedUnsignedInt = &v
+}
+
+// SetAllowedString sets CoverageEligibilityResponse.insurance.item.benefit.allowed[x] to the string and clears the other types.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedString(v string) {
+	r.clearAllowed()
+	r.AllowedString = &v
+}
+
+// SetAllowedMoney sets CoverageEligibilityResponse.insurance.item.benefit.allowed[x] to the Money and clears the other types.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedMoney(v Money) {
+	r.clearAllowed()
+	r.AllowedMoney = &v
+}
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) clearAllowed() {
+	r.AllowedUnsignedInt = nil
+	r.AllowedUnsignedIntElement = nil
+	r.AllowedString = nil
+	r.AllowedStringElement = nil
+	r.AllowedMoney = nil
+}
+
+// Used returns CoverageEligibilityResponse.insurance.item.benefit.used[x] of the set type, such as *int, or nil when it is not set.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) Used() interface{} {
+	switch {
+	case r.UsedUnsignedInt != nil:
+		return r.UsedUnsignedInt
+	case r.UsedString != nil:
+		return r.UsedString
+	case r.UsedMoney != nil:
+		return r.UsedMoney
+	}
+	return nil
+}
+
+// SetUsedUnsignedInt sets CoverageEligibilityResponse.insurance.item.benefit.used[x] to the unsignedInt and clears the other types.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedUnsignedInt(v int) {
+	r.clearUsed()
+	r.UsedUnsignedInt = &v
+}
+
+// SetUsedString sets CoverageEligibilityResponse.insurance.item.benefit.used[x] to the string and clears the other types.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedString(v string) {
+	r.clearUsed()
+	r.UsedString = &v
+}
+
+// SetUsedMoney sets CoverageEligibilityResponse.insurance.item.benefit.used[x] to the Money and clears the other types.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedMoney(v Money) {
+	r.clearUsed()
+	r.UsedMoney = &v
+}
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) clearUsed() {
+	r.UsedUnsignedInt = nil
+	r.UsedUnsignedIntElement = nil
+	r.UsedString = nil
+	r.UsedStringElement = nil
+	r.UsedMoney = nil
+}
+
+// UnmarshalJSON unmarshals the CoverageEligibilityResponseInsuranceItemBenefit and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalJSON(b []byte) error {
+	type other CoverageEligibilityResponseInsuranceItemBenefit
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CoverageEligibilityResponse.insurance.item.benefit.allowed[x]",
+		r.AllowedUnsignedInt != nil || r.AllowedUnsignedIntElement != nil,
+		r.AllowedString != nil || r.AllowedStringElement != nil,
+		r.AllowedMoney != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CoverageEligibilityResponse.insurance.item.benefit.used[x]",
+		r.UsedUnsignedInt != nil || r.UsedUnsignedIntElement != nil,
+		r.UsedString != nil || r.UsedStringElement != nil,
+		r.UsedMoney != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the CoverageEligibilityResponseInsuranceItemBenefit with its unknown fields.
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityResponseInsuranceItemBenefit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the CoverageEligibilityResponseInsuranceItem with its unknown fields.
+func (r CoverageEligibilityResponseInsuranceItem) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityResponseInsuranceItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the CoverageEligibilityResponseInsurance with its unknown fields.
+func (r CoverageEligibilityResponseInsurance) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityResponseInsurance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type CoverageEligibilityResponseError struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              CodeableConcept            `bson:"code" json:"code"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the CoverageEligibilityResponseError with its unknown fields.
+func (r CoverageEligibilityResponseError) MarshalJSON() ([]byte, error) {
+	type other CoverageEligibilityResponseError
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Serviced returns CoverageEligibilityResponse.serviced[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *CoverageEligibilityResponse) Serviced() interface{} {
+	switch {
+	case r.ServicedDate != nil:
+		return r.ServicedDate
+	case r.ServicedPeriod != nil:
+		return r.ServicedPeriod
+	}
+	return nil
+}
+
+// SetServicedDate sets CoverageEligibilityResponse.serviced[x] to the date and clears the other types.
+func (r *CoverageEligibilityResponse) SetServicedDate(v DateTime) {
+	r.clearServiced()
+	r.ServicedDate = &v
 }
+
+// SetServicedPeriod sets CoverageEligibilityResponse.serviced[x] to the Period and clears the other types.
+func (r *CoverageEligibilityResponse) SetServicedPeriod(v Period) {
+	r.clearServiced()
+	r.ServicedPeriod = &v
+}
+func (r *CoverageEligibilityResponse) clearServiced() {
+	r.ServicedDate = nil
+	r.ServicedDateElement = nil
+	r.ServicedPeriod = nil
+}
+
+// UnmarshalJSON unmarshals the CoverageEligibilityResponse and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *CoverageEligibilityResponse) UnmarshalJSON(b []byte) error {
+	type other CoverageEligibilityResponse
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"CoverageEligibilityResponse.serviced[x]",
+		r.ServicedDate != nil || r.ServicedDateElement != nil,
+		r.ServicedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("CoverageEligibilityResponse.purpose", len(r.Purpose), len(r.PurposeElement)); err != nil {
+		return err
+	}
+	return checkCodes("CoverageEligibilityResponse", r)
+}
+
 type OtherCoverageEligibilityResponse CoverageEligibilityResponse
 
 // MarshalJSON marshals the given CoverageEligibilityResponse as JSON into a byte slice
 func (r CoverageEligibilityResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherCoverageEligibilityResponse
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherCoverageEligibilityResponse: OtherCoverageEligibilityResponse(r),
 		ResourceType:                     "CoverageEligibilityResponse",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalCoverageEligibilityResponse unmarshals a CoverageEligibilityResponse.
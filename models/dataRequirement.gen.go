@@ -18,35 +18,158 @@
 
 package models
 
+import "encoding/json"
+
 // DataRequirement is documented here http://hl7.org/fhir/StructureDefinition/DataRequirement
 type DataRequirement struct {
-	ID          *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type        string                      `bson:"type" json:"type"`
-	Profile     []string                    `bson:"profile,omitempty" json:"profile,omitempty"`
-	MustSupport []string                    `bson:"mustSupport,omitempty" json:"mustSupport,omitempty"`
-	CodeFilter  []DataRequirementCodeFilter `bson:"codeFilter,omitempty" json:"codeFilter,omitempty"`
-	DateFilter  []DataRequirementDateFilter `bson:"dateFilter,omitempty" json:"dateFilter,omitempty"`
-	Limit       *int                        `bson:"limit,omitempty" json:"limit,omitempty"`
-	Sort        []DataRequirementSort       `bson:"sort,omitempty" json:"sort,omitempty"`
+	ID                     *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type                   string                      `bson:"type" json:"type"`
+	TypeElement            *Element                    `bson:"_type,omitempty" json:"_type,omitempty"`
+	Profile                []*string                   `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement         []*Element                  `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	SubjectCodeableConcept *CodeableConcept            `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference       *Reference                  `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	MustSupport            []*string                   `bson:"mustSupport,omitempty" json:"mustSupport,omitempty"`
+	MustSupportElement     []*Element                  `bson:"_mustSupport,omitempty" json:"_mustSupport,omitempty"`
+	CodeFilter             []DataRequirementCodeFilter `bson:"codeFilter,omitempty" json:"codeFilter,omitempty"`
+	DateFilter             []DataRequirementDateFilter `bson:"dateFilter,omitempty" json:"dateFilter,omitempty"`
+	Limit                  *int                        `bson:"limit,omitempty" json:"limit,omitempty"`
+	LimitElement           *Element                    `bson:"_limit,omitempty" json:"_limit,omitempty"`
+	Sort                   []DataRequirementSort       `bson:"sort,omitempty" json:"sort,omitempty"`
 }
 type DataRequirementCodeFilter struct {
-	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path        *string     `bson:"path,omitempty" json:"path,omitempty"`
-	SearchParam *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
-	ValueSet    *string     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
-	Code        []Coding    `bson:"code,omitempty" json:"code,omitempty"`
+	ID                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path               *string     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement        *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SearchParam        *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	SearchParamElement *Element    `bson:"_searchParam,omitempty" json:"_searchParam,omitempty"`
+	ValueSet           *string     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement    *Element    `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
+	Code               []Coding    `bson:"code,omitempty" json:"code,omitempty"`
 }
 type DataRequirementDateFilter struct {
-	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path        *string     `bson:"path,omitempty" json:"path,omitempty"`
-	SearchParam *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	ID                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path                 *string     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement          *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SearchParam          *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	SearchParamElement   *Element    `bson:"_searchParam,omitempty" json:"_searchParam,omitempty"`
+	ValueDateTime        *DateTime   `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValuePeriod          *Period     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueDuration        *Duration   `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+}
+
+// Value returns DataRequirement.dateFilter.value[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *DataRequirementDateFilter) Value() interface{} {
+	switch {
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	case r.ValueDuration != nil:
+		return r.ValueDuration
+	}
+	return nil
+}
+
+// SetValueDateTime sets DataRequirement.dateFilter.value[x] to the dateTime and clears the other types.
+func (r *DataRequirementDateFilter) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValuePeriod sets DataRequirement.dateFilter.value[x] to the Period and clears the other types.
+func (r *DataRequirementDateFilter) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+
+// SetValueDuration sets DataRequirement.dateFilter.value[x] to the Duration and clears the other types.
+func (r *DataRequirementDateFilter) SetValueDuration(v Duration) {
+	r.clearValue()
+	r.ValueDuration = &v
+}
+func (r *DataRequirementDateFilter) clearValue() {
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValuePeriod = nil
+	r.ValueDuration = nil
+}
+
+// UnmarshalJSON unmarshals the DataRequirementDateFilter and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *DataRequirementDateFilter) UnmarshalJSON(b []byte) error {
+	type other DataRequirementDateFilter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DataRequirement.dateFilter.value[x]",
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValuePeriod != nil,
+		r.ValueDuration != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
 type DataRequirementSort struct {
-	ID        *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path      string        `bson:"path" json:"path"`
-	Direction SortDirection `bson:"direction" json:"direction"`
+	ID               *string       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension        []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path             string        `bson:"path" json:"path"`
+	PathElement      *Element      `bson:"_path,omitempty" json:"_path,omitempty"`
+	Direction        SortDirection `bson:"direction" json:"direction"`
+	DirectionElement *Element      `bson:"_direction,omitempty" json:"_direction,omitempty"`
+}
+
+// Subject returns DataRequirement.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *DataRequirement) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets DataRequirement.subject[x] to the CodeableConcept and clears the other types.
+func (r *DataRequirement) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets DataRequirement.subject[x] to the Reference and clears the other types.
+func (r *DataRequirement) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *DataRequirement) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// UnmarshalJSON unmarshals the DataRequirement and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *DataRequirement) UnmarshalJSON(b []byte) error {
+	type other DataRequirement
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DataRequirement.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("DataRequirement.profile", len(r.Profile), len(r.ProfileElement)); err != nil {
+		return err
+	}
+	if err := checkElements("DataRequirement.mustSupport", len(r.MustSupport), len(r.MustSupportElement)); err != nil {
+		return err
+	}
+	return nil
 }
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.057637 +0000 UTC
+// 2026-10-19 02:55:18.765125473 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DaysOfWeek is documented here http://hl7.org/fhir/ValueSet/days-of-week
-type DaysOfWeek int
+// The empty DaysOfWeek is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DaysOfWeek string
 
 const (
-	DaysOfWeekMon DaysOfWeek = iota
-	DaysOfWeekTue
-	DaysOfWeekWed
-	DaysOfWeekThu
-	DaysOfWeekFri
-	DaysOfWeekSat
-	DaysOfWeekSun
+	DaysOfWeekMon DaysOfWeek = "mon"
+	DaysOfWeekTue DaysOfWeek = "tue"
+	DaysOfWeekWed DaysOfWeek = "wed"
+	DaysOfWeekThu DaysOfWeek = "thu"
+	DaysOfWeekFri DaysOfWeek = "fri"
+	DaysOfWeekSat DaysOfWeek = "sat"
+	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
 func (code DaysOfWeek) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DaysOfWeek"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DaysOfWeek) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "mon":
-		*code = DaysOfWeekMon
-	case "tue":
-		*code = DaysOfWeekTue
-	case "wed":
-		*code = DaysOfWeekWed
-	case "thu":
-		*code = DaysOfWeekThu
-	case "fri":
-		*code = DaysOfWeekFri
-	case "sat":
-		*code = DaysOfWeekSat
-	case "sun":
-		*code = DaysOfWeekSun
-	default:
-		return fmt.Errorf("unknown DaysOfWeek code `%s`", s)
+func (code *DaysOfWeek) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DaysOfWeek(s)
 	return nil
 }
 func (code DaysOfWeek) String() string {
 	return code.Code()
 }
 func (code DaysOfWeek) Code() string {
+	return string(code)
+}
+func (code DaysOfWeek) Known() bool {
 	switch code {
-	case DaysOfWeekMon:
-		return "mon"
-	case DaysOfWeekTue:
-		return "tue"
-	case DaysOfWeekWed:
-		return "wed"
-	case DaysOfWeekThu:
-		return "thu"
-	case DaysOfWeekFri:
-		return "fri"
-	case DaysOfWeekSat:
-		return "sat"
-	case DaysOfWeekSun:
-		return "sun"
+	case DaysOfWeekMon, DaysOfWeekTue, DaysOfWeekWed, DaysOfWeekThu, DaysOfWeekFri, DaysOfWeekSat, DaysOfWeekSun:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DaysOfWeek) Display() string {
 	switch code {
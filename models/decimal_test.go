@@ -0,0 +1,118 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		float   float64
+		scale   int
+		figures int
+		wantErr bool
+	}{
+		{name: "trailing zero", data: "0.10", float: 0.1, scale: 2, figures: 2},
+		{name: "beyond float64", data: "1.000000000000001000", float: 1.000000000000001, scale: 18, figures: 19},
+		{name: "integer", data: "100", float: 100, scale: 0, figures: 3},
+		{name: "negative", data: "-7.25", float: -7.25, scale: 2, figures: 3},
+		{name: "exponent", data: "1.0e2", float: 100, scale: -1, figures: 2},
+		{name: "negative exponent", data: "1.5E-3", float: 0.0015, scale: 4, figures: 2},
+		{name: "zero", data: "0.00", float: 0, scale: 2, figures: 1},
+		{name: "string", data: `"0.10"`, wantErr: true},
+		{name: "leading zero", data: "01", wantErr: true},
+		{name: "no digits after point", data: "1.", wantErr: true},
+		{name: "exponent out of range", data: "1e400000000", wantErr: true},
+		{name: "exponent overflow", data: "1e99999999999999999999", wantErr: true},
+		{name: "largest exponent", data: "1e-1000", float: 0, scale: 1000, figures: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			if err := d.UnmarshalJSON([]byte(tt.data)); (err != nil) != tt.wantErr {
+				t.Fatalf("Decimal.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if d.Float64() != tt.float {
+				t.Errorf("expected float64 %v, got %v", tt.float, d.Float64())
+			}
+			if d.Scale() != tt.scale || d.SignificantFigures() != tt.figures {
+				t.Errorf("expected scale %d and %d significant figures, got %d and %d", tt.scale, tt.figures, d.Scale(), d.SignificantFigures())
+			}
+			data, err := d.MarshalJSON()
+			if err != nil {
+				t.Fatalf("Decimal.MarshalJSON() error = %v", err)
+			}
+			if string(data) != tt.data {
+				t.Errorf("expected: %v; actual: %v", tt.data, string(data))
+			}
+		})
+	}
+
+	var observation Observation
+	data := `{"status":"final","code":{"text":"glucose"},"valueQuantity":{"value":1.000000000000001,"unit":"mmol/L"},"referenceRange":[{"low":{"value":0.10}}],"resourceType":"Observation"}`
+	if err := json.Unmarshal([]byte(data), &observation); err != nil {
+		t.Fatalf("unmarshal error = %v", err)
+	}
+	b, err := json.Marshal(observation)
+	if err != nil {
+		t.Fatalf("marshal error = %v", err)
+	}
+	if string(b) != data {
+		t.Errorf("expected: %s; actual: %s", data, b)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	decimal := func(s string) Decimal {
+		d, err := ParseDecimal(s)
+		if err != nil {
+			t.Fatalf("ParseDecimal() error = %v", err)
+		}
+		return d
+	}
+	tests := []struct {
+		name     string
+		actual   Decimal
+		expected string
+	}{
+		{name: "add keeps precision", actual: decimal("0.10").Add(decimal("1")), expected: "1.10"},
+		{name: "add beyond float64", actual: decimal("1").Add(decimal("0.000000000000000001")), expected: "1.000000000000000001"},
+		{name: "sub", actual: decimal("0.3").Sub(decimal("0.1")), expected: "0.2"},
+		{name: "sub to negative", actual: decimal("0.1").Sub(decimal("0.35")), expected: "-0.25"},
+		{name: "mul", actual: decimal("1.5").Mul(decimal("0.20")), expected: "0.300"},
+		{name: "mul exponent", actual: decimal("1e2").Mul(decimal("-3")), expected: "-300"},
+		{name: "neg", actual: decimal("0.10").Neg(), expected: "-0.10"},
+		{name: "from float64", actual: DecimalFromFloat64(0.1), expected: "0.1"},
+		{name: "zero value", actual: Decimal{}, expected: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.actual.String() != tt.expected {
+				t.Errorf("expected: %s; actual: %s", tt.expected, tt.actual)
+			}
+		})
+	}
+
+	comparisons := []struct {
+		d, u     string
+		expected int
+	}{
+		{d: "0.1", u: "0.10", expected: 0},
+		{d: "1.000000000000001", u: "1", expected: 1},
+		{d: "-2", u: "1e-9", expected: -1},
+		{d: "1.5e3", u: "1500.0", expected: 0},
+	}
+	for _, c := range comparisons {
+		if actual := decimal(c.d).Cmp(decimal(c.u)); actual != c.expected {
+			t.Errorf("%s.Cmp(%s) = %d, expected %d", c.d, c.u, actual, c.expected)
+		}
+	}
+	if decimal("0.1") == decimal("0.10") || !decimal("0.1").Equal(decimal("0.10")) {
+		t.Errorf("expected 0.1 and 0.10 equal in value, but not in precision")
+	}
+}
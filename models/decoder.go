@@ -0,0 +1,217 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// UnknownFieldsMode tells the Decoder what to do with the JSON properties the models don't know,
+// such as the vendor properties or the elements of the later FHIR versions.
+type UnknownFieldsMode int
+
+const (
+	// IgnoreUnknownFields drops the unknown properties like json.Unmarshal.
+	IgnoreUnknownFields UnknownFieldsMode = iota
+	// KeepUnknownFields keeps the unknown properties of the resources and their backbone elements in their
+	// UnknownFields, MarshalJSON writes them back. The unknown properties of the data types are dropped.
+	KeepUnknownFields
+	// RejectUnknownFields fails with UnknownFieldError on the first unknown property.
+	RejectUnknownFields
+)
+
+// Decoder unmarshals the resources. The zero Decoder fails on the unknown codes of the enums with CodeError.
+// The lenient Decoder keeps the unknown codes in the enum values instead and reports them to Warn,
+// Code and MarshalJSON of the enums return the kept codes verbatim.
+type Decoder struct {
+	// Lenient enables keeping the unknown codes.
+	Lenient bool
+	// Warn is called for every kept code with the path of its element, it may be nil.
+	Warn func(warning CodeError)
+	// UnknownFields tells what to do with the unknown properties, they are dropped by default.
+	UnknownFields UnknownFieldsMode
+}
+
+// Unmarshal unmarshals the data into v, a pointer to the resource, such as *Patient.
+func (d Decoder) Unmarshal(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	// the resources check the codes after they are unmarshaled, so CodeError comes with the whole resource
+	if d.Lenient && errors.As(err, new(CodeError)) {
+		root := reflect.TypeOf(v)
+		for root.Kind() == reflect.Ptr {
+			root = root.Elem()
+		}
+		err = d.CheckCodes(v, root.Name())
+	}
+	target := reflect.ValueOf(v)
+	if err != nil || d.UnknownFields == IgnoreUnknownFields || target.Kind() != reflect.Ptr {
+		return err
+	}
+	target = target.Elem()
+
+	// the numbers are kept as is, so the unknown properties are written back verbatim
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	type keptFields struct {
+		path   []interface{}
+		fields map[string]json.RawMessage
+	}
+	var fields []keptFields
+	var unknownErr error
+	root := target.Type().Name()
+	walkElements(value, target.Type(), nil, func(value interface{}, t reflect.Type, path []interface{}) {
+		object, _ := value.(map[string]interface{})
+		unknown := unknownFields(object, t)
+		if len(unknown) == 0 {
+			return
+		}
+		if d.UnknownFields == RejectUnknownFields {
+			if unknownErr == nil {
+				unknownErr = UnknownFieldError{Path: formatPath(root, append(path[:len(path):len(path)], unknown[0]))}
+			}
+			return
+		}
+		if _, ok := t.FieldByName("UnknownFields"); ok {
+			kept := keptFields{path: path, fields: make(map[string]json.RawMessage, len(unknown))}
+			for _, name := range unknown {
+				kept.fields[name], _ = json.Marshal(object[name])
+			}
+			fields = append(fields, kept)
+		}
+	})
+	if unknownErr != nil {
+		return unknownErr
+	}
+	for _, kept := range fields {
+		if element, ok := elementAt(target, append(kept.path, "")); ok {
+			element.FieldByName("UnknownFields").Set(reflect.ValueOf(kept.fields))
+		}
+	}
+	return nil
+}
+
+// CheckCodes checks the codes of the enums in v the way Unmarshal checks the resources, for the values decoded
+// outside of a resource, such as the codes of the operation parameters. The zero Decoder returns CodeError for
+// the first unknown code, the lenient Decoder reports the unknown codes to Warn. The paths start with the root.
+func (d Decoder) CheckCodes(v interface{}, root string) error {
+	var codeErr error
+	walkCodes(reflect.ValueOf(v), root, nil, func(warning CodeError) bool {
+		if !d.Lenient {
+			codeErr = warning
+			return false
+		}
+		if d.Warn != nil {
+			d.Warn(warning)
+		}
+		return true
+	})
+	return codeErr
+}
+
+var containedResourcesType = reflect.TypeOf(ContainedResources{})
+
+// elementVisitor is called for every element of a struct type found in the decoded JSON, the path consists
+// of the JSON names of the elements and the indexes of the items.
+type elementVisitor func(value interface{}, t reflect.Type, path []interface{})
+
+// walkElements walks the decoded JSON value of the type and visits the structs.
+func walkElements(value interface{}, t reflect.Type, path []interface{}, visit elementVisitor) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == containedResourcesType:
+		items, _ := value.([]interface{})
+		for i, item := range items {
+			object, _ := item.(map[string]interface{})
+			resourceType, _ := object["resourceType"].(string)
+			if newResource, ok := resources[resourceType]; ok {
+				walkElements(item, reflect.TypeOf(newResource()), append(path[:len(path):len(path)], i), visit)
+			}
+		}
+	case t.Kind() == reflect.Slice:
+		items, _ := value.([]interface{})
+		for i, item := range items {
+			walkElements(item, t.Elem(), append(path[:len(path):len(path)], i), visit)
+		}
+	case t.Kind() == reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		visit(value, t, path)
+		for i := 0; i < t.NumField(); i++ {
+			name := jsonName(t.Field(i))
+			if item, ok := object[name]; ok && name != "" && name != "-" {
+				walkElements(item, t.Field(i).Type, append(path[:len(path):len(path)], name), visit)
+			}
+		}
+	}
+}
+
+// elementAt returns the element found by the path, the pointers and interfaces on the way are dereferenced.
+// The empty name at the end of the path dereferences the element itself.
+func elementAt(v reflect.Value, path []interface{}) (reflect.Value, bool) {
+	for _, segment := range path {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		}
+		switch segment := segment.(type) {
+		case int:
+			if v.Kind() != reflect.Slice || segment >= v.Len() {
+				return v, false
+			}
+			v = v.Index(segment)
+		case string:
+			if v.Kind() != reflect.Struct {
+				return v, false
+			}
+			if segment == "" {
+				return v, true
+			}
+			field, ok := fieldByJSONName(v.Type(), segment)
+			if !ok {
+				return v, false
+			}
+			v = v.Field(field)
+		}
+	}
+	return v, true
+}
+
+func formatPath(root string, path []interface{}) string {
+	var b strings.Builder
+	b.WriteString(root)
+	for _, segment := range path {
+		switch segment := segment.(type) {
+		case int:
+			b.WriteString("[" + strconv.Itoa(segment) + "]")
+		case string:
+			b.WriteString("." + segment)
+		}
+	}
+	return b.String()
+}
+
+func jsonName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
+
+func fieldByJSONName(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	data := `{"resourceType":"Encounter","status":"done","class":{"code":"AMB"},` +
+		`"statusHistory":[{"status":"finished","period":{}},{"status":"gone","period":{}}],` +
+		`"contained":[{"resourceType":"Patient","gender":"robot"}]}`
+
+	var encounter Encounter
+	var codeErr CodeError
+	if err := (Decoder{}).Unmarshal([]byte(data), &encounter); !errors.As(err, &codeErr) || codeErr.Path != "Encounter.contained[0].gender" {
+		t.Fatalf("expected unknown code at Encounter.contained[0].gender, got %v", err)
+	}
+
+	var warnings []CodeError
+	decoder := Decoder{Lenient: true, Warn: func(warning CodeError) { warnings = append(warnings, warning) }}
+	if err := decoder.Unmarshal([]byte(data), &encounter); err != nil {
+		t.Fatal(err)
+	}
+	expected := []CodeError{
+		{Type: "AdministrativeGender", Code: "robot", Path: "Encounter.contained[0].gender"},
+		{Type: "EncounterStatus", Code: "done", Path: "Encounter.status"},
+		{Type: "EncounterStatus", Code: "gone", Path: "Encounter.statusHistory[1].status"},
+	}
+	if !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("expected warnings %v, got %v", expected, warnings)
+	}
+	if encounter.Status.Code() != "done" || encounter.StatusHistory[0].Status != EncounterStatusFinished || encounter.StatusHistory[1].Status.Code() != "gone" {
+		t.Errorf("unexpected codes: %s, %s, %s", encounter.Status, encounter.StatusHistory[0].Status, encounter.StatusHistory[1].Status)
+	}
+	if patient, ok := encounter.Contained[0].(*Patient); !ok || patient.Gender == nil || patient.Gender.Code() != "robot" {
+		t.Errorf("unexpected contained resource: %+v", encounter.Contained[0])
+	}
+
+	b, err := json.Marshal(encounter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded, expectedJSON interface{}
+	_ = json.Unmarshal(b, &decoded)
+	_ = json.Unmarshal([]byte(data), &expectedJSON)
+	if !reflect.DeepEqual(decoded, expectedJSON) {
+		t.Errorf("expected the codes re-emitted verbatim %s, got %s", data, b)
+	}
+}
+
+func TestDecoderCheckCodes(t *testing.T) {
+	// The data types decoded on their own keep the unknown codes, CheckCodes reports them.
+	var name HumanName
+	if err := json.Unmarshal([]byte(`{"use":"alias","family":"Doe"}`), &name); err != nil {
+		t.Fatal(err)
+	}
+	var codeErr CodeError
+	if err := (Decoder{}).CheckCodes(&name, "HumanName"); !errors.As(err, &codeErr) || codeErr.Path != "HumanName.use" {
+		t.Errorf("expected unknown code at HumanName.use, got %v", err)
+	}
+	if err := (Decoder{Lenient: true}).CheckCodes(&name, "HumanName"); err != nil {
+		t.Errorf("expected the lenient Decoder to accept the code, got %v", err)
+	}
+}
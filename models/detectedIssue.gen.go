@@ -22,51 +22,135 @@ import "encoding/json"
 
 // DetectedIssue is documented here http://hl7.org/fhir/StructureDefinition/DetectedIssue
 type DetectedIssue struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ObservationStatus         `bson:"status" json:"status"`
-	Code              *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
-	Severity          *DetectedIssueSeverity    `bson:"severity,omitempty" json:"severity,omitempty"`
-	Patient           *Reference                `bson:"patient,omitempty" json:"patient,omitempty"`
-	Author            *Reference                `bson:"author,omitempty" json:"author,omitempty"`
-	Implicated        []Reference               `bson:"implicated,omitempty" json:"implicated,omitempty"`
-	Evidence          []DetectedIssueEvidence   `bson:"evidence,omitempty" json:"evidence,omitempty"`
-	Detail            *string                   `bson:"detail,omitempty" json:"detail,omitempty"`
-	Reference         *string                   `bson:"reference,omitempty" json:"reference,omitempty"`
-	Mitigation        []DetectedIssueMitigation `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    ObservationStatus          `bson:"status" json:"status"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Code                      *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Severity                  *DetectedIssueSeverity     `bson:"severity,omitempty" json:"severity,omitempty"`
+	SeverityElement           *Element                   `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	Patient                   *Reference                 `bson:"patient,omitempty" json:"patient,omitempty"`
+	IdentifiedDateTime        *DateTime                  `bson:"identifiedDateTime,omitempty" json:"identifiedDateTime,omitempty"`
+	IdentifiedDateTimeElement *Element                   `bson:"_identifiedDateTime,omitempty" json:"_identifiedDateTime,omitempty"`
+	IdentifiedPeriod          *Period                    `bson:"identifiedPeriod,omitempty" json:"identifiedPeriod,omitempty"`
+	Author                    *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	Implicated                []Reference                `bson:"implicated,omitempty" json:"implicated,omitempty"`
+	Evidence                  []DetectedIssueEvidence    `bson:"evidence,omitempty" json:"evidence,omitempty"`
+	Detail                    *string                    `bson:"detail,omitempty" json:"detail,omitempty"`
+	DetailElement             *Element                   `bson:"_detail,omitempty" json:"_detail,omitempty"`
+	Reference                 *string                    `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement          *Element                   `bson:"_reference,omitempty" json:"_reference,omitempty"`
+	Mitigation                []DetectedIssueMitigation  `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type DetectedIssueEvidence struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Detail            []Reference       `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              []CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	Detail            []Reference                `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DetectedIssueEvidence with its unknown fields.
+func (r DetectedIssueEvidence) MarshalJSON() ([]byte, error) {
+	type other DetectedIssueEvidence
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DetectedIssueMitigation struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Action            CodeableConcept `bson:"action" json:"action"`
-	Date              *DateTime       `bson:"date,omitempty" json:"date,omitempty"`
-	Author            *Reference      `bson:"author,omitempty" json:"author,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Action            CodeableConcept            `bson:"action" json:"action"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Author            *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DetectedIssueMitigation with its unknown fields.
+func (r DetectedIssueMitigation) MarshalJSON() ([]byte, error) {
+	type other DetectedIssueMitigation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Identified returns DetectedIssue.identified[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *DetectedIssue) Identified() interface{} {
+	switch {
+	case r.IdentifiedDateTime != nil:
+		return r.IdentifiedDateTime
+	case r.IdentifiedPeriod != nil:
+		return r.IdentifiedPeriod
+	}
+	return nil
+}
+
+// SetIdentifiedDateTime sets DetectedIssue.identified[x] to the dateTime and clears the other types.
+func (r *DetectedIssue) SetIdentifiedDateTime(v DateTime) {
+	r.clearIdentified()
+	r.IdentifiedDateTime = &v
+}
+
+// SetIdentifiedPeriod sets DetectedIssue.identified[x] to the Period and clears the other types.
+func (r *DetectedIssue) SetIdentifiedPeriod(v Period) {
+	r.clearIdentified()
+	r.IdentifiedPeriod = &v
+}
+func (r *DetectedIssue) clearIdentified() {
+	r.IdentifiedDateTime = nil
+	r.IdentifiedDateTimeElement = nil
+	r.IdentifiedPeriod = nil
+}
+
+// UnmarshalJSON unmarshals the DetectedIssue and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *DetectedIssue) UnmarshalJSON(b []byte) error {
+	type other DetectedIssue
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DetectedIssue.identified[x]",
+		r.IdentifiedDateTime != nil || r.IdentifiedDateTimeElement != nil,
+		r.IdentifiedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("DetectedIssue", r)
+}
+
 type OtherDetectedIssue DetectedIssue
 
 // MarshalJSON marshals the given DetectedIssue as JSON into a byte slice
 func (r DetectedIssue) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDetectedIssue
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDetectedIssue: OtherDetectedIssue(r),
 		ResourceType:       "DetectedIssue",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDetectedIssue unmarshals a DetectedIssue.
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.075103 +0000 UTC
+// 2026-10-19 02:55:18.767899286 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DetectedIssueSeverity is documented here http://hl7.org/fhir/ValueSet/detectedissue-severity
-type DetectedIssueSeverity int
+// The empty DetectedIssueSeverity is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DetectedIssueSeverity string
 
 const (
-	DetectedIssueSeverityHigh DetectedIssueSeverity = iota
-	DetectedIssueSeverityModerate
-	DetectedIssueSeverityLow
+	DetectedIssueSeverityHigh     DetectedIssueSeverity = "high"
+	DetectedIssueSeverityModerate DetectedIssueSeverity = "moderate"
+	DetectedIssueSeverityLow      DetectedIssueSeverity = "low"
 )
 
 func (code DetectedIssueSeverity) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DetectedIssueSeverity"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DetectedIssueSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "high":
-		*code = DetectedIssueSeverityHigh
-	case "moderate":
-		*code = DetectedIssueSeverityModerate
-	case "low":
-		*code = DetectedIssueSeverityLow
-	default:
-		return fmt.Errorf("unknown DetectedIssueSeverity code `%s`", s)
+func (code *DetectedIssueSeverity) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = DetectedIssueSeverity(s)
 	return nil
 }
 func (code DetectedIssueSeverity) String() string {
 	return code.Code()
 }
 func (code DetectedIssueSeverity) Code() string {
+	return string(code)
+}
+func (code DetectedIssueSeverity) Known() bool {
 	switch code {
-	case DetectedIssueSeverityHigh:
-		return "high"
-	case DetectedIssueSeverityModerate:
-		return "moderate"
-	case DetectedIssueSeverityLow:
-		return "low"
+	case DetectedIssueSeverityHigh, DetectedIssueSeverityModerate, DetectedIssueSeverityLow:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DetectedIssueSeverity) Display() string {
 	switch code {
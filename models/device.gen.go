@@ -22,92 +22,189 @@ import "encoding/json"
 
 // Device is documented here http://hl7.org/fhir/StructureDefinition/Device
 type Device struct {
-	ID                 *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Definition         *Reference             `bson:"definition,omitempty" json:"definition,omitempty"`
-	UdiCarrier         []DeviceUdiCarrier     `bson:"udiCarrier,omitempty" json:"udiCarrier,omitempty"`
-	Status             *FHIRDeviceStatus      `bson:"status,omitempty" json:"status,omitempty"`
-	StatusReason       []CodeableConcept      `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	DistinctIdentifier *string                `bson:"distinctIdentifier,omitempty" json:"distinctIdentifier,omitempty"`
-	Manufacturer       *string                `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	ManufactureDate    *DateTime              `bson:"manufactureDate,omitempty" json:"manufactureDate,omitempty"`
-	ExpirationDate     *DateTime              `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
-	LotNumber          *string                `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	SerialNumber       *string                `bson:"serialNumber,omitempty" json:"serialNumber,omitempty"`
-	DeviceName         []DeviceDeviceName     `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	ModelNumber        *string                `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
-	PartNumber         *string                `bson:"partNumber,omitempty" json:"partNumber,omitempty"`
-	Type               *CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
-	Specialization     []DeviceSpecialization `bson:"specialization,omitempty" json:"specialization,omitempty"`
-	Version            []DeviceVersion        `bson:"version,omitempty" json:"version,omitempty"`
-	Property           []DeviceProperty       `bson:"property,omitempty" json:"property,omitempty"`
-	Patient            *Reference             `bson:"patient,omitempty" json:"patient,omitempty"`
-	Owner              *Reference             `bson:"owner,omitempty" json:"owner,omitempty"`
-	Contact            []ContactPoint         `bson:"contact,omitempty" json:"contact,omitempty"`
-	Location           *Reference             `bson:"location,omitempty" json:"location,omitempty"`
-	URL                *string                `bson:"url,omitempty" json:"url,omitempty"`
-	Note               []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
-	Safety             []CodeableConcept      `bson:"safety,omitempty" json:"safety,omitempty"`
-	Parent             *Reference             `bson:"parent,omitempty" json:"parent,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Definition                *Reference                 `bson:"definition,omitempty" json:"definition,omitempty"`
+	UdiCarrier                []DeviceUdiCarrier         `bson:"udiCarrier,omitempty" json:"udiCarrier,omitempty"`
+	Status                    *FHIRDeviceStatus          `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              []CodeableConcept          `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	DistinctIdentifier        *string                    `bson:"distinctIdentifier,omitempty" json:"distinctIdentifier,omitempty"`
+	DistinctIdentifierElement *Element                   `bson:"_distinctIdentifier,omitempty" json:"_distinctIdentifier,omitempty"`
+	Manufacturer              *string                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	ManufacturerElement       *Element                   `bson:"_manufacturer,omitempty" json:"_manufacturer,omitempty"`
+	ManufactureDate           *DateTime                  `bson:"manufactureDate,omitempty" json:"manufactureDate,omitempty"`
+	ManufactureDateElement    *Element                   `bson:"_manufactureDate,omitempty" json:"_manufactureDate,omitempty"`
+	ExpirationDate            *DateTime                  `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ExpirationDateElement     *Element                   `bson:"_expirationDate,omitempty" json:"_expirationDate,omitempty"`
+	LotNumber                 *string                    `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	LotNumberElement          *Element                   `bson:"_lotNumber,omitempty" json:"_lotNumber,omitempty"`
+	SerialNumber              *string                    `bson:"serialNumber,omitempty" json:"serialNumber,omitempty"`
+	SerialNumberElement       *Element                   `bson:"_serialNumber,omitempty" json:"_serialNumber,omitempty"`
+	DeviceName                []DeviceDeviceName         `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	ModelNumber               *string                    `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
+	ModelNumberElement        *Element                   `bson:"_modelNumber,omitempty" json:"_modelNumber,omitempty"`
+	PartNumber                *string                    `bson:"partNumber,omitempty" json:"partNumber,omitempty"`
+	PartNumberElement         *Element                   `bson:"_partNumber,omitempty" json:"_partNumber,omitempty"`
+	Type                      *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Specialization            []DeviceSpecialization     `bson:"specialization,omitempty" json:"specialization,omitempty"`
+	Version                   []DeviceVersion            `bson:"version,omitempty" json:"version,omitempty"`
+	Property                  []DeviceProperty           `bson:"property,omitempty" json:"property,omitempty"`
+	Patient                   *Reference                 `bson:"patient,omitempty" json:"patient,omitempty"`
+	Owner                     *Reference                 `bson:"owner,omitempty" json:"owner,omitempty"`
+	Contact                   []ContactPoint             `bson:"contact,omitempty" json:"contact,omitempty"`
+	Location                  *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	URL                       *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement                *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Note                      []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Safety                    []CodeableConcept          `bson:"safety,omitempty" json:"safety,omitempty"`
+	Parent                    *Reference                 `bson:"parent,omitempty" json:"parent,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type DeviceUdiCarrier struct {
-	ID                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DeviceIdentifier  *string       `bson:"deviceIdentifier,omitempty" json:"deviceIdentifier,omitempty"`
-	Issuer            *string       `bson:"issuer,omitempty" json:"issuer,omitempty"`
-	Jurisdiction      *string       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	CarrierAIDC       *string       `bson:"carrierAIDC,omitempty" json:"carrierAIDC,omitempty"`
-	CarrierHRF        *string       `bson:"carrierHRF,omitempty" json:"carrierHRF,omitempty"`
-	EntryType         *UDIEntryType `bson:"entryType,omitempty" json:"entryType,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DeviceIdentifier        *string                    `bson:"deviceIdentifier,omitempty" json:"deviceIdentifier,omitempty"`
+	DeviceIdentifierElement *Element                   `bson:"_deviceIdentifier,omitempty" json:"_deviceIdentifier,omitempty"`
+	Issuer                  *string                    `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	IssuerElement           *Element                   `bson:"_issuer,omitempty" json:"_issuer,omitempty"`
+	Jurisdiction            *string                    `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	JurisdictionElement     *Element                   `bson:"_jurisdiction,omitempty" json:"_jurisdiction,omitempty"`
+	CarrierAIDC             *string                    `bson:"carrierAIDC,omitempty" json:"carrierAIDC,omitempty"`
+	CarrierAIDCElement      *Element                   `bson:"_carrierAIDC,omitempty" json:"_carrierAIDC,omitempty"`
+	CarrierHRF              *string                    `bson:"carrierHRF,omitempty" json:"carrierHRF,omitempty"`
+	CarrierHRFElement       *Element                   `bson:"_carrierHRF,omitempty" json:"_carrierHRF,omitempty"`
+	EntryType               *UDIEntryType              `bson:"entryType,omitempty" json:"entryType,omitempty"`
+	EntryTypeElement        *Element                   `bson:"_entryType,omitempty" json:"_entryType,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DeviceUdiCarrier with its unknown fields.
+func (r DeviceUdiCarrier) MarshalJSON() ([]byte, error) {
+	type other DeviceUdiCarrier
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DeviceDeviceName struct {
-	ID                *string        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string         `bson:"name" json:"name"`
-	Type              DeviceNameType `bson:"type" json:"type"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              string                     `bson:"name" json:"name"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type              DeviceNameType             `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceDeviceName with its unknown fields.
+func (r DeviceDeviceName) MarshalJSON() ([]byte, error) {
+	type other DeviceDeviceName
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type DeviceSpecialization struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SystemType        CodeableConcept `bson:"systemType" json:"systemType"`
-	Version           *string         `bson:"version,omitempty" json:"version,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SystemType        CodeableConcept            `bson:"systemType" json:"systemType"`
+	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceSpecialization with its unknown fields.
+func (r DeviceSpecialization) MarshalJSON() ([]byte, error) {
+	type other DeviceSpecialization
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type DeviceVersion struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Component         *Identifier      `bson:"component,omitempty" json:"component,omitempty"`
-	Value             string           `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Component         *Identifier                `bson:"component,omitempty" json:"component,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DeviceVersion with its unknown fields.
+func (r DeviceVersion) MarshalJSON() ([]byte, error) {
+	type other DeviceVersion
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DeviceProperty struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept   `bson:"type" json:"type"`
-	ValueQuantity     []Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
-	ValueCode         []CodeableConcept `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	ValueQuantity     []Quantity                 `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueCode         []CodeableConcept          `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceProperty with its unknown fields.
+func (r DeviceProperty) MarshalJSON() ([]byte, error) {
+	type other DeviceProperty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Device and fails with CodeError when a coded element has an unknown code.
+func (r *Device) UnmarshalJSON(b []byte) error {
+	type other Device
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Device", r)
 }
+
 type OtherDevice Device
 
 // MarshalJSON marshals the given Device as JSON into a byte slice
 func (r Device) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDevice
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDevice:  OtherDevice(r),
 		ResourceType: "Device",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDevice unmarshals a Device.
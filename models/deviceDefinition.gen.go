@@ -22,91 +22,234 @@ import "encoding/json"
 
 // DeviceDefinition is documented here http://hl7.org/fhir/StructureDefinition/DeviceDefinition
 type DeviceDefinition struct {
-	ID                      *string                               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                    *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules           *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                *string                               `bson:"language,omitempty" json:"language,omitempty"`
-	Text                    *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension               []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier              []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	UdiDeviceIdentifier     []DeviceDefinitionUdiDeviceIdentifier `bson:"udiDeviceIdentifier,omitempty" json:"udiDeviceIdentifier,omitempty"`
-	DeviceName              []DeviceDefinitionDeviceName          `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	ModelNumber             *string                               `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
-	Type                    *CodeableConcept                      `bson:"type,omitempty" json:"type,omitempty"`
-	Specialization          []DeviceDefinitionSpecialization      `bson:"specialization,omitempty" json:"specialization,omitempty"`
-	Version                 []string                              `bson:"version,omitempty" json:"version,omitempty"`
-	Safety                  []CodeableConcept                     `bson:"safety,omitempty" json:"safety,omitempty"`
-	ShelfLifeStorage        []ProductShelfLife                    `bson:"shelfLifeStorage,omitempty" json:"shelfLifeStorage,omitempty"`
-	PhysicalCharacteristics *ProdCharacteristic                   `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
-	LanguageCode            []CodeableConcept                     `bson:"languageCode,omitempty" json:"languageCode,omitempty"`
-	Capability              []DeviceDefinitionCapability          `bson:"capability,omitempty" json:"capability,omitempty"`
-	Property                []DeviceDefinitionProperty            `bson:"property,omitempty" json:"property,omitempty"`
-	Owner                   *Reference                            `bson:"owner,omitempty" json:"owner,omitempty"`
-	Contact                 []ContactPoint                        `bson:"contact,omitempty" json:"contact,omitempty"`
-	URL                     *string                               `bson:"url,omitempty" json:"url,omitempty"`
-	OnlineInformation       *string                               `bson:"onlineInformation,omitempty" json:"onlineInformation,omitempty"`
-	Note                    []Annotation                          `bson:"note,omitempty" json:"note,omitempty"`
-	Quantity                *Quantity                             `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ParentDevice            *Reference                            `bson:"parentDevice,omitempty" json:"parentDevice,omitempty"`
-	Material                []DeviceDefinitionMaterial            `bson:"material,omitempty" json:"material,omitempty"`
+	ID                        *string                               `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources                    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	UdiDeviceIdentifier       []DeviceDefinitionUdiDeviceIdentifier `bson:"udiDeviceIdentifier,omitempty" json:"udiDeviceIdentifier,omitempty"`
+	ManufacturerString        *string                               `bson:"manufacturerString,omitempty" json:"manufacturerString,omitempty"`
+	ManufacturerStringElement *Element                              `bson:"_manufacturerString,omitempty" json:"_manufacturerString,omitempty"`
+	ManufacturerReference     *Reference                            `bson:"manufacturerReference,omitempty" json:"manufacturerReference,omitempty"`
+	DeviceName                []DeviceDefinitionDeviceName          `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	ModelNumber               *string                               `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
+	ModelNumberElement        *Element                              `bson:"_modelNumber,omitempty" json:"_modelNumber,omitempty"`
+	Type                      *CodeableConcept                      `bson:"type,omitempty" json:"type,omitempty"`
+	Specialization            []DeviceDefinitionSpecialization      `bson:"specialization,omitempty" json:"specialization,omitempty"`
+	Version                   []*string                             `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement            []*Element                            `bson:"_version,omitempty" json:"_version,omitempty"`
+	Safety                    []CodeableConcept                     `bson:"safety,omitempty" json:"safety,omitempty"`
+	ShelfLifeStorage          []ProductShelfLife                    `bson:"shelfLifeStorage,omitempty" json:"shelfLifeStorage,omitempty"`
+	PhysicalCharacteristics   *ProdCharacteristic                   `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
+	LanguageCode              []CodeableConcept                     `bson:"languageCode,omitempty" json:"languageCode,omitempty"`
+	Capability                []DeviceDefinitionCapability          `bson:"capability,omitempty" json:"capability,omitempty"`
+	Property                  []DeviceDefinitionProperty            `bson:"property,omitempty" json:"property,omitempty"`
+	Owner                     *Reference                            `bson:"owner,omitempty" json:"owner,omitempty"`
+	Contact                   []ContactPoint                        `bson:"contact,omitempty" json:"contact,omitempty"`
+	URL                       *string                               `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement                *Element                              `bson:"_url,omitempty" json:"_url,omitempty"`
+	OnlineInformation         *string                               `bson:"onlineInformation,omitempty" json:"onlineInformation,omitempty"`
+	OnlineInformationElement  *Element                              `bson:"_onlineInformation,omitempty" json:"_onlineInformation,omitempty"`
+	Note                      []Annotation                          `bson:"note,omitempty" json:"note,omitempty"`
+	Quantity                  *Quantity                             `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ParentDevice              *Reference                            `bson:"parentDevice,omitempty" json:"parentDevice,omitempty"`
+	Material                  []DeviceDefinitionMaterial            `bson:"material,omitempty" json:"material,omitempty"`
+	UnknownFields             map[string]json.RawMessage            `bson:"-" json:"-"`
 }
 type DeviceDefinitionUdiDeviceIdentifier struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DeviceIdentifier  string      `bson:"deviceIdentifier" json:"deviceIdentifier"`
-	Issuer            string      `bson:"issuer" json:"issuer"`
-	Jurisdiction      string      `bson:"jurisdiction" json:"jurisdiction"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DeviceIdentifier        string                     `bson:"deviceIdentifier" json:"deviceIdentifier"`
+	DeviceIdentifierElement *Element                   `bson:"_deviceIdentifier,omitempty" json:"_deviceIdentifier,omitempty"`
+	Issuer                  string                     `bson:"issuer" json:"issuer"`
+	IssuerElement           *Element                   `bson:"_issuer,omitempty" json:"_issuer,omitempty"`
+	Jurisdiction            string                     `bson:"jurisdiction" json:"jurisdiction"`
+	JurisdictionElement     *Element                   `bson:"_jurisdiction,omitempty" json:"_jurisdiction,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DeviceDefinitionUdiDeviceIdentifier with its unknown fields.
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalJSON() ([]byte, error) {
+	type other DeviceDefinitionUdiDeviceIdentifier
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DeviceDefinitionDeviceName struct {
-	ID                *string        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string         `bson:"name" json:"name"`
-	Type              DeviceNameType `bson:"type" json:"type"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              string                     `bson:"name" json:"name"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type              DeviceNameType             `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceDefinitionDeviceName with its unknown fields.
+func (r DeviceDefinitionDeviceName) MarshalJSON() ([]byte, error) {
+	type other DeviceDefinitionDeviceName
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type DeviceDefinitionSpecialization struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SystemType        string      `bson:"systemType" json:"systemType"`
-	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SystemType        string                     `bson:"systemType" json:"systemType"`
+	SystemTypeElement *Element                   `bson:"_systemType,omitempty" json:"_systemType,omitempty"`
+	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DeviceDefinitionSpecialization with its unknown fields.
+func (r DeviceDefinitionSpecialization) MarshalJSON() ([]byte, error) {
+	type other DeviceDefinitionSpecialization
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DeviceDefinitionCapability struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept   `bson:"type" json:"type"`
-	Description       []CodeableConcept `bson:"description,omitempty" json:"description,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Description       []CodeableConcept          `bson:"description,omitempty" json:"description,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceDefinitionCapability with its unknown fields.
+func (r DeviceDefinitionCapability) MarshalJSON() ([]byte, error) {
+	type other DeviceDefinitionCapability
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type DeviceDefinitionProperty struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept   `bson:"type" json:"type"`
-	ValueQuantity     []Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
-	ValueCode         []CodeableConcept `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	ValueQuantity     []Quantity                 `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueCode         []CodeableConcept          `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceDefinitionProperty with its unknown fields.
+func (r DeviceDefinitionProperty) MarshalJSON() ([]byte, error) {
+	type other DeviceDefinitionProperty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type DeviceDefinitionMaterial struct {
-	ID                  *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Substance           CodeableConcept `bson:"substance" json:"substance"`
-	Alternate           *bool           `bson:"alternate,omitempty" json:"alternate,omitempty"`
-	AllergenicIndicator *bool           `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Substance                  CodeableConcept            `bson:"substance" json:"substance"`
+	Alternate                  *bool                      `bson:"alternate,omitempty" json:"alternate,omitempty"`
+	AlternateElement           *Element                   `bson:"_alternate,omitempty" json:"_alternate,omitempty"`
+	AllergenicIndicator        *bool                      `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *Element                   `bson:"_allergenicIndicator,omitempty" json:"_allergenicIndicator,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the DeviceDefinitionMaterial with its unknown fields.
+func (r DeviceDefinitionMaterial) MarshalJSON() ([]byte, error) {
+	type other DeviceDefinitionMaterial
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Manufacturer returns DeviceDefinition.manufacturer[x] of the set type, such as *string, or nil when it is not set.
+func (r *DeviceDefinition) Manufacturer() interface{} {
+	switch {
+	case r.ManufacturerString != nil:
+		return r.ManufacturerString
+	case r.ManufacturerReference != nil:
+		return r.ManufacturerReference
+	}
+	return nil
 }
+
+// SetManufacturerString sets DeviceDefinition.manufacturer[x] to the string and clears the other types.
+func (r *DeviceDefinition) SetManufacturerString(v string) {
+	r.clearManufacturer()
+	r.ManufacturerString = &v
+}
+
+// SetManufacturerReference sets DeviceDefinition.manufacturer[x] to the Reference and clears the other types.
+func (r *DeviceDefinition) SetManufacturerReference(v Reference) {
+	r.clearManufacturer()
+	r.ManufacturerReference = &v
+}
+func (r *DeviceDefinition) clearManufacturer() {
+	r.ManufacturerString = nil
+	r.ManufacturerStringElement = nil
+	r.ManufacturerReference = nil
+}
+
+// UnmarshalJSON unmarshals the DeviceDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *DeviceDefinition) UnmarshalJSON(b []byte) error {
+	type other DeviceDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DeviceDefinition.manufacturer[x]",
+		r.ManufacturerString != nil || r.ManufacturerStringElement != nil,
+		r.ManufacturerReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("DeviceDefinition.version", len(r.Version), len(r.VersionElement)); err != nil {
+		return err
+	}
+	return checkCodes("DeviceDefinition", r)
+}
+
 type OtherDeviceDefinition DeviceDefinition
 
 // MarshalJSON marshals the given DeviceDefinition as JSON into a byte slice
 func (r DeviceDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDeviceDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDeviceDefinition: OtherDeviceDefinition(r),
 		ResourceType:          "DeviceDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDeviceDefinition unmarshals a DeviceDefinition.
@@ -22,43 +22,78 @@ import "encoding/json"
 
 // DeviceMetric is documented here http://hl7.org/fhir/StructureDefinition/DeviceMetric
 type DeviceMetric struct {
-	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              CodeableConcept                `bson:"type" json:"type"`
-	Unit              *CodeableConcept               `bson:"unit,omitempty" json:"unit,omitempty"`
-	Source            *Reference                     `bson:"source,omitempty" json:"source,omitempty"`
-	Parent            *Reference                     `bson:"parent,omitempty" json:"parent,omitempty"`
-	OperationalStatus *DeviceMetricOperationalStatus `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
-	Color             *DeviceMetricColor             `bson:"color,omitempty" json:"color,omitempty"`
-	Category          DeviceMetricCategory           `bson:"category" json:"category"`
-	MeasurementPeriod *Timing                        `bson:"measurementPeriod,omitempty" json:"measurementPeriod,omitempty"`
-	Calibration       []DeviceMetricCalibration      `bson:"calibration,omitempty" json:"calibration,omitempty"`
+	ID                       *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                     CodeableConcept                `bson:"type" json:"type"`
+	Unit                     *CodeableConcept               `bson:"unit,omitempty" json:"unit,omitempty"`
+	Source                   *Reference                     `bson:"source,omitempty" json:"source,omitempty"`
+	Parent                   *Reference                     `bson:"parent,omitempty" json:"parent,omitempty"`
+	OperationalStatus        *DeviceMetricOperationalStatus `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
+	OperationalStatusElement *Element                       `bson:"_operationalStatus,omitempty" json:"_operationalStatus,omitempty"`
+	Color                    *DeviceMetricColor             `bson:"color,omitempty" json:"color,omitempty"`
+	ColorElement             *Element                       `bson:"_color,omitempty" json:"_color,omitempty"`
+	Category                 DeviceMetricCategory           `bson:"category" json:"category"`
+	CategoryElement          *Element                       `bson:"_category,omitempty" json:"_category,omitempty"`
+	MeasurementPeriod        *Timing                        `bson:"measurementPeriod,omitempty" json:"measurementPeriod,omitempty"`
+	Calibration              []DeviceMetricCalibration      `bson:"calibration,omitempty" json:"calibration,omitempty"`
+	UnknownFields            map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type DeviceMetricCalibration struct {
 	ID                *string                       `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *DeviceMetricCalibrationType  `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                      `bson:"_type,omitempty" json:"_type,omitempty"`
 	State             *DeviceMetricCalibrationState `bson:"state,omitempty" json:"state,omitempty"`
-	Time              *string                       `bson:"time,omitempty" json:"time,omitempty"`
+	StateElement      *Element                      `bson:"_state,omitempty" json:"_state,omitempty"`
+	Time              *Instant                      `bson:"time,omitempty" json:"time,omitempty"`
+	TimeElement       *Element                      `bson:"_time,omitempty" json:"_time,omitempty"`
+	UnknownFields     map[string]json.RawMessage    `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DeviceMetricCalibration with its unknown fields.
+func (r DeviceMetricCalibration) MarshalJSON() ([]byte, error) {
+	type other DeviceMetricCalibration
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the DeviceMetric and fails with CodeError when a coded element has an unknown code.
+func (r *DeviceMetric) UnmarshalJSON(b []byte) error {
+	type other DeviceMetric
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("DeviceMetric", r)
+}
+
 type OtherDeviceMetric DeviceMetric
 
 // MarshalJSON marshals the given DeviceMetric as JSON into a byte slice
 func (r DeviceMetric) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDeviceMetric
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDeviceMetric: OtherDeviceMetric(r),
 		ResourceType:      "DeviceMetric",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDeviceMetric unmarshals a DeviceMetric.
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.190291 +0000 UTC
+// 2026-10-19 02:55:18.768977889 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceMetricCalibrationState is documented here http://hl7.org/fhir/ValueSet/metric-calibration-state
-type DeviceMetricCalibrationState int
+// The empty DeviceMetricCalibrationState is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceMetricCalibrationState string
 
 const (
-	DeviceMetricCalibrationStateNotCalibrated DeviceMetricCalibrationState = iota
-	DeviceMetricCalibrationStateCalibrationRequired
-	DeviceMetricCalibrationStateCalibrated
-	DeviceMetricCalibrationStateUnspecified
+	DeviceMetricCalibrationStateNotCalibrated       DeviceMetricCalibrationState = "not-calibrated"
+	DeviceMetricCalibrationStateCalibrationRequired DeviceMetricCalibrationState = "calibration-required"
+	DeviceMetricCalibrationStateCalibrated          DeviceMetricCalibrationState = "calibrated"
+	DeviceMetricCalibrationStateUnspecified         DeviceMetricCalibrationState = "unspecified"
 )
 
 func (code DeviceMetricCalibrationState) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceMetricCalibrationState"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricCalibrationState) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "not-calibrated":
-		*code = DeviceMetricCalibrationStateNotCalibrated
-	case "calibration-required":
-		*code = DeviceMetricCalibrationStateCalibrationRequired
-	case "calibrated":
-		*code = DeviceMetricCalibrationStateCalibrated
-	case "unspecified":
-		*code = DeviceMetricCalibrationStateUnspecified
-	default:
-		return fmt.Errorf("unknown DeviceMetricCalibrationState code `%s`", s)
+func (code *DeviceMetricCalibrationState) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DeviceMetricCalibrationState(s)
 	return nil
 }
 func (code DeviceMetricCalibrationState) String() string {
 	return code.Code()
 }
 func (code DeviceMetricCalibrationState) Code() string {
+	return string(code)
+}
+func (code DeviceMetricCalibrationState) Known() bool {
 	switch code {
-	case DeviceMetricCalibrationStateNotCalibrated:
-		return "not-calibrated"
-	case DeviceMetricCalibrationStateCalibrationRequired:
-		return "calibration-required"
-	case DeviceMetricCalibrationStateCalibrated:
-		return "calibrated"
-	case DeviceMetricCalibrationStateUnspecified:
-		return "unspecified"
+	case DeviceMetricCalibrationStateNotCalibrated, DeviceMetricCalibrationStateCalibrationRequired, DeviceMetricCalibrationStateCalibrated, DeviceMetricCalibrationStateUnspecified:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceMetricCalibrationState) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.064982 +0000 UTC
+// 2026-10-19 02:55:18.770198375 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceMetricCalibrationType is documented here http://hl7.org/fhir/ValueSet/metric-calibration-type
-type DeviceMetricCalibrationType int
+// The empty DeviceMetricCalibrationType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceMetricCalibrationType string
 
 const (
-	DeviceMetricCalibrationTypeUnspecified DeviceMetricCalibrationType = iota
-	DeviceMetricCalibrationTypeOffset
-	DeviceMetricCalibrationTypeGain
-	DeviceMetricCalibrationTypeTwoPoint
+	DeviceMetricCalibrationTypeUnspecified DeviceMetricCalibrationType = "unspecified"
+	DeviceMetricCalibrationTypeOffset      DeviceMetricCalibrationType = "offset"
+	DeviceMetricCalibrationTypeGain        DeviceMetricCalibrationType = "gain"
+	DeviceMetricCalibrationTypeTwoPoint    DeviceMetricCalibrationType = "two-point"
 )
 
 func (code DeviceMetricCalibrationType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceMetricCalibrationType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricCalibrationType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "unspecified":
-		*code = DeviceMetricCalibrationTypeUnspecified
-	case "offset":
-		*code = DeviceMetricCalibrationTypeOffset
-	case "gain":
-		*code = DeviceMetricCalibrationTypeGain
-	case "two-point":
-		*code = DeviceMetricCalibrationTypeTwoPoint
-	default:
-		return fmt.Errorf("unknown DeviceMetricCalibrationType code `%s`", s)
+func (code *DeviceMetricCalibrationType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DeviceMetricCalibrationType(s)
 	return nil
 }
 func (code DeviceMetricCalibrationType) String() string {
 	return code.Code()
 }
 func (code DeviceMetricCalibrationType) Code() string {
+	return string(code)
+}
+func (code DeviceMetricCalibrationType) Known() bool {
 	switch code {
-	case DeviceMetricCalibrationTypeUnspecified:
-		return "unspecified"
-	case DeviceMetricCalibrationTypeOffset:
-		return "offset"
-	case DeviceMetricCalibrationTypeGain:
-		return "gain"
-	case DeviceMetricCalibrationTypeTwoPoint:
-		return "two-point"
+	case DeviceMetricCalibrationTypeUnspecified, DeviceMetricCalibrationTypeOffset, DeviceMetricCalibrationTypeGain, DeviceMetricCalibrationTypeTwoPoint:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceMetricCalibrationType) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.207857 +0000 UTC
+// 2026-10-19 02:55:18.771448415 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceMetricCategory is documented here http://hl7.org/fhir/ValueSet/metric-category
-type DeviceMetricCategory int
+// The empty DeviceMetricCategory is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceMetricCategory string
 
 const (
-	DeviceMetricCategoryMeasurement DeviceMetricCategory = iota
-	DeviceMetricCategorySetting
-	DeviceMetricCategoryCalculation
-	DeviceMetricCategoryUnspecified
+	DeviceMetricCategoryMeasurement DeviceMetricCategory = "measurement"
+	DeviceMetricCategorySetting     DeviceMetricCategory = "setting"
+	DeviceMetricCategoryCalculation DeviceMetricCategory = "calculation"
+	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
 )
 
 func (code DeviceMetricCategory) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceMetricCategory"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "measurement":
-		*code = DeviceMetricCategoryMeasurement
-	case "setting":
-		*code = DeviceMetricCategorySetting
-	case "calculation":
-		*code = DeviceMetricCategoryCalculation
-	case "unspecified":
-		*code = DeviceMetricCategoryUnspecified
-	default:
-		return fmt.Errorf("unknown DeviceMetricCategory code `%s`", s)
+func (code *DeviceMetricCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DeviceMetricCategory(s)
 	return nil
 }
 func (code DeviceMetricCategory) String() string {
 	return code.Code()
 }
 func (code DeviceMetricCategory) Code() string {
+	return string(code)
+}
+func (code DeviceMetricCategory) Known() bool {
 	switch code {
-	case DeviceMetricCategoryMeasurement:
-		return "measurement"
-	case DeviceMetricCategorySetting:
-		return "setting"
-	case DeviceMetricCategoryCalculation:
-		return "calculation"
-	case DeviceMetricCategoryUnspecified:
-		return "unspecified"
+	case DeviceMetricCategoryMeasurement, DeviceMetricCategorySetting, DeviceMetricCategoryCalculation, DeviceMetricCategoryUnspecified:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceMetricCategory) Display() string {
 	switch code {
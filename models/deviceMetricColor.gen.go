@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.231153 +0000 UTC
+// 2026-10-19 02:55:18.773283623 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceMetricColor is documented here http://hl7.org/fhir/ValueSet/metric-color
-type DeviceMetricColor int
+// The empty DeviceMetricColor is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceMetricColor string
 
 const (
-	DeviceMetricColorBlack DeviceMetricColor = iota
-	DeviceMetricColorRed
-	DeviceMetricColorGreen
-	DeviceMetricColorYellow
-	DeviceMetricColorBlue
-	DeviceMetricColorMagenta
-	DeviceMetricColorCyan
-	DeviceMetricColorWhite
+	DeviceMetricColorBlack   DeviceMetricColor = "black"
+	DeviceMetricColorRed     DeviceMetricColor = "red"
+	DeviceMetricColorGreen   DeviceMetricColor = "green"
+	DeviceMetricColorYellow  DeviceMetricColor = "yellow"
+	DeviceMetricColorBlue    DeviceMetricColor = "blue"
+	DeviceMetricColorMagenta DeviceMetricColor = "magenta"
+	DeviceMetricColorCyan    DeviceMetricColor = "cyan"
+	DeviceMetricColorWhite   DeviceMetricColor = "white"
 )
 
 func (code DeviceMetricColor) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceMetricColor"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricColor) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "black":
-		*code = DeviceMetricColorBlack
-	case "red":
-		*code = DeviceMetricColorRed
-	case "green":
-		*code = DeviceMetricColorGreen
-	case "yellow":
-		*code = DeviceMetricColorYellow
-	case "blue":
-		*code = DeviceMetricColorBlue
-	case "magenta":
-		*code = DeviceMetricColorMagenta
-	case "cyan":
-		*code = DeviceMetricColorCyan
-	case "white":
-		*code = DeviceMetricColorWhite
-	default:
-		return fmt.Errorf("unknown DeviceMetricColor code `%s`", s)
+func (code *DeviceMetricColor) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = DeviceMetricColor(s)
 	return nil
 }
 func (code DeviceMetricColor) String() string {
 	return code.Code()
 }
 func (code DeviceMetricColor) Code() string {
+	return string(code)
+}
+func (code DeviceMetricColor) Known() bool {
 	switch code {
-	case DeviceMetricColorBlack:
-		return "black"
-	case DeviceMetricColorRed:
-		return "red"
-	case DeviceMetricColorGreen:
-		return "green"
-	case DeviceMetricColorYellow:
-		return "yellow"
-	case DeviceMetricColorBlue:
-		return "blue"
-	case DeviceMetricColorMagenta:
-		return "magenta"
-	case DeviceMetricColorCyan:
-		return "cyan"
-	case DeviceMetricColorWhite:
-		return "white"
+	case DeviceMetricColorBlack, DeviceMetricColorRed, DeviceMetricColorGreen, DeviceMetricColorYellow, DeviceMetricColorBlue, DeviceMetricColorMagenta, DeviceMetricColorCyan, DeviceMetricColorWhite:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceMetricColor) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.222497 +0000 UTC
+// 2026-10-19 02:55:18.774752026 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceMetricOperationalStatus is documented here http://hl7.org/fhir/ValueSet/metric-operational-status
-type DeviceMetricOperationalStatus int
+// The empty DeviceMetricOperationalStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceMetricOperationalStatus string
 
 const (
-	DeviceMetricOperationalStatusOn DeviceMetricOperationalStatus = iota
-	DeviceMetricOperationalStatusOff
-	DeviceMetricOperationalStatusStandby
-	DeviceMetricOperationalStatusEnteredInError
+	DeviceMetricOperationalStatusOn             DeviceMetricOperationalStatus = "on"
+	DeviceMetricOperationalStatusOff            DeviceMetricOperationalStatus = "off"
+	DeviceMetricOperationalStatusStandby        DeviceMetricOperationalStatus = "standby"
+	DeviceMetricOperationalStatusEnteredInError DeviceMetricOperationalStatus = "entered-in-error"
 )
 
 func (code DeviceMetricOperationalStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceMetricOperationalStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricOperationalStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "on":
-		*code = DeviceMetricOperationalStatusOn
-	case "off":
-		*code = DeviceMetricOperationalStatusOff
-	case "standby":
-		*code = DeviceMetricOperationalStatusStandby
-	case "entered-in-error":
-		*code = DeviceMetricOperationalStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown DeviceMetricOperationalStatus code `%s`", s)
+func (code *DeviceMetricOperationalStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DeviceMetricOperationalStatus(s)
 	return nil
 }
 func (code DeviceMetricOperationalStatus) String() string {
 	return code.Code()
 }
 func (code DeviceMetricOperationalStatus) Code() string {
+	return string(code)
+}
+func (code DeviceMetricOperationalStatus) Known() bool {
 	switch code {
-	case DeviceMetricOperationalStatusOn:
-		return "on"
-	case DeviceMetricOperationalStatusOff:
-		return "off"
-	case DeviceMetricOperationalStatusStandby:
-		return "standby"
-	case DeviceMetricOperationalStatusEnteredInError:
-		return "entered-in-error"
+	case DeviceMetricOperationalStatusOn, DeviceMetricOperationalStatusOff, DeviceMetricOperationalStatusStandby, DeviceMetricOperationalStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceMetricOperationalStatus) Display() string {
 	switch code {
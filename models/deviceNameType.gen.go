@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.188391 +0000 UTC
+// 2026-10-19 02:55:18.776093328 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceNameType is documented here http://hl7.org/fhir/ValueSet/device-nametype
-type DeviceNameType int
+// The empty DeviceNameType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceNameType string
 
 const (
-	DeviceNameTypeUdiLabelName DeviceNameType = iota
-	DeviceNameTypeUserFriendlyName
-	DeviceNameTypePatientReportedName
-	DeviceNameTypeManufacturerName
-	DeviceNameTypeModelName
-	DeviceNameTypeOther
+	DeviceNameTypeUdiLabelName        DeviceNameType = "udi-label-name"
+	DeviceNameTypeUserFriendlyName    DeviceNameType = "user-friendly-name"
+	DeviceNameTypePatientReportedName DeviceNameType = "patient-reported-name"
+	DeviceNameTypeManufacturerName    DeviceNameType = "manufacturer-name"
+	DeviceNameTypeModelName           DeviceNameType = "model-name"
+	DeviceNameTypeOther               DeviceNameType = "other"
 )
 
 func (code DeviceNameType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceNameType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceNameType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "udi-label-name":
-		*code = DeviceNameTypeUdiLabelName
-	case "user-friendly-name":
-		*code = DeviceNameTypeUserFriendlyName
-	case "patient-reported-name":
-		*code = DeviceNameTypePatientReportedName
-	case "manufacturer-name":
-		*code = DeviceNameTypeManufacturerName
-	case "model-name":
-		*code = DeviceNameTypeModelName
-	case "other":
-		*code = DeviceNameTypeOther
-	default:
-		return fmt.Errorf("unknown DeviceNameType code `%s`", s)
+func (code *DeviceNameType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DeviceNameType(s)
 	return nil
 }
 func (code DeviceNameType) String() string {
 	return code.Code()
 }
 func (code DeviceNameType) Code() string {
+	return string(code)
+}
+func (code DeviceNameType) Known() bool {
 	switch code {
-	case DeviceNameTypeUdiLabelName:
-		return "udi-label-name"
-	case DeviceNameTypeUserFriendlyName:
-		return "user-friendly-name"
-	case DeviceNameTypePatientReportedName:
-		return "patient-reported-name"
-	case DeviceNameTypeManufacturerName:
-		return "manufacturer-name"
-	case DeviceNameTypeModelName:
-		return "model-name"
-	case DeviceNameTypeOther:
-		return "other"
+	case DeviceNameTypeUdiLabelName, DeviceNameTypeUserFriendlyName, DeviceNameTypePatientReportedName, DeviceNameTypeManufacturerName, DeviceNameTypeModelName, DeviceNameTypeOther:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceNameType) Display() string {
 	switch code {
@@ -22,53 +22,248 @@ import "encoding/json"
 
 // DeviceRequest is documented here http://hl7.org/fhir/StructureDefinition/DeviceRequest
 type DeviceRequest struct {
-	ID                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string                 `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                 `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PriorRequest          []Reference              `bson:"priorRequest,omitempty" json:"priorRequest,omitempty"`
-	GroupIdentifier       *Identifier              `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	Status                *RequestStatus           `bson:"status,omitempty" json:"status,omitempty"`
-	Intent                RequestIntent            `bson:"intent" json:"intent"`
-	Priority              *RequestPriority         `bson:"priority,omitempty" json:"priority,omitempty"`
-	Parameter             []DeviceRequestParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	Subject               Reference                `bson:"subject" json:"subject"`
-	Encounter             *Reference               `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	AuthoredOn            *DateTime                `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester             *Reference               `bson:"requester,omitempty" json:"requester,omitempty"`
-	PerformerType         *CodeableConcept         `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Performer             *Reference               `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode            []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Insurance             []Reference              `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	SupportingInfo        []Reference              `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Note                  []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
-	RelevantHistory       []Reference              `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PriorRequest                 []Reference                `bson:"priorRequest,omitempty" json:"priorRequest,omitempty"`
+	GroupIdentifier              *Identifier                `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	Status                       *RequestStatus             `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent              `bson:"intent" json:"intent"`
+	IntentElement                *Element                   `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Priority                     *RequestPriority           `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	CodeReference                *Reference                 `bson:"codeReference,omitempty" json:"codeReference,omitempty"`
+	CodeCodeableConcept          *CodeableConcept           `bson:"codeCodeableConcept,omitempty" json:"codeCodeableConcept,omitempty"`
+	Parameter                    []DeviceRequestParameter   `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Subject                      Reference                  `bson:"subject" json:"subject"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime           *DateTime                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement    *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod             *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming             *Timing                    `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	AuthoredOn                   *DateTime                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                   `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                    *Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
+	PerformerType                *CodeableConcept           `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Performer                    *Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                   []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Insurance                    []Reference                `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	SupportingInfo               []Reference                `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	RelevantHistory              []Reference                `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type DeviceRequestParameter struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	ValueCodeableConcept *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueQuantity        *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange           *Range                     `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Value returns DeviceRequest.parameter.value[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *DeviceRequestParameter) Value() interface{} {
+	switch {
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	}
+	return nil
+}
+
+// SetValueCodeableConcept sets DeviceRequest.parameter.value[x] to the CodeableConcept and clears the other types.
+func (r *DeviceRequestParameter) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueQuantity sets DeviceRequest.parameter.value[x] to the Quantity and clears the other types.
+func (r *DeviceRequestParameter) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets DeviceRequest.parameter.value[x] to the Range and clears the other types.
+func (r *DeviceRequestParameter) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueBoolean sets DeviceRequest.parameter.value[x] to the boolean and clears the other types.
+func (r *DeviceRequestParameter) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+func (r *DeviceRequestParameter) clearValue() {
+	r.ValueCodeableConcept = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+}
+
+// UnmarshalJSON unmarshals the DeviceRequestParameter and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *DeviceRequestParameter) UnmarshalJSON(b []byte) error {
+	type other DeviceRequestParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DeviceRequest.parameter.value[x]",
+		r.ValueCodeableConcept != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the DeviceRequestParameter with its unknown fields.
+func (r DeviceRequestParameter) MarshalJSON() ([]byte, error) {
+	type other DeviceRequestParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Code returns DeviceRequest.code[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *DeviceRequest) Code() interface{} {
+	switch {
+	case r.CodeReference != nil:
+		return r.CodeReference
+	case r.CodeCodeableConcept != nil:
+		return r.CodeCodeableConcept
+	}
+	return nil
+}
+
+// SetCodeReference sets DeviceRequest.code[x] to the Reference and clears the other types.
+func (r *DeviceRequest) SetCodeReference(v Reference) {
+	r.clearCode()
+	r.CodeReference = &v
+}
+
+// SetCodeCodeableConcept sets DeviceRequest.code[x] to the CodeableConcept and clears the other types.
+func (r *DeviceRequest) SetCodeCodeableConcept(v CodeableConcept) {
+	r.clearCode()
+	r.CodeCodeableConcept = &v
+}
+func (r *DeviceRequest) clearCode() {
+	r.CodeReference = nil
+	r.CodeCodeableConcept = nil
+}
+
+// Occurrence returns DeviceRequest.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *DeviceRequest) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	case r.OccurrenceTiming != nil:
+		return r.OccurrenceTiming
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets DeviceRequest.occurrence[x] to the dateTime and clears the other types.
+func (r *DeviceRequest) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets DeviceRequest.occurrence[x] to the Period and clears the other types.
+func (r *DeviceRequest) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+
+// SetOccurrenceTiming sets DeviceRequest.occurrence[x] to the Timing and clears the other types.
+func (r *DeviceRequest) SetOccurrenceTiming(v Timing) {
+	r.clearOccurrence()
+	r.OccurrenceTiming = &v
+}
+func (r *DeviceRequest) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+}
+
+// UnmarshalJSON unmarshals the DeviceRequest and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *DeviceRequest) UnmarshalJSON(b []byte) error {
+	type other DeviceRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DeviceRequest.code[x]",
+		r.CodeReference != nil,
+		r.CodeCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DeviceRequest.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+		r.OccurrenceTiming != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("DeviceRequest.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("DeviceRequest.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("DeviceRequest", r)
+}
+
 type OtherDeviceRequest DeviceRequest
 
 // MarshalJSON marshals the given DeviceRequest as JSON into a byte slice
 func (r DeviceRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDeviceRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDeviceRequest: OtherDeviceRequest(r),
 		ResourceType:       "DeviceRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDeviceRequest unmarshals a DeviceRequest.
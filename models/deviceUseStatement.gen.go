@@ -22,37 +22,106 @@ import "encoding/json"
 
 // DeviceUseStatement is documented here http://hl7.org/fhir/StructureDefinition/DeviceUseStatement
 type DeviceUseStatement struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Status            DeviceUseStatementStatus `bson:"status" json:"status"`
-	Subject           Reference                `bson:"subject" json:"subject"`
-	DerivedFrom       []Reference              `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	RecordedOn        *DateTime                `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
-	Source            *Reference               `bson:"source,omitempty" json:"source,omitempty"`
-	Device            Reference                `bson:"device" json:"device"`
-	ReasonCode        []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	BodySite          *CodeableConcept         `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Note              []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier            []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn               []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Status                DeviceUseStatementStatus   `bson:"status" json:"status"`
+	StatusElement         *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Subject               Reference                  `bson:"subject" json:"subject"`
+	DerivedFrom           []Reference                `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	TimingTiming          *Timing                    `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingPeriod          *Period                    `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDateTime        *DateTime                  `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *Element                   `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	RecordedOn            *DateTime                  `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
+	RecordedOnElement     *Element                   `bson:"_recordedOn,omitempty" json:"_recordedOn,omitempty"`
+	Source                *Reference                 `bson:"source,omitempty" json:"source,omitempty"`
+	Device                Reference                  `bson:"device" json:"device"`
+	ReasonCode            []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference       []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	BodySite              *CodeableConcept           `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Note                  []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Timing returns DeviceUseStatement.timing[x] of the set type, such as *Timing, or nil when it is not set.
+func (r *DeviceUseStatement) Timing() interface{} {
+	switch {
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	}
+	return nil
+}
+
+// SetTimingTiming sets DeviceUseStatement.timing[x] to the Timing and clears the other types.
+func (r *DeviceUseStatement) SetTimingTiming(v Timing) {
+	r.clearTiming()
+	r.TimingTiming = &v
+}
+
+// SetTimingPeriod sets DeviceUseStatement.timing[x] to the Period and clears the other types.
+func (r *DeviceUseStatement) SetTimingPeriod(v Period) {
+	r.clearTiming()
+	r.TimingPeriod = &v
+}
+
+// SetTimingDateTime sets DeviceUseStatement.timing[x] to the dateTime and clears the other types.
+func (r *DeviceUseStatement) SetTimingDateTime(v DateTime) {
+	r.clearTiming()
+	r.TimingDateTime = &v
+}
+func (r *DeviceUseStatement) clearTiming() {
+	r.TimingTiming = nil
+	r.TimingPeriod = nil
+	r.TimingDateTime = nil
+	r.TimingDateTimeElement = nil
+}
+
+// UnmarshalJSON unmarshals the DeviceUseStatement and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *DeviceUseStatement) UnmarshalJSON(b []byte) error {
+	type other DeviceUseStatement
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DeviceUseStatement.timing[x]",
+		r.TimingTiming != nil,
+		r.TimingPeriod != nil,
+		r.TimingDateTime != nil || r.TimingDateTimeElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("DeviceUseStatement", r)
+}
+
 type OtherDeviceUseStatement DeviceUseStatement
 
 // MarshalJSON marshals the given DeviceUseStatement as JSON into a byte slice
 func (r DeviceUseStatement) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDeviceUseStatement
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDeviceUseStatement: OtherDeviceUseStatement(r),
 		ResourceType:            "DeviceUseStatement",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDeviceUseStatement unmarshals a DeviceUseStatement.
@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.111363 +0000 UTC
+// 2026-10-19 02:55:18.777213178 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DeviceUseStatementStatus is documented here http://hl7.org/fhir/ValueSet/device-statement-status
-type DeviceUseStatementStatus int
+// The empty DeviceUseStatementStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DeviceUseStatementStatus string
 
 const (
-	DeviceUseStatementStatusActive DeviceUseStatementStatus = iota
-	DeviceUseStatementStatusCompleted
-	DeviceUseStatementStatusEnteredInError
-	DeviceUseStatementStatusIntended
-	DeviceUseStatementStatusStopped
-	DeviceUseStatementStatusOnHold
+	DeviceUseStatementStatusActive         DeviceUseStatementStatus = "active"
+	DeviceUseStatementStatusCompleted      DeviceUseStatementStatus = "completed"
+	DeviceUseStatementStatusEnteredInError DeviceUseStatementStatus = "entered-in-error"
+	DeviceUseStatementStatusIntended       DeviceUseStatementStatus = "intended"
+	DeviceUseStatementStatusStopped        DeviceUseStatementStatus = "stopped"
+	DeviceUseStatementStatusOnHold         DeviceUseStatementStatus = "on-hold"
 )
 
 func (code DeviceUseStatementStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DeviceUseStatementStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DeviceUseStatementStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = DeviceUseStatementStatusActive
-	case "completed":
-		*code = DeviceUseStatementStatusCompleted
-	case "entered-in-error":
-		*code = DeviceUseStatementStatusEnteredInError
-	case "intended":
-		*code = DeviceUseStatementStatusIntended
-	case "stopped":
-		*code = DeviceUseStatementStatusStopped
-	case "on-hold":
-		*code = DeviceUseStatementStatusOnHold
-	default:
-		return fmt.Errorf("unknown DeviceUseStatementStatus code `%s`", s)
+func (code *DeviceUseStatementStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DeviceUseStatementStatus(s)
 	return nil
 }
 func (code DeviceUseStatementStatus) String() string {
 	return code.Code()
 }
 func (code DeviceUseStatementStatus) Code() string {
+	return string(code)
+}
+func (code DeviceUseStatementStatus) Known() bool {
 	switch code {
-	case DeviceUseStatementStatusActive:
-		return "active"
-	case DeviceUseStatementStatusCompleted:
-		return "completed"
-	case DeviceUseStatementStatusEnteredInError:
-		return "entered-in-error"
-	case DeviceUseStatementStatusIntended:
-		return "intended"
-	case DeviceUseStatementStatusStopped:
-		return "stopped"
-	case DeviceUseStatementStatusOnHold:
-		return "on-hold"
+	case DeviceUseStatementStatusActive, DeviceUseStatementStatusCompleted, DeviceUseStatementStatusEnteredInError, DeviceUseStatementStatusIntended, DeviceUseStatementStatusStopped, DeviceUseStatementStatusOnHold:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DeviceUseStatementStatus) Display() string {
 	switch code {
@@ -22,49 +22,120 @@ import "encoding/json"
 
 // DiagnosticReport is documented here http://hl7.org/fhir/StructureDefinition/DiagnosticReport
 type DiagnosticReport struct {
-	ID                 *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn            []Reference             `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Status             DiagnosticReportStatus  `bson:"status" json:"status"`
-	Category           []CodeableConcept       `bson:"category,omitempty" json:"category,omitempty"`
-	Code               CodeableConcept         `bson:"code" json:"code"`
-	Subject            *Reference              `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter          *Reference              `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Issued             *string                 `bson:"issued,omitempty" json:"issued,omitempty"`
-	Performer          []Reference             `bson:"performer,omitempty" json:"performer,omitempty"`
-	ResultsInterpreter []Reference             `bson:"resultsInterpreter,omitempty" json:"resultsInterpreter,omitempty"`
-	Specimen           []Reference             `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Result             []Reference             `bson:"result,omitempty" json:"result,omitempty"`
-	ImagingStudy       []Reference             `bson:"imagingStudy,omitempty" json:"imagingStudy,omitempty"`
-	Media              []DiagnosticReportMedia `bson:"media,omitempty" json:"media,omitempty"`
-	Conclusion         *string                 `bson:"conclusion,omitempty" json:"conclusion,omitempty"`
-	ConclusionCode     []CodeableConcept       `bson:"conclusionCode,omitempty" json:"conclusionCode,omitempty"`
-	PresentedForm      []Attachment            `bson:"presentedForm,omitempty" json:"presentedForm,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                  []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Status                   DiagnosticReportStatus     `bson:"status" json:"status"`
+	StatusElement            *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category                 []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Code                     CodeableConcept            `bson:"code" json:"code"`
+	Subject                  *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter                *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	EffectiveDateTime        *DateTime                  `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *Element                   `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Issued                   *Instant                   `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement            *Element                   `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Performer                []Reference                `bson:"performer,omitempty" json:"performer,omitempty"`
+	ResultsInterpreter       []Reference                `bson:"resultsInterpreter,omitempty" json:"resultsInterpreter,omitempty"`
+	Specimen                 []Reference                `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Result                   []Reference                `bson:"result,omitempty" json:"result,omitempty"`
+	ImagingStudy             []Reference                `bson:"imagingStudy,omitempty" json:"imagingStudy,omitempty"`
+	Media                    []DiagnosticReportMedia    `bson:"media,omitempty" json:"media,omitempty"`
+	Conclusion               *string                    `bson:"conclusion,omitempty" json:"conclusion,omitempty"`
+	ConclusionElement        *Element                   `bson:"_conclusion,omitempty" json:"_conclusion,omitempty"`
+	ConclusionCode           []CodeableConcept          `bson:"conclusionCode,omitempty" json:"conclusionCode,omitempty"`
+	PresentedForm            []Attachment               `bson:"presentedForm,omitempty" json:"presentedForm,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type DiagnosticReportMedia struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Comment           *string     `bson:"comment,omitempty" json:"comment,omitempty"`
-	Link              Reference   `bson:"link" json:"link"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Comment           *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Link              Reference                  `bson:"link" json:"link"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DiagnosticReportMedia with its unknown fields.
+func (r DiagnosticReportMedia) MarshalJSON() ([]byte, error) {
+	type other DiagnosticReportMedia
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Effective returns DiagnosticReport.effective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *DiagnosticReport) Effective() interface{} {
+	switch {
+	case r.EffectiveDateTime != nil:
+		return r.EffectiveDateTime
+	case r.EffectivePeriod != nil:
+		return r.EffectivePeriod
+	}
+	return nil
+}
+
+// SetEffectiveDateTime sets DiagnosticReport.effective[x] to the dateTime and clears the other types.
+func (r *DiagnosticReport) SetEffectiveDateTime(v DateTime) {
+	r.clearEffective()
+	r.EffectiveDateTime = &v
+}
+
+// SetEffectivePeriod sets DiagnosticReport.effective[x] to the Period and clears the other types.
+func (r *DiagnosticReport) SetEffectivePeriod(v Period) {
+	r.clearEffective()
+	r.EffectivePeriod = &v
+}
+func (r *DiagnosticReport) clearEffective() {
+	r.EffectiveDateTime = nil
+	r.EffectiveDateTimeElement = nil
+	r.EffectivePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the DiagnosticReport and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *DiagnosticReport) UnmarshalJSON(b []byte) error {
+	type other DiagnosticReport
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"DiagnosticReport.effective[x]",
+		r.EffectiveDateTime != nil || r.EffectiveDateTimeElement != nil,
+		r.EffectivePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("DiagnosticReport", r)
+}
+
 type OtherDiagnosticReport DiagnosticReport
 
 // MarshalJSON marshals the given DiagnosticReport as JSON into a byte slice
 func (r DiagnosticReport) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDiagnosticReport
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDiagnosticReport: OtherDiagnosticReport(r),
 		ResourceType:          "DiagnosticReport",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDiagnosticReport unmarshals a DiagnosticReport.
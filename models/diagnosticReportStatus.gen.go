@@ -14,90 +14,59 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.01284 +0000 UTC
+// 2026-10-19 02:55:18.779135243 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DiagnosticReportStatus is documented here http://hl7.org/fhir/ValueSet/diagnostic-report-status
-type DiagnosticReportStatus int
+// The empty DiagnosticReportStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DiagnosticReportStatus string
 
 const (
-	DiagnosticReportStatusRegistered DiagnosticReportStatus = iota
-	DiagnosticReportStatusPartial
-	DiagnosticReportStatusPreliminary
-	DiagnosticReportStatusFinal
-	DiagnosticReportStatusAmended
-	DiagnosticReportStatusCorrected
-	DiagnosticReportStatusAppended
-	DiagnosticReportStatusCancelled
-	DiagnosticReportStatusEnteredInError
-	DiagnosticReportStatusUnknown
+	DiagnosticReportStatusRegistered     DiagnosticReportStatus = "registered"
+	DiagnosticReportStatusPartial        DiagnosticReportStatus = "partial"
+	DiagnosticReportStatusPreliminary    DiagnosticReportStatus = "preliminary"
+	DiagnosticReportStatusFinal          DiagnosticReportStatus = "final"
+	DiagnosticReportStatusAmended        DiagnosticReportStatus = "amended"
+	DiagnosticReportStatusCorrected      DiagnosticReportStatus = "corrected"
+	DiagnosticReportStatusAppended       DiagnosticReportStatus = "appended"
+	DiagnosticReportStatusCancelled      DiagnosticReportStatus = "cancelled"
+	DiagnosticReportStatusEnteredInError DiagnosticReportStatus = "entered-in-error"
+	DiagnosticReportStatusUnknown        DiagnosticReportStatus = "unknown"
 )
 
 func (code DiagnosticReportStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DiagnosticReportStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DiagnosticReportStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "registered":
-		*code = DiagnosticReportStatusRegistered
-	case "partial":
-		*code = DiagnosticReportStatusPartial
-	case "preliminary":
-		*code = DiagnosticReportStatusPreliminary
-	case "final":
-		*code = DiagnosticReportStatusFinal
-	case "amended":
-		*code = DiagnosticReportStatusAmended
-	case "corrected":
-		*code = DiagnosticReportStatusCorrected
-	case "appended":
-		*code = DiagnosticReportStatusAppended
-	case "cancelled":
-		*code = DiagnosticReportStatusCancelled
-	case "entered-in-error":
-		*code = DiagnosticReportStatusEnteredInError
-	case "unknown":
-		*code = DiagnosticReportStatusUnknown
-	default:
-		return fmt.Errorf("unknown DiagnosticReportStatus code `%s`", s)
+func (code *DiagnosticReportStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = DiagnosticReportStatus(s)
 	return nil
 }
 func (code DiagnosticReportStatus) String() string {
 	return code.Code()
 }
 func (code DiagnosticReportStatus) Code() string {
+	return string(code)
+}
+func (code DiagnosticReportStatus) Known() bool {
 	switch code {
-	case DiagnosticReportStatusRegistered:
-		return "registered"
-	case DiagnosticReportStatusPartial:
-		return "partial"
-	case DiagnosticReportStatusPreliminary:
-		return "preliminary"
-	case DiagnosticReportStatusFinal:
-		return "final"
-	case DiagnosticReportStatusAmended:
-		return "amended"
-	case DiagnosticReportStatusCorrected:
-		return "corrected"
-	case DiagnosticReportStatusAppended:
-		return "appended"
-	case DiagnosticReportStatusCancelled:
-		return "cancelled"
-	case DiagnosticReportStatusEnteredInError:
-		return "entered-in-error"
-	case DiagnosticReportStatusUnknown:
-		return "unknown"
+	case DiagnosticReportStatusRegistered, DiagnosticReportStatusPartial, DiagnosticReportStatusPreliminary, DiagnosticReportStatusFinal, DiagnosticReportStatusAmended, DiagnosticReportStatusCorrected, DiagnosticReportStatusAppended, DiagnosticReportStatusCancelled, DiagnosticReportStatusEnteredInError, DiagnosticReportStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DiagnosticReportStatus) Display() string {
 	switch code {
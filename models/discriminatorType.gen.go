@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.010454 +0000 UTC
+// 2026-10-19 02:55:18.780779944 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DiscriminatorType is documented here http://hl7.org/fhir/ValueSet/discriminator-type
-type DiscriminatorType int
+// The empty DiscriminatorType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DiscriminatorType string
 
 const (
-	DiscriminatorTypeValue DiscriminatorType = iota
-	DiscriminatorTypeExists
-	DiscriminatorTypePattern
-	DiscriminatorTypeType
-	DiscriminatorTypeProfile
+	DiscriminatorTypeValue   DiscriminatorType = "value"
+	DiscriminatorTypeExists  DiscriminatorType = "exists"
+	DiscriminatorTypePattern DiscriminatorType = "pattern"
+	DiscriminatorTypeType    DiscriminatorType = "type"
+	DiscriminatorTypeProfile DiscriminatorType = "profile"
 )
 
 func (code DiscriminatorType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DiscriminatorType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DiscriminatorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "value":
-		*code = DiscriminatorTypeValue
-	case "exists":
-		*code = DiscriminatorTypeExists
-	case "pattern":
-		*code = DiscriminatorTypePattern
-	case "type":
-		*code = DiscriminatorTypeType
-	case "profile":
-		*code = DiscriminatorTypeProfile
-	default:
-		return fmt.Errorf("unknown DiscriminatorType code `%s`", s)
+func (code *DiscriminatorType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DiscriminatorType(s)
 	return nil
 }
 func (code DiscriminatorType) String() string {
 	return code.Code()
 }
 func (code DiscriminatorType) Code() string {
+	return string(code)
+}
+func (code DiscriminatorType) Known() bool {
 	switch code {
-	case DiscriminatorTypeValue:
-		return "value"
-	case DiscriminatorTypeExists:
-		return "exists"
-	case DiscriminatorTypePattern:
-		return "pattern"
-	case DiscriminatorTypeType:
-		return "type"
-	case DiscriminatorTypeProfile:
-		return "profile"
+	case DiscriminatorTypeValue, DiscriminatorTypeExists, DiscriminatorTypePattern, DiscriminatorTypeType, DiscriminatorTypeProfile:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DiscriminatorType) Display() string {
 	switch code {
@@ -22,44 +22,77 @@ import "encoding/json"
 
 // DocumentManifest is documented here http://hl7.org/fhir/StructureDefinition/DocumentManifest
 type DocumentManifest struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	MasterIdentifier  *Identifier               `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            DocumentReferenceStatus   `bson:"status" json:"status"`
-	Type              *CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
-	Subject           *Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
-	Created           *DateTime                 `bson:"created,omitempty" json:"created,omitempty"`
-	Author            []Reference               `bson:"author,omitempty" json:"author,omitempty"`
-	Recipient         []Reference               `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Source            *string                   `bson:"source,omitempty" json:"source,omitempty"`
-	Description       *string                   `bson:"description,omitempty" json:"description,omitempty"`
-	Content           []Reference               `bson:"content" json:"content"`
-	Related           []DocumentManifestRelated `bson:"related,omitempty" json:"related,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MasterIdentifier     *Identifier                `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               DocumentReferenceStatus    `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Created              *DateTime                  `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                   `bson:"_created,omitempty" json:"_created,omitempty"`
+	Author               []Reference                `bson:"author,omitempty" json:"author,omitempty"`
+	Recipient            []Reference                `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Source               *string                    `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement        *Element                   `bson:"_source,omitempty" json:"_source,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Content              []Reference                `bson:"content" json:"content"`
+	Related              []DocumentManifestRelated  `bson:"related,omitempty" json:"related,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type DocumentManifestRelated struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Ref               *Reference  `bson:"ref,omitempty" json:"ref,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Ref               *Reference                 `bson:"ref,omitempty" json:"ref,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DocumentManifestRelated with its unknown fields.
+func (r DocumentManifestRelated) MarshalJSON() ([]byte, error) {
+	type other DocumentManifestRelated
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the DocumentManifest and fails with CodeError when a coded element has an unknown code.
+func (r *DocumentManifest) UnmarshalJSON(b []byte) error {
+	type other DocumentManifest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("DocumentManifest", r)
+}
+
 type OtherDocumentManifest DocumentManifest
 
 // MarshalJSON marshals the given DocumentManifest as JSON into a byte slice
 func (r DocumentManifest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDocumentManifest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDocumentManifest: OtherDocumentManifest(r),
 		ResourceType:          "DocumentManifest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDocumentManifest unmarshals a DocumentManifest.
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.255593 +0000 UTC
+// 2026-10-19 02:55:18.782213643 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DocumentMode is documented here http://hl7.org/fhir/ValueSet/document-mode
-type DocumentMode int
+// The empty DocumentMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DocumentMode string
 
 const (
-	DocumentModeProducer DocumentMode = iota
-	DocumentModeConsumer
+	DocumentModeProducer DocumentMode = "producer"
+	DocumentModeConsumer DocumentMode = "consumer"
 )
 
 func (code DocumentMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DocumentMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DocumentMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "producer":
-		*code = DocumentModeProducer
-	case "consumer":
-		*code = DocumentModeConsumer
-	default:
-		return fmt.Errorf("unknown DocumentMode code `%s`", s)
+func (code *DocumentMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = DocumentMode(s)
 	return nil
 }
 func (code DocumentMode) String() string {
 	return code.Code()
 }
 func (code DocumentMode) Code() string {
+	return string(code)
+}
+func (code DocumentMode) Known() bool {
 	switch code {
-	case DocumentModeProducer:
-		return "producer"
-	case DocumentModeConsumer:
-		return "consumer"
+	case DocumentModeProducer, DocumentModeConsumer:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DocumentMode) Display() string {
 	switch code {
@@ -22,67 +22,125 @@ import "encoding/json"
 
 // DocumentReference is documented here http://hl7.org/fhir/StructureDefinition/DocumentReference
 type DocumentReference struct {
-	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	MasterIdentifier  *Identifier                  `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            DocumentReferenceStatus      `bson:"status" json:"status"`
-	DocStatus         *CompositionStatus           `bson:"docStatus,omitempty" json:"docStatus,omitempty"`
-	Type              *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	Category          []CodeableConcept            `bson:"category,omitempty" json:"category,omitempty"`
-	Subject           *Reference                   `bson:"subject,omitempty" json:"subject,omitempty"`
-	Date              *string                      `bson:"date,omitempty" json:"date,omitempty"`
-	Author            []Reference                  `bson:"author,omitempty" json:"author,omitempty"`
-	Authenticator     *Reference                   `bson:"authenticator,omitempty" json:"authenticator,omitempty"`
-	Custodian         *Reference                   `bson:"custodian,omitempty" json:"custodian,omitempty"`
-	RelatesTo         []DocumentReferenceRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
-	Description       *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	SecurityLabel     []CodeableConcept            `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Content           []DocumentReferenceContent   `bson:"content" json:"content"`
-	Context           *DocumentReferenceContext    `bson:"context,omitempty" json:"context,omitempty"`
+	ID                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MasterIdentifier     *Identifier                  `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               DocumentReferenceStatus      `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	DocStatus            *CompositionStatus           `bson:"docStatus,omitempty" json:"docStatus,omitempty"`
+	DocStatusElement     *Element                     `bson:"_docStatus,omitempty" json:"_docStatus,omitempty"`
+	Type                 *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	Category             []CodeableConcept            `bson:"category,omitempty" json:"category,omitempty"`
+	Subject              *Reference                   `bson:"subject,omitempty" json:"subject,omitempty"`
+	Date                 *Instant                     `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                     `bson:"_date,omitempty" json:"_date,omitempty"`
+	Author               []Reference                  `bson:"author,omitempty" json:"author,omitempty"`
+	Authenticator        *Reference                   `bson:"authenticator,omitempty" json:"authenticator,omitempty"`
+	Custodian            *Reference                   `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	RelatesTo            []DocumentReferenceRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
+	Description          *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	SecurityLabel        []CodeableConcept            `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Content              []DocumentReferenceContent   `bson:"content" json:"content"`
+	Context              *DocumentReferenceContext    `bson:"context,omitempty" json:"context,omitempty"`
+	UnknownFields        map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type DocumentReferenceRelatesTo struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              DocumentRelationshipType `bson:"code" json:"code"`
-	Target            Reference                `bson:"target" json:"target"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              DocumentRelationshipType   `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Target            Reference                  `bson:"target" json:"target"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DocumentReferenceRelatesTo with its unknown fields.
+func (r DocumentReferenceRelatesTo) MarshalJSON() ([]byte, error) {
+	type other DocumentReferenceRelatesTo
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DocumentReferenceContent struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Attachment        Attachment  `bson:"attachment" json:"attachment"`
-	Format            *Coding     `bson:"format,omitempty" json:"format,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Attachment        Attachment                 `bson:"attachment" json:"attachment"`
+	Format            *Coding                    `bson:"format,omitempty" json:"format,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DocumentReferenceContent with its unknown fields.
+func (r DocumentReferenceContent) MarshalJSON() ([]byte, error) {
+	type other DocumentReferenceContent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type DocumentReferenceContext struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Encounter         []Reference       `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Event             []CodeableConcept `bson:"event,omitempty" json:"event,omitempty"`
-	Period            *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	FacilityType      *CodeableConcept  `bson:"facilityType,omitempty" json:"facilityType,omitempty"`
-	PracticeSetting   *CodeableConcept  `bson:"practiceSetting,omitempty" json:"practiceSetting,omitempty"`
-	SourcePatientInfo *Reference        `bson:"sourcePatientInfo,omitempty" json:"sourcePatientInfo,omitempty"`
-	Related           []Reference       `bson:"related,omitempty" json:"related,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Encounter         []Reference                `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Event             []CodeableConcept          `bson:"event,omitempty" json:"event,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	FacilityType      *CodeableConcept           `bson:"facilityType,omitempty" json:"facilityType,omitempty"`
+	PracticeSetting   *CodeableConcept           `bson:"practiceSetting,omitempty" json:"practiceSetting,omitempty"`
+	SourcePatientInfo *Reference                 `bson:"sourcePatientInfo,omitempty" json:"sourcePatientInfo,omitempty"`
+	Related           []Reference                `bson:"related,omitempty" json:"related,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the DocumentReferenceContext with its unknown fields.
+func (r DocumentReferenceContext) MarshalJSON() ([]byte, error) {
+	type other DocumentReferenceContext
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the DocumentReference and fails with CodeError when a coded element has an unknown code.
+func (r *DocumentReference) UnmarshalJSON(b []byte) error {
+	type other DocumentReference
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("DocumentReference", r)
+}
+
 type OtherDocumentReference DocumentReference
 
 // MarshalJSON marshals the given DocumentReference as JSON into a byte slice
 func (r DocumentReference) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDocumentReference
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDocumentReference: OtherDocumentReference(r),
 		ResourceType:           "DocumentReference",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDocumentReference unmarshals a DocumentReference.
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.233157 +0000 UTC
+// 2026-10-19 02:55:18.783347276 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DocumentReferenceStatus is documented here http://hl7.org/fhir/ValueSet/document-reference-status
-type DocumentReferenceStatus int
+// The empty DocumentReferenceStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DocumentReferenceStatus string
 
 const (
-	DocumentReferenceStatusCurrent DocumentReferenceStatus = iota
-	DocumentReferenceStatusSuperseded
-	DocumentReferenceStatusEnteredInError
+	DocumentReferenceStatusCurrent        DocumentReferenceStatus = "current"
+	DocumentReferenceStatusSuperseded     DocumentReferenceStatus = "superseded"
+	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
 func (code DocumentReferenceStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DocumentReferenceStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DocumentReferenceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "current":
-		*code = DocumentReferenceStatusCurrent
-	case "superseded":
-		*code = DocumentReferenceStatusSuperseded
-	case "entered-in-error":
-		*code = DocumentReferenceStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown DocumentReferenceStatus code `%s`", s)
+func (code *DocumentReferenceStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = DocumentReferenceStatus(s)
 	return nil
 }
 func (code DocumentReferenceStatus) String() string {
 	return code.Code()
 }
 func (code DocumentReferenceStatus) Code() string {
+	return string(code)
+}
+func (code DocumentReferenceStatus) Known() bool {
 	switch code {
-	case DocumentReferenceStatusCurrent:
-		return "current"
-	case DocumentReferenceStatusSuperseded:
-		return "superseded"
-	case DocumentReferenceStatusEnteredInError:
-		return "entered-in-error"
+	case DocumentReferenceStatusCurrent, DocumentReferenceStatusSuperseded, DocumentReferenceStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DocumentReferenceStatus) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.098753 +0000 UTC
+// 2026-10-19 02:55:18.784522572 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // DocumentRelationshipType is documented here http://hl7.org/fhir/ValueSet/document-relationship-type
-type DocumentRelationshipType int
+// The empty DocumentRelationshipType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type DocumentRelationshipType string
 
 const (
-	DocumentRelationshipTypeReplaces DocumentRelationshipType = iota
-	DocumentRelationshipTypeTransforms
-	DocumentRelationshipTypeSigns
-	DocumentRelationshipTypeAppends
+	DocumentRelationshipTypeReplaces   DocumentRelationshipType = "replaces"
+	DocumentRelationshipTypeTransforms DocumentRelationshipType = "transforms"
+	DocumentRelationshipTypeSigns      DocumentRelationshipType = "signs"
+	DocumentRelationshipTypeAppends    DocumentRelationshipType = "appends"
 )
 
 func (code DocumentRelationshipType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "DocumentRelationshipType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *DocumentRelationshipType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "replaces":
-		*code = DocumentRelationshipTypeReplaces
-	case "transforms":
-		*code = DocumentRelationshipTypeTransforms
-	case "signs":
-		*code = DocumentRelationshipTypeSigns
-	case "appends":
-		*code = DocumentRelationshipTypeAppends
-	default:
-		return fmt.Errorf("unknown DocumentRelationshipType code `%s`", s)
+func (code *DocumentRelationshipType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = DocumentRelationshipType(s)
 	return nil
 }
 func (code DocumentRelationshipType) String() string {
 	return code.Code()
 }
 func (code DocumentRelationshipType) Code() string {
+	return string(code)
+}
+func (code DocumentRelationshipType) Known() bool {
 	switch code {
-	case DocumentRelationshipTypeReplaces:
-		return "replaces"
-	case DocumentRelationshipTypeTransforms:
-		return "transforms"
-	case DocumentRelationshipTypeSigns:
-		return "signs"
-	case DocumentRelationshipTypeAppends:
-		return "appends"
+	case DocumentRelationshipTypeReplaces, DocumentRelationshipTypeTransforms, DocumentRelationshipTypeSigns, DocumentRelationshipTypeAppends:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code DocumentRelationshipType) Display() string {
 	switch code {
@@ -22,25 +22,43 @@ import "encoding/json"
 
 // DomainResource is documented here http://hl7.org/fhir/StructureDefinition/DomainResource
 type DomainResource struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the DomainResource and fails with CodeError when a coded element has an unknown code.
+func (r *DomainResource) UnmarshalJSON(b []byte) error {
+	type other DomainResource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("DomainResource", r)
+}
+
 type OtherDomainResource DomainResource
 
 // MarshalJSON marshals the given DomainResource as JSON into a byte slice
 func (r DomainResource) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherDomainResource
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherDomainResource: OtherDomainResource(r),
 		ResourceType:        "DomainResource",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalDomainResource unmarshals a DomainResource.
@@ -18,26 +18,170 @@
 
 package models
 
+import "encoding/json"
+
 // Dosage is documented here http://hl7.org/fhir/StructureDefinition/Dosage
 type Dosage struct {
-	ID                       *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence                 *int                `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	Text                     *string             `bson:"text,omitempty" json:"text,omitempty"`
-	AdditionalInstruction    []CodeableConcept   `bson:"additionalInstruction,omitempty" json:"additionalInstruction,omitempty"`
-	PatientInstruction       *string             `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
-	Timing                   *Timing             `bson:"timing,omitempty" json:"timing,omitempty"`
-	Site                     *CodeableConcept    `bson:"site,omitempty" json:"site,omitempty"`
-	Route                    *CodeableConcept    `bson:"route,omitempty" json:"route,omitempty"`
-	Method                   *CodeableConcept    `bson:"method,omitempty" json:"method,omitempty"`
-	DoseAndRate              []DosageDoseAndRate `bson:"doseAndRate,omitempty" json:"doseAndRate,omitempty"`
-	MaxDosePerPeriod         *Ratio              `bson:"maxDosePerPeriod,omitempty" json:"maxDosePerPeriod,omitempty"`
-	MaxDosePerAdministration *Quantity           `bson:"maxDosePerAdministration,omitempty" json:"maxDosePerAdministration,omitempty"`
-	MaxDosePerLifetime       *Quantity           `bson:"maxDosePerLifetime,omitempty" json:"maxDosePerLifetime,omitempty"`
+	ID                        *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                  *int                `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement           *Element            `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Text                      *string             `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement               *Element            `bson:"_text,omitempty" json:"_text,omitempty"`
+	AdditionalInstruction     []CodeableConcept   `bson:"additionalInstruction,omitempty" json:"additionalInstruction,omitempty"`
+	PatientInstruction        *string             `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
+	PatientInstructionElement *Element            `bson:"_patientInstruction,omitempty" json:"_patientInstruction,omitempty"`
+	Timing                    *Timing             `bson:"timing,omitempty" json:"timing,omitempty"`
+	AsNeededBoolean           *bool               `bson:"asNeededBoolean,omitempty" json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement    *Element            `bson:"_asNeededBoolean,omitempty" json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept   *CodeableConcept    `bson:"asNeededCodeableConcept,omitempty" json:"asNeededCodeableConcept,omitempty"`
+	Site                      *CodeableConcept    `bson:"site,omitempty" json:"site,omitempty"`
+	Route                     *CodeableConcept    `bson:"route,omitempty" json:"route,omitempty"`
+	Method                    *CodeableConcept    `bson:"method,omitempty" json:"method,omitempty"`
+	DoseAndRate               []DosageDoseAndRate `bson:"doseAndRate,omitempty" json:"doseAndRate,omitempty"`
+	MaxDosePerPeriod          *Ratio              `bson:"maxDosePerPeriod,omitempty" json:"maxDosePerPeriod,omitempty"`
+	MaxDosePerAdministration  *Quantity           `bson:"maxDosePerAdministration,omitempty" json:"maxDosePerAdministration,omitempty"`
+	MaxDosePerLifetime        *Quantity           `bson:"maxDosePerLifetime,omitempty" json:"maxDosePerLifetime,omitempty"`
 }
 type DosageDoseAndRate struct {
-	ID        *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type      *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ID           *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension    []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type         *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	DoseRange    *Range           `bson:"doseRange,omitempty" json:"doseRange,omitempty"`
+	DoseQuantity *Quantity        `bson:"doseQuantity,omitempty" json:"doseQuantity,omitempty"`
+	RateRatio    *Ratio           `bson:"rateRatio,omitempty" json:"rateRatio,omitempty"`
+	RateRange    *Range           `bson:"rateRange,omitempty" json:"rateRange,omitempty"`
+	RateQuantity *Quantity        `bson:"rateQuantity,omitempty" json:"rateQuantity,omitempty"`
+}
+
+// Dose returns Dosage.doseAndRate.dose[x] of the set type, such as *Range, or nil when it is not set.
+func (r *DosageDoseAndRate) Dose() interface{} {
+	switch {
+	case r.DoseRange != nil:
+		return r.DoseRange
+	case r.DoseQuantity != nil:
+		return r.DoseQuantity
+	}
+	return nil
+}
+
+// SetDoseRange sets Dosage.doseAndRate.dose[x] to the Range and clears the other types.
+func (r *DosageDoseAndRate) SetDoseRange(v Range) {
+	r.clearDose()
+	r.DoseRange = &v
+}
+
+// SetDoseQuantity sets Dosage.doseAndRate.dose[x] to the Quantity and clears the other types.
+func (r *DosageDoseAndRate) SetDoseQuantity(v Quantity) {
+	r.clearDose()
+	r.DoseQuantity = &v
+}
+func (r *DosageDoseAndRate) clearDose() {
+	r.DoseRange = nil
+	r.DoseQuantity = nil
+}
+
+// Rate returns Dosage.doseAndRate.rate[x] of the set type, such as *Ratio, or nil when it is not set.
+func (r *DosageDoseAndRate) Rate() interface{} {
+	switch {
+	case r.RateRatio != nil:
+		return r.RateRatio
+	case r.RateRange != nil:
+		return r.RateRange
+	case r.RateQuantity != nil:
+		return r.RateQuantity
+	}
+	return nil
+}
+
+// SetRateRatio sets Dosage.doseAndRate.rate[x] to the Ratio and clears the other types.
+func (r *DosageDoseAndRate) SetRateRatio(v Ratio) {
+	r.clearRate()
+	r.RateRatio = &v
+}
+
+// SetRateRange sets Dosage.doseAndRate.rate[x] to the Range and clears the other types.
+func (r *DosageDoseAndRate) SetRateRange(v Range) {
+	r.clearRate()
+	r.RateRange = &v
+}
+
+// SetRateQuantity sets Dosage.doseAndRate.rate[x] to the Quantity and clears the other types.
+func (r *DosageDoseAndRate) SetRateQuantity(v Quantity) {
+	r.clearRate()
+	r.RateQuantity = &v
+}
+func (r *DosageDoseAndRate) clearRate() {
+	r.RateRatio = nil
+	r.RateRange = nil
+	r.RateQuantity = nil
+}
+
+// UnmarshalJSON unmarshals the DosageDoseAndRate and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *DosageDoseAndRate) UnmarshalJSON(b []byte) error {
+	type other DosageDoseAndRate
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Dosage.doseAndRate.dose[x]",
+		r.DoseRange != nil,
+		r.DoseQuantity != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Dosage.doseAndRate.rate[x]",
+		r.RateRatio != nil,
+		r.RateRange != nil,
+		r.RateQuantity != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AsNeeded returns Dosage.asNeeded[x] of the set type, such as *bool, or nil when it is not set.
+func (r *Dosage) AsNeeded() interface{} {
+	switch {
+	case r.AsNeededBoolean != nil:
+		return r.AsNeededBoolean
+	case r.AsNeededCodeableConcept != nil:
+		return r.AsNeededCodeableConcept
+	}
+	return nil
+}
+
+// SetAsNeededBoolean sets Dosage.asNeeded[x] to the boolean and clears the other types.
+func (r *Dosage) SetAsNeededBoolean(v bool) {
+	r.clearAsNeeded()
+	r.AsNeededBoolean = &v
+}
+
+// SetAsNeededCodeableConcept sets Dosage.asNeeded[x] to the CodeableConcept and clears the other types.
+func (r *Dosage) SetAsNeededCodeableConcept(v CodeableConcept) {
+	r.clearAsNeeded()
+	r.AsNeededCodeableConcept = &v
+}
+func (r *Dosage) clearAsNeeded() {
+	r.AsNeededBoolean = nil
+	r.AsNeededBooleanElement = nil
+	r.AsNeededCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the Dosage and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *Dosage) UnmarshalJSON(b []byte) error {
+	type other Dosage
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Dosage.asNeeded[x]",
+		r.AsNeededBoolean != nil || r.AsNeededBooleanElement != nil,
+		r.AsNeededCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
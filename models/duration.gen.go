@@ -20,11 +20,16 @@ package models
 
 // Duration is documented here http://hl7.org/fhir/StructureDefinition/Duration
 type Duration struct {
-	ID         *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	Value      *Decimal            `bson:"value,omitempty" json:"value,omitempty"`
-	Comparator *QuantityComparator `bson:"comparator,omitempty" json:"comparator,omitempty"`
-	Unit       *string             `bson:"unit,omitempty" json:"unit,omitempty"`
-	System     *string             `bson:"system,omitempty" json:"system,omitempty"`
-	Code       *string             `bson:"code,omitempty" json:"code,omitempty"`
+	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	Value             *Decimal            `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement      *Element            `bson:"_value,omitempty" json:"_value,omitempty"`
+	Comparator        *QuantityComparator `bson:"comparator,omitempty" json:"comparator,omitempty"`
+	ComparatorElement *Element            `bson:"_comparator,omitempty" json:"_comparator,omitempty"`
+	Unit              *string             `bson:"unit,omitempty" json:"unit,omitempty"`
+	UnitElement       *Element            `bson:"_unit,omitempty" json:"_unit,omitempty"`
+	System            *string             `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element            `bson:"_system,omitempty" json:"_system,omitempty"`
+	Code              *string             `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element            `bson:"_code,omitempty" json:"_code,omitempty"`
 }
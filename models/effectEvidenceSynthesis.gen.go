@@ -22,84 +22,156 @@ import "encoding/json"
 
 // EffectEvidenceSynthesis is documented here http://hl7.org/fhir/StructureDefinition/EffectEvidenceSynthesis
 type EffectEvidenceSynthesis struct {
-	ID                  *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL                 *string                                    `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier          []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version             *string                                    `bson:"version,omitempty" json:"version,omitempty"`
-	Name                *string                                    `bson:"name,omitempty" json:"name,omitempty"`
-	Title               *string                                    `bson:"title,omitempty" json:"title,omitempty"`
-	Status              PublicationStatus                          `bson:"status" json:"status"`
-	Date                *DateTime                                  `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher           *string                                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact             []ContactDetail                            `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description         *string                                    `bson:"description,omitempty" json:"description,omitempty"`
-	Note                []Annotation                               `bson:"note,omitempty" json:"note,omitempty"`
-	UseContext          []UsageContext                             `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction        []CodeableConcept                          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright           *string                                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate        *DateTime                                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate      *DateTime                                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod     *Period                                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic               []CodeableConcept                          `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author              []ContactDetail                            `bson:"author,omitempty" json:"author,omitempty"`
-	Editor              []ContactDetail                            `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer            []ContactDetail                            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser            []ContactDetail                            `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact     []RelatedArtifact                          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	SynthesisType       *CodeableConcept                           `bson:"synthesisType,omitempty" json:"synthesisType,omitempty"`
-	StudyType           *CodeableConcept                           `bson:"studyType,omitempty" json:"studyType,omitempty"`
-	Population          Reference                                  `bson:"population" json:"population"`
-	Exposure            Reference                                  `bson:"exposure" json:"exposure"`
-	ExposureAlternative Reference                                  `bson:"exposureAlternative" json:"exposureAlternative"`
-	Outcome             Reference                                  `bson:"outcome" json:"outcome"`
-	SampleSize          *EffectEvidenceSynthesisSampleSize         `bson:"sampleSize,omitempty" json:"sampleSize,omitempty"`
-	ResultsByExposure   []EffectEvidenceSynthesisResultsByExposure `bson:"resultsByExposure,omitempty" json:"resultsByExposure,omitempty"`
-	EffectEstimate      []EffectEvidenceSynthesisEffectEstimate    `bson:"effectEstimate,omitempty" json:"effectEstimate,omitempty"`
-	Certainty           []EffectEvidenceSynthesisCertainty         `bson:"certainty,omitempty" json:"certainty,omitempty"`
+	ID                    *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                   *string                                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement            *Element                                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                PublicationStatus                          `bson:"status" json:"status"`
+	StatusElement         *Element                                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Date                  *DateTime                                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Note                  []Annotation                               `bson:"note,omitempty" json:"note,omitempty"`
+	UseContext            []UsageContext                             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *DateTime                                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *DateTime                                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                 []CodeableConcept                          `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                []ContactDetail                            `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                []ContactDetail                            `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer              []ContactDetail                            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser              []ContactDetail                            `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact       []RelatedArtifact                          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	SynthesisType         *CodeableConcept                           `bson:"synthesisType,omitempty" json:"synthesisType,omitempty"`
+	StudyType             *CodeableConcept                           `bson:"studyType,omitempty" json:"studyType,omitempty"`
+	Population            Reference                                  `bson:"population" json:"population"`
+	Exposure              Reference                                  `bson:"exposure" json:"exposure"`
+	ExposureAlternative   Reference                                  `bson:"exposureAlternative" json:"exposureAlternative"`
+	Outcome               Reference                                  `bson:"outcome" json:"outcome"`
+	SampleSize            *EffectEvidenceSynthesisSampleSize         `bson:"sampleSize,omitempty" json:"sampleSize,omitempty"`
+	ResultsByExposure     []EffectEvidenceSynthesisResultsByExposure `bson:"resultsByExposure,omitempty" json:"resultsByExposure,omitempty"`
+	EffectEstimate        []EffectEvidenceSynthesisEffectEstimate    `bson:"effectEstimate,omitempty" json:"effectEstimate,omitempty"`
+	Certainty             []EffectEvidenceSynthesisCertainty         `bson:"certainty,omitempty" json:"certainty,omitempty"`
+	UnknownFields         map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type EffectEvidenceSynthesisSampleSize struct {
-	ID                   *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description          *string     `bson:"description,omitempty" json:"description,omitempty"`
-	NumberOfStudies      *int        `bson:"numberOfStudies,omitempty" json:"numberOfStudies,omitempty"`
-	NumberOfParticipants *int        `bson:"numberOfParticipants,omitempty" json:"numberOfParticipants,omitempty"`
+	ID                          *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                   []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description                 *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement          *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	NumberOfStudies             *int                       `bson:"numberOfStudies,omitempty" json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement      *Element                   `bson:"_numberOfStudies,omitempty" json:"_numberOfStudies,omitempty"`
+	NumberOfParticipants        *int                       `bson:"numberOfParticipants,omitempty" json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *Element                   `bson:"_numberOfParticipants,omitempty" json:"_numberOfParticipants,omitempty"`
+	UnknownFields               map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the EffectEvidenceSynthesisSampleSize with its unknown fields.
+func (r EffectEvidenceSynthesisSampleSize) MarshalJSON() ([]byte, error) {
+	type other EffectEvidenceSynthesisSampleSize
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type EffectEvidenceSynthesisResultsByExposure struct {
-	ID                    *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description           *string          `bson:"description,omitempty" json:"description,omitempty"`
-	ExposureState         *ExposureState   `bson:"exposureState,omitempty" json:"exposureState,omitempty"`
-	VariantState          *CodeableConcept `bson:"variantState,omitempty" json:"variantState,omitempty"`
-	RiskEvidenceSynthesis Reference        `bson:"riskEvidenceSynthesis" json:"riskEvidenceSynthesis"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description           *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	ExposureState         *ExposureState             `bson:"exposureState,omitempty" json:"exposureState,omitempty"`
+	ExposureStateElement  *Element                   `bson:"_exposureState,omitempty" json:"_exposureState,omitempty"`
+	VariantState          *CodeableConcept           `bson:"variantState,omitempty" json:"variantState,omitempty"`
+	RiskEvidenceSynthesis Reference                  `bson:"riskEvidenceSynthesis" json:"riskEvidenceSynthesis"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EffectEvidenceSynthesisResultsByExposure with its unknown fields.
+func (r EffectEvidenceSynthesisResultsByExposure) MarshalJSON() ([]byte, error) {
+	type other EffectEvidenceSynthesisResultsByExposure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type EffectEvidenceSynthesisEffectEstimate struct {
-	ID                *string                                                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string                                                  `bson:"description,omitempty" json:"description,omitempty"`
-	Type              *CodeableConcept                                         `bson:"type,omitempty" json:"type,omitempty"`
-	VariantState      *CodeableConcept                                         `bson:"variantState,omitempty" json:"variantState,omitempty"`
-	Value             *Decimal                                                 `bson:"value,omitempty" json:"value,omitempty"`
-	UnitOfMeasure     *CodeableConcept                                         `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
-	PrecisionEstimate []EffectEvidenceSynthesisEffectEstimatePrecisionEstimate `bson:"precisionEstimate,omitempty" json:"precisionEstimate,omitempty"`
+	ID                 *string                                                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                                              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        *string                                                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                                 `bson:"_description,omitempty" json:"_description,omitempty"`
+	Type               *CodeableConcept                                         `bson:"type,omitempty" json:"type,omitempty"`
+	VariantState       *CodeableConcept                                         `bson:"variantState,omitempty" json:"variantState,omitempty"`
+	Value              *Decimal                                                 `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement       *Element                                                 `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnitOfMeasure      *CodeableConcept                                         `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
+	PrecisionEstimate  []EffectEvidenceSynthesisEffectEstimatePrecisionEstimate `bson:"precisionEstimate,omitempty" json:"precisionEstimate,omitempty"`
+	UnknownFields      map[string]json.RawMessage                               `bson:"-" json:"-"`
 }
 type EffectEvidenceSynthesisEffectEstimatePrecisionEstimate struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Level             *Decimal         `bson:"level,omitempty" json:"level,omitempty"`
-	From              *Decimal         `bson:"from,omitempty" json:"from,omitempty"`
-	To                *Decimal         `bson:"to,omitempty" json:"to,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Level             *Decimal                   `bson:"level,omitempty" json:"level,omitempty"`
+	LevelElement      *Element                   `bson:"_level,omitempty" json:"_level,omitempty"`
+	From              *Decimal                   `bson:"from,omitempty" json:"from,omitempty"`
+	FromElement       *Element                   `bson:"_from,omitempty" json:"_from,omitempty"`
+	To                *Decimal                   `bson:"to,omitempty" json:"to,omitempty"`
+	ToElement         *Element                   `bson:"_to,omitempty" json:"_to,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EffectEvidenceSynthesisEffectEstimatePrecisionEstimate with its unknown fields.
+func (r EffectEvidenceSynthesisEffectEstimatePrecisionEstimate) MarshalJSON() ([]byte, error) {
+	type other EffectEvidenceSynthesisEffectEstimatePrecisionEstimate
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the EffectEvidenceSynthesisEffectEstimate with its unknown fields.
+func (r EffectEvidenceSynthesisEffectEstimate) MarshalJSON() ([]byte, error) {
+	type other EffectEvidenceSynthesisEffectEstimate
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type EffectEvidenceSynthesisCertainty struct {
 	ID                    *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
 	Extension             []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -107,26 +179,62 @@ type EffectEvidenceSynthesisCertainty struct {
 	Rating                []CodeableConcept                                       `bson:"rating,omitempty" json:"rating,omitempty"`
 	Note                  []Annotation                                            `bson:"note,omitempty" json:"note,omitempty"`
 	CertaintySubcomponent []EffectEvidenceSynthesisCertaintyCertaintySubcomponent `bson:"certaintySubcomponent,omitempty" json:"certaintySubcomponent,omitempty"`
+	UnknownFields         map[string]json.RawMessage                              `bson:"-" json:"-"`
 }
 type EffectEvidenceSynthesisCertaintyCertaintySubcomponent struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Rating            []CodeableConcept `bson:"rating,omitempty" json:"rating,omitempty"`
-	Note              []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Rating            []CodeableConcept          `bson:"rating,omitempty" json:"rating,omitempty"`
+	Note              []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the EffectEvidenceSynthesisCertaintyCertaintySubcomponent with its unknown fields.
+func (r EffectEvidenceSynthesisCertaintyCertaintySubcomponent) MarshalJSON() ([]byte, error) {
+	type other EffectEvidenceSynthesisCertaintyCertaintySubcomponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the EffectEvidenceSynthesisCertainty with its unknown fields.
+func (r EffectEvidenceSynthesisCertainty) MarshalJSON() ([]byte, error) {
+	type other EffectEvidenceSynthesisCertainty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the EffectEvidenceSynthesis and fails with CodeError when a coded element has an unknown code.
+func (r *EffectEvidenceSynthesis) UnmarshalJSON(b []byte) error {
+	type other EffectEvidenceSynthesis
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("EffectEvidenceSynthesis", r)
+}
+
 type OtherEffectEvidenceSynthesis EffectEvidenceSynthesis
 
 // MarshalJSON marshals the given EffectEvidenceSynthesis as JSON into a byte slice
 func (r EffectEvidenceSynthesis) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEffectEvidenceSynthesis
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEffectEvidenceSynthesis: OtherEffectEvidenceSynthesis(r),
 		ResourceType:                 "EffectEvidenceSynthesis",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEffectEvidenceSynthesis unmarshals a EffectEvidenceSynthesis.
@@ -18,99 +18,2863 @@
 
 package models
 
+import "encoding/json"
+
 // ElementDefinition is documented here http://hl7.org/fhir/StructureDefinition/ElementDefinition
 type ElementDefinition struct {
-	ID                  *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Path                string                        `bson:"path" json:"path"`
-	Representation      []PropertyRepresentation      `bson:"representation,omitempty" json:"representation,omitempty"`
-	SliceName           *string                       `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
-	SliceIsConstraining *bool                         `bson:"sliceIsConstraining,omitempty" json:"sliceIsConstraining,omitempty"`
-	Label               *string                       `bson:"label,omitempty" json:"label,omitempty"`
-	Code                []Coding                      `bson:"code,omitempty" json:"code,omitempty"`
-	Slicing             *ElementDefinitionSlicing     `bson:"slicing,omitempty" json:"slicing,omitempty"`
-	Short               *string                       `bson:"short,omitempty" json:"short,omitempty"`
-	Definition          *string                       `bson:"definition,omitempty" json:"definition,omitempty"`
-	Comment             *string                       `bson:"comment,omitempty" json:"comment,omitempty"`
-	Requirements        *string                       `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Alias               []string                      `bson:"alias,omitempty" json:"alias,omitempty"`
-	Min                 *int                          `bson:"min,omitempty" json:"min,omitempty"`
-	Max                 *string                       `bson:"max,omitempty" json:"max,omitempty"`
-	Base                *ElementDefinitionBase        `bson:"base,omitempty" json:"base,omitempty"`
-	ContentReference    *string                       `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
-	Type                []ElementDefinitionType       `bson:"type,omitempty" json:"type,omitempty"`
-	MeaningWhenMissing  *string                       `bson:"meaningWhenMissing,omitempty" json:"meaningWhenMissing,omitempty"`
-	OrderMeaning        *string                       `bson:"orderMeaning,omitempty" json:"orderMeaning,omitempty"`
-	Example             []ElementDefinitionExample    `bson:"example,omitempty" json:"example,omitempty"`
-	MaxLength           *int                          `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
-	Condition           []string                      `bson:"condition,omitempty" json:"condition,omitempty"`
-	Constraint          []ElementDefinitionConstraint `bson:"constraint,omitempty" json:"constraint,omitempty"`
-	MustSupport         *bool                         `bson:"mustSupport,omitempty" json:"mustSupport,omitempty"`
-	IsModifier          *bool                         `bson:"isModifier,omitempty" json:"isModifier,omitempty"`
-	IsModifierReason    *string                       `bson:"isModifierReason,omitempty" json:"isModifierReason,omitempty"`
-	IsSummary           *bool                         `bson:"isSummary,omitempty" json:"isSummary,omitempty"`
-	Binding             *ElementDefinitionBinding     `bson:"binding,omitempty" json:"binding,omitempty"`
-	Mapping             []ElementDefinitionMapping    `bson:"mapping,omitempty" json:"mapping,omitempty"`
+	ID                              *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                       []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Path                            string                        `bson:"path" json:"path"`
+	PathElement                     *Element                      `bson:"_path,omitempty" json:"_path,omitempty"`
+	Representation                  []*PropertyRepresentation     `bson:"representation,omitempty" json:"representation,omitempty"`
+	RepresentationElement           []*Element                    `bson:"_representation,omitempty" json:"_representation,omitempty"`
+	SliceName                       *string                       `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
+	SliceNameElement                *Element                      `bson:"_sliceName,omitempty" json:"_sliceName,omitempty"`
+	SliceIsConstraining             *bool                         `bson:"sliceIsConstraining,omitempty" json:"sliceIsConstraining,omitempty"`
+	SliceIsConstrainingElement      *Element                      `bson:"_sliceIsConstraining,omitempty" json:"_sliceIsConstraining,omitempty"`
+	Label                           *string                       `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement                    *Element                      `bson:"_label,omitempty" json:"_label,omitempty"`
+	Code                            []Coding                      `bson:"code,omitempty" json:"code,omitempty"`
+	Slicing                         *ElementDefinitionSlicing     `bson:"slicing,omitempty" json:"slicing,omitempty"`
+	Short                           *string                       `bson:"short,omitempty" json:"short,omitempty"`
+	ShortElement                    *Element                      `bson:"_short,omitempty" json:"_short,omitempty"`
+	Definition                      *string                       `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement               *Element                      `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Comment                         *string                       `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement                  *Element                      `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Requirements                    *string                       `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	RequirementsElement             *Element                      `bson:"_requirements,omitempty" json:"_requirements,omitempty"`
+	Alias                           []*string                     `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement                    []*Element                    `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Min                             *int                          `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement                      *Element                      `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                             *string                       `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement                      *Element                      `bson:"_max,omitempty" json:"_max,omitempty"`
+	Base                            *ElementDefinitionBase        `bson:"base,omitempty" json:"base,omitempty"`
+	ContentReference                *string                       `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	ContentReferenceElement         *Element                      `bson:"_contentReference,omitempty" json:"_contentReference,omitempty"`
+	Type                            []ElementDefinitionType       `bson:"type,omitempty" json:"type,omitempty"`
+	DefaultValueBase64Binary        *string                       `bson:"defaultValueBase64Binary,omitempty" json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *Element                      `bson:"_defaultValueBase64Binary,omitempty" json:"_defaultValueBase64Binary,omitempty"`
+	DefaultValueBoolean             *bool                         `bson:"defaultValueBoolean,omitempty" json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *Element                      `bson:"_defaultValueBoolean,omitempty" json:"_defaultValueBoolean,omitempty"`
+	DefaultValueCanonical           *string                       `bson:"defaultValueCanonical,omitempty" json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement    *Element                      `bson:"_defaultValueCanonical,omitempty" json:"_defaultValueCanonical,omitempty"`
+	DefaultValueCode                *string                       `bson:"defaultValueCode,omitempty" json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *Element                      `bson:"_defaultValueCode,omitempty" json:"_defaultValueCode,omitempty"`
+	DefaultValueDate                *DateTime                     `bson:"defaultValueDate,omitempty" json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *Element                      `bson:"_defaultValueDate,omitempty" json:"_defaultValueDate,omitempty"`
+	DefaultValueDateTime            *DateTime                     `bson:"defaultValueDateTime,omitempty" json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *Element                      `bson:"_defaultValueDateTime,omitempty" json:"_defaultValueDateTime,omitempty"`
+	DefaultValueDecimal             *Decimal                      `bson:"defaultValueDecimal,omitempty" json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *Element                      `bson:"_defaultValueDecimal,omitempty" json:"_defaultValueDecimal,omitempty"`
+	DefaultValueId                  *string                       `bson:"defaultValueId,omitempty" json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *Element                      `bson:"_defaultValueId,omitempty" json:"_defaultValueId,omitempty"`
+	DefaultValueInstant             *Instant                      `bson:"defaultValueInstant,omitempty" json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *Element                      `bson:"_defaultValueInstant,omitempty" json:"_defaultValueInstant,omitempty"`
+	DefaultValueInteger             *int                          `bson:"defaultValueInteger,omitempty" json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *Element                      `bson:"_defaultValueInteger,omitempty" json:"_defaultValueInteger,omitempty"`
+	DefaultValueMarkdown            *string                       `bson:"defaultValueMarkdown,omitempty" json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *Element                      `bson:"_defaultValueMarkdown,omitempty" json:"_defaultValueMarkdown,omitempty"`
+	DefaultValueOid                 *string                       `bson:"defaultValueOid,omitempty" json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *Element                      `bson:"_defaultValueOid,omitempty" json:"_defaultValueOid,omitempty"`
+	DefaultValuePositiveInt         *int                          `bson:"defaultValuePositiveInt,omitempty" json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *Element                      `bson:"_defaultValuePositiveInt,omitempty" json:"_defaultValuePositiveInt,omitempty"`
+	DefaultValueString              *string                       `bson:"defaultValueString,omitempty" json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *Element                      `bson:"_defaultValueString,omitempty" json:"_defaultValueString,omitempty"`
+	DefaultValueTime                *Time                         `bson:"defaultValueTime,omitempty" json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *Element                      `bson:"_defaultValueTime,omitempty" json:"_defaultValueTime,omitempty"`
+	DefaultValueUnsignedInt         *int                          `bson:"defaultValueUnsignedInt,omitempty" json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *Element                      `bson:"_defaultValueUnsignedInt,omitempty" json:"_defaultValueUnsignedInt,omitempty"`
+	DefaultValueUri                 *string                       `bson:"defaultValueUri,omitempty" json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *Element                      `bson:"_defaultValueUri,omitempty" json:"_defaultValueUri,omitempty"`
+	DefaultValueUrl                 *string                       `bson:"defaultValueUrl,omitempty" json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement          *Element                      `bson:"_defaultValueUrl,omitempty" json:"_defaultValueUrl,omitempty"`
+	DefaultValueUuid                *string                       `bson:"defaultValueUuid,omitempty" json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement         *Element                      `bson:"_defaultValueUuid,omitempty" json:"_defaultValueUuid,omitempty"`
+	DefaultValueAddress             *Address                      `bson:"defaultValueAddress,omitempty" json:"defaultValueAddress,omitempty"`
+	DefaultValueAge                 *Age                          `bson:"defaultValueAge,omitempty" json:"defaultValueAge,omitempty"`
+	DefaultValueAnnotation          *Annotation                   `bson:"defaultValueAnnotation,omitempty" json:"defaultValueAnnotation,omitempty"`
+	DefaultValueAttachment          *Attachment                   `bson:"defaultValueAttachment,omitempty" json:"defaultValueAttachment,omitempty"`
+	DefaultValueCodeableConcept     *CodeableConcept              `bson:"defaultValueCodeableConcept,omitempty" json:"defaultValueCodeableConcept,omitempty"`
+	DefaultValueCoding              *Coding                       `bson:"defaultValueCoding,omitempty" json:"defaultValueCoding,omitempty"`
+	DefaultValueContactPoint        *ContactPoint                 `bson:"defaultValueContactPoint,omitempty" json:"defaultValueContactPoint,omitempty"`
+	DefaultValueCount               *Count                        `bson:"defaultValueCount,omitempty" json:"defaultValueCount,omitempty"`
+	DefaultValueDistance            *Distance                     `bson:"defaultValueDistance,omitempty" json:"defaultValueDistance,omitempty"`
+	DefaultValueDuration            *Duration                     `bson:"defaultValueDuration,omitempty" json:"defaultValueDuration,omitempty"`
+	DefaultValueHumanName           *HumanName                    `bson:"defaultValueHumanName,omitempty" json:"defaultValueHumanName,omitempty"`
+	DefaultValueIdentifier          *Identifier                   `bson:"defaultValueIdentifier,omitempty" json:"defaultValueIdentifier,omitempty"`
+	DefaultValueMoney               *Money                        `bson:"defaultValueMoney,omitempty" json:"defaultValueMoney,omitempty"`
+	DefaultValuePeriod              *Period                       `bson:"defaultValuePeriod,omitempty" json:"defaultValuePeriod,omitempty"`
+	DefaultValueQuantity            *Quantity                     `bson:"defaultValueQuantity,omitempty" json:"defaultValueQuantity,omitempty"`
+	DefaultValueRange               *Range                        `bson:"defaultValueRange,omitempty" json:"defaultValueRange,omitempty"`
+	DefaultValueRatio               *Ratio                        `bson:"defaultValueRatio,omitempty" json:"defaultValueRatio,omitempty"`
+	DefaultValueReference           *Reference                    `bson:"defaultValueReference,omitempty" json:"defaultValueReference,omitempty"`
+	DefaultValueSampledData         *SampledData                  `bson:"defaultValueSampledData,omitempty" json:"defaultValueSampledData,omitempty"`
+	DefaultValueSignature           *Signature                    `bson:"defaultValueSignature,omitempty" json:"defaultValueSignature,omitempty"`
+	DefaultValueTiming              *Timing                       `bson:"defaultValueTiming,omitempty" json:"defaultValueTiming,omitempty"`
+	DefaultValueContactDetail       *ContactDetail                `bson:"defaultValueContactDetail,omitempty" json:"defaultValueContactDetail,omitempty"`
+	DefaultValueContributor         *Contributor                  `bson:"defaultValueContributor,omitempty" json:"defaultValueContributor,omitempty"`
+	DefaultValueDataRequirement     *DataRequirement              `bson:"defaultValueDataRequirement,omitempty" json:"defaultValueDataRequirement,omitempty"`
+	DefaultValueExpression          *Expression                   `bson:"defaultValueExpression,omitempty" json:"defaultValueExpression,omitempty"`
+	DefaultValueParameterDefinition *ParameterDefinition          `bson:"defaultValueParameterDefinition,omitempty" json:"defaultValueParameterDefinition,omitempty"`
+	DefaultValueRelatedArtifact     *RelatedArtifact              `bson:"defaultValueRelatedArtifact,omitempty" json:"defaultValueRelatedArtifact,omitempty"`
+	DefaultValueTriggerDefinition   *TriggerDefinition            `bson:"defaultValueTriggerDefinition,omitempty" json:"defaultValueTriggerDefinition,omitempty"`
+	DefaultValueUsageContext        *UsageContext                 `bson:"defaultValueUsageContext,omitempty" json:"defaultValueUsageContext,omitempty"`
+	DefaultValueDosage              *Dosage                       `bson:"defaultValueDosage,omitempty" json:"defaultValueDosage,omitempty"`
+	DefaultValueMeta                *Meta                         `bson:"defaultValueMeta,omitempty" json:"defaultValueMeta,omitempty"`
+	MeaningWhenMissing              *string                       `bson:"meaningWhenMissing,omitempty" json:"meaningWhenMissing,omitempty"`
+	MeaningWhenMissingElement       *Element                      `bson:"_meaningWhenMissing,omitempty" json:"_meaningWhenMissing,omitempty"`
+	OrderMeaning                    *string                       `bson:"orderMeaning,omitempty" json:"orderMeaning,omitempty"`
+	OrderMeaningElement             *Element                      `bson:"_orderMeaning,omitempty" json:"_orderMeaning,omitempty"`
+	FixedBase64Binary               *string                       `bson:"fixedBase64Binary,omitempty" json:"fixedBase64Binary,omitempty"`
+	FixedBase64BinaryElement        *Element                      `bson:"_fixedBase64Binary,omitempty" json:"_fixedBase64Binary,omitempty"`
+	FixedBoolean                    *bool                         `bson:"fixedBoolean,omitempty" json:"fixedBoolean,omitempty"`
+	FixedBooleanElement             *Element                      `bson:"_fixedBoolean,omitempty" json:"_fixedBoolean,omitempty"`
+	FixedCanonical                  *string                       `bson:"fixedCanonical,omitempty" json:"fixedCanonical,omitempty"`
+	FixedCanonicalElement           *Element                      `bson:"_fixedCanonical,omitempty" json:"_fixedCanonical,omitempty"`
+	FixedCode                       *string                       `bson:"fixedCode,omitempty" json:"fixedCode,omitempty"`
+	FixedCodeElement                *Element                      `bson:"_fixedCode,omitempty" json:"_fixedCode,omitempty"`
+	FixedDate                       *DateTime                     `bson:"fixedDate,omitempty" json:"fixedDate,omitempty"`
+	FixedDateElement                *Element                      `bson:"_fixedDate,omitempty" json:"_fixedDate,omitempty"`
+	FixedDateTime                   *DateTime                     `bson:"fixedDateTime,omitempty" json:"fixedDateTime,omitempty"`
+	FixedDateTimeElement            *Element                      `bson:"_fixedDateTime,omitempty" json:"_fixedDateTime,omitempty"`
+	FixedDecimal                    *Decimal                      `bson:"fixedDecimal,omitempty" json:"fixedDecimal,omitempty"`
+	FixedDecimalElement             *Element                      `bson:"_fixedDecimal,omitempty" json:"_fixedDecimal,omitempty"`
+	FixedId                         *string                       `bson:"fixedId,omitempty" json:"fixedId,omitempty"`
+	FixedIdElement                  *Element                      `bson:"_fixedId,omitempty" json:"_fixedId,omitempty"`
+	FixedInstant                    *Instant                      `bson:"fixedInstant,omitempty" json:"fixedInstant,omitempty"`
+	FixedInstantElement             *Element                      `bson:"_fixedInstant,omitempty" json:"_fixedInstant,omitempty"`
+	FixedInteger                    *int                          `bson:"fixedInteger,omitempty" json:"fixedInteger,omitempty"`
+	FixedIntegerElement             *Element                      `bson:"_fixedInteger,omitempty" json:"_fixedInteger,omitempty"`
+	FixedMarkdown                   *string                       `bson:"fixedMarkdown,omitempty" json:"fixedMarkdown,omitempty"`
+	FixedMarkdownElement            *Element                      `bson:"_fixedMarkdown,omitempty" json:"_fixedMarkdown,omitempty"`
+	FixedOid                        *string                       `bson:"fixedOid,omitempty" json:"fixedOid,omitempty"`
+	FixedOidElement                 *Element                      `bson:"_fixedOid,omitempty" json:"_fixedOid,omitempty"`
+	FixedPositiveInt                *int                          `bson:"fixedPositiveInt,omitempty" json:"fixedPositiveInt,omitempty"`
+	FixedPositiveIntElement         *Element                      `bson:"_fixedPositiveInt,omitempty" json:"_fixedPositiveInt,omitempty"`
+	FixedString                     *string                       `bson:"fixedString,omitempty" json:"fixedString,omitempty"`
+	FixedStringElement              *Element                      `bson:"_fixedString,omitempty" json:"_fixedString,omitempty"`
+	FixedTime                       *Time                         `bson:"fixedTime,omitempty" json:"fixedTime,omitempty"`
+	FixedTimeElement                *Element                      `bson:"_fixedTime,omitempty" json:"_fixedTime,omitempty"`
+	FixedUnsignedInt                *int                          `bson:"fixedUnsignedInt,omitempty" json:"fixedUnsignedInt,omitempty"`
+	FixedUnsignedIntElement         *Element                      `bson:"_fixedUnsignedInt,omitempty" json:"_fixedUnsignedInt,omitempty"`
+	FixedUri                        *string                       `bson:"fixedUri,omitempty" json:"fixedUri,omitempty"`
+	FixedUriElement                 *Element                      `bson:"_fixedUri,omitempty" json:"_fixedUri,omitempty"`
+	FixedUrl                        *string                       `bson:"fixedUrl,omitempty" json:"fixedUrl,omitempty"`
+	FixedUrlElement                 *Element                      `bson:"_fixedUrl,omitempty" json:"_fixedUrl,omitempty"`
+	FixedUuid                       *string                       `bson:"fixedUuid,omitempty" json:"fixedUuid,omitempty"`
+	FixedUuidElement                *Element                      `bson:"_fixedUuid,omitempty" json:"_fixedUuid,omitempty"`
+	FixedAddress                    *Address                      `bson:"fixedAddress,omitempty" json:"fixedAddress,omitempty"`
+	FixedAge                        *Age                          `bson:"fixedAge,omitempty" json:"fixedAge,omitempty"`
+	FixedAnnotation                 *Annotation                   `bson:"fixedAnnotation,omitempty" json:"fixedAnnotation,omitempty"`
+	FixedAttachment                 *Attachment                   `bson:"fixedAttachment,omitempty" json:"fixedAttachment,omitempty"`
+	FixedCodeableConcept            *CodeableConcept              `bson:"fixedCodeableConcept,omitempty" json:"fixedCodeableConcept,omitempty"`
+	FixedCoding                     *Coding                       `bson:"fixedCoding,omitempty" json:"fixedCoding,omitempty"`
+	FixedContactPoint               *ContactPoint                 `bson:"fixedContactPoint,omitempty" json:"fixedContactPoint,omitempty"`
+	FixedCount                      *Count                        `bson:"fixedCount,omitempty" json:"fixedCount,omitempty"`
+	FixedDistance                   *Distance                     `bson:"fixedDistance,omitempty" json:"fixedDistance,omitempty"`
+	FixedDuration                   *Duration                     `bson:"fixedDuration,omitempty" json:"fixedDuration,omitempty"`
+	FixedHumanName                  *HumanName                    `bson:"fixedHumanName,omitempty" json:"fixedHumanName,omitempty"`
+	FixedIdentifier                 *Identifier                   `bson:"fixedIdentifier,omitempty" json:"fixedIdentifier,omitempty"`
+	FixedMoney                      *Money                        `bson:"fixedMoney,omitempty" json:"fixedMoney,omitempty"`
+	FixedPeriod                     *Period                       `bson:"fixedPeriod,omitempty" json:"fixedPeriod,omitempty"`
+	FixedQuantity                   *Quantity                     `bson:"fixedQuantity,omitempty" json:"fixedQuantity,omitempty"`
+	FixedRange                      *Range                        `bson:"fixedRange,omitempty" json:"fixedRange,omitempty"`
+	FixedRatio                      *Ratio                        `bson:"fixedRatio,omitempty" json:"fixedRatio,omitempty"`
+	FixedReference                  *Reference                    `bson:"fixedReference,omitempty" json:"fixedReference,omitempty"`
+	FixedSampledData                *SampledData                  `bson:"fixedSampledData,omitempty" json:"fixedSampledData,omitempty"`
+	FixedSignature                  *Signature                    `bson:"fixedSignature,omitempty" json:"fixedSignature,omitempty"`
+	FixedTiming                     *Timing                       `bson:"fixedTiming,omitempty" json:"fixedTiming,omitempty"`
+	FixedContactDetail              *ContactDetail                `bson:"fixedContactDetail,omitempty" json:"fixedContactDetail,omitempty"`
+	FixedContributor                *Contributor                  `bson:"fixedContributor,omitempty" json:"fixedContributor,omitempty"`
+	FixedDataRequirement            *DataRequirement              `bson:"fixedDataRequirement,omitempty" json:"fixedDataRequirement,omitempty"`
+	FixedExpression                 *Expression                   `bson:"fixedExpression,omitempty" json:"fixedExpression,omitempty"`
+	FixedParameterDefinition        *ParameterDefinition          `bson:"fixedParameterDefinition,omitempty" json:"fixedParameterDefinition,omitempty"`
+	FixedRelatedArtifact            *RelatedArtifact              `bson:"fixedRelatedArtifact,omitempty" json:"fixedRelatedArtifact,omitempty"`
+	FixedTriggerDefinition          *TriggerDefinition            `bson:"fixedTriggerDefinition,omitempty" json:"fixedTriggerDefinition,omitempty"`
+	FixedUsageContext               *UsageContext                 `bson:"fixedUsageContext,omitempty" json:"fixedUsageContext,omitempty"`
+	FixedDosage                     *Dosage                       `bson:"fixedDosage,omitempty" json:"fixedDosage,omitempty"`
+	FixedMeta                       *Meta                         `bson:"fixedMeta,omitempty" json:"fixedMeta,omitempty"`
+	PatternBase64Binary             *string                       `bson:"patternBase64Binary,omitempty" json:"patternBase64Binary,omitempty"`
+	PatternBase64BinaryElement      *Element                      `bson:"_patternBase64Binary,omitempty" json:"_patternBase64Binary,omitempty"`
+	PatternBoolean                  *bool                         `bson:"patternBoolean,omitempty" json:"patternBoolean,omitempty"`
+	PatternBooleanElement           *Element                      `bson:"_patternBoolean,omitempty" json:"_patternBoolean,omitempty"`
+	PatternCanonical                *string                       `bson:"patternCanonical,omitempty" json:"patternCanonical,omitempty"`
+	PatternCanonicalElement         *Element                      `bson:"_patternCanonical,omitempty" json:"_patternCanonical,omitempty"`
+	PatternCode                     *string                       `bson:"patternCode,omitempty" json:"patternCode,omitempty"`
+	PatternCodeElement              *Element                      `bson:"_patternCode,omitempty" json:"_patternCode,omitempty"`
+	PatternDate                     *DateTime                     `bson:"patternDate,omitempty" json:"patternDate,omitempty"`
+	PatternDateElement              *Element                      `bson:"_patternDate,omitempty" json:"_patternDate,omitempty"`
+	PatternDateTime                 *DateTime                     `bson:"patternDateTime,omitempty" json:"patternDateTime,omitempty"`
+	PatternDateTimeElement          *Element                      `bson:"_patternDateTime,omitempty" json:"_patternDateTime,omitempty"`
+	PatternDecimal                  *Decimal                      `bson:"patternDecimal,omitempty" json:"patternDecimal,omitempty"`
+	PatternDecimalElement           *Element                      `bson:"_patternDecimal,omitempty" json:"_patternDecimal,omitempty"`
+	PatternId                       *string                       `bson:"patternId,omitempty" json:"patternId,omitempty"`
+	PatternIdElement                *Element                      `bson:"_patternId,omitempty" json:"_patternId,omitempty"`
+	PatternInstant                  *Instant                      `bson:"patternInstant,omitempty" json:"patternInstant,omitempty"`
+	PatternInstantElement           *Element                      `bson:"_patternInstant,omitempty" json:"_patternInstant,omitempty"`
+	PatternInteger                  *int                          `bson:"patternInteger,omitempty" json:"patternInteger,omitempty"`
+	PatternIntegerElement           *Element                      `bson:"_patternInteger,omitempty" json:"_patternInteger,omitempty"`
+	PatternMarkdown                 *string                       `bson:"patternMarkdown,omitempty" json:"patternMarkdown,omitempty"`
+	PatternMarkdownElement          *Element                      `bson:"_patternMarkdown,omitempty" json:"_patternMarkdown,omitempty"`
+	PatternOid                      *string                       `bson:"patternOid,omitempty" json:"patternOid,omitempty"`
+	PatternOidElement               *Element                      `bson:"_patternOid,omitempty" json:"_patternOid,omitempty"`
+	PatternPositiveInt              *int                          `bson:"patternPositiveInt,omitempty" json:"patternPositiveInt,omitempty"`
+	PatternPositiveIntElement       *Element                      `bson:"_patternPositiveInt,omitempty" json:"_patternPositiveInt,omitempty"`
+	PatternString                   *string                       `bson:"patternString,omitempty" json:"patternString,omitempty"`
+	PatternStringElement            *Element                      `bson:"_patternString,omitempty" json:"_patternString,omitempty"`
+	PatternTime                     *Time                         `bson:"patternTime,omitempty" json:"patternTime,omitempty"`
+	PatternTimeElement              *Element                      `bson:"_patternTime,omitempty" json:"_patternTime,omitempty"`
+	PatternUnsignedInt              *int                          `bson:"patternUnsignedInt,omitempty" json:"patternUnsignedInt,omitempty"`
+	PatternUnsignedIntElement       *Element                      `bson:"_patternUnsignedInt,omitempty" json:"_patternUnsignedInt,omitempty"`
+	PatternUri                      *string                       `bson:"patternUri,omitempty" json:"patternUri,omitempty"`
+	PatternUriElement               *Element                      `bson:"_patternUri,omitempty" json:"_patternUri,omitempty"`
+	PatternUrl                      *string                       `bson:"patternUrl,omitempty" json:"patternUrl,omitempty"`
+	PatternUrlElement               *Element                      `bson:"_patternUrl,omitempty" json:"_patternUrl,omitempty"`
+	PatternUuid                     *string                       `bson:"patternUuid,omitempty" json:"patternUuid,omitempty"`
+	PatternUuidElement              *Element                      `bson:"_patternUuid,omitempty" json:"_patternUuid,omitempty"`
+	PatternAddress                  *Address                      `bson:"patternAddress,omitempty" json:"patternAddress,omitempty"`
+	PatternAge                      *Age                          `bson:"patternAge,omitempty" json:"patternAge,omitempty"`
+	PatternAnnotation               *Annotation                   `bson:"patternAnnotation,omitempty" json:"patternAnnotation,omitempty"`
+	PatternAttachment               *Attachment                   `bson:"patternAttachment,omitempty" json:"patternAttachment,omitempty"`
+	PatternCodeableConcept          *CodeableConcept              `bson:"patternCodeableConcept,omitempty" json:"patternCodeableConcept,omitempty"`
+	PatternCoding                   *Coding                       `bson:"patternCoding,omitempty" json:"patternCoding,omitempty"`
+	PatternContactPoint             *ContactPoint                 `bson:"patternContactPoint,omitempty" json:"patternContactPoint,omitempty"`
+	PatternCount                    *Count                        `bson:"patternCount,omitempty" json:"patternCount,omitempty"`
+	PatternDistance                 *Distance                     `bson:"patternDistance,omitempty" json:"patternDistance,omitempty"`
+	PatternDuration                 *Duration                     `bson:"patternDuration,omitempty" json:"patternDuration,omitempty"`
+	PatternHumanName                *HumanName                    `bson:"patternHumanName,omitempty" json:"patternHumanName,omitempty"`
+	PatternIdentifier               *Identifier                   `bson:"patternIdentifier,omitempty" json:"patternIdentifier,omitempty"`
+	PatternMoney                    *Money                        `bson:"patternMoney,omitempty" json:"patternMoney,omitempty"`
+	PatternPeriod                   *Period                       `bson:"patternPeriod,omitempty" json:"patternPeriod,omitempty"`
+	PatternQuantity                 *Quantity                     `bson:"patternQuantity,omitempty" json:"patternQuantity,omitempty"`
+	PatternRange                    *Range                        `bson:"patternRange,omitempty" json:"patternRange,omitempty"`
+	PatternRatio                    *Ratio                        `bson:"patternRatio,omitempty" json:"patternRatio,omitempty"`
+	PatternReference                *Reference                    `bson:"patternReference,omitempty" json:"patternReference,omitempty"`
+	PatternSampledData              *SampledData                  `bson:"patternSampledData,omitempty" json:"patternSampledData,omitempty"`
+	PatternSignature                *Signature                    `bson:"patternSignature,omitempty" json:"patternSignature,omitempty"`
+	PatternTiming                   *Timing                       `bson:"patternTiming,omitempty" json:"patternTiming,omitempty"`
+	PatternContactDetail            *ContactDetail                `bson:"patternContactDetail,omitempty" json:"patternContactDetail,omitempty"`
+	PatternContributor              *Contributor                  `bson:"patternContributor,omitempty" json:"patternContributor,omitempty"`
+	PatternDataRequirement          *DataRequirement              `bson:"patternDataRequirement,omitempty" json:"patternDataRequirement,omitempty"`
+	PatternExpression               *Expression                   `bson:"patternExpression,omitempty" json:"patternExpression,omitempty"`
+	PatternParameterDefinition      *ParameterDefinition          `bson:"patternParameterDefinition,omitempty" json:"patternParameterDefinition,omitempty"`
+	PatternRelatedArtifact          *RelatedArtifact              `bson:"patternRelatedArtifact,omitempty" json:"patternRelatedArtifact,omitempty"`
+	PatternTriggerDefinition        *TriggerDefinition            `bson:"patternTriggerDefinition,omitempty" json:"patternTriggerDefinition,omitempty"`
+	PatternUsageContext             *UsageContext                 `bson:"patternUsageContext,omitempty" json:"patternUsageContext,omitempty"`
+	PatternDosage                   *Dosage                       `bson:"patternDosage,omitempty" json:"patternDosage,omitempty"`
+	PatternMeta                     *Meta                         `bson:"patternMeta,omitempty" json:"patternMeta,omitempty"`
+	Example                         []ElementDefinitionExample    `bson:"example,omitempty" json:"example,omitempty"`
+	MinValueDate                    *DateTime                     `bson:"minValueDate,omitempty" json:"minValueDate,omitempty"`
+	MinValueDateElement             *Element                      `bson:"_minValueDate,omitempty" json:"_minValueDate,omitempty"`
+	MinValueDateTime                *DateTime                     `bson:"minValueDateTime,omitempty" json:"minValueDateTime,omitempty"`
+	MinValueDateTimeElement         *Element                      `bson:"_minValueDateTime,omitempty" json:"_minValueDateTime,omitempty"`
+	MinValueInstant                 *Instant                      `bson:"minValueInstant,omitempty" json:"minValueInstant,omitempty"`
+	MinValueInstantElement          *Element                      `bson:"_minValueInstant,omitempty" json:"_minValueInstant,omitempty"`
+	MinValueTime                    *Time                         `bson:"minValueTime,omitempty" json:"minValueTime,omitempty"`
+	MinValueTimeElement             *Element                      `bson:"_minValueTime,omitempty" json:"_minValueTime,omitempty"`
+	MinValueDecimal                 *Decimal                      `bson:"minValueDecimal,omitempty" json:"minValueDecimal,omitempty"`
+	MinValueDecimalElement          *Element                      `bson:"_minValueDecimal,omitempty" json:"_minValueDecimal,omitempty"`
+	MinValueInteger                 *int                          `bson:"minValueInteger,omitempty" json:"minValueInteger,omitempty"`
+	MinValueIntegerElement          *Element                      `bson:"_minValueInteger,omitempty" json:"_minValueInteger,omitempty"`
+	MinValuePositiveInt             *int                          `bson:"minValuePositiveInt,omitempty" json:"minValuePositiveInt,omitempty"`
+	MinValuePositiveIntElement      *Element                      `bson:"_minValuePositiveInt,omitempty" json:"_minValuePositiveInt,omitempty"`
+	MinValueUnsignedInt             *int                          `bson:"minValueUnsignedInt,omitempty" json:"minValueUnsignedInt,omitempty"`
+	MinValueUnsignedIntElement      *Element                      `bson:"_minValueUnsignedInt,omitempty" json:"_minValueUnsignedInt,omitempty"`
+	MinValueQuantity                *Quantity                     `bson:"minValueQuantity,omitempty" json:"minValueQuantity,omitempty"`
+	MaxValueDate                    *DateTime                     `bson:"maxValueDate,omitempty" json:"maxValueDate,omitempty"`
+	MaxValueDateElement             *Element                      `bson:"_maxValueDate,omitempty" json:"_maxValueDate,omitempty"`
+	MaxValueDateTime                *DateTime                     `bson:"maxValueDateTime,omitempty" json:"maxValueDateTime,omitempty"`
+	MaxValueDateTimeElement         *Element                      `bson:"_maxValueDateTime,omitempty" json:"_maxValueDateTime,omitempty"`
+	MaxValueInstant                 *Instant                      `bson:"maxValueInstant,omitempty" json:"maxValueInstant,omitempty"`
+	MaxValueInstantElement          *Element                      `bson:"_maxValueInstant,omitempty" json:"_maxValueInstant,omitempty"`
+	MaxValueTime                    *Time                         `bson:"maxValueTime,omitempty" json:"maxValueTime,omitempty"`
+	MaxValueTimeElement             *Element                      `bson:"_maxValueTime,omitempty" json:"_maxValueTime,omitempty"`
+	MaxValueDecimal                 *Decimal                      `bson:"maxValueDecimal,omitempty" json:"maxValueDecimal,omitempty"`
+	MaxValueDecimalElement          *Element                      `bson:"_maxValueDecimal,omitempty" json:"_maxValueDecimal,omitempty"`
+	MaxValueInteger                 *int                          `bson:"maxValueInteger,omitempty" json:"maxValueInteger,omitempty"`
+	MaxValueIntegerElement          *Element                      `bson:"_maxValueInteger,omitempty" json:"_maxValueInteger,omitempty"`
+	MaxValuePositiveInt             *int                          `bson:"maxValuePositiveInt,omitempty" json:"maxValuePositiveInt,omitempty"`
+	MaxValuePositiveIntElement      *Element                      `bson:"_maxValuePositiveInt,omitempty" json:"_maxValuePositiveInt,omitempty"`
+	MaxValueUnsignedInt             *int                          `bson:"maxValueUnsignedInt,omitempty" json:"maxValueUnsignedInt,omitempty"`
+	MaxValueUnsignedIntElement      *Element                      `bson:"_maxValueUnsignedInt,omitempty" json:"_maxValueUnsignedInt,omitempty"`
+	MaxValueQuantity                *Quantity                     `bson:"maxValueQuantity,omitempty" json:"maxValueQuantity,omitempty"`
+	MaxLength                       *int                          `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
+	MaxLengthElement                *Element                      `bson:"_maxLength,omitempty" json:"_maxLength,omitempty"`
+	Condition                       []*string                     `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement                []*Element                    `bson:"_condition,omitempty" json:"_condition,omitempty"`
+	Constraint                      []ElementDefinitionConstraint `bson:"constraint,omitempty" json:"constraint,omitempty"`
+	MustSupport                     *bool                         `bson:"mustSupport,omitempty" json:"mustSupport,omitempty"`
+	MustSupportElement              *Element                      `bson:"_mustSupport,omitempty" json:"_mustSupport,omitempty"`
+	IsModifier                      *bool                         `bson:"isModifier,omitempty" json:"isModifier,omitempty"`
+	IsModifierElement               *Element                      `bson:"_isModifier,omitempty" json:"_isModifier,omitempty"`
+	IsModifierReason                *string                       `bson:"isModifierReason,omitempty" json:"isModifierReason,omitempty"`
+	IsModifierReasonElement         *Element                      `bson:"_isModifierReason,omitempty" json:"_isModifierReason,omitempty"`
+	IsSummary                       *bool                         `bson:"isSummary,omitempty" json:"isSummary,omitempty"`
+	IsSummaryElement                *Element                      `bson:"_isSummary,omitempty" json:"_isSummary,omitempty"`
+	Binding                         *ElementDefinitionBinding     `bson:"binding,omitempty" json:"binding,omitempty"`
+	Mapping                         []ElementDefinitionMapping    `bson:"mapping,omitempty" json:"mapping,omitempty"`
 }
 type ElementDefinitionSlicing struct {
-	ID            *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	Discriminator []ElementDefinitionSlicingDiscriminator `bson:"discriminator,omitempty" json:"discriminator,omitempty"`
-	Description   *string                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Ordered       *bool                                   `bson:"ordered,omitempty" json:"ordered,omitempty"`
-	Rules         SlicingRules                            `bson:"rules" json:"rules"`
+	ID                 *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	Discriminator      []ElementDefinitionSlicingDiscriminator `bson:"discriminator,omitempty" json:"discriminator,omitempty"`
+	Description        *string                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                `bson:"_description,omitempty" json:"_description,omitempty"`
+	Ordered            *bool                                   `bson:"ordered,omitempty" json:"ordered,omitempty"`
+	OrderedElement     *Element                                `bson:"_ordered,omitempty" json:"_ordered,omitempty"`
+	Rules              SlicingRules                            `bson:"rules" json:"rules"`
+	RulesElement       *Element                                `bson:"_rules,omitempty" json:"_rules,omitempty"`
 }
 type ElementDefinitionSlicingDiscriminator struct {
-	ID        *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type      DiscriminatorType `bson:"type" json:"type"`
-	Path      string            `bson:"path" json:"path"`
+	ID          *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type        DiscriminatorType `bson:"type" json:"type"`
+	TypeElement *Element          `bson:"_type,omitempty" json:"_type,omitempty"`
+	Path        string            `bson:"path" json:"path"`
+	PathElement *Element          `bson:"_path,omitempty" json:"_path,omitempty"`
 }
 type ElementDefinitionBase struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path      string      `bson:"path" json:"path"`
-	Min       int         `bson:"min" json:"min"`
-	Max       string      `bson:"max" json:"max"`
+	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path        string      `bson:"path" json:"path"`
+	PathElement *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	Min         int         `bson:"min" json:"min"`
+	MinElement  *Element    `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max         string      `bson:"max" json:"max"`
+	MaxElement  *Element    `bson:"_max,omitempty" json:"_max,omitempty"`
 }
 type ElementDefinitionType struct {
-	ID            *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	Code          string                 `bson:"code" json:"code"`
-	Profile       []string               `bson:"profile,omitempty" json:"profile,omitempty"`
-	TargetProfile []string               `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
-	Aggregation   []AggregationMode      `bson:"aggregation,omitempty" json:"aggregation,omitempty"`
-	Versioning    *ReferenceVersionRules `bson:"versioning,omitempty" json:"versioning,omitempty"`
+	ID                   *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	Code                 string                 `bson:"code" json:"code"`
+	CodeElement          *Element               `bson:"_code,omitempty" json:"_code,omitempty"`
+	Profile              []*string              `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement       []*Element             `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	TargetProfile        []*string              `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
+	TargetProfileElement []*Element             `bson:"_targetProfile,omitempty" json:"_targetProfile,omitempty"`
+	Aggregation          []*AggregationMode     `bson:"aggregation,omitempty" json:"aggregation,omitempty"`
+	AggregationElement   []*Element             `bson:"_aggregation,omitempty" json:"_aggregation,omitempty"`
+	Versioning           *ReferenceVersionRules `bson:"versioning,omitempty" json:"versioning,omitempty"`
+	VersioningElement    *Element               `bson:"_versioning,omitempty" json:"_versioning,omitempty"`
+}
+
+// UnmarshalJSON unmarshals the ElementDefinitionType and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ElementDefinitionType) UnmarshalJSON(b []byte) error {
+	type other ElementDefinitionType
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ElementDefinition.type.profile", len(r.Profile), len(r.ProfileElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ElementDefinition.type.targetProfile", len(r.TargetProfile), len(r.TargetProfileElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ElementDefinition.type.aggregation", len(r.Aggregation), len(r.AggregationElement)); err != nil {
+		return err
+	}
+	return nil
 }
+
 type ElementDefinitionExample struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Label     string      `bson:"label" json:"label"`
+	ID                       *string              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	Label                    string               `bson:"label" json:"label"`
+	LabelElement             *Element             `bson:"_label,omitempty" json:"_label,omitempty"`
+	ValueBase64Binary        *string              `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element             `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element             `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string              `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element             `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string              `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element             `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *DateTime            `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element             `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *DateTime            `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element             `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *Decimal             `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element             `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string              `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element             `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *Instant             `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element             `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                 `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element             `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string              `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element             `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string              `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element             `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                 `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element             `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string              `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element             `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *Time                `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element             `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                 `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element             `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string              `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element             `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string              `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element             `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string              `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element             `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address             `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                 `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation          `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment          `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept     `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding              `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint        `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count               `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance            `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration            `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName           `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier          `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period              `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range               `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio               `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference           `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData         `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature           `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing              `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail       `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor         `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement     `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression          `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact     `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition   `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext        `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage              `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+}
+
+// Value returns ElementDefinition.example.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *ElementDefinitionExample) Value() interface{} {
+	switch {
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueCanonical != nil:
+		return r.ValueCanonical
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueId != nil:
+		return r.ValueId
+	case r.ValueInstant != nil:
+		return r.ValueInstant
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueMarkdown != nil:
+		return r.ValueMarkdown
+	case r.ValueOid != nil:
+		return r.ValueOid
+	case r.ValuePositiveInt != nil:
+		return r.ValuePositiveInt
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueUnsignedInt != nil:
+		return r.ValueUnsignedInt
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueUrl != nil:
+		return r.ValueUrl
+	case r.ValueUuid != nil:
+		return r.ValueUuid
+	case r.ValueAddress != nil:
+		return r.ValueAddress
+	case r.ValueAge != nil:
+		return r.ValueAge
+	case r.ValueAnnotation != nil:
+		return r.ValueAnnotation
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueContactPoint != nil:
+		return r.ValueContactPoint
+	case r.ValueCount != nil:
+		return r.ValueCount
+	case r.ValueDistance != nil:
+		return r.ValueDistance
+	case r.ValueDuration != nil:
+		return r.ValueDuration
+	case r.ValueHumanName != nil:
+		return r.ValueHumanName
+	case r.ValueIdentifier != nil:
+		return r.ValueIdentifier
+	case r.ValueMoney != nil:
+		return r.ValueMoney
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueReference != nil:
+		return r.ValueReference
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueSignature != nil:
+		return r.ValueSignature
+	case r.ValueTiming != nil:
+		return r.ValueTiming
+	case r.ValueContactDetail != nil:
+		return r.ValueContactDetail
+	case r.ValueContributor != nil:
+		return r.ValueContributor
+	case r.ValueDataRequirement != nil:
+		return r.ValueDataRequirement
+	case r.ValueExpression != nil:
+		return r.ValueExpression
+	case r.ValueParameterDefinition != nil:
+		return r.ValueParameterDefinition
+	case r.ValueRelatedArtifact != nil:
+		return r.ValueRelatedArtifact
+	case r.ValueTriggerDefinition != nil:
+		return r.ValueTriggerDefinition
+	case r.ValueUsageContext != nil:
+		return r.ValueUsageContext
+	case r.ValueDosage != nil:
+		return r.ValueDosage
+	case r.ValueMeta != nil:
+		return r.ValueMeta
+	}
+	return nil
+}
+
+// SetValueBase64Binary sets ElementDefinition.example.value[x] to the base64Binary and clears the other types.
+func (r *ElementDefinitionExample) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+
+// SetValueBoolean sets ElementDefinition.example.value[x] to the boolean and clears the other types.
+func (r *ElementDefinitionExample) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueCanonical sets ElementDefinition.example.value[x] to the canonical and clears the other types.
+func (r *ElementDefinitionExample) SetValueCanonical(v string) {
+	r.clearValue()
+	r.ValueCanonical = &v
+}
+
+// SetValueCode sets ElementDefinition.example.value[x] to the code and clears the other types.
+func (r *ElementDefinitionExample) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
+}
+
+// SetValueDate sets ElementDefinition.example.value[x] to the date and clears the other types.
+func (r *ElementDefinitionExample) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets ElementDefinition.example.value[x] to the dateTime and clears the other types.
+func (r *ElementDefinitionExample) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueDecimal sets ElementDefinition.example.value[x] to the decimal and clears the other types.
+func (r *ElementDefinitionExample) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueId sets ElementDefinition.example.value[x] to the id and clears the other types.
+func (r *ElementDefinitionExample) SetValueId(v string) {
+	r.clearValue()
+	r.ValueId = &v
+}
+
+// SetValueInstant sets ElementDefinition.example.value[x] to the instant and clears the other types.
+func (r *ElementDefinitionExample) SetValueInstant(v Instant) {
+	r.clearValue()
+	r.ValueInstant = &v
+}
+
+// SetValueInteger sets ElementDefinition.example.value[x] to the integer and clears the other types.
+func (r *ElementDefinitionExample) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueMarkdown sets ElementDefinition.example.value[x] to the markdown and clears the other types.
+func (r *ElementDefinitionExample) SetValueMarkdown(v string) {
+	r.clearValue()
+	r.ValueMarkdown = &v
+}
+
+// SetValueOid sets ElementDefinition.example.value[x] to the oid and clears the other types.
+func (r *ElementDefinitionExample) SetValueOid(v string) {
+	r.clearValue()
+	r.ValueOid = &v
+}
+
+// SetValuePositiveInt sets ElementDefinition.example.value[x] to the positiveInt and clears the other types.
+func (r *ElementDefinitionExample) SetValuePositiveInt(v int) {
+	r.clearValue()
+	r.ValuePositiveInt = &v
+}
+
+// SetValueString sets ElementDefinition.example.value[x] to the string and clears the other types.
+func (r *ElementDefinitionExample) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueTime sets ElementDefinition.example.value[x] to the time and clears the other types.
+func (r *ElementDefinitionExample) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueUnsignedInt sets ElementDefinition.example.value[x] to the unsignedInt and clears the other types.
+func (r *ElementDefinitionExample) SetValueUnsignedInt(v int) {
+	r.clearValue()
+	r.ValueUnsignedInt = &v
+}
+
+// SetValueUri sets ElementDefinition.example.value[x] to the uri and clears the other types.
+func (r *ElementDefinitionExample) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueUrl sets ElementDefinition.example.value[x] to the url and clears the other types.
+func (r *ElementDefinitionExample) SetValueUrl(v string) {
+	r.clearValue()
+	r.ValueUrl = &v
+}
+
+// SetValueUuid sets ElementDefinition.example.value[x] to the uuid and clears the other types.
+func (r *ElementDefinitionExample) SetValueUuid(v string) {
+	r.clearValue()
+	r.ValueUuid = &v
+}
+
+// SetValueAddress sets ElementDefinition.example.value[x] to the Address and clears the other types.
+func (r *ElementDefinitionExample) SetValueAddress(v Address) {
+	r.clearValue()
+	r.ValueAddress = &v
+}
+
+// SetValueAge sets ElementDefinition.example.value[x] to the Age and clears the other types.
+func (r *ElementDefinitionExample) SetValueAge(v Age) {
+	r.clearValue()
+	r.ValueAge = &v
+}
+
+// SetValueAnnotation sets ElementDefinition.example.value[x] to the Annotation and clears the other types.
+func (r *ElementDefinitionExample) SetValueAnnotation(v Annotation) {
+	r.clearValue()
+	r.ValueAnnotation = &v
+}
+
+// SetValueAttachment sets ElementDefinition.example.value[x] to the Attachment and clears the other types.
+func (r *ElementDefinitionExample) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCodeableConcept sets ElementDefinition.example.value[x] to the CodeableConcept and clears the other types.
+func (r *ElementDefinitionExample) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueCoding sets ElementDefinition.example.value[x] to the Coding and clears the other types.
+func (r *ElementDefinitionExample) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueContactPoint sets ElementDefinition.example.value[x] to the ContactPoint and clears the other types.
+func (r *ElementDefinitionExample) SetValueContactPoint(v ContactPoint) {
+	r.clearValue()
+	r.ValueContactPoint = &v
 }
+
+// SetValueCount sets ElementDefinition.example.value[x] to the Count and clears the other types.
+func (r *ElementDefinitionExample) SetValueCount(v Count) {
+	r.clearValue()
+	r.ValueCount = &v
+}
+
+// SetValueDistance sets ElementDefinition.example.value[x] to the Distance and clears the other types.
+func (r *ElementDefinitionExample) SetValueDistance(v Distance) {
+	r.clearValue()
+	r.ValueDistance = &v
+}
+
+// SetValueDuration sets ElementDefinition.example.value[x] to the Duration and clears the other types.
+func (r *ElementDefinitionExample) SetValueDuration(v Duration) {
+	r.clearValue()
+	r.ValueDuration = &v
+}
+
+// SetValueHumanName sets ElementDefinition.example.value[x] to the HumanName and clears the other types.
+func (r *ElementDefinitionExample) SetValueHumanName(v HumanName) {
+	r.clearValue()
+	r.ValueHumanName = &v
+}
+
+// SetValueIdentifier sets ElementDefinition.example.value[x] to the Identifier and clears the other types.
+func (r *ElementDefinitionExample) SetValueIdentifier(v Identifier) {
+	r.clearValue()
+	r.ValueIdentifier = &v
+}
+
+// SetValueMoney sets ElementDefinition.example.value[x] to the Money and clears the other types.
+func (r *ElementDefinitionExample) SetValueMoney(v Money) {
+	r.clearValue()
+	r.ValueMoney = &v
+}
+
+// SetValuePeriod sets ElementDefinition.example.value[x] to the Period and clears the other types.
+func (r *ElementDefinitionExample) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+
+// SetValueQuantity sets ElementDefinition.example.value[x] to the Quantity and clears the other types.
+func (r *ElementDefinitionExample) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets ElementDefinition.example.value[x] to the Range and clears the other types.
+func (r *ElementDefinitionExample) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueRatio sets ElementDefinition.example.value[x] to the Ratio and clears the other types.
+func (r *ElementDefinitionExample) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
+
+// SetValueReference sets ElementDefinition.example.value[x] to the Reference and clears the other types.
+func (r *ElementDefinitionExample) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+
+// SetValueSampledData sets ElementDefinition.example.value[x] to the SampledData and clears the other types.
+func (r *ElementDefinitionExample) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
+
+// SetValueSignature sets ElementDefinition.example.value[x] to the Signature and clears the other types.
+func (r *ElementDefinitionExample) SetValueSignature(v Signature) {
+	r.clearValue()
+	r.ValueSignature = &v
+}
+
+// SetValueTiming sets ElementDefinition.example.value[x] to the Timing and clears the other types.
+func (r *ElementDefinitionExample) SetValueTiming(v Timing) {
+	r.clearValue()
+	r.ValueTiming = &v
+}
+
+// SetValueContactDetail sets ElementDefinition.example.value[x] to the ContactDetail and clears the other types.
+func (r *ElementDefinitionExample) SetValueContactDetail(v ContactDetail) {
+	r.clearValue()
+	r.ValueContactDetail = &v
+}
+
+// SetValueContributor sets ElementDefinition.example.value[x] to the Contributor and clears the other types.
+func (r *ElementDefinitionExample) SetValueContributor(v Contributor) {
+	r.clearValue()
+	r.ValueContributor = &v
+}
+
+// SetValueDataRequirement sets ElementDefinition.example.value[x] to the DataRequirement and clears the other types.
+func (r *ElementDefinitionExample) SetValueDataRequirement(v DataRequirement) {
+	r.clearValue()
+	r.ValueDataRequirement = &v
+}
+
+// SetValueExpression sets ElementDefinition.example.value[x] to the Expression and clears the other types.
+func (r *ElementDefinitionExample) SetValueExpression(v Expression) {
+	r.clearValue()
+	r.ValueExpression = &v
+}
+
+// SetValueParameterDefinition sets ElementDefinition.example.value[x] to the ParameterDefinition and clears the other types.
+func (r *ElementDefinitionExample) SetValueParameterDefinition(v ParameterDefinition) {
+	r.clearValue()
+	r.ValueParameterDefinition = &v
+}
+
+// SetValueRelatedArtifact sets ElementDefinition.example.value[x] to the RelatedArtifact and clears the other types.
+func (r *ElementDefinitionExample) SetValueRelatedArtifact(v RelatedArtifact) {
+	r.clearValue()
+	r.ValueRelatedArtifact = &v
+}
+
+// SetValueTriggerDefinition sets ElementDefinition.example.value[x] to the TriggerDefinition and clears the other types.
+func (r *ElementDefinitionExample) SetValueTriggerDefinition(v TriggerDefinition) {
+	r.clearValue()
+	r.ValueTriggerDefinition = &v
+}
+
+// SetValueUsageContext sets ElementDefinition.example.value[x] to the UsageContext and clears the other types.
+func (r *ElementDefinitionExample) SetValueUsageContext(v UsageContext) {
+	r.clearValue()
+	r.ValueUsageContext = &v
+}
+
+// SetValueDosage sets ElementDefinition.example.value[x] to the Dosage and clears the other types.
+func (r *ElementDefinitionExample) SetValueDosage(v Dosage) {
+	r.clearValue()
+	r.ValueDosage = &v
+}
+
+// SetValueMeta sets ElementDefinition.example.value[x] to the Meta and clears the other types.
+func (r *ElementDefinitionExample) SetValueMeta(v Meta) {
+	r.clearValue()
+	r.ValueMeta = &v
+}
+func (r *ElementDefinitionExample) clearValue() {
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueCanonical = nil
+	r.ValueCanonicalElement = nil
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueId = nil
+	r.ValueIdElement = nil
+	r.ValueInstant = nil
+	r.ValueInstantElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueMarkdown = nil
+	r.ValueMarkdownElement = nil
+	r.ValueOid = nil
+	r.ValueOidElement = nil
+	r.ValuePositiveInt = nil
+	r.ValuePositiveIntElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUnsignedIntElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueUrl = nil
+	r.ValueUrlElement = nil
+	r.ValueUuid = nil
+	r.ValueUuidElement = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+}
+
+// UnmarshalJSON unmarshals the ElementDefinitionExample and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ElementDefinitionExample) UnmarshalJSON(b []byte) error {
+	type other ElementDefinitionExample
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ElementDefinition.example.value[x]",
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueCanonical != nil || r.ValueCanonicalElement != nil,
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueId != nil || r.ValueIdElement != nil,
+		r.ValueInstant != nil || r.ValueInstantElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueMarkdown != nil || r.ValueMarkdownElement != nil,
+		r.ValueOid != nil || r.ValueOidElement != nil,
+		r.ValuePositiveInt != nil || r.ValuePositiveIntElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueUnsignedInt != nil || r.ValueUnsignedIntElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueUrl != nil || r.ValueUrlElement != nil,
+		r.ValueUuid != nil || r.ValueUuidElement != nil,
+		r.ValueAddress != nil,
+		r.ValueAge != nil,
+		r.ValueAnnotation != nil,
+		r.ValueAttachment != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueCoding != nil,
+		r.ValueContactPoint != nil,
+		r.ValueCount != nil,
+		r.ValueDistance != nil,
+		r.ValueDuration != nil,
+		r.ValueHumanName != nil,
+		r.ValueIdentifier != nil,
+		r.ValueMoney != nil,
+		r.ValuePeriod != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueReference != nil,
+		r.ValueSampledData != nil,
+		r.ValueSignature != nil,
+		r.ValueTiming != nil,
+		r.ValueContactDetail != nil,
+		r.ValueContributor != nil,
+		r.ValueDataRequirement != nil,
+		r.ValueExpression != nil,
+		r.ValueParameterDefinition != nil,
+		r.ValueRelatedArtifact != nil,
+		r.ValueTriggerDefinition != nil,
+		r.ValueUsageContext != nil,
+		r.ValueDosage != nil,
+		r.ValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ElementDefinitionConstraint struct {
-	ID           *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	Key          string             `bson:"key" json:"key"`
-	Requirements *string            `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Severity     ConstraintSeverity `bson:"severity" json:"severity"`
-	Human        string             `bson:"human" json:"human"`
-	Expression   *string            `bson:"expression,omitempty" json:"expression,omitempty"`
-	Xpath        *string            `bson:"xpath,omitempty" json:"xpath,omitempty"`
-	Source       *string            `bson:"source,omitempty" json:"source,omitempty"`
+	ID                  *string            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	Key                 string             `bson:"key" json:"key"`
+	KeyElement          *Element           `bson:"_key,omitempty" json:"_key,omitempty"`
+	Requirements        *string            `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	RequirementsElement *Element           `bson:"_requirements,omitempty" json:"_requirements,omitempty"`
+	Severity            ConstraintSeverity `bson:"severity" json:"severity"`
+	SeverityElement     *Element           `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	Human               string             `bson:"human" json:"human"`
+	HumanElement        *Element           `bson:"_human,omitempty" json:"_human,omitempty"`
+	Expression          *string            `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement   *Element           `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Xpath               *string            `bson:"xpath,omitempty" json:"xpath,omitempty"`
+	XpathElement        *Element           `bson:"_xpath,omitempty" json:"_xpath,omitempty"`
+	Source              *string            `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement       *Element           `bson:"_source,omitempty" json:"_source,omitempty"`
 }
 type ElementDefinitionBinding struct {
-	ID          *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	Strength    BindingStrength `bson:"strength" json:"strength"`
-	Description *string         `bson:"description,omitempty" json:"description,omitempty"`
-	ValueSet    *string         `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ID                 *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	Strength           BindingStrength `bson:"strength" json:"strength"`
+	StrengthElement    *Element        `bson:"_strength,omitempty" json:"_strength,omitempty"`
+	Description        *string         `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element        `bson:"_description,omitempty" json:"_description,omitempty"`
+	ValueSet           *string         `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement    *Element        `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
 }
 type ElementDefinitionMapping struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Identity  string      `bson:"identity" json:"identity"`
-	Language  *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Map       string      `bson:"map" json:"map"`
-	Comment   *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID              *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension       []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Identity        string      `bson:"identity" json:"identity"`
+	IdentityElement *Element    `bson:"_identity,omitempty" json:"_identity,omitempty"`
+	Language        *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Map             string      `bson:"map" json:"map"`
+	MapElement      *Element    `bson:"_map,omitempty" json:"_map,omitempty"`
+	Comment         *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement  *Element    `bson:"_comment,omitempty" json:"_comment,omitempty"`
+}
+
+// DefaultValue returns ElementDefinition.defaultValue[x] of the set type, such as *string, or nil when it is not set.
+func (r *ElementDefinition) DefaultValue() interface{} {
+	switch {
+	case r.DefaultValueBase64Binary != nil:
+		return r.DefaultValueBase64Binary
+	case r.DefaultValueBoolean != nil:
+		return r.DefaultValueBoolean
+	case r.DefaultValueCanonical != nil:
+		return r.DefaultValueCanonical
+	case r.DefaultValueCode != nil:
+		return r.DefaultValueCode
+	case r.DefaultValueDate != nil:
+		return r.DefaultValueDate
+	case r.DefaultValueDateTime != nil:
+		return r.DefaultValueDateTime
+	case r.DefaultValueDecimal != nil:
+		return r.DefaultValueDecimal
+	case r.DefaultValueId != nil:
+		return r.DefaultValueId
+	case r.DefaultValueInstant != nil:
+		return r.DefaultValueInstant
+	case r.DefaultValueInteger != nil:
+		return r.DefaultValueInteger
+	case r.DefaultValueMarkdown != nil:
+		return r.DefaultValueMarkdown
+	case r.DefaultValueOid != nil:
+		return r.DefaultValueOid
+	case r.DefaultValuePositiveInt != nil:
+		return r.DefaultValuePositiveInt
+	case r.DefaultValueString != nil:
+		return r.DefaultValueString
+	case r.DefaultValueTime != nil:
+		return r.DefaultValueTime
+	case r.DefaultValueUnsignedInt != nil:
+		return r.DefaultValueUnsignedInt
+	case r.DefaultValueUri != nil:
+		return r.DefaultValueUri
+	case r.DefaultValueUrl != nil:
+		return r.DefaultValueUrl
+	case r.DefaultValueUuid != nil:
+		return r.DefaultValueUuid
+	case r.DefaultValueAddress != nil:
+		return r.DefaultValueAddress
+	case r.DefaultValueAge != nil:
+		return r.DefaultValueAge
+	case r.DefaultValueAnnotation != nil:
+		return r.DefaultValueAnnotation
+	case r.DefaultValueAttachment != nil:
+		return r.DefaultValueAttachment
+	case r.DefaultValueCodeableConcept != nil:
+		return r.DefaultValueCodeableConcept
+	case r.DefaultValueCoding != nil:
+		return r.DefaultValueCoding
+	case r.DefaultValueContactPoint != nil:
+		return r.DefaultValueContactPoint
+	case r.DefaultValueCount != nil:
+		return r.DefaultValueCount
+	case r.DefaultValueDistance != nil:
+		return r.DefaultValueDistance
+	case r.DefaultValueDuration != nil:
+		return r.DefaultValueDuration
+	case r.DefaultValueHumanName != nil:
+		return r.DefaultValueHumanName
+	case r.DefaultValueIdentifier != nil:
+		return r.DefaultValueIdentifier
+	case r.DefaultValueMoney != nil:
+		return r.DefaultValueMoney
+	case r.DefaultValuePeriod != nil:
+		return r.DefaultValuePeriod
+	case r.DefaultValueQuantity != nil:
+		return r.DefaultValueQuantity
+	case r.DefaultValueRange != nil:
+		return r.DefaultValueRange
+	case r.DefaultValueRatio != nil:
+		return r.DefaultValueRatio
+	case r.DefaultValueReference != nil:
+		return r.DefaultValueReference
+	case r.DefaultValueSampledData != nil:
+		return r.DefaultValueSampledData
+	case r.DefaultValueSignature != nil:
+		return r.DefaultValueSignature
+	case r.DefaultValueTiming != nil:
+		return r.DefaultValueTiming
+	case r.DefaultValueContactDetail != nil:
+		return r.DefaultValueContactDetail
+	case r.DefaultValueContributor != nil:
+		return r.DefaultValueContributor
+	case r.DefaultValueDataRequirement != nil:
+		return r.DefaultValueDataRequirement
+	case r.DefaultValueExpression != nil:
+		return r.DefaultValueExpression
+	case r.DefaultValueParameterDefinition != nil:
+		return r.DefaultValueParameterDefinition
+	case r.DefaultValueRelatedArtifact != nil:
+		return r.DefaultValueRelatedArtifact
+	case r.DefaultValueTriggerDefinition != nil:
+		return r.DefaultValueTriggerDefinition
+	case r.DefaultValueUsageContext != nil:
+		return r.DefaultValueUsageContext
+	case r.DefaultValueDosage != nil:
+		return r.DefaultValueDosage
+	case r.DefaultValueMeta != nil:
+		return r.DefaultValueMeta
+	}
+	return nil
+}
+
+// SetDefaultValueBase64Binary sets ElementDefinition.defaultValue[x] to the base64Binary and clears the other types.
+func (r *ElementDefinition) SetDefaultValueBase64Binary(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueBase64Binary = &v
+}
+
+// SetDefaultValueBoolean sets ElementDefinition.defaultValue[x] to the boolean and clears the other types.
+func (r *ElementDefinition) SetDefaultValueBoolean(v bool) {
+	r.clearDefaultValue()
+	r.DefaultValueBoolean = &v
+}
+
+// SetDefaultValueCanonical sets ElementDefinition.defaultValue[x] to the canonical and clears the other types.
+func (r *ElementDefinition) SetDefaultValueCanonical(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueCanonical = &v
+}
+
+// SetDefaultValueCode sets ElementDefinition.defaultValue[x] to the code and clears the other types.
+func (r *ElementDefinition) SetDefaultValueCode(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueCode = &v
+}
+
+// SetDefaultValueDate sets ElementDefinition.defaultValue[x] to the date and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDate(v DateTime) {
+	r.clearDefaultValue()
+	r.DefaultValueDate = &v
+}
+
+// SetDefaultValueDateTime sets ElementDefinition.defaultValue[x] to the dateTime and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDateTime(v DateTime) {
+	r.clearDefaultValue()
+	r.DefaultValueDateTime = &v
+}
+
+// SetDefaultValueDecimal sets ElementDefinition.defaultValue[x] to the decimal and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDecimal(v Decimal) {
+	r.clearDefaultValue()
+	r.DefaultValueDecimal = &v
+}
+
+// SetDefaultValueId sets ElementDefinition.defaultValue[x] to the id and clears the other types.
+func (r *ElementDefinition) SetDefaultValueId(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueId = &v
+}
+
+// SetDefaultValueInstant sets ElementDefinition.defaultValue[x] to the instant and clears the other types.
+func (r *ElementDefinition) SetDefaultValueInstant(v Instant) {
+	r.clearDefaultValue()
+	r.DefaultValueInstant = &v
+}
+
+// SetDefaultValueInteger sets ElementDefinition.defaultValue[x] to the integer and clears the other types.
+func (r *ElementDefinition) SetDefaultValueInteger(v int) {
+	r.clearDefaultValue()
+	r.DefaultValueInteger = &v
+}
+
+// SetDefaultValueMarkdown sets ElementDefinition.defaultValue[x] to the markdown and clears the other types.
+func (r *ElementDefinition) SetDefaultValueMarkdown(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueMarkdown = &v
+}
+
+// SetDefaultValueOid sets ElementDefinition.defaultValue[x] to the oid and clears the other types.
+func (r *ElementDefinition) SetDefaultValueOid(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueOid = &v
+}
+
+// SetDefaultValuePositiveInt sets ElementDefinition.defaultValue[x] to the positiveInt and clears the other types.
+func (r *ElementDefinition) SetDefaultValuePositiveInt(v int) {
+	r.clearDefaultValue()
+	r.DefaultValuePositiveInt = &v
+}
+
+// SetDefaultValueString sets ElementDefinition.defaultValue[x] to the string and clears the other types.
+func (r *ElementDefinition) SetDefaultValueString(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueString = &v
+}
+
+// SetDefaultValueTime sets ElementDefinition.defaultValue[x] to the time and clears the other types.
+func (r *ElementDefinition) SetDefaultValueTime(v Time) {
+	r.clearDefaultValue()
+	r.DefaultValueTime = &v
+}
+
+// SetDefaultValueUnsignedInt sets ElementDefinition.defaultValue[x] to the unsignedInt and clears the other types.
+func (r *ElementDefinition) SetDefaultValueUnsignedInt(v int) {
+	r.clearDefaultValue()
+	r.DefaultValueUnsignedInt = &v
+}
+
+// SetDefaultValueUri sets ElementDefinition.defaultValue[x] to the uri and clears the other types.
+func (r *ElementDefinition) SetDefaultValueUri(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueUri = &v
+}
+
+// SetDefaultValueUrl sets ElementDefinition.defaultValue[x] to the url and clears the other types.
+func (r *ElementDefinition) SetDefaultValueUrl(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueUrl = &v
+}
+
+// SetDefaultValueUuid sets ElementDefinition.defaultValue[x] to the uuid and clears the other types.
+func (r *ElementDefinition) SetDefaultValueUuid(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueUuid = &v
+}
+
+// SetDefaultValueAddress sets ElementDefinition.defaultValue[x] to the Address and clears the other types.
+func (r *ElementDefinition) SetDefaultValueAddress(v Address) {
+	r.clearDefaultValue()
+	r.DefaultValueAddress = &v
+}
+
+// SetDefaultValueAge sets ElementDefinition.defaultValue[x] to the Age and clears the other types.
+func (r *ElementDefinition) SetDefaultValueAge(v Age) {
+	r.clearDefaultValue()
+	r.DefaultValueAge = &v
+}
+
+// SetDefaultValueAnnotation sets ElementDefinition.defaultValue[x] to the Annotation and clears the other types.
+func (r *ElementDefinition) SetDefaultValueAnnotation(v Annotation) {
+	r.clearDefaultValue()
+	r.DefaultValueAnnotation = &v
+}
+
+// SetDefaultValueAttachment sets ElementDefinition.defaultValue[x] to the Attachment and clears the other types.
+func (r *ElementDefinition) SetDefaultValueAttachment(v Attachment) {
+	r.clearDefaultValue()
+	r.DefaultValueAttachment = &v
+}
+
+// SetDefaultValueCodeableConcept sets ElementDefinition.defaultValue[x] to the CodeableConcept and clears the other types.
+func (r *ElementDefinition) SetDefaultValueCodeableConcept(v CodeableConcept) {
+	r.clearDefaultValue()
+	r.DefaultValueCodeableConcept = &v
+}
+
+// SetDefaultValueCoding sets ElementDefinition.defaultValue[x] to the Coding and clears the other types.
+func (r *ElementDefinition) SetDefaultValueCoding(v Coding) {
+	r.clearDefaultValue()
+	r.DefaultValueCoding = &v
+}
+
+// SetDefaultValueContactPoint sets ElementDefinition.defaultValue[x] to the ContactPoint and clears the other types.
+func (r *ElementDefinition) SetDefaultValueContactPoint(v ContactPoint) {
+	r.clearDefaultValue()
+	r.DefaultValueContactPoint = &v
+}
+
+// SetDefaultValueCount sets ElementDefinition.defaultValue[x] to the Count and clears the other types.
+func (r *ElementDefinition) SetDefaultValueCount(v Count) {
+	r.clearDefaultValue()
+	r.DefaultValueCount = &v
+}
+
+// SetDefaultValueDistance sets ElementDefinition.defaultValue[x] to the Distance and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDistance(v Distance) {
+	r.clearDefaultValue()
+	r.DefaultValueDistance = &v
+}
+
+// SetDefaultValueDuration sets ElementDefinition.defaultValue[x] to the Duration and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDuration(v Duration) {
+	r.clearDefaultValue()
+	r.DefaultValueDuration = &v
+}
+
+// SetDefaultValueHumanName sets ElementDefinition.defaultValue[x] to the HumanName and clears the other types.
+func (r *ElementDefinition) SetDefaultValueHumanName(v HumanName) {
+	r.clearDefaultValue()
+	r.DefaultValueHumanName = &v
+}
+
+// SetDefaultValueIdentifier sets ElementDefinition.defaultValue[x] to the Identifier and clears the other types.
+func (r *ElementDefinition) SetDefaultValueIdentifier(v Identifier) {
+	r.clearDefaultValue()
+	r.DefaultValueIdentifier = &v
+}
+
+// SetDefaultValueMoney sets ElementDefinition.defaultValue[x] to the Money and clears the other types.
+func (r *ElementDefinition) SetDefaultValueMoney(v Money) {
+	r.clearDefaultValue()
+	r.DefaultValueMoney = &v
+}
+
+// SetDefaultValuePeriod sets ElementDefinition.defaultValue[x] to the Period and clears the other types.
+func (r *ElementDefinition) SetDefaultValuePeriod(v Period) {
+	r.clearDefaultValue()
+	r.DefaultValuePeriod = &v
+}
+
+// SetDefaultValueQuantity sets ElementDefinition.defaultValue[x] to the Quantity and clears the other types.
+func (r *ElementDefinition) SetDefaultValueQuantity(v Quantity) {
+	r.clearDefaultValue()
+	r.DefaultValueQuantity = &v
+}
+
+// SetDefaultValueRange sets ElementDefinition.defaultValue[x] to the Range and clears the other types.
+func (r *ElementDefinition) SetDefaultValueRange(v Range) {
+	r.clearDefaultValue()
+	r.DefaultValueRange = &v
+}
+
+// SetDefaultValueRatio sets ElementDefinition.defaultValue[x] to the Ratio and clears the other types.
+func (r *ElementDefinition) SetDefaultValueRatio(v Ratio) {
+	r.clearDefaultValue()
+	r.DefaultValueRatio = &v
+}
+
+// SetDefaultValueReference sets ElementDefinition.defaultValue[x] to the Reference and clears the other types.
+func (r *ElementDefinition) SetDefaultValueReference(v Reference) {
+	r.clearDefaultValue()
+	r.DefaultValueReference = &v
+}
+
+// SetDefaultValueSampledData sets ElementDefinition.defaultValue[x] to the SampledData and clears the other types.
+func (r *ElementDefinition) SetDefaultValueSampledData(v SampledData) {
+	r.clearDefaultValue()
+	r.DefaultValueSampledData = &v
+}
+
+// SetDefaultValueSignature sets ElementDefinition.defaultValue[x] to the Signature and clears the other types.
+func (r *ElementDefinition) SetDefaultValueSignature(v Signature) {
+	r.clearDefaultValue()
+	r.DefaultValueSignature = &v
+}
+
+// SetDefaultValueTiming sets ElementDefinition.defaultValue[x] to the Timing and clears the other types.
+func (r *ElementDefinition) SetDefaultValueTiming(v Timing) {
+	r.clearDefaultValue()
+	r.DefaultValueTiming = &v
+}
+
+// SetDefaultValueContactDetail sets ElementDefinition.defaultValue[x] to the ContactDetail and clears the other types.
+func (r *ElementDefinition) SetDefaultValueContactDetail(v ContactDetail) {
+	r.clearDefaultValue()
+	r.DefaultValueContactDetail = &v
+}
+
+// SetDefaultValueContributor sets ElementDefinition.defaultValue[x] to the Contributor and clears the other types.
+func (r *ElementDefinition) SetDefaultValueContributor(v Contributor) {
+	r.clearDefaultValue()
+	r.DefaultValueContributor = &v
+}
+
+// SetDefaultValueDataRequirement sets ElementDefinition.defaultValue[x] to the DataRequirement and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDataRequirement(v DataRequirement) {
+	r.clearDefaultValue()
+	r.DefaultValueDataRequirement = &v
+}
+
+// SetDefaultValueExpression sets ElementDefinition.defaultValue[x] to the Expression and clears the other types.
+func (r *ElementDefinition) SetDefaultValueExpression(v Expression) {
+	r.clearDefaultValue()
+	r.DefaultValueExpression = &v
+}
+
+// SetDefaultValueParameterDefinition sets ElementDefinition.defaultValue[x] to the ParameterDefinition and clears the other types.
+func (r *ElementDefinition) SetDefaultValueParameterDefinition(v ParameterDefinition) {
+	r.clearDefaultValue()
+	r.DefaultValueParameterDefinition = &v
+}
+
+// SetDefaultValueRelatedArtifact sets ElementDefinition.defaultValue[x] to the RelatedArtifact and clears the other types.
+func (r *ElementDefinition) SetDefaultValueRelatedArtifact(v RelatedArtifact) {
+	r.clearDefaultValue()
+	r.DefaultValueRelatedArtifact = &v
+}
+
+// SetDefaultValueTriggerDefinition sets ElementDefinition.defaultValue[x] to the TriggerDefinition and clears the other types.
+func (r *ElementDefinition) SetDefaultValueTriggerDefinition(v TriggerDefinition) {
+	r.clearDefaultValue()
+	r.DefaultValueTriggerDefinition = &v
+}
+
+// SetDefaultValueUsageContext sets ElementDefinition.defaultValue[x] to the UsageContext and clears the other types.
+func (r *ElementDefinition) SetDefaultValueUsageContext(v UsageContext) {
+	r.clearDefaultValue()
+	r.DefaultValueUsageContext = &v
+}
+
+// SetDefaultValueDosage sets ElementDefinition.defaultValue[x] to the Dosage and clears the other types.
+func (r *ElementDefinition) SetDefaultValueDosage(v Dosage) {
+	r.clearDefaultValue()
+	r.DefaultValueDosage = &v
+}
+
+// SetDefaultValueMeta sets ElementDefinition.defaultValue[x] to the Meta and clears the other types.
+func (r *ElementDefinition) SetDefaultValueMeta(v Meta) {
+	r.clearDefaultValue()
+	r.DefaultValueMeta = &v
+}
+func (r *ElementDefinition) clearDefaultValue() {
+	r.DefaultValueBase64Binary = nil
+	r.DefaultValueBase64BinaryElement = nil
+	r.DefaultValueBoolean = nil
+	r.DefaultValueBooleanElement = nil
+	r.DefaultValueCanonical = nil
+	r.DefaultValueCanonicalElement = nil
+	r.DefaultValueCode = nil
+	r.DefaultValueCodeElement = nil
+	r.DefaultValueDate = nil
+	r.DefaultValueDateElement = nil
+	r.DefaultValueDateTime = nil
+	r.DefaultValueDateTimeElement = nil
+	r.DefaultValueDecimal = nil
+	r.DefaultValueDecimalElement = nil
+	r.DefaultValueId = nil
+	r.DefaultValueIdElement = nil
+	r.DefaultValueInstant = nil
+	r.DefaultValueInstantElement = nil
+	r.DefaultValueInteger = nil
+	r.DefaultValueIntegerElement = nil
+	r.DefaultValueMarkdown = nil
+	r.DefaultValueMarkdownElement = nil
+	r.DefaultValueOid = nil
+	r.DefaultValueOidElement = nil
+	r.DefaultValuePositiveInt = nil
+	r.DefaultValuePositiveIntElement = nil
+	r.DefaultValueString = nil
+	r.DefaultValueStringElement = nil
+	r.DefaultValueTime = nil
+	r.DefaultValueTimeElement = nil
+	r.DefaultValueUnsignedInt = nil
+	r.DefaultValueUnsignedIntElement = nil
+	r.DefaultValueUri = nil
+	r.DefaultValueUriElement = nil
+	r.DefaultValueUrl = nil
+	r.DefaultValueUrlElement = nil
+	r.DefaultValueUuid = nil
+	r.DefaultValueUuidElement = nil
+	r.DefaultValueAddress = nil
+	r.DefaultValueAge = nil
+	r.DefaultValueAnnotation = nil
+	r.DefaultValueAttachment = nil
+	r.DefaultValueCodeableConcept = nil
+	r.DefaultValueCoding = nil
+	r.DefaultValueContactPoint = nil
+	r.DefaultValueCount = nil
+	r.DefaultValueDistance = nil
+	r.DefaultValueDuration = nil
+	r.DefaultValueHumanName = nil
+	r.DefaultValueIdentifier = nil
+	r.DefaultValueMoney = nil
+	r.DefaultValuePeriod = nil
+	r.DefaultValueQuantity = nil
+	r.DefaultValueRange = nil
+	r.DefaultValueRatio = nil
+	r.DefaultValueReference = nil
+	r.DefaultValueSampledData = nil
+	r.DefaultValueSignature = nil
+	r.DefaultValueTiming = nil
+	r.DefaultValueContactDetail = nil
+	r.DefaultValueContributor = nil
+	r.DefaultValueDataRequirement = nil
+	r.DefaultValueExpression = nil
+	r.DefaultValueParameterDefinition = nil
+	r.DefaultValueRelatedArtifact = nil
+	r.DefaultValueTriggerDefinition = nil
+	r.DefaultValueUsageContext = nil
+	r.DefaultValueDosage = nil
+	r.DefaultValueMeta = nil
+}
+
+// Fixed returns ElementDefinition.fixed[x] of the set type, such as *string, or nil when it is not set.
+func (r *ElementDefinition) Fixed() interface{} {
+	switch {
+	case r.FixedBase64Binary != nil:
+		return r.FixedBase64Binary
+	case r.FixedBoolean != nil:
+		return r.FixedBoolean
+	case r.FixedCanonical != nil:
+		return r.FixedCanonical
+	case r.FixedCode != nil:
+		return r.FixedCode
+	case r.FixedDate != nil:
+		return r.FixedDate
+	case r.FixedDateTime != nil:
+		return r.FixedDateTime
+	case r.FixedDecimal != nil:
+		return r.FixedDecimal
+	case r.FixedId != nil:
+		return r.FixedId
+	case r.FixedInstant != nil:
+		return r.FixedInstant
+	case r.FixedInteger != nil:
+		return r.FixedInteger
+	case r.FixedMarkdown != nil:
+		return r.FixedMarkdown
+	case r.FixedOid != nil:
+		return r.FixedOid
+	case r.FixedPositiveInt != nil:
+		return r.FixedPositiveInt
+	case r.FixedString != nil:
+		return r.FixedString
+	case r.FixedTime != nil:
+		return r.FixedTime
+	case r.FixedUnsignedInt != nil:
+		return r.FixedUnsignedInt
+	case r.FixedUri != nil:
+		return r.FixedUri
+	case r.FixedUrl != nil:
+		return r.FixedUrl
+	case r.FixedUuid != nil:
+		return r.FixedUuid
+	case r.FixedAddress != nil:
+		return r.FixedAddress
+	case r.FixedAge != nil:
+		return r.FixedAge
+	case r.FixedAnnotation != nil:
+		return r.FixedAnnotation
+	case r.FixedAttachment != nil:
+		return r.FixedAttachment
+	case r.FixedCodeableConcept != nil:
+		return r.FixedCodeableConcept
+	case r.FixedCoding != nil:
+		return r.FixedCoding
+	case r.FixedContactPoint != nil:
+		return r.FixedContactPoint
+	case r.FixedCount != nil:
+		return r.FixedCount
+	case r.FixedDistance != nil:
+		return r.FixedDistance
+	case r.FixedDuration != nil:
+		return r.FixedDuration
+	case r.FixedHumanName != nil:
+		return r.FixedHumanName
+	case r.FixedIdentifier != nil:
+		return r.FixedIdentifier
+	case r.FixedMoney != nil:
+		return r.FixedMoney
+	case r.FixedPeriod != nil:
+		return r.FixedPeriod
+	case r.FixedQuantity != nil:
+		return r.FixedQuantity
+	case r.FixedRange != nil:
+		return r.FixedRange
+	case r.FixedRatio != nil:
+		return r.FixedRatio
+	case r.FixedReference != nil:
+		return r.FixedReference
+	case r.FixedSampledData != nil:
+		return r.FixedSampledData
+	case r.FixedSignature != nil:
+		return r.FixedSignature
+	case r.FixedTiming != nil:
+		return r.FixedTiming
+	case r.FixedContactDetail != nil:
+		return r.FixedContactDetail
+	case r.FixedContributor != nil:
+		return r.FixedContributor
+	case r.FixedDataRequirement != nil:
+		return r.FixedDataRequirement
+	case r.FixedExpression != nil:
+		return r.FixedExpression
+	case r.FixedParameterDefinition != nil:
+		return r.FixedParameterDefinition
+	case r.FixedRelatedArtifact != nil:
+		return r.FixedRelatedArtifact
+	case r.FixedTriggerDefinition != nil:
+		return r.FixedTriggerDefinition
+	case r.FixedUsageContext != nil:
+		return r.FixedUsageContext
+	case r.FixedDosage != nil:
+		return r.FixedDosage
+	case r.FixedMeta != nil:
+		return r.FixedMeta
+	}
+	return nil
+}
+
+// SetFixedBase64Binary sets ElementDefinition.fixed[x] to the base64Binary and clears the other types.
+func (r *ElementDefinition) SetFixedBase64Binary(v string) {
+	r.clearFixed()
+	r.FixedBase64Binary = &v
+}
+
+// SetFixedBoolean sets ElementDefinition.fixed[x] to the boolean and clears the other types.
+func (r *ElementDefinition) SetFixedBoolean(v bool) {
+	r.clearFixed()
+	r.FixedBoolean = &v
+}
+
+// SetFixedCanonical sets ElementDefinition.fixed[x] to the canonical and clears the other types.
+func (r *ElementDefinition) SetFixedCanonical(v string) {
+	r.clearFixed()
+	r.FixedCanonical = &v
+}
+
+// SetFixedCode sets ElementDefinition.fixed[x] to the code and clears the other types.
+func (r *ElementDefinition) SetFixedCode(v string) {
+	r.clearFixed()
+	r.FixedCode = &v
+}
+
+// SetFixedDate sets ElementDefinition.fixed[x] to the date and clears the other types.
+func (r *ElementDefinition) SetFixedDate(v DateTime) {
+	r.clearFixed()
+	r.FixedDate = &v
+}
+
+// SetFixedDateTime sets ElementDefinition.fixed[x] to the dateTime and clears the other types.
+func (r *ElementDefinition) SetFixedDateTime(v DateTime) {
+	r.clearFixed()
+	r.FixedDateTime = &v
+}
+
+// SetFixedDecimal sets ElementDefinition.fixed[x] to the decimal and clears the other types.
+func (r *ElementDefinition) SetFixedDecimal(v Decimal) {
+	r.clearFixed()
+	r.FixedDecimal = &v
+}
+
+// SetFixedId sets ElementDefinition.fixed[x] to the id and clears the other types.
+func (r *ElementDefinition) SetFixedId(v string) {
+	r.clearFixed()
+	r.FixedId = &v
+}
+
+// SetFixedInstant sets ElementDefinition.fixed[x] to the instant and clears the other types.
+func (r *ElementDefinition) SetFixedInstant(v Instant) {
+	r.clearFixed()
+	r.FixedInstant = &v
+}
+
+// SetFixedInteger sets ElementDefinition.fixed[x] to the integer and clears the other types.
+func (r *ElementDefinition) SetFixedInteger(v int) {
+	r.clearFixed()
+	r.FixedInteger = &v
+}
+
+// SetFixedMarkdown sets ElementDefinition.fixed[x] to the markdown and clears the other types.
+func (r *ElementDefinition) SetFixedMarkdown(v string) {
+	r.clearFixed()
+	r.FixedMarkdown = &v
+}
+
+// SetFixedOid sets ElementDefinition.fixed[x] to the oid and clears the other types.
+func (r *ElementDefinition) SetFixedOid(v string) {
+	r.clearFixed()
+	r.FixedOid = &v
+}
+
+// SetFixedPositiveInt sets ElementDefinition.fixed[x] to the positiveInt and clears the other types.
+func (r *ElementDefinition) SetFixedPositiveInt(v int) {
+	r.clearFixed()
+	r.FixedPositiveInt = &v
+}
+
+// SetFixedString sets ElementDefinition.fixed[x] to the string and clears the other types.
+func (r *ElementDefinition) SetFixedString(v string) {
+	r.clearFixed()
+	r.FixedString = &v
+}
+
+// SetFixedTime sets ElementDefinition.fixed[x] to the time and clears the other types.
+func (r *ElementDefinition) SetFixedTime(v Time) {
+	r.clearFixed()
+	r.FixedTime = &v
+}
+
+// SetFixedUnsignedInt sets ElementDefinition.fixed[x] to the unsignedInt and clears the other types.
+func (r *ElementDefinition) SetFixedUnsignedInt(v int) {
+	r.clearFixed()
+	r.FixedUnsignedInt = &v
+}
+
+// SetFixedUri sets ElementDefinition.fixed[x] to the uri and clears the other types.
+func (r *ElementDefinition) SetFixedUri(v string) {
+	r.clearFixed()
+	r.FixedUri = &v
+}
+
+// SetFixedUrl sets ElementDefinition.fixed[x] to the url and clears the other types.
+func (r *ElementDefinition) SetFixedUrl(v string) {
+	r.clearFixed()
+	r.FixedUrl = &v
+}
+
+// SetFixedUuid sets ElementDefinition.fixed[x] to the uuid and clears the other types.
+func (r *ElementDefinition) SetFixedUuid(v string) {
+	r.clearFixed()
+	r.FixedUuid = &v
+}
+
+// SetFixedAddress sets ElementDefinition.fixed[x] to the Address and clears the other types.
+func (r *ElementDefinition) SetFixedAddress(v Address) {
+	r.clearFixed()
+	r.FixedAddress = &v
+}
+
+// SetFixedAge sets ElementDefinition.fixed[x] to the Age and clears the other types.
+func (r *ElementDefinition) SetFixedAge(v Age) {
+	r.clearFixed()
+	r.FixedAge = &v
+}
+
+// SetFixedAnnotation sets ElementDefinition.fixed[x] to the Annotation and clears the other types.
+func (r *ElementDefinition) SetFixedAnnotation(v Annotation) {
+	r.clearFixed()
+	r.FixedAnnotation = &v
+}
+
+// SetFixedAttachment sets ElementDefinition.fixed[x] to the Attachment and clears the other types.
+func (r *ElementDefinition) SetFixedAttachment(v Attachment) {
+	r.clearFixed()
+	r.FixedAttachment = &v
+}
+
+// SetFixedCodeableConcept sets ElementDefinition.fixed[x] to the CodeableConcept and clears the other types.
+func (r *ElementDefinition) SetFixedCodeableConcept(v CodeableConcept) {
+	r.clearFixed()
+	r.FixedCodeableConcept = &v
+}
+
+// SetFixedCoding sets ElementDefinition.fixed[x] to the Coding and clears the other types.
+func (r *ElementDefinition) SetFixedCoding(v Coding) {
+	r.clearFixed()
+	r.FixedCoding = &v
+}
+
+// SetFixedContactPoint sets ElementDefinition.fixed[x] to the ContactPoint and clears the other types.
+func (r *ElementDefinition) SetFixedContactPoint(v ContactPoint) {
+	r.clearFixed()
+	r.FixedContactPoint = &v
+}
+
+// SetFixedCount sets ElementDefinition.fixed[x] to the Count and clears the other types.
+func (r *ElementDefinition) SetFixedCount(v Count) {
+	r.clearFixed()
+	r.FixedCount = &v
+}
+
+// SetFixedDistance sets ElementDefinition.fixed[x] to the Distance and clears the other types.
+func (r *ElementDefinition) SetFixedDistance(v Distance) {
+	r.clearFixed()
+	r.FixedDistance = &v
+}
+
+// SetFixedDuration sets ElementDefinition.fixed[x] to the Duration and clears the other types.
+func (r *ElementDefinition) SetFixedDuration(v Duration) {
+	r.clearFixed()
+	r.FixedDuration = &v
+}
+
+// SetFixedHumanName sets ElementDefinition.fixed[x] to the HumanName and clears the other types.
+func (r *ElementDefinition) SetFixedHumanName(v HumanName) {
+	r.clearFixed()
+	r.FixedHumanName = &v
+}
+
+// SetFixedIdentifier sets ElementDefinition.fixed[x] to the Identifier and clears the other types.
+func (r *ElementDefinition) SetFixedIdentifier(v Identifier) {
+	r.clearFixed()
+	r.FixedIdentifier = &v
+}
+
+// SetFixedMoney sets ElementDefinition.fixed[x] to the Money and clears the other types.
+func (r *ElementDefinition) SetFixedMoney(v Money) {
+	r.clearFixed()
+	r.FixedMoney = &v
+}
+
+// SetFixedPeriod sets ElementDefinition.fixed[x] to the Period and clears the other types.
+func (r *ElementDefinition) SetFixedPeriod(v Period) {
+	r.clearFixed()
+	r.FixedPeriod = &v
+}
+
+// SetFixedQuantity sets ElementDefinition.fixed[x] to the Quantity and clears the other types.
+func (r *ElementDefinition) SetFixedQuantity(v Quantity) {
+	r.clearFixed()
+	r.FixedQuantity = &v
+}
+
+// SetFixedRange sets ElementDefinition.fixed[x] to the Range and clears the other types.
+func (r *ElementDefinition) SetFixedRange(v Range) {
+	r.clearFixed()
+	r.FixedRange = &v
+}
+
+// SetFixedRatio sets ElementDefinition.fixed[x] to the Ratio and clears the other types.
+func (r *ElementDefinition) SetFixedRatio(v Ratio) {
+	r.clearFixed()
+	r.FixedRatio = &v
+}
+
+// SetFixedReference sets ElementDefinition.fixed[x] to the Reference and clears the other types.
+func (r *ElementDefinition) SetFixedReference(v Reference) {
+	r.clearFixed()
+	r.FixedReference = &v
+}
+
+// SetFixedSampledData sets ElementDefinition.fixed[x] to the SampledData and clears the other types.
+func (r *ElementDefinition) SetFixedSampledData(v SampledData) {
+	r.clearFixed()
+	r.FixedSampledData = &v
+}
+
+// SetFixedSignature sets ElementDefinition.fixed[x] to the Signature and clears the other types.
+func (r *ElementDefinition) SetFixedSignature(v Signature) {
+	r.clearFixed()
+	r.FixedSignature = &v
+}
+
+// SetFixedTiming sets ElementDefinition.fixed[x] to the Timing and clears the other types.
+func (r *ElementDefinition) SetFixedTiming(v Timing) {
+	r.clearFixed()
+	r.FixedTiming = &v
+}
+
+// SetFixedContactDetail sets ElementDefinition.fixed[x] to the ContactDetail and clears the other types.
+func (r *ElementDefinition) SetFixedContactDetail(v ContactDetail) {
+	r.clearFixed()
+	r.FixedContactDetail = &v
+}
+
+// SetFixedContributor sets ElementDefinition.fixed[x] to the Contributor and clears the other types.
+func (r *ElementDefinition) SetFixedContributor(v Contributor) {
+	r.clearFixed()
+	r.FixedContributor = &v
+}
+
+// SetFixedDataRequirement sets ElementDefinition.fixed[x] to the DataRequirement and clears the other types.
+func (r *ElementDefinition) SetFixedDataRequirement(v DataRequirement) {
+	r.clearFixed()
+	r.FixedDataRequirement = &v
+}
+
+// SetFixedExpression sets ElementDefinition.fixed[x] to the Expression and clears the other types.
+func (r *ElementDefinition) SetFixedExpression(v Expression) {
+	r.clearFixed()
+	r.FixedExpression = &v
+}
+
+// SetFixedParameterDefinition sets ElementDefinition.fixed[x] to the ParameterDefinition and clears the other types.
+func (r *ElementDefinition) SetFixedParameterDefinition(v ParameterDefinition) {
+	r.clearFixed()
+	r.FixedParameterDefinition = &v
+}
+
+// SetFixedRelatedArtifact sets ElementDefinition.fixed[x] to the RelatedArtifact and clears the other types.
+func (r *ElementDefinition) SetFixedRelatedArtifact(v RelatedArtifact) {
+	r.clearFixed()
+	r.FixedRelatedArtifact = &v
+}
+
+// SetFixedTriggerDefinition sets ElementDefinition.fixed[x] to the TriggerDefinition and clears the other types.
+func (r *ElementDefinition) SetFixedTriggerDefinition(v TriggerDefinition) {
+	r.clearFixed()
+	r.FixedTriggerDefinition = &v
+}
+
+// SetFixedUsageContext sets ElementDefinition.fixed[x] to the UsageContext and clears the other types.
+func (r *ElementDefinition) SetFixedUsageContext(v UsageContext) {
+	r.clearFixed()
+	r.FixedUsageContext = &v
+}
+
+// SetFixedDosage sets ElementDefinition.fixed[x] to the Dosage and clears the other types.
+func (r *ElementDefinition) SetFixedDosage(v Dosage) {
+	r.clearFixed()
+	r.FixedDosage = &v
+}
+
+// SetFixedMeta sets ElementDefinition.fixed[x] to the Meta and clears the other types.
+func (r *ElementDefinition) SetFixedMeta(v Meta) {
+	r.clearFixed()
+	r.FixedMeta = &v
+}
+func (r *ElementDefinition) clearFixed() {
+	r.FixedBase64Binary = nil
+	r.FixedBase64BinaryElement = nil
+	r.FixedBoolean = nil
+	r.FixedBooleanElement = nil
+	r.FixedCanonical = nil
+	r.FixedCanonicalElement = nil
+	r.FixedCode = nil
+	r.FixedCodeElement = nil
+	r.FixedDate = nil
+	r.FixedDateElement = nil
+	r.FixedDateTime = nil
+	r.FixedDateTimeElement = nil
+	r.FixedDecimal = nil
+	r.FixedDecimalElement = nil
+	r.FixedId = nil
+	r.FixedIdElement = nil
+	r.FixedInstant = nil
+	r.FixedInstantElement = nil
+	r.FixedInteger = nil
+	r.FixedIntegerElement = nil
+	r.FixedMarkdown = nil
+	r.FixedMarkdownElement = nil
+	r.FixedOid = nil
+	r.FixedOidElement = nil
+	r.FixedPositiveInt = nil
+	r.FixedPositiveIntElement = nil
+	r.FixedString = nil
+	r.FixedStringElement = nil
+	r.FixedTime = nil
+	r.FixedTimeElement = nil
+	r.FixedUnsignedInt = nil
+	r.FixedUnsignedIntElement = nil
+	r.FixedUri = nil
+	r.FixedUriElement = nil
+	r.FixedUrl = nil
+	r.FixedUrlElement = nil
+	r.FixedUuid = nil
+	r.FixedUuidElement = nil
+	r.FixedAddress = nil
+	r.FixedAge = nil
+	r.FixedAnnotation = nil
+	r.FixedAttachment = nil
+	r.FixedCodeableConcept = nil
+	r.FixedCoding = nil
+	r.FixedContactPoint = nil
+	r.FixedCount = nil
+	r.FixedDistance = nil
+	r.FixedDuration = nil
+	r.FixedHumanName = nil
+	r.FixedIdentifier = nil
+	r.FixedMoney = nil
+	r.FixedPeriod = nil
+	r.FixedQuantity = nil
+	r.FixedRange = nil
+	r.FixedRatio = nil
+	r.FixedReference = nil
+	r.FixedSampledData = nil
+	r.FixedSignature = nil
+	r.FixedTiming = nil
+	r.FixedContactDetail = nil
+	r.FixedContributor = nil
+	r.FixedDataRequirement = nil
+	r.FixedExpression = nil
+	r.FixedParameterDefinition = nil
+	r.FixedRelatedArtifact = nil
+	r.FixedTriggerDefinition = nil
+	r.FixedUsageContext = nil
+	r.FixedDosage = nil
+	r.FixedMeta = nil
+}
+
+// Pattern returns ElementDefinition.pattern[x] of the set type, such as *string, or nil when it is not set.
+func (r *ElementDefinition) Pattern() interface{} {
+	switch {
+	case r.PatternBase64Binary != nil:
+		return r.PatternBase64Binary
+	case r.PatternBoolean != nil:
+		return r.PatternBoolean
+	case r.PatternCanonical != nil:
+		return r.PatternCanonical
+	case r.PatternCode != nil:
+		return r.PatternCode
+	case r.PatternDate != nil:
+		return r.PatternDate
+	case r.PatternDateTime != nil:
+		return r.PatternDateTime
+	case r.PatternDecimal != nil:
+		return r.PatternDecimal
+	case r.PatternId != nil:
+		return r.PatternId
+	case r.PatternInstant != nil:
+		return r.PatternInstant
+	case r.PatternInteger != nil:
+		return r.PatternInteger
+	case r.PatternMarkdown != nil:
+		return r.PatternMarkdown
+	case r.PatternOid != nil:
+		return r.PatternOid
+	case r.PatternPositiveInt != nil:
+		return r.PatternPositiveInt
+	case r.PatternString != nil:
+		return r.PatternString
+	case r.PatternTime != nil:
+		return r.PatternTime
+	case r.PatternUnsignedInt != nil:
+		return r.PatternUnsignedInt
+	case r.PatternUri != nil:
+		return r.PatternUri
+	case r.PatternUrl != nil:
+		return r.PatternUrl
+	case r.PatternUuid != nil:
+		return r.PatternUuid
+	case r.PatternAddress != nil:
+		return r.PatternAddress
+	case r.PatternAge != nil:
+		return r.PatternAge
+	case r.PatternAnnotation != nil:
+		return r.PatternAnnotation
+	case r.PatternAttachment != nil:
+		return r.PatternAttachment
+	case r.PatternCodeableConcept != nil:
+		return r.PatternCodeableConcept
+	case r.PatternCoding != nil:
+		return r.PatternCoding
+	case r.PatternContactPoint != nil:
+		return r.PatternContactPoint
+	case r.PatternCount != nil:
+		return r.PatternCount
+	case r.PatternDistance != nil:
+		return r.PatternDistance
+	case r.PatternDuration != nil:
+		return r.PatternDuration
+	case r.PatternHumanName != nil:
+		return r.PatternHumanName
+	case r.PatternIdentifier != nil:
+		return r.PatternIdentifier
+	case r.PatternMoney != nil:
+		return r.PatternMoney
+	case r.PatternPeriod != nil:
+		return r.PatternPeriod
+	case r.PatternQuantity != nil:
+		return r.PatternQuantity
+	case r.PatternRange != nil:
+		return r.PatternRange
+	case r.PatternRatio != nil:
+		return r.PatternRatio
+	case r.PatternReference != nil:
+		return r.PatternReference
+	case r.PatternSampledData != nil:
+		return r.PatternSampledData
+	case r.PatternSignature != nil:
+		return r.PatternSignature
+	case r.PatternTiming != nil:
+		return r.PatternTiming
+	case r.PatternContactDetail != nil:
+		return r.PatternContactDetail
+	case r.PatternContributor != nil:
+		return r.PatternContributor
+	case r.PatternDataRequirement != nil:
+		return r.PatternDataRequirement
+	case r.PatternExpression != nil:
+		return r.PatternExpression
+	case r.PatternParameterDefinition != nil:
+		return r.PatternParameterDefinition
+	case r.PatternRelatedArtifact != nil:
+		return r.PatternRelatedArtifact
+	case r.PatternTriggerDefinition != nil:
+		return r.PatternTriggerDefinition
+	case r.PatternUsageContext != nil:
+		return r.PatternUsageContext
+	case r.PatternDosage != nil:
+		return r.PatternDosage
+	case r.PatternMeta != nil:
+		return r.PatternMeta
+	}
+	return nil
+}
+
+// SetPatternBase64Binary sets ElementDefinition.pattern[x] to the base64Binary and clears the other types.
+func (r *ElementDefinition) SetPatternBase64Binary(v string) {
+	r.clearPattern()
+	r.PatternBase64Binary = &v
+}
+
+// SetPatternBoolean sets ElementDefinition.pattern[x] to the boolean and clears the other types.
+func (r *ElementDefinition) SetPatternBoolean(v bool) {
+	r.clearPattern()
+	r.PatternBoolean = &v
+}
+
+// SetPatternCanonical sets ElementDefinition.pattern[x] to the canonical and clears the other types.
+func (r *ElementDefinition) SetPatternCanonical(v string) {
+	r.clearPattern()
+	r.PatternCanonical = &v
+}
+
+// SetPatternCode sets ElementDefinition.pattern[x] to the code and clears the other types.
+func (r *ElementDefinition) SetPatternCode(v string) {
+	r.clearPattern()
+	r.PatternCode = &v
+}
+
+// SetPatternDate sets ElementDefinition.pattern[x] to the date and clears the other types.
+func (r *ElementDefinition) SetPatternDate(v DateTime) {
+	r.clearPattern()
+	r.PatternDate = &v
+}
+
+// SetPatternDateTime sets ElementDefinition.pattern[x] to the dateTime and clears the other types.
+func (r *ElementDefinition) SetPatternDateTime(v DateTime) {
+	r.clearPattern()
+	r.PatternDateTime = &v
+}
+
+// SetPatternDecimal sets ElementDefinition.pattern[x] to the decimal and clears the other types.
+func (r *ElementDefinition) SetPatternDecimal(v Decimal) {
+	r.clearPattern()
+	r.PatternDecimal = &v
+}
+
+// SetPatternId sets ElementDefinition.pattern[x] to the id and clears the other types.
+func (r *ElementDefinition) SetPatternId(v string) {
+	r.clearPattern()
+	r.PatternId = &v
+}
+
+// SetPatternInstant sets ElementDefinition.pattern[x] to the instant and clears the other types.
+func (r *ElementDefinition) SetPatternInstant(v Instant) {
+	r.clearPattern()
+	r.PatternInstant = &v
+}
+
+// SetPatternInteger sets ElementDefinition.pattern[x] to the integer and clears the other types.
+func (r *ElementDefinition) SetPatternInteger(v int) {
+	r.clearPattern()
+	r.PatternInteger = &v
+}
+
+// SetPatternMarkdown sets ElementDefinition.pattern[x] to the markdown and clears the other types.
+func (r *ElementDefinition) SetPatternMarkdown(v string) {
+	r.clearPattern()
+	r.PatternMarkdown = &v
+}
+
+// SetPatternOid sets ElementDefinition.pattern[x] to the oid and clears the other types.
+func (r *ElementDefinition) SetPatternOid(v string) {
+	r.clearPattern()
+	r.PatternOid = &v
+}
+
+// SetPatternPositiveInt sets ElementDefinition.pattern[x] to the positiveInt and clears the other types.
+func (r *ElementDefinition) SetPatternPositiveInt(v int) {
+	r.clearPattern()
+	r.PatternPositiveInt = &v
+}
+
+// SetPatternString sets ElementDefinition.pattern[x] to the string and clears the other types.
+func (r *ElementDefinition) SetPatternString(v string) {
+	r.clearPattern()
+	r.PatternString = &v
+}
+
+// SetPatternTime sets ElementDefinition.pattern[x] to the time and clears the other types.
+func (r *ElementDefinition) SetPatternTime(v Time) {
+	r.clearPattern()
+	r.PatternTime = &v
+}
+
+// SetPatternUnsignedInt sets ElementDefinition.pattern[x] to the unsignedInt and clears the other types.
+func (r *ElementDefinition) SetPatternUnsignedInt(v int) {
+	r.clearPattern()
+	r.PatternUnsignedInt = &v
+}
+
+// SetPatternUri sets ElementDefinition.pattern[x] to the uri and clears the other types.
+func (r *ElementDefinition) SetPatternUri(v string) {
+	r.clearPattern()
+	r.PatternUri = &v
+}
+
+// SetPatternUrl sets ElementDefinition.pattern[x] to the url and clears the other types.
+func (r *ElementDefinition) SetPatternUrl(v string) {
+	r.clearPattern()
+	r.PatternUrl = &v
+}
+
+// SetPatternUuid sets ElementDefinition.pattern[x] to the uuid and clears the other types.
+func (r *ElementDefinition) SetPatternUuid(v string) {
+	r.clearPattern()
+	r.PatternUuid = &v
+}
+
+// SetPatternAddress sets ElementDefinition.pattern[x] to the Address and clears the other types.
+func (r *ElementDefinition) SetPatternAddress(v Address) {
+	r.clearPattern()
+	r.PatternAddress = &v
+}
+
+// SetPatternAge sets ElementDefinition.pattern[x] to the Age and clears the other types.
+func (r *ElementDefinition) SetPatternAge(v Age) {
+	r.clearPattern()
+	r.PatternAge = &v
+}
+
+// SetPatternAnnotation sets ElementDefinition.pattern[x] to the Annotation and clears the other types.
+func (r *ElementDefinition) SetPatternAnnotation(v Annotation) {
+	r.clearPattern()
+	r.PatternAnnotation = &v
+}
+
+// SetPatternAttachment sets ElementDefinition.pattern[x] to the Attachment and clears the other types.
+func (r *ElementDefinition) SetPatternAttachment(v Attachment) {
+	r.clearPattern()
+	r.PatternAttachment = &v
+}
+
+// SetPatternCodeableConcept sets ElementDefinition.pattern[x] to the CodeableConcept and clears the other types.
+func (r *ElementDefinition) SetPatternCodeableConcept(v CodeableConcept) {
+	r.clearPattern()
+	r.PatternCodeableConcept = &v
+}
+
+// SetPatternCoding sets ElementDefinition.pattern[x] to the Coding and clears the other types.
+func (r *ElementDefinition) SetPatternCoding(v Coding) {
+	r.clearPattern()
+	r.PatternCoding = &v
+}
+
+// SetPatternContactPoint sets ElementDefinition.pattern[x] to the ContactPoint and clears the other types.
+func (r *ElementDefinition) SetPatternContactPoint(v ContactPoint) {
+	r.clearPattern()
+	r.PatternContactPoint = &v
+}
+
+// SetPatternCount sets ElementDefinition.pattern[x] to the Count and clears the other types.
+func (r *ElementDefinition) SetPatternCount(v Count) {
+	r.clearPattern()
+	r.PatternCount = &v
+}
+
+// SetPatternDistance sets ElementDefinition.pattern[x] to the Distance and clears the other types.
+func (r *ElementDefinition) SetPatternDistance(v Distance) {
+	r.clearPattern()
+	r.PatternDistance = &v
+}
+
+// SetPatternDuration sets ElementDefinition.pattern[x] to the Duration and clears the other types.
+func (r *ElementDefinition) SetPatternDuration(v Duration) {
+	r.clearPattern()
+	r.PatternDuration = &v
+}
+
+// SetPatternHumanName sets ElementDefinition.pattern[x] to the HumanName and clears the other types.
+func (r *ElementDefinition) SetPatternHumanName(v HumanName) {
+	r.clearPattern()
+	r.PatternHumanName = &v
+}
+
+// SetPatternIdentifier sets ElementDefinition.pattern[x] to the Identifier and clears the other types.
+func (r *ElementDefinition) SetPatternIdentifier(v Identifier) {
+	r.clearPattern()
+	r.PatternIdentifier = &v
+}
+
+// SetPatternMoney sets ElementDefinition.pattern[x] to the Money and clears the other types.
+func (r *ElementDefinition) SetPatternMoney(v Money) {
+	r.clearPattern()
+	r.PatternMoney = &v
+}
+
+// SetPatternPeriod sets ElementDefinition.pattern[x] to the Period and clears the other types.
+func (r *ElementDefinition) SetPatternPeriod(v Period) {
+	r.clearPattern()
+	r.PatternPeriod = &v
+}
+
+// SetPatternQuantity sets ElementDefinition.pattern[x] to the Quantity and clears the other types.
+func (r *ElementDefinition) SetPatternQuantity(v Quantity) {
+	r.clearPattern()
+	r.PatternQuantity = &v
+}
+
+// SetPatternRange sets ElementDefinition.pattern[x] to the Range and clears the other types.
+func (r *ElementDefinition) SetPatternRange(v Range) {
+	r.clearPattern()
+	r.PatternRange = &v
+}
+
+// SetPatternRatio sets ElementDefinition.pattern[x] to the Ratio and clears the other types.
+func (r *ElementDefinition) SetPatternRatio(v Ratio) {
+	r.clearPattern()
+	r.PatternRatio = &v
+}
+
+// SetPatternReference sets ElementDefinition.pattern[x] to the Reference and clears the other types.
+func (r *ElementDefinition) SetPatternReference(v Reference) {
+	r.clearPattern()
+	r.PatternReference = &v
+}
+
+// SetPatternSampledData sets ElementDefinition.pattern[x] to the SampledData and clears the other types.
+func (r *ElementDefinition) SetPatternSampledData(v SampledData) {
+	r.clearPattern()
+	r.PatternSampledData = &v
+}
+
+// SetPatternSignature sets ElementDefinition.pattern[x] to the Signature and clears the other types.
+func (r *ElementDefinition) SetPatternSignature(v Signature) {
+	r.clearPattern()
+	r.PatternSignature = &v
+}
+
+// SetPatternTiming sets ElementDefinition.pattern[x] to the Timing and clears the other types.
+func (r *ElementDefinition) SetPatternTiming(v Timing) {
+	r.clearPattern()
+	r.PatternTiming = &v
+}
+
+// SetPatternContactDetail sets ElementDefinition.pattern[x] to the ContactDetail and clears the other types.
+func (r *ElementDefinition) SetPatternContactDetail(v ContactDetail) {
+	r.clearPattern()
+	r.PatternContactDetail = &v
+}
+
+// SetPatternContributor sets ElementDefinition.pattern[x] to the Contributor and clears the other types.
+func (r *ElementDefinition) SetPatternContributor(v Contributor) {
+	r.clearPattern()
+	r.PatternContributor = &v
+}
+
+// SetPatternDataRequirement sets ElementDefinition.pattern[x] to the DataRequirement and clears the other types.
+func (r *ElementDefinition) SetPatternDataRequirement(v DataRequirement) {
+	r.clearPattern()
+	r.PatternDataRequirement = &v
+}
+
+// SetPatternExpression sets ElementDefinition.pattern[x] to the Expression and clears the other types.
+func (r *ElementDefinition) SetPatternExpression(v Expression) {
+	r.clearPattern()
+	r.PatternExpression = &v
+}
+
+// SetPatternParameterDefinition sets ElementDefinition.pattern[x] to the ParameterDefinition and clears the other types.
+func (r *ElementDefinition) SetPatternParameterDefinition(v ParameterDefinition) {
+	r.clearPattern()
+	r.PatternParameterDefinition = &v
+}
+
+// SetPatternRelatedArtifact sets ElementDefinition.pattern[x] to the RelatedArtifact and clears the other types.
+func (r *ElementDefinition) SetPatternRelatedArtifact(v RelatedArtifact) {
+	r.clearPattern()
+	r.PatternRelatedArtifact = &v
+}
+
+// SetPatternTriggerDefinition sets ElementDefinition.pattern[x] to the TriggerDefinition and clears the other types.
+func (r *ElementDefinition) SetPatternTriggerDefinition(v TriggerDefinition) {
+	r.clearPattern()
+	r.PatternTriggerDefinition = &v
+}
+
+// SetPatternUsageContext sets ElementDefinition.pattern[x] to the UsageContext and clears the other types.
+func (r *ElementDefinition) SetPatternUsageContext(v UsageContext) {
+	r.clearPattern()
+	r.PatternUsageContext = &v
+}
+
+// SetPatternDosage sets ElementDefinition.pattern[x] to the Dosage and clears the other types.
+func (r *ElementDefinition) SetPatternDosage(v Dosage) {
+	r.clearPattern()
+	r.PatternDosage = &v
+}
+
+// SetPatternMeta sets ElementDefinition.pattern[x] to the Meta and clears the other types.
+func (r *ElementDefinition) SetPatternMeta(v Meta) {
+	r.clearPattern()
+	r.PatternMeta = &v
+}
+func (r *ElementDefinition) clearPattern() {
+	r.PatternBase64Binary = nil
+	r.PatternBase64BinaryElement = nil
+	r.PatternBoolean = nil
+	r.PatternBooleanElement = nil
+	r.PatternCanonical = nil
+	r.PatternCanonicalElement = nil
+	r.PatternCode = nil
+	r.PatternCodeElement = nil
+	r.PatternDate = nil
+	r.PatternDateElement = nil
+	r.PatternDateTime = nil
+	r.PatternDateTimeElement = nil
+	r.PatternDecimal = nil
+	r.PatternDecimalElement = nil
+	r.PatternId = nil
+	r.PatternIdElement = nil
+	r.PatternInstant = nil
+	r.PatternInstantElement = nil
+	r.PatternInteger = nil
+	r.PatternIntegerElement = nil
+	r.PatternMarkdown = nil
+	r.PatternMarkdownElement = nil
+	r.PatternOid = nil
+	r.PatternOidElement = nil
+	r.PatternPositiveInt = nil
+	r.PatternPositiveIntElement = nil
+	r.PatternString = nil
+	r.PatternStringElement = nil
+	r.PatternTime = nil
+	r.PatternTimeElement = nil
+	r.PatternUnsignedInt = nil
+	r.PatternUnsignedIntElement = nil
+	r.PatternUri = nil
+	r.PatternUriElement = nil
+	r.PatternUrl = nil
+	r.PatternUrlElement = nil
+	r.PatternUuid = nil
+	r.PatternUuidElement = nil
+	r.PatternAddress = nil
+	r.PatternAge = nil
+	r.PatternAnnotation = nil
+	r.PatternAttachment = nil
+	r.PatternCodeableConcept = nil
+	r.PatternCoding = nil
+	r.PatternContactPoint = nil
+	r.PatternCount = nil
+	r.PatternDistance = nil
+	r.PatternDuration = nil
+	r.PatternHumanName = nil
+	r.PatternIdentifier = nil
+	r.PatternMoney = nil
+	r.PatternPeriod = nil
+	r.PatternQuantity = nil
+	r.PatternRange = nil
+	r.PatternRatio = nil
+	r.PatternReference = nil
+	r.PatternSampledData = nil
+	r.PatternSignature = nil
+	r.PatternTiming = nil
+	r.PatternContactDetail = nil
+	r.PatternContributor = nil
+	r.PatternDataRequirement = nil
+	r.PatternExpression = nil
+	r.PatternParameterDefinition = nil
+	r.PatternRelatedArtifact = nil
+	r.PatternTriggerDefinition = nil
+	r.PatternUsageContext = nil
+	r.PatternDosage = nil
+	r.PatternMeta = nil
+}
+
+// MinValue returns ElementDefinition.minValue[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ElementDefinition) MinValue() interface{} {
+	switch {
+	case r.MinValueDate != nil:
+		return r.MinValueDate
+	case r.MinValueDateTime != nil:
+		return r.MinValueDateTime
+	case r.MinValueInstant != nil:
+		return r.MinValueInstant
+	case r.MinValueTime != nil:
+		return r.MinValueTime
+	case r.MinValueDecimal != nil:
+		return r.MinValueDecimal
+	case r.MinValueInteger != nil:
+		return r.MinValueInteger
+	case r.MinValuePositiveInt != nil:
+		return r.MinValuePositiveInt
+	case r.MinValueUnsignedInt != nil:
+		return r.MinValueUnsignedInt
+	case r.MinValueQuantity != nil:
+		return r.MinValueQuantity
+	}
+	return nil
+}
+
+// SetMinValueDate sets ElementDefinition.minValue[x] to the date and clears the other types.
+func (r *ElementDefinition) SetMinValueDate(v DateTime) {
+	r.clearMinValue()
+	r.MinValueDate = &v
+}
+
+// SetMinValueDateTime sets ElementDefinition.minValue[x] to the dateTime and clears the other types.
+func (r *ElementDefinition) SetMinValueDateTime(v DateTime) {
+	r.clearMinValue()
+	r.MinValueDateTime = &v
+}
+
+// SetMinValueInstant sets ElementDefinition.minValue[x] to the instant and clears the other types.
+func (r *ElementDefinition) SetMinValueInstant(v Instant) {
+	r.clearMinValue()
+	r.MinValueInstant = &v
+}
+
+// SetMinValueTime sets ElementDefinition.minValue[x] to the time and clears the other types.
+func (r *ElementDefinition) SetMinValueTime(v Time) {
+	r.clearMinValue()
+	r.MinValueTime = &v
+}
+
+// SetMinValueDecimal sets ElementDefinition.minValue[x] to the decimal and clears the other types.
+func (r *ElementDefinition) SetMinValueDecimal(v Decimal) {
+	r.clearMinValue()
+	r.MinValueDecimal = &v
+}
+
+// SetMinValueInteger sets ElementDefinition.minValue[x] to the integer and clears the other types.
+func (r *ElementDefinition) SetMinValueInteger(v int) {
+	r.clearMinValue()
+	r.MinValueInteger = &v
+}
+
+// SetMinValuePositiveInt sets ElementDefinition.minValue[x] to the positiveInt and clears the other types.
+func (r *ElementDefinition) SetMinValuePositiveInt(v int) {
+	r.clearMinValue()
+	r.MinValuePositiveInt = &v
+}
+
+// SetMinValueUnsignedInt sets ElementDefinition.minValue[x] to the unsignedInt and clears the other types.
+func (r *ElementDefinition) SetMinValueUnsignedInt(v int) {
+	r.clearMinValue()
+	r.MinValueUnsignedInt = &v
+}
+
+// SetMinValueQuantity sets ElementDefinition.minValue[x] to the Quantity and clears the other types.
+func (r *ElementDefinition) SetMinValueQuantity(v Quantity) {
+	r.clearMinValue()
+	r.MinValueQuantity = &v
+}
+func (r *ElementDefinition) clearMinValue() {
+	r.MinValueDate = nil
+	r.MinValueDateElement = nil
+	r.MinValueDateTime = nil
+	r.MinValueDateTimeElement = nil
+	r.MinValueInstant = nil
+	r.MinValueInstantElement = nil
+	r.MinValueTime = nil
+	r.MinValueTimeElement = nil
+	r.MinValueDecimal = nil
+	r.MinValueDecimalElement = nil
+	r.MinValueInteger = nil
+	r.MinValueIntegerElement = nil
+	r.MinValuePositiveInt = nil
+	r.MinValuePositiveIntElement = nil
+	r.MinValueUnsignedInt = nil
+	r.MinValueUnsignedIntElement = nil
+	r.MinValueQuantity = nil
+}
+
+// MaxValue returns ElementDefinition.maxValue[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ElementDefinition) MaxValue() interface{} {
+	switch {
+	case r.MaxValueDate != nil:
+		return r.MaxValueDate
+	case r.MaxValueDateTime != nil:
+		return r.MaxValueDateTime
+	case r.MaxValueInstant != nil:
+		return r.MaxValueInstant
+	case r.MaxValueTime != nil:
+		return r.MaxValueTime
+	case r.MaxValueDecimal != nil:
+		return r.MaxValueDecimal
+	case r.MaxValueInteger != nil:
+		return r.MaxValueInteger
+	case r.MaxValuePositiveInt != nil:
+		return r.MaxValuePositiveInt
+	case r.MaxValueUnsignedInt != nil:
+		return r.MaxValueUnsignedInt
+	case r.MaxValueQuantity != nil:
+		return r.MaxValueQuantity
+	}
+	return nil
+}
+
+// SetMaxValueDate sets ElementDefinition.maxValue[x] to the date and clears the other types.
+func (r *ElementDefinition) SetMaxValueDate(v DateTime) {
+	r.clearMaxValue()
+	r.MaxValueDate = &v
+}
+
+// SetMaxValueDateTime sets ElementDefinition.maxValue[x] to the dateTime and clears the other types.
+func (r *ElementDefinition) SetMaxValueDateTime(v DateTime) {
+	r.clearMaxValue()
+	r.MaxValueDateTime = &v
+}
+
+// SetMaxValueInstant sets ElementDefinition.maxValue[x] to the instant and clears the other types.
+func (r *ElementDefinition) SetMaxValueInstant(v Instant) {
+	r.clearMaxValue()
+	r.MaxValueInstant = &v
+}
+
+// SetMaxValueTime sets ElementDefinition.maxValue[x] to the time and clears the other types.
+func (r *ElementDefinition) SetMaxValueTime(v Time) {
+	r.clearMaxValue()
+	r.MaxValueTime = &v
+}
+
+// SetMaxValueDecimal sets ElementDefinition.maxValue[x] to the decimal and clears the other types.
+func (r *ElementDefinition) SetMaxValueDecimal(v Decimal) {
+	r.clearMaxValue()
+	r.MaxValueDecimal = &v
+}
+
+// SetMaxValueInteger sets ElementDefinition.maxValue[x] to the integer and clears the other types.
+func (r *ElementDefinition) SetMaxValueInteger(v int) {
+	r.clearMaxValue()
+	r.MaxValueInteger = &v
+}
+
+// SetMaxValuePositiveInt sets ElementDefinition.maxValue[x] to the positiveInt and clears the other types.
+func (r *ElementDefinition) SetMaxValuePositiveInt(v int) {
+	r.clearMaxValue()
+	r.MaxValuePositiveInt = &v
+}
+
+// SetMaxValueUnsignedInt sets ElementDefinition.maxValue[x] to the unsignedInt and clears the other types.
+func (r *ElementDefinition) SetMaxValueUnsignedInt(v int) {
+	r.clearMaxValue()
+	r.MaxValueUnsignedInt = &v
+}
+
+// SetMaxValueQuantity sets ElementDefinition.maxValue[x] to the Quantity and clears the other types.
+func (r *ElementDefinition) SetMaxValueQuantity(v Quantity) {
+	r.clearMaxValue()
+	r.MaxValueQuantity = &v
+}
+func (r *ElementDefinition) clearMaxValue() {
+	r.MaxValueDate = nil
+	r.MaxValueDateElement = nil
+	r.MaxValueDateTime = nil
+	r.MaxValueDateTimeElement = nil
+	r.MaxValueInstant = nil
+	r.MaxValueInstantElement = nil
+	r.MaxValueTime = nil
+	r.MaxValueTimeElement = nil
+	r.MaxValueDecimal = nil
+	r.MaxValueDecimalElement = nil
+	r.MaxValueInteger = nil
+	r.MaxValueIntegerElement = nil
+	r.MaxValuePositiveInt = nil
+	r.MaxValuePositiveIntElement = nil
+	r.MaxValueUnsignedInt = nil
+	r.MaxValueUnsignedIntElement = nil
+	r.MaxValueQuantity = nil
+}
+
+// UnmarshalJSON unmarshals the ElementDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ElementDefinition) UnmarshalJSON(b []byte) error {
+	type other ElementDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ElementDefinition.defaultValue[x]",
+		r.DefaultValueBase64Binary != nil || r.DefaultValueBase64BinaryElement != nil,
+		r.DefaultValueBoolean != nil || r.DefaultValueBooleanElement != nil,
+		r.DefaultValueCanonical != nil || r.DefaultValueCanonicalElement != nil,
+		r.DefaultValueCode != nil || r.DefaultValueCodeElement != nil,
+		r.DefaultValueDate != nil || r.DefaultValueDateElement != nil,
+		r.DefaultValueDateTime != nil || r.DefaultValueDateTimeElement != nil,
+		r.DefaultValueDecimal != nil || r.DefaultValueDecimalElement != nil,
+		r.DefaultValueId != nil || r.DefaultValueIdElement != nil,
+		r.DefaultValueInstant != nil || r.DefaultValueInstantElement != nil,
+		r.DefaultValueInteger != nil || r.DefaultValueIntegerElement != nil,
+		r.DefaultValueMarkdown != nil || r.DefaultValueMarkdownElement != nil,
+		r.DefaultValueOid != nil || r.DefaultValueOidElement != nil,
+		r.DefaultValuePositiveInt != nil || r.DefaultValuePositiveIntElement != nil,
+		r.DefaultValueString != nil || r.DefaultValueStringElement != nil,
+		r.DefaultValueTime != nil || r.DefaultValueTimeElement != nil,
+		r.DefaultValueUnsignedInt != nil || r.DefaultValueUnsignedIntElement != nil,
+		r.DefaultValueUri != nil || r.DefaultValueUriElement != nil,
+		r.DefaultValueUrl != nil || r.DefaultValueUrlElement != nil,
+		r.DefaultValueUuid != nil || r.DefaultValueUuidElement != nil,
+		r.DefaultValueAddress != nil,
+		r.DefaultValueAge != nil,
+		r.DefaultValueAnnotation != nil,
+		r.DefaultValueAttachment != nil,
+		r.DefaultValueCodeableConcept != nil,
+		r.DefaultValueCoding != nil,
+		r.DefaultValueContactPoint != nil,
+		r.DefaultValueCount != nil,
+		r.DefaultValueDistance != nil,
+		r.DefaultValueDuration != nil,
+		r.DefaultValueHumanName != nil,
+		r.DefaultValueIdentifier != nil,
+		r.DefaultValueMoney != nil,
+		r.DefaultValuePeriod != nil,
+		r.DefaultValueQuantity != nil,
+		r.DefaultValueRange != nil,
+		r.DefaultValueRatio != nil,
+		r.DefaultValueReference != nil,
+		r.DefaultValueSampledData != nil,
+		r.DefaultValueSignature != nil,
+		r.DefaultValueTiming != nil,
+		r.DefaultValueContactDetail != nil,
+		r.DefaultValueContributor != nil,
+		r.DefaultValueDataRequirement != nil,
+		r.DefaultValueExpression != nil,
+		r.DefaultValueParameterDefinition != nil,
+		r.DefaultValueRelatedArtifact != nil,
+		r.DefaultValueTriggerDefinition != nil,
+		r.DefaultValueUsageContext != nil,
+		r.DefaultValueDosage != nil,
+		r.DefaultValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ElementDefinition.fixed[x]",
+		r.FixedBase64Binary != nil || r.FixedBase64BinaryElement != nil,
+		r.FixedBoolean != nil || r.FixedBooleanElement != nil,
+		r.FixedCanonical != nil || r.FixedCanonicalElement != nil,
+		r.FixedCode != nil || r.FixedCodeElement != nil,
+		r.FixedDate != nil || r.FixedDateElement != nil,
+		r.FixedDateTime != nil || r.FixedDateTimeElement != nil,
+		r.FixedDecimal != nil || r.FixedDecimalElement != nil,
+		r.FixedId != nil || r.FixedIdElement != nil,
+		r.FixedInstant != nil || r.FixedInstantElement != nil,
+		r.FixedInteger != nil || r.FixedIntegerElement != nil,
+		r.FixedMarkdown != nil || r.FixedMarkdownElement != nil,
+		r.FixedOid != nil || r.FixedOidElement != nil,
+		r.FixedPositiveInt != nil || r.FixedPositiveIntElement != nil,
+		r.FixedString != nil || r.FixedStringElement != nil,
+		r.FixedTime != nil || r.FixedTimeElement != nil,
+		r.FixedUnsignedInt != nil || r.FixedUnsignedIntElement != nil,
+		r.FixedUri != nil || r.FixedUriElement != nil,
+		r.FixedUrl != nil || r.FixedUrlElement != nil,
+		r.FixedUuid != nil || r.FixedUuidElement != nil,
+		r.FixedAddress != nil,
+		r.FixedAge != nil,
+		r.FixedAnnotation != nil,
+		r.FixedAttachment != nil,
+		r.FixedCodeableConcept != nil,
+		r.FixedCoding != nil,
+		r.FixedContactPoint != nil,
+		r.FixedCount != nil,
+		r.FixedDistance != nil,
+		r.FixedDuration != nil,
+		r.FixedHumanName != nil,
+		r.FixedIdentifier != nil,
+		r.FixedMoney != nil,
+		r.FixedPeriod != nil,
+		r.FixedQuantity != nil,
+		r.FixedRange != nil,
+		r.FixedRatio != nil,
+		r.FixedReference != nil,
+		r.FixedSampledData != nil,
+		r.FixedSignature != nil,
+		r.FixedTiming != nil,
+		r.FixedContactDetail != nil,
+		r.FixedContributor != nil,
+		r.FixedDataRequirement != nil,
+		r.FixedExpression != nil,
+		r.FixedParameterDefinition != nil,
+		r.FixedRelatedArtifact != nil,
+		r.FixedTriggerDefinition != nil,
+		r.FixedUsageContext != nil,
+		r.FixedDosage != nil,
+		r.FixedMeta != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ElementDefinition.pattern[x]",
+		r.PatternBase64Binary != nil || r.PatternBase64BinaryElement != nil,
+		r.PatternBoolean != nil || r.PatternBooleanElement != nil,
+		r.PatternCanonical != nil || r.PatternCanonicalElement != nil,
+		r.PatternCode != nil || r.PatternCodeElement != nil,
+		r.PatternDate != nil || r.PatternDateElement != nil,
+		r.PatternDateTime != nil || r.PatternDateTimeElement != nil,
+		r.PatternDecimal != nil || r.PatternDecimalElement != nil,
+		r.PatternId != nil || r.PatternIdElement != nil,
+		r.PatternInstant != nil || r.PatternInstantElement != nil,
+		r.PatternInteger != nil || r.PatternIntegerElement != nil,
+		r.PatternMarkdown != nil || r.PatternMarkdownElement != nil,
+		r.PatternOid != nil || r.PatternOidElement != nil,
+		r.PatternPositiveInt != nil || r.PatternPositiveIntElement != nil,
+		r.PatternString != nil || r.PatternStringElement != nil,
+		r.PatternTime != nil || r.PatternTimeElement != nil,
+		r.PatternUnsignedInt != nil || r.PatternUnsignedIntElement != nil,
+		r.PatternUri != nil || r.PatternUriElement != nil,
+		r.PatternUrl != nil || r.PatternUrlElement != nil,
+		r.PatternUuid != nil || r.PatternUuidElement != nil,
+		r.PatternAddress != nil,
+		r.PatternAge != nil,
+		r.PatternAnnotation != nil,
+		r.PatternAttachment != nil,
+		r.PatternCodeableConcept != nil,
+		r.PatternCoding != nil,
+		r.PatternContactPoint != nil,
+		r.PatternCount != nil,
+		r.PatternDistance != nil,
+		r.PatternDuration != nil,
+		r.PatternHumanName != nil,
+		r.PatternIdentifier != nil,
+		r.PatternMoney != nil,
+		r.PatternPeriod != nil,
+		r.PatternQuantity != nil,
+		r.PatternRange != nil,
+		r.PatternRatio != nil,
+		r.PatternReference != nil,
+		r.PatternSampledData != nil,
+		r.PatternSignature != nil,
+		r.PatternTiming != nil,
+		r.PatternContactDetail != nil,
+		r.PatternContributor != nil,
+		r.PatternDataRequirement != nil,
+		r.PatternExpression != nil,
+		r.PatternParameterDefinition != nil,
+		r.PatternRelatedArtifact != nil,
+		r.PatternTriggerDefinition != nil,
+		r.PatternUsageContext != nil,
+		r.PatternDosage != nil,
+		r.PatternMeta != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ElementDefinition.minValue[x]",
+		r.MinValueDate != nil || r.MinValueDateElement != nil,
+		r.MinValueDateTime != nil || r.MinValueDateTimeElement != nil,
+		r.MinValueInstant != nil || r.MinValueInstantElement != nil,
+		r.MinValueTime != nil || r.MinValueTimeElement != nil,
+		r.MinValueDecimal != nil || r.MinValueDecimalElement != nil,
+		r.MinValueInteger != nil || r.MinValueIntegerElement != nil,
+		r.MinValuePositiveInt != nil || r.MinValuePositiveIntElement != nil,
+		r.MinValueUnsignedInt != nil || r.MinValueUnsignedIntElement != nil,
+		r.MinValueQuantity != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ElementDefinition.maxValue[x]",
+		r.MaxValueDate != nil || r.MaxValueDateElement != nil,
+		r.MaxValueDateTime != nil || r.MaxValueDateTimeElement != nil,
+		r.MaxValueInstant != nil || r.MaxValueInstantElement != nil,
+		r.MaxValueTime != nil || r.MaxValueTimeElement != nil,
+		r.MaxValueDecimal != nil || r.MaxValueDecimalElement != nil,
+		r.MaxValueInteger != nil || r.MaxValueIntegerElement != nil,
+		r.MaxValuePositiveInt != nil || r.MaxValuePositiveIntElement != nil,
+		r.MaxValueUnsignedInt != nil || r.MaxValueUnsignedIntElement != nil,
+		r.MaxValueQuantity != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ElementDefinition.representation", len(r.Representation), len(r.RepresentationElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ElementDefinition.alias", len(r.Alias), len(r.AliasElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ElementDefinition.condition", len(r.Condition), len(r.ConditionElement)); err != nil {
+		return err
+	}
+	return nil
 }
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.053871 +0000 UTC
+// 2026-10-19 02:55:18.78573528 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EligibilityRequestPurpose is documented here http://hl7.org/fhir/ValueSet/eligibilityrequest-purpose
-type EligibilityRequestPurpose int
+// The empty EligibilityRequestPurpose is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EligibilityRequestPurpose string
 
 const (
-	EligibilityRequestPurposeAuthRequirements EligibilityRequestPurpose = iota
-	EligibilityRequestPurposeBenefits
-	EligibilityRequestPurposeDiscovery
-	EligibilityRequestPurposeValidation
+	EligibilityRequestPurposeAuthRequirements EligibilityRequestPurpose = "auth-requirements"
+	EligibilityRequestPurposeBenefits         EligibilityRequestPurpose = "benefits"
+	EligibilityRequestPurposeDiscovery        EligibilityRequestPurpose = "discovery"
+	EligibilityRequestPurposeValidation       EligibilityRequestPurpose = "validation"
 )
 
 func (code EligibilityRequestPurpose) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EligibilityRequestPurpose"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EligibilityRequestPurpose) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "auth-requirements":
-		*code = EligibilityRequestPurposeAuthRequirements
-	case "benefits":
-		*code = EligibilityRequestPurposeBenefits
-	case "discovery":
-		*code = EligibilityRequestPurposeDiscovery
-	case "validation":
-		*code = EligibilityRequestPurposeValidation
-	default:
-		return fmt.Errorf("unknown EligibilityRequestPurpose code `%s`", s)
+func (code *EligibilityRequestPurpose) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = EligibilityRequestPurpose(s)
 	return nil
 }
 func (code EligibilityRequestPurpose) String() string {
 	return code.Code()
 }
 func (code EligibilityRequestPurpose) Code() string {
+	return string(code)
+}
+func (code EligibilityRequestPurpose) Known() bool {
 	switch code {
-	case EligibilityRequestPurposeAuthRequirements:
-		return "auth-requirements"
-	case EligibilityRequestPurposeBenefits:
-		return "benefits"
-	case EligibilityRequestPurposeDiscovery:
-		return "discovery"
-	case EligibilityRequestPurposeValidation:
-		return "validation"
+	case EligibilityRequestPurposeAuthRequirements, EligibilityRequestPurposeBenefits, EligibilityRequestPurposeDiscovery, EligibilityRequestPurposeValidation:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EligibilityRequestPurpose) Display() string {
 	switch code {
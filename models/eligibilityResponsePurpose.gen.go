@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.114997 +0000 UTC
+// 2026-10-19 02:55:18.786968943 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EligibilityResponsePurpose is documented here http://hl7.org/fhir/ValueSet/eligibilityresponse-purpose
-type EligibilityResponsePurpose int
+// The empty EligibilityResponsePurpose is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EligibilityResponsePurpose string
 
 const (
-	EligibilityResponsePurposeAuthRequirements EligibilityResponsePurpose = iota
-	EligibilityResponsePurposeBenefits
-	EligibilityResponsePurposeDiscovery
-	EligibilityResponsePurposeValidation
+	EligibilityResponsePurposeAuthRequirements EligibilityResponsePurpose = "auth-requirements"
+	EligibilityResponsePurposeBenefits         EligibilityResponsePurpose = "benefits"
+	EligibilityResponsePurposeDiscovery        EligibilityResponsePurpose = "discovery"
+	EligibilityResponsePurposeValidation       EligibilityResponsePurpose = "validation"
 )
 
 func (code EligibilityResponsePurpose) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EligibilityResponsePurpose"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EligibilityResponsePurpose) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "auth-requirements":
-		*code = EligibilityResponsePurposeAuthRequirements
-	case "benefits":
-		*code = EligibilityResponsePurposeBenefits
-	case "discovery":
-		*code = EligibilityResponsePurposeDiscovery
-	case "validation":
-		*code = EligibilityResponsePurposeValidation
-	default:
-		return fmt.Errorf("unknown EligibilityResponsePurpose code `%s`", s)
+func (code *EligibilityResponsePurpose) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = EligibilityResponsePurpose(s)
 	return nil
 }
 func (code EligibilityResponsePurpose) String() string {
 	return code.Code()
 }
 func (code EligibilityResponsePurpose) Code() string {
+	return string(code)
+}
+func (code EligibilityResponsePurpose) Known() bool {
 	switch code {
-	case EligibilityResponsePurposeAuthRequirements:
-		return "auth-requirements"
-	case EligibilityResponsePurposeBenefits:
-		return "benefits"
-	case EligibilityResponsePurposeDiscovery:
-		return "discovery"
-	case EligibilityResponsePurposeValidation:
-		return "validation"
+	case EligibilityResponsePurposeAuthRequirements, EligibilityResponsePurposeBenefits, EligibilityResponsePurposeDiscovery, EligibilityResponsePurposeValidation:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EligibilityResponsePurpose) Display() string {
 	switch code {
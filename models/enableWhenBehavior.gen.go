@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.194806 +0000 UTC
+// 2026-10-19 02:55:18.789793582 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EnableWhenBehavior is documented here http://hl7.org/fhir/ValueSet/questionnaire-enable-behavior
-type EnableWhenBehavior int
+// The empty EnableWhenBehavior is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EnableWhenBehavior string
 
 const (
-	EnableWhenBehaviorAll EnableWhenBehavior = iota
-	EnableWhenBehaviorAny
+	EnableWhenBehaviorAll EnableWhenBehavior = "all"
+	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
 func (code EnableWhenBehavior) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EnableWhenBehavior"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EnableWhenBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "all":
-		*code = EnableWhenBehaviorAll
-	case "any":
-		*code = EnableWhenBehaviorAny
-	default:
-		return fmt.Errorf("unknown EnableWhenBehavior code `%s`", s)
+func (code *EnableWhenBehavior) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = EnableWhenBehavior(s)
 	return nil
 }
 func (code EnableWhenBehavior) String() string {
 	return code.Code()
 }
 func (code EnableWhenBehavior) Code() string {
+	return string(code)
+}
+func (code EnableWhenBehavior) Known() bool {
 	switch code {
-	case EnableWhenBehaviorAll:
-		return "all"
-	case EnableWhenBehaviorAny:
-		return "any"
+	case EnableWhenBehaviorAll, EnableWhenBehaviorAny:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EnableWhenBehavior) Display() string {
 	switch code {
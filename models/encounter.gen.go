@@ -22,101 +22,194 @@ import "encoding/json"
 
 // Encounter is documented here http://hl7.org/fhir/StructureDefinition/Encounter
 type Encounter struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            EncounterStatus           `bson:"status" json:"status"`
-	StatusHistory     []EncounterStatusHistory  `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
-	Class             Coding                    `bson:"class" json:"class"`
-	ClassHistory      []EncounterClassHistory   `bson:"classHistory,omitempty" json:"classHistory,omitempty"`
-	Type              []CodeableConcept         `bson:"type,omitempty" json:"type,omitempty"`
-	ServiceType       *CodeableConcept          `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
-	Priority          *CodeableConcept          `bson:"priority,omitempty" json:"priority,omitempty"`
-	Subject           *Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
-	EpisodeOfCare     []Reference               `bson:"episodeOfCare,omitempty" json:"episodeOfCare,omitempty"`
-	BasedOn           []Reference               `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Participant       []EncounterParticipant    `bson:"participant,omitempty" json:"participant,omitempty"`
-	Appointment       []Reference               `bson:"appointment,omitempty" json:"appointment,omitempty"`
-	Period            *Period                   `bson:"period,omitempty" json:"period,omitempty"`
-	Length            *Duration                 `bson:"length,omitempty" json:"length,omitempty"`
-	ReasonCode        []CodeableConcept         `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference               `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Diagnosis         []EncounterDiagnosis      `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
-	Account           []Reference               `bson:"account,omitempty" json:"account,omitempty"`
-	Hospitalization   *EncounterHospitalization `bson:"hospitalization,omitempty" json:"hospitalization,omitempty"`
-	Location          []EncounterLocation       `bson:"location,omitempty" json:"location,omitempty"`
-	ServiceProvider   *Reference                `bson:"serviceProvider,omitempty" json:"serviceProvider,omitempty"`
-	PartOf            *Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               EncounterStatus            `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusHistory        []EncounterStatusHistory   `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
+	Class                Coding                     `bson:"class" json:"class"`
+	ClassHistory         []EncounterClassHistory    `bson:"classHistory,omitempty" json:"classHistory,omitempty"`
+	Type                 []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	ServiceType          *CodeableConcept           `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
+	Priority             *CodeableConcept           `bson:"priority,omitempty" json:"priority,omitempty"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	EpisodeOfCare        []Reference                `bson:"episodeOfCare,omitempty" json:"episodeOfCare,omitempty"`
+	BasedOn              []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Participant          []EncounterParticipant     `bson:"participant,omitempty" json:"participant,omitempty"`
+	Appointment          []Reference                `bson:"appointment,omitempty" json:"appointment,omitempty"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Length               *Duration                  `bson:"length,omitempty" json:"length,omitempty"`
+	ReasonCode           []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference      []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Diagnosis            []EncounterDiagnosis       `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
+	Account              []Reference                `bson:"account,omitempty" json:"account,omitempty"`
+	Hospitalization      *EncounterHospitalization  `bson:"hospitalization,omitempty" json:"hospitalization,omitempty"`
+	Location             []EncounterLocation        `bson:"location,omitempty" json:"location,omitempty"`
+	ServiceProvider      *Reference                 `bson:"serviceProvider,omitempty" json:"serviceProvider,omitempty"`
+	PartOf               *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type EncounterStatusHistory struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Status            EncounterStatus `bson:"status" json:"status"`
-	Period            Period          `bson:"period" json:"period"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status            EncounterStatus            `bson:"status" json:"status"`
+	StatusElement     *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period            Period                     `bson:"period" json:"period"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the EncounterStatusHistory with its unknown fields.
+func (r EncounterStatusHistory) MarshalJSON() ([]byte, error) {
+	type other EncounterStatusHistory
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type EncounterClassHistory struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Class             Coding      `bson:"class" json:"class"`
-	Period            Period      `bson:"period" json:"period"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Class             Coding                     `bson:"class" json:"class"`
+	Period            Period                     `bson:"period" json:"period"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EncounterClassHistory with its unknown fields.
+func (r EncounterClassHistory) MarshalJSON() ([]byte, error) {
+	type other EncounterClassHistory
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type EncounterParticipant struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Period            *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	Individual        *Reference        `bson:"individual,omitempty" json:"individual,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Individual        *Reference                 `bson:"individual,omitempty" json:"individual,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EncounterParticipant with its unknown fields.
+func (r EncounterParticipant) MarshalJSON() ([]byte, error) {
+	type other EncounterParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type EncounterDiagnosis struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Condition         Reference        `bson:"condition" json:"condition"`
-	Use               *CodeableConcept `bson:"use,omitempty" json:"use,omitempty"`
-	Rank              *int             `bson:"rank,omitempty" json:"rank,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Condition         Reference                  `bson:"condition" json:"condition"`
+	Use               *CodeableConcept           `bson:"use,omitempty" json:"use,omitempty"`
+	Rank              *int                       `bson:"rank,omitempty" json:"rank,omitempty"`
+	RankElement       *Element                   `bson:"_rank,omitempty" json:"_rank,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EncounterDiagnosis with its unknown fields.
+func (r EncounterDiagnosis) MarshalJSON() ([]byte, error) {
+	type other EncounterDiagnosis
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type EncounterHospitalization struct {
-	ID                     *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	PreAdmissionIdentifier *Identifier       `bson:"preAdmissionIdentifier,omitempty" json:"preAdmissionIdentifier,omitempty"`
-	Origin                 *Reference        `bson:"origin,omitempty" json:"origin,omitempty"`
-	AdmitSource            *CodeableConcept  `bson:"admitSource,omitempty" json:"admitSource,omitempty"`
-	ReAdmission            *CodeableConcept  `bson:"reAdmission,omitempty" json:"reAdmission,omitempty"`
-	DietPreference         []CodeableConcept `bson:"dietPreference,omitempty" json:"dietPreference,omitempty"`
-	SpecialCourtesy        []CodeableConcept `bson:"specialCourtesy,omitempty" json:"specialCourtesy,omitempty"`
-	SpecialArrangement     []CodeableConcept `bson:"specialArrangement,omitempty" json:"specialArrangement,omitempty"`
-	Destination            *Reference        `bson:"destination,omitempty" json:"destination,omitempty"`
-	DischargeDisposition   *CodeableConcept  `bson:"dischargeDisposition,omitempty" json:"dischargeDisposition,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	PreAdmissionIdentifier *Identifier                `bson:"preAdmissionIdentifier,omitempty" json:"preAdmissionIdentifier,omitempty"`
+	Origin                 *Reference                 `bson:"origin,omitempty" json:"origin,omitempty"`
+	AdmitSource            *CodeableConcept           `bson:"admitSource,omitempty" json:"admitSource,omitempty"`
+	ReAdmission            *CodeableConcept           `bson:"reAdmission,omitempty" json:"reAdmission,omitempty"`
+	DietPreference         []CodeableConcept          `bson:"dietPreference,omitempty" json:"dietPreference,omitempty"`
+	SpecialCourtesy        []CodeableConcept          `bson:"specialCourtesy,omitempty" json:"specialCourtesy,omitempty"`
+	SpecialArrangement     []CodeableConcept          `bson:"specialArrangement,omitempty" json:"specialArrangement,omitempty"`
+	Destination            *Reference                 `bson:"destination,omitempty" json:"destination,omitempty"`
+	DischargeDisposition   *CodeableConcept           `bson:"dischargeDisposition,omitempty" json:"dischargeDisposition,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the EncounterHospitalization with its unknown fields.
+func (r EncounterHospitalization) MarshalJSON() ([]byte, error) {
+	type other EncounterHospitalization
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type EncounterLocation struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Location          Reference                `bson:"location" json:"location"`
-	Status            *EncounterLocationStatus `bson:"status,omitempty" json:"status,omitempty"`
-	PhysicalType      *CodeableConcept         `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
-	Period            *Period                  `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Location          Reference                  `bson:"location" json:"location"`
+	Status            *EncounterLocationStatus   `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement     *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	PhysicalType      *CodeableConcept           `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EncounterLocation with its unknown fields.
+func (r EncounterLocation) MarshalJSON() ([]byte, error) {
+	type other EncounterLocation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the Encounter and fails with CodeError when a coded element has an unknown code.
+func (r *Encounter) UnmarshalJSON(b []byte) error {
+	type other Encounter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Encounter", r)
+}
+
 type OtherEncounter Encounter
 
 // MarshalJSON marshals the given Encounter as JSON into a byte slice
 func (r Encounter) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEncounter
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEncounter: OtherEncounter(r),
 		ResourceType:   "Encounter",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEncounter unmarshals a Encounter.
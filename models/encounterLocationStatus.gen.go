@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.185716 +0000 UTC
+// 2026-10-19 02:55:18.790633897 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EncounterLocationStatus is documented here http://hl7.org/fhir/ValueSet/encounter-location-status
-type EncounterLocationStatus int
+// The empty EncounterLocationStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EncounterLocationStatus string
 
 const (
-	EncounterLocationStatusPlanned EncounterLocationStatus = iota
-	EncounterLocationStatusActive
-	EncounterLocationStatusReserved
-	EncounterLocationStatusCompleted
+	EncounterLocationStatusPlanned   EncounterLocationStatus = "planned"
+	EncounterLocationStatusActive    EncounterLocationStatus = "active"
+	EncounterLocationStatusReserved  EncounterLocationStatus = "reserved"
+	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
 func (code EncounterLocationStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EncounterLocationStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EncounterLocationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "planned":
-		*code = EncounterLocationStatusPlanned
-	case "active":
-		*code = EncounterLocationStatusActive
-	case "reserved":
-		*code = EncounterLocationStatusReserved
-	case "completed":
-		*code = EncounterLocationStatusCompleted
-	default:
-		return fmt.Errorf("unknown EncounterLocationStatus code `%s`", s)
+func (code *EncounterLocationStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = EncounterLocationStatus(s)
 	return nil
 }
 func (code EncounterLocationStatus) String() string {
 	return code.Code()
 }
 func (code EncounterLocationStatus) Code() string {
+	return string(code)
+}
+func (code EncounterLocationStatus) Known() bool {
 	switch code {
-	case EncounterLocationStatusPlanned:
-		return "planned"
-	case EncounterLocationStatusActive:
-		return "active"
-	case EncounterLocationStatusReserved:
-		return "reserved"
-	case EncounterLocationStatusCompleted:
-		return "completed"
+	case EncounterLocationStatusPlanned, EncounterLocationStatusActive, EncounterLocationStatusReserved, EncounterLocationStatusCompleted:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EncounterLocationStatus) Display() string {
 	switch code {
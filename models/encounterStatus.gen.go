@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.136026 +0000 UTC
+// 2026-10-19 02:55:18.794037229 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EncounterStatus is documented here http://hl7.org/fhir/ValueSet/encounter-status
-type EncounterStatus int
+// The empty EncounterStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EncounterStatus string
 
 const (
-	EncounterStatusPlanned EncounterStatus = iota
-	EncounterStatusArrived
-	EncounterStatusTriaged
-	EncounterStatusInProgress
-	EncounterStatusOnleave
-	EncounterStatusFinished
-	EncounterStatusCancelled
-	EncounterStatusEnteredInError
-	EncounterStatusUnknown
+	EncounterStatusPlanned        EncounterStatus = "planned"
+	EncounterStatusArrived        EncounterStatus = "arrived"
+	EncounterStatusTriaged        EncounterStatus = "triaged"
+	EncounterStatusInProgress     EncounterStatus = "in-progress"
+	EncounterStatusOnleave        EncounterStatus = "onleave"
+	EncounterStatusFinished       EncounterStatus = "finished"
+	EncounterStatusCancelled      EncounterStatus = "cancelled"
+	EncounterStatusEnteredInError EncounterStatus = "entered-in-error"
+	EncounterStatusUnknown        EncounterStatus = "unknown"
 )
 
 func (code EncounterStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EncounterStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EncounterStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "planned":
-		*code = EncounterStatusPlanned
-	case "arrived":
-		*code = EncounterStatusArrived
-	case "triaged":
-		*code = EncounterStatusTriaged
-	case "in-progress":
-		*code = EncounterStatusInProgress
-	case "onleave":
-		*code = EncounterStatusOnleave
-	case "finished":
-		*code = EncounterStatusFinished
-	case "cancelled":
-		*code = EncounterStatusCancelled
-	case "entered-in-error":
-		*code = EncounterStatusEnteredInError
-	case "unknown":
-		*code = EncounterStatusUnknown
-	default:
-		return fmt.Errorf("unknown EncounterStatus code `%s`", s)
+func (code *EncounterStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = EncounterStatus(s)
 	return nil
 }
 func (code EncounterStatus) String() string {
 	return code.Code()
 }
 func (code EncounterStatus) Code() string {
+	return string(code)
+}
+func (code EncounterStatus) Known() bool {
 	switch code {
-	case EncounterStatusPlanned:
-		return "planned"
-	case EncounterStatusArrived:
-		return "arrived"
-	case EncounterStatusTriaged:
-		return "triaged"
-	case EncounterStatusInProgress:
-		return "in-progress"
-	case EncounterStatusOnleave:
-		return "onleave"
-	case EncounterStatusFinished:
-		return "finished"
-	case EncounterStatusCancelled:
-		return "cancelled"
-	case EncounterStatusEnteredInError:
-		return "entered-in-error"
-	case EncounterStatusUnknown:
-		return "unknown"
+	case EncounterStatusPlanned, EncounterStatusArrived, EncounterStatusTriaged, EncounterStatusInProgress, EncounterStatusOnleave, EncounterStatusFinished, EncounterStatusCancelled, EncounterStatusEnteredInError, EncounterStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EncounterStatus) Display() string {
 	switch code {
@@ -22,36 +22,65 @@ import "encoding/json"
 
 // Endpoint is documented here http://hl7.org/fhir/StructureDefinition/Endpoint
 type Endpoint struct {
-	ID                   *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status               EndpointStatus    `bson:"status" json:"status"`
-	ConnectionType       Coding            `bson:"connectionType" json:"connectionType"`
-	Name                 *string           `bson:"name,omitempty" json:"name,omitempty"`
-	ManagingOrganization *Reference        `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	Contact              []ContactPoint    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Period               *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	PayloadType          []CodeableConcept `bson:"payloadType" json:"payloadType"`
-	PayloadMimeType      []string          `bson:"payloadMimeType,omitempty" json:"payloadMimeType,omitempty"`
-	Address              string            `bson:"address" json:"address"`
-	Header               []string          `bson:"header,omitempty" json:"header,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                 EndpointStatus             `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	ConnectionType         Coding                     `bson:"connectionType" json:"connectionType"`
+	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	ManagingOrganization   *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	Contact                []ContactPoint             `bson:"contact,omitempty" json:"contact,omitempty"`
+	Period                 *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	PayloadType            []CodeableConcept          `bson:"payloadType" json:"payloadType"`
+	PayloadMimeType        []*string                  `bson:"payloadMimeType,omitempty" json:"payloadMimeType,omitempty"`
+	PayloadMimeTypeElement []*Element                 `bson:"_payloadMimeType,omitempty" json:"_payloadMimeType,omitempty"`
+	Address                string                     `bson:"address" json:"address"`
+	AddressElement         *Element                   `bson:"_address,omitempty" json:"_address,omitempty"`
+	Header                 []*string                  `bson:"header,omitempty" json:"header,omitempty"`
+	HeaderElement          []*Element                 `bson:"_header,omitempty" json:"_header,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Endpoint and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Endpoint) UnmarshalJSON(b []byte) error {
+	type other Endpoint
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Endpoint.payloadMimeType", len(r.PayloadMimeType), len(r.PayloadMimeTypeElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Endpoint.header", len(r.Header), len(r.HeaderElement)); err != nil {
+		return err
+	}
+	return checkCodes("Endpoint", r)
+}
+
 type OtherEndpoint Endpoint
 
 // MarshalJSON marshals the given Endpoint as JSON into a byte slice
 func (r Endpoint) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEndpoint
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEndpoint: OtherEndpoint(r),
 		ResourceType:  "Endpoint",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEndpoint unmarshals a Endpoint.
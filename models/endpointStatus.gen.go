@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.234109 +0000 UTC
+// 2026-10-19 02:55:18.797720045 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EndpointStatus is documented here http://hl7.org/fhir/ValueSet/endpoint-status
-type EndpointStatus int
+// The empty EndpointStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EndpointStatus string
 
 const (
-	EndpointStatusActive EndpointStatus = iota
-	EndpointStatusSuspended
-	EndpointStatusError
-	EndpointStatusOff
-	EndpointStatusEnteredInError
-	EndpointStatusTest
+	EndpointStatusActive         EndpointStatus = "active"
+	EndpointStatusSuspended      EndpointStatus = "suspended"
+	EndpointStatusError          EndpointStatus = "error"
+	EndpointStatusOff            EndpointStatus = "off"
+	EndpointStatusEnteredInError EndpointStatus = "entered-in-error"
+	EndpointStatusTest           EndpointStatus = "test"
 )
 
 func (code EndpointStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EndpointStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EndpointStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = EndpointStatusActive
-	case "suspended":
-		*code = EndpointStatusSuspended
-	case "error":
-		*code = EndpointStatusError
-	case "off":
-		*code = EndpointStatusOff
-	case "entered-in-error":
-		*code = EndpointStatusEnteredInError
-	case "test":
-		*code = EndpointStatusTest
-	default:
-		return fmt.Errorf("unknown EndpointStatus code `%s`", s)
+func (code *EndpointStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = EndpointStatus(s)
 	return nil
 }
 func (code EndpointStatus) String() string {
 	return code.Code()
 }
 func (code EndpointStatus) Code() string {
+	return string(code)
+}
+func (code EndpointStatus) Known() bool {
 	switch code {
-	case EndpointStatusActive:
-		return "active"
-	case EndpointStatusSuspended:
-		return "suspended"
-	case EndpointStatusError:
-		return "error"
-	case EndpointStatusOff:
-		return "off"
-	case EndpointStatusEnteredInError:
-		return "entered-in-error"
-	case EndpointStatusTest:
-		return "test"
+	case EndpointStatusActive, EndpointStatusSuspended, EndpointStatusError, EndpointStatusOff, EndpointStatusEnteredInError, EndpointStatusTest:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EndpointStatus) Display() string {
 	switch code {
@@ -22,32 +22,52 @@ import "encoding/json"
 
 // EnrollmentRequest is documented here http://hl7.org/fhir/StructureDefinition/EnrollmentRequest
 type EnrollmentRequest struct {
-	ID                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
-	Created           *DateTime                     `bson:"created,omitempty" json:"created,omitempty"`
-	Insurer           *Reference                    `bson:"insurer,omitempty" json:"insurer,omitempty"`
-	Provider          *Reference                    `bson:"provider,omitempty" json:"provider,omitempty"`
-	Candidate         *Reference                    `bson:"candidate,omitempty" json:"candidate,omitempty"`
-	Coverage          *Reference                    `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	ID                   *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	Created              *DateTime                     `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                      `bson:"_created,omitempty" json:"_created,omitempty"`
+	Insurer              *Reference                    `bson:"insurer,omitempty" json:"insurer,omitempty"`
+	Provider             *Reference                    `bson:"provider,omitempty" json:"provider,omitempty"`
+	Candidate            *Reference                    `bson:"candidate,omitempty" json:"candidate,omitempty"`
+	Coverage             *Reference                    `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	UnknownFields        map[string]json.RawMessage    `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the EnrollmentRequest and fails with CodeError when a coded element has an unknown code.
+func (r *EnrollmentRequest) UnmarshalJSON(b []byte) error {
+	type other EnrollmentRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("EnrollmentRequest", r)
+}
+
 type OtherEnrollmentRequest EnrollmentRequest
 
 // MarshalJSON marshals the given EnrollmentRequest as JSON into a byte slice
 func (r EnrollmentRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEnrollmentRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEnrollmentRequest: OtherEnrollmentRequest(r),
 		ResourceType:           "EnrollmentRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEnrollmentRequest unmarshals a EnrollmentRequest.
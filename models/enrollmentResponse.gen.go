@@ -22,33 +22,55 @@ import "encoding/json"
 
 // EnrollmentResponse is documented here http://hl7.org/fhir/StructureDefinition/EnrollmentResponse
 type EnrollmentResponse struct {
-	ID                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
-	Request           *Reference                    `bson:"request,omitempty" json:"request,omitempty"`
-	Outcome           *ClaimProcessingCodes         `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Disposition       *string                       `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	Created           *DateTime                     `bson:"created,omitempty" json:"created,omitempty"`
-	Organization      *Reference                    `bson:"organization,omitempty" json:"organization,omitempty"`
-	RequestProvider   *Reference                    `bson:"requestProvider,omitempty" json:"requestProvider,omitempty"`
+	ID                   *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	Request              *Reference                    `bson:"request,omitempty" json:"request,omitempty"`
+	Outcome              *ClaimProcessingCodes         `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	OutcomeElement       *Element                      `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	Disposition          *string                       `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                      `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	Created              *DateTime                     `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                      `bson:"_created,omitempty" json:"_created,omitempty"`
+	Organization         *Reference                    `bson:"organization,omitempty" json:"organization,omitempty"`
+	RequestProvider      *Reference                    `bson:"requestProvider,omitempty" json:"requestProvider,omitempty"`
+	UnknownFields        map[string]json.RawMessage    `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the EnrollmentResponse and fails with CodeError when a coded element has an unknown code.
+func (r *EnrollmentResponse) UnmarshalJSON(b []byte) error {
+	type other EnrollmentResponse
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("EnrollmentResponse", r)
+}
+
 type OtherEnrollmentResponse EnrollmentResponse
 
 // MarshalJSON marshals the given EnrollmentResponse as JSON into a byte slice
 func (r EnrollmentResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEnrollmentResponse
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEnrollmentResponse: OtherEnrollmentResponse(r),
 		ResourceType:            "EnrollmentResponse",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEnrollmentResponse unmarshals a EnrollmentResponse.
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// UnsetCodeError is returned by MarshalJSON of the enums when the code is not set, for example when
+// the required Observation.status is missing.
+type UnsetCodeError struct {
+	Type string
+}
+
+func (e UnsetCodeError) Error() string {
+	return fmt.Sprintf("%s code is not set", e.Type)
+}
+
+// CodeError is returned by UnmarshalJSON of the resources for the unknown codes of their enums, Path is the element
+// with the code, for example Encounter.statusHistory[0].status. The data types and backbone elements decoded on their
+// own, such as HumanName, don't check their codes, Decoder.CheckCodes returns CodeError for them.
+type CodeError struct {
+	Type string
+	Code string
+	Path string
+}
+
+func (e CodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("unknown %s code `%s`", e.Type, e.Code)
+	}
+	return fmt.Sprintf("unknown %s code `%s` at %s", e.Type, e.Code, e.Path)
+}
+
+// knownCoder is implemented by the enums generated for the ValueSets.
+type knownCoder interface {
+	Code() string
+	Known() bool
+}
+
+var knownCoderType = reflect.TypeOf((*knownCoder)(nil)).Elem()
+
+// isEnum reports whether the type is the enum generated for a ValueSet.
+func isEnum(t reflect.Type) bool {
+	return t.Kind() == reflect.String && t.Implements(knownCoderType)
+}
+
+// checkCodes returns CodeError for the first unknown code in the resource, a pointer to the resource of the type.
+func checkCodes(resourceType string, resource interface{}) error {
+	var codeErr error
+	walkCodes(reflect.ValueOf(resource), resourceType, nil, func(err CodeError) bool {
+		codeErr = err
+		return false
+	})
+	return codeErr
+}
+
+// walkCodes visits the unknown codes of the enums in the value, the path consists of the JSON names of the elements
+// and the indexes of the items. The walk stops when visit returns false.
+func walkCodes(v reflect.Value, root string, path []interface{}, visit func(CodeError) bool) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	switch {
+	case isEnum(v.Type()):
+		code := v.Interface().(knownCoder)
+		if v.Len() == 0 || code.Known() {
+			return true
+		}
+		return visit(CodeError{Type: v.Type().Name(), Code: code.Code(), Path: formatPath(root, path)})
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8:
+		for i := 0; i < v.Len(); i++ {
+			if !walkCodes(v.Index(i), root, append(path[:len(path):len(path)], i), visit) {
+				return false
+			}
+		}
+	case v.Kind() == reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name := jsonName(t.Field(i))
+			if name == "" || name == "-" {
+				continue
+			}
+			if !walkCodes(v.Field(i), root, append(path[:len(path):len(path)], name), visit) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEnumSystem(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected FHIRAllTypes
+		system   string
+	}{
+		{name: "data type", code: "Quantity", expected: FHIRAllTypesQuantity, system: "http://hl7.org/fhir/data-types"},
+		{name: "primitive type", code: "dateTime", expected: FHIRAllTypesDateTime, system: "http://hl7.org/fhir/data-types"},
+		{name: "abstract type", code: "Any", expected: FHIRAllTypesAny, system: "http://hl7.org/fhir/abstract-types"},
+		{name: "resource type", code: "Patient", expected: FHIRAllTypesPatient, system: "http://hl7.org/fhir/resource-types"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var definition ParameterDefinition
+			if err := json.Unmarshal([]byte(`{"use":"in","type":"`+tt.code+`"}`), &definition); err != nil {
+				t.Fatal(err)
+			}
+			if definition.Type != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, definition.Type)
+			}
+			if system := definition.Type.System(); system != tt.system {
+				t.Errorf("expected system %s, got %s", tt.system, system)
+			}
+			b, err := json.Marshal(definition.Type)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != `"`+tt.code+`"` {
+				t.Errorf("expected code %s, got %s", tt.code, b)
+			}
+		})
+	}
+}
+
+func TestEnumUnset(t *testing.T) {
+	observation, err := UnmarshalObservation([]byte(`{"resourceType":"Observation","code":{"text":"Glucose"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if observation.Status != "" {
+		t.Errorf("expected unset status, got %s", observation.Status)
+	}
+	_, err = json.Marshal(observation)
+	var unsetErr UnsetCodeError
+	if !errors.As(err, &unsetErr) || unsetErr.Type != "ObservationStatus" {
+		t.Errorf("expected UnsetCodeError, got %v", err)
+	}
+
+	observation.Status = ObservationStatusRegistered
+	if _, err := json.Marshal(observation); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestEnumUnknownCode(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want CodeError
+	}{
+		{
+			name: "resource element",
+			data: `{"resourceType":"Encounter","status":"done"}`,
+			want: CodeError{Type: "EncounterStatus", Code: "done", Path: "Encounter.status"},
+		},
+		{
+			name: "backbone element",
+			data: `{"resourceType":"Encounter","status":"finished","statusHistory":[{"status":"finished"},{"status":"gone"}]}`,
+			want: CodeError{Type: "EncounterStatus", Code: "gone", Path: "Encounter.statusHistory[1].status"},
+		},
+		{
+			name: "contained resource",
+			data: `{"resourceType":"Encounter","status":"finished","contained":[{"resourceType":"Encounter","status":"gone"}]}`,
+			want: CodeError{Type: "EncounterStatus", Code: "gone", Path: "Encounter.contained[0].status"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalEncounter([]byte(tt.data))
+			var codeErr CodeError
+			if !errors.As(err, &codeErr) || codeErr != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+			var encounter Encounter
+			if err := json.Unmarshal([]byte(tt.data), &encounter); !errors.As(err, &codeErr) || codeErr != tt.want {
+				t.Errorf("expected %v from json.Unmarshal, got %v", tt.want, err)
+			}
+		})
+	}
+}
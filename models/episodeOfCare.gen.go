@@ -25,12 +25,16 @@ type EpisodeOfCare struct {
 	ID                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               EpisodeOfCareStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusHistory        []EpisodeOfCareStatusHistory `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
 	Type                 []CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
 	Diagnosis            []EpisodeOfCareDiagnosis     `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
@@ -41,33 +45,73 @@ type EpisodeOfCare struct {
 	CareManager          *Reference                   `bson:"careManager,omitempty" json:"careManager,omitempty"`
 	Team                 []Reference                  `bson:"team,omitempty" json:"team,omitempty"`
 	Account              []Reference                  `bson:"account,omitempty" json:"account,omitempty"`
+	UnknownFields        map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type EpisodeOfCareStatusHistory struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Status            EpisodeOfCareStatus `bson:"status" json:"status"`
-	Period            Period              `bson:"period" json:"period"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status            EpisodeOfCareStatus        `bson:"status" json:"status"`
+	StatusElement     *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period            Period                     `bson:"period" json:"period"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the EpisodeOfCareStatusHistory with its unknown fields.
+func (r EpisodeOfCareStatusHistory) MarshalJSON() ([]byte, error) {
+	type other EpisodeOfCareStatusHistory
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type EpisodeOfCareDiagnosis struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Condition         Reference        `bson:"condition" json:"condition"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Rank              *int             `bson:"rank,omitempty" json:"rank,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Condition         Reference                  `bson:"condition" json:"condition"`
+	Role              *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	Rank              *int                       `bson:"rank,omitempty" json:"rank,omitempty"`
+	RankElement       *Element                   `bson:"_rank,omitempty" json:"_rank,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the EpisodeOfCareDiagnosis with its unknown fields.
+func (r EpisodeOfCareDiagnosis) MarshalJSON() ([]byte, error) {
+	type other EpisodeOfCareDiagnosis
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the EpisodeOfCare and fails with CodeError when a coded element has an unknown code.
+func (r *EpisodeOfCare) UnmarshalJSON(b []byte) error {
+	type other EpisodeOfCare
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("EpisodeOfCare", r)
 }
+
 type OtherEpisodeOfCare EpisodeOfCare
 
 // MarshalJSON marshals the given EpisodeOfCare as JSON into a byte slice
 func (r EpisodeOfCare) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEpisodeOfCare
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEpisodeOfCare: OtherEpisodeOfCare(r),
 		ResourceType:       "EpisodeOfCare",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEpisodeOfCare unmarshals a EpisodeOfCare.
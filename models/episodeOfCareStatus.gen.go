@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.260963 +0000 UTC
+// 2026-10-19 02:55:18.799007527 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EpisodeOfCareStatus is documented here http://hl7.org/fhir/ValueSet/episode-of-care-status
-type EpisodeOfCareStatus int
+// The empty EpisodeOfCareStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EpisodeOfCareStatus string
 
 const (
-	EpisodeOfCareStatusPlanned EpisodeOfCareStatus = iota
-	EpisodeOfCareStatusWaitlist
-	EpisodeOfCareStatusActive
-	EpisodeOfCareStatusOnhold
-	EpisodeOfCareStatusFinished
-	EpisodeOfCareStatusCancelled
-	EpisodeOfCareStatusEnteredInError
+	EpisodeOfCareStatusPlanned        EpisodeOfCareStatus = "planned"
+	EpisodeOfCareStatusWaitlist       EpisodeOfCareStatus = "waitlist"
+	EpisodeOfCareStatusActive         EpisodeOfCareStatus = "active"
+	EpisodeOfCareStatusOnhold         EpisodeOfCareStatus = "onhold"
+	EpisodeOfCareStatusFinished       EpisodeOfCareStatus = "finished"
+	EpisodeOfCareStatusCancelled      EpisodeOfCareStatus = "cancelled"
+	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
 func (code EpisodeOfCareStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EpisodeOfCareStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EpisodeOfCareStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "planned":
-		*code = EpisodeOfCareStatusPlanned
-	case "waitlist":
-		*code = EpisodeOfCareStatusWaitlist
-	case "active":
-		*code = EpisodeOfCareStatusActive
-	case "onhold":
-		*code = EpisodeOfCareStatusOnhold
-	case "finished":
-		*code = EpisodeOfCareStatusFinished
-	case "cancelled":
-		*code = EpisodeOfCareStatusCancelled
-	case "entered-in-error":
-		*code = EpisodeOfCareStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown EpisodeOfCareStatus code `%s`", s)
+func (code *EpisodeOfCareStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = EpisodeOfCareStatus(s)
 	return nil
 }
 func (code EpisodeOfCareStatus) String() string {
 	return code.Code()
 }
 func (code EpisodeOfCareStatus) Code() string {
+	return string(code)
+}
+func (code EpisodeOfCareStatus) Known() bool {
 	switch code {
-	case EpisodeOfCareStatusPlanned:
-		return "planned"
-	case EpisodeOfCareStatusWaitlist:
-		return "waitlist"
-	case EpisodeOfCareStatusActive:
-		return "active"
-	case EpisodeOfCareStatusOnhold:
-		return "onhold"
-	case EpisodeOfCareStatusFinished:
-		return "finished"
-	case EpisodeOfCareStatusCancelled:
-		return "cancelled"
-	case EpisodeOfCareStatusEnteredInError:
-		return "entered-in-error"
+	case EpisodeOfCareStatusPlanned, EpisodeOfCareStatusWaitlist, EpisodeOfCareStatusActive, EpisodeOfCareStatusOnhold, EpisodeOfCareStatusFinished, EpisodeOfCareStatusCancelled, EpisodeOfCareStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EpisodeOfCareStatus) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.192627 +0000 UTC
+// 2026-10-19 02:55:18.801973801 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EventCapabilityMode is documented here http://hl7.org/fhir/ValueSet/event-capability-mode
-type EventCapabilityMode int
+// The empty EventCapabilityMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EventCapabilityMode string
 
 const (
-	EventCapabilityModeSender EventCapabilityMode = iota
-	EventCapabilityModeReceiver
+	EventCapabilityModeSender   EventCapabilityMode = "sender"
+	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
 func (code EventCapabilityMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EventCapabilityMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EventCapabilityMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "sender":
-		*code = EventCapabilityModeSender
-	case "receiver":
-		*code = EventCapabilityModeReceiver
-	default:
-		return fmt.Errorf("unknown EventCapabilityMode code `%s`", s)
+func (code *EventCapabilityMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = EventCapabilityMode(s)
 	return nil
 }
 func (code EventCapabilityMode) String() string {
 	return code.Code()
 }
 func (code EventCapabilityMode) Code() string {
+	return string(code)
+}
+func (code EventCapabilityMode) Known() bool {
 	switch code {
-	case EventCapabilityModeSender:
-		return "sender"
-	case EventCapabilityModeReceiver:
-		return "receiver"
+	case EventCapabilityModeSender, EventCapabilityModeReceiver:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EventCapabilityMode) Display() string {
 	switch code {
@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.258471 +0000 UTC
+// 2026-10-19 02:55:18.807602346 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EventStatus is documented here http://hl7.org/fhir/ValueSet/event-status
-type EventStatus int
+// The empty EventStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EventStatus string
 
 const (
-	EventStatusPreparation EventStatus = iota
-	EventStatusInProgress
-	EventStatusNotDone
-	EventStatusOnHold
-	EventStatusStopped
-	EventStatusCompleted
-	EventStatusEnteredInError
-	EventStatusUnknown
+	EventStatusPreparation    EventStatus = "preparation"
+	EventStatusInProgress     EventStatus = "in-progress"
+	EventStatusNotDone        EventStatus = "not-done"
+	EventStatusOnHold         EventStatus = "on-hold"
+	EventStatusStopped        EventStatus = "stopped"
+	EventStatusCompleted      EventStatus = "completed"
+	EventStatusEnteredInError EventStatus = "entered-in-error"
+	EventStatusUnknown        EventStatus = "unknown"
 )
 
 func (code EventStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EventStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EventStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "preparation":
-		*code = EventStatusPreparation
-	case "in-progress":
-		*code = EventStatusInProgress
-	case "not-done":
-		*code = EventStatusNotDone
-	case "on-hold":
-		*code = EventStatusOnHold
-	case "stopped":
-		*code = EventStatusStopped
-	case "completed":
-		*code = EventStatusCompleted
-	case "entered-in-error":
-		*code = EventStatusEnteredInError
-	case "unknown":
-		*code = EventStatusUnknown
-	default:
-		return fmt.Errorf("unknown EventStatus code `%s`", s)
+func (code *EventStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = EventStatus(s)
 	return nil
 }
 func (code EventStatus) String() string {
 	return code.Code()
 }
 func (code EventStatus) Code() string {
+	return string(code)
+}
+func (code EventStatus) Known() bool {
 	switch code {
-	case EventStatusPreparation:
-		return "preparation"
-	case EventStatusInProgress:
-		return "in-progress"
-	case EventStatusNotDone:
-		return "not-done"
-	case EventStatusOnHold:
-		return "on-hold"
-	case EventStatusStopped:
-		return "stopped"
-	case EventStatusCompleted:
-		return "completed"
-	case EventStatusEnteredInError:
-		return "entered-in-error"
-	case EventStatusUnknown:
-		return "unknown"
+	case EventStatusPreparation, EventStatusInProgress, EventStatusNotDone, EventStatusOnHold, EventStatusStopped, EventStatusCompleted, EventStatusEnteredInError, EventStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EventStatus) Display() string {
 	switch code {
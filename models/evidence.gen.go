@@ -22,53 +22,84 @@ import "encoding/json"
 
 // Evidence is documented here http://hl7.org/fhir/StructureDefinition/Evidence
 type Evidence struct {
-	ID                 *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL                *string           `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier         []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version            *string           `bson:"version,omitempty" json:"version,omitempty"`
-	Name               *string           `bson:"name,omitempty" json:"name,omitempty"`
-	Title              *string           `bson:"title,omitempty" json:"title,omitempty"`
-	ShortTitle         *string           `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
-	Subtitle           *string           `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status             PublicationStatus `bson:"status" json:"status"`
-	Date               *DateTime         `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher          *string           `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact            []ContactDetail   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description        *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Note               []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-	UseContext         []UsageContext    `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction       []CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright          *string           `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate       *DateTime         `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate     *DateTime         `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod    *Period           `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic              []CodeableConcept `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author             []ContactDetail   `bson:"author,omitempty" json:"author,omitempty"`
-	Editor             []ContactDetail   `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer           []ContactDetail   `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser           []ContactDetail   `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact    []RelatedArtifact `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	ExposureBackground Reference         `bson:"exposureBackground" json:"exposureBackground"`
-	ExposureVariant    []Reference       `bson:"exposureVariant,omitempty" json:"exposureVariant,omitempty"`
-	Outcome            []Reference       `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                   *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement            *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	ShortTitle            *string                    `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
+	ShortTitleElement     *Element                   `bson:"_shortTitle,omitempty" json:"_shortTitle,omitempty"`
+	Subtitle              *string                    `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement       *Element                   `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                PublicationStatus          `bson:"status" json:"status"`
+	StatusElement         *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Date                  *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Note                  []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UseContext            []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *DateTime                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *DateTime                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                 []CodeableConcept          `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                []ContactDetail            `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                []ContactDetail            `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer              []ContactDetail            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser              []ContactDetail            `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact       []RelatedArtifact          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	ExposureBackground    Reference                  `bson:"exposureBackground" json:"exposureBackground"`
+	ExposureVariant       []Reference                `bson:"exposureVariant,omitempty" json:"exposureVariant,omitempty"`
+	Outcome               []Reference                `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Evidence and fails with CodeError when a coded element has an unknown code.
+func (r *Evidence) UnmarshalJSON(b []byte) error {
+	type other Evidence
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Evidence", r)
+}
+
 type OtherEvidence Evidence
 
 // MarshalJSON marshals the given Evidence as JSON into a byte slice
 func (r Evidence) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEvidence
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEvidence: OtherEvidence(r),
 		ResourceType:  "Evidence",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEvidence unmarshals a Evidence.
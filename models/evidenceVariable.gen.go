@@ -22,62 +22,259 @@ import "encoding/json"
 
 // EvidenceVariable is documented here http://hl7.org/fhir/StructureDefinition/EvidenceVariable
 type EvidenceVariable struct {
-	ID                *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                          `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                          `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                          `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                          `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                          `bson:"title,omitempty" json:"title,omitempty"`
-	ShortTitle        *string                          `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
-	Subtitle          *string                          `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status            PublicationStatus                `bson:"status" json:"status"`
-	Date              *DateTime                        `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                          `bson:"description,omitempty" json:"description,omitempty"`
-	Note              []Annotation                     `bson:"note,omitempty" json:"note,omitempty"`
-	UseContext        []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime                        `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime                        `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                          `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept                `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail                  `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail                  `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail                  `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail                  `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact                `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Type              *EvidenceVariableType            `bson:"type,omitempty" json:"type,omitempty"`
-	Characteristic    []EvidenceVariableCharacteristic `bson:"characteristic" json:"characteristic"`
+	ID                    *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                   *string                          `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement            *Element                         `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                          `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                         `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                          `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                         `bson:"_title,omitempty" json:"_title,omitempty"`
+	ShortTitle            *string                          `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
+	ShortTitleElement     *Element                         `bson:"_shortTitle,omitempty" json:"_shortTitle,omitempty"`
+	Subtitle              *string                          `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement       *Element                         `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                PublicationStatus                `bson:"status" json:"status"`
+	StatusElement         *Element                         `bson:"_status,omitempty" json:"_status,omitempty"`
+	Date                  *DateTime                        `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                         `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                         `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                         `bson:"_description,omitempty" json:"_description,omitempty"`
+	Note                  []Annotation                     `bson:"note,omitempty" json:"note,omitempty"`
+	UseContext            []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                         `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *DateTime                        `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                         `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *DateTime                        `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                         `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                          `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                 []CodeableConcept                `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                []ContactDetail                  `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                []ContactDetail                  `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer              []ContactDetail                  `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser              []ContactDetail                  `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact       []RelatedArtifact                `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Type                  *EvidenceVariableType            `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement           *Element                         `bson:"_type,omitempty" json:"_type,omitempty"`
+	Characteristic        []EvidenceVariableCharacteristic `bson:"characteristic" json:"characteristic"`
+	UnknownFields         map[string]json.RawMessage       `bson:"-" json:"-"`
 }
 type EvidenceVariableCharacteristic struct {
-	ID                *string        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string        `bson:"description,omitempty" json:"description,omitempty"`
-	UsageContext      []UsageContext `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
-	Exclude           *bool          `bson:"exclude,omitempty" json:"exclude,omitempty"`
-	TimeFromStart     *Duration      `bson:"timeFromStart,omitempty" json:"timeFromStart,omitempty"`
-	GroupMeasure      *GroupMeasure  `bson:"groupMeasure,omitempty" json:"groupMeasure,omitempty"`
+	ID                                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description                         *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement                  *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	DefinitionReference                 *Reference                 `bson:"definitionReference,omitempty" json:"definitionReference,omitempty"`
+	DefinitionCanonical                 *string                    `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement          *Element                   `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	DefinitionCodeableConcept           *CodeableConcept           `bson:"definitionCodeableConcept,omitempty" json:"definitionCodeableConcept,omitempty"`
+	DefinitionExpression                *Expression                `bson:"definitionExpression,omitempty" json:"definitionExpression,omitempty"`
+	DefinitionDataRequirement           *DataRequirement           `bson:"definitionDataRequirement,omitempty" json:"definitionDataRequirement,omitempty"`
+	DefinitionTriggerDefinition         *TriggerDefinition         `bson:"definitionTriggerDefinition,omitempty" json:"definitionTriggerDefinition,omitempty"`
+	UsageContext                        []UsageContext             `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
+	Exclude                             *bool                      `bson:"exclude,omitempty" json:"exclude,omitempty"`
+	ExcludeElement                      *Element                   `bson:"_exclude,omitempty" json:"_exclude,omitempty"`
+	ParticipantEffectiveDateTime        *DateTime                  `bson:"participantEffectiveDateTime,omitempty" json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTimeElement *Element                   `bson:"_participantEffectiveDateTime,omitempty" json:"_participantEffectiveDateTime,omitempty"`
+	ParticipantEffectivePeriod          *Period                    `bson:"participantEffectivePeriod,omitempty" json:"participantEffectivePeriod,omitempty"`
+	ParticipantEffectiveDuration        *Duration                  `bson:"participantEffectiveDuration,omitempty" json:"participantEffectiveDuration,omitempty"`
+	ParticipantEffectiveTiming          *Timing                    `bson:"participantEffectiveTiming,omitempty" json:"participantEffectiveTiming,omitempty"`
+	TimeFromStart                       *Duration                  `bson:"timeFromStart,omitempty" json:"timeFromStart,omitempty"`
+	GroupMeasure                        *GroupMeasure              `bson:"groupMeasure,omitempty" json:"groupMeasure,omitempty"`
+	GroupMeasureElement                 *Element                   `bson:"_groupMeasure,omitempty" json:"_groupMeasure,omitempty"`
+	UnknownFields                       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Definition returns EvidenceVariable.characteristic.definition[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *EvidenceVariableCharacteristic) Definition() interface{} {
+	switch {
+	case r.DefinitionReference != nil:
+		return r.DefinitionReference
+	case r.DefinitionCanonical != nil:
+		return r.DefinitionCanonical
+	case r.DefinitionCodeableConcept != nil:
+		return r.DefinitionCodeableConcept
+	case r.DefinitionExpression != nil:
+		return r.DefinitionExpression
+	case r.DefinitionDataRequirement != nil:
+		return r.DefinitionDataRequirement
+	case r.DefinitionTriggerDefinition != nil:
+		return r.DefinitionTriggerDefinition
+	}
+	return nil
+}
+
+// SetDefinitionReference sets EvidenceVariable.characteristic.definition[x] to the Reference and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetDefinitionReference(v Reference) {
+	r.clearDefinition()
+	r.DefinitionReference = &v
+}
+
+// SetDefinitionCanonical sets EvidenceVariable.characteristic.definition[x] to the canonical and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetDefinitionCanonical(v string) {
+	r.clearDefinition()
+	r.DefinitionCanonical = &v
+}
+
+// SetDefinitionCodeableConcept sets EvidenceVariable.characteristic.definition[x] to the CodeableConcept and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetDefinitionCodeableConcept(v CodeableConcept) {
+	r.clearDefinition()
+	r.DefinitionCodeableConcept = &v
+}
+
+// SetDefinitionExpression sets EvidenceVariable.characteristic.definition[x] to the Expression and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetDefinitionExpression(v Expression) {
+	r.clearDefinition()
+	r.DefinitionExpression = &v
+}
+
+// SetDefinitionDataRequirement sets EvidenceVariable.characteristic.definition[x] to the DataRequirement and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetDefinitionDataRequirement(v DataRequirement) {
+	r.clearDefinition()
+	r.DefinitionDataRequirement = &v
+}
+
+// SetDefinitionTriggerDefinition sets EvidenceVariable.characteristic.definition[x] to the TriggerDefinition and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetDefinitionTriggerDefinition(v TriggerDefinition) {
+	r.clearDefinition()
+	r.DefinitionTriggerDefinition = &v
+}
+func (r *EvidenceVariableCharacteristic) clearDefinition() {
+	r.DefinitionReference = nil
+	r.DefinitionCanonical = nil
+	r.DefinitionCanonicalElement = nil
+	r.DefinitionCodeableConcept = nil
+	r.DefinitionExpression = nil
+	r.DefinitionDataRequirement = nil
+	r.DefinitionTriggerDefinition = nil
+}
+
+// ParticipantEffective returns EvidenceVariable.characteristic.participantEffective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *EvidenceVariableCharacteristic) ParticipantEffective() interface{} {
+	switch {
+	case r.ParticipantEffectiveDateTime != nil:
+		return r.ParticipantEffectiveDateTime
+	case r.ParticipantEffectivePeriod != nil:
+		return r.ParticipantEffectivePeriod
+	case r.ParticipantEffectiveDuration != nil:
+		return r.ParticipantEffectiveDuration
+	case r.ParticipantEffectiveTiming != nil:
+		return r.ParticipantEffectiveTiming
+	}
+	return nil
+}
+
+// SetParticipantEffectiveDateTime sets EvidenceVariable.characteristic.participantEffective[x] to the dateTime and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetParticipantEffectiveDateTime(v DateTime) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectiveDateTime = &v
+}
+
+// SetParticipantEffectivePeriod sets EvidenceVariable.characteristic.participantEffective[x] to the Period and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetParticipantEffectivePeriod(v Period) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectivePeriod = &v
+}
+
+// SetParticipantEffectiveDuration sets EvidenceVariable.characteristic.participantEffective[x] to the Duration and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetParticipantEffectiveDuration(v Duration) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectiveDuration = &v
+}
+
+// SetParticipantEffectiveTiming sets EvidenceVariable.characteristic.participantEffective[x] to the Timing and clears the other types.
+func (r *EvidenceVariableCharacteristic) SetParticipantEffectiveTiming(v Timing) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectiveTiming = &v
+}
+func (r *EvidenceVariableCharacteristic) clearParticipantEffective() {
+	r.ParticipantEffectiveDateTime = nil
+	r.ParticipantEffectiveDateTimeElement = nil
+	r.ParticipantEffectivePeriod = nil
+	r.ParticipantEffectiveDuration = nil
+	r.ParticipantEffectiveTiming = nil
+}
+
+// UnmarshalJSON unmarshals the EvidenceVariableCharacteristic and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *EvidenceVariableCharacteristic) UnmarshalJSON(b []byte) error {
+	type other EvidenceVariableCharacteristic
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"EvidenceVariable.characteristic.definition[x]",
+		r.DefinitionReference != nil,
+		r.DefinitionCanonical != nil || r.DefinitionCanonicalElement != nil,
+		r.DefinitionCodeableConcept != nil,
+		r.DefinitionExpression != nil,
+		r.DefinitionDataRequirement != nil,
+		r.DefinitionTriggerDefinition != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"EvidenceVariable.characteristic.participantEffective[x]",
+		r.ParticipantEffectiveDateTime != nil || r.ParticipantEffectiveDateTimeElement != nil,
+		r.ParticipantEffectivePeriod != nil,
+		r.ParticipantEffectiveDuration != nil,
+		r.ParticipantEffectiveTiming != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the EvidenceVariableCharacteristic with its unknown fields.
+func (r EvidenceVariableCharacteristic) MarshalJSON() ([]byte, error) {
+	type other EvidenceVariableCharacteristic
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the EvidenceVariable and fails with CodeError when a coded element has an unknown code.
+func (r *EvidenceVariable) UnmarshalJSON(b []byte) error {
+	type other EvidenceVariable
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("EvidenceVariable", r)
+}
+
 type OtherEvidenceVariable EvidenceVariable
 
 // MarshalJSON marshals the given EvidenceVariable as JSON into a byte slice
 func (r EvidenceVariable) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherEvidenceVariable
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherEvidenceVariable: OtherEvidenceVariable(r),
 		ResourceType:          "EvidenceVariable",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalEvidenceVariable unmarshals a EvidenceVariable.
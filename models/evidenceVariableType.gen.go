@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.020945 +0000 UTC
+// 2026-10-19 02:55:18.808928498 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // EvidenceVariableType is documented here http://hl7.org/fhir/ValueSet/variable-type
-type EvidenceVariableType int
+// The empty EvidenceVariableType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type EvidenceVariableType string
 
 const (
-	EvidenceVariableTypeDichotomous EvidenceVariableType = iota
-	EvidenceVariableTypeContinuous
-	EvidenceVariableTypeDescriptive
+	EvidenceVariableTypeDichotomous EvidenceVariableType = "dichotomous"
+	EvidenceVariableTypeContinuous  EvidenceVariableType = "continuous"
+	EvidenceVariableTypeDescriptive EvidenceVariableType = "descriptive"
 )
 
 func (code EvidenceVariableType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "EvidenceVariableType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *EvidenceVariableType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "dichotomous":
-		*code = EvidenceVariableTypeDichotomous
-	case "continuous":
-		*code = EvidenceVariableTypeContinuous
-	case "descriptive":
-		*code = EvidenceVariableTypeDescriptive
-	default:
-		return fmt.Errorf("unknown EvidenceVariableType code `%s`", s)
+func (code *EvidenceVariableType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = EvidenceVariableType(s)
 	return nil
 }
 func (code EvidenceVariableType) String() string {
 	return code.Code()
 }
 func (code EvidenceVariableType) Code() string {
+	return string(code)
+}
+func (code EvidenceVariableType) Known() bool {
 	switch code {
-	case EvidenceVariableTypeDichotomous:
-		return "dichotomous"
-	case EvidenceVariableTypeContinuous:
-		return "continuous"
-	case EvidenceVariableTypeDescriptive:
-		return "descriptive"
+	case EvidenceVariableTypeDichotomous, EvidenceVariableTypeContinuous, EvidenceVariableTypeDescriptive:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code EvidenceVariableType) Display() string {
 	switch code {
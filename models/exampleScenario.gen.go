@@ -22,74 +22,152 @@ import "encoding/json"
 
 // ExampleScenario is documented here http://hl7.org/fhir/StructureDefinition/ExampleScenario
 type ExampleScenario struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                   `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                   `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                   `bson:"name,omitempty" json:"name,omitempty"`
-	Status            PublicationStatus         `bson:"status" json:"status"`
-	Experimental      *bool                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                 `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail           `bson:"contact,omitempty" json:"contact,omitempty"`
-	UseContext        []UsageContext            `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Purpose           *string                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Actor             []ExampleScenarioActor    `bson:"actor,omitempty" json:"actor,omitempty"`
-	Instance          []ExampleScenarioInstance `bson:"instance,omitempty" json:"instance,omitempty"`
-	Process           []ExampleScenarioProcess  `bson:"process,omitempty" json:"process,omitempty"`
-	Workflow          []string                  `bson:"workflow,omitempty" json:"workflow,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright            *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Actor                []ExampleScenarioActor     `bson:"actor,omitempty" json:"actor,omitempty"`
+	Instance             []ExampleScenarioInstance  `bson:"instance,omitempty" json:"instance,omitempty"`
+	Process              []ExampleScenarioProcess   `bson:"process,omitempty" json:"process,omitempty"`
+	Workflow             []*string                  `bson:"workflow,omitempty" json:"workflow,omitempty"`
+	WorkflowElement      []*Element                 `bson:"_workflow,omitempty" json:"_workflow,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ExampleScenarioActor struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ActorId           string                   `bson:"actorId" json:"actorId"`
-	Type              ExampleScenarioActorType `bson:"type" json:"type"`
-	Name              *string                  `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                  `bson:"description,omitempty" json:"description,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ActorId            string                     `bson:"actorId" json:"actorId"`
+	ActorIdElement     *Element                   `bson:"_actorId,omitempty" json:"_actorId,omitempty"`
+	Type               ExampleScenarioActorType   `bson:"type" json:"type"`
+	TypeElement        *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Name               *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExampleScenarioActor with its unknown fields.
+func (r ExampleScenarioActor) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioActor
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExampleScenarioInstance struct {
-	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ResourceId        string                                     `bson:"resourceId" json:"resourceId"`
-	ResourceType      ResourceType                               `bson:"resourceType" json:"resourceType"`
-	Name              *string                                    `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                                    `bson:"description,omitempty" json:"description,omitempty"`
-	Version           []ExampleScenarioInstanceVersion           `bson:"version,omitempty" json:"version,omitempty"`
-	ContainedInstance []ExampleScenarioInstanceContainedInstance `bson:"containedInstance,omitempty" json:"containedInstance,omitempty"`
+	ID                  *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ResourceId          string                                     `bson:"resourceId" json:"resourceId"`
+	ResourceIdElement   *Element                                   `bson:"_resourceId,omitempty" json:"_resourceId,omitempty"`
+	ResourceType        ResourceType                               `bson:"resourceType" json:"resourceType"`
+	ResourceTypeElement *Element                                   `bson:"_resourceType,omitempty" json:"_resourceType,omitempty"`
+	Name                *string                                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement         *Element                                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description         *string                                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Version             []ExampleScenarioInstanceVersion           `bson:"version,omitempty" json:"version,omitempty"`
+	ContainedInstance   []ExampleScenarioInstanceContainedInstance `bson:"containedInstance,omitempty" json:"containedInstance,omitempty"`
+	UnknownFields       map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type ExampleScenarioInstanceVersion struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	VersionId         string      `bson:"versionId" json:"versionId"`
-	Description       string      `bson:"description" json:"description"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	VersionId          string                     `bson:"versionId" json:"versionId"`
+	VersionIdElement   *Element                   `bson:"_versionId,omitempty" json:"_versionId,omitempty"`
+	Description        string                     `bson:"description" json:"description"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExampleScenarioInstanceVersion with its unknown fields.
+func (r ExampleScenarioInstanceVersion) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioInstanceVersion
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExampleScenarioInstanceContainedInstance struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ResourceId        string      `bson:"resourceId" json:"resourceId"`
-	VersionId         *string     `bson:"versionId,omitempty" json:"versionId,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ResourceId        string                     `bson:"resourceId" json:"resourceId"`
+	ResourceIdElement *Element                   `bson:"_resourceId,omitempty" json:"_resourceId,omitempty"`
+	VersionId         *string                    `bson:"versionId,omitempty" json:"versionId,omitempty"`
+	VersionIdElement  *Element                   `bson:"_versionId,omitempty" json:"_versionId,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExampleScenarioInstanceContainedInstance with its unknown fields.
+func (r ExampleScenarioInstanceContainedInstance) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioInstanceContainedInstance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ExampleScenarioInstance with its unknown fields.
+func (r ExampleScenarioInstance) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioInstance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExampleScenarioProcess struct {
-	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Title             string                       `bson:"title" json:"title"`
-	Description       *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	PreConditions     *string                      `bson:"preConditions,omitempty" json:"preConditions,omitempty"`
-	PostConditions    *string                      `bson:"postConditions,omitempty" json:"postConditions,omitempty"`
-	Step              []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
+	ID                    *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Title                 string                       `bson:"title" json:"title"`
+	TitleElement          *Element                     `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description           *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	PreConditions         *string                      `bson:"preConditions,omitempty" json:"preConditions,omitempty"`
+	PreConditionsElement  *Element                     `bson:"_preConditions,omitempty" json:"_preConditions,omitempty"`
+	PostConditions        *string                      `bson:"postConditions,omitempty" json:"postConditions,omitempty"`
+	PostConditionsElement *Element                     `bson:"_postConditions,omitempty" json:"_postConditions,omitempty"`
+	Step                  []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
+	UnknownFields         map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type ExampleScenarioProcessStep struct {
 	ID                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -97,43 +175,115 @@ type ExampleScenarioProcessStep struct {
 	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Process           []ExampleScenarioProcess                `bson:"process,omitempty" json:"process,omitempty"`
 	Pause             *bool                                   `bson:"pause,omitempty" json:"pause,omitempty"`
+	PauseElement      *Element                                `bson:"_pause,omitempty" json:"_pause,omitempty"`
 	Operation         *ExampleScenarioProcessStepOperation    `bson:"operation,omitempty" json:"operation,omitempty"`
 	Alternative       []ExampleScenarioProcessStepAlternative `bson:"alternative,omitempty" json:"alternative,omitempty"`
+	UnknownFields     map[string]json.RawMessage              `bson:"-" json:"-"`
 }
 type ExampleScenarioProcessStepOperation struct {
-	ID                *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Number            string                                    `bson:"number" json:"number"`
-	Type              *string                                   `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string                                   `bson:"name,omitempty" json:"name,omitempty"`
-	Initiator         *string                                   `bson:"initiator,omitempty" json:"initiator,omitempty"`
-	Receiver          *string                                   `bson:"receiver,omitempty" json:"receiver,omitempty"`
-	Description       *string                                   `bson:"description,omitempty" json:"description,omitempty"`
-	InitiatorActive   *bool                                     `bson:"initiatorActive,omitempty" json:"initiatorActive,omitempty"`
-	ReceiverActive    *bool                                     `bson:"receiverActive,omitempty" json:"receiverActive,omitempty"`
-	Request           *ExampleScenarioInstanceContainedInstance `bson:"request,omitempty" json:"request,omitempty"`
-	Response          *ExampleScenarioInstanceContainedInstance `bson:"response,omitempty" json:"response,omitempty"`
+	ID                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Number                 string                                    `bson:"number" json:"number"`
+	NumberElement          *Element                                  `bson:"_number,omitempty" json:"_number,omitempty"`
+	Type                   *string                                   `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement            *Element                                  `bson:"_type,omitempty" json:"_type,omitempty"`
+	Name                   *string                                   `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                                  `bson:"_name,omitempty" json:"_name,omitempty"`
+	Initiator              *string                                   `bson:"initiator,omitempty" json:"initiator,omitempty"`
+	InitiatorElement       *Element                                  `bson:"_initiator,omitempty" json:"_initiator,omitempty"`
+	Receiver               *string                                   `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	ReceiverElement        *Element                                  `bson:"_receiver,omitempty" json:"_receiver,omitempty"`
+	Description            *string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                                  `bson:"_description,omitempty" json:"_description,omitempty"`
+	InitiatorActive        *bool                                     `bson:"initiatorActive,omitempty" json:"initiatorActive,omitempty"`
+	InitiatorActiveElement *Element                                  `bson:"_initiatorActive,omitempty" json:"_initiatorActive,omitempty"`
+	ReceiverActive         *bool                                     `bson:"receiverActive,omitempty" json:"receiverActive,omitempty"`
+	ReceiverActiveElement  *Element                                  `bson:"_receiverActive,omitempty" json:"_receiverActive,omitempty"`
+	Request                *ExampleScenarioInstanceContainedInstance `bson:"request,omitempty" json:"request,omitempty"`
+	Response               *ExampleScenarioInstanceContainedInstance `bson:"response,omitempty" json:"response,omitempty"`
+	UnknownFields          map[string]json.RawMessage                `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ExampleScenarioProcessStepOperation with its unknown fields.
+func (r ExampleScenarioProcessStepOperation) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioProcessStepOperation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ExampleScenarioProcessStepAlternative struct {
-	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Title             string                       `bson:"title" json:"title"`
-	Description       *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	Step              []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
+	ID                 *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Title              string                       `bson:"title" json:"title"`
+	TitleElement       *Element                     `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description        *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	Step               []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
+	UnknownFields      map[string]json.RawMessage   `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ExampleScenarioProcessStepAlternative with its unknown fields.
+func (r ExampleScenarioProcessStepAlternative) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioProcessStepAlternative
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the ExampleScenarioProcessStep with its unknown fields.
+func (r ExampleScenarioProcessStep) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioProcessStep
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ExampleScenarioProcess with its unknown fields.
+func (r ExampleScenarioProcess) MarshalJSON() ([]byte, error) {
+	type other ExampleScenarioProcess
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ExampleScenario and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ExampleScenario) UnmarshalJSON(b []byte) error {
+	type other ExampleScenario
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExampleScenario.workflow", len(r.Workflow), len(r.WorkflowElement)); err != nil {
+		return err
+	}
+	return checkCodes("ExampleScenario", r)
+}
+
 type OtherExampleScenario ExampleScenario
 
 // MarshalJSON marshals the given ExampleScenario as JSON into a byte slice
 func (r ExampleScenario) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherExampleScenario
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherExampleScenario: OtherExampleScenario(r),
 		ResourceType:         "ExampleScenario",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalExampleScenario unmarshals a ExampleScenario.
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.171347 +0000 UTC
+// 2026-10-19 02:55:18.809960495 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ExampleScenarioActorType is documented here http://hl7.org/fhir/ValueSet/examplescenario-actor-type
-type ExampleScenarioActorType int
+// The empty ExampleScenarioActorType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ExampleScenarioActorType string
 
 const (
-	ExampleScenarioActorTypePerson ExampleScenarioActorType = iota
-	ExampleScenarioActorTypeEntity
+	ExampleScenarioActorTypePerson ExampleScenarioActorType = "person"
+	ExampleScenarioActorTypeEntity ExampleScenarioActorType = "entity"
 )
 
 func (code ExampleScenarioActorType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ExampleScenarioActorType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ExampleScenarioActorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "person":
-		*code = ExampleScenarioActorTypePerson
-	case "entity":
-		*code = ExampleScenarioActorTypeEntity
-	default:
-		return fmt.Errorf("unknown ExampleScenarioActorType code `%s`", s)
+func (code *ExampleScenarioActorType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ExampleScenarioActorType(s)
 	return nil
 }
 func (code ExampleScenarioActorType) String() string {
 	return code.Code()
 }
 func (code ExampleScenarioActorType) Code() string {
+	return string(code)
+}
+func (code ExampleScenarioActorType) Known() bool {
 	switch code {
-	case ExampleScenarioActorTypePerson:
-		return "person"
-	case ExampleScenarioActorTypeEntity:
-		return "entity"
+	case ExampleScenarioActorTypePerson, ExampleScenarioActorTypeEntity:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ExampleScenarioActorType) Display() string {
 	switch code {
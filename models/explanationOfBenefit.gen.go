@@ -25,18 +25,24 @@ type ExplanationOfBenefit struct {
 	ID                    *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                  *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                               `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                     `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            []Identifier                           `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status                ExplanationOfBenefitStatus             `bson:"status" json:"status"`
+	StatusElement         *Element                               `bson:"_status,omitempty" json:"_status,omitempty"`
 	Type                  CodeableConcept                        `bson:"type" json:"type"`
 	SubType               *CodeableConcept                       `bson:"subType,omitempty" json:"subType,omitempty"`
 	Use                   Use                                    `bson:"use" json:"use"`
+	UseElement            *Element                               `bson:"_use,omitempty" json:"_use,omitempty"`
 	Patient               Reference                              `bson:"patient" json:"patient"`
 	BillablePeriod        *Period                                `bson:"billablePeriod,omitempty" json:"billablePeriod,omitempty"`
 	Created               DateTime                               `bson:"created" json:"created"`
+	CreatedElement        *Element                               `bson:"_created,omitempty" json:"_created,omitempty"`
 	Enterer               *Reference                             `bson:"enterer,omitempty" json:"enterer,omitempty"`
 	Insurer               Reference                              `bson:"insurer" json:"insurer"`
 	Provider              Reference                              `bson:"provider" json:"provider"`
@@ -52,14 +58,18 @@ type ExplanationOfBenefit struct {
 	Claim                 *Reference                             `bson:"claim,omitempty" json:"claim,omitempty"`
 	ClaimResponse         *Reference                             `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
 	Outcome               ClaimProcessingCodes                   `bson:"outcome" json:"outcome"`
+	OutcomeElement        *Element                               `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
 	Disposition           *string                                `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	PreAuthRef            []string                               `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	DispositionElement    *Element                               `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	PreAuthRef            []*string                              `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement     []*Element                             `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
 	PreAuthRefPeriod      []Period                               `bson:"preAuthRefPeriod,omitempty" json:"preAuthRefPeriod,omitempty"`
 	CareTeam              []ExplanationOfBenefitCareTeam         `bson:"careTeam,omitempty" json:"careTeam,omitempty"`
 	SupportingInfo        []ExplanationOfBenefitSupportingInfo   `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
 	Diagnosis             []ExplanationOfBenefitDiagnosis        `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
 	Procedure             []ExplanationOfBenefitProcedure        `bson:"procedure,omitempty" json:"procedure,omitempty"`
 	Precedence            *int                                   `bson:"precedence,omitempty" json:"precedence,omitempty"`
+	PrecedenceElement     *Element                               `bson:"_precedence,omitempty" json:"_precedence,omitempty"`
 	Insurance             []ExplanationOfBenefitInsurance        `bson:"insurance" json:"insurance"`
 	Accident              *ExplanationOfBenefitAccident          `bson:"accident,omitempty" json:"accident,omitempty"`
 	Item                  []ExplanationOfBenefitItem             `bson:"item,omitempty" json:"item,omitempty"`
@@ -72,114 +82,515 @@ type ExplanationOfBenefit struct {
 	ProcessNote           []ExplanationOfBenefitProcessNote      `bson:"processNote,omitempty" json:"processNote,omitempty"`
 	BenefitPeriod         *Period                                `bson:"benefitPeriod,omitempty" json:"benefitPeriod,omitempty"`
 	BenefitBalance        []ExplanationOfBenefitBenefitBalance   `bson:"benefitBalance,omitempty" json:"benefitBalance,omitempty"`
+	UnknownFields         map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type ExplanationOfBenefitRelated struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Claim             *Reference       `bson:"claim,omitempty" json:"claim,omitempty"`
-	Relationship      *CodeableConcept `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Reference         *Identifier      `bson:"reference,omitempty" json:"reference,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Claim             *Reference                 `bson:"claim,omitempty" json:"claim,omitempty"`
+	Relationship      *CodeableConcept           `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Reference         *Identifier                `bson:"reference,omitempty" json:"reference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitRelated with its unknown fields.
+func (r ExplanationOfBenefitRelated) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitRelated
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitPayee struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Party             *Reference       `bson:"party,omitempty" json:"party,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Party             *Reference                 `bson:"party,omitempty" json:"party,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitPayee with its unknown fields.
+func (r ExplanationOfBenefitPayee) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitPayee
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitCareTeam struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Provider          Reference        `bson:"provider" json:"provider"`
-	Responsible       *bool            `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Qualification     *CodeableConcept `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence           int                        `bson:"sequence" json:"sequence"`
+	SequenceElement    *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Provider           Reference                  `bson:"provider" json:"provider"`
+	Responsible        *bool                      `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	ResponsibleElement *Element                   `bson:"_responsible,omitempty" json:"_responsible,omitempty"`
+	Role               *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	Qualification      *CodeableConcept           `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitCareTeam with its unknown fields.
+func (r ExplanationOfBenefitCareTeam) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitCareTeam
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitSupportingInfo struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Category          CodeableConcept  `bson:"category" json:"category"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Reason            *Coding          `bson:"reason,omitempty" json:"reason,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence            int                        `bson:"sequence" json:"sequence"`
+	SequenceElement     *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Category            CodeableConcept            `bson:"category" json:"category"`
+	Code                *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	TimingDate          *DateTime                  `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
+	TimingDateElement   *Element                   `bson:"_timingDate,omitempty" json:"_timingDate,omitempty"`
+	TimingPeriod        *Period                    `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	ValueBoolean        *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueString         *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement  *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueQuantity       *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueAttachment     *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueReference      *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Reason              *Coding                    `bson:"reason,omitempty" json:"reason,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Timing returns ExplanationOfBenefit.supportingInfo.timing[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ExplanationOfBenefitSupportingInfo) Timing() interface{} {
+	switch {
+	case r.TimingDate != nil:
+		return r.TimingDate
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	}
+	return nil
+}
+
+// SetTimingDate sets ExplanationOfBenefit.supportingInfo.timing[x] to the date and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetTimingDate(v DateTime) {
+	r.clearTiming()
+	r.TimingDate = &v
+}
+
+// SetTimingPeriod sets ExplanationOfBenefit.supportingInfo.timing[x] to the Period and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetTimingPeriod(v Period) {
+	r.clearTiming()
+	r.TimingPeriod = &v
+}
+func (r *ExplanationOfBenefitSupportingInfo) clearTiming() {
+	r.TimingDate = nil
+	r.TimingDateElement = nil
+	r.TimingPeriod = nil
+}
+
+// Value returns ExplanationOfBenefit.supportingInfo.value[x] of the set type, such as *bool, or nil when it is not set.
+func (r *ExplanationOfBenefitSupportingInfo) Value() interface{} {
+	switch {
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueBoolean sets ExplanationOfBenefit.supportingInfo.value[x] to the boolean and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueString sets ExplanationOfBenefit.supportingInfo.value[x] to the string and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueQuantity sets ExplanationOfBenefit.supportingInfo.value[x] to the Quantity and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueAttachment sets ExplanationOfBenefit.supportingInfo.value[x] to the Attachment and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
 }
+
+// SetValueReference sets ExplanationOfBenefit.supportingInfo.value[x] to the Reference and clears the other types.
+func (r *ExplanationOfBenefitSupportingInfo) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *ExplanationOfBenefitSupportingInfo) clearValue() {
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueQuantity = nil
+	r.ValueAttachment = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitSupportingInfo and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitSupportingInfo
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.supportingInfo.timing[x]",
+		r.TimingDate != nil || r.TimingDateElement != nil,
+		r.TimingPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.supportingInfo.value[x]",
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueQuantity != nil,
+		r.ValueAttachment != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitSupportingInfo with its unknown fields.
+func (r ExplanationOfBenefitSupportingInfo) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitSupportingInfo
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitDiagnosis struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int               `bson:"sequence" json:"sequence"`
-	Type              []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	OnAdmission       *CodeableConcept  `bson:"onAdmission,omitempty" json:"onAdmission,omitempty"`
-	PackageCode       *CodeableConcept  `bson:"packageCode,omitempty" json:"packageCode,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                 int                        `bson:"sequence" json:"sequence"`
+	SequenceElement          *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	DiagnosisCodeableConcept *CodeableConcept           `bson:"diagnosisCodeableConcept,omitempty" json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *Reference                 `bson:"diagnosisReference,omitempty" json:"diagnosisReference,omitempty"`
+	Type                     []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	OnAdmission              *CodeableConcept           `bson:"onAdmission,omitempty" json:"onAdmission,omitempty"`
+	PackageCode              *CodeableConcept           `bson:"packageCode,omitempty" json:"packageCode,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Diagnosis returns ExplanationOfBenefit.diagnosis.diagnosis[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ExplanationOfBenefitDiagnosis) Diagnosis() interface{} {
+	switch {
+	case r.DiagnosisCodeableConcept != nil:
+		return r.DiagnosisCodeableConcept
+	case r.DiagnosisReference != nil:
+		return r.DiagnosisReference
+	}
+	return nil
+}
+
+// SetDiagnosisCodeableConcept sets ExplanationOfBenefit.diagnosis.diagnosis[x] to the CodeableConcept and clears the other types.
+func (r *ExplanationOfBenefitDiagnosis) SetDiagnosisCodeableConcept(v CodeableConcept) {
+	r.clearDiagnosis()
+	r.DiagnosisCodeableConcept = &v
 }
+
+// SetDiagnosisReference sets ExplanationOfBenefit.diagnosis.diagnosis[x] to the Reference and clears the other types.
+func (r *ExplanationOfBenefitDiagnosis) SetDiagnosisReference(v Reference) {
+	r.clearDiagnosis()
+	r.DiagnosisReference = &v
+}
+func (r *ExplanationOfBenefitDiagnosis) clearDiagnosis() {
+	r.DiagnosisCodeableConcept = nil
+	r.DiagnosisReference = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitDiagnosis and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitDiagnosis
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.diagnosis.diagnosis[x]",
+		r.DiagnosisCodeableConcept != nil,
+		r.DiagnosisReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitDiagnosis with its unknown fields.
+func (r ExplanationOfBenefitDiagnosis) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitDiagnosis
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitProcedure struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int               `bson:"sequence" json:"sequence"`
-	Type              []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Date              *DateTime         `bson:"date,omitempty" json:"date,omitempty"`
-	Udi               []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                 int                        `bson:"sequence" json:"sequence"`
+	SequenceElement          *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Type                     []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Date                     *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement              *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	ProcedureCodeableConcept *CodeableConcept           `bson:"procedureCodeableConcept,omitempty" json:"procedureCodeableConcept,omitempty"`
+	ProcedureReference       *Reference                 `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
+	Udi                      []Reference                `bson:"udi,omitempty" json:"udi,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Procedure returns ExplanationOfBenefit.procedure.procedure[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ExplanationOfBenefitProcedure) Procedure() interface{} {
+	switch {
+	case r.ProcedureCodeableConcept != nil:
+		return r.ProcedureCodeableConcept
+	case r.ProcedureReference != nil:
+		return r.ProcedureReference
+	}
+	return nil
+}
+
+// SetProcedureCodeableConcept sets ExplanationOfBenefit.procedure.procedure[x] to the CodeableConcept and clears the other types.
+func (r *ExplanationOfBenefitProcedure) SetProcedureCodeableConcept(v CodeableConcept) {
+	r.clearProcedure()
+	r.ProcedureCodeableConcept = &v
+}
+
+// SetProcedureReference sets ExplanationOfBenefit.procedure.procedure[x] to the Reference and clears the other types.
+func (r *ExplanationOfBenefitProcedure) SetProcedureReference(v Reference) {
+	r.clearProcedure()
+	r.ProcedureReference = &v
+}
+func (r *ExplanationOfBenefitProcedure) clearProcedure() {
+	r.ProcedureCodeableConcept = nil
+	r.ProcedureReference = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitProcedure and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ExplanationOfBenefitProcedure) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitProcedure
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.procedure.procedure[x]",
+		r.ProcedureCodeableConcept != nil,
+		r.ProcedureReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitProcedure with its unknown fields.
+func (r ExplanationOfBenefitProcedure) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitProcedure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitInsurance struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Focal             bool        `bson:"focal" json:"focal"`
-	Coverage          Reference   `bson:"coverage" json:"coverage"`
-	PreAuthRef        []string    `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Focal             bool                       `bson:"focal" json:"focal"`
+	FocalElement      *Element                   `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Coverage          Reference                  `bson:"coverage" json:"coverage"`
+	PreAuthRef        []*string                  `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*Element                 `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitInsurance and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitInsurance) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitInsurance
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.insurance.preAuthRef", len(r.PreAuthRef), len(r.PreAuthRefElement)); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitInsurance with its unknown fields.
+func (r ExplanationOfBenefitInsurance) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitInsurance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitAccident struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	LocationAddress   *Address                   `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference *Reference                 `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Location returns ExplanationOfBenefit.accident.location[x] of the set type, such as *Address, or nil when it is not set.
+func (r *ExplanationOfBenefitAccident) Location() interface{} {
+	switch {
+	case r.LocationAddress != nil:
+		return r.LocationAddress
+	case r.LocationReference != nil:
+		return r.LocationReference
+	}
+	return nil
+}
+
+// SetLocationAddress sets ExplanationOfBenefit.accident.location[x] to the Address and clears the other types.
+func (r *ExplanationOfBenefitAccident) SetLocationAddress(v Address) {
+	r.clearLocation()
+	r.LocationAddress = &v
+}
+
+// SetLocationReference sets ExplanationOfBenefit.accident.location[x] to the Reference and clears the other types.
+func (r *ExplanationOfBenefitAccident) SetLocationReference(v Reference) {
+	r.clearLocation()
+	r.LocationReference = &v
+}
+func (r *ExplanationOfBenefitAccident) clearLocation() {
+	r.LocationAddress = nil
+	r.LocationReference = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitAccident and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ExplanationOfBenefitAccident) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitAccident
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.accident.location[x]",
+		r.LocationAddress != nil,
+		r.LocationReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitAccident with its unknown fields.
+func (r ExplanationOfBenefitAccident) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitAccident
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitItem struct {
-	ID                  *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence            int                                    `bson:"sequence" json:"sequence"`
-	CareTeamSequence    []int                                  `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
-	DiagnosisSequence   []int                                  `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
-	ProcedureSequence   []int                                  `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
-	InformationSequence []int                                  `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
-	Revenue             *CodeableConcept                       `bson:"revenue,omitempty" json:"revenue,omitempty"`
-	Category            *CodeableConcept                       `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService    CodeableConcept                        `bson:"productOrService" json:"productOrService"`
-	Modifier            []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode         []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	Quantity            *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice           *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor              *Decimal                               `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net                 *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
-	Udi                 []Reference                            `bson:"udi,omitempty" json:"udi,omitempty"`
-	BodySite            *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite             []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	Encounter           []Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	NoteNumber          []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication        []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	Detail              []ExplanationOfBenefitItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                         *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int                                    `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element                               `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	CareTeamSequence           []*int                                 `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement    []*Element                             `bson:"_careTeamSequence,omitempty" json:"_careTeamSequence,omitempty"`
+	DiagnosisSequence          []*int                                 `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement   []*Element                             `bson:"_diagnosisSequence,omitempty" json:"_diagnosisSequence,omitempty"`
+	ProcedureSequence          []*int                                 `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement   []*Element                             `bson:"_procedureSequence,omitempty" json:"_procedureSequence,omitempty"`
+	InformationSequence        []*int                                 `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
+	InformationSequenceElement []*Element                             `bson:"_informationSequence,omitempty" json:"_informationSequence,omitempty"`
+	Revenue                    *CodeableConcept                       `bson:"revenue,omitempty" json:"revenue,omitempty"`
+	Category                   *CodeableConcept                       `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService           CodeableConcept                        `bson:"productOrService" json:"productOrService"`
+	Modifier                   []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode                []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate               *DateTime                              `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement        *Element                               `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod             *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept    *CodeableConcept                       `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress            *Address                               `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference          *Reference                             `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                   *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                  *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                     *Decimal                               `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement              *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                        *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
+	Udi                        []Reference                            `bson:"udi,omitempty" json:"udi,omitempty"`
+	BodySite                   *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                    []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	Encounter                  []Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	NoteNumber                 []*int                                 `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement          []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication               []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Detail                     []ExplanationOfBenefitItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields              map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type ExplanationOfBenefitItemAdjudication struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          CodeableConcept  `bson:"category" json:"category"`
-	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
-	Amount            *Money           `bson:"amount,omitempty" json:"amount,omitempty"`
-	Value             *Decimal         `bson:"value,omitempty" json:"value,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category          CodeableConcept            `bson:"category" json:"category"`
+	Reason            *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	Amount            *Money                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	Value             *Decimal                   `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitItemAdjudication with its unknown fields.
+func (r ExplanationOfBenefitItemAdjudication) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitItemAdjudication
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitItemDetail struct {
 	ID                *string                                   `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int                                       `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                                  `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept                          `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept                          `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept                           `bson:"productOrService" json:"productOrService"`
@@ -188,17 +599,21 @@ type ExplanationOfBenefitItemDetail struct {
 	Quantity          *Quantity                                 `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                    `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                                  `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                                  `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                    `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference                               `bson:"udi,omitempty" json:"udi,omitempty"`
-	NoteNumber        []int                                     `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumber        []*int                                    `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                                `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication    `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 	SubDetail         []ExplanationOfBenefitItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	UnknownFields     map[string]json.RawMessage                `bson:"-" json:"-"`
 }
 type ExplanationOfBenefitItemDetailSubDetail struct {
 	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int                                    `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                               `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept                       `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept                       `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept                        `bson:"productOrService" json:"productOrService"`
@@ -207,31 +622,204 @@ type ExplanationOfBenefitItemDetailSubDetail struct {
 	Quantity          *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                               `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference                            `bson:"udi,omitempty" json:"udi,omitempty"`
-	NoteNumber        []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumber        []*int                                 `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	UnknownFields     map[string]json.RawMessage             `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitItemDetailSubDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitItemDetailSubDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.detail.subDetail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitItemDetailSubDetail with its unknown fields.
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitItemDetailSubDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitItemDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitItemDetail) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitItemDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.detail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitItemDetail with its unknown fields.
+func (r ExplanationOfBenefitItemDetail) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitItemDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Serviced returns ExplanationOfBenefit.item.serviced[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ExplanationOfBenefitItem) Serviced() interface{} {
+	switch {
+	case r.ServicedDate != nil:
+		return r.ServicedDate
+	case r.ServicedPeriod != nil:
+		return r.ServicedPeriod
+	}
+	return nil
+}
+
+// SetServicedDate sets ExplanationOfBenefit.item.serviced[x] to the date and clears the other types.
+func (r *ExplanationOfBenefitItem) SetServicedDate(v DateTime) {
+	r.clearServiced()
+	r.ServicedDate = &v
+}
+
+// SetServicedPeriod sets ExplanationOfBenefit.item.serviced[x] to the Period and clears the other types.
+func (r *ExplanationOfBenefitItem) SetServicedPeriod(v Period) {
+	r.clearServiced()
+	r.ServicedPeriod = &v
+}
+func (r *ExplanationOfBenefitItem) clearServiced() {
+	r.ServicedDate = nil
+	r.ServicedDateElement = nil
+	r.ServicedPeriod = nil
+}
+
+// Location returns ExplanationOfBenefit.item.location[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ExplanationOfBenefitItem) Location() interface{} {
+	switch {
+	case r.LocationCodeableConcept != nil:
+		return r.LocationCodeableConcept
+	case r.LocationAddress != nil:
+		return r.LocationAddress
+	case r.LocationReference != nil:
+		return r.LocationReference
+	}
+	return nil
+}
+
+// SetLocationCodeableConcept sets ExplanationOfBenefit.item.location[x] to the CodeableConcept and clears the other types.
+func (r *ExplanationOfBenefitItem) SetLocationCodeableConcept(v CodeableConcept) {
+	r.clearLocation()
+	r.LocationCodeableConcept = &v
+}
+
+// SetLocationAddress sets ExplanationOfBenefit.item.location[x] to the Address and clears the other types.
+func (r *ExplanationOfBenefitItem) SetLocationAddress(v Address) {
+	r.clearLocation()
+	r.LocationAddress = &v
+}
+
+// SetLocationReference sets ExplanationOfBenefit.item.location[x] to the Reference and clears the other types.
+func (r *ExplanationOfBenefitItem) SetLocationReference(v Reference) {
+	r.clearLocation()
+	r.LocationReference = &v
+}
+func (r *ExplanationOfBenefitItem) clearLocation() {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitItem and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitItem) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.item.serviced[x]",
+		r.ServicedDate != nil || r.ServicedDateElement != nil,
+		r.ServicedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.item.location[x]",
+		r.LocationCodeableConcept != nil,
+		r.LocationAddress != nil,
+		r.LocationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.careTeamSequence", len(r.CareTeamSequence), len(r.CareTeamSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.diagnosisSequence", len(r.DiagnosisSequence), len(r.DiagnosisSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.procedureSequence", len(r.ProcedureSequence), len(r.ProcedureSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.informationSequence", len(r.InformationSequence), len(r.InformationSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.item.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitItem with its unknown fields.
+func (r ExplanationOfBenefitItem) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ExplanationOfBenefitAddItem struct {
-	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence      []int                                  `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
-	DetailSequence    []int                                  `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
-	SubDetailSequence []int                                  `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
-	Provider          []Reference                            `bson:"provider,omitempty" json:"provider,omitempty"`
-	ProductOrService  CodeableConcept                        `bson:"productOrService" json:"productOrService"`
-	Modifier          []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode       []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	Quantity          *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice         *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor            *Decimal                               `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net               *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
-	BodySite          *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite           []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	NoteNumber        []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	Detail            []ExplanationOfBenefitAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                       *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence             []*int                                 `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
+	ItemSequenceElement      []*Element                             `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	DetailSequence           []*int                                 `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
+	DetailSequenceElement    []*Element                             `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	SubDetailSequence        []*int                                 `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement []*Element                             `bson:"_subDetailSequence,omitempty" json:"_subDetailSequence,omitempty"`
+	Provider                 []Reference                            `bson:"provider,omitempty" json:"provider,omitempty"`
+	ProductOrService         CodeableConcept                        `bson:"productOrService" json:"productOrService"`
+	Modifier                 []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode              []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate             *DateTime                              `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement      *Element                               `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod           *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept  *CodeableConcept                       `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress          *Address                               `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference        *Reference                             `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                 *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                   *Decimal                               `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement            *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                      *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
+	BodySite                 *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                  []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	NoteNumber               []*int                                 `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement        []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication             []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Detail                   []ExplanationOfBenefitAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	UnknownFields            map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type ExplanationOfBenefitAddItemDetail struct {
 	ID                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
@@ -242,10 +830,13 @@ type ExplanationOfBenefitAddItemDetail struct {
 	Quantity          *Quantity                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                       `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                                     `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                                     `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                       `bson:"net,omitempty" json:"net,omitempty"`
-	NoteNumber        []int                                        `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumber        []*int                                       `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                                   `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication       `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 	SubDetail         []ExplanationOfBenefitAddItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	UnknownFields     map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type ExplanationOfBenefitAddItemDetailSubDetail struct {
 	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -256,67 +847,405 @@ type ExplanationOfBenefitAddItemDetailSubDetail struct {
 	Quantity          *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *Decimal                               `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
-	NoteNumber        []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumber        []*int                                 `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	UnknownFields     map[string]json.RawMessage             `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitAddItemDetailSubDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitAddItemDetailSubDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.addItem.detail.subDetail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitAddItemDetailSubDetail with its unknown fields.
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitAddItemDetailSubDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitAddItemDetail and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitAddItemDetail
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.addItem.detail.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitAddItemDetail with its unknown fields.
+func (r ExplanationOfBenefitAddItemDetail) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitAddItemDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Serviced returns ExplanationOfBenefit.addItem.serviced[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ExplanationOfBenefitAddItem) Serviced() interface{} {
+	switch {
+	case r.ServicedDate != nil:
+		return r.ServicedDate
+	case r.ServicedPeriod != nil:
+		return r.ServicedPeriod
+	}
+	return nil
+}
+
+// SetServicedDate sets ExplanationOfBenefit.addItem.serviced[x] to the date and clears the other types.
+func (r *ExplanationOfBenefitAddItem) SetServicedDate(v DateTime) {
+	r.clearServiced()
+	r.ServicedDate = &v
+}
+
+// SetServicedPeriod sets ExplanationOfBenefit.addItem.serviced[x] to the Period and clears the other types.
+func (r *ExplanationOfBenefitAddItem) SetServicedPeriod(v Period) {
+	r.clearServiced()
+	r.ServicedPeriod = &v
+}
+func (r *ExplanationOfBenefitAddItem) clearServiced() {
+	r.ServicedDate = nil
+	r.ServicedDateElement = nil
+	r.ServicedPeriod = nil
+}
+
+// Location returns ExplanationOfBenefit.addItem.location[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ExplanationOfBenefitAddItem) Location() interface{} {
+	switch {
+	case r.LocationCodeableConcept != nil:
+		return r.LocationCodeableConcept
+	case r.LocationAddress != nil:
+		return r.LocationAddress
+	case r.LocationReference != nil:
+		return r.LocationReference
+	}
+	return nil
+}
+
+// SetLocationCodeableConcept sets ExplanationOfBenefit.addItem.location[x] to the CodeableConcept and clears the other types.
+func (r *ExplanationOfBenefitAddItem) SetLocationCodeableConcept(v CodeableConcept) {
+	r.clearLocation()
+	r.LocationCodeableConcept = &v
+}
+
+// SetLocationAddress sets ExplanationOfBenefit.addItem.location[x] to the Address and clears the other types.
+func (r *ExplanationOfBenefitAddItem) SetLocationAddress(v Address) {
+	r.clearLocation()
+	r.LocationAddress = &v
 }
+
+// SetLocationReference sets ExplanationOfBenefit.addItem.location[x] to the Reference and clears the other types.
+func (r *ExplanationOfBenefitAddItem) SetLocationReference(v Reference) {
+	r.clearLocation()
+	r.LocationReference = &v
+}
+func (r *ExplanationOfBenefitAddItem) clearLocation() {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitAddItem and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ExplanationOfBenefitAddItem) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitAddItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.addItem.serviced[x]",
+		r.ServicedDate != nil || r.ServicedDateElement != nil,
+		r.ServicedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.addItem.location[x]",
+		r.LocationCodeableConcept != nil,
+		r.LocationAddress != nil,
+		r.LocationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.addItem.itemSequence", len(r.ItemSequence), len(r.ItemSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.addItem.detailSequence", len(r.DetailSequence), len(r.DetailSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.addItem.subDetailSequence", len(r.SubDetailSequence), len(r.SubDetailSequenceElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.addItem.noteNumber", len(r.NoteNumber), len(r.NoteNumberElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitAddItem with its unknown fields.
+func (r ExplanationOfBenefitAddItem) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitAddItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitTotal struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          CodeableConcept `bson:"category" json:"category"`
-	Amount            Money           `bson:"amount" json:"amount"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category          CodeableConcept            `bson:"category" json:"category"`
+	Amount            Money                      `bson:"amount" json:"amount"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitTotal with its unknown fields.
+func (r ExplanationOfBenefitTotal) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitTotal
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitPayment struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Adjustment        *Money           `bson:"adjustment,omitempty" json:"adjustment,omitempty"`
-	AdjustmentReason  *CodeableConcept `bson:"adjustmentReason,omitempty" json:"adjustmentReason,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	Amount            *Money           `bson:"amount,omitempty" json:"amount,omitempty"`
-	Identifier        *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Adjustment        *Money                     `bson:"adjustment,omitempty" json:"adjustment,omitempty"`
+	AdjustmentReason  *CodeableConcept           `bson:"adjustmentReason,omitempty" json:"adjustmentReason,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Amount            *Money                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	Identifier        *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitPayment with its unknown fields.
+func (r ExplanationOfBenefitPayment) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitPayment
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitProcessNote struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Number            *int             `bson:"number,omitempty" json:"number,omitempty"`
-	Type              *NoteType        `bson:"type,omitempty" json:"type,omitempty"`
-	Text              *string          `bson:"text,omitempty" json:"text,omitempty"`
-	Language          *CodeableConcept `bson:"language,omitempty" json:"language,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Number            *int                       `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     *Element                   `bson:"_number,omitempty" json:"_number,omitempty"`
+	Type              *NoteType                  `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Text              *string                    `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	Language          *CodeableConcept           `bson:"language,omitempty" json:"language,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ExplanationOfBenefitProcessNote with its unknown fields.
+func (r ExplanationOfBenefitProcessNote) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitProcessNote
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ExplanationOfBenefitBenefitBalance struct {
-	ID                *string                                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          CodeableConcept                               `bson:"category" json:"category"`
-	Excluded          *bool                                         `bson:"excluded,omitempty" json:"excluded,omitempty"`
-	Name              *string                                       `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                                       `bson:"description,omitempty" json:"description,omitempty"`
-	Network           *CodeableConcept                              `bson:"network,omitempty" json:"network,omitempty"`
-	Unit              *CodeableConcept                              `bson:"unit,omitempty" json:"unit,omitempty"`
-	Term              *CodeableConcept                              `bson:"term,omitempty" json:"term,omitempty"`
-	Financial         []ExplanationOfBenefitBenefitBalanceFinancial `bson:"financial,omitempty" json:"financial,omitempty"`
+	ID                 *string                                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category           CodeableConcept                               `bson:"category" json:"category"`
+	Excluded           *bool                                         `bson:"excluded,omitempty" json:"excluded,omitempty"`
+	ExcludedElement    *Element                                      `bson:"_excluded,omitempty" json:"_excluded,omitempty"`
+	Name               *string                                       `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                                      `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                                       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                      `bson:"_description,omitempty" json:"_description,omitempty"`
+	Network            *CodeableConcept                              `bson:"network,omitempty" json:"network,omitempty"`
+	Unit               *CodeableConcept                              `bson:"unit,omitempty" json:"unit,omitempty"`
+	Term               *CodeableConcept                              `bson:"term,omitempty" json:"term,omitempty"`
+	Financial          []ExplanationOfBenefitBenefitBalanceFinancial `bson:"financial,omitempty" json:"financial,omitempty"`
+	UnknownFields      map[string]json.RawMessage                    `bson:"-" json:"-"`
 }
 type ExplanationOfBenefitBenefitBalanceFinancial struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                      CodeableConcept            `bson:"type" json:"type"`
+	AllowedUnsignedInt        *int                       `bson:"allowedUnsignedInt,omitempty" json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *Element                   `bson:"_allowedUnsignedInt,omitempty" json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string                    `bson:"allowedString,omitempty" json:"allowedString,omitempty"`
+	AllowedStringElement      *Element                   `bson:"_allowedString,omitempty" json:"_allowedString,omitempty"`
+	AllowedMoney              *Money                     `bson:"allowedMoney,omitempty" json:"allowedMoney,omitempty"`
+	UsedUnsignedInt           *int                       `bson:"usedUnsignedInt,omitempty" json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement    *Element                   `bson:"_usedUnsignedInt,omitempty" json:"_usedUnsignedInt,omitempty"`
+	UsedMoney                 *Money                     `bson:"usedMoney,omitempty" json:"usedMoney,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Allowed returns ExplanationOfBenefit.benefitBalance.financial.allowed[x] of the set type, such as *int, or nil when it is not set.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) Allowed() interface{} {
+	switch {
+	case r.AllowedUnsignedInt != nil:
+		return r.AllowedUnsignedInt
+	case r.AllowedString != nil:
+		return r.AllowedString
+	case r.AllowedMoney != nil:
+		return r.AllowedMoney
+	}
+	return nil
+}
+
+// SetAllowedUnsignedInt sets ExplanationOfBenefit.benefitBalance.financial.allowed[x] to the unsignedInt and clears the other types.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowedUnsignedInt(v int) {
+	r.clearAllowed()
+	r.AllowedUnsignedInt = &v
+}
+
+// SetAllowedString sets ExplanationOfBenefit.benefitBalance.financial.allowed[x] to the string and clears the other types.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowedString(v string) {
+	r.clearAllowed()
+	r.AllowedString = &v
+}
+
+// SetAllowedMoney sets ExplanationOfBenefit.benefitBalance.financial.allowed[x] to the Money and clears the other types.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowedMoney(v Money) {
+	r.clearAllowed()
+	r.AllowedMoney = &v
+}
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) clearAllowed() {
+	r.AllowedUnsignedInt = nil
+	r.AllowedUnsignedIntElement = nil
+	r.AllowedString = nil
+	r.AllowedStringElement = nil
+	r.AllowedMoney = nil
+}
+
+// Used returns ExplanationOfBenefit.benefitBalance.financial.used[x] of the set type, such as *int, or nil when it is not set.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) Used() interface{} {
+	switch {
+	case r.UsedUnsignedInt != nil:
+		return r.UsedUnsignedInt
+	case r.UsedMoney != nil:
+		return r.UsedMoney
+	}
+	return nil
+}
+
+// SetUsedUnsignedInt sets ExplanationOfBenefit.benefitBalance.financial.used[x] to the unsignedInt and clears the other types.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetUsedUnsignedInt(v int) {
+	r.clearUsed()
+	r.UsedUnsignedInt = &v
+}
+
+// SetUsedMoney sets ExplanationOfBenefit.benefitBalance.financial.used[x] to the Money and clears the other types.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetUsedMoney(v Money) {
+	r.clearUsed()
+	r.UsedMoney = &v
+}
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) clearUsed() {
+	r.UsedUnsignedInt = nil
+	r.UsedUnsignedIntElement = nil
+	r.UsedMoney = nil
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefitBenefitBalanceFinancial and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefitBenefitBalanceFinancial
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.benefitBalance.financial.allowed[x]",
+		r.AllowedUnsignedInt != nil || r.AllowedUnsignedIntElement != nil,
+		r.AllowedString != nil || r.AllowedStringElement != nil,
+		r.AllowedMoney != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ExplanationOfBenefit.benefitBalance.financial.used[x]",
+		r.UsedUnsignedInt != nil || r.UsedUnsignedIntElement != nil,
+		r.UsedMoney != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitBenefitBalanceFinancial with its unknown fields.
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitBenefitBalanceFinancial
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ExplanationOfBenefitBenefitBalance with its unknown fields.
+func (r ExplanationOfBenefitBenefitBalance) MarshalJSON() ([]byte, error) {
+	type other ExplanationOfBenefitBenefitBalance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ExplanationOfBenefit and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ExplanationOfBenefit) UnmarshalJSON(b []byte) error {
+	type other ExplanationOfBenefit
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ExplanationOfBenefit.preAuthRef", len(r.PreAuthRef), len(r.PreAuthRefElement)); err != nil {
+		return err
+	}
+	return checkCodes("ExplanationOfBenefit", r)
+}
+
 type OtherExplanationOfBenefit ExplanationOfBenefit
 
 // MarshalJSON marshals the given ExplanationOfBenefit as JSON into a byte slice
 func (r ExplanationOfBenefit) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherExplanationOfBenefit
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherExplanationOfBenefit: OtherExplanationOfBenefit(r),
 		ResourceType:              "ExplanationOfBenefit",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalExplanationOfBenefit unmarshals a ExplanationOfBenefit.
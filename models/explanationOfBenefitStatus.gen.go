@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.152454 +0000 UTC
+// 2026-10-19 02:55:18.811056729 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ExplanationOfBenefitStatus is documented here http://hl7.org/fhir/ValueSet/explanationofbenefit-status
-type ExplanationOfBenefitStatus int
+// The empty ExplanationOfBenefitStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ExplanationOfBenefitStatus string
 
 const (
-	ExplanationOfBenefitStatusActive ExplanationOfBenefitStatus = iota
-	ExplanationOfBenefitStatusCancelled
-	ExplanationOfBenefitStatusDraft
-	ExplanationOfBenefitStatusEnteredInError
+	ExplanationOfBenefitStatusActive         ExplanationOfBenefitStatus = "active"
+	ExplanationOfBenefitStatusCancelled      ExplanationOfBenefitStatus = "cancelled"
+	ExplanationOfBenefitStatusDraft          ExplanationOfBenefitStatus = "draft"
+	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
 func (code ExplanationOfBenefitStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ExplanationOfBenefitStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ExplanationOfBenefitStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = ExplanationOfBenefitStatusActive
-	case "cancelled":
-		*code = ExplanationOfBenefitStatusCancelled
-	case "draft":
-		*code = ExplanationOfBenefitStatusDraft
-	case "entered-in-error":
-		*code = ExplanationOfBenefitStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown ExplanationOfBenefitStatus code `%s`", s)
+func (code *ExplanationOfBenefitStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ExplanationOfBenefitStatus(s)
 	return nil
 }
 func (code ExplanationOfBenefitStatus) String() string {
 	return code.Code()
 }
 func (code ExplanationOfBenefitStatus) Code() string {
+	return string(code)
+}
+func (code ExplanationOfBenefitStatus) Known() bool {
 	switch code {
-	case ExplanationOfBenefitStatusActive:
-		return "active"
-	case ExplanationOfBenefitStatusCancelled:
-		return "cancelled"
-	case ExplanationOfBenefitStatusDraft:
-		return "draft"
-	case ExplanationOfBenefitStatusEnteredInError:
-		return "entered-in-error"
+	case ExplanationOfBenefitStatusActive, ExplanationOfBenefitStatusCancelled, ExplanationOfBenefitStatusDraft, ExplanationOfBenefitStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ExplanationOfBenefitStatus) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.249729 +0000 UTC
+// 2026-10-19 02:55:18.812264671 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ExposureState is documented here http://hl7.org/fhir/ValueSet/exposure-state
-type ExposureState int
+// The empty ExposureState is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ExposureState string
 
 const (
-	ExposureStateExposure ExposureState = iota
-	ExposureStateExposureAlternative
+	ExposureStateExposure            ExposureState = "exposure"
+	ExposureStateExposureAlternative ExposureState = "exposure-alternative"
 )
 
 func (code ExposureState) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ExposureState"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ExposureState) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "exposure":
-		*code = ExposureStateExposure
-	case "exposure-alternative":
-		*code = ExposureStateExposureAlternative
-	default:
-		return fmt.Errorf("unknown ExposureState code `%s`", s)
+func (code *ExposureState) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ExposureState(s)
 	return nil
 }
 func (code ExposureState) String() string {
 	return code.Code()
 }
 func (code ExposureState) Code() string {
+	return string(code)
+}
+func (code ExposureState) Known() bool {
 	switch code {
-	case ExposureStateExposure:
-		return "exposure"
-	case ExposureStateExposureAlternative:
-		return "exposure-alternative"
+	case ExposureStateExposure, ExposureStateExposureAlternative:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ExposureState) Display() string {
 	switch code {
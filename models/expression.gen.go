@@ -20,11 +20,16 @@ package models
 
 // Expression is documented here http://hl7.org/fhir/StructureDefinition/Expression
 type Expression struct {
-	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Description *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Name        *string     `bson:"name,omitempty" json:"name,omitempty"`
-	Language    string      `bson:"language" json:"language"`
-	Expression  *string     `bson:"expression,omitempty" json:"expression,omitempty"`
-	Reference   *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	ID                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Description        *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Name               *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Language           string      `bson:"language" json:"language"`
+	LanguageElement    *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Expression         *string     `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  *Element    `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Reference          *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement   *Element    `bson:"_reference,omitempty" json:"_reference,omitempty"`
 }
@@ -18,159 +18,622 @@
 
 package models
 
+import "encoding/json"
+
 // Extension is documented here http://hl7.org/fhir/StructureDefinition/Extension
 type Extension struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	URL       string      `bson:"url" json:"url"`
+	ID                       *string              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	URL                      string               `bson:"url" json:"url"`
+	ValueBase64Binary        *string              `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element             `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element             `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string              `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element             `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string              `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element             `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *DateTime            `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element             `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *DateTime            `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element             `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *Decimal             `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element             `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string              `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element             `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *Instant             `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element             `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                 `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element             `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string              `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element             `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string              `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element             `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                 `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element             `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string              `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element             `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *Time                `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element             `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                 `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element             `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string              `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element             `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string              `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element             `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string              `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element             `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address             `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                 `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation          `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment          `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept     `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding              `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint        `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count               `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance            `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration            `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName           `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier          `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period              `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range               `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio               `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference           `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData         `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature           `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing              `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail       `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor         `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement     `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression          `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact     `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition   `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext        `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage              `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+}
+
+// Value returns Extension.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *Extension) Value() interface{} {
+	switch {
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueCanonical != nil:
+		return r.ValueCanonical
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueId != nil:
+		return r.ValueId
+	case r.ValueInstant != nil:
+		return r.ValueInstant
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueMarkdown != nil:
+		return r.ValueMarkdown
+	case r.ValueOid != nil:
+		return r.ValueOid
+	case r.ValuePositiveInt != nil:
+		return r.ValuePositiveInt
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueUnsignedInt != nil:
+		return r.ValueUnsignedInt
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueUrl != nil:
+		return r.ValueUrl
+	case r.ValueUuid != nil:
+		return r.ValueUuid
+	case r.ValueAddress != nil:
+		return r.ValueAddress
+	case r.ValueAge != nil:
+		return r.ValueAge
+	case r.ValueAnnotation != nil:
+		return r.ValueAnnotation
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueContactPoint != nil:
+		return r.ValueContactPoint
+	case r.ValueCount != nil:
+		return r.ValueCount
+	case r.ValueDistance != nil:
+		return r.ValueDistance
+	case r.ValueDuration != nil:
+		return r.ValueDuration
+	case r.ValueHumanName != nil:
+		return r.ValueHumanName
+	case r.ValueIdentifier != nil:
+		return r.ValueIdentifier
+	case r.ValueMoney != nil:
+		return r.ValueMoney
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueReference != nil:
+		return r.ValueReference
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueSignature != nil:
+		return r.ValueSignature
+	case r.ValueTiming != nil:
+		return r.ValueTiming
+	case r.ValueContactDetail != nil:
+		return r.ValueContactDetail
+	case r.ValueContributor != nil:
+		return r.ValueContributor
+	case r.ValueDataRequirement != nil:
+		return r.ValueDataRequirement
+	case r.ValueExpression != nil:
+		return r.ValueExpression
+	case r.ValueParameterDefinition != nil:
+		return r.ValueParameterDefinition
+	case r.ValueRelatedArtifact != nil:
+		return r.ValueRelatedArtifact
+	case r.ValueTriggerDefinition != nil:
+		return r.ValueTriggerDefinition
+	case r.ValueUsageContext != nil:
+		return r.ValueUsageContext
+	case r.ValueDosage != nil:
+		return r.ValueDosage
+	case r.ValueMeta != nil:
+		return r.ValueMeta
+	}
+	return nil
+}
 
-	// An address expressed using postal conventions (as opposed to GPS or other location definition formats).  This data type may be used to convey addresses for use in delivering mail as well as for visiting locations which might not be valid for mail delivery.  There are a variety of postal address formats defined around the world.
-	ValueAddress *Address `json:"valueAddress,omitempty"`
+// SetValueBase64Binary sets Extension.value[x] to the base64Binary and clears the other types.
+func (r *Extension) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+
+// SetValueBoolean sets Extension.value[x] to the boolean and clears the other types.
+func (r *Extension) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
 
-	// A duration of time during which an organism (or a process) has existed.
-	// ValueAge *Age `json:"valueAge,omitempty"`
+// SetValueCanonical sets Extension.value[x] to the canonical and clears the other types.
+func (r *Extension) SetValueCanonical(v string) {
+	r.clearValue()
+	r.ValueCanonical = &v
+}
 
-	// A  text note which also  contains information about who made the statement and when.
-	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty"`
+// SetValueCode sets Extension.value[x] to the code and clears the other types.
+func (r *Extension) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
+}
 
-	// For referring to data content defined in other formats.
-	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+// SetValueDate sets Extension.value[x] to the date and clears the other types.
+func (r *Extension) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+// SetValueDateTime sets Extension.value[x] to the dateTime and clears the other types.
+func (r *Extension) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+// SetValueDecimal sets Extension.value[x] to the decimal and clears the other types.
+func (r *Extension) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueCanonical *string `json:"valueCanonical,omitempty"`
+// SetValueId sets Extension.value[x] to the id and clears the other types.
+func (r *Extension) SetValueId(v string) {
+	r.clearValue()
+	r.ValueId = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueCode *string `json:"valueCode,omitempty"`
+// SetValueInstant sets Extension.value[x] to the instant and clears the other types.
+func (r *Extension) SetValueInstant(v Instant) {
+	r.clearValue()
+	r.ValueInstant = &v
+}
 
-	// A concept that may be defined by a formal reference to a terminology or ontology or may be provided by text.
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty"`
+// SetValueInteger sets Extension.value[x] to the integer and clears the other types.
+func (r *Extension) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
 
-	// A reference to a code defined by a terminology system.
-	ValueCoding *Coding `json:"valueCoding,omitempty"`
+// SetValueMarkdown sets Extension.value[x] to the markdown and clears the other types.
+func (r *Extension) SetValueMarkdown(v string) {
+	r.clearValue()
+	r.ValueMarkdown = &v
+}
 
-	// Specifies contact information for a person or organization.
-	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty"`
+// SetValueOid sets Extension.value[x] to the oid and clears the other types.
+func (r *Extension) SetValueOid(v string) {
+	r.clearValue()
+	r.ValueOid = &v
+}
 
-	// Details for all kinds of technology mediated contact points for a person or organization, including telephone, email, etc.
-	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty"`
+// SetValuePositiveInt sets Extension.value[x] to the positiveInt and clears the other types.
+func (r *Extension) SetValuePositiveInt(v int) {
+	r.clearValue()
+	r.ValuePositiveInt = &v
+}
 
-	// A contributor to the content of a knowledge asset, including authors, editors, reviewers, and endorsers.
-	// ValueContributor *Contributor `json:"valueContributor,omitempty"`
+// SetValueString sets Extension.value[x] to the string and clears the other types.
+func (r *Extension) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
 
-	// A measured amount (or an amount that can potentially be measured). Note that measured amounts include amounts that are not precisely quantified, including amounts involving arbitrary units and floating currencies.
-	// ValueCount *Count `json:"valueCount,omitempty"`
+// SetValueTime sets Extension.value[x] to the time and clears the other types.
+func (r *Extension) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
 
-	// Describes a required data item for evaluation in terms of the type of data, and optional code or date-based filters of the data.
-	ValueDataRequirement *DataRequirement `json:"valueDataRequirement,omitempty"`
+// SetValueUnsignedInt sets Extension.value[x] to the unsignedInt and clears the other types.
+func (r *Extension) SetValueUnsignedInt(v int) {
+	r.clearValue()
+	r.ValueUnsignedInt = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueDate *DateTime `json:"valueDate,omitempty"`
+// SetValueUri sets Extension.value[x] to the uri and clears the other types.
+func (r *Extension) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueDateTime *DateTime `json:"valueDateTime,omitempty"`
+// SetValueUrl sets Extension.value[x] to the url and clears the other types.
+func (r *Extension) SetValueUrl(v string) {
+	r.clearValue()
+	r.ValueUrl = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+// SetValueUuid sets Extension.value[x] to the uuid and clears the other types.
+func (r *Extension) SetValueUuid(v string) {
+	r.clearValue()
+	r.ValueUuid = &v
+}
 
-	// A length - a value with a unit that is a physical distance.
-	// ValueDistance *Distance `json:"valueDistance,omitempty"`
+// SetValueAddress sets Extension.value[x] to the Address and clears the other types.
+func (r *Extension) SetValueAddress(v Address) {
+	r.clearValue()
+	r.ValueAddress = &v
+}
 
-	// Indicates how the medication is/was taken or should be taken by the patient.
-	ValueDosage *Dosage `json:"valueDosage,omitempty"`
+// SetValueAge sets Extension.value[x] to the Age and clears the other types.
+func (r *Extension) SetValueAge(v Age) {
+	r.clearValue()
+	r.ValueAge = &v
+}
 
-	// A length of time.
-	ValueDuration *Duration `json:"valueDuration,omitempty"`
+// SetValueAnnotation sets Extension.value[x] to the Annotation and clears the other types.
+func (r *Extension) SetValueAnnotation(v Annotation) {
+	r.clearValue()
+	r.ValueAnnotation = &v
+}
 
-	// A expression that is evaluated in a specified context and returns a value. The context of use of the expression must specify the context in which the expression is evaluated, and how the result of the expression is used.
-	ValueExpression *Expression `json:"valueExpression,omitempty"`
+// SetValueAttachment sets Extension.value[x] to the Attachment and clears the other types.
+func (r *Extension) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
 
-	// A human's name with the ability to identify parts and usage.
-	ValueHumanName *HumanName `json:"valueHumanName,omitempty"`
+// SetValueCodeableConcept sets Extension.value[x] to the CodeableConcept and clears the other types.
+func (r *Extension) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueId *string `json:"valueId,omitempty"`
+// SetValueCoding sets Extension.value[x] to the Coding and clears the other types.
+func (r *Extension) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
 
-	// An identifier - identifies some entity uniquely and unambiguously. Typically this is used for business identifiers.
-	ValueIdentifier *Identifier `json:"valueIdentifier,omitempty"`
+// SetValueContactPoint sets Extension.value[x] to the ContactPoint and clears the other types.
+func (r *Extension) SetValueContactPoint(v ContactPoint) {
+	r.clearValue()
+	r.ValueContactPoint = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueInstant *string `json:"valueInstant,omitempty"`
+// SetValueCount sets Extension.value[x] to the Count and clears the other types.
+func (r *Extension) SetValueCount(v Count) {
+	r.clearValue()
+	r.ValueCount = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueInteger *int `json:"valueInteger,omitempty"`
+// SetValueDistance sets Extension.value[x] to the Distance and clears the other types.
+func (r *Extension) SetValueDistance(v Distance) {
+	r.clearValue()
+	r.ValueDistance = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueMarkdown *string `json:"valueMarkdown,omitempty"`
+// SetValueDuration sets Extension.value[x] to the Duration and clears the other types.
+func (r *Extension) SetValueDuration(v Duration) {
+	r.clearValue()
+	r.ValueDuration = &v
+}
 
-	// The metadata about a resource. This is content in the resource that is maintained by the infrastructure. Changes to the content might not always be associated with version changes to the resource.
-	ValueMeta *Meta `json:"valueMeta,omitempty"`
+// SetValueHumanName sets Extension.value[x] to the HumanName and clears the other types.
+func (r *Extension) SetValueHumanName(v HumanName) {
+	r.clearValue()
+	r.ValueHumanName = &v
+}
 
-	// An amount of economic utility in some recognized currency.
-	ValueMoney *Money `json:"valueMoney,omitempty"`
+// SetValueIdentifier sets Extension.value[x] to the Identifier and clears the other types.
+func (r *Extension) SetValueIdentifier(v Identifier) {
+	r.clearValue()
+	r.ValueIdentifier = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueOid *string `json:"valueOid,omitempty"`
+// SetValueMoney sets Extension.value[x] to the Money and clears the other types.
+func (r *Extension) SetValueMoney(v Money) {
+	r.clearValue()
+	r.ValueMoney = &v
+}
 
-	// The parameters to the module. This collection specifies both the input and output parameters. Input parameters are provided by the caller as part of the $evaluate operation. Output parameters are included in the GuidanceResponse.
-	ValueParameterDefinition *ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+// SetValuePeriod sets Extension.value[x] to the Period and clears the other types.
+func (r *Extension) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
 
-	// A time period defined by a start and end date and optionally time.
-	ValuePeriod *Period `json:"valuePeriod,omitempty"`
+// SetValueQuantity sets Extension.value[x] to the Quantity and clears the other types.
+func (r *Extension) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty"`
+// SetValueRange sets Extension.value[x] to the Range and clears the other types.
+func (r *Extension) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
 
-	// A measured amount (or an amount that can potentially be measured). Note that measured amounts include amounts that are not precisely quantified, including amounts involving arbitrary units and floating currencies.
-	ValueQuantity *Quantity `json:"valueQuantity,omitempty"`
+// SetValueRatio sets Extension.value[x] to the Ratio and clears the other types.
+func (r *Extension) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
 
-	// A set of ordered Quantities defined by a low and high limit.
-	ValueRange *Range `json:"valueRange,omitempty"`
+// SetValueReference sets Extension.value[x] to the Reference and clears the other types.
+func (r *Extension) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
 
-	// A relationship of two Quantity values - expressed as a numerator and a denominator.
-	ValueRatio *Ratio `json:"valueRatio,omitempty"`
+// SetValueSampledData sets Extension.value[x] to the SampledData and clears the other types.
+func (r *Extension) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
 
-	// A reference from one resource to another.
-	ValueReference *Reference `json:"valueReference,omitempty"`
+// SetValueSignature sets Extension.value[x] to the Signature and clears the other types.
+func (r *Extension) SetValueSignature(v Signature) {
+	r.clearValue()
+	r.ValueSignature = &v
+}
 
-	// Related artifacts such as additional documentation, justification, or bibliographic references.
-	ValueRelatedArtifact *RelatedArtifact `json:"valueRelatedArtifact,omitempty"`
+// SetValueTiming sets Extension.value[x] to the Timing and clears the other types.
+func (r *Extension) SetValueTiming(v Timing) {
+	r.clearValue()
+	r.ValueTiming = &v
+}
 
-	// A series of measurements taken by a device, with upper and lower limits. There may be more than one dimension in the data.
-	// ValueSampledData *SampledData `json:"valueSampledData,omitempty"`
+// SetValueContactDetail sets Extension.value[x] to the ContactDetail and clears the other types.
+func (r *Extension) SetValueContactDetail(v ContactDetail) {
+	r.clearValue()
+	r.ValueContactDetail = &v
+}
 
-	// A signature along with supporting context. The signature may be a digital signature that is cryptographic in nature, or some other signature acceptable to the domain. This other signature may be as simple as a graphical image representing a hand-written signature, or a signature ceremony Different signature approaches have different utilities.
-	ValueSignature *Signature `json:"valueSignature,omitempty"`
+// SetValueContributor sets Extension.value[x] to the Contributor and clears the other types.
+func (r *Extension) SetValueContributor(v Contributor) {
+	r.clearValue()
+	r.ValueContributor = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueString *string `json:"valueString,omitempty"`
+// SetValueDataRequirement sets Extension.value[x] to the DataRequirement and clears the other types.
+func (r *Extension) SetValueDataRequirement(v DataRequirement) {
+	r.clearValue()
+	r.ValueDataRequirement = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueTime *string `json:"valueTime,omitempty"`
+// SetValueExpression sets Extension.value[x] to the Expression and clears the other types.
+func (r *Extension) SetValueExpression(v Expression) {
+	r.clearValue()
+	r.ValueExpression = &v
+}
 
-	// Specifies an event that may occur multiple times. Timing schedules are used to record when things are planned, expected or requested to occur. The most common usage is in dosage instructions for medications. They are also used when planning care of various kinds, and may be used for reporting the schedule to which past regular activities were carried out.
-	ValueTiming *Timing `json:"valueTiming,omitempty"`
+// SetValueParameterDefinition sets Extension.value[x] to the ParameterDefinition and clears the other types.
+func (r *Extension) SetValueParameterDefinition(v ParameterDefinition) {
+	r.clearValue()
+	r.ValueParameterDefinition = &v
+}
 
-	// A description of a triggering event. Triggering events can be named events, data events, or periodic, as determined by the type element.
-	ValueTriggerDefinition *TriggerDefinition `json:"valueTriggerDefinition,omitempty"`
+// SetValueRelatedArtifact sets Extension.value[x] to the RelatedArtifact and clears the other types.
+func (r *Extension) SetValueRelatedArtifact(v RelatedArtifact) {
+	r.clearValue()
+	r.ValueRelatedArtifact = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueUnsignedInt *int `json:"valueUnsignedInt,omitempty"`
+// SetValueTriggerDefinition sets Extension.value[x] to the TriggerDefinition and clears the other types.
+func (r *Extension) SetValueTriggerDefinition(v TriggerDefinition) {
+	r.clearValue()
+	r.ValueTriggerDefinition = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueUri *string `json:"valueUri,omitempty"`
+// SetValueUsageContext sets Extension.value[x] to the UsageContext and clears the other types.
+func (r *Extension) SetValueUsageContext(v UsageContext) {
+	r.clearValue()
+	r.ValueUsageContext = &v
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueUrl *string `json:"valueUrl,omitempty"`
+// SetValueDosage sets Extension.value[x] to the Dosage and clears the other types.
+func (r *Extension) SetValueDosage(v Dosage) {
+	r.clearValue()
+	r.ValueDosage = &v
+}
 
-	// Specifies clinical/business/etc. metadata that can be used to retrieve, index and/or categorize an artifact. This metadata can either be specific to the applicable population (e.g., age category, DRG) or the specific context of care (e.g., venue, care setting, provider of care).
-	ValueUsageContext *UsageContext `json:"valueUsageContext,omitempty"`
+// SetValueMeta sets Extension.value[x] to the Meta and clears the other types.
+func (r *Extension) SetValueMeta(v Meta) {
+	r.clearValue()
+	r.ValueMeta = &v
+}
+func (r *Extension) clearValue() {
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueCanonical = nil
+	r.ValueCanonicalElement = nil
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueId = nil
+	r.ValueIdElement = nil
+	r.ValueInstant = nil
+	r.ValueInstantElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueMarkdown = nil
+	r.ValueMarkdownElement = nil
+	r.ValueOid = nil
+	r.ValueOidElement = nil
+	r.ValuePositiveInt = nil
+	r.ValuePositiveIntElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUnsignedIntElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueUrl = nil
+	r.ValueUrlElement = nil
+	r.ValueUuid = nil
+	r.ValueUuidElement = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+}
 
-	// Value of extension - must be one of a constrained set of the data types (see [Extensibility](extensibility.html) for a list).
-	ValueUuid *string `json:"valueUuid,omitempty"`
+// UnmarshalJSON unmarshals the Extension and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *Extension) UnmarshalJSON(b []byte) error {
+	type other Extension
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Extension.value[x]",
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueCanonical != nil || r.ValueCanonicalElement != nil,
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueId != nil || r.ValueIdElement != nil,
+		r.ValueInstant != nil || r.ValueInstantElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueMarkdown != nil || r.ValueMarkdownElement != nil,
+		r.ValueOid != nil || r.ValueOidElement != nil,
+		r.ValuePositiveInt != nil || r.ValuePositiveIntElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueUnsignedInt != nil || r.ValueUnsignedIntElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueUrl != nil || r.ValueUrlElement != nil,
+		r.ValueUuid != nil || r.ValueUuidElement != nil,
+		r.ValueAddress != nil,
+		r.ValueAge != nil,
+		r.ValueAnnotation != nil,
+		r.ValueAttachment != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueCoding != nil,
+		r.ValueContactPoint != nil,
+		r.ValueCount != nil,
+		r.ValueDistance != nil,
+		r.ValueDuration != nil,
+		r.ValueHumanName != nil,
+		r.ValueIdentifier != nil,
+		r.ValueMoney != nil,
+		r.ValuePeriod != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueReference != nil,
+		r.ValueSampledData != nil,
+		r.ValueSignature != nil,
+		r.ValueTiming != nil,
+		r.ValueContactDetail != nil,
+		r.ValueContributor != nil,
+		r.ValueDataRequirement != nil,
+		r.ValueExpression != nil,
+		r.ValueParameterDefinition != nil,
+		r.ValueRelatedArtifact != nil,
+		r.ValueTriggerDefinition != nil,
+		r.ValueUsageContext != nil,
+		r.ValueDosage != nil,
+		r.ValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.007282 +0000 UTC
+// 2026-10-19 02:55:18.813212187 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ExtensionContextType is documented here http://hl7.org/fhir/ValueSet/extension-context-type
-type ExtensionContextType int
+// The empty ExtensionContextType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ExtensionContextType string
 
 const (
-	ExtensionContextTypeFhirpath ExtensionContextType = iota
-	ExtensionContextTypeElement
-	ExtensionContextTypeExtension
+	ExtensionContextTypeFhirpath  ExtensionContextType = "fhirpath"
+	ExtensionContextTypeElement   ExtensionContextType = "element"
+	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
 func (code ExtensionContextType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ExtensionContextType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ExtensionContextType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "fhirpath":
-		*code = ExtensionContextTypeFhirpath
-	case "element":
-		*code = ExtensionContextTypeElement
-	case "extension":
-		*code = ExtensionContextTypeExtension
-	default:
-		return fmt.Errorf("unknown ExtensionContextType code `%s`", s)
+func (code *ExtensionContextType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ExtensionContextType(s)
 	return nil
 }
 func (code ExtensionContextType) String() string {
 	return code.Code()
 }
 func (code ExtensionContextType) Code() string {
+	return string(code)
+}
+func (code ExtensionContextType) Known() bool {
 	switch code {
-	case ExtensionContextTypeFhirpath:
-		return "fhirpath"
-	case ExtensionContextTypeElement:
-		return "element"
-	case ExtensionContextTypeExtension:
-		return "extension"
+	case ExtensionContextTypeFhirpath, ExtensionContextTypeElement, ExtensionContextTypeExtension:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ExtensionContextType) Display() string {
 	switch code {
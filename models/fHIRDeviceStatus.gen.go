@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.137766 +0000 UTC
+// 2026-10-19 02:55:18.814399117 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FHIRDeviceStatus is documented here http://hl7.org/fhir/ValueSet/device-status
-type FHIRDeviceStatus int
+// The empty FHIRDeviceStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FHIRDeviceStatus string
 
 const (
-	FHIRDeviceStatusActive FHIRDeviceStatus = iota
-	FHIRDeviceStatusInactive
-	FHIRDeviceStatusEnteredInError
-	FHIRDeviceStatusUnknown
+	FHIRDeviceStatusActive         FHIRDeviceStatus = "active"
+	FHIRDeviceStatusInactive       FHIRDeviceStatus = "inactive"
+	FHIRDeviceStatusEnteredInError FHIRDeviceStatus = "entered-in-error"
+	FHIRDeviceStatusUnknown        FHIRDeviceStatus = "unknown"
 )
 
 func (code FHIRDeviceStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FHIRDeviceStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FHIRDeviceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = FHIRDeviceStatusActive
-	case "inactive":
-		*code = FHIRDeviceStatusInactive
-	case "entered-in-error":
-		*code = FHIRDeviceStatusEnteredInError
-	case "unknown":
-		*code = FHIRDeviceStatusUnknown
-	default:
-		return fmt.Errorf("unknown FHIRDeviceStatus code `%s`", s)
+func (code *FHIRDeviceStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = FHIRDeviceStatus(s)
 	return nil
 }
 func (code FHIRDeviceStatus) String() string {
 	return code.Code()
 }
 func (code FHIRDeviceStatus) Code() string {
+	return string(code)
+}
+func (code FHIRDeviceStatus) Known() bool {
 	switch code {
-	case FHIRDeviceStatusActive:
-		return "active"
-	case FHIRDeviceStatusInactive:
-		return "inactive"
-	case FHIRDeviceStatusEnteredInError:
-		return "entered-in-error"
-	case FHIRDeviceStatusUnknown:
-		return "unknown"
+	case FHIRDeviceStatusActive, FHIRDeviceStatusInactive, FHIRDeviceStatusEnteredInError, FHIRDeviceStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FHIRDeviceStatus) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.113833 +0000 UTC
+// 2026-10-19 02:55:18.81557116 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FHIRSubstanceStatus is documented here http://hl7.org/fhir/ValueSet/substance-status
-type FHIRSubstanceStatus int
+// The empty FHIRSubstanceStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FHIRSubstanceStatus string
 
 const (
-	FHIRSubstanceStatusActive FHIRSubstanceStatus = iota
-	FHIRSubstanceStatusInactive
-	FHIRSubstanceStatusEnteredInError
+	FHIRSubstanceStatusActive         FHIRSubstanceStatus = "active"
+	FHIRSubstanceStatusInactive       FHIRSubstanceStatus = "inactive"
+	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
 func (code FHIRSubstanceStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FHIRSubstanceStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FHIRSubstanceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = FHIRSubstanceStatusActive
-	case "inactive":
-		*code = FHIRSubstanceStatusInactive
-	case "entered-in-error":
-		*code = FHIRSubstanceStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown FHIRSubstanceStatus code `%s`", s)
+func (code *FHIRSubstanceStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = FHIRSubstanceStatus(s)
 	return nil
 }
 func (code FHIRSubstanceStatus) String() string {
 	return code.Code()
 }
 func (code FHIRSubstanceStatus) Code() string {
+	return string(code)
+}
+func (code FHIRSubstanceStatus) Known() bool {
 	switch code {
-	case FHIRSubstanceStatusActive:
-		return "active"
-	case FHIRSubstanceStatusInactive:
-		return "inactive"
-	case FHIRSubstanceStatusEnteredInError:
-		return "entered-in-error"
+	case FHIRSubstanceStatusActive, FHIRSubstanceStatusInactive, FHIRSubstanceStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FHIRSubstanceStatus) Display() string {
 	switch code {
@@ -14,150 +14,71 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.018717 +0000 UTC
+// 2026-10-19 02:55:18.817128575 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FHIRVersion is documented here http://hl7.org/fhir/ValueSet/FHIR-version
-type FHIRVersion int
+// The empty FHIRVersion is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FHIRVersion string
 
 const (
-	FHIRVersion0_01 FHIRVersion = iota
-	FHIRVersion0_05
-	FHIRVersion0_06
-	FHIRVersion0_11
-	FHIRVersion0_0_80
-	FHIRVersion0_0_81
-	FHIRVersion0_0_82
-	FHIRVersion0_4_0
-	FHIRVersion0_5_0
-	FHIRVersion1_0_0
-	FHIRVersion1_0_1
-	FHIRVersion1_0_2
-	FHIRVersion1_1_0
-	FHIRVersion1_4_0
-	FHIRVersion1_6_0
-	FHIRVersion1_8_0
-	FHIRVersion3_0_0
-	FHIRVersion3_0_1
-	FHIRVersion3_3_0
-	FHIRVersion3_5_0
-	FHIRVersion4_0_0
-	FHIRVersion4_0_1
+	FHIRVersion0_01   FHIRVersion = "0.01"
+	FHIRVersion0_05   FHIRVersion = "0.05"
+	FHIRVersion0_06   FHIRVersion = "0.06"
+	FHIRVersion0_11   FHIRVersion = "0.11"
+	FHIRVersion0_0_80 FHIRVersion = "0.0.80"
+	FHIRVersion0_0_81 FHIRVersion = "0.0.81"
+	FHIRVersion0_0_82 FHIRVersion = "0.0.82"
+	FHIRVersion0_4_0  FHIRVersion = "0.4.0"
+	FHIRVersion0_5_0  FHIRVersion = "0.5.0"
+	FHIRVersion1_0_0  FHIRVersion = "1.0.0"
+	FHIRVersion1_0_1  FHIRVersion = "1.0.1"
+	FHIRVersion1_0_2  FHIRVersion = "1.0.2"
+	FHIRVersion1_1_0  FHIRVersion = "1.1.0"
+	FHIRVersion1_4_0  FHIRVersion = "1.4.0"
+	FHIRVersion1_6_0  FHIRVersion = "1.6.0"
+	FHIRVersion1_8_0  FHIRVersion = "1.8.0"
+	FHIRVersion3_0_0  FHIRVersion = "3.0.0"
+	FHIRVersion3_0_1  FHIRVersion = "3.0.1"
+	FHIRVersion3_3_0  FHIRVersion = "3.3.0"
+	FHIRVersion3_5_0  FHIRVersion = "3.5.0"
+	FHIRVersion4_0_0  FHIRVersion = "4.0.0"
+	FHIRVersion4_0_1  FHIRVersion = "4.0.1"
 )
 
 func (code FHIRVersion) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FHIRVersion"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FHIRVersion) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "0.01":
-		*code = FHIRVersion0_01
-	case "0.05":
-		*code = FHIRVersion0_05
-	case "0.06":
-		*code = FHIRVersion0_06
-	case "0.11":
-		*code = FHIRVersion0_11
-	case "0.0.80":
-		*code = FHIRVersion0_0_80
-	case "0.0.81":
-		*code = FHIRVersion0_0_81
-	case "0.0.82":
-		*code = FHIRVersion0_0_82
-	case "0.4.0":
-		*code = FHIRVersion0_4_0
-	case "0.5.0":
-		*code = FHIRVersion0_5_0
-	case "1.0.0":
-		*code = FHIRVersion1_0_0
-	case "1.0.1":
-		*code = FHIRVersion1_0_1
-	case "1.0.2":
-		*code = FHIRVersion1_0_2
-	case "1.1.0":
-		*code = FHIRVersion1_1_0
-	case "1.4.0":
-		*code = FHIRVersion1_4_0
-	case "1.6.0":
-		*code = FHIRVersion1_6_0
-	case "1.8.0":
-		*code = FHIRVersion1_8_0
-	case "3.0.0":
-		*code = FHIRVersion3_0_0
-	case "3.0.1":
-		*code = FHIRVersion3_0_1
-	case "3.3.0":
-		*code = FHIRVersion3_3_0
-	case "3.5.0":
-		*code = FHIRVersion3_5_0
-	case "4.0.0":
-		*code = FHIRVersion4_0_0
-	case "4.0.1":
-		*code = FHIRVersion4_0_1
-	default:
-		return fmt.Errorf("unknown FHIRVersion code `%s`", s)
+func (code *FHIRVersion) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = FHIRVersion(s)
 	return nil
 }
 func (code FHIRVersion) String() string {
 	return code.Code()
 }
 func (code FHIRVersion) Code() string {
+	return string(code)
+}
+func (code FHIRVersion) Known() bool {
 	switch code {
-	case FHIRVersion0_01:
-		return "0.01"
-	case FHIRVersion0_05:
-		return "0.05"
-	case FHIRVersion0_06:
-		return "0.06"
-	case FHIRVersion0_11:
-		return "0.11"
-	case FHIRVersion0_0_80:
-		return "0.0.80"
-	case FHIRVersion0_0_81:
-		return "0.0.81"
-	case FHIRVersion0_0_82:
-		return "0.0.82"
-	case FHIRVersion0_4_0:
-		return "0.4.0"
-	case FHIRVersion0_5_0:
-		return "0.5.0"
-	case FHIRVersion1_0_0:
-		return "1.0.0"
-	case FHIRVersion1_0_1:
-		return "1.0.1"
-	case FHIRVersion1_0_2:
-		return "1.0.2"
-	case FHIRVersion1_1_0:
-		return "1.1.0"
-	case FHIRVersion1_4_0:
-		return "1.4.0"
-	case FHIRVersion1_6_0:
-		return "1.6.0"
-	case FHIRVersion1_8_0:
-		return "1.8.0"
-	case FHIRVersion3_0_0:
-		return "3.0.0"
-	case FHIRVersion3_0_1:
-		return "3.0.1"
-	case FHIRVersion3_3_0:
-		return "3.3.0"
-	case FHIRVersion3_5_0:
-		return "3.5.0"
-	case FHIRVersion4_0_0:
-		return "4.0.0"
-	case FHIRVersion4_0_1:
-		return "4.0.1"
+	case FHIRVersion0_01, FHIRVersion0_05, FHIRVersion0_06, FHIRVersion0_11, FHIRVersion0_0_80, FHIRVersion0_0_81, FHIRVersion0_0_82, FHIRVersion0_4_0, FHIRVersion0_5_0, FHIRVersion1_0_0, FHIRVersion1_0_1, FHIRVersion1_0_2, FHIRVersion1_1_0, FHIRVersion1_4_0, FHIRVersion1_6_0, FHIRVersion1_8_0, FHIRVersion3_0_0, FHIRVersion3_0_1, FHIRVersion3_3_0, FHIRVersion3_5_0, FHIRVersion4_0_0, FHIRVersion4_0_1:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FHIRVersion) Display() string {
 	switch code {
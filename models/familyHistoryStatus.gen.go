@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.091857 +0000 UTC
+// 2026-10-19 02:55:18.81875429 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FamilyHistoryStatus is documented here http://hl7.org/fhir/ValueSet/history-status
-type FamilyHistoryStatus int
+// The empty FamilyHistoryStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FamilyHistoryStatus string
 
 const (
-	FamilyHistoryStatusPartial FamilyHistoryStatus = iota
-	FamilyHistoryStatusCompleted
-	FamilyHistoryStatusEnteredInError
-	FamilyHistoryStatusHealthUnknown
+	FamilyHistoryStatusPartial        FamilyHistoryStatus = "partial"
+	FamilyHistoryStatusCompleted      FamilyHistoryStatus = "completed"
+	FamilyHistoryStatusEnteredInError FamilyHistoryStatus = "entered-in-error"
+	FamilyHistoryStatusHealthUnknown  FamilyHistoryStatus = "health-unknown"
 )
 
 func (code FamilyHistoryStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FamilyHistoryStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FamilyHistoryStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "partial":
-		*code = FamilyHistoryStatusPartial
-	case "completed":
-		*code = FamilyHistoryStatusCompleted
-	case "entered-in-error":
-		*code = FamilyHistoryStatusEnteredInError
-	case "health-unknown":
-		*code = FamilyHistoryStatusHealthUnknown
-	default:
-		return fmt.Errorf("unknown FamilyHistoryStatus code `%s`", s)
+func (code *FamilyHistoryStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = FamilyHistoryStatus(s)
 	return nil
 }
 func (code FamilyHistoryStatus) String() string {
 	return code.Code()
 }
 func (code FamilyHistoryStatus) Code() string {
+	return string(code)
+}
+func (code FamilyHistoryStatus) Known() bool {
 	switch code {
-	case FamilyHistoryStatusPartial:
-		return "partial"
-	case FamilyHistoryStatusCompleted:
-		return "completed"
-	case FamilyHistoryStatusEnteredInError:
-		return "entered-in-error"
-	case FamilyHistoryStatusHealthUnknown:
-		return "health-unknown"
+	case FamilyHistoryStatusPartial, FamilyHistoryStatusCompleted, FamilyHistoryStatusEnteredInError, FamilyHistoryStatusHealthUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FamilyHistoryStatus) Display() string {
 	switch code {
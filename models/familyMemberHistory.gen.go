@@ -22,49 +22,335 @@ import "encoding/json"
 
 // FamilyMemberHistory is documented here http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory
 type FamilyMemberHistory struct {
-	ID                    *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string                       `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                       `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	Status                FamilyHistoryStatus            `bson:"status" json:"status"`
-	DataAbsentReason      *CodeableConcept               `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
-	Patient               Reference                      `bson:"patient" json:"patient"`
-	Date                  *DateTime                      `bson:"date,omitempty" json:"date,omitempty"`
-	Name                  *string                        `bson:"name,omitempty" json:"name,omitempty"`
-	Relationship          CodeableConcept                `bson:"relationship" json:"relationship"`
-	Sex                   *CodeableConcept               `bson:"sex,omitempty" json:"sex,omitempty"`
-	EstimatedAge          *bool                          `bson:"estimatedAge,omitempty" json:"estimatedAge,omitempty"`
-	ReasonCode            []CodeableConcept              `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference                    `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note                  []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
-	Condition             []FamilyMemberHistoryCondition `bson:"condition,omitempty" json:"condition,omitempty"`
+	ID                           *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                      `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                     `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                     `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	Status                       FamilyHistoryStatus            `bson:"status" json:"status"`
+	StatusElement                *Element                       `bson:"_status,omitempty" json:"_status,omitempty"`
+	DataAbsentReason             *CodeableConcept               `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
+	Patient                      Reference                      `bson:"patient" json:"patient"`
+	Date                         *DateTime                      `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                  *Element                       `bson:"_date,omitempty" json:"_date,omitempty"`
+	Name                         *string                        `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                  *Element                       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Relationship                 CodeableConcept                `bson:"relationship" json:"relationship"`
+	Sex                          *CodeableConcept               `bson:"sex,omitempty" json:"sex,omitempty"`
+	BornPeriod                   *Period                        `bson:"bornPeriod,omitempty" json:"bornPeriod,omitempty"`
+	BornDate                     *DateTime                      `bson:"bornDate,omitempty" json:"bornDate,omitempty"`
+	BornDateElement              *Element                       `bson:"_bornDate,omitempty" json:"_bornDate,omitempty"`
+	BornString                   *string                        `bson:"bornString,omitempty" json:"bornString,omitempty"`
+	BornStringElement            *Element                       `bson:"_bornString,omitempty" json:"_bornString,omitempty"`
+	AgeAge                       *Age                           `bson:"ageAge,omitempty" json:"ageAge,omitempty"`
+	AgeRange                     *Range                         `bson:"ageRange,omitempty" json:"ageRange,omitempty"`
+	AgeString                    *string                        `bson:"ageString,omitempty" json:"ageString,omitempty"`
+	AgeStringElement             *Element                       `bson:"_ageString,omitempty" json:"_ageString,omitempty"`
+	EstimatedAge                 *bool                          `bson:"estimatedAge,omitempty" json:"estimatedAge,omitempty"`
+	EstimatedAgeElement          *Element                       `bson:"_estimatedAge,omitempty" json:"_estimatedAge,omitempty"`
+	DeceasedBoolean              *bool                          `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement       *Element                       `bson:"_deceasedBoolean,omitempty" json:"_deceasedBoolean,omitempty"`
+	DeceasedAge                  *Age                           `bson:"deceasedAge,omitempty" json:"deceasedAge,omitempty"`
+	DeceasedRange                *Range                         `bson:"deceasedRange,omitempty" json:"deceasedRange,omitempty"`
+	DeceasedDate                 *DateTime                      `bson:"deceasedDate,omitempty" json:"deceasedDate,omitempty"`
+	DeceasedDateElement          *Element                       `bson:"_deceasedDate,omitempty" json:"_deceasedDate,omitempty"`
+	DeceasedString               *string                        `bson:"deceasedString,omitempty" json:"deceasedString,omitempty"`
+	DeceasedStringElement        *Element                       `bson:"_deceasedString,omitempty" json:"_deceasedString,omitempty"`
+	ReasonCode                   []CodeableConcept              `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                    `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                         []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
+	Condition                    []FamilyMemberHistoryCondition `bson:"condition,omitempty" json:"condition,omitempty"`
+	UnknownFields                map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type FamilyMemberHistoryCondition struct {
-	ID                 *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code               CodeableConcept  `bson:"code" json:"code"`
-	Outcome            *CodeableConcept `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	ContributedToDeath *bool            `bson:"contributedToDeath,omitempty" json:"contributedToDeath,omitempty"`
-	Note               []Annotation     `bson:"note,omitempty" json:"note,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                      CodeableConcept            `bson:"code" json:"code"`
+	Outcome                   *CodeableConcept           `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ContributedToDeath        *bool                      `bson:"contributedToDeath,omitempty" json:"contributedToDeath,omitempty"`
+	ContributedToDeathElement *Element                   `bson:"_contributedToDeath,omitempty" json:"_contributedToDeath,omitempty"`
+	OnsetAge                  *Age                       `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
+	OnsetRange                *Range                     `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
+	OnsetPeriod               *Period                    `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
+	OnsetString               *string                    `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
+	OnsetStringElement        *Element                   `bson:"_onsetString,omitempty" json:"_onsetString,omitempty"`
+	Note                      []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Onset returns FamilyMemberHistory.condition.onset[x] of the set type, such as *Age, or nil when it is not set.
+func (r *FamilyMemberHistoryCondition) Onset() interface{} {
+	switch {
+	case r.OnsetAge != nil:
+		return r.OnsetAge
+	case r.OnsetRange != nil:
+		return r.OnsetRange
+	case r.OnsetPeriod != nil:
+		return r.OnsetPeriod
+	case r.OnsetString != nil:
+		return r.OnsetString
+	}
+	return nil
+}
+
+// SetOnsetAge sets FamilyMemberHistory.condition.onset[x] to the Age and clears the other types.
+func (r *FamilyMemberHistoryCondition) SetOnsetAge(v Age) {
+	r.clearOnset()
+	r.OnsetAge = &v
+}
+
+// SetOnsetRange sets FamilyMemberHistory.condition.onset[x] to the Range and clears the other types.
+func (r *FamilyMemberHistoryCondition) SetOnsetRange(v Range) {
+	r.clearOnset()
+	r.OnsetRange = &v
+}
+
+// SetOnsetPeriod sets FamilyMemberHistory.condition.onset[x] to the Period and clears the other types.
+func (r *FamilyMemberHistoryCondition) SetOnsetPeriod(v Period) {
+	r.clearOnset()
+	r.OnsetPeriod = &v
+}
+
+// SetOnsetString sets FamilyMemberHistory.condition.onset[x] to the string and clears the other types.
+func (r *FamilyMemberHistoryCondition) SetOnsetString(v string) {
+	r.clearOnset()
+	r.OnsetString = &v
+}
+func (r *FamilyMemberHistoryCondition) clearOnset() {
+	r.OnsetAge = nil
+	r.OnsetRange = nil
+	r.OnsetPeriod = nil
+	r.OnsetString = nil
+	r.OnsetStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the FamilyMemberHistoryCondition and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *FamilyMemberHistoryCondition) UnmarshalJSON(b []byte) error {
+	type other FamilyMemberHistoryCondition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"FamilyMemberHistory.condition.onset[x]",
+		r.OnsetAge != nil,
+		r.OnsetRange != nil,
+		r.OnsetPeriod != nil,
+		r.OnsetString != nil || r.OnsetStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the FamilyMemberHistoryCondition with its unknown fields.
+func (r FamilyMemberHistoryCondition) MarshalJSON() ([]byte, error) {
+	type other FamilyMemberHistoryCondition
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Born returns FamilyMemberHistory.born[x] of the set type, such as *Period, or nil when it is not set.
+func (r *FamilyMemberHistory) Born() interface{} {
+	switch {
+	case r.BornPeriod != nil:
+		return r.BornPeriod
+	case r.BornDate != nil:
+		return r.BornDate
+	case r.BornString != nil:
+		return r.BornString
+	}
+	return nil
+}
+
+// SetBornPeriod sets FamilyMemberHistory.born[x] to the Period and clears the other types.
+func (r *FamilyMemberHistory) SetBornPeriod(v Period) {
+	r.clearBorn()
+	r.BornPeriod = &v
+}
+
+// SetBornDate sets FamilyMemberHistory.born[x] to the date and clears the other types.
+func (r *FamilyMemberHistory) SetBornDate(v DateTime) {
+	r.clearBorn()
+	r.BornDate = &v
+}
+
+// SetBornString sets FamilyMemberHistory.born[x] to the string and clears the other types.
+func (r *FamilyMemberHistory) SetBornString(v string) {
+	r.clearBorn()
+	r.BornString = &v
+}
+func (r *FamilyMemberHistory) clearBorn() {
+	r.BornPeriod = nil
+	r.BornDate = nil
+	r.BornDateElement = nil
+	r.BornString = nil
+	r.BornStringElement = nil
+}
+
+// Age returns FamilyMemberHistory.age[x] of the set type, such as *Age, or nil when it is not set.
+func (r *FamilyMemberHistory) Age() interface{} {
+	switch {
+	case r.AgeAge != nil:
+		return r.AgeAge
+	case r.AgeRange != nil:
+		return r.AgeRange
+	case r.AgeString != nil:
+		return r.AgeString
+	}
+	return nil
+}
+
+// SetAgeAge sets FamilyMemberHistory.age[x] to the Age and clears the other types.
+func (r *FamilyMemberHistory) SetAgeAge(v Age) {
+	r.clearAge()
+	r.AgeAge = &v
+}
+
+// SetAgeRange sets FamilyMemberHistory.age[x] to the Range and clears the other types.
+func (r *FamilyMemberHistory) SetAgeRange(v Range) {
+	r.clearAge()
+	r.AgeRange = &v
+}
+
+// SetAgeString sets FamilyMemberHistory.age[x] to the string and clears the other types.
+func (r *FamilyMemberHistory) SetAgeString(v string) {
+	r.clearAge()
+	r.AgeString = &v
+}
+func (r *FamilyMemberHistory) clearAge() {
+	r.AgeAge = nil
+	r.AgeRange = nil
+	r.AgeString = nil
+	r.AgeStringElement = nil
+}
+
+// Deceased returns FamilyMemberHistory.deceased[x] of the set type, such as *bool, or nil when it is not set.
+func (r *FamilyMemberHistory) Deceased() interface{} {
+	switch {
+	case r.DeceasedBoolean != nil:
+		return r.DeceasedBoolean
+	case r.DeceasedAge != nil:
+		return r.DeceasedAge
+	case r.DeceasedRange != nil:
+		return r.DeceasedRange
+	case r.DeceasedDate != nil:
+		return r.DeceasedDate
+	case r.DeceasedString != nil:
+		return r.DeceasedString
+	}
+	return nil
+}
+
+// SetDeceasedBoolean sets FamilyMemberHistory.deceased[x] to the boolean and clears the other types.
+func (r *FamilyMemberHistory) SetDeceasedBoolean(v bool) {
+	r.clearDeceased()
+	r.DeceasedBoolean = &v
+}
+
+// SetDeceasedAge sets FamilyMemberHistory.deceased[x] to the Age and clears the other types.
+func (r *FamilyMemberHistory) SetDeceasedAge(v Age) {
+	r.clearDeceased()
+	r.DeceasedAge = &v
+}
+
+// SetDeceasedRange sets FamilyMemberHistory.deceased[x] to the Range and clears the other types.
+func (r *FamilyMemberHistory) SetDeceasedRange(v Range) {
+	r.clearDeceased()
+	r.DeceasedRange = &v
+}
+
+// SetDeceasedDate sets FamilyMemberHistory.deceased[x] to the date and clears the other types.
+func (r *FamilyMemberHistory) SetDeceasedDate(v DateTime) {
+	r.clearDeceased()
+	r.DeceasedDate = &v
+}
+
+// SetDeceasedString sets FamilyMemberHistory.deceased[x] to the string and clears the other types.
+func (r *FamilyMemberHistory) SetDeceasedString(v string) {
+	r.clearDeceased()
+	r.DeceasedString = &v
+}
+func (r *FamilyMemberHistory) clearDeceased() {
+	r.DeceasedBoolean = nil
+	r.DeceasedBooleanElement = nil
+	r.DeceasedAge = nil
+	r.DeceasedRange = nil
+	r.DeceasedDate = nil
+	r.DeceasedDateElement = nil
+	r.DeceasedString = nil
+	r.DeceasedStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the FamilyMemberHistory and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *FamilyMemberHistory) UnmarshalJSON(b []byte) error {
+	type other FamilyMemberHistory
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"FamilyMemberHistory.born[x]",
+		r.BornPeriod != nil,
+		r.BornDate != nil || r.BornDateElement != nil,
+		r.BornString != nil || r.BornStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"FamilyMemberHistory.age[x]",
+		r.AgeAge != nil,
+		r.AgeRange != nil,
+		r.AgeString != nil || r.AgeStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"FamilyMemberHistory.deceased[x]",
+		r.DeceasedBoolean != nil || r.DeceasedBooleanElement != nil,
+		r.DeceasedAge != nil,
+		r.DeceasedRange != nil,
+		r.DeceasedDate != nil || r.DeceasedDateElement != nil,
+		r.DeceasedString != nil || r.DeceasedStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("FamilyMemberHistory.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("FamilyMemberHistory.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("FamilyMemberHistory", r)
+}
+
 type OtherFamilyMemberHistory FamilyMemberHistory
 
 // MarshalJSON marshals the given FamilyMemberHistory as JSON into a byte slice
 func (r FamilyMemberHistory) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherFamilyMemberHistory
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherFamilyMemberHistory: OtherFamilyMemberHistory(r),
 		ResourceType:             "FamilyMemberHistory",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalFamilyMemberHistory unmarshals a FamilyMemberHistory.
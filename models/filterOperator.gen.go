@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.218367 +0000 UTC
+// 2026-10-19 02:55:18.819814948 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FilterOperator is documented here http://hl7.org/fhir/ValueSet/filter-operator
-type FilterOperator int
+// The empty FilterOperator is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FilterOperator string
 
 const (
-	FilterOperatorEquals FilterOperator = iota
-	FilterOperatorIsA
-	FilterOperatorDescendentOf
-	FilterOperatorIsNotA
-	FilterOperatorRegex
-	FilterOperatorIn
-	FilterOperatorNotIn
-	FilterOperatorGeneralizes
-	FilterOperatorExists
+	FilterOperatorEquals       FilterOperator = "="
+	FilterOperatorIsA          FilterOperator = "is-a"
+	FilterOperatorDescendentOf FilterOperator = "descendent-of"
+	FilterOperatorIsNotA       FilterOperator = "is-not-a"
+	FilterOperatorRegex        FilterOperator = "regex"
+	FilterOperatorIn           FilterOperator = "in"
+	FilterOperatorNotIn        FilterOperator = "not-in"
+	FilterOperatorGeneralizes  FilterOperator = "generalizes"
+	FilterOperatorExists       FilterOperator = "exists"
 )
 
 func (code FilterOperator) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FilterOperator"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FilterOperator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "=":
-		*code = FilterOperatorEquals
-	case "is-a":
-		*code = FilterOperatorIsA
-	case "descendent-of":
-		*code = FilterOperatorDescendentOf
-	case "is-not-a":
-		*code = FilterOperatorIsNotA
-	case "regex":
-		*code = FilterOperatorRegex
-	case "in":
-		*code = FilterOperatorIn
-	case "not-in":
-		*code = FilterOperatorNotIn
-	case "generalizes":
-		*code = FilterOperatorGeneralizes
-	case "exists":
-		*code = FilterOperatorExists
-	default:
-		return fmt.Errorf("unknown FilterOperator code `%s`", s)
+func (code *FilterOperator) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = FilterOperator(s)
 	return nil
 }
 func (code FilterOperator) String() string {
 	return code.Code()
 }
 func (code FilterOperator) Code() string {
+	return string(code)
+}
+func (code FilterOperator) Known() bool {
 	switch code {
-	case FilterOperatorEquals:
-		return "="
-	case FilterOperatorIsA:
-		return "is-a"
-	case FilterOperatorDescendentOf:
-		return "descendent-of"
-	case FilterOperatorIsNotA:
-		return "is-not-a"
-	case FilterOperatorRegex:
-		return "regex"
-	case FilterOperatorIn:
-		return "in"
-	case FilterOperatorNotIn:
-		return "not-in"
-	case FilterOperatorGeneralizes:
-		return "generalizes"
-	case FilterOperatorExists:
-		return "exists"
+	case FilterOperatorEquals, FilterOperatorIsA, FilterOperatorDescendentOf, FilterOperatorIsNotA, FilterOperatorRegex, FilterOperatorIn, FilterOperatorNotIn, FilterOperatorGeneralizes, FilterOperatorExists:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FilterOperator) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.257477 +0000 UTC
+// 2026-10-19 02:55:18.821162269 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FinancialResourceStatusCodes is documented here http://hl7.org/fhir/ValueSet/fm-status
-type FinancialResourceStatusCodes int
+// The empty FinancialResourceStatusCodes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FinancialResourceStatusCodes string
 
 const (
-	FinancialResourceStatusCodesActive FinancialResourceStatusCodes = iota
-	FinancialResourceStatusCodesCancelled
-	FinancialResourceStatusCodesDraft
-	FinancialResourceStatusCodesEnteredInError
+	FinancialResourceStatusCodesActive         FinancialResourceStatusCodes = "active"
+	FinancialResourceStatusCodesCancelled      FinancialResourceStatusCodes = "cancelled"
+	FinancialResourceStatusCodesDraft          FinancialResourceStatusCodes = "draft"
+	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
 func (code FinancialResourceStatusCodes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FinancialResourceStatusCodes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FinancialResourceStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = FinancialResourceStatusCodesActive
-	case "cancelled":
-		*code = FinancialResourceStatusCodesCancelled
-	case "draft":
-		*code = FinancialResourceStatusCodesDraft
-	case "entered-in-error":
-		*code = FinancialResourceStatusCodesEnteredInError
-	default:
-		return fmt.Errorf("unknown FinancialResourceStatusCodes code `%s`", s)
+func (code *FinancialResourceStatusCodes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = FinancialResourceStatusCodes(s)
 	return nil
 }
 func (code FinancialResourceStatusCodes) String() string {
 	return code.Code()
 }
 func (code FinancialResourceStatusCodes) Code() string {
+	return string(code)
+}
+func (code FinancialResourceStatusCodes) Known() bool {
 	switch code {
-	case FinancialResourceStatusCodesActive:
-		return "active"
-	case FinancialResourceStatusCodesCancelled:
-		return "cancelled"
-	case FinancialResourceStatusCodesDraft:
-		return "draft"
-	case FinancialResourceStatusCodesEnteredInError:
-		return "entered-in-error"
+	case FinancialResourceStatusCodesActive, FinancialResourceStatusCodesCancelled, FinancialResourceStatusCodesDraft, FinancialResourceStatusCodesEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FinancialResourceStatusCodes) Display() string {
 	switch code {
@@ -22,33 +22,52 @@ import "encoding/json"
 
 // Flag is documented here http://hl7.org/fhir/StructureDefinition/Flag
 type Flag struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FlagStatus        `bson:"status" json:"status"`
-	Category          []CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
-	Code              CodeableConcept   `bson:"code" json:"code"`
-	Subject           Reference         `bson:"subject" json:"subject"`
-	Period            *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	Encounter         *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Author            *Reference        `bson:"author,omitempty" json:"author,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FlagStatus                 `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category             []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Code                 CodeableConcept            `bson:"code" json:"code"`
+	Subject              Reference                  `bson:"subject" json:"subject"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Encounter            *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Author               *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Flag and fails with CodeError when a coded element has an unknown code.
+func (r *Flag) UnmarshalJSON(b []byte) error {
+	type other Flag
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Flag", r)
+}
+
 type OtherFlag Flag
 
 // MarshalJSON marshals the given Flag as JSON into a byte slice
 func (r Flag) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherFlag
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherFlag:    OtherFlag(r),
 		ResourceType: "Flag",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalFlag unmarshals a Flag.
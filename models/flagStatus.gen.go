@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.18501 +0000 UTC
+// 2026-10-19 02:55:18.822596514 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // FlagStatus is documented here http://hl7.org/fhir/ValueSet/flag-status
-type FlagStatus int
+// The empty FlagStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type FlagStatus string
 
 const (
-	FlagStatusActive FlagStatus = iota
-	FlagStatusInactive
-	FlagStatusEnteredInError
+	FlagStatusActive         FlagStatus = "active"
+	FlagStatusInactive       FlagStatus = "inactive"
+	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
 
 func (code FlagStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "FlagStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *FlagStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = FlagStatusActive
-	case "inactive":
-		*code = FlagStatusInactive
-	case "entered-in-error":
-		*code = FlagStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown FlagStatus code `%s`", s)
+func (code *FlagStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = FlagStatus(s)
 	return nil
 }
 func (code FlagStatus) String() string {
 	return code.Code()
 }
 func (code FlagStatus) Code() string {
+	return string(code)
+}
+func (code FlagStatus) Known() bool {
 	switch code {
-	case FlagStatusActive:
-		return "active"
-	case FlagStatusInactive:
-		return "inactive"
-	case FlagStatusEnteredInError:
-		return "entered-in-error"
+	case FlagStatusActive, FlagStatusInactive, FlagStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code FlagStatus) Display() string {
 	switch code {
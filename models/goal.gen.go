@@ -22,46 +22,260 @@ import "encoding/json"
 
 // Goal is documented here http://hl7.org/fhir/StructureDefinition/Goal
 type Goal struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	LifecycleStatus   GoalLifecycleStatus `bson:"lifecycleStatus" json:"lifecycleStatus"`
-	AchievementStatus *CodeableConcept    `bson:"achievementStatus,omitempty" json:"achievementStatus,omitempty"`
-	Category          []CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
-	Priority          *CodeableConcept    `bson:"priority,omitempty" json:"priority,omitempty"`
-	Description       CodeableConcept     `bson:"description" json:"description"`
-	Subject           Reference           `bson:"subject" json:"subject"`
-	Target            []GoalTarget        `bson:"target,omitempty" json:"target,omitempty"`
-	StatusDate        *DateTime           `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	StatusReason      *string             `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	ExpressedBy       *Reference          `bson:"expressedBy,omitempty" json:"expressedBy,omitempty"`
-	Addresses         []Reference         `bson:"addresses,omitempty" json:"addresses,omitempty"`
-	Note              []Annotation        `bson:"note,omitempty" json:"note,omitempty"`
-	OutcomeCode       []CodeableConcept   `bson:"outcomeCode,omitempty" json:"outcomeCode,omitempty"`
-	OutcomeReference  []Reference         `bson:"outcomeReference,omitempty" json:"outcomeReference,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	LifecycleStatus        GoalLifecycleStatus        `bson:"lifecycleStatus" json:"lifecycleStatus"`
+	LifecycleStatusElement *Element                   `bson:"_lifecycleStatus,omitempty" json:"_lifecycleStatus,omitempty"`
+	AchievementStatus      *CodeableConcept           `bson:"achievementStatus,omitempty" json:"achievementStatus,omitempty"`
+	Category               []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Priority               *CodeableConcept           `bson:"priority,omitempty" json:"priority,omitempty"`
+	Description            CodeableConcept            `bson:"description" json:"description"`
+	Subject                Reference                  `bson:"subject" json:"subject"`
+	StartDate              *DateTime                  `bson:"startDate,omitempty" json:"startDate,omitempty"`
+	StartDateElement       *Element                   `bson:"_startDate,omitempty" json:"_startDate,omitempty"`
+	StartCodeableConcept   *CodeableConcept           `bson:"startCodeableConcept,omitempty" json:"startCodeableConcept,omitempty"`
+	Target                 []GoalTarget               `bson:"target,omitempty" json:"target,omitempty"`
+	StatusDate             *DateTime                  `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement      *Element                   `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	StatusReason           *string                    `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	StatusReasonElement    *Element                   `bson:"_statusReason,omitempty" json:"_statusReason,omitempty"`
+	ExpressedBy            *Reference                 `bson:"expressedBy,omitempty" json:"expressedBy,omitempty"`
+	Addresses              []Reference                `bson:"addresses,omitempty" json:"addresses,omitempty"`
+	Note                   []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	OutcomeCode            []CodeableConcept          `bson:"outcomeCode,omitempty" json:"outcomeCode,omitempty"`
+	OutcomeReference       []Reference                `bson:"outcomeReference,omitempty" json:"outcomeReference,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type GoalTarget struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Measure           *CodeableConcept `bson:"measure,omitempty" json:"measure,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Measure               *CodeableConcept           `bson:"measure,omitempty" json:"measure,omitempty"`
+	DetailQuantity        *Quantity                  `bson:"detailQuantity,omitempty" json:"detailQuantity,omitempty"`
+	DetailRange           *Range                     `bson:"detailRange,omitempty" json:"detailRange,omitempty"`
+	DetailCodeableConcept *CodeableConcept           `bson:"detailCodeableConcept,omitempty" json:"detailCodeableConcept,omitempty"`
+	DetailString          *string                    `bson:"detailString,omitempty" json:"detailString,omitempty"`
+	DetailStringElement   *Element                   `bson:"_detailString,omitempty" json:"_detailString,omitempty"`
+	DetailBoolean         *bool                      `bson:"detailBoolean,omitempty" json:"detailBoolean,omitempty"`
+	DetailBooleanElement  *Element                   `bson:"_detailBoolean,omitempty" json:"_detailBoolean,omitempty"`
+	DetailInteger         *int                       `bson:"detailInteger,omitempty" json:"detailInteger,omitempty"`
+	DetailIntegerElement  *Element                   `bson:"_detailInteger,omitempty" json:"_detailInteger,omitempty"`
+	DetailRatio           *Ratio                     `bson:"detailRatio,omitempty" json:"detailRatio,omitempty"`
+	DueDate               *DateTime                  `bson:"dueDate,omitempty" json:"dueDate,omitempty"`
+	DueDateElement        *Element                   `bson:"_dueDate,omitempty" json:"_dueDate,omitempty"`
+	DueDuration           *Duration                  `bson:"dueDuration,omitempty" json:"dueDuration,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Detail returns Goal.target.detail[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *GoalTarget) Detail() interface{} {
+	switch {
+	case r.DetailQuantity != nil:
+		return r.DetailQuantity
+	case r.DetailRange != nil:
+		return r.DetailRange
+	case r.DetailCodeableConcept != nil:
+		return r.DetailCodeableConcept
+	case r.DetailString != nil:
+		return r.DetailString
+	case r.DetailBoolean != nil:
+		return r.DetailBoolean
+	case r.DetailInteger != nil:
+		return r.DetailInteger
+	case r.DetailRatio != nil:
+		return r.DetailRatio
+	}
+	return nil
+}
+
+// SetDetailQuantity sets Goal.target.detail[x] to the Quantity and clears the other types.
+func (r *GoalTarget) SetDetailQuantity(v Quantity) {
+	r.clearDetail()
+	r.DetailQuantity = &v
+}
+
+// SetDetailRange sets Goal.target.detail[x] to the Range and clears the other types.
+func (r *GoalTarget) SetDetailRange(v Range) {
+	r.clearDetail()
+	r.DetailRange = &v
+}
+
+// SetDetailCodeableConcept sets Goal.target.detail[x] to the CodeableConcept and clears the other types.
+func (r *GoalTarget) SetDetailCodeableConcept(v CodeableConcept) {
+	r.clearDetail()
+	r.DetailCodeableConcept = &v
+}
+
+// SetDetailString sets Goal.target.detail[x] to the string and clears the other types.
+func (r *GoalTarget) SetDetailString(v string) {
+	r.clearDetail()
+	r.DetailString = &v
+}
+
+// SetDetailBoolean sets Goal.target.detail[x] to the boolean and clears the other types.
+func (r *GoalTarget) SetDetailBoolean(v bool) {
+	r.clearDetail()
+	r.DetailBoolean = &v
+}
+
+// SetDetailInteger sets Goal.target.detail[x] to the integer and clears the other types.
+func (r *GoalTarget) SetDetailInteger(v int) {
+	r.clearDetail()
+	r.DetailInteger = &v
+}
+
+// SetDetailRatio sets Goal.target.detail[x] to the Ratio and clears the other types.
+func (r *GoalTarget) SetDetailRatio(v Ratio) {
+	r.clearDetail()
+	r.DetailRatio = &v
+}
+func (r *GoalTarget) clearDetail() {
+	r.DetailQuantity = nil
+	r.DetailRange = nil
+	r.DetailCodeableConcept = nil
+	r.DetailString = nil
+	r.DetailStringElement = nil
+	r.DetailBoolean = nil
+	r.DetailBooleanElement = nil
+	r.DetailInteger = nil
+	r.DetailIntegerElement = nil
+	r.DetailRatio = nil
+}
+
+// Due returns Goal.target.due[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *GoalTarget) Due() interface{} {
+	switch {
+	case r.DueDate != nil:
+		return r.DueDate
+	case r.DueDuration != nil:
+		return r.DueDuration
+	}
+	return nil
+}
+
+// SetDueDate sets Goal.target.due[x] to the date and clears the other types.
+func (r *GoalTarget) SetDueDate(v DateTime) {
+	r.clearDue()
+	r.DueDate = &v
+}
+
+// SetDueDuration sets Goal.target.due[x] to the Duration and clears the other types.
+func (r *GoalTarget) SetDueDuration(v Duration) {
+	r.clearDue()
+	r.DueDuration = &v
+}
+func (r *GoalTarget) clearDue() {
+	r.DueDate = nil
+	r.DueDateElement = nil
+	r.DueDuration = nil
+}
+
+// UnmarshalJSON unmarshals the GoalTarget and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *GoalTarget) UnmarshalJSON(b []byte) error {
+	type other GoalTarget
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Goal.target.detail[x]",
+		r.DetailQuantity != nil,
+		r.DetailRange != nil,
+		r.DetailCodeableConcept != nil,
+		r.DetailString != nil || r.DetailStringElement != nil,
+		r.DetailBoolean != nil || r.DetailBooleanElement != nil,
+		r.DetailInteger != nil || r.DetailIntegerElement != nil,
+		r.DetailRatio != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Goal.target.due[x]",
+		r.DueDate != nil || r.DueDateElement != nil,
+		r.DueDuration != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the GoalTarget with its unknown fields.
+func (r GoalTarget) MarshalJSON() ([]byte, error) {
+	type other GoalTarget
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Start returns Goal.start[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Goal) Start() interface{} {
+	switch {
+	case r.StartDate != nil:
+		return r.StartDate
+	case r.StartCodeableConcept != nil:
+		return r.StartCodeableConcept
+	}
+	return nil
+}
+
+// SetStartDate sets Goal.start[x] to the date and clears the other types.
+func (r *Goal) SetStartDate(v DateTime) {
+	r.clearStart()
+	r.StartDate = &v
+}
+
+// SetStartCodeableConcept sets Goal.start[x] to the CodeableConcept and clears the other types.
+func (r *Goal) SetStartCodeableConcept(v CodeableConcept) {
+	r.clearStart()
+	r.StartCodeableConcept = &v
+}
+func (r *Goal) clearStart() {
+	r.StartDate = nil
+	r.StartDateElement = nil
+	r.StartCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the Goal and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Goal) UnmarshalJSON(b []byte) error {
+	type other Goal
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Goal.start[x]",
+		r.StartDate != nil || r.StartDateElement != nil,
+		r.StartCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Goal", r)
+}
+
 type OtherGoal Goal
 
 // MarshalJSON marshals the given Goal as JSON into a byte slice
 func (r Goal) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherGoal
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherGoal:    OtherGoal(r),
 		ResourceType: "Goal",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalGoal unmarshals a Goal.
@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.28444 +0000 UTC
+// 2026-10-19 02:55:18.823813383 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GoalLifecycleStatus is documented here http://hl7.org/fhir/ValueSet/goal-status
-type GoalLifecycleStatus int
+// The empty GoalLifecycleStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GoalLifecycleStatus string
 
 const (
-	GoalLifecycleStatusProposed GoalLifecycleStatus = iota
-	GoalLifecycleStatusPlanned
-	GoalLifecycleStatusAccepted
-	GoalLifecycleStatusActive
-	GoalLifecycleStatusOnHold
-	GoalLifecycleStatusCompleted
-	GoalLifecycleStatusCancelled
-	GoalLifecycleStatusEnteredInError
-	GoalLifecycleStatusRejected
+	GoalLifecycleStatusProposed       GoalLifecycleStatus = "proposed"
+	GoalLifecycleStatusPlanned        GoalLifecycleStatus = "planned"
+	GoalLifecycleStatusAccepted       GoalLifecycleStatus = "accepted"
+	GoalLifecycleStatusActive         GoalLifecycleStatus = "active"
+	GoalLifecycleStatusOnHold         GoalLifecycleStatus = "on-hold"
+	GoalLifecycleStatusCompleted      GoalLifecycleStatus = "completed"
+	GoalLifecycleStatusCancelled      GoalLifecycleStatus = "cancelled"
+	GoalLifecycleStatusEnteredInError GoalLifecycleStatus = "entered-in-error"
+	GoalLifecycleStatusRejected       GoalLifecycleStatus = "rejected"
 )
 
 func (code GoalLifecycleStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GoalLifecycleStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GoalLifecycleStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "proposed":
-		*code = GoalLifecycleStatusProposed
-	case "planned":
-		*code = GoalLifecycleStatusPlanned
-	case "accepted":
-		*code = GoalLifecycleStatusAccepted
-	case "active":
-		*code = GoalLifecycleStatusActive
-	case "on-hold":
-		*code = GoalLifecycleStatusOnHold
-	case "completed":
-		*code = GoalLifecycleStatusCompleted
-	case "cancelled":
-		*code = GoalLifecycleStatusCancelled
-	case "entered-in-error":
-		*code = GoalLifecycleStatusEnteredInError
-	case "rejected":
-		*code = GoalLifecycleStatusRejected
-	default:
-		return fmt.Errorf("unknown GoalLifecycleStatus code `%s`", s)
+func (code *GoalLifecycleStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = GoalLifecycleStatus(s)
 	return nil
 }
 func (code GoalLifecycleStatus) String() string {
 	return code.Code()
 }
 func (code GoalLifecycleStatus) Code() string {
+	return string(code)
+}
+func (code GoalLifecycleStatus) Known() bool {
 	switch code {
-	case GoalLifecycleStatusProposed:
-		return "proposed"
-	case GoalLifecycleStatusPlanned:
-		return "planned"
-	case GoalLifecycleStatusAccepted:
-		return "accepted"
-	case GoalLifecycleStatusActive:
-		return "active"
-	case GoalLifecycleStatusOnHold:
-		return "on-hold"
-	case GoalLifecycleStatusCompleted:
-		return "completed"
-	case GoalLifecycleStatusCancelled:
-		return "cancelled"
-	case GoalLifecycleStatusEnteredInError:
-		return "entered-in-error"
-	case GoalLifecycleStatusRejected:
-		return "rejected"
+	case GoalLifecycleStatusProposed, GoalLifecycleStatusPlanned, GoalLifecycleStatusAccepted, GoalLifecycleStatusActive, GoalLifecycleStatusOnHold, GoalLifecycleStatusCompleted, GoalLifecycleStatusCancelled, GoalLifecycleStatusEnteredInError, GoalLifecycleStatusRejected:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GoalLifecycleStatus) Display() string {
 	switch code {
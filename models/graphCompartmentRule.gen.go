@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.26311 +0000 UTC
+// 2026-10-19 02:55:18.825190898 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GraphCompartmentRule is documented here http://hl7.org/fhir/ValueSet/graph-compartment-rule
-type GraphCompartmentRule int
+// The empty GraphCompartmentRule is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GraphCompartmentRule string
 
 const (
-	GraphCompartmentRuleIdentical GraphCompartmentRule = iota
-	GraphCompartmentRuleMatching
-	GraphCompartmentRuleDifferent
-	GraphCompartmentRuleCustom
+	GraphCompartmentRuleIdentical GraphCompartmentRule = "identical"
+	GraphCompartmentRuleMatching  GraphCompartmentRule = "matching"
+	GraphCompartmentRuleDifferent GraphCompartmentRule = "different"
+	GraphCompartmentRuleCustom    GraphCompartmentRule = "custom"
 )
 
 func (code GraphCompartmentRule) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GraphCompartmentRule"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GraphCompartmentRule) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "identical":
-		*code = GraphCompartmentRuleIdentical
-	case "matching":
-		*code = GraphCompartmentRuleMatching
-	case "different":
-		*code = GraphCompartmentRuleDifferent
-	case "custom":
-		*code = GraphCompartmentRuleCustom
-	default:
-		return fmt.Errorf("unknown GraphCompartmentRule code `%s`", s)
+func (code *GraphCompartmentRule) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = GraphCompartmentRule(s)
 	return nil
 }
 func (code GraphCompartmentRule) String() string {
 	return code.Code()
 }
 func (code GraphCompartmentRule) Code() string {
+	return string(code)
+}
+func (code GraphCompartmentRule) Known() bool {
 	switch code {
-	case GraphCompartmentRuleIdentical:
-		return "identical"
-	case GraphCompartmentRuleMatching:
-		return "matching"
-	case GraphCompartmentRuleDifferent:
-		return "different"
-	case GraphCompartmentRuleCustom:
-		return "custom"
+	case GraphCompartmentRuleIdentical, GraphCompartmentRuleMatching, GraphCompartmentRuleDifferent, GraphCompartmentRuleCustom:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GraphCompartmentRule) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.238444 +0000 UTC
+// 2026-10-19 02:55:18.826249673 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GraphCompartmentUse is documented here http://hl7.org/fhir/ValueSet/graph-compartment-use
-type GraphCompartmentUse int
+// The empty GraphCompartmentUse is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GraphCompartmentUse string
 
 const (
-	GraphCompartmentUseCondition GraphCompartmentUse = iota
-	GraphCompartmentUseRequirement
+	GraphCompartmentUseCondition   GraphCompartmentUse = "condition"
+	GraphCompartmentUseRequirement GraphCompartmentUse = "requirement"
 )
 
 func (code GraphCompartmentUse) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GraphCompartmentUse"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GraphCompartmentUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "condition":
-		*code = GraphCompartmentUseCondition
-	case "requirement":
-		*code = GraphCompartmentUseRequirement
-	default:
-		return fmt.Errorf("unknown GraphCompartmentUse code `%s`", s)
+func (code *GraphCompartmentUse) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = GraphCompartmentUse(s)
 	return nil
 }
 func (code GraphCompartmentUse) String() string {
 	return code.Code()
 }
 func (code GraphCompartmentUse) Code() string {
+	return string(code)
+}
+func (code GraphCompartmentUse) Known() bool {
 	switch code {
-	case GraphCompartmentUseCondition:
-		return "condition"
-	case GraphCompartmentUseRequirement:
-		return "requirement"
+	case GraphCompartmentUseCondition, GraphCompartmentUseRequirement:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GraphCompartmentUse) Display() string {
 	switch code {
@@ -22,71 +22,146 @@ import "encoding/json"
 
 // GraphDefinition is documented here http://hl7.org/fhir/StructureDefinition/GraphDefinition
 type GraphDefinition struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string               `bson:"url,omitempty" json:"url,omitempty"`
-	Version           *string               `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                `bson:"name" json:"name"`
-	Status            PublicationStatus     `bson:"status" json:"status"`
-	Experimental      *bool                 `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime             `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string               `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string               `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext        `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept     `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string               `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Start             ResourceType          `bson:"start" json:"start"`
-	Profile           *string               `bson:"profile,omitempty" json:"profile,omitempty"`
-	Link              []GraphDefinitionLink `bson:"link,omitempty" json:"link,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Start                ResourceType               `bson:"start" json:"start"`
+	StartElement         *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	Profile              *string                    `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement       *Element                   `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	Link                 []GraphDefinitionLink      `bson:"link,omitempty" json:"link,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type GraphDefinitionLink struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Path              *string                     `bson:"path,omitempty" json:"path,omitempty"`
-	SliceName         *string                     `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
-	Min               *int                        `bson:"min,omitempty" json:"min,omitempty"`
-	Max               *string                     `bson:"max,omitempty" json:"max,omitempty"`
-	Description       *string                     `bson:"description,omitempty" json:"description,omitempty"`
-	Target            []GraphDefinitionLinkTarget `bson:"target,omitempty" json:"target,omitempty"`
+	ID                 *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Path               *string                     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement        *Element                    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SliceName          *string                     `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
+	SliceNameElement   *Element                    `bson:"_sliceName,omitempty" json:"_sliceName,omitempty"`
+	Min                *int                        `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement         *Element                    `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                *string                     `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement         *Element                    `bson:"_max,omitempty" json:"_max,omitempty"`
+	Description        *string                     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Target             []GraphDefinitionLinkTarget `bson:"target,omitempty" json:"target,omitempty"`
+	UnknownFields      map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type GraphDefinitionLinkTarget struct {
 	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              ResourceType                           `bson:"type" json:"type"`
+	TypeElement       *Element                               `bson:"_type,omitempty" json:"_type,omitempty"`
 	Params            *string                                `bson:"params,omitempty" json:"params,omitempty"`
+	ParamsElement     *Element                               `bson:"_params,omitempty" json:"_params,omitempty"`
 	Profile           *string                                `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement    *Element                               `bson:"_profile,omitempty" json:"_profile,omitempty"`
 	Compartment       []GraphDefinitionLinkTargetCompartment `bson:"compartment,omitempty" json:"compartment,omitempty"`
 	Link              []GraphDefinitionLink                  `bson:"link,omitempty" json:"link,omitempty"`
+	UnknownFields     map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type GraphDefinitionLinkTargetCompartment struct {
-	ID                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Use               GraphCompartmentUse  `bson:"use" json:"use"`
-	Code              CompartmentType      `bson:"code" json:"code"`
-	Rule              GraphCompartmentRule `bson:"rule" json:"rule"`
-	Expression        *string              `bson:"expression,omitempty" json:"expression,omitempty"`
-	Description       *string              `bson:"description,omitempty" json:"description,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Use                GraphCompartmentUse        `bson:"use" json:"use"`
+	UseElement         *Element                   `bson:"_use,omitempty" json:"_use,omitempty"`
+	Code               CompartmentType            `bson:"code" json:"code"`
+	CodeElement        *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Rule               GraphCompartmentRule       `bson:"rule" json:"rule"`
+	RuleElement        *Element                   `bson:"_rule,omitempty" json:"_rule,omitempty"`
+	Expression         *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the GraphDefinitionLinkTargetCompartment with its unknown fields.
+func (r GraphDefinitionLinkTargetCompartment) MarshalJSON() ([]byte, error) {
+	type other GraphDefinitionLinkTargetCompartment
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the GraphDefinitionLinkTarget with its unknown fields.
+func (r GraphDefinitionLinkTarget) MarshalJSON() ([]byte, error) {
+	type other GraphDefinitionLinkTarget
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the GraphDefinitionLink with its unknown fields.
+func (r GraphDefinitionLink) MarshalJSON() ([]byte, error) {
+	type other GraphDefinitionLink
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the GraphDefinition and fails with CodeError when a coded element has an unknown code.
+func (r *GraphDefinition) UnmarshalJSON(b []byte) error {
+	type other GraphDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("GraphDefinition", r)
+}
+
 type OtherGraphDefinition GraphDefinition
 
 // MarshalJSON marshals the given GraphDefinition as JSON into a byte slice
 func (r GraphDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherGraphDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherGraphDefinition: OtherGraphDefinition(r),
 		ResourceType:         "GraphDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalGraphDefinition unmarshals a GraphDefinition.
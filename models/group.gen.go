@@ -22,51 +22,179 @@ import "encoding/json"
 
 // Group is documented here http://hl7.org/fhir/StructureDefinition/Group
 type Group struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                 `bson:"active,omitempty" json:"active,omitempty"`
-	Type              GroupType             `bson:"type" json:"type"`
-	Actual            bool                  `bson:"actual" json:"actual"`
-	Code              *CodeableConcept      `bson:"code,omitempty" json:"code,omitempty"`
-	Name              *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Quantity          *int                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ManagingEntity    *Reference            `bson:"managingEntity,omitempty" json:"managingEntity,omitempty"`
-	Characteristic    []GroupCharacteristic `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
-	Member            []GroupMember         `bson:"member,omitempty" json:"member,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Type                 GroupType                  `bson:"type" json:"type"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Actual               bool                       `bson:"actual" json:"actual"`
+	ActualElement        *Element                   `bson:"_actual,omitempty" json:"_actual,omitempty"`
+	Code                 *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Quantity             *int                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	QuantityElement      *Element                   `bson:"_quantity,omitempty" json:"_quantity,omitempty"`
+	ManagingEntity       *Reference                 `bson:"managingEntity,omitempty" json:"managingEntity,omitempty"`
+	Characteristic       []GroupCharacteristic      `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
+	Member               []GroupMember              `bson:"member,omitempty" json:"member,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type GroupCharacteristic struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
-	Exclude           bool            `bson:"exclude" json:"exclude"`
-	Period            *Period         `bson:"period,omitempty" json:"period,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 CodeableConcept            `bson:"code" json:"code"`
+	ValueCodeableConcept *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueQuantity        *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange           *Range                     `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueReference       *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Exclude              bool                       `bson:"exclude" json:"exclude"`
+	ExcludeElement       *Element                   `bson:"_exclude,omitempty" json:"_exclude,omitempty"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Value returns Group.characteristic.value[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *GroupCharacteristic) Value() interface{} {
+	switch {
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueCodeableConcept sets Group.characteristic.value[x] to the CodeableConcept and clears the other types.
+func (r *GroupCharacteristic) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueBoolean sets Group.characteristic.value[x] to the boolean and clears the other types.
+func (r *GroupCharacteristic) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueQuantity sets Group.characteristic.value[x] to the Quantity and clears the other types.
+func (r *GroupCharacteristic) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets Group.characteristic.value[x] to the Range and clears the other types.
+func (r *GroupCharacteristic) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueReference sets Group.characteristic.value[x] to the Reference and clears the other types.
+func (r *GroupCharacteristic) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *GroupCharacteristic) clearValue() {
+	r.ValueCodeableConcept = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the GroupCharacteristic and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *GroupCharacteristic) UnmarshalJSON(b []byte) error {
+	type other GroupCharacteristic
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Group.characteristic.value[x]",
+		r.ValueCodeableConcept != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the GroupCharacteristic with its unknown fields.
+func (r GroupCharacteristic) MarshalJSON() ([]byte, error) {
+	type other GroupCharacteristic
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type GroupMember struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Entity            Reference   `bson:"entity" json:"entity"`
-	Period            *Period     `bson:"period,omitempty" json:"period,omitempty"`
-	Inactive          *bool       `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Entity            Reference                  `bson:"entity" json:"entity"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Inactive          *bool                      `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	InactiveElement   *Element                   `bson:"_inactive,omitempty" json:"_inactive,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the GroupMember with its unknown fields.
+func (r GroupMember) MarshalJSON() ([]byte, error) {
+	type other GroupMember
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Group and fails with CodeError when a coded element has an unknown code.
+func (r *Group) UnmarshalJSON(b []byte) error {
+	type other Group
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Group", r)
+}
+
 type OtherGroup Group
 
 // MarshalJSON marshals the given Group as JSON into a byte slice
 func (r Group) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherGroup
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherGroup:   OtherGroup(r),
 		ResourceType: "Group",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalGroup unmarshals a Group.
@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.224923 +0000 UTC
+// 2026-10-19 02:55:18.827259795 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GroupMeasure is documented here http://hl7.org/fhir/ValueSet/group-measure
-type GroupMeasure int
+// The empty GroupMeasure is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GroupMeasure string
 
 const (
-	GroupMeasureMean GroupMeasure = iota
-	GroupMeasureMedian
-	GroupMeasureMeanOfMean
-	GroupMeasureMeanOfMedian
-	GroupMeasureMedianOfMean
-	GroupMeasureMedianOfMedian
+	GroupMeasureMean           GroupMeasure = "mean"
+	GroupMeasureMedian         GroupMeasure = "median"
+	GroupMeasureMeanOfMean     GroupMeasure = "mean-of-mean"
+	GroupMeasureMeanOfMedian   GroupMeasure = "mean-of-median"
+	GroupMeasureMedianOfMean   GroupMeasure = "median-of-mean"
+	GroupMeasureMedianOfMedian GroupMeasure = "median-of-median"
 )
 
 func (code GroupMeasure) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GroupMeasure"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GroupMeasure) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "mean":
-		*code = GroupMeasureMean
-	case "median":
-		*code = GroupMeasureMedian
-	case "mean-of-mean":
-		*code = GroupMeasureMeanOfMean
-	case "mean-of-median":
-		*code = GroupMeasureMeanOfMedian
-	case "median-of-mean":
-		*code = GroupMeasureMedianOfMean
-	case "median-of-median":
-		*code = GroupMeasureMedianOfMedian
-	default:
-		return fmt.Errorf("unknown GroupMeasure code `%s`", s)
+func (code *GroupMeasure) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = GroupMeasure(s)
 	return nil
 }
 func (code GroupMeasure) String() string {
 	return code.Code()
 }
 func (code GroupMeasure) Code() string {
+	return string(code)
+}
+func (code GroupMeasure) Known() bool {
 	switch code {
-	case GroupMeasureMean:
-		return "mean"
-	case GroupMeasureMedian:
-		return "median"
-	case GroupMeasureMeanOfMean:
-		return "mean-of-mean"
-	case GroupMeasureMeanOfMedian:
-		return "mean-of-median"
-	case GroupMeasureMedianOfMean:
-		return "median-of-mean"
-	case GroupMeasureMedianOfMedian:
-		return "median-of-median"
+	case GroupMeasureMean, GroupMeasureMedian, GroupMeasureMeanOfMean, GroupMeasureMeanOfMedian, GroupMeasureMedianOfMean, GroupMeasureMedianOfMedian:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GroupMeasure) Display() string {
 	switch code {
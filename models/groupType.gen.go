@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.285505 +0000 UTC
+// 2026-10-19 02:55:18.8284876 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GroupType is documented here http://hl7.org/fhir/ValueSet/group-type
-type GroupType int
+// The empty GroupType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GroupType string
 
 const (
-	GroupTypePerson GroupType = iota
-	GroupTypeAnimal
-	GroupTypePractitioner
-	GroupTypeDevice
-	GroupTypeMedication
-	GroupTypeSubstance
+	GroupTypePerson       GroupType = "person"
+	GroupTypeAnimal       GroupType = "animal"
+	GroupTypePractitioner GroupType = "practitioner"
+	GroupTypeDevice       GroupType = "device"
+	GroupTypeMedication   GroupType = "medication"
+	GroupTypeSubstance    GroupType = "substance"
 )
 
 func (code GroupType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GroupType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GroupType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "person":
-		*code = GroupTypePerson
-	case "animal":
-		*code = GroupTypeAnimal
-	case "practitioner":
-		*code = GroupTypePractitioner
-	case "device":
-		*code = GroupTypeDevice
-	case "medication":
-		*code = GroupTypeMedication
-	case "substance":
-		*code = GroupTypeSubstance
-	default:
-		return fmt.Errorf("unknown GroupType code `%s`", s)
+func (code *GroupType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = GroupType(s)
 	return nil
 }
 func (code GroupType) String() string {
 	return code.Code()
 }
 func (code GroupType) Code() string {
+	return string(code)
+}
+func (code GroupType) Known() bool {
 	switch code {
-	case GroupTypePerson:
-		return "person"
-	case GroupTypeAnimal:
-		return "animal"
-	case GroupTypePractitioner:
-		return "practitioner"
-	case GroupTypeDevice:
-		return "device"
-	case GroupTypeMedication:
-		return "medication"
-	case GroupTypeSubstance:
-		return "substance"
+	case GroupTypePerson, GroupTypeAnimal, GroupTypePractitioner, GroupTypeDevice, GroupTypeMedication, GroupTypeSubstance:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GroupType) Display() string {
 	switch code {
@@ -22,39 +22,110 @@ import "encoding/json"
 
 // GuidanceResponse is documented here http://hl7.org/fhir/StructureDefinition/GuidanceResponse
 type GuidanceResponse struct {
-	ID                 *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	RequestIdentifier  *Identifier            `bson:"requestIdentifier,omitempty" json:"requestIdentifier,omitempty"`
-	Identifier         []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status             GuidanceResponseStatus `bson:"status" json:"status"`
-	Subject            *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter          *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OccurrenceDateTime *DateTime              `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	Performer          *Reference             `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode         []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note               []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
-	EvaluationMessage  []Reference            `bson:"evaluationMessage,omitempty" json:"evaluationMessage,omitempty"`
-	OutputParameters   *Reference             `bson:"outputParameters,omitempty" json:"outputParameters,omitempty"`
-	Result             *Reference             `bson:"result,omitempty" json:"result,omitempty"`
-	DataRequirement    []DataRequirement      `bson:"dataRequirement,omitempty" json:"dataRequirement,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	RequestIdentifier         *Identifier                `bson:"requestIdentifier,omitempty" json:"requestIdentifier,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ModuleUri                 *string                    `bson:"moduleUri,omitempty" json:"moduleUri,omitempty"`
+	ModuleUriElement          *Element                   `bson:"_moduleUri,omitempty" json:"_moduleUri,omitempty"`
+	ModuleCanonical           *string                    `bson:"moduleCanonical,omitempty" json:"moduleCanonical,omitempty"`
+	ModuleCanonicalElement    *Element                   `bson:"_moduleCanonical,omitempty" json:"_moduleCanonical,omitempty"`
+	ModuleCodeableConcept     *CodeableConcept           `bson:"moduleCodeableConcept,omitempty" json:"moduleCodeableConcept,omitempty"`
+	Status                    GuidanceResponseStatus     `bson:"status" json:"status"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Subject                   *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter                 *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime        *DateTime                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	Performer                 *Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                      []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	EvaluationMessage         []Reference                `bson:"evaluationMessage,omitempty" json:"evaluationMessage,omitempty"`
+	OutputParameters          *Reference                 `bson:"outputParameters,omitempty" json:"outputParameters,omitempty"`
+	Result                    *Reference                 `bson:"result,omitempty" json:"result,omitempty"`
+	DataRequirement           []DataRequirement          `bson:"dataRequirement,omitempty" json:"dataRequirement,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Module returns GuidanceResponse.module[x] of the set type, such as *string, or nil when it is not set.
+func (r *GuidanceResponse) Module() interface{} {
+	switch {
+	case r.ModuleUri != nil:
+		return r.ModuleUri
+	case r.ModuleCanonical != nil:
+		return r.ModuleCanonical
+	case r.ModuleCodeableConcept != nil:
+		return r.ModuleCodeableConcept
+	}
+	return nil
+}
+
+// SetModuleUri sets GuidanceResponse.module[x] to the uri and clears the other types.
+func (r *GuidanceResponse) SetModuleUri(v string) {
+	r.clearModule()
+	r.ModuleUri = &v
+}
+
+// SetModuleCanonical sets GuidanceResponse.module[x] to the canonical and clears the other types.
+func (r *GuidanceResponse) SetModuleCanonical(v string) {
+	r.clearModule()
+	r.ModuleCanonical = &v
+}
+
+// SetModuleCodeableConcept sets GuidanceResponse.module[x] to the CodeableConcept and clears the other types.
+func (r *GuidanceResponse) SetModuleCodeableConcept(v CodeableConcept) {
+	r.clearModule()
+	r.ModuleCodeableConcept = &v
+}
+func (r *GuidanceResponse) clearModule() {
+	r.ModuleUri = nil
+	r.ModuleUriElement = nil
+	r.ModuleCanonical = nil
+	r.ModuleCanonicalElement = nil
+	r.ModuleCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the GuidanceResponse and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *GuidanceResponse) UnmarshalJSON(b []byte) error {
+	type other GuidanceResponse
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"GuidanceResponse.module[x]",
+		r.ModuleUri != nil || r.ModuleUriElement != nil,
+		r.ModuleCanonical != nil || r.ModuleCanonicalElement != nil,
+		r.ModuleCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("GuidanceResponse", r)
+}
+
 type OtherGuidanceResponse GuidanceResponse
 
 // MarshalJSON marshals the given GuidanceResponse as JSON into a byte slice
 func (r GuidanceResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherGuidanceResponse
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherGuidanceResponse: OtherGuidanceResponse(r),
 		ResourceType:          "GuidanceResponse",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalGuidanceResponse unmarshals a GuidanceResponse.
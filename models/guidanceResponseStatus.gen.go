@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.128084 +0000 UTC
+// 2026-10-19 02:55:18.829491803 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GuidanceResponseStatus is documented here http://hl7.org/fhir/ValueSet/guidance-response-status
-type GuidanceResponseStatus int
+// The empty GuidanceResponseStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GuidanceResponseStatus string
 
 const (
-	GuidanceResponseStatusSuccess GuidanceResponseStatus = iota
-	GuidanceResponseStatusDataRequested
-	GuidanceResponseStatusDataRequired
-	GuidanceResponseStatusInProgress
-	GuidanceResponseStatusFailure
-	GuidanceResponseStatusEnteredInError
+	GuidanceResponseStatusSuccess        GuidanceResponseStatus = "success"
+	GuidanceResponseStatusDataRequested  GuidanceResponseStatus = "data-requested"
+	GuidanceResponseStatusDataRequired   GuidanceResponseStatus = "data-required"
+	GuidanceResponseStatusInProgress     GuidanceResponseStatus = "in-progress"
+	GuidanceResponseStatusFailure        GuidanceResponseStatus = "failure"
+	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
 func (code GuidanceResponseStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GuidanceResponseStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GuidanceResponseStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "success":
-		*code = GuidanceResponseStatusSuccess
-	case "data-requested":
-		*code = GuidanceResponseStatusDataRequested
-	case "data-required":
-		*code = GuidanceResponseStatusDataRequired
-	case "in-progress":
-		*code = GuidanceResponseStatusInProgress
-	case "failure":
-		*code = GuidanceResponseStatusFailure
-	case "entered-in-error":
-		*code = GuidanceResponseStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown GuidanceResponseStatus code `%s`", s)
+func (code *GuidanceResponseStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = GuidanceResponseStatus(s)
 	return nil
 }
 func (code GuidanceResponseStatus) String() string {
 	return code.Code()
 }
 func (code GuidanceResponseStatus) Code() string {
+	return string(code)
+}
+func (code GuidanceResponseStatus) Known() bool {
 	switch code {
-	case GuidanceResponseStatusSuccess:
-		return "success"
-	case GuidanceResponseStatusDataRequested:
-		return "data-requested"
-	case GuidanceResponseStatusDataRequired:
-		return "data-required"
-	case GuidanceResponseStatusInProgress:
-		return "in-progress"
-	case GuidanceResponseStatusFailure:
-		return "failure"
-	case GuidanceResponseStatusEnteredInError:
-		return "entered-in-error"
+	case GuidanceResponseStatusSuccess, GuidanceResponseStatusDataRequested, GuidanceResponseStatusDataRequired, GuidanceResponseStatusInProgress, GuidanceResponseStatusFailure, GuidanceResponseStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GuidanceResponseStatus) Display() string {
 	switch code {
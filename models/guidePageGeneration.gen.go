@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.220575 +0000 UTC
+// 2026-10-19 02:55:18.831709353 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GuidePageGeneration is documented here http://hl7.org/fhir/ValueSet/guide-page-generation
-type GuidePageGeneration int
+// The empty GuidePageGeneration is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GuidePageGeneration string
 
 const (
-	GuidePageGenerationHtml GuidePageGeneration = iota
-	GuidePageGenerationMarkdown
-	GuidePageGenerationXml
-	GuidePageGenerationGenerated
+	GuidePageGenerationHtml      GuidePageGeneration = "html"
+	GuidePageGenerationMarkdown  GuidePageGeneration = "markdown"
+	GuidePageGenerationXml       GuidePageGeneration = "xml"
+	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
 func (code GuidePageGeneration) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GuidePageGeneration"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GuidePageGeneration) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "html":
-		*code = GuidePageGenerationHtml
-	case "markdown":
-		*code = GuidePageGenerationMarkdown
-	case "xml":
-		*code = GuidePageGenerationXml
-	case "generated":
-		*code = GuidePageGenerationGenerated
-	default:
-		return fmt.Errorf("unknown GuidePageGeneration code `%s`", s)
+func (code *GuidePageGeneration) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = GuidePageGeneration(s)
 	return nil
 }
 func (code GuidePageGeneration) String() string {
 	return code.Code()
 }
 func (code GuidePageGeneration) Code() string {
+	return string(code)
+}
+func (code GuidePageGeneration) Known() bool {
 	switch code {
-	case GuidePageGenerationHtml:
-		return "html"
-	case GuidePageGenerationMarkdown:
-		return "markdown"
-	case GuidePageGenerationXml:
-		return "xml"
-	case GuidePageGenerationGenerated:
-		return "generated"
+	case GuidePageGenerationHtml, GuidePageGenerationMarkdown, GuidePageGenerationXml, GuidePageGenerationGenerated:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GuidePageGeneration) Display() string {
 	switch code {
@@ -14,90 +14,59 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.015326 +0000 UTC
+// 2026-10-19 02:55:18.832874911 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // GuideParameterCode is documented here http://hl7.org/fhir/ValueSet/guide-parameter-code
-type GuideParameterCode int
+// The empty GuideParameterCode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type GuideParameterCode string
 
 const (
-	GuideParameterCodeApply GuideParameterCode = iota
-	GuideParameterCodePathResource
-	GuideParameterCodePathPages
-	GuideParameterCodePathTxCache
-	GuideParameterCodeExpansionParameter
-	GuideParameterCodeRuleBrokenLinks
-	GuideParameterCodeGenerateXml
-	GuideParameterCodeGenerateJson
-	GuideParameterCodeGenerateTurtle
-	GuideParameterCodeHtmlTemplate
+	GuideParameterCodeApply              GuideParameterCode = "apply"
+	GuideParameterCodePathResource       GuideParameterCode = "path-resource"
+	GuideParameterCodePathPages          GuideParameterCode = "path-pages"
+	GuideParameterCodePathTxCache        GuideParameterCode = "path-tx-cache"
+	GuideParameterCodeExpansionParameter GuideParameterCode = "expansion-parameter"
+	GuideParameterCodeRuleBrokenLinks    GuideParameterCode = "rule-broken-links"
+	GuideParameterCodeGenerateXml        GuideParameterCode = "generate-xml"
+	GuideParameterCodeGenerateJson       GuideParameterCode = "generate-json"
+	GuideParameterCodeGenerateTurtle     GuideParameterCode = "generate-turtle"
+	GuideParameterCodeHtmlTemplate       GuideParameterCode = "html-template"
 )
 
 func (code GuideParameterCode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "GuideParameterCode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *GuideParameterCode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "apply":
-		*code = GuideParameterCodeApply
-	case "path-resource":
-		*code = GuideParameterCodePathResource
-	case "path-pages":
-		*code = GuideParameterCodePathPages
-	case "path-tx-cache":
-		*code = GuideParameterCodePathTxCache
-	case "expansion-parameter":
-		*code = GuideParameterCodeExpansionParameter
-	case "rule-broken-links":
-		*code = GuideParameterCodeRuleBrokenLinks
-	case "generate-xml":
-		*code = GuideParameterCodeGenerateXml
-	case "generate-json":
-		*code = GuideParameterCodeGenerateJson
-	case "generate-turtle":
-		*code = GuideParameterCodeGenerateTurtle
-	case "html-template":
-		*code = GuideParameterCodeHtmlTemplate
-	default:
-		return fmt.Errorf("unknown GuideParameterCode code `%s`", s)
+func (code *GuideParameterCode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = GuideParameterCode(s)
 	return nil
 }
 func (code GuideParameterCode) String() string {
 	return code.Code()
 }
 func (code GuideParameterCode) Code() string {
+	return string(code)
+}
+func (code GuideParameterCode) Known() bool {
 	switch code {
-	case GuideParameterCodeApply:
-		return "apply"
-	case GuideParameterCodePathResource:
-		return "path-resource"
-	case GuideParameterCodePathPages:
-		return "path-pages"
-	case GuideParameterCodePathTxCache:
-		return "path-tx-cache"
-	case GuideParameterCodeExpansionParameter:
-		return "expansion-parameter"
-	case GuideParameterCodeRuleBrokenLinks:
-		return "rule-broken-links"
-	case GuideParameterCodeGenerateXml:
-		return "generate-xml"
-	case GuideParameterCodeGenerateJson:
-		return "generate-json"
-	case GuideParameterCodeGenerateTurtle:
-		return "generate-turtle"
-	case GuideParameterCodeHtmlTemplate:
-		return "html-template"
+	case GuideParameterCodeApply, GuideParameterCodePathResource, GuideParameterCodePathPages, GuideParameterCodePathTxCache, GuideParameterCodeExpansionParameter, GuideParameterCodeRuleBrokenLinks, GuideParameterCodeGenerateXml, GuideParameterCodeGenerateJson, GuideParameterCodeGenerateTurtle, GuideParameterCodeHtmlTemplate:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code GuideParameterCode) Display() string {
 	switch code {
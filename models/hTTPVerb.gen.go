@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.176336 +0000 UTC
+// 2026-10-19 02:55:18.834614193 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // HTTPVerb is documented here http://hl7.org/fhir/ValueSet/http-verb
-type HTTPVerb int
+// The empty HTTPVerb is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type HTTPVerb string
 
 const (
-	HTTPVerbGET HTTPVerb = iota
-	HTTPVerbHEAD
-	HTTPVerbPOST
-	HTTPVerbPUT
-	HTTPVerbDELETE
-	HTTPVerbPATCH
+	HTTPVerbGET    HTTPVerb = "GET"
+	HTTPVerbHEAD   HTTPVerb = "HEAD"
+	HTTPVerbPOST   HTTPVerb = "POST"
+	HTTPVerbPUT    HTTPVerb = "PUT"
+	HTTPVerbDELETE HTTPVerb = "DELETE"
+	HTTPVerbPATCH  HTTPVerb = "PATCH"
 )
 
 func (code HTTPVerb) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "HTTPVerb"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *HTTPVerb) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "GET":
-		*code = HTTPVerbGET
-	case "HEAD":
-		*code = HTTPVerbHEAD
-	case "POST":
-		*code = HTTPVerbPOST
-	case "PUT":
-		*code = HTTPVerbPUT
-	case "DELETE":
-		*code = HTTPVerbDELETE
-	case "PATCH":
-		*code = HTTPVerbPATCH
-	default:
-		return fmt.Errorf("unknown HTTPVerb code `%s`", s)
+func (code *HTTPVerb) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = HTTPVerb(s)
 	return nil
 }
 func (code HTTPVerb) String() string {
 	return code.Code()
 }
 func (code HTTPVerb) Code() string {
+	return string(code)
+}
+func (code HTTPVerb) Known() bool {
 	switch code {
-	case HTTPVerbGET:
-		return "GET"
-	case HTTPVerbHEAD:
-		return "HEAD"
-	case HTTPVerbPOST:
-		return "POST"
-	case HTTPVerbPUT:
-		return "PUT"
-	case HTTPVerbDELETE:
-		return "DELETE"
-	case HTTPVerbPATCH:
-		return "PATCH"
+	case HTTPVerbGET, HTTPVerbHEAD, HTTPVerbPOST, HTTPVerbPUT, HTTPVerbDELETE, HTTPVerbPATCH:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code HTTPVerb) Display() string {
 	switch code {
@@ -22,72 +22,149 @@ import "encoding/json"
 
 // HealthcareService is documented here http://hl7.org/fhir/StructureDefinition/HealthcareService
 type HealthcareService struct {
-	ID                     *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                          `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active                 *bool                            `bson:"active,omitempty" json:"active,omitempty"`
-	ProvidedBy             *Reference                       `bson:"providedBy,omitempty" json:"providedBy,omitempty"`
-	Category               []CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
-	Type                   []CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
-	Specialty              []CodeableConcept                `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Location               []Reference                      `bson:"location,omitempty" json:"location,omitempty"`
-	Name                   *string                          `bson:"name,omitempty" json:"name,omitempty"`
-	Comment                *string                          `bson:"comment,omitempty" json:"comment,omitempty"`
-	ExtraDetails           *string                          `bson:"extraDetails,omitempty" json:"extraDetails,omitempty"`
-	Photo                  *Attachment                      `bson:"photo,omitempty" json:"photo,omitempty"`
-	Telecom                []ContactPoint                   `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	CoverageArea           []Reference                      `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
-	ServiceProvisionCode   []CodeableConcept                `bson:"serviceProvisionCode,omitempty" json:"serviceProvisionCode,omitempty"`
-	Eligibility            []HealthcareServiceEligibility   `bson:"eligibility,omitempty" json:"eligibility,omitempty"`
-	Program                []CodeableConcept                `bson:"program,omitempty" json:"program,omitempty"`
-	Characteristic         []CodeableConcept                `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
-	Communication          []CodeableConcept                `bson:"communication,omitempty" json:"communication,omitempty"`
-	ReferralMethod         []CodeableConcept                `bson:"referralMethod,omitempty" json:"referralMethod,omitempty"`
-	AppointmentRequired    *bool                            `bson:"appointmentRequired,omitempty" json:"appointmentRequired,omitempty"`
-	AvailableTime          []HealthcareServiceAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
-	NotAvailable           []HealthcareServiceNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
-	AvailabilityExceptions *string                          `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
-	Endpoint               []Reference                      `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ID                            *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                          *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     ContainedResources               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                        *bool                            `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement                 *Element                         `bson:"_active,omitempty" json:"_active,omitempty"`
+	ProvidedBy                    *Reference                       `bson:"providedBy,omitempty" json:"providedBy,omitempty"`
+	Category                      []CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
+	Type                          []CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
+	Specialty                     []CodeableConcept                `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Location                      []Reference                      `bson:"location,omitempty" json:"location,omitempty"`
+	Name                          *string                          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                   *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Comment                       *string                          `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement                *Element                         `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	ExtraDetails                  *string                          `bson:"extraDetails,omitempty" json:"extraDetails,omitempty"`
+	ExtraDetailsElement           *Element                         `bson:"_extraDetails,omitempty" json:"_extraDetails,omitempty"`
+	Photo                         *Attachment                      `bson:"photo,omitempty" json:"photo,omitempty"`
+	Telecom                       []ContactPoint                   `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	CoverageArea                  []Reference                      `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
+	ServiceProvisionCode          []CodeableConcept                `bson:"serviceProvisionCode,omitempty" json:"serviceProvisionCode,omitempty"`
+	Eligibility                   []HealthcareServiceEligibility   `bson:"eligibility,omitempty" json:"eligibility,omitempty"`
+	Program                       []CodeableConcept                `bson:"program,omitempty" json:"program,omitempty"`
+	Characteristic                []CodeableConcept                `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
+	Communication                 []CodeableConcept                `bson:"communication,omitempty" json:"communication,omitempty"`
+	ReferralMethod                []CodeableConcept                `bson:"referralMethod,omitempty" json:"referralMethod,omitempty"`
+	AppointmentRequired           *bool                            `bson:"appointmentRequired,omitempty" json:"appointmentRequired,omitempty"`
+	AppointmentRequiredElement    *Element                         `bson:"_appointmentRequired,omitempty" json:"_appointmentRequired,omitempty"`
+	AvailableTime                 []HealthcareServiceAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
+	NotAvailable                  []HealthcareServiceNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
+	AvailabilityExceptions        *string                          `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *Element                         `bson:"_availabilityExceptions,omitempty" json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []Reference                      `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	UnknownFields                 map[string]json.RawMessage       `bson:"-" json:"-"`
 }
 type HealthcareServiceEligibility struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Comment           *string          `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Comment           *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the HealthcareServiceEligibility with its unknown fields.
+func (r HealthcareServiceEligibility) MarshalJSON() ([]byte, error) {
+	type other HealthcareServiceEligibility
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type HealthcareServiceAvailableTime struct {
-	ID                 *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DaysOfWeek         []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
-	AllDay             *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
-	AvailableStartTime *Time        `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
-	AvailableEndTime   *Time        `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DaysOfWeek                []*DaysOfWeek              `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement         []*Element                 `bson:"_daysOfWeek,omitempty" json:"_daysOfWeek,omitempty"`
+	AllDay                    *bool                      `bson:"allDay,omitempty" json:"allDay,omitempty"`
+	AllDayElement             *Element                   `bson:"_allDay,omitempty" json:"_allDay,omitempty"`
+	AvailableStartTime        *Time                      `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *Element                   `bson:"_availableStartTime,omitempty" json:"_availableStartTime,omitempty"`
+	AvailableEndTime          *Time                      `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *Element                   `bson:"_availableEndTime,omitempty" json:"_availableEndTime,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the HealthcareServiceAvailableTime and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *HealthcareServiceAvailableTime) UnmarshalJSON(b []byte) error {
+	type other HealthcareServiceAvailableTime
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("HealthcareService.availableTime.daysOfWeek", len(r.DaysOfWeek), len(r.DaysOfWeekElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the HealthcareServiceAvailableTime with its unknown fields.
+func (r HealthcareServiceAvailableTime) MarshalJSON() ([]byte, error) {
+	type other HealthcareServiceAvailableTime
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type HealthcareServiceNotAvailable struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	During            *Period     `bson:"during,omitempty" json:"during,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string                     `bson:"description" json:"description"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	During             *Period                    `bson:"during,omitempty" json:"during,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the HealthcareServiceNotAvailable with its unknown fields.
+func (r HealthcareServiceNotAvailable) MarshalJSON() ([]byte, error) {
+	type other HealthcareServiceNotAvailable
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the HealthcareService and fails with CodeError when a coded element has an unknown code.
+func (r *HealthcareService) UnmarshalJSON(b []byte) error {
+	type other HealthcareService
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("HealthcareService", r)
+}
+
 type OtherHealthcareService HealthcareService
 
 // MarshalJSON marshals the given HealthcareService as JSON into a byte slice
 func (r HealthcareService) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherHealthcareService
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherHealthcareService: OtherHealthcareService(r),
 		ResourceType:           "HealthcareService",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalHealthcareService unmarshals a HealthcareService.
@@ -18,15 +18,41 @@
 
 package models
 
+import "encoding/json"
+
 // HumanName is documented here http://hl7.org/fhir/StructureDefinition/HumanName
 type HumanName struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Use       *NameUse    `bson:"use,omitempty" json:"use,omitempty"`
-	Text      *string     `bson:"text,omitempty" json:"text,omitempty"`
-	Family    *string     `bson:"family,omitempty" json:"family,omitempty"`
-	Given     []string    `bson:"given,omitempty" json:"given,omitempty"`
-	Prefix    []string    `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Suffix    []string    `bson:"suffix,omitempty" json:"suffix,omitempty"`
-	Period    *Period     `bson:"period,omitempty" json:"period,omitempty"`
+	ID            *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension     []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Use           *NameUse    `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement    *Element    `bson:"_use,omitempty" json:"_use,omitempty"`
+	Text          *string     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement   *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
+	Family        *string     `bson:"family,omitempty" json:"family,omitempty"`
+	FamilyElement *Element    `bson:"_family,omitempty" json:"_family,omitempty"`
+	Given         []*string   `bson:"given,omitempty" json:"given,omitempty"`
+	GivenElement  []*Element  `bson:"_given,omitempty" json:"_given,omitempty"`
+	Prefix        []*string   `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement []*Element  `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Suffix        []*string   `bson:"suffix,omitempty" json:"suffix,omitempty"`
+	SuffixElement []*Element  `bson:"_suffix,omitempty" json:"_suffix,omitempty"`
+	Period        *Period     `bson:"period,omitempty" json:"period,omitempty"`
+}
+
+// UnmarshalJSON unmarshals the HumanName and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *HumanName) UnmarshalJSON(b []byte) error {
+	type other HumanName
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("HumanName.given", len(r.Given), len(r.GivenElement)); err != nil {
+		return err
+	}
+	if err := checkElements("HumanName.prefix", len(r.Prefix), len(r.PrefixElement)); err != nil {
+		return err
+	}
+	if err := checkElements("HumanName.suffix", len(r.Suffix), len(r.SuffixElement)); err != nil {
+		return err
+	}
+	return nil
 }
@@ -20,12 +20,15 @@ package models
 
 // Identifier is documented here http://hl7.org/fhir/StructureDefinition/Identifier
 type Identifier struct {
-	ID        *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	Use       *IdentifierUse   `bson:"use,omitempty" json:"use,omitempty"`
-	Type      *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	System    *string          `bson:"system,omitempty" json:"system,omitempty"`
-	Value     *string          `bson:"value,omitempty" json:"value,omitempty"`
-	Period    *Period          `bson:"period,omitempty" json:"period,omitempty"`
-	Assigner  *Reference       `bson:"assigner,omitempty" json:"assigner,omitempty"`
+	ID            *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension     []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	Use           *IdentifierUse   `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement    *Element         `bson:"_use,omitempty" json:"_use,omitempty"`
+	Type          *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	System        *string          `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement *Element         `bson:"_system,omitempty" json:"_system,omitempty"`
+	Value         *string          `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement  *Element         `bson:"_value,omitempty" json:"_value,omitempty"`
+	Period        *Period          `bson:"period,omitempty" json:"period,omitempty"`
+	Assigner      *Reference       `bson:"assigner,omitempty" json:"assigner,omitempty"`
 }
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.251769 +0000 UTC
+// 2026-10-19 02:55:18.835862874 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // IdentifierUse is documented here http://hl7.org/fhir/ValueSet/identifier-use
-type IdentifierUse int
+// The empty IdentifierUse is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type IdentifierUse string
 
 const (
-	IdentifierUseUsual IdentifierUse = iota
-	IdentifierUseOfficial
-	IdentifierUseTemp
-	IdentifierUseSecondary
-	IdentifierUseOld
+	IdentifierUseUsual     IdentifierUse = "usual"
+	IdentifierUseOfficial  IdentifierUse = "official"
+	IdentifierUseTemp      IdentifierUse = "temp"
+	IdentifierUseSecondary IdentifierUse = "secondary"
+	IdentifierUseOld       IdentifierUse = "old"
 )
 
 func (code IdentifierUse) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "IdentifierUse"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *IdentifierUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "usual":
-		*code = IdentifierUseUsual
-	case "official":
-		*code = IdentifierUseOfficial
-	case "temp":
-		*code = IdentifierUseTemp
-	case "secondary":
-		*code = IdentifierUseSecondary
-	case "old":
-		*code = IdentifierUseOld
-	default:
-		return fmt.Errorf("unknown IdentifierUse code `%s`", s)
+func (code *IdentifierUse) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = IdentifierUse(s)
 	return nil
 }
 func (code IdentifierUse) String() string {
 	return code.Code()
 }
 func (code IdentifierUse) Code() string {
+	return string(code)
+}
+func (code IdentifierUse) Known() bool {
 	switch code {
-	case IdentifierUseUsual:
-		return "usual"
-	case IdentifierUseOfficial:
-		return "official"
-	case IdentifierUseTemp:
-		return "temp"
-	case IdentifierUseSecondary:
-		return "secondary"
-	case IdentifierUseOld:
-		return "old"
+	case IdentifierUseUsual, IdentifierUseOfficial, IdentifierUseTemp, IdentifierUseSecondary, IdentifierUseOld:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code IdentifierUse) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.130911 +0000 UTC
+// 2026-10-19 02:55:18.837120195 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // IdentityAssuranceLevel is documented here http://hl7.org/fhir/ValueSet/identity-assuranceLevel
-type IdentityAssuranceLevel int
+// The empty IdentityAssuranceLevel is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type IdentityAssuranceLevel string
 
 const (
-	IdentityAssuranceLevelLevel1 IdentityAssuranceLevel = iota
-	IdentityAssuranceLevelLevel2
-	IdentityAssuranceLevelLevel3
-	IdentityAssuranceLevelLevel4
+	IdentityAssuranceLevelLevel1 IdentityAssuranceLevel = "level1"
+	IdentityAssuranceLevelLevel2 IdentityAssuranceLevel = "level2"
+	IdentityAssuranceLevelLevel3 IdentityAssuranceLevel = "level3"
+	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
 func (code IdentityAssuranceLevel) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "IdentityAssuranceLevel"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *IdentityAssuranceLevel) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "level1":
-		*code = IdentityAssuranceLevelLevel1
-	case "level2":
-		*code = IdentityAssuranceLevelLevel2
-	case "level3":
-		*code = IdentityAssuranceLevelLevel3
-	case "level4":
-		*code = IdentityAssuranceLevelLevel4
-	default:
-		return fmt.Errorf("unknown IdentityAssuranceLevel code `%s`", s)
+func (code *IdentityAssuranceLevel) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = IdentityAssuranceLevel(s)
 	return nil
 }
 func (code IdentityAssuranceLevel) String() string {
 	return code.Code()
 }
 func (code IdentityAssuranceLevel) Code() string {
+	return string(code)
+}
+func (code IdentityAssuranceLevel) Known() bool {
 	switch code {
-	case IdentityAssuranceLevelLevel1:
-		return "level1"
-	case IdentityAssuranceLevelLevel2:
-		return "level2"
-	case IdentityAssuranceLevelLevel3:
-		return "level3"
-	case IdentityAssuranceLevelLevel4:
-		return "level4"
+	case IdentityAssuranceLevelLevel1, IdentityAssuranceLevelLevel2, IdentityAssuranceLevelLevel3, IdentityAssuranceLevelLevel4:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code IdentityAssuranceLevel) Display() string {
 	switch code {
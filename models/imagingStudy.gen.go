@@ -22,78 +22,143 @@ import "encoding/json"
 
 // ImagingStudy is documented here http://hl7.org/fhir/StructureDefinition/ImagingStudy
 type ImagingStudy struct {
-	ID                 *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status             ImagingStudyStatus   `bson:"status" json:"status"`
-	Modality           []Coding             `bson:"modality,omitempty" json:"modality,omitempty"`
-	Subject            Reference            `bson:"subject" json:"subject"`
-	Encounter          *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Started            *DateTime            `bson:"started,omitempty" json:"started,omitempty"`
-	BasedOn            []Reference          `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Referrer           *Reference           `bson:"referrer,omitempty" json:"referrer,omitempty"`
-	Interpreter        []Reference          `bson:"interpreter,omitempty" json:"interpreter,omitempty"`
-	Endpoint           []Reference          `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	NumberOfSeries     *int                 `bson:"numberOfSeries,omitempty" json:"numberOfSeries,omitempty"`
-	NumberOfInstances  *int                 `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
-	ProcedureReference *Reference           `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
-	ProcedureCode      []CodeableConcept    `bson:"procedureCode,omitempty" json:"procedureCode,omitempty"`
-	Location           *Reference           `bson:"location,omitempty" json:"location,omitempty"`
-	ReasonCode         []CodeableConcept    `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference          `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note               []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
-	Description        *string              `bson:"description,omitempty" json:"description,omitempty"`
-	Series             []ImagingStudySeries `bson:"series,omitempty" json:"series,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                   ImagingStudyStatus         `bson:"status" json:"status"`
+	StatusElement            *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Modality                 []Coding                   `bson:"modality,omitempty" json:"modality,omitempty"`
+	Subject                  Reference                  `bson:"subject" json:"subject"`
+	Encounter                *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Started                  *DateTime                  `bson:"started,omitempty" json:"started,omitempty"`
+	StartedElement           *Element                   `bson:"_started,omitempty" json:"_started,omitempty"`
+	BasedOn                  []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Referrer                 *Reference                 `bson:"referrer,omitempty" json:"referrer,omitempty"`
+	Interpreter              []Reference                `bson:"interpreter,omitempty" json:"interpreter,omitempty"`
+	Endpoint                 []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	NumberOfSeries           *int                       `bson:"numberOfSeries,omitempty" json:"numberOfSeries,omitempty"`
+	NumberOfSeriesElement    *Element                   `bson:"_numberOfSeries,omitempty" json:"_numberOfSeries,omitempty"`
+	NumberOfInstances        *int                       `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *Element                   `bson:"_numberOfInstances,omitempty" json:"_numberOfInstances,omitempty"`
+	ProcedureReference       *Reference                 `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
+	ProcedureCode            []CodeableConcept          `bson:"procedureCode,omitempty" json:"procedureCode,omitempty"`
+	Location                 *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	ReasonCode               []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference          []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                     []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Description              *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement       *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Series                   []ImagingStudySeries       `bson:"series,omitempty" json:"series,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ImagingStudySeries struct {
-	ID                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Uid               string                        `bson:"uid" json:"uid"`
-	Number            *int                          `bson:"number,omitempty" json:"number,omitempty"`
-	Modality          Coding                        `bson:"modality" json:"modality"`
-	Description       *string                       `bson:"description,omitempty" json:"description,omitempty"`
-	NumberOfInstances *int                          `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
-	Endpoint          []Reference                   `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	BodySite          *Coding                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Laterality        *Coding                       `bson:"laterality,omitempty" json:"laterality,omitempty"`
-	Specimen          []Reference                   `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Started           *DateTime                     `bson:"started,omitempty" json:"started,omitempty"`
-	Performer         []ImagingStudySeriesPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
-	Instance          []ImagingStudySeriesInstance  `bson:"instance,omitempty" json:"instance,omitempty"`
+	ID                       *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Uid                      string                        `bson:"uid" json:"uid"`
+	UidElement               *Element                      `bson:"_uid,omitempty" json:"_uid,omitempty"`
+	Number                   *int                          `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement            *Element                      `bson:"_number,omitempty" json:"_number,omitempty"`
+	Modality                 Coding                        `bson:"modality" json:"modality"`
+	Description              *string                       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement       *Element                      `bson:"_description,omitempty" json:"_description,omitempty"`
+	NumberOfInstances        *int                          `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *Element                      `bson:"_numberOfInstances,omitempty" json:"_numberOfInstances,omitempty"`
+	Endpoint                 []Reference                   `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	BodySite                 *Coding                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Laterality               *Coding                       `bson:"laterality,omitempty" json:"laterality,omitempty"`
+	Specimen                 []Reference                   `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Started                  *DateTime                     `bson:"started,omitempty" json:"started,omitempty"`
+	StartedElement           *Element                      `bson:"_started,omitempty" json:"_started,omitempty"`
+	Performer                []ImagingStudySeriesPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
+	Instance                 []ImagingStudySeriesInstance  `bson:"instance,omitempty" json:"instance,omitempty"`
+	UnknownFields            map[string]json.RawMessage    `bson:"-" json:"-"`
 }
 type ImagingStudySeriesPerformer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Function          *CodeableConcept           `bson:"function,omitempty" json:"function,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImagingStudySeriesPerformer with its unknown fields.
+func (r ImagingStudySeriesPerformer) MarshalJSON() ([]byte, error) {
+	type other ImagingStudySeriesPerformer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImagingStudySeriesInstance struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Uid               string      `bson:"uid" json:"uid"`
-	SopClass          Coding      `bson:"sopClass" json:"sopClass"`
-	Number            *int        `bson:"number,omitempty" json:"number,omitempty"`
-	Title             *string     `bson:"title,omitempty" json:"title,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Uid               string                     `bson:"uid" json:"uid"`
+	UidElement        *Element                   `bson:"_uid,omitempty" json:"_uid,omitempty"`
+	SopClass          Coding                     `bson:"sopClass" json:"sopClass"`
+	Number            *int                       `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     *Element                   `bson:"_number,omitempty" json:"_number,omitempty"`
+	Title             *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement      *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImagingStudySeriesInstance with its unknown fields.
+func (r ImagingStudySeriesInstance) MarshalJSON() ([]byte, error) {
+	type other ImagingStudySeriesInstance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ImagingStudySeries with its unknown fields.
+func (r ImagingStudySeries) MarshalJSON() ([]byte, error) {
+	type other ImagingStudySeries
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ImagingStudy and fails with CodeError when a coded element has an unknown code.
+func (r *ImagingStudy) UnmarshalJSON(b []byte) error {
+	type other ImagingStudy
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("ImagingStudy", r)
+}
+
 type OtherImagingStudy ImagingStudy
 
 // MarshalJSON marshals the given ImagingStudy as JSON into a byte slice
 func (r ImagingStudy) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherImagingStudy
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherImagingStudy: OtherImagingStudy(r),
 		ResourceType:      "ImagingStudy",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalImagingStudy unmarshals a ImagingStudy.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.087067 +0000 UTC
+// 2026-10-19 02:55:18.838140823 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ImagingStudyStatus is documented here http://hl7.org/fhir/ValueSet/imagingstudy-status
-type ImagingStudyStatus int
+// The empty ImagingStudyStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ImagingStudyStatus string
 
 const (
-	ImagingStudyStatusRegistered ImagingStudyStatus = iota
-	ImagingStudyStatusAvailable
-	ImagingStudyStatusCancelled
-	ImagingStudyStatusEnteredInError
-	ImagingStudyStatusUnknown
+	ImagingStudyStatusRegistered     ImagingStudyStatus = "registered"
+	ImagingStudyStatusAvailable      ImagingStudyStatus = "available"
+	ImagingStudyStatusCancelled      ImagingStudyStatus = "cancelled"
+	ImagingStudyStatusEnteredInError ImagingStudyStatus = "entered-in-error"
+	ImagingStudyStatusUnknown        ImagingStudyStatus = "unknown"
 )
 
 func (code ImagingStudyStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ImagingStudyStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ImagingStudyStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "registered":
-		*code = ImagingStudyStatusRegistered
-	case "available":
-		*code = ImagingStudyStatusAvailable
-	case "cancelled":
-		*code = ImagingStudyStatusCancelled
-	case "entered-in-error":
-		*code = ImagingStudyStatusEnteredInError
-	case "unknown":
-		*code = ImagingStudyStatusUnknown
-	default:
-		return fmt.Errorf("unknown ImagingStudyStatus code `%s`", s)
+func (code *ImagingStudyStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ImagingStudyStatus(s)
 	return nil
 }
 func (code ImagingStudyStatus) String() string {
 	return code.Code()
 }
 func (code ImagingStudyStatus) Code() string {
+	return string(code)
+}
+func (code ImagingStudyStatus) Known() bool {
 	switch code {
-	case ImagingStudyStatusRegistered:
-		return "registered"
-	case ImagingStudyStatusAvailable:
-		return "available"
-	case ImagingStudyStatusCancelled:
-		return "cancelled"
-	case ImagingStudyStatusEnteredInError:
-		return "entered-in-error"
-	case ImagingStudyStatusUnknown:
-		return "unknown"
+	case ImagingStudyStatusRegistered, ImagingStudyStatusAvailable, ImagingStudyStatusCancelled, ImagingStudyStatusEnteredInError, ImagingStudyStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ImagingStudyStatus) Display() string {
 	switch code {
@@ -22,84 +22,291 @@ import "encoding/json"
 
 // Immunization is documented here http://hl7.org/fhir/StructureDefinition/Immunization
 type Immunization struct {
-	ID                 *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status             ImmunizationStatusCodes       `bson:"status" json:"status"`
-	StatusReason       *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	VaccineCode        CodeableConcept               `bson:"vaccineCode" json:"vaccineCode"`
-	Patient            Reference                     `bson:"patient" json:"patient"`
-	Encounter          *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Recorded           *DateTime                     `bson:"recorded,omitempty" json:"recorded,omitempty"`
-	PrimarySource      *bool                         `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
-	ReportOrigin       *CodeableConcept              `bson:"reportOrigin,omitempty" json:"reportOrigin,omitempty"`
-	Location           *Reference                    `bson:"location,omitempty" json:"location,omitempty"`
-	Manufacturer       *Reference                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	LotNumber          *string                       `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	ExpirationDate     *DateTime                     `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
-	Site               *CodeableConcept              `bson:"site,omitempty" json:"site,omitempty"`
-	Route              *CodeableConcept              `bson:"route,omitempty" json:"route,omitempty"`
-	DoseQuantity       *Quantity                     `bson:"doseQuantity,omitempty" json:"doseQuantity,omitempty"`
-	Performer          []ImmunizationPerformer       `bson:"performer,omitempty" json:"performer,omitempty"`
-	Note               []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
-	ReasonCode         []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	IsSubpotent        *bool                         `bson:"isSubpotent,omitempty" json:"isSubpotent,omitempty"`
-	SubpotentReason    []CodeableConcept             `bson:"subpotentReason,omitempty" json:"subpotentReason,omitempty"`
-	Education          []ImmunizationEducation       `bson:"education,omitempty" json:"education,omitempty"`
-	ProgramEligibility []CodeableConcept             `bson:"programEligibility,omitempty" json:"programEligibility,omitempty"`
-	FundingSource      *CodeableConcept              `bson:"fundingSource,omitempty" json:"fundingSource,omitempty"`
-	Reaction           []ImmunizationReaction        `bson:"reaction,omitempty" json:"reaction,omitempty"`
-	ProtocolApplied    []ImmunizationProtocolApplied `bson:"protocolApplied,omitempty" json:"protocolApplied,omitempty"`
+	ID                        *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    ImmunizationStatusCodes       `bson:"status" json:"status"`
+	StatusElement             *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	VaccineCode               CodeableConcept               `bson:"vaccineCode" json:"vaccineCode"`
+	Patient                   Reference                     `bson:"patient" json:"patient"`
+	Encounter                 *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime        *DateTime                     `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                      `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrenceString          *string                       `bson:"occurrenceString,omitempty" json:"occurrenceString,omitempty"`
+	OccurrenceStringElement   *Element                      `bson:"_occurrenceString,omitempty" json:"_occurrenceString,omitempty"`
+	Recorded                  *DateTime                     `bson:"recorded,omitempty" json:"recorded,omitempty"`
+	RecordedElement           *Element                      `bson:"_recorded,omitempty" json:"_recorded,omitempty"`
+	PrimarySource             *bool                         `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
+	PrimarySourceElement      *Element                      `bson:"_primarySource,omitempty" json:"_primarySource,omitempty"`
+	ReportOrigin              *CodeableConcept              `bson:"reportOrigin,omitempty" json:"reportOrigin,omitempty"`
+	Location                  *Reference                    `bson:"location,omitempty" json:"location,omitempty"`
+	Manufacturer              *Reference                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	LotNumber                 *string                       `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	LotNumberElement          *Element                      `bson:"_lotNumber,omitempty" json:"_lotNumber,omitempty"`
+	ExpirationDate            *DateTime                     `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ExpirationDateElement     *Element                      `bson:"_expirationDate,omitempty" json:"_expirationDate,omitempty"`
+	Site                      *CodeableConcept              `bson:"site,omitempty" json:"site,omitempty"`
+	Route                     *CodeableConcept              `bson:"route,omitempty" json:"route,omitempty"`
+	DoseQuantity              *Quantity                     `bson:"doseQuantity,omitempty" json:"doseQuantity,omitempty"`
+	Performer                 []ImmunizationPerformer       `bson:"performer,omitempty" json:"performer,omitempty"`
+	Note                      []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	ReasonCode                []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	IsSubpotent               *bool                         `bson:"isSubpotent,omitempty" json:"isSubpotent,omitempty"`
+	IsSubpotentElement        *Element                      `bson:"_isSubpotent,omitempty" json:"_isSubpotent,omitempty"`
+	SubpotentReason           []CodeableConcept             `bson:"subpotentReason,omitempty" json:"subpotentReason,omitempty"`
+	Education                 []ImmunizationEducation       `bson:"education,omitempty" json:"education,omitempty"`
+	ProgramEligibility        []CodeableConcept             `bson:"programEligibility,omitempty" json:"programEligibility,omitempty"`
+	FundingSource             *CodeableConcept              `bson:"fundingSource,omitempty" json:"fundingSource,omitempty"`
+	Reaction                  []ImmunizationReaction        `bson:"reaction,omitempty" json:"reaction,omitempty"`
+	ProtocolApplied           []ImmunizationProtocolApplied `bson:"protocolApplied,omitempty" json:"protocolApplied,omitempty"`
+	UnknownFields             map[string]json.RawMessage    `bson:"-" json:"-"`
 }
 type ImmunizationPerformer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Function          *CodeableConcept           `bson:"function,omitempty" json:"function,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImmunizationPerformer with its unknown fields.
+func (r ImmunizationPerformer) MarshalJSON() ([]byte, error) {
+	type other ImmunizationPerformer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImmunizationEducation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DocumentType      *string     `bson:"documentType,omitempty" json:"documentType,omitempty"`
-	Reference         *string     `bson:"reference,omitempty" json:"reference,omitempty"`
-	PublicationDate   *DateTime   `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
-	PresentationDate  *DateTime   `bson:"presentationDate,omitempty" json:"presentationDate,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DocumentType            *string                    `bson:"documentType,omitempty" json:"documentType,omitempty"`
+	DocumentTypeElement     *Element                   `bson:"_documentType,omitempty" json:"_documentType,omitempty"`
+	Reference               *string                    `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement        *Element                   `bson:"_reference,omitempty" json:"_reference,omitempty"`
+	PublicationDate         *DateTime                  `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
+	PublicationDateElement  *Element                   `bson:"_publicationDate,omitempty" json:"_publicationDate,omitempty"`
+	PresentationDate        *DateTime                  `bson:"presentationDate,omitempty" json:"presentationDate,omitempty"`
+	PresentationDateElement *Element                   `bson:"_presentationDate,omitempty" json:"_presentationDate,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ImmunizationEducation with its unknown fields.
+func (r ImmunizationEducation) MarshalJSON() ([]byte, error) {
+	type other ImmunizationEducation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ImmunizationReaction struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Date              *DateTime   `bson:"date,omitempty" json:"date,omitempty"`
-	Detail            *Reference  `bson:"detail,omitempty" json:"detail,omitempty"`
-	Reported          *bool       `bson:"reported,omitempty" json:"reported,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Detail            *Reference                 `bson:"detail,omitempty" json:"detail,omitempty"`
+	Reported          *bool                      `bson:"reported,omitempty" json:"reported,omitempty"`
+	ReportedElement   *Element                   `bson:"_reported,omitempty" json:"_reported,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ImmunizationReaction with its unknown fields.
+func (r ImmunizationReaction) MarshalJSON() ([]byte, error) {
+	type other ImmunizationReaction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ImmunizationProtocolApplied struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Series            *string           `bson:"series,omitempty" json:"series,omitempty"`
-	Authority         *Reference        `bson:"authority,omitempty" json:"authority,omitempty"`
-	TargetDisease     []CodeableConcept `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
+	ID                            *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Series                        *string                    `bson:"series,omitempty" json:"series,omitempty"`
+	SeriesElement                 *Element                   `bson:"_series,omitempty" json:"_series,omitempty"`
+	Authority                     *Reference                 `bson:"authority,omitempty" json:"authority,omitempty"`
+	TargetDisease                 []CodeableConcept          `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
+	DoseNumberPositiveInt         *int                       `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement  *Element                   `bson:"_doseNumberPositiveInt,omitempty" json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString              *string                    `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
+	DoseNumberStringElement       *Element                   `bson:"_doseNumberString,omitempty" json:"_doseNumberString,omitempty"`
+	SeriesDosesPositiveInt        *int                       `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *Element                   `bson:"_seriesDosesPositiveInt,omitempty" json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string                    `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *Element                   `bson:"_seriesDosesString,omitempty" json:"_seriesDosesString,omitempty"`
+	UnknownFields                 map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// DoseNumber returns Immunization.protocolApplied.doseNumber[x] of the set type, such as *int, or nil when it is not set.
+func (r *ImmunizationProtocolApplied) DoseNumber() interface{} {
+	switch {
+	case r.DoseNumberPositiveInt != nil:
+		return r.DoseNumberPositiveInt
+	case r.DoseNumberString != nil:
+		return r.DoseNumberString
+	}
+	return nil
+}
+
+// SetDoseNumberPositiveInt sets Immunization.protocolApplied.doseNumber[x] to the positiveInt and clears the other types.
+func (r *ImmunizationProtocolApplied) SetDoseNumberPositiveInt(v int) {
+	r.clearDoseNumber()
+	r.DoseNumberPositiveInt = &v
+}
+
+// SetDoseNumberString sets Immunization.protocolApplied.doseNumber[x] to the string and clears the other types.
+func (r *ImmunizationProtocolApplied) SetDoseNumberString(v string) {
+	r.clearDoseNumber()
+	r.DoseNumberString = &v
+}
+func (r *ImmunizationProtocolApplied) clearDoseNumber() {
+	r.DoseNumberPositiveInt = nil
+	r.DoseNumberPositiveIntElement = nil
+	r.DoseNumberString = nil
+	r.DoseNumberStringElement = nil
+}
+
+// SeriesDoses returns Immunization.protocolApplied.seriesDoses[x] of the set type, such as *int, or nil when it is not set.
+func (r *ImmunizationProtocolApplied) SeriesDoses() interface{} {
+	switch {
+	case r.SeriesDosesPositiveInt != nil:
+		return r.SeriesDosesPositiveInt
+	case r.SeriesDosesString != nil:
+		return r.SeriesDosesString
+	}
+	return nil
+}
+
+// SetSeriesDosesPositiveInt sets Immunization.protocolApplied.seriesDoses[x] to the positiveInt and clears the other types.
+func (r *ImmunizationProtocolApplied) SetSeriesDosesPositiveInt(v int) {
+	r.clearSeriesDoses()
+	r.SeriesDosesPositiveInt = &v
 }
+
+// SetSeriesDosesString sets Immunization.protocolApplied.seriesDoses[x] to the string and clears the other types.
+func (r *ImmunizationProtocolApplied) SetSeriesDosesString(v string) {
+	r.clearSeriesDoses()
+	r.SeriesDosesString = &v
+}
+func (r *ImmunizationProtocolApplied) clearSeriesDoses() {
+	r.SeriesDosesPositiveInt = nil
+	r.SeriesDosesPositiveIntElement = nil
+	r.SeriesDosesString = nil
+	r.SeriesDosesStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the ImmunizationProtocolApplied and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ImmunizationProtocolApplied) UnmarshalJSON(b []byte) error {
+	type other ImmunizationProtocolApplied
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Immunization.protocolApplied.doseNumber[x]",
+		r.DoseNumberPositiveInt != nil || r.DoseNumberPositiveIntElement != nil,
+		r.DoseNumberString != nil || r.DoseNumberStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Immunization.protocolApplied.seriesDoses[x]",
+		r.SeriesDosesPositiveInt != nil || r.SeriesDosesPositiveIntElement != nil,
+		r.SeriesDosesString != nil || r.SeriesDosesStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ImmunizationProtocolApplied with its unknown fields.
+func (r ImmunizationProtocolApplied) MarshalJSON() ([]byte, error) {
+	type other ImmunizationProtocolApplied
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurrence returns Immunization.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Immunization) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrenceString != nil:
+		return r.OccurrenceString
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets Immunization.occurrence[x] to the dateTime and clears the other types.
+func (r *Immunization) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrenceString sets Immunization.occurrence[x] to the string and clears the other types.
+func (r *Immunization) SetOccurrenceString(v string) {
+	r.clearOccurrence()
+	r.OccurrenceString = &v
+}
+func (r *Immunization) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrenceString = nil
+	r.OccurrenceStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the Immunization and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Immunization) UnmarshalJSON(b []byte) error {
+	type other Immunization
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Immunization.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrenceString != nil || r.OccurrenceStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Immunization", r)
+}
+
 type OtherImmunization Immunization
 
 // MarshalJSON marshals the given Immunization as JSON into a byte slice
 func (r Immunization) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherImmunization
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherImmunization: OtherImmunization(r),
 		ResourceType:      "Immunization",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalImmunization unmarshals a Immunization.
@@ -22,36 +22,138 @@ import "encoding/json"
 
 // ImmunizationEvaluation is documented here http://hl7.org/fhir/StructureDefinition/ImmunizationEvaluation
 type ImmunizationEvaluation struct {
-	ID                *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ImmunizationEvaluationStatusCodes `bson:"status" json:"status"`
-	Patient           Reference                         `bson:"patient" json:"patient"`
-	Date              *DateTime                         `bson:"date,omitempty" json:"date,omitempty"`
-	Authority         *Reference                        `bson:"authority,omitempty" json:"authority,omitempty"`
-	TargetDisease     CodeableConcept                   `bson:"targetDisease" json:"targetDisease"`
-	ImmunizationEvent Reference                         `bson:"immunizationEvent" json:"immunizationEvent"`
-	DoseStatus        CodeableConcept                   `bson:"doseStatus" json:"doseStatus"`
-	DoseStatusReason  []CodeableConcept                 `bson:"doseStatusReason,omitempty" json:"doseStatusReason,omitempty"`
-	Description       *string                           `bson:"description,omitempty" json:"description,omitempty"`
-	Series            *string                           `bson:"series,omitempty" json:"series,omitempty"`
+	ID                            *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                          *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     ContainedResources                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                        ImmunizationEvaluationStatusCodes `bson:"status" json:"status"`
+	StatusElement                 *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
+	Patient                       Reference                         `bson:"patient" json:"patient"`
+	Date                          *DateTime                         `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                   *Element                          `bson:"_date,omitempty" json:"_date,omitempty"`
+	Authority                     *Reference                        `bson:"authority,omitempty" json:"authority,omitempty"`
+	TargetDisease                 CodeableConcept                   `bson:"targetDisease" json:"targetDisease"`
+	ImmunizationEvent             Reference                         `bson:"immunizationEvent" json:"immunizationEvent"`
+	DoseStatus                    CodeableConcept                   `bson:"doseStatus" json:"doseStatus"`
+	DoseStatusReason              []CodeableConcept                 `bson:"doseStatusReason,omitempty" json:"doseStatusReason,omitempty"`
+	Description                   *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement            *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Series                        *string                           `bson:"series,omitempty" json:"series,omitempty"`
+	SeriesElement                 *Element                          `bson:"_series,omitempty" json:"_series,omitempty"`
+	DoseNumberPositiveInt         *int                              `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement  *Element                          `bson:"_doseNumberPositiveInt,omitempty" json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString              *string                           `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
+	DoseNumberStringElement       *Element                          `bson:"_doseNumberString,omitempty" json:"_doseNumberString,omitempty"`
+	SeriesDosesPositiveInt        *int                              `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *Element                          `bson:"_seriesDosesPositiveInt,omitempty" json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string                           `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *Element                          `bson:"_seriesDosesString,omitempty" json:"_seriesDosesString,omitempty"`
+	UnknownFields                 map[string]json.RawMessage        `bson:"-" json:"-"`
 }
+
+// DoseNumber returns ImmunizationEvaluation.doseNumber[x] of the set type, such as *int, or nil when it is not set.
+func (r *ImmunizationEvaluation) DoseNumber() interface{} {
+	switch {
+	case r.DoseNumberPositiveInt != nil:
+		return r.DoseNumberPositiveInt
+	case r.DoseNumberString != nil:
+		return r.DoseNumberString
+	}
+	return nil
+}
+
+// SetDoseNumberPositiveInt sets ImmunizationEvaluation.doseNumber[x] to the positiveInt and clears the other types.
+func (r *ImmunizationEvaluation) SetDoseNumberPositiveInt(v int) {
+	r.clearDoseNumber()
+	r.DoseNumberPositiveInt = &v
+}
+
+// SetDoseNumberString sets ImmunizationEvaluation.doseNumber[x] to the string and clears the other types.
+func (r *ImmunizationEvaluation) SetDoseNumberString(v string) {
+	r.clearDoseNumber()
+	r.DoseNumberString = &v
+}
+func (r *ImmunizationEvaluation) clearDoseNumber() {
+	r.DoseNumberPositiveInt = nil
+	r.DoseNumberPositiveIntElement = nil
+	r.DoseNumberString = nil
+	r.DoseNumberStringElement = nil
+}
+
+// SeriesDoses returns ImmunizationEvaluation.seriesDoses[x] of the set type, such as *int, or nil when it is not set.
+func (r *ImmunizationEvaluation) SeriesDoses() interface{} {
+	switch {
+	case r.SeriesDosesPositiveInt != nil:
+		return r.SeriesDosesPositiveInt
+	case r.SeriesDosesString != nil:
+		return r.SeriesDosesString
+	}
+	return nil
+}
+
+// SetSeriesDosesPositiveInt sets ImmunizationEvaluation.seriesDoses[x] to the positiveInt and clears the other types.
+func (r *ImmunizationEvaluation) SetSeriesDosesPositiveInt(v int) {
+	r.clearSeriesDoses()
+	r.SeriesDosesPositiveInt = &v
+}
+
+// SetSeriesDosesString sets ImmunizationEvaluation.seriesDoses[x] to the string and clears the other types.
+func (r *ImmunizationEvaluation) SetSeriesDosesString(v string) {
+	r.clearSeriesDoses()
+	r.SeriesDosesString = &v
+}
+func (r *ImmunizationEvaluation) clearSeriesDoses() {
+	r.SeriesDosesPositiveInt = nil
+	r.SeriesDosesPositiveIntElement = nil
+	r.SeriesDosesString = nil
+	r.SeriesDosesStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the ImmunizationEvaluation and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *ImmunizationEvaluation) UnmarshalJSON(b []byte) error {
+	type other ImmunizationEvaluation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ImmunizationEvaluation.doseNumber[x]",
+		r.DoseNumberPositiveInt != nil || r.DoseNumberPositiveIntElement != nil,
+		r.DoseNumberString != nil || r.DoseNumberStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ImmunizationEvaluation.seriesDoses[x]",
+		r.SeriesDosesPositiveInt != nil || r.SeriesDosesPositiveIntElement != nil,
+		r.SeriesDosesString != nil || r.SeriesDosesStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("ImmunizationEvaluation", r)
+}
+
 type OtherImmunizationEvaluation ImmunizationEvaluation
 
 // MarshalJSON marshals the given ImmunizationEvaluation as JSON into a byte slice
 func (r ImmunizationEvaluation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherImmunizationEvaluation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherImmunizationEvaluation: OtherImmunizationEvaluation(r),
 		ResourceType:                "ImmunizationEvaluation",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalImmunizationEvaluation unmarshals a ImmunizationEvaluation.
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.223674 +0000 UTC
+// 2026-10-19 02:55:18.839362022 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ImmunizationEvaluationStatusCodes is documented here http://hl7.org/fhir/ValueSet/immunization-evaluation-status
-type ImmunizationEvaluationStatusCodes int
+// The empty ImmunizationEvaluationStatusCodes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ImmunizationEvaluationStatusCodes string
 
 const (
-	ImmunizationEvaluationStatusCodesInProgress ImmunizationEvaluationStatusCodes = iota
-	ImmunizationEvaluationStatusCodesNotDone
-	ImmunizationEvaluationStatusCodesOnHold
-	ImmunizationEvaluationStatusCodesCompleted
-	ImmunizationEvaluationStatusCodesEnteredInError
-	ImmunizationEvaluationStatusCodesStopped
-	ImmunizationEvaluationStatusCodesUnknown
+	ImmunizationEvaluationStatusCodesInProgress     ImmunizationEvaluationStatusCodes = "in-progress"
+	ImmunizationEvaluationStatusCodesNotDone        ImmunizationEvaluationStatusCodes = "not-done"
+	ImmunizationEvaluationStatusCodesOnHold         ImmunizationEvaluationStatusCodes = "on-hold"
+	ImmunizationEvaluationStatusCodesCompleted      ImmunizationEvaluationStatusCodes = "completed"
+	ImmunizationEvaluationStatusCodesEnteredInError ImmunizationEvaluationStatusCodes = "entered-in-error"
+	ImmunizationEvaluationStatusCodesStopped        ImmunizationEvaluationStatusCodes = "stopped"
+	ImmunizationEvaluationStatusCodesUnknown        ImmunizationEvaluationStatusCodes = "unknown"
 )
 
 func (code ImmunizationEvaluationStatusCodes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ImmunizationEvaluationStatusCodes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ImmunizationEvaluationStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "in-progress":
-		*code = ImmunizationEvaluationStatusCodesInProgress
-	case "not-done":
-		*code = ImmunizationEvaluationStatusCodesNotDone
-	case "on-hold":
-		*code = ImmunizationEvaluationStatusCodesOnHold
-	case "completed":
-		*code = ImmunizationEvaluationStatusCodesCompleted
-	case "entered-in-error":
-		*code = ImmunizationEvaluationStatusCodesEnteredInError
-	case "stopped":
-		*code = ImmunizationEvaluationStatusCodesStopped
-	case "unknown":
-		*code = ImmunizationEvaluationStatusCodesUnknown
-	default:
-		return fmt.Errorf("unknown ImmunizationEvaluationStatusCodes code `%s`", s)
+func (code *ImmunizationEvaluationStatusCodes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ImmunizationEvaluationStatusCodes(s)
 	return nil
 }
 func (code ImmunizationEvaluationStatusCodes) String() string {
 	return code.Code()
 }
 func (code ImmunizationEvaluationStatusCodes) Code() string {
+	return string(code)
+}
+func (code ImmunizationEvaluationStatusCodes) Known() bool {
 	switch code {
-	case ImmunizationEvaluationStatusCodesInProgress:
-		return "in-progress"
-	case ImmunizationEvaluationStatusCodesNotDone:
-		return "not-done"
-	case ImmunizationEvaluationStatusCodesOnHold:
-		return "on-hold"
-	case ImmunizationEvaluationStatusCodesCompleted:
-		return "completed"
-	case ImmunizationEvaluationStatusCodesEnteredInError:
-		return "entered-in-error"
-	case ImmunizationEvaluationStatusCodesStopped:
-		return "stopped"
-	case ImmunizationEvaluationStatusCodesUnknown:
-		return "unknown"
+	case ImmunizationEvaluationStatusCodesInProgress, ImmunizationEvaluationStatusCodesNotDone, ImmunizationEvaluationStatusCodesOnHold, ImmunizationEvaluationStatusCodesCompleted, ImmunizationEvaluationStatusCodesEnteredInError, ImmunizationEvaluationStatusCodesStopped, ImmunizationEvaluationStatusCodesUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ImmunizationEvaluationStatusCodes) Display() string {
 	switch code {
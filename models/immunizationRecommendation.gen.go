@@ -22,52 +22,185 @@ import "encoding/json"
 
 // ImmunizationRecommendation is documented here http://hl7.org/fhir/StructureDefinition/ImmunizationRecommendation
 type ImmunizationRecommendation struct {
-	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Patient           Reference                                  `bson:"patient" json:"patient"`
-	Date              DateTime                                   `bson:"date" json:"date"`
-	Authority         *Reference                                 `bson:"authority,omitempty" json:"authority,omitempty"`
-	Recommendation    []ImmunizationRecommendationRecommendation `bson:"recommendation" json:"recommendation"`
+	ID                   *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Patient              Reference                                  `bson:"patient" json:"patient"`
+	Date                 DateTime                                   `bson:"date" json:"date"`
+	DateElement          *Element                                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Authority            *Reference                                 `bson:"authority,omitempty" json:"authority,omitempty"`
+	Recommendation       []ImmunizationRecommendationRecommendation `bson:"recommendation" json:"recommendation"`
+	UnknownFields        map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type ImmunizationRecommendationRecommendation struct {
-	ID                           *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                    []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension            []Extension                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	VaccineCode                  []CodeableConcept                                       `bson:"vaccineCode,omitempty" json:"vaccineCode,omitempty"`
-	TargetDisease                *CodeableConcept                                        `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
-	ContraindicatedVaccineCode   []CodeableConcept                                       `bson:"contraindicatedVaccineCode,omitempty" json:"contraindicatedVaccineCode,omitempty"`
-	ForecastStatus               CodeableConcept                                         `bson:"forecastStatus" json:"forecastStatus"`
-	ForecastReason               []CodeableConcept                                       `bson:"forecastReason,omitempty" json:"forecastReason,omitempty"`
-	DateCriterion                []ImmunizationRecommendationRecommendationDateCriterion `bson:"dateCriterion,omitempty" json:"dateCriterion,omitempty"`
-	Description                  *string                                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Series                       *string                                                 `bson:"series,omitempty" json:"series,omitempty"`
-	SupportingImmunization       []Reference                                             `bson:"supportingImmunization,omitempty" json:"supportingImmunization,omitempty"`
-	SupportingPatientInformation []Reference                                             `bson:"supportingPatientInformation,omitempty" json:"supportingPatientInformation,omitempty"`
+	ID                            *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	VaccineCode                   []CodeableConcept                                       `bson:"vaccineCode,omitempty" json:"vaccineCode,omitempty"`
+	TargetDisease                 *CodeableConcept                                        `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
+	ContraindicatedVaccineCode    []CodeableConcept                                       `bson:"contraindicatedVaccineCode,omitempty" json:"contraindicatedVaccineCode,omitempty"`
+	ForecastStatus                CodeableConcept                                         `bson:"forecastStatus" json:"forecastStatus"`
+	ForecastReason                []CodeableConcept                                       `bson:"forecastReason,omitempty" json:"forecastReason,omitempty"`
+	DateCriterion                 []ImmunizationRecommendationRecommendationDateCriterion `bson:"dateCriterion,omitempty" json:"dateCriterion,omitempty"`
+	Description                   *string                                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement            *Element                                                `bson:"_description,omitempty" json:"_description,omitempty"`
+	Series                        *string                                                 `bson:"series,omitempty" json:"series,omitempty"`
+	SeriesElement                 *Element                                                `bson:"_series,omitempty" json:"_series,omitempty"`
+	DoseNumberPositiveInt         *int                                                    `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement  *Element                                                `bson:"_doseNumberPositiveInt,omitempty" json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString              *string                                                 `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
+	DoseNumberStringElement       *Element                                                `bson:"_doseNumberString,omitempty" json:"_doseNumberString,omitempty"`
+	SeriesDosesPositiveInt        *int                                                    `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *Element                                                `bson:"_seriesDosesPositiveInt,omitempty" json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string                                                 `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *Element                                                `bson:"_seriesDosesString,omitempty" json:"_seriesDosesString,omitempty"`
+	SupportingImmunization        []Reference                                             `bson:"supportingImmunization,omitempty" json:"supportingImmunization,omitempty"`
+	SupportingPatientInformation  []Reference                                             `bson:"supportingPatientInformation,omitempty" json:"supportingPatientInformation,omitempty"`
+	UnknownFields                 map[string]json.RawMessage                              `bson:"-" json:"-"`
 }
 type ImmunizationRecommendationRecommendationDateCriterion struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
-	Value             DateTime        `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              CodeableConcept            `bson:"code" json:"code"`
+	Value             DateTime                   `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImmunizationRecommendationRecommendationDateCriterion with its unknown fields.
+func (r ImmunizationRecommendationRecommendationDateCriterion) MarshalJSON() ([]byte, error) {
+	type other ImmunizationRecommendationRecommendationDateCriterion
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// DoseNumber returns ImmunizationRecommendation.recommendation.doseNumber[x] of the set type, such as *int, or nil when it is not set.
+func (r *ImmunizationRecommendationRecommendation) DoseNumber() interface{} {
+	switch {
+	case r.DoseNumberPositiveInt != nil:
+		return r.DoseNumberPositiveInt
+	case r.DoseNumberString != nil:
+		return r.DoseNumberString
+	}
+	return nil
+}
+
+// SetDoseNumberPositiveInt sets ImmunizationRecommendation.recommendation.doseNumber[x] to the positiveInt and clears the other types.
+func (r *ImmunizationRecommendationRecommendation) SetDoseNumberPositiveInt(v int) {
+	r.clearDoseNumber()
+	r.DoseNumberPositiveInt = &v
+}
+
+// SetDoseNumberString sets ImmunizationRecommendation.recommendation.doseNumber[x] to the string and clears the other types.
+func (r *ImmunizationRecommendationRecommendation) SetDoseNumberString(v string) {
+	r.clearDoseNumber()
+	r.DoseNumberString = &v
+}
+func (r *ImmunizationRecommendationRecommendation) clearDoseNumber() {
+	r.DoseNumberPositiveInt = nil
+	r.DoseNumberPositiveIntElement = nil
+	r.DoseNumberString = nil
+	r.DoseNumberStringElement = nil
+}
+
+// SeriesDoses returns ImmunizationRecommendation.recommendation.seriesDoses[x] of the set type, such as *int, or nil when it is not set.
+func (r *ImmunizationRecommendationRecommendation) SeriesDoses() interface{} {
+	switch {
+	case r.SeriesDosesPositiveInt != nil:
+		return r.SeriesDosesPositiveInt
+	case r.SeriesDosesString != nil:
+		return r.SeriesDosesString
+	}
+	return nil
+}
+
+// SetSeriesDosesPositiveInt sets ImmunizationRecommendation.recommendation.seriesDoses[x] to the positiveInt and clears the other types.
+func (r *ImmunizationRecommendationRecommendation) SetSeriesDosesPositiveInt(v int) {
+	r.clearSeriesDoses()
+	r.SeriesDosesPositiveInt = &v
+}
+
+// SetSeriesDosesString sets ImmunizationRecommendation.recommendation.seriesDoses[x] to the string and clears the other types.
+func (r *ImmunizationRecommendationRecommendation) SetSeriesDosesString(v string) {
+	r.clearSeriesDoses()
+	r.SeriesDosesString = &v
+}
+func (r *ImmunizationRecommendationRecommendation) clearSeriesDoses() {
+	r.SeriesDosesPositiveInt = nil
+	r.SeriesDosesPositiveIntElement = nil
+	r.SeriesDosesString = nil
+	r.SeriesDosesStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the ImmunizationRecommendationRecommendation and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ImmunizationRecommendationRecommendation) UnmarshalJSON(b []byte) error {
+	type other ImmunizationRecommendationRecommendation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ImmunizationRecommendation.recommendation.doseNumber[x]",
+		r.DoseNumberPositiveInt != nil || r.DoseNumberPositiveIntElement != nil,
+		r.DoseNumberString != nil || r.DoseNumberStringElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ImmunizationRecommendation.recommendation.seriesDoses[x]",
+		r.SeriesDosesPositiveInt != nil || r.SeriesDosesPositiveIntElement != nil,
+		r.SeriesDosesString != nil || r.SeriesDosesStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ImmunizationRecommendationRecommendation with its unknown fields.
+func (r ImmunizationRecommendationRecommendation) MarshalJSON() ([]byte, error) {
+	type other ImmunizationRecommendationRecommendation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ImmunizationRecommendation and fails with CodeError when a coded element has an unknown code.
+func (r *ImmunizationRecommendation) UnmarshalJSON(b []byte) error {
+	type other ImmunizationRecommendation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("ImmunizationRecommendation", r)
+}
+
 type OtherImmunizationRecommendation ImmunizationRecommendation
 
 // MarshalJSON marshals the given ImmunizationRecommendation as JSON into a byte slice
 func (r ImmunizationRecommendation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherImmunizationRecommendation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherImmunizationRecommendation: OtherImmunizationRecommendation(r),
 		ResourceType:                    "ImmunizationRecommendation",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalImmunizationRecommendation unmarshals a ImmunizationRecommendation.
@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.080858 +0000 UTC
+// 2026-10-19 02:55:18.840720175 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ImmunizationStatusCodes is documented here http://hl7.org/fhir/ValueSet/immunization-status
-type ImmunizationStatusCodes int
+// The empty ImmunizationStatusCodes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ImmunizationStatusCodes string
 
 const (
-	ImmunizationStatusCodesPreparation ImmunizationStatusCodes = iota
-	ImmunizationStatusCodesInProgress
-	ImmunizationStatusCodesNotDone
-	ImmunizationStatusCodesOnHold
-	ImmunizationStatusCodesStopped
-	ImmunizationStatusCodesCompleted
-	ImmunizationStatusCodesEnteredInError
-	ImmunizationStatusCodesUnknown
+	ImmunizationStatusCodesPreparation    ImmunizationStatusCodes = "preparation"
+	ImmunizationStatusCodesInProgress     ImmunizationStatusCodes = "in-progress"
+	ImmunizationStatusCodesNotDone        ImmunizationStatusCodes = "not-done"
+	ImmunizationStatusCodesOnHold         ImmunizationStatusCodes = "on-hold"
+	ImmunizationStatusCodesStopped        ImmunizationStatusCodes = "stopped"
+	ImmunizationStatusCodesCompleted      ImmunizationStatusCodes = "completed"
+	ImmunizationStatusCodesEnteredInError ImmunizationStatusCodes = "entered-in-error"
+	ImmunizationStatusCodesUnknown        ImmunizationStatusCodes = "unknown"
 )
 
 func (code ImmunizationStatusCodes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ImmunizationStatusCodes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ImmunizationStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "preparation":
-		*code = ImmunizationStatusCodesPreparation
-	case "in-progress":
-		*code = ImmunizationStatusCodesInProgress
-	case "not-done":
-		*code = ImmunizationStatusCodesNotDone
-	case "on-hold":
-		*code = ImmunizationStatusCodesOnHold
-	case "stopped":
-		*code = ImmunizationStatusCodesStopped
-	case "completed":
-		*code = ImmunizationStatusCodesCompleted
-	case "entered-in-error":
-		*code = ImmunizationStatusCodesEnteredInError
-	case "unknown":
-		*code = ImmunizationStatusCodesUnknown
-	default:
-		return fmt.Errorf("unknown ImmunizationStatusCodes code `%s`", s)
+func (code *ImmunizationStatusCodes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ImmunizationStatusCodes(s)
 	return nil
 }
 func (code ImmunizationStatusCodes) String() string {
 	return code.Code()
 }
 func (code ImmunizationStatusCodes) Code() string {
+	return string(code)
+}
+func (code ImmunizationStatusCodes) Known() bool {
 	switch code {
-	case ImmunizationStatusCodesPreparation:
-		return "preparation"
-	case ImmunizationStatusCodesInProgress:
-		return "in-progress"
-	case ImmunizationStatusCodesNotDone:
-		return "not-done"
-	case ImmunizationStatusCodesOnHold:
-		return "on-hold"
-	case ImmunizationStatusCodesStopped:
-		return "stopped"
-	case ImmunizationStatusCodesCompleted:
-		return "completed"
-	case ImmunizationStatusCodesEnteredInError:
-		return "entered-in-error"
-	case ImmunizationStatusCodesUnknown:
-		return "unknown"
+	case ImmunizationStatusCodesPreparation, ImmunizationStatusCodesInProgress, ImmunizationStatusCodesNotDone, ImmunizationStatusCodesOnHold, ImmunizationStatusCodesStopped, ImmunizationStatusCodesCompleted, ImmunizationStatusCodesEnteredInError, ImmunizationStatusCodesUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ImmunizationStatusCodes) Display() string {
 	switch code {
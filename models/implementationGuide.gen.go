@@ -22,49 +22,95 @@ import "encoding/json"
 
 // ImplementationGuide is documented here http://hl7.org/fhir/StructureDefinition/ImplementationGuide
 type ImplementationGuide struct {
-	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                         `bson:"url" json:"url"`
-	Version           *string                        `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                         `bson:"name" json:"name"`
-	Title             *string                        `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus              `bson:"status" json:"status"`
-	Experimental      *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                      `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                        `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                        `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	PackageId         string                         `bson:"packageId" json:"packageId"`
-	License           *SPDXLicense                   `bson:"license,omitempty" json:"license,omitempty"`
-	FhirVersion       []FHIRVersion                  `bson:"fhirVersion" json:"fhirVersion"`
-	DependsOn         []ImplementationGuideDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
-	Global            []ImplementationGuideGlobal    `bson:"global,omitempty" json:"global,omitempty"`
-	Definition        *ImplementationGuideDefinition `bson:"definition,omitempty" json:"definition,omitempty"`
-	Manifest          *ImplementationGuideManifest   `bson:"manifest,omitempty" json:"manifest,omitempty"`
+	ID                   *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  string                         `bson:"url" json:"url"`
+	URLElement           *Element                       `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                        `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                       `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                         `bson:"name" json:"name"`
+	NameElement          *Element                       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                        `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                       `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus              `bson:"status" json:"status"`
+	StatusElement        *Element                       `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                       `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                      `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                       `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                       `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                        `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                       `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright            *string                        `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                       `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	PackageId            string                         `bson:"packageId" json:"packageId"`
+	PackageIdElement     *Element                       `bson:"_packageId,omitempty" json:"_packageId,omitempty"`
+	License              *SPDXLicense                   `bson:"license,omitempty" json:"license,omitempty"`
+	LicenseElement       *Element                       `bson:"_license,omitempty" json:"_license,omitempty"`
+	FhirVersion          []*FHIRVersion                 `bson:"fhirVersion" json:"fhirVersion"`
+	FhirVersionElement   []*Element                     `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	DependsOn            []ImplementationGuideDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+	Global               []ImplementationGuideGlobal    `bson:"global,omitempty" json:"global,omitempty"`
+	Definition           *ImplementationGuideDefinition `bson:"definition,omitempty" json:"definition,omitempty"`
+	Manifest             *ImplementationGuideManifest   `bson:"manifest,omitempty" json:"manifest,omitempty"`
+	UnknownFields        map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type ImplementationGuideDependsOn struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URI               string      `bson:"uri" json:"uri"`
-	PackageId         *string     `bson:"packageId,omitempty" json:"packageId,omitempty"`
-	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URI               string                     `bson:"uri" json:"uri"`
+	URIElement        *Element                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	PackageId         *string                    `bson:"packageId,omitempty" json:"packageId,omitempty"`
+	PackageIdElement  *Element                   `bson:"_packageId,omitempty" json:"_packageId,omitempty"`
+	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImplementationGuideDependsOn with its unknown fields.
+func (r ImplementationGuideDependsOn) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDependsOn
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImplementationGuideGlobal struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              ResourceType `bson:"type" json:"type"`
-	Profile           string       `bson:"profile" json:"profile"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              ResourceType               `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Profile           string                     `bson:"profile" json:"profile"`
+	ProfileElement    *Element                   `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImplementationGuideGlobal with its unknown fields.
+func (r ImplementationGuideGlobal) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideGlobal
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImplementationGuideDefinition struct {
 	ID                *string                                  `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -74,83 +120,353 @@ type ImplementationGuideDefinition struct {
 	Page              *ImplementationGuideDefinitionPage       `bson:"page,omitempty" json:"page,omitempty"`
 	Parameter         []ImplementationGuideDefinitionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
 	Template          []ImplementationGuideDefinitionTemplate  `bson:"template,omitempty" json:"template,omitempty"`
+	UnknownFields     map[string]json.RawMessage               `bson:"-" json:"-"`
 }
 type ImplementationGuideDefinitionGrouping struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               string                     `bson:"name" json:"name"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ImplementationGuideDefinitionGrouping with its unknown fields.
+func (r ImplementationGuideDefinitionGrouping) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDefinitionGrouping
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ImplementationGuideDefinitionResource struct {
-	ID                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         Reference     `bson:"reference" json:"reference"`
-	FhirVersion       []FHIRVersion `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	Name              *string       `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string       `bson:"description,omitempty" json:"description,omitempty"`
-	GroupingId        *string       `bson:"groupingId,omitempty" json:"groupingId,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference               Reference                  `bson:"reference" json:"reference"`
+	FhirVersion             []*FHIRVersion             `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	FhirVersionElement      []*Element                 `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	Name                    *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description             *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	ExampleBoolean          *bool                      `bson:"exampleBoolean,omitempty" json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement   *Element                   `bson:"_exampleBoolean,omitempty" json:"_exampleBoolean,omitempty"`
+	ExampleCanonical        *string                    `bson:"exampleCanonical,omitempty" json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *Element                   `bson:"_exampleCanonical,omitempty" json:"_exampleCanonical,omitempty"`
+	GroupingId              *string                    `bson:"groupingId,omitempty" json:"groupingId,omitempty"`
+	GroupingIdElement       *Element                   `bson:"_groupingId,omitempty" json:"_groupingId,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Example returns ImplementationGuide.definition.resource.example[x] of the set type, such as *bool, or nil when it is not set.
+func (r *ImplementationGuideDefinitionResource) Example() interface{} {
+	switch {
+	case r.ExampleBoolean != nil:
+		return r.ExampleBoolean
+	case r.ExampleCanonical != nil:
+		return r.ExampleCanonical
+	}
+	return nil
+}
+
+// SetExampleBoolean sets ImplementationGuide.definition.resource.example[x] to the boolean and clears the other types.
+func (r *ImplementationGuideDefinitionResource) SetExampleBoolean(v bool) {
+	r.clearExample()
+	r.ExampleBoolean = &v
+}
+
+// SetExampleCanonical sets ImplementationGuide.definition.resource.example[x] to the canonical and clears the other types.
+func (r *ImplementationGuideDefinitionResource) SetExampleCanonical(v string) {
+	r.clearExample()
+	r.ExampleCanonical = &v
 }
+func (r *ImplementationGuideDefinitionResource) clearExample() {
+	r.ExampleBoolean = nil
+	r.ExampleBooleanElement = nil
+	r.ExampleCanonical = nil
+	r.ExampleCanonicalElement = nil
+}
+
+// UnmarshalJSON unmarshals the ImplementationGuideDefinitionResource and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ImplementationGuideDefinitionResource) UnmarshalJSON(b []byte) error {
+	type other ImplementationGuideDefinitionResource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ImplementationGuide.definition.resource.example[x]",
+		r.ExampleBoolean != nil || r.ExampleBooleanElement != nil,
+		r.ExampleCanonical != nil || r.ExampleCanonicalElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ImplementationGuide.definition.resource.fhirVersion", len(r.FhirVersion), len(r.FhirVersionElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ImplementationGuideDefinitionResource with its unknown fields.
+func (r ImplementationGuideDefinitionResource) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDefinitionResource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImplementationGuideDefinitionPage struct {
 	ID                *string                             `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Title             string                              `bson:"title" json:"title"`
+	TitleElement      *Element                            `bson:"_title,omitempty" json:"_title,omitempty"`
 	Generation        GuidePageGeneration                 `bson:"generation" json:"generation"`
+	GenerationElement *Element                            `bson:"_generation,omitempty" json:"_generation,omitempty"`
 	Page              []ImplementationGuideDefinitionPage `bson:"page,omitempty" json:"page,omitempty"`
+	UnknownFields     map[string]json.RawMessage          `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ImplementationGuideDefinitionPage with its unknown fields.
+func (r ImplementationGuideDefinitionPage) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDefinitionPage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ImplementationGuideDefinitionParameter struct {
-	ID                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              GuideParameterCode `bson:"code" json:"code"`
-	Value             string             `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              GuideParameterCode         `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ImplementationGuideDefinitionParameter with its unknown fields.
+func (r ImplementationGuideDefinitionParameter) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDefinitionParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type ImplementationGuideDefinitionTemplate struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string      `bson:"code" json:"code"`
-	Source            string      `bson:"source" json:"source"`
-	Scope             *string     `bson:"scope,omitempty" json:"scope,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              string                     `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Source            string                     `bson:"source" json:"source"`
+	SourceElement     *Element                   `bson:"_source,omitempty" json:"_source,omitempty"`
+	Scope             *string                    `bson:"scope,omitempty" json:"scope,omitempty"`
+	ScopeElement      *Element                   `bson:"_scope,omitempty" json:"_scope,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ImplementationGuideDefinitionTemplate with its unknown fields.
+func (r ImplementationGuideDefinitionTemplate) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDefinitionTemplate
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ImplementationGuideDefinition with its unknown fields.
+func (r ImplementationGuideDefinition) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideDefinition
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImplementationGuideManifest struct {
 	ID                *string                               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Rendering         *string                               `bson:"rendering,omitempty" json:"rendering,omitempty"`
+	RenderingElement  *Element                              `bson:"_rendering,omitempty" json:"_rendering,omitempty"`
 	Resource          []ImplementationGuideManifestResource `bson:"resource" json:"resource"`
 	Page              []ImplementationGuideManifestPage     `bson:"page,omitempty" json:"page,omitempty"`
-	Image             []string                              `bson:"image,omitempty" json:"image,omitempty"`
-	Other             []string                              `bson:"other,omitempty" json:"other,omitempty"`
+	Image             []*string                             `bson:"image,omitempty" json:"image,omitempty"`
+	ImageElement      []*Element                            `bson:"_image,omitempty" json:"_image,omitempty"`
+	Other             []*string                             `bson:"other,omitempty" json:"other,omitempty"`
+	OtherElement      []*Element                            `bson:"_other,omitempty" json:"_other,omitempty"`
+	UnknownFields     map[string]json.RawMessage            `bson:"-" json:"-"`
 }
 type ImplementationGuideManifestResource struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         Reference   `bson:"reference" json:"reference"`
-	RelativePath      *string     `bson:"relativePath,omitempty" json:"relativePath,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference               Reference                  `bson:"reference" json:"reference"`
+	ExampleBoolean          *bool                      `bson:"exampleBoolean,omitempty" json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement   *Element                   `bson:"_exampleBoolean,omitempty" json:"_exampleBoolean,omitempty"`
+	ExampleCanonical        *string                    `bson:"exampleCanonical,omitempty" json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *Element                   `bson:"_exampleCanonical,omitempty" json:"_exampleCanonical,omitempty"`
+	RelativePath            *string                    `bson:"relativePath,omitempty" json:"relativePath,omitempty"`
+	RelativePathElement     *Element                   `bson:"_relativePath,omitempty" json:"_relativePath,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Example returns ImplementationGuide.manifest.resource.example[x] of the set type, such as *bool, or nil when it is not set.
+func (r *ImplementationGuideManifestResource) Example() interface{} {
+	switch {
+	case r.ExampleBoolean != nil:
+		return r.ExampleBoolean
+	case r.ExampleCanonical != nil:
+		return r.ExampleCanonical
+	}
+	return nil
+}
+
+// SetExampleBoolean sets ImplementationGuide.manifest.resource.example[x] to the boolean and clears the other types.
+func (r *ImplementationGuideManifestResource) SetExampleBoolean(v bool) {
+	r.clearExample()
+	r.ExampleBoolean = &v
+}
+
+// SetExampleCanonical sets ImplementationGuide.manifest.resource.example[x] to the canonical and clears the other types.
+func (r *ImplementationGuideManifestResource) SetExampleCanonical(v string) {
+	r.clearExample()
+	r.ExampleCanonical = &v
 }
+func (r *ImplementationGuideManifestResource) clearExample() {
+	r.ExampleBoolean = nil
+	r.ExampleBooleanElement = nil
+	r.ExampleCanonical = nil
+	r.ExampleCanonicalElement = nil
+}
+
+// UnmarshalJSON unmarshals the ImplementationGuideManifestResource and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ImplementationGuideManifestResource) UnmarshalJSON(b []byte) error {
+	type other ImplementationGuideManifestResource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ImplementationGuide.manifest.resource.example[x]",
+		r.ExampleBoolean != nil || r.ExampleBooleanElement != nil,
+		r.ExampleCanonical != nil || r.ExampleCanonicalElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ImplementationGuideManifestResource with its unknown fields.
+func (r ImplementationGuideManifestResource) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideManifestResource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ImplementationGuideManifestPage struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Title             *string     `bson:"title,omitempty" json:"title,omitempty"`
-	Anchor            []string    `bson:"anchor,omitempty" json:"anchor,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              string                     `bson:"name" json:"name"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title             *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement      *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Anchor            []*string                  `bson:"anchor,omitempty" json:"anchor,omitempty"`
+	AnchorElement     []*Element                 `bson:"_anchor,omitempty" json:"_anchor,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the ImplementationGuideManifestPage and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ImplementationGuideManifestPage) UnmarshalJSON(b []byte) error {
+	type other ImplementationGuideManifestPage
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ImplementationGuide.manifest.page.anchor", len(r.Anchor), len(r.AnchorElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ImplementationGuideManifestPage with its unknown fields.
+func (r ImplementationGuideManifestPage) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideManifestPage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ImplementationGuideManifest and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ImplementationGuideManifest) UnmarshalJSON(b []byte) error {
+	type other ImplementationGuideManifest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ImplementationGuide.manifest.image", len(r.Image), len(r.ImageElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ImplementationGuide.manifest.other", len(r.Other), len(r.OtherElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ImplementationGuideManifest with its unknown fields.
+func (r ImplementationGuideManifest) MarshalJSON() ([]byte, error) {
+	type other ImplementationGuideManifest
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ImplementationGuide and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ImplementationGuide) UnmarshalJSON(b []byte) error {
+	type other ImplementationGuide
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ImplementationGuide.fhirVersion", len(r.FhirVersion), len(r.FhirVersionElement)); err != nil {
+		return err
+	}
+	return checkCodes("ImplementationGuide", r)
 }
+
 type OtherImplementationGuide ImplementationGuide
 
 // MarshalJSON marshals the given ImplementationGuide as JSON into a byte slice
 func (r ImplementationGuide) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherImplementationGuide
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherImplementationGuide: OtherImplementationGuide(r),
 		ResourceType:             "ImplementationGuide",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalImplementationGuide unmarshals a ImplementationGuide.
@@ -22,37 +22,56 @@ import "encoding/json"
 
 // InsurancePlan is documented here http://hl7.org/fhir/StructureDefinition/InsurancePlan
 type InsurancePlan struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *PublicationStatus      `bson:"status,omitempty" json:"status,omitempty"`
-	Type              []CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string                 `bson:"name,omitempty" json:"name,omitempty"`
-	Alias             []string                `bson:"alias,omitempty" json:"alias,omitempty"`
-	Period            *Period                 `bson:"period,omitempty" json:"period,omitempty"`
-	OwnedBy           *Reference              `bson:"ownedBy,omitempty" json:"ownedBy,omitempty"`
-	AdministeredBy    *Reference              `bson:"administeredBy,omitempty" json:"administeredBy,omitempty"`
-	CoverageArea      []Reference             `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
-	Contact           []InsurancePlanContact  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Endpoint          []Reference             `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	Network           []Reference             `bson:"network,omitempty" json:"network,omitempty"`
-	Coverage          []InsurancePlanCoverage `bson:"coverage,omitempty" json:"coverage,omitempty"`
-	Plan              []InsurancePlanPlan     `bson:"plan,omitempty" json:"plan,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *PublicationStatus         `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Alias                []*string                  `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement         []*Element                 `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	OwnedBy              *Reference                 `bson:"ownedBy,omitempty" json:"ownedBy,omitempty"`
+	AdministeredBy       *Reference                 `bson:"administeredBy,omitempty" json:"administeredBy,omitempty"`
+	CoverageArea         []Reference                `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
+	Contact              []InsurancePlanContact     `bson:"contact,omitempty" json:"contact,omitempty"`
+	Endpoint             []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Network              []Reference                `bson:"network,omitempty" json:"network,omitempty"`
+	Coverage             []InsurancePlanCoverage    `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	Plan                 []InsurancePlanPlan        `bson:"plan,omitempty" json:"plan,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type InsurancePlanContact struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Purpose           *CodeableConcept `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Name              *HumanName       `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint   `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address           *Address         `bson:"address,omitempty" json:"address,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Purpose           *CodeableConcept           `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	Name              *HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom           []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address           *Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the InsurancePlanContact with its unknown fields.
+func (r InsurancePlanContact) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanContact
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type InsurancePlanCoverage struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -60,22 +79,57 @@ type InsurancePlanCoverage struct {
 	Type              CodeableConcept                `bson:"type" json:"type"`
 	Network           []Reference                    `bson:"network,omitempty" json:"network,omitempty"`
 	Benefit           []InsurancePlanCoverageBenefit `bson:"benefit" json:"benefit"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type InsurancePlanCoverageBenefit struct {
-	ID                *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept                     `bson:"type" json:"type"`
-	Requirement       *string                             `bson:"requirement,omitempty" json:"requirement,omitempty"`
-	Limit             []InsurancePlanCoverageBenefitLimit `bson:"limit,omitempty" json:"limit,omitempty"`
+	ID                 *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type               CodeableConcept                     `bson:"type" json:"type"`
+	Requirement        *string                             `bson:"requirement,omitempty" json:"requirement,omitempty"`
+	RequirementElement *Element                            `bson:"_requirement,omitempty" json:"_requirement,omitempty"`
+	Limit              []InsurancePlanCoverageBenefitLimit `bson:"limit,omitempty" json:"limit,omitempty"`
+	UnknownFields      map[string]json.RawMessage          `bson:"-" json:"-"`
 }
 type InsurancePlanCoverageBenefitLimit struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Value             *Quantity        `bson:"value,omitempty" json:"value,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Value             *Quantity                  `bson:"value,omitempty" json:"value,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the InsurancePlanCoverageBenefitLimit with its unknown fields.
+func (r InsurancePlanCoverageBenefitLimit) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanCoverageBenefitLimit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the InsurancePlanCoverageBenefit with its unknown fields.
+func (r InsurancePlanCoverageBenefit) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanCoverageBenefit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the InsurancePlanCoverage with its unknown fields.
+func (r InsurancePlanCoverage) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanCoverage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type InsurancePlanPlan struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -86,22 +140,38 @@ type InsurancePlanPlan struct {
 	Network           []Reference                     `bson:"network,omitempty" json:"network,omitempty"`
 	GeneralCost       []InsurancePlanPlanGeneralCost  `bson:"generalCost,omitempty" json:"generalCost,omitempty"`
 	SpecificCost      []InsurancePlanPlanSpecificCost `bson:"specificCost,omitempty" json:"specificCost,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type InsurancePlanPlanGeneralCost struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	GroupSize         *int             `bson:"groupSize,omitempty" json:"groupSize,omitempty"`
-	Cost              *Money           `bson:"cost,omitempty" json:"cost,omitempty"`
-	Comment           *string          `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	GroupSize         *int                       `bson:"groupSize,omitempty" json:"groupSize,omitempty"`
+	GroupSizeElement  *Element                   `bson:"_groupSize,omitempty" json:"_groupSize,omitempty"`
+	Cost              *Money                     `bson:"cost,omitempty" json:"cost,omitempty"`
+	Comment           *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the InsurancePlanPlanGeneralCost with its unknown fields.
+func (r InsurancePlanPlanGeneralCost) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanPlanGeneralCost
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type InsurancePlanPlanSpecificCost struct {
 	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Category          CodeableConcept                        `bson:"category" json:"category"`
 	Benefit           []InsurancePlanPlanSpecificCostBenefit `bson:"benefit,omitempty" json:"benefit,omitempty"`
+	UnknownFields     map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type InsurancePlanPlanSpecificCostBenefit struct {
 	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -109,27 +179,86 @@ type InsurancePlanPlanSpecificCostBenefit struct {
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              CodeableConcept                            `bson:"type" json:"type"`
 	Cost              []InsurancePlanPlanSpecificCostBenefitCost `bson:"cost,omitempty" json:"cost,omitempty"`
+	UnknownFields     map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type InsurancePlanPlanSpecificCostBenefitCost struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept   `bson:"type" json:"type"`
-	Applicability     *CodeableConcept  `bson:"applicability,omitempty" json:"applicability,omitempty"`
-	Qualifiers        []CodeableConcept `bson:"qualifiers,omitempty" json:"qualifiers,omitempty"`
-	Value             *Quantity         `bson:"value,omitempty" json:"value,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Applicability     *CodeableConcept           `bson:"applicability,omitempty" json:"applicability,omitempty"`
+	Qualifiers        []CodeableConcept          `bson:"qualifiers,omitempty" json:"qualifiers,omitempty"`
+	Value             *Quantity                  `bson:"value,omitempty" json:"value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the InsurancePlanPlanSpecificCostBenefitCost with its unknown fields.
+func (r InsurancePlanPlanSpecificCostBenefitCost) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanPlanSpecificCostBenefitCost
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the InsurancePlanPlanSpecificCostBenefit with its unknown fields.
+func (r InsurancePlanPlanSpecificCostBenefit) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanPlanSpecificCostBenefit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the InsurancePlanPlanSpecificCost with its unknown fields.
+func (r InsurancePlanPlanSpecificCost) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanPlanSpecificCost
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the InsurancePlanPlan with its unknown fields.
+func (r InsurancePlanPlan) MarshalJSON() ([]byte, error) {
+	type other InsurancePlanPlan
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the InsurancePlan and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *InsurancePlan) UnmarshalJSON(b []byte) error {
+	type other InsurancePlan
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("InsurancePlan.alias", len(r.Alias), len(r.AliasElement)); err != nil {
+		return err
+	}
+	return checkCodes("InsurancePlan", r)
+}
+
 type OtherInsurancePlan InsurancePlan
 
 // MarshalJSON marshals the given InsurancePlan as JSON into a byte slice
 func (r InsurancePlan) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherInsurancePlan
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherInsurancePlan: OtherInsurancePlan(r),
 		ResourceType:       "InsurancePlan",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalInsurancePlan unmarshals a InsurancePlan.
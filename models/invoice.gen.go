@@ -22,64 +22,168 @@ import "encoding/json"
 
 // Invoice is documented here http://hl7.org/fhir/StructureDefinition/Invoice
 type Invoice struct {
-	ID                  *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status              InvoiceStatus                   `bson:"status" json:"status"`
-	CancelledReason     *string                         `bson:"cancelledReason,omitempty" json:"cancelledReason,omitempty"`
-	Type                *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
-	Subject             *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
-	Recipient           *Reference                      `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Date                *DateTime                       `bson:"date,omitempty" json:"date,omitempty"`
-	Participant         []InvoiceParticipant            `bson:"participant,omitempty" json:"participant,omitempty"`
-	Issuer              *Reference                      `bson:"issuer,omitempty" json:"issuer,omitempty"`
-	Account             *Reference                      `bson:"account,omitempty" json:"account,omitempty"`
-	LineItem            []InvoiceLineItem               `bson:"lineItem,omitempty" json:"lineItem,omitempty"`
-	TotalPriceComponent []InvoiceLineItemPriceComponent `bson:"totalPriceComponent,omitempty" json:"totalPriceComponent,omitempty"`
-	TotalNet            *Money                          `bson:"totalNet,omitempty" json:"totalNet,omitempty"`
-	TotalGross          *Money                          `bson:"totalGross,omitempty" json:"totalGross,omitempty"`
-	PaymentTerms        *string                         `bson:"paymentTerms,omitempty" json:"paymentTerms,omitempty"`
-	Note                []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
+	ID                     *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                 InvoiceStatus                   `bson:"status" json:"status"`
+	StatusElement          *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	CancelledReason        *string                         `bson:"cancelledReason,omitempty" json:"cancelledReason,omitempty"`
+	CancelledReasonElement *Element                        `bson:"_cancelledReason,omitempty" json:"_cancelledReason,omitempty"`
+	Type                   *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
+	Subject                *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
+	Recipient              *Reference                      `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Date                   *DateTime                       `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                        `bson:"_date,omitempty" json:"_date,omitempty"`
+	Participant            []InvoiceParticipant            `bson:"participant,omitempty" json:"participant,omitempty"`
+	Issuer                 *Reference                      `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	Account                *Reference                      `bson:"account,omitempty" json:"account,omitempty"`
+	LineItem               []InvoiceLineItem               `bson:"lineItem,omitempty" json:"lineItem,omitempty"`
+	TotalPriceComponent    []InvoiceLineItemPriceComponent `bson:"totalPriceComponent,omitempty" json:"totalPriceComponent,omitempty"`
+	TotalNet               *Money                          `bson:"totalNet,omitempty" json:"totalNet,omitempty"`
+	TotalGross             *Money                          `bson:"totalGross,omitempty" json:"totalGross,omitempty"`
+	PaymentTerms           *string                         `bson:"paymentTerms,omitempty" json:"paymentTerms,omitempty"`
+	PaymentTermsElement    *Element                        `bson:"_paymentTerms,omitempty" json:"_paymentTerms,omitempty"`
+	Note                   []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields          map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type InvoiceParticipant struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Role              *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the InvoiceParticipant with its unknown fields.
+func (r InvoiceParticipant) MarshalJSON() ([]byte, error) {
+	type other InvoiceParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type InvoiceLineItem struct {
-	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          *int                            `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	PriceComponent    []InvoiceLineItemPriceComponent `bson:"priceComponent,omitempty" json:"priceComponent,omitempty"`
+	ID                        *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                  *int                            `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement           *Element                        `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	ChargeItemReference       *Reference                      `bson:"chargeItemReference,omitempty" json:"chargeItemReference,omitempty"`
+	ChargeItemCodeableConcept *CodeableConcept                `bson:"chargeItemCodeableConcept,omitempty" json:"chargeItemCodeableConcept,omitempty"`
+	PriceComponent            []InvoiceLineItemPriceComponent `bson:"priceComponent,omitempty" json:"priceComponent,omitempty"`
+	UnknownFields             map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type InvoiceLineItemPriceComponent struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              InvoicePriceComponentType `bson:"type" json:"type"`
-	Code              *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
-	Factor            *Decimal                  `bson:"factor,omitempty" json:"factor,omitempty"`
-	Amount            *Money                    `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              InvoicePriceComponentType  `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Factor            *Decimal                   `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Amount            *Money                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the InvoiceLineItemPriceComponent with its unknown fields.
+func (r InvoiceLineItemPriceComponent) MarshalJSON() ([]byte, error) {
+	type other InvoiceLineItemPriceComponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// ChargeItem returns Invoice.lineItem.chargeItem[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *InvoiceLineItem) ChargeItem() interface{} {
+	switch {
+	case r.ChargeItemReference != nil:
+		return r.ChargeItemReference
+	case r.ChargeItemCodeableConcept != nil:
+		return r.ChargeItemCodeableConcept
+	}
+	return nil
+}
+
+// SetChargeItemReference sets Invoice.lineItem.chargeItem[x] to the Reference and clears the other types.
+func (r *InvoiceLineItem) SetChargeItemReference(v Reference) {
+	r.clearChargeItem()
+	r.ChargeItemReference = &v
+}
+
+// SetChargeItemCodeableConcept sets Invoice.lineItem.chargeItem[x] to the CodeableConcept and clears the other types.
+func (r *InvoiceLineItem) SetChargeItemCodeableConcept(v CodeableConcept) {
+	r.clearChargeItem()
+	r.ChargeItemCodeableConcept = &v
 }
+func (r *InvoiceLineItem) clearChargeItem() {
+	r.ChargeItemReference = nil
+	r.ChargeItemCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the InvoiceLineItem and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *InvoiceLineItem) UnmarshalJSON(b []byte) error {
+	type other InvoiceLineItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Invoice.lineItem.chargeItem[x]",
+		r.ChargeItemReference != nil,
+		r.ChargeItemCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the InvoiceLineItem with its unknown fields.
+func (r InvoiceLineItem) MarshalJSON() ([]byte, error) {
+	type other InvoiceLineItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Invoice and fails with CodeError when a coded element has an unknown code.
+func (r *Invoice) UnmarshalJSON(b []byte) error {
+	type other Invoice
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Invoice", r)
+}
+
 type OtherInvoice Invoice
 
 // MarshalJSON marshals the given Invoice as JSON into a byte slice
 func (r Invoice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherInvoice
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherInvoice: OtherInvoice(r),
 		ResourceType: "Invoice",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalInvoice unmarshals a Invoice.
@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.244504 +0000 UTC
+// 2026-10-19 02:55:18.842163178 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // InvoicePriceComponentType is documented here http://hl7.org/fhir/ValueSet/invoice-priceComponentType
-type InvoicePriceComponentType int
+// The empty InvoicePriceComponentType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type InvoicePriceComponentType string
 
 const (
-	InvoicePriceComponentTypeBase InvoicePriceComponentType = iota
-	InvoicePriceComponentTypeSurcharge
-	InvoicePriceComponentTypeDeduction
-	InvoicePriceComponentTypeDiscount
-	InvoicePriceComponentTypeTax
-	InvoicePriceComponentTypeInformational
+	InvoicePriceComponentTypeBase          InvoicePriceComponentType = "base"
+	InvoicePriceComponentTypeSurcharge     InvoicePriceComponentType = "surcharge"
+	InvoicePriceComponentTypeDeduction     InvoicePriceComponentType = "deduction"
+	InvoicePriceComponentTypeDiscount      InvoicePriceComponentType = "discount"
+	InvoicePriceComponentTypeTax           InvoicePriceComponentType = "tax"
+	InvoicePriceComponentTypeInformational InvoicePriceComponentType = "informational"
 )
 
 func (code InvoicePriceComponentType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "InvoicePriceComponentType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *InvoicePriceComponentType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "base":
-		*code = InvoicePriceComponentTypeBase
-	case "surcharge":
-		*code = InvoicePriceComponentTypeSurcharge
-	case "deduction":
-		*code = InvoicePriceComponentTypeDeduction
-	case "discount":
-		*code = InvoicePriceComponentTypeDiscount
-	case "tax":
-		*code = InvoicePriceComponentTypeTax
-	case "informational":
-		*code = InvoicePriceComponentTypeInformational
-	default:
-		return fmt.Errorf("unknown InvoicePriceComponentType code `%s`", s)
+func (code *InvoicePriceComponentType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = InvoicePriceComponentType(s)
 	return nil
 }
 func (code InvoicePriceComponentType) String() string {
 	return code.Code()
 }
 func (code InvoicePriceComponentType) Code() string {
+	return string(code)
+}
+func (code InvoicePriceComponentType) Known() bool {
 	switch code {
-	case InvoicePriceComponentTypeBase:
-		return "base"
-	case InvoicePriceComponentTypeSurcharge:
-		return "surcharge"
-	case InvoicePriceComponentTypeDeduction:
-		return "deduction"
-	case InvoicePriceComponentTypeDiscount:
-		return "discount"
-	case InvoicePriceComponentTypeTax:
-		return "tax"
-	case InvoicePriceComponentTypeInformational:
-		return "informational"
+	case InvoicePriceComponentTypeBase, InvoicePriceComponentTypeSurcharge, InvoicePriceComponentTypeDeduction, InvoicePriceComponentTypeDiscount, InvoicePriceComponentTypeTax, InvoicePriceComponentTypeInformational:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code InvoicePriceComponentType) Display() string {
 	switch code {
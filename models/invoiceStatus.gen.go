@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.286413 +0000 UTC
+// 2026-10-19 02:55:18.843493071 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // InvoiceStatus is documented here http://hl7.org/fhir/ValueSet/invoice-status
-type InvoiceStatus int
+// The empty InvoiceStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type InvoiceStatus string
 
 const (
-	InvoiceStatusDraft InvoiceStatus = iota
-	InvoiceStatusIssued
-	InvoiceStatusBalanced
-	InvoiceStatusCancelled
-	InvoiceStatusEnteredInError
+	InvoiceStatusDraft          InvoiceStatus = "draft"
+	InvoiceStatusIssued         InvoiceStatus = "issued"
+	InvoiceStatusBalanced       InvoiceStatus = "balanced"
+	InvoiceStatusCancelled      InvoiceStatus = "cancelled"
+	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
 func (code InvoiceStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "InvoiceStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *InvoiceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "draft":
-		*code = InvoiceStatusDraft
-	case "issued":
-		*code = InvoiceStatusIssued
-	case "balanced":
-		*code = InvoiceStatusBalanced
-	case "cancelled":
-		*code = InvoiceStatusCancelled
-	case "entered-in-error":
-		*code = InvoiceStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown InvoiceStatus code `%s`", s)
+func (code *InvoiceStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = InvoiceStatus(s)
 	return nil
 }
 func (code InvoiceStatus) String() string {
 	return code.Code()
 }
 func (code InvoiceStatus) Code() string {
+	return string(code)
+}
+func (code InvoiceStatus) Known() bool {
 	switch code {
-	case InvoiceStatusDraft:
-		return "draft"
-	case InvoiceStatusIssued:
-		return "issued"
-	case InvoiceStatusBalanced:
-		return "balanced"
-	case InvoiceStatusCancelled:
-		return "cancelled"
-	case InvoiceStatusEnteredInError:
-		return "entered-in-error"
+	case InvoiceStatusDraft, InvoiceStatusIssued, InvoiceStatusBalanced, InvoiceStatusCancelled, InvoiceStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code InvoiceStatus) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.295031 +0000 UTC
+// 2026-10-19 02:55:18.844552914 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // IssueSeverity is documented here http://hl7.org/fhir/ValueSet/issue-severity
-type IssueSeverity int
+// The empty IssueSeverity is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type IssueSeverity string
 
 const (
-	IssueSeverityFatal IssueSeverity = iota
-	IssueSeverityError
-	IssueSeverityWarning
-	IssueSeverityInformation
+	IssueSeverityFatal       IssueSeverity = "fatal"
+	IssueSeverityError       IssueSeverity = "error"
+	IssueSeverityWarning     IssueSeverity = "warning"
+	IssueSeverityInformation IssueSeverity = "information"
 )
 
 func (code IssueSeverity) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "IssueSeverity"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *IssueSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "fatal":
-		*code = IssueSeverityFatal
-	case "error":
-		*code = IssueSeverityError
-	case "warning":
-		*code = IssueSeverityWarning
-	case "information":
-		*code = IssueSeverityInformation
-	default:
-		return fmt.Errorf("unknown IssueSeverity code `%s`", s)
+func (code *IssueSeverity) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = IssueSeverity(s)
 	return nil
 }
 func (code IssueSeverity) String() string {
 	return code.Code()
 }
 func (code IssueSeverity) Code() string {
+	return string(code)
+}
+func (code IssueSeverity) Known() bool {
 	switch code {
-	case IssueSeverityFatal:
-		return "fatal"
-	case IssueSeverityError:
-		return "error"
-	case IssueSeverityWarning:
-		return "warning"
-	case IssueSeverityInformation:
-		return "information"
+	case IssueSeverityFatal, IssueSeverityError, IssueSeverityWarning, IssueSeverityInformation:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code IssueSeverity) Display() string {
 	switch code {
@@ -14,195 +14,80 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.209628 +0000 UTC
+// 2026-10-19 02:55:18.845766641 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // IssueType is documented here http://hl7.org/fhir/ValueSet/issue-type
-type IssueType int
+// The empty IssueType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type IssueType string
 
 const (
-	IssueTypeInvalid IssueType = iota
-	IssueTypeStructure
-	IssueTypeRequired
-	IssueTypeValue
-	IssueTypeInvariant
-	IssueTypeSecurity
-	IssueTypeLogin
-	IssueTypeUnknown
-	IssueTypeExpired
-	IssueTypeForbidden
-	IssueTypeSuppressed
-	IssueTypeProcessing
-	IssueTypeNotSupported
-	IssueTypeDuplicate
-	IssueTypeMultipleMatches
-	IssueTypeNotFound
-	IssueTypeDeleted
-	IssueTypeTooLong
-	IssueTypeCodeInvalid
-	IssueTypeExtension
-	IssueTypeTooCostly
-	IssueTypeBusinessRule
-	IssueTypeConflict
-	IssueTypeTransient
-	IssueTypeLockError
-	IssueTypeNoStore
-	IssueTypeException
-	IssueTypeTimeout
-	IssueTypeIncomplete
-	IssueTypeThrottled
-	IssueTypeInformational
+	IssueTypeInvalid         IssueType = "invalid"
+	IssueTypeStructure       IssueType = "structure"
+	IssueTypeRequired        IssueType = "required"
+	IssueTypeValue           IssueType = "value"
+	IssueTypeInvariant       IssueType = "invariant"
+	IssueTypeSecurity        IssueType = "security"
+	IssueTypeLogin           IssueType = "login"
+	IssueTypeUnknown         IssueType = "unknown"
+	IssueTypeExpired         IssueType = "expired"
+	IssueTypeForbidden       IssueType = "forbidden"
+	IssueTypeSuppressed      IssueType = "suppressed"
+	IssueTypeProcessing      IssueType = "processing"
+	IssueTypeNotSupported    IssueType = "not-supported"
+	IssueTypeDuplicate       IssueType = "duplicate"
+	IssueTypeMultipleMatches IssueType = "multiple-matches"
+	IssueTypeNotFound        IssueType = "not-found"
+	IssueTypeDeleted         IssueType = "deleted"
+	IssueTypeTooLong         IssueType = "too-long"
+	IssueTypeCodeInvalid     IssueType = "code-invalid"
+	IssueTypeExtension       IssueType = "extension"
+	IssueTypeTooCostly       IssueType = "too-costly"
+	IssueTypeBusinessRule    IssueType = "business-rule"
+	IssueTypeConflict        IssueType = "conflict"
+	IssueTypeTransient       IssueType = "transient"
+	IssueTypeLockError       IssueType = "lock-error"
+	IssueTypeNoStore         IssueType = "no-store"
+	IssueTypeException       IssueType = "exception"
+	IssueTypeTimeout         IssueType = "timeout"
+	IssueTypeIncomplete      IssueType = "incomplete"
+	IssueTypeThrottled       IssueType = "throttled"
+	IssueTypeInformational   IssueType = "informational"
 )
 
 func (code IssueType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "IssueType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *IssueType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "invalid":
-		*code = IssueTypeInvalid
-	case "structure":
-		*code = IssueTypeStructure
-	case "required":
-		*code = IssueTypeRequired
-	case "value":
-		*code = IssueTypeValue
-	case "invariant":
-		*code = IssueTypeInvariant
-	case "security":
-		*code = IssueTypeSecurity
-	case "login":
-		*code = IssueTypeLogin
-	case "unknown":
-		*code = IssueTypeUnknown
-	case "expired":
-		*code = IssueTypeExpired
-	case "forbidden":
-		*code = IssueTypeForbidden
-	case "suppressed":
-		*code = IssueTypeSuppressed
-	case "processing":
-		*code = IssueTypeProcessing
-	case "not-supported":
-		*code = IssueTypeNotSupported
-	case "duplicate":
-		*code = IssueTypeDuplicate
-	case "multiple-matches":
-		*code = IssueTypeMultipleMatches
-	case "not-found":
-		*code = IssueTypeNotFound
-	case "deleted":
-		*code = IssueTypeDeleted
-	case "too-long":
-		*code = IssueTypeTooLong
-	case "code-invalid":
-		*code = IssueTypeCodeInvalid
-	case "extension":
-		*code = IssueTypeExtension
-	case "too-costly":
-		*code = IssueTypeTooCostly
-	case "business-rule":
-		*code = IssueTypeBusinessRule
-	case "conflict":
-		*code = IssueTypeConflict
-	case "transient":
-		*code = IssueTypeTransient
-	case "lock-error":
-		*code = IssueTypeLockError
-	case "no-store":
-		*code = IssueTypeNoStore
-	case "exception":
-		*code = IssueTypeException
-	case "timeout":
-		*code = IssueTypeTimeout
-	case "incomplete":
-		*code = IssueTypeIncomplete
-	case "throttled":
-		*code = IssueTypeThrottled
-	case "informational":
-		*code = IssueTypeInformational
-	default:
-		return fmt.Errorf("unknown IssueType code `%s`", s)
+func (code *IssueType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = IssueType(s)
 	return nil
 }
 func (code IssueType) String() string {
 	return code.Code()
 }
 func (code IssueType) Code() string {
+	return string(code)
+}
+func (code IssueType) Known() bool {
 	switch code {
-	case IssueTypeInvalid:
-		return "invalid"
-	case IssueTypeStructure:
-		return "structure"
-	case IssueTypeRequired:
-		return "required"
-	case IssueTypeValue:
-		return "value"
-	case IssueTypeInvariant:
-		return "invariant"
-	case IssueTypeSecurity:
-		return "security"
-	case IssueTypeLogin:
-		return "login"
-	case IssueTypeUnknown:
-		return "unknown"
-	case IssueTypeExpired:
-		return "expired"
-	case IssueTypeForbidden:
-		return "forbidden"
-	case IssueTypeSuppressed:
-		return "suppressed"
-	case IssueTypeProcessing:
-		return "processing"
-	case IssueTypeNotSupported:
-		return "not-supported"
-	case IssueTypeDuplicate:
-		return "duplicate"
-	case IssueTypeMultipleMatches:
-		return "multiple-matches"
-	case IssueTypeNotFound:
-		return "not-found"
-	case IssueTypeDeleted:
-		return "deleted"
-	case IssueTypeTooLong:
-		return "too-long"
-	case IssueTypeCodeInvalid:
-		return "code-invalid"
-	case IssueTypeExtension:
-		return "extension"
-	case IssueTypeTooCostly:
-		return "too-costly"
-	case IssueTypeBusinessRule:
-		return "business-rule"
-	case IssueTypeConflict:
-		return "conflict"
-	case IssueTypeTransient:
-		return "transient"
-	case IssueTypeLockError:
-		return "lock-error"
-	case IssueTypeNoStore:
-		return "no-store"
-	case IssueTypeException:
-		return "exception"
-	case IssueTypeTimeout:
-		return "timeout"
-	case IssueTypeIncomplete:
-		return "incomplete"
-	case IssueTypeThrottled:
-		return "throttled"
-	case IssueTypeInformational:
-		return "informational"
+	case IssueTypeInvalid, IssueTypeStructure, IssueTypeRequired, IssueTypeValue, IssueTypeInvariant, IssueTypeSecurity, IssueTypeLogin, IssueTypeUnknown, IssueTypeExpired, IssueTypeForbidden, IssueTypeSuppressed, IssueTypeProcessing, IssueTypeNotSupported, IssueTypeDuplicate, IssueTypeMultipleMatches, IssueTypeNotFound, IssueTypeDeleted, IssueTypeTooLong, IssueTypeCodeInvalid, IssueTypeExtension, IssueTypeTooCostly, IssueTypeBusinessRule, IssueTypeConflict, IssueTypeTransient, IssueTypeLockError, IssueTypeNoStore, IssueTypeException, IssueTypeTimeout, IssueTypeIncomplete, IssueTypeThrottled, IssueTypeInformational:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code IssueType) Display() string {
 	switch code {
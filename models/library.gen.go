@@ -22,55 +22,124 @@ import "encoding/json"
 
 // Library is documented here http://hl7.org/fhir/StructureDefinition/Library
 type Library struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string               `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string               `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string               `bson:"title,omitempty" json:"title,omitempty"`
-	Subtitle          *string               `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status            PublicationStatus     `bson:"status" json:"status"`
-	Experimental      *bool                 `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Type              CodeableConcept       `bson:"type" json:"type"`
-	Date              *DateTime             `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string               `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string               `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext        `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept     `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string               `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Usage             *string               `bson:"usage,omitempty" json:"usage,omitempty"`
-	Copyright         *string               `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime             `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime             `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period               `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept     `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail       `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail       `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail       `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail       `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact     `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Parameter         []ParameterDefinition `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	DataRequirement   []DataRequirement     `bson:"dataRequirement,omitempty" json:"dataRequirement,omitempty"`
-	Content           []Attachment          `bson:"content,omitempty" json:"content,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                    *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement             *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                  *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Subtitle               *string                    `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element                   `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                 PublicationStatus          `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental           *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Type                   CodeableConcept            `bson:"type" json:"type"`
+	SubjectCodeableConcept *CodeableConcept           `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference       *Reference                 `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Date                   *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher              *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description            *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext             []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction           []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Usage                  *string                    `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element                   `bson:"_usage,omitempty" json:"_usage,omitempty"`
+	Copyright              *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate           *DateTime                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate         *DateTime                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod        *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                  []CodeableConcept          `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                 []ContactDetail            `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                 []ContactDetail            `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer               []ContactDetail            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser               []ContactDetail            `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact        []RelatedArtifact          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Parameter              []ParameterDefinition      `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	DataRequirement        []DataRequirement          `bson:"dataRequirement,omitempty" json:"dataRequirement,omitempty"`
+	Content                []Attachment               `bson:"content,omitempty" json:"content,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Subject returns Library.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *Library) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets Library.subject[x] to the CodeableConcept and clears the other types.
+func (r *Library) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets Library.subject[x] to the Reference and clears the other types.
+func (r *Library) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *Library) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// UnmarshalJSON unmarshals the Library and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Library) UnmarshalJSON(b []byte) error {
+	type other Library
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Library.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Library", r)
+}
+
 type OtherLibrary Library
 
 // MarshalJSON marshals the given Library as JSON into a byte slice
 func (r Library) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherLibrary
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherLibrary: OtherLibrary(r),
 		ResourceType: "Library",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalLibrary unmarshals a Library.
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.217324 +0000 UTC
+// 2026-10-19 02:55:18.848096784 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // LinkType is documented here http://hl7.org/fhir/ValueSet/link-type
-type LinkType int
+// The empty LinkType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type LinkType string
 
 const (
-	LinkTypeReplacedBy LinkType = iota
-	LinkTypeReplaces
-	LinkTypeRefer
-	LinkTypeSeealso
+	LinkTypeReplacedBy LinkType = "replaced-by"
+	LinkTypeReplaces   LinkType = "replaces"
+	LinkTypeRefer      LinkType = "refer"
+	LinkTypeSeealso    LinkType = "seealso"
 )
 
 func (code LinkType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "LinkType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *LinkType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "replaced-by":
-		*code = LinkTypeReplacedBy
-	case "replaces":
-		*code = LinkTypeReplaces
-	case "refer":
-		*code = LinkTypeRefer
-	case "seealso":
-		*code = LinkTypeSeealso
-	default:
-		return fmt.Errorf("unknown LinkType code `%s`", s)
+func (code *LinkType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = LinkType(s)
 	return nil
 }
 func (code LinkType) String() string {
 	return code.Code()
 }
 func (code LinkType) Code() string {
+	return string(code)
+}
+func (code LinkType) Known() bool {
 	switch code {
-	case LinkTypeReplacedBy:
-		return "replaced-by"
-	case LinkTypeReplaces:
-		return "replaces"
-	case LinkTypeRefer:
-		return "refer"
-	case LinkTypeSeealso:
-		return "seealso"
+	case LinkTypeReplacedBy, LinkTypeReplaces, LinkTypeRefer, LinkTypeSeealso:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code LinkType) Display() string {
 	switch code {
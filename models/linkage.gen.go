@@ -22,35 +22,66 @@ import "encoding/json"
 
 // Linkage is documented here http://hl7.org/fhir/StructureDefinition/Linkage
 type Linkage struct {
-	ID                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Active            *bool         `bson:"active,omitempty" json:"active,omitempty"`
-	Author            *Reference    `bson:"author,omitempty" json:"author,omitempty"`
-	Item              []LinkageItem `bson:"item" json:"item"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Active               *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Author               *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	Item                 []LinkageItem              `bson:"item" json:"item"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type LinkageItem struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              LinkageType `bson:"type" json:"type"`
-	Resource          Reference   `bson:"resource" json:"resource"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              LinkageType                `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Resource          Reference                  `bson:"resource" json:"resource"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the LinkageItem with its unknown fields.
+func (r LinkageItem) MarshalJSON() ([]byte, error) {
+	type other LinkageItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Linkage and fails with CodeError when a coded element has an unknown code.
+func (r *Linkage) UnmarshalJSON(b []byte) error {
+	type other Linkage
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Linkage", r)
+}
+
 type OtherLinkage Linkage
 
 // MarshalJSON marshals the given Linkage as JSON into a byte slice
 func (r Linkage) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherLinkage
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherLinkage: OtherLinkage(r),
 		ResourceType: "Linkage",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalLinkage unmarshals a Linkage.
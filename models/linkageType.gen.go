@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.084868 +0000 UTC
+// 2026-10-19 02:55:18.84921483 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // LinkageType is documented here http://hl7.org/fhir/ValueSet/linkage-type
-type LinkageType int
+// The empty LinkageType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type LinkageType string
 
 const (
-	LinkageTypeSource LinkageType = iota
-	LinkageTypeAlternate
-	LinkageTypeHistorical
+	LinkageTypeSource     LinkageType = "source"
+	LinkageTypeAlternate  LinkageType = "alternate"
+	LinkageTypeHistorical LinkageType = "historical"
 )
 
 func (code LinkageType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "LinkageType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *LinkageType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "source":
-		*code = LinkageTypeSource
-	case "alternate":
-		*code = LinkageTypeAlternate
-	case "historical":
-		*code = LinkageTypeHistorical
-	default:
-		return fmt.Errorf("unknown LinkageType code `%s`", s)
+func (code *LinkageType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = LinkageType(s)
 	return nil
 }
 func (code LinkageType) String() string {
 	return code.Code()
 }
 func (code LinkageType) Code() string {
+	return string(code)
+}
+func (code LinkageType) Known() bool {
 	switch code {
-	case LinkageTypeSource:
-		return "source"
-	case LinkageTypeAlternate:
-		return "alternate"
-	case LinkageTypeHistorical:
-		return "historical"
+	case LinkageTypeSource, LinkageTypeAlternate, LinkageTypeHistorical:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code LinkageType) Display() string {
 	switch code {
@@ -22,47 +22,82 @@ import "encoding/json"
 
 // List is documented here http://hl7.org/fhir/StructureDefinition/List
 type List struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string          `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ListStatus       `bson:"status" json:"status"`
-	Mode              ListMode         `bson:"mode" json:"mode"`
-	Title             *string          `bson:"title,omitempty" json:"title,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Subject           *Reference       `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter         *Reference       `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	Source            *Reference       `bson:"source,omitempty" json:"source,omitempty"`
-	OrderedBy         *CodeableConcept `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
-	Note              []Annotation     `bson:"note,omitempty" json:"note,omitempty"`
-	Entry             []ListEntry      `bson:"entry,omitempty" json:"entry,omitempty"`
-	EmptyReason       *CodeableConcept `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               ListStatus                 `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Mode                 ListMode                   `bson:"mode" json:"mode"`
+	ModeElement          *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Title                *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Code                 *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter            *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Source               *Reference                 `bson:"source,omitempty" json:"source,omitempty"`
+	OrderedBy            *CodeableConcept           `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
+	Note                 []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Entry                []ListEntry                `bson:"entry,omitempty" json:"entry,omitempty"`
+	EmptyReason          *CodeableConcept           `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ListEntry struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Flag              *CodeableConcept `bson:"flag,omitempty" json:"flag,omitempty"`
-	Deleted           *bool            `bson:"deleted,omitempty" json:"deleted,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	Item              Reference        `bson:"item" json:"item"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Flag              *CodeableConcept           `bson:"flag,omitempty" json:"flag,omitempty"`
+	Deleted           *bool                      `bson:"deleted,omitempty" json:"deleted,omitempty"`
+	DeletedElement    *Element                   `bson:"_deleted,omitempty" json:"_deleted,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Item              Reference                  `bson:"item" json:"item"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ListEntry with its unknown fields.
+func (r ListEntry) MarshalJSON() ([]byte, error) {
+	type other ListEntry
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the List and fails with CodeError when a coded element has an unknown code.
+func (r *List) UnmarshalJSON(b []byte) error {
+	type other List
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("List", r)
+}
+
 type OtherList List
 
 // MarshalJSON marshals the given List as JSON into a byte slice
 func (r List) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherList
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherList:    OtherList(r),
 		ResourceType: "List",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalList unmarshals a List.
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.213267 +0000 UTC
+// 2026-10-19 02:55:18.850168042 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ListMode is documented here http://hl7.org/fhir/ValueSet/list-mode
-type ListMode int
+// The empty ListMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ListMode string
 
 const (
-	ListModeWorking ListMode = iota
-	ListModeSnapshot
-	ListModeChanges
+	ListModeWorking  ListMode = "working"
+	ListModeSnapshot ListMode = "snapshot"
+	ListModeChanges  ListMode = "changes"
 )
 
 func (code ListMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ListMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ListMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "working":
-		*code = ListModeWorking
-	case "snapshot":
-		*code = ListModeSnapshot
-	case "changes":
-		*code = ListModeChanges
-	default:
-		return fmt.Errorf("unknown ListMode code `%s`", s)
+func (code *ListMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ListMode(s)
 	return nil
 }
 func (code ListMode) String() string {
 	return code.Code()
 }
 func (code ListMode) Code() string {
+	return string(code)
+}
+func (code ListMode) Known() bool {
 	switch code {
-	case ListModeWorking:
-		return "working"
-	case ListModeSnapshot:
-		return "snapshot"
-	case ListModeChanges:
-		return "changes"
+	case ListModeWorking, ListModeSnapshot, ListModeChanges:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ListMode) Display() string {
 	switch code {
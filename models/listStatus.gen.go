@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.237474 +0000 UTC
+// 2026-10-19 02:55:18.851995001 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ListStatus is documented here http://hl7.org/fhir/ValueSet/list-status
-type ListStatus int
+// The empty ListStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ListStatus string
 
 const (
-	ListStatusCurrent ListStatus = iota
-	ListStatusRetired
-	ListStatusEnteredInError
+	ListStatusCurrent        ListStatus = "current"
+	ListStatusRetired        ListStatus = "retired"
+	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
 func (code ListStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ListStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ListStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "current":
-		*code = ListStatusCurrent
-	case "retired":
-		*code = ListStatusRetired
-	case "entered-in-error":
-		*code = ListStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown ListStatus code `%s`", s)
+func (code *ListStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ListStatus(s)
 	return nil
 }
 func (code ListStatus) String() string {
 	return code.Code()
 }
 func (code ListStatus) Code() string {
+	return string(code)
+}
+func (code ListStatus) Known() bool {
 	switch code {
-	case ListStatusCurrent:
-		return "current"
-	case ListStatusRetired:
-		return "retired"
-	case ListStatusEnteredInError:
-		return "entered-in-error"
+	case ListStatusCurrent, ListStatusRetired, ListStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ListStatus) Display() string {
 	switch code {
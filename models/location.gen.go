@@ -22,59 +22,128 @@ import "encoding/json"
 
 // Location is documented here http://hl7.org/fhir/StructureDefinition/Location
 type Location struct {
-	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                 *LocationStatus            `bson:"status,omitempty" json:"status,omitempty"`
-	OperationalStatus      *Coding                    `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
-	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
-	Alias                  []string                   `bson:"alias,omitempty" json:"alias,omitempty"`
-	Description            *string                    `bson:"description,omitempty" json:"description,omitempty"`
-	Mode                   *LocationMode              `bson:"mode,omitempty" json:"mode,omitempty"`
-	Type                   []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
-	Telecom                []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address                *Address                   `bson:"address,omitempty" json:"address,omitempty"`
-	PhysicalType           *CodeableConcept           `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
-	Position               *LocationPosition          `bson:"position,omitempty" json:"position,omitempty"`
-	ManagingOrganization   *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	PartOf                 *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	HoursOfOperation       []LocationHoursOfOperation `bson:"hoursOfOperation,omitempty" json:"hoursOfOperation,omitempty"`
-	AvailabilityExceptions *string                    `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
-	Endpoint               []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ID                            *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                          *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                        *LocationStatus            `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement                 *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	OperationalStatus             *Coding                    `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
+	Name                          *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                   *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Alias                         []*string                  `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement                  []*Element                 `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Description                   *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement            *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Mode                          *LocationMode              `bson:"mode,omitempty" json:"mode,omitempty"`
+	ModeElement                   *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Type                          []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Telecom                       []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address                       *Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	PhysicalType                  *CodeableConcept           `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
+	Position                      *LocationPosition          `bson:"position,omitempty" json:"position,omitempty"`
+	ManagingOrganization          *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	PartOf                        *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	HoursOfOperation              []LocationHoursOfOperation `bson:"hoursOfOperation,omitempty" json:"hoursOfOperation,omitempty"`
+	AvailabilityExceptions        *string                    `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *Element                   `bson:"_availabilityExceptions,omitempty" json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	UnknownFields                 map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type LocationPosition struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Longitude         Decimal     `bson:"longitude" json:"longitude"`
-	Latitude          Decimal     `bson:"latitude" json:"latitude"`
-	Altitude          *Decimal    `bson:"altitude,omitempty" json:"altitude,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Longitude         Decimal                    `bson:"longitude" json:"longitude"`
+	LongitudeElement  *Element                   `bson:"_longitude,omitempty" json:"_longitude,omitempty"`
+	Latitude          Decimal                    `bson:"latitude" json:"latitude"`
+	LatitudeElement   *Element                   `bson:"_latitude,omitempty" json:"_latitude,omitempty"`
+	Altitude          *Decimal                   `bson:"altitude,omitempty" json:"altitude,omitempty"`
+	AltitudeElement   *Element                   `bson:"_altitude,omitempty" json:"_altitude,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the LocationPosition with its unknown fields.
+func (r LocationPosition) MarshalJSON() ([]byte, error) {
+	type other LocationPosition
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type LocationHoursOfOperation struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DaysOfWeek        []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
-	AllDay            *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
-	OpeningTime       *Time        `bson:"openingTime,omitempty" json:"openingTime,omitempty"`
-	ClosingTime       *Time        `bson:"closingTime,omitempty" json:"closingTime,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DaysOfWeek         []*DaysOfWeek              `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement  []*Element                 `bson:"_daysOfWeek,omitempty" json:"_daysOfWeek,omitempty"`
+	AllDay             *bool                      `bson:"allDay,omitempty" json:"allDay,omitempty"`
+	AllDayElement      *Element                   `bson:"_allDay,omitempty" json:"_allDay,omitempty"`
+	OpeningTime        *Time                      `bson:"openingTime,omitempty" json:"openingTime,omitempty"`
+	OpeningTimeElement *Element                   `bson:"_openingTime,omitempty" json:"_openingTime,omitempty"`
+	ClosingTime        *Time                      `bson:"closingTime,omitempty" json:"closingTime,omitempty"`
+	ClosingTimeElement *Element                   `bson:"_closingTime,omitempty" json:"_closingTime,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the LocationHoursOfOperation and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *LocationHoursOfOperation) UnmarshalJSON(b []byte) error {
+	type other LocationHoursOfOperation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Location.hoursOfOperation.daysOfWeek", len(r.DaysOfWeek), len(r.DaysOfWeekElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the LocationHoursOfOperation with its unknown fields.
+func (r LocationHoursOfOperation) MarshalJSON() ([]byte, error) {
+	type other LocationHoursOfOperation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Location and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Location) UnmarshalJSON(b []byte) error {
+	type other Location
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Location.alias", len(r.Alias), len(r.AliasElement)); err != nil {
+		return err
+	}
+	return checkCodes("Location", r)
+}
+
 type OtherLocation Location
 
 // MarshalJSON marshals the given Location as JSON into a byte slice
 func (r Location) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherLocation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherLocation: OtherLocation(r),
 		ResourceType:  "Location",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalLocation unmarshals a Location.
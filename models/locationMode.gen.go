@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.299203 +0000 UTC
+// 2026-10-19 02:55:18.853902256 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // LocationMode is documented here http://hl7.org/fhir/ValueSet/location-mode
-type LocationMode int
+// The empty LocationMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type LocationMode string
 
 const (
-	LocationModeInstance LocationMode = iota
-	LocationModeKind
+	LocationModeInstance LocationMode = "instance"
+	LocationModeKind     LocationMode = "kind"
 )
 
 func (code LocationMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "LocationMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *LocationMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "instance":
-		*code = LocationModeInstance
-	case "kind":
-		*code = LocationModeKind
-	default:
-		return fmt.Errorf("unknown LocationMode code `%s`", s)
+func (code *LocationMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = LocationMode(s)
 	return nil
 }
 func (code LocationMode) String() string {
 	return code.Code()
 }
 func (code LocationMode) Code() string {
+	return string(code)
+}
+func (code LocationMode) Known() bool {
 	switch code {
-	case LocationModeInstance:
-		return "instance"
-	case LocationModeKind:
-		return "kind"
+	case LocationModeInstance, LocationModeKind:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code LocationMode) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.074055 +0000 UTC
+// 2026-10-19 02:55:18.854852057 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // LocationStatus is documented here http://hl7.org/fhir/ValueSet/location-status
-type LocationStatus int
+// The empty LocationStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type LocationStatus string
 
 const (
-	LocationStatusActive LocationStatus = iota
-	LocationStatusSuspended
-	LocationStatusInactive
+	LocationStatusActive    LocationStatus = "active"
+	LocationStatusSuspended LocationStatus = "suspended"
+	LocationStatusInactive  LocationStatus = "inactive"
 )
 
 func (code LocationStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "LocationStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *LocationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = LocationStatusActive
-	case "suspended":
-		*code = LocationStatusSuspended
-	case "inactive":
-		*code = LocationStatusInactive
-	default:
-		return fmt.Errorf("unknown LocationStatus code `%s`", s)
+func (code *LocationStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = LocationStatus(s)
 	return nil
 }
 func (code LocationStatus) String() string {
 	return code.Code()
 }
 func (code LocationStatus) Code() string {
+	return string(code)
+}
+func (code LocationStatus) Known() bool {
 	switch code {
-	case LocationStatusActive:
-		return "active"
-	case LocationStatusSuspended:
-		return "suspended"
-	case LocationStatusInactive:
-		return "inactive"
+	case LocationStatusActive, LocationStatusSuspended, LocationStatusInactive:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code LocationStatus) Display() string {
 	switch code {
@@ -20,12 +20,13 @@ package models
 
 // MarketingStatus is documented here http://hl7.org/fhir/StructureDefinition/MarketingStatus
 type MarketingStatus struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Country           CodeableConcept  `bson:"country" json:"country"`
-	Jurisdiction      *CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Status            CodeableConcept  `bson:"status" json:"status"`
-	DateRange         Period           `bson:"dateRange" json:"dateRange"`
-	RestoreDate       *DateTime        `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
+	ID                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Country            CodeableConcept  `bson:"country" json:"country"`
+	Jurisdiction       *CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Status             CodeableConcept  `bson:"status" json:"status"`
+	DateRange          Period           `bson:"dateRange" json:"dateRange"`
+	RestoreDate        *DateTime        `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
+	RestoreDateElement *Element         `bson:"_restoreDate,omitempty" json:"_restoreDate,omitempty"`
 }
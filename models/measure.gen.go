@@ -22,108 +22,253 @@ import "encoding/json"
 
 // Measure is documented here http://hl7.org/fhir/StructureDefinition/Measure
 type Measure struct {
-	ID                              *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                            *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules                   *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                        *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text                            *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                       []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension               []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL                             *string                   `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier                      []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version                         *string                   `bson:"version,omitempty" json:"version,omitempty"`
-	Name                            *string                   `bson:"name,omitempty" json:"name,omitempty"`
-	Title                           *string                   `bson:"title,omitempty" json:"title,omitempty"`
-	Subtitle                        *string                   `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status                          PublicationStatus         `bson:"status" json:"status"`
-	Experimental                    *bool                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date                            *DateTime                 `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher                       *string                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact                         []ContactDetail           `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description                     *string                   `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext                      []UsageContext            `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction                    []CodeableConcept         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose                         *string                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Usage                           *string                   `bson:"usage,omitempty" json:"usage,omitempty"`
-	Copyright                       *string                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate                    *DateTime                 `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate                  *DateTime                 `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod                 *Period                   `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic                           []CodeableConcept         `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author                          []ContactDetail           `bson:"author,omitempty" json:"author,omitempty"`
-	Editor                          []ContactDetail           `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer                        []ContactDetail           `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser                        []ContactDetail           `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact                 []RelatedArtifact         `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Library                         []string                  `bson:"library,omitempty" json:"library,omitempty"`
-	Disclaimer                      *string                   `bson:"disclaimer,omitempty" json:"disclaimer,omitempty"`
-	Scoring                         *CodeableConcept          `bson:"scoring,omitempty" json:"scoring,omitempty"`
-	CompositeScoring                *CodeableConcept          `bson:"compositeScoring,omitempty" json:"compositeScoring,omitempty"`
-	Type                            []CodeableConcept         `bson:"type,omitempty" json:"type,omitempty"`
-	RiskAdjustment                  *string                   `bson:"riskAdjustment,omitempty" json:"riskAdjustment,omitempty"`
-	RateAggregation                 *string                   `bson:"rateAggregation,omitempty" json:"rateAggregation,omitempty"`
-	Rationale                       *string                   `bson:"rationale,omitempty" json:"rationale,omitempty"`
-	ClinicalRecommendationStatement *string                   `bson:"clinicalRecommendationStatement,omitempty" json:"clinicalRecommendationStatement,omitempty"`
-	ImprovementNotation             *CodeableConcept          `bson:"improvementNotation,omitempty" json:"improvementNotation,omitempty"`
-	Definition                      []string                  `bson:"definition,omitempty" json:"definition,omitempty"`
-	Guidance                        *string                   `bson:"guidance,omitempty" json:"guidance,omitempty"`
-	Group                           []MeasureGroup            `bson:"group,omitempty" json:"group,omitempty"`
-	SupplementalData                []MeasureSupplementalData `bson:"supplementalData,omitempty" json:"supplementalData,omitempty"`
+	ID                                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement                   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement                        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                                    *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement                             *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier                             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                                *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement                         *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                            *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                                  *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement                           *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Subtitle                               *string                    `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement                        *Element                   `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                                 PublicationStatus          `bson:"status" json:"status"`
+	StatusElement                          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental                           *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement                    *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectCodeableConcept                 *CodeableConcept           `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference                       *Reference                 `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Date                                   *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                            *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher                              *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement                       *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                                []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description                            *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement                     *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext                             []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction                           []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                                *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement                         *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Usage                                  *string                    `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement                           *Element                   `bson:"_usage,omitempty" json:"_usage,omitempty"`
+	Copyright                              *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement                       *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate                           *DateTime                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement                    *Element                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate                         *DateTime                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement                  *Element                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod                        *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                                  []CodeableConcept          `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                                 []ContactDetail            `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                                 []ContactDetail            `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer                               []ContactDetail            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser                               []ContactDetail            `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact                        []RelatedArtifact          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Library                                []*string                  `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement                         []*Element                 `bson:"_library,omitempty" json:"_library,omitempty"`
+	Disclaimer                             *string                    `bson:"disclaimer,omitempty" json:"disclaimer,omitempty"`
+	DisclaimerElement                      *Element                   `bson:"_disclaimer,omitempty" json:"_disclaimer,omitempty"`
+	Scoring                                *CodeableConcept           `bson:"scoring,omitempty" json:"scoring,omitempty"`
+	CompositeScoring                       *CodeableConcept           `bson:"compositeScoring,omitempty" json:"compositeScoring,omitempty"`
+	Type                                   []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	RiskAdjustment                         *string                    `bson:"riskAdjustment,omitempty" json:"riskAdjustment,omitempty"`
+	RiskAdjustmentElement                  *Element                   `bson:"_riskAdjustment,omitempty" json:"_riskAdjustment,omitempty"`
+	RateAggregation                        *string                    `bson:"rateAggregation,omitempty" json:"rateAggregation,omitempty"`
+	RateAggregationElement                 *Element                   `bson:"_rateAggregation,omitempty" json:"_rateAggregation,omitempty"`
+	Rationale                              *string                    `bson:"rationale,omitempty" json:"rationale,omitempty"`
+	RationaleElement                       *Element                   `bson:"_rationale,omitempty" json:"_rationale,omitempty"`
+	ClinicalRecommendationStatement        *string                    `bson:"clinicalRecommendationStatement,omitempty" json:"clinicalRecommendationStatement,omitempty"`
+	ClinicalRecommendationStatementElement *Element                   `bson:"_clinicalRecommendationStatement,omitempty" json:"_clinicalRecommendationStatement,omitempty"`
+	ImprovementNotation                    *CodeableConcept           `bson:"improvementNotation,omitempty" json:"improvementNotation,omitempty"`
+	Definition                             []*string                  `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement                      []*Element                 `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Guidance                               *string                    `bson:"guidance,omitempty" json:"guidance,omitempty"`
+	GuidanceElement                        *Element                   `bson:"_guidance,omitempty" json:"_guidance,omitempty"`
+	Group                                  []MeasureGroup             `bson:"group,omitempty" json:"group,omitempty"`
+	SupplementalData                       []MeasureSupplementalData  `bson:"supplementalData,omitempty" json:"supplementalData,omitempty"`
+	UnknownFields                          map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type MeasureGroup struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept         `bson:"code,omitempty" json:"code,omitempty"`
-	Description       *string                  `bson:"description,omitempty" json:"description,omitempty"`
-	Population        []MeasureGroupPopulation `bson:"population,omitempty" json:"population,omitempty"`
-	Stratifier        []MeasureGroupStratifier `bson:"stratifier,omitempty" json:"stratifier,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Population         []MeasureGroupPopulation   `bson:"population,omitempty" json:"population,omitempty"`
+	Stratifier         []MeasureGroupStratifier   `bson:"stratifier,omitempty" json:"stratifier,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type MeasureGroupPopulation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Criteria          Expression       `bson:"criteria" json:"criteria"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Criteria           Expression                 `bson:"criteria" json:"criteria"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MeasureGroupPopulation with its unknown fields.
+func (r MeasureGroupPopulation) MarshalJSON() ([]byte, error) {
+	type other MeasureGroupPopulation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MeasureGroupStratifier struct {
-	ID                *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept                  `bson:"code,omitempty" json:"code,omitempty"`
-	Description       *string                           `bson:"description,omitempty" json:"description,omitempty"`
-	Criteria          *Expression                       `bson:"criteria,omitempty" json:"criteria,omitempty"`
-	Component         []MeasureGroupStratifierComponent `bson:"component,omitempty" json:"component,omitempty"`
+	ID                 *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *CodeableConcept                  `bson:"code,omitempty" json:"code,omitempty"`
+	Description        *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Criteria           *Expression                       `bson:"criteria,omitempty" json:"criteria,omitempty"`
+	Component          []MeasureGroupStratifierComponent `bson:"component,omitempty" json:"component,omitempty"`
+	UnknownFields      map[string]json.RawMessage        `bson:"-" json:"-"`
 }
 type MeasureGroupStratifierComponent struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Criteria          Expression       `bson:"criteria" json:"criteria"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Criteria           Expression                 `bson:"criteria" json:"criteria"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MeasureGroupStratifierComponent with its unknown fields.
+func (r MeasureGroupStratifierComponent) MarshalJSON() ([]byte, error) {
+	type other MeasureGroupStratifierComponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MeasureGroupStratifier with its unknown fields.
+func (r MeasureGroupStratifier) MarshalJSON() ([]byte, error) {
+	type other MeasureGroupStratifier
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MeasureGroup with its unknown fields.
+func (r MeasureGroup) MarshalJSON() ([]byte, error) {
+	type other MeasureGroup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MeasureSupplementalData struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
-	Usage             []CodeableConcept `bson:"usage,omitempty" json:"usage,omitempty"`
-	Description       *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Criteria          Expression        `bson:"criteria" json:"criteria"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Usage              []CodeableConcept          `bson:"usage,omitempty" json:"usage,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Criteria           Expression                 `bson:"criteria" json:"criteria"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MeasureSupplementalData with its unknown fields.
+func (r MeasureSupplementalData) MarshalJSON() ([]byte, error) {
+	type other MeasureSupplementalData
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// Subject returns Measure.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *Measure) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets Measure.subject[x] to the CodeableConcept and clears the other types.
+func (r *Measure) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets Measure.subject[x] to the Reference and clears the other types.
+func (r *Measure) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *Measure) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// UnmarshalJSON unmarshals the Measure and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Measure) UnmarshalJSON(b []byte) error {
+	type other Measure
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Measure.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Measure.library", len(r.Library), len(r.LibraryElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Measure.definition", len(r.Definition), len(r.DefinitionElement)); err != nil {
+		return err
+	}
+	return checkCodes("Measure", r)
+}
+
 type OtherMeasure Measure
 
 // MarshalJSON marshals the given Measure as JSON into a byte slice
 func (r Measure) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMeasure
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMeasure: OtherMeasure(r),
 		ResourceType: "Measure",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMeasure unmarshals a Measure.
@@ -22,24 +22,32 @@ import "encoding/json"
 
 // MeasureReport is documented here http://hl7.org/fhir/StructureDefinition/MeasureReport
 type MeasureReport struct {
-	ID                  *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status              MeasureReportStatus  `bson:"status" json:"status"`
-	Type                MeasureReportType    `bson:"type" json:"type"`
-	Measure             string               `bson:"measure" json:"measure"`
-	Subject             *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	Date                *DateTime            `bson:"date,omitempty" json:"date,omitempty"`
-	Reporter            *Reference           `bson:"reporter,omitempty" json:"reporter,omitempty"`
-	Period              Period               `bson:"period" json:"period"`
-	ImprovementNotation *CodeableConcept     `bson:"improvementNotation,omitempty" json:"improvementNotation,omitempty"`
-	Group               []MeasureReportGroup `bson:"group,omitempty" json:"group,omitempty"`
-	EvaluatedResource   []Reference          `bson:"evaluatedResource,omitempty" json:"evaluatedResource,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               MeasureReportStatus        `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 MeasureReportType          `bson:"type" json:"type"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Measure              string                     `bson:"measure" json:"measure"`
+	MeasureElement       *Element                   `bson:"_measure,omitempty" json:"_measure,omitempty"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Reporter             *Reference                 `bson:"reporter,omitempty" json:"reporter,omitempty"`
+	Period               Period                     `bson:"period" json:"period"`
+	ImprovementNotation  *CodeableConcept           `bson:"improvementNotation,omitempty" json:"improvementNotation,omitempty"`
+	Group                []MeasureReportGroup       `bson:"group,omitempty" json:"group,omitempty"`
+	EvaluatedResource    []Reference                `bson:"evaluatedResource,omitempty" json:"evaluatedResource,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type MeasureReportGroup struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
@@ -49,21 +57,36 @@ type MeasureReportGroup struct {
 	Population        []MeasureReportGroupPopulation `bson:"population,omitempty" json:"population,omitempty"`
 	MeasureScore      *Quantity                      `bson:"measureScore,omitempty" json:"measureScore,omitempty"`
 	Stratifier        []MeasureReportGroupStratifier `bson:"stratifier,omitempty" json:"stratifier,omitempty"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type MeasureReportGroupPopulation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Count             *int             `bson:"count,omitempty" json:"count,omitempty"`
-	SubjectResults    *Reference       `bson:"subjectResults,omitempty" json:"subjectResults,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Count             *int                       `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement      *Element                   `bson:"_count,omitempty" json:"_count,omitempty"`
+	SubjectResults    *Reference                 `bson:"subjectResults,omitempty" json:"subjectResults,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MeasureReportGroupPopulation with its unknown fields.
+func (r MeasureReportGroupPopulation) MarshalJSON() ([]byte, error) {
+	type other MeasureReportGroupPopulation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MeasureReportGroupStratifier struct {
 	ID                *string                               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              []CodeableConcept                     `bson:"code,omitempty" json:"code,omitempty"`
 	Stratum           []MeasureReportGroupStratifierStratum `bson:"stratum,omitempty" json:"stratum,omitempty"`
+	UnknownFields     map[string]json.RawMessage            `bson:"-" json:"-"`
 }
 type MeasureReportGroupStratifierStratum struct {
 	ID                *string                                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -73,33 +96,102 @@ type MeasureReportGroupStratifierStratum struct {
 	Component         []MeasureReportGroupStratifierStratumComponent  `bson:"component,omitempty" json:"component,omitempty"`
 	Population        []MeasureReportGroupStratifierStratumPopulation `bson:"population,omitempty" json:"population,omitempty"`
 	MeasureScore      *Quantity                                       `bson:"measureScore,omitempty" json:"measureScore,omitempty"`
+	UnknownFields     map[string]json.RawMessage                      `bson:"-" json:"-"`
 }
 type MeasureReportGroupStratifierStratumComponent struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
-	Value             CodeableConcept `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              CodeableConcept            `bson:"code" json:"code"`
+	Value             CodeableConcept            `bson:"value" json:"value"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MeasureReportGroupStratifierStratumComponent with its unknown fields.
+func (r MeasureReportGroupStratifierStratumComponent) MarshalJSON() ([]byte, error) {
+	type other MeasureReportGroupStratifierStratumComponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MeasureReportGroupStratifierStratumPopulation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Count             *int             `bson:"count,omitempty" json:"count,omitempty"`
-	SubjectResults    *Reference       `bson:"subjectResults,omitempty" json:"subjectResults,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Count             *int                       `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement      *Element                   `bson:"_count,omitempty" json:"_count,omitempty"`
+	SubjectResults    *Reference                 `bson:"subjectResults,omitempty" json:"subjectResults,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MeasureReportGroupStratifierStratumPopulation with its unknown fields.
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalJSON() ([]byte, error) {
+	type other MeasureReportGroupStratifierStratumPopulation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the MeasureReportGroupStratifierStratum with its unknown fields.
+func (r MeasureReportGroupStratifierStratum) MarshalJSON() ([]byte, error) {
+	type other MeasureReportGroupStratifierStratum
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MeasureReportGroupStratifier with its unknown fields.
+func (r MeasureReportGroupStratifier) MarshalJSON() ([]byte, error) {
+	type other MeasureReportGroupStratifier
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MeasureReportGroup with its unknown fields.
+func (r MeasureReportGroup) MarshalJSON() ([]byte, error) {
+	type other MeasureReportGroup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MeasureReport and fails with CodeError when a coded element has an unknown code.
+func (r *MeasureReport) UnmarshalJSON(b []byte) error {
+	type other MeasureReport
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MeasureReport", r)
+}
+
 type OtherMeasureReport MeasureReport
 
 // MarshalJSON marshals the given MeasureReport as JSON into a byte slice
 func (r MeasureReport) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMeasureReport
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMeasureReport: OtherMeasureReport(r),
 		ResourceType:       "MeasureReport",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMeasureReport unmarshals a MeasureReport.
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.006119 +0000 UTC
+// 2026-10-19 02:55:18.85597676 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // MeasureReportStatus is documented here http://hl7.org/fhir/ValueSet/measure-report-status
-type MeasureReportStatus int
+// The empty MeasureReportStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type MeasureReportStatus string
 
 const (
-	MeasureReportStatusComplete MeasureReportStatus = iota
-	MeasureReportStatusPending
-	MeasureReportStatusError
+	MeasureReportStatusComplete MeasureReportStatus = "complete"
+	MeasureReportStatusPending  MeasureReportStatus = "pending"
+	MeasureReportStatusError    MeasureReportStatus = "error"
 )
 
 func (code MeasureReportStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "MeasureReportStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *MeasureReportStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "complete":
-		*code = MeasureReportStatusComplete
-	case "pending":
-		*code = MeasureReportStatusPending
-	case "error":
-		*code = MeasureReportStatusError
-	default:
-		return fmt.Errorf("unknown MeasureReportStatus code `%s`", s)
+func (code *MeasureReportStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = MeasureReportStatus(s)
 	return nil
 }
 func (code MeasureReportStatus) String() string {
 	return code.Code()
 }
 func (code MeasureReportStatus) Code() string {
+	return string(code)
+}
+func (code MeasureReportStatus) Known() bool {
 	switch code {
-	case MeasureReportStatusComplete:
-		return "complete"
-	case MeasureReportStatusPending:
-		return "pending"
-	case MeasureReportStatusError:
-		return "error"
+	case MeasureReportStatusComplete, MeasureReportStatusPending, MeasureReportStatusError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code MeasureReportStatus) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.17287 +0000 UTC
+// 2026-10-19 02:55:18.856979285 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // MeasureReportType is documented here http://hl7.org/fhir/ValueSet/measure-report-type
-type MeasureReportType int
+// The empty MeasureReportType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type MeasureReportType string
 
 const (
-	MeasureReportTypeIndividual MeasureReportType = iota
-	MeasureReportTypeSubjectList
-	MeasureReportTypeSummary
-	MeasureReportTypeDataCollection
+	MeasureReportTypeIndividual     MeasureReportType = "individual"
+	MeasureReportTypeSubjectList    MeasureReportType = "subject-list"
+	MeasureReportTypeSummary        MeasureReportType = "summary"
+	MeasureReportTypeDataCollection MeasureReportType = "data-collection"
 )
 
 func (code MeasureReportType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "MeasureReportType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *MeasureReportType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "individual":
-		*code = MeasureReportTypeIndividual
-	case "subject-list":
-		*code = MeasureReportTypeSubjectList
-	case "summary":
-		*code = MeasureReportTypeSummary
-	case "data-collection":
-		*code = MeasureReportTypeDataCollection
-	default:
-		return fmt.Errorf("unknown MeasureReportType code `%s`", s)
+func (code *MeasureReportType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = MeasureReportType(s)
 	return nil
 }
 func (code MeasureReportType) String() string {
 	return code.Code()
 }
 func (code MeasureReportType) Code() string {
+	return string(code)
+}
+func (code MeasureReportType) Known() bool {
 	switch code {
-	case MeasureReportTypeIndividual:
-		return "individual"
-	case MeasureReportTypeSubjectList:
-		return "subject-list"
-	case MeasureReportTypeSummary:
-		return "summary"
-	case MeasureReportTypeDataCollection:
-		return "data-collection"
+	case MeasureReportTypeIndividual, MeasureReportTypeSubjectList, MeasureReportTypeSummary, MeasureReportTypeDataCollection:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code MeasureReportType) Display() string {
 	switch code {
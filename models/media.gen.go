@@ -22,46 +22,109 @@ import "encoding/json"
 
 // Media is documented here http://hl7.org/fhir/StructureDefinition/Media
 type Media struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf            []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status            EventStatus       `bson:"status" json:"status"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Modality          *CodeableConcept  `bson:"modality,omitempty" json:"modality,omitempty"`
-	View              *CodeableConcept  `bson:"view,omitempty" json:"view,omitempty"`
-	Subject           *Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter         *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Issued            *string           `bson:"issued,omitempty" json:"issued,omitempty"`
-	Operator          *Reference        `bson:"operator,omitempty" json:"operator,omitempty"`
-	ReasonCode        []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	BodySite          *CodeableConcept  `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	DeviceName        *string           `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	Device            *Reference        `bson:"device,omitempty" json:"device,omitempty"`
-	Height            *int              `bson:"height,omitempty" json:"height,omitempty"`
-	Width             *int              `bson:"width,omitempty" json:"width,omitempty"`
-	Frames            *int              `bson:"frames,omitempty" json:"frames,omitempty"`
-	Duration          *Decimal          `bson:"duration,omitempty" json:"duration,omitempty"`
-	Content           Attachment        `bson:"content" json:"content"`
-	Note              []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                 []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                 EventStatus                `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                   *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Modality               *CodeableConcept           `bson:"modality,omitempty" json:"modality,omitempty"`
+	View                   *CodeableConcept           `bson:"view,omitempty" json:"view,omitempty"`
+	Subject                *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter              *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	CreatedDateTime        *DateTime                  `bson:"createdDateTime,omitempty" json:"createdDateTime,omitempty"`
+	CreatedDateTimeElement *Element                   `bson:"_createdDateTime,omitempty" json:"_createdDateTime,omitempty"`
+	CreatedPeriod          *Period                    `bson:"createdPeriod,omitempty" json:"createdPeriod,omitempty"`
+	Issued                 *Instant                   `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement          *Element                   `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Operator               *Reference                 `bson:"operator,omitempty" json:"operator,omitempty"`
+	ReasonCode             []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	BodySite               *CodeableConcept           `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	DeviceName             *string                    `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	DeviceNameElement      *Element                   `bson:"_deviceName,omitempty" json:"_deviceName,omitempty"`
+	Device                 *Reference                 `bson:"device,omitempty" json:"device,omitempty"`
+	Height                 *int                       `bson:"height,omitempty" json:"height,omitempty"`
+	HeightElement          *Element                   `bson:"_height,omitempty" json:"_height,omitempty"`
+	Width                  *int                       `bson:"width,omitempty" json:"width,omitempty"`
+	WidthElement           *Element                   `bson:"_width,omitempty" json:"_width,omitempty"`
+	Frames                 *int                       `bson:"frames,omitempty" json:"frames,omitempty"`
+	FramesElement          *Element                   `bson:"_frames,omitempty" json:"_frames,omitempty"`
+	Duration               *Decimal                   `bson:"duration,omitempty" json:"duration,omitempty"`
+	DurationElement        *Element                   `bson:"_duration,omitempty" json:"_duration,omitempty"`
+	Content                Attachment                 `bson:"content" json:"content"`
+	Note                   []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Created returns Media.created[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Media) Created() interface{} {
+	switch {
+	case r.CreatedDateTime != nil:
+		return r.CreatedDateTime
+	case r.CreatedPeriod != nil:
+		return r.CreatedPeriod
+	}
+	return nil
+}
+
+// SetCreatedDateTime sets Media.created[x] to the dateTime and clears the other types.
+func (r *Media) SetCreatedDateTime(v DateTime) {
+	r.clearCreated()
+	r.CreatedDateTime = &v
+}
+
+// SetCreatedPeriod sets Media.created[x] to the Period and clears the other types.
+func (r *Media) SetCreatedPeriod(v Period) {
+	r.clearCreated()
+	r.CreatedPeriod = &v
+}
+func (r *Media) clearCreated() {
+	r.CreatedDateTime = nil
+	r.CreatedDateTimeElement = nil
+	r.CreatedPeriod = nil
+}
+
+// UnmarshalJSON unmarshals the Media and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Media) UnmarshalJSON(b []byte) error {
+	type other Media
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Media.created[x]",
+		r.CreatedDateTime != nil || r.CreatedDateTimeElement != nil,
+		r.CreatedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Media", r)
+}
+
 type OtherMedia Media
 
 // MarshalJSON marshals the given Media as JSON into a byte slice
 func (r Media) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedia
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedia:   OtherMedia(r),
 		ResourceType: "Media",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedia unmarshals a Media.
@@ -22,47 +22,137 @@ import "encoding/json"
 
 // Medication is documented here http://hl7.org/fhir/StructureDefinition/Medication
 type Medication struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Code              *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	Status            *string                `bson:"status,omitempty" json:"status,omitempty"`
-	Manufacturer      *Reference             `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	Form              *CodeableConcept       `bson:"form,omitempty" json:"form,omitempty"`
-	Amount            *Ratio                 `bson:"amount,omitempty" json:"amount,omitempty"`
-	Ingredient        []MedicationIngredient `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
-	Batch             *MedicationBatch       `bson:"batch,omitempty" json:"batch,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Code                 *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Status               *string                    `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Manufacturer         *Reference                 `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Form                 *CodeableConcept           `bson:"form,omitempty" json:"form,omitempty"`
+	Amount               *Ratio                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	Ingredient           []MedicationIngredient     `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	Batch                *MedicationBatch           `bson:"batch,omitempty" json:"batch,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type MedicationIngredient struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	IsActive          *bool       `bson:"isActive,omitempty" json:"isActive,omitempty"`
-	Strength          *Ratio      `bson:"strength,omitempty" json:"strength,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemCodeableConcept *CodeableConcept           `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference       *Reference                 `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	IsActive            *bool                      `bson:"isActive,omitempty" json:"isActive,omitempty"`
+	IsActiveElement     *Element                   `bson:"_isActive,omitempty" json:"_isActive,omitempty"`
+	Strength            *Ratio                     `bson:"strength,omitempty" json:"strength,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Item returns Medication.ingredient.item[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationIngredient) Item() interface{} {
+	switch {
+	case r.ItemCodeableConcept != nil:
+		return r.ItemCodeableConcept
+	case r.ItemReference != nil:
+		return r.ItemReference
+	}
+	return nil
+}
+
+// SetItemCodeableConcept sets Medication.ingredient.item[x] to the CodeableConcept and clears the other types.
+func (r *MedicationIngredient) SetItemCodeableConcept(v CodeableConcept) {
+	r.clearItem()
+	r.ItemCodeableConcept = &v
+}
+
+// SetItemReference sets Medication.ingredient.item[x] to the Reference and clears the other types.
+func (r *MedicationIngredient) SetItemReference(v Reference) {
+	r.clearItem()
+	r.ItemReference = &v
+}
+func (r *MedicationIngredient) clearItem() {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationIngredient and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicationIngredient) UnmarshalJSON(b []byte) error {
+	type other MedicationIngredient
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Medication.ingredient.item[x]",
+		r.ItemCodeableConcept != nil,
+		r.ItemReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicationIngredient with its unknown fields.
+func (r MedicationIngredient) MarshalJSON() ([]byte, error) {
+	type other MedicationIngredient
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationBatch struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	LotNumber         *string     `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	ExpirationDate    *DateTime   `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	LotNumber             *string                    `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	LotNumberElement      *Element                   `bson:"_lotNumber,omitempty" json:"_lotNumber,omitempty"`
+	ExpirationDate        *DateTime                  `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ExpirationDateElement *Element                   `bson:"_expirationDate,omitempty" json:"_expirationDate,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationBatch with its unknown fields.
+func (r MedicationBatch) MarshalJSON() ([]byte, error) {
+	type other MedicationBatch
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Medication and fails with CodeError when a coded element has an unknown code.
+func (r *Medication) UnmarshalJSON(b []byte) error {
+	type other Medication
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Medication", r)
 }
+
 type OtherMedication Medication
 
 // MarshalJSON marshals the given Medication as JSON into a byte slice
 func (r Medication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedication
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedication: OtherMedication(r),
 		ResourceType:    "Medication",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedication unmarshals a Medication.
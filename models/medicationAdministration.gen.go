@@ -22,59 +22,225 @@ import "encoding/json"
 
 // MedicationAdministration is documented here http://hl7.org/fhir/StructureDefinition/MedicationAdministration
 type MedicationAdministration struct {
-	ID                    *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                             `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Instantiates          []string                            `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
-	PartOf                []Reference                         `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                string                              `bson:"status" json:"status"`
-	StatusReason          []CodeableConcept                   `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category              *CodeableConcept                    `bson:"category,omitempty" json:"category,omitempty"`
-	Subject               Reference                           `bson:"subject" json:"subject"`
-	Context               *Reference                          `bson:"context,omitempty" json:"context,omitempty"`
-	SupportingInformation []Reference                         `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	Performer             []MedicationAdministrationPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode            []CodeableConcept                   `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference                         `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Request               *Reference                          `bson:"request,omitempty" json:"request,omitempty"`
-	Device                []Reference                         `bson:"device,omitempty" json:"device,omitempty"`
-	Note                  []Annotation                        `bson:"note,omitempty" json:"note,omitempty"`
-	Dosage                *MedicationAdministrationDosage     `bson:"dosage,omitempty" json:"dosage,omitempty"`
-	EventHistory          []Reference                         `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	ID                        *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Instantiates              []*string                           `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
+	InstantiatesElement       []*Element                          `bson:"_instantiates,omitempty" json:"_instantiates,omitempty"`
+	PartOf                    []Reference                         `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                    string                              `bson:"status" json:"status"`
+	StatusElement             *Element                            `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              []CodeableConcept                   `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                  *CodeableConcept                    `bson:"category,omitempty" json:"category,omitempty"`
+	MedicationCodeableConcept *CodeableConcept                    `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference                          `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	Subject                   Reference                           `bson:"subject" json:"subject"`
+	Context                   *Reference                          `bson:"context,omitempty" json:"context,omitempty"`
+	SupportingInformation     []Reference                         `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	EffectiveDateTime         *DateTime                           `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement  *Element                            `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod           *Period                             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Performer                 []MedicationAdministrationPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                []CodeableConcept                   `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                         `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Request                   *Reference                          `bson:"request,omitempty" json:"request,omitempty"`
+	Device                    []Reference                         `bson:"device,omitempty" json:"device,omitempty"`
+	Note                      []Annotation                        `bson:"note,omitempty" json:"note,omitempty"`
+	Dosage                    *MedicationAdministrationDosage     `bson:"dosage,omitempty" json:"dosage,omitempty"`
+	EventHistory              []Reference                         `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	UnknownFields             map[string]json.RawMessage          `bson:"-" json:"-"`
 }
 type MedicationAdministrationPerformer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Function          *CodeableConcept           `bson:"function,omitempty" json:"function,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationAdministrationPerformer with its unknown fields.
+func (r MedicationAdministrationPerformer) MarshalJSON() ([]byte, error) {
+	type other MedicationAdministrationPerformer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationAdministrationDosage struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Text              *string          `bson:"text,omitempty" json:"text,omitempty"`
-	Site              *CodeableConcept `bson:"site,omitempty" json:"site,omitempty"`
-	Route             *CodeableConcept `bson:"route,omitempty" json:"route,omitempty"`
-	Method            *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
-	Dose              *Quantity        `bson:"dose,omitempty" json:"dose,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Text              *string                    `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	Site              *CodeableConcept           `bson:"site,omitempty" json:"site,omitempty"`
+	Route             *CodeableConcept           `bson:"route,omitempty" json:"route,omitempty"`
+	Method            *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
+	Dose              *Quantity                  `bson:"dose,omitempty" json:"dose,omitempty"`
+	RateRatio         *Ratio                     `bson:"rateRatio,omitempty" json:"rateRatio,omitempty"`
+	RateQuantity      *Quantity                  `bson:"rateQuantity,omitempty" json:"rateQuantity,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Rate returns MedicationAdministration.dosage.rate[x] of the set type, such as *Ratio, or nil when it is not set.
+func (r *MedicationAdministrationDosage) Rate() interface{} {
+	switch {
+	case r.RateRatio != nil:
+		return r.RateRatio
+	case r.RateQuantity != nil:
+		return r.RateQuantity
+	}
+	return nil
+}
+
+// SetRateRatio sets MedicationAdministration.dosage.rate[x] to the Ratio and clears the other types.
+func (r *MedicationAdministrationDosage) SetRateRatio(v Ratio) {
+	r.clearRate()
+	r.RateRatio = &v
+}
+
+// SetRateQuantity sets MedicationAdministration.dosage.rate[x] to the Quantity and clears the other types.
+func (r *MedicationAdministrationDosage) SetRateQuantity(v Quantity) {
+	r.clearRate()
+	r.RateQuantity = &v
+}
+func (r *MedicationAdministrationDosage) clearRate() {
+	r.RateRatio = nil
+	r.RateQuantity = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationAdministrationDosage and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicationAdministrationDosage) UnmarshalJSON(b []byte) error {
+	type other MedicationAdministrationDosage
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationAdministration.dosage.rate[x]",
+		r.RateRatio != nil,
+		r.RateQuantity != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicationAdministrationDosage with its unknown fields.
+func (r MedicationAdministrationDosage) MarshalJSON() ([]byte, error) {
+	type other MedicationAdministrationDosage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Medication returns MedicationAdministration.medication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationAdministration) Medication() interface{} {
+	switch {
+	case r.MedicationCodeableConcept != nil:
+		return r.MedicationCodeableConcept
+	case r.MedicationReference != nil:
+		return r.MedicationReference
+	}
+	return nil
 }
+
+// SetMedicationCodeableConcept sets MedicationAdministration.medication[x] to the CodeableConcept and clears the other types.
+func (r *MedicationAdministration) SetMedicationCodeableConcept(v CodeableConcept) {
+	r.clearMedication()
+	r.MedicationCodeableConcept = &v
+}
+
+// SetMedicationReference sets MedicationAdministration.medication[x] to the Reference and clears the other types.
+func (r *MedicationAdministration) SetMedicationReference(v Reference) {
+	r.clearMedication()
+	r.MedicationReference = &v
+}
+func (r *MedicationAdministration) clearMedication() {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+}
+
+// Effective returns MedicationAdministration.effective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *MedicationAdministration) Effective() interface{} {
+	switch {
+	case r.EffectiveDateTime != nil:
+		return r.EffectiveDateTime
+	case r.EffectivePeriod != nil:
+		return r.EffectivePeriod
+	}
+	return nil
+}
+
+// SetEffectiveDateTime sets MedicationAdministration.effective[x] to the dateTime and clears the other types.
+func (r *MedicationAdministration) SetEffectiveDateTime(v DateTime) {
+	r.clearEffective()
+	r.EffectiveDateTime = &v
+}
+
+// SetEffectivePeriod sets MedicationAdministration.effective[x] to the Period and clears the other types.
+func (r *MedicationAdministration) SetEffectivePeriod(v Period) {
+	r.clearEffective()
+	r.EffectivePeriod = &v
+}
+func (r *MedicationAdministration) clearEffective() {
+	r.EffectiveDateTime = nil
+	r.EffectiveDateTimeElement = nil
+	r.EffectivePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationAdministration and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *MedicationAdministration) UnmarshalJSON(b []byte) error {
+	type other MedicationAdministration
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationAdministration.medication[x]",
+		r.MedicationCodeableConcept != nil,
+		r.MedicationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationAdministration.effective[x]",
+		r.EffectiveDateTime != nil || r.EffectiveDateTimeElement != nil,
+		r.EffectivePeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("MedicationAdministration.instantiates", len(r.Instantiates), len(r.InstantiatesElement)); err != nil {
+		return err
+	}
+	return checkCodes("MedicationAdministration", r)
+}
+
 type OtherMedicationAdministration MedicationAdministration
 
 // MarshalJSON marshals the given MedicationAdministration as JSON into a byte slice
 func (r MedicationAdministration) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicationAdministration
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicationAdministration: OtherMedicationAdministration(r),
 		ResourceType:                  "MedicationAdministration",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicationAdministration unmarshals a MedicationAdministration.
@@ -22,63 +22,180 @@ import "encoding/json"
 
 // MedicationDispense is documented here http://hl7.org/fhir/StructureDefinition/MedicationDispense
 type MedicationDispense struct {
-	ID                      *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                    *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules           *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                    *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension               []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier              []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	PartOf                  []Reference                     `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                  string                          `bson:"status" json:"status"`
-	Category                *CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
-	Subject                 *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
-	Context                 *Reference                      `bson:"context,omitempty" json:"context,omitempty"`
-	SupportingInformation   []Reference                     `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	Performer               []MedicationDispensePerformer   `bson:"performer,omitempty" json:"performer,omitempty"`
-	Location                *Reference                      `bson:"location,omitempty" json:"location,omitempty"`
-	AuthorizingPrescription []Reference                     `bson:"authorizingPrescription,omitempty" json:"authorizingPrescription,omitempty"`
-	Type                    *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
-	Quantity                *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	DaysSupply              *Quantity                       `bson:"daysSupply,omitempty" json:"daysSupply,omitempty"`
-	WhenPrepared            *DateTime                       `bson:"whenPrepared,omitempty" json:"whenPrepared,omitempty"`
-	WhenHandedOver          *DateTime                       `bson:"whenHandedOver,omitempty" json:"whenHandedOver,omitempty"`
-	Destination             *Reference                      `bson:"destination,omitempty" json:"destination,omitempty"`
-	Receiver                []Reference                     `bson:"receiver,omitempty" json:"receiver,omitempty"`
-	Note                    []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
-	DosageInstruction       []Dosage                        `bson:"dosageInstruction,omitempty" json:"dosageInstruction,omitempty"`
-	Substitution            *MedicationDispenseSubstitution `bson:"substitution,omitempty" json:"substitution,omitempty"`
-	DetectedIssue           []Reference                     `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
-	EventHistory            []Reference                     `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	ID                          *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                        *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules               *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement        *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                    *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement             *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                        *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                   ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                   []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                  []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	PartOf                      []Reference                     `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                      string                          `bson:"status" json:"status"`
+	StatusElement               *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReasonCodeableConcept *CodeableConcept                `bson:"statusReasonCodeableConcept,omitempty" json:"statusReasonCodeableConcept,omitempty"`
+	StatusReasonReference       *Reference                      `bson:"statusReasonReference,omitempty" json:"statusReasonReference,omitempty"`
+	Category                    *CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
+	MedicationCodeableConcept   *CodeableConcept                `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference         *Reference                      `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	Subject                     *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
+	Context                     *Reference                      `bson:"context,omitempty" json:"context,omitempty"`
+	SupportingInformation       []Reference                     `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	Performer                   []MedicationDispensePerformer   `bson:"performer,omitempty" json:"performer,omitempty"`
+	Location                    *Reference                      `bson:"location,omitempty" json:"location,omitempty"`
+	AuthorizingPrescription     []Reference                     `bson:"authorizingPrescription,omitempty" json:"authorizingPrescription,omitempty"`
+	Type                        *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
+	Quantity                    *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	DaysSupply                  *Quantity                       `bson:"daysSupply,omitempty" json:"daysSupply,omitempty"`
+	WhenPrepared                *DateTime                       `bson:"whenPrepared,omitempty" json:"whenPrepared,omitempty"`
+	WhenPreparedElement         *Element                        `bson:"_whenPrepared,omitempty" json:"_whenPrepared,omitempty"`
+	WhenHandedOver              *DateTime                       `bson:"whenHandedOver,omitempty" json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement       *Element                        `bson:"_whenHandedOver,omitempty" json:"_whenHandedOver,omitempty"`
+	Destination                 *Reference                      `bson:"destination,omitempty" json:"destination,omitempty"`
+	Receiver                    []Reference                     `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	Note                        []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
+	DosageInstruction           []Dosage                        `bson:"dosageInstruction,omitempty" json:"dosageInstruction,omitempty"`
+	Substitution                *MedicationDispenseSubstitution `bson:"substitution,omitempty" json:"substitution,omitempty"`
+	DetectedIssue               []Reference                     `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
+	EventHistory                []Reference                     `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	UnknownFields               map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type MedicationDispensePerformer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Function          *CodeableConcept           `bson:"function,omitempty" json:"function,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationDispensePerformer with its unknown fields.
+func (r MedicationDispensePerformer) MarshalJSON() ([]byte, error) {
+	type other MedicationDispensePerformer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationDispenseSubstitution struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	WasSubstituted    bool              `bson:"wasSubstituted" json:"wasSubstituted"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Reason            []CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
-	ResponsibleParty  []Reference       `bson:"responsibleParty,omitempty" json:"responsibleParty,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	WasSubstituted        bool                       `bson:"wasSubstituted" json:"wasSubstituted"`
+	WasSubstitutedElement *Element                   `bson:"_wasSubstituted,omitempty" json:"_wasSubstituted,omitempty"`
+	Type                  *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Reason                []CodeableConcept          `bson:"reason,omitempty" json:"reason,omitempty"`
+	ResponsibleParty      []Reference                `bson:"responsibleParty,omitempty" json:"responsibleParty,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationDispenseSubstitution with its unknown fields.
+func (r MedicationDispenseSubstitution) MarshalJSON() ([]byte, error) {
+	type other MedicationDispenseSubstitution
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// StatusReason returns MedicationDispense.statusReason[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationDispense) StatusReason() interface{} {
+	switch {
+	case r.StatusReasonCodeableConcept != nil:
+		return r.StatusReasonCodeableConcept
+	case r.StatusReasonReference != nil:
+		return r.StatusReasonReference
+	}
+	return nil
+}
+
+// SetStatusReasonCodeableConcept sets MedicationDispense.statusReason[x] to the CodeableConcept and clears the other types.
+func (r *MedicationDispense) SetStatusReasonCodeableConcept(v CodeableConcept) {
+	r.clearStatusReason()
+	r.StatusReasonCodeableConcept = &v
+}
+
+// SetStatusReasonReference sets MedicationDispense.statusReason[x] to the Reference and clears the other types.
+func (r *MedicationDispense) SetStatusReasonReference(v Reference) {
+	r.clearStatusReason()
+	r.StatusReasonReference = &v
+}
+func (r *MedicationDispense) clearStatusReason() {
+	r.StatusReasonCodeableConcept = nil
+	r.StatusReasonReference = nil
 }
+
+// Medication returns MedicationDispense.medication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationDispense) Medication() interface{} {
+	switch {
+	case r.MedicationCodeableConcept != nil:
+		return r.MedicationCodeableConcept
+	case r.MedicationReference != nil:
+		return r.MedicationReference
+	}
+	return nil
+}
+
+// SetMedicationCodeableConcept sets MedicationDispense.medication[x] to the CodeableConcept and clears the other types.
+func (r *MedicationDispense) SetMedicationCodeableConcept(v CodeableConcept) {
+	r.clearMedication()
+	r.MedicationCodeableConcept = &v
+}
+
+// SetMedicationReference sets MedicationDispense.medication[x] to the Reference and clears the other types.
+func (r *MedicationDispense) SetMedicationReference(v Reference) {
+	r.clearMedication()
+	r.MedicationReference = &v
+}
+func (r *MedicationDispense) clearMedication() {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationDispense and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *MedicationDispense) UnmarshalJSON(b []byte) error {
+	type other MedicationDispense
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationDispense.statusReason[x]",
+		r.StatusReasonCodeableConcept != nil,
+		r.StatusReasonReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationDispense.medication[x]",
+		r.MedicationCodeableConcept != nil,
+		r.MedicationReference != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("MedicationDispense", r)
+}
+
 type OtherMedicationDispense MedicationDispense
 
 // MarshalJSON marshals the given MedicationDispense as JSON into a byte slice
 func (r MedicationDispense) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicationDispense
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicationDispense: OtherMedicationDispense(r),
 		ResourceType:            "MedicationDispense",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicationDispense unmarshals a MedicationDispense.
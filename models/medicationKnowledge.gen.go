@@ -22,112 +22,463 @@ import "encoding/json"
 
 // MedicationKnowledge is documented here http://hl7.org/fhir/StructureDefinition/MedicationKnowledge
 type MedicationKnowledge struct {
-	ID                         *string                                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                       *Meta                                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules              *string                                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                   *string                                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                       *Narrative                                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                  []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension          []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code                       *CodeableConcept                                `bson:"code,omitempty" json:"code,omitempty"`
-	Status                     *string                                         `bson:"status,omitempty" json:"status,omitempty"`
-	Manufacturer               *Reference                                      `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	DoseForm                   *CodeableConcept                                `bson:"doseForm,omitempty" json:"doseForm,omitempty"`
-	Amount                     *Quantity                                       `bson:"amount,omitempty" json:"amount,omitempty"`
-	Synonym                    []string                                        `bson:"synonym,omitempty" json:"synonym,omitempty"`
-	RelatedMedicationKnowledge []MedicationKnowledgeRelatedMedicationKnowledge `bson:"relatedMedicationKnowledge,omitempty" json:"relatedMedicationKnowledge,omitempty"`
-	AssociatedMedication       []Reference                                     `bson:"associatedMedication,omitempty" json:"associatedMedication,omitempty"`
-	ProductType                []CodeableConcept                               `bson:"productType,omitempty" json:"productType,omitempty"`
-	Monograph                  []MedicationKnowledgeMonograph                  `bson:"monograph,omitempty" json:"monograph,omitempty"`
-	Ingredient                 []MedicationKnowledgeIngredient                 `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
-	PreparationInstruction     *string                                         `bson:"preparationInstruction,omitempty" json:"preparationInstruction,omitempty"`
-	IntendedRoute              []CodeableConcept                               `bson:"intendedRoute,omitempty" json:"intendedRoute,omitempty"`
-	Cost                       []MedicationKnowledgeCost                       `bson:"cost,omitempty" json:"cost,omitempty"`
-	MonitoringProgram          []MedicationKnowledgeMonitoringProgram          `bson:"monitoringProgram,omitempty" json:"monitoringProgram,omitempty"`
-	AdministrationGuidelines   []MedicationKnowledgeAdministrationGuidelines   `bson:"administrationGuidelines,omitempty" json:"administrationGuidelines,omitempty"`
-	MedicineClassification     []MedicationKnowledgeMedicineClassification     `bson:"medicineClassification,omitempty" json:"medicineClassification,omitempty"`
-	Packaging                  *MedicationKnowledgePackaging                   `bson:"packaging,omitempty" json:"packaging,omitempty"`
-	DrugCharacteristic         []MedicationKnowledgeDrugCharacteristic         `bson:"drugCharacteristic,omitempty" json:"drugCharacteristic,omitempty"`
-	Contraindication           []Reference                                     `bson:"contraindication,omitempty" json:"contraindication,omitempty"`
-	Regulatory                 []MedicationKnowledgeRegulatory                 `bson:"regulatory,omitempty" json:"regulatory,omitempty"`
-	Kinetics                   []MedicationKnowledgeKinetics                   `bson:"kinetics,omitempty" json:"kinetics,omitempty"`
+	ID                            *string                                         `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                          *Meta                                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     ContainedResources                              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                          *CodeableConcept                                `bson:"code,omitempty" json:"code,omitempty"`
+	Status                        *string                                         `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement                 *Element                                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	Manufacturer                  *Reference                                      `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	DoseForm                      *CodeableConcept                                `bson:"doseForm,omitempty" json:"doseForm,omitempty"`
+	Amount                        *Quantity                                       `bson:"amount,omitempty" json:"amount,omitempty"`
+	Synonym                       []*string                                       `bson:"synonym,omitempty" json:"synonym,omitempty"`
+	SynonymElement                []*Element                                      `bson:"_synonym,omitempty" json:"_synonym,omitempty"`
+	RelatedMedicationKnowledge    []MedicationKnowledgeRelatedMedicationKnowledge `bson:"relatedMedicationKnowledge,omitempty" json:"relatedMedicationKnowledge,omitempty"`
+	AssociatedMedication          []Reference                                     `bson:"associatedMedication,omitempty" json:"associatedMedication,omitempty"`
+	ProductType                   []CodeableConcept                               `bson:"productType,omitempty" json:"productType,omitempty"`
+	Monograph                     []MedicationKnowledgeMonograph                  `bson:"monograph,omitempty" json:"monograph,omitempty"`
+	Ingredient                    []MedicationKnowledgeIngredient                 `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	PreparationInstruction        *string                                         `bson:"preparationInstruction,omitempty" json:"preparationInstruction,omitempty"`
+	PreparationInstructionElement *Element                                        `bson:"_preparationInstruction,omitempty" json:"_preparationInstruction,omitempty"`
+	IntendedRoute                 []CodeableConcept                               `bson:"intendedRoute,omitempty" json:"intendedRoute,omitempty"`
+	Cost                          []MedicationKnowledgeCost                       `bson:"cost,omitempty" json:"cost,omitempty"`
+	MonitoringProgram             []MedicationKnowledgeMonitoringProgram          `bson:"monitoringProgram,omitempty" json:"monitoringProgram,omitempty"`
+	AdministrationGuidelines      []MedicationKnowledgeAdministrationGuidelines   `bson:"administrationGuidelines,omitempty" json:"administrationGuidelines,omitempty"`
+	MedicineClassification        []MedicationKnowledgeMedicineClassification     `bson:"medicineClassification,omitempty" json:"medicineClassification,omitempty"`
+	Packaging                     *MedicationKnowledgePackaging                   `bson:"packaging,omitempty" json:"packaging,omitempty"`
+	DrugCharacteristic            []MedicationKnowledgeDrugCharacteristic         `bson:"drugCharacteristic,omitempty" json:"drugCharacteristic,omitempty"`
+	Contraindication              []Reference                                     `bson:"contraindication,omitempty" json:"contraindication,omitempty"`
+	Regulatory                    []MedicationKnowledgeRegulatory                 `bson:"regulatory,omitempty" json:"regulatory,omitempty"`
+	Kinetics                      []MedicationKnowledgeKinetics                   `bson:"kinetics,omitempty" json:"kinetics,omitempty"`
+	UnknownFields                 map[string]json.RawMessage                      `bson:"-" json:"-"`
 }
 type MedicationKnowledgeRelatedMedicationKnowledge struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Reference         []Reference     `bson:"reference" json:"reference"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Reference         []Reference                `bson:"reference" json:"reference"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationKnowledgeRelatedMedicationKnowledge with its unknown fields.
+func (r MedicationKnowledgeRelatedMedicationKnowledge) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeRelatedMedicationKnowledge
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgeMonograph struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Source            *Reference       `bson:"source,omitempty" json:"source,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Source            *Reference                 `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationKnowledgeMonograph with its unknown fields.
+func (r MedicationKnowledgeMonograph) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeMonograph
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgeIngredient struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	IsActive          *bool       `bson:"isActive,omitempty" json:"isActive,omitempty"`
-	Strength          *Ratio      `bson:"strength,omitempty" json:"strength,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemCodeableConcept *CodeableConcept           `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference       *Reference                 `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	IsActive            *bool                      `bson:"isActive,omitempty" json:"isActive,omitempty"`
+	IsActiveElement     *Element                   `bson:"_isActive,omitempty" json:"_isActive,omitempty"`
+	Strength            *Ratio                     `bson:"strength,omitempty" json:"strength,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Item returns MedicationKnowledge.ingredient.item[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationKnowledgeIngredient) Item() interface{} {
+	switch {
+	case r.ItemCodeableConcept != nil:
+		return r.ItemCodeableConcept
+	case r.ItemReference != nil:
+		return r.ItemReference
+	}
+	return nil
+}
+
+// SetItemCodeableConcept sets MedicationKnowledge.ingredient.item[x] to the CodeableConcept and clears the other types.
+func (r *MedicationKnowledgeIngredient) SetItemCodeableConcept(v CodeableConcept) {
+	r.clearItem()
+	r.ItemCodeableConcept = &v
+}
+
+// SetItemReference sets MedicationKnowledge.ingredient.item[x] to the Reference and clears the other types.
+func (r *MedicationKnowledgeIngredient) SetItemReference(v Reference) {
+	r.clearItem()
+	r.ItemReference = &v
 }
+func (r *MedicationKnowledgeIngredient) clearItem() {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationKnowledgeIngredient and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicationKnowledgeIngredient) UnmarshalJSON(b []byte) error {
+	type other MedicationKnowledgeIngredient
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationKnowledge.ingredient.item[x]",
+		r.ItemCodeableConcept != nil,
+		r.ItemReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicationKnowledgeIngredient with its unknown fields.
+func (r MedicationKnowledgeIngredient) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeIngredient
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgeCost struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Source            *string         `bson:"source,omitempty" json:"source,omitempty"`
-	Cost              Money           `bson:"cost" json:"cost"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Source            *string                    `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement     *Element                   `bson:"_source,omitempty" json:"_source,omitempty"`
+	Cost              Money                      `bson:"cost" json:"cost"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationKnowledgeCost with its unknown fields.
+func (r MedicationKnowledgeCost) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeCost
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicationKnowledgeMonitoringProgram struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string          `bson:"name,omitempty" json:"name,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationKnowledgeMonitoringProgram with its unknown fields.
+func (r MedicationKnowledgeMonitoringProgram) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeMonitoringProgram
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicationKnowledgeAdministrationGuidelines struct {
-	ID                     *string                                                             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension                                                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                                                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Dosage                 []MedicationKnowledgeAdministrationGuidelinesDosage                 `bson:"dosage,omitempty" json:"dosage,omitempty"`
-	PatientCharacteristics []MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics `bson:"patientCharacteristics,omitempty" json:"patientCharacteristics,omitempty"`
+	ID                        *string                                                             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                                                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                                                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Dosage                    []MedicationKnowledgeAdministrationGuidelinesDosage                 `bson:"dosage,omitempty" json:"dosage,omitempty"`
+	IndicationCodeableConcept *CodeableConcept                                                    `bson:"indicationCodeableConcept,omitempty" json:"indicationCodeableConcept,omitempty"`
+	IndicationReference       *Reference                                                          `bson:"indicationReference,omitempty" json:"indicationReference,omitempty"`
+	PatientCharacteristics    []MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics `bson:"patientCharacteristics,omitempty" json:"patientCharacteristics,omitempty"`
+	UnknownFields             map[string]json.RawMessage                                          `bson:"-" json:"-"`
 }
 type MedicationKnowledgeAdministrationGuidelinesDosage struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Dosage            []Dosage        `bson:"dosage" json:"dosage"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Dosage            []Dosage                   `bson:"dosage" json:"dosage"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationKnowledgeAdministrationGuidelinesDosage with its unknown fields.
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeAdministrationGuidelinesDosage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Value             []string    `bson:"value,omitempty" json:"value,omitempty"`
+	ID                            *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	CharacteristicCodeableConcept *CodeableConcept           `bson:"characteristicCodeableConcept,omitempty" json:"characteristicCodeableConcept,omitempty"`
+	CharacteristicQuantity        *Quantity                  `bson:"characteristicQuantity,omitempty" json:"characteristicQuantity,omitempty"`
+	Value                         []*string                  `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement                  []*Element                 `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields                 map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Characteristic returns MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) Characteristic() interface{} {
+	switch {
+	case r.CharacteristicCodeableConcept != nil:
+		return r.CharacteristicCodeableConcept
+	case r.CharacteristicQuantity != nil:
+		return r.CharacteristicQuantity
+	}
+	return nil
+}
+
+// SetCharacteristicCodeableConcept sets MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x] to the CodeableConcept and clears the other types.
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) SetCharacteristicCodeableConcept(v CodeableConcept) {
+	r.clearCharacteristic()
+	r.CharacteristicCodeableConcept = &v
+}
+
+// SetCharacteristicQuantity sets MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x] to the Quantity and clears the other types.
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) SetCharacteristicQuantity(v Quantity) {
+	r.clearCharacteristic()
+	r.CharacteristicQuantity = &v
+}
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) clearCharacteristic() {
+	r.CharacteristicCodeableConcept = nil
+	r.CharacteristicQuantity = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) UnmarshalJSON(b []byte) error {
+	type other MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x]",
+		r.CharacteristicCodeableConcept != nil,
+		r.CharacteristicQuantity != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("MedicationKnowledge.administrationGuidelines.patientCharacteristics.value", len(r.Value), len(r.ValueElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics with its unknown fields.
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Indication returns MedicationKnowledge.administrationGuidelines.indication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationKnowledgeAdministrationGuidelines) Indication() interface{} {
+	switch {
+	case r.IndicationCodeableConcept != nil:
+		return r.IndicationCodeableConcept
+	case r.IndicationReference != nil:
+		return r.IndicationReference
+	}
+	return nil
+}
+
+// SetIndicationCodeableConcept sets MedicationKnowledge.administrationGuidelines.indication[x] to the CodeableConcept and clears the other types.
+func (r *MedicationKnowledgeAdministrationGuidelines) SetIndicationCodeableConcept(v CodeableConcept) {
+	r.clearIndication()
+	r.IndicationCodeableConcept = &v
+}
+
+// SetIndicationReference sets MedicationKnowledge.administrationGuidelines.indication[x] to the Reference and clears the other types.
+func (r *MedicationKnowledgeAdministrationGuidelines) SetIndicationReference(v Reference) {
+	r.clearIndication()
+	r.IndicationReference = &v
+}
+func (r *MedicationKnowledgeAdministrationGuidelines) clearIndication() {
+	r.IndicationCodeableConcept = nil
+	r.IndicationReference = nil
 }
+
+// UnmarshalJSON unmarshals the MedicationKnowledgeAdministrationGuidelines and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicationKnowledgeAdministrationGuidelines) UnmarshalJSON(b []byte) error {
+	type other MedicationKnowledgeAdministrationGuidelines
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationKnowledge.administrationGuidelines.indication[x]",
+		r.IndicationCodeableConcept != nil,
+		r.IndicationReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicationKnowledgeAdministrationGuidelines with its unknown fields.
+func (r MedicationKnowledgeAdministrationGuidelines) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeAdministrationGuidelines
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgeMedicineClassification struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept   `bson:"type" json:"type"`
-	Classification    []CodeableConcept `bson:"classification,omitempty" json:"classification,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Classification    []CodeableConcept          `bson:"classification,omitempty" json:"classification,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationKnowledgeMedicineClassification with its unknown fields.
+func (r MedicationKnowledgeMedicineClassification) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeMedicineClassification
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgePackaging struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Quantity          *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationKnowledgePackaging with its unknown fields.
+func (r MedicationKnowledgePackaging) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgePackaging
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicationKnowledgeDrugCharacteristic struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	ValueCodeableConcept     *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueString              *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueQuantity            *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueBase64Binary        *string                    `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element                   `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns MedicationKnowledge.drugCharacteristic.value[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationKnowledgeDrugCharacteristic) Value() interface{} {
+	switch {
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	}
+	return nil
+}
+
+// SetValueCodeableConcept sets MedicationKnowledge.drugCharacteristic.value[x] to the CodeableConcept and clears the other types.
+func (r *MedicationKnowledgeDrugCharacteristic) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueString sets MedicationKnowledge.drugCharacteristic.value[x] to the string and clears the other types.
+func (r *MedicationKnowledgeDrugCharacteristic) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueQuantity sets MedicationKnowledge.drugCharacteristic.value[x] to the Quantity and clears the other types.
+func (r *MedicationKnowledgeDrugCharacteristic) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueBase64Binary sets MedicationKnowledge.drugCharacteristic.value[x] to the base64Binary and clears the other types.
+func (r *MedicationKnowledgeDrugCharacteristic) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+func (r *MedicationKnowledgeDrugCharacteristic) clearValue() {
+	r.ValueCodeableConcept = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueQuantity = nil
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationKnowledgeDrugCharacteristic and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicationKnowledgeDrugCharacteristic) UnmarshalJSON(b []byte) error {
+	type other MedicationKnowledgeDrugCharacteristic
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationKnowledge.drugCharacteristic.value[x]",
+		r.ValueCodeableConcept != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueQuantity != nil,
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicationKnowledgeDrugCharacteristic with its unknown fields.
+func (r MedicationKnowledgeDrugCharacteristic) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeDrugCharacteristic
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicationKnowledgeRegulatory struct {
 	ID                  *string                                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension           []Extension                                 `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -136,46 +487,122 @@ type MedicationKnowledgeRegulatory struct {
 	Substitution        []MedicationKnowledgeRegulatorySubstitution `bson:"substitution,omitempty" json:"substitution,omitempty"`
 	Schedule            []MedicationKnowledgeRegulatorySchedule     `bson:"schedule,omitempty" json:"schedule,omitempty"`
 	MaxDispense         *MedicationKnowledgeRegulatoryMaxDispense   `bson:"maxDispense,omitempty" json:"maxDispense,omitempty"`
+	UnknownFields       map[string]json.RawMessage                  `bson:"-" json:"-"`
 }
 type MedicationKnowledgeRegulatorySubstitution struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Allowed           bool            `bson:"allowed" json:"allowed"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Allowed           bool                       `bson:"allowed" json:"allowed"`
+	AllowedElement    *Element                   `bson:"_allowed,omitempty" json:"_allowed,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationKnowledgeRegulatorySubstitution with its unknown fields.
+func (r MedicationKnowledgeRegulatorySubstitution) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeRegulatorySubstitution
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgeRegulatorySchedule struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Schedule          CodeableConcept `bson:"schedule" json:"schedule"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Schedule          CodeableConcept            `bson:"schedule" json:"schedule"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationKnowledgeRegulatorySchedule with its unknown fields.
+func (r MedicationKnowledgeRegulatorySchedule) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeRegulatorySchedule
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationKnowledgeRegulatoryMaxDispense struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Quantity          Quantity    `bson:"quantity" json:"quantity"`
-	Period            *Duration   `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Quantity          Quantity                   `bson:"quantity" json:"quantity"`
+	Period            *Duration                  `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationKnowledgeRegulatoryMaxDispense with its unknown fields.
+func (r MedicationKnowledgeRegulatoryMaxDispense) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeRegulatoryMaxDispense
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MedicationKnowledgeRegulatory with its unknown fields.
+func (r MedicationKnowledgeRegulatory) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeRegulatory
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicationKnowledgeKinetics struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AreaUnderCurve    []Quantity  `bson:"areaUnderCurve,omitempty" json:"areaUnderCurve,omitempty"`
-	LethalDose50      []Quantity  `bson:"lethalDose50,omitempty" json:"lethalDose50,omitempty"`
-	HalfLifePeriod    *Duration   `bson:"halfLifePeriod,omitempty" json:"halfLifePeriod,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AreaUnderCurve    []Quantity                 `bson:"areaUnderCurve,omitempty" json:"areaUnderCurve,omitempty"`
+	LethalDose50      []Quantity                 `bson:"lethalDose50,omitempty" json:"lethalDose50,omitempty"`
+	HalfLifePeriod    *Duration                  `bson:"halfLifePeriod,omitempty" json:"halfLifePeriod,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationKnowledgeKinetics with its unknown fields.
+func (r MedicationKnowledgeKinetics) MarshalJSON() ([]byte, error) {
+	type other MedicationKnowledgeKinetics
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicationKnowledge and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *MedicationKnowledge) UnmarshalJSON(b []byte) error {
+	type other MedicationKnowledge
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("MedicationKnowledge.synonym", len(r.Synonym), len(r.SynonymElement)); err != nil {
+		return err
+	}
+	return checkCodes("MedicationKnowledge", r)
+}
+
 type OtherMedicationKnowledge MedicationKnowledge
 
 // MarshalJSON marshals the given MedicationKnowledge as JSON into a byte slice
 func (r MedicationKnowledge) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicationKnowledge
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicationKnowledge: OtherMedicationKnowledge(r),
 		ResourceType:             "MedicationKnowledge",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicationKnowledge unmarshals a MedicationKnowledge.
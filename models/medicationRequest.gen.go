@@ -22,80 +22,220 @@ import "encoding/json"
 
 // MedicationRequest is documented here http://hl7.org/fhir/StructureDefinition/MedicationRequest
 type MedicationRequest struct {
-	ID                    *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                string                            `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept                  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Intent                string                            `bson:"intent" json:"intent"`
-	Category              []CodeableConcept                 `bson:"category,omitempty" json:"category,omitempty"`
-	Priority              *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
-	DoNotPerform          *bool                             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Subject               Reference                         `bson:"subject" json:"subject"`
-	Encounter             *Reference                        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	SupportingInformation []Reference                       `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	AuthoredOn            *DateTime                         `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester             *Reference                        `bson:"requester,omitempty" json:"requester,omitempty"`
-	Performer             *Reference                        `bson:"performer,omitempty" json:"performer,omitempty"`
-	PerformerType         *CodeableConcept                  `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Recorder              *Reference                        `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	ReasonCode            []CodeableConcept                 `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference                       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	InstantiatesCanonical []string                          `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                          `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference                       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	GroupIdentifier       *Identifier                       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	CourseOfTherapyType   *CodeableConcept                  `bson:"courseOfTherapyType,omitempty" json:"courseOfTherapyType,omitempty"`
-	Insurance             []Reference                       `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	Note                  []Annotation                      `bson:"note,omitempty" json:"note,omitempty"`
-	DosageInstruction     []Dosage                          `bson:"dosageInstruction,omitempty" json:"dosageInstruction,omitempty"`
-	DispenseRequest       *MedicationRequestDispenseRequest `bson:"dispenseRequest,omitempty" json:"dispenseRequest,omitempty"`
-	Substitution          *MedicationRequestSubstitution    `bson:"substitution,omitempty" json:"substitution,omitempty"`
-	PriorPrescription     *Reference                        `bson:"priorPrescription,omitempty" json:"priorPrescription,omitempty"`
-	DetectedIssue         []Reference                       `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
-	EventHistory          []Reference                       `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	ID                           *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                       string                            `bson:"status" json:"status"`
+	StatusElement                *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept                  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Intent                       string                            `bson:"intent" json:"intent"`
+	IntentElement                *Element                          `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Category                     []CodeableConcept                 `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                     *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                          `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	DoNotPerform                 *bool                             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element                          `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	ReportedBoolean              *bool                             `bson:"reportedBoolean,omitempty" json:"reportedBoolean,omitempty"`
+	ReportedBooleanElement       *Element                          `bson:"_reportedBoolean,omitempty" json:"_reportedBoolean,omitempty"`
+	ReportedReference            *Reference                        `bson:"reportedReference,omitempty" json:"reportedReference,omitempty"`
+	MedicationCodeableConcept    *CodeableConcept                  `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference          *Reference                        `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	Subject                      Reference                         `bson:"subject" json:"subject"`
+	Encounter                    *Reference                        `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	SupportingInformation        []Reference                       `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	AuthoredOn                   *DateTime                         `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                          `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                    *Reference                        `bson:"requester,omitempty" json:"requester,omitempty"`
+	Performer                    *Reference                        `bson:"performer,omitempty" json:"performer,omitempty"`
+	PerformerType                *CodeableConcept                  `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Recorder                     *Reference                        `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	ReasonCode                   []CodeableConcept                 `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	InstantiatesCanonical        []*string                         `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                        `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                         `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                        `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	GroupIdentifier              *Identifier                       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	CourseOfTherapyType          *CodeableConcept                  `bson:"courseOfTherapyType,omitempty" json:"courseOfTherapyType,omitempty"`
+	Insurance                    []Reference                       `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	Note                         []Annotation                      `bson:"note,omitempty" json:"note,omitempty"`
+	DosageInstruction            []Dosage                          `bson:"dosageInstruction,omitempty" json:"dosageInstruction,omitempty"`
+	DispenseRequest              *MedicationRequestDispenseRequest `bson:"dispenseRequest,omitempty" json:"dispenseRequest,omitempty"`
+	Substitution                 *MedicationRequestSubstitution    `bson:"substitution,omitempty" json:"substitution,omitempty"`
+	PriorPrescription            *Reference                        `bson:"priorPrescription,omitempty" json:"priorPrescription,omitempty"`
+	DetectedIssue                []Reference                       `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
+	EventHistory                 []Reference                       `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	UnknownFields                map[string]json.RawMessage        `bson:"-" json:"-"`
 }
 type MedicationRequestDispenseRequest struct {
-	ID                     *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	InitialFill            *MedicationRequestDispenseRequestInitialFill `bson:"initialFill,omitempty" json:"initialFill,omitempty"`
-	DispenseInterval       *Duration                                    `bson:"dispenseInterval,omitempty" json:"dispenseInterval,omitempty"`
-	ValidityPeriod         *Period                                      `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
-	NumberOfRepeatsAllowed *int                                         `bson:"numberOfRepeatsAllowed,omitempty" json:"numberOfRepeatsAllowed,omitempty"`
-	Quantity               *Quantity                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ExpectedSupplyDuration *Duration                                    `bson:"expectedSupplyDuration,omitempty" json:"expectedSupplyDuration,omitempty"`
-	Performer              *Reference                                   `bson:"performer,omitempty" json:"performer,omitempty"`
+	ID                            *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	InitialFill                   *MedicationRequestDispenseRequestInitialFill `bson:"initialFill,omitempty" json:"initialFill,omitempty"`
+	DispenseInterval              *Duration                                    `bson:"dispenseInterval,omitempty" json:"dispenseInterval,omitempty"`
+	ValidityPeriod                *Period                                      `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
+	NumberOfRepeatsAllowed        *int                                         `bson:"numberOfRepeatsAllowed,omitempty" json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *Element                                     `bson:"_numberOfRepeatsAllowed,omitempty" json:"_numberOfRepeatsAllowed,omitempty"`
+	Quantity                      *Quantity                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ExpectedSupplyDuration        *Duration                                    `bson:"expectedSupplyDuration,omitempty" json:"expectedSupplyDuration,omitempty"`
+	Performer                     *Reference                                   `bson:"performer,omitempty" json:"performer,omitempty"`
+	UnknownFields                 map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type MedicationRequestDispenseRequestInitialFill struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Quantity          *Quantity   `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Duration          *Duration   `bson:"duration,omitempty" json:"duration,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Duration          *Duration                  `bson:"duration,omitempty" json:"duration,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicationRequestDispenseRequestInitialFill with its unknown fields.
+func (r MedicationRequestDispenseRequestInitialFill) MarshalJSON() ([]byte, error) {
+	type other MedicationRequestDispenseRequestInitialFill
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MedicationRequestDispenseRequest with its unknown fields.
+func (r MedicationRequestDispenseRequest) MarshalJSON() ([]byte, error) {
+	type other MedicationRequestDispenseRequest
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicationRequestSubstitution struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reason            *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicationRequestSubstitution with its unknown fields.
+func (r MedicationRequestSubstitution) MarshalJSON() ([]byte, error) {
+	type other MedicationRequestSubstitution
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Reported returns MedicationRequest.reported[x] of the set type, such as *bool, or nil when it is not set.
+func (r *MedicationRequest) Reported() interface{} {
+	switch {
+	case r.ReportedBoolean != nil:
+		return r.ReportedBoolean
+	case r.ReportedReference != nil:
+		return r.ReportedReference
+	}
+	return nil
+}
+
+// SetReportedBoolean sets MedicationRequest.reported[x] to the boolean and clears the other types.
+func (r *MedicationRequest) SetReportedBoolean(v bool) {
+	r.clearReported()
+	r.ReportedBoolean = &v
+}
+
+// SetReportedReference sets MedicationRequest.reported[x] to the Reference and clears the other types.
+func (r *MedicationRequest) SetReportedReference(v Reference) {
+	r.clearReported()
+	r.ReportedReference = &v
+}
+func (r *MedicationRequest) clearReported() {
+	r.ReportedBoolean = nil
+	r.ReportedBooleanElement = nil
+	r.ReportedReference = nil
 }
+
+// Medication returns MedicationRequest.medication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationRequest) Medication() interface{} {
+	switch {
+	case r.MedicationCodeableConcept != nil:
+		return r.MedicationCodeableConcept
+	case r.MedicationReference != nil:
+		return r.MedicationReference
+	}
+	return nil
+}
+
+// SetMedicationCodeableConcept sets MedicationRequest.medication[x] to the CodeableConcept and clears the other types.
+func (r *MedicationRequest) SetMedicationCodeableConcept(v CodeableConcept) {
+	r.clearMedication()
+	r.MedicationCodeableConcept = &v
+}
+
+// SetMedicationReference sets MedicationRequest.medication[x] to the Reference and clears the other types.
+func (r *MedicationRequest) SetMedicationReference(v Reference) {
+	r.clearMedication()
+	r.MedicationReference = &v
+}
+func (r *MedicationRequest) clearMedication() {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationRequest and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *MedicationRequest) UnmarshalJSON(b []byte) error {
+	type other MedicationRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationRequest.reported[x]",
+		r.ReportedBoolean != nil || r.ReportedBooleanElement != nil,
+		r.ReportedReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationRequest.medication[x]",
+		r.MedicationCodeableConcept != nil,
+		r.MedicationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("MedicationRequest.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MedicationRequest.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("MedicationRequest", r)
+}
+
 type OtherMedicationRequest MedicationRequest
 
 // MarshalJSON marshals the given MedicationRequest as JSON into a byte slice
 func (r MedicationRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicationRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicationRequest: OtherMedicationRequest(r),
 		ResourceType:           "MedicationRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicationRequest unmarshals a MedicationRequest.
@@ -22,40 +22,134 @@ import "encoding/json"
 
 // MedicationStatement is documented here http://hl7.org/fhir/StructureDefinition/MedicationStatement
 type MedicationStatement struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf            []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status            string            `bson:"status" json:"status"`
-	StatusReason      []CodeableConcept `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category          *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	Subject           Reference         `bson:"subject" json:"subject"`
-	Context           *Reference        `bson:"context,omitempty" json:"context,omitempty"`
-	DateAsserted      *DateTime         `bson:"dateAsserted,omitempty" json:"dateAsserted,omitempty"`
-	InformationSource *Reference        `bson:"informationSource,omitempty" json:"informationSource,omitempty"`
-	DerivedFrom       []Reference       `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	ReasonCode        []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note              []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-	Dosage            []Dosage          `bson:"dosage,omitempty" json:"dosage,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                    []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                    string                     `bson:"status" json:"status"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              []CodeableConcept          `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                  *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	MedicationCodeableConcept *CodeableConcept           `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference                 `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	Subject                   Reference                  `bson:"subject" json:"subject"`
+	Context                   *Reference                 `bson:"context,omitempty" json:"context,omitempty"`
+	EffectiveDateTime         *DateTime                  `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement  *Element                   `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod           *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	DateAsserted              *DateTime                  `bson:"dateAsserted,omitempty" json:"dateAsserted,omitempty"`
+	DateAssertedElement       *Element                   `bson:"_dateAsserted,omitempty" json:"_dateAsserted,omitempty"`
+	InformationSource         *Reference                 `bson:"informationSource,omitempty" json:"informationSource,omitempty"`
+	DerivedFrom               []Reference                `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	ReasonCode                []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                      []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Dosage                    []Dosage                   `bson:"dosage,omitempty" json:"dosage,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Medication returns MedicationStatement.medication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicationStatement) Medication() interface{} {
+	switch {
+	case r.MedicationCodeableConcept != nil:
+		return r.MedicationCodeableConcept
+	case r.MedicationReference != nil:
+		return r.MedicationReference
+	}
+	return nil
+}
+
+// SetMedicationCodeableConcept sets MedicationStatement.medication[x] to the CodeableConcept and clears the other types.
+func (r *MedicationStatement) SetMedicationCodeableConcept(v CodeableConcept) {
+	r.clearMedication()
+	r.MedicationCodeableConcept = &v
+}
+
+// SetMedicationReference sets MedicationStatement.medication[x] to the Reference and clears the other types.
+func (r *MedicationStatement) SetMedicationReference(v Reference) {
+	r.clearMedication()
+	r.MedicationReference = &v
+}
+func (r *MedicationStatement) clearMedication() {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+}
+
+// Effective returns MedicationStatement.effective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *MedicationStatement) Effective() interface{} {
+	switch {
+	case r.EffectiveDateTime != nil:
+		return r.EffectiveDateTime
+	case r.EffectivePeriod != nil:
+		return r.EffectivePeriod
+	}
+	return nil
+}
+
+// SetEffectiveDateTime sets MedicationStatement.effective[x] to the dateTime and clears the other types.
+func (r *MedicationStatement) SetEffectiveDateTime(v DateTime) {
+	r.clearEffective()
+	r.EffectiveDateTime = &v
+}
+
+// SetEffectivePeriod sets MedicationStatement.effective[x] to the Period and clears the other types.
+func (r *MedicationStatement) SetEffectivePeriod(v Period) {
+	r.clearEffective()
+	r.EffectivePeriod = &v
+}
+func (r *MedicationStatement) clearEffective() {
+	r.EffectiveDateTime = nil
+	r.EffectiveDateTimeElement = nil
+	r.EffectivePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the MedicationStatement and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *MedicationStatement) UnmarshalJSON(b []byte) error {
+	type other MedicationStatement
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationStatement.medication[x]",
+		r.MedicationCodeableConcept != nil,
+		r.MedicationReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicationStatement.effective[x]",
+		r.EffectiveDateTime != nil || r.EffectiveDateTimeElement != nil,
+		r.EffectivePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("MedicationStatement", r)
+}
+
 type OtherMedicationStatement MedicationStatement
 
 // MarshalJSON marshals the given MedicationStatement as JSON into a byte slice
 func (r MedicationStatement) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicationStatement
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicationStatement: OtherMedicationStatement(r),
 		ResourceType:             "MedicationStatement",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicationStatement unmarshals a MedicationStatement.
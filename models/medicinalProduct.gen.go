@@ -25,8 +25,11 @@ type MedicinalProduct struct {
 	ID                             *string                                          `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                           *Meta                                            `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules                  *string                                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement           *Element                                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                       *string                                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement                *Element                                         `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                           *Narrative                                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                      ContainedResources                               `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                      []Extension                                      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension              []Extension                                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier                     []Identifier                                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
@@ -35,7 +38,8 @@ type MedicinalProduct struct {
 	CombinedPharmaceuticalDoseForm *CodeableConcept                                 `bson:"combinedPharmaceuticalDoseForm,omitempty" json:"combinedPharmaceuticalDoseForm,omitempty"`
 	LegalStatusOfSupply            *CodeableConcept                                 `bson:"legalStatusOfSupply,omitempty" json:"legalStatusOfSupply,omitempty"`
 	AdditionalMonitoringIndicator  *CodeableConcept                                 `bson:"additionalMonitoringIndicator,omitempty" json:"additionalMonitoringIndicator,omitempty"`
-	SpecialMeasures                []string                                         `bson:"specialMeasures,omitempty" json:"specialMeasures,omitempty"`
+	SpecialMeasures                []*string                                        `bson:"specialMeasures,omitempty" json:"specialMeasures,omitempty"`
+	SpecialMeasuresElement         []*Element                                       `bson:"_specialMeasures,omitempty" json:"_specialMeasures,omitempty"`
 	PaediatricUseIndicator         *CodeableConcept                                 `bson:"paediatricUseIndicator,omitempty" json:"paediatricUseIndicator,omitempty"`
 	ProductClassification          []CodeableConcept                                `bson:"productClassification,omitempty" json:"productClassification,omitempty"`
 	MarketingStatus                []MarketingStatus                                `bson:"marketingStatus,omitempty" json:"marketingStatus,omitempty"`
@@ -49,63 +53,188 @@ type MedicinalProduct struct {
 	CrossReference                 []Identifier                                     `bson:"crossReference,omitempty" json:"crossReference,omitempty"`
 	ManufacturingBusinessOperation []MedicinalProductManufacturingBusinessOperation `bson:"manufacturingBusinessOperation,omitempty" json:"manufacturingBusinessOperation,omitempty"`
 	SpecialDesignation             []MedicinalProductSpecialDesignation             `bson:"specialDesignation,omitempty" json:"specialDesignation,omitempty"`
+	UnknownFields                  map[string]json.RawMessage                       `bson:"-" json:"-"`
 }
 type MedicinalProductName struct {
-	ID                *string                               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ProductName       string                                `bson:"productName" json:"productName"`
-	NamePart          []MedicinalProductNameNamePart        `bson:"namePart,omitempty" json:"namePart,omitempty"`
-	CountryLanguage   []MedicinalProductNameCountryLanguage `bson:"countryLanguage,omitempty" json:"countryLanguage,omitempty"`
+	ID                 *string                               `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ProductName        string                                `bson:"productName" json:"productName"`
+	ProductNameElement *Element                              `bson:"_productName,omitempty" json:"_productName,omitempty"`
+	NamePart           []MedicinalProductNameNamePart        `bson:"namePart,omitempty" json:"namePart,omitempty"`
+	CountryLanguage    []MedicinalProductNameCountryLanguage `bson:"countryLanguage,omitempty" json:"countryLanguage,omitempty"`
+	UnknownFields      map[string]json.RawMessage            `bson:"-" json:"-"`
 }
 type MedicinalProductNameNamePart struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Part              string      `bson:"part" json:"part"`
-	Type              Coding      `bson:"type" json:"type"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Part              string                     `bson:"part" json:"part"`
+	PartElement       *Element                   `bson:"_part,omitempty" json:"_part,omitempty"`
+	Type              Coding                     `bson:"type" json:"type"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicinalProductNameNamePart with its unknown fields.
+func (r MedicinalProductNameNamePart) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductNameNamePart
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicinalProductNameCountryLanguage struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Country           CodeableConcept  `bson:"country" json:"country"`
-	Jurisdiction      *CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Language          CodeableConcept  `bson:"language" json:"language"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Country           CodeableConcept            `bson:"country" json:"country"`
+	Jurisdiction      *CodeableConcept           `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Language          CodeableConcept            `bson:"language" json:"language"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicinalProductNameCountryLanguage with its unknown fields.
+func (r MedicinalProductNameCountryLanguage) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductNameCountryLanguage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MedicinalProductName with its unknown fields.
+func (r MedicinalProductName) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductName
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicinalProductManufacturingBusinessOperation struct {
-	ID                           *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                    []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension            []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	OperationType                *CodeableConcept `bson:"operationType,omitempty" json:"operationType,omitempty"`
-	AuthorisationReferenceNumber *Identifier      `bson:"authorisationReferenceNumber,omitempty" json:"authorisationReferenceNumber,omitempty"`
-	EffectiveDate                *DateTime        `bson:"effectiveDate,omitempty" json:"effectiveDate,omitempty"`
-	ConfidentialityIndicator     *CodeableConcept `bson:"confidentialityIndicator,omitempty" json:"confidentialityIndicator,omitempty"`
-	Manufacturer                 []Reference      `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	Regulator                    *Reference       `bson:"regulator,omitempty" json:"regulator,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	OperationType                *CodeableConcept           `bson:"operationType,omitempty" json:"operationType,omitempty"`
+	AuthorisationReferenceNumber *Identifier                `bson:"authorisationReferenceNumber,omitempty" json:"authorisationReferenceNumber,omitempty"`
+	EffectiveDate                *DateTime                  `bson:"effectiveDate,omitempty" json:"effectiveDate,omitempty"`
+	EffectiveDateElement         *Element                   `bson:"_effectiveDate,omitempty" json:"_effectiveDate,omitempty"`
+	ConfidentialityIndicator     *CodeableConcept           `bson:"confidentialityIndicator,omitempty" json:"confidentialityIndicator,omitempty"`
+	Manufacturer                 []Reference                `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Regulator                    *Reference                 `bson:"regulator,omitempty" json:"regulator,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicinalProductManufacturingBusinessOperation with its unknown fields.
+func (r MedicinalProductManufacturingBusinessOperation) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductManufacturingBusinessOperation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MedicinalProductSpecialDesignation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	IntendedUse       *CodeableConcept `bson:"intendedUse,omitempty" json:"intendedUse,omitempty"`
-	Status            *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	Species           *CodeableConcept `bson:"species,omitempty" json:"species,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                      *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	IntendedUse               *CodeableConcept           `bson:"intendedUse,omitempty" json:"intendedUse,omitempty"`
+	IndicationCodeableConcept *CodeableConcept           `bson:"indicationCodeableConcept,omitempty" json:"indicationCodeableConcept,omitempty"`
+	IndicationReference       *Reference                 `bson:"indicationReference,omitempty" json:"indicationReference,omitempty"`
+	Status                    *CodeableConcept           `bson:"status,omitempty" json:"status,omitempty"`
+	Date                      *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement               *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Species                   *CodeableConcept           `bson:"species,omitempty" json:"species,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Indication returns MedicinalProduct.specialDesignation.indication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicinalProductSpecialDesignation) Indication() interface{} {
+	switch {
+	case r.IndicationCodeableConcept != nil:
+		return r.IndicationCodeableConcept
+	case r.IndicationReference != nil:
+		return r.IndicationReference
+	}
+	return nil
 }
+
+// SetIndicationCodeableConcept sets MedicinalProduct.specialDesignation.indication[x] to the CodeableConcept and clears the other types.
+func (r *MedicinalProductSpecialDesignation) SetIndicationCodeableConcept(v CodeableConcept) {
+	r.clearIndication()
+	r.IndicationCodeableConcept = &v
+}
+
+// SetIndicationReference sets MedicinalProduct.specialDesignation.indication[x] to the Reference and clears the other types.
+func (r *MedicinalProductSpecialDesignation) SetIndicationReference(v Reference) {
+	r.clearIndication()
+	r.IndicationReference = &v
+}
+func (r *MedicinalProductSpecialDesignation) clearIndication() {
+	r.IndicationCodeableConcept = nil
+	r.IndicationReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductSpecialDesignation and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicinalProductSpecialDesignation) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductSpecialDesignation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicinalProduct.specialDesignation.indication[x]",
+		r.IndicationCodeableConcept != nil,
+		r.IndicationReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicinalProductSpecialDesignation with its unknown fields.
+func (r MedicinalProductSpecialDesignation) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductSpecialDesignation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProduct and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *MedicinalProduct) UnmarshalJSON(b []byte) error {
+	type other MedicinalProduct
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("MedicinalProduct.specialMeasures", len(r.SpecialMeasures), len(r.SpecialMeasuresElement)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProduct", r)
+}
+
 type OtherMedicinalProduct MedicinalProduct
 
 // MarshalJSON marshals the given MedicinalProduct as JSON into a byte slice
 func (r MedicinalProduct) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProduct
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProduct: OtherMedicinalProduct(r),
 		ResourceType:          "MedicinalProduct",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProduct unmarshals a MedicinalProduct.
@@ -22,59 +22,151 @@ import "encoding/json"
 
 // MedicinalProductAuthorization is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductAuthorization
 type MedicinalProductAuthorization struct {
-	ID                          *string                                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                        *Meta                                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules               *string                                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                    *string                                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text                        *Narrative                                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                   []Extension                                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension           []Extension                                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier                  []Identifier                                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Subject                     *Reference                                                 `bson:"subject,omitempty" json:"subject,omitempty"`
-	Country                     []CodeableConcept                                          `bson:"country,omitempty" json:"country,omitempty"`
-	Jurisdiction                []CodeableConcept                                          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Status                      *CodeableConcept                                           `bson:"status,omitempty" json:"status,omitempty"`
-	StatusDate                  *DateTime                                                  `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	RestoreDate                 *DateTime                                                  `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
-	ValidityPeriod              *Period                                                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
-	DataExclusivityPeriod       *Period                                                    `bson:"dataExclusivityPeriod,omitempty" json:"dataExclusivityPeriod,omitempty"`
-	DateOfFirstAuthorization    *DateTime                                                  `bson:"dateOfFirstAuthorization,omitempty" json:"dateOfFirstAuthorization,omitempty"`
-	InternationalBirthDate      *DateTime                                                  `bson:"internationalBirthDate,omitempty" json:"internationalBirthDate,omitempty"`
-	LegalBasis                  *CodeableConcept                                           `bson:"legalBasis,omitempty" json:"legalBasis,omitempty"`
-	JurisdictionalAuthorization []MedicinalProductAuthorizationJurisdictionalAuthorization `bson:"jurisdictionalAuthorization,omitempty" json:"jurisdictionalAuthorization,omitempty"`
-	Holder                      *Reference                                                 `bson:"holder,omitempty" json:"holder,omitempty"`
-	Regulator                   *Reference                                                 `bson:"regulator,omitempty" json:"regulator,omitempty"`
-	Procedure                   *MedicinalProductAuthorizationProcedure                    `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	ID                              *string                                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                            *Meta                                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                   *string                                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement            *Element                                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                        *string                                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement                 *Element                                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                            *Narrative                                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                       ContainedResources                                         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                       []Extension                                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                      []Identifier                                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Subject                         *Reference                                                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Country                         []CodeableConcept                                          `bson:"country,omitempty" json:"country,omitempty"`
+	Jurisdiction                    []CodeableConcept                                          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Status                          *CodeableConcept                                           `bson:"status,omitempty" json:"status,omitempty"`
+	StatusDate                      *DateTime                                                  `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement               *Element                                                   `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	RestoreDate                     *DateTime                                                  `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
+	RestoreDateElement              *Element                                                   `bson:"_restoreDate,omitempty" json:"_restoreDate,omitempty"`
+	ValidityPeriod                  *Period                                                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
+	DataExclusivityPeriod           *Period                                                    `bson:"dataExclusivityPeriod,omitempty" json:"dataExclusivityPeriod,omitempty"`
+	DateOfFirstAuthorization        *DateTime                                                  `bson:"dateOfFirstAuthorization,omitempty" json:"dateOfFirstAuthorization,omitempty"`
+	DateOfFirstAuthorizationElement *Element                                                   `bson:"_dateOfFirstAuthorization,omitempty" json:"_dateOfFirstAuthorization,omitempty"`
+	InternationalBirthDate          *DateTime                                                  `bson:"internationalBirthDate,omitempty" json:"internationalBirthDate,omitempty"`
+	InternationalBirthDateElement   *Element                                                   `bson:"_internationalBirthDate,omitempty" json:"_internationalBirthDate,omitempty"`
+	LegalBasis                      *CodeableConcept                                           `bson:"legalBasis,omitempty" json:"legalBasis,omitempty"`
+	JurisdictionalAuthorization     []MedicinalProductAuthorizationJurisdictionalAuthorization `bson:"jurisdictionalAuthorization,omitempty" json:"jurisdictionalAuthorization,omitempty"`
+	Holder                          *Reference                                                 `bson:"holder,omitempty" json:"holder,omitempty"`
+	Regulator                       *Reference                                                 `bson:"regulator,omitempty" json:"regulator,omitempty"`
+	Procedure                       *MedicinalProductAuthorizationProcedure                    `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	UnknownFields                   map[string]json.RawMessage                                 `bson:"-" json:"-"`
 }
 type MedicinalProductAuthorizationJurisdictionalAuthorization struct {
-	ID                  *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Country             *CodeableConcept  `bson:"country,omitempty" json:"country,omitempty"`
-	Jurisdiction        []CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	LegalStatusOfSupply *CodeableConcept  `bson:"legalStatusOfSupply,omitempty" json:"legalStatusOfSupply,omitempty"`
-	ValidityPeriod      *Period           `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier          []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Country             *CodeableConcept           `bson:"country,omitempty" json:"country,omitempty"`
+	Jurisdiction        []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	LegalStatusOfSupply *CodeableConcept           `bson:"legalStatusOfSupply,omitempty" json:"legalStatusOfSupply,omitempty"`
+	ValidityPeriod      *Period                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicinalProductAuthorizationJurisdictionalAuthorization with its unknown fields.
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductAuthorizationJurisdictionalAuthorization
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicinalProductAuthorizationProcedure struct {
-	ID                *string                                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              CodeableConcept                          `bson:"type" json:"type"`
-	Application       []MedicinalProductAuthorizationProcedure `bson:"application,omitempty" json:"application,omitempty"`
+	ID                  *string                                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier          *Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                CodeableConcept                          `bson:"type" json:"type"`
+	DatePeriod          *Period                                  `bson:"datePeriod,omitempty" json:"datePeriod,omitempty"`
+	DateDateTime        *DateTime                                `bson:"dateDateTime,omitempty" json:"dateDateTime,omitempty"`
+	DateDateTimeElement *Element                                 `bson:"_dateDateTime,omitempty" json:"_dateDateTime,omitempty"`
+	Application         []MedicinalProductAuthorizationProcedure `bson:"application,omitempty" json:"application,omitempty"`
+	UnknownFields       map[string]json.RawMessage               `bson:"-" json:"-"`
+}
+
+// Date returns MedicinalProductAuthorization.procedure.date[x] of the set type, such as *Period, or nil when it is not set.
+func (r *MedicinalProductAuthorizationProcedure) Date() interface{} {
+	switch {
+	case r.DatePeriod != nil:
+		return r.DatePeriod
+	case r.DateDateTime != nil:
+		return r.DateDateTime
+	}
+	return nil
+}
+
+// SetDatePeriod sets MedicinalProductAuthorization.procedure.date[x] to the Period and clears the other types.
+func (r *MedicinalProductAuthorizationProcedure) SetDatePeriod(v Period) {
+	r.clearDate()
+	r.DatePeriod = &v
+}
+
+// SetDateDateTime sets MedicinalProductAuthorization.procedure.date[x] to the dateTime and clears the other types.
+func (r *MedicinalProductAuthorizationProcedure) SetDateDateTime(v DateTime) {
+	r.clearDate()
+	r.DateDateTime = &v
+}
+func (r *MedicinalProductAuthorizationProcedure) clearDate() {
+	r.DatePeriod = nil
+	r.DateDateTime = nil
+	r.DateDateTimeElement = nil
 }
+
+// UnmarshalJSON unmarshals the MedicinalProductAuthorizationProcedure and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicinalProductAuthorizationProcedure) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductAuthorizationProcedure
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicinalProductAuthorization.procedure.date[x]",
+		r.DatePeriod != nil,
+		r.DateDateTime != nil || r.DateDateTimeElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicinalProductAuthorizationProcedure with its unknown fields.
+func (r MedicinalProductAuthorizationProcedure) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductAuthorizationProcedure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductAuthorization and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductAuthorization) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductAuthorization
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductAuthorization", r)
+}
+
 type OtherMedicinalProductAuthorization MedicinalProductAuthorization
 
 // MarshalJSON marshals the given MedicinalProductAuthorization as JSON into a byte slice
 func (r MedicinalProductAuthorization) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductAuthorization
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductAuthorization: OtherMedicinalProductAuthorization(r),
 		ResourceType:                       "MedicinalProductAuthorization",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductAuthorization unmarshals a MedicinalProductAuthorization.
@@ -25,8 +25,11 @@ type MedicinalProductContraindication struct {
 	ID                    *string                                        `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                  *Meta                                          `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                                       `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                             `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Subject               []Reference                                    `bson:"subject,omitempty" json:"subject,omitempty"`
@@ -36,24 +39,95 @@ type MedicinalProductContraindication struct {
 	TherapeuticIndication []Reference                                    `bson:"therapeuticIndication,omitempty" json:"therapeuticIndication,omitempty"`
 	OtherTherapy          []MedicinalProductContraindicationOtherTherapy `bson:"otherTherapy,omitempty" json:"otherTherapy,omitempty"`
 	Population            []Population                                   `bson:"population,omitempty" json:"population,omitempty"`
+	UnknownFields         map[string]json.RawMessage                     `bson:"-" json:"-"`
 }
 type MedicinalProductContraindicationOtherTherapy struct {
-	ID                      *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	TherapyRelationshipType CodeableConcept `bson:"therapyRelationshipType" json:"therapyRelationshipType"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	TherapyRelationshipType   CodeableConcept            `bson:"therapyRelationshipType" json:"therapyRelationshipType"`
+	MedicationCodeableConcept *CodeableConcept           `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference                 `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Medication returns MedicinalProductContraindication.otherTherapy.medication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicinalProductContraindicationOtherTherapy) Medication() interface{} {
+	switch {
+	case r.MedicationCodeableConcept != nil:
+		return r.MedicationCodeableConcept
+	case r.MedicationReference != nil:
+		return r.MedicationReference
+	}
+	return nil
+}
+
+// SetMedicationCodeableConcept sets MedicinalProductContraindication.otherTherapy.medication[x] to the CodeableConcept and clears the other types.
+func (r *MedicinalProductContraindicationOtherTherapy) SetMedicationCodeableConcept(v CodeableConcept) {
+	r.clearMedication()
+	r.MedicationCodeableConcept = &v
+}
+
+// SetMedicationReference sets MedicinalProductContraindication.otherTherapy.medication[x] to the Reference and clears the other types.
+func (r *MedicinalProductContraindicationOtherTherapy) SetMedicationReference(v Reference) {
+	r.clearMedication()
+	r.MedicationReference = &v
+}
+func (r *MedicinalProductContraindicationOtherTherapy) clearMedication() {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductContraindicationOtherTherapy and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicinalProductContraindicationOtherTherapy) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductContraindicationOtherTherapy
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicinalProductContraindication.otherTherapy.medication[x]",
+		r.MedicationCodeableConcept != nil,
+		r.MedicationReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicinalProductContraindicationOtherTherapy with its unknown fields.
+func (r MedicinalProductContraindicationOtherTherapy) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductContraindicationOtherTherapy
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductContraindication and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductContraindication) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductContraindication
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductContraindication", r)
+}
+
 type OtherMedicinalProductContraindication MedicinalProductContraindication
 
 // MarshalJSON marshals the given MedicinalProductContraindication as JSON into a byte slice
 func (r MedicinalProductContraindication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductContraindication
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductContraindication: OtherMedicinalProductContraindication(r),
 		ResourceType:                          "MedicinalProductContraindication",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductContraindication unmarshals a MedicinalProductContraindication.
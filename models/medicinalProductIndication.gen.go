@@ -25,8 +25,11 @@ type MedicinalProductIndication struct {
 	ID                      *string                                  `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                    *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules           *string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                *string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                                 `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                    *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources                       `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension               []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension       []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Subject                 []Reference                              `bson:"subject,omitempty" json:"subject,omitempty"`
@@ -38,24 +41,95 @@ type MedicinalProductIndication struct {
 	OtherTherapy            []MedicinalProductIndicationOtherTherapy `bson:"otherTherapy,omitempty" json:"otherTherapy,omitempty"`
 	UndesirableEffect       []Reference                              `bson:"undesirableEffect,omitempty" json:"undesirableEffect,omitempty"`
 	Population              []Population                             `bson:"population,omitempty" json:"population,omitempty"`
+	UnknownFields           map[string]json.RawMessage               `bson:"-" json:"-"`
 }
 type MedicinalProductIndicationOtherTherapy struct {
-	ID                      *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	TherapyRelationshipType CodeableConcept `bson:"therapyRelationshipType" json:"therapyRelationshipType"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	TherapyRelationshipType   CodeableConcept            `bson:"therapyRelationshipType" json:"therapyRelationshipType"`
+	MedicationCodeableConcept *CodeableConcept           `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference                 `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Medication returns MedicinalProductIndication.otherTherapy.medication[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *MedicinalProductIndicationOtherTherapy) Medication() interface{} {
+	switch {
+	case r.MedicationCodeableConcept != nil:
+		return r.MedicationCodeableConcept
+	case r.MedicationReference != nil:
+		return r.MedicationReference
+	}
+	return nil
+}
+
+// SetMedicationCodeableConcept sets MedicinalProductIndication.otherTherapy.medication[x] to the CodeableConcept and clears the other types.
+func (r *MedicinalProductIndicationOtherTherapy) SetMedicationCodeableConcept(v CodeableConcept) {
+	r.clearMedication()
+	r.MedicationCodeableConcept = &v
+}
+
+// SetMedicationReference sets MedicinalProductIndication.otherTherapy.medication[x] to the Reference and clears the other types.
+func (r *MedicinalProductIndicationOtherTherapy) SetMedicationReference(v Reference) {
+	r.clearMedication()
+	r.MedicationReference = &v
+}
+func (r *MedicinalProductIndicationOtherTherapy) clearMedication() {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductIndicationOtherTherapy and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicinalProductIndicationOtherTherapy) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductIndicationOtherTherapy
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicinalProductIndication.otherTherapy.medication[x]",
+		r.MedicationCodeableConcept != nil,
+		r.MedicationReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicinalProductIndicationOtherTherapy with its unknown fields.
+func (r MedicinalProductIndicationOtherTherapy) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductIndicationOtherTherapy
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductIndication and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductIndication) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductIndication
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductIndication", r)
+}
+
 type OtherMedicinalProductIndication MedicinalProductIndication
 
 // MarshalJSON marshals the given MedicinalProductIndication as JSON into a byte slice
 func (r MedicinalProductIndication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductIndication
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductIndication: OtherMedicinalProductIndication(r),
 		ResourceType:                    "MedicinalProductIndication",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductIndication unmarshals a MedicinalProductIndication.
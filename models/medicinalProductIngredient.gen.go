@@ -22,19 +22,24 @@ import "encoding/json"
 
 // MedicinalProductIngredient is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductIngredient
 type MedicinalProductIngredient struct {
-	ID                  *string                                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          *Identifier                                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Role                CodeableConcept                                `bson:"role" json:"role"`
-	AllergenicIndicator *bool                                          `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
-	Manufacturer        []Reference                                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	SpecifiedSubstance  []MedicinalProductIngredientSpecifiedSubstance `bson:"specifiedSubstance,omitempty" json:"specifiedSubstance,omitempty"`
-	Substance           *MedicinalProductIngredientSubstance           `bson:"substance,omitempty" json:"substance,omitempty"`
+	ID                         *string                                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                       *Meta                                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  ContainedResources                             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                 *Identifier                                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Role                       CodeableConcept                                `bson:"role" json:"role"`
+	AllergenicIndicator        *bool                                          `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *Element                                       `bson:"_allergenicIndicator,omitempty" json:"_allergenicIndicator,omitempty"`
+	Manufacturer               []Reference                                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	SpecifiedSubstance         []MedicinalProductIngredientSpecifiedSubstance `bson:"specifiedSubstance,omitempty" json:"specifiedSubstance,omitempty"`
+	Substance                  *MedicinalProductIngredientSubstance           `bson:"substance,omitempty" json:"substance,omitempty"`
+	UnknownFields              map[string]json.RawMessage                     `bson:"-" json:"-"`
 }
 type MedicinalProductIngredientSpecifiedSubstance struct {
 	ID                *string                                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -44,47 +49,108 @@ type MedicinalProductIngredientSpecifiedSubstance struct {
 	Group             CodeableConcept                                        `bson:"group" json:"group"`
 	Confidentiality   *CodeableConcept                                       `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
 	Strength          []MedicinalProductIngredientSpecifiedSubstanceStrength `bson:"strength,omitempty" json:"strength,omitempty"`
+	UnknownFields     map[string]json.RawMessage                             `bson:"-" json:"-"`
 }
 type MedicinalProductIngredientSpecifiedSubstanceStrength struct {
-	ID                    *string                                                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension                                                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Presentation          Ratio                                                                   `bson:"presentation" json:"presentation"`
-	PresentationLowLimit  *Ratio                                                                  `bson:"presentationLowLimit,omitempty" json:"presentationLowLimit,omitempty"`
-	Concentration         *Ratio                                                                  `bson:"concentration,omitempty" json:"concentration,omitempty"`
-	ConcentrationLowLimit *Ratio                                                                  `bson:"concentrationLowLimit,omitempty" json:"concentrationLowLimit,omitempty"`
-	MeasurementPoint      *string                                                                 `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
-	Country               []CodeableConcept                                                       `bson:"country,omitempty" json:"country,omitempty"`
-	ReferenceStrength     []MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength `bson:"referenceStrength,omitempty" json:"referenceStrength,omitempty"`
+	ID                      *string                                                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                                                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Presentation            Ratio                                                                   `bson:"presentation" json:"presentation"`
+	PresentationLowLimit    *Ratio                                                                  `bson:"presentationLowLimit,omitempty" json:"presentationLowLimit,omitempty"`
+	Concentration           *Ratio                                                                  `bson:"concentration,omitempty" json:"concentration,omitempty"`
+	ConcentrationLowLimit   *Ratio                                                                  `bson:"concentrationLowLimit,omitempty" json:"concentrationLowLimit,omitempty"`
+	MeasurementPoint        *string                                                                 `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
+	MeasurementPointElement *Element                                                                `bson:"_measurementPoint,omitempty" json:"_measurementPoint,omitempty"`
+	Country                 []CodeableConcept                                                       `bson:"country,omitempty" json:"country,omitempty"`
+	ReferenceStrength       []MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength `bson:"referenceStrength,omitempty" json:"referenceStrength,omitempty"`
+	UnknownFields           map[string]json.RawMessage                                              `bson:"-" json:"-"`
 }
 type MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Substance         *CodeableConcept  `bson:"substance,omitempty" json:"substance,omitempty"`
-	Strength          Ratio             `bson:"strength" json:"strength"`
-	StrengthLowLimit  *Ratio            `bson:"strengthLowLimit,omitempty" json:"strengthLowLimit,omitempty"`
-	MeasurementPoint  *string           `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
-	Country           []CodeableConcept `bson:"country,omitempty" json:"country,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Substance               *CodeableConcept           `bson:"substance,omitempty" json:"substance,omitempty"`
+	Strength                Ratio                      `bson:"strength" json:"strength"`
+	StrengthLowLimit        *Ratio                     `bson:"strengthLowLimit,omitempty" json:"strengthLowLimit,omitempty"`
+	MeasurementPoint        *string                    `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
+	MeasurementPointElement *Element                   `bson:"_measurementPoint,omitempty" json:"_measurementPoint,omitempty"`
+	Country                 []CodeableConcept          `bson:"country,omitempty" json:"country,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength with its unknown fields.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MedicinalProductIngredientSpecifiedSubstanceStrength with its unknown fields.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductIngredientSpecifiedSubstanceStrength
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MedicinalProductIngredientSpecifiedSubstance with its unknown fields.
+func (r MedicinalProductIngredientSpecifiedSubstance) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductIngredientSpecifiedSubstance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicinalProductIngredientSubstance struct {
 	ID                *string                                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              CodeableConcept                                        `bson:"code" json:"code"`
 	Strength          []MedicinalProductIngredientSpecifiedSubstanceStrength `bson:"strength,omitempty" json:"strength,omitempty"`
+	UnknownFields     map[string]json.RawMessage                             `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicinalProductIngredientSubstance with its unknown fields.
+func (r MedicinalProductIngredientSubstance) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductIngredientSubstance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductIngredient and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductIngredient) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductIngredient
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductIngredient", r)
+}
+
 type OtherMedicinalProductIngredient MedicinalProductIngredient
 
 // MarshalJSON marshals the given MedicinalProductIngredient as JSON into a byte slice
 func (r MedicinalProductIngredient) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductIngredient
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductIngredient: OtherMedicinalProductIngredient(r),
 		ResourceType:                    "MedicinalProductIngredient",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductIngredient unmarshals a MedicinalProductIngredient.
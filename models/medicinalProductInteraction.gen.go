@@ -22,37 +22,112 @@ import "encoding/json"
 
 // MedicinalProductInteraction is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductInteraction
 type MedicinalProductInteraction struct {
-	ID                *string                                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Subject           []Reference                              `bson:"subject,omitempty" json:"subject,omitempty"`
-	Description       *string                                  `bson:"description,omitempty" json:"description,omitempty"`
-	Interactant       []MedicinalProductInteractionInteractant `bson:"interactant,omitempty" json:"interactant,omitempty"`
-	Type              *CodeableConcept                         `bson:"type,omitempty" json:"type,omitempty"`
-	Effect            *CodeableConcept                         `bson:"effect,omitempty" json:"effect,omitempty"`
-	Incidence         *CodeableConcept                         `bson:"incidence,omitempty" json:"incidence,omitempty"`
-	Management        *CodeableConcept                         `bson:"management,omitempty" json:"management,omitempty"`
+	ID                   *string                                  `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                 `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subject              []Reference                              `bson:"subject,omitempty" json:"subject,omitempty"`
+	Description          *string                                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                                 `bson:"_description,omitempty" json:"_description,omitempty"`
+	Interactant          []MedicinalProductInteractionInteractant `bson:"interactant,omitempty" json:"interactant,omitempty"`
+	Type                 *CodeableConcept                         `bson:"type,omitempty" json:"type,omitempty"`
+	Effect               *CodeableConcept                         `bson:"effect,omitempty" json:"effect,omitempty"`
+	Incidence            *CodeableConcept                         `bson:"incidence,omitempty" json:"incidence,omitempty"`
+	Management           *CodeableConcept                         `bson:"management,omitempty" json:"management,omitempty"`
+	UnknownFields        map[string]json.RawMessage               `bson:"-" json:"-"`
 }
 type MedicinalProductInteractionInteractant struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemReference       *Reference                 `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	ItemCodeableConcept *CodeableConcept           `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Item returns MedicinalProductInteraction.interactant.item[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *MedicinalProductInteractionInteractant) Item() interface{} {
+	switch {
+	case r.ItemReference != nil:
+		return r.ItemReference
+	case r.ItemCodeableConcept != nil:
+		return r.ItemCodeableConcept
+	}
+	return nil
+}
+
+// SetItemReference sets MedicinalProductInteraction.interactant.item[x] to the Reference and clears the other types.
+func (r *MedicinalProductInteractionInteractant) SetItemReference(v Reference) {
+	r.clearItem()
+	r.ItemReference = &v
+}
+
+// SetItemCodeableConcept sets MedicinalProductInteraction.interactant.item[x] to the CodeableConcept and clears the other types.
+func (r *MedicinalProductInteractionInteractant) SetItemCodeableConcept(v CodeableConcept) {
+	r.clearItem()
+	r.ItemCodeableConcept = &v
+}
+func (r *MedicinalProductInteractionInteractant) clearItem() {
+	r.ItemReference = nil
+	r.ItemCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductInteractionInteractant and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *MedicinalProductInteractionInteractant) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductInteractionInteractant
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MedicinalProductInteraction.interactant.item[x]",
+		r.ItemReference != nil,
+		r.ItemCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MedicinalProductInteractionInteractant with its unknown fields.
+func (r MedicinalProductInteractionInteractant) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductInteractionInteractant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductInteraction and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductInteraction) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductInteraction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductInteraction", r)
+}
+
 type OtherMedicinalProductInteraction MedicinalProductInteraction
 
 // MarshalJSON marshals the given MedicinalProductInteraction as JSON into a byte slice
 func (r MedicinalProductInteraction) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductInteraction
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductInteraction: OtherMedicinalProductInteraction(r),
 		ResourceType:                     "MedicinalProductInteraction",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductInteraction unmarshals a MedicinalProductInteraction.
@@ -22,32 +22,50 @@ import "encoding/json"
 
 // MedicinalProductManufactured is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductManufactured
 type MedicinalProductManufactured struct {
-	ID                      *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                    *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules           *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text                    *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension               []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ManufacturedDoseForm    CodeableConcept     `bson:"manufacturedDoseForm" json:"manufacturedDoseForm"`
-	UnitOfPresentation      *CodeableConcept    `bson:"unitOfPresentation,omitempty" json:"unitOfPresentation,omitempty"`
-	Quantity                Quantity            `bson:"quantity" json:"quantity"`
-	Manufacturer            []Reference         `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	Ingredient              []Reference         `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
-	PhysicalCharacteristics *ProdCharacteristic `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
-	OtherCharacteristics    []CodeableConcept   `bson:"otherCharacteristics,omitempty" json:"otherCharacteristics,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ManufacturedDoseForm    CodeableConcept            `bson:"manufacturedDoseForm" json:"manufacturedDoseForm"`
+	UnitOfPresentation      *CodeableConcept           `bson:"unitOfPresentation,omitempty" json:"unitOfPresentation,omitempty"`
+	Quantity                Quantity                   `bson:"quantity" json:"quantity"`
+	Manufacturer            []Reference                `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Ingredient              []Reference                `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	PhysicalCharacteristics *ProdCharacteristic        `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
+	OtherCharacteristics    []CodeableConcept          `bson:"otherCharacteristics,omitempty" json:"otherCharacteristics,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the MedicinalProductManufactured and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductManufactured) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductManufactured
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductManufactured", r)
+}
+
 type OtherMedicinalProductManufactured MedicinalProductManufactured
 
 // MarshalJSON marshals the given MedicinalProductManufactured as JSON into a byte slice
 func (r MedicinalProductManufactured) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductManufactured
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductManufactured: OtherMedicinalProductManufactured(r),
 		ResourceType:                      "MedicinalProductManufactured",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductManufactured unmarshals a MedicinalProductManufactured.
@@ -25,27 +25,44 @@ type MedicinalProductPackaged struct {
 	ID                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                   *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules          *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language               *string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                                  `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                   *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources                        `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier             []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Subject                []Reference                               `bson:"subject,omitempty" json:"subject,omitempty"`
 	Description            *string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                                  `bson:"_description,omitempty" json:"_description,omitempty"`
 	LegalStatusOfSupply    *CodeableConcept                          `bson:"legalStatusOfSupply,omitempty" json:"legalStatusOfSupply,omitempty"`
 	MarketingStatus        []MarketingStatus                         `bson:"marketingStatus,omitempty" json:"marketingStatus,omitempty"`
 	MarketingAuthorization *Reference                                `bson:"marketingAuthorization,omitempty" json:"marketingAuthorization,omitempty"`
 	Manufacturer           []Reference                               `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
 	BatchIdentifier        []MedicinalProductPackagedBatchIdentifier `bson:"batchIdentifier,omitempty" json:"batchIdentifier,omitempty"`
 	PackageItem            []MedicinalProductPackagedPackageItem     `bson:"packageItem" json:"packageItem"`
+	UnknownFields          map[string]json.RawMessage                `bson:"-" json:"-"`
 }
 type MedicinalProductPackagedBatchIdentifier struct {
-	ID                 *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	OuterPackaging     Identifier  `bson:"outerPackaging" json:"outerPackaging"`
-	ImmediatePackaging *Identifier `bson:"immediatePackaging,omitempty" json:"immediatePackaging,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	OuterPackaging     Identifier                 `bson:"outerPackaging" json:"outerPackaging"`
+	ImmediatePackaging *Identifier                `bson:"immediatePackaging,omitempty" json:"immediatePackaging,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicinalProductPackagedBatchIdentifier with its unknown fields.
+func (r MedicinalProductPackagedBatchIdentifier) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductPackagedBatchIdentifier
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicinalProductPackagedPackageItem struct {
 	ID                      *string                               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension               []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -62,18 +79,43 @@ type MedicinalProductPackagedPackageItem struct {
 	OtherCharacteristics    []CodeableConcept                     `bson:"otherCharacteristics,omitempty" json:"otherCharacteristics,omitempty"`
 	ShelfLifeStorage        []ProductShelfLife                    `bson:"shelfLifeStorage,omitempty" json:"shelfLifeStorage,omitempty"`
 	Manufacturer            []Reference                           `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	UnknownFields           map[string]json.RawMessage            `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicinalProductPackagedPackageItem with its unknown fields.
+func (r MedicinalProductPackagedPackageItem) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductPackagedPackageItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductPackaged and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductPackaged) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductPackaged
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductPackaged", r)
 }
+
 type OtherMedicinalProductPackaged MedicinalProductPackaged
 
 // MarshalJSON marshals the given MedicinalProductPackaged as JSON into a byte slice
 func (r MedicinalProductPackaged) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductPackaged
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductPackaged: OtherMedicinalProductPackaged(r),
 		ResourceType:                  "MedicinalProductPackaged",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductPackaged unmarshals a MedicinalProductPackaged.
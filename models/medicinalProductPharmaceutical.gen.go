@@ -25,8 +25,11 @@ type MedicinalProductPharmaceutical struct {
 	ID                    *string                                               `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                  *Meta                                                 `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                                              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                                               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                                              `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                                    `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                                           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            []Identifier                                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
@@ -36,14 +39,27 @@ type MedicinalProductPharmaceutical struct {
 	Device                []Reference                                           `bson:"device,omitempty" json:"device,omitempty"`
 	Characteristics       []MedicinalProductPharmaceuticalCharacteristics       `bson:"characteristics,omitempty" json:"characteristics,omitempty"`
 	RouteOfAdministration []MedicinalProductPharmaceuticalRouteOfAdministration `bson:"routeOfAdministration" json:"routeOfAdministration"`
+	UnknownFields         map[string]json.RawMessage                            `bson:"-" json:"-"`
 }
 type MedicinalProductPharmaceuticalCharacteristics struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept  `bson:"code" json:"code"`
-	Status            *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              CodeableConcept            `bson:"code" json:"code"`
+	Status            *CodeableConcept           `bson:"status,omitempty" json:"status,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MedicinalProductPharmaceuticalCharacteristics with its unknown fields.
+func (r MedicinalProductPharmaceuticalCharacteristics) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductPharmaceuticalCharacteristics
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MedicinalProductPharmaceuticalRouteOfAdministration struct {
 	ID                        *string                                                            `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                 []Extension                                                        `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -55,6 +71,7 @@ type MedicinalProductPharmaceuticalRouteOfAdministration struct {
 	MaxDosePerTreatmentPeriod *Ratio                                                             `bson:"maxDosePerTreatmentPeriod,omitempty" json:"maxDosePerTreatmentPeriod,omitempty"`
 	MaxTreatmentPeriod        *Duration                                                          `bson:"maxTreatmentPeriod,omitempty" json:"maxTreatmentPeriod,omitempty"`
 	TargetSpecies             []MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies `bson:"targetSpecies,omitempty" json:"targetSpecies,omitempty"`
+	UnknownFields             map[string]json.RawMessage                                         `bson:"-" json:"-"`
 }
 type MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies struct {
 	ID                *string                                                                            `bson:"id,omitempty" json:"id,omitempty"`
@@ -62,26 +79,73 @@ type MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies struct {
 	ModifierExtension []Extension                                                                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              CodeableConcept                                                                    `bson:"code" json:"code"`
 	WithdrawalPeriod  []MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod `bson:"withdrawalPeriod,omitempty" json:"withdrawalPeriod,omitempty"`
+	UnknownFields     map[string]json.RawMessage                                                         `bson:"-" json:"-"`
 }
 type MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod struct {
-	ID                    *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Tissue                CodeableConcept `bson:"tissue" json:"tissue"`
-	Value                 Quantity        `bson:"value" json:"value"`
-	SupportingInformation *string         `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Tissue                       CodeableConcept            `bson:"tissue" json:"tissue"`
+	Value                        Quantity                   `bson:"value" json:"value"`
+	SupportingInformation        *string                    `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	SupportingInformationElement *Element                   `bson:"_supportingInformation,omitempty" json:"_supportingInformation,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod with its unknown fields.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies with its unknown fields.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the MedicinalProductPharmaceuticalRouteOfAdministration with its unknown fields.
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) MarshalJSON() ([]byte, error) {
+	type other MedicinalProductPharmaceuticalRouteOfAdministration
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MedicinalProductPharmaceutical and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductPharmaceutical) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductPharmaceutical
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductPharmaceutical", r)
+}
+
 type OtherMedicinalProductPharmaceutical MedicinalProductPharmaceutical
 
 // MarshalJSON marshals the given MedicinalProductPharmaceutical as JSON into a byte slice
 func (r MedicinalProductPharmaceutical) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductPharmaceutical
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductPharmaceutical: OtherMedicinalProductPharmaceutical(r),
 		ResourceType:                        "MedicinalProductPharmaceutical",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductPharmaceutical unmarshals a MedicinalProductPharmaceutical.
@@ -22,30 +22,48 @@ import "encoding/json"
 
 // MedicinalProductUndesirableEffect is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductUndesirableEffect
 type MedicinalProductUndesirableEffect struct {
-	ID                     *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string          `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Subject                []Reference      `bson:"subject,omitempty" json:"subject,omitempty"`
-	SymptomConditionEffect *CodeableConcept `bson:"symptomConditionEffect,omitempty" json:"symptomConditionEffect,omitempty"`
-	Classification         *CodeableConcept `bson:"classification,omitempty" json:"classification,omitempty"`
-	FrequencyOfOccurrence  *CodeableConcept `bson:"frequencyOfOccurrence,omitempty" json:"frequencyOfOccurrence,omitempty"`
-	Population             []Population     `bson:"population,omitempty" json:"population,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subject                []Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
+	SymptomConditionEffect *CodeableConcept           `bson:"symptomConditionEffect,omitempty" json:"symptomConditionEffect,omitempty"`
+	Classification         *CodeableConcept           `bson:"classification,omitempty" json:"classification,omitempty"`
+	FrequencyOfOccurrence  *CodeableConcept           `bson:"frequencyOfOccurrence,omitempty" json:"frequencyOfOccurrence,omitempty"`
+	Population             []Population               `bson:"population,omitempty" json:"population,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the MedicinalProductUndesirableEffect and fails with CodeError when a coded element has an unknown code.
+func (r *MedicinalProductUndesirableEffect) UnmarshalJSON(b []byte) error {
+	type other MedicinalProductUndesirableEffect
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MedicinalProductUndesirableEffect", r)
+}
+
 type OtherMedicinalProductUndesirableEffect MedicinalProductUndesirableEffect
 
 // MarshalJSON marshals the given MedicinalProductUndesirableEffect as JSON into a byte slice
 func (r MedicinalProductUndesirableEffect) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMedicinalProductUndesirableEffect
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMedicinalProductUndesirableEffect: OtherMedicinalProductUndesirableEffect(r),
 		ResourceType:                           "MedicinalProductUndesirableEffect",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMedicinalProductUndesirableEffect unmarshals a MedicinalProductUndesirableEffect.
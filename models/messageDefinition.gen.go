@@ -22,64 +22,175 @@ import "encoding/json"
 
 // MessageDefinition is documented here http://hl7.org/fhir/StructureDefinition/MessageDefinition
 type MessageDefinition struct {
-	ID                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                            `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                            `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                            `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                            `bson:"title,omitempty" json:"title,omitempty"`
-	Replaces          []string                           `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	Status            PublicationStatus                  `bson:"status" json:"status"`
-	Experimental      *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              DateTime                           `bson:"date" json:"date"`
-	Publisher         *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                            `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Base              *string                            `bson:"base,omitempty" json:"base,omitempty"`
-	Parent            []string                           `bson:"parent,omitempty" json:"parent,omitempty"`
-	Category          *MessageSignificanceCategory       `bson:"category,omitempty" json:"category,omitempty"`
-	Focus             []MessageDefinitionFocus           `bson:"focus,omitempty" json:"focus,omitempty"`
-	ResponseRequired  *string                            `bson:"responseRequired,omitempty" json:"responseRequired,omitempty"`
-	AllowedResponse   []MessageDefinitionAllowedResponse `bson:"allowedResponse,omitempty" json:"allowedResponse,omitempty"`
-	Graph             []string                           `bson:"graph,omitempty" json:"graph,omitempty"`
+	ID                      *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                     *string                            `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement              *Element                           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier              []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                 *string                            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement          *Element                           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                    *string                            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element                           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                   *string                            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement            *Element                           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Replaces                []*string                          `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	ReplacesElement         []*Element                         `bson:"_replaces,omitempty" json:"_replaces,omitempty"`
+	Status                  PublicationStatus                  `bson:"status" json:"status"`
+	StatusElement           *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental            *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement     *Element                           `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                    DateTime                           `bson:"date" json:"date"`
+	DateElement             *Element                           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher               *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement        *Element                           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                 []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description             *string                            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                           `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext              []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction            []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                 *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement          *Element                           `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright               *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement        *Element                           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Base                    *string                            `bson:"base,omitempty" json:"base,omitempty"`
+	BaseElement             *Element                           `bson:"_base,omitempty" json:"_base,omitempty"`
+	Parent                  []*string                          `bson:"parent,omitempty" json:"parent,omitempty"`
+	ParentElement           []*Element                         `bson:"_parent,omitempty" json:"_parent,omitempty"`
+	EventCoding             *Coding                            `bson:"eventCoding,omitempty" json:"eventCoding,omitempty"`
+	EventUri                *string                            `bson:"eventUri,omitempty" json:"eventUri,omitempty"`
+	EventUriElement         *Element                           `bson:"_eventUri,omitempty" json:"_eventUri,omitempty"`
+	Category                *MessageSignificanceCategory       `bson:"category,omitempty" json:"category,omitempty"`
+	CategoryElement         *Element                           `bson:"_category,omitempty" json:"_category,omitempty"`
+	Focus                   []MessageDefinitionFocus           `bson:"focus,omitempty" json:"focus,omitempty"`
+	ResponseRequired        *string                            `bson:"responseRequired,omitempty" json:"responseRequired,omitempty"`
+	ResponseRequiredElement *Element                           `bson:"_responseRequired,omitempty" json:"_responseRequired,omitempty"`
+	AllowedResponse         []MessageDefinitionAllowedResponse `bson:"allowedResponse,omitempty" json:"allowedResponse,omitempty"`
+	Graph                   []*string                          `bson:"graph,omitempty" json:"graph,omitempty"`
+	GraphElement            []*Element                         `bson:"_graph,omitempty" json:"_graph,omitempty"`
+	UnknownFields           map[string]json.RawMessage         `bson:"-" json:"-"`
 }
 type MessageDefinitionFocus struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              ResourceType `bson:"code" json:"code"`
-	Profile           *string      `bson:"profile,omitempty" json:"profile,omitempty"`
-	Min               int          `bson:"min" json:"min"`
-	Max               *string      `bson:"max,omitempty" json:"max,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              ResourceType               `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Profile           *string                    `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement    *Element                   `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	Min               int                        `bson:"min" json:"min"`
+	MinElement        *Element                   `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max               *string                    `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement        *Element                   `bson:"_max,omitempty" json:"_max,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MessageDefinitionFocus with its unknown fields.
+func (r MessageDefinitionFocus) MarshalJSON() ([]byte, error) {
+	type other MessageDefinitionFocus
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MessageDefinitionAllowedResponse struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Message           string      `bson:"message" json:"message"`
-	Situation         *string     `bson:"situation,omitempty" json:"situation,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Message           string                     `bson:"message" json:"message"`
+	MessageElement    *Element                   `bson:"_message,omitempty" json:"_message,omitempty"`
+	Situation         *string                    `bson:"situation,omitempty" json:"situation,omitempty"`
+	SituationElement  *Element                   `bson:"_situation,omitempty" json:"_situation,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MessageDefinitionAllowedResponse with its unknown fields.
+func (r MessageDefinitionAllowedResponse) MarshalJSON() ([]byte, error) {
+	type other MessageDefinitionAllowedResponse
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Event returns MessageDefinition.event[x] of the set type, such as *Coding, or nil when it is not set.
+func (r *MessageDefinition) Event() interface{} {
+	switch {
+	case r.EventCoding != nil:
+		return r.EventCoding
+	case r.EventUri != nil:
+		return r.EventUri
+	}
+	return nil
+}
+
+// SetEventCoding sets MessageDefinition.event[x] to the Coding and clears the other types.
+func (r *MessageDefinition) SetEventCoding(v Coding) {
+	r.clearEvent()
+	r.EventCoding = &v
 }
+
+// SetEventUri sets MessageDefinition.event[x] to the uri and clears the other types.
+func (r *MessageDefinition) SetEventUri(v string) {
+	r.clearEvent()
+	r.EventUri = &v
+}
+func (r *MessageDefinition) clearEvent() {
+	r.EventCoding = nil
+	r.EventUri = nil
+	r.EventUriElement = nil
+}
+
+// UnmarshalJSON unmarshals the MessageDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *MessageDefinition) UnmarshalJSON(b []byte) error {
+	type other MessageDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MessageDefinition.event[x]",
+		r.EventCoding != nil,
+		r.EventUri != nil || r.EventUriElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("MessageDefinition.replaces", len(r.Replaces), len(r.ReplacesElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MessageDefinition.parent", len(r.Parent), len(r.ParentElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MessageDefinition.graph", len(r.Graph), len(r.GraphElement)); err != nil {
+		return err
+	}
+	return checkCodes("MessageDefinition", r)
+}
+
 type OtherMessageDefinition MessageDefinition
 
 // MarshalJSON marshals the given MessageDefinition as JSON into a byte slice
 func (r MessageDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMessageDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMessageDefinition: OtherMessageDefinition(r),
 		ResourceType:           "MessageDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMessageDefinition unmarshals a MessageDefinition.
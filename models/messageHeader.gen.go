@@ -22,62 +22,162 @@ import "encoding/json"
 
 // MessageHeader is documented here http://hl7.org/fhir/StructureDefinition/MessageHeader
 type MessageHeader struct {
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	EventCoding          *Coding                    `bson:"eventCoding,omitempty" json:"eventCoding,omitempty"`
+	EventUri             *string                    `bson:"eventUri,omitempty" json:"eventUri,omitempty"`
+	EventUriElement      *Element                   `bson:"_eventUri,omitempty" json:"_eventUri,omitempty"`
+	Destination          []MessageHeaderDestination `bson:"destination,omitempty" json:"destination,omitempty"`
+	Sender               *Reference                 `bson:"sender,omitempty" json:"sender,omitempty"`
+	Enterer              *Reference                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	Author               *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	Source               MessageHeaderSource        `bson:"source" json:"source"`
+	Responsible          *Reference                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Reason               *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	Response             *MessageHeaderResponse     `bson:"response,omitempty" json:"response,omitempty"`
+	Focus                []Reference                `bson:"focus,omitempty" json:"focus,omitempty"`
+	Definition           *string                    `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement    *Element                   `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+type MessageHeaderDestination struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Destination       []MessageHeaderDestination `bson:"destination,omitempty" json:"destination,omitempty"`
-	Sender            *Reference                 `bson:"sender,omitempty" json:"sender,omitempty"`
-	Enterer           *Reference                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	Author            *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
-	Source            MessageHeaderSource        `bson:"source" json:"source"`
-	Responsible       *Reference                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Reason            *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
-	Response          *MessageHeaderResponse     `bson:"response,omitempty" json:"response,omitempty"`
-	Focus             []Reference                `bson:"focus,omitempty" json:"focus,omitempty"`
-	Definition        *string                    `bson:"definition,omitempty" json:"definition,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Target            *Reference                 `bson:"target,omitempty" json:"target,omitempty"`
+	Endpoint          string                     `bson:"endpoint" json:"endpoint"`
+	EndpointElement   *Element                   `bson:"_endpoint,omitempty" json:"_endpoint,omitempty"`
+	Receiver          *Reference                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
-type MessageHeaderDestination struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	Target            *Reference  `bson:"target,omitempty" json:"target,omitempty"`
-	Endpoint          string      `bson:"endpoint" json:"endpoint"`
-	Receiver          *Reference  `bson:"receiver,omitempty" json:"receiver,omitempty"`
+
+// MarshalJSON marshals the MessageHeaderDestination with its unknown fields.
+func (r MessageHeaderDestination) MarshalJSON() ([]byte, error) {
+	type other MessageHeaderDestination
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MessageHeaderSource struct {
-	ID                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string       `bson:"name,omitempty" json:"name,omitempty"`
-	Software          *string       `bson:"software,omitempty" json:"software,omitempty"`
-	Version           *string       `bson:"version,omitempty" json:"version,omitempty"`
-	Contact           *ContactPoint `bson:"contact,omitempty" json:"contact,omitempty"`
-	Endpoint          string        `bson:"endpoint" json:"endpoint"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Software          *string                    `bson:"software,omitempty" json:"software,omitempty"`
+	SoftwareElement   *Element                   `bson:"_software,omitempty" json:"_software,omitempty"`
+	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Contact           *ContactPoint              `bson:"contact,omitempty" json:"contact,omitempty"`
+	Endpoint          string                     `bson:"endpoint" json:"endpoint"`
+	EndpointElement   *Element                   `bson:"_endpoint,omitempty" json:"_endpoint,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MessageHeaderSource with its unknown fields.
+func (r MessageHeaderSource) MarshalJSON() ([]byte, error) {
+	type other MessageHeaderSource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MessageHeaderResponse struct {
-	ID                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        string       `bson:"identifier" json:"identifier"`
-	Code              ResponseType `bson:"code" json:"code"`
-	Details           *Reference   `bson:"details,omitempty" json:"details,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        string                     `bson:"identifier" json:"identifier"`
+	IdentifierElement *Element                   `bson:"_identifier,omitempty" json:"_identifier,omitempty"`
+	Code              ResponseType               `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Details           *Reference                 `bson:"details,omitempty" json:"details,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MessageHeaderResponse with its unknown fields.
+func (r MessageHeaderResponse) MarshalJSON() ([]byte, error) {
+	type other MessageHeaderResponse
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Event returns MessageHeader.event[x] of the set type, such as *Coding, or nil when it is not set.
+func (r *MessageHeader) Event() interface{} {
+	switch {
+	case r.EventCoding != nil:
+		return r.EventCoding
+	case r.EventUri != nil:
+		return r.EventUri
+	}
+	return nil
+}
+
+// SetEventCoding sets MessageHeader.event[x] to the Coding and clears the other types.
+func (r *MessageHeader) SetEventCoding(v Coding) {
+	r.clearEvent()
+	r.EventCoding = &v
+}
+
+// SetEventUri sets MessageHeader.event[x] to the uri and clears the other types.
+func (r *MessageHeader) SetEventUri(v string) {
+	r.clearEvent()
+	r.EventUri = &v
+}
+func (r *MessageHeader) clearEvent() {
+	r.EventCoding = nil
+	r.EventUri = nil
+	r.EventUriElement = nil
+}
+
+// UnmarshalJSON unmarshals the MessageHeader and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *MessageHeader) UnmarshalJSON(b []byte) error {
+	type other MessageHeader
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"MessageHeader.event[x]",
+		r.EventCoding != nil,
+		r.EventUri != nil || r.EventUriElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("MessageHeader", r)
+}
+
 type OtherMessageHeader MessageHeader
 
 // MarshalJSON marshals the given MessageHeader as JSON into a byte slice
 func (r MessageHeader) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMessageHeader
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMessageHeader: OtherMessageHeader(r),
 		ResourceType:       "MessageHeader",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMessageHeader unmarshals a MessageHeader.
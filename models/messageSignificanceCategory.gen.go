@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.055078 +0000 UTC
+// 2026-10-19 02:55:18.858155249 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // MessageSignificanceCategory is documented here http://hl7.org/fhir/ValueSet/message-significance-category
-type MessageSignificanceCategory int
+// The empty MessageSignificanceCategory is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type MessageSignificanceCategory string
 
 const (
-	MessageSignificanceCategoryConsequence MessageSignificanceCategory = iota
-	MessageSignificanceCategoryCurrency
-	MessageSignificanceCategoryNotification
+	MessageSignificanceCategoryConsequence  MessageSignificanceCategory = "consequence"
+	MessageSignificanceCategoryCurrency     MessageSignificanceCategory = "currency"
+	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
 func (code MessageSignificanceCategory) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "MessageSignificanceCategory"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *MessageSignificanceCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "consequence":
-		*code = MessageSignificanceCategoryConsequence
-	case "currency":
-		*code = MessageSignificanceCategoryCurrency
-	case "notification":
-		*code = MessageSignificanceCategoryNotification
-	default:
-		return fmt.Errorf("unknown MessageSignificanceCategory code `%s`", s)
+func (code *MessageSignificanceCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = MessageSignificanceCategory(s)
 	return nil
 }
 func (code MessageSignificanceCategory) String() string {
 	return code.Code()
 }
 func (code MessageSignificanceCategory) Code() string {
+	return string(code)
+}
+func (code MessageSignificanceCategory) Known() bool {
 	switch code {
-	case MessageSignificanceCategoryConsequence:
-		return "consequence"
-	case MessageSignificanceCategoryCurrency:
-		return "currency"
-	case MessageSignificanceCategoryNotification:
-		return "notification"
+	case MessageSignificanceCategoryConsequence, MessageSignificanceCategoryCurrency, MessageSignificanceCategoryNotification:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code MessageSignificanceCategory) Display() string {
 	switch code {
@@ -18,14 +18,32 @@
 
 package models
 
+import "encoding/json"
+
 // Meta is documented here http://hl7.org/fhir/StructureDefinition/Meta
 type Meta struct {
-	ID          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	VersionId   *string     `bson:"versionId,omitempty" json:"versionId,omitempty"`
-	LastUpdated *string     `bson:"lastUpdated,omitempty" json:"lastUpdated,omitempty"`
-	Source      *string     `bson:"source,omitempty" json:"source,omitempty"`
-	Profile     []string    `bson:"profile,omitempty" json:"profile,omitempty"`
-	Security    []Coding    `bson:"security,omitempty" json:"security,omitempty"`
-	Tag         []Coding    `bson:"tag,omitempty" json:"tag,omitempty"`
+	ID                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	VersionId          *string     `bson:"versionId,omitempty" json:"versionId,omitempty"`
+	VersionIdElement   *Element    `bson:"_versionId,omitempty" json:"_versionId,omitempty"`
+	LastUpdated        *Instant    `bson:"lastUpdated,omitempty" json:"lastUpdated,omitempty"`
+	LastUpdatedElement *Element    `bson:"_lastUpdated,omitempty" json:"_lastUpdated,omitempty"`
+	Source             *string     `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement      *Element    `bson:"_source,omitempty" json:"_source,omitempty"`
+	Profile            []*string   `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement     []*Element  `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	Security           []Coding    `bson:"security,omitempty" json:"security,omitempty"`
+	Tag                []Coding    `bson:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// UnmarshalJSON unmarshals the Meta and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *Meta) UnmarshalJSON(b []byte) error {
+	type other Meta
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Meta.profile", len(r.Profile), len(r.ProfileElement)); err != nil {
+		return err
+	}
+	return nil
 }
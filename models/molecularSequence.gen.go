@@ -22,133 +22,319 @@ import "encoding/json"
 
 // MolecularSequence is documented here http://hl7.org/fhir/StructureDefinition/MolecularSequence
 type MolecularSequence struct {
-	ID                *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              *string                             `bson:"type,omitempty" json:"type,omitempty"`
-	CoordinateSystem  int                                 `bson:"coordinateSystem" json:"coordinateSystem"`
-	Patient           *Reference                          `bson:"patient,omitempty" json:"patient,omitempty"`
-	Specimen          *Reference                          `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Device            *Reference                          `bson:"device,omitempty" json:"device,omitempty"`
-	Performer         *Reference                          `bson:"performer,omitempty" json:"performer,omitempty"`
-	Quantity          *Quantity                           `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ReferenceSeq      *MolecularSequenceReferenceSeq      `bson:"referenceSeq,omitempty" json:"referenceSeq,omitempty"`
-	Variant           []MolecularSequenceVariant          `bson:"variant,omitempty" json:"variant,omitempty"`
-	ObservedSeq       *string                             `bson:"observedSeq,omitempty" json:"observedSeq,omitempty"`
-	Quality           []MolecularSequenceQuality          `bson:"quality,omitempty" json:"quality,omitempty"`
-	ReadCoverage      *int                                `bson:"readCoverage,omitempty" json:"readCoverage,omitempty"`
-	Repository        []MolecularSequenceRepository       `bson:"repository,omitempty" json:"repository,omitempty"`
-	Pointer           []Reference                         `bson:"pointer,omitempty" json:"pointer,omitempty"`
-	StructureVariant  []MolecularSequenceStructureVariant `bson:"structureVariant,omitempty" json:"structureVariant,omitempty"`
+	ID                      *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier              []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                    *string                             `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement             *Element                            `bson:"_type,omitempty" json:"_type,omitempty"`
+	CoordinateSystem        int                                 `bson:"coordinateSystem" json:"coordinateSystem"`
+	CoordinateSystemElement *Element                            `bson:"_coordinateSystem,omitempty" json:"_coordinateSystem,omitempty"`
+	Patient                 *Reference                          `bson:"patient,omitempty" json:"patient,omitempty"`
+	Specimen                *Reference                          `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Device                  *Reference                          `bson:"device,omitempty" json:"device,omitempty"`
+	Performer               *Reference                          `bson:"performer,omitempty" json:"performer,omitempty"`
+	Quantity                *Quantity                           `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ReferenceSeq            *MolecularSequenceReferenceSeq      `bson:"referenceSeq,omitempty" json:"referenceSeq,omitempty"`
+	Variant                 []MolecularSequenceVariant          `bson:"variant,omitempty" json:"variant,omitempty"`
+	ObservedSeq             *string                             `bson:"observedSeq,omitempty" json:"observedSeq,omitempty"`
+	ObservedSeqElement      *Element                            `bson:"_observedSeq,omitempty" json:"_observedSeq,omitempty"`
+	Quality                 []MolecularSequenceQuality          `bson:"quality,omitempty" json:"quality,omitempty"`
+	ReadCoverage            *int                                `bson:"readCoverage,omitempty" json:"readCoverage,omitempty"`
+	ReadCoverageElement     *Element                            `bson:"_readCoverage,omitempty" json:"_readCoverage,omitempty"`
+	Repository              []MolecularSequenceRepository       `bson:"repository,omitempty" json:"repository,omitempty"`
+	Pointer                 []Reference                         `bson:"pointer,omitempty" json:"pointer,omitempty"`
+	StructureVariant        []MolecularSequenceStructureVariant `bson:"structureVariant,omitempty" json:"structureVariant,omitempty"`
+	UnknownFields           map[string]json.RawMessage          `bson:"-" json:"-"`
 }
 type MolecularSequenceReferenceSeq struct {
-	ID                  *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Chromosome          *CodeableConcept `bson:"chromosome,omitempty" json:"chromosome,omitempty"`
-	GenomeBuild         *string          `bson:"genomeBuild,omitempty" json:"genomeBuild,omitempty"`
-	Orientation         *string          `bson:"orientation,omitempty" json:"orientation,omitempty"`
-	ReferenceSeqId      *CodeableConcept `bson:"referenceSeqId,omitempty" json:"referenceSeqId,omitempty"`
-	ReferenceSeqPointer *Reference       `bson:"referenceSeqPointer,omitempty" json:"referenceSeqPointer,omitempty"`
-	ReferenceSeqString  *string          `bson:"referenceSeqString,omitempty" json:"referenceSeqString,omitempty"`
-	Strand              *string          `bson:"strand,omitempty" json:"strand,omitempty"`
-	WindowStart         *int             `bson:"windowStart,omitempty" json:"windowStart,omitempty"`
-	WindowEnd           *int             `bson:"windowEnd,omitempty" json:"windowEnd,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Chromosome                *CodeableConcept           `bson:"chromosome,omitempty" json:"chromosome,omitempty"`
+	GenomeBuild               *string                    `bson:"genomeBuild,omitempty" json:"genomeBuild,omitempty"`
+	GenomeBuildElement        *Element                   `bson:"_genomeBuild,omitempty" json:"_genomeBuild,omitempty"`
+	Orientation               *string                    `bson:"orientation,omitempty" json:"orientation,omitempty"`
+	OrientationElement        *Element                   `bson:"_orientation,omitempty" json:"_orientation,omitempty"`
+	ReferenceSeqId            *CodeableConcept           `bson:"referenceSeqId,omitempty" json:"referenceSeqId,omitempty"`
+	ReferenceSeqPointer       *Reference                 `bson:"referenceSeqPointer,omitempty" json:"referenceSeqPointer,omitempty"`
+	ReferenceSeqString        *string                    `bson:"referenceSeqString,omitempty" json:"referenceSeqString,omitempty"`
+	ReferenceSeqStringElement *Element                   `bson:"_referenceSeqString,omitempty" json:"_referenceSeqString,omitempty"`
+	Strand                    *string                    `bson:"strand,omitempty" json:"strand,omitempty"`
+	StrandElement             *Element                   `bson:"_strand,omitempty" json:"_strand,omitempty"`
+	WindowStart               *int                       `bson:"windowStart,omitempty" json:"windowStart,omitempty"`
+	WindowStartElement        *Element                   `bson:"_windowStart,omitempty" json:"_windowStart,omitempty"`
+	WindowEnd                 *int                       `bson:"windowEnd,omitempty" json:"windowEnd,omitempty"`
+	WindowEndElement          *Element                   `bson:"_windowEnd,omitempty" json:"_windowEnd,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MolecularSequenceReferenceSeq with its unknown fields.
+func (r MolecularSequenceReferenceSeq) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceReferenceSeq
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MolecularSequenceVariant struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Start             *int        `bson:"start,omitempty" json:"start,omitempty"`
-	End               *int        `bson:"end,omitempty" json:"end,omitempty"`
-	ObservedAllele    *string     `bson:"observedAllele,omitempty" json:"observedAllele,omitempty"`
-	ReferenceAllele   *string     `bson:"referenceAllele,omitempty" json:"referenceAllele,omitempty"`
-	Cigar             *string     `bson:"cigar,omitempty" json:"cigar,omitempty"`
-	VariantPointer    *Reference  `bson:"variantPointer,omitempty" json:"variantPointer,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Start                  *int                       `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement           *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                    *int                       `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement             *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	ObservedAllele         *string                    `bson:"observedAllele,omitempty" json:"observedAllele,omitempty"`
+	ObservedAlleleElement  *Element                   `bson:"_observedAllele,omitempty" json:"_observedAllele,omitempty"`
+	ReferenceAllele        *string                    `bson:"referenceAllele,omitempty" json:"referenceAllele,omitempty"`
+	ReferenceAlleleElement *Element                   `bson:"_referenceAllele,omitempty" json:"_referenceAllele,omitempty"`
+	Cigar                  *string                    `bson:"cigar,omitempty" json:"cigar,omitempty"`
+	CigarElement           *Element                   `bson:"_cigar,omitempty" json:"_cigar,omitempty"`
+	VariantPointer         *Reference                 `bson:"variantPointer,omitempty" json:"variantPointer,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MolecularSequenceVariant with its unknown fields.
+func (r MolecularSequenceVariant) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceVariant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MolecularSequenceQuality struct {
 	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              string                       `bson:"type" json:"type"`
+	TypeElement       *Element                     `bson:"_type,omitempty" json:"_type,omitempty"`
 	StandardSequence  *CodeableConcept             `bson:"standardSequence,omitempty" json:"standardSequence,omitempty"`
 	Start             *int                         `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement      *Element                     `bson:"_start,omitempty" json:"_start,omitempty"`
 	End               *int                         `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement        *Element                     `bson:"_end,omitempty" json:"_end,omitempty"`
 	Score             *Quantity                    `bson:"score,omitempty" json:"score,omitempty"`
 	Method            *CodeableConcept             `bson:"method,omitempty" json:"method,omitempty"`
 	TruthTP           *Decimal                     `bson:"truthTP,omitempty" json:"truthTP,omitempty"`
+	TruthTPElement    *Element                     `bson:"_truthTP,omitempty" json:"_truthTP,omitempty"`
 	QueryTP           *Decimal                     `bson:"queryTP,omitempty" json:"queryTP,omitempty"`
+	QueryTPElement    *Element                     `bson:"_queryTP,omitempty" json:"_queryTP,omitempty"`
 	TruthFN           *Decimal                     `bson:"truthFN,omitempty" json:"truthFN,omitempty"`
+	TruthFNElement    *Element                     `bson:"_truthFN,omitempty" json:"_truthFN,omitempty"`
 	QueryFP           *Decimal                     `bson:"queryFP,omitempty" json:"queryFP,omitempty"`
+	QueryFPElement    *Element                     `bson:"_queryFP,omitempty" json:"_queryFP,omitempty"`
 	GtFP              *Decimal                     `bson:"gtFP,omitempty" json:"gtFP,omitempty"`
+	GtFPElement       *Element                     `bson:"_gtFP,omitempty" json:"_gtFP,omitempty"`
 	Precision         *Decimal                     `bson:"precision,omitempty" json:"precision,omitempty"`
+	PrecisionElement  *Element                     `bson:"_precision,omitempty" json:"_precision,omitempty"`
 	Recall            *Decimal                     `bson:"recall,omitempty" json:"recall,omitempty"`
+	RecallElement     *Element                     `bson:"_recall,omitempty" json:"_recall,omitempty"`
 	FScore            *Decimal                     `bson:"fScore,omitempty" json:"fScore,omitempty"`
+	FScoreElement     *Element                     `bson:"_fScore,omitempty" json:"_fScore,omitempty"`
 	Roc               *MolecularSequenceQualityRoc `bson:"roc,omitempty" json:"roc,omitempty"`
+	UnknownFields     map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type MolecularSequenceQualityRoc struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Score             []int       `bson:"score,omitempty" json:"score,omitempty"`
-	NumTP             []int       `bson:"numTP,omitempty" json:"numTP,omitempty"`
-	NumFP             []int       `bson:"numFP,omitempty" json:"numFP,omitempty"`
-	NumFN             []int       `bson:"numFN,omitempty" json:"numFN,omitempty"`
-	Precision         []Decimal   `bson:"precision,omitempty" json:"precision,omitempty"`
-	Sensitivity       []Decimal   `bson:"sensitivity,omitempty" json:"sensitivity,omitempty"`
-	FMeasure          []Decimal   `bson:"fMeasure,omitempty" json:"fMeasure,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Score              []*int                     `bson:"score,omitempty" json:"score,omitempty"`
+	ScoreElement       []*Element                 `bson:"_score,omitempty" json:"_score,omitempty"`
+	NumTP              []*int                     `bson:"numTP,omitempty" json:"numTP,omitempty"`
+	NumTPElement       []*Element                 `bson:"_numTP,omitempty" json:"_numTP,omitempty"`
+	NumFP              []*int                     `bson:"numFP,omitempty" json:"numFP,omitempty"`
+	NumFPElement       []*Element                 `bson:"_numFP,omitempty" json:"_numFP,omitempty"`
+	NumFN              []*int                     `bson:"numFN,omitempty" json:"numFN,omitempty"`
+	NumFNElement       []*Element                 `bson:"_numFN,omitempty" json:"_numFN,omitempty"`
+	Precision          []*Decimal                 `bson:"precision,omitempty" json:"precision,omitempty"`
+	PrecisionElement   []*Element                 `bson:"_precision,omitempty" json:"_precision,omitempty"`
+	Sensitivity        []*Decimal                 `bson:"sensitivity,omitempty" json:"sensitivity,omitempty"`
+	SensitivityElement []*Element                 `bson:"_sensitivity,omitempty" json:"_sensitivity,omitempty"`
+	FMeasure           []*Decimal                 `bson:"fMeasure,omitempty" json:"fMeasure,omitempty"`
+	FMeasureElement    []*Element                 `bson:"_fMeasure,omitempty" json:"_fMeasure,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the MolecularSequenceQualityRoc and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *MolecularSequenceQualityRoc) UnmarshalJSON(b []byte) error {
+	type other MolecularSequenceQualityRoc
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.score", len(r.Score), len(r.ScoreElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.numTP", len(r.NumTP), len(r.NumTPElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.numFP", len(r.NumFP), len(r.NumFPElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.numFN", len(r.NumFN), len(r.NumFNElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.precision", len(r.Precision), len(r.PrecisionElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.sensitivity", len(r.Sensitivity), len(r.SensitivityElement)); err != nil {
+		return err
+	}
+	if err := checkElements("MolecularSequence.quality.roc.fMeasure", len(r.FMeasure), len(r.FMeasureElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the MolecularSequenceQualityRoc with its unknown fields.
+func (r MolecularSequenceQualityRoc) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceQualityRoc
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MolecularSequenceQuality with its unknown fields.
+func (r MolecularSequenceQuality) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceQuality
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MolecularSequenceRepository struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              string      `bson:"type" json:"type"`
-	URL               *string     `bson:"url,omitempty" json:"url,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	DatasetId         *string     `bson:"datasetId,omitempty" json:"datasetId,omitempty"`
-	VariantsetId      *string     `bson:"variantsetId,omitempty" json:"variantsetId,omitempty"`
-	ReadsetId         *string     `bson:"readsetId,omitempty" json:"readsetId,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                string                     `bson:"type" json:"type"`
+	TypeElement         *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	URL                 *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement          *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Name                *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement         *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	DatasetId           *string                    `bson:"datasetId,omitempty" json:"datasetId,omitempty"`
+	DatasetIdElement    *Element                   `bson:"_datasetId,omitempty" json:"_datasetId,omitempty"`
+	VariantsetId        *string                    `bson:"variantsetId,omitempty" json:"variantsetId,omitempty"`
+	VariantsetIdElement *Element                   `bson:"_variantsetId,omitempty" json:"_variantsetId,omitempty"`
+	ReadsetId           *string                    `bson:"readsetId,omitempty" json:"readsetId,omitempty"`
+	ReadsetIdElement    *Element                   `bson:"_readsetId,omitempty" json:"_readsetId,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the MolecularSequenceRepository with its unknown fields.
+func (r MolecularSequenceRepository) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceRepository
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type MolecularSequenceStructureVariant struct {
 	ID                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	VariantType       *CodeableConcept                        `bson:"variantType,omitempty" json:"variantType,omitempty"`
 	Exact             *bool                                   `bson:"exact,omitempty" json:"exact,omitempty"`
+	ExactElement      *Element                                `bson:"_exact,omitempty" json:"_exact,omitempty"`
 	Length            *int                                    `bson:"length,omitempty" json:"length,omitempty"`
+	LengthElement     *Element                                `bson:"_length,omitempty" json:"_length,omitempty"`
 	Outer             *MolecularSequenceStructureVariantOuter `bson:"outer,omitempty" json:"outer,omitempty"`
 	Inner             *MolecularSequenceStructureVariantInner `bson:"inner,omitempty" json:"inner,omitempty"`
+	UnknownFields     map[string]json.RawMessage              `bson:"-" json:"-"`
 }
 type MolecularSequenceStructureVariantOuter struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Start             *int        `bson:"start,omitempty" json:"start,omitempty"`
-	End               *int        `bson:"end,omitempty" json:"end,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Start             *int                       `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement      *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	End               *int                       `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement        *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MolecularSequenceStructureVariantOuter with its unknown fields.
+func (r MolecularSequenceStructureVariantOuter) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceStructureVariantOuter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type MolecularSequenceStructureVariantInner struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Start             *int        `bson:"start,omitempty" json:"start,omitempty"`
-	End               *int        `bson:"end,omitempty" json:"end,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Start             *int                       `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement      *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	End               *int                       `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement        *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the MolecularSequenceStructureVariantInner with its unknown fields.
+func (r MolecularSequenceStructureVariantInner) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceStructureVariantInner
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the MolecularSequenceStructureVariant with its unknown fields.
+func (r MolecularSequenceStructureVariant) MarshalJSON() ([]byte, error) {
+	type other MolecularSequenceStructureVariant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the MolecularSequence and fails with CodeError when a coded element has an unknown code.
+func (r *MolecularSequence) UnmarshalJSON(b []byte) error {
+	type other MolecularSequence
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("MolecularSequence", r)
+}
+
 type OtherMolecularSequence MolecularSequence
 
 // MarshalJSON marshals the given MolecularSequence as JSON into a byte slice
 func (r MolecularSequence) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherMolecularSequence
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherMolecularSequence: OtherMolecularSequence(r),
 		ResourceType:           "MolecularSequence",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalMolecularSequence unmarshals a MolecularSequence.
@@ -20,8 +20,10 @@ package models
 
 // Money is documented here http://hl7.org/fhir/StructureDefinition/Money
 type Money struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Value     *Decimal    `bson:"value,omitempty" json:"value,omitempty"`
-	Currency  *string     `bson:"currency,omitempty" json:"currency,omitempty"`
+	ID              *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension       []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Value           *Decimal    `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement    *Element    `bson:"_value,omitempty" json:"_value,omitempty"`
+	Currency        *string     `bson:"currency,omitempty" json:"currency,omitempty"`
+	CurrencyElement *Element    `bson:"_currency,omitempty" json:"_currency,omitempty"`
 }
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.10616 +0000 UTC
+// 2026-10-19 02:55:18.859169384 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // NameUse is documented here http://hl7.org/fhir/ValueSet/name-use
-type NameUse int
+// The empty NameUse is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type NameUse string
 
 const (
-	NameUseUsual NameUse = iota
-	NameUseOfficial
-	NameUseTemp
-	NameUseNickname
-	NameUseAnonymous
-	NameUseOld
-	NameUseMaiden
+	NameUseUsual     NameUse = "usual"
+	NameUseOfficial  NameUse = "official"
+	NameUseTemp      NameUse = "temp"
+	NameUseNickname  NameUse = "nickname"
+	NameUseAnonymous NameUse = "anonymous"
+	NameUseOld       NameUse = "old"
+	NameUseMaiden    NameUse = "maiden"
 )
 
 func (code NameUse) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "NameUse"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *NameUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "usual":
-		*code = NameUseUsual
-	case "official":
-		*code = NameUseOfficial
-	case "temp":
-		*code = NameUseTemp
-	case "nickname":
-		*code = NameUseNickname
-	case "anonymous":
-		*code = NameUseAnonymous
-	case "old":
-		*code = NameUseOld
-	case "maiden":
-		*code = NameUseMaiden
-	default:
-		return fmt.Errorf("unknown NameUse code `%s`", s)
+func (code *NameUse) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = NameUse(s)
 	return nil
 }
 func (code NameUse) String() string {
 	return code.Code()
 }
 func (code NameUse) Code() string {
+	return string(code)
+}
+func (code NameUse) Known() bool {
 	switch code {
-	case NameUseUsual:
-		return "usual"
-	case NameUseOfficial:
-		return "official"
-	case NameUseTemp:
-		return "temp"
-	case NameUseNickname:
-		return "nickname"
-	case NameUseAnonymous:
-		return "anonymous"
-	case NameUseOld:
-		return "old"
-	case NameUseMaiden:
-		return "maiden"
+	case NameUseUsual, NameUseOfficial, NameUseTemp, NameUseNickname, NameUseAnonymous, NameUseOld, NameUseMaiden:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code NameUse) Display() string {
 	switch code {
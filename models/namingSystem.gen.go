@@ -22,48 +22,89 @@ import "encoding/json"
 
 // NamingSystem is documented here http://hl7.org/fhir/StructureDefinition/NamingSystem
 type NamingSystem struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                 `bson:"name" json:"name"`
-	Status            PublicationStatus      `bson:"status" json:"status"`
-	Kind              NamingSystemType       `bson:"kind" json:"kind"`
-	Date              DateTime               `bson:"date" json:"date"`
-	Publisher         *string                `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail        `bson:"contact,omitempty" json:"contact,omitempty"`
-	Responsible       *string                `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Type              *CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
-	Description       *string                `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext         `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Usage             *string                `bson:"usage,omitempty" json:"usage,omitempty"`
-	UniqueId          []NamingSystemUniqueId `bson:"uniqueId" json:"uniqueId"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Kind                 NamingSystemType           `bson:"kind" json:"kind"`
+	KindElement          *Element                   `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Date                 DateTime                   `bson:"date" json:"date"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Responsible          *string                    `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	ResponsibleElement   *Element                   `bson:"_responsible,omitempty" json:"_responsible,omitempty"`
+	Type                 *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Usage                *string                    `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement         *Element                   `bson:"_usage,omitempty" json:"_usage,omitempty"`
+	UniqueId             []NamingSystemUniqueId     `bson:"uniqueId" json:"uniqueId"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type NamingSystemUniqueId struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              NamingSystemIdentifierType `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
 	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
 	Preferred         *bool                      `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element                   `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
 	Comment           *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the NamingSystemUniqueId with its unknown fields.
+func (r NamingSystemUniqueId) MarshalJSON() ([]byte, error) {
+	type other NamingSystemUniqueId
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the NamingSystem and fails with CodeError when a coded element has an unknown code.
+func (r *NamingSystem) UnmarshalJSON(b []byte) error {
+	type other NamingSystem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("NamingSystem", r)
+}
+
 type OtherNamingSystem NamingSystem
 
 // MarshalJSON marshals the given NamingSystem as JSON into a byte slice
 func (r NamingSystem) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherNamingSystem
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherNamingSystem: OtherNamingSystem(r),
 		ResourceType:      "NamingSystem",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalNamingSystem unmarshals a NamingSystem.
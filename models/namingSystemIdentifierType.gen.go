@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.290316 +0000 UTC
+// 2026-10-19 02:55:18.860323595 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // NamingSystemIdentifierType is documented here http://hl7.org/fhir/ValueSet/namingsystem-identifier-type
-type NamingSystemIdentifierType int
+// The empty NamingSystemIdentifierType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type NamingSystemIdentifierType string
 
 const (
-	NamingSystemIdentifierTypeOid NamingSystemIdentifierType = iota
-	NamingSystemIdentifierTypeUuid
-	NamingSystemIdentifierTypeUri
-	NamingSystemIdentifierTypeOther
+	NamingSystemIdentifierTypeOid   NamingSystemIdentifierType = "oid"
+	NamingSystemIdentifierTypeUuid  NamingSystemIdentifierType = "uuid"
+	NamingSystemIdentifierTypeUri   NamingSystemIdentifierType = "uri"
+	NamingSystemIdentifierTypeOther NamingSystemIdentifierType = "other"
 )
 
 func (code NamingSystemIdentifierType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "NamingSystemIdentifierType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *NamingSystemIdentifierType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "oid":
-		*code = NamingSystemIdentifierTypeOid
-	case "uuid":
-		*code = NamingSystemIdentifierTypeUuid
-	case "uri":
-		*code = NamingSystemIdentifierTypeUri
-	case "other":
-		*code = NamingSystemIdentifierTypeOther
-	default:
-		return fmt.Errorf("unknown NamingSystemIdentifierType code `%s`", s)
+func (code *NamingSystemIdentifierType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = NamingSystemIdentifierType(s)
 	return nil
 }
 func (code NamingSystemIdentifierType) String() string {
 	return code.Code()
 }
 func (code NamingSystemIdentifierType) Code() string {
+	return string(code)
+}
+func (code NamingSystemIdentifierType) Known() bool {
 	switch code {
-	case NamingSystemIdentifierTypeOid:
-		return "oid"
-	case NamingSystemIdentifierTypeUuid:
-		return "uuid"
-	case NamingSystemIdentifierTypeUri:
-		return "uri"
-	case NamingSystemIdentifierTypeOther:
-		return "other"
+	case NamingSystemIdentifierTypeOid, NamingSystemIdentifierTypeUuid, NamingSystemIdentifierTypeUri, NamingSystemIdentifierTypeOther:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code NamingSystemIdentifierType) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.147914 +0000 UTC
+// 2026-10-19 02:55:18.861435953 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // NamingSystemType is documented here http://hl7.org/fhir/ValueSet/namingsystem-type
-type NamingSystemType int
+// The empty NamingSystemType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type NamingSystemType string
 
 const (
-	NamingSystemTypeCodesystem NamingSystemType = iota
-	NamingSystemTypeIdentifier
-	NamingSystemTypeRoot
+	NamingSystemTypeCodesystem NamingSystemType = "codesystem"
+	NamingSystemTypeIdentifier NamingSystemType = "identifier"
+	NamingSystemTypeRoot       NamingSystemType = "root"
 )
 
 func (code NamingSystemType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "NamingSystemType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *NamingSystemType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "codesystem":
-		*code = NamingSystemTypeCodesystem
-	case "identifier":
-		*code = NamingSystemTypeIdentifier
-	case "root":
-		*code = NamingSystemTypeRoot
-	default:
-		return fmt.Errorf("unknown NamingSystemType code `%s`", s)
+func (code *NamingSystemType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = NamingSystemType(s)
 	return nil
 }
 func (code NamingSystemType) String() string {
 	return code.Code()
 }
 func (code NamingSystemType) Code() string {
+	return string(code)
+}
+func (code NamingSystemType) Known() bool {
 	switch code {
-	case NamingSystemTypeCodesystem:
-		return "codesystem"
-	case NamingSystemTypeIdentifier:
-		return "identifier"
-	case NamingSystemTypeRoot:
-		return "root"
+	case NamingSystemTypeCodesystem, NamingSystemTypeIdentifier, NamingSystemTypeRoot:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code NamingSystemType) Display() string {
 	switch code {
@@ -20,8 +20,9 @@ package models
 
 // Narrative is documented here http://hl7.org/fhir/StructureDefinition/Narrative
 type Narrative struct {
-	ID        *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	Status    NarrativeStatus `bson:"status" json:"status"`
-	Div       string          `bson:"div" json:"div"`
+	ID            *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension     []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	Status        NarrativeStatus `bson:"status" json:"status"`
+	StatusElement *Element        `bson:"_status,omitempty" json:"_status,omitempty"`
+	Div           string          `bson:"div" json:"div"`
 }
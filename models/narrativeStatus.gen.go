@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.016947 +0000 UTC
+// 2026-10-19 02:55:18.86260971 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // NarrativeStatus is documented here http://hl7.org/fhir/ValueSet/narrative-status
-type NarrativeStatus int
+// The empty NarrativeStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type NarrativeStatus string
 
 const (
-	NarrativeStatusGenerated NarrativeStatus = iota
-	NarrativeStatusExtensions
-	NarrativeStatusAdditional
-	NarrativeStatusEmpty
+	NarrativeStatusGenerated  NarrativeStatus = "generated"
+	NarrativeStatusExtensions NarrativeStatus = "extensions"
+	NarrativeStatusAdditional NarrativeStatus = "additional"
+	NarrativeStatusEmpty      NarrativeStatus = "empty"
 )
 
 func (code NarrativeStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "NarrativeStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *NarrativeStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "generated":
-		*code = NarrativeStatusGenerated
-	case "extensions":
-		*code = NarrativeStatusExtensions
-	case "additional":
-		*code = NarrativeStatusAdditional
-	case "empty":
-		*code = NarrativeStatusEmpty
-	default:
-		return fmt.Errorf("unknown NarrativeStatus code `%s`", s)
+func (code *NarrativeStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = NarrativeStatus(s)
 	return nil
 }
 func (code NarrativeStatus) String() string {
 	return code.Code()
 }
 func (code NarrativeStatus) Code() string {
+	return string(code)
+}
+func (code NarrativeStatus) Known() bool {
 	switch code {
-	case NarrativeStatusGenerated:
-		return "generated"
-	case NarrativeStatusExtensions:
-		return "extensions"
-	case NarrativeStatusAdditional:
-		return "additional"
-	case NarrativeStatusEmpty:
-		return "empty"
+	case NarrativeStatusGenerated, NarrativeStatusExtensions, NarrativeStatusAdditional, NarrativeStatusEmpty:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code NarrativeStatus) Display() string {
 	switch code {
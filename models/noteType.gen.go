@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.089897 +0000 UTC
+// 2026-10-19 02:55:18.86369597 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // NoteType is documented here http://hl7.org/fhir/ValueSet/note-type
-type NoteType int
+// The empty NoteType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type NoteType string
 
 const (
-	NoteTypeDisplay NoteType = iota
-	NoteTypePrint
-	NoteTypePrintoper
+	NoteTypeDisplay   NoteType = "display"
+	NoteTypePrint     NoteType = "print"
+	NoteTypePrintoper NoteType = "printoper"
 )
 
 func (code NoteType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "NoteType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *NoteType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "display":
-		*code = NoteTypeDisplay
-	case "print":
-		*code = NoteTypePrint
-	case "printoper":
-		*code = NoteTypePrintoper
-	default:
-		return fmt.Errorf("unknown NoteType code `%s`", s)
+func (code *NoteType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = NoteType(s)
 	return nil
 }
 func (code NoteType) String() string {
 	return code.Code()
 }
 func (code NoteType) Code() string {
+	return string(code)
+}
+func (code NoteType) Known() bool {
 	switch code {
-	case NoteTypeDisplay:
-		return "display"
-	case NoteTypePrint:
-		return "print"
-	case NoteTypePrintoper:
-		return "printoper"
+	case NoteTypeDisplay, NoteTypePrint, NoteTypePrintoper:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code NoteType) Display() string {
 	switch code {
@@ -22,30 +22,40 @@ import "encoding/json"
 
 // NutritionOrder is documented here http://hl7.org/fhir/StructureDefinition/NutritionOrder
 type NutritionOrder struct {
-	ID                     *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical  []string                      `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri        []string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	Instantiates           []string                      `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
-	Status                 RequestStatus                 `bson:"status" json:"status"`
-	Intent                 RequestIntent                 `bson:"intent" json:"intent"`
-	Patient                Reference                     `bson:"patient" json:"patient"`
-	Encounter              *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	DateTime               DateTime                      `bson:"dateTime" json:"dateTime"`
-	Orderer                *Reference                    `bson:"orderer,omitempty" json:"orderer,omitempty"`
-	AllergyIntolerance     []Reference                   `bson:"allergyIntolerance,omitempty" json:"allergyIntolerance,omitempty"`
-	FoodPreferenceModifier []CodeableConcept             `bson:"foodPreferenceModifier,omitempty" json:"foodPreferenceModifier,omitempty"`
-	ExcludeFoodModifier    []CodeableConcept             `bson:"excludeFoodModifier,omitempty" json:"excludeFoodModifier,omitempty"`
-	OralDiet               *NutritionOrderOralDiet       `bson:"oralDiet,omitempty" json:"oralDiet,omitempty"`
-	Supplement             []NutritionOrderSupplement    `bson:"supplement,omitempty" json:"supplement,omitempty"`
-	EnteralFormula         *NutritionOrderEnteralFormula `bson:"enteralFormula,omitempty" json:"enteralFormula,omitempty"`
-	Note                   []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	ID                           *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                     `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                    `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                     `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                    `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	Instantiates                 []*string                     `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
+	InstantiatesElement          []*Element                    `bson:"_instantiates,omitempty" json:"_instantiates,omitempty"`
+	Status                       RequestStatus                 `bson:"status" json:"status"`
+	StatusElement                *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent                 `bson:"intent" json:"intent"`
+	IntentElement                *Element                      `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Patient                      Reference                     `bson:"patient" json:"patient"`
+	Encounter                    *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	DateTime                     DateTime                      `bson:"dateTime" json:"dateTime"`
+	DateTimeElement              *Element                      `bson:"_dateTime,omitempty" json:"_dateTime,omitempty"`
+	Orderer                      *Reference                    `bson:"orderer,omitempty" json:"orderer,omitempty"`
+	AllergyIntolerance           []Reference                   `bson:"allergyIntolerance,omitempty" json:"allergyIntolerance,omitempty"`
+	FoodPreferenceModifier       []CodeableConcept             `bson:"foodPreferenceModifier,omitempty" json:"foodPreferenceModifier,omitempty"`
+	ExcludeFoodModifier          []CodeableConcept             `bson:"excludeFoodModifier,omitempty" json:"excludeFoodModifier,omitempty"`
+	OralDiet                     *NutritionOrderOralDiet       `bson:"oralDiet,omitempty" json:"oralDiet,omitempty"`
+	Supplement                   []NutritionOrderSupplement    `bson:"supplement,omitempty" json:"supplement,omitempty"`
+	EnteralFormula               *NutritionOrderEnteralFormula `bson:"enteralFormula,omitempty" json:"enteralFormula,omitempty"`
+	Note                         []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields                map[string]json.RawMessage    `bson:"-" json:"-"`
 }
 type NutritionOrderOralDiet struct {
 	ID                   *string                          `bson:"id,omitempty" json:"id,omitempty"`
@@ -57,63 +67,206 @@ type NutritionOrderOralDiet struct {
 	Texture              []NutritionOrderOralDietTexture  `bson:"texture,omitempty" json:"texture,omitempty"`
 	FluidConsistencyType []CodeableConcept                `bson:"fluidConsistencyType,omitempty" json:"fluidConsistencyType,omitempty"`
 	Instruction          *string                          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	InstructionElement   *Element                         `bson:"_instruction,omitempty" json:"_instruction,omitempty"`
+	UnknownFields        map[string]json.RawMessage       `bson:"-" json:"-"`
 }
 type NutritionOrderOralDietNutrient struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Modifier          *CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Amount            *Quantity        `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Modifier          *CodeableConcept           `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	Amount            *Quantity                  `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the NutritionOrderOralDietNutrient with its unknown fields.
+func (r NutritionOrderOralDietNutrient) MarshalJSON() ([]byte, error) {
+	type other NutritionOrderOralDietNutrient
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type NutritionOrderOralDietTexture struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Modifier          *CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	FoodType          *CodeableConcept `bson:"foodType,omitempty" json:"foodType,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Modifier          *CodeableConcept           `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	FoodType          *CodeableConcept           `bson:"foodType,omitempty" json:"foodType,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the NutritionOrderOralDietTexture with its unknown fields.
+func (r NutritionOrderOralDietTexture) MarshalJSON() ([]byte, error) {
+	type other NutritionOrderOralDietTexture
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the NutritionOrderOralDiet with its unknown fields.
+func (r NutritionOrderOralDiet) MarshalJSON() ([]byte, error) {
+	type other NutritionOrderOralDiet
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type NutritionOrderSupplement struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	ProductName       *string          `bson:"productName,omitempty" json:"productName,omitempty"`
-	Schedule          []Timing         `bson:"schedule,omitempty" json:"schedule,omitempty"`
-	Quantity          *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Instruction       *string          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type               *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	ProductName        *string                    `bson:"productName,omitempty" json:"productName,omitempty"`
+	ProductNameElement *Element                   `bson:"_productName,omitempty" json:"_productName,omitempty"`
+	Schedule           []Timing                   `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	Quantity           *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Instruction        *string                    `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	InstructionElement *Element                   `bson:"_instruction,omitempty" json:"_instruction,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the NutritionOrderSupplement with its unknown fields.
+func (r NutritionOrderSupplement) MarshalJSON() ([]byte, error) {
+	type other NutritionOrderSupplement
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type NutritionOrderEnteralFormula struct {
-	ID                        *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	BaseFormulaType           *CodeableConcept                             `bson:"baseFormulaType,omitempty" json:"baseFormulaType,omitempty"`
-	BaseFormulaProductName    *string                                      `bson:"baseFormulaProductName,omitempty" json:"baseFormulaProductName,omitempty"`
-	AdditiveType              *CodeableConcept                             `bson:"additiveType,omitempty" json:"additiveType,omitempty"`
-	AdditiveProductName       *string                                      `bson:"additiveProductName,omitempty" json:"additiveProductName,omitempty"`
-	CaloricDensity            *Quantity                                    `bson:"caloricDensity,omitempty" json:"caloricDensity,omitempty"`
-	RouteofAdministration     *CodeableConcept                             `bson:"routeofAdministration,omitempty" json:"routeofAdministration,omitempty"`
-	Administration            []NutritionOrderEnteralFormulaAdministration `bson:"administration,omitempty" json:"administration,omitempty"`
-	MaxVolumeToDeliver        *Quantity                                    `bson:"maxVolumeToDeliver,omitempty" json:"maxVolumeToDeliver,omitempty"`
-	AdministrationInstruction *string                                      `bson:"administrationInstruction,omitempty" json:"administrationInstruction,omitempty"`
+	ID                               *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	BaseFormulaType                  *CodeableConcept                             `bson:"baseFormulaType,omitempty" json:"baseFormulaType,omitempty"`
+	BaseFormulaProductName           *string                                      `bson:"baseFormulaProductName,omitempty" json:"baseFormulaProductName,omitempty"`
+	BaseFormulaProductNameElement    *Element                                     `bson:"_baseFormulaProductName,omitempty" json:"_baseFormulaProductName,omitempty"`
+	AdditiveType                     *CodeableConcept                             `bson:"additiveType,omitempty" json:"additiveType,omitempty"`
+	AdditiveProductName              *string                                      `bson:"additiveProductName,omitempty" json:"additiveProductName,omitempty"`
+	AdditiveProductNameElement       *Element                                     `bson:"_additiveProductName,omitempty" json:"_additiveProductName,omitempty"`
+	CaloricDensity                   *Quantity                                    `bson:"caloricDensity,omitempty" json:"caloricDensity,omitempty"`
+	RouteofAdministration            *CodeableConcept                             `bson:"routeofAdministration,omitempty" json:"routeofAdministration,omitempty"`
+	Administration                   []NutritionOrderEnteralFormulaAdministration `bson:"administration,omitempty" json:"administration,omitempty"`
+	MaxVolumeToDeliver               *Quantity                                    `bson:"maxVolumeToDeliver,omitempty" json:"maxVolumeToDeliver,omitempty"`
+	AdministrationInstruction        *string                                      `bson:"administrationInstruction,omitempty" json:"administrationInstruction,omitempty"`
+	AdministrationInstructionElement *Element                                     `bson:"_administrationInstruction,omitempty" json:"_administrationInstruction,omitempty"`
+	UnknownFields                    map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type NutritionOrderEnteralFormulaAdministration struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Schedule          *Timing     `bson:"schedule,omitempty" json:"schedule,omitempty"`
-	Quantity          *Quantity   `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Schedule          *Timing                    `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	RateQuantity      *Quantity                  `bson:"rateQuantity,omitempty" json:"rateQuantity,omitempty"`
+	RateRatio         *Ratio                     `bson:"rateRatio,omitempty" json:"rateRatio,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Rate returns NutritionOrder.enteralFormula.administration.rate[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *NutritionOrderEnteralFormulaAdministration) Rate() interface{} {
+	switch {
+	case r.RateQuantity != nil:
+		return r.RateQuantity
+	case r.RateRatio != nil:
+		return r.RateRatio
+	}
+	return nil
 }
+
+// SetRateQuantity sets NutritionOrder.enteralFormula.administration.rate[x] to the Quantity and clears the other types.
+func (r *NutritionOrderEnteralFormulaAdministration) SetRateQuantity(v Quantity) {
+	r.clearRate()
+	r.RateQuantity = &v
+}
+
+// SetRateRatio sets NutritionOrder.enteralFormula.administration.rate[x] to the Ratio and clears the other types.
+func (r *NutritionOrderEnteralFormulaAdministration) SetRateRatio(v Ratio) {
+	r.clearRate()
+	r.RateRatio = &v
+}
+func (r *NutritionOrderEnteralFormulaAdministration) clearRate() {
+	r.RateQuantity = nil
+	r.RateRatio = nil
+}
+
+// UnmarshalJSON unmarshals the NutritionOrderEnteralFormulaAdministration and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalJSON(b []byte) error {
+	type other NutritionOrderEnteralFormulaAdministration
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"NutritionOrder.enteralFormula.administration.rate[x]",
+		r.RateQuantity != nil,
+		r.RateRatio != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the NutritionOrderEnteralFormulaAdministration with its unknown fields.
+func (r NutritionOrderEnteralFormulaAdministration) MarshalJSON() ([]byte, error) {
+	type other NutritionOrderEnteralFormulaAdministration
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the NutritionOrderEnteralFormula with its unknown fields.
+func (r NutritionOrderEnteralFormula) MarshalJSON() ([]byte, error) {
+	type other NutritionOrderEnteralFormula
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the NutritionOrder and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *NutritionOrder) UnmarshalJSON(b []byte) error {
+	type other NutritionOrder
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("NutritionOrder.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("NutritionOrder.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	if err := checkElements("NutritionOrder.instantiates", len(r.Instantiates), len(r.InstantiatesElement)); err != nil {
+		return err
+	}
+	return checkCodes("NutritionOrder", r)
+}
+
 type OtherNutritionOrder NutritionOrder
 
 // MarshalJSON marshals the given NutritionOrder as JSON into a byte slice
 func (r NutritionOrder) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherNutritionOrder
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherNutritionOrder: OtherNutritionOrder(r),
 		ResourceType:        "NutritionOrder",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalNutritionOrder unmarshals a NutritionOrder.
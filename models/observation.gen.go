@@ -22,67 +22,472 @@ import "encoding/json"
 
 // Observation is documented here http://hl7.org/fhir/StructureDefinition/Observation
 type Observation struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf            []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status            ObservationStatus           `bson:"status" json:"status"`
-	Category          []CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
-	Code              CodeableConcept             `bson:"code" json:"code"`
-	Subject           *Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
-	Focus             []Reference                 `bson:"focus,omitempty" json:"focus,omitempty"`
-	Encounter         *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Issued            *string                     `bson:"issued,omitempty" json:"issued,omitempty"`
-	Performer         []Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
-	DataAbsentReason  *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
-	Interpretation    []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
-	Note              []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
-	BodySite          *CodeableConcept            `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Method            *CodeableConcept            `bson:"method,omitempty" json:"method,omitempty"`
-	Specimen          *Reference                  `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Device            *Reference                  `bson:"device,omitempty" json:"device,omitempty"`
-	ReferenceRange    []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
-	HasMember         []Reference                 `bson:"hasMember,omitempty" json:"hasMember,omitempty"`
-	DerivedFrom       []Reference                 `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	Component         []ObservationComponent      `bson:"component,omitempty" json:"component,omitempty"`
+	ID                       *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                     *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                  []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                   []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                   ObservationStatus           `bson:"status" json:"status"`
+	StatusElement            *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category                 []CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	Code                     CodeableConcept             `bson:"code" json:"code"`
+	Subject                  *Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
+	Focus                    []Reference                 `bson:"focus,omitempty" json:"focus,omitempty"`
+	Encounter                *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	EffectiveDateTime        *DateTime                   `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *Element                    `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *Period                     `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	EffectiveTiming          *Timing                     `bson:"effectiveTiming,omitempty" json:"effectiveTiming,omitempty"`
+	EffectiveInstant         *Instant                    `bson:"effectiveInstant,omitempty" json:"effectiveInstant,omitempty"`
+	EffectiveInstantElement  *Element                    `bson:"_effectiveInstant,omitempty" json:"_effectiveInstant,omitempty"`
+	Issued                   *Instant                    `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement            *Element                    `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Performer                []Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
+	ValueQuantity            *Quantity                   `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueCodeableConcept     *CodeableConcept            `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueString              *string                     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean             *bool                       `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element                    `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger             *int                        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element                    `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueRange               *Range                      `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                      `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueSampledData         *SampledData                `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueTime                *Time                       `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element                    `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueDateTime            *DateTime                   `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element                    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValuePeriod              *Period                     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	DataAbsentReason         *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
+	Interpretation           []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
+	Note                     []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+	BodySite                 *CodeableConcept            `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Method                   *CodeableConcept            `bson:"method,omitempty" json:"method,omitempty"`
+	Specimen                 *Reference                  `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Device                   *Reference                  `bson:"device,omitempty" json:"device,omitempty"`
+	ReferenceRange           []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+	HasMember                []Reference                 `bson:"hasMember,omitempty" json:"hasMember,omitempty"`
+	DerivedFrom              []Reference                 `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	Component                []ObservationComponent      `bson:"component,omitempty" json:"component,omitempty"`
+	UnknownFields            map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type ObservationReferenceRange struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Low               *Quantity         `bson:"low,omitempty" json:"low,omitempty"`
-	High              *Quantity         `bson:"high,omitempty" json:"high,omitempty"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	AppliesTo         []CodeableConcept `bson:"appliesTo,omitempty" json:"appliesTo,omitempty"`
-	Age               *Range            `bson:"age,omitempty" json:"age,omitempty"`
-	Text              *string           `bson:"text,omitempty" json:"text,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Low               *Quantity                  `bson:"low,omitempty" json:"low,omitempty"`
+	High              *Quantity                  `bson:"high,omitempty" json:"high,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	AppliesTo         []CodeableConcept          `bson:"appliesTo,omitempty" json:"appliesTo,omitempty"`
+	Age               *Range                     `bson:"age,omitempty" json:"age,omitempty"`
+	Text              *string                    `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ObservationReferenceRange with its unknown fields.
+func (r ObservationReferenceRange) MarshalJSON() ([]byte, error) {
+	type other ObservationReferenceRange
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ObservationComponent struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              CodeableConcept             `bson:"code" json:"code"`
-	DataAbsentReason  *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
-	Interpretation    []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
-	ReferenceRange    []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+	ID                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 CodeableConcept             `bson:"code" json:"code"`
+	ValueQuantity        *Quantity                   `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *CodeableConcept            `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueString          *string                     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean         *bool                       `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                    `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger         *int                        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                    `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueRange           *Range                      `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio           *Ratio                      `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueSampledData     *SampledData                `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueTime            *Time                       `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element                    `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueDateTime        *DateTime                   `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValuePeriod          *Period                     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	DataAbsentReason     *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
+	Interpretation       []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
+	ReferenceRange       []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+	UnknownFields        map[string]json.RawMessage  `bson:"-" json:"-"`
+}
+
+// Value returns Observation.component.value[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *ObservationComponent) Value() interface{} {
+	switch {
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	}
+	return nil
+}
+
+// SetValueQuantity sets Observation.component.value[x] to the Quantity and clears the other types.
+func (r *ObservationComponent) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueCodeableConcept sets Observation.component.value[x] to the CodeableConcept and clears the other types.
+func (r *ObservationComponent) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueString sets Observation.component.value[x] to the string and clears the other types.
+func (r *ObservationComponent) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueBoolean sets Observation.component.value[x] to the boolean and clears the other types.
+func (r *ObservationComponent) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueInteger sets Observation.component.value[x] to the integer and clears the other types.
+func (r *ObservationComponent) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueRange sets Observation.component.value[x] to the Range and clears the other types.
+func (r *ObservationComponent) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueRatio sets Observation.component.value[x] to the Ratio and clears the other types.
+func (r *ObservationComponent) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
+
+// SetValueSampledData sets Observation.component.value[x] to the SampledData and clears the other types.
+func (r *ObservationComponent) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
+
+// SetValueTime sets Observation.component.value[x] to the time and clears the other types.
+func (r *ObservationComponent) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueDateTime sets Observation.component.value[x] to the dateTime and clears the other types.
+func (r *ObservationComponent) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValuePeriod sets Observation.component.value[x] to the Period and clears the other types.
+func (r *ObservationComponent) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+func (r *ObservationComponent) clearValue() {
+	r.ValueQuantity = nil
+	r.ValueCodeableConcept = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueSampledData = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValuePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the ObservationComponent and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ObservationComponent) UnmarshalJSON(b []byte) error {
+	type other ObservationComponent
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Observation.component.value[x]",
+		r.ValueQuantity != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueSampledData != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValuePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ObservationComponent with its unknown fields.
+func (r ObservationComponent) MarshalJSON() ([]byte, error) {
+	type other ObservationComponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Effective returns Observation.effective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Observation) Effective() interface{} {
+	switch {
+	case r.EffectiveDateTime != nil:
+		return r.EffectiveDateTime
+	case r.EffectivePeriod != nil:
+		return r.EffectivePeriod
+	case r.EffectiveTiming != nil:
+		return r.EffectiveTiming
+	case r.EffectiveInstant != nil:
+		return r.EffectiveInstant
+	}
+	return nil
+}
+
+// SetEffectiveDateTime sets Observation.effective[x] to the dateTime and clears the other types.
+func (r *Observation) SetEffectiveDateTime(v DateTime) {
+	r.clearEffective()
+	r.EffectiveDateTime = &v
+}
+
+// SetEffectivePeriod sets Observation.effective[x] to the Period and clears the other types.
+func (r *Observation) SetEffectivePeriod(v Period) {
+	r.clearEffective()
+	r.EffectivePeriod = &v
+}
+
+// SetEffectiveTiming sets Observation.effective[x] to the Timing and clears the other types.
+func (r *Observation) SetEffectiveTiming(v Timing) {
+	r.clearEffective()
+	r.EffectiveTiming = &v
+}
+
+// SetEffectiveInstant sets Observation.effective[x] to the instant and clears the other types.
+func (r *Observation) SetEffectiveInstant(v Instant) {
+	r.clearEffective()
+	r.EffectiveInstant = &v
+}
+func (r *Observation) clearEffective() {
+	r.EffectiveDateTime = nil
+	r.EffectiveDateTimeElement = nil
+	r.EffectivePeriod = nil
+	r.EffectiveTiming = nil
+	r.EffectiveInstant = nil
+	r.EffectiveInstantElement = nil
+}
+
+// Value returns Observation.value[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *Observation) Value() interface{} {
+	switch {
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	}
+	return nil
+}
+
+// SetValueQuantity sets Observation.value[x] to the Quantity and clears the other types.
+func (r *Observation) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueCodeableConcept sets Observation.value[x] to the CodeableConcept and clears the other types.
+func (r *Observation) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueString sets Observation.value[x] to the string and clears the other types.
+func (r *Observation) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueBoolean sets Observation.value[x] to the boolean and clears the other types.
+func (r *Observation) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueInteger sets Observation.value[x] to the integer and clears the other types.
+func (r *Observation) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
 }
+
+// SetValueRange sets Observation.value[x] to the Range and clears the other types.
+func (r *Observation) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueRatio sets Observation.value[x] to the Ratio and clears the other types.
+func (r *Observation) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
+
+// SetValueSampledData sets Observation.value[x] to the SampledData and clears the other types.
+func (r *Observation) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
+
+// SetValueTime sets Observation.value[x] to the time and clears the other types.
+func (r *Observation) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueDateTime sets Observation.value[x] to the dateTime and clears the other types.
+func (r *Observation) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValuePeriod sets Observation.value[x] to the Period and clears the other types.
+func (r *Observation) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+func (r *Observation) clearValue() {
+	r.ValueQuantity = nil
+	r.ValueCodeableConcept = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueSampledData = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValuePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the Observation and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Observation) UnmarshalJSON(b []byte) error {
+	type other Observation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Observation.effective[x]",
+		r.EffectiveDateTime != nil || r.EffectiveDateTimeElement != nil,
+		r.EffectivePeriod != nil,
+		r.EffectiveTiming != nil,
+		r.EffectiveInstant != nil || r.EffectiveInstantElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Observation.value[x]",
+		r.ValueQuantity != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueSampledData != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValuePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Observation", r)
+}
+
 type OtherObservation Observation
 
 // MarshalJSON marshals the given Observation as JSON into a byte slice
 func (r Observation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherObservation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherObservation: OtherObservation(r),
 		ResourceType:     "Observation",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalObservation unmarshals a Observation.
@@ -14,95 +14,60 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.094496 +0000 UTC
+// 2026-10-19 02:55:18.864706636 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ObservationDataType is documented here http://hl7.org/fhir/ValueSet/permitted-data-type
-type ObservationDataType int
+// The empty ObservationDataType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ObservationDataType string
 
 const (
-	ObservationDataTypeQuantity ObservationDataType = iota
-	ObservationDataTypeCodeableConcept
-	ObservationDataTypeString
-	ObservationDataTypeBoolean
-	ObservationDataTypeInteger
-	ObservationDataTypeRange
-	ObservationDataTypeRatio
-	ObservationDataTypeSampledData
-	ObservationDataTypeTime
-	ObservationDataTypeDateTime
-	ObservationDataTypePeriod
+	ObservationDataTypeQuantity        ObservationDataType = "Quantity"
+	ObservationDataTypeCodeableConcept ObservationDataType = "CodeableConcept"
+	ObservationDataTypeString          ObservationDataType = "string"
+	ObservationDataTypeBoolean         ObservationDataType = "boolean"
+	ObservationDataTypeInteger         ObservationDataType = "integer"
+	ObservationDataTypeRange           ObservationDataType = "Range"
+	ObservationDataTypeRatio           ObservationDataType = "Ratio"
+	ObservationDataTypeSampledData     ObservationDataType = "SampledData"
+	ObservationDataTypeTime            ObservationDataType = "time"
+	ObservationDataTypeDateTime        ObservationDataType = "dateTime"
+	ObservationDataTypePeriod          ObservationDataType = "Period"
 )
 
 func (code ObservationDataType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ObservationDataType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ObservationDataType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "Quantity":
-		*code = ObservationDataTypeQuantity
-	case "CodeableConcept":
-		*code = ObservationDataTypeCodeableConcept
-	case "string":
-		*code = ObservationDataTypeString
-	case "boolean":
-		*code = ObservationDataTypeBoolean
-	case "integer":
-		*code = ObservationDataTypeInteger
-	case "Range":
-		*code = ObservationDataTypeRange
-	case "Ratio":
-		*code = ObservationDataTypeRatio
-	case "SampledData":
-		*code = ObservationDataTypeSampledData
-	case "time":
-		*code = ObservationDataTypeTime
-	case "dateTime":
-		*code = ObservationDataTypeDateTime
-	case "Period":
-		*code = ObservationDataTypePeriod
-	default:
-		return fmt.Errorf("unknown ObservationDataType code `%s`", s)
+func (code *ObservationDataType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ObservationDataType(s)
 	return nil
 }
 func (code ObservationDataType) String() string {
 	return code.Code()
 }
 func (code ObservationDataType) Code() string {
+	return string(code)
+}
+func (code ObservationDataType) Known() bool {
 	switch code {
-	case ObservationDataTypeQuantity:
-		return "Quantity"
-	case ObservationDataTypeCodeableConcept:
-		return "CodeableConcept"
-	case ObservationDataTypeString:
-		return "string"
-	case ObservationDataTypeBoolean:
-		return "boolean"
-	case ObservationDataTypeInteger:
-		return "integer"
-	case ObservationDataTypeRange:
-		return "Range"
-	case ObservationDataTypeRatio:
-		return "Ratio"
-	case ObservationDataTypeSampledData:
-		return "SampledData"
-	case ObservationDataTypeTime:
-		return "time"
-	case ObservationDataTypeDateTime:
-		return "dateTime"
-	case ObservationDataTypePeriod:
-		return "Period"
+	case ObservationDataTypeQuantity, ObservationDataTypeCodeableConcept, ObservationDataTypeString, ObservationDataTypeBoolean, ObservationDataTypeInteger, ObservationDataTypeRange, ObservationDataTypeRatio, ObservationDataTypeSampledData, ObservationDataTypeTime, ObservationDataTypeDateTime, ObservationDataTypePeriod:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ObservationDataType) Display() string {
 	switch code {
@@ -22,60 +22,112 @@ import "encoding/json"
 
 // ObservationDefinition is documented here http://hl7.org/fhir/StructureDefinition/ObservationDefinition
 type ObservationDefinition struct {
-	ID                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category               []CodeableConcept                         `bson:"category,omitempty" json:"category,omitempty"`
-	Code                   CodeableConcept                           `bson:"code" json:"code"`
-	Identifier             []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	PermittedDataType      []ObservationDataType                     `bson:"permittedDataType,omitempty" json:"permittedDataType,omitempty"`
-	MultipleResultsAllowed *bool                                     `bson:"multipleResultsAllowed,omitempty" json:"multipleResultsAllowed,omitempty"`
-	Method                 *CodeableConcept                          `bson:"method,omitempty" json:"method,omitempty"`
-	PreferredReportName    *string                                   `bson:"preferredReportName,omitempty" json:"preferredReportName,omitempty"`
-	QuantitativeDetails    *ObservationDefinitionQuantitativeDetails `bson:"quantitativeDetails,omitempty" json:"quantitativeDetails,omitempty"`
-	QualifiedInterval      []ObservationDefinitionQualifiedInterval  `bson:"qualifiedInterval,omitempty" json:"qualifiedInterval,omitempty"`
-	ValidCodedValueSet     *Reference                                `bson:"validCodedValueSet,omitempty" json:"validCodedValueSet,omitempty"`
-	NormalCodedValueSet    *Reference                                `bson:"normalCodedValueSet,omitempty" json:"normalCodedValueSet,omitempty"`
-	AbnormalCodedValueSet  *Reference                                `bson:"abnormalCodedValueSet,omitempty" json:"abnormalCodedValueSet,omitempty"`
-	CriticalCodedValueSet  *Reference                                `bson:"criticalCodedValueSet,omitempty" json:"criticalCodedValueSet,omitempty"`
+	ID                            *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                          *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     ContainedResources                        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category                      []CodeableConcept                         `bson:"category,omitempty" json:"category,omitempty"`
+	Code                          CodeableConcept                           `bson:"code" json:"code"`
+	Identifier                    []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	PermittedDataType             []*ObservationDataType                    `bson:"permittedDataType,omitempty" json:"permittedDataType,omitempty"`
+	PermittedDataTypeElement      []*Element                                `bson:"_permittedDataType,omitempty" json:"_permittedDataType,omitempty"`
+	MultipleResultsAllowed        *bool                                     `bson:"multipleResultsAllowed,omitempty" json:"multipleResultsAllowed,omitempty"`
+	MultipleResultsAllowedElement *Element                                  `bson:"_multipleResultsAllowed,omitempty" json:"_multipleResultsAllowed,omitempty"`
+	Method                        *CodeableConcept                          `bson:"method,omitempty" json:"method,omitempty"`
+	PreferredReportName           *string                                   `bson:"preferredReportName,omitempty" json:"preferredReportName,omitempty"`
+	PreferredReportNameElement    *Element                                  `bson:"_preferredReportName,omitempty" json:"_preferredReportName,omitempty"`
+	QuantitativeDetails           *ObservationDefinitionQuantitativeDetails `bson:"quantitativeDetails,omitempty" json:"quantitativeDetails,omitempty"`
+	QualifiedInterval             []ObservationDefinitionQualifiedInterval  `bson:"qualifiedInterval,omitempty" json:"qualifiedInterval,omitempty"`
+	ValidCodedValueSet            *Reference                                `bson:"validCodedValueSet,omitempty" json:"validCodedValueSet,omitempty"`
+	NormalCodedValueSet           *Reference                                `bson:"normalCodedValueSet,omitempty" json:"normalCodedValueSet,omitempty"`
+	AbnormalCodedValueSet         *Reference                                `bson:"abnormalCodedValueSet,omitempty" json:"abnormalCodedValueSet,omitempty"`
+	CriticalCodedValueSet         *Reference                                `bson:"criticalCodedValueSet,omitempty" json:"criticalCodedValueSet,omitempty"`
+	UnknownFields                 map[string]json.RawMessage                `bson:"-" json:"-"`
 }
 type ObservationDefinitionQuantitativeDetails struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	CustomaryUnit     *CodeableConcept `bson:"customaryUnit,omitempty" json:"customaryUnit,omitempty"`
-	Unit              *CodeableConcept `bson:"unit,omitempty" json:"unit,omitempty"`
-	ConversionFactor  *Decimal         `bson:"conversionFactor,omitempty" json:"conversionFactor,omitempty"`
-	DecimalPrecision  *int             `bson:"decimalPrecision,omitempty" json:"decimalPrecision,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	CustomaryUnit           *CodeableConcept           `bson:"customaryUnit,omitempty" json:"customaryUnit,omitempty"`
+	Unit                    *CodeableConcept           `bson:"unit,omitempty" json:"unit,omitempty"`
+	ConversionFactor        *Decimal                   `bson:"conversionFactor,omitempty" json:"conversionFactor,omitempty"`
+	ConversionFactorElement *Element                   `bson:"_conversionFactor,omitempty" json:"_conversionFactor,omitempty"`
+	DecimalPrecision        *int                       `bson:"decimalPrecision,omitempty" json:"decimalPrecision,omitempty"`
+	DecimalPrecisionElement *Element                   `bson:"_decimalPrecision,omitempty" json:"_decimalPrecision,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ObservationDefinitionQuantitativeDetails with its unknown fields.
+func (r ObservationDefinitionQuantitativeDetails) MarshalJSON() ([]byte, error) {
+	type other ObservationDefinitionQuantitativeDetails
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ObservationDefinitionQualifiedInterval struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          *ObservationRangeCategory `bson:"category,omitempty" json:"category,omitempty"`
-	Range             *Range                    `bson:"range,omitempty" json:"range,omitempty"`
-	Context           *CodeableConcept          `bson:"context,omitempty" json:"context,omitempty"`
-	AppliesTo         []CodeableConcept         `bson:"appliesTo,omitempty" json:"appliesTo,omitempty"`
-	Gender            *AdministrativeGender     `bson:"gender,omitempty" json:"gender,omitempty"`
-	Age               *Range                    `bson:"age,omitempty" json:"age,omitempty"`
-	GestationalAge    *Range                    `bson:"gestationalAge,omitempty" json:"gestationalAge,omitempty"`
-	Condition         *string                   `bson:"condition,omitempty" json:"condition,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category          *ObservationRangeCategory  `bson:"category,omitempty" json:"category,omitempty"`
+	CategoryElement   *Element                   `bson:"_category,omitempty" json:"_category,omitempty"`
+	Range             *Range                     `bson:"range,omitempty" json:"range,omitempty"`
+	Context           *CodeableConcept           `bson:"context,omitempty" json:"context,omitempty"`
+	AppliesTo         []CodeableConcept          `bson:"appliesTo,omitempty" json:"appliesTo,omitempty"`
+	Gender            *AdministrativeGender      `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement     *Element                   `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	Age               *Range                     `bson:"age,omitempty" json:"age,omitempty"`
+	GestationalAge    *Range                     `bson:"gestationalAge,omitempty" json:"gestationalAge,omitempty"`
+	Condition         *string                    `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement  *Element                   `bson:"_condition,omitempty" json:"_condition,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ObservationDefinitionQualifiedInterval with its unknown fields.
+func (r ObservationDefinitionQualifiedInterval) MarshalJSON() ([]byte, error) {
+	type other ObservationDefinitionQualifiedInterval
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the ObservationDefinition and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ObservationDefinition) UnmarshalJSON(b []byte) error {
+	type other ObservationDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ObservationDefinition.permittedDataType", len(r.PermittedDataType), len(r.PermittedDataTypeElement)); err != nil {
+		return err
+	}
+	return checkCodes("ObservationDefinition", r)
+}
+
 type OtherObservationDefinition ObservationDefinition
 
 // MarshalJSON marshals the given ObservationDefinition as JSON into a byte slice
 func (r ObservationDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherObservationDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherObservationDefinition: OtherObservationDefinition(r),
 		ResourceType:               "ObservationDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalObservationDefinition unmarshals a ObservationDefinition.
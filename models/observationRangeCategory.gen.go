@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.186492 +0000 UTC
+// 2026-10-19 02:55:18.866077484 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ObservationRangeCategory is documented here http://hl7.org/fhir/ValueSet/observation-range-category
-type ObservationRangeCategory int
+// The empty ObservationRangeCategory is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ObservationRangeCategory string
 
 const (
-	ObservationRangeCategoryReference ObservationRangeCategory = iota
-	ObservationRangeCategoryCritical
-	ObservationRangeCategoryAbsolute
+	ObservationRangeCategoryReference ObservationRangeCategory = "reference"
+	ObservationRangeCategoryCritical  ObservationRangeCategory = "critical"
+	ObservationRangeCategoryAbsolute  ObservationRangeCategory = "absolute"
 )
 
 func (code ObservationRangeCategory) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ObservationRangeCategory"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ObservationRangeCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "reference":
-		*code = ObservationRangeCategoryReference
-	case "critical":
-		*code = ObservationRangeCategoryCritical
-	case "absolute":
-		*code = ObservationRangeCategoryAbsolute
-	default:
-		return fmt.Errorf("unknown ObservationRangeCategory code `%s`", s)
+func (code *ObservationRangeCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ObservationRangeCategory(s)
 	return nil
 }
 func (code ObservationRangeCategory) String() string {
 	return code.Code()
 }
 func (code ObservationRangeCategory) Code() string {
+	return string(code)
+}
+func (code ObservationRangeCategory) Known() bool {
 	switch code {
-	case ObservationRangeCategoryReference:
-		return "reference"
-	case ObservationRangeCategoryCritical:
-		return "critical"
-	case ObservationRangeCategoryAbsolute:
-		return "absolute"
+	case ObservationRangeCategoryReference, ObservationRangeCategoryCritical, ObservationRangeCategoryAbsolute:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ObservationRangeCategory) Display() string {
 	switch code {
@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.022352 +0000 UTC
+// 2026-10-19 02:55:18.867342396 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ObservationStatus is documented here http://hl7.org/fhir/ValueSet/observation-status
-type ObservationStatus int
+// The empty ObservationStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ObservationStatus string
 
 const (
-	ObservationStatusRegistered ObservationStatus = iota
-	ObservationStatusPreliminary
-	ObservationStatusFinal
-	ObservationStatusAmended
-	ObservationStatusCorrected
-	ObservationStatusCancelled
-	ObservationStatusEnteredInError
-	ObservationStatusUnknown
+	ObservationStatusRegistered     ObservationStatus = "registered"
+	ObservationStatusPreliminary    ObservationStatus = "preliminary"
+	ObservationStatusFinal          ObservationStatus = "final"
+	ObservationStatusAmended        ObservationStatus = "amended"
+	ObservationStatusCorrected      ObservationStatus = "corrected"
+	ObservationStatusCancelled      ObservationStatus = "cancelled"
+	ObservationStatusEnteredInError ObservationStatus = "entered-in-error"
+	ObservationStatusUnknown        ObservationStatus = "unknown"
 )
 
 func (code ObservationStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ObservationStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ObservationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "registered":
-		*code = ObservationStatusRegistered
-	case "preliminary":
-		*code = ObservationStatusPreliminary
-	case "final":
-		*code = ObservationStatusFinal
-	case "amended":
-		*code = ObservationStatusAmended
-	case "corrected":
-		*code = ObservationStatusCorrected
-	case "cancelled":
-		*code = ObservationStatusCancelled
-	case "entered-in-error":
-		*code = ObservationStatusEnteredInError
-	case "unknown":
-		*code = ObservationStatusUnknown
-	default:
-		return fmt.Errorf("unknown ObservationStatus code `%s`", s)
+func (code *ObservationStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ObservationStatus(s)
 	return nil
 }
 func (code ObservationStatus) String() string {
 	return code.Code()
 }
 func (code ObservationStatus) Code() string {
+	return string(code)
+}
+func (code ObservationStatus) Known() bool {
 	switch code {
-	case ObservationStatusRegistered:
-		return "registered"
-	case ObservationStatusPreliminary:
-		return "preliminary"
-	case ObservationStatusFinal:
-		return "final"
-	case ObservationStatusAmended:
-		return "amended"
-	case ObservationStatusCorrected:
-		return "corrected"
-	case ObservationStatusCancelled:
-		return "cancelled"
-	case ObservationStatusEnteredInError:
-		return "entered-in-error"
-	case ObservationStatusUnknown:
-		return "unknown"
+	case ObservationStatusRegistered, ObservationStatusPreliminary, ObservationStatusFinal, ObservationStatusAmended, ObservationStatusCorrected, ObservationStatusCancelled, ObservationStatusEnteredInError, ObservationStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ObservationStatus) Display() string {
 	switch code {
@@ -22,88 +22,214 @@ import "encoding/json"
 
 // OperationDefinition is documented here http://hl7.org/fhir/StructureDefinition/OperationDefinition
 type OperationDefinition struct {
-	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                        `bson:"url,omitempty" json:"url,omitempty"`
-	Version           *string                        `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                         `bson:"name" json:"name"`
-	Title             *string                        `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus              `bson:"status" json:"status"`
-	Kind              OperationKind                  `bson:"kind" json:"kind"`
-	Experimental      *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                      `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                        `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                        `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	AffectsState      *bool                          `bson:"affectsState,omitempty" json:"affectsState,omitempty"`
-	Code              string                         `bson:"code" json:"code"`
-	Comment           *string                        `bson:"comment,omitempty" json:"comment,omitempty"`
-	Base              *string                        `bson:"base,omitempty" json:"base,omitempty"`
-	Resource          []ResourceType                 `bson:"resource,omitempty" json:"resource,omitempty"`
-	System            bool                           `bson:"system" json:"system"`
-	Type              bool                           `bson:"type" json:"type"`
-	Instance          bool                           `bson:"instance" json:"instance"`
-	InputProfile      *string                        `bson:"inputProfile,omitempty" json:"inputProfile,omitempty"`
-	OutputProfile     *string                        `bson:"outputProfile,omitempty" json:"outputProfile,omitempty"`
-	Parameter         []OperationDefinitionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	Overload          []OperationDefinitionOverload  `bson:"overload,omitempty" json:"overload,omitempty"`
+	ID                   *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  *string                        `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement           *Element                       `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                        `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                       `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                         `bson:"name" json:"name"`
+	NameElement          *Element                       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                        `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                       `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus              `bson:"status" json:"status"`
+	StatusElement        *Element                       `bson:"_status,omitempty" json:"_status,omitempty"`
+	Kind                 OperationKind                  `bson:"kind" json:"kind"`
+	KindElement          *Element                       `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Experimental         *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                       `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                      `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                       `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                       `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                        `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                       `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                        `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                       `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	AffectsState         *bool                          `bson:"affectsState,omitempty" json:"affectsState,omitempty"`
+	AffectsStateElement  *Element                       `bson:"_affectsState,omitempty" json:"_affectsState,omitempty"`
+	Code                 string                         `bson:"code" json:"code"`
+	CodeElement          *Element                       `bson:"_code,omitempty" json:"_code,omitempty"`
+	Comment              *string                        `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                       `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Base                 *string                        `bson:"base,omitempty" json:"base,omitempty"`
+	BaseElement          *Element                       `bson:"_base,omitempty" json:"_base,omitempty"`
+	Resource             []*ResourceType                `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement      []*Element                     `bson:"_resource,omitempty" json:"_resource,omitempty"`
+	System               bool                           `bson:"system" json:"system"`
+	SystemElement        *Element                       `bson:"_system,omitempty" json:"_system,omitempty"`
+	Type                 bool                           `bson:"type" json:"type"`
+	TypeElement          *Element                       `bson:"_type,omitempty" json:"_type,omitempty"`
+	Instance             bool                           `bson:"instance" json:"instance"`
+	InstanceElement      *Element                       `bson:"_instance,omitempty" json:"_instance,omitempty"`
+	InputProfile         *string                        `bson:"inputProfile,omitempty" json:"inputProfile,omitempty"`
+	InputProfileElement  *Element                       `bson:"_inputProfile,omitempty" json:"_inputProfile,omitempty"`
+	OutputProfile        *string                        `bson:"outputProfile,omitempty" json:"outputProfile,omitempty"`
+	OutputProfileElement *Element                       `bson:"_outputProfile,omitempty" json:"_outputProfile,omitempty"`
+	Parameter            []OperationDefinitionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Overload             []OperationDefinitionOverload  `bson:"overload,omitempty" json:"overload,omitempty"`
+	UnknownFields        map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type OperationDefinitionParameter struct {
-	ID                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                                       `bson:"name" json:"name"`
-	Use               OperationParameterUse                        `bson:"use" json:"use"`
-	Min               int                                          `bson:"min" json:"min"`
-	Max               string                                       `bson:"max" json:"max"`
-	Documentation     *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Type              *string                                      `bson:"type,omitempty" json:"type,omitempty"`
-	TargetProfile     []string                                     `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
-	SearchType        *SearchParamType                             `bson:"searchType,omitempty" json:"searchType,omitempty"`
-	Binding           *OperationDefinitionParameterBinding         `bson:"binding,omitempty" json:"binding,omitempty"`
-	ReferencedFrom    []OperationDefinitionParameterReferencedFrom `bson:"referencedFrom,omitempty" json:"referencedFrom,omitempty"`
-	Part              []OperationDefinitionParameter               `bson:"part,omitempty" json:"part,omitempty"`
+	ID                   *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                                       `bson:"name" json:"name"`
+	NameElement          *Element                                     `bson:"_name,omitempty" json:"_name,omitempty"`
+	Use                  OperationParameterUse                        `bson:"use" json:"use"`
+	UseElement           *Element                                     `bson:"_use,omitempty" json:"_use,omitempty"`
+	Min                  int                                          `bson:"min" json:"min"`
+	MinElement           *Element                                     `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                  string                                       `bson:"max" json:"max"`
+	MaxElement           *Element                                     `bson:"_max,omitempty" json:"_max,omitempty"`
+	Documentation        *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                                     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Type                 *FHIRAllTypes                                `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement          *Element                                     `bson:"_type,omitempty" json:"_type,omitempty"`
+	TargetProfile        []*string                                    `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
+	TargetProfileElement []*Element                                   `bson:"_targetProfile,omitempty" json:"_targetProfile,omitempty"`
+	SearchType           *SearchParamType                             `bson:"searchType,omitempty" json:"searchType,omitempty"`
+	SearchTypeElement    *Element                                     `bson:"_searchType,omitempty" json:"_searchType,omitempty"`
+	Binding              *OperationDefinitionParameterBinding         `bson:"binding,omitempty" json:"binding,omitempty"`
+	ReferencedFrom       []OperationDefinitionParameterReferencedFrom `bson:"referencedFrom,omitempty" json:"referencedFrom,omitempty"`
+	Part                 []OperationDefinitionParameter               `bson:"part,omitempty" json:"part,omitempty"`
+	UnknownFields        map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type OperationDefinitionParameterBinding struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Strength          BindingStrength `bson:"strength" json:"strength"`
-	ValueSet          string          `bson:"valueSet" json:"valueSet"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Strength          BindingStrength            `bson:"strength" json:"strength"`
+	StrengthElement   *Element                   `bson:"_strength,omitempty" json:"_strength,omitempty"`
+	ValueSet          string                     `bson:"valueSet" json:"valueSet"`
+	ValueSetElement   *Element                   `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the OperationDefinitionParameterBinding with its unknown fields.
+func (r OperationDefinitionParameterBinding) MarshalJSON() ([]byte, error) {
+	type other OperationDefinitionParameterBinding
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type OperationDefinitionParameterReferencedFrom struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Source            string      `bson:"source" json:"source"`
-	SourceId          *string     `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Source            string                     `bson:"source" json:"source"`
+	SourceElement     *Element                   `bson:"_source,omitempty" json:"_source,omitempty"`
+	SourceId          *string                    `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement   *Element                   `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the OperationDefinitionParameterReferencedFrom with its unknown fields.
+func (r OperationDefinitionParameterReferencedFrom) MarshalJSON() ([]byte, error) {
+	type other OperationDefinitionParameterReferencedFrom
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the OperationDefinitionParameter and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *OperationDefinitionParameter) UnmarshalJSON(b []byte) error {
+	type other OperationDefinitionParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("OperationDefinition.parameter.targetProfile", len(r.TargetProfile), len(r.TargetProfileElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the OperationDefinitionParameter with its unknown fields.
+func (r OperationDefinitionParameter) MarshalJSON() ([]byte, error) {
+	type other OperationDefinitionParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type OperationDefinitionOverload struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ParameterName     []string    `bson:"parameterName,omitempty" json:"parameterName,omitempty"`
-	Comment           *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ParameterName        []*string                  `bson:"parameterName,omitempty" json:"parameterName,omitempty"`
+	ParameterNameElement []*Element                 `bson:"_parameterName,omitempty" json:"_parameterName,omitempty"`
+	Comment              *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the OperationDefinitionOverload and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *OperationDefinitionOverload) UnmarshalJSON(b []byte) error {
+	type other OperationDefinitionOverload
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("OperationDefinition.overload.parameterName", len(r.ParameterName), len(r.ParameterNameElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the OperationDefinitionOverload with its unknown fields.
+func (r OperationDefinitionOverload) MarshalJSON() ([]byte, error) {
+	type other OperationDefinitionOverload
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the OperationDefinition and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *OperationDefinition) UnmarshalJSON(b []byte) error {
+	type other OperationDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("OperationDefinition.resource", len(r.Resource), len(r.ResourceElement)); err != nil {
+		return err
+	}
+	return checkCodes("OperationDefinition", r)
+}
+
 type OtherOperationDefinition OperationDefinition
 
 // MarshalJSON marshals the given OperationDefinition as JSON into a byte slice
 func (r OperationDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherOperationDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherOperationDefinition: OtherOperationDefinition(r),
 		ResourceType:             "OperationDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalOperationDefinition unmarshals a OperationDefinition.
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.292598 +0000 UTC
+// 2026-10-19 02:55:18.868587717 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // OperationKind is documented here http://hl7.org/fhir/ValueSet/operation-kind
-type OperationKind int
+// The empty OperationKind is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type OperationKind string
 
 const (
-	OperationKindOperation OperationKind = iota
-	OperationKindQuery
+	OperationKindOperation OperationKind = "operation"
+	OperationKindQuery     OperationKind = "query"
 )
 
 func (code OperationKind) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "OperationKind"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *OperationKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "operation":
-		*code = OperationKindOperation
-	case "query":
-		*code = OperationKindQuery
-	default:
-		return fmt.Errorf("unknown OperationKind code `%s`", s)
+func (code *OperationKind) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = OperationKind(s)
 	return nil
 }
 func (code OperationKind) String() string {
 	return code.Code()
 }
 func (code OperationKind) Code() string {
+	return string(code)
+}
+func (code OperationKind) Known() bool {
 	switch code {
-	case OperationKindOperation:
-		return "operation"
-	case OperationKindQuery:
-		return "query"
+	case OperationKindOperation, OperationKindQuery:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code OperationKind) Display() string {
 	switch code {
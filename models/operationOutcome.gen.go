@@ -22,37 +22,86 @@ import "encoding/json"
 
 // OperationOutcome is documented here http://hl7.org/fhir/StructureDefinition/OperationOutcome
 type OperationOutcome struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Issue             []OperationOutcomeIssue `bson:"issue" json:"issue"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Issue                []OperationOutcomeIssue    `bson:"issue" json:"issue"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type OperationOutcomeIssue struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Severity          IssueSeverity    `bson:"severity" json:"severity"`
-	Code              IssueType        `bson:"code" json:"code"`
-	Details           *CodeableConcept `bson:"details,omitempty" json:"details,omitempty"`
-	Diagnostics       *string          `bson:"diagnostics,omitempty" json:"diagnostics,omitempty"`
-	Location          []string         `bson:"location,omitempty" json:"location,omitempty"`
-	Expression        []string         `bson:"expression,omitempty" json:"expression,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Severity           IssueSeverity              `bson:"severity" json:"severity"`
+	SeverityElement    *Element                   `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	Code               IssueType                  `bson:"code" json:"code"`
+	CodeElement        *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Details            *CodeableConcept           `bson:"details,omitempty" json:"details,omitempty"`
+	Diagnostics        *string                    `bson:"diagnostics,omitempty" json:"diagnostics,omitempty"`
+	DiagnosticsElement *Element                   `bson:"_diagnostics,omitempty" json:"_diagnostics,omitempty"`
+	Location           []*string                  `bson:"location,omitempty" json:"location,omitempty"`
+	LocationElement    []*Element                 `bson:"_location,omitempty" json:"_location,omitempty"`
+	Expression         []*string                  `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  []*Element                 `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the OperationOutcomeIssue and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *OperationOutcomeIssue) UnmarshalJSON(b []byte) error {
+	type other OperationOutcomeIssue
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("OperationOutcome.issue.location", len(r.Location), len(r.LocationElement)); err != nil {
+		return err
+	}
+	if err := checkElements("OperationOutcome.issue.expression", len(r.Expression), len(r.ExpressionElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the OperationOutcomeIssue with its unknown fields.
+func (r OperationOutcomeIssue) MarshalJSON() ([]byte, error) {
+	type other OperationOutcomeIssue
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the OperationOutcome and fails with CodeError when a coded element has an unknown code.
+func (r *OperationOutcome) UnmarshalJSON(b []byte) error {
+	type other OperationOutcome
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("OperationOutcome", r)
+}
+
 type OtherOperationOutcome OperationOutcome
 
 // MarshalJSON marshals the given OperationOutcome as JSON into a byte slice
 func (r OperationOutcome) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherOperationOutcome
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherOperationOutcome: OtherOperationOutcome(r),
 		ResourceType:          "OperationOutcome",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalOperationOutcome unmarshals a OperationOutcome.
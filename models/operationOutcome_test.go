@@ -0,0 +1,81 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestOperationOutcome(t *testing.T) {
+	outcome := OperationOutcome{
+		Text: &Narrative{Div: `<div xmlns="http://www.w3.org/1999/xhtml"><p>Validation failed</p></div>`},
+		Issue: []OperationOutcomeIssue{
+			{
+				Severity:    IssueSeverityError,
+				Code:        IssueTypeRequired,
+				Details:     &CodeableConcept{Coding: []Coding{{Code: NewString("MSG_REQUIRED")}}},
+				Diagnostics: NewString("minimum required = 1"),
+				Expression:  NewStrings("Patient.name"),
+			},
+			{
+				Severity: IssueSeverityWarning,
+				Code:     IssueTypeBusinessRule,
+				Details:  &CodeableConcept{Text: NewString("Unusual birth date")},
+				Location: NewStrings("/f:Patient/f:birthDate"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{
+			name:     "error issue",
+			got:      outcome.Issue[0].String(),
+			expected: "error [required] MSG_REQUIRED: minimum required = 1 (at Patient.name)",
+		},
+		{
+			name:     "warning issue",
+			got:      outcome.Issue[1].String(),
+			expected: "warning [business-rule] Unusual birth date (at /f:Patient/f:birthDate)",
+		},
+		{
+			name:     "narrative",
+			got:      OperationOutcome{Text: outcome.Text}.String(),
+			expected: "Validation failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("expected: %q; actual: %q", tt.expected, tt.got)
+			}
+		})
+	}
+
+	if n := len(outcome.Warnings()); n != 1 {
+		t.Errorf("expected 1 warning, got %d", n)
+	}
+	var issueErr IssueError
+	if err := outcome.Err(); !errors.As(err, &issueErr) || issueErr.Issue.Code != IssueTypeRequired {
+		t.Errorf("expected IssueError, got %v", err)
+	}
+	if err := (OperationOutcome{Issue: outcome.Issue[1:]}).Err(); err != nil {
+		t.Errorf("expected no error for warnings, got %v", err)
+	}
+
+	invalid := OperationOutcomeIssue{Severity: IssueSeverityFatal, Code: IssueTypeInvalid}
+	errs := OperationOutcome{Issue: []OperationOutcomeIssue{outcome.Issue[1], invalid, outcome.Issue[0]}}.Err()
+	var coder IssueCoder
+	if !errors.As(errs, &issueErr) || issueErr.Issue.Code != IssueTypeInvalid || !errors.As(errs, &coder) || coder.IssueCode() != IssueTypeInvalid {
+		t.Errorf("expected the first IssueError of %v", errs)
+	}
+
+	built := NewOperationOutcome(outcome.Err(), fmt.Errorf("wrapped: %w", IssueError{Issue: outcome.Issue[1]}), errors.New("boom"), nil)
+	if len(built.Issue) != 3 || built.Issue[1].Severity != IssueSeverityWarning || built.Issue[2].Code != IssueTypeException ||
+		ToString(built.Issue[2].Diagnostics) != "boom" {
+		t.Errorf("unexpected outcome: %s", built)
+	}
+}
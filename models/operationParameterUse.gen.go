@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.11254 +0000 UTC
+// 2026-10-19 02:55:18.869715629 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // OperationParameterUse is documented here http://hl7.org/fhir/ValueSet/operation-parameter-use
-type OperationParameterUse int
+// The empty OperationParameterUse is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type OperationParameterUse string
 
 const (
-	OperationParameterUseIn OperationParameterUse = iota
-	OperationParameterUseOut
+	OperationParameterUseIn  OperationParameterUse = "in"
+	OperationParameterUseOut OperationParameterUse = "out"
 )
 
 func (code OperationParameterUse) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "OperationParameterUse"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *OperationParameterUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "in":
-		*code = OperationParameterUseIn
-	case "out":
-		*code = OperationParameterUseOut
-	default:
-		return fmt.Errorf("unknown OperationParameterUse code `%s`", s)
+func (code *OperationParameterUse) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = OperationParameterUse(s)
 	return nil
 }
 func (code OperationParameterUse) String() string {
 	return code.Code()
 }
 func (code OperationParameterUse) Code() string {
+	return string(code)
+}
+func (code OperationParameterUse) Known() bool {
 	switch code {
-	case OperationParameterUseIn:
-		return "in"
-	case OperationParameterUseOut:
-		return "out"
+	case OperationParameterUseIn, OperationParameterUseOut:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code OperationParameterUse) Display() string {
 	switch code {
@@ -22,44 +22,79 @@ import "encoding/json"
 
 // Organization is documented here http://hl7.org/fhir/StructureDefinition/Organization
 type Organization struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                 `bson:"active,omitempty" json:"active,omitempty"`
-	Type              []CodeableConcept     `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Alias             []string              `bson:"alias,omitempty" json:"alias,omitempty"`
-	Telecom           []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address           []Address             `bson:"address,omitempty" json:"address,omitempty"`
-	PartOf            *Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Contact           []OrganizationContact `bson:"contact,omitempty" json:"contact,omitempty"`
-	Endpoint          []Reference           `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Type                 []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Alias                []*string                  `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement         []*Element                 `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Telecom              []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address              []Address                  `bson:"address,omitempty" json:"address,omitempty"`
+	PartOf               *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Contact              []OrganizationContact      `bson:"contact,omitempty" json:"contact,omitempty"`
+	Endpoint             []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type OrganizationContact struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Purpose           *CodeableConcept `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Name              *HumanName       `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint   `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address           *Address         `bson:"address,omitempty" json:"address,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Purpose           *CodeableConcept           `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	Name              *HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom           []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address           *Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the OrganizationContact with its unknown fields.
+func (r OrganizationContact) MarshalJSON() ([]byte, error) {
+	type other OrganizationContact
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Organization and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Organization) UnmarshalJSON(b []byte) error {
+	type other Organization
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Organization.alias", len(r.Alias), len(r.AliasElement)); err != nil {
+		return err
+	}
+	return checkCodes("Organization", r)
+}
+
 type OtherOrganization Organization
 
 // MarshalJSON marshals the given Organization as JSON into a byte slice
 func (r Organization) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherOrganization
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherOrganization: OtherOrganization(r),
 		ResourceType:      "Organization",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalOrganization unmarshals a Organization.
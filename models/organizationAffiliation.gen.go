@@ -22,37 +22,56 @@ import "encoding/json"
 
 // OrganizationAffiliation is documented here http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation
 type OrganizationAffiliation struct {
-	ID                        *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                      *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules             *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                  *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                      *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                 []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier                []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active                    *bool             `bson:"active,omitempty" json:"active,omitempty"`
-	Period                    *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	Organization              *Reference        `bson:"organization,omitempty" json:"organization,omitempty"`
-	ParticipatingOrganization *Reference        `bson:"participatingOrganization,omitempty" json:"participatingOrganization,omitempty"`
-	Network                   []Reference       `bson:"network,omitempty" json:"network,omitempty"`
-	Code                      []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Specialty                 []CodeableConcept `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Location                  []Reference       `bson:"location,omitempty" json:"location,omitempty"`
-	HealthcareService         []Reference       `bson:"healthcareService,omitempty" json:"healthcareService,omitempty"`
-	Telecom                   []ContactPoint    `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Endpoint                  []Reference       `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                    *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement             *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Period                    *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Organization              *Reference                 `bson:"organization,omitempty" json:"organization,omitempty"`
+	ParticipatingOrganization *Reference                 `bson:"participatingOrganization,omitempty" json:"participatingOrganization,omitempty"`
+	Network                   []Reference                `bson:"network,omitempty" json:"network,omitempty"`
+	Code                      []CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	Specialty                 []CodeableConcept          `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Location                  []Reference                `bson:"location,omitempty" json:"location,omitempty"`
+	HealthcareService         []Reference                `bson:"healthcareService,omitempty" json:"healthcareService,omitempty"`
+	Telecom                   []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Endpoint                  []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the OrganizationAffiliation and fails with CodeError when a coded element has an unknown code.
+func (r *OrganizationAffiliation) UnmarshalJSON(b []byte) error {
+	type other OrganizationAffiliation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("OrganizationAffiliation", r)
+}
+
 type OtherOrganizationAffiliation OrganizationAffiliation
 
 // MarshalJSON marshals the given OrganizationAffiliation as JSON into a byte slice
 func (r OrganizationAffiliation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherOrganizationAffiliation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherOrganizationAffiliation: OtherOrganizationAffiliation(r),
 		ResourceType:                 "OrganizationAffiliation",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalOrganizationAffiliation unmarshals a OrganizationAffiliation.
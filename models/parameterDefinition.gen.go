@@ -20,13 +20,20 @@ package models
 
 // ParameterDefinition is documented here http://hl7.org/fhir/StructureDefinition/ParameterDefinition
 type ParameterDefinition struct {
-	ID            *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	Name          *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Use           OperationParameterUse `bson:"use" json:"use"`
-	Min           *int                  `bson:"min,omitempty" json:"min,omitempty"`
-	Max           *string               `bson:"max,omitempty" json:"max,omitempty"`
-	Documentation *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Type          string                `bson:"type" json:"type"`
-	Profile       *string               `bson:"profile,omitempty" json:"profile,omitempty"`
+	ID                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	Name                 *string               `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
+	Use                  OperationParameterUse `bson:"use" json:"use"`
+	UseElement           *Element              `bson:"_use,omitempty" json:"_use,omitempty"`
+	Min                  *int                  `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement           *Element              `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                  *string               `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement           *Element              `bson:"_max,omitempty" json:"_max,omitempty"`
+	Documentation        *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element              `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Type                 FHIRAllTypes          `bson:"type" json:"type"`
+	TypeElement          *Element              `bson:"_type,omitempty" json:"_type,omitempty"`
+	Profile              *string               `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement       *Element              `bson:"_profile,omitempty" json:"_profile,omitempty"`
 }
@@ -22,31 +22,671 @@ import "encoding/json"
 
 // Parameters is documented here http://hl7.org/fhir/StructureDefinition/Parameters
 type Parameters struct {
-	ID            *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta          *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language      *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Parameter     []ParametersParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Parameter            []ParametersParameter      `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ParametersParameter struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                `bson:"name" json:"name"`
-	Resource          json.RawMessage       `bson:"resource,omitempty" json:"resource,omitempty"`
-	Part              []ParametersParameter `bson:"part,omitempty" json:"part,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                     string                     `bson:"name" json:"name"`
+	NameElement              *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	ValueBase64Binary        *string                    `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element                   `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string                    `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element                   `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string                    `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element                   `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *DateTime                  `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element                   `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string                    `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element                   `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *Instant                   `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element                   `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                    `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element                   `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                    `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element                   `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                       `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element                   `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *Time                      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element                   `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                       `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element                   `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                    `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element                   `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string                    `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element                   `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string                    `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element                   `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address                   `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                       `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation                `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint              `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                     `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance                  `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration                  `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName                 `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier                `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money                     `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period                    `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range                     `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                     `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData               `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature                 `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                    `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail             `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor               `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement           `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression                `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition       `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact           `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition         `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext              `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage                    `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                      `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+	Resource                 json.RawMessage            `bson:"resource,omitempty" json:"resource,omitempty"`
+	Part                     []ParametersParameter      `bson:"part,omitempty" json:"part,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Value returns Parameters.parameter.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *ParametersParameter) Value() interface{} {
+	switch {
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueCanonical != nil:
+		return r.ValueCanonical
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueId != nil:
+		return r.ValueId
+	case r.ValueInstant != nil:
+		return r.ValueInstant
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueMarkdown != nil:
+		return r.ValueMarkdown
+	case r.ValueOid != nil:
+		return r.ValueOid
+	case r.ValuePositiveInt != nil:
+		return r.ValuePositiveInt
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueUnsignedInt != nil:
+		return r.ValueUnsignedInt
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueUrl != nil:
+		return r.ValueUrl
+	case r.ValueUuid != nil:
+		return r.ValueUuid
+	case r.ValueAddress != nil:
+		return r.ValueAddress
+	case r.ValueAge != nil:
+		return r.ValueAge
+	case r.ValueAnnotation != nil:
+		return r.ValueAnnotation
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueContactPoint != nil:
+		return r.ValueContactPoint
+	case r.ValueCount != nil:
+		return r.ValueCount
+	case r.ValueDistance != nil:
+		return r.ValueDistance
+	case r.ValueDuration != nil:
+		return r.ValueDuration
+	case r.ValueHumanName != nil:
+		return r.ValueHumanName
+	case r.ValueIdentifier != nil:
+		return r.ValueIdentifier
+	case r.ValueMoney != nil:
+		return r.ValueMoney
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueReference != nil:
+		return r.ValueReference
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueSignature != nil:
+		return r.ValueSignature
+	case r.ValueTiming != nil:
+		return r.ValueTiming
+	case r.ValueContactDetail != nil:
+		return r.ValueContactDetail
+	case r.ValueContributor != nil:
+		return r.ValueContributor
+	case r.ValueDataRequirement != nil:
+		return r.ValueDataRequirement
+	case r.ValueExpression != nil:
+		return r.ValueExpression
+	case r.ValueParameterDefinition != nil:
+		return r.ValueParameterDefinition
+	case r.ValueRelatedArtifact != nil:
+		return r.ValueRelatedArtifact
+	case r.ValueTriggerDefinition != nil:
+		return r.ValueTriggerDefinition
+	case r.ValueUsageContext != nil:
+		return r.ValueUsageContext
+	case r.ValueDosage != nil:
+		return r.ValueDosage
+	case r.ValueMeta != nil:
+		return r.ValueMeta
+	}
+	return nil
+}
+
+// SetValueBase64Binary sets Parameters.parameter.value[x] to the base64Binary and clears the other types.
+func (r *ParametersParameter) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+
+// SetValueBoolean sets Parameters.parameter.value[x] to the boolean and clears the other types.
+func (r *ParametersParameter) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueCanonical sets Parameters.parameter.value[x] to the canonical and clears the other types.
+func (r *ParametersParameter) SetValueCanonical(v string) {
+	r.clearValue()
+	r.ValueCanonical = &v
+}
+
+// SetValueCode sets Parameters.parameter.value[x] to the code and clears the other types.
+func (r *ParametersParameter) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
+}
+
+// SetValueDate sets Parameters.parameter.value[x] to the date and clears the other types.
+func (r *ParametersParameter) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets Parameters.parameter.value[x] to the dateTime and clears the other types.
+func (r *ParametersParameter) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueDecimal sets Parameters.parameter.value[x] to the decimal and clears the other types.
+func (r *ParametersParameter) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueId sets Parameters.parameter.value[x] to the id and clears the other types.
+func (r *ParametersParameter) SetValueId(v string) {
+	r.clearValue()
+	r.ValueId = &v
+}
+
+// SetValueInstant sets Parameters.parameter.value[x] to the instant and clears the other types.
+func (r *ParametersParameter) SetValueInstant(v Instant) {
+	r.clearValue()
+	r.ValueInstant = &v
+}
+
+// SetValueInteger sets Parameters.parameter.value[x] to the integer and clears the other types.
+func (r *ParametersParameter) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueMarkdown sets Parameters.parameter.value[x] to the markdown and clears the other types.
+func (r *ParametersParameter) SetValueMarkdown(v string) {
+	r.clearValue()
+	r.ValueMarkdown = &v
+}
+
+// SetValueOid sets Parameters.parameter.value[x] to the oid and clears the other types.
+func (r *ParametersParameter) SetValueOid(v string) {
+	r.clearValue()
+	r.ValueOid = &v
+}
+
+// SetValuePositiveInt sets Parameters.parameter.value[x] to the positiveInt and clears the other types.
+func (r *ParametersParameter) SetValuePositiveInt(v int) {
+	r.clearValue()
+	r.ValuePositiveInt = &v
+}
+
+// SetValueString sets Parameters.parameter.value[x] to the string and clears the other types.
+func (r *ParametersParameter) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueTime sets Parameters.parameter.value[x] to the time and clears the other types.
+func (r *ParametersParameter) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueUnsignedInt sets Parameters.parameter.value[x] to the unsignedInt and clears the other types.
+func (r *ParametersParameter) SetValueUnsignedInt(v int) {
+	r.clearValue()
+	r.ValueUnsignedInt = &v
+}
+
+// SetValueUri sets Parameters.parameter.value[x] to the uri and clears the other types.
+func (r *ParametersParameter) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueUrl sets Parameters.parameter.value[x] to the url and clears the other types.
+func (r *ParametersParameter) SetValueUrl(v string) {
+	r.clearValue()
+	r.ValueUrl = &v
+}
+
+// SetValueUuid sets Parameters.parameter.value[x] to the uuid and clears the other types.
+func (r *ParametersParameter) SetValueUuid(v string) {
+	r.clearValue()
+	r.ValueUuid = &v
+}
+
+// SetValueAddress sets Parameters.parameter.value[x] to the Address and clears the other types.
+func (r *ParametersParameter) SetValueAddress(v Address) {
+	r.clearValue()
+	r.ValueAddress = &v
+}
+
+// SetValueAge sets Parameters.parameter.value[x] to the Age and clears the other types.
+func (r *ParametersParameter) SetValueAge(v Age) {
+	r.clearValue()
+	r.ValueAge = &v
+}
+
+// SetValueAnnotation sets Parameters.parameter.value[x] to the Annotation and clears the other types.
+func (r *ParametersParameter) SetValueAnnotation(v Annotation) {
+	r.clearValue()
+	r.ValueAnnotation = &v
+}
+
+// SetValueAttachment sets Parameters.parameter.value[x] to the Attachment and clears the other types.
+func (r *ParametersParameter) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCodeableConcept sets Parameters.parameter.value[x] to the CodeableConcept and clears the other types.
+func (r *ParametersParameter) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueCoding sets Parameters.parameter.value[x] to the Coding and clears the other types.
+func (r *ParametersParameter) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueContactPoint sets Parameters.parameter.value[x] to the ContactPoint and clears the other types.
+func (r *ParametersParameter) SetValueContactPoint(v ContactPoint) {
+	r.clearValue()
+	r.ValueContactPoint = &v
+}
+
+// SetValueCount sets Parameters.parameter.value[x] to the Count and clears the other types.
+func (r *ParametersParameter) SetValueCount(v Count) {
+	r.clearValue()
+	r.ValueCount = &v
+}
+
+// SetValueDistance sets Parameters.parameter.value[x] to the Distance and clears the other types.
+func (r *ParametersParameter) SetValueDistance(v Distance) {
+	r.clearValue()
+	r.ValueDistance = &v
+}
+
+// SetValueDuration sets Parameters.parameter.value[x] to the Duration and clears the other types.
+func (r *ParametersParameter) SetValueDuration(v Duration) {
+	r.clearValue()
+	r.ValueDuration = &v
+}
+
+// SetValueHumanName sets Parameters.parameter.value[x] to the HumanName and clears the other types.
+func (r *ParametersParameter) SetValueHumanName(v HumanName) {
+	r.clearValue()
+	r.ValueHumanName = &v
+}
+
+// SetValueIdentifier sets Parameters.parameter.value[x] to the Identifier and clears the other types.
+func (r *ParametersParameter) SetValueIdentifier(v Identifier) {
+	r.clearValue()
+	r.ValueIdentifier = &v
+}
+
+// SetValueMoney sets Parameters.parameter.value[x] to the Money and clears the other types.
+func (r *ParametersParameter) SetValueMoney(v Money) {
+	r.clearValue()
+	r.ValueMoney = &v
+}
+
+// SetValuePeriod sets Parameters.parameter.value[x] to the Period and clears the other types.
+func (r *ParametersParameter) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+
+// SetValueQuantity sets Parameters.parameter.value[x] to the Quantity and clears the other types.
+func (r *ParametersParameter) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets Parameters.parameter.value[x] to the Range and clears the other types.
+func (r *ParametersParameter) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueRatio sets Parameters.parameter.value[x] to the Ratio and clears the other types.
+func (r *ParametersParameter) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
+
+// SetValueReference sets Parameters.parameter.value[x] to the Reference and clears the other types.
+func (r *ParametersParameter) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+
+// SetValueSampledData sets Parameters.parameter.value[x] to the SampledData and clears the other types.
+func (r *ParametersParameter) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
+
+// SetValueSignature sets Parameters.parameter.value[x] to the Signature and clears the other types.
+func (r *ParametersParameter) SetValueSignature(v Signature) {
+	r.clearValue()
+	r.ValueSignature = &v
+}
+
+// SetValueTiming sets Parameters.parameter.value[x] to the Timing and clears the other types.
+func (r *ParametersParameter) SetValueTiming(v Timing) {
+	r.clearValue()
+	r.ValueTiming = &v
+}
+
+// SetValueContactDetail sets Parameters.parameter.value[x] to the ContactDetail and clears the other types.
+func (r *ParametersParameter) SetValueContactDetail(v ContactDetail) {
+	r.clearValue()
+	r.ValueContactDetail = &v
+}
+
+// SetValueContributor sets Parameters.parameter.value[x] to the Contributor and clears the other types.
+func (r *ParametersParameter) SetValueContributor(v Contributor) {
+	r.clearValue()
+	r.ValueContributor = &v
+}
+
+// SetValueDataRequirement sets Parameters.parameter.value[x] to the DataRequirement and clears the other types.
+func (r *ParametersParameter) SetValueDataRequirement(v DataRequirement) {
+	r.clearValue()
+	r.ValueDataRequirement = &v
+}
+
+// SetValueExpression sets Parameters.parameter.value[x] to the Expression and clears the other types.
+func (r *ParametersParameter) SetValueExpression(v Expression) {
+	r.clearValue()
+	r.ValueExpression = &v
+}
+
+// SetValueParameterDefinition sets Parameters.parameter.value[x] to the ParameterDefinition and clears the other types.
+func (r *ParametersParameter) SetValueParameterDefinition(v ParameterDefinition) {
+	r.clearValue()
+	r.ValueParameterDefinition = &v
+}
+
+// SetValueRelatedArtifact sets Parameters.parameter.value[x] to the RelatedArtifact and clears the other types.
+func (r *ParametersParameter) SetValueRelatedArtifact(v RelatedArtifact) {
+	r.clearValue()
+	r.ValueRelatedArtifact = &v
+}
+
+// SetValueTriggerDefinition sets Parameters.parameter.value[x] to the TriggerDefinition and clears the other types.
+func (r *ParametersParameter) SetValueTriggerDefinition(v TriggerDefinition) {
+	r.clearValue()
+	r.ValueTriggerDefinition = &v
+}
+
+// SetValueUsageContext sets Parameters.parameter.value[x] to the UsageContext and clears the other types.
+func (r *ParametersParameter) SetValueUsageContext(v UsageContext) {
+	r.clearValue()
+	r.ValueUsageContext = &v
+}
+
+// SetValueDosage sets Parameters.parameter.value[x] to the Dosage and clears the other types.
+func (r *ParametersParameter) SetValueDosage(v Dosage) {
+	r.clearValue()
+	r.ValueDosage = &v
+}
+
+// SetValueMeta sets Parameters.parameter.value[x] to the Meta and clears the other types.
+func (r *ParametersParameter) SetValueMeta(v Meta) {
+	r.clearValue()
+	r.ValueMeta = &v
+}
+func (r *ParametersParameter) clearValue() {
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueCanonical = nil
+	r.ValueCanonicalElement = nil
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueId = nil
+	r.ValueIdElement = nil
+	r.ValueInstant = nil
+	r.ValueInstantElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueMarkdown = nil
+	r.ValueMarkdownElement = nil
+	r.ValueOid = nil
+	r.ValueOidElement = nil
+	r.ValuePositiveInt = nil
+	r.ValuePositiveIntElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUnsignedIntElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueUrl = nil
+	r.ValueUrlElement = nil
+	r.ValueUuid = nil
+	r.ValueUuidElement = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+}
+
+// UnmarshalJSON unmarshals the ParametersParameter and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ParametersParameter) UnmarshalJSON(b []byte) error {
+	type other ParametersParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Parameters.parameter.value[x]",
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueCanonical != nil || r.ValueCanonicalElement != nil,
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueId != nil || r.ValueIdElement != nil,
+		r.ValueInstant != nil || r.ValueInstantElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueMarkdown != nil || r.ValueMarkdownElement != nil,
+		r.ValueOid != nil || r.ValueOidElement != nil,
+		r.ValuePositiveInt != nil || r.ValuePositiveIntElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueUnsignedInt != nil || r.ValueUnsignedIntElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueUrl != nil || r.ValueUrlElement != nil,
+		r.ValueUuid != nil || r.ValueUuidElement != nil,
+		r.ValueAddress != nil,
+		r.ValueAge != nil,
+		r.ValueAnnotation != nil,
+		r.ValueAttachment != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueCoding != nil,
+		r.ValueContactPoint != nil,
+		r.ValueCount != nil,
+		r.ValueDistance != nil,
+		r.ValueDuration != nil,
+		r.ValueHumanName != nil,
+		r.ValueIdentifier != nil,
+		r.ValueMoney != nil,
+		r.ValuePeriod != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueReference != nil,
+		r.ValueSampledData != nil,
+		r.ValueSignature != nil,
+		r.ValueTiming != nil,
+		r.ValueContactDetail != nil,
+		r.ValueContributor != nil,
+		r.ValueDataRequirement != nil,
+		r.ValueExpression != nil,
+		r.ValueParameterDefinition != nil,
+		r.ValueRelatedArtifact != nil,
+		r.ValueTriggerDefinition != nil,
+		r.ValueUsageContext != nil,
+		r.ValueDosage != nil,
+		r.ValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ParametersParameter with its unknown fields.
+func (r ParametersParameter) MarshalJSON() ([]byte, error) {
+	type other ParametersParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Parameters and fails with CodeError when a coded element has an unknown code.
+func (r *Parameters) UnmarshalJSON(b []byte) error {
+	type other Parameters
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Parameters", r)
+}
+
 type OtherParameters Parameters
 
 // MarshalJSON marshals the given Parameters as JSON into a byte slice
 func (r Parameters) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherParameters
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherParameters: OtherParameters(r),
 		ResourceType:    "Parameters",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalParameters unmarshals a Parameters.
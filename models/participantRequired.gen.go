@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.252652 +0000 UTC
+// 2026-10-19 02:55:18.870726837 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ParticipantRequired is documented here http://hl7.org/fhir/ValueSet/participantrequired
-type ParticipantRequired int
+// The empty ParticipantRequired is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ParticipantRequired string
 
 const (
-	ParticipantRequiredRequired ParticipantRequired = iota
-	ParticipantRequiredOptional
-	ParticipantRequiredInformationOnly
+	ParticipantRequiredRequired        ParticipantRequired = "required"
+	ParticipantRequiredOptional        ParticipantRequired = "optional"
+	ParticipantRequiredInformationOnly ParticipantRequired = "information-only"
 )
 
 func (code ParticipantRequired) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ParticipantRequired"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ParticipantRequired) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "required":
-		*code = ParticipantRequiredRequired
-	case "optional":
-		*code = ParticipantRequiredOptional
-	case "information-only":
-		*code = ParticipantRequiredInformationOnly
-	default:
-		return fmt.Errorf("unknown ParticipantRequired code `%s`", s)
+func (code *ParticipantRequired) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ParticipantRequired(s)
 	return nil
 }
 func (code ParticipantRequired) String() string {
 	return code.Code()
 }
 func (code ParticipantRequired) Code() string {
+	return string(code)
+}
+func (code ParticipantRequired) Known() bool {
 	switch code {
-	case ParticipantRequiredRequired:
-		return "required"
-	case ParticipantRequiredOptional:
-		return "optional"
-	case ParticipantRequiredInformationOnly:
-		return "information-only"
+	case ParticipantRequiredRequired, ParticipantRequiredOptional, ParticipantRequiredInformationOnly:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ParticipantRequired) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.201855 +0000 UTC
+// 2026-10-19 02:55:18.8718155 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ParticipationStatus is documented here http://hl7.org/fhir/ValueSet/participationstatus
-type ParticipationStatus int
+// The empty ParticipationStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ParticipationStatus string
 
 const (
-	ParticipationStatusAccepted ParticipationStatus = iota
-	ParticipationStatusDeclined
-	ParticipationStatusTentative
-	ParticipationStatusNeedsAction
+	ParticipationStatusAccepted    ParticipationStatus = "accepted"
+	ParticipationStatusDeclined    ParticipationStatus = "declined"
+	ParticipationStatusTentative   ParticipationStatus = "tentative"
+	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
 func (code ParticipationStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ParticipationStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ParticipationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "accepted":
-		*code = ParticipationStatusAccepted
-	case "declined":
-		*code = ParticipationStatusDeclined
-	case "tentative":
-		*code = ParticipationStatusTentative
-	case "needs-action":
-		*code = ParticipationStatusNeedsAction
-	default:
-		return fmt.Errorf("unknown ParticipationStatus code `%s`", s)
+func (code *ParticipationStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ParticipationStatus(s)
 	return nil
 }
 func (code ParticipationStatus) String() string {
 	return code.Code()
 }
 func (code ParticipationStatus) Code() string {
+	return string(code)
+}
+func (code ParticipationStatus) Known() bool {
 	switch code {
-	case ParticipationStatusAccepted:
-		return "accepted"
-	case ParticipationStatusDeclined:
-		return "declined"
-	case ParticipationStatusTentative:
-		return "tentative"
-	case ParticipationStatusNeedsAction:
-		return "needs-action"
+	case ParticipationStatusAccepted, ParticipationStatusDeclined, ParticipationStatusTentative, ParticipationStatusNeedsAction:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ParticipationStatus) Display() string {
 	switch code {
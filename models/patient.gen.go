@@ -22,65 +22,204 @@ import "encoding/json"
 
 // Patient is documented here http://hl7.org/fhir/StructureDefinition/Patient
 type Patient struct {
-	ID                   *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active               *bool                  `bson:"active,omitempty" json:"active,omitempty"`
-	Name                 []HumanName            `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom              []ContactPoint         `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Gender               *AdministrativeGender  `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate            *DateTime              `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Address              []Address              `bson:"address,omitempty" json:"address,omitempty"`
-	MaritalStatus        *CodeableConcept       `bson:"maritalStatus,omitempty" json:"maritalStatus,omitempty"`
-	Photo                []Attachment           `bson:"photo,omitempty" json:"photo,omitempty"`
-	Contact              []PatientContact       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Communication        []PatientCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
-	GeneralPractitioner  []Reference            `bson:"generalPractitioner,omitempty" json:"generalPractitioner,omitempty"`
-	ManagingOrganization *Reference             `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	Link                 []PatientLink          `bson:"link,omitempty" json:"link,omitempty"`
+	ID                          *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                        *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules               *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement        *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                    *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement             *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                        *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                   ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                   []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                  []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                      *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement               *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Name                        []HumanName                `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom                     []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Gender                      *AdministrativeGender      `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement               *Element                   `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate                   *DateTime                  `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement            *Element                   `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	DeceasedBoolean             *bool                      `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement      *Element                   `bson:"_deceasedBoolean,omitempty" json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime            *DateTime                  `bson:"deceasedDateTime,omitempty" json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement     *Element                   `bson:"_deceasedDateTime,omitempty" json:"_deceasedDateTime,omitempty"`
+	Address                     []Address                  `bson:"address,omitempty" json:"address,omitempty"`
+	MaritalStatus               *CodeableConcept           `bson:"maritalStatus,omitempty" json:"maritalStatus,omitempty"`
+	MultipleBirthBoolean        *bool                      `bson:"multipleBirthBoolean,omitempty" json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *Element                   `bson:"_multipleBirthBoolean,omitempty" json:"_multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger        *int                       `bson:"multipleBirthInteger,omitempty" json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *Element                   `bson:"_multipleBirthInteger,omitempty" json:"_multipleBirthInteger,omitempty"`
+	Photo                       []Attachment               `bson:"photo,omitempty" json:"photo,omitempty"`
+	Contact                     []PatientContact           `bson:"contact,omitempty" json:"contact,omitempty"`
+	Communication               []PatientCommunication     `bson:"communication,omitempty" json:"communication,omitempty"`
+	GeneralPractitioner         []Reference                `bson:"generalPractitioner,omitempty" json:"generalPractitioner,omitempty"`
+	ManagingOrganization        *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	Link                        []PatientLink              `bson:"link,omitempty" json:"link,omitempty"`
+	UnknownFields               map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type PatientContact struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Relationship      []CodeableConcept     `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Name              *HumanName            `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address           *Address              `bson:"address,omitempty" json:"address,omitempty"`
-	Gender            *AdministrativeGender `bson:"gender,omitempty" json:"gender,omitempty"`
-	Organization      *Reference            `bson:"organization,omitempty" json:"organization,omitempty"`
-	Period            *Period               `bson:"period,omitempty" json:"period,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Relationship      []CodeableConcept          `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Name              *HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom           []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address           *Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	Gender            *AdministrativeGender      `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement     *Element                   `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	Organization      *Reference                 `bson:"organization,omitempty" json:"organization,omitempty"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PatientContact with its unknown fields.
+func (r PatientContact) MarshalJSON() ([]byte, error) {
+	type other PatientContact
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PatientCommunication struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Language          CodeableConcept `bson:"language" json:"language"`
-	Preferred         *bool           `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Language          CodeableConcept            `bson:"language" json:"language"`
+	Preferred         *bool                      `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element                   `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PatientCommunication with its unknown fields.
+func (r PatientCommunication) MarshalJSON() ([]byte, error) {
+	type other PatientCommunication
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PatientLink struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Other             Reference   `bson:"other" json:"other"`
-	Type              LinkType    `bson:"type" json:"type"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Other             Reference                  `bson:"other" json:"other"`
+	Type              LinkType                   `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the PatientLink with its unknown fields.
+func (r PatientLink) MarshalJSON() ([]byte, error) {
+	type other PatientLink
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Deceased returns Patient.deceased[x] of the set type, such as *bool, or nil when it is not set.
+func (r *Patient) Deceased() interface{} {
+	switch {
+	case r.DeceasedBoolean != nil:
+		return r.DeceasedBoolean
+	case r.DeceasedDateTime != nil:
+		return r.DeceasedDateTime
+	}
+	return nil
+}
+
+// SetDeceasedBoolean sets Patient.deceased[x] to the boolean and clears the other types.
+func (r *Patient) SetDeceasedBoolean(v bool) {
+	r.clearDeceased()
+	r.DeceasedBoolean = &v
+}
+
+// SetDeceasedDateTime sets Patient.deceased[x] to the dateTime and clears the other types.
+func (r *Patient) SetDeceasedDateTime(v DateTime) {
+	r.clearDeceased()
+	r.DeceasedDateTime = &v
+}
+func (r *Patient) clearDeceased() {
+	r.DeceasedBoolean = nil
+	r.DeceasedBooleanElement = nil
+	r.DeceasedDateTime = nil
+	r.DeceasedDateTimeElement = nil
+}
+
+// MultipleBirth returns Patient.multipleBirth[x] of the set type, such as *bool, or nil when it is not set.
+func (r *Patient) MultipleBirth() interface{} {
+	switch {
+	case r.MultipleBirthBoolean != nil:
+		return r.MultipleBirthBoolean
+	case r.MultipleBirthInteger != nil:
+		return r.MultipleBirthInteger
+	}
+	return nil
+}
+
+// SetMultipleBirthBoolean sets Patient.multipleBirth[x] to the boolean and clears the other types.
+func (r *Patient) SetMultipleBirthBoolean(v bool) {
+	r.clearMultipleBirth()
+	r.MultipleBirthBoolean = &v
+}
+
+// SetMultipleBirthInteger sets Patient.multipleBirth[x] to the integer and clears the other types.
+func (r *Patient) SetMultipleBirthInteger(v int) {
+	r.clearMultipleBirth()
+	r.MultipleBirthInteger = &v
 }
+func (r *Patient) clearMultipleBirth() {
+	r.MultipleBirthBoolean = nil
+	r.MultipleBirthBooleanElement = nil
+	r.MultipleBirthInteger = nil
+	r.MultipleBirthIntegerElement = nil
+}
+
+// UnmarshalJSON unmarshals the Patient and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *Patient) UnmarshalJSON(b []byte) error {
+	type other Patient
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Patient.deceased[x]",
+		r.DeceasedBoolean != nil || r.DeceasedBooleanElement != nil,
+		r.DeceasedDateTime != nil || r.DeceasedDateTimeElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Patient.multipleBirth[x]",
+		r.MultipleBirthBoolean != nil || r.MultipleBirthBooleanElement != nil,
+		r.MultipleBirthInteger != nil || r.MultipleBirthIntegerElement != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("Patient", r)
+}
+
 type OtherPatient Patient
 
 // MarshalJSON marshals the given Patient as JSON into a byte slice
 func (r Patient) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPatient
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPatient: OtherPatient(r),
 		ResourceType: "Patient",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPatient unmarshals a Patient.
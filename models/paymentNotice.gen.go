@@ -22,37 +22,58 @@ import "encoding/json"
 
 // PaymentNotice is documented here http://hl7.org/fhir/StructureDefinition/PaymentNotice
 type PaymentNotice struct {
-	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes `bson:"status" json:"status"`
-	Request           *Reference                   `bson:"request,omitempty" json:"request,omitempty"`
-	Response          *Reference                   `bson:"response,omitempty" json:"response,omitempty"`
-	Created           DateTime                     `bson:"created" json:"created"`
-	Provider          *Reference                   `bson:"provider,omitempty" json:"provider,omitempty"`
-	Payment           Reference                    `bson:"payment" json:"payment"`
-	PaymentDate       *DateTime                    `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
-	Payee             *Reference                   `bson:"payee,omitempty" json:"payee,omitempty"`
-	Recipient         Reference                    `bson:"recipient" json:"recipient"`
-	Amount            Money                        `bson:"amount" json:"amount"`
-	PaymentStatus     *CodeableConcept             `bson:"paymentStatus,omitempty" json:"paymentStatus,omitempty"`
+	ID                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	Request              *Reference                   `bson:"request,omitempty" json:"request,omitempty"`
+	Response             *Reference                   `bson:"response,omitempty" json:"response,omitempty"`
+	Created              DateTime                     `bson:"created" json:"created"`
+	CreatedElement       *Element                     `bson:"_created,omitempty" json:"_created,omitempty"`
+	Provider             *Reference                   `bson:"provider,omitempty" json:"provider,omitempty"`
+	Payment              Reference                    `bson:"payment" json:"payment"`
+	PaymentDate          *DateTime                    `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
+	PaymentDateElement   *Element                     `bson:"_paymentDate,omitempty" json:"_paymentDate,omitempty"`
+	Payee                *Reference                   `bson:"payee,omitempty" json:"payee,omitempty"`
+	Recipient            Reference                    `bson:"recipient" json:"recipient"`
+	Amount               Money                        `bson:"amount" json:"amount"`
+	PaymentStatus        *CodeableConcept             `bson:"paymentStatus,omitempty" json:"paymentStatus,omitempty"`
+	UnknownFields        map[string]json.RawMessage   `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the PaymentNotice and fails with CodeError when a coded element has an unknown code.
+func (r *PaymentNotice) UnmarshalJSON(b []byte) error {
+	type other PaymentNotice
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("PaymentNotice", r)
+}
+
 type OtherPaymentNotice PaymentNotice
 
 // MarshalJSON marshals the given PaymentNotice as JSON into a byte slice
 func (r PaymentNotice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPaymentNotice
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPaymentNotice: OtherPaymentNotice(r),
 		ResourceType:       "PaymentNotice",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPaymentNotice unmarshals a PaymentNotice.
@@ -22,62 +22,111 @@ import "encoding/json"
 
 // PaymentReconciliation is documented here http://hl7.org/fhir/StructureDefinition/PaymentReconciliation
 type PaymentReconciliation struct {
-	ID                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes       `bson:"status" json:"status"`
-	Period            *Period                            `bson:"period,omitempty" json:"period,omitempty"`
-	Created           DateTime                           `bson:"created" json:"created"`
-	PaymentIssuer     *Reference                         `bson:"paymentIssuer,omitempty" json:"paymentIssuer,omitempty"`
-	Request           *Reference                         `bson:"request,omitempty" json:"request,omitempty"`
-	Requestor         *Reference                         `bson:"requestor,omitempty" json:"requestor,omitempty"`
-	Outcome           *ClaimProcessingCodes              `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Disposition       *string                            `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	PaymentDate       DateTime                           `bson:"paymentDate" json:"paymentDate"`
-	PaymentAmount     Money                              `bson:"paymentAmount" json:"paymentAmount"`
-	PaymentIdentifier *Identifier                        `bson:"paymentIdentifier,omitempty" json:"paymentIdentifier,omitempty"`
-	Detail            []PaymentReconciliationDetail      `bson:"detail,omitempty" json:"detail,omitempty"`
-	FormCode          *CodeableConcept                   `bson:"formCode,omitempty" json:"formCode,omitempty"`
-	ProcessNote       []PaymentReconciliationProcessNote `bson:"processNote,omitempty" json:"processNote,omitempty"`
+	ID                   *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes       `bson:"status" json:"status"`
+	StatusElement        *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period               *Period                            `bson:"period,omitempty" json:"period,omitempty"`
+	Created              DateTime                           `bson:"created" json:"created"`
+	CreatedElement       *Element                           `bson:"_created,omitempty" json:"_created,omitempty"`
+	PaymentIssuer        *Reference                         `bson:"paymentIssuer,omitempty" json:"paymentIssuer,omitempty"`
+	Request              *Reference                         `bson:"request,omitempty" json:"request,omitempty"`
+	Requestor            *Reference                         `bson:"requestor,omitempty" json:"requestor,omitempty"`
+	Outcome              *ClaimProcessingCodes              `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	OutcomeElement       *Element                           `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	Disposition          *string                            `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                           `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	PaymentDate          DateTime                           `bson:"paymentDate" json:"paymentDate"`
+	PaymentDateElement   *Element                           `bson:"_paymentDate,omitempty" json:"_paymentDate,omitempty"`
+	PaymentAmount        Money                              `bson:"paymentAmount" json:"paymentAmount"`
+	PaymentIdentifier    *Identifier                        `bson:"paymentIdentifier,omitempty" json:"paymentIdentifier,omitempty"`
+	Detail               []PaymentReconciliationDetail      `bson:"detail,omitempty" json:"detail,omitempty"`
+	FormCode             *CodeableConcept                   `bson:"formCode,omitempty" json:"formCode,omitempty"`
+	ProcessNote          []PaymentReconciliationProcessNote `bson:"processNote,omitempty" json:"processNote,omitempty"`
+	UnknownFields        map[string]json.RawMessage         `bson:"-" json:"-"`
 }
 type PaymentReconciliationDetail struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Predecessor       *Identifier     `bson:"predecessor,omitempty" json:"predecessor,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
-	Request           *Reference      `bson:"request,omitempty" json:"request,omitempty"`
-	Submitter         *Reference      `bson:"submitter,omitempty" json:"submitter,omitempty"`
-	Response          *Reference      `bson:"response,omitempty" json:"response,omitempty"`
-	Date              *DateTime       `bson:"date,omitempty" json:"date,omitempty"`
-	Responsible       *Reference      `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Payee             *Reference      `bson:"payee,omitempty" json:"payee,omitempty"`
-	Amount            *Money          `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Predecessor       *Identifier                `bson:"predecessor,omitempty" json:"predecessor,omitempty"`
+	Type              CodeableConcept            `bson:"type" json:"type"`
+	Request           *Reference                 `bson:"request,omitempty" json:"request,omitempty"`
+	Submitter         *Reference                 `bson:"submitter,omitempty" json:"submitter,omitempty"`
+	Response          *Reference                 `bson:"response,omitempty" json:"response,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Responsible       *Reference                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Payee             *Reference                 `bson:"payee,omitempty" json:"payee,omitempty"`
+	Amount            *Money                     `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PaymentReconciliationDetail with its unknown fields.
+func (r PaymentReconciliationDetail) MarshalJSON() ([]byte, error) {
+	type other PaymentReconciliationDetail
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PaymentReconciliationProcessNote struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *NoteType   `bson:"type,omitempty" json:"type,omitempty"`
-	Text              *string     `bson:"text,omitempty" json:"text,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *NoteType                  `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Text              *string                    `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element                   `bson:"_text,omitempty" json:"_text,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the PaymentReconciliationProcessNote with its unknown fields.
+func (r PaymentReconciliationProcessNote) MarshalJSON() ([]byte, error) {
+	type other PaymentReconciliationProcessNote
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the PaymentReconciliation and fails with CodeError when a coded element has an unknown code.
+func (r *PaymentReconciliation) UnmarshalJSON(b []byte) error {
+	type other PaymentReconciliation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("PaymentReconciliation", r)
 }
+
 type OtherPaymentReconciliation PaymentReconciliation
 
 // MarshalJSON marshals the given PaymentReconciliation as JSON into a byte slice
 func (r PaymentReconciliation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPaymentReconciliation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPaymentReconciliation: OtherPaymentReconciliation(r),
 		ResourceType:               "PaymentReconciliation",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPaymentReconciliation unmarshals a PaymentReconciliation.
@@ -20,8 +20,10 @@ package models
 
 // Period is documented here http://hl7.org/fhir/StructureDefinition/Period
 type Period struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Start     *DateTime   `bson:"start,omitempty" json:"start,omitempty"`
-	End       *DateTime   `bson:"end,omitempty" json:"end,omitempty"`
+	ID           *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Start        *DateTime   `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement *Element    `bson:"_start,omitempty" json:"_start,omitempty"`
+	End          *DateTime   `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement   *Element    `bson:"_end,omitempty" json:"_end,omitempty"`
 }
@@ -22,42 +22,75 @@ import "encoding/json"
 
 // Person is documented here http://hl7.org/fhir/StructureDefinition/Person
 type Person struct {
-	ID                   *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name                 []HumanName           `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom              []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Gender               *AdministrativeGender `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate            *DateTime             `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Address              []Address             `bson:"address,omitempty" json:"address,omitempty"`
-	Photo                *Attachment           `bson:"photo,omitempty" json:"photo,omitempty"`
-	ManagingOrganization *Reference            `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	Active               *bool                 `bson:"active,omitempty" json:"active,omitempty"`
-	Link                 []PersonLink          `bson:"link,omitempty" json:"link,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                 []HumanName                `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom              []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Gender               *AdministrativeGender      `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement        *Element                   `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate            *DateTime                  `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement     *Element                   `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	Address              []Address                  `bson:"address,omitempty" json:"address,omitempty"`
+	Photo                *Attachment                `bson:"photo,omitempty" json:"photo,omitempty"`
+	ManagingOrganization *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	Active               *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	Link                 []PersonLink               `bson:"link,omitempty" json:"link,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type PersonLink struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            Reference               `bson:"target" json:"target"`
-	Assurance         *IdentityAssuranceLevel `bson:"assurance,omitempty" json:"assurance,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target            Reference                  `bson:"target" json:"target"`
+	Assurance         *IdentityAssuranceLevel    `bson:"assurance,omitempty" json:"assurance,omitempty"`
+	AssuranceElement  *Element                   `bson:"_assurance,omitempty" json:"_assurance,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PersonLink with its unknown fields.
+func (r PersonLink) MarshalJSON() ([]byte, error) {
+	type other PersonLink
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Person and fails with CodeError when a coded element has an unknown code.
+func (r *Person) UnmarshalJSON(b []byte) error {
+	type other Person
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Person", r)
+}
+
 type OtherPerson Person
 
 // MarshalJSON marshals the given Person as JSON into a byte slice
 func (r Person) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPerson
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPerson:  OtherPerson(r),
 		ResourceType: "Person",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPerson unmarshals a Person.
@@ -22,43 +22,65 @@ import "encoding/json"
 
 // PlanDefinition is documented here http://hl7.org/fhir/StructureDefinition/PlanDefinition
 type PlanDefinition struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                `bson:"title,omitempty" json:"title,omitempty"`
-	Subtitle          *string                `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Type              *CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
-	Status            PublicationStatus      `bson:"status" json:"status"`
-	Experimental      *bool                  `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime              `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail        `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext         `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Usage             *string                `bson:"usage,omitempty" json:"usage,omitempty"`
-	Copyright         *string                `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime              `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime              `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept      `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail        `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail        `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail        `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail        `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact      `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Library           []string               `bson:"library,omitempty" json:"library,omitempty"`
-	Goal              []PlanDefinitionGoal   `bson:"goal,omitempty" json:"goal,omitempty"`
-	Action            []PlanDefinitionAction `bson:"action,omitempty" json:"action,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                    *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement             *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                  *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Subtitle               *string                    `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element                   `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Type                   *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Status                 PublicationStatus          `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental           *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectCodeableConcept *CodeableConcept           `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference       *Reference                 `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Date                   *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher              *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description            *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext             []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction           []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Usage                  *string                    `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element                   `bson:"_usage,omitempty" json:"_usage,omitempty"`
+	Copyright              *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate           *DateTime                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate         *DateTime                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod        *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                  []CodeableConcept          `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                 []ContactDetail            `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                 []ContactDetail            `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer               []ContactDetail            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser               []ContactDetail            `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact        []RelatedArtifact          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Library                []*string                  `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement         []*Element                 `bson:"_library,omitempty" json:"_library,omitempty"`
+	Goal                   []PlanDefinitionGoal       `bson:"goal,omitempty" json:"goal,omitempty"`
+	Action                 []PlanDefinitionAction     `bson:"action,omitempty" json:"action,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type PlanDefinitionGoal struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -71,82 +93,502 @@ type PlanDefinitionGoal struct {
 	Addresses         []CodeableConcept          `bson:"addresses,omitempty" json:"addresses,omitempty"`
 	Documentation     []RelatedArtifact          `bson:"documentation,omitempty" json:"documentation,omitempty"`
 	Target            []PlanDefinitionGoalTarget `bson:"target,omitempty" json:"target,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type PlanDefinitionGoalTarget struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Measure           *CodeableConcept `bson:"measure,omitempty" json:"measure,omitempty"`
-	Due               *Duration        `bson:"due,omitempty" json:"due,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Measure               *CodeableConcept           `bson:"measure,omitempty" json:"measure,omitempty"`
+	DetailQuantity        *Quantity                  `bson:"detailQuantity,omitempty" json:"detailQuantity,omitempty"`
+	DetailRange           *Range                     `bson:"detailRange,omitempty" json:"detailRange,omitempty"`
+	DetailCodeableConcept *CodeableConcept           `bson:"detailCodeableConcept,omitempty" json:"detailCodeableConcept,omitempty"`
+	Due                   *Duration                  `bson:"due,omitempty" json:"due,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Detail returns PlanDefinition.goal.target.detail[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *PlanDefinitionGoalTarget) Detail() interface{} {
+	switch {
+	case r.DetailQuantity != nil:
+		return r.DetailQuantity
+	case r.DetailRange != nil:
+		return r.DetailRange
+	case r.DetailCodeableConcept != nil:
+		return r.DetailCodeableConcept
+	}
+	return nil
+}
+
+// SetDetailQuantity sets PlanDefinition.goal.target.detail[x] to the Quantity and clears the other types.
+func (r *PlanDefinitionGoalTarget) SetDetailQuantity(v Quantity) {
+	r.clearDetail()
+	r.DetailQuantity = &v
+}
+
+// SetDetailRange sets PlanDefinition.goal.target.detail[x] to the Range and clears the other types.
+func (r *PlanDefinitionGoalTarget) SetDetailRange(v Range) {
+	r.clearDetail()
+	r.DetailRange = &v
+}
+
+// SetDetailCodeableConcept sets PlanDefinition.goal.target.detail[x] to the CodeableConcept and clears the other types.
+func (r *PlanDefinitionGoalTarget) SetDetailCodeableConcept(v CodeableConcept) {
+	r.clearDetail()
+	r.DetailCodeableConcept = &v
+}
+func (r *PlanDefinitionGoalTarget) clearDetail() {
+	r.DetailQuantity = nil
+	r.DetailRange = nil
+	r.DetailCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the PlanDefinitionGoalTarget and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *PlanDefinitionGoalTarget) UnmarshalJSON(b []byte) error {
+	type other PlanDefinitionGoalTarget
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"PlanDefinition.goal.target.detail[x]",
+		r.DetailQuantity != nil,
+		r.DetailRange != nil,
+		r.DetailCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the PlanDefinitionGoalTarget with its unknown fields.
+func (r PlanDefinitionGoalTarget) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionGoalTarget
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the PlanDefinitionGoal with its unknown fields.
+func (r PlanDefinitionGoal) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionGoal
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PlanDefinitionAction struct {
-	ID                  *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Prefix              *string                             `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Title               *string                             `bson:"title,omitempty" json:"title,omitempty"`
-	Description         *string                             `bson:"description,omitempty" json:"description,omitempty"`
-	TextEquivalent      *string                             `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
-	Priority            *RequestPriority                    `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                []CodeableConcept                   `bson:"code,omitempty" json:"code,omitempty"`
-	Reason              []CodeableConcept                   `bson:"reason,omitempty" json:"reason,omitempty"`
-	Documentation       []RelatedArtifact                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	GoalId              []string                            `bson:"goalId,omitempty" json:"goalId,omitempty"`
-	Trigger             []TriggerDefinition                 `bson:"trigger,omitempty" json:"trigger,omitempty"`
-	Condition           []PlanDefinitionActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
-	Input               []DataRequirement                   `bson:"input,omitempty" json:"input,omitempty"`
-	Output              []DataRequirement                   `bson:"output,omitempty" json:"output,omitempty"`
-	RelatedAction       []PlanDefinitionActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
-	Participant         []PlanDefinitionActionParticipant   `bson:"participant,omitempty" json:"participant,omitempty"`
-	Type                *CodeableConcept                    `bson:"type,omitempty" json:"type,omitempty"`
-	GroupingBehavior    *ActionGroupingBehavior             `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
-	SelectionBehavior   *ActionSelectionBehavior            `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
-	RequiredBehavior    *ActionRequiredBehavior             `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
-	PrecheckBehavior    *ActionPrecheckBehavior             `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
-	CardinalityBehavior *ActionCardinalityBehavior          `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
-	Transform           *string                             `bson:"transform,omitempty" json:"transform,omitempty"`
-	DynamicValue        []PlanDefinitionActionDynamicValue  `bson:"dynamicValue,omitempty" json:"dynamicValue,omitempty"`
-	Action              []PlanDefinitionAction              `bson:"action,omitempty" json:"action,omitempty"`
+	ID                         *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Prefix                     *string                             `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement              *Element                            `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Title                      *string                             `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement               *Element                            `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description                *string                             `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                            `bson:"_description,omitempty" json:"_description,omitempty"`
+	TextEquivalent             *string                             `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
+	TextEquivalentElement      *Element                            `bson:"_textEquivalent,omitempty" json:"_textEquivalent,omitempty"`
+	Priority                   *RequestPriority                    `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement            *Element                            `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                       []CodeableConcept                   `bson:"code,omitempty" json:"code,omitempty"`
+	Reason                     []CodeableConcept                   `bson:"reason,omitempty" json:"reason,omitempty"`
+	Documentation              []RelatedArtifact                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	GoalId                     []*string                           `bson:"goalId,omitempty" json:"goalId,omitempty"`
+	GoalIdElement              []*Element                          `bson:"_goalId,omitempty" json:"_goalId,omitempty"`
+	SubjectCodeableConcept     *CodeableConcept                    `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference           *Reference                          `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Trigger                    []TriggerDefinition                 `bson:"trigger,omitempty" json:"trigger,omitempty"`
+	Condition                  []PlanDefinitionActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
+	Input                      []DataRequirement                   `bson:"input,omitempty" json:"input,omitempty"`
+	Output                     []DataRequirement                   `bson:"output,omitempty" json:"output,omitempty"`
+	RelatedAction              []PlanDefinitionActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
+	TimingDateTime             *DateTime                           `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement      *Element                            `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	TimingAge                  *Age                                `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
+	TimingPeriod               *Period                             `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDuration             *Duration                           `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
+	TimingRange                *Range                              `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
+	TimingTiming               *Timing                             `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	Participant                []PlanDefinitionActionParticipant   `bson:"participant,omitempty" json:"participant,omitempty"`
+	Type                       *CodeableConcept                    `bson:"type,omitempty" json:"type,omitempty"`
+	GroupingBehavior           *ActionGroupingBehavior             `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement    *Element                            `bson:"_groupingBehavior,omitempty" json:"_groupingBehavior,omitempty"`
+	SelectionBehavior          *ActionSelectionBehavior            `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement   *Element                            `bson:"_selectionBehavior,omitempty" json:"_selectionBehavior,omitempty"`
+	RequiredBehavior           *ActionRequiredBehavior             `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement    *Element                            `bson:"_requiredBehavior,omitempty" json:"_requiredBehavior,omitempty"`
+	PrecheckBehavior           *ActionPrecheckBehavior             `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement    *Element                            `bson:"_precheckBehavior,omitempty" json:"_precheckBehavior,omitempty"`
+	CardinalityBehavior        *ActionCardinalityBehavior          `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *Element                            `bson:"_cardinalityBehavior,omitempty" json:"_cardinalityBehavior,omitempty"`
+	DefinitionCanonical        *string                             `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *Element                            `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	DefinitionUri              *string                             `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
+	DefinitionUriElement       *Element                            `bson:"_definitionUri,omitempty" json:"_definitionUri,omitempty"`
+	Transform                  *string                             `bson:"transform,omitempty" json:"transform,omitempty"`
+	TransformElement           *Element                            `bson:"_transform,omitempty" json:"_transform,omitempty"`
+	DynamicValue               []PlanDefinitionActionDynamicValue  `bson:"dynamicValue,omitempty" json:"dynamicValue,omitempty"`
+	Action                     []PlanDefinitionAction              `bson:"action,omitempty" json:"action,omitempty"`
+	UnknownFields              map[string]json.RawMessage          `bson:"-" json:"-"`
 }
 type PlanDefinitionActionCondition struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Kind              ActionConditionKind `bson:"kind" json:"kind"`
-	Expression        *Expression         `bson:"expression,omitempty" json:"expression,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Kind              ActionConditionKind        `bson:"kind" json:"kind"`
+	KindElement       *Element                   `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Expression        *Expression                `bson:"expression,omitempty" json:"expression,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PlanDefinitionActionCondition with its unknown fields.
+func (r PlanDefinitionActionCondition) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionActionCondition
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PlanDefinitionActionRelatedAction struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ActionId          string                 `bson:"actionId" json:"actionId"`
-	Relationship      ActionRelationshipType `bson:"relationship" json:"relationship"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ActionId            string                     `bson:"actionId" json:"actionId"`
+	ActionIdElement     *Element                   `bson:"_actionId,omitempty" json:"_actionId,omitempty"`
+	Relationship        ActionRelationshipType     `bson:"relationship" json:"relationship"`
+	RelationshipElement *Element                   `bson:"_relationship,omitempty" json:"_relationship,omitempty"`
+	OffsetDuration      *Duration                  `bson:"offsetDuration,omitempty" json:"offsetDuration,omitempty"`
+	OffsetRange         *Range                     `bson:"offsetRange,omitempty" json:"offsetRange,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Offset returns PlanDefinition.action.relatedAction.offset[x] of the set type, such as *Duration, or nil when it is not set.
+func (r *PlanDefinitionActionRelatedAction) Offset() interface{} {
+	switch {
+	case r.OffsetDuration != nil:
+		return r.OffsetDuration
+	case r.OffsetRange != nil:
+		return r.OffsetRange
+	}
+	return nil
+}
+
+// SetOffsetDuration sets PlanDefinition.action.relatedAction.offset[x] to the Duration and clears the other types.
+func (r *PlanDefinitionActionRelatedAction) SetOffsetDuration(v Duration) {
+	r.clearOffset()
+	r.OffsetDuration = &v
+}
+
+// SetOffsetRange sets PlanDefinition.action.relatedAction.offset[x] to the Range and clears the other types.
+func (r *PlanDefinitionActionRelatedAction) SetOffsetRange(v Range) {
+	r.clearOffset()
+	r.OffsetRange = &v
+}
+func (r *PlanDefinitionActionRelatedAction) clearOffset() {
+	r.OffsetDuration = nil
+	r.OffsetRange = nil
+}
+
+// UnmarshalJSON unmarshals the PlanDefinitionActionRelatedAction and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *PlanDefinitionActionRelatedAction) UnmarshalJSON(b []byte) error {
+	type other PlanDefinitionActionRelatedAction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"PlanDefinition.action.relatedAction.offset[x]",
+		r.OffsetDuration != nil,
+		r.OffsetRange != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the PlanDefinitionActionRelatedAction with its unknown fields.
+func (r PlanDefinitionActionRelatedAction) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionActionRelatedAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PlanDefinitionActionParticipant struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              ActionParticipantType `bson:"type" json:"type"`
-	Role              *CodeableConcept      `bson:"role,omitempty" json:"role,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              ActionParticipantType      `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Role              *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the PlanDefinitionActionParticipant with its unknown fields.
+func (r PlanDefinitionActionParticipant) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionActionParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type PlanDefinitionActionDynamicValue struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Path              *string     `bson:"path,omitempty" json:"path,omitempty"`
-	Expression        *Expression `bson:"expression,omitempty" json:"expression,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Path              *string                    `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement       *Element                   `bson:"_path,omitempty" json:"_path,omitempty"`
+	Expression        *Expression                `bson:"expression,omitempty" json:"expression,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the PlanDefinitionActionDynamicValue with its unknown fields.
+func (r PlanDefinitionActionDynamicValue) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionActionDynamicValue
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// Subject returns PlanDefinition.action.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *PlanDefinitionAction) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets PlanDefinition.action.subject[x] to the CodeableConcept and clears the other types.
+func (r *PlanDefinitionAction) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets PlanDefinition.action.subject[x] to the Reference and clears the other types.
+func (r *PlanDefinitionAction) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *PlanDefinitionAction) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// Timing returns PlanDefinition.action.timing[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *PlanDefinitionAction) Timing() interface{} {
+	switch {
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	case r.TimingAge != nil:
+		return r.TimingAge
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	case r.TimingDuration != nil:
+		return r.TimingDuration
+	case r.TimingRange != nil:
+		return r.TimingRange
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	}
+	return nil
+}
+
+// SetTimingDateTime sets PlanDefinition.action.timing[x] to the dateTime and clears the other types.
+func (r *PlanDefinitionAction) SetTimingDateTime(v DateTime) {
+	r.clearTiming()
+	r.TimingDateTime = &v
+}
+
+// SetTimingAge sets PlanDefinition.action.timing[x] to the Age and clears the other types.
+func (r *PlanDefinitionAction) SetTimingAge(v Age) {
+	r.clearTiming()
+	r.TimingAge = &v
+}
+
+// SetTimingPeriod sets PlanDefinition.action.timing[x] to the Period and clears the other types.
+func (r *PlanDefinitionAction) SetTimingPeriod(v Period) {
+	r.clearTiming()
+	r.TimingPeriod = &v
+}
+
+// SetTimingDuration sets PlanDefinition.action.timing[x] to the Duration and clears the other types.
+func (r *PlanDefinitionAction) SetTimingDuration(v Duration) {
+	r.clearTiming()
+	r.TimingDuration = &v
+}
+
+// SetTimingRange sets PlanDefinition.action.timing[x] to the Range and clears the other types.
+func (r *PlanDefinitionAction) SetTimingRange(v Range) {
+	r.clearTiming()
+	r.TimingRange = &v
+}
+
+// SetTimingTiming sets PlanDefinition.action.timing[x] to the Timing and clears the other types.
+func (r *PlanDefinitionAction) SetTimingTiming(v Timing) {
+	r.clearTiming()
+	r.TimingTiming = &v
+}
+func (r *PlanDefinitionAction) clearTiming() {
+	r.TimingDateTime = nil
+	r.TimingDateTimeElement = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingDuration = nil
+	r.TimingRange = nil
+	r.TimingTiming = nil
+}
+
+// Definition returns PlanDefinition.action.definition[x] of the set type, such as *string, or nil when it is not set.
+func (r *PlanDefinitionAction) Definition() interface{} {
+	switch {
+	case r.DefinitionCanonical != nil:
+		return r.DefinitionCanonical
+	case r.DefinitionUri != nil:
+		return r.DefinitionUri
+	}
+	return nil
+}
+
+// SetDefinitionCanonical sets PlanDefinition.action.definition[x] to the canonical and clears the other types.
+func (r *PlanDefinitionAction) SetDefinitionCanonical(v string) {
+	r.clearDefinition()
+	r.DefinitionCanonical = &v
+}
+
+// SetDefinitionUri sets PlanDefinition.action.definition[x] to the uri and clears the other types.
+func (r *PlanDefinitionAction) SetDefinitionUri(v string) {
+	r.clearDefinition()
+	r.DefinitionUri = &v
+}
+func (r *PlanDefinitionAction) clearDefinition() {
+	r.DefinitionCanonical = nil
+	r.DefinitionCanonicalElement = nil
+	r.DefinitionUri = nil
+	r.DefinitionUriElement = nil
+}
+
+// UnmarshalJSON unmarshals the PlanDefinitionAction and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *PlanDefinitionAction) UnmarshalJSON(b []byte) error {
+	type other PlanDefinitionAction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"PlanDefinition.action.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"PlanDefinition.action.timing[x]",
+		r.TimingDateTime != nil || r.TimingDateTimeElement != nil,
+		r.TimingAge != nil,
+		r.TimingPeriod != nil,
+		r.TimingDuration != nil,
+		r.TimingRange != nil,
+		r.TimingTiming != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"PlanDefinition.action.definition[x]",
+		r.DefinitionCanonical != nil || r.DefinitionCanonicalElement != nil,
+		r.DefinitionUri != nil || r.DefinitionUriElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("PlanDefinition.action.goalId", len(r.GoalId), len(r.GoalIdElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the PlanDefinitionAction with its unknown fields.
+func (r PlanDefinitionAction) MarshalJSON() ([]byte, error) {
+	type other PlanDefinitionAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Subject returns PlanDefinition.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *PlanDefinition) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets PlanDefinition.subject[x] to the CodeableConcept and clears the other types.
+func (r *PlanDefinition) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets PlanDefinition.subject[x] to the Reference and clears the other types.
+func (r *PlanDefinition) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *PlanDefinition) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// UnmarshalJSON unmarshals the PlanDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *PlanDefinition) UnmarshalJSON(b []byte) error {
+	type other PlanDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"PlanDefinition.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("PlanDefinition.library", len(r.Library), len(r.LibraryElement)); err != nil {
+		return err
+	}
+	return checkCodes("PlanDefinition", r)
+}
+
 type OtherPlanDefinition PlanDefinition
 
 // MarshalJSON marshals the given PlanDefinition as JSON into a byte slice
 func (r PlanDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPlanDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPlanDefinition: OtherPlanDefinition(r),
 		ResourceType:        "PlanDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPlanDefinition unmarshals a PlanDefinition.
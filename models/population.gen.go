@@ -18,12 +18,59 @@
 
 package models
 
+import "encoding/json"
+
 // Population is documented here http://hl7.org/fhir/StructureDefinition/Population
 type Population struct {
 	ID                     *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension              []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AgeRange               *Range           `bson:"ageRange,omitempty" json:"ageRange,omitempty"`
+	AgeCodeableConcept     *CodeableConcept `bson:"ageCodeableConcept,omitempty" json:"ageCodeableConcept,omitempty"`
 	Gender                 *CodeableConcept `bson:"gender,omitempty" json:"gender,omitempty"`
 	Race                   *CodeableConcept `bson:"race,omitempty" json:"race,omitempty"`
 	PhysiologicalCondition *CodeableConcept `bson:"physiologicalCondition,omitempty" json:"physiologicalCondition,omitempty"`
 }
+
+// Age returns Population.age[x] of the set type, such as *Range, or nil when it is not set.
+func (r *Population) Age() interface{} {
+	switch {
+	case r.AgeRange != nil:
+		return r.AgeRange
+	case r.AgeCodeableConcept != nil:
+		return r.AgeCodeableConcept
+	}
+	return nil
+}
+
+// SetAgeRange sets Population.age[x] to the Range and clears the other types.
+func (r *Population) SetAgeRange(v Range) {
+	r.clearAge()
+	r.AgeRange = &v
+}
+
+// SetAgeCodeableConcept sets Population.age[x] to the CodeableConcept and clears the other types.
+func (r *Population) SetAgeCodeableConcept(v CodeableConcept) {
+	r.clearAge()
+	r.AgeCodeableConcept = &v
+}
+func (r *Population) clearAge() {
+	r.AgeRange = nil
+	r.AgeCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the Population and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *Population) UnmarshalJSON(b []byte) error {
+	type other Population
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Population.age[x]",
+		r.AgeRange != nil,
+		r.AgeCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
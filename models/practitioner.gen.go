@@ -22,44 +22,76 @@ import "encoding/json"
 
 // Practitioner is documented here http://hl7.org/fhir/StructureDefinition/Practitioner
 type Practitioner struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                       `bson:"active,omitempty" json:"active,omitempty"`
-	Name              []HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint              `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address           []Address                   `bson:"address,omitempty" json:"address,omitempty"`
-	Gender            *AdministrativeGender       `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate         *DateTime                   `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Photo             []Attachment                `bson:"photo,omitempty" json:"photo,omitempty"`
-	Qualification     []PractitionerQualification `bson:"qualification,omitempty" json:"qualification,omitempty"`
-	Communication     []CodeableConcept           `bson:"communication,omitempty" json:"communication,omitempty"`
+	ID                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                       `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                    `bson:"_active,omitempty" json:"_active,omitempty"`
+	Name                 []HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom              []ContactPoint              `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address              []Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	Gender               *AdministrativeGender       `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement        *Element                    `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate            *DateTime                   `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement     *Element                    `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	Photo                []Attachment                `bson:"photo,omitempty" json:"photo,omitempty"`
+	Qualification        []PractitionerQualification `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	Communication        []CodeableConcept           `bson:"communication,omitempty" json:"communication,omitempty"`
+	UnknownFields        map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type PractitionerQualification struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
-	Period            *Period         `bson:"period,omitempty" json:"period,omitempty"`
-	Issuer            *Reference      `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Code              CodeableConcept            `bson:"code" json:"code"`
+	Period            *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Issuer            *Reference                 `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PractitionerQualification with its unknown fields.
+func (r PractitionerQualification) MarshalJSON() ([]byte, error) {
+	type other PractitionerQualification
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Practitioner and fails with CodeError when a coded element has an unknown code.
+func (r *Practitioner) UnmarshalJSON(b []byte) error {
+	type other Practitioner
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Practitioner", r)
+}
+
 type OtherPractitioner Practitioner
 
 // MarshalJSON marshals the given Practitioner as JSON into a byte slice
 func (r Practitioner) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPractitioner
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPractitioner: OtherPractitioner(r),
 		ResourceType:      "Practitioner",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPractitioner unmarshals a Practitioner.
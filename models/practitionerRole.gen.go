@@ -22,55 +22,115 @@ import "encoding/json"
 
 // PractitionerRole is documented here http://hl7.org/fhir/StructureDefinition/PractitionerRole
 type PractitionerRole struct {
-	ID                     *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active                 *bool                           `bson:"active,omitempty" json:"active,omitempty"`
-	Period                 *Period                         `bson:"period,omitempty" json:"period,omitempty"`
-	Practitioner           *Reference                      `bson:"practitioner,omitempty" json:"practitioner,omitempty"`
-	Organization           *Reference                      `bson:"organization,omitempty" json:"organization,omitempty"`
-	Code                   []CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
-	Specialty              []CodeableConcept               `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Location               []Reference                     `bson:"location,omitempty" json:"location,omitempty"`
-	HealthcareService      []Reference                     `bson:"healthcareService,omitempty" json:"healthcareService,omitempty"`
-	Telecom                []ContactPoint                  `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	AvailableTime          []PractitionerRoleAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
-	NotAvailable           []PractitionerRoleNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
-	AvailabilityExceptions *string                         `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
-	Endpoint               []Reference                     `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ID                            *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                          *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                        *bool                           `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement                 *Element                        `bson:"_active,omitempty" json:"_active,omitempty"`
+	Period                        *Period                         `bson:"period,omitempty" json:"period,omitempty"`
+	Practitioner                  *Reference                      `bson:"practitioner,omitempty" json:"practitioner,omitempty"`
+	Organization                  *Reference                      `bson:"organization,omitempty" json:"organization,omitempty"`
+	Code                          []CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
+	Specialty                     []CodeableConcept               `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Location                      []Reference                     `bson:"location,omitempty" json:"location,omitempty"`
+	HealthcareService             []Reference                     `bson:"healthcareService,omitempty" json:"healthcareService,omitempty"`
+	Telecom                       []ContactPoint                  `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	AvailableTime                 []PractitionerRoleAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
+	NotAvailable                  []PractitionerRoleNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
+	AvailabilityExceptions        *string                         `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *Element                        `bson:"_availabilityExceptions,omitempty" json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []Reference                     `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	UnknownFields                 map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type PractitionerRoleAvailableTime struct {
-	ID                 *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DaysOfWeek         []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
-	AllDay             *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
-	AvailableStartTime *Time        `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
-	AvailableEndTime   *Time        `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DaysOfWeek                []*DaysOfWeek              `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement         []*Element                 `bson:"_daysOfWeek,omitempty" json:"_daysOfWeek,omitempty"`
+	AllDay                    *bool                      `bson:"allDay,omitempty" json:"allDay,omitempty"`
+	AllDayElement             *Element                   `bson:"_allDay,omitempty" json:"_allDay,omitempty"`
+	AvailableStartTime        *Time                      `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *Element                   `bson:"_availableStartTime,omitempty" json:"_availableStartTime,omitempty"`
+	AvailableEndTime          *Time                      `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *Element                   `bson:"_availableEndTime,omitempty" json:"_availableEndTime,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the PractitionerRoleAvailableTime and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *PractitionerRoleAvailableTime) UnmarshalJSON(b []byte) error {
+	type other PractitionerRoleAvailableTime
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("PractitionerRole.availableTime.daysOfWeek", len(r.DaysOfWeek), len(r.DaysOfWeekElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the PractitionerRoleAvailableTime with its unknown fields.
+func (r PractitionerRoleAvailableTime) MarshalJSON() ([]byte, error) {
+	type other PractitionerRoleAvailableTime
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type PractitionerRoleNotAvailable struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	During            *Period     `bson:"during,omitempty" json:"during,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string                     `bson:"description" json:"description"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	During             *Period                    `bson:"during,omitempty" json:"during,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the PractitionerRoleNotAvailable with its unknown fields.
+func (r PractitionerRoleNotAvailable) MarshalJSON() ([]byte, error) {
+	type other PractitionerRoleNotAvailable
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the PractitionerRole and fails with CodeError when a coded element has an unknown code.
+func (r *PractitionerRole) UnmarshalJSON(b []byte) error {
+	type other PractitionerRole
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("PractitionerRole", r)
+}
+
 type OtherPractitionerRole PractitionerRole
 
 // MarshalJSON marshals the given PractitionerRole as JSON into a byte slice
 func (r PractitionerRole) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherPractitionerRole
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherPractitionerRole: OtherPractitionerRole(r),
 		ResourceType:          "PractitionerRole",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalPractitionerRole unmarshals a PractitionerRole.
@@ -0,0 +1,22 @@
+package models
+
+import "fmt"
+
+// PrimitiveElementError is returned by UnmarshalJSON when the extensions of the repeating primitive element,
+// such as _given of HumanName.given, are not aligned with its values.
+type PrimitiveElementError struct {
+	Element string
+}
+
+func (e PrimitiveElementError) Error() string {
+	return fmt.Sprintf("extensions of primitive element \"%s\" are not aligned with its values", e.Element)
+}
+
+// checkElements returns PrimitiveElementError when the elements with the id and extensions of the repeating primitive
+// are present and their number differs from the number of the values, the missing ones must be null.
+func checkElements(element string, values, elements int) error {
+	if elements > 0 && elements != values {
+		return PrimitiveElementError{Element: element}
+	}
+	return nil
+}
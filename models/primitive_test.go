@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestPrimitiveElement(t *testing.T) {
+	data := `{"resourceType":"Patient",` +
+		`"_birthDate":{"extension":[{"url":"http://hl7.org/fhir/StructureDefinition/data-absent-reason","valueCode":"unknown"}]},` +
+		`"name":[{"given":["Peter","James"],"_given":[null,{"id":"g2","extension":[{"url":"http://example.org/nickname","valueString":"Jim"}]}]}]}`
+
+	patient, err := UnmarshalPatient([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patient.BirthDate != nil || patient.BirthDateElement == nil || ToString(patient.BirthDateElement.Extension[0].ValueCode) != "unknown" {
+		t.Errorf("expected data absent reason of birthDate, got %+v", patient.BirthDateElement)
+	}
+	given := patient.Name[0].GivenElement
+	if len(given) != 2 || given[0] != nil || ToString(given[1].ID) != "g2" {
+		t.Errorf("expected extensions of the second given name, got %+v", given)
+	}
+
+	b, err := json.Marshal(patient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected, actual interface{}
+	_ = json.Unmarshal([]byte(data), &expected)
+	_ = json.Unmarshal(b, &actual)
+	if e, a := toJSON(expected), toJSON(actual); e != a {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+
+	// the null value with the extensions only
+	data = `{"resourceType":"Patient","name":[{"given":[null,"Bob"],"_given":[{"extension":[{"url":"http://hl7.org/fhir/StructureDefinition/data-absent-reason","valueCode":"masked"}]},null]}]}`
+	if patient, err = UnmarshalPatient([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if values := patient.Name[0].Given; len(values) != 2 || values[0] != nil || ToString(values[1]) != "Bob" {
+		t.Errorf("expected null and Bob given names, got %v", ToStrings(values))
+	}
+	if b, err = json.Marshal(patient); err != nil {
+		t.Fatal(err)
+	}
+	_ = json.Unmarshal([]byte(data), &expected)
+	_ = json.Unmarshal(b, &actual)
+	if e, a := toJSON(expected), toJSON(actual); e != a {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+
+	_, err = UnmarshalPatient([]byte(`{"resourceType":"Patient","name":[{"given":["Peter","James"],"_given":[{"id":"g1"}]}]}`))
+	var elementErr PrimitiveElementError
+	if !errors.As(err, &elementErr) || elementErr.Element != "HumanName.given" {
+		t.Errorf("expected PrimitiveElementError, got %v", err)
+	}
+}
+
+func toJSON(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -22,67 +22,190 @@ import "encoding/json"
 
 // Procedure is documented here http://hl7.org/fhir/StructureDefinition/Procedure
 type Procedure struct {
-	ID                    *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference            `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf                []Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                EventStatus            `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category              *CodeableConcept       `bson:"category,omitempty" json:"category,omitempty"`
-	Code                  *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	Subject               Reference              `bson:"subject" json:"subject"`
-	Encounter             *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Recorder              *Reference             `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Asserter              *Reference             `bson:"asserter,omitempty" json:"asserter,omitempty"`
-	Performer             []ProcedurePerformer   `bson:"performer,omitempty" json:"performer,omitempty"`
-	Location              *Reference             `bson:"location,omitempty" json:"location,omitempty"`
-	ReasonCode            []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	BodySite              []CodeableConcept      `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Outcome               *CodeableConcept       `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Report                []Reference            `bson:"report,omitempty" json:"report,omitempty"`
-	Complication          []CodeableConcept      `bson:"complication,omitempty" json:"complication,omitempty"`
-	ComplicationDetail    []Reference            `bson:"complicationDetail,omitempty" json:"complicationDetail,omitempty"`
-	FollowUp              []CodeableConcept      `bson:"followUp,omitempty" json:"followUp,omitempty"`
-	Note                  []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
-	FocalDevice           []ProcedureFocalDevice `bson:"focalDevice,omitempty" json:"focalDevice,omitempty"`
-	UsedReference         []Reference            `bson:"usedReference,omitempty" json:"usedReference,omitempty"`
-	UsedCode              []CodeableConcept      `bson:"usedCode,omitempty" json:"usedCode,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                       []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                       EventStatus                `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept           `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                     *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	Code                         *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Subject                      Reference                  `bson:"subject" json:"subject"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	PerformedDateTime            *DateTime                  `bson:"performedDateTime,omitempty" json:"performedDateTime,omitempty"`
+	PerformedDateTimeElement     *Element                   `bson:"_performedDateTime,omitempty" json:"_performedDateTime,omitempty"`
+	PerformedPeriod              *Period                    `bson:"performedPeriod,omitempty" json:"performedPeriod,omitempty"`
+	PerformedString              *string                    `bson:"performedString,omitempty" json:"performedString,omitempty"`
+	PerformedStringElement       *Element                   `bson:"_performedString,omitempty" json:"_performedString,omitempty"`
+	PerformedAge                 *Age                       `bson:"performedAge,omitempty" json:"performedAge,omitempty"`
+	PerformedRange               *Range                     `bson:"performedRange,omitempty" json:"performedRange,omitempty"`
+	Recorder                     *Reference                 `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Asserter                     *Reference                 `bson:"asserter,omitempty" json:"asserter,omitempty"`
+	Performer                    []ProcedurePerformer       `bson:"performer,omitempty" json:"performer,omitempty"`
+	Location                     *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	ReasonCode                   []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	BodySite                     []CodeableConcept          `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Outcome                      *CodeableConcept           `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	Report                       []Reference                `bson:"report,omitempty" json:"report,omitempty"`
+	Complication                 []CodeableConcept          `bson:"complication,omitempty" json:"complication,omitempty"`
+	ComplicationDetail           []Reference                `bson:"complicationDetail,omitempty" json:"complicationDetail,omitempty"`
+	FollowUp                     []CodeableConcept          `bson:"followUp,omitempty" json:"followUp,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	FocalDevice                  []ProcedureFocalDevice     `bson:"focalDevice,omitempty" json:"focalDevice,omitempty"`
+	UsedReference                []Reference                `bson:"usedReference,omitempty" json:"usedReference,omitempty"`
+	UsedCode                     []CodeableConcept          `bson:"usedCode,omitempty" json:"usedCode,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ProcedurePerformer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
-	Actor             Reference        `bson:"actor" json:"actor"`
-	OnBehalfOf        *Reference       `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Function          *CodeableConcept           `bson:"function,omitempty" json:"function,omitempty"`
+	Actor             Reference                  `bson:"actor" json:"actor"`
+	OnBehalfOf        *Reference                 `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ProcedurePerformer with its unknown fields.
+func (r ProcedurePerformer) MarshalJSON() ([]byte, error) {
+	type other ProcedurePerformer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ProcedureFocalDevice struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Action            *CodeableConcept `bson:"action,omitempty" json:"action,omitempty"`
-	Manipulated       Reference        `bson:"manipulated" json:"manipulated"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Action            *CodeableConcept           `bson:"action,omitempty" json:"action,omitempty"`
+	Manipulated       Reference                  `bson:"manipulated" json:"manipulated"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ProcedureFocalDevice with its unknown fields.
+func (r ProcedureFocalDevice) MarshalJSON() ([]byte, error) {
+	type other ProcedureFocalDevice
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Performed returns Procedure.performed[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *Procedure) Performed() interface{} {
+	switch {
+	case r.PerformedDateTime != nil:
+		return r.PerformedDateTime
+	case r.PerformedPeriod != nil:
+		return r.PerformedPeriod
+	case r.PerformedString != nil:
+		return r.PerformedString
+	case r.PerformedAge != nil:
+		return r.PerformedAge
+	case r.PerformedRange != nil:
+		return r.PerformedRange
+	}
+	return nil
+}
+
+// SetPerformedDateTime sets Procedure.performed[x] to the dateTime and clears the other types.
+func (r *Procedure) SetPerformedDateTime(v DateTime) {
+	r.clearPerformed()
+	r.PerformedDateTime = &v
+}
+
+// SetPerformedPeriod sets Procedure.performed[x] to the Period and clears the other types.
+func (r *Procedure) SetPerformedPeriod(v Period) {
+	r.clearPerformed()
+	r.PerformedPeriod = &v
+}
+
+// SetPerformedString sets Procedure.performed[x] to the string and clears the other types.
+func (r *Procedure) SetPerformedString(v string) {
+	r.clearPerformed()
+	r.PerformedString = &v
+}
+
+// SetPerformedAge sets Procedure.performed[x] to the Age and clears the other types.
+func (r *Procedure) SetPerformedAge(v Age) {
+	r.clearPerformed()
+	r.PerformedAge = &v
+}
+
+// SetPerformedRange sets Procedure.performed[x] to the Range and clears the other types.
+func (r *Procedure) SetPerformedRange(v Range) {
+	r.clearPerformed()
+	r.PerformedRange = &v
+}
+func (r *Procedure) clearPerformed() {
+	r.PerformedDateTime = nil
+	r.PerformedDateTimeElement = nil
+	r.PerformedPeriod = nil
+	r.PerformedString = nil
+	r.PerformedStringElement = nil
+	r.PerformedAge = nil
+	r.PerformedRange = nil
+}
+
+// UnmarshalJSON unmarshals the Procedure and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Procedure) UnmarshalJSON(b []byte) error {
+	type other Procedure
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Procedure.performed[x]",
+		r.PerformedDateTime != nil || r.PerformedDateTimeElement != nil,
+		r.PerformedPeriod != nil,
+		r.PerformedString != nil || r.PerformedStringElement != nil,
+		r.PerformedAge != nil,
+		r.PerformedRange != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Procedure.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Procedure.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("Procedure", r)
+}
+
 type OtherProcedure Procedure
 
 // MarshalJSON marshals the given Procedure as JSON into a byte slice
 func (r Procedure) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherProcedure
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherProcedure: OtherProcedure(r),
 		ResourceType:   "Procedure",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalProcedure unmarshals a Procedure.
@@ -18,6 +18,8 @@
 
 package models
 
+import "encoding/json"
+
 // ProdCharacteristic is documented here http://hl7.org/fhir/StructureDefinition/ProdCharacteristic
 type ProdCharacteristic struct {
 	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -30,8 +32,26 @@ type ProdCharacteristic struct {
 	NominalVolume     *Quantity        `bson:"nominalVolume,omitempty" json:"nominalVolume,omitempty"`
 	ExternalDiameter  *Quantity        `bson:"externalDiameter,omitempty" json:"externalDiameter,omitempty"`
 	Shape             *string          `bson:"shape,omitempty" json:"shape,omitempty"`
-	Color             []string         `bson:"color,omitempty" json:"color,omitempty"`
-	Imprint           []string         `bson:"imprint,omitempty" json:"imprint,omitempty"`
+	ShapeElement      *Element         `bson:"_shape,omitempty" json:"_shape,omitempty"`
+	Color             []*string        `bson:"color,omitempty" json:"color,omitempty"`
+	ColorElement      []*Element       `bson:"_color,omitempty" json:"_color,omitempty"`
+	Imprint           []*string        `bson:"imprint,omitempty" json:"imprint,omitempty"`
+	ImprintElement    []*Element       `bson:"_imprint,omitempty" json:"_imprint,omitempty"`
 	Image             []Attachment     `bson:"image,omitempty" json:"image,omitempty"`
 	Scoring           *CodeableConcept `bson:"scoring,omitempty" json:"scoring,omitempty"`
 }
+
+// UnmarshalJSON unmarshals the ProdCharacteristic and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ProdCharacteristic) UnmarshalJSON(b []byte) error {
+	type other ProdCharacteristic
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ProdCharacteristic.color", len(r.Color), len(r.ColorElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ProdCharacteristic.imprint", len(r.Imprint), len(r.ImprintElement)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.096122 +0000 UTC
+// 2026-10-19 02:55:18.873014609 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // PropertyRepresentation is documented here http://hl7.org/fhir/ValueSet/property-representation
-type PropertyRepresentation int
+// The empty PropertyRepresentation is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type PropertyRepresentation string
 
 const (
-	PropertyRepresentationXmlAttr PropertyRepresentation = iota
-	PropertyRepresentationXmlText
-	PropertyRepresentationTypeAttr
-	PropertyRepresentationCdaText
-	PropertyRepresentationXhtml
+	PropertyRepresentationXmlAttr  PropertyRepresentation = "xmlAttr"
+	PropertyRepresentationXmlText  PropertyRepresentation = "xmlText"
+	PropertyRepresentationTypeAttr PropertyRepresentation = "typeAttr"
+	PropertyRepresentationCdaText  PropertyRepresentation = "cdaText"
+	PropertyRepresentationXhtml    PropertyRepresentation = "xhtml"
 )
 
 func (code PropertyRepresentation) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "PropertyRepresentation"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *PropertyRepresentation) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "xmlAttr":
-		*code = PropertyRepresentationXmlAttr
-	case "xmlText":
-		*code = PropertyRepresentationXmlText
-	case "typeAttr":
-		*code = PropertyRepresentationTypeAttr
-	case "cdaText":
-		*code = PropertyRepresentationCdaText
-	case "xhtml":
-		*code = PropertyRepresentationXhtml
-	default:
-		return fmt.Errorf("unknown PropertyRepresentation code `%s`", s)
+func (code *PropertyRepresentation) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = PropertyRepresentation(s)
 	return nil
 }
 func (code PropertyRepresentation) String() string {
 	return code.Code()
 }
 func (code PropertyRepresentation) Code() string {
+	return string(code)
+}
+func (code PropertyRepresentation) Known() bool {
 	switch code {
-	case PropertyRepresentationXmlAttr:
-		return "xmlAttr"
-	case PropertyRepresentationXmlText:
-		return "xmlText"
-	case PropertyRepresentationTypeAttr:
-		return "typeAttr"
-	case PropertyRepresentationCdaText:
-		return "cdaText"
-	case PropertyRepresentationXhtml:
-		return "xhtml"
+	case PropertyRepresentationXmlAttr, PropertyRepresentationXmlText, PropertyRepresentationTypeAttr, PropertyRepresentationCdaText, PropertyRepresentationXhtml:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code PropertyRepresentation) Display() string {
 	switch code {
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.229087 +0000 UTC
+// 2026-10-19 02:55:18.874318121 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // PropertyType is documented here http://hl7.org/fhir/ValueSet/concept-property-type
-type PropertyType int
+// The empty PropertyType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type PropertyType string
 
 const (
-	PropertyTypeCode PropertyType = iota
-	PropertyTypeCoding
-	PropertyTypeString
-	PropertyTypeInteger
-	PropertyTypeBoolean
-	PropertyTypeDateTime
-	PropertyTypeDecimal
+	PropertyTypeCode     PropertyType = "code"
+	PropertyTypeCoding   PropertyType = "Coding"
+	PropertyTypeString   PropertyType = "string"
+	PropertyTypeInteger  PropertyType = "integer"
+	PropertyTypeBoolean  PropertyType = "boolean"
+	PropertyTypeDateTime PropertyType = "dateTime"
+	PropertyTypeDecimal  PropertyType = "decimal"
 )
 
 func (code PropertyType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "PropertyType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *PropertyType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "code":
-		*code = PropertyTypeCode
-	case "Coding":
-		*code = PropertyTypeCoding
-	case "string":
-		*code = PropertyTypeString
-	case "integer":
-		*code = PropertyTypeInteger
-	case "boolean":
-		*code = PropertyTypeBoolean
-	case "dateTime":
-		*code = PropertyTypeDateTime
-	case "decimal":
-		*code = PropertyTypeDecimal
-	default:
-		return fmt.Errorf("unknown PropertyType code `%s`", s)
+func (code *PropertyType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = PropertyType(s)
 	return nil
 }
 func (code PropertyType) String() string {
 	return code.Code()
 }
 func (code PropertyType) Code() string {
+	return string(code)
+}
+func (code PropertyType) Known() bool {
 	switch code {
-	case PropertyTypeCode:
-		return "code"
-	case PropertyTypeCoding:
-		return "Coding"
-	case PropertyTypeString:
-		return "string"
-	case PropertyTypeInteger:
-		return "integer"
-	case PropertyTypeBoolean:
-		return "boolean"
-	case PropertyTypeDateTime:
-		return "dateTime"
-	case PropertyTypeDecimal:
-		return "decimal"
+	case PropertyTypeCode, PropertyTypeCoding, PropertyTypeString, PropertyTypeInteger, PropertyTypeBoolean, PropertyTypeDateTime, PropertyTypeDecimal:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code PropertyType) Display() string {
 	switch code {
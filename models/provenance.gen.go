@@ -22,51 +22,136 @@ import "encoding/json"
 
 // Provenance is documented here http://hl7.org/fhir/StructureDefinition/Provenance
 type Provenance struct {
-	ID                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            []Reference        `bson:"target" json:"target"`
-	Recorded          string             `bson:"recorded" json:"recorded"`
-	Policy            []string           `bson:"policy,omitempty" json:"policy,omitempty"`
-	Location          *Reference         `bson:"location,omitempty" json:"location,omitempty"`
-	Reason            []CodeableConcept  `bson:"reason,omitempty" json:"reason,omitempty"`
-	Activity          *CodeableConcept   `bson:"activity,omitempty" json:"activity,omitempty"`
-	Agent             []ProvenanceAgent  `bson:"agent" json:"agent"`
-	Entity            []ProvenanceEntity `bson:"entity,omitempty" json:"entity,omitempty"`
-	Signature         []Signature        `bson:"signature,omitempty" json:"signature,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target                  []Reference                `bson:"target" json:"target"`
+	OccurredPeriod          *Period                    `bson:"occurredPeriod,omitempty" json:"occurredPeriod,omitempty"`
+	OccurredDateTime        *DateTime                  `bson:"occurredDateTime,omitempty" json:"occurredDateTime,omitempty"`
+	OccurredDateTimeElement *Element                   `bson:"_occurredDateTime,omitempty" json:"_occurredDateTime,omitempty"`
+	Recorded                Instant                    `bson:"recorded" json:"recorded"`
+	RecordedElement         *Element                   `bson:"_recorded,omitempty" json:"_recorded,omitempty"`
+	Policy                  []*string                  `bson:"policy,omitempty" json:"policy,omitempty"`
+	PolicyElement           []*Element                 `bson:"_policy,omitempty" json:"_policy,omitempty"`
+	Location                *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	Reason                  []CodeableConcept          `bson:"reason,omitempty" json:"reason,omitempty"`
+	Activity                *CodeableConcept           `bson:"activity,omitempty" json:"activity,omitempty"`
+	Agent                   []ProvenanceAgent          `bson:"agent" json:"agent"`
+	Entity                  []ProvenanceEntity         `bson:"entity,omitempty" json:"entity,omitempty"`
+	Signature               []Signature                `bson:"signature,omitempty" json:"signature,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ProvenanceAgent struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Role              []CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Who               Reference         `bson:"who" json:"who"`
-	OnBehalfOf        *Reference        `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Role              []CodeableConcept          `bson:"role,omitempty" json:"role,omitempty"`
+	Who               Reference                  `bson:"who" json:"who"`
+	OnBehalfOf        *Reference                 `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ProvenanceAgent with its unknown fields.
+func (r ProvenanceAgent) MarshalJSON() ([]byte, error) {
+	type other ProvenanceAgent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ProvenanceEntity struct {
-	ID                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Role              ProvenanceEntityRole `bson:"role" json:"role"`
-	What              Reference            `bson:"what" json:"what"`
-	Agent             []ProvenanceAgent    `bson:"agent,omitempty" json:"agent,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Role              ProvenanceEntityRole       `bson:"role" json:"role"`
+	RoleElement       *Element                   `bson:"_role,omitempty" json:"_role,omitempty"`
+	What              Reference                  `bson:"what" json:"what"`
+	Agent             []ProvenanceAgent          `bson:"agent,omitempty" json:"agent,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ProvenanceEntity with its unknown fields.
+func (r ProvenanceEntity) MarshalJSON() ([]byte, error) {
+	type other ProvenanceEntity
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurred returns Provenance.occurred[x] of the set type, such as *Period, or nil when it is not set.
+func (r *Provenance) Occurred() interface{} {
+	switch {
+	case r.OccurredPeriod != nil:
+		return r.OccurredPeriod
+	case r.OccurredDateTime != nil:
+		return r.OccurredDateTime
+	}
+	return nil
+}
+
+// SetOccurredPeriod sets Provenance.occurred[x] to the Period and clears the other types.
+func (r *Provenance) SetOccurredPeriod(v Period) {
+	r.clearOccurred()
+	r.OccurredPeriod = &v
+}
+
+// SetOccurredDateTime sets Provenance.occurred[x] to the dateTime and clears the other types.
+func (r *Provenance) SetOccurredDateTime(v DateTime) {
+	r.clearOccurred()
+	r.OccurredDateTime = &v
 }
+func (r *Provenance) clearOccurred() {
+	r.OccurredPeriod = nil
+	r.OccurredDateTime = nil
+	r.OccurredDateTimeElement = nil
+}
+
+// UnmarshalJSON unmarshals the Provenance and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Provenance) UnmarshalJSON(b []byte) error {
+	type other Provenance
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Provenance.occurred[x]",
+		r.OccurredPeriod != nil,
+		r.OccurredDateTime != nil || r.OccurredDateTimeElement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Provenance.policy", len(r.Policy), len(r.PolicyElement)); err != nil {
+		return err
+	}
+	return checkCodes("Provenance", r)
+}
+
 type OtherProvenance Provenance
 
 // MarshalJSON marshals the given Provenance as JSON into a byte slice
 func (r Provenance) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherProvenance
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherProvenance: OtherProvenance(r),
 		ResourceType:    "Provenance",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalProvenance unmarshals a Provenance.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.121053 +0000 UTC
+// 2026-10-19 02:55:18.875668753 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ProvenanceEntityRole is documented here http://hl7.org/fhir/ValueSet/provenance-entity-role
-type ProvenanceEntityRole int
+// The empty ProvenanceEntityRole is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ProvenanceEntityRole string
 
 const (
-	ProvenanceEntityRoleDerivation ProvenanceEntityRole = iota
-	ProvenanceEntityRoleRevision
-	ProvenanceEntityRoleQuotation
-	ProvenanceEntityRoleSource
-	ProvenanceEntityRoleRemoval
+	ProvenanceEntityRoleDerivation ProvenanceEntityRole = "derivation"
+	ProvenanceEntityRoleRevision   ProvenanceEntityRole = "revision"
+	ProvenanceEntityRoleQuotation  ProvenanceEntityRole = "quotation"
+	ProvenanceEntityRoleSource     ProvenanceEntityRole = "source"
+	ProvenanceEntityRoleRemoval    ProvenanceEntityRole = "removal"
 )
 
 func (code ProvenanceEntityRole) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ProvenanceEntityRole"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ProvenanceEntityRole) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "derivation":
-		*code = ProvenanceEntityRoleDerivation
-	case "revision":
-		*code = ProvenanceEntityRoleRevision
-	case "quotation":
-		*code = ProvenanceEntityRoleQuotation
-	case "source":
-		*code = ProvenanceEntityRoleSource
-	case "removal":
-		*code = ProvenanceEntityRoleRemoval
-	default:
-		return fmt.Errorf("unknown ProvenanceEntityRole code `%s`", s)
+func (code *ProvenanceEntityRole) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ProvenanceEntityRole(s)
 	return nil
 }
 func (code ProvenanceEntityRole) String() string {
 	return code.Code()
 }
 func (code ProvenanceEntityRole) Code() string {
+	return string(code)
+}
+func (code ProvenanceEntityRole) Known() bool {
 	switch code {
-	case ProvenanceEntityRoleDerivation:
-		return "derivation"
-	case ProvenanceEntityRoleRevision:
-		return "revision"
-	case ProvenanceEntityRoleQuotation:
-		return "quotation"
-	case ProvenanceEntityRoleSource:
-		return "source"
-	case ProvenanceEntityRoleRemoval:
-		return "removal"
+	case ProvenanceEntityRoleDerivation, ProvenanceEntityRoleRevision, ProvenanceEntityRoleQuotation, ProvenanceEntityRoleSource, ProvenanceEntityRoleRemoval:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ProvenanceEntityRole) Display() string {
 	switch code {
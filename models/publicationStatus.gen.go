@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.272031 +0000 UTC
+// 2026-10-19 02:55:18.87693638 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // PublicationStatus is documented here http://hl7.org/fhir/ValueSet/publication-status
-type PublicationStatus int
+// The empty PublicationStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type PublicationStatus string
 
 const (
-	PublicationStatusDraft PublicationStatus = iota
-	PublicationStatusActive
-	PublicationStatusRetired
-	PublicationStatusUnknown
+	PublicationStatusDraft   PublicationStatus = "draft"
+	PublicationStatusActive  PublicationStatus = "active"
+	PublicationStatusRetired PublicationStatus = "retired"
+	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
 func (code PublicationStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "PublicationStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *PublicationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "draft":
-		*code = PublicationStatusDraft
-	case "active":
-		*code = PublicationStatusActive
-	case "retired":
-		*code = PublicationStatusRetired
-	case "unknown":
-		*code = PublicationStatusUnknown
-	default:
-		return fmt.Errorf("unknown PublicationStatus code `%s`", s)
+func (code *PublicationStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = PublicationStatus(s)
 	return nil
 }
 func (code PublicationStatus) String() string {
 	return code.Code()
 }
 func (code PublicationStatus) Code() string {
+	return string(code)
+}
+func (code PublicationStatus) Known() bool {
 	switch code {
-	case PublicationStatusDraft:
-		return "draft"
-	case PublicationStatusActive:
-		return "active"
-	case PublicationStatusRetired:
-		return "retired"
-	case PublicationStatusUnknown:
-		return "unknown"
+	case PublicationStatusDraft, PublicationStatusActive, PublicationStatusRetired, PublicationStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code PublicationStatus) Display() string {
 	switch code {
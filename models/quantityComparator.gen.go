@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.179074 +0000 UTC
+// 2026-10-19 02:55:18.88001224 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // QuantityComparator is documented here http://hl7.org/fhir/ValueSet/quantity-comparator
-type QuantityComparator int
+// The empty QuantityComparator is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type QuantityComparator string
 
 const (
-	QuantityComparatorLessThan QuantityComparator = iota
-	QuantityComparatorLessOrEquals
-	QuantityComparatorGreaterOrEquals
-	QuantityComparatorGreaterThan
+	QuantityComparatorLessThan        QuantityComparator = "<"
+	QuantityComparatorLessOrEquals    QuantityComparator = "<="
+	QuantityComparatorGreaterOrEquals QuantityComparator = ">="
+	QuantityComparatorGreaterThan     QuantityComparator = ">"
 )
 
 func (code QuantityComparator) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "QuantityComparator"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *QuantityComparator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "<":
-		*code = QuantityComparatorLessThan
-	case "<=":
-		*code = QuantityComparatorLessOrEquals
-	case ">=":
-		*code = QuantityComparatorGreaterOrEquals
-	case ">":
-		*code = QuantityComparatorGreaterThan
-	default:
-		return fmt.Errorf("unknown QuantityComparator code `%s`", s)
+func (code *QuantityComparator) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = QuantityComparator(s)
 	return nil
 }
 func (code QuantityComparator) String() string {
 	return code.Code()
 }
 func (code QuantityComparator) Code() string {
+	return string(code)
+}
+func (code QuantityComparator) Known() bool {
 	switch code {
-	case QuantityComparatorLessThan:
-		return "<"
-	case QuantityComparatorLessOrEquals:
-		return "<="
-	case QuantityComparatorGreaterOrEquals:
-		return ">="
-	case QuantityComparatorGreaterThan:
-		return ">"
+	case QuantityComparatorLessThan, QuantityComparatorLessOrEquals, QuantityComparatorGreaterOrEquals, QuantityComparatorGreaterThan:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code QuantityComparator) Display() string {
 	switch code {
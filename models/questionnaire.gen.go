@@ -22,86 +22,600 @@ import "encoding/json"
 
 // Questionnaire is documented here http://hl7.org/fhir/StructureDefinition/Questionnaire
 type Questionnaire struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string             `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string             `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string             `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string             `bson:"title,omitempty" json:"title,omitempty"`
-	DerivedFrom       []string            `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	Status            PublicationStatus   `bson:"status" json:"status"`
-	Experimental      *bool               `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	SubjectType       []ResourceType      `bson:"subjectType,omitempty" json:"subjectType,omitempty"`
-	Date              *DateTime           `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail     `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string             `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext      `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string             `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string             `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime           `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime           `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Code              []Coding            `bson:"code,omitempty" json:"code,omitempty"`
-	Item              []QuestionnaireItem `bson:"item,omitempty" json:"item,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                   *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement            *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	DerivedFrom           []*string                  `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	DerivedFromElement    []*Element                 `bson:"_derivedFrom,omitempty" json:"_derivedFrom,omitempty"`
+	Status                PublicationStatus          `bson:"status" json:"status"`
+	StatusElement         *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental          *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement   *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectType           []*ResourceType            `bson:"subjectType,omitempty" json:"subjectType,omitempty"`
+	SubjectTypeElement    []*Element                 `bson:"_subjectType,omitempty" json:"_subjectType,omitempty"`
+	Date                  *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext            []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose               *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement        *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright             *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *DateTime                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *DateTime                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Code                  []Coding                   `bson:"code,omitempty" json:"code,omitempty"`
+	Item                  []QuestionnaireItem        `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type QuestionnaireItem struct {
-	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	LinkId            string                          `bson:"linkId" json:"linkId"`
-	Definition        *string                         `bson:"definition,omitempty" json:"definition,omitempty"`
-	Code              []Coding                        `bson:"code,omitempty" json:"code,omitempty"`
-	Prefix            *string                         `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Text              *string                         `bson:"text,omitempty" json:"text,omitempty"`
-	Type              QuestionnaireItemType           `bson:"type" json:"type"`
-	EnableWhen        []QuestionnaireItemEnableWhen   `bson:"enableWhen,omitempty" json:"enableWhen,omitempty"`
-	EnableBehavior    *EnableWhenBehavior             `bson:"enableBehavior,omitempty" json:"enableBehavior,omitempty"`
-	Required          *bool                           `bson:"required,omitempty" json:"required,omitempty"`
-	Repeats           *bool                           `bson:"repeats,omitempty" json:"repeats,omitempty"`
-	ReadOnly          *bool                           `bson:"readOnly,omitempty" json:"readOnly,omitempty"`
-	MaxLength         *int                            `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
-	AnswerValueSet    *string                         `bson:"answerValueSet,omitempty" json:"answerValueSet,omitempty"`
-	AnswerOption      []QuestionnaireItemAnswerOption `bson:"answerOption,omitempty" json:"answerOption,omitempty"`
-	Initial           []QuestionnaireItemInitial      `bson:"initial,omitempty" json:"initial,omitempty"`
-	Item              []QuestionnaireItem             `bson:"item,omitempty" json:"item,omitempty"`
+	ID                    *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	LinkId                string                          `bson:"linkId" json:"linkId"`
+	LinkIdElement         *Element                        `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	Definition            *string                         `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement     *Element                        `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Code                  []Coding                        `bson:"code,omitempty" json:"code,omitempty"`
+	Prefix                *string                         `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement         *Element                        `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Text                  *string                         `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement           *Element                        `bson:"_text,omitempty" json:"_text,omitempty"`
+	Type                  QuestionnaireItemType           `bson:"type" json:"type"`
+	TypeElement           *Element                        `bson:"_type,omitempty" json:"_type,omitempty"`
+	EnableWhen            []QuestionnaireItemEnableWhen   `bson:"enableWhen,omitempty" json:"enableWhen,omitempty"`
+	EnableBehavior        *EnableWhenBehavior             `bson:"enableBehavior,omitempty" json:"enableBehavior,omitempty"`
+	EnableBehaviorElement *Element                        `bson:"_enableBehavior,omitempty" json:"_enableBehavior,omitempty"`
+	Required              *bool                           `bson:"required,omitempty" json:"required,omitempty"`
+	RequiredElement       *Element                        `bson:"_required,omitempty" json:"_required,omitempty"`
+	Repeats               *bool                           `bson:"repeats,omitempty" json:"repeats,omitempty"`
+	RepeatsElement        *Element                        `bson:"_repeats,omitempty" json:"_repeats,omitempty"`
+	ReadOnly              *bool                           `bson:"readOnly,omitempty" json:"readOnly,omitempty"`
+	ReadOnlyElement       *Element                        `bson:"_readOnly,omitempty" json:"_readOnly,omitempty"`
+	MaxLength             *int                            `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
+	MaxLengthElement      *Element                        `bson:"_maxLength,omitempty" json:"_maxLength,omitempty"`
+	AnswerValueSet        *string                         `bson:"answerValueSet,omitempty" json:"answerValueSet,omitempty"`
+	AnswerValueSetElement *Element                        `bson:"_answerValueSet,omitempty" json:"_answerValueSet,omitempty"`
+	AnswerOption          []QuestionnaireItemAnswerOption `bson:"answerOption,omitempty" json:"answerOption,omitempty"`
+	Initial               []QuestionnaireItemInitial      `bson:"initial,omitempty" json:"initial,omitempty"`
+	Item                  []QuestionnaireItem             `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields         map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type QuestionnaireItemEnableWhen struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Question          string                    `bson:"question" json:"question"`
-	Operator          QuestionnaireItemOperator `bson:"operator" json:"operator"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Question              string                     `bson:"question" json:"question"`
+	QuestionElement       *Element                   `bson:"_question,omitempty" json:"_question,omitempty"`
+	Operator              QuestionnaireItemOperator  `bson:"operator" json:"operator"`
+	OperatorElement       *Element                   `bson:"_operator,omitempty" json:"_operator,omitempty"`
+	AnswerBoolean         *bool                      `bson:"answerBoolean,omitempty" json:"answerBoolean,omitempty"`
+	AnswerBooleanElement  *Element                   `bson:"_answerBoolean,omitempty" json:"_answerBoolean,omitempty"`
+	AnswerDecimal         *Decimal                   `bson:"answerDecimal,omitempty" json:"answerDecimal,omitempty"`
+	AnswerDecimalElement  *Element                   `bson:"_answerDecimal,omitempty" json:"_answerDecimal,omitempty"`
+	AnswerInteger         *int                       `bson:"answerInteger,omitempty" json:"answerInteger,omitempty"`
+	AnswerIntegerElement  *Element                   `bson:"_answerInteger,omitempty" json:"_answerInteger,omitempty"`
+	AnswerDate            *DateTime                  `bson:"answerDate,omitempty" json:"answerDate,omitempty"`
+	AnswerDateElement     *Element                   `bson:"_answerDate,omitempty" json:"_answerDate,omitempty"`
+	AnswerDateTime        *DateTime                  `bson:"answerDateTime,omitempty" json:"answerDateTime,omitempty"`
+	AnswerDateTimeElement *Element                   `bson:"_answerDateTime,omitempty" json:"_answerDateTime,omitempty"`
+	AnswerTime            *Time                      `bson:"answerTime,omitempty" json:"answerTime,omitempty"`
+	AnswerTimeElement     *Element                   `bson:"_answerTime,omitempty" json:"_answerTime,omitempty"`
+	AnswerString          *string                    `bson:"answerString,omitempty" json:"answerString,omitempty"`
+	AnswerStringElement   *Element                   `bson:"_answerString,omitempty" json:"_answerString,omitempty"`
+	AnswerCoding          *Coding                    `bson:"answerCoding,omitempty" json:"answerCoding,omitempty"`
+	AnswerQuantity        *Quantity                  `bson:"answerQuantity,omitempty" json:"answerQuantity,omitempty"`
+	AnswerReference       *Reference                 `bson:"answerReference,omitempty" json:"answerReference,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Answer returns Questionnaire.item.enableWhen.answer[x] of the set type, such as *bool, or nil when it is not set.
+func (r *QuestionnaireItemEnableWhen) Answer() interface{} {
+	switch {
+	case r.AnswerBoolean != nil:
+		return r.AnswerBoolean
+	case r.AnswerDecimal != nil:
+		return r.AnswerDecimal
+	case r.AnswerInteger != nil:
+		return r.AnswerInteger
+	case r.AnswerDate != nil:
+		return r.AnswerDate
+	case r.AnswerDateTime != nil:
+		return r.AnswerDateTime
+	case r.AnswerTime != nil:
+		return r.AnswerTime
+	case r.AnswerString != nil:
+		return r.AnswerString
+	case r.AnswerCoding != nil:
+		return r.AnswerCoding
+	case r.AnswerQuantity != nil:
+		return r.AnswerQuantity
+	case r.AnswerReference != nil:
+		return r.AnswerReference
+	}
+	return nil
+}
+
+// SetAnswerBoolean sets Questionnaire.item.enableWhen.answer[x] to the boolean and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerBoolean(v bool) {
+	r.clearAnswer()
+	r.AnswerBoolean = &v
+}
+
+// SetAnswerDecimal sets Questionnaire.item.enableWhen.answer[x] to the decimal and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerDecimal(v Decimal) {
+	r.clearAnswer()
+	r.AnswerDecimal = &v
+}
+
+// SetAnswerInteger sets Questionnaire.item.enableWhen.answer[x] to the integer and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerInteger(v int) {
+	r.clearAnswer()
+	r.AnswerInteger = &v
+}
+
+// SetAnswerDate sets Questionnaire.item.enableWhen.answer[x] to the date and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerDate(v DateTime) {
+	r.clearAnswer()
+	r.AnswerDate = &v
+}
+
+// SetAnswerDateTime sets Questionnaire.item.enableWhen.answer[x] to the dateTime and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerDateTime(v DateTime) {
+	r.clearAnswer()
+	r.AnswerDateTime = &v
+}
+
+// SetAnswerTime sets Questionnaire.item.enableWhen.answer[x] to the time and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerTime(v Time) {
+	r.clearAnswer()
+	r.AnswerTime = &v
+}
+
+// SetAnswerString sets Questionnaire.item.enableWhen.answer[x] to the string and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerString(v string) {
+	r.clearAnswer()
+	r.AnswerString = &v
+}
+
+// SetAnswerCoding sets Questionnaire.item.enableWhen.answer[x] to the Coding and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerCoding(v Coding) {
+	r.clearAnswer()
+	r.AnswerCoding = &v
+}
+
+// SetAnswerQuantity sets Questionnaire.item.enableWhen.answer[x] to the Quantity and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerQuantity(v Quantity) {
+	r.clearAnswer()
+	r.AnswerQuantity = &v
+}
+
+// SetAnswerReference sets Questionnaire.item.enableWhen.answer[x] to the Reference and clears the other types.
+func (r *QuestionnaireItemEnableWhen) SetAnswerReference(v Reference) {
+	r.clearAnswer()
+	r.AnswerReference = &v
+}
+func (r *QuestionnaireItemEnableWhen) clearAnswer() {
+	r.AnswerBoolean = nil
+	r.AnswerBooleanElement = nil
+	r.AnswerDecimal = nil
+	r.AnswerDecimalElement = nil
+	r.AnswerInteger = nil
+	r.AnswerIntegerElement = nil
+	r.AnswerDate = nil
+	r.AnswerDateElement = nil
+	r.AnswerDateTime = nil
+	r.AnswerDateTimeElement = nil
+	r.AnswerTime = nil
+	r.AnswerTimeElement = nil
+	r.AnswerString = nil
+	r.AnswerStringElement = nil
+	r.AnswerCoding = nil
+	r.AnswerQuantity = nil
+	r.AnswerReference = nil
+}
+
+// UnmarshalJSON unmarshals the QuestionnaireItemEnableWhen and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *QuestionnaireItemEnableWhen) UnmarshalJSON(b []byte) error {
+	type other QuestionnaireItemEnableWhen
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Questionnaire.item.enableWhen.answer[x]",
+		r.AnswerBoolean != nil || r.AnswerBooleanElement != nil,
+		r.AnswerDecimal != nil || r.AnswerDecimalElement != nil,
+		r.AnswerInteger != nil || r.AnswerIntegerElement != nil,
+		r.AnswerDate != nil || r.AnswerDateElement != nil,
+		r.AnswerDateTime != nil || r.AnswerDateTimeElement != nil,
+		r.AnswerTime != nil || r.AnswerTimeElement != nil,
+		r.AnswerString != nil || r.AnswerStringElement != nil,
+		r.AnswerCoding != nil,
+		r.AnswerQuantity != nil,
+		r.AnswerReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the QuestionnaireItemEnableWhen with its unknown fields.
+func (r QuestionnaireItemEnableWhen) MarshalJSON() ([]byte, error) {
+	type other QuestionnaireItemEnableWhen
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type QuestionnaireItemAnswerOption struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	InitialSelected   *bool       `bson:"initialSelected,omitempty" json:"initialSelected,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueInteger           *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement    *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate              *DateTime                  `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement       *Element                   `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueTime              *Time                      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement       *Element                   `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString            *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement     *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueCoding            *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueReference         *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	InitialSelected        *bool                      `bson:"initialSelected,omitempty" json:"initialSelected,omitempty"`
+	InitialSelectedElement *Element                   `bson:"_initialSelected,omitempty" json:"_initialSelected,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns Questionnaire.item.answerOption.value[x] of the set type, such as *int, or nil when it is not set.
+func (r *QuestionnaireItemAnswerOption) Value() interface{} {
+	switch {
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueInteger sets Questionnaire.item.answerOption.value[x] to the integer and clears the other types.
+func (r *QuestionnaireItemAnswerOption) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueDate sets Questionnaire.item.answerOption.value[x] to the date and clears the other types.
+func (r *QuestionnaireItemAnswerOption) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
 }
+
+// SetValueTime sets Questionnaire.item.answerOption.value[x] to the time and clears the other types.
+func (r *QuestionnaireItemAnswerOption) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueString sets Questionnaire.item.answerOption.value[x] to the string and clears the other types.
+func (r *QuestionnaireItemAnswerOption) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueCoding sets Questionnaire.item.answerOption.value[x] to the Coding and clears the other types.
+func (r *QuestionnaireItemAnswerOption) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueReference sets Questionnaire.item.answerOption.value[x] to the Reference and clears the other types.
+func (r *QuestionnaireItemAnswerOption) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *QuestionnaireItemAnswerOption) clearValue() {
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueCoding = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the QuestionnaireItemAnswerOption and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *QuestionnaireItemAnswerOption) UnmarshalJSON(b []byte) error {
+	type other QuestionnaireItemAnswerOption
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Questionnaire.item.answerOption.value[x]",
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueCoding != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the QuestionnaireItemAnswerOption with its unknown fields.
+func (r QuestionnaireItemAnswerOption) MarshalJSON() ([]byte, error) {
+	type other QuestionnaireItemAnswerOption
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type QuestionnaireItemInitial struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDecimal         *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueInteger         *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate            *DateTime                  `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement     *Element                   `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime        *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueTime            *Time                      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element                   `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString          *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueUri             *string                    `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element                   `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCoding          *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueQuantity        *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueReference       *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns Questionnaire.item.initial.value[x] of the set type, such as *bool, or nil when it is not set.
+func (r *QuestionnaireItemInitial) Value() interface{} {
+	switch {
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueBoolean sets Questionnaire.item.initial.value[x] to the boolean and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueDecimal sets Questionnaire.item.initial.value[x] to the decimal and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueInteger sets Questionnaire.item.initial.value[x] to the integer and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueDate sets Questionnaire.item.initial.value[x] to the date and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets Questionnaire.item.initial.value[x] to the dateTime and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueTime sets Questionnaire.item.initial.value[x] to the time and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueString sets Questionnaire.item.initial.value[x] to the string and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueUri sets Questionnaire.item.initial.value[x] to the uri and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueAttachment sets Questionnaire.item.initial.value[x] to the Attachment and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCoding sets Questionnaire.item.initial.value[x] to the Coding and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueQuantity sets Questionnaire.item.initial.value[x] to the Quantity and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueReference sets Questionnaire.item.initial.value[x] to the Reference and clears the other types.
+func (r *QuestionnaireItemInitial) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
 }
+func (r *QuestionnaireItemInitial) clearValue() {
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueAttachment = nil
+	r.ValueCoding = nil
+	r.ValueQuantity = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the QuestionnaireItemInitial and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *QuestionnaireItemInitial) UnmarshalJSON(b []byte) error {
+	type other QuestionnaireItemInitial
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Questionnaire.item.initial.value[x]",
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueAttachment != nil,
+		r.ValueCoding != nil,
+		r.ValueQuantity != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the QuestionnaireItemInitial with its unknown fields.
+func (r QuestionnaireItemInitial) MarshalJSON() ([]byte, error) {
+	type other QuestionnaireItemInitial
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the QuestionnaireItem with its unknown fields.
+func (r QuestionnaireItem) MarshalJSON() ([]byte, error) {
+	type other QuestionnaireItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Questionnaire and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *Questionnaire) UnmarshalJSON(b []byte) error {
+	type other Questionnaire
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Questionnaire.derivedFrom", len(r.DerivedFrom), len(r.DerivedFromElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Questionnaire.subjectType", len(r.SubjectType), len(r.SubjectTypeElement)); err != nil {
+		return err
+	}
+	return checkCodes("Questionnaire", r)
+}
+
 type OtherQuestionnaire Questionnaire
 
 // MarshalJSON marshals the given Questionnaire as JSON into a byte slice
 func (r Questionnaire) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherQuestionnaire
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherQuestionnaire: OtherQuestionnaire(r),
 		ResourceType:       "Questionnaire",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalQuestionnaire unmarshals a Questionnaire.
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.193628 +0000 UTC
+// 2026-10-19 02:55:18.881027313 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // QuestionnaireItemOperator is documented here http://hl7.org/fhir/ValueSet/questionnaire-enable-operator
-type QuestionnaireItemOperator int
+// The empty QuestionnaireItemOperator is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type QuestionnaireItemOperator string
 
 const (
-	QuestionnaireItemOperatorExists QuestionnaireItemOperator = iota
-	QuestionnaireItemOperatorEquals
-	QuestionnaireItemOperatorNotEquals
-	QuestionnaireItemOperatorGreaterThan
-	QuestionnaireItemOperatorLessThan
-	QuestionnaireItemOperatorGreaterOrEquals
-	QuestionnaireItemOperatorLessOrEquals
+	QuestionnaireItemOperatorExists          QuestionnaireItemOperator = "exists"
+	QuestionnaireItemOperatorEquals          QuestionnaireItemOperator = "="
+	QuestionnaireItemOperatorNotEquals       QuestionnaireItemOperator = "!="
+	QuestionnaireItemOperatorGreaterThan     QuestionnaireItemOperator = ">"
+	QuestionnaireItemOperatorLessThan        QuestionnaireItemOperator = "<"
+	QuestionnaireItemOperatorGreaterOrEquals QuestionnaireItemOperator = ">="
+	QuestionnaireItemOperatorLessOrEquals    QuestionnaireItemOperator = "<="
 )
 
 func (code QuestionnaireItemOperator) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "QuestionnaireItemOperator"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *QuestionnaireItemOperator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "exists":
-		*code = QuestionnaireItemOperatorExists
-	case "=":
-		*code = QuestionnaireItemOperatorEquals
-	case "!=":
-		*code = QuestionnaireItemOperatorNotEquals
-	case ">":
-		*code = QuestionnaireItemOperatorGreaterThan
-	case "<":
-		*code = QuestionnaireItemOperatorLessThan
-	case ">=":
-		*code = QuestionnaireItemOperatorGreaterOrEquals
-	case "<=":
-		*code = QuestionnaireItemOperatorLessOrEquals
-	default:
-		return fmt.Errorf("unknown QuestionnaireItemOperator code `%s`", s)
+func (code *QuestionnaireItemOperator) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = QuestionnaireItemOperator(s)
 	return nil
 }
 func (code QuestionnaireItemOperator) String() string {
 	return code.Code()
 }
 func (code QuestionnaireItemOperator) Code() string {
+	return string(code)
+}
+func (code QuestionnaireItemOperator) Known() bool {
 	switch code {
-	case QuestionnaireItemOperatorExists:
-		return "exists"
-	case QuestionnaireItemOperatorEquals:
-		return "="
-	case QuestionnaireItemOperatorNotEquals:
-		return "!="
-	case QuestionnaireItemOperatorGreaterThan:
-		return ">"
-	case QuestionnaireItemOperatorLessThan:
-		return "<"
-	case QuestionnaireItemOperatorGreaterOrEquals:
-		return ">="
-	case QuestionnaireItemOperatorLessOrEquals:
-		return "<="
+	case QuestionnaireItemOperatorExists, QuestionnaireItemOperatorEquals, QuestionnaireItemOperatorNotEquals, QuestionnaireItemOperatorGreaterThan, QuestionnaireItemOperatorLessThan, QuestionnaireItemOperatorGreaterOrEquals, QuestionnaireItemOperatorLessOrEquals:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code QuestionnaireItemOperator) Display() string {
 	switch code {
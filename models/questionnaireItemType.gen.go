@@ -14,125 +14,66 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.125323 +0000 UTC
+// 2026-10-19 02:55:18.882521707 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // QuestionnaireItemType is documented here http://hl7.org/fhir/ValueSet/item-type
-type QuestionnaireItemType int
+// The empty QuestionnaireItemType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type QuestionnaireItemType string
 
 const (
-	QuestionnaireItemTypeGroup QuestionnaireItemType = iota
-	QuestionnaireItemTypeDisplay
-	QuestionnaireItemTypeQuestion
-	QuestionnaireItemTypeBoolean
-	QuestionnaireItemTypeDecimal
-	QuestionnaireItemTypeInteger
-	QuestionnaireItemTypeDate
-	QuestionnaireItemTypeDateTime
-	QuestionnaireItemTypeTime
-	QuestionnaireItemTypeString
-	QuestionnaireItemTypeText
-	QuestionnaireItemTypeUrl
-	QuestionnaireItemTypeChoice
-	QuestionnaireItemTypeOpenChoice
-	QuestionnaireItemTypeAttachment
-	QuestionnaireItemTypeReference
-	QuestionnaireItemTypeQuantity
+	QuestionnaireItemTypeGroup      QuestionnaireItemType = "group"
+	QuestionnaireItemTypeDisplay    QuestionnaireItemType = "display"
+	QuestionnaireItemTypeQuestion   QuestionnaireItemType = "question"
+	QuestionnaireItemTypeBoolean    QuestionnaireItemType = "boolean"
+	QuestionnaireItemTypeDecimal    QuestionnaireItemType = "decimal"
+	QuestionnaireItemTypeInteger    QuestionnaireItemType = "integer"
+	QuestionnaireItemTypeDate       QuestionnaireItemType = "date"
+	QuestionnaireItemTypeDateTime   QuestionnaireItemType = "dateTime"
+	QuestionnaireItemTypeTime       QuestionnaireItemType = "time"
+	QuestionnaireItemTypeString     QuestionnaireItemType = "string"
+	QuestionnaireItemTypeText       QuestionnaireItemType = "text"
+	QuestionnaireItemTypeUrl        QuestionnaireItemType = "url"
+	QuestionnaireItemTypeChoice     QuestionnaireItemType = "choice"
+	QuestionnaireItemTypeOpenChoice QuestionnaireItemType = "open-choice"
+	QuestionnaireItemTypeAttachment QuestionnaireItemType = "attachment"
+	QuestionnaireItemTypeReference  QuestionnaireItemType = "reference"
+	QuestionnaireItemTypeQuantity   QuestionnaireItemType = "quantity"
 )
 
 func (code QuestionnaireItemType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "QuestionnaireItemType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *QuestionnaireItemType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "group":
-		*code = QuestionnaireItemTypeGroup
-	case "display":
-		*code = QuestionnaireItemTypeDisplay
-	case "question":
-		*code = QuestionnaireItemTypeQuestion
-	case "boolean":
-		*code = QuestionnaireItemTypeBoolean
-	case "decimal":
-		*code = QuestionnaireItemTypeDecimal
-	case "integer":
-		*code = QuestionnaireItemTypeInteger
-	case "date":
-		*code = QuestionnaireItemTypeDate
-	case "dateTime":
-		*code = QuestionnaireItemTypeDateTime
-	case "time":
-		*code = QuestionnaireItemTypeTime
-	case "string":
-		*code = QuestionnaireItemTypeString
-	case "text":
-		*code = QuestionnaireItemTypeText
-	case "url":
-		*code = QuestionnaireItemTypeUrl
-	case "choice":
-		*code = QuestionnaireItemTypeChoice
-	case "open-choice":
-		*code = QuestionnaireItemTypeOpenChoice
-	case "attachment":
-		*code = QuestionnaireItemTypeAttachment
-	case "reference":
-		*code = QuestionnaireItemTypeReference
-	case "quantity":
-		*code = QuestionnaireItemTypeQuantity
-	default:
-		return fmt.Errorf("unknown QuestionnaireItemType code `%s`", s)
+func (code *QuestionnaireItemType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = QuestionnaireItemType(s)
 	return nil
 }
 func (code QuestionnaireItemType) String() string {
 	return code.Code()
 }
 func (code QuestionnaireItemType) Code() string {
+	return string(code)
+}
+func (code QuestionnaireItemType) Known() bool {
 	switch code {
-	case QuestionnaireItemTypeGroup:
-		return "group"
-	case QuestionnaireItemTypeDisplay:
-		return "display"
-	case QuestionnaireItemTypeQuestion:
-		return "question"
-	case QuestionnaireItemTypeBoolean:
-		return "boolean"
-	case QuestionnaireItemTypeDecimal:
-		return "decimal"
-	case QuestionnaireItemTypeInteger:
-		return "integer"
-	case QuestionnaireItemTypeDate:
-		return "date"
-	case QuestionnaireItemTypeDateTime:
-		return "dateTime"
-	case QuestionnaireItemTypeTime:
-		return "time"
-	case QuestionnaireItemTypeString:
-		return "string"
-	case QuestionnaireItemTypeText:
-		return "text"
-	case QuestionnaireItemTypeUrl:
-		return "url"
-	case QuestionnaireItemTypeChoice:
-		return "choice"
-	case QuestionnaireItemTypeOpenChoice:
-		return "open-choice"
-	case QuestionnaireItemTypeAttachment:
-		return "attachment"
-	case QuestionnaireItemTypeReference:
-		return "reference"
-	case QuestionnaireItemTypeQuantity:
-		return "quantity"
+	case QuestionnaireItemTypeGroup, QuestionnaireItemTypeDisplay, QuestionnaireItemTypeQuestion, QuestionnaireItemTypeBoolean, QuestionnaireItemTypeDecimal, QuestionnaireItemTypeInteger, QuestionnaireItemTypeDate, QuestionnaireItemTypeDateTime, QuestionnaireItemTypeTime, QuestionnaireItemTypeString, QuestionnaireItemTypeText, QuestionnaireItemTypeUrl, QuestionnaireItemTypeChoice, QuestionnaireItemTypeOpenChoice, QuestionnaireItemTypeAttachment, QuestionnaireItemTypeReference, QuestionnaireItemTypeQuantity:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code QuestionnaireItemType) Display() string {
 	switch code {
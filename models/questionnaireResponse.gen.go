@@ -22,52 +22,269 @@ import "encoding/json"
 
 // QuestionnaireResponse is documented here http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse
 type QuestionnaireResponse struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf            []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Questionnaire     *string                     `bson:"questionnaire,omitempty" json:"questionnaire,omitempty"`
-	Status            QuestionnaireResponseStatus `bson:"status" json:"status"`
-	Subject           *Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter         *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Authored          *DateTime                   `bson:"authored,omitempty" json:"authored,omitempty"`
-	Author            *Reference                  `bson:"author,omitempty" json:"author,omitempty"`
-	Source            *Reference                  `bson:"source,omitempty" json:"source,omitempty"`
-	Item              []QuestionnaireResponseItem `bson:"item,omitempty" json:"item,omitempty"`
+	ID                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn              []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf               []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Questionnaire        *string                     `bson:"questionnaire,omitempty" json:"questionnaire,omitempty"`
+	QuestionnaireElement *Element                    `bson:"_questionnaire,omitempty" json:"_questionnaire,omitempty"`
+	Status               QuestionnaireResponseStatus `bson:"status" json:"status"`
+	StatusElement        *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Subject              *Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter            *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Authored             *DateTime                   `bson:"authored,omitempty" json:"authored,omitempty"`
+	AuthoredElement      *Element                    `bson:"_authored,omitempty" json:"_authored,omitempty"`
+	Author               *Reference                  `bson:"author,omitempty" json:"author,omitempty"`
+	Source               *Reference                  `bson:"source,omitempty" json:"source,omitempty"`
+	Item                 []QuestionnaireResponseItem `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields        map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type QuestionnaireResponseItem struct {
 	ID                *string                           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	LinkId            string                            `bson:"linkId" json:"linkId"`
+	LinkIdElement     *Element                          `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
 	Definition        *string                           `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement *Element                          `bson:"_definition,omitempty" json:"_definition,omitempty"`
 	Text              *string                           `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element                          `bson:"_text,omitempty" json:"_text,omitempty"`
 	Answer            []QuestionnaireResponseItemAnswer `bson:"answer,omitempty" json:"answer,omitempty"`
 	Item              []QuestionnaireResponseItem       `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields     map[string]json.RawMessage        `bson:"-" json:"-"`
 }
 type QuestionnaireResponseItemAnswer struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Item              []QuestionnaireResponseItem `bson:"item,omitempty" json:"item,omitempty"`
+	ID                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueBoolean         *bool                       `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                    `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDecimal         *Decimal                    `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element                    `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueInteger         *int                        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                    `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate            *DateTime                   `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement     *Element                    `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime        *DateTime                   `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueTime            *Time                       `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element                    `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString          *string                     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueUri             *string                     `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element                    `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment                 `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCoding          *Coding                     `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueQuantity        *Quantity                   `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueReference       *Reference                  `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Item                 []QuestionnaireResponseItem `bson:"item,omitempty" json:"item,omitempty"`
+	UnknownFields        map[string]json.RawMessage  `bson:"-" json:"-"`
 }
+
+// Value returns QuestionnaireResponse.item.answer.value[x] of the set type, such as *bool, or nil when it is not set.
+func (r *QuestionnaireResponseItemAnswer) Value() interface{} {
+	switch {
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueBoolean sets QuestionnaireResponse.item.answer.value[x] to the boolean and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueDecimal sets QuestionnaireResponse.item.answer.value[x] to the decimal and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueInteger sets QuestionnaireResponse.item.answer.value[x] to the integer and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueDate sets QuestionnaireResponse.item.answer.value[x] to the date and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets QuestionnaireResponse.item.answer.value[x] to the dateTime and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueTime sets QuestionnaireResponse.item.answer.value[x] to the time and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueString sets QuestionnaireResponse.item.answer.value[x] to the string and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueUri sets QuestionnaireResponse.item.answer.value[x] to the uri and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueAttachment sets QuestionnaireResponse.item.answer.value[x] to the Attachment and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCoding sets QuestionnaireResponse.item.answer.value[x] to the Coding and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueQuantity sets QuestionnaireResponse.item.answer.value[x] to the Quantity and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueReference sets QuestionnaireResponse.item.answer.value[x] to the Reference and clears the other types.
+func (r *QuestionnaireResponseItemAnswer) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *QuestionnaireResponseItemAnswer) clearValue() {
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueAttachment = nil
+	r.ValueCoding = nil
+	r.ValueQuantity = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the QuestionnaireResponseItemAnswer and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *QuestionnaireResponseItemAnswer) UnmarshalJSON(b []byte) error {
+	type other QuestionnaireResponseItemAnswer
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"QuestionnaireResponse.item.answer.value[x]",
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueAttachment != nil,
+		r.ValueCoding != nil,
+		r.ValueQuantity != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the QuestionnaireResponseItemAnswer with its unknown fields.
+func (r QuestionnaireResponseItemAnswer) MarshalJSON() ([]byte, error) {
+	type other QuestionnaireResponseItemAnswer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the QuestionnaireResponseItem with its unknown fields.
+func (r QuestionnaireResponseItem) MarshalJSON() ([]byte, error) {
+	type other QuestionnaireResponseItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the QuestionnaireResponse and fails with CodeError when a coded element has an unknown code.
+func (r *QuestionnaireResponse) UnmarshalJSON(b []byte) error {
+	type other QuestionnaireResponse
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("QuestionnaireResponse", r)
+}
+
 type OtherQuestionnaireResponse QuestionnaireResponse
 
 // MarshalJSON marshals the given QuestionnaireResponse as JSON into a byte slice
 func (r QuestionnaireResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherQuestionnaireResponse
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherQuestionnaireResponse: OtherQuestionnaireResponse(r),
 		ResourceType:               "QuestionnaireResponse",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalQuestionnaireResponse unmarshals a QuestionnaireResponse.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.226002 +0000 UTC
+// 2026-10-19 02:55:18.884340227 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // QuestionnaireResponseStatus is documented here http://hl7.org/fhir/ValueSet/questionnaire-answers-status
-type QuestionnaireResponseStatus int
+// The empty QuestionnaireResponseStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type QuestionnaireResponseStatus string
 
 const (
-	QuestionnaireResponseStatusInProgress QuestionnaireResponseStatus = iota
-	QuestionnaireResponseStatusCompleted
-	QuestionnaireResponseStatusAmended
-	QuestionnaireResponseStatusEnteredInError
-	QuestionnaireResponseStatusStopped
+	QuestionnaireResponseStatusInProgress     QuestionnaireResponseStatus = "in-progress"
+	QuestionnaireResponseStatusCompleted      QuestionnaireResponseStatus = "completed"
+	QuestionnaireResponseStatusAmended        QuestionnaireResponseStatus = "amended"
+	QuestionnaireResponseStatusEnteredInError QuestionnaireResponseStatus = "entered-in-error"
+	QuestionnaireResponseStatusStopped        QuestionnaireResponseStatus = "stopped"
 )
 
 func (code QuestionnaireResponseStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "QuestionnaireResponseStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *QuestionnaireResponseStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "in-progress":
-		*code = QuestionnaireResponseStatusInProgress
-	case "completed":
-		*code = QuestionnaireResponseStatusCompleted
-	case "amended":
-		*code = QuestionnaireResponseStatusAmended
-	case "entered-in-error":
-		*code = QuestionnaireResponseStatusEnteredInError
-	case "stopped":
-		*code = QuestionnaireResponseStatusStopped
-	default:
-		return fmt.Errorf("unknown QuestionnaireResponseStatus code `%s`", s)
+func (code *QuestionnaireResponseStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = QuestionnaireResponseStatus(s)
 	return nil
 }
 func (code QuestionnaireResponseStatus) String() string {
 	return code.Code()
 }
 func (code QuestionnaireResponseStatus) Code() string {
+	return string(code)
+}
+func (code QuestionnaireResponseStatus) Known() bool {
 	switch code {
-	case QuestionnaireResponseStatusInProgress:
-		return "in-progress"
-	case QuestionnaireResponseStatusCompleted:
-		return "completed"
-	case QuestionnaireResponseStatusAmended:
-		return "amended"
-	case QuestionnaireResponseStatusEnteredInError:
-		return "entered-in-error"
-	case QuestionnaireResponseStatusStopped:
-		return "stopped"
+	case QuestionnaireResponseStatusInProgress, QuestionnaireResponseStatusCompleted, QuestionnaireResponseStatusAmended, QuestionnaireResponseStatusEnteredInError, QuestionnaireResponseStatusStopped:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code QuestionnaireResponseStatus) Display() string {
 	switch code {
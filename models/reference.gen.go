@@ -20,10 +20,13 @@ package models
 
 // Reference is documented here http://hl7.org/fhir/StructureDefinition/Reference
 type Reference struct {
-	ID         *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Reference  *string     `bson:"reference,omitempty" json:"reference,omitempty"`
-	Type       *string     `bson:"type,omitempty" json:"type,omitempty"`
-	Identifier *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Display    *string     `bson:"display,omitempty" json:"display,omitempty"`
+	ID               *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension        []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Reference        *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement *Element    `bson:"_reference,omitempty" json:"_reference,omitempty"`
+	Type             *string     `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement      *Element    `bson:"_type,omitempty" json:"_type,omitempty"`
+	Identifier       *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Display          *string     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement   *Element    `bson:"_display,omitempty" json:"_display,omitempty"`
 }
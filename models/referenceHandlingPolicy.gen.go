@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.069544 +0000 UTC
+// 2026-10-19 02:55:18.885416725 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ReferenceHandlingPolicy is documented here http://hl7.org/fhir/ValueSet/reference-handling-policy
-type ReferenceHandlingPolicy int
+// The empty ReferenceHandlingPolicy is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ReferenceHandlingPolicy string
 
 const (
-	ReferenceHandlingPolicyLiteral ReferenceHandlingPolicy = iota
-	ReferenceHandlingPolicyLogical
-	ReferenceHandlingPolicyResolves
-	ReferenceHandlingPolicyEnforced
-	ReferenceHandlingPolicyLocal
+	ReferenceHandlingPolicyLiteral  ReferenceHandlingPolicy = "literal"
+	ReferenceHandlingPolicyLogical  ReferenceHandlingPolicy = "logical"
+	ReferenceHandlingPolicyResolves ReferenceHandlingPolicy = "resolves"
+	ReferenceHandlingPolicyEnforced ReferenceHandlingPolicy = "enforced"
+	ReferenceHandlingPolicyLocal    ReferenceHandlingPolicy = "local"
 )
 
 func (code ReferenceHandlingPolicy) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ReferenceHandlingPolicy"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ReferenceHandlingPolicy) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "literal":
-		*code = ReferenceHandlingPolicyLiteral
-	case "logical":
-		*code = ReferenceHandlingPolicyLogical
-	case "resolves":
-		*code = ReferenceHandlingPolicyResolves
-	case "enforced":
-		*code = ReferenceHandlingPolicyEnforced
-	case "local":
-		*code = ReferenceHandlingPolicyLocal
-	default:
-		return fmt.Errorf("unknown ReferenceHandlingPolicy code `%s`", s)
+func (code *ReferenceHandlingPolicy) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ReferenceHandlingPolicy(s)
 	return nil
 }
 func (code ReferenceHandlingPolicy) String() string {
 	return code.Code()
 }
 func (code ReferenceHandlingPolicy) Code() string {
+	return string(code)
+}
+func (code ReferenceHandlingPolicy) Known() bool {
 	switch code {
-	case ReferenceHandlingPolicyLiteral:
-		return "literal"
-	case ReferenceHandlingPolicyLogical:
-		return "logical"
-	case ReferenceHandlingPolicyResolves:
-		return "resolves"
-	case ReferenceHandlingPolicyEnforced:
-		return "enforced"
-	case ReferenceHandlingPolicyLocal:
-		return "local"
+	case ReferenceHandlingPolicyLiteral, ReferenceHandlingPolicyLogical, ReferenceHandlingPolicyResolves, ReferenceHandlingPolicyEnforced, ReferenceHandlingPolicyLocal:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ReferenceHandlingPolicy) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.248734 +0000 UTC
+// 2026-10-19 02:55:18.88657929 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ReferenceVersionRules is documented here http://hl7.org/fhir/ValueSet/reference-version-rules
-type ReferenceVersionRules int
+// The empty ReferenceVersionRules is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ReferenceVersionRules string
 
 const (
-	ReferenceVersionRulesEither ReferenceVersionRules = iota
-	ReferenceVersionRulesIndependent
-	ReferenceVersionRulesSpecific
+	ReferenceVersionRulesEither      ReferenceVersionRules = "either"
+	ReferenceVersionRulesIndependent ReferenceVersionRules = "independent"
+	ReferenceVersionRulesSpecific    ReferenceVersionRules = "specific"
 )
 
 func (code ReferenceVersionRules) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ReferenceVersionRules"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ReferenceVersionRules) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "either":
-		*code = ReferenceVersionRulesEither
-	case "independent":
-		*code = ReferenceVersionRulesIndependent
-	case "specific":
-		*code = ReferenceVersionRulesSpecific
-	default:
-		return fmt.Errorf("unknown ReferenceVersionRules code `%s`", s)
+func (code *ReferenceVersionRules) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ReferenceVersionRules(s)
 	return nil
 }
 func (code ReferenceVersionRules) String() string {
 	return code.Code()
 }
 func (code ReferenceVersionRules) Code() string {
+	return string(code)
+}
+func (code ReferenceVersionRules) Known() bool {
 	switch code {
-	case ReferenceVersionRulesEither:
-		return "either"
-	case ReferenceVersionRulesIndependent:
-		return "independent"
-	case ReferenceVersionRulesSpecific:
-		return "specific"
+	case ReferenceVersionRulesEither, ReferenceVersionRulesIndependent, ReferenceVersionRulesSpecific:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ReferenceVersionRules) Display() string {
 	switch code {
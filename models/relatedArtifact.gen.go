@@ -20,13 +20,19 @@ package models
 
 // RelatedArtifact is documented here http://hl7.org/fhir/StructureDefinition/RelatedArtifact
 type RelatedArtifact struct {
-	ID        *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type      RelatedArtifactType `bson:"type" json:"type"`
-	Label     *string             `bson:"label,omitempty" json:"label,omitempty"`
-	Display   *string             `bson:"display,omitempty" json:"display,omitempty"`
-	Citation  *string             `bson:"citation,omitempty" json:"citation,omitempty"`
-	URL       *string             `bson:"url,omitempty" json:"url,omitempty"`
-	Document  *Attachment         `bson:"document,omitempty" json:"document,omitempty"`
-	Resource  *string             `bson:"resource,omitempty" json:"resource,omitempty"`
+	ID              *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension       []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type            RelatedArtifactType `bson:"type" json:"type"`
+	TypeElement     *Element            `bson:"_type,omitempty" json:"_type,omitempty"`
+	Label           *string             `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement    *Element            `bson:"_label,omitempty" json:"_label,omitempty"`
+	Display         *string             `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement  *Element            `bson:"_display,omitempty" json:"_display,omitempty"`
+	Citation        *string             `bson:"citation,omitempty" json:"citation,omitempty"`
+	CitationElement *Element            `bson:"_citation,omitempty" json:"_citation,omitempty"`
+	URL             *string             `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement      *Element            `bson:"_url,omitempty" json:"_url,omitempty"`
+	Document        *Attachment         `bson:"document,omitempty" json:"document,omitempty"`
+	Resource        *string             `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement *Element            `bson:"_resource,omitempty" json:"_resource,omitempty"`
 }
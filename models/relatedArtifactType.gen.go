@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.21461 +0000 UTC
+// 2026-10-19 02:55:18.8877141 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // RelatedArtifactType is documented here http://hl7.org/fhir/ValueSet/related-artifact-type
-type RelatedArtifactType int
+// The empty RelatedArtifactType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type RelatedArtifactType string
 
 const (
-	RelatedArtifactTypeDocumentation RelatedArtifactType = iota
-	RelatedArtifactTypeJustification
-	RelatedArtifactTypeCitation
-	RelatedArtifactTypePredecessor
-	RelatedArtifactTypeSuccessor
-	RelatedArtifactTypeDerivedFrom
-	RelatedArtifactTypeDependsOn
-	RelatedArtifactTypeComposedOf
+	RelatedArtifactTypeDocumentation RelatedArtifactType = "documentation"
+	RelatedArtifactTypeJustification RelatedArtifactType = "justification"
+	RelatedArtifactTypeCitation      RelatedArtifactType = "citation"
+	RelatedArtifactTypePredecessor   RelatedArtifactType = "predecessor"
+	RelatedArtifactTypeSuccessor     RelatedArtifactType = "successor"
+	RelatedArtifactTypeDerivedFrom   RelatedArtifactType = "derived-from"
+	RelatedArtifactTypeDependsOn     RelatedArtifactType = "depends-on"
+	RelatedArtifactTypeComposedOf    RelatedArtifactType = "composed-of"
 )
 
 func (code RelatedArtifactType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "RelatedArtifactType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *RelatedArtifactType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "documentation":
-		*code = RelatedArtifactTypeDocumentation
-	case "justification":
-		*code = RelatedArtifactTypeJustification
-	case "citation":
-		*code = RelatedArtifactTypeCitation
-	case "predecessor":
-		*code = RelatedArtifactTypePredecessor
-	case "successor":
-		*code = RelatedArtifactTypeSuccessor
-	case "derived-from":
-		*code = RelatedArtifactTypeDerivedFrom
-	case "depends-on":
-		*code = RelatedArtifactTypeDependsOn
-	case "composed-of":
-		*code = RelatedArtifactTypeComposedOf
-	default:
-		return fmt.Errorf("unknown RelatedArtifactType code `%s`", s)
+func (code *RelatedArtifactType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = RelatedArtifactType(s)
 	return nil
 }
 func (code RelatedArtifactType) String() string {
 	return code.Code()
 }
 func (code RelatedArtifactType) Code() string {
+	return string(code)
+}
+func (code RelatedArtifactType) Known() bool {
 	switch code {
-	case RelatedArtifactTypeDocumentation:
-		return "documentation"
-	case RelatedArtifactTypeJustification:
-		return "justification"
-	case RelatedArtifactTypeCitation:
-		return "citation"
-	case RelatedArtifactTypePredecessor:
-		return "predecessor"
-	case RelatedArtifactTypeSuccessor:
-		return "successor"
-	case RelatedArtifactTypeDerivedFrom:
-		return "derived-from"
-	case RelatedArtifactTypeDependsOn:
-		return "depends-on"
-	case RelatedArtifactTypeComposedOf:
-		return "composed-of"
+	case RelatedArtifactTypeDocumentation, RelatedArtifactTypeJustification, RelatedArtifactTypeCitation, RelatedArtifactTypePredecessor, RelatedArtifactTypeSuccessor, RelatedArtifactTypeDerivedFrom, RelatedArtifactTypeDependsOn, RelatedArtifactTypeComposedOf:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code RelatedArtifactType) Display() string {
 	switch code {
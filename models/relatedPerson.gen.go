@@ -22,44 +22,77 @@ import "encoding/json"
 
 // RelatedPerson is documented here http://hl7.org/fhir/StructureDefinition/RelatedPerson
 type RelatedPerson struct {
-	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                        `bson:"active,omitempty" json:"active,omitempty"`
-	Patient           Reference                    `bson:"patient" json:"patient"`
-	Relationship      []CodeableConcept            `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Name              []HumanName                  `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint               `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Gender            *AdministrativeGender        `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate         *DateTime                    `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Address           []Address                    `bson:"address,omitempty" json:"address,omitempty"`
-	Photo             []Attachment                 `bson:"photo,omitempty" json:"photo,omitempty"`
-	Period            *Period                      `bson:"period,omitempty" json:"period,omitempty"`
-	Communication     []RelatedPersonCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
+	ID                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                        `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                     `bson:"_active,omitempty" json:"_active,omitempty"`
+	Patient              Reference                    `bson:"patient" json:"patient"`
+	Relationship         []CodeableConcept            `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Name                 []HumanName                  `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom              []ContactPoint               `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Gender               *AdministrativeGender        `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement        *Element                     `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate            *DateTime                    `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement     *Element                     `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	Address              []Address                    `bson:"address,omitempty" json:"address,omitempty"`
+	Photo                []Attachment                 `bson:"photo,omitempty" json:"photo,omitempty"`
+	Period               *Period                      `bson:"period,omitempty" json:"period,omitempty"`
+	Communication        []RelatedPersonCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
+	UnknownFields        map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type RelatedPersonCommunication struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Language          CodeableConcept `bson:"language" json:"language"`
-	Preferred         *bool           `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Language          CodeableConcept            `bson:"language" json:"language"`
+	Preferred         *bool                      `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element                   `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the RelatedPersonCommunication with its unknown fields.
+func (r RelatedPersonCommunication) MarshalJSON() ([]byte, error) {
+	type other RelatedPersonCommunication
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the RelatedPerson and fails with CodeError when a coded element has an unknown code.
+func (r *RelatedPerson) UnmarshalJSON(b []byte) error {
+	type other RelatedPerson
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("RelatedPerson", r)
+}
+
 type OtherRelatedPerson RelatedPerson
 
 // MarshalJSON marshals the given RelatedPerson as JSON into a byte slice
 func (r RelatedPerson) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherRelatedPerson
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherRelatedPerson: OtherRelatedPerson(r),
 		ResourceType:       "RelatedPerson",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalRelatedPerson unmarshals a RelatedPerson.
@@ -22,80 +22,293 @@ import "encoding/json"
 
 // RequestGroup is documented here http://hl7.org/fhir/StructureDefinition/RequestGroup
 type RequestGroup struct {
-	ID                    *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string             `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string             `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference          `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces              []Reference          `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	GroupIdentifier       *Identifier          `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	Status                RequestStatus        `bson:"status" json:"status"`
-	Intent                RequestIntent        `bson:"intent" json:"intent"`
-	Priority              *RequestPriority     `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                  *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
-	Subject               *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter             *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	AuthoredOn            *DateTime            `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Author                *Reference           `bson:"author,omitempty" json:"author,omitempty"`
-	ReasonCode            []CodeableConcept    `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference          `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note                  []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
-	Action                []RequestGroupAction `bson:"action,omitempty" json:"action,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                     []Reference                `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	GroupIdentifier              *Identifier                `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	Status                       RequestStatus              `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent              `bson:"intent" json:"intent"`
+	IntentElement                *Element                   `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Priority                     *RequestPriority           `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                         *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Subject                      *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	AuthoredOn                   *DateTime                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                   `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Author                       *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	ReasonCode                   []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Action                       []RequestGroupAction       `bson:"action,omitempty" json:"action,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type RequestGroupAction struct {
-	ID                  *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Prefix              *string                           `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Title               *string                           `bson:"title,omitempty" json:"title,omitempty"`
-	Description         *string                           `bson:"description,omitempty" json:"description,omitempty"`
-	TextEquivalent      *string                           `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
-	Priority            *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                []CodeableConcept                 `bson:"code,omitempty" json:"code,omitempty"`
-	Documentation       []RelatedArtifact                 `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Condition           []RequestGroupActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
-	RelatedAction       []RequestGroupActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
-	Participant         []Reference                       `bson:"participant,omitempty" json:"participant,omitempty"`
-	Type                *CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
-	GroupingBehavior    *ActionGroupingBehavior           `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
-	SelectionBehavior   *ActionSelectionBehavior          `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
-	RequiredBehavior    *ActionRequiredBehavior           `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
-	PrecheckBehavior    *ActionPrecheckBehavior           `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
-	CardinalityBehavior *ActionCardinalityBehavior        `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
-	Resource            *Reference                        `bson:"resource,omitempty" json:"resource,omitempty"`
-	Action              []RequestGroupAction              `bson:"action,omitempty" json:"action,omitempty"`
+	ID                         *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Prefix                     *string                           `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement              *Element                          `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Title                      *string                           `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement               *Element                          `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description                *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	TextEquivalent             *string                           `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
+	TextEquivalentElement      *Element                          `bson:"_textEquivalent,omitempty" json:"_textEquivalent,omitempty"`
+	Priority                   *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement            *Element                          `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                       []CodeableConcept                 `bson:"code,omitempty" json:"code,omitempty"`
+	Documentation              []RelatedArtifact                 `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Condition                  []RequestGroupActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
+	RelatedAction              []RequestGroupActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
+	TimingDateTime             *DateTime                         `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement      *Element                          `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	TimingAge                  *Age                              `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
+	TimingPeriod               *Period                           `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDuration             *Duration                         `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
+	TimingRange                *Range                            `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
+	TimingTiming               *Timing                           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	Participant                []Reference                       `bson:"participant,omitempty" json:"participant,omitempty"`
+	Type                       *CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
+	GroupingBehavior           *ActionGroupingBehavior           `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement    *Element                          `bson:"_groupingBehavior,omitempty" json:"_groupingBehavior,omitempty"`
+	SelectionBehavior          *ActionSelectionBehavior          `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement   *Element                          `bson:"_selectionBehavior,omitempty" json:"_selectionBehavior,omitempty"`
+	RequiredBehavior           *ActionRequiredBehavior           `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement    *Element                          `bson:"_requiredBehavior,omitempty" json:"_requiredBehavior,omitempty"`
+	PrecheckBehavior           *ActionPrecheckBehavior           `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement    *Element                          `bson:"_precheckBehavior,omitempty" json:"_precheckBehavior,omitempty"`
+	CardinalityBehavior        *ActionCardinalityBehavior        `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *Element                          `bson:"_cardinalityBehavior,omitempty" json:"_cardinalityBehavior,omitempty"`
+	Resource                   *Reference                        `bson:"resource,omitempty" json:"resource,omitempty"`
+	Action                     []RequestGroupAction              `bson:"action,omitempty" json:"action,omitempty"`
+	UnknownFields              map[string]json.RawMessage        `bson:"-" json:"-"`
 }
 type RequestGroupActionCondition struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Kind              ActionConditionKind `bson:"kind" json:"kind"`
-	Expression        *Expression         `bson:"expression,omitempty" json:"expression,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Kind              ActionConditionKind        `bson:"kind" json:"kind"`
+	KindElement       *Element                   `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Expression        *Expression                `bson:"expression,omitempty" json:"expression,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the RequestGroupActionCondition with its unknown fields.
+func (r RequestGroupActionCondition) MarshalJSON() ([]byte, error) {
+	type other RequestGroupActionCondition
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type RequestGroupActionRelatedAction struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ActionId          string                 `bson:"actionId" json:"actionId"`
-	Relationship      ActionRelationshipType `bson:"relationship" json:"relationship"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ActionId            string                     `bson:"actionId" json:"actionId"`
+	ActionIdElement     *Element                   `bson:"_actionId,omitempty" json:"_actionId,omitempty"`
+	Relationship        ActionRelationshipType     `bson:"relationship" json:"relationship"`
+	RelationshipElement *Element                   `bson:"_relationship,omitempty" json:"_relationship,omitempty"`
+	OffsetDuration      *Duration                  `bson:"offsetDuration,omitempty" json:"offsetDuration,omitempty"`
+	OffsetRange         *Range                     `bson:"offsetRange,omitempty" json:"offsetRange,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Offset returns RequestGroup.action.relatedAction.offset[x] of the set type, such as *Duration, or nil when it is not set.
+func (r *RequestGroupActionRelatedAction) Offset() interface{} {
+	switch {
+	case r.OffsetDuration != nil:
+		return r.OffsetDuration
+	case r.OffsetRange != nil:
+		return r.OffsetRange
+	}
+	return nil
 }
+
+// SetOffsetDuration sets RequestGroup.action.relatedAction.offset[x] to the Duration and clears the other types.
+func (r *RequestGroupActionRelatedAction) SetOffsetDuration(v Duration) {
+	r.clearOffset()
+	r.OffsetDuration = &v
+}
+
+// SetOffsetRange sets RequestGroup.action.relatedAction.offset[x] to the Range and clears the other types.
+func (r *RequestGroupActionRelatedAction) SetOffsetRange(v Range) {
+	r.clearOffset()
+	r.OffsetRange = &v
+}
+func (r *RequestGroupActionRelatedAction) clearOffset() {
+	r.OffsetDuration = nil
+	r.OffsetRange = nil
+}
+
+// UnmarshalJSON unmarshals the RequestGroupActionRelatedAction and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *RequestGroupActionRelatedAction) UnmarshalJSON(b []byte) error {
+	type other RequestGroupActionRelatedAction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"RequestGroup.action.relatedAction.offset[x]",
+		r.OffsetDuration != nil,
+		r.OffsetRange != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the RequestGroupActionRelatedAction with its unknown fields.
+func (r RequestGroupActionRelatedAction) MarshalJSON() ([]byte, error) {
+	type other RequestGroupActionRelatedAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Timing returns RequestGroup.action.timing[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *RequestGroupAction) Timing() interface{} {
+	switch {
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	case r.TimingAge != nil:
+		return r.TimingAge
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	case r.TimingDuration != nil:
+		return r.TimingDuration
+	case r.TimingRange != nil:
+		return r.TimingRange
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	}
+	return nil
+}
+
+// SetTimingDateTime sets RequestGroup.action.timing[x] to the dateTime and clears the other types.
+func (r *RequestGroupAction) SetTimingDateTime(v DateTime) {
+	r.clearTiming()
+	r.TimingDateTime = &v
+}
+
+// SetTimingAge sets RequestGroup.action.timing[x] to the Age and clears the other types.
+func (r *RequestGroupAction) SetTimingAge(v Age) {
+	r.clearTiming()
+	r.TimingAge = &v
+}
+
+// SetTimingPeriod sets RequestGroup.action.timing[x] to the Period and clears the other types.
+func (r *RequestGroupAction) SetTimingPeriod(v Period) {
+	r.clearTiming()
+	r.TimingPeriod = &v
+}
+
+// SetTimingDuration sets RequestGroup.action.timing[x] to the Duration and clears the other types.
+func (r *RequestGroupAction) SetTimingDuration(v Duration) {
+	r.clearTiming()
+	r.TimingDuration = &v
+}
+
+// SetTimingRange sets RequestGroup.action.timing[x] to the Range and clears the other types.
+func (r *RequestGroupAction) SetTimingRange(v Range) {
+	r.clearTiming()
+	r.TimingRange = &v
+}
+
+// SetTimingTiming sets RequestGroup.action.timing[x] to the Timing and clears the other types.
+func (r *RequestGroupAction) SetTimingTiming(v Timing) {
+	r.clearTiming()
+	r.TimingTiming = &v
+}
+func (r *RequestGroupAction) clearTiming() {
+	r.TimingDateTime = nil
+	r.TimingDateTimeElement = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingDuration = nil
+	r.TimingRange = nil
+	r.TimingTiming = nil
+}
+
+// UnmarshalJSON unmarshals the RequestGroupAction and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *RequestGroupAction) UnmarshalJSON(b []byte) error {
+	type other RequestGroupAction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"RequestGroup.action.timing[x]",
+		r.TimingDateTime != nil || r.TimingDateTimeElement != nil,
+		r.TimingAge != nil,
+		r.TimingPeriod != nil,
+		r.TimingDuration != nil,
+		r.TimingRange != nil,
+		r.TimingTiming != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the RequestGroupAction with its unknown fields.
+func (r RequestGroupAction) MarshalJSON() ([]byte, error) {
+	type other RequestGroupAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the RequestGroup and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *RequestGroup) UnmarshalJSON(b []byte) error {
+	type other RequestGroup
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("RequestGroup.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("RequestGroup.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("RequestGroup", r)
+}
+
 type OtherRequestGroup RequestGroup
 
 // MarshalJSON marshals the given RequestGroup as JSON into a byte slice
 func (r RequestGroup) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherRequestGroup
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherRequestGroup: OtherRequestGroup(r),
 		ResourceType:      "RequestGroup",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalRequestGroup unmarshals a RequestGroup.
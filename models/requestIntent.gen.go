@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.029599 +0000 UTC
+// 2026-10-19 02:55:18.889159808 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // RequestIntent is documented here http://hl7.org/fhir/ValueSet/request-intent
-type RequestIntent int
+// The empty RequestIntent is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type RequestIntent string
 
 const (
-	RequestIntentProposal RequestIntent = iota
-	RequestIntentPlan
-	RequestIntentDirective
-	RequestIntentOrder
-	RequestIntentOriginalOrder
-	RequestIntentReflexOrder
-	RequestIntentFillerOrder
-	RequestIntentInstanceOrder
-	RequestIntentOption
+	RequestIntentProposal      RequestIntent = "proposal"
+	RequestIntentPlan          RequestIntent = "plan"
+	RequestIntentDirective     RequestIntent = "directive"
+	RequestIntentOrder         RequestIntent = "order"
+	RequestIntentOriginalOrder RequestIntent = "original-order"
+	RequestIntentReflexOrder   RequestIntent = "reflex-order"
+	RequestIntentFillerOrder   RequestIntent = "filler-order"
+	RequestIntentInstanceOrder RequestIntent = "instance-order"
+	RequestIntentOption        RequestIntent = "option"
 )
 
 func (code RequestIntent) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "RequestIntent"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *RequestIntent) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "proposal":
-		*code = RequestIntentProposal
-	case "plan":
-		*code = RequestIntentPlan
-	case "directive":
-		*code = RequestIntentDirective
-	case "order":
-		*code = RequestIntentOrder
-	case "original-order":
-		*code = RequestIntentOriginalOrder
-	case "reflex-order":
-		*code = RequestIntentReflexOrder
-	case "filler-order":
-		*code = RequestIntentFillerOrder
-	case "instance-order":
-		*code = RequestIntentInstanceOrder
-	case "option":
-		*code = RequestIntentOption
-	default:
-		return fmt.Errorf("unknown RequestIntent code `%s`", s)
+func (code *RequestIntent) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = RequestIntent(s)
 	return nil
 }
 func (code RequestIntent) String() string {
 	return code.Code()
 }
 func (code RequestIntent) Code() string {
+	return string(code)
+}
+func (code RequestIntent) Known() bool {
 	switch code {
-	case RequestIntentProposal:
-		return "proposal"
-	case RequestIntentPlan:
-		return "plan"
-	case RequestIntentDirective:
-		return "directive"
-	case RequestIntentOrder:
-		return "order"
-	case RequestIntentOriginalOrder:
-		return "original-order"
-	case RequestIntentReflexOrder:
-		return "reflex-order"
-	case RequestIntentFillerOrder:
-		return "filler-order"
-	case RequestIntentInstanceOrder:
-		return "instance-order"
-	case RequestIntentOption:
-		return "option"
+	case RequestIntentProposal, RequestIntentPlan, RequestIntentDirective, RequestIntentOrder, RequestIntentOriginalOrder, RequestIntentReflexOrder, RequestIntentFillerOrder, RequestIntentInstanceOrder, RequestIntentOption:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code RequestIntent) Display() string {
 	switch code {
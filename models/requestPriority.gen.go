@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.289483 +0000 UTC
+// 2026-10-19 02:55:18.89063775 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // RequestPriority is documented here http://hl7.org/fhir/ValueSet/request-priority
-type RequestPriority int
+// The empty RequestPriority is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type RequestPriority string
 
 const (
-	RequestPriorityRoutine RequestPriority = iota
-	RequestPriorityUrgent
-	RequestPriorityAsap
-	RequestPriorityStat
+	RequestPriorityRoutine RequestPriority = "routine"
+	RequestPriorityUrgent  RequestPriority = "urgent"
+	RequestPriorityAsap    RequestPriority = "asap"
+	RequestPriorityStat    RequestPriority = "stat"
 )
 
 func (code RequestPriority) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "RequestPriority"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *RequestPriority) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "routine":
-		*code = RequestPriorityRoutine
-	case "urgent":
-		*code = RequestPriorityUrgent
-	case "asap":
-		*code = RequestPriorityAsap
-	case "stat":
-		*code = RequestPriorityStat
-	default:
-		return fmt.Errorf("unknown RequestPriority code `%s`", s)
+func (code *RequestPriority) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = RequestPriority(s)
 	return nil
 }
 func (code RequestPriority) String() string {
 	return code.Code()
 }
 func (code RequestPriority) Code() string {
+	return string(code)
+}
+func (code RequestPriority) Known() bool {
 	switch code {
-	case RequestPriorityRoutine:
-		return "routine"
-	case RequestPriorityUrgent:
-		return "urgent"
-	case RequestPriorityAsap:
-		return "asap"
-	case RequestPriorityStat:
-		return "stat"
+	case RequestPriorityRoutine, RequestPriorityUrgent, RequestPriorityAsap, RequestPriorityStat:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code RequestPriority) Display() string {
 	switch code {
@@ -14,115 +14,64 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.264992 +0000 UTC
+// 2026-10-19 02:55:18.891898985 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // RequestResourceType is documented here http://hl7.org/fhir/ValueSet/request-resource-types
-type RequestResourceType int
+// The empty RequestResourceType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type RequestResourceType string
 
 const (
-	RequestResourceTypeAppointment RequestResourceType = iota
-	RequestResourceTypeAppointmentResponse
-	RequestResourceTypeCarePlan
-	RequestResourceTypeClaim
-	RequestResourceTypeCommunicationRequest
-	RequestResourceTypeContract
-	RequestResourceTypeDeviceRequest
-	RequestResourceTypeEnrollmentRequest
-	RequestResourceTypeImmunizationRecommendation
-	RequestResourceTypeMedicationRequest
-	RequestResourceTypeNutritionOrder
-	RequestResourceTypeServiceRequest
-	RequestResourceTypeSupplyRequest
-	RequestResourceTypeTask
-	RequestResourceTypeVisionPrescription
+	RequestResourceTypeAppointment                RequestResourceType = "Appointment"
+	RequestResourceTypeAppointmentResponse        RequestResourceType = "AppointmentResponse"
+	RequestResourceTypeCarePlan                   RequestResourceType = "CarePlan"
+	RequestResourceTypeClaim                      RequestResourceType = "Claim"
+	RequestResourceTypeCommunicationRequest       RequestResourceType = "CommunicationRequest"
+	RequestResourceTypeContract                   RequestResourceType = "Contract"
+	RequestResourceTypeDeviceRequest              RequestResourceType = "DeviceRequest"
+	RequestResourceTypeEnrollmentRequest          RequestResourceType = "EnrollmentRequest"
+	RequestResourceTypeImmunizationRecommendation RequestResourceType = "ImmunizationRecommendation"
+	RequestResourceTypeMedicationRequest          RequestResourceType = "MedicationRequest"
+	RequestResourceTypeNutritionOrder             RequestResourceType = "NutritionOrder"
+	RequestResourceTypeServiceRequest             RequestResourceType = "ServiceRequest"
+	RequestResourceTypeSupplyRequest              RequestResourceType = "SupplyRequest"
+	RequestResourceTypeTask                       RequestResourceType = "Task"
+	RequestResourceTypeVisionPrescription         RequestResourceType = "VisionPrescription"
 )
 
 func (code RequestResourceType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "RequestResourceType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *RequestResourceType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "Appointment":
-		*code = RequestResourceTypeAppointment
-	case "AppointmentResponse":
-		*code = RequestResourceTypeAppointmentResponse
-	case "CarePlan":
-		*code = RequestResourceTypeCarePlan
-	case "Claim":
-		*code = RequestResourceTypeClaim
-	case "CommunicationRequest":
-		*code = RequestResourceTypeCommunicationRequest
-	case "Contract":
-		*code = RequestResourceTypeContract
-	case "DeviceRequest":
-		*code = RequestResourceTypeDeviceRequest
-	case "EnrollmentRequest":
-		*code = RequestResourceTypeEnrollmentRequest
-	case "ImmunizationRecommendation":
-		*code = RequestResourceTypeImmunizationRecommendation
-	case "MedicationRequest":
-		*code = RequestResourceTypeMedicationRequest
-	case "NutritionOrder":
-		*code = RequestResourceTypeNutritionOrder
-	case "ServiceRequest":
-		*code = RequestResourceTypeServiceRequest
-	case "SupplyRequest":
-		*code = RequestResourceTypeSupplyRequest
-	case "Task":
-		*code = RequestResourceTypeTask
-	case "VisionPrescription":
-		*code = RequestResourceTypeVisionPrescription
-	default:
-		return fmt.Errorf("unknown RequestResourceType code `%s`", s)
+func (code *RequestResourceType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = RequestResourceType(s)
 	return nil
 }
 func (code RequestResourceType) String() string {
 	return code.Code()
 }
 func (code RequestResourceType) Code() string {
+	return string(code)
+}
+func (code RequestResourceType) Known() bool {
 	switch code {
-	case RequestResourceTypeAppointment:
-		return "Appointment"
-	case RequestResourceTypeAppointmentResponse:
-		return "AppointmentResponse"
-	case RequestResourceTypeCarePlan:
-		return "CarePlan"
-	case RequestResourceTypeClaim:
-		return "Claim"
-	case RequestResourceTypeCommunicationRequest:
-		return "CommunicationRequest"
-	case RequestResourceTypeContract:
-		return "Contract"
-	case RequestResourceTypeDeviceRequest:
-		return "DeviceRequest"
-	case RequestResourceTypeEnrollmentRequest:
-		return "EnrollmentRequest"
-	case RequestResourceTypeImmunizationRecommendation:
-		return "ImmunizationRecommendation"
-	case RequestResourceTypeMedicationRequest:
-		return "MedicationRequest"
-	case RequestResourceTypeNutritionOrder:
-		return "NutritionOrder"
-	case RequestResourceTypeServiceRequest:
-		return "ServiceRequest"
-	case RequestResourceTypeSupplyRequest:
-		return "SupplyRequest"
-	case RequestResourceTypeTask:
-		return "Task"
-	case RequestResourceTypeVisionPrescription:
-		return "VisionPrescription"
+	case RequestResourceTypeAppointment, RequestResourceTypeAppointmentResponse, RequestResourceTypeCarePlan, RequestResourceTypeClaim, RequestResourceTypeCommunicationRequest, RequestResourceTypeContract, RequestResourceTypeDeviceRequest, RequestResourceTypeEnrollmentRequest, RequestResourceTypeImmunizationRecommendation, RequestResourceTypeMedicationRequest, RequestResourceTypeNutritionOrder, RequestResourceTypeServiceRequest, RequestResourceTypeSupplyRequest, RequestResourceTypeTask, RequestResourceTypeVisionPrescription:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code RequestResourceType) Display() string {
 	switch code {
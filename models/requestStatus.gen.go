@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.117733 +0000 UTC
+// 2026-10-19 02:55:18.893687759 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // RequestStatus is documented here http://hl7.org/fhir/ValueSet/request-status
-type RequestStatus int
+// The empty RequestStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type RequestStatus string
 
 const (
-	RequestStatusDraft RequestStatus = iota
-	RequestStatusActive
-	RequestStatusOnHold
-	RequestStatusRevoked
-	RequestStatusCompleted
-	RequestStatusEnteredInError
-	RequestStatusUnknown
+	RequestStatusDraft          RequestStatus = "draft"
+	RequestStatusActive         RequestStatus = "active"
+	RequestStatusOnHold         RequestStatus = "on-hold"
+	RequestStatusRevoked        RequestStatus = "revoked"
+	RequestStatusCompleted      RequestStatus = "completed"
+	RequestStatusEnteredInError RequestStatus = "entered-in-error"
+	RequestStatusUnknown        RequestStatus = "unknown"
 )
 
 func (code RequestStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "RequestStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *RequestStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "draft":
-		*code = RequestStatusDraft
-	case "active":
-		*code = RequestStatusActive
-	case "on-hold":
-		*code = RequestStatusOnHold
-	case "revoked":
-		*code = RequestStatusRevoked
-	case "completed":
-		*code = RequestStatusCompleted
-	case "entered-in-error":
-		*code = RequestStatusEnteredInError
-	case "unknown":
-		*code = RequestStatusUnknown
-	default:
-		return fmt.Errorf("unknown RequestStatus code `%s`", s)
+func (code *RequestStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = RequestStatus(s)
 	return nil
 }
 func (code RequestStatus) String() string {
 	return code.Code()
 }
 func (code RequestStatus) Code() string {
+	return string(code)
+}
+func (code RequestStatus) Known() bool {
 	switch code {
-	case RequestStatusDraft:
-		return "draft"
-	case RequestStatusActive:
-		return "active"
-	case RequestStatusOnHold:
-		return "on-hold"
-	case RequestStatusRevoked:
-		return "revoked"
-	case RequestStatusCompleted:
-		return "completed"
-	case RequestStatusEnteredInError:
-		return "entered-in-error"
-	case RequestStatusUnknown:
-		return "unknown"
+	case RequestStatusDraft, RequestStatusActive, RequestStatusOnHold, RequestStatusRevoked, RequestStatusCompleted, RequestStatusEnteredInError, RequestStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code RequestStatus) Display() string {
 	switch code {
@@ -22,58 +22,136 @@ import "encoding/json"
 
 // ResearchDefinition is documented here http://hl7.org/fhir/StructureDefinition/ResearchDefinition
 type ResearchDefinition struct {
-	ID                  *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL                 *string           `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier          []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version             *string           `bson:"version,omitempty" json:"version,omitempty"`
-	Name                *string           `bson:"name,omitempty" json:"name,omitempty"`
-	Title               *string           `bson:"title,omitempty" json:"title,omitempty"`
-	ShortTitle          *string           `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
-	Subtitle            *string           `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status              PublicationStatus `bson:"status" json:"status"`
-	Experimental        *bool             `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date                *DateTime         `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher           *string           `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact             []ContactDetail   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description         *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Comment             []string          `bson:"comment,omitempty" json:"comment,omitempty"`
-	UseContext          []UsageContext    `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction        []CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose             *string           `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Usage               *string           `bson:"usage,omitempty" json:"usage,omitempty"`
-	Copyright           *string           `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate        *DateTime         `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate      *DateTime         `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod     *Period           `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic               []CodeableConcept `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author              []ContactDetail   `bson:"author,omitempty" json:"author,omitempty"`
-	Editor              []ContactDetail   `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer            []ContactDetail   `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser            []ContactDetail   `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact     []RelatedArtifact `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Library             []string          `bson:"library,omitempty" json:"library,omitempty"`
-	Population          Reference         `bson:"population" json:"population"`
-	Exposure            *Reference        `bson:"exposure,omitempty" json:"exposure,omitempty"`
-	ExposureAlternative *Reference        `bson:"exposureAlternative,omitempty" json:"exposureAlternative,omitempty"`
-	Outcome             *Reference        `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ID                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                    *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement             *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                  *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	ShortTitle             *string                    `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
+	ShortTitleElement      *Element                   `bson:"_shortTitle,omitempty" json:"_shortTitle,omitempty"`
+	Subtitle               *string                    `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element                   `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                 PublicationStatus          `bson:"status" json:"status"`
+	StatusElement          *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental           *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectCodeableConcept *CodeableConcept           `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference       *Reference                 `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Date                   *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher              *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description            *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Comment                []*string                  `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement         []*Element                 `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UseContext             []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction           []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Usage                  *string                    `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element                   `bson:"_usage,omitempty" json:"_usage,omitempty"`
+	Copyright              *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate           *DateTime                  `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element                   `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate         *DateTime                  `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element                   `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod        *Period                    `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                  []CodeableConcept          `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                 []ContactDetail            `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                 []ContactDetail            `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer               []ContactDetail            `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser               []ContactDetail            `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact        []RelatedArtifact          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Library                []*string                  `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement         []*Element                 `bson:"_library,omitempty" json:"_library,omitempty"`
+	Population             Reference                  `bson:"population" json:"population"`
+	Exposure               *Reference                 `bson:"exposure,omitempty" json:"exposure,omitempty"`
+	ExposureAlternative    *Reference                 `bson:"exposureAlternative,omitempty" json:"exposureAlternative,omitempty"`
+	Outcome                *Reference                 `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	UnknownFields          map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Subject returns ResearchDefinition.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ResearchDefinition) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets ResearchDefinition.subject[x] to the CodeableConcept and clears the other types.
+func (r *ResearchDefinition) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets ResearchDefinition.subject[x] to the Reference and clears the other types.
+func (r *ResearchDefinition) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *ResearchDefinition) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// UnmarshalJSON unmarshals the ResearchDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ResearchDefinition) UnmarshalJSON(b []byte) error {
+	type other ResearchDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ResearchDefinition.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ResearchDefinition.comment", len(r.Comment), len(r.CommentElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ResearchDefinition.library", len(r.Library), len(r.LibraryElement)); err != nil {
+		return err
+	}
+	return checkCodes("ResearchDefinition", r)
+}
+
 type OtherResearchDefinition ResearchDefinition
 
 // MarshalJSON marshals the given ResearchDefinition as JSON into a byte slice
 func (r ResearchDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherResearchDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherResearchDefinition: OtherResearchDefinition(r),
 		ResourceType:            "ResearchDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalResearchDefinition unmarshals a ResearchDefinition.
@@ -22,71 +22,356 @@ import "encoding/json"
 
 // ResearchElementDefinition is documented here http://hl7.org/fhir/StructureDefinition/ResearchElementDefinition
 type ResearchElementDefinition struct {
-	ID                *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                                   `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                                   `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                                   `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                                   `bson:"title,omitempty" json:"title,omitempty"`
-	ShortTitle        *string                                   `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
-	Subtitle          *string                                   `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status            PublicationStatus                         `bson:"status" json:"status"`
-	Experimental      *bool                                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                                 `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                           `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                                   `bson:"description,omitempty" json:"description,omitempty"`
-	Comment           []string                                  `bson:"comment,omitempty" json:"comment,omitempty"`
-	UseContext        []UsageContext                            `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Usage             *string                                   `bson:"usage,omitempty" json:"usage,omitempty"`
-	Copyright         *string                                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime                                 `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime                                 `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                                   `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept                         `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail                           `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail                           `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail                           `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail                           `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact                         `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Library           []string                                  `bson:"library,omitempty" json:"library,omitempty"`
-	Type              ResearchElementType                       `bson:"type" json:"type"`
-	VariableType      *EvidenceVariableType                     `bson:"variableType,omitempty" json:"variableType,omitempty"`
-	Characteristic    []ResearchElementDefinitionCharacteristic `bson:"characteristic" json:"characteristic"`
+	ID                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                   *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              ContainedResources                        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                    *string                                   `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement             *Element                                  `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier             []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                *string                                   `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element                                  `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                   *string                                   `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                                  `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                  *string                                   `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element                                  `bson:"_title,omitempty" json:"_title,omitempty"`
+	ShortTitle             *string                                   `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
+	ShortTitleElement      *Element                                  `bson:"_shortTitle,omitempty" json:"_shortTitle,omitempty"`
+	Subtitle               *string                                   `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element                                  `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                 PublicationStatus                         `bson:"status" json:"status"`
+	StatusElement          *Element                                  `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental           *bool                                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element                                  `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectCodeableConcept *CodeableConcept                          `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference       *Reference                                `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Date                   *DateTime                                 `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                                  `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher              *string                                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element                                  `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                []ContactDetail                           `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description            *string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                                  `bson:"_description,omitempty" json:"_description,omitempty"`
+	Comment                []*string                                 `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement         []*Element                                `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UseContext             []UsageContext                            `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction           []CodeableConcept                         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                *string                                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element                                  `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Usage                  *string                                   `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element                                  `bson:"_usage,omitempty" json:"_usage,omitempty"`
+	Copyright              *string                                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element                                  `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate           *DateTime                                 `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element                                  `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate         *DateTime                                 `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element                                  `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod        *Period                                   `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                  []CodeableConcept                         `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                 []ContactDetail                           `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                 []ContactDetail                           `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer               []ContactDetail                           `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser               []ContactDetail                           `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact        []RelatedArtifact                         `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Library                []*string                                 `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement         []*Element                                `bson:"_library,omitempty" json:"_library,omitempty"`
+	Type                   ResearchElementType                       `bson:"type" json:"type"`
+	TypeElement            *Element                                  `bson:"_type,omitempty" json:"_type,omitempty"`
+	VariableType           *EvidenceVariableType                     `bson:"variableType,omitempty" json:"variableType,omitempty"`
+	VariableTypeElement    *Element                                  `bson:"_variableType,omitempty" json:"_variableType,omitempty"`
+	Characteristic         []ResearchElementDefinitionCharacteristic `bson:"characteristic" json:"characteristic"`
+	UnknownFields          map[string]json.RawMessage                `bson:"-" json:"-"`
 }
 type ResearchElementDefinitionCharacteristic struct {
-	ID                                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension                 []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	UsageContext                      []UsageContext   `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
-	Exclude                           *bool            `bson:"exclude,omitempty" json:"exclude,omitempty"`
-	UnitOfMeasure                     *CodeableConcept `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
-	StudyEffectiveDescription         *string          `bson:"studyEffectiveDescription,omitempty" json:"studyEffectiveDescription,omitempty"`
-	StudyEffectiveTimeFromStart       *Duration        `bson:"studyEffectiveTimeFromStart,omitempty" json:"studyEffectiveTimeFromStart,omitempty"`
-	StudyEffectiveGroupMeasure        *GroupMeasure    `bson:"studyEffectiveGroupMeasure,omitempty" json:"studyEffectiveGroupMeasure,omitempty"`
-	ParticipantEffectiveDescription   *string          `bson:"participantEffectiveDescription,omitempty" json:"participantEffectiveDescription,omitempty"`
-	ParticipantEffectiveTimeFromStart *Duration        `bson:"participantEffectiveTimeFromStart,omitempty" json:"participantEffectiveTimeFromStart,omitempty"`
-	ParticipantEffectiveGroupMeasure  *GroupMeasure    `bson:"participantEffectiveGroupMeasure,omitempty" json:"participantEffectiveGroupMeasure,omitempty"`
+	ID                                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DefinitionCodeableConcept               *CodeableConcept           `bson:"definitionCodeableConcept,omitempty" json:"definitionCodeableConcept,omitempty"`
+	DefinitionCanonical                     *string                    `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement              *Element                   `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	DefinitionExpression                    *Expression                `bson:"definitionExpression,omitempty" json:"definitionExpression,omitempty"`
+	DefinitionDataRequirement               *DataRequirement           `bson:"definitionDataRequirement,omitempty" json:"definitionDataRequirement,omitempty"`
+	UsageContext                            []UsageContext             `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
+	Exclude                                 *bool                      `bson:"exclude,omitempty" json:"exclude,omitempty"`
+	ExcludeElement                          *Element                   `bson:"_exclude,omitempty" json:"_exclude,omitempty"`
+	UnitOfMeasure                           *CodeableConcept           `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
+	StudyEffectiveDescription               *string                    `bson:"studyEffectiveDescription,omitempty" json:"studyEffectiveDescription,omitempty"`
+	StudyEffectiveDescriptionElement        *Element                   `bson:"_studyEffectiveDescription,omitempty" json:"_studyEffectiveDescription,omitempty"`
+	StudyEffectiveDateTime                  *DateTime                  `bson:"studyEffectiveDateTime,omitempty" json:"studyEffectiveDateTime,omitempty"`
+	StudyEffectiveDateTimeElement           *Element                   `bson:"_studyEffectiveDateTime,omitempty" json:"_studyEffectiveDateTime,omitempty"`
+	StudyEffectivePeriod                    *Period                    `bson:"studyEffectivePeriod,omitempty" json:"studyEffectivePeriod,omitempty"`
+	StudyEffectiveDuration                  *Duration                  `bson:"studyEffectiveDuration,omitempty" json:"studyEffectiveDuration,omitempty"`
+	StudyEffectiveTiming                    *Timing                    `bson:"studyEffectiveTiming,omitempty" json:"studyEffectiveTiming,omitempty"`
+	StudyEffectiveTimeFromStart             *Duration                  `bson:"studyEffectiveTimeFromStart,omitempty" json:"studyEffectiveTimeFromStart,omitempty"`
+	StudyEffectiveGroupMeasure              *GroupMeasure              `bson:"studyEffectiveGroupMeasure,omitempty" json:"studyEffectiveGroupMeasure,omitempty"`
+	StudyEffectiveGroupMeasureElement       *Element                   `bson:"_studyEffectiveGroupMeasure,omitempty" json:"_studyEffectiveGroupMeasure,omitempty"`
+	ParticipantEffectiveDescription         *string                    `bson:"participantEffectiveDescription,omitempty" json:"participantEffectiveDescription,omitempty"`
+	ParticipantEffectiveDescriptionElement  *Element                   `bson:"_participantEffectiveDescription,omitempty" json:"_participantEffectiveDescription,omitempty"`
+	ParticipantEffectiveDateTime            *DateTime                  `bson:"participantEffectiveDateTime,omitempty" json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTimeElement     *Element                   `bson:"_participantEffectiveDateTime,omitempty" json:"_participantEffectiveDateTime,omitempty"`
+	ParticipantEffectivePeriod              *Period                    `bson:"participantEffectivePeriod,omitempty" json:"participantEffectivePeriod,omitempty"`
+	ParticipantEffectiveDuration            *Duration                  `bson:"participantEffectiveDuration,omitempty" json:"participantEffectiveDuration,omitempty"`
+	ParticipantEffectiveTiming              *Timing                    `bson:"participantEffectiveTiming,omitempty" json:"participantEffectiveTiming,omitempty"`
+	ParticipantEffectiveTimeFromStart       *Duration                  `bson:"participantEffectiveTimeFromStart,omitempty" json:"participantEffectiveTimeFromStart,omitempty"`
+	ParticipantEffectiveGroupMeasure        *GroupMeasure              `bson:"participantEffectiveGroupMeasure,omitempty" json:"participantEffectiveGroupMeasure,omitempty"`
+	ParticipantEffectiveGroupMeasureElement *Element                   `bson:"_participantEffectiveGroupMeasure,omitempty" json:"_participantEffectiveGroupMeasure,omitempty"`
+	UnknownFields                           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Definition returns ResearchElementDefinition.characteristic.definition[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ResearchElementDefinitionCharacteristic) Definition() interface{} {
+	switch {
+	case r.DefinitionCodeableConcept != nil:
+		return r.DefinitionCodeableConcept
+	case r.DefinitionCanonical != nil:
+		return r.DefinitionCanonical
+	case r.DefinitionExpression != nil:
+		return r.DefinitionExpression
+	case r.DefinitionDataRequirement != nil:
+		return r.DefinitionDataRequirement
+	}
+	return nil
+}
+
+// SetDefinitionCodeableConcept sets ResearchElementDefinition.characteristic.definition[x] to the CodeableConcept and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionCodeableConcept(v CodeableConcept) {
+	r.clearDefinition()
+	r.DefinitionCodeableConcept = &v
+}
+
+// SetDefinitionCanonical sets ResearchElementDefinition.characteristic.definition[x] to the canonical and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionCanonical(v string) {
+	r.clearDefinition()
+	r.DefinitionCanonical = &v
+}
+
+// SetDefinitionExpression sets ResearchElementDefinition.characteristic.definition[x] to the Expression and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionExpression(v Expression) {
+	r.clearDefinition()
+	r.DefinitionExpression = &v
+}
+
+// SetDefinitionDataRequirement sets ResearchElementDefinition.characteristic.definition[x] to the DataRequirement and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionDataRequirement(v DataRequirement) {
+	r.clearDefinition()
+	r.DefinitionDataRequirement = &v
+}
+func (r *ResearchElementDefinitionCharacteristic) clearDefinition() {
+	r.DefinitionCodeableConcept = nil
+	r.DefinitionCanonical = nil
+	r.DefinitionCanonicalElement = nil
+	r.DefinitionExpression = nil
+	r.DefinitionDataRequirement = nil
+}
+
+// StudyEffective returns ResearchElementDefinition.characteristic.studyEffective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ResearchElementDefinitionCharacteristic) StudyEffective() interface{} {
+	switch {
+	case r.StudyEffectiveDateTime != nil:
+		return r.StudyEffectiveDateTime
+	case r.StudyEffectivePeriod != nil:
+		return r.StudyEffectivePeriod
+	case r.StudyEffectiveDuration != nil:
+		return r.StudyEffectiveDuration
+	case r.StudyEffectiveTiming != nil:
+		return r.StudyEffectiveTiming
+	}
+	return nil
+}
+
+// SetStudyEffectiveDateTime sets ResearchElementDefinition.characteristic.studyEffective[x] to the dateTime and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectiveDateTime(v DateTime) {
+	r.clearStudyEffective()
+	r.StudyEffectiveDateTime = &v
+}
+
+// SetStudyEffectivePeriod sets ResearchElementDefinition.characteristic.studyEffective[x] to the Period and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectivePeriod(v Period) {
+	r.clearStudyEffective()
+	r.StudyEffectivePeriod = &v
+}
+
+// SetStudyEffectiveDuration sets ResearchElementDefinition.characteristic.studyEffective[x] to the Duration and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectiveDuration(v Duration) {
+	r.clearStudyEffective()
+	r.StudyEffectiveDuration = &v
+}
+
+// SetStudyEffectiveTiming sets ResearchElementDefinition.characteristic.studyEffective[x] to the Timing and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectiveTiming(v Timing) {
+	r.clearStudyEffective()
+	r.StudyEffectiveTiming = &v
+}
+func (r *ResearchElementDefinitionCharacteristic) clearStudyEffective() {
+	r.StudyEffectiveDateTime = nil
+	r.StudyEffectiveDateTimeElement = nil
+	r.StudyEffectivePeriod = nil
+	r.StudyEffectiveDuration = nil
+	r.StudyEffectiveTiming = nil
+}
+
+// ParticipantEffective returns ResearchElementDefinition.characteristic.participantEffective[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ResearchElementDefinitionCharacteristic) ParticipantEffective() interface{} {
+	switch {
+	case r.ParticipantEffectiveDateTime != nil:
+		return r.ParticipantEffectiveDateTime
+	case r.ParticipantEffectivePeriod != nil:
+		return r.ParticipantEffectivePeriod
+	case r.ParticipantEffectiveDuration != nil:
+		return r.ParticipantEffectiveDuration
+	case r.ParticipantEffectiveTiming != nil:
+		return r.ParticipantEffectiveTiming
+	}
+	return nil
+}
+
+// SetParticipantEffectiveDateTime sets ResearchElementDefinition.characteristic.participantEffective[x] to the dateTime and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectiveDateTime(v DateTime) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectiveDateTime = &v
+}
+
+// SetParticipantEffectivePeriod sets ResearchElementDefinition.characteristic.participantEffective[x] to the Period and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectivePeriod(v Period) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectivePeriod = &v
+}
+
+// SetParticipantEffectiveDuration sets ResearchElementDefinition.characteristic.participantEffective[x] to the Duration and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectiveDuration(v Duration) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectiveDuration = &v
+}
+
+// SetParticipantEffectiveTiming sets ResearchElementDefinition.characteristic.participantEffective[x] to the Timing and clears the other types.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectiveTiming(v Timing) {
+	r.clearParticipantEffective()
+	r.ParticipantEffectiveTiming = &v
+}
+func (r *ResearchElementDefinitionCharacteristic) clearParticipantEffective() {
+	r.ParticipantEffectiveDateTime = nil
+	r.ParticipantEffectiveDateTimeElement = nil
+	r.ParticipantEffectivePeriod = nil
+	r.ParticipantEffectiveDuration = nil
+	r.ParticipantEffectiveTiming = nil
+}
+
+// UnmarshalJSON unmarshals the ResearchElementDefinitionCharacteristic and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ResearchElementDefinitionCharacteristic) UnmarshalJSON(b []byte) error {
+	type other ResearchElementDefinitionCharacteristic
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ResearchElementDefinition.characteristic.definition[x]",
+		r.DefinitionCodeableConcept != nil,
+		r.DefinitionCanonical != nil || r.DefinitionCanonicalElement != nil,
+		r.DefinitionExpression != nil,
+		r.DefinitionDataRequirement != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ResearchElementDefinition.characteristic.studyEffective[x]",
+		r.StudyEffectiveDateTime != nil || r.StudyEffectiveDateTimeElement != nil,
+		r.StudyEffectivePeriod != nil,
+		r.StudyEffectiveDuration != nil,
+		r.StudyEffectiveTiming != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ResearchElementDefinition.characteristic.participantEffective[x]",
+		r.ParticipantEffectiveDateTime != nil || r.ParticipantEffectiveDateTimeElement != nil,
+		r.ParticipantEffectivePeriod != nil,
+		r.ParticipantEffectiveDuration != nil,
+		r.ParticipantEffectiveTiming != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ResearchElementDefinitionCharacteristic with its unknown fields.
+func (r ResearchElementDefinitionCharacteristic) MarshalJSON() ([]byte, error) {
+	type other ResearchElementDefinitionCharacteristic
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Subject returns ResearchElementDefinition.subject[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *ResearchElementDefinition) Subject() interface{} {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SetSubjectCodeableConcept sets ResearchElementDefinition.subject[x] to the CodeableConcept and clears the other types.
+func (r *ResearchElementDefinition) SetSubjectCodeableConcept(v CodeableConcept) {
+	r.clearSubject()
+	r.SubjectCodeableConcept = &v
+}
+
+// SetSubjectReference sets ResearchElementDefinition.subject[x] to the Reference and clears the other types.
+func (r *ResearchElementDefinition) SetSubjectReference(v Reference) {
+	r.clearSubject()
+	r.SubjectReference = &v
+}
+func (r *ResearchElementDefinition) clearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// UnmarshalJSON unmarshals the ResearchElementDefinition and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ResearchElementDefinition) UnmarshalJSON(b []byte) error {
+	type other ResearchElementDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ResearchElementDefinition.subject[x]",
+		r.SubjectCodeableConcept != nil,
+		r.SubjectReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ResearchElementDefinition.comment", len(r.Comment), len(r.CommentElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ResearchElementDefinition.library", len(r.Library), len(r.LibraryElement)); err != nil {
+		return err
+	}
+	return checkCodes("ResearchElementDefinition", r)
+}
+
 type OtherResearchElementDefinition ResearchElementDefinition
 
 // MarshalJSON marshals the given ResearchElementDefinition as JSON into a byte slice
 func (r ResearchElementDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherResearchElementDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherResearchElementDefinition: OtherResearchElementDefinition(r),
 		ResourceType:                   "ResearchElementDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalResearchElementDefinition unmarshals a ResearchElementDefinition.
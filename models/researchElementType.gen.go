@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.092939 +0000 UTC
+// 2026-10-19 02:55:18.89498732 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ResearchElementType is documented here http://hl7.org/fhir/ValueSet/research-element-type
-type ResearchElementType int
+// The empty ResearchElementType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ResearchElementType string
 
 const (
-	ResearchElementTypePopulation ResearchElementType = iota
-	ResearchElementTypeExposure
-	ResearchElementTypeOutcome
+	ResearchElementTypePopulation ResearchElementType = "population"
+	ResearchElementTypeExposure   ResearchElementType = "exposure"
+	ResearchElementTypeOutcome    ResearchElementType = "outcome"
 )
 
 func (code ResearchElementType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ResearchElementType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ResearchElementType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "population":
-		*code = ResearchElementTypePopulation
-	case "exposure":
-		*code = ResearchElementTypeExposure
-	case "outcome":
-		*code = ResearchElementTypeOutcome
-	default:
-		return fmt.Errorf("unknown ResearchElementType code `%s`", s)
+func (code *ResearchElementType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ResearchElementType(s)
 	return nil
 }
 func (code ResearchElementType) String() string {
 	return code.Code()
 }
 func (code ResearchElementType) Code() string {
+	return string(code)
+}
+func (code ResearchElementType) Known() bool {
 	switch code {
-	case ResearchElementTypePopulation:
-		return "population"
-	case ResearchElementTypeExposure:
-		return "exposure"
-	case ResearchElementTypeOutcome:
-		return "outcome"
+	case ResearchElementTypePopulation, ResearchElementTypeExposure, ResearchElementTypeOutcome:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ResearchElementType) Display() string {
 	switch code {
@@ -22,64 +22,111 @@ import "encoding/json"
 
 // ResearchStudy is documented here http://hl7.org/fhir/StructureDefinition/ResearchStudy
 type ResearchStudy struct {
-	ID                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Title                 *string                  `bson:"title,omitempty" json:"title,omitempty"`
-	Protocol              []Reference              `bson:"protocol,omitempty" json:"protocol,omitempty"`
-	PartOf                []Reference              `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                ResearchStudyStatus      `bson:"status" json:"status"`
-	PrimaryPurposeType    *CodeableConcept         `bson:"primaryPurposeType,omitempty" json:"primaryPurposeType,omitempty"`
-	Phase                 *CodeableConcept         `bson:"phase,omitempty" json:"phase,omitempty"`
-	Category              []CodeableConcept        `bson:"category,omitempty" json:"category,omitempty"`
-	Focus                 []CodeableConcept        `bson:"focus,omitempty" json:"focus,omitempty"`
-	Condition             []CodeableConcept        `bson:"condition,omitempty" json:"condition,omitempty"`
-	Contact               []ContactDetail          `bson:"contact,omitempty" json:"contact,omitempty"`
-	RelatedArtifact       []RelatedArtifact        `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Keyword               []CodeableConcept        `bson:"keyword,omitempty" json:"keyword,omitempty"`
-	Location              []CodeableConcept        `bson:"location,omitempty" json:"location,omitempty"`
-	Description           *string                  `bson:"description,omitempty" json:"description,omitempty"`
-	Enrollment            []Reference              `bson:"enrollment,omitempty" json:"enrollment,omitempty"`
-	Period                *Period                  `bson:"period,omitempty" json:"period,omitempty"`
-	Sponsor               *Reference               `bson:"sponsor,omitempty" json:"sponsor,omitempty"`
-	PrincipalInvestigator *Reference               `bson:"principalInvestigator,omitempty" json:"principalInvestigator,omitempty"`
-	Site                  []Reference              `bson:"site,omitempty" json:"site,omitempty"`
-	ReasonStopped         *CodeableConcept         `bson:"reasonStopped,omitempty" json:"reasonStopped,omitempty"`
-	Note                  []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
-	Arm                   []ResearchStudyArm       `bson:"arm,omitempty" json:"arm,omitempty"`
-	Objective             []ResearchStudyObjective `bson:"objective,omitempty" json:"objective,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier            []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Title                 *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Protocol              []Reference                `bson:"protocol,omitempty" json:"protocol,omitempty"`
+	PartOf                []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                ResearchStudyStatus        `bson:"status" json:"status"`
+	StatusElement         *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	PrimaryPurposeType    *CodeableConcept           `bson:"primaryPurposeType,omitempty" json:"primaryPurposeType,omitempty"`
+	Phase                 *CodeableConcept           `bson:"phase,omitempty" json:"phase,omitempty"`
+	Category              []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Focus                 []CodeableConcept          `bson:"focus,omitempty" json:"focus,omitempty"`
+	Condition             []CodeableConcept          `bson:"condition,omitempty" json:"condition,omitempty"`
+	Contact               []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	RelatedArtifact       []RelatedArtifact          `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Keyword               []CodeableConcept          `bson:"keyword,omitempty" json:"keyword,omitempty"`
+	Location              []CodeableConcept          `bson:"location,omitempty" json:"location,omitempty"`
+	Description           *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Enrollment            []Reference                `bson:"enrollment,omitempty" json:"enrollment,omitempty"`
+	Period                *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Sponsor               *Reference                 `bson:"sponsor,omitempty" json:"sponsor,omitempty"`
+	PrincipalInvestigator *Reference                 `bson:"principalInvestigator,omitempty" json:"principalInvestigator,omitempty"`
+	Site                  []Reference                `bson:"site,omitempty" json:"site,omitempty"`
+	ReasonStopped         *CodeableConcept           `bson:"reasonStopped,omitempty" json:"reasonStopped,omitempty"`
+	Note                  []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Arm                   []ResearchStudyArm         `bson:"arm,omitempty" json:"arm,omitempty"`
+	Objective             []ResearchStudyObjective   `bson:"objective,omitempty" json:"objective,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ResearchStudyArm struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string           `bson:"name" json:"name"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               string                     `bson:"name" json:"name"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type               *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ResearchStudyArm with its unknown fields.
+func (r ResearchStudyArm) MarshalJSON() ([]byte, error) {
+	type other ResearchStudyArm
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ResearchStudyObjective struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string          `bson:"name,omitempty" json:"name,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ResearchStudyObjective with its unknown fields.
+func (r ResearchStudyObjective) MarshalJSON() ([]byte, error) {
+	type other ResearchStudyObjective
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ResearchStudy and fails with CodeError when a coded element has an unknown code.
+func (r *ResearchStudy) UnmarshalJSON(b []byte) error {
+	type other ResearchStudy
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("ResearchStudy", r)
 }
+
 type OtherResearchStudy ResearchStudy
 
 // MarshalJSON marshals the given ResearchStudy as JSON into a byte slice
 func (r ResearchStudy) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherResearchStudy
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherResearchStudy: OtherResearchStudy(r),
 		ResourceType:       "ResearchStudy",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalResearchStudy unmarshals a ResearchStudy.
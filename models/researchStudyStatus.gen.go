@@ -14,95 +14,60 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.109227 +0000 UTC
+// 2026-10-19 02:55:18.89601951 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ResearchStudyStatus is documented here http://hl7.org/fhir/ValueSet/research-study-status
-type ResearchStudyStatus int
+// The empty ResearchStudyStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ResearchStudyStatus string
 
 const (
-	ResearchStudyStatusActive ResearchStudyStatus = iota
-	ResearchStudyStatusAdministrativelyCompleted
-	ResearchStudyStatusApproved
-	ResearchStudyStatusClosedToAccrual
-	ResearchStudyStatusClosedToAccrualAndIntervention
-	ResearchStudyStatusCompleted
-	ResearchStudyStatusDisapproved
-	ResearchStudyStatusInReview
-	ResearchStudyStatusTemporarilyClosedToAccrual
-	ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention
-	ResearchStudyStatusWithdrawn
+	ResearchStudyStatusActive                                    ResearchStudyStatus = "active"
+	ResearchStudyStatusAdministrativelyCompleted                 ResearchStudyStatus = "administratively-completed"
+	ResearchStudyStatusApproved                                  ResearchStudyStatus = "approved"
+	ResearchStudyStatusClosedToAccrual                           ResearchStudyStatus = "closed-to-accrual"
+	ResearchStudyStatusClosedToAccrualAndIntervention            ResearchStudyStatus = "closed-to-accrual-and-intervention"
+	ResearchStudyStatusCompleted                                 ResearchStudyStatus = "completed"
+	ResearchStudyStatusDisapproved                               ResearchStudyStatus = "disapproved"
+	ResearchStudyStatusInReview                                  ResearchStudyStatus = "in-review"
+	ResearchStudyStatusTemporarilyClosedToAccrual                ResearchStudyStatus = "temporarily-closed-to-accrual"
+	ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention ResearchStudyStatus = "temporarily-closed-to-accrual-and-intervention"
+	ResearchStudyStatusWithdrawn                                 ResearchStudyStatus = "withdrawn"
 )
 
 func (code ResearchStudyStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ResearchStudyStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ResearchStudyStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "active":
-		*code = ResearchStudyStatusActive
-	case "administratively-completed":
-		*code = ResearchStudyStatusAdministrativelyCompleted
-	case "approved":
-		*code = ResearchStudyStatusApproved
-	case "closed-to-accrual":
-		*code = ResearchStudyStatusClosedToAccrual
-	case "closed-to-accrual-and-intervention":
-		*code = ResearchStudyStatusClosedToAccrualAndIntervention
-	case "completed":
-		*code = ResearchStudyStatusCompleted
-	case "disapproved":
-		*code = ResearchStudyStatusDisapproved
-	case "in-review":
-		*code = ResearchStudyStatusInReview
-	case "temporarily-closed-to-accrual":
-		*code = ResearchStudyStatusTemporarilyClosedToAccrual
-	case "temporarily-closed-to-accrual-and-intervention":
-		*code = ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention
-	case "withdrawn":
-		*code = ResearchStudyStatusWithdrawn
-	default:
-		return fmt.Errorf("unknown ResearchStudyStatus code `%s`", s)
+func (code *ResearchStudyStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ResearchStudyStatus(s)
 	return nil
 }
 func (code ResearchStudyStatus) String() string {
 	return code.Code()
 }
 func (code ResearchStudyStatus) Code() string {
+	return string(code)
+}
+func (code ResearchStudyStatus) Known() bool {
 	switch code {
-	case ResearchStudyStatusActive:
-		return "active"
-	case ResearchStudyStatusAdministrativelyCompleted:
-		return "administratively-completed"
-	case ResearchStudyStatusApproved:
-		return "approved"
-	case ResearchStudyStatusClosedToAccrual:
-		return "closed-to-accrual"
-	case ResearchStudyStatusClosedToAccrualAndIntervention:
-		return "closed-to-accrual-and-intervention"
-	case ResearchStudyStatusCompleted:
-		return "completed"
-	case ResearchStudyStatusDisapproved:
-		return "disapproved"
-	case ResearchStudyStatusInReview:
-		return "in-review"
-	case ResearchStudyStatusTemporarilyClosedToAccrual:
-		return "temporarily-closed-to-accrual"
-	case ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention:
-		return "temporarily-closed-to-accrual-and-intervention"
-	case ResearchStudyStatusWithdrawn:
-		return "withdrawn"
+	case ResearchStudyStatusActive, ResearchStudyStatusAdministrativelyCompleted, ResearchStudyStatusApproved, ResearchStudyStatusClosedToAccrual, ResearchStudyStatusClosedToAccrualAndIntervention, ResearchStudyStatusCompleted, ResearchStudyStatusDisapproved, ResearchStudyStatusInReview, ResearchStudyStatusTemporarilyClosedToAccrual, ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention, ResearchStudyStatusWithdrawn:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ResearchStudyStatus) Display() string {
 	switch code {
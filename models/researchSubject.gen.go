@@ -22,33 +22,54 @@ import "encoding/json"
 
 // ResearchSubject is documented here http://hl7.org/fhir/StructureDefinition/ResearchSubject
 type ResearchSubject struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ResearchSubjectStatus `bson:"status" json:"status"`
-	Period            *Period               `bson:"period,omitempty" json:"period,omitempty"`
-	Study             Reference             `bson:"study" json:"study"`
-	Individual        Reference             `bson:"individual" json:"individual"`
-	AssignedArm       *string               `bson:"assignedArm,omitempty" json:"assignedArm,omitempty"`
-	ActualArm         *string               `bson:"actualArm,omitempty" json:"actualArm,omitempty"`
-	Consent           *Reference            `bson:"consent,omitempty" json:"consent,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               ResearchSubjectStatus      `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period               *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Study                Reference                  `bson:"study" json:"study"`
+	Individual           Reference                  `bson:"individual" json:"individual"`
+	AssignedArm          *string                    `bson:"assignedArm,omitempty" json:"assignedArm,omitempty"`
+	AssignedArmElement   *Element                   `bson:"_assignedArm,omitempty" json:"_assignedArm,omitempty"`
+	ActualArm            *string                    `bson:"actualArm,omitempty" json:"actualArm,omitempty"`
+	ActualArmElement     *Element                   `bson:"_actualArm,omitempty" json:"_actualArm,omitempty"`
+	Consent              *Reference                 `bson:"consent,omitempty" json:"consent,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the ResearchSubject and fails with CodeError when a coded element has an unknown code.
+func (r *ResearchSubject) UnmarshalJSON(b []byte) error {
+	type other ResearchSubject
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("ResearchSubject", r)
+}
+
 type OtherResearchSubject ResearchSubject
 
 // MarshalJSON marshals the given ResearchSubject as JSON into a byte slice
 func (r ResearchSubject) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherResearchSubject
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherResearchSubject: OtherResearchSubject(r),
 		ResourceType:         "ResearchSubject",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalResearchSubject unmarshals a ResearchSubject.
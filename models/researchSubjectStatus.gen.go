@@ -14,105 +14,62 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.267265 +0000 UTC
+// 2026-10-19 02:55:18.89769406 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ResearchSubjectStatus is documented here http://hl7.org/fhir/ValueSet/research-subject-status
-type ResearchSubjectStatus int
+// The empty ResearchSubjectStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ResearchSubjectStatus string
 
 const (
-	ResearchSubjectStatusCandidate ResearchSubjectStatus = iota
-	ResearchSubjectStatusEligible
-	ResearchSubjectStatusFollowUp
-	ResearchSubjectStatusIneligible
-	ResearchSubjectStatusNotRegistered
-	ResearchSubjectStatusOffStudy
-	ResearchSubjectStatusOnStudy
-	ResearchSubjectStatusOnStudyIntervention
-	ResearchSubjectStatusOnStudyObservation
-	ResearchSubjectStatusPendingOnStudy
-	ResearchSubjectStatusPotentialCandidate
-	ResearchSubjectStatusScreening
-	ResearchSubjectStatusWithdrawn
+	ResearchSubjectStatusCandidate           ResearchSubjectStatus = "candidate"
+	ResearchSubjectStatusEligible            ResearchSubjectStatus = "eligible"
+	ResearchSubjectStatusFollowUp            ResearchSubjectStatus = "follow-up"
+	ResearchSubjectStatusIneligible          ResearchSubjectStatus = "ineligible"
+	ResearchSubjectStatusNotRegistered       ResearchSubjectStatus = "not-registered"
+	ResearchSubjectStatusOffStudy            ResearchSubjectStatus = "off-study"
+	ResearchSubjectStatusOnStudy             ResearchSubjectStatus = "on-study"
+	ResearchSubjectStatusOnStudyIntervention ResearchSubjectStatus = "on-study-intervention"
+	ResearchSubjectStatusOnStudyObservation  ResearchSubjectStatus = "on-study-observation"
+	ResearchSubjectStatusPendingOnStudy      ResearchSubjectStatus = "pending-on-study"
+	ResearchSubjectStatusPotentialCandidate  ResearchSubjectStatus = "potential-candidate"
+	ResearchSubjectStatusScreening           ResearchSubjectStatus = "screening"
+	ResearchSubjectStatusWithdrawn           ResearchSubjectStatus = "withdrawn"
 )
 
 func (code ResearchSubjectStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ResearchSubjectStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ResearchSubjectStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "candidate":
-		*code = ResearchSubjectStatusCandidate
-	case "eligible":
-		*code = ResearchSubjectStatusEligible
-	case "follow-up":
-		*code = ResearchSubjectStatusFollowUp
-	case "ineligible":
-		*code = ResearchSubjectStatusIneligible
-	case "not-registered":
-		*code = ResearchSubjectStatusNotRegistered
-	case "off-study":
-		*code = ResearchSubjectStatusOffStudy
-	case "on-study":
-		*code = ResearchSubjectStatusOnStudy
-	case "on-study-intervention":
-		*code = ResearchSubjectStatusOnStudyIntervention
-	case "on-study-observation":
-		*code = ResearchSubjectStatusOnStudyObservation
-	case "pending-on-study":
-		*code = ResearchSubjectStatusPendingOnStudy
-	case "potential-candidate":
-		*code = ResearchSubjectStatusPotentialCandidate
-	case "screening":
-		*code = ResearchSubjectStatusScreening
-	case "withdrawn":
-		*code = ResearchSubjectStatusWithdrawn
-	default:
-		return fmt.Errorf("unknown ResearchSubjectStatus code `%s`", s)
+func (code *ResearchSubjectStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ResearchSubjectStatus(s)
 	return nil
 }
 func (code ResearchSubjectStatus) String() string {
 	return code.Code()
 }
 func (code ResearchSubjectStatus) Code() string {
+	return string(code)
+}
+func (code ResearchSubjectStatus) Known() bool {
 	switch code {
-	case ResearchSubjectStatusCandidate:
-		return "candidate"
-	case ResearchSubjectStatusEligible:
-		return "eligible"
-	case ResearchSubjectStatusFollowUp:
-		return "follow-up"
-	case ResearchSubjectStatusIneligible:
-		return "ineligible"
-	case ResearchSubjectStatusNotRegistered:
-		return "not-registered"
-	case ResearchSubjectStatusOffStudy:
-		return "off-study"
-	case ResearchSubjectStatusOnStudy:
-		return "on-study"
-	case ResearchSubjectStatusOnStudyIntervention:
-		return "on-study-intervention"
-	case ResearchSubjectStatusOnStudyObservation:
-		return "on-study-observation"
-	case ResearchSubjectStatusPendingOnStudy:
-		return "pending-on-study"
-	case ResearchSubjectStatusPotentialCandidate:
-		return "potential-candidate"
-	case ResearchSubjectStatusScreening:
-		return "screening"
-	case ResearchSubjectStatusWithdrawn:
-		return "withdrawn"
+	case ResearchSubjectStatusCandidate, ResearchSubjectStatusEligible, ResearchSubjectStatusFollowUp, ResearchSubjectStatusIneligible, ResearchSubjectStatusNotRegistered, ResearchSubjectStatusOffStudy, ResearchSubjectStatusOnStudy, ResearchSubjectStatusOnStudyIntervention, ResearchSubjectStatusOnStudyObservation, ResearchSubjectStatusPendingOnStudy, ResearchSubjectStatusPotentialCandidate, ResearchSubjectStatusScreening, ResearchSubjectStatusWithdrawn:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ResearchSubjectStatus) Display() string {
 	switch code {
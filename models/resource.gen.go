@@ -22,22 +22,39 @@ import "encoding/json"
 
 // Resource is documented here http://hl7.org/fhir/StructureDefinition/Resource
 type Resource struct {
-	ID            *string `bson:"id,omitempty" json:"id,omitempty"`
-	Meta          *Meta   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules *string `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language      *string `bson:"language,omitempty" json:"language,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Resource and fails with CodeError when a coded element has an unknown code.
+func (r *Resource) UnmarshalJSON(b []byte) error {
+	type other Resource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Resource", r)
+}
+
 type OtherResource Resource
 
 // MarshalJSON marshals the given Resource as JSON into a byte slice
 func (r Resource) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherResource
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherResource: OtherResource(r),
 		ResourceType:  "Resource",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalResource unmarshals a Resource.
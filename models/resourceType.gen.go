@@ -14,780 +14,197 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.276184 +0000 UTC
+// 2026-10-19 02:55:18.899861434 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ResourceType is documented here http://hl7.org/fhir/ValueSet/resource-types
-type ResourceType int
+// The empty ResourceType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ResourceType string
 
 const (
-	ResourceTypeAccount ResourceType = iota
-	ResourceTypeActivityDefinition
-	ResourceTypeAdverseEvent
-	ResourceTypeAllergyIntolerance
-	ResourceTypeAppointment
-	ResourceTypeAppointmentResponse
-	ResourceTypeAuditEvent
-	ResourceTypeBasic
-	ResourceTypeBinary
-	ResourceTypeBiologicallyDerivedProduct
-	ResourceTypeBodyStructure
-	ResourceTypeBundle
-	ResourceTypeCapabilityStatement
-	ResourceTypeCarePlan
-	ResourceTypeCareTeam
-	ResourceTypeCatalogEntry
-	ResourceTypeChargeItem
-	ResourceTypeChargeItemDefinition
-	ResourceTypeClaim
-	ResourceTypeClaimResponse
-	ResourceTypeClinicalImpression
-	ResourceTypeCodeSystem
-	ResourceTypeCommunication
-	ResourceTypeCommunicationRequest
-	ResourceTypeCompartmentDefinition
-	ResourceTypeComposition
-	ResourceTypeConceptMap
-	ResourceTypeCondition
-	ResourceTypeConsent
-	ResourceTypeContract
-	ResourceTypeCoverage
-	ResourceTypeCoverageEligibilityRequest
-	ResourceTypeCoverageEligibilityResponse
-	ResourceTypeDetectedIssue
-	ResourceTypeDevice
-	ResourceTypeDeviceDefinition
-	ResourceTypeDeviceMetric
-	ResourceTypeDeviceRequest
-	ResourceTypeDeviceUseStatement
-	ResourceTypeDiagnosticReport
-	ResourceTypeDocumentManifest
-	ResourceTypeDocumentReference
-	ResourceTypeDomainResource
-	ResourceTypeEffectEvidenceSynthesis
-	ResourceTypeEncounter
-	ResourceTypeEndpoint
-	ResourceTypeEnrollmentRequest
-	ResourceTypeEnrollmentResponse
-	ResourceTypeEpisodeOfCare
-	ResourceTypeEventDefinition
-	ResourceTypeEvidence
-	ResourceTypeEvidenceVariable
-	ResourceTypeExampleScenario
-	ResourceTypeExplanationOfBenefit
-	ResourceTypeFamilyMemberHistory
-	ResourceTypeFlag
-	ResourceTypeGoal
-	ResourceTypeGraphDefinition
-	ResourceTypeGroup
-	ResourceTypeGuidanceResponse
-	ResourceTypeHealthcareService
-	ResourceTypeImagingStudy
-	ResourceTypeImmunization
-	ResourceTypeImmunizationEvaluation
-	ResourceTypeImmunizationRecommendation
-	ResourceTypeImplementationGuide
-	ResourceTypeInsurancePlan
-	ResourceTypeInvoice
-	ResourceTypeLibrary
-	ResourceTypeLinkage
-	ResourceTypeList
-	ResourceTypeLocation
-	ResourceTypeMeasure
-	ResourceTypeMeasureReport
-	ResourceTypeMedia
-	ResourceTypeMedication
-	ResourceTypeMedicationAdministration
-	ResourceTypeMedicationDispense
-	ResourceTypeMedicationKnowledge
-	ResourceTypeMedicationRequest
-	ResourceTypeMedicationStatement
-	ResourceTypeMedicinalProduct
-	ResourceTypeMedicinalProductAuthorization
-	ResourceTypeMedicinalProductContraindication
-	ResourceTypeMedicinalProductIndication
-	ResourceTypeMedicinalProductIngredient
-	ResourceTypeMedicinalProductInteraction
-	ResourceTypeMedicinalProductManufactured
-	ResourceTypeMedicinalProductPackaged
-	ResourceTypeMedicinalProductPharmaceutical
-	ResourceTypeMedicinalProductUndesirableEffect
-	ResourceTypeMessageDefinition
-	ResourceTypeMessageHeader
-	ResourceTypeMolecularSequence
-	ResourceTypeNamingSystem
-	ResourceTypeNutritionOrder
-	ResourceTypeObservation
-	ResourceTypeObservationDefinition
-	ResourceTypeOperationDefinition
-	ResourceTypeOperationOutcome
-	ResourceTypeOrganization
-	ResourceTypeOrganizationAffiliation
-	ResourceTypeParameters
-	ResourceTypePatient
-	ResourceTypePaymentNotice
-	ResourceTypePaymentReconciliation
-	ResourceTypePerson
-	ResourceTypePlanDefinition
-	ResourceTypePractitioner
-	ResourceTypePractitionerRole
-	ResourceTypeProcedure
-	ResourceTypeProvenance
-	ResourceTypeQuestionnaire
-	ResourceTypeQuestionnaireResponse
-	ResourceTypeRelatedPerson
-	ResourceTypeRequestGroup
-	ResourceTypeResearchDefinition
-	ResourceTypeResearchElementDefinition
-	ResourceTypeResearchStudy
-	ResourceTypeResearchSubject
-	ResourceTypeResource
-	ResourceTypeRiskAssessment
-	ResourceTypeRiskEvidenceSynthesis
-	ResourceTypeSchedule
-	ResourceTypeSearchParameter
-	ResourceTypeServiceRequest
-	ResourceTypeSlot
-	ResourceTypeSpecimen
-	ResourceTypeSpecimenDefinition
-	ResourceTypeStructureDefinition
-	ResourceTypeStructureMap
-	ResourceTypeSubscription
-	ResourceTypeSubstance
-	ResourceTypeSubstanceNucleicAcid
-	ResourceTypeSubstancePolymer
-	ResourceTypeSubstanceProtein
-	ResourceTypeSubstanceReferenceInformation
-	ResourceTypeSubstanceSourceMaterial
-	ResourceTypeSubstanceSpecification
-	ResourceTypeSupplyDelivery
-	ResourceTypeSupplyRequest
-	ResourceTypeTask
-	ResourceTypeTerminologyCapabilities
-	ResourceTypeTestReport
-	ResourceTypeTestScript
-	ResourceTypeValueSet
-	ResourceTypeVerificationResult
-	ResourceTypeVisionPrescription
+	ResourceTypeAccount                           ResourceType = "Account"
+	ResourceTypeActivityDefinition                ResourceType = "ActivityDefinition"
+	ResourceTypeAdverseEvent                      ResourceType = "AdverseEvent"
+	ResourceTypeAllergyIntolerance                ResourceType = "AllergyIntolerance"
+	ResourceTypeAppointment                       ResourceType = "Appointment"
+	ResourceTypeAppointmentResponse               ResourceType = "AppointmentResponse"
+	ResourceTypeAuditEvent                        ResourceType = "AuditEvent"
+	ResourceTypeBasic                             ResourceType = "Basic"
+	ResourceTypeBinary                            ResourceType = "Binary"
+	ResourceTypeBiologicallyDerivedProduct        ResourceType = "BiologicallyDerivedProduct"
+	ResourceTypeBodyStructure                     ResourceType = "BodyStructure"
+	ResourceTypeBundle                            ResourceType = "Bundle"
+	ResourceTypeCapabilityStatement               ResourceType = "CapabilityStatement"
+	ResourceTypeCarePlan                          ResourceType = "CarePlan"
+	ResourceTypeCareTeam                          ResourceType = "CareTeam"
+	ResourceTypeCatalogEntry                      ResourceType = "CatalogEntry"
+	ResourceTypeChargeItem                        ResourceType = "ChargeItem"
+	ResourceTypeChargeItemDefinition              ResourceType = "ChargeItemDefinition"
+	ResourceTypeClaim                             ResourceType = "Claim"
+	ResourceTypeClaimResponse                     ResourceType = "ClaimResponse"
+	ResourceTypeClinicalImpression                ResourceType = "ClinicalImpression"
+	ResourceTypeCodeSystem                        ResourceType = "CodeSystem"
+	ResourceTypeCommunication                     ResourceType = "Communication"
+	ResourceTypeCommunicationRequest              ResourceType = "CommunicationRequest"
+	ResourceTypeCompartmentDefinition             ResourceType = "CompartmentDefinition"
+	ResourceTypeComposition                       ResourceType = "Composition"
+	ResourceTypeConceptMap                        ResourceType = "ConceptMap"
+	ResourceTypeCondition                         ResourceType = "Condition"
+	ResourceTypeConsent                           ResourceType = "Consent"
+	ResourceTypeContract                          ResourceType = "Contract"
+	ResourceTypeCoverage                          ResourceType = "Coverage"
+	ResourceTypeCoverageEligibilityRequest        ResourceType = "CoverageEligibilityRequest"
+	ResourceTypeCoverageEligibilityResponse       ResourceType = "CoverageEligibilityResponse"
+	ResourceTypeDetectedIssue                     ResourceType = "DetectedIssue"
+	ResourceTypeDevice                            ResourceType = "Device"
+	ResourceTypeDeviceDefinition                  ResourceType = "DeviceDefinition"
+	ResourceTypeDeviceMetric                      ResourceType = "DeviceMetric"
+	ResourceTypeDeviceRequest                     ResourceType = "DeviceRequest"
+	ResourceTypeDeviceUseStatement                ResourceType = "DeviceUseStatement"
+	ResourceTypeDiagnosticReport                  ResourceType = "DiagnosticReport"
+	ResourceTypeDocumentManifest                  ResourceType = "DocumentManifest"
+	ResourceTypeDocumentReference                 ResourceType = "DocumentReference"
+	ResourceTypeDomainResource                    ResourceType = "DomainResource"
+	ResourceTypeEffectEvidenceSynthesis           ResourceType = "EffectEvidenceSynthesis"
+	ResourceTypeEncounter                         ResourceType = "Encounter"
+	ResourceTypeEndpoint                          ResourceType = "Endpoint"
+	ResourceTypeEnrollmentRequest                 ResourceType = "EnrollmentRequest"
+	ResourceTypeEnrollmentResponse                ResourceType = "EnrollmentResponse"
+	ResourceTypeEpisodeOfCare                     ResourceType = "EpisodeOfCare"
+	ResourceTypeEventDefinition                   ResourceType = "EventDefinition"
+	ResourceTypeEvidence                          ResourceType = "Evidence"
+	ResourceTypeEvidenceVariable                  ResourceType = "EvidenceVariable"
+	ResourceTypeExampleScenario                   ResourceType = "ExampleScenario"
+	ResourceTypeExplanationOfBenefit              ResourceType = "ExplanationOfBenefit"
+	ResourceTypeFamilyMemberHistory               ResourceType = "FamilyMemberHistory"
+	ResourceTypeFlag                              ResourceType = "Flag"
+	ResourceTypeGoal                              ResourceType = "Goal"
+	ResourceTypeGraphDefinition                   ResourceType = "GraphDefinition"
+	ResourceTypeGroup                             ResourceType = "Group"
+	ResourceTypeGuidanceResponse                  ResourceType = "GuidanceResponse"
+	ResourceTypeHealthcareService                 ResourceType = "HealthcareService"
+	ResourceTypeImagingStudy                      ResourceType = "ImagingStudy"
+	ResourceTypeImmunization                      ResourceType = "Immunization"
+	ResourceTypeImmunizationEvaluation            ResourceType = "ImmunizationEvaluation"
+	ResourceTypeImmunizationRecommendation        ResourceType = "ImmunizationRecommendation"
+	ResourceTypeImplementationGuide               ResourceType = "ImplementationGuide"
+	ResourceTypeInsurancePlan                     ResourceType = "InsurancePlan"
+	ResourceTypeInvoice                           ResourceType = "Invoice"
+	ResourceTypeLibrary                           ResourceType = "Library"
+	ResourceTypeLinkage                           ResourceType = "Linkage"
+	ResourceTypeList                              ResourceType = "List"
+	ResourceTypeLocation                          ResourceType = "Location"
+	ResourceTypeMeasure                           ResourceType = "Measure"
+	ResourceTypeMeasureReport                     ResourceType = "MeasureReport"
+	ResourceTypeMedia                             ResourceType = "Media"
+	ResourceTypeMedication                        ResourceType = "Medication"
+	ResourceTypeMedicationAdministration          ResourceType = "MedicationAdministration"
+	ResourceTypeMedicationDispense                ResourceType = "MedicationDispense"
+	ResourceTypeMedicationKnowledge               ResourceType = "MedicationKnowledge"
+	ResourceTypeMedicationRequest                 ResourceType = "MedicationRequest"
+	ResourceTypeMedicationStatement               ResourceType = "MedicationStatement"
+	ResourceTypeMedicinalProduct                  ResourceType = "MedicinalProduct"
+	ResourceTypeMedicinalProductAuthorization     ResourceType = "MedicinalProductAuthorization"
+	ResourceTypeMedicinalProductContraindication  ResourceType = "MedicinalProductContraindication"
+	ResourceTypeMedicinalProductIndication        ResourceType = "MedicinalProductIndication"
+	ResourceTypeMedicinalProductIngredient        ResourceType = "MedicinalProductIngredient"
+	ResourceTypeMedicinalProductInteraction       ResourceType = "MedicinalProductInteraction"
+	ResourceTypeMedicinalProductManufactured      ResourceType = "MedicinalProductManufactured"
+	ResourceTypeMedicinalProductPackaged          ResourceType = "MedicinalProductPackaged"
+	ResourceTypeMedicinalProductPharmaceutical    ResourceType = "MedicinalProductPharmaceutical"
+	ResourceTypeMedicinalProductUndesirableEffect ResourceType = "MedicinalProductUndesirableEffect"
+	ResourceTypeMessageDefinition                 ResourceType = "MessageDefinition"
+	ResourceTypeMessageHeader                     ResourceType = "MessageHeader"
+	ResourceTypeMolecularSequence                 ResourceType = "MolecularSequence"
+	ResourceTypeNamingSystem                      ResourceType = "NamingSystem"
+	ResourceTypeNutritionOrder                    ResourceType = "NutritionOrder"
+	ResourceTypeObservation                       ResourceType = "Observation"
+	ResourceTypeObservationDefinition             ResourceType = "ObservationDefinition"
+	ResourceTypeOperationDefinition               ResourceType = "OperationDefinition"
+	ResourceTypeOperationOutcome                  ResourceType = "OperationOutcome"
+	ResourceTypeOrganization                      ResourceType = "Organization"
+	ResourceTypeOrganizationAffiliation           ResourceType = "OrganizationAffiliation"
+	ResourceTypeParameters                        ResourceType = "Parameters"
+	ResourceTypePatient                           ResourceType = "Patient"
+	ResourceTypePaymentNotice                     ResourceType = "PaymentNotice"
+	ResourceTypePaymentReconciliation             ResourceType = "PaymentReconciliation"
+	ResourceTypePerson                            ResourceType = "Person"
+	ResourceTypePlanDefinition                    ResourceType = "PlanDefinition"
+	ResourceTypePractitioner                      ResourceType = "Practitioner"
+	ResourceTypePractitionerRole                  ResourceType = "PractitionerRole"
+	ResourceTypeProcedure                         ResourceType = "Procedure"
+	ResourceTypeProvenance                        ResourceType = "Provenance"
+	ResourceTypeQuestionnaire                     ResourceType = "Questionnaire"
+	ResourceTypeQuestionnaireResponse             ResourceType = "QuestionnaireResponse"
+	ResourceTypeRelatedPerson                     ResourceType = "RelatedPerson"
+	ResourceTypeRequestGroup                      ResourceType = "RequestGroup"
+	ResourceTypeResearchDefinition                ResourceType = "ResearchDefinition"
+	ResourceTypeResearchElementDefinition         ResourceType = "ResearchElementDefinition"
+	ResourceTypeResearchStudy                     ResourceType = "ResearchStudy"
+	ResourceTypeResearchSubject                   ResourceType = "ResearchSubject"
+	ResourceTypeResource                          ResourceType = "Resource"
+	ResourceTypeRiskAssessment                    ResourceType = "RiskAssessment"
+	ResourceTypeRiskEvidenceSynthesis             ResourceType = "RiskEvidenceSynthesis"
+	ResourceTypeSchedule                          ResourceType = "Schedule"
+	ResourceTypeSearchParameter                   ResourceType = "SearchParameter"
+	ResourceTypeServiceRequest                    ResourceType = "ServiceRequest"
+	ResourceTypeSlot                              ResourceType = "Slot"
+	ResourceTypeSpecimen                          ResourceType = "Specimen"
+	ResourceTypeSpecimenDefinition                ResourceType = "SpecimenDefinition"
+	ResourceTypeStructureDefinition               ResourceType = "StructureDefinition"
+	ResourceTypeStructureMap                      ResourceType = "StructureMap"
+	ResourceTypeSubscription                      ResourceType = "Subscription"
+	ResourceTypeSubstance                         ResourceType = "Substance"
+	ResourceTypeSubstanceNucleicAcid              ResourceType = "SubstanceNucleicAcid"
+	ResourceTypeSubstancePolymer                  ResourceType = "SubstancePolymer"
+	ResourceTypeSubstanceProtein                  ResourceType = "SubstanceProtein"
+	ResourceTypeSubstanceReferenceInformation     ResourceType = "SubstanceReferenceInformation"
+	ResourceTypeSubstanceSourceMaterial           ResourceType = "SubstanceSourceMaterial"
+	ResourceTypeSubstanceSpecification            ResourceType = "SubstanceSpecification"
+	ResourceTypeSupplyDelivery                    ResourceType = "SupplyDelivery"
+	ResourceTypeSupplyRequest                     ResourceType = "SupplyRequest"
+	ResourceTypeTask                              ResourceType = "Task"
+	ResourceTypeTerminologyCapabilities           ResourceType = "TerminologyCapabilities"
+	ResourceTypeTestReport                        ResourceType = "TestReport"
+	ResourceTypeTestScript                        ResourceType = "TestScript"
+	ResourceTypeValueSet                          ResourceType = "ValueSet"
+	ResourceTypeVerificationResult                ResourceType = "VerificationResult"
+	ResourceTypeVisionPrescription                ResourceType = "VisionPrescription"
 )
 
 func (code ResourceType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ResourceType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ResourceType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "Account":
-		*code = ResourceTypeAccount
-	case "ActivityDefinition":
-		*code = ResourceTypeActivityDefinition
-	case "AdverseEvent":
-		*code = ResourceTypeAdverseEvent
-	case "AllergyIntolerance":
-		*code = ResourceTypeAllergyIntolerance
-	case "Appointment":
-		*code = ResourceTypeAppointment
-	case "AppointmentResponse":
-		*code = ResourceTypeAppointmentResponse
-	case "AuditEvent":
-		*code = ResourceTypeAuditEvent
-	case "Basic":
-		*code = ResourceTypeBasic
-	case "Binary":
-		*code = ResourceTypeBinary
-	case "BiologicallyDerivedProduct":
-		*code = ResourceTypeBiologicallyDerivedProduct
-	case "BodyStructure":
-		*code = ResourceTypeBodyStructure
-	case "Bundle":
-		*code = ResourceTypeBundle
-	case "CapabilityStatement":
-		*code = ResourceTypeCapabilityStatement
-	case "CarePlan":
-		*code = ResourceTypeCarePlan
-	case "CareTeam":
-		*code = ResourceTypeCareTeam
-	case "CatalogEntry":
-		*code = ResourceTypeCatalogEntry
-	case "ChargeItem":
-		*code = ResourceTypeChargeItem
-	case "ChargeItemDefinition":
-		*code = ResourceTypeChargeItemDefinition
-	case "Claim":
-		*code = ResourceTypeClaim
-	case "ClaimResponse":
-		*code = ResourceTypeClaimResponse
-	case "ClinicalImpression":
-		*code = ResourceTypeClinicalImpression
-	case "CodeSystem":
-		*code = ResourceTypeCodeSystem
-	case "Communication":
-		*code = ResourceTypeCommunication
-	case "CommunicationRequest":
-		*code = ResourceTypeCommunicationRequest
-	case "CompartmentDefinition":
-		*code = ResourceTypeCompartmentDefinition
-	case "Composition":
-		*code = ResourceTypeComposition
-	case "ConceptMap":
-		*code = ResourceTypeConceptMap
-	case "Condition":
-		*code = ResourceTypeCondition
-	case "Consent":
-		*code = ResourceTypeConsent
-	case "Contract":
-		*code = ResourceTypeContract
-	case "Coverage":
-		*code = ResourceTypeCoverage
-	case "CoverageEligibilityRequest":
-		*code = ResourceTypeCoverageEligibilityRequest
-	case "CoverageEligibilityResponse":
-		*code = ResourceTypeCoverageEligibilityResponse
-	case "DetectedIssue":
-		*code = ResourceTypeDetectedIssue
-	case "Device":
-		*code = ResourceTypeDevice
-	case "DeviceDefinition":
-		*code = ResourceTypeDeviceDefinition
-	case "DeviceMetric":
-		*code = ResourceTypeDeviceMetric
-	case "DeviceRequest":
-		*code = ResourceTypeDeviceRequest
-	case "DeviceUseStatement":
-		*code = ResourceTypeDeviceUseStatement
-	case "DiagnosticReport":
-		*code = ResourceTypeDiagnosticReport
-	case "DocumentManifest":
-		*code = ResourceTypeDocumentManifest
-	case "DocumentReference":
-		*code = ResourceTypeDocumentReference
-	case "DomainResource":
-		*code = ResourceTypeDomainResource
-	case "EffectEvidenceSynthesis":
-		*code = ResourceTypeEffectEvidenceSynthesis
-	case "Encounter":
-		*code = ResourceTypeEncounter
-	case "Endpoint":
-		*code = ResourceTypeEndpoint
-	case "EnrollmentRequest":
-		*code = ResourceTypeEnrollmentRequest
-	case "EnrollmentResponse":
-		*code = ResourceTypeEnrollmentResponse
-	case "EpisodeOfCare":
-		*code = ResourceTypeEpisodeOfCare
-	case "EventDefinition":
-		*code = ResourceTypeEventDefinition
-	case "Evidence":
-		*code = ResourceTypeEvidence
-	case "EvidenceVariable":
-		*code = ResourceTypeEvidenceVariable
-	case "ExampleScenario":
-		*code = ResourceTypeExampleScenario
-	case "ExplanationOfBenefit":
-		*code = ResourceTypeExplanationOfBenefit
-	case "FamilyMemberHistory":
-		*code = ResourceTypeFamilyMemberHistory
-	case "Flag":
-		*code = ResourceTypeFlag
-	case "Goal":
-		*code = ResourceTypeGoal
-	case "GraphDefinition":
-		*code = ResourceTypeGraphDefinition
-	case "Group":
-		*code = ResourceTypeGroup
-	case "GuidanceResponse":
-		*code = ResourceTypeGuidanceResponse
-	case "HealthcareService":
-		*code = ResourceTypeHealthcareService
-	case "ImagingStudy":
-		*code = ResourceTypeImagingStudy
-	case "Immunization":
-		*code = ResourceTypeImmunization
-	case "ImmunizationEvaluation":
-		*code = ResourceTypeImmunizationEvaluation
-	case "ImmunizationRecommendation":
-		*code = ResourceTypeImmunizationRecommendation
-	case "ImplementationGuide":
-		*code = ResourceTypeImplementationGuide
-	case "InsurancePlan":
-		*code = ResourceTypeInsurancePlan
-	case "Invoice":
-		*code = ResourceTypeInvoice
-	case "Library":
-		*code = ResourceTypeLibrary
-	case "Linkage":
-		*code = ResourceTypeLinkage
-	case "List":
-		*code = ResourceTypeList
-	case "Location":
-		*code = ResourceTypeLocation
-	case "Measure":
-		*code = ResourceTypeMeasure
-	case "MeasureReport":
-		*code = ResourceTypeMeasureReport
-	case "Media":
-		*code = ResourceTypeMedia
-	case "Medication":
-		*code = ResourceTypeMedication
-	case "MedicationAdministration":
-		*code = ResourceTypeMedicationAdministration
-	case "MedicationDispense":
-		*code = ResourceTypeMedicationDispense
-	case "MedicationKnowledge":
-		*code = ResourceTypeMedicationKnowledge
-	case "MedicationRequest":
-		*code = ResourceTypeMedicationRequest
-	case "MedicationStatement":
-		*code = ResourceTypeMedicationStatement
-	case "MedicinalProduct":
-		*code = ResourceTypeMedicinalProduct
-	case "MedicinalProductAuthorization":
-		*code = ResourceTypeMedicinalProductAuthorization
-	case "MedicinalProductContraindication":
-		*code = ResourceTypeMedicinalProductContraindication
-	case "MedicinalProductIndication":
-		*code = ResourceTypeMedicinalProductIndication
-	case "MedicinalProductIngredient":
-		*code = ResourceTypeMedicinalProductIngredient
-	case "MedicinalProductInteraction":
-		*code = ResourceTypeMedicinalProductInteraction
-	case "MedicinalProductManufactured":
-		*code = ResourceTypeMedicinalProductManufactured
-	case "MedicinalProductPackaged":
-		*code = ResourceTypeMedicinalProductPackaged
-	case "MedicinalProductPharmaceutical":
-		*code = ResourceTypeMedicinalProductPharmaceutical
-	case "MedicinalProductUndesirableEffect":
-		*code = ResourceTypeMedicinalProductUndesirableEffect
-	case "MessageDefinition":
-		*code = ResourceTypeMessageDefinition
-	case "MessageHeader":
-		*code = ResourceTypeMessageHeader
-	case "MolecularSequence":
-		*code = ResourceTypeMolecularSequence
-	case "NamingSystem":
-		*code = ResourceTypeNamingSystem
-	case "NutritionOrder":
-		*code = ResourceTypeNutritionOrder
-	case "Observation":
-		*code = ResourceTypeObservation
-	case "ObservationDefinition":
-		*code = ResourceTypeObservationDefinition
-	case "OperationDefinition":
-		*code = ResourceTypeOperationDefinition
-	case "OperationOutcome":
-		*code = ResourceTypeOperationOutcome
-	case "Organization":
-		*code = ResourceTypeOrganization
-	case "OrganizationAffiliation":
-		*code = ResourceTypeOrganizationAffiliation
-	case "Parameters":
-		*code = ResourceTypeParameters
-	case "Patient":
-		*code = ResourceTypePatient
-	case "PaymentNotice":
-		*code = ResourceTypePaymentNotice
-	case "PaymentReconciliation":
-		*code = ResourceTypePaymentReconciliation
-	case "Person":
-		*code = ResourceTypePerson
-	case "PlanDefinition":
-		*code = ResourceTypePlanDefinition
-	case "Practitioner":
-		*code = ResourceTypePractitioner
-	case "PractitionerRole":
-		*code = ResourceTypePractitionerRole
-	case "Procedure":
-		*code = ResourceTypeProcedure
-	case "Provenance":
-		*code = ResourceTypeProvenance
-	case "Questionnaire":
-		*code = ResourceTypeQuestionnaire
-	case "QuestionnaireResponse":
-		*code = ResourceTypeQuestionnaireResponse
-	case "RelatedPerson":
-		*code = ResourceTypeRelatedPerson
-	case "RequestGroup":
-		*code = ResourceTypeRequestGroup
-	case "ResearchDefinition":
-		*code = ResourceTypeResearchDefinition
-	case "ResearchElementDefinition":
-		*code = ResourceTypeResearchElementDefinition
-	case "ResearchStudy":
-		*code = ResourceTypeResearchStudy
-	case "ResearchSubject":
-		*code = ResourceTypeResearchSubject
-	case "Resource":
-		*code = ResourceTypeResource
-	case "RiskAssessment":
-		*code = ResourceTypeRiskAssessment
-	case "RiskEvidenceSynthesis":
-		*code = ResourceTypeRiskEvidenceSynthesis
-	case "Schedule":
-		*code = ResourceTypeSchedule
-	case "SearchParameter":
-		*code = ResourceTypeSearchParameter
-	case "ServiceRequest":
-		*code = ResourceTypeServiceRequest
-	case "Slot":
-		*code = ResourceTypeSlot
-	case "Specimen":
-		*code = ResourceTypeSpecimen
-	case "SpecimenDefinition":
-		*code = ResourceTypeSpecimenDefinition
-	case "StructureDefinition":
-		*code = ResourceTypeStructureDefinition
-	case "StructureMap":
-		*code = ResourceTypeStructureMap
-	case "Subscription":
-		*code = ResourceTypeSubscription
-	case "Substance":
-		*code = ResourceTypeSubstance
-	case "SubstanceNucleicAcid":
-		*code = ResourceTypeSubstanceNucleicAcid
-	case "SubstancePolymer":
-		*code = ResourceTypeSubstancePolymer
-	case "SubstanceProtein":
-		*code = ResourceTypeSubstanceProtein
-	case "SubstanceReferenceInformation":
-		*code = ResourceTypeSubstanceReferenceInformation
-	case "SubstanceSourceMaterial":
-		*code = ResourceTypeSubstanceSourceMaterial
-	case "SubstanceSpecification":
-		*code = ResourceTypeSubstanceSpecification
-	case "SupplyDelivery":
-		*code = ResourceTypeSupplyDelivery
-	case "SupplyRequest":
-		*code = ResourceTypeSupplyRequest
-	case "Task":
-		*code = ResourceTypeTask
-	case "TerminologyCapabilities":
-		*code = ResourceTypeTerminologyCapabilities
-	case "TestReport":
-		*code = ResourceTypeTestReport
-	case "TestScript":
-		*code = ResourceTypeTestScript
-	case "ValueSet":
-		*code = ResourceTypeValueSet
-	case "VerificationResult":
-		*code = ResourceTypeVerificationResult
-	case "VisionPrescription":
-		*code = ResourceTypeVisionPrescription
-	default:
-		return fmt.Errorf("unknown ResourceType code `%s`", s)
+func (code *ResourceType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = ResourceType(s)
 	return nil
 }
 func (code ResourceType) String() string {
 	return code.Code()
 }
 func (code ResourceType) Code() string {
+	return string(code)
+}
+func (code ResourceType) Known() bool {
 	switch code {
-	case ResourceTypeAccount:
-		return "Account"
-	case ResourceTypeActivityDefinition:
-		return "ActivityDefinition"
-	case ResourceTypeAdverseEvent:
-		return "AdverseEvent"
-	case ResourceTypeAllergyIntolerance:
-		return "AllergyIntolerance"
-	case ResourceTypeAppointment:
-		return "Appointment"
-	case ResourceTypeAppointmentResponse:
-		return "AppointmentResponse"
-	case ResourceTypeAuditEvent:
-		return "AuditEvent"
-	case ResourceTypeBasic:
-		return "Basic"
-	case ResourceTypeBinary:
-		return "Binary"
-	case ResourceTypeBiologicallyDerivedProduct:
-		return "BiologicallyDerivedProduct"
-	case ResourceTypeBodyStructure:
-		return "BodyStructure"
-	case ResourceTypeBundle:
-		return "Bundle"
-	case ResourceTypeCapabilityStatement:
-		return "CapabilityStatement"
-	case ResourceTypeCarePlan:
-		return "CarePlan"
-	case ResourceTypeCareTeam:
-		return "CareTeam"
-	case ResourceTypeCatalogEntry:
-		return "CatalogEntry"
-	case ResourceTypeChargeItem:
-		return "ChargeItem"
-	case ResourceTypeChargeItemDefinition:
-		return "ChargeItemDefinition"
-	case ResourceTypeClaim:
-		return "Claim"
-	case ResourceTypeClaimResponse:
-		return "ClaimResponse"
-	case ResourceTypeClinicalImpression:
-		return "ClinicalImpression"
-	case ResourceTypeCodeSystem:
-		return "CodeSystem"
-	case ResourceTypeCommunication:
-		return "Communication"
-	case ResourceTypeCommunicationRequest:
-		return "CommunicationRequest"
-	case ResourceTypeCompartmentDefinition:
-		return "CompartmentDefinition"
-	case ResourceTypeComposition:
-		return "Composition"
-	case ResourceTypeConceptMap:
-		return "ConceptMap"
-	case ResourceTypeCondition:
-		return "Condition"
-	case ResourceTypeConsent:
-		return "Consent"
-	case ResourceTypeContract:
-		return "Contract"
-	case ResourceTypeCoverage:
-		return "Coverage"
-	case ResourceTypeCoverageEligibilityRequest:
-		return "CoverageEligibilityRequest"
-	case ResourceTypeCoverageEligibilityResponse:
-		return "CoverageEligibilityResponse"
-	case ResourceTypeDetectedIssue:
-		return "DetectedIssue"
-	case ResourceTypeDevice:
-		return "Device"
-	case ResourceTypeDeviceDefinition:
-		return "DeviceDefinition"
-	case ResourceTypeDeviceMetric:
-		return "DeviceMetric"
-	case ResourceTypeDeviceRequest:
-		return "DeviceRequest"
-	case ResourceTypeDeviceUseStatement:
-		return "DeviceUseStatement"
-	case ResourceTypeDiagnosticReport:
-		return "DiagnosticReport"
-	case ResourceTypeDocumentManifest:
-		return "DocumentManifest"
-	case ResourceTypeDocumentReference:
-		return "DocumentReference"
-	case ResourceTypeDomainResource:
-		return "DomainResource"
-	case ResourceTypeEffectEvidenceSynthesis:
-		return "EffectEvidenceSynthesis"
-	case ResourceTypeEncounter:
-		return "Encounter"
-	case ResourceTypeEndpoint:
-		return "Endpoint"
-	case ResourceTypeEnrollmentRequest:
-		return "EnrollmentRequest"
-	case ResourceTypeEnrollmentResponse:
-		return "EnrollmentResponse"
-	case ResourceTypeEpisodeOfCare:
-		return "EpisodeOfCare"
-	case ResourceTypeEventDefinition:
-		return "EventDefinition"
-	case ResourceTypeEvidence:
-		return "Evidence"
-	case ResourceTypeEvidenceVariable:
-		return "EvidenceVariable"
-	case ResourceTypeExampleScenario:
-		return "ExampleScenario"
-	case ResourceTypeExplanationOfBenefit:
-		return "ExplanationOfBenefit"
-	case ResourceTypeFamilyMemberHistory:
-		return "FamilyMemberHistory"
-	case ResourceTypeFlag:
-		return "Flag"
-	case ResourceTypeGoal:
-		return "Goal"
-	case ResourceTypeGraphDefinition:
-		return "GraphDefinition"
-	case ResourceTypeGroup:
-		return "Group"
-	case ResourceTypeGuidanceResponse:
-		return "GuidanceResponse"
-	case ResourceTypeHealthcareService:
-		return "HealthcareService"
-	case ResourceTypeImagingStudy:
-		return "ImagingStudy"
-	case ResourceTypeImmunization:
-		return "Immunization"
-	case ResourceTypeImmunizationEvaluation:
-		return "ImmunizationEvaluation"
-	case ResourceTypeImmunizationRecommendation:
-		return "ImmunizationRecommendation"
-	case ResourceTypeImplementationGuide:
-		return "ImplementationGuide"
-	case ResourceTypeInsurancePlan:
-		return "InsurancePlan"
-	case ResourceTypeInvoice:
-		return "Invoice"
-	case ResourceTypeLibrary:
-		return "Library"
-	case ResourceTypeLinkage:
-		return "Linkage"
-	case ResourceTypeList:
-		return "List"
-	case ResourceTypeLocation:
-		return "Location"
-	case ResourceTypeMeasure:
-		return "Measure"
-	case ResourceTypeMeasureReport:
-		return "MeasureReport"
-	case ResourceTypeMedia:
-		return "Media"
-	case ResourceTypeMedication:
-		return "Medication"
-	case ResourceTypeMedicationAdministration:
-		return "MedicationAdministration"
-	case ResourceTypeMedicationDispense:
-		return "MedicationDispense"
-	case ResourceTypeMedicationKnowledge:
-		return "MedicationKnowledge"
-	case ResourceTypeMedicationRequest:
-		return "MedicationRequest"
-	case ResourceTypeMedicationStatement:
-		return "MedicationStatement"
-	case ResourceTypeMedicinalProduct:
-		return "MedicinalProduct"
-	case ResourceTypeMedicinalProductAuthorization:
-		return "MedicinalProductAuthorization"
-	case ResourceTypeMedicinalProductContraindication:
-		return "MedicinalProductContraindication"
-	case ResourceTypeMedicinalProductIndication:
-		return "MedicinalProductIndication"
-	case ResourceTypeMedicinalProductIngredient:
-		return "MedicinalProductIngredient"
-	case ResourceTypeMedicinalProductInteraction:
-		return "MedicinalProductInteraction"
-	case ResourceTypeMedicinalProductManufactured:
-		return "MedicinalProductManufactured"
-	case ResourceTypeMedicinalProductPackaged:
-		return "MedicinalProductPackaged"
-	case ResourceTypeMedicinalProductPharmaceutical:
-		return "MedicinalProductPharmaceutical"
-	case ResourceTypeMedicinalProductUndesirableEffect:
-		return "MedicinalProductUndesirableEffect"
-	case ResourceTypeMessageDefinition:
-		return "MessageDefinition"
-	case ResourceTypeMessageHeader:
-		return "MessageHeader"
-	case ResourceTypeMolecularSequence:
-		return "MolecularSequence"
-	case ResourceTypeNamingSystem:
-		return "NamingSystem"
-	case ResourceTypeNutritionOrder:
-		return "NutritionOrder"
-	case ResourceTypeObservation:
-		return "Observation"
-	case ResourceTypeObservationDefinition:
-		return "ObservationDefinition"
-	case ResourceTypeOperationDefinition:
-		return "OperationDefinition"
-	case ResourceTypeOperationOutcome:
-		return "OperationOutcome"
-	case ResourceTypeOrganization:
-		return "Organization"
-	case ResourceTypeOrganizationAffiliation:
-		return "OrganizationAffiliation"
-	case ResourceTypeParameters:
-		return "Parameters"
-	case ResourceTypePatient:
-		return "Patient"
-	case ResourceTypePaymentNotice:
-		return "PaymentNotice"
-	case ResourceTypePaymentReconciliation:
-		return "PaymentReconciliation"
-	case ResourceTypePerson:
-		return "Person"
-	case ResourceTypePlanDefinition:
-		return "PlanDefinition"
-	case ResourceTypePractitioner:
-		return "Practitioner"
-	case ResourceTypePractitionerRole:
-		return "PractitionerRole"
-	case ResourceTypeProcedure:
-		return "Procedure"
-	case ResourceTypeProvenance:
-		return "Provenance"
-	case ResourceTypeQuestionnaire:
-		return "Questionnaire"
-	case ResourceTypeQuestionnaireResponse:
-		return "QuestionnaireResponse"
-	case ResourceTypeRelatedPerson:
-		return "RelatedPerson"
-	case ResourceTypeRequestGroup:
-		return "RequestGroup"
-	case ResourceTypeResearchDefinition:
-		return "ResearchDefinition"
-	case ResourceTypeResearchElementDefinition:
-		return "ResearchElementDefinition"
-	case ResourceTypeResearchStudy:
-		return "ResearchStudy"
-	case ResourceTypeResearchSubject:
-		return "ResearchSubject"
-	case ResourceTypeResource:
-		return "Resource"
-	case ResourceTypeRiskAssessment:
-		return "RiskAssessment"
-	case ResourceTypeRiskEvidenceSynthesis:
-		return "RiskEvidenceSynthesis"
-	case ResourceTypeSchedule:
-		return "Schedule"
-	case ResourceTypeSearchParameter:
-		return "SearchParameter"
-	case ResourceTypeServiceRequest:
-		return "ServiceRequest"
-	case ResourceTypeSlot:
-		return "Slot"
-	case ResourceTypeSpecimen:
-		return "Specimen"
-	case ResourceTypeSpecimenDefinition:
-		return "SpecimenDefinition"
-	case ResourceTypeStructureDefinition:
-		return "StructureDefinition"
-	case ResourceTypeStructureMap:
-		return "StructureMap"
-	case ResourceTypeSubscription:
-		return "Subscription"
-	case ResourceTypeSubstance:
-		return "Substance"
-	case ResourceTypeSubstanceNucleicAcid:
-		return "SubstanceNucleicAcid"
-	case ResourceTypeSubstancePolymer:
-		return "SubstancePolymer"
-	case ResourceTypeSubstanceProtein:
-		return "SubstanceProtein"
-	case ResourceTypeSubstanceReferenceInformation:
-		return "SubstanceReferenceInformation"
-	case ResourceTypeSubstanceSourceMaterial:
-		return "SubstanceSourceMaterial"
-	case ResourceTypeSubstanceSpecification:
-		return "SubstanceSpecification"
-	case ResourceTypeSupplyDelivery:
-		return "SupplyDelivery"
-	case ResourceTypeSupplyRequest:
-		return "SupplyRequest"
-	case ResourceTypeTask:
-		return "Task"
-	case ResourceTypeTerminologyCapabilities:
-		return "TerminologyCapabilities"
-	case ResourceTypeTestReport:
-		return "TestReport"
-	case ResourceTypeTestScript:
-		return "TestScript"
-	case ResourceTypeValueSet:
-		return "ValueSet"
-	case ResourceTypeVerificationResult:
-		return "VerificationResult"
-	case ResourceTypeVisionPrescription:
-		return "VisionPrescription"
+	case ResourceTypeAccount, ResourceTypeActivityDefinition, ResourceTypeAdverseEvent, ResourceTypeAllergyIntolerance, ResourceTypeAppointment, ResourceTypeAppointmentResponse, ResourceTypeAuditEvent, ResourceTypeBasic, ResourceTypeBinary, ResourceTypeBiologicallyDerivedProduct, ResourceTypeBodyStructure, ResourceTypeBundle, ResourceTypeCapabilityStatement, ResourceTypeCarePlan, ResourceTypeCareTeam, ResourceTypeCatalogEntry, ResourceTypeChargeItem, ResourceTypeChargeItemDefinition, ResourceTypeClaim, ResourceTypeClaimResponse, ResourceTypeClinicalImpression, ResourceTypeCodeSystem, ResourceTypeCommunication, ResourceTypeCommunicationRequest, ResourceTypeCompartmentDefinition, ResourceTypeComposition, ResourceTypeConceptMap, ResourceTypeCondition, ResourceTypeConsent, ResourceTypeContract, ResourceTypeCoverage, ResourceTypeCoverageEligibilityRequest, ResourceTypeCoverageEligibilityResponse, ResourceTypeDetectedIssue, ResourceTypeDevice, ResourceTypeDeviceDefinition, ResourceTypeDeviceMetric, ResourceTypeDeviceRequest, ResourceTypeDeviceUseStatement, ResourceTypeDiagnosticReport, ResourceTypeDocumentManifest, ResourceTypeDocumentReference, ResourceTypeDomainResource, ResourceTypeEffectEvidenceSynthesis, ResourceTypeEncounter, ResourceTypeEndpoint, ResourceTypeEnrollmentRequest, ResourceTypeEnrollmentResponse, ResourceTypeEpisodeOfCare, ResourceTypeEventDefinition, ResourceTypeEvidence, ResourceTypeEvidenceVariable, ResourceTypeExampleScenario, ResourceTypeExplanationOfBenefit, ResourceTypeFamilyMemberHistory, ResourceTypeFlag, ResourceTypeGoal, ResourceTypeGraphDefinition, ResourceTypeGroup, ResourceTypeGuidanceResponse, ResourceTypeHealthcareService, ResourceTypeImagingStudy, ResourceTypeImmunization, ResourceTypeImmunizationEvaluation, ResourceTypeImmunizationRecommendation, ResourceTypeImplementationGuide, ResourceTypeInsurancePlan, ResourceTypeInvoice, ResourceTypeLibrary, ResourceTypeLinkage, ResourceTypeList, ResourceTypeLocation, ResourceTypeMeasure, ResourceTypeMeasureReport, ResourceTypeMedia, ResourceTypeMedication, ResourceTypeMedicationAdministration, ResourceTypeMedicationDispense, ResourceTypeMedicationKnowledge, ResourceTypeMedicationRequest, ResourceTypeMedicationStatement, ResourceTypeMedicinalProduct, ResourceTypeMedicinalProductAuthorization, ResourceTypeMedicinalProductContraindication, ResourceTypeMedicinalProductIndication, ResourceTypeMedicinalProductIngredient, ResourceTypeMedicinalProductInteraction, ResourceTypeMedicinalProductManufactured, ResourceTypeMedicinalProductPackaged, ResourceTypeMedicinalProductPharmaceutical, ResourceTypeMedicinalProductUndesirableEffect, ResourceTypeMessageDefinition, ResourceTypeMessageHeader, ResourceTypeMolecularSequence, ResourceTypeNamingSystem, ResourceTypeNutritionOrder, ResourceTypeObservation, ResourceTypeObservationDefinition, ResourceTypeOperationDefinition, ResourceTypeOperationOutcome, ResourceTypeOrganization, ResourceTypeOrganizationAffiliation, ResourceTypeParameters, ResourceTypePatient, ResourceTypePaymentNotice, ResourceTypePaymentReconciliation, ResourceTypePerson, ResourceTypePlanDefinition, ResourceTypePractitioner, ResourceTypePractitionerRole, ResourceTypeProcedure, ResourceTypeProvenance, ResourceTypeQuestionnaire, ResourceTypeQuestionnaireResponse, ResourceTypeRelatedPerson, ResourceTypeRequestGroup, ResourceTypeResearchDefinition, ResourceTypeResearchElementDefinition, ResourceTypeResearchStudy, ResourceTypeResearchSubject, ResourceTypeResource, ResourceTypeRiskAssessment, ResourceTypeRiskEvidenceSynthesis, ResourceTypeSchedule, ResourceTypeSearchParameter, ResourceTypeServiceRequest, ResourceTypeSlot, ResourceTypeSpecimen, ResourceTypeSpecimenDefinition, ResourceTypeStructureDefinition, ResourceTypeStructureMap, ResourceTypeSubscription, ResourceTypeSubstance, ResourceTypeSubstanceNucleicAcid, ResourceTypeSubstancePolymer, ResourceTypeSubstanceProtein, ResourceTypeSubstanceReferenceInformation, ResourceTypeSubstanceSourceMaterial, ResourceTypeSubstanceSpecification, ResourceTypeSupplyDelivery, ResourceTypeSupplyRequest, ResourceTypeTask, ResourceTypeTerminologyCapabilities, ResourceTypeTestReport, ResourceTypeTestScript, ResourceTypeValueSet, ResourceTypeVerificationResult, ResourceTypeVisionPrescription:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ResourceType) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.0761 +0000 UTC
+// 2026-10-19 02:55:18.910837288 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ResourceVersionPolicy is documented here http://hl7.org/fhir/ValueSet/versioning-policy
-type ResourceVersionPolicy int
+// The empty ResourceVersionPolicy is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ResourceVersionPolicy string
 
 const (
-	ResourceVersionPolicyNoVersion ResourceVersionPolicy = iota
-	ResourceVersionPolicyVersioned
-	ResourceVersionPolicyVersionedUpdate
+	ResourceVersionPolicyNoVersion       ResourceVersionPolicy = "no-version"
+	ResourceVersionPolicyVersioned       ResourceVersionPolicy = "versioned"
+	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
 func (code ResourceVersionPolicy) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ResourceVersionPolicy"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ResourceVersionPolicy) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "no-version":
-		*code = ResourceVersionPolicyNoVersion
-	case "versioned":
-		*code = ResourceVersionPolicyVersioned
-	case "versioned-update":
-		*code = ResourceVersionPolicyVersionedUpdate
-	default:
-		return fmt.Errorf("unknown ResourceVersionPolicy code `%s`", s)
+func (code *ResourceVersionPolicy) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ResourceVersionPolicy(s)
 	return nil
 }
 func (code ResourceVersionPolicy) String() string {
 	return code.Code()
 }
 func (code ResourceVersionPolicy) Code() string {
+	return string(code)
+}
+func (code ResourceVersionPolicy) Known() bool {
 	switch code {
-	case ResourceVersionPolicyNoVersion:
-		return "no-version"
-	case ResourceVersionPolicyVersioned:
-		return "versioned"
-	case ResourceVersionPolicyVersionedUpdate:
-		return "versioned-update"
+	case ResourceVersionPolicyNoVersion, ResourceVersionPolicyVersioned, ResourceVersionPolicyVersionedUpdate:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ResourceVersionPolicy) Display() string {
 	switch code {
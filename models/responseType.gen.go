@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.097404 +0000 UTC
+// 2026-10-19 02:55:18.912127841 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // ResponseType is documented here http://hl7.org/fhir/ValueSet/response-code
-type ResponseType int
+// The empty ResponseType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type ResponseType string
 
 const (
-	ResponseTypeOk ResponseType = iota
-	ResponseTypeTransientError
-	ResponseTypeFatalError
+	ResponseTypeOk             ResponseType = "ok"
+	ResponseTypeTransientError ResponseType = "transient-error"
+	ResponseTypeFatalError     ResponseType = "fatal-error"
 )
 
 func (code ResponseType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "ResponseType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *ResponseType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "ok":
-		*code = ResponseTypeOk
-	case "transient-error":
-		*code = ResponseTypeTransientError
-	case "fatal-error":
-		*code = ResponseTypeFatalError
-	default:
-		return fmt.Errorf("unknown ResponseType code `%s`", s)
+func (code *ResponseType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = ResponseType(s)
 	return nil
 }
 func (code ResponseType) String() string {
 	return code.Code()
 }
 func (code ResponseType) Code() string {
+	return string(code)
+}
+func (code ResponseType) Known() bool {
 	switch code {
-	case ResponseTypeOk:
-		return "ok"
-	case ResponseTypeTransientError:
-		return "transient-error"
-	case ResponseTypeFatalError:
-		return "fatal-error"
+	case ResponseTypeOk, ResponseTypeTransientError, ResponseTypeFatalError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code ResponseType) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.115869 +0000 UTC
+// 2026-10-19 02:55:18.913077869 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // RestfulCapabilityMode is documented here http://hl7.org/fhir/ValueSet/restful-capability-mode
-type RestfulCapabilityMode int
+// The empty RestfulCapabilityMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type RestfulCapabilityMode string
 
 const (
-	RestfulCapabilityModeClient RestfulCapabilityMode = iota
-	RestfulCapabilityModeServer
+	RestfulCapabilityModeClient RestfulCapabilityMode = "client"
+	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
 
 func (code RestfulCapabilityMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "RestfulCapabilityMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *RestfulCapabilityMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "client":
-		*code = RestfulCapabilityModeClient
-	case "server":
-		*code = RestfulCapabilityModeServer
-	default:
-		return fmt.Errorf("unknown RestfulCapabilityMode code `%s`", s)
+func (code *RestfulCapabilityMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = RestfulCapabilityMode(s)
 	return nil
 }
 func (code RestfulCapabilityMode) String() string {
 	return code.Code()
 }
 func (code RestfulCapabilityMode) Code() string {
+	return string(code)
+}
+func (code RestfulCapabilityMode) Known() bool {
 	switch code {
-	case RestfulCapabilityModeClient:
-		return "client"
-	case RestfulCapabilityModeServer:
-		return "server"
+	case RestfulCapabilityModeClient, RestfulCapabilityModeServer:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code RestfulCapabilityMode) Display() string {
 	switch code {
@@ -22,50 +22,204 @@ import "encoding/json"
 
 // RiskAssessment is documented here http://hl7.org/fhir/StructureDefinition/RiskAssessment
 type RiskAssessment struct {
-	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           *Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Parent            *Reference                 `bson:"parent,omitempty" json:"parent,omitempty"`
-	Status            ObservationStatus          `bson:"status" json:"status"`
-	Method            *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
-	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
-	Subject           Reference                  `bson:"subject" json:"subject"`
-	Encounter         *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Condition         *Reference                 `bson:"condition,omitempty" json:"condition,omitempty"`
-	Performer         *Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode        []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Basis             []Reference                `bson:"basis,omitempty" json:"basis,omitempty"`
-	Prediction        []RiskAssessmentPrediction `bson:"prediction,omitempty" json:"prediction,omitempty"`
-	Mitigation        *string                    `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
-	Note              []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   *Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Parent                    *Reference                 `bson:"parent,omitempty" json:"parent,omitempty"`
+	Status                    ObservationStatus          `bson:"status" json:"status"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Method                    *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
+	Code                      *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Subject                   Reference                  `bson:"subject" json:"subject"`
+	Encounter                 *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime        *DateTime                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	Condition                 *Reference                 `bson:"condition,omitempty" json:"condition,omitempty"`
+	Performer                 *Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Basis                     []Reference                `bson:"basis,omitempty" json:"basis,omitempty"`
+	Prediction                []RiskAssessmentPrediction `bson:"prediction,omitempty" json:"prediction,omitempty"`
+	Mitigation                *string                    `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	MitigationElement         *Element                   `bson:"_mitigation,omitempty" json:"_mitigation,omitempty"`
+	Note                      []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type RiskAssessmentPrediction struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Outcome           *CodeableConcept `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	QualitativeRisk   *CodeableConcept `bson:"qualitativeRisk,omitempty" json:"qualitativeRisk,omitempty"`
-	RelativeRisk      *Decimal         `bson:"relativeRisk,omitempty" json:"relativeRisk,omitempty"`
-	Rationale         *string          `bson:"rationale,omitempty" json:"rationale,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Outcome                   *CodeableConcept           `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ProbabilityDecimal        *Decimal                   `bson:"probabilityDecimal,omitempty" json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement *Element                   `bson:"_probabilityDecimal,omitempty" json:"_probabilityDecimal,omitempty"`
+	ProbabilityRange          *Range                     `bson:"probabilityRange,omitempty" json:"probabilityRange,omitempty"`
+	QualitativeRisk           *CodeableConcept           `bson:"qualitativeRisk,omitempty" json:"qualitativeRisk,omitempty"`
+	RelativeRisk              *Decimal                   `bson:"relativeRisk,omitempty" json:"relativeRisk,omitempty"`
+	RelativeRiskElement       *Element                   `bson:"_relativeRisk,omitempty" json:"_relativeRisk,omitempty"`
+	WhenPeriod                *Period                    `bson:"whenPeriod,omitempty" json:"whenPeriod,omitempty"`
+	WhenRange                 *Range                     `bson:"whenRange,omitempty" json:"whenRange,omitempty"`
+	Rationale                 *string                    `bson:"rationale,omitempty" json:"rationale,omitempty"`
+	RationaleElement          *Element                   `bson:"_rationale,omitempty" json:"_rationale,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Probability returns RiskAssessment.prediction.probability[x] of the set type, such as *Decimal, or nil when it is not set.
+func (r *RiskAssessmentPrediction) Probability() interface{} {
+	switch {
+	case r.ProbabilityDecimal != nil:
+		return r.ProbabilityDecimal
+	case r.ProbabilityRange != nil:
+		return r.ProbabilityRange
+	}
+	return nil
+}
+
+// SetProbabilityDecimal sets RiskAssessment.prediction.probability[x] to the decimal and clears the other types.
+func (r *RiskAssessmentPrediction) SetProbabilityDecimal(v Decimal) {
+	r.clearProbability()
+	r.ProbabilityDecimal = &v
+}
+
+// SetProbabilityRange sets RiskAssessment.prediction.probability[x] to the Range and clears the other types.
+func (r *RiskAssessmentPrediction) SetProbabilityRange(v Range) {
+	r.clearProbability()
+	r.ProbabilityRange = &v
+}
+func (r *RiskAssessmentPrediction) clearProbability() {
+	r.ProbabilityDecimal = nil
+	r.ProbabilityDecimalElement = nil
+	r.ProbabilityRange = nil
+}
+
+// When returns RiskAssessment.prediction.when[x] of the set type, such as *Period, or nil when it is not set.
+func (r *RiskAssessmentPrediction) When() interface{} {
+	switch {
+	case r.WhenPeriod != nil:
+		return r.WhenPeriod
+	case r.WhenRange != nil:
+		return r.WhenRange
+	}
+	return nil
+}
+
+// SetWhenPeriod sets RiskAssessment.prediction.when[x] to the Period and clears the other types.
+func (r *RiskAssessmentPrediction) SetWhenPeriod(v Period) {
+	r.clearWhen()
+	r.WhenPeriod = &v
+}
+
+// SetWhenRange sets RiskAssessment.prediction.when[x] to the Range and clears the other types.
+func (r *RiskAssessmentPrediction) SetWhenRange(v Range) {
+	r.clearWhen()
+	r.WhenRange = &v
+}
+func (r *RiskAssessmentPrediction) clearWhen() {
+	r.WhenPeriod = nil
+	r.WhenRange = nil
+}
+
+// UnmarshalJSON unmarshals the RiskAssessmentPrediction and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *RiskAssessmentPrediction) UnmarshalJSON(b []byte) error {
+	type other RiskAssessmentPrediction
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"RiskAssessment.prediction.probability[x]",
+		r.ProbabilityDecimal != nil || r.ProbabilityDecimalElement != nil,
+		r.ProbabilityRange != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"RiskAssessment.prediction.when[x]",
+		r.WhenPeriod != nil,
+		r.WhenRange != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the RiskAssessmentPrediction with its unknown fields.
+func (r RiskAssessmentPrediction) MarshalJSON() ([]byte, error) {
+	type other RiskAssessmentPrediction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurrence returns RiskAssessment.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *RiskAssessment) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets RiskAssessment.occurrence[x] to the dateTime and clears the other types.
+func (r *RiskAssessment) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets RiskAssessment.occurrence[x] to the Period and clears the other types.
+func (r *RiskAssessment) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+func (r *RiskAssessment) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the RiskAssessment and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *RiskAssessment) UnmarshalJSON(b []byte) error {
+	type other RiskAssessment
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"RiskAssessment.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("RiskAssessment", r)
+}
+
 type OtherRiskAssessment RiskAssessment
 
 // MarshalJSON marshals the given RiskAssessment as JSON into a byte slice
 func (r RiskAssessment) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherRiskAssessment
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherRiskAssessment: OtherRiskAssessment(r),
 		ResourceType:        "RiskAssessment",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalRiskAssessment unmarshals a RiskAssessment.
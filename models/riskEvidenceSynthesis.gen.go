@@ -22,74 +22,134 @@ import "encoding/json"
 
 // RiskEvidenceSynthesis is documented here http://hl7.org/fhir/StructureDefinition/RiskEvidenceSynthesis
 type RiskEvidenceSynthesis struct {
-	ID                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                            `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                            `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                            `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                            `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus                  `bson:"status" json:"status"`
-	Date              *DateTime                          `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                            `bson:"description,omitempty" json:"description,omitempty"`
-	Note              []Annotation                       `bson:"note,omitempty" json:"note,omitempty"`
-	UseContext        []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *DateTime                          `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *DateTime                          `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                            `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept                  `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail                    `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail                    `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail                    `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail                    `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact                  `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	SynthesisType     *CodeableConcept                   `bson:"synthesisType,omitempty" json:"synthesisType,omitempty"`
-	StudyType         *CodeableConcept                   `bson:"studyType,omitempty" json:"studyType,omitempty"`
-	Population        Reference                          `bson:"population" json:"population"`
-	Exposure          *Reference                         `bson:"exposure,omitempty" json:"exposure,omitempty"`
-	Outcome           Reference                          `bson:"outcome" json:"outcome"`
-	SampleSize        *RiskEvidenceSynthesisSampleSize   `bson:"sampleSize,omitempty" json:"sampleSize,omitempty"`
-	RiskEstimate      *RiskEvidenceSynthesisRiskEstimate `bson:"riskEstimate,omitempty" json:"riskEstimate,omitempty"`
-	Certainty         []RiskEvidenceSynthesisCertainty   `bson:"certainty,omitempty" json:"certainty,omitempty"`
+	ID                    *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                   *string                            `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement            *Element                           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                PublicationStatus                  `bson:"status" json:"status"`
+	StatusElement         *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Date                  *DateTime                          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                           `bson:"_description,omitempty" json:"_description,omitempty"`
+	Note                  []Annotation                       `bson:"note,omitempty" json:"note,omitempty"`
+	UseContext            []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *DateTime                          `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                           `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *DateTime                          `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                           `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                            `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                 []CodeableConcept                  `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                []ContactDetail                    `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                []ContactDetail                    `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer              []ContactDetail                    `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser              []ContactDetail                    `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact       []RelatedArtifact                  `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	SynthesisType         *CodeableConcept                   `bson:"synthesisType,omitempty" json:"synthesisType,omitempty"`
+	StudyType             *CodeableConcept                   `bson:"studyType,omitempty" json:"studyType,omitempty"`
+	Population            Reference                          `bson:"population" json:"population"`
+	Exposure              *Reference                         `bson:"exposure,omitempty" json:"exposure,omitempty"`
+	Outcome               Reference                          `bson:"outcome" json:"outcome"`
+	SampleSize            *RiskEvidenceSynthesisSampleSize   `bson:"sampleSize,omitempty" json:"sampleSize,omitempty"`
+	RiskEstimate          *RiskEvidenceSynthesisRiskEstimate `bson:"riskEstimate,omitempty" json:"riskEstimate,omitempty"`
+	Certainty             []RiskEvidenceSynthesisCertainty   `bson:"certainty,omitempty" json:"certainty,omitempty"`
+	UnknownFields         map[string]json.RawMessage         `bson:"-" json:"-"`
 }
 type RiskEvidenceSynthesisSampleSize struct {
-	ID                   *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description          *string     `bson:"description,omitempty" json:"description,omitempty"`
-	NumberOfStudies      *int        `bson:"numberOfStudies,omitempty" json:"numberOfStudies,omitempty"`
-	NumberOfParticipants *int        `bson:"numberOfParticipants,omitempty" json:"numberOfParticipants,omitempty"`
+	ID                          *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                   []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description                 *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement          *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	NumberOfStudies             *int                       `bson:"numberOfStudies,omitempty" json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement      *Element                   `bson:"_numberOfStudies,omitempty" json:"_numberOfStudies,omitempty"`
+	NumberOfParticipants        *int                       `bson:"numberOfParticipants,omitempty" json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *Element                   `bson:"_numberOfParticipants,omitempty" json:"_numberOfParticipants,omitempty"`
+	UnknownFields               map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the RiskEvidenceSynthesisSampleSize with its unknown fields.
+func (r RiskEvidenceSynthesisSampleSize) MarshalJSON() ([]byte, error) {
+	type other RiskEvidenceSynthesisSampleSize
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type RiskEvidenceSynthesisRiskEstimate struct {
-	ID                *string                                              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string                                              `bson:"description,omitempty" json:"description,omitempty"`
-	Type              *CodeableConcept                                     `bson:"type,omitempty" json:"type,omitempty"`
-	Value             *Decimal                                             `bson:"value,omitempty" json:"value,omitempty"`
-	UnitOfMeasure     *CodeableConcept                                     `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
-	DenominatorCount  *int                                                 `bson:"denominatorCount,omitempty" json:"denominatorCount,omitempty"`
-	NumeratorCount    *int                                                 `bson:"numeratorCount,omitempty" json:"numeratorCount,omitempty"`
-	PrecisionEstimate []RiskEvidenceSynthesisRiskEstimatePrecisionEstimate `bson:"precisionEstimate,omitempty" json:"precisionEstimate,omitempty"`
+	ID                      *string                                              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                                          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description             *string                                              `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                                             `bson:"_description,omitempty" json:"_description,omitempty"`
+	Type                    *CodeableConcept                                     `bson:"type,omitempty" json:"type,omitempty"`
+	Value                   *Decimal                                             `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement            *Element                                             `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnitOfMeasure           *CodeableConcept                                     `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
+	DenominatorCount        *int                                                 `bson:"denominatorCount,omitempty" json:"denominatorCount,omitempty"`
+	DenominatorCountElement *Element                                             `bson:"_denominatorCount,omitempty" json:"_denominatorCount,omitempty"`
+	NumeratorCount          *int                                                 `bson:"numeratorCount,omitempty" json:"numeratorCount,omitempty"`
+	NumeratorCountElement   *Element                                             `bson:"_numeratorCount,omitempty" json:"_numeratorCount,omitempty"`
+	PrecisionEstimate       []RiskEvidenceSynthesisRiskEstimatePrecisionEstimate `bson:"precisionEstimate,omitempty" json:"precisionEstimate,omitempty"`
+	UnknownFields           map[string]json.RawMessage                           `bson:"-" json:"-"`
 }
 type RiskEvidenceSynthesisRiskEstimatePrecisionEstimate struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Level             *Decimal         `bson:"level,omitempty" json:"level,omitempty"`
-	From              *Decimal         `bson:"from,omitempty" json:"from,omitempty"`
-	To                *Decimal         `bson:"to,omitempty" json:"to,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Level             *Decimal                   `bson:"level,omitempty" json:"level,omitempty"`
+	LevelElement      *Element                   `bson:"_level,omitempty" json:"_level,omitempty"`
+	From              *Decimal                   `bson:"from,omitempty" json:"from,omitempty"`
+	FromElement       *Element                   `bson:"_from,omitempty" json:"_from,omitempty"`
+	To                *Decimal                   `bson:"to,omitempty" json:"to,omitempty"`
+	ToElement         *Element                   `bson:"_to,omitempty" json:"_to,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the RiskEvidenceSynthesisRiskEstimatePrecisionEstimate with its unknown fields.
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) MarshalJSON() ([]byte, error) {
+	type other RiskEvidenceSynthesisRiskEstimatePrecisionEstimate
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the RiskEvidenceSynthesisRiskEstimate with its unknown fields.
+func (r RiskEvidenceSynthesisRiskEstimate) MarshalJSON() ([]byte, error) {
+	type other RiskEvidenceSynthesisRiskEstimate
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type RiskEvidenceSynthesisCertainty struct {
 	ID                    *string                                               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension             []Extension                                           `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -97,26 +157,62 @@ type RiskEvidenceSynthesisCertainty struct {
 	Rating                []CodeableConcept                                     `bson:"rating,omitempty" json:"rating,omitempty"`
 	Note                  []Annotation                                          `bson:"note,omitempty" json:"note,omitempty"`
 	CertaintySubcomponent []RiskEvidenceSynthesisCertaintyCertaintySubcomponent `bson:"certaintySubcomponent,omitempty" json:"certaintySubcomponent,omitempty"`
+	UnknownFields         map[string]json.RawMessage                            `bson:"-" json:"-"`
 }
 type RiskEvidenceSynthesisCertaintyCertaintySubcomponent struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Rating            []CodeableConcept `bson:"rating,omitempty" json:"rating,omitempty"`
-	Note              []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Rating            []CodeableConcept          `bson:"rating,omitempty" json:"rating,omitempty"`
+	Note              []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the RiskEvidenceSynthesisCertaintyCertaintySubcomponent with its unknown fields.
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) MarshalJSON() ([]byte, error) {
+	type other RiskEvidenceSynthesisCertaintyCertaintySubcomponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the RiskEvidenceSynthesisCertainty with its unknown fields.
+func (r RiskEvidenceSynthesisCertainty) MarshalJSON() ([]byte, error) {
+	type other RiskEvidenceSynthesisCertainty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the RiskEvidenceSynthesis and fails with CodeError when a coded element has an unknown code.
+func (r *RiskEvidenceSynthesis) UnmarshalJSON(b []byte) error {
+	type other RiskEvidenceSynthesis
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("RiskEvidenceSynthesis", r)
+}
+
 type OtherRiskEvidenceSynthesis RiskEvidenceSynthesis
 
 // MarshalJSON marshals the given RiskEvidenceSynthesis as JSON into a byte slice
 func (r RiskEvidenceSynthesis) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherRiskEvidenceSynthesis
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherRiskEvidenceSynthesis: OtherRiskEvidenceSynthesis(r),
 		ResourceType:               "RiskEvidenceSynthesis",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalRiskEvidenceSynthesis unmarshals a RiskEvidenceSynthesis.
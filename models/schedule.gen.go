@@ -22,33 +22,53 @@ import "encoding/json"
 
 // Schedule is documented here http://hl7.org/fhir/StructureDefinition/Schedule
 type Schedule struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool             `bson:"active,omitempty" json:"active,omitempty"`
-	ServiceCategory   []CodeableConcept `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
-	ServiceType       []CodeableConcept `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
-	Specialty         []CodeableConcept `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Actor             []Reference       `bson:"actor" json:"actor"`
-	PlanningHorizon   *Period           `bson:"planningHorizon,omitempty" json:"planningHorizon,omitempty"`
-	Comment           *string           `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                      `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                   `bson:"_active,omitempty" json:"_active,omitempty"`
+	ServiceCategory      []CodeableConcept          `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
+	ServiceType          []CodeableConcept          `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
+	Specialty            []CodeableConcept          `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Actor                []Reference                `bson:"actor" json:"actor"`
+	PlanningHorizon      *Period                    `bson:"planningHorizon,omitempty" json:"planningHorizon,omitempty"`
+	Comment              *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Schedule and fails with CodeError when a coded element has an unknown code.
+func (r *Schedule) UnmarshalJSON(b []byte) error {
+	type other Schedule
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Schedule", r)
+}
+
 type OtherSchedule Schedule
 
 // MarshalJSON marshals the given Schedule as JSON into a byte slice
 func (r Schedule) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSchedule
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSchedule: OtherSchedule(r),
 		ResourceType:  "Schedule",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSchedule unmarshals a Schedule.
@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.200557 +0000 UTC
+// 2026-10-19 02:55:18.930757599 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SearchComparator is documented here http://hl7.org/fhir/ValueSet/search-comparator
-type SearchComparator int
+// The empty SearchComparator is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SearchComparator string
 
 const (
-	SearchComparatorEq SearchComparator = iota
-	SearchComparatorNe
-	SearchComparatorGt
-	SearchComparatorLt
-	SearchComparatorGe
-	SearchComparatorLe
-	SearchComparatorSa
-	SearchComparatorEb
-	SearchComparatorAp
+	SearchComparatorEq SearchComparator = "eq"
+	SearchComparatorNe SearchComparator = "ne"
+	SearchComparatorGt SearchComparator = "gt"
+	SearchComparatorLt SearchComparator = "lt"
+	SearchComparatorGe SearchComparator = "ge"
+	SearchComparatorLe SearchComparator = "le"
+	SearchComparatorSa SearchComparator = "sa"
+	SearchComparatorEb SearchComparator = "eb"
+	SearchComparatorAp SearchComparator = "ap"
 )
 
 func (code SearchComparator) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SearchComparator"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SearchComparator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "eq":
-		*code = SearchComparatorEq
-	case "ne":
-		*code = SearchComparatorNe
-	case "gt":
-		*code = SearchComparatorGt
-	case "lt":
-		*code = SearchComparatorLt
-	case "ge":
-		*code = SearchComparatorGe
-	case "le":
-		*code = SearchComparatorLe
-	case "sa":
-		*code = SearchComparatorSa
-	case "eb":
-		*code = SearchComparatorEb
-	case "ap":
-		*code = SearchComparatorAp
-	default:
-		return fmt.Errorf("unknown SearchComparator code `%s`", s)
+func (code *SearchComparator) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = SearchComparator(s)
 	return nil
 }
 func (code SearchComparator) String() string {
 	return code.Code()
 }
 func (code SearchComparator) Code() string {
+	return string(code)
+}
+func (code SearchComparator) Known() bool {
 	switch code {
-	case SearchComparatorEq:
-		return "eq"
-	case SearchComparatorNe:
-		return "ne"
-	case SearchComparatorGt:
-		return "gt"
-	case SearchComparatorLt:
-		return "lt"
-	case SearchComparatorGe:
-		return "ge"
-	case SearchComparatorLe:
-		return "le"
-	case SearchComparatorSa:
-		return "sa"
-	case SearchComparatorEb:
-		return "eb"
-	case SearchComparatorAp:
-		return "ap"
+	case SearchComparatorEq, SearchComparatorNe, SearchComparatorGt, SearchComparatorLt, SearchComparatorGe, SearchComparatorLe, SearchComparatorSa, SearchComparatorEb, SearchComparatorAp:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SearchComparator) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.26859 +0000 UTC
+// 2026-10-19 02:55:18.93228087 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SearchEntryMode is documented here http://hl7.org/fhir/ValueSet/search-entry-mode
-type SearchEntryMode int
+// The empty SearchEntryMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SearchEntryMode string
 
 const (
-	SearchEntryModeMatch SearchEntryMode = iota
-	SearchEntryModeInclude
-	SearchEntryModeOutcome
+	SearchEntryModeMatch   SearchEntryMode = "match"
+	SearchEntryModeInclude SearchEntryMode = "include"
+	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
 func (code SearchEntryMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SearchEntryMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SearchEntryMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "match":
-		*code = SearchEntryModeMatch
-	case "include":
-		*code = SearchEntryModeInclude
-	case "outcome":
-		*code = SearchEntryModeOutcome
-	default:
-		return fmt.Errorf("unknown SearchEntryMode code `%s`", s)
+func (code *SearchEntryMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = SearchEntryMode(s)
 	return nil
 }
 func (code SearchEntryMode) String() string {
 	return code.Code()
 }
 func (code SearchEntryMode) Code() string {
+	return string(code)
+}
+func (code SearchEntryMode) Known() bool {
 	switch code {
-	case SearchEntryModeMatch:
-		return "match"
-	case SearchEntryModeInclude:
-		return "include"
-	case SearchEntryModeOutcome:
-		return "outcome"
+	case SearchEntryModeMatch, SearchEntryModeInclude, SearchEntryModeOutcome:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SearchEntryMode) Display() string {
 	switch code {
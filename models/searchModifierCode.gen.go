@@ -14,100 +14,61 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.183513 +0000 UTC
+// 2026-10-19 02:55:18.933491433 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SearchModifierCode is documented here http://hl7.org/fhir/ValueSet/search-modifier-code
-type SearchModifierCode int
+// The empty SearchModifierCode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SearchModifierCode string
 
 const (
-	SearchModifierCodeMissing SearchModifierCode = iota
-	SearchModifierCodeExact
-	SearchModifierCodeContains
-	SearchModifierCodeNot
-	SearchModifierCodeText
-	SearchModifierCodeIn
-	SearchModifierCodeNotIn
-	SearchModifierCodeBelow
-	SearchModifierCodeAbove
-	SearchModifierCodeType
-	SearchModifierCodeIdentifier
-	SearchModifierCodeOfType
+	SearchModifierCodeMissing    SearchModifierCode = "missing"
+	SearchModifierCodeExact      SearchModifierCode = "exact"
+	SearchModifierCodeContains   SearchModifierCode = "contains"
+	SearchModifierCodeNot        SearchModifierCode = "not"
+	SearchModifierCodeText       SearchModifierCode = "text"
+	SearchModifierCodeIn         SearchModifierCode = "in"
+	SearchModifierCodeNotIn      SearchModifierCode = "not-in"
+	SearchModifierCodeBelow      SearchModifierCode = "below"
+	SearchModifierCodeAbove      SearchModifierCode = "above"
+	SearchModifierCodeType       SearchModifierCode = "type"
+	SearchModifierCodeIdentifier SearchModifierCode = "identifier"
+	SearchModifierCodeOfType     SearchModifierCode = "ofType"
 )
 
 func (code SearchModifierCode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SearchModifierCode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SearchModifierCode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "missing":
-		*code = SearchModifierCodeMissing
-	case "exact":
-		*code = SearchModifierCodeExact
-	case "contains":
-		*code = SearchModifierCodeContains
-	case "not":
-		*code = SearchModifierCodeNot
-	case "text":
-		*code = SearchModifierCodeText
-	case "in":
-		*code = SearchModifierCodeIn
-	case "not-in":
-		*code = SearchModifierCodeNotIn
-	case "below":
-		*code = SearchModifierCodeBelow
-	case "above":
-		*code = SearchModifierCodeAbove
-	case "type":
-		*code = SearchModifierCodeType
-	case "identifier":
-		*code = SearchModifierCodeIdentifier
-	case "ofType":
-		*code = SearchModifierCodeOfType
-	default:
-		return fmt.Errorf("unknown SearchModifierCode code `%s`", s)
+func (code *SearchModifierCode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SearchModifierCode(s)
 	return nil
 }
 func (code SearchModifierCode) String() string {
 	return code.Code()
 }
 func (code SearchModifierCode) Code() string {
+	return string(code)
+}
+func (code SearchModifierCode) Known() bool {
 	switch code {
-	case SearchModifierCodeMissing:
-		return "missing"
-	case SearchModifierCodeExact:
-		return "exact"
-	case SearchModifierCodeContains:
-		return "contains"
-	case SearchModifierCodeNot:
-		return "not"
-	case SearchModifierCodeText:
-		return "text"
-	case SearchModifierCodeIn:
-		return "in"
-	case SearchModifierCodeNotIn:
-		return "not-in"
-	case SearchModifierCodeBelow:
-		return "below"
-	case SearchModifierCodeAbove:
-		return "above"
-	case SearchModifierCodeType:
-		return "type"
-	case SearchModifierCodeIdentifier:
-		return "identifier"
-	case SearchModifierCodeOfType:
-		return "ofType"
+	case SearchModifierCodeMissing, SearchModifierCodeExact, SearchModifierCodeContains, SearchModifierCodeNot, SearchModifierCodeText, SearchModifierCodeIn, SearchModifierCodeNotIn, SearchModifierCodeBelow, SearchModifierCodeAbove, SearchModifierCodeType, SearchModifierCodeIdentifier, SearchModifierCodeOfType:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SearchModifierCode) Display() string {
 	switch code {
@@ -14,85 +14,58 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.008413 +0000 UTC
+// 2026-10-19 02:55:18.934901253 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SearchParamType is documented here http://hl7.org/fhir/ValueSet/search-param-type
-type SearchParamType int
+// The empty SearchParamType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SearchParamType string
 
 const (
-	SearchParamTypeNumber SearchParamType = iota
-	SearchParamTypeDate
-	SearchParamTypeString
-	SearchParamTypeToken
-	SearchParamTypeReference
-	SearchParamTypeComposite
-	SearchParamTypeQuantity
-	SearchParamTypeUri
-	SearchParamTypeSpecial
+	SearchParamTypeNumber    SearchParamType = "number"
+	SearchParamTypeDate      SearchParamType = "date"
+	SearchParamTypeString    SearchParamType = "string"
+	SearchParamTypeToken     SearchParamType = "token"
+	SearchParamTypeReference SearchParamType = "reference"
+	SearchParamTypeComposite SearchParamType = "composite"
+	SearchParamTypeQuantity  SearchParamType = "quantity"
+	SearchParamTypeUri       SearchParamType = "uri"
+	SearchParamTypeSpecial   SearchParamType = "special"
 )
 
 func (code SearchParamType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SearchParamType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SearchParamType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "number":
-		*code = SearchParamTypeNumber
-	case "date":
-		*code = SearchParamTypeDate
-	case "string":
-		*code = SearchParamTypeString
-	case "token":
-		*code = SearchParamTypeToken
-	case "reference":
-		*code = SearchParamTypeReference
-	case "composite":
-		*code = SearchParamTypeComposite
-	case "quantity":
-		*code = SearchParamTypeQuantity
-	case "uri":
-		*code = SearchParamTypeUri
-	case "special":
-		*code = SearchParamTypeSpecial
-	default:
-		return fmt.Errorf("unknown SearchParamType code `%s`", s)
+func (code *SearchParamType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = SearchParamType(s)
 	return nil
 }
 func (code SearchParamType) String() string {
 	return code.Code()
 }
 func (code SearchParamType) Code() string {
+	return string(code)
+}
+func (code SearchParamType) Known() bool {
 	switch code {
-	case SearchParamTypeNumber:
-		return "number"
-	case SearchParamTypeDate:
-		return "date"
-	case SearchParamTypeString:
-		return "string"
-	case SearchParamTypeToken:
-		return "token"
-	case SearchParamTypeReference:
-		return "reference"
-	case SearchParamTypeComposite:
-		return "composite"
-	case SearchParamTypeQuantity:
-		return "quantity"
-	case SearchParamTypeUri:
-		return "uri"
-	case SearchParamTypeSpecial:
-		return "special"
+	case SearchParamTypeNumber, SearchParamTypeDate, SearchParamTypeString, SearchParamTypeToken, SearchParamTypeReference, SearchParamTypeComposite, SearchParamTypeQuantity, SearchParamTypeUri, SearchParamTypeSpecial:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SearchParamType) Display() string {
 	switch code {
@@ -22,58 +22,126 @@ import "encoding/json"
 
 // SearchParameter is documented here http://hl7.org/fhir/StructureDefinition/SearchParameter
 type SearchParameter struct {
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  string                     `bson:"url" json:"url"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	DerivedFrom          *string                    `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	DerivedFromElement   *Element                   `bson:"_derivedFrom,omitempty" json:"_derivedFrom,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          string                     `bson:"description" json:"description"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Code                 string                     `bson:"code" json:"code"`
+	CodeElement          *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Base                 []*ResourceType            `bson:"base" json:"base"`
+	BaseElement          []*Element                 `bson:"_base,omitempty" json:"_base,omitempty"`
+	Type                 SearchParamType            `bson:"type" json:"type"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Expression           *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement    *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Xpath                *string                    `bson:"xpath,omitempty" json:"xpath,omitempty"`
+	XpathElement         *Element                   `bson:"_xpath,omitempty" json:"_xpath,omitempty"`
+	XpathUsage           *XPathUsageType            `bson:"xpathUsage,omitempty" json:"xpathUsage,omitempty"`
+	XpathUsageElement    *Element                   `bson:"_xpathUsage,omitempty" json:"_xpathUsage,omitempty"`
+	Target               []*ResourceType            `bson:"target,omitempty" json:"target,omitempty"`
+	TargetElement        []*Element                 `bson:"_target,omitempty" json:"_target,omitempty"`
+	MultipleOr           *bool                      `bson:"multipleOr,omitempty" json:"multipleOr,omitempty"`
+	MultipleOrElement    *Element                   `bson:"_multipleOr,omitempty" json:"_multipleOr,omitempty"`
+	MultipleAnd          *bool                      `bson:"multipleAnd,omitempty" json:"multipleAnd,omitempty"`
+	MultipleAndElement   *Element                   `bson:"_multipleAnd,omitempty" json:"_multipleAnd,omitempty"`
+	Comparator           []*SearchComparator        `bson:"comparator,omitempty" json:"comparator,omitempty"`
+	ComparatorElement    []*Element                 `bson:"_comparator,omitempty" json:"_comparator,omitempty"`
+	Modifier             []*SearchModifierCode      `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ModifierElement      []*Element                 `bson:"_modifier,omitempty" json:"_modifier,omitempty"`
+	Chain                []*string                  `bson:"chain,omitempty" json:"chain,omitempty"`
+	ChainElement         []*Element                 `bson:"_chain,omitempty" json:"_chain,omitempty"`
+	Component            []SearchParameterComponent `bson:"component,omitempty" json:"component,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+type SearchParameterComponent struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                     `bson:"url" json:"url"`
-	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                     `bson:"name" json:"name"`
-	DerivedFrom       *string                    `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	Status            PublicationStatus          `bson:"status" json:"status"`
-	Experimental      *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       string                     `bson:"description" json:"description"`
-	UseContext        []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Code              string                     `bson:"code" json:"code"`
-	Base              []ResourceType             `bson:"base" json:"base"`
-	Type              SearchParamType            `bson:"type" json:"type"`
-	Expression        *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
-	Xpath             *string                    `bson:"xpath,omitempty" json:"xpath,omitempty"`
-	XpathUsage        *XPathUsageType            `bson:"xpathUsage,omitempty" json:"xpathUsage,omitempty"`
-	Target            []ResourceType             `bson:"target,omitempty" json:"target,omitempty"`
-	MultipleOr        *bool                      `bson:"multipleOr,omitempty" json:"multipleOr,omitempty"`
-	MultipleAnd       *bool                      `bson:"multipleAnd,omitempty" json:"multipleAnd,omitempty"`
-	Comparator        []SearchComparator         `bson:"comparator,omitempty" json:"comparator,omitempty"`
-	Modifier          []SearchModifierCode       `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Chain             []string                   `bson:"chain,omitempty" json:"chain,omitempty"`
-	Component         []SearchParameterComponent `bson:"component,omitempty" json:"component,omitempty"`
+	Definition        string                     `bson:"definition" json:"definition"`
+	DefinitionElement *Element                   `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Expression        string                     `bson:"expression" json:"expression"`
+	ExpressionElement *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
-type SearchParameterComponent struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Definition        string      `bson:"definition" json:"definition"`
-	Expression        string      `bson:"expression" json:"expression"`
+
+// MarshalJSON marshals the SearchParameterComponent with its unknown fields.
+func (r SearchParameterComponent) MarshalJSON() ([]byte, error) {
+	type other SearchParameterComponent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the SearchParameter and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *SearchParameter) UnmarshalJSON(b []byte) error {
+	type other SearchParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("SearchParameter.base", len(r.Base), len(r.BaseElement)); err != nil {
+		return err
+	}
+	if err := checkElements("SearchParameter.target", len(r.Target), len(r.TargetElement)); err != nil {
+		return err
+	}
+	if err := checkElements("SearchParameter.comparator", len(r.Comparator), len(r.ComparatorElement)); err != nil {
+		return err
+	}
+	if err := checkElements("SearchParameter.modifier", len(r.Modifier), len(r.ModifierElement)); err != nil {
+		return err
+	}
+	if err := checkElements("SearchParameter.chain", len(r.Chain), len(r.ChainElement)); err != nil {
+		return err
+	}
+	return checkCodes("SearchParameter", r)
+}
+
 type OtherSearchParameter SearchParameter
 
 // MarshalJSON marshals the given SearchParameter as JSON into a byte slice
 func (r SearchParameter) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSearchParameter
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSearchParameter: OtherSearchParameter(r),
 		ResourceType:         "SearchParameter",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSearchParameter unmarshals a SearchParameter.
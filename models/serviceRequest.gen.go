@@ -22,55 +22,221 @@ import "encoding/json"
 
 // ServiceRequest is documented here http://hl7.org/fhir/StructureDefinition/ServiceRequest
 type ServiceRequest struct {
-	ID                    *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string          `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string          `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces              []Reference       `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	Requisition           *Identifier       `bson:"requisition,omitempty" json:"requisition,omitempty"`
-	Status                RequestStatus     `bson:"status" json:"status"`
-	Intent                RequestIntent     `bson:"intent" json:"intent"`
-	Category              []CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
-	Priority              *RequestPriority  `bson:"priority,omitempty" json:"priority,omitempty"`
-	DoNotPerform          *bool             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Code                  *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
-	OrderDetail           []CodeableConcept `bson:"orderDetail,omitempty" json:"orderDetail,omitempty"`
-	Subject               Reference         `bson:"subject" json:"subject"`
-	Encounter             *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	AuthoredOn            *DateTime         `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester             *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
-	PerformerType         *CodeableConcept  `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Performer             []Reference       `bson:"performer,omitempty" json:"performer,omitempty"`
-	LocationCode          []CodeableConcept `bson:"locationCode,omitempty" json:"locationCode,omitempty"`
-	LocationReference     []Reference       `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
-	ReasonCode            []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Insurance             []Reference       `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	SupportingInfo        []Reference       `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Specimen              []Reference       `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	BodySite              []CodeableConcept `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Note                  []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-	PatientInstruction    *string           `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
-	RelevantHistory       []Reference       `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []*string                  `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                 `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []*string                  `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                 `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                     []Reference                `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	Requisition                  *Identifier                `bson:"requisition,omitempty" json:"requisition,omitempty"`
+	Status                       RequestStatus              `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent              `bson:"intent" json:"intent"`
+	IntentElement                *Element                   `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Category                     []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                     *RequestPriority           `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	DoNotPerform                 *bool                      `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element                   `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	Code                         *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	OrderDetail                  []CodeableConcept          `bson:"orderDetail,omitempty" json:"orderDetail,omitempty"`
+	QuantityQuantity             *Quantity                  `bson:"quantityQuantity,omitempty" json:"quantityQuantity,omitempty"`
+	QuantityRatio                *Ratio                     `bson:"quantityRatio,omitempty" json:"quantityRatio,omitempty"`
+	QuantityRange                *Range                     `bson:"quantityRange,omitempty" json:"quantityRange,omitempty"`
+	Subject                      Reference                  `bson:"subject" json:"subject"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime           *DateTime                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement    *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod             *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming             *Timing                    `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	AsNeededBoolean              *bool                      `bson:"asNeededBoolean,omitempty" json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement       *Element                   `bson:"_asNeededBoolean,omitempty" json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept      *CodeableConcept           `bson:"asNeededCodeableConcept,omitempty" json:"asNeededCodeableConcept,omitempty"`
+	AuthoredOn                   *DateTime                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                   `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                    *Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
+	PerformerType                *CodeableConcept           `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Performer                    []Reference                `bson:"performer,omitempty" json:"performer,omitempty"`
+	LocationCode                 []CodeableConcept          `bson:"locationCode,omitempty" json:"locationCode,omitempty"`
+	LocationReference            []Reference                `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	ReasonCode                   []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Insurance                    []Reference                `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	SupportingInfo               []Reference                `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Specimen                     []Reference                `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	BodySite                     []CodeableConcept          `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	PatientInstruction           *string                    `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
+	PatientInstructionElement    *Element                   `bson:"_patientInstruction,omitempty" json:"_patientInstruction,omitempty"`
+	RelevantHistory              []Reference                `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Quantity returns ServiceRequest.quantity[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *ServiceRequest) Quantity() interface{} {
+	switch {
+	case r.QuantityQuantity != nil:
+		return r.QuantityQuantity
+	case r.QuantityRatio != nil:
+		return r.QuantityRatio
+	case r.QuantityRange != nil:
+		return r.QuantityRange
+	}
+	return nil
+}
+
+// SetQuantityQuantity sets ServiceRequest.quantity[x] to the Quantity and clears the other types.
+func (r *ServiceRequest) SetQuantityQuantity(v Quantity) {
+	r.clearQuantity()
+	r.QuantityQuantity = &v
+}
+
+// SetQuantityRatio sets ServiceRequest.quantity[x] to the Ratio and clears the other types.
+func (r *ServiceRequest) SetQuantityRatio(v Ratio) {
+	r.clearQuantity()
+	r.QuantityRatio = &v
+}
+
+// SetQuantityRange sets ServiceRequest.quantity[x] to the Range and clears the other types.
+func (r *ServiceRequest) SetQuantityRange(v Range) {
+	r.clearQuantity()
+	r.QuantityRange = &v
+}
+func (r *ServiceRequest) clearQuantity() {
+	r.QuantityQuantity = nil
+	r.QuantityRatio = nil
+	r.QuantityRange = nil
+}
+
+// Occurrence returns ServiceRequest.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *ServiceRequest) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	case r.OccurrenceTiming != nil:
+		return r.OccurrenceTiming
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets ServiceRequest.occurrence[x] to the dateTime and clears the other types.
+func (r *ServiceRequest) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets ServiceRequest.occurrence[x] to the Period and clears the other types.
+func (r *ServiceRequest) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+
+// SetOccurrenceTiming sets ServiceRequest.occurrence[x] to the Timing and clears the other types.
+func (r *ServiceRequest) SetOccurrenceTiming(v Timing) {
+	r.clearOccurrence()
+	r.OccurrenceTiming = &v
+}
+func (r *ServiceRequest) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+}
+
+// AsNeeded returns ServiceRequest.asNeeded[x] of the set type, such as *bool, or nil when it is not set.
+func (r *ServiceRequest) AsNeeded() interface{} {
+	switch {
+	case r.AsNeededBoolean != nil:
+		return r.AsNeededBoolean
+	case r.AsNeededCodeableConcept != nil:
+		return r.AsNeededCodeableConcept
+	}
+	return nil
+}
+
+// SetAsNeededBoolean sets ServiceRequest.asNeeded[x] to the boolean and clears the other types.
+func (r *ServiceRequest) SetAsNeededBoolean(v bool) {
+	r.clearAsNeeded()
+	r.AsNeededBoolean = &v
+}
+
+// SetAsNeededCodeableConcept sets ServiceRequest.asNeeded[x] to the CodeableConcept and clears the other types.
+func (r *ServiceRequest) SetAsNeededCodeableConcept(v CodeableConcept) {
+	r.clearAsNeeded()
+	r.AsNeededCodeableConcept = &v
+}
+func (r *ServiceRequest) clearAsNeeded() {
+	r.AsNeededBoolean = nil
+	r.AsNeededBooleanElement = nil
+	r.AsNeededCodeableConcept = nil
+}
+
+// UnmarshalJSON unmarshals the ServiceRequest and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *ServiceRequest) UnmarshalJSON(b []byte) error {
+	type other ServiceRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ServiceRequest.quantity[x]",
+		r.QuantityQuantity != nil,
+		r.QuantityRatio != nil,
+		r.QuantityRange != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ServiceRequest.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+		r.OccurrenceTiming != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ServiceRequest.asNeeded[x]",
+		r.AsNeededBoolean != nil || r.AsNeededBooleanElement != nil,
+		r.AsNeededCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("ServiceRequest.instantiatesCanonical", len(r.InstantiatesCanonical), len(r.InstantiatesCanonicalElement)); err != nil {
+		return err
+	}
+	if err := checkElements("ServiceRequest.instantiatesUri", len(r.InstantiatesUri), len(r.InstantiatesUriElement)); err != nil {
+		return err
+	}
+	return checkCodes("ServiceRequest", r)
+}
+
 type OtherServiceRequest ServiceRequest
 
 // MarshalJSON marshals the given ServiceRequest as JSON into a byte slice
 func (r ServiceRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherServiceRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherServiceRequest: OtherServiceRequest(r),
 		ResourceType:        "ServiceRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalServiceRequest unmarshals a ServiceRequest.
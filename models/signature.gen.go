@@ -20,13 +20,17 @@ package models
 
 // Signature is documented here http://hl7.org/fhir/StructureDefinition/Signature
 type Signature struct {
-	ID           *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type         []Coding    `bson:"type" json:"type"`
-	When         string      `bson:"when" json:"when"`
-	Who          Reference   `bson:"who" json:"who"`
-	OnBehalfOf   *Reference  `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
-	TargetFormat *string     `bson:"targetFormat,omitempty" json:"targetFormat,omitempty"`
-	SigFormat    *string     `bson:"sigFormat,omitempty" json:"sigFormat,omitempty"`
-	Data         *string     `bson:"data,omitempty" json:"data,omitempty"`
+	ID                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type                []Coding    `bson:"type" json:"type"`
+	When                Instant     `bson:"when" json:"when"`
+	WhenElement         *Element    `bson:"_when,omitempty" json:"_when,omitempty"`
+	Who                 Reference   `bson:"who" json:"who"`
+	OnBehalfOf          *Reference  `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	TargetFormat        *string     `bson:"targetFormat,omitempty" json:"targetFormat,omitempty"`
+	TargetFormatElement *Element    `bson:"_targetFormat,omitempty" json:"_targetFormat,omitempty"`
+	SigFormat           *string     `bson:"sigFormat,omitempty" json:"sigFormat,omitempty"`
+	SigFormatElement    *Element    `bson:"_sigFormat,omitempty" json:"_sigFormat,omitempty"`
+	Data                *string     `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement         *Element    `bson:"_data,omitempty" json:"_data,omitempty"`
 }
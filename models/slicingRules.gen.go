@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.293204 +0000 UTC
+// 2026-10-19 02:55:18.936132218 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SlicingRules is documented here http://hl7.org/fhir/ValueSet/resource-slicing-rules
-type SlicingRules int
+// The empty SlicingRules is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SlicingRules string
 
 const (
-	SlicingRulesClosed SlicingRules = iota
-	SlicingRulesOpen
-	SlicingRulesOpenAtEnd
+	SlicingRulesClosed    SlicingRules = "closed"
+	SlicingRulesOpen      SlicingRules = "open"
+	SlicingRulesOpenAtEnd SlicingRules = "openAtEnd"
 )
 
 func (code SlicingRules) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SlicingRules"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SlicingRules) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "closed":
-		*code = SlicingRulesClosed
-	case "open":
-		*code = SlicingRulesOpen
-	case "openAtEnd":
-		*code = SlicingRulesOpenAtEnd
-	default:
-		return fmt.Errorf("unknown SlicingRules code `%s`", s)
+func (code *SlicingRules) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = SlicingRules(s)
 	return nil
 }
 func (code SlicingRules) String() string {
 	return code.Code()
 }
 func (code SlicingRules) Code() string {
+	return string(code)
+}
+func (code SlicingRules) Known() bool {
 	switch code {
-	case SlicingRulesClosed:
-		return "closed"
-	case SlicingRulesOpen:
-		return "open"
-	case SlicingRulesOpenAtEnd:
-		return "openAtEnd"
+	case SlicingRulesClosed, SlicingRulesOpen, SlicingRulesOpenAtEnd:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SlicingRules) Display() string {
 	switch code {
@@ -22,36 +22,59 @@ import "encoding/json"
 
 // Slot is documented here http://hl7.org/fhir/StructureDefinition/Slot
 type Slot struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ServiceCategory   []CodeableConcept `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
-	ServiceType       []CodeableConcept `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
-	Specialty         []CodeableConcept `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	AppointmentType   *CodeableConcept  `bson:"appointmentType,omitempty" json:"appointmentType,omitempty"`
-	Schedule          Reference         `bson:"schedule" json:"schedule"`
-	Status            SlotStatus        `bson:"status" json:"status"`
-	Start             string            `bson:"start" json:"start"`
-	End               string            `bson:"end" json:"end"`
-	Overbooked        *bool             `bson:"overbooked,omitempty" json:"overbooked,omitempty"`
-	Comment           *string           `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ServiceCategory      []CodeableConcept          `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
+	ServiceType          []CodeableConcept          `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
+	Specialty            []CodeableConcept          `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	AppointmentType      *CodeableConcept           `bson:"appointmentType,omitempty" json:"appointmentType,omitempty"`
+	Schedule             Reference                  `bson:"schedule" json:"schedule"`
+	Status               SlotStatus                 `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Start                Instant                    `bson:"start" json:"start"`
+	StartElement         *Element                   `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                  Instant                    `bson:"end" json:"end"`
+	EndElement           *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	Overbooked           *bool                      `bson:"overbooked,omitempty" json:"overbooked,omitempty"`
+	OverbookedElement    *Element                   `bson:"_overbooked,omitempty" json:"_overbooked,omitempty"`
+	Comment              *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the Slot and fails with CodeError when a coded element has an unknown code.
+func (r *Slot) UnmarshalJSON(b []byte) error {
+	type other Slot
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Slot", r)
+}
+
 type OtherSlot Slot
 
 // MarshalJSON marshals the given Slot as JSON into a byte slice
 func (r Slot) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSlot
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSlot:    OtherSlot(r),
 		ResourceType: "Slot",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSlot unmarshals a Slot.
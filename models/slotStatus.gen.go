@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.02431 +0000 UTC
+// 2026-10-19 02:55:18.937038742 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SlotStatus is documented here http://hl7.org/fhir/ValueSet/slotstatus
-type SlotStatus int
+// The empty SlotStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SlotStatus string
 
 const (
-	SlotStatusBusy SlotStatus = iota
-	SlotStatusFree
-	SlotStatusBusyUnavailable
-	SlotStatusBusyTentative
-	SlotStatusEnteredInError
+	SlotStatusBusy            SlotStatus = "busy"
+	SlotStatusFree            SlotStatus = "free"
+	SlotStatusBusyUnavailable SlotStatus = "busy-unavailable"
+	SlotStatusBusyTentative   SlotStatus = "busy-tentative"
+	SlotStatusEnteredInError  SlotStatus = "entered-in-error"
 )
 
 func (code SlotStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SlotStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SlotStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "busy":
-		*code = SlotStatusBusy
-	case "free":
-		*code = SlotStatusFree
-	case "busy-unavailable":
-		*code = SlotStatusBusyUnavailable
-	case "busy-tentative":
-		*code = SlotStatusBusyTentative
-	case "entered-in-error":
-		*code = SlotStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown SlotStatus code `%s`", s)
+func (code *SlotStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SlotStatus(s)
 	return nil
 }
 func (code SlotStatus) String() string {
 	return code.Code()
 }
 func (code SlotStatus) Code() string {
+	return string(code)
+}
+func (code SlotStatus) Known() bool {
 	switch code {
-	case SlotStatusBusy:
-		return "busy"
-	case SlotStatusFree:
-		return "free"
-	case SlotStatusBusyUnavailable:
-		return "busy-unavailable"
-	case SlotStatusBusyTentative:
-		return "busy-tentative"
-	case SlotStatusEnteredInError:
-		return "entered-in-error"
+	case SlotStatusBusy, SlotStatusFree, SlotStatusBusyUnavailable, SlotStatusBusyTentative, SlotStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SlotStatus) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.11682 +0000 UTC
+// 2026-10-19 02:55:18.938048277 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SortDirection is documented here http://hl7.org/fhir/ValueSet/sort-direction
-type SortDirection int
+// The empty SortDirection is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SortDirection string
 
 const (
-	SortDirectionAscending SortDirection = iota
-	SortDirectionDescending
+	SortDirectionAscending  SortDirection = "ascending"
+	SortDirectionDescending SortDirection = "descending"
 )
 
 func (code SortDirection) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SortDirection"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SortDirection) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "ascending":
-		*code = SortDirectionAscending
-	case "descending":
-		*code = SortDirectionDescending
-	default:
-		return fmt.Errorf("unknown SortDirection code `%s`", s)
+func (code *SortDirection) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = SortDirection(s)
 	return nil
 }
 func (code SortDirection) String() string {
 	return code.Code()
 }
 func (code SortDirection) Code() string {
+	return string(code)
+}
+func (code SortDirection) Known() bool {
 	switch code {
-	case SortDirectionAscending:
-		return "ascending"
-	case SortDirectionDescending:
-		return "descending"
+	case SortDirectionAscending, SortDirectionDescending:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SortDirection) Display() string {
 	switch code {
@@ -22,66 +22,298 @@ import "encoding/json"
 
 // Specimen is documented here http://hl7.org/fhir/StructureDefinition/Specimen
 type Specimen struct {
-	ID                  *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	AccessionIdentifier *Identifier          `bson:"accessionIdentifier,omitempty" json:"accessionIdentifier,omitempty"`
-	Status              *SpecimenStatus      `bson:"status,omitempty" json:"status,omitempty"`
-	Type                *CodeableConcept     `bson:"type,omitempty" json:"type,omitempty"`
-	Subject             *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	ReceivedTime        *DateTime            `bson:"receivedTime,omitempty" json:"receivedTime,omitempty"`
-	Parent              []Reference          `bson:"parent,omitempty" json:"parent,omitempty"`
-	Request             []Reference          `bson:"request,omitempty" json:"request,omitempty"`
-	Collection          *SpecimenCollection  `bson:"collection,omitempty" json:"collection,omitempty"`
-	Processing          []SpecimenProcessing `bson:"processing,omitempty" json:"processing,omitempty"`
-	Container           []SpecimenContainer  `bson:"container,omitempty" json:"container,omitempty"`
-	Condition           []CodeableConcept    `bson:"condition,omitempty" json:"condition,omitempty"`
-	Note                []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	AccessionIdentifier  *Identifier                `bson:"accessionIdentifier,omitempty" json:"accessionIdentifier,omitempty"`
+	Status               *SpecimenStatus            `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Subject              *Reference                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	ReceivedTime         *DateTime                  `bson:"receivedTime,omitempty" json:"receivedTime,omitempty"`
+	ReceivedTimeElement  *Element                   `bson:"_receivedTime,omitempty" json:"_receivedTime,omitempty"`
+	Parent               []Reference                `bson:"parent,omitempty" json:"parent,omitempty"`
+	Request              []Reference                `bson:"request,omitempty" json:"request,omitempty"`
+	Collection           *SpecimenCollection        `bson:"collection,omitempty" json:"collection,omitempty"`
+	Processing           []SpecimenProcessing       `bson:"processing,omitempty" json:"processing,omitempty"`
+	Container            []SpecimenContainer        `bson:"container,omitempty" json:"container,omitempty"`
+	Condition            []CodeableConcept          `bson:"condition,omitempty" json:"condition,omitempty"`
+	Note                 []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type SpecimenCollection struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Collector         *Reference       `bson:"collector,omitempty" json:"collector,omitempty"`
-	Duration          *Duration        `bson:"duration,omitempty" json:"duration,omitempty"`
-	Quantity          *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Method            *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
-	BodySite          *CodeableConcept `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Collector                    *Reference                 `bson:"collector,omitempty" json:"collector,omitempty"`
+	CollectedDateTime            *DateTime                  `bson:"collectedDateTime,omitempty" json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement     *Element                   `bson:"_collectedDateTime,omitempty" json:"_collectedDateTime,omitempty"`
+	CollectedPeriod              *Period                    `bson:"collectedPeriod,omitempty" json:"collectedPeriod,omitempty"`
+	Duration                     *Duration                  `bson:"duration,omitempty" json:"duration,omitempty"`
+	Quantity                     *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Method                       *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
+	BodySite                     *CodeableConcept           `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	FastingStatusCodeableConcept *CodeableConcept           `bson:"fastingStatusCodeableConcept,omitempty" json:"fastingStatusCodeableConcept,omitempty"`
+	FastingStatusDuration        *Duration                  `bson:"fastingStatusDuration,omitempty" json:"fastingStatusDuration,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Collected returns Specimen.collection.collected[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *SpecimenCollection) Collected() interface{} {
+	switch {
+	case r.CollectedDateTime != nil:
+		return r.CollectedDateTime
+	case r.CollectedPeriod != nil:
+		return r.CollectedPeriod
+	}
+	return nil
+}
+
+// SetCollectedDateTime sets Specimen.collection.collected[x] to the dateTime and clears the other types.
+func (r *SpecimenCollection) SetCollectedDateTime(v DateTime) {
+	r.clearCollected()
+	r.CollectedDateTime = &v
+}
+
+// SetCollectedPeriod sets Specimen.collection.collected[x] to the Period and clears the other types.
+func (r *SpecimenCollection) SetCollectedPeriod(v Period) {
+	r.clearCollected()
+	r.CollectedPeriod = &v
+}
+func (r *SpecimenCollection) clearCollected() {
+	r.CollectedDateTime = nil
+	r.CollectedDateTimeElement = nil
+	r.CollectedPeriod = nil
+}
+
+// FastingStatus returns Specimen.collection.fastingStatus[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SpecimenCollection) FastingStatus() interface{} {
+	switch {
+	case r.FastingStatusCodeableConcept != nil:
+		return r.FastingStatusCodeableConcept
+	case r.FastingStatusDuration != nil:
+		return r.FastingStatusDuration
+	}
+	return nil
+}
+
+// SetFastingStatusCodeableConcept sets Specimen.collection.fastingStatus[x] to the CodeableConcept and clears the other types.
+func (r *SpecimenCollection) SetFastingStatusCodeableConcept(v CodeableConcept) {
+	r.clearFastingStatus()
+	r.FastingStatusCodeableConcept = &v
+}
+
+// SetFastingStatusDuration sets Specimen.collection.fastingStatus[x] to the Duration and clears the other types.
+func (r *SpecimenCollection) SetFastingStatusDuration(v Duration) {
+	r.clearFastingStatus()
+	r.FastingStatusDuration = &v
+}
+func (r *SpecimenCollection) clearFastingStatus() {
+	r.FastingStatusCodeableConcept = nil
+	r.FastingStatusDuration = nil
+}
+
+// UnmarshalJSON unmarshals the SpecimenCollection and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SpecimenCollection) UnmarshalJSON(b []byte) error {
+	type other SpecimenCollection
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Specimen.collection.collected[x]",
+		r.CollectedDateTime != nil || r.CollectedDateTimeElement != nil,
+		r.CollectedPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Specimen.collection.fastingStatus[x]",
+		r.FastingStatusCodeableConcept != nil,
+		r.FastingStatusDuration != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SpecimenCollection with its unknown fields.
+func (r SpecimenCollection) MarshalJSON() ([]byte, error) {
+	type other SpecimenCollection
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SpecimenProcessing struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Procedure         *CodeableConcept `bson:"procedure,omitempty" json:"procedure,omitempty"`
-	Additive          []Reference      `bson:"additive,omitempty" json:"additive,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description         *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Procedure           *CodeableConcept           `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	Additive            []Reference                `bson:"additive,omitempty" json:"additive,omitempty"`
+	TimeDateTime        *DateTime                  `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *Element                   `bson:"_timeDateTime,omitempty" json:"_timeDateTime,omitempty"`
+	TimePeriod          *Period                    `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Time returns Specimen.processing.time[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *SpecimenProcessing) Time() interface{} {
+	switch {
+	case r.TimeDateTime != nil:
+		return r.TimeDateTime
+	case r.TimePeriod != nil:
+		return r.TimePeriod
+	}
+	return nil
 }
+
+// SetTimeDateTime sets Specimen.processing.time[x] to the dateTime and clears the other types.
+func (r *SpecimenProcessing) SetTimeDateTime(v DateTime) {
+	r.clearTime()
+	r.TimeDateTime = &v
+}
+
+// SetTimePeriod sets Specimen.processing.time[x] to the Period and clears the other types.
+func (r *SpecimenProcessing) SetTimePeriod(v Period) {
+	r.clearTime()
+	r.TimePeriod = &v
+}
+func (r *SpecimenProcessing) clearTime() {
+	r.TimeDateTime = nil
+	r.TimeDateTimeElement = nil
+	r.TimePeriod = nil
+}
+
+// UnmarshalJSON unmarshals the SpecimenProcessing and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SpecimenProcessing) UnmarshalJSON(b []byte) error {
+	type other SpecimenProcessing
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Specimen.processing.time[x]",
+		r.TimeDateTime != nil || r.TimeDateTimeElement != nil,
+		r.TimePeriod != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SpecimenProcessing with its unknown fields.
+func (r SpecimenProcessing) MarshalJSON() ([]byte, error) {
+	type other SpecimenProcessing
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SpecimenContainer struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Capacity          *Quantity        `bson:"capacity,omitempty" json:"capacity,omitempty"`
-	SpecimenQuantity  *Quantity        `bson:"specimenQuantity,omitempty" json:"specimenQuantity,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier              []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Description             *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Type                    *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Capacity                *Quantity                  `bson:"capacity,omitempty" json:"capacity,omitempty"`
+	SpecimenQuantity        *Quantity                  `bson:"specimenQuantity,omitempty" json:"specimenQuantity,omitempty"`
+	AdditiveCodeableConcept *CodeableConcept           `bson:"additiveCodeableConcept,omitempty" json:"additiveCodeableConcept,omitempty"`
+	AdditiveReference       *Reference                 `bson:"additiveReference,omitempty" json:"additiveReference,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Additive returns Specimen.container.additive[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SpecimenContainer) Additive() interface{} {
+	switch {
+	case r.AdditiveCodeableConcept != nil:
+		return r.AdditiveCodeableConcept
+	case r.AdditiveReference != nil:
+		return r.AdditiveReference
+	}
+	return nil
+}
+
+// SetAdditiveCodeableConcept sets Specimen.container.additive[x] to the CodeableConcept and clears the other types.
+func (r *SpecimenContainer) SetAdditiveCodeableConcept(v CodeableConcept) {
+	r.clearAdditive()
+	r.AdditiveCodeableConcept = &v
 }
+
+// SetAdditiveReference sets Specimen.container.additive[x] to the Reference and clears the other types.
+func (r *SpecimenContainer) SetAdditiveReference(v Reference) {
+	r.clearAdditive()
+	r.AdditiveReference = &v
+}
+func (r *SpecimenContainer) clearAdditive() {
+	r.AdditiveCodeableConcept = nil
+	r.AdditiveReference = nil
+}
+
+// UnmarshalJSON unmarshals the SpecimenContainer and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SpecimenContainer) UnmarshalJSON(b []byte) error {
+	type other SpecimenContainer
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Specimen.container.additive[x]",
+		r.AdditiveCodeableConcept != nil,
+		r.AdditiveReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SpecimenContainer with its unknown fields.
+func (r SpecimenContainer) MarshalJSON() ([]byte, error) {
+	type other SpecimenContainer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Specimen and fails with CodeError when a coded element has an unknown code.
+func (r *Specimen) UnmarshalJSON(b []byte) error {
+	type other Specimen
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Specimen", r)
+}
+
 type OtherSpecimen Specimen
 
 // MarshalJSON marshals the given Specimen as JSON into a byte slice
 func (r Specimen) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSpecimen
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSpecimen: OtherSpecimen(r),
 		ResourceType:  "Specimen",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSpecimen unmarshals a Specimen.
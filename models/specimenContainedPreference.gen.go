@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.059637 +0000 UTC
+// 2026-10-19 02:55:18.938919748 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SpecimenContainedPreference is documented here http://hl7.org/fhir/ValueSet/specimen-contained-preference
-type SpecimenContainedPreference int
+// The empty SpecimenContainedPreference is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SpecimenContainedPreference string
 
 const (
-	SpecimenContainedPreferencePreferred SpecimenContainedPreference = iota
-	SpecimenContainedPreferenceAlternate
+	SpecimenContainedPreferencePreferred SpecimenContainedPreference = "preferred"
+	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
 func (code SpecimenContainedPreference) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SpecimenContainedPreference"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SpecimenContainedPreference) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "preferred":
-		*code = SpecimenContainedPreferencePreferred
-	case "alternate":
-		*code = SpecimenContainedPreferenceAlternate
-	default:
-		return fmt.Errorf("unknown SpecimenContainedPreference code `%s`", s)
+func (code *SpecimenContainedPreference) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = SpecimenContainedPreference(s)
 	return nil
 }
 func (code SpecimenContainedPreference) String() string {
 	return code.Code()
 }
 func (code SpecimenContainedPreference) Code() string {
+	return string(code)
+}
+func (code SpecimenContainedPreference) Known() bool {
 	switch code {
-	case SpecimenContainedPreferencePreferred:
-		return "preferred"
-	case SpecimenContainedPreferenceAlternate:
-		return "alternate"
+	case SpecimenContainedPreferencePreferred, SpecimenContainedPreferenceAlternate:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SpecimenContainedPreference) Display() string {
 	switch code {
@@ -22,70 +22,232 @@ import "encoding/json"
 
 // SpecimenDefinition is documented here http://hl7.org/fhir/StructureDefinition/SpecimenDefinition
 type SpecimenDefinition struct {
-	ID                 *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         *Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	TypeCollected      *CodeableConcept               `bson:"typeCollected,omitempty" json:"typeCollected,omitempty"`
-	PatientPreparation []CodeableConcept              `bson:"patientPreparation,omitempty" json:"patientPreparation,omitempty"`
-	TimeAspect         *string                        `bson:"timeAspect,omitempty" json:"timeAspect,omitempty"`
-	Collection         []CodeableConcept              `bson:"collection,omitempty" json:"collection,omitempty"`
-	TypeTested         []SpecimenDefinitionTypeTested `bson:"typeTested,omitempty" json:"typeTested,omitempty"`
+	ID                   *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           *Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	TypeCollected        *CodeableConcept               `bson:"typeCollected,omitempty" json:"typeCollected,omitempty"`
+	PatientPreparation   []CodeableConcept              `bson:"patientPreparation,omitempty" json:"patientPreparation,omitempty"`
+	TimeAspect           *string                        `bson:"timeAspect,omitempty" json:"timeAspect,omitempty"`
+	TimeAspectElement    *Element                       `bson:"_timeAspect,omitempty" json:"_timeAspect,omitempty"`
+	Collection           []CodeableConcept              `bson:"collection,omitempty" json:"collection,omitempty"`
+	TypeTested           []SpecimenDefinitionTypeTested `bson:"typeTested,omitempty" json:"typeTested,omitempty"`
+	UnknownFields        map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type SpecimenDefinitionTypeTested struct {
 	ID                 *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension          []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension  []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	IsDerived          *bool                                  `bson:"isDerived,omitempty" json:"isDerived,omitempty"`
+	IsDerivedElement   *Element                               `bson:"_isDerived,omitempty" json:"_isDerived,omitempty"`
 	Type               *CodeableConcept                       `bson:"type,omitempty" json:"type,omitempty"`
 	Preference         SpecimenContainedPreference            `bson:"preference" json:"preference"`
+	PreferenceElement  *Element                               `bson:"_preference,omitempty" json:"_preference,omitempty"`
 	Container          *SpecimenDefinitionTypeTestedContainer `bson:"container,omitempty" json:"container,omitempty"`
 	Requirement        *string                                `bson:"requirement,omitempty" json:"requirement,omitempty"`
+	RequirementElement *Element                               `bson:"_requirement,omitempty" json:"_requirement,omitempty"`
 	RetentionTime      *Duration                              `bson:"retentionTime,omitempty" json:"retentionTime,omitempty"`
 	RejectionCriterion []CodeableConcept                      `bson:"rejectionCriterion,omitempty" json:"rejectionCriterion,omitempty"`
 	Handling           []SpecimenDefinitionTypeTestedHandling `bson:"handling,omitempty" json:"handling,omitempty"`
+	UnknownFields      map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type SpecimenDefinitionTypeTestedContainer struct {
-	ID                *string                                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Material          *CodeableConcept                                `bson:"material,omitempty" json:"material,omitempty"`
-	Type              *CodeableConcept                                `bson:"type,omitempty" json:"type,omitempty"`
-	Cap               *CodeableConcept                                `bson:"cap,omitempty" json:"cap,omitempty"`
-	Description       *string                                         `bson:"description,omitempty" json:"description,omitempty"`
-	Capacity          *Quantity                                       `bson:"capacity,omitempty" json:"capacity,omitempty"`
-	Additive          []SpecimenDefinitionTypeTestedContainerAdditive `bson:"additive,omitempty" json:"additive,omitempty"`
-	Preparation       *string                                         `bson:"preparation,omitempty" json:"preparation,omitempty"`
+	ID                         *string                                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Material                   *CodeableConcept                                `bson:"material,omitempty" json:"material,omitempty"`
+	Type                       *CodeableConcept                                `bson:"type,omitempty" json:"type,omitempty"`
+	Cap                        *CodeableConcept                                `bson:"cap,omitempty" json:"cap,omitempty"`
+	Description                *string                                         `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                                        `bson:"_description,omitempty" json:"_description,omitempty"`
+	Capacity                   *Quantity                                       `bson:"capacity,omitempty" json:"capacity,omitempty"`
+	MinimumVolumeQuantity      *Quantity                                       `bson:"minimumVolumeQuantity,omitempty" json:"minimumVolumeQuantity,omitempty"`
+	MinimumVolumeString        *string                                         `bson:"minimumVolumeString,omitempty" json:"minimumVolumeString,omitempty"`
+	MinimumVolumeStringElement *Element                                        `bson:"_minimumVolumeString,omitempty" json:"_minimumVolumeString,omitempty"`
+	Additive                   []SpecimenDefinitionTypeTestedContainerAdditive `bson:"additive,omitempty" json:"additive,omitempty"`
+	Preparation                *string                                         `bson:"preparation,omitempty" json:"preparation,omitempty"`
+	PreparationElement         *Element                                        `bson:"_preparation,omitempty" json:"_preparation,omitempty"`
+	UnknownFields              map[string]json.RawMessage                      `bson:"-" json:"-"`
 }
 type SpecimenDefinitionTypeTestedContainerAdditive struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AdditiveCodeableConcept *CodeableConcept           `bson:"additiveCodeableConcept,omitempty" json:"additiveCodeableConcept,omitempty"`
+	AdditiveReference       *Reference                 `bson:"additiveReference,omitempty" json:"additiveReference,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Additive returns SpecimenDefinition.typeTested.container.additive.additive[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) Additive() interface{} {
+	switch {
+	case r.AdditiveCodeableConcept != nil:
+		return r.AdditiveCodeableConcept
+	case r.AdditiveReference != nil:
+		return r.AdditiveReference
+	}
+	return nil
+}
+
+// SetAdditiveCodeableConcept sets SpecimenDefinition.typeTested.container.additive.additive[x] to the CodeableConcept and clears the other types.
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditiveCodeableConcept(v CodeableConcept) {
+	r.clearAdditive()
+	r.AdditiveCodeableConcept = &v
+}
+
+// SetAdditiveReference sets SpecimenDefinition.typeTested.container.additive.additive[x] to the Reference and clears the other types.
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditiveReference(v Reference) {
+	r.clearAdditive()
+	r.AdditiveReference = &v
+}
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) clearAdditive() {
+	r.AdditiveCodeableConcept = nil
+	r.AdditiveReference = nil
+}
+
+// UnmarshalJSON unmarshals the SpecimenDefinitionTypeTestedContainerAdditive and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalJSON(b []byte) error {
+	type other SpecimenDefinitionTypeTestedContainerAdditive
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SpecimenDefinition.typeTested.container.additive.additive[x]",
+		r.AdditiveCodeableConcept != nil,
+		r.AdditiveReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SpecimenDefinitionTypeTestedContainerAdditive with its unknown fields.
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalJSON() ([]byte, error) {
+	type other SpecimenDefinitionTypeTestedContainerAdditive
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MinimumVolume returns SpecimenDefinition.typeTested.container.minimumVolume[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *SpecimenDefinitionTypeTestedContainer) MinimumVolume() interface{} {
+	switch {
+	case r.MinimumVolumeQuantity != nil:
+		return r.MinimumVolumeQuantity
+	case r.MinimumVolumeString != nil:
+		return r.MinimumVolumeString
+	}
+	return nil
+}
+
+// SetMinimumVolumeQuantity sets SpecimenDefinition.typeTested.container.minimumVolume[x] to the Quantity and clears the other types.
+func (r *SpecimenDefinitionTypeTestedContainer) SetMinimumVolumeQuantity(v Quantity) {
+	r.clearMinimumVolume()
+	r.MinimumVolumeQuantity = &v
+}
+
+// SetMinimumVolumeString sets SpecimenDefinition.typeTested.container.minimumVolume[x] to the string and clears the other types.
+func (r *SpecimenDefinitionTypeTestedContainer) SetMinimumVolumeString(v string) {
+	r.clearMinimumVolume()
+	r.MinimumVolumeString = &v
+}
+func (r *SpecimenDefinitionTypeTestedContainer) clearMinimumVolume() {
+	r.MinimumVolumeQuantity = nil
+	r.MinimumVolumeString = nil
+	r.MinimumVolumeStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the SpecimenDefinitionTypeTestedContainer and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalJSON(b []byte) error {
+	type other SpecimenDefinitionTypeTestedContainer
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SpecimenDefinition.typeTested.container.minimumVolume[x]",
+		r.MinimumVolumeQuantity != nil,
+		r.MinimumVolumeString != nil || r.MinimumVolumeStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SpecimenDefinitionTypeTestedContainer with its unknown fields.
+func (r SpecimenDefinitionTypeTestedContainer) MarshalJSON() ([]byte, error) {
+	type other SpecimenDefinitionTypeTestedContainer
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SpecimenDefinitionTypeTestedHandling struct {
-	ID                   *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	TemperatureQualifier *CodeableConcept `bson:"temperatureQualifier,omitempty" json:"temperatureQualifier,omitempty"`
-	TemperatureRange     *Range           `bson:"temperatureRange,omitempty" json:"temperatureRange,omitempty"`
-	MaxDuration          *Duration        `bson:"maxDuration,omitempty" json:"maxDuration,omitempty"`
-	Instruction          *string          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	TemperatureQualifier *CodeableConcept           `bson:"temperatureQualifier,omitempty" json:"temperatureQualifier,omitempty"`
+	TemperatureRange     *Range                     `bson:"temperatureRange,omitempty" json:"temperatureRange,omitempty"`
+	MaxDuration          *Duration                  `bson:"maxDuration,omitempty" json:"maxDuration,omitempty"`
+	Instruction          *string                    `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	InstructionElement   *Element                   `bson:"_instruction,omitempty" json:"_instruction,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SpecimenDefinitionTypeTestedHandling with its unknown fields.
+func (r SpecimenDefinitionTypeTestedHandling) MarshalJSON() ([]byte, error) {
+	type other SpecimenDefinitionTypeTestedHandling
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the SpecimenDefinitionTypeTested with its unknown fields.
+func (r SpecimenDefinitionTypeTested) MarshalJSON() ([]byte, error) {
+	type other SpecimenDefinitionTypeTested
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the SpecimenDefinition and fails with CodeError when a coded element has an unknown code.
+func (r *SpecimenDefinition) UnmarshalJSON(b []byte) error {
+	type other SpecimenDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("SpecimenDefinition", r)
+}
+
 type OtherSpecimenDefinition SpecimenDefinition
 
 // MarshalJSON marshals the given SpecimenDefinition as JSON into a byte slice
 func (r SpecimenDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSpecimenDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSpecimenDefinition: OtherSpecimenDefinition(r),
 		ResourceType:            "SpecimenDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSpecimenDefinition unmarshals a SpecimenDefinition.
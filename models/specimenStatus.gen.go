@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.12941 +0000 UTC
+// 2026-10-19 02:55:18.939768838 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SpecimenStatus is documented here http://hl7.org/fhir/ValueSet/specimen-status
-type SpecimenStatus int
+// The empty SpecimenStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SpecimenStatus string
 
 const (
-	SpecimenStatusAvailable SpecimenStatus = iota
-	SpecimenStatusUnavailable
-	SpecimenStatusUnsatisfactory
-	SpecimenStatusEnteredInError
+	SpecimenStatusAvailable      SpecimenStatus = "available"
+	SpecimenStatusUnavailable    SpecimenStatus = "unavailable"
+	SpecimenStatusUnsatisfactory SpecimenStatus = "unsatisfactory"
+	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
 func (code SpecimenStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SpecimenStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SpecimenStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "available":
-		*code = SpecimenStatusAvailable
-	case "unavailable":
-		*code = SpecimenStatusUnavailable
-	case "unsatisfactory":
-		*code = SpecimenStatusUnsatisfactory
-	case "entered-in-error":
-		*code = SpecimenStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown SpecimenStatus code `%s`", s)
+func (code *SpecimenStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SpecimenStatus(s)
 	return nil
 }
 func (code SpecimenStatus) String() string {
 	return code.Code()
 }
 func (code SpecimenStatus) Code() string {
+	return string(code)
+}
+func (code SpecimenStatus) Known() bool {
 	switch code {
-	case SpecimenStatusAvailable:
-		return "available"
-	case SpecimenStatusUnavailable:
-		return "unavailable"
-	case SpecimenStatusUnsatisfactory:
-		return "unsatisfactory"
-	case SpecimenStatusEnteredInError:
-		return "entered-in-error"
+	case SpecimenStatusAvailable, SpecimenStatusUnavailable, SpecimenStatusUnsatisfactory, SpecimenStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SpecimenStatus) Display() string {
 	switch code {
@@ -22,80 +22,172 @@ import "encoding/json"
 
 // StructureDefinition is documented here http://hl7.org/fhir/StructureDefinition/StructureDefinition
 type StructureDefinition struct {
-	ID                *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                          `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                           `bson:"url" json:"url"`
-	Identifier        []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                          `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                           `bson:"name" json:"name"`
-	Title             *string                          `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus                `bson:"status" json:"status"`
-	Experimental      *bool                            `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime                        `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                          `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                          `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Keyword           []Coding                         `bson:"keyword,omitempty" json:"keyword,omitempty"`
-	FhirVersion       *FHIRVersion                     `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	Mapping           []StructureDefinitionMapping     `bson:"mapping,omitempty" json:"mapping,omitempty"`
-	Kind              StructureDefinitionKind          `bson:"kind" json:"kind"`
-	Abstract          bool                             `bson:"abstract" json:"abstract"`
-	Context           []StructureDefinitionContext     `bson:"context,omitempty" json:"context,omitempty"`
-	ContextInvariant  []string                         `bson:"contextInvariant,omitempty" json:"contextInvariant,omitempty"`
-	Type              string                           `bson:"type" json:"type"`
-	BaseDefinition    *string                          `bson:"baseDefinition,omitempty" json:"baseDefinition,omitempty"`
-	Derivation        *TypeDerivationRule              `bson:"derivation,omitempty" json:"derivation,omitempty"`
-	Snapshot          *StructureDefinitionSnapshot     `bson:"snapshot,omitempty" json:"snapshot,omitempty"`
-	Differential      *StructureDefinitionDifferential `bson:"differential,omitempty" json:"differential,omitempty"`
+	ID                      *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                     string                           `bson:"url" json:"url"`
+	URLElement              *Element                         `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier              []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                 *string                          `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement          *Element                         `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                    string                           `bson:"name" json:"name"`
+	NameElement             *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                   *string                          `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement            *Element                         `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                  PublicationStatus                `bson:"status" json:"status"`
+	StatusElement           *Element                         `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental            *bool                            `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement     *Element                         `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                    *DateTime                        `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement             *Element                         `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher               *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement        *Element                         `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                 []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description             *string                          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                         `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext              []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction            []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                 *string                          `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement          *Element                         `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright               *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement        *Element                         `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Keyword                 []Coding                         `bson:"keyword,omitempty" json:"keyword,omitempty"`
+	FhirVersion             *FHIRVersion                     `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	FhirVersionElement      *Element                         `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	Mapping                 []StructureDefinitionMapping     `bson:"mapping,omitempty" json:"mapping,omitempty"`
+	Kind                    StructureDefinitionKind          `bson:"kind" json:"kind"`
+	KindElement             *Element                         `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Abstract                bool                             `bson:"abstract" json:"abstract"`
+	AbstractElement         *Element                         `bson:"_abstract,omitempty" json:"_abstract,omitempty"`
+	Context                 []StructureDefinitionContext     `bson:"context,omitempty" json:"context,omitempty"`
+	ContextInvariant        []*string                        `bson:"contextInvariant,omitempty" json:"contextInvariant,omitempty"`
+	ContextInvariantElement []*Element                       `bson:"_contextInvariant,omitempty" json:"_contextInvariant,omitempty"`
+	Type                    string                           `bson:"type" json:"type"`
+	TypeElement             *Element                         `bson:"_type,omitempty" json:"_type,omitempty"`
+	BaseDefinition          *string                          `bson:"baseDefinition,omitempty" json:"baseDefinition,omitempty"`
+	BaseDefinitionElement   *Element                         `bson:"_baseDefinition,omitempty" json:"_baseDefinition,omitempty"`
+	Derivation              *TypeDerivationRule              `bson:"derivation,omitempty" json:"derivation,omitempty"`
+	DerivationElement       *Element                         `bson:"_derivation,omitempty" json:"_derivation,omitempty"`
+	Snapshot                *StructureDefinitionSnapshot     `bson:"snapshot,omitempty" json:"snapshot,omitempty"`
+	Differential            *StructureDefinitionDifferential `bson:"differential,omitempty" json:"differential,omitempty"`
+	UnknownFields           map[string]json.RawMessage       `bson:"-" json:"-"`
 }
 type StructureDefinitionMapping struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identity          string      `bson:"identity" json:"identity"`
-	URI               *string     `bson:"uri,omitempty" json:"uri,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	Comment           *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identity          string                     `bson:"identity" json:"identity"`
+	IdentityElement   *Element                   `bson:"_identity,omitempty" json:"_identity,omitempty"`
+	URI               *string                    `bson:"uri,omitempty" json:"uri,omitempty"`
+	URIElement        *Element                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	Name              *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Comment           *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the StructureDefinitionMapping with its unknown fields.
+func (r StructureDefinitionMapping) MarshalJSON() ([]byte, error) {
+	type other StructureDefinitionMapping
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type StructureDefinitionContext struct {
-	ID                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              ExtensionContextType `bson:"type" json:"type"`
-	Expression        string               `bson:"expression" json:"expression"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              ExtensionContextType       `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Expression        string                     `bson:"expression" json:"expression"`
+	ExpressionElement *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the StructureDefinitionContext with its unknown fields.
+func (r StructureDefinitionContext) MarshalJSON() ([]byte, error) {
+	type other StructureDefinitionContext
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type StructureDefinitionSnapshot struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Element           []ElementDefinition `bson:"element" json:"element"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Element           []ElementDefinition        `bson:"element" json:"element"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the StructureDefinitionSnapshot with its unknown fields.
+func (r StructureDefinitionSnapshot) MarshalJSON() ([]byte, error) {
+	type other StructureDefinitionSnapshot
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type StructureDefinitionDifferential struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Element           []ElementDefinition `bson:"element" json:"element"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Element           []ElementDefinition        `bson:"element" json:"element"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the StructureDefinitionDifferential with its unknown fields.
+func (r StructureDefinitionDifferential) MarshalJSON() ([]byte, error) {
+	type other StructureDefinitionDifferential
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the StructureDefinition and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *StructureDefinition) UnmarshalJSON(b []byte) error {
+	type other StructureDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("StructureDefinition.contextInvariant", len(r.ContextInvariant), len(r.ContextInvariantElement)); err != nil {
+		return err
+	}
+	return checkCodes("StructureDefinition", r)
 }
+
 type OtherStructureDefinition StructureDefinition
 
 // MarshalJSON marshals the given StructureDefinition as JSON into a byte slice
 func (r StructureDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherStructureDefinition
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherStructureDefinition: OtherStructureDefinition(r),
 		ResourceType:             "StructureDefinition",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalStructureDefinition unmarshals a StructureDefinition.
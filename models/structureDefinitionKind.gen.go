@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.204927 +0000 UTC
+// 2026-10-19 02:55:18.940697667 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureDefinitionKind is documented here http://hl7.org/fhir/ValueSet/structure-definition-kind
-type StructureDefinitionKind int
+// The empty StructureDefinitionKind is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureDefinitionKind string
 
 const (
-	StructureDefinitionKindPrimitiveType StructureDefinitionKind = iota
-	StructureDefinitionKindComplexType
-	StructureDefinitionKindResource
-	StructureDefinitionKindLogical
+	StructureDefinitionKindPrimitiveType StructureDefinitionKind = "primitive-type"
+	StructureDefinitionKindComplexType   StructureDefinitionKind = "complex-type"
+	StructureDefinitionKindResource      StructureDefinitionKind = "resource"
+	StructureDefinitionKindLogical       StructureDefinitionKind = "logical"
 )
 
 func (code StructureDefinitionKind) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureDefinitionKind"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureDefinitionKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "primitive-type":
-		*code = StructureDefinitionKindPrimitiveType
-	case "complex-type":
-		*code = StructureDefinitionKindComplexType
-	case "resource":
-		*code = StructureDefinitionKindResource
-	case "logical":
-		*code = StructureDefinitionKindLogical
-	default:
-		return fmt.Errorf("unknown StructureDefinitionKind code `%s`", s)
+func (code *StructureDefinitionKind) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = StructureDefinitionKind(s)
 	return nil
 }
 func (code StructureDefinitionKind) String() string {
 	return code.Code()
 }
 func (code StructureDefinitionKind) Code() string {
+	return string(code)
+}
+func (code StructureDefinitionKind) Known() bool {
 	switch code {
-	case StructureDefinitionKindPrimitiveType:
-		return "primitive-type"
-	case StructureDefinitionKindComplexType:
-		return "complex-type"
-	case StructureDefinitionKindResource:
-		return "resource"
-	case StructureDefinitionKindLogical:
-		return "logical"
+	case StructureDefinitionKindPrimitiveType, StructureDefinitionKindComplexType, StructureDefinitionKindResource, StructureDefinitionKindLogical:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureDefinitionKind) Display() string {
 	switch code {
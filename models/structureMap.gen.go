@@ -22,123 +22,1004 @@ import "encoding/json"
 
 // StructureMap is documented here http://hl7.org/fhir/StructureDefinition/StructureMap
 type StructureMap struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                  `bson:"url" json:"url"`
-	Identifier        []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                 `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                  `bson:"name" json:"name"`
-	Title             *string                 `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus       `bson:"status" json:"status"`
-	Experimental      *bool                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime               `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                 `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail         `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                 `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext          `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                 `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                 `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Structure         []StructureMapStructure `bson:"structure,omitempty" json:"structure,omitempty"`
-	Import            []string                `bson:"import,omitempty" json:"import,omitempty"`
-	Group             []StructureMapGroup     `bson:"group" json:"group"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  string                     `bson:"url" json:"url"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Structure            []StructureMapStructure    `bson:"structure,omitempty" json:"structure,omitempty"`
+	Import               []*string                  `bson:"import,omitempty" json:"import,omitempty"`
+	ImportElement        []*Element                 `bson:"_import,omitempty" json:"_import,omitempty"`
+	Group                []StructureMapGroup        `bson:"group" json:"group"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type StructureMapStructure struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                `bson:"url" json:"url"`
-	Mode              StructureMapModelMode `bson:"mode" json:"mode"`
-	Alias             *string               `bson:"alias,omitempty" json:"alias,omitempty"`
-	Documentation     *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  string                     `bson:"url" json:"url"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Mode                 StructureMapModelMode      `bson:"mode" json:"mode"`
+	ModeElement          *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Alias                *string                    `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement         *Element                   `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the StructureMapStructure with its unknown fields.
+func (r StructureMapStructure) MarshalJSON() ([]byte, error) {
+	type other StructureMapStructure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type StructureMapGroup struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                    `bson:"name" json:"name"`
-	Extends           *string                   `bson:"extends,omitempty" json:"extends,omitempty"`
-	TypeMode          StructureMapGroupTypeMode `bson:"typeMode" json:"typeMode"`
-	Documentation     *string                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Input             []StructureMapGroupInput  `bson:"input" json:"input"`
-	Rule              []StructureMapGroupRule   `bson:"rule" json:"rule"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Extends              *string                    `bson:"extends,omitempty" json:"extends,omitempty"`
+	ExtendsElement       *Element                   `bson:"_extends,omitempty" json:"_extends,omitempty"`
+	TypeMode             StructureMapGroupTypeMode  `bson:"typeMode" json:"typeMode"`
+	TypeModeElement      *Element                   `bson:"_typeMode,omitempty" json:"_typeMode,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Input                []StructureMapGroupInput   `bson:"input" json:"input"`
+	Rule                 []StructureMapGroupRule    `bson:"rule" json:"rule"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type StructureMapGroupInput struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                `bson:"name" json:"name"`
-	Type              *string               `bson:"type,omitempty" json:"type,omitempty"`
-	Mode              StructureMapInputMode `bson:"mode" json:"mode"`
-	Documentation     *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type                 *string                    `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Mode                 StructureMapInputMode      `bson:"mode" json:"mode"`
+	ModeElement          *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the StructureMapGroupInput with its unknown fields.
+func (r StructureMapGroupInput) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroupInput
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type StructureMapGroupRule struct {
-	ID                *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                           `bson:"name" json:"name"`
-	Source            []StructureMapGroupRuleSource    `bson:"source" json:"source"`
-	Target            []StructureMapGroupRuleTarget    `bson:"target,omitempty" json:"target,omitempty"`
-	Rule              []StructureMapGroupRule          `bson:"rule,omitempty" json:"rule,omitempty"`
-	Dependent         []StructureMapGroupRuleDependent `bson:"dependent,omitempty" json:"dependent,omitempty"`
-	Documentation     *string                          `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                           `bson:"name" json:"name"`
+	NameElement          *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Source               []StructureMapGroupRuleSource    `bson:"source" json:"source"`
+	Target               []StructureMapGroupRuleTarget    `bson:"target,omitempty" json:"target,omitempty"`
+	Rule                 []StructureMapGroupRule          `bson:"rule,omitempty" json:"rule,omitempty"`
+	Dependent            []StructureMapGroupRuleDependent `bson:"dependent,omitempty" json:"dependent,omitempty"`
+	Documentation        *string                          `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                         `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage       `bson:"-" json:"-"`
 }
 type StructureMapGroupRuleSource struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Context           string                      `bson:"context" json:"context"`
-	Min               *int                        `bson:"min,omitempty" json:"min,omitempty"`
-	Max               *string                     `bson:"max,omitempty" json:"max,omitempty"`
-	Type              *string                     `bson:"type,omitempty" json:"type,omitempty"`
-	Element           *string                     `bson:"element,omitempty" json:"element,omitempty"`
-	ListMode          *StructureMapSourceListMode `bson:"listMode,omitempty" json:"listMode,omitempty"`
-	Variable          *string                     `bson:"variable,omitempty" json:"variable,omitempty"`
-	Condition         *string                     `bson:"condition,omitempty" json:"condition,omitempty"`
-	Check             *string                     `bson:"check,omitempty" json:"check,omitempty"`
-	LogMessage        *string                     `bson:"logMessage,omitempty" json:"logMessage,omitempty"`
+	ID                              *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                       []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Context                         string                      `bson:"context" json:"context"`
+	ContextElement                  *Element                    `bson:"_context,omitempty" json:"_context,omitempty"`
+	Min                             *int                        `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement                      *Element                    `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                             *string                     `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement                      *Element                    `bson:"_max,omitempty" json:"_max,omitempty"`
+	Type                            *string                     `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement                     *Element                    `bson:"_type,omitempty" json:"_type,omitempty"`
+	DefaultValueBase64Binary        *string                     `bson:"defaultValueBase64Binary,omitempty" json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *Element                    `bson:"_defaultValueBase64Binary,omitempty" json:"_defaultValueBase64Binary,omitempty"`
+	DefaultValueBoolean             *bool                       `bson:"defaultValueBoolean,omitempty" json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *Element                    `bson:"_defaultValueBoolean,omitempty" json:"_defaultValueBoolean,omitempty"`
+	DefaultValueCanonical           *string                     `bson:"defaultValueCanonical,omitempty" json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement    *Element                    `bson:"_defaultValueCanonical,omitempty" json:"_defaultValueCanonical,omitempty"`
+	DefaultValueCode                *string                     `bson:"defaultValueCode,omitempty" json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *Element                    `bson:"_defaultValueCode,omitempty" json:"_defaultValueCode,omitempty"`
+	DefaultValueDate                *DateTime                   `bson:"defaultValueDate,omitempty" json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *Element                    `bson:"_defaultValueDate,omitempty" json:"_defaultValueDate,omitempty"`
+	DefaultValueDateTime            *DateTime                   `bson:"defaultValueDateTime,omitempty" json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *Element                    `bson:"_defaultValueDateTime,omitempty" json:"_defaultValueDateTime,omitempty"`
+	DefaultValueDecimal             *Decimal                    `bson:"defaultValueDecimal,omitempty" json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *Element                    `bson:"_defaultValueDecimal,omitempty" json:"_defaultValueDecimal,omitempty"`
+	DefaultValueId                  *string                     `bson:"defaultValueId,omitempty" json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *Element                    `bson:"_defaultValueId,omitempty" json:"_defaultValueId,omitempty"`
+	DefaultValueInstant             *Instant                    `bson:"defaultValueInstant,omitempty" json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *Element                    `bson:"_defaultValueInstant,omitempty" json:"_defaultValueInstant,omitempty"`
+	DefaultValueInteger             *int                        `bson:"defaultValueInteger,omitempty" json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *Element                    `bson:"_defaultValueInteger,omitempty" json:"_defaultValueInteger,omitempty"`
+	DefaultValueMarkdown            *string                     `bson:"defaultValueMarkdown,omitempty" json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *Element                    `bson:"_defaultValueMarkdown,omitempty" json:"_defaultValueMarkdown,omitempty"`
+	DefaultValueOid                 *string                     `bson:"defaultValueOid,omitempty" json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *Element                    `bson:"_defaultValueOid,omitempty" json:"_defaultValueOid,omitempty"`
+	DefaultValuePositiveInt         *int                        `bson:"defaultValuePositiveInt,omitempty" json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *Element                    `bson:"_defaultValuePositiveInt,omitempty" json:"_defaultValuePositiveInt,omitempty"`
+	DefaultValueString              *string                     `bson:"defaultValueString,omitempty" json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *Element                    `bson:"_defaultValueString,omitempty" json:"_defaultValueString,omitempty"`
+	DefaultValueTime                *Time                       `bson:"defaultValueTime,omitempty" json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *Element                    `bson:"_defaultValueTime,omitempty" json:"_defaultValueTime,omitempty"`
+	DefaultValueUnsignedInt         *int                        `bson:"defaultValueUnsignedInt,omitempty" json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *Element                    `bson:"_defaultValueUnsignedInt,omitempty" json:"_defaultValueUnsignedInt,omitempty"`
+	DefaultValueUri                 *string                     `bson:"defaultValueUri,omitempty" json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *Element                    `bson:"_defaultValueUri,omitempty" json:"_defaultValueUri,omitempty"`
+	DefaultValueUrl                 *string                     `bson:"defaultValueUrl,omitempty" json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement          *Element                    `bson:"_defaultValueUrl,omitempty" json:"_defaultValueUrl,omitempty"`
+	DefaultValueUuid                *string                     `bson:"defaultValueUuid,omitempty" json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement         *Element                    `bson:"_defaultValueUuid,omitempty" json:"_defaultValueUuid,omitempty"`
+	DefaultValueAddress             *Address                    `bson:"defaultValueAddress,omitempty" json:"defaultValueAddress,omitempty"`
+	DefaultValueAge                 *Age                        `bson:"defaultValueAge,omitempty" json:"defaultValueAge,omitempty"`
+	DefaultValueAnnotation          *Annotation                 `bson:"defaultValueAnnotation,omitempty" json:"defaultValueAnnotation,omitempty"`
+	DefaultValueAttachment          *Attachment                 `bson:"defaultValueAttachment,omitempty" json:"defaultValueAttachment,omitempty"`
+	DefaultValueCodeableConcept     *CodeableConcept            `bson:"defaultValueCodeableConcept,omitempty" json:"defaultValueCodeableConcept,omitempty"`
+	DefaultValueCoding              *Coding                     `bson:"defaultValueCoding,omitempty" json:"defaultValueCoding,omitempty"`
+	DefaultValueContactPoint        *ContactPoint               `bson:"defaultValueContactPoint,omitempty" json:"defaultValueContactPoint,omitempty"`
+	DefaultValueCount               *Count                      `bson:"defaultValueCount,omitempty" json:"defaultValueCount,omitempty"`
+	DefaultValueDistance            *Distance                   `bson:"defaultValueDistance,omitempty" json:"defaultValueDistance,omitempty"`
+	DefaultValueDuration            *Duration                   `bson:"defaultValueDuration,omitempty" json:"defaultValueDuration,omitempty"`
+	DefaultValueHumanName           *HumanName                  `bson:"defaultValueHumanName,omitempty" json:"defaultValueHumanName,omitempty"`
+	DefaultValueIdentifier          *Identifier                 `bson:"defaultValueIdentifier,omitempty" json:"defaultValueIdentifier,omitempty"`
+	DefaultValueMoney               *Money                      `bson:"defaultValueMoney,omitempty" json:"defaultValueMoney,omitempty"`
+	DefaultValuePeriod              *Period                     `bson:"defaultValuePeriod,omitempty" json:"defaultValuePeriod,omitempty"`
+	DefaultValueQuantity            *Quantity                   `bson:"defaultValueQuantity,omitempty" json:"defaultValueQuantity,omitempty"`
+	DefaultValueRange               *Range                      `bson:"defaultValueRange,omitempty" json:"defaultValueRange,omitempty"`
+	DefaultValueRatio               *Ratio                      `bson:"defaultValueRatio,omitempty" json:"defaultValueRatio,omitempty"`
+	DefaultValueReference           *Reference                  `bson:"defaultValueReference,omitempty" json:"defaultValueReference,omitempty"`
+	DefaultValueSampledData         *SampledData                `bson:"defaultValueSampledData,omitempty" json:"defaultValueSampledData,omitempty"`
+	DefaultValueSignature           *Signature                  `bson:"defaultValueSignature,omitempty" json:"defaultValueSignature,omitempty"`
+	DefaultValueTiming              *Timing                     `bson:"defaultValueTiming,omitempty" json:"defaultValueTiming,omitempty"`
+	DefaultValueContactDetail       *ContactDetail              `bson:"defaultValueContactDetail,omitempty" json:"defaultValueContactDetail,omitempty"`
+	DefaultValueContributor         *Contributor                `bson:"defaultValueContributor,omitempty" json:"defaultValueContributor,omitempty"`
+	DefaultValueDataRequirement     *DataRequirement            `bson:"defaultValueDataRequirement,omitempty" json:"defaultValueDataRequirement,omitempty"`
+	DefaultValueExpression          *Expression                 `bson:"defaultValueExpression,omitempty" json:"defaultValueExpression,omitempty"`
+	DefaultValueParameterDefinition *ParameterDefinition        `bson:"defaultValueParameterDefinition,omitempty" json:"defaultValueParameterDefinition,omitempty"`
+	DefaultValueRelatedArtifact     *RelatedArtifact            `bson:"defaultValueRelatedArtifact,omitempty" json:"defaultValueRelatedArtifact,omitempty"`
+	DefaultValueTriggerDefinition   *TriggerDefinition          `bson:"defaultValueTriggerDefinition,omitempty" json:"defaultValueTriggerDefinition,omitempty"`
+	DefaultValueUsageContext        *UsageContext               `bson:"defaultValueUsageContext,omitempty" json:"defaultValueUsageContext,omitempty"`
+	DefaultValueDosage              *Dosage                     `bson:"defaultValueDosage,omitempty" json:"defaultValueDosage,omitempty"`
+	DefaultValueMeta                *Meta                       `bson:"defaultValueMeta,omitempty" json:"defaultValueMeta,omitempty"`
+	Element                         *string                     `bson:"element,omitempty" json:"element,omitempty"`
+	ElementElement                  *Element                    `bson:"_element,omitempty" json:"_element,omitempty"`
+	ListMode                        *StructureMapSourceListMode `bson:"listMode,omitempty" json:"listMode,omitempty"`
+	ListModeElement                 *Element                    `bson:"_listMode,omitempty" json:"_listMode,omitempty"`
+	Variable                        *string                     `bson:"variable,omitempty" json:"variable,omitempty"`
+	VariableElement                 *Element                    `bson:"_variable,omitempty" json:"_variable,omitempty"`
+	Condition                       *string                     `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement                *Element                    `bson:"_condition,omitempty" json:"_condition,omitempty"`
+	Check                           *string                     `bson:"check,omitempty" json:"check,omitempty"`
+	CheckElement                    *Element                    `bson:"_check,omitempty" json:"_check,omitempty"`
+	LogMessage                      *string                     `bson:"logMessage,omitempty" json:"logMessage,omitempty"`
+	LogMessageElement               *Element                    `bson:"_logMessage,omitempty" json:"_logMessage,omitempty"`
+	UnknownFields                   map[string]json.RawMessage  `bson:"-" json:"-"`
+}
+
+// DefaultValue returns StructureMap.group.rule.source.defaultValue[x] of the set type, such as *string, or nil when it is not set.
+func (r *StructureMapGroupRuleSource) DefaultValue() interface{} {
+	switch {
+	case r.DefaultValueBase64Binary != nil:
+		return r.DefaultValueBase64Binary
+	case r.DefaultValueBoolean != nil:
+		return r.DefaultValueBoolean
+	case r.DefaultValueCanonical != nil:
+		return r.DefaultValueCanonical
+	case r.DefaultValueCode != nil:
+		return r.DefaultValueCode
+	case r.DefaultValueDate != nil:
+		return r.DefaultValueDate
+	case r.DefaultValueDateTime != nil:
+		return r.DefaultValueDateTime
+	case r.DefaultValueDecimal != nil:
+		return r.DefaultValueDecimal
+	case r.DefaultValueId != nil:
+		return r.DefaultValueId
+	case r.DefaultValueInstant != nil:
+		return r.DefaultValueInstant
+	case r.DefaultValueInteger != nil:
+		return r.DefaultValueInteger
+	case r.DefaultValueMarkdown != nil:
+		return r.DefaultValueMarkdown
+	case r.DefaultValueOid != nil:
+		return r.DefaultValueOid
+	case r.DefaultValuePositiveInt != nil:
+		return r.DefaultValuePositiveInt
+	case r.DefaultValueString != nil:
+		return r.DefaultValueString
+	case r.DefaultValueTime != nil:
+		return r.DefaultValueTime
+	case r.DefaultValueUnsignedInt != nil:
+		return r.DefaultValueUnsignedInt
+	case r.DefaultValueUri != nil:
+		return r.DefaultValueUri
+	case r.DefaultValueUrl != nil:
+		return r.DefaultValueUrl
+	case r.DefaultValueUuid != nil:
+		return r.DefaultValueUuid
+	case r.DefaultValueAddress != nil:
+		return r.DefaultValueAddress
+	case r.DefaultValueAge != nil:
+		return r.DefaultValueAge
+	case r.DefaultValueAnnotation != nil:
+		return r.DefaultValueAnnotation
+	case r.DefaultValueAttachment != nil:
+		return r.DefaultValueAttachment
+	case r.DefaultValueCodeableConcept != nil:
+		return r.DefaultValueCodeableConcept
+	case r.DefaultValueCoding != nil:
+		return r.DefaultValueCoding
+	case r.DefaultValueContactPoint != nil:
+		return r.DefaultValueContactPoint
+	case r.DefaultValueCount != nil:
+		return r.DefaultValueCount
+	case r.DefaultValueDistance != nil:
+		return r.DefaultValueDistance
+	case r.DefaultValueDuration != nil:
+		return r.DefaultValueDuration
+	case r.DefaultValueHumanName != nil:
+		return r.DefaultValueHumanName
+	case r.DefaultValueIdentifier != nil:
+		return r.DefaultValueIdentifier
+	case r.DefaultValueMoney != nil:
+		return r.DefaultValueMoney
+	case r.DefaultValuePeriod != nil:
+		return r.DefaultValuePeriod
+	case r.DefaultValueQuantity != nil:
+		return r.DefaultValueQuantity
+	case r.DefaultValueRange != nil:
+		return r.DefaultValueRange
+	case r.DefaultValueRatio != nil:
+		return r.DefaultValueRatio
+	case r.DefaultValueReference != nil:
+		return r.DefaultValueReference
+	case r.DefaultValueSampledData != nil:
+		return r.DefaultValueSampledData
+	case r.DefaultValueSignature != nil:
+		return r.DefaultValueSignature
+	case r.DefaultValueTiming != nil:
+		return r.DefaultValueTiming
+	case r.DefaultValueContactDetail != nil:
+		return r.DefaultValueContactDetail
+	case r.DefaultValueContributor != nil:
+		return r.DefaultValueContributor
+	case r.DefaultValueDataRequirement != nil:
+		return r.DefaultValueDataRequirement
+	case r.DefaultValueExpression != nil:
+		return r.DefaultValueExpression
+	case r.DefaultValueParameterDefinition != nil:
+		return r.DefaultValueParameterDefinition
+	case r.DefaultValueRelatedArtifact != nil:
+		return r.DefaultValueRelatedArtifact
+	case r.DefaultValueTriggerDefinition != nil:
+		return r.DefaultValueTriggerDefinition
+	case r.DefaultValueUsageContext != nil:
+		return r.DefaultValueUsageContext
+	case r.DefaultValueDosage != nil:
+		return r.DefaultValueDosage
+	case r.DefaultValueMeta != nil:
+		return r.DefaultValueMeta
+	}
+	return nil
+}
+
+// SetDefaultValueBase64Binary sets StructureMap.group.rule.source.defaultValue[x] to the base64Binary and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueBase64Binary(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueBase64Binary = &v
+}
+
+// SetDefaultValueBoolean sets StructureMap.group.rule.source.defaultValue[x] to the boolean and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueBoolean(v bool) {
+	r.clearDefaultValue()
+	r.DefaultValueBoolean = &v
+}
+
+// SetDefaultValueCanonical sets StructureMap.group.rule.source.defaultValue[x] to the canonical and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueCanonical(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueCanonical = &v
+}
+
+// SetDefaultValueCode sets StructureMap.group.rule.source.defaultValue[x] to the code and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueCode(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueCode = &v
+}
+
+// SetDefaultValueDate sets StructureMap.group.rule.source.defaultValue[x] to the date and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDate(v DateTime) {
+	r.clearDefaultValue()
+	r.DefaultValueDate = &v
+}
+
+// SetDefaultValueDateTime sets StructureMap.group.rule.source.defaultValue[x] to the dateTime and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDateTime(v DateTime) {
+	r.clearDefaultValue()
+	r.DefaultValueDateTime = &v
+}
+
+// SetDefaultValueDecimal sets StructureMap.group.rule.source.defaultValue[x] to the decimal and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDecimal(v Decimal) {
+	r.clearDefaultValue()
+	r.DefaultValueDecimal = &v
+}
+
+// SetDefaultValueId sets StructureMap.group.rule.source.defaultValue[x] to the id and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueId(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueId = &v
+}
+
+// SetDefaultValueInstant sets StructureMap.group.rule.source.defaultValue[x] to the instant and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueInstant(v Instant) {
+	r.clearDefaultValue()
+	r.DefaultValueInstant = &v
+}
+
+// SetDefaultValueInteger sets StructureMap.group.rule.source.defaultValue[x] to the integer and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueInteger(v int) {
+	r.clearDefaultValue()
+	r.DefaultValueInteger = &v
+}
+
+// SetDefaultValueMarkdown sets StructureMap.group.rule.source.defaultValue[x] to the markdown and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueMarkdown(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueMarkdown = &v
+}
+
+// SetDefaultValueOid sets StructureMap.group.rule.source.defaultValue[x] to the oid and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueOid(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueOid = &v
+}
+
+// SetDefaultValuePositiveInt sets StructureMap.group.rule.source.defaultValue[x] to the positiveInt and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValuePositiveInt(v int) {
+	r.clearDefaultValue()
+	r.DefaultValuePositiveInt = &v
+}
+
+// SetDefaultValueString sets StructureMap.group.rule.source.defaultValue[x] to the string and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueString(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueString = &v
+}
+
+// SetDefaultValueTime sets StructureMap.group.rule.source.defaultValue[x] to the time and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueTime(v Time) {
+	r.clearDefaultValue()
+	r.DefaultValueTime = &v
+}
+
+// SetDefaultValueUnsignedInt sets StructureMap.group.rule.source.defaultValue[x] to the unsignedInt and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueUnsignedInt(v int) {
+	r.clearDefaultValue()
+	r.DefaultValueUnsignedInt = &v
+}
+
+// SetDefaultValueUri sets StructureMap.group.rule.source.defaultValue[x] to the uri and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueUri(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueUri = &v
+}
+
+// SetDefaultValueUrl sets StructureMap.group.rule.source.defaultValue[x] to the url and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueUrl(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueUrl = &v
+}
+
+// SetDefaultValueUuid sets StructureMap.group.rule.source.defaultValue[x] to the uuid and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueUuid(v string) {
+	r.clearDefaultValue()
+	r.DefaultValueUuid = &v
+}
+
+// SetDefaultValueAddress sets StructureMap.group.rule.source.defaultValue[x] to the Address and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueAddress(v Address) {
+	r.clearDefaultValue()
+	r.DefaultValueAddress = &v
+}
+
+// SetDefaultValueAge sets StructureMap.group.rule.source.defaultValue[x] to the Age and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueAge(v Age) {
+	r.clearDefaultValue()
+	r.DefaultValueAge = &v
+}
+
+// SetDefaultValueAnnotation sets StructureMap.group.rule.source.defaultValue[x] to the Annotation and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueAnnotation(v Annotation) {
+	r.clearDefaultValue()
+	r.DefaultValueAnnotation = &v
+}
+
+// SetDefaultValueAttachment sets StructureMap.group.rule.source.defaultValue[x] to the Attachment and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueAttachment(v Attachment) {
+	r.clearDefaultValue()
+	r.DefaultValueAttachment = &v
+}
+
+// SetDefaultValueCodeableConcept sets StructureMap.group.rule.source.defaultValue[x] to the CodeableConcept and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueCodeableConcept(v CodeableConcept) {
+	r.clearDefaultValue()
+	r.DefaultValueCodeableConcept = &v
+}
+
+// SetDefaultValueCoding sets StructureMap.group.rule.source.defaultValue[x] to the Coding and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueCoding(v Coding) {
+	r.clearDefaultValue()
+	r.DefaultValueCoding = &v
+}
+
+// SetDefaultValueContactPoint sets StructureMap.group.rule.source.defaultValue[x] to the ContactPoint and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueContactPoint(v ContactPoint) {
+	r.clearDefaultValue()
+	r.DefaultValueContactPoint = &v
+}
+
+// SetDefaultValueCount sets StructureMap.group.rule.source.defaultValue[x] to the Count and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueCount(v Count) {
+	r.clearDefaultValue()
+	r.DefaultValueCount = &v
+}
+
+// SetDefaultValueDistance sets StructureMap.group.rule.source.defaultValue[x] to the Distance and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDistance(v Distance) {
+	r.clearDefaultValue()
+	r.DefaultValueDistance = &v
+}
+
+// SetDefaultValueDuration sets StructureMap.group.rule.source.defaultValue[x] to the Duration and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDuration(v Duration) {
+	r.clearDefaultValue()
+	r.DefaultValueDuration = &v
+}
+
+// SetDefaultValueHumanName sets StructureMap.group.rule.source.defaultValue[x] to the HumanName and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueHumanName(v HumanName) {
+	r.clearDefaultValue()
+	r.DefaultValueHumanName = &v
+}
+
+// SetDefaultValueIdentifier sets StructureMap.group.rule.source.defaultValue[x] to the Identifier and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueIdentifier(v Identifier) {
+	r.clearDefaultValue()
+	r.DefaultValueIdentifier = &v
+}
+
+// SetDefaultValueMoney sets StructureMap.group.rule.source.defaultValue[x] to the Money and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueMoney(v Money) {
+	r.clearDefaultValue()
+	r.DefaultValueMoney = &v
+}
+
+// SetDefaultValuePeriod sets StructureMap.group.rule.source.defaultValue[x] to the Period and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValuePeriod(v Period) {
+	r.clearDefaultValue()
+	r.DefaultValuePeriod = &v
+}
+
+// SetDefaultValueQuantity sets StructureMap.group.rule.source.defaultValue[x] to the Quantity and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueQuantity(v Quantity) {
+	r.clearDefaultValue()
+	r.DefaultValueQuantity = &v
+}
+
+// SetDefaultValueRange sets StructureMap.group.rule.source.defaultValue[x] to the Range and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueRange(v Range) {
+	r.clearDefaultValue()
+	r.DefaultValueRange = &v
+}
+
+// SetDefaultValueRatio sets StructureMap.group.rule.source.defaultValue[x] to the Ratio and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueRatio(v Ratio) {
+	r.clearDefaultValue()
+	r.DefaultValueRatio = &v
+}
+
+// SetDefaultValueReference sets StructureMap.group.rule.source.defaultValue[x] to the Reference and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueReference(v Reference) {
+	r.clearDefaultValue()
+	r.DefaultValueReference = &v
+}
+
+// SetDefaultValueSampledData sets StructureMap.group.rule.source.defaultValue[x] to the SampledData and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueSampledData(v SampledData) {
+	r.clearDefaultValue()
+	r.DefaultValueSampledData = &v
+}
+
+// SetDefaultValueSignature sets StructureMap.group.rule.source.defaultValue[x] to the Signature and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueSignature(v Signature) {
+	r.clearDefaultValue()
+	r.DefaultValueSignature = &v
+}
+
+// SetDefaultValueTiming sets StructureMap.group.rule.source.defaultValue[x] to the Timing and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueTiming(v Timing) {
+	r.clearDefaultValue()
+	r.DefaultValueTiming = &v
+}
+
+// SetDefaultValueContactDetail sets StructureMap.group.rule.source.defaultValue[x] to the ContactDetail and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueContactDetail(v ContactDetail) {
+	r.clearDefaultValue()
+	r.DefaultValueContactDetail = &v
+}
+
+// SetDefaultValueContributor sets StructureMap.group.rule.source.defaultValue[x] to the Contributor and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueContributor(v Contributor) {
+	r.clearDefaultValue()
+	r.DefaultValueContributor = &v
+}
+
+// SetDefaultValueDataRequirement sets StructureMap.group.rule.source.defaultValue[x] to the DataRequirement and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDataRequirement(v DataRequirement) {
+	r.clearDefaultValue()
+	r.DefaultValueDataRequirement = &v
+}
+
+// SetDefaultValueExpression sets StructureMap.group.rule.source.defaultValue[x] to the Expression and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueExpression(v Expression) {
+	r.clearDefaultValue()
+	r.DefaultValueExpression = &v
+}
+
+// SetDefaultValueParameterDefinition sets StructureMap.group.rule.source.defaultValue[x] to the ParameterDefinition and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueParameterDefinition(v ParameterDefinition) {
+	r.clearDefaultValue()
+	r.DefaultValueParameterDefinition = &v
+}
+
+// SetDefaultValueRelatedArtifact sets StructureMap.group.rule.source.defaultValue[x] to the RelatedArtifact and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueRelatedArtifact(v RelatedArtifact) {
+	r.clearDefaultValue()
+	r.DefaultValueRelatedArtifact = &v
+}
+
+// SetDefaultValueTriggerDefinition sets StructureMap.group.rule.source.defaultValue[x] to the TriggerDefinition and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueTriggerDefinition(v TriggerDefinition) {
+	r.clearDefaultValue()
+	r.DefaultValueTriggerDefinition = &v
 }
+
+// SetDefaultValueUsageContext sets StructureMap.group.rule.source.defaultValue[x] to the UsageContext and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueUsageContext(v UsageContext) {
+	r.clearDefaultValue()
+	r.DefaultValueUsageContext = &v
+}
+
+// SetDefaultValueDosage sets StructureMap.group.rule.source.defaultValue[x] to the Dosage and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueDosage(v Dosage) {
+	r.clearDefaultValue()
+	r.DefaultValueDosage = &v
+}
+
+// SetDefaultValueMeta sets StructureMap.group.rule.source.defaultValue[x] to the Meta and clears the other types.
+func (r *StructureMapGroupRuleSource) SetDefaultValueMeta(v Meta) {
+	r.clearDefaultValue()
+	r.DefaultValueMeta = &v
+}
+func (r *StructureMapGroupRuleSource) clearDefaultValue() {
+	r.DefaultValueBase64Binary = nil
+	r.DefaultValueBase64BinaryElement = nil
+	r.DefaultValueBoolean = nil
+	r.DefaultValueBooleanElement = nil
+	r.DefaultValueCanonical = nil
+	r.DefaultValueCanonicalElement = nil
+	r.DefaultValueCode = nil
+	r.DefaultValueCodeElement = nil
+	r.DefaultValueDate = nil
+	r.DefaultValueDateElement = nil
+	r.DefaultValueDateTime = nil
+	r.DefaultValueDateTimeElement = nil
+	r.DefaultValueDecimal = nil
+	r.DefaultValueDecimalElement = nil
+	r.DefaultValueId = nil
+	r.DefaultValueIdElement = nil
+	r.DefaultValueInstant = nil
+	r.DefaultValueInstantElement = nil
+	r.DefaultValueInteger = nil
+	r.DefaultValueIntegerElement = nil
+	r.DefaultValueMarkdown = nil
+	r.DefaultValueMarkdownElement = nil
+	r.DefaultValueOid = nil
+	r.DefaultValueOidElement = nil
+	r.DefaultValuePositiveInt = nil
+	r.DefaultValuePositiveIntElement = nil
+	r.DefaultValueString = nil
+	r.DefaultValueStringElement = nil
+	r.DefaultValueTime = nil
+	r.DefaultValueTimeElement = nil
+	r.DefaultValueUnsignedInt = nil
+	r.DefaultValueUnsignedIntElement = nil
+	r.DefaultValueUri = nil
+	r.DefaultValueUriElement = nil
+	r.DefaultValueUrl = nil
+	r.DefaultValueUrlElement = nil
+	r.DefaultValueUuid = nil
+	r.DefaultValueUuidElement = nil
+	r.DefaultValueAddress = nil
+	r.DefaultValueAge = nil
+	r.DefaultValueAnnotation = nil
+	r.DefaultValueAttachment = nil
+	r.DefaultValueCodeableConcept = nil
+	r.DefaultValueCoding = nil
+	r.DefaultValueContactPoint = nil
+	r.DefaultValueCount = nil
+	r.DefaultValueDistance = nil
+	r.DefaultValueDuration = nil
+	r.DefaultValueHumanName = nil
+	r.DefaultValueIdentifier = nil
+	r.DefaultValueMoney = nil
+	r.DefaultValuePeriod = nil
+	r.DefaultValueQuantity = nil
+	r.DefaultValueRange = nil
+	r.DefaultValueRatio = nil
+	r.DefaultValueReference = nil
+	r.DefaultValueSampledData = nil
+	r.DefaultValueSignature = nil
+	r.DefaultValueTiming = nil
+	r.DefaultValueContactDetail = nil
+	r.DefaultValueContributor = nil
+	r.DefaultValueDataRequirement = nil
+	r.DefaultValueExpression = nil
+	r.DefaultValueParameterDefinition = nil
+	r.DefaultValueRelatedArtifact = nil
+	r.DefaultValueTriggerDefinition = nil
+	r.DefaultValueUsageContext = nil
+	r.DefaultValueDosage = nil
+	r.DefaultValueMeta = nil
+}
+
+// UnmarshalJSON unmarshals the StructureMapGroupRuleSource and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *StructureMapGroupRuleSource) UnmarshalJSON(b []byte) error {
+	type other StructureMapGroupRuleSource
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"StructureMap.group.rule.source.defaultValue[x]",
+		r.DefaultValueBase64Binary != nil || r.DefaultValueBase64BinaryElement != nil,
+		r.DefaultValueBoolean != nil || r.DefaultValueBooleanElement != nil,
+		r.DefaultValueCanonical != nil || r.DefaultValueCanonicalElement != nil,
+		r.DefaultValueCode != nil || r.DefaultValueCodeElement != nil,
+		r.DefaultValueDate != nil || r.DefaultValueDateElement != nil,
+		r.DefaultValueDateTime != nil || r.DefaultValueDateTimeElement != nil,
+		r.DefaultValueDecimal != nil || r.DefaultValueDecimalElement != nil,
+		r.DefaultValueId != nil || r.DefaultValueIdElement != nil,
+		r.DefaultValueInstant != nil || r.DefaultValueInstantElement != nil,
+		r.DefaultValueInteger != nil || r.DefaultValueIntegerElement != nil,
+		r.DefaultValueMarkdown != nil || r.DefaultValueMarkdownElement != nil,
+		r.DefaultValueOid != nil || r.DefaultValueOidElement != nil,
+		r.DefaultValuePositiveInt != nil || r.DefaultValuePositiveIntElement != nil,
+		r.DefaultValueString != nil || r.DefaultValueStringElement != nil,
+		r.DefaultValueTime != nil || r.DefaultValueTimeElement != nil,
+		r.DefaultValueUnsignedInt != nil || r.DefaultValueUnsignedIntElement != nil,
+		r.DefaultValueUri != nil || r.DefaultValueUriElement != nil,
+		r.DefaultValueUrl != nil || r.DefaultValueUrlElement != nil,
+		r.DefaultValueUuid != nil || r.DefaultValueUuidElement != nil,
+		r.DefaultValueAddress != nil,
+		r.DefaultValueAge != nil,
+		r.DefaultValueAnnotation != nil,
+		r.DefaultValueAttachment != nil,
+		r.DefaultValueCodeableConcept != nil,
+		r.DefaultValueCoding != nil,
+		r.DefaultValueContactPoint != nil,
+		r.DefaultValueCount != nil,
+		r.DefaultValueDistance != nil,
+		r.DefaultValueDuration != nil,
+		r.DefaultValueHumanName != nil,
+		r.DefaultValueIdentifier != nil,
+		r.DefaultValueMoney != nil,
+		r.DefaultValuePeriod != nil,
+		r.DefaultValueQuantity != nil,
+		r.DefaultValueRange != nil,
+		r.DefaultValueRatio != nil,
+		r.DefaultValueReference != nil,
+		r.DefaultValueSampledData != nil,
+		r.DefaultValueSignature != nil,
+		r.DefaultValueTiming != nil,
+		r.DefaultValueContactDetail != nil,
+		r.DefaultValueContributor != nil,
+		r.DefaultValueDataRequirement != nil,
+		r.DefaultValueExpression != nil,
+		r.DefaultValueParameterDefinition != nil,
+		r.DefaultValueRelatedArtifact != nil,
+		r.DefaultValueTriggerDefinition != nil,
+		r.DefaultValueUsageContext != nil,
+		r.DefaultValueDosage != nil,
+		r.DefaultValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the StructureMapGroupRuleSource with its unknown fields.
+func (r StructureMapGroupRuleSource) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroupRuleSource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type StructureMapGroupRuleTarget struct {
-	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Context           *string                                `bson:"context,omitempty" json:"context,omitempty"`
-	ContextType       *StructureMapContextType               `bson:"contextType,omitempty" json:"contextType,omitempty"`
-	Element           *string                                `bson:"element,omitempty" json:"element,omitempty"`
-	Variable          *string                                `bson:"variable,omitempty" json:"variable,omitempty"`
-	ListMode          []StructureMapTargetListMode           `bson:"listMode,omitempty" json:"listMode,omitempty"`
-	ListRuleId        *string                                `bson:"listRuleId,omitempty" json:"listRuleId,omitempty"`
-	Transform         *StructureMapTransform                 `bson:"transform,omitempty" json:"transform,omitempty"`
-	Parameter         []StructureMapGroupRuleTargetParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	ID                 *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Context            *string                                `bson:"context,omitempty" json:"context,omitempty"`
+	ContextElement     *Element                               `bson:"_context,omitempty" json:"_context,omitempty"`
+	ContextType        *StructureMapContextType               `bson:"contextType,omitempty" json:"contextType,omitempty"`
+	ContextTypeElement *Element                               `bson:"_contextType,omitempty" json:"_contextType,omitempty"`
+	Element            *string                                `bson:"element,omitempty" json:"element,omitempty"`
+	ElementElement     *Element                               `bson:"_element,omitempty" json:"_element,omitempty"`
+	Variable           *string                                `bson:"variable,omitempty" json:"variable,omitempty"`
+	VariableElement    *Element                               `bson:"_variable,omitempty" json:"_variable,omitempty"`
+	ListMode           []*StructureMapTargetListMode          `bson:"listMode,omitempty" json:"listMode,omitempty"`
+	ListModeElement    []*Element                             `bson:"_listMode,omitempty" json:"_listMode,omitempty"`
+	ListRuleId         *string                                `bson:"listRuleId,omitempty" json:"listRuleId,omitempty"`
+	ListRuleIdElement  *Element                               `bson:"_listRuleId,omitempty" json:"_listRuleId,omitempty"`
+	Transform          *StructureMapTransform                 `bson:"transform,omitempty" json:"transform,omitempty"`
+	TransformElement   *Element                               `bson:"_transform,omitempty" json:"_transform,omitempty"`
+	Parameter          []StructureMapGroupRuleTargetParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	UnknownFields      map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type StructureMapGroupRuleTargetParameter struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueId             *string                    `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement      *Element                   `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueString         *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement  *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean        *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger        *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDecimal        *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns StructureMap.group.rule.target.parameter.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *StructureMapGroupRuleTargetParameter) Value() interface{} {
+	switch {
+	case r.ValueId != nil:
+		return r.ValueId
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	}
+	return nil
+}
+
+// SetValueId sets StructureMap.group.rule.target.parameter.value[x] to the id and clears the other types.
+func (r *StructureMapGroupRuleTargetParameter) SetValueId(v string) {
+	r.clearValue()
+	r.ValueId = &v
+}
+
+// SetValueString sets StructureMap.group.rule.target.parameter.value[x] to the string and clears the other types.
+func (r *StructureMapGroupRuleTargetParameter) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueBoolean sets StructureMap.group.rule.target.parameter.value[x] to the boolean and clears the other types.
+func (r *StructureMapGroupRuleTargetParameter) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueInteger sets StructureMap.group.rule.target.parameter.value[x] to the integer and clears the other types.
+func (r *StructureMapGroupRuleTargetParameter) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueDecimal sets StructureMap.group.rule.target.parameter.value[x] to the decimal and clears the other types.
+func (r *StructureMapGroupRuleTargetParameter) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+func (r *StructureMapGroupRuleTargetParameter) clearValue() {
+	r.ValueId = nil
+	r.ValueIdElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+}
+
+// UnmarshalJSON unmarshals the StructureMapGroupRuleTargetParameter and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalJSON(b []byte) error {
+	type other StructureMapGroupRuleTargetParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"StructureMap.group.rule.target.parameter.value[x]",
+		r.ValueId != nil || r.ValueIdElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the StructureMapGroupRuleTargetParameter with its unknown fields.
+func (r StructureMapGroupRuleTargetParameter) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroupRuleTargetParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the StructureMapGroupRuleTarget and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *StructureMapGroupRuleTarget) UnmarshalJSON(b []byte) error {
+	type other StructureMapGroupRuleTarget
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("StructureMap.group.rule.target.listMode", len(r.ListMode), len(r.ListModeElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the StructureMapGroupRuleTarget with its unknown fields.
+func (r StructureMapGroupRuleTarget) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroupRuleTarget
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type StructureMapGroupRuleDependent struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Variable          []string    `bson:"variable" json:"variable"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              string                     `bson:"name" json:"name"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Variable          []*string                  `bson:"variable" json:"variable"`
+	VariableElement   []*Element                 `bson:"_variable,omitempty" json:"_variable,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the StructureMapGroupRuleDependent and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *StructureMapGroupRuleDependent) UnmarshalJSON(b []byte) error {
+	type other StructureMapGroupRuleDependent
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("StructureMap.group.rule.dependent.variable", len(r.Variable), len(r.VariableElement)); err != nil {
+		return err
+	}
+	return nil
 }
+
+// MarshalJSON marshals the StructureMapGroupRuleDependent with its unknown fields.
+func (r StructureMapGroupRuleDependent) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroupRuleDependent
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the StructureMapGroupRule with its unknown fields.
+func (r StructureMapGroupRule) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroupRule
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the StructureMapGroup with its unknown fields.
+func (r StructureMapGroup) MarshalJSON() ([]byte, error) {
+	type other StructureMapGroup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the StructureMap and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *StructureMap) UnmarshalJSON(b []byte) error {
+	type other StructureMap
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("StructureMap.import", len(r.Import), len(r.ImportElement)); err != nil {
+		return err
+	}
+	return checkCodes("StructureMap", r)
+}
+
 type OtherStructureMap StructureMap
 
 // MarshalJSON marshals the given StructureMap as JSON into a byte slice
 func (r StructureMap) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherStructureMap
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherStructureMap: OtherStructureMap(r),
 		ResourceType:      "StructureMap",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalStructureMap unmarshals a StructureMap.
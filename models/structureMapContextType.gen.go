@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.011384 +0000 UTC
+// 2026-10-19 02:55:18.941878251 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapContextType is documented here http://hl7.org/fhir/ValueSet/map-context-type
-type StructureMapContextType int
+// The empty StructureMapContextType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapContextType string
 
 const (
-	StructureMapContextTypeType StructureMapContextType = iota
-	StructureMapContextTypeVariable
+	StructureMapContextTypeType     StructureMapContextType = "type"
+	StructureMapContextTypeVariable StructureMapContextType = "variable"
 )
 
 func (code StructureMapContextType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapContextType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapContextType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "type":
-		*code = StructureMapContextTypeType
-	case "variable":
-		*code = StructureMapContextTypeVariable
-	default:
-		return fmt.Errorf("unknown StructureMapContextType code `%s`", s)
+func (code *StructureMapContextType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = StructureMapContextType(s)
 	return nil
 }
 func (code StructureMapContextType) String() string {
 	return code.Code()
 }
 func (code StructureMapContextType) Code() string {
+	return string(code)
+}
+func (code StructureMapContextType) Known() bool {
 	switch code {
-	case StructureMapContextTypeType:
-		return "type"
-	case StructureMapContextTypeVariable:
-		return "variable"
+	case StructureMapContextTypeType, StructureMapContextTypeVariable:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapContextType) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.287227 +0000 UTC
+// 2026-10-19 02:55:18.942767083 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapGroupTypeMode is documented here http://hl7.org/fhir/ValueSet/map-group-type-mode
-type StructureMapGroupTypeMode int
+// The empty StructureMapGroupTypeMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapGroupTypeMode string
 
 const (
-	StructureMapGroupTypeModeNone StructureMapGroupTypeMode = iota
-	StructureMapGroupTypeModeTypes
-	StructureMapGroupTypeModeTypeAndTypes
+	StructureMapGroupTypeModeNone         StructureMapGroupTypeMode = "none"
+	StructureMapGroupTypeModeTypes        StructureMapGroupTypeMode = "types"
+	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
 func (code StructureMapGroupTypeMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapGroupTypeMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapGroupTypeMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "none":
-		*code = StructureMapGroupTypeModeNone
-	case "types":
-		*code = StructureMapGroupTypeModeTypes
-	case "type-and-types":
-		*code = StructureMapGroupTypeModeTypeAndTypes
-	default:
-		return fmt.Errorf("unknown StructureMapGroupTypeMode code `%s`", s)
+func (code *StructureMapGroupTypeMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = StructureMapGroupTypeMode(s)
 	return nil
 }
 func (code StructureMapGroupTypeMode) String() string {
 	return code.Code()
 }
 func (code StructureMapGroupTypeMode) Code() string {
+	return string(code)
+}
+func (code StructureMapGroupTypeMode) Known() bool {
 	switch code {
-	case StructureMapGroupTypeModeNone:
-		return "none"
-	case StructureMapGroupTypeModeTypes:
-		return "types"
-	case StructureMapGroupTypeModeTypeAndTypes:
-		return "type-and-types"
+	case StructureMapGroupTypeModeNone, StructureMapGroupTypeModeTypes, StructureMapGroupTypeModeTypeAndTypes:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapGroupTypeMode) Display() string {
 	switch code {
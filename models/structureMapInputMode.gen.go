@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.297816 +0000 UTC
+// 2026-10-19 02:55:18.943753023 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapInputMode is documented here http://hl7.org/fhir/ValueSet/map-input-mode
-type StructureMapInputMode int
+// The empty StructureMapInputMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapInputMode string
 
 const (
-	StructureMapInputModeSource StructureMapInputMode = iota
-	StructureMapInputModeTarget
+	StructureMapInputModeSource StructureMapInputMode = "source"
+	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
 func (code StructureMapInputMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapInputMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapInputMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "source":
-		*code = StructureMapInputModeSource
-	case "target":
-		*code = StructureMapInputModeTarget
-	default:
-		return fmt.Errorf("unknown StructureMapInputMode code `%s`", s)
+func (code *StructureMapInputMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = StructureMapInputMode(s)
 	return nil
 }
 func (code StructureMapInputMode) String() string {
 	return code.Code()
 }
 func (code StructureMapInputMode) Code() string {
+	return string(code)
+}
+func (code StructureMapInputMode) Known() bool {
 	switch code {
-	case StructureMapInputModeSource:
-		return "source"
-	case StructureMapInputModeTarget:
-		return "target"
+	case StructureMapInputModeSource, StructureMapInputModeTarget:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapInputMode) Display() string {
 	switch code {
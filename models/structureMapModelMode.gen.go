@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.227248 +0000 UTC
+// 2026-10-19 02:55:18.944799765 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapModelMode is documented here http://hl7.org/fhir/ValueSet/map-model-mode
-type StructureMapModelMode int
+// The empty StructureMapModelMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapModelMode string
 
 const (
-	StructureMapModelModeSource StructureMapModelMode = iota
-	StructureMapModelModeQueried
-	StructureMapModelModeTarget
-	StructureMapModelModeProduced
+	StructureMapModelModeSource   StructureMapModelMode = "source"
+	StructureMapModelModeQueried  StructureMapModelMode = "queried"
+	StructureMapModelModeTarget   StructureMapModelMode = "target"
+	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
 func (code StructureMapModelMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapModelMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapModelMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "source":
-		*code = StructureMapModelModeSource
-	case "queried":
-		*code = StructureMapModelModeQueried
-	case "target":
-		*code = StructureMapModelModeTarget
-	case "produced":
-		*code = StructureMapModelModeProduced
-	default:
-		return fmt.Errorf("unknown StructureMapModelMode code `%s`", s)
+func (code *StructureMapModelMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = StructureMapModelMode(s)
 	return nil
 }
 func (code StructureMapModelMode) String() string {
 	return code.Code()
 }
 func (code StructureMapModelMode) Code() string {
+	return string(code)
+}
+func (code StructureMapModelMode) Known() bool {
 	switch code {
-	case StructureMapModelModeSource:
-		return "source"
-	case StructureMapModelModeQueried:
-		return "queried"
-	case StructureMapModelModeTarget:
-		return "target"
-	case StructureMapModelModeProduced:
-		return "produced"
+	case StructureMapModelModeSource, StructureMapModelModeQueried, StructureMapModelModeTarget, StructureMapModelModeProduced:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapModelMode) Display() string {
 	switch code {
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.149366 +0000 UTC
+// 2026-10-19 02:55:18.946130554 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapSourceListMode is documented here http://hl7.org/fhir/ValueSet/map-source-list-mode
-type StructureMapSourceListMode int
+// The empty StructureMapSourceListMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapSourceListMode string
 
 const (
-	StructureMapSourceListModeFirst StructureMapSourceListMode = iota
-	StructureMapSourceListModeNot_first
-	StructureMapSourceListModeLast
-	StructureMapSourceListModeNot_last
-	StructureMapSourceListModeOnly_one
+	StructureMapSourceListModeFirst     StructureMapSourceListMode = "first"
+	StructureMapSourceListModeNot_first StructureMapSourceListMode = "not_first"
+	StructureMapSourceListModeLast      StructureMapSourceListMode = "last"
+	StructureMapSourceListModeNot_last  StructureMapSourceListMode = "not_last"
+	StructureMapSourceListModeOnly_one  StructureMapSourceListMode = "only_one"
 )
 
 func (code StructureMapSourceListMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapSourceListMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapSourceListMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "first":
-		*code = StructureMapSourceListModeFirst
-	case "not_first":
-		*code = StructureMapSourceListModeNot_first
-	case "last":
-		*code = StructureMapSourceListModeLast
-	case "not_last":
-		*code = StructureMapSourceListModeNot_last
-	case "only_one":
-		*code = StructureMapSourceListModeOnly_one
-	default:
-		return fmt.Errorf("unknown StructureMapSourceListMode code `%s`", s)
+func (code *StructureMapSourceListMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = StructureMapSourceListMode(s)
 	return nil
 }
 func (code StructureMapSourceListMode) String() string {
 	return code.Code()
 }
 func (code StructureMapSourceListMode) Code() string {
+	return string(code)
+}
+func (code StructureMapSourceListMode) Known() bool {
 	switch code {
-	case StructureMapSourceListModeFirst:
-		return "first"
-	case StructureMapSourceListModeNot_first:
-		return "not_first"
-	case StructureMapSourceListModeLast:
-		return "last"
-	case StructureMapSourceListModeNot_last:
-		return "not_last"
-	case StructureMapSourceListModeOnly_one:
-		return "only_one"
+	case StructureMapSourceListModeFirst, StructureMapSourceListModeNot_first, StructureMapSourceListModeLast, StructureMapSourceListModeNot_last, StructureMapSourceListModeOnly_one:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapSourceListMode) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.153634 +0000 UTC
+// 2026-10-19 02:55:18.947551622 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapTargetListMode is documented here http://hl7.org/fhir/ValueSet/map-target-list-mode
-type StructureMapTargetListMode int
+// The empty StructureMapTargetListMode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapTargetListMode string
 
 const (
-	StructureMapTargetListModeFirst StructureMapTargetListMode = iota
-	StructureMapTargetListModeShare
-	StructureMapTargetListModeLast
-	StructureMapTargetListModeCollate
+	StructureMapTargetListModeFirst   StructureMapTargetListMode = "first"
+	StructureMapTargetListModeShare   StructureMapTargetListMode = "share"
+	StructureMapTargetListModeLast    StructureMapTargetListMode = "last"
+	StructureMapTargetListModeCollate StructureMapTargetListMode = "collate"
 )
 
 func (code StructureMapTargetListMode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapTargetListMode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapTargetListMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "first":
-		*code = StructureMapTargetListModeFirst
-	case "share":
-		*code = StructureMapTargetListModeShare
-	case "last":
-		*code = StructureMapTargetListModeLast
-	case "collate":
-		*code = StructureMapTargetListModeCollate
-	default:
-		return fmt.Errorf("unknown StructureMapTargetListMode code `%s`", s)
+func (code *StructureMapTargetListMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = StructureMapTargetListMode(s)
 	return nil
 }
 func (code StructureMapTargetListMode) String() string {
 	return code.Code()
 }
 func (code StructureMapTargetListMode) Code() string {
+	return string(code)
+}
+func (code StructureMapTargetListMode) Known() bool {
 	switch code {
-	case StructureMapTargetListModeFirst:
-		return "first"
-	case StructureMapTargetListModeShare:
-		return "share"
-	case StructureMapTargetListModeLast:
-		return "last"
-	case StructureMapTargetListModeCollate:
-		return "collate"
+	case StructureMapTargetListModeFirst, StructureMapTargetListModeShare, StructureMapTargetListModeLast, StructureMapTargetListModeCollate:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapTargetListMode) Display() string {
 	switch code {
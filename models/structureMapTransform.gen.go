@@ -14,125 +14,66 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.198096 +0000 UTC
+// 2026-10-19 02:55:18.949139961 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // StructureMapTransform is documented here http://hl7.org/fhir/ValueSet/map-transform
-type StructureMapTransform int
+// The empty StructureMapTransform is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type StructureMapTransform string
 
 const (
-	StructureMapTransformCreate StructureMapTransform = iota
-	StructureMapTransformCopy
-	StructureMapTransformTruncate
-	StructureMapTransformEscape
-	StructureMapTransformCast
-	StructureMapTransformAppend
-	StructureMapTransformTranslate
-	StructureMapTransformReference
-	StructureMapTransformDateOp
-	StructureMapTransformUuid
-	StructureMapTransformPointer
-	StructureMapTransformEvaluate
-	StructureMapTransformCc
-	StructureMapTransformC
-	StructureMapTransformQty
-	StructureMapTransformId
-	StructureMapTransformCp
+	StructureMapTransformCreate    StructureMapTransform = "create"
+	StructureMapTransformCopy      StructureMapTransform = "copy"
+	StructureMapTransformTruncate  StructureMapTransform = "truncate"
+	StructureMapTransformEscape    StructureMapTransform = "escape"
+	StructureMapTransformCast      StructureMapTransform = "cast"
+	StructureMapTransformAppend    StructureMapTransform = "append"
+	StructureMapTransformTranslate StructureMapTransform = "translate"
+	StructureMapTransformReference StructureMapTransform = "reference"
+	StructureMapTransformDateOp    StructureMapTransform = "dateOp"
+	StructureMapTransformUuid      StructureMapTransform = "uuid"
+	StructureMapTransformPointer   StructureMapTransform = "pointer"
+	StructureMapTransformEvaluate  StructureMapTransform = "evaluate"
+	StructureMapTransformCc        StructureMapTransform = "cc"
+	StructureMapTransformC         StructureMapTransform = "c"
+	StructureMapTransformQty       StructureMapTransform = "qty"
+	StructureMapTransformId        StructureMapTransform = "id"
+	StructureMapTransformCp        StructureMapTransform = "cp"
 )
 
 func (code StructureMapTransform) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "StructureMapTransform"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapTransform) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "create":
-		*code = StructureMapTransformCreate
-	case "copy":
-		*code = StructureMapTransformCopy
-	case "truncate":
-		*code = StructureMapTransformTruncate
-	case "escape":
-		*code = StructureMapTransformEscape
-	case "cast":
-		*code = StructureMapTransformCast
-	case "append":
-		*code = StructureMapTransformAppend
-	case "translate":
-		*code = StructureMapTransformTranslate
-	case "reference":
-		*code = StructureMapTransformReference
-	case "dateOp":
-		*code = StructureMapTransformDateOp
-	case "uuid":
-		*code = StructureMapTransformUuid
-	case "pointer":
-		*code = StructureMapTransformPointer
-	case "evaluate":
-		*code = StructureMapTransformEvaluate
-	case "cc":
-		*code = StructureMapTransformCc
-	case "c":
-		*code = StructureMapTransformC
-	case "qty":
-		*code = StructureMapTransformQty
-	case "id":
-		*code = StructureMapTransformId
-	case "cp":
-		*code = StructureMapTransformCp
-	default:
-		return fmt.Errorf("unknown StructureMapTransform code `%s`", s)
+func (code *StructureMapTransform) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = StructureMapTransform(s)
 	return nil
 }
 func (code StructureMapTransform) String() string {
 	return code.Code()
 }
 func (code StructureMapTransform) Code() string {
+	return string(code)
+}
+func (code StructureMapTransform) Known() bool {
 	switch code {
-	case StructureMapTransformCreate:
-		return "create"
-	case StructureMapTransformCopy:
-		return "copy"
-	case StructureMapTransformTruncate:
-		return "truncate"
-	case StructureMapTransformEscape:
-		return "escape"
-	case StructureMapTransformCast:
-		return "cast"
-	case StructureMapTransformAppend:
-		return "append"
-	case StructureMapTransformTranslate:
-		return "translate"
-	case StructureMapTransformReference:
-		return "reference"
-	case StructureMapTransformDateOp:
-		return "dateOp"
-	case StructureMapTransformUuid:
-		return "uuid"
-	case StructureMapTransformPointer:
-		return "pointer"
-	case StructureMapTransformEvaluate:
-		return "evaluate"
-	case StructureMapTransformCc:
-		return "cc"
-	case StructureMapTransformC:
-		return "c"
-	case StructureMapTransformQty:
-		return "qty"
-	case StructureMapTransformId:
-		return "id"
-	case StructureMapTransformCp:
-		return "cp"
+	case StructureMapTransformCreate, StructureMapTransformCopy, StructureMapTransformTruncate, StructureMapTransformEscape, StructureMapTransformCast, StructureMapTransformAppend, StructureMapTransformTranslate, StructureMapTransformReference, StructureMapTransformDateOp, StructureMapTransformUuid, StructureMapTransformPointer, StructureMapTransformEvaluate, StructureMapTransformCc, StructureMapTransformC, StructureMapTransformQty, StructureMapTransformId, StructureMapTransformCp:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code StructureMapTransform) Display() string {
 	switch code {
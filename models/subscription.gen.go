@@ -22,41 +22,91 @@ import "encoding/json"
 
 // Subscription is documented here http://hl7.org/fhir/StructureDefinition/Subscription
 type Subscription struct {
-	ID                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Status            SubscriptionStatus  `bson:"status" json:"status"`
-	Contact           []ContactPoint      `bson:"contact,omitempty" json:"contact,omitempty"`
-	End               *string             `bson:"end,omitempty" json:"end,omitempty"`
-	Reason            string              `bson:"reason" json:"reason"`
-	Criteria          string              `bson:"criteria" json:"criteria"`
-	Error             *string             `bson:"error,omitempty" json:"error,omitempty"`
-	Channel           SubscriptionChannel `bson:"channel" json:"channel"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status               SubscriptionStatus         `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Contact              []ContactPoint             `bson:"contact,omitempty" json:"contact,omitempty"`
+	End                  *Instant                   `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement           *Element                   `bson:"_end,omitempty" json:"_end,omitempty"`
+	Reason               string                     `bson:"reason" json:"reason"`
+	ReasonElement        *Element                   `bson:"_reason,omitempty" json:"_reason,omitempty"`
+	Criteria             string                     `bson:"criteria" json:"criteria"`
+	CriteriaElement      *Element                   `bson:"_criteria,omitempty" json:"_criteria,omitempty"`
+	Error                *string                    `bson:"error,omitempty" json:"error,omitempty"`
+	ErrorElement         *Element                   `bson:"_error,omitempty" json:"_error,omitempty"`
+	Channel              SubscriptionChannel        `bson:"channel" json:"channel"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type SubscriptionChannel struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              SubscriptionChannelType `bson:"type" json:"type"`
-	Endpoint          *string                 `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	Payload           *string                 `bson:"payload,omitempty" json:"payload,omitempty"`
-	Header            []string                `bson:"header,omitempty" json:"header,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              SubscriptionChannelType    `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Endpoint          *string                    `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	EndpointElement   *Element                   `bson:"_endpoint,omitempty" json:"_endpoint,omitempty"`
+	Payload           *string                    `bson:"payload,omitempty" json:"payload,omitempty"`
+	PayloadElement    *Element                   `bson:"_payload,omitempty" json:"_payload,omitempty"`
+	Header            []*string                  `bson:"header,omitempty" json:"header,omitempty"`
+	HeaderElement     []*Element                 `bson:"_header,omitempty" json:"_header,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the SubscriptionChannel and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *SubscriptionChannel) UnmarshalJSON(b []byte) error {
+	type other SubscriptionChannel
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Subscription.channel.header", len(r.Header), len(r.HeaderElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SubscriptionChannel with its unknown fields.
+func (r SubscriptionChannel) MarshalJSON() ([]byte, error) {
+	type other SubscriptionChannel
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Subscription and fails with CodeError when a coded element has an unknown code.
+func (r *Subscription) UnmarshalJSON(b []byte) error {
+	type other Subscription
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Subscription", r)
+}
+
 type OtherSubscription Subscription
 
 // MarshalJSON marshals the given Subscription as JSON into a byte slice
 func (r Subscription) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubscription
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubscription: OtherSubscription(r),
 		ResourceType:      "Subscription",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubscription unmarshals a Subscription.
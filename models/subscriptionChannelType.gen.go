@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.175246 +0000 UTC
+// 2026-10-19 02:55:18.951679054 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SubscriptionChannelType is documented here http://hl7.org/fhir/ValueSet/subscription-channel-type
-type SubscriptionChannelType int
+// The empty SubscriptionChannelType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SubscriptionChannelType string
 
 const (
-	SubscriptionChannelTypeRestHook SubscriptionChannelType = iota
-	SubscriptionChannelTypeWebsocket
-	SubscriptionChannelTypeEmail
-	SubscriptionChannelTypeSms
-	SubscriptionChannelTypeMessage
+	SubscriptionChannelTypeRestHook  SubscriptionChannelType = "rest-hook"
+	SubscriptionChannelTypeWebsocket SubscriptionChannelType = "websocket"
+	SubscriptionChannelTypeEmail     SubscriptionChannelType = "email"
+	SubscriptionChannelTypeSms       SubscriptionChannelType = "sms"
+	SubscriptionChannelTypeMessage   SubscriptionChannelType = "message"
 )
 
 func (code SubscriptionChannelType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SubscriptionChannelType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SubscriptionChannelType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "rest-hook":
-		*code = SubscriptionChannelTypeRestHook
-	case "websocket":
-		*code = SubscriptionChannelTypeWebsocket
-	case "email":
-		*code = SubscriptionChannelTypeEmail
-	case "sms":
-		*code = SubscriptionChannelTypeSms
-	case "message":
-		*code = SubscriptionChannelTypeMessage
-	default:
-		return fmt.Errorf("unknown SubscriptionChannelType code `%s`", s)
+func (code *SubscriptionChannelType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SubscriptionChannelType(s)
 	return nil
 }
 func (code SubscriptionChannelType) String() string {
 	return code.Code()
 }
 func (code SubscriptionChannelType) Code() string {
+	return string(code)
+}
+func (code SubscriptionChannelType) Known() bool {
 	switch code {
-	case SubscriptionChannelTypeRestHook:
-		return "rest-hook"
-	case SubscriptionChannelTypeWebsocket:
-		return "websocket"
-	case SubscriptionChannelTypeEmail:
-		return "email"
-	case SubscriptionChannelTypeSms:
-		return "sms"
-	case SubscriptionChannelTypeMessage:
-		return "message"
+	case SubscriptionChannelTypeRestHook, SubscriptionChannelTypeWebsocket, SubscriptionChannelTypeEmail, SubscriptionChannelTypeSms, SubscriptionChannelTypeMessage:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SubscriptionChannelType) Display() string {
 	switch code {
@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.243733 +0000 UTC
+// 2026-10-19 02:55:18.952729513 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SubscriptionStatus is documented here http://hl7.org/fhir/ValueSet/subscription-status
-type SubscriptionStatus int
+// The empty SubscriptionStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SubscriptionStatus string
 
 const (
-	SubscriptionStatusRequested SubscriptionStatus = iota
-	SubscriptionStatusActive
-	SubscriptionStatusError
-	SubscriptionStatusOff
+	SubscriptionStatusRequested SubscriptionStatus = "requested"
+	SubscriptionStatusActive    SubscriptionStatus = "active"
+	SubscriptionStatusError     SubscriptionStatus = "error"
+	SubscriptionStatusOff       SubscriptionStatus = "off"
 )
 
 func (code SubscriptionStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SubscriptionStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SubscriptionStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "requested":
-		*code = SubscriptionStatusRequested
-	case "active":
-		*code = SubscriptionStatusActive
-	case "error":
-		*code = SubscriptionStatusError
-	case "off":
-		*code = SubscriptionStatusOff
-	default:
-		return fmt.Errorf("unknown SubscriptionStatus code `%s`", s)
+func (code *SubscriptionStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SubscriptionStatus(s)
 	return nil
 }
 func (code SubscriptionStatus) String() string {
 	return code.Code()
 }
 func (code SubscriptionStatus) Code() string {
+	return string(code)
+}
+func (code SubscriptionStatus) Known() bool {
 	switch code {
-	case SubscriptionStatusRequested:
-		return "requested"
-	case SubscriptionStatusActive:
-		return "active"
-	case SubscriptionStatusError:
-		return "error"
-	case SubscriptionStatusOff:
-		return "off"
+	case SubscriptionStatusRequested, SubscriptionStatusActive, SubscriptionStatusError, SubscriptionStatusOff:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SubscriptionStatus) Display() string {
 	switch code {
@@ -22,46 +22,135 @@ import "encoding/json"
 
 // Substance is documented here http://hl7.org/fhir/StructureDefinition/Substance
 type Substance struct {
-	ID                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *FHIRSubstanceStatus  `bson:"status,omitempty" json:"status,omitempty"`
-	Category          []CodeableConcept     `bson:"category,omitempty" json:"category,omitempty"`
-	Code              CodeableConcept       `bson:"code" json:"code"`
-	Description       *string               `bson:"description,omitempty" json:"description,omitempty"`
-	Instance          []SubstanceInstance   `bson:"instance,omitempty" json:"instance,omitempty"`
-	Ingredient        []SubstanceIngredient `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *FHIRSubstanceStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category             []CodeableConcept          `bson:"category,omitempty" json:"category,omitempty"`
+	Code                 CodeableConcept            `bson:"code" json:"code"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Instance             []SubstanceInstance        `bson:"instance,omitempty" json:"instance,omitempty"`
+	Ingredient           []SubstanceIngredient      `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type SubstanceInstance struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Expiry            *DateTime   `bson:"expiry,omitempty" json:"expiry,omitempty"`
-	Quantity          *Quantity   `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Expiry            *DateTime                  `bson:"expiry,omitempty" json:"expiry,omitempty"`
+	ExpiryElement     *Element                   `bson:"_expiry,omitempty" json:"_expiry,omitempty"`
+	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceInstance with its unknown fields.
+func (r SubstanceInstance) MarshalJSON() ([]byte, error) {
+	type other SubstanceInstance
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceIngredient struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Quantity          *Ratio      `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Quantity                 *Ratio                     `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	SubstanceCodeableConcept *CodeableConcept           `bson:"substanceCodeableConcept,omitempty" json:"substanceCodeableConcept,omitempty"`
+	SubstanceReference       *Reference                 `bson:"substanceReference,omitempty" json:"substanceReference,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Substance returns Substance.ingredient.substance[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SubstanceIngredient) Substance() interface{} {
+	switch {
+	case r.SubstanceCodeableConcept != nil:
+		return r.SubstanceCodeableConcept
+	case r.SubstanceReference != nil:
+		return r.SubstanceReference
+	}
+	return nil
+}
+
+// SetSubstanceCodeableConcept sets Substance.ingredient.substance[x] to the CodeableConcept and clears the other types.
+func (r *SubstanceIngredient) SetSubstanceCodeableConcept(v CodeableConcept) {
+	r.clearSubstance()
+	r.SubstanceCodeableConcept = &v
+}
+
+// SetSubstanceReference sets Substance.ingredient.substance[x] to the Reference and clears the other types.
+func (r *SubstanceIngredient) SetSubstanceReference(v Reference) {
+	r.clearSubstance()
+	r.SubstanceReference = &v
+}
+func (r *SubstanceIngredient) clearSubstance() {
+	r.SubstanceCodeableConcept = nil
+	r.SubstanceReference = nil
 }
+
+// UnmarshalJSON unmarshals the SubstanceIngredient and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SubstanceIngredient) UnmarshalJSON(b []byte) error {
+	type other SubstanceIngredient
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Substance.ingredient.substance[x]",
+		r.SubstanceCodeableConcept != nil,
+		r.SubstanceReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SubstanceIngredient with its unknown fields.
+func (r SubstanceIngredient) MarshalJSON() ([]byte, error) {
+	type other SubstanceIngredient
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Substance and fails with CodeError when a coded element has an unknown code.
+func (r *Substance) UnmarshalJSON(b []byte) error {
+	type other Substance
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Substance", r)
+}
+
 type OtherSubstance Substance
 
 // MarshalJSON marshals the given Substance as JSON into a byte slice
 func (r Substance) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstance
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstance: OtherSubstance(r),
 		ResourceType:   "Substance",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstance unmarshals a Substance.
@@ -18,14 +18,21 @@
 
 package models
 
+import "encoding/json"
+
 // SubstanceAmount is documented here http://hl7.org/fhir/StructureDefinition/SubstanceAmount
 type SubstanceAmount struct {
-	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AmountType        *CodeableConcept               `bson:"amountType,omitempty" json:"amountType,omitempty"`
-	AmountText        *string                        `bson:"amountText,omitempty" json:"amountText,omitempty"`
-	ReferenceRange    *SubstanceAmountReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+	ID                  *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AmountQuantity      *Quantity                      `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountRange         *Range                         `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
+	AmountString        *string                        `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement *Element                       `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	AmountType          *CodeableConcept               `bson:"amountType,omitempty" json:"amountType,omitempty"`
+	AmountText          *string                        `bson:"amountText,omitempty" json:"amountText,omitempty"`
+	AmountTextElement   *Element                       `bson:"_amountText,omitempty" json:"_amountText,omitempty"`
+	ReferenceRange      *SubstanceAmountReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
 }
 type SubstanceAmountReferenceRange struct {
 	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -33,3 +40,57 @@ type SubstanceAmountReferenceRange struct {
 	LowLimit  *Quantity   `bson:"lowLimit,omitempty" json:"lowLimit,omitempty"`
 	HighLimit *Quantity   `bson:"highLimit,omitempty" json:"highLimit,omitempty"`
 }
+
+// Amount returns SubstanceAmount.amount[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *SubstanceAmount) Amount() interface{} {
+	switch {
+	case r.AmountQuantity != nil:
+		return r.AmountQuantity
+	case r.AmountRange != nil:
+		return r.AmountRange
+	case r.AmountString != nil:
+		return r.AmountString
+	}
+	return nil
+}
+
+// SetAmountQuantity sets SubstanceAmount.amount[x] to the Quantity and clears the other types.
+func (r *SubstanceAmount) SetAmountQuantity(v Quantity) {
+	r.clearAmount()
+	r.AmountQuantity = &v
+}
+
+// SetAmountRange sets SubstanceAmount.amount[x] to the Range and clears the other types.
+func (r *SubstanceAmount) SetAmountRange(v Range) {
+	r.clearAmount()
+	r.AmountRange = &v
+}
+
+// SetAmountString sets SubstanceAmount.amount[x] to the string and clears the other types.
+func (r *SubstanceAmount) SetAmountString(v string) {
+	r.clearAmount()
+	r.AmountString = &v
+}
+func (r *SubstanceAmount) clearAmount() {
+	r.AmountQuantity = nil
+	r.AmountRange = nil
+	r.AmountString = nil
+	r.AmountStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the SubstanceAmount and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SubstanceAmount) UnmarshalJSON(b []byte) error {
+	type other SubstanceAmount
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceAmount.amount[x]",
+		r.AmountQuantity != nil,
+		r.AmountRange != nil,
+		r.AmountString != nil || r.AmountStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
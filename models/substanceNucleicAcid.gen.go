@@ -22,60 +22,122 @@ import "encoding/json"
 
 // SubstanceNucleicAcid is documented here http://hl7.org/fhir/StructureDefinition/SubstanceNucleicAcid
 type SubstanceNucleicAcid struct {
-	ID                  *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SequenceType        *CodeableConcept              `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
-	NumberOfSubunits    *int                          `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
-	AreaOfHybridisation *string                       `bson:"areaOfHybridisation,omitempty" json:"areaOfHybridisation,omitempty"`
-	OligoNucleotideType *CodeableConcept              `bson:"oligoNucleotideType,omitempty" json:"oligoNucleotideType,omitempty"`
-	Subunit             []SubstanceNucleicAcidSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	ID                         *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                       *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SequenceType               *CodeableConcept              `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
+	NumberOfSubunits           *int                          `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
+	NumberOfSubunitsElement    *Element                      `bson:"_numberOfSubunits,omitempty" json:"_numberOfSubunits,omitempty"`
+	AreaOfHybridisation        *string                       `bson:"areaOfHybridisation,omitempty" json:"areaOfHybridisation,omitempty"`
+	AreaOfHybridisationElement *Element                      `bson:"_areaOfHybridisation,omitempty" json:"_areaOfHybridisation,omitempty"`
+	OligoNucleotideType        *CodeableConcept              `bson:"oligoNucleotideType,omitempty" json:"oligoNucleotideType,omitempty"`
+	Subunit                    []SubstanceNucleicAcidSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	UnknownFields              map[string]json.RawMessage    `bson:"-" json:"-"`
 }
 type SubstanceNucleicAcidSubunit struct {
 	ID                 *string                              `bson:"id,omitempty" json:"id,omitempty"`
 	Extension          []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension  []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Subunit            *int                                 `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	SubunitElement     *Element                             `bson:"_subunit,omitempty" json:"_subunit,omitempty"`
 	Sequence           *string                              `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement    *Element                             `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Length             *int                                 `bson:"length,omitempty" json:"length,omitempty"`
+	LengthElement      *Element                             `bson:"_length,omitempty" json:"_length,omitempty"`
 	SequenceAttachment *Attachment                          `bson:"sequenceAttachment,omitempty" json:"sequenceAttachment,omitempty"`
 	FivePrime          *CodeableConcept                     `bson:"fivePrime,omitempty" json:"fivePrime,omitempty"`
 	ThreePrime         *CodeableConcept                     `bson:"threePrime,omitempty" json:"threePrime,omitempty"`
 	Linkage            []SubstanceNucleicAcidSubunitLinkage `bson:"linkage,omitempty" json:"linkage,omitempty"`
 	Sugar              []SubstanceNucleicAcidSubunitSugar   `bson:"sugar,omitempty" json:"sugar,omitempty"`
+	UnknownFields      map[string]json.RawMessage           `bson:"-" json:"-"`
 }
 type SubstanceNucleicAcidSubunitLinkage struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Connectivity      *string     `bson:"connectivity,omitempty" json:"connectivity,omitempty"`
-	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	ResidueSite       *string     `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Connectivity        *string                    `bson:"connectivity,omitempty" json:"connectivity,omitempty"`
+	ConnectivityElement *Element                   `bson:"_connectivity,omitempty" json:"_connectivity,omitempty"`
+	Identifier          *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement         *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	ResidueSite         *string                    `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	ResidueSiteElement  *Element                   `bson:"_residueSite,omitempty" json:"_residueSite,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceNucleicAcidSubunitLinkage with its unknown fields.
+func (r SubstanceNucleicAcidSubunitLinkage) MarshalJSON() ([]byte, error) {
+	type other SubstanceNucleicAcidSubunitLinkage
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceNucleicAcidSubunitSugar struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	ResidueSite       *string     `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier         *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name               *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	ResidueSite        *string                    `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	ResidueSiteElement *Element                   `bson:"_residueSite,omitempty" json:"_residueSite,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceNucleicAcidSubunitSugar with its unknown fields.
+func (r SubstanceNucleicAcidSubunitSugar) MarshalJSON() ([]byte, error) {
+	type other SubstanceNucleicAcidSubunitSugar
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the SubstanceNucleicAcidSubunit with its unknown fields.
+func (r SubstanceNucleicAcidSubunit) MarshalJSON() ([]byte, error) {
+	type other SubstanceNucleicAcidSubunit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the SubstanceNucleicAcid and fails with CodeError when a coded element has an unknown code.
+func (r *SubstanceNucleicAcid) UnmarshalJSON(b []byte) error {
+	type other SubstanceNucleicAcid
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("SubstanceNucleicAcid", r)
+}
+
 type OtherSubstanceNucleicAcid SubstanceNucleicAcid
 
 // MarshalJSON marshals the given SubstanceNucleicAcid as JSON into a byte slice
 func (r SubstanceNucleicAcid) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstanceNucleicAcid
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstanceNucleicAcid: OtherSubstanceNucleicAcid(r),
 		ResourceType:              "SubstanceNucleicAcid",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstanceNucleicAcid unmarshals a SubstanceNucleicAcid.
@@ -25,16 +25,21 @@ type SubstancePolymer struct {
 	ID                    *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                  *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Class                 *CodeableConcept             `bson:"class,omitempty" json:"class,omitempty"`
 	Geometry              *CodeableConcept             `bson:"geometry,omitempty" json:"geometry,omitempty"`
 	CopolymerConnectivity []CodeableConcept            `bson:"copolymerConnectivity,omitempty" json:"copolymerConnectivity,omitempty"`
-	Modification          []string                     `bson:"modification,omitempty" json:"modification,omitempty"`
+	Modification          []*string                    `bson:"modification,omitempty" json:"modification,omitempty"`
+	ModificationElement   []*Element                   `bson:"_modification,omitempty" json:"_modification,omitempty"`
 	MonomerSet            []SubstancePolymerMonomerSet `bson:"monomerSet,omitempty" json:"monomerSet,omitempty"`
 	Repeat                []SubstancePolymerRepeat     `bson:"repeat,omitempty" json:"repeat,omitempty"`
+	UnknownFields         map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type SubstancePolymerMonomerSet struct {
 	ID                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
@@ -42,24 +47,51 @@ type SubstancePolymerMonomerSet struct {
 	ModifierExtension []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	RatioType         *CodeableConcept                             `bson:"ratioType,omitempty" json:"ratioType,omitempty"`
 	StartingMaterial  []SubstancePolymerMonomerSetStartingMaterial `bson:"startingMaterial,omitempty" json:"startingMaterial,omitempty"`
+	UnknownFields     map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type SubstancePolymerMonomerSetStartingMaterial struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Material          *CodeableConcept `bson:"material,omitempty" json:"material,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	IsDefining        *bool            `bson:"isDefining,omitempty" json:"isDefining,omitempty"`
-	Amount            *SubstanceAmount `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Material          *CodeableConcept           `bson:"material,omitempty" json:"material,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	IsDefining        *bool                      `bson:"isDefining,omitempty" json:"isDefining,omitempty"`
+	IsDefiningElement *Element                   `bson:"_isDefining,omitempty" json:"_isDefining,omitempty"`
+	Amount            *SubstanceAmount           `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstancePolymerMonomerSetStartingMaterial with its unknown fields.
+func (r SubstancePolymerMonomerSetStartingMaterial) MarshalJSON() ([]byte, error) {
+	type other SubstancePolymerMonomerSetStartingMaterial
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the SubstancePolymerMonomerSet with its unknown fields.
+func (r SubstancePolymerMonomerSet) MarshalJSON() ([]byte, error) {
+	type other SubstancePolymerMonomerSet
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstancePolymerRepeat struct {
-	ID                      *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	NumberOfUnits           *int                               `bson:"numberOfUnits,omitempty" json:"numberOfUnits,omitempty"`
-	AverageMolecularFormula *string                            `bson:"averageMolecularFormula,omitempty" json:"averageMolecularFormula,omitempty"`
-	RepeatUnitAmountType    *CodeableConcept                   `bson:"repeatUnitAmountType,omitempty" json:"repeatUnitAmountType,omitempty"`
-	RepeatUnit              []SubstancePolymerRepeatRepeatUnit `bson:"repeatUnit,omitempty" json:"repeatUnit,omitempty"`
+	ID                             *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                      []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension              []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	NumberOfUnits                  *int                               `bson:"numberOfUnits,omitempty" json:"numberOfUnits,omitempty"`
+	NumberOfUnitsElement           *Element                           `bson:"_numberOfUnits,omitempty" json:"_numberOfUnits,omitempty"`
+	AverageMolecularFormula        *string                            `bson:"averageMolecularFormula,omitempty" json:"averageMolecularFormula,omitempty"`
+	AverageMolecularFormulaElement *Element                           `bson:"_averageMolecularFormula,omitempty" json:"_averageMolecularFormula,omitempty"`
+	RepeatUnitAmountType           *CodeableConcept                   `bson:"repeatUnitAmountType,omitempty" json:"repeatUnitAmountType,omitempty"`
+	RepeatUnit                     []SubstancePolymerRepeatRepeatUnit `bson:"repeatUnit,omitempty" json:"repeatUnit,omitempty"`
+	UnknownFields                  map[string]json.RawMessage         `bson:"-" json:"-"`
 }
 type SubstancePolymerRepeatRepeatUnit struct {
 	ID                          *string                                                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -67,36 +99,99 @@ type SubstancePolymerRepeatRepeatUnit struct {
 	ModifierExtension           []Extension                                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	OrientationOfPolymerisation *CodeableConcept                                           `bson:"orientationOfPolymerisation,omitempty" json:"orientationOfPolymerisation,omitempty"`
 	RepeatUnit                  *string                                                    `bson:"repeatUnit,omitempty" json:"repeatUnit,omitempty"`
+	RepeatUnitElement           *Element                                                   `bson:"_repeatUnit,omitempty" json:"_repeatUnit,omitempty"`
 	Amount                      *SubstanceAmount                                           `bson:"amount,omitempty" json:"amount,omitempty"`
 	DegreeOfPolymerisation      []SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation   `bson:"degreeOfPolymerisation,omitempty" json:"degreeOfPolymerisation,omitempty"`
 	StructuralRepresentation    []SubstancePolymerRepeatRepeatUnitStructuralRepresentation `bson:"structuralRepresentation,omitempty" json:"structuralRepresentation,omitempty"`
+	UnknownFields               map[string]json.RawMessage                                 `bson:"-" json:"-"`
 }
 type SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Degree            *CodeableConcept `bson:"degree,omitempty" json:"degree,omitempty"`
-	Amount            *SubstanceAmount `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Degree            *CodeableConcept           `bson:"degree,omitempty" json:"degree,omitempty"`
+	Amount            *SubstanceAmount           `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation with its unknown fields.
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) MarshalJSON() ([]byte, error) {
+	type other SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstancePolymerRepeatRepeatUnitStructuralRepresentation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Representation    *string          `bson:"representation,omitempty" json:"representation,omitempty"`
-	Attachment        *Attachment      `bson:"attachment,omitempty" json:"attachment,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                  *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Representation        *string                    `bson:"representation,omitempty" json:"representation,omitempty"`
+	RepresentationElement *Element                   `bson:"_representation,omitempty" json:"_representation,omitempty"`
+	Attachment            *Attachment                `bson:"attachment,omitempty" json:"attachment,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the SubstancePolymerRepeatRepeatUnitStructuralRepresentation with its unknown fields.
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) MarshalJSON() ([]byte, error) {
+	type other SubstancePolymerRepeatRepeatUnitStructuralRepresentation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the SubstancePolymerRepeatRepeatUnit with its unknown fields.
+func (r SubstancePolymerRepeatRepeatUnit) MarshalJSON() ([]byte, error) {
+	type other SubstancePolymerRepeatRepeatUnit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the SubstancePolymerRepeat with its unknown fields.
+func (r SubstancePolymerRepeat) MarshalJSON() ([]byte, error) {
+	type other SubstancePolymerRepeat
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the SubstancePolymer and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *SubstancePolymer) UnmarshalJSON(b []byte) error {
+	type other SubstancePolymer
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("SubstancePolymer.modification", len(r.Modification), len(r.ModificationElement)); err != nil {
+		return err
+	}
+	return checkCodes("SubstancePolymer", r)
+}
+
 type OtherSubstancePolymer SubstancePolymer
 
 // MarshalJSON marshals the given SubstancePolymer as JSON into a byte slice
 func (r SubstancePolymer) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstancePolymer
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstancePolymer: OtherSubstancePolymer(r),
 		ResourceType:          "SubstancePolymer",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstancePolymer unmarshals a SubstancePolymer.
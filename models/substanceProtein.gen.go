@@ -22,42 +22,81 @@ import "encoding/json"
 
 // SubstanceProtein is documented here http://hl7.org/fhir/StructureDefinition/SubstanceProtein
 type SubstanceProtein struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SequenceType      *CodeableConcept          `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
-	NumberOfSubunits  *int                      `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
-	DisulfideLinkage  []string                  `bson:"disulfideLinkage,omitempty" json:"disulfideLinkage,omitempty"`
-	Subunit           []SubstanceProteinSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                    *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SequenceType            *CodeableConcept           `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
+	NumberOfSubunits        *int                       `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
+	NumberOfSubunitsElement *Element                   `bson:"_numberOfSubunits,omitempty" json:"_numberOfSubunits,omitempty"`
+	DisulfideLinkage        []*string                  `bson:"disulfideLinkage,omitempty" json:"disulfideLinkage,omitempty"`
+	DisulfideLinkageElement []*Element                 `bson:"_disulfideLinkage,omitempty" json:"_disulfideLinkage,omitempty"`
+	Subunit                 []SubstanceProteinSubunit  `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type SubstanceProteinSubunit struct {
-	ID                      *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Subunit                 *int        `bson:"subunit,omitempty" json:"subunit,omitempty"`
-	Sequence                *string     `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	Length                  *int        `bson:"length,omitempty" json:"length,omitempty"`
-	SequenceAttachment      *Attachment `bson:"sequenceAttachment,omitempty" json:"sequenceAttachment,omitempty"`
-	NTerminalModificationId *Identifier `bson:"nTerminalModificationId,omitempty" json:"nTerminalModificationId,omitempty"`
-	NTerminalModification   *string     `bson:"nTerminalModification,omitempty" json:"nTerminalModification,omitempty"`
-	CTerminalModificationId *Identifier `bson:"cTerminalModificationId,omitempty" json:"cTerminalModificationId,omitempty"`
-	CTerminalModification   *string     `bson:"cTerminalModification,omitempty" json:"cTerminalModification,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subunit                      *int                       `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	SubunitElement               *Element                   `bson:"_subunit,omitempty" json:"_subunit,omitempty"`
+	Sequence                     *string                    `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement              *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Length                       *int                       `bson:"length,omitempty" json:"length,omitempty"`
+	LengthElement                *Element                   `bson:"_length,omitempty" json:"_length,omitempty"`
+	SequenceAttachment           *Attachment                `bson:"sequenceAttachment,omitempty" json:"sequenceAttachment,omitempty"`
+	NTerminalModificationId      *Identifier                `bson:"nTerminalModificationId,omitempty" json:"nTerminalModificationId,omitempty"`
+	NTerminalModification        *string                    `bson:"nTerminalModification,omitempty" json:"nTerminalModification,omitempty"`
+	NTerminalModificationElement *Element                   `bson:"_nTerminalModification,omitempty" json:"_nTerminalModification,omitempty"`
+	CTerminalModificationId      *Identifier                `bson:"cTerminalModificationId,omitempty" json:"cTerminalModificationId,omitempty"`
+	CTerminalModification        *string                    `bson:"cTerminalModification,omitempty" json:"cTerminalModification,omitempty"`
+	CTerminalModificationElement *Element                   `bson:"_cTerminalModification,omitempty" json:"_cTerminalModification,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceProteinSubunit with its unknown fields.
+func (r SubstanceProteinSubunit) MarshalJSON() ([]byte, error) {
+	type other SubstanceProteinSubunit
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the SubstanceProtein and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *SubstanceProtein) UnmarshalJSON(b []byte) error {
+	type other SubstanceProtein
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("SubstanceProtein.disulfideLinkage", len(r.DisulfideLinkage), len(r.DisulfideLinkageElement)); err != nil {
+		return err
+	}
+	return checkCodes("SubstanceProtein", r)
+}
+
 type OtherSubstanceProtein SubstanceProtein
 
 // MarshalJSON marshals the given SubstanceProtein as JSON into a byte slice
 func (r SubstanceProtein) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstanceProtein
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstanceProtein: OtherSubstanceProtein(r),
 		ResourceType:          "SubstanceProtein",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstanceProtein unmarshals a SubstanceProtein.
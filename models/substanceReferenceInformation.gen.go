@@ -22,67 +22,191 @@ import "encoding/json"
 
 // SubstanceReferenceInformation is documented here http://hl7.org/fhir/StructureDefinition/SubstanceReferenceInformation
 type SubstanceReferenceInformation struct {
-	ID                *string                                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Comment           *string                                       `bson:"comment,omitempty" json:"comment,omitempty"`
-	Gene              []SubstanceReferenceInformationGene           `bson:"gene,omitempty" json:"gene,omitempty"`
-	GeneElement       []SubstanceReferenceInformationGeneElement    `bson:"geneElement,omitempty" json:"geneElement,omitempty"`
-	Classification    []SubstanceReferenceInformationClassification `bson:"classification,omitempty" json:"classification,omitempty"`
-	Target            []SubstanceReferenceInformationTarget         `bson:"target,omitempty" json:"target,omitempty"`
+	ID                   *string                                       `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Comment              *string                                       `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                                      `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Gene                 []SubstanceReferenceInformationGene           `bson:"gene,omitempty" json:"gene,omitempty"`
+	GeneElement          []SubstanceReferenceInformationGeneElement    `bson:"geneElement,omitempty" json:"geneElement,omitempty"`
+	Classification       []SubstanceReferenceInformationClassification `bson:"classification,omitempty" json:"classification,omitempty"`
+	Target               []SubstanceReferenceInformationTarget         `bson:"target,omitempty" json:"target,omitempty"`
+	UnknownFields        map[string]json.RawMessage                    `bson:"-" json:"-"`
 }
 type SubstanceReferenceInformationGene struct {
-	ID                 *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	GeneSequenceOrigin *CodeableConcept `bson:"geneSequenceOrigin,omitempty" json:"geneSequenceOrigin,omitempty"`
-	Gene               *CodeableConcept `bson:"gene,omitempty" json:"gene,omitempty"`
-	Source             []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	GeneSequenceOrigin *CodeableConcept           `bson:"geneSequenceOrigin,omitempty" json:"geneSequenceOrigin,omitempty"`
+	Gene               *CodeableConcept           `bson:"gene,omitempty" json:"gene,omitempty"`
+	Source             []Reference                `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceReferenceInformationGene with its unknown fields.
+func (r SubstanceReferenceInformationGene) MarshalJSON() ([]byte, error) {
+	type other SubstanceReferenceInformationGene
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceReferenceInformationGeneElement struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Element           *Identifier      `bson:"element,omitempty" json:"element,omitempty"`
-	Source            []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Element           *Identifier                `bson:"element,omitempty" json:"element,omitempty"`
+	Source            []Reference                `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceReferenceInformationGeneElement with its unknown fields.
+func (r SubstanceReferenceInformationGeneElement) MarshalJSON() ([]byte, error) {
+	type other SubstanceReferenceInformationGeneElement
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceReferenceInformationClassification struct {
-	ID                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Domain            *CodeableConcept  `bson:"domain,omitempty" json:"domain,omitempty"`
-	Classification    *CodeableConcept  `bson:"classification,omitempty" json:"classification,omitempty"`
-	Subtype           []CodeableConcept `bson:"subtype,omitempty" json:"subtype,omitempty"`
-	Source            []Reference       `bson:"source,omitempty" json:"source,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Domain            *CodeableConcept           `bson:"domain,omitempty" json:"domain,omitempty"`
+	Classification    *CodeableConcept           `bson:"classification,omitempty" json:"classification,omitempty"`
+	Subtype           []CodeableConcept          `bson:"subtype,omitempty" json:"subtype,omitempty"`
+	Source            []Reference                `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceReferenceInformationClassification with its unknown fields.
+func (r SubstanceReferenceInformationClassification) MarshalJSON() ([]byte, error) {
+	type other SubstanceReferenceInformationClassification
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceReferenceInformationTarget struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            *Identifier      `bson:"target,omitempty" json:"target,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Interaction       *CodeableConcept `bson:"interaction,omitempty" json:"interaction,omitempty"`
-	Organism          *CodeableConcept `bson:"organism,omitempty" json:"organism,omitempty"`
-	OrganismType      *CodeableConcept `bson:"organismType,omitempty" json:"organismType,omitempty"`
-	AmountType        *CodeableConcept `bson:"amountType,omitempty" json:"amountType,omitempty"`
-	Source            []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target              *Identifier                `bson:"target,omitempty" json:"target,omitempty"`
+	Type                *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Interaction         *CodeableConcept           `bson:"interaction,omitempty" json:"interaction,omitempty"`
+	Organism            *CodeableConcept           `bson:"organism,omitempty" json:"organism,omitempty"`
+	OrganismType        *CodeableConcept           `bson:"organismType,omitempty" json:"organismType,omitempty"`
+	AmountQuantity      *Quantity                  `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountRange         *Range                     `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
+	AmountString        *string                    `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement *Element                   `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	AmountType          *CodeableConcept           `bson:"amountType,omitempty" json:"amountType,omitempty"`
+	Source              []Reference                `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Amount returns SubstanceReferenceInformation.target.amount[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *SubstanceReferenceInformationTarget) Amount() interface{} {
+	switch {
+	case r.AmountQuantity != nil:
+		return r.AmountQuantity
+	case r.AmountRange != nil:
+		return r.AmountRange
+	case r.AmountString != nil:
+		return r.AmountString
+	}
+	return nil
+}
+
+// SetAmountQuantity sets SubstanceReferenceInformation.target.amount[x] to the Quantity and clears the other types.
+func (r *SubstanceReferenceInformationTarget) SetAmountQuantity(v Quantity) {
+	r.clearAmount()
+	r.AmountQuantity = &v
 }
+
+// SetAmountRange sets SubstanceReferenceInformation.target.amount[x] to the Range and clears the other types.
+func (r *SubstanceReferenceInformationTarget) SetAmountRange(v Range) {
+	r.clearAmount()
+	r.AmountRange = &v
+}
+
+// SetAmountString sets SubstanceReferenceInformation.target.amount[x] to the string and clears the other types.
+func (r *SubstanceReferenceInformationTarget) SetAmountString(v string) {
+	r.clearAmount()
+	r.AmountString = &v
+}
+func (r *SubstanceReferenceInformationTarget) clearAmount() {
+	r.AmountQuantity = nil
+	r.AmountRange = nil
+	r.AmountString = nil
+	r.AmountStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the SubstanceReferenceInformationTarget and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SubstanceReferenceInformationTarget) UnmarshalJSON(b []byte) error {
+	type other SubstanceReferenceInformationTarget
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceReferenceInformation.target.amount[x]",
+		r.AmountQuantity != nil,
+		r.AmountRange != nil,
+		r.AmountString != nil || r.AmountStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SubstanceReferenceInformationTarget with its unknown fields.
+func (r SubstanceReferenceInformationTarget) MarshalJSON() ([]byte, error) {
+	type other SubstanceReferenceInformationTarget
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the SubstanceReferenceInformation and fails with CodeError when a coded element has an unknown code.
+func (r *SubstanceReferenceInformation) UnmarshalJSON(b []byte) error {
+	type other SubstanceReferenceInformation
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("SubstanceReferenceInformation", r)
+}
+
 type OtherSubstanceReferenceInformation SubstanceReferenceInformation
 
 // MarshalJSON marshals the given SubstanceReferenceInformation as JSON into a byte slice
 func (r SubstanceReferenceInformation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstanceReferenceInformation
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstanceReferenceInformation: OtherSubstanceReferenceInformation(r),
 		ResourceType:                       "SubstanceReferenceInformation",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstanceReferenceInformation unmarshals a SubstanceReferenceInformation.
@@ -22,91 +22,195 @@ import "encoding/json"
 
 // SubstanceSourceMaterial is documented here http://hl7.org/fhir/StructureDefinition/SubstanceSourceMaterial
 type SubstanceSourceMaterial struct {
-	ID                   *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string                                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string                                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative                                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SourceMaterialClass  *CodeableConcept                             `bson:"sourceMaterialClass,omitempty" json:"sourceMaterialClass,omitempty"`
-	SourceMaterialType   *CodeableConcept                             `bson:"sourceMaterialType,omitempty" json:"sourceMaterialType,omitempty"`
-	SourceMaterialState  *CodeableConcept                             `bson:"sourceMaterialState,omitempty" json:"sourceMaterialState,omitempty"`
-	OrganismId           *Identifier                                  `bson:"organismId,omitempty" json:"organismId,omitempty"`
-	OrganismName         *string                                      `bson:"organismName,omitempty" json:"organismName,omitempty"`
-	ParentSubstanceId    []Identifier                                 `bson:"parentSubstanceId,omitempty" json:"parentSubstanceId,omitempty"`
-	ParentSubstanceName  []string                                     `bson:"parentSubstanceName,omitempty" json:"parentSubstanceName,omitempty"`
-	CountryOfOrigin      []CodeableConcept                            `bson:"countryOfOrigin,omitempty" json:"countryOfOrigin,omitempty"`
-	GeographicalLocation []string                                     `bson:"geographicalLocation,omitempty" json:"geographicalLocation,omitempty"`
-	DevelopmentStage     *CodeableConcept                             `bson:"developmentStage,omitempty" json:"developmentStage,omitempty"`
-	FractionDescription  []SubstanceSourceMaterialFractionDescription `bson:"fractionDescription,omitempty" json:"fractionDescription,omitempty"`
-	Organism             *SubstanceSourceMaterialOrganism             `bson:"organism,omitempty" json:"organism,omitempty"`
-	PartDescription      []SubstanceSourceMaterialPartDescription     `bson:"partDescription,omitempty" json:"partDescription,omitempty"`
+	ID                          *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                        *Meta                                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules               *string                                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement        *Element                                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                    *string                                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement             *Element                                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                        *Narrative                                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                   ContainedResources                           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                   []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SourceMaterialClass         *CodeableConcept                             `bson:"sourceMaterialClass,omitempty" json:"sourceMaterialClass,omitempty"`
+	SourceMaterialType          *CodeableConcept                             `bson:"sourceMaterialType,omitempty" json:"sourceMaterialType,omitempty"`
+	SourceMaterialState         *CodeableConcept                             `bson:"sourceMaterialState,omitempty" json:"sourceMaterialState,omitempty"`
+	OrganismId                  *Identifier                                  `bson:"organismId,omitempty" json:"organismId,omitempty"`
+	OrganismName                *string                                      `bson:"organismName,omitempty" json:"organismName,omitempty"`
+	OrganismNameElement         *Element                                     `bson:"_organismName,omitempty" json:"_organismName,omitempty"`
+	ParentSubstanceId           []Identifier                                 `bson:"parentSubstanceId,omitempty" json:"parentSubstanceId,omitempty"`
+	ParentSubstanceName         []*string                                    `bson:"parentSubstanceName,omitempty" json:"parentSubstanceName,omitempty"`
+	ParentSubstanceNameElement  []*Element                                   `bson:"_parentSubstanceName,omitempty" json:"_parentSubstanceName,omitempty"`
+	CountryOfOrigin             []CodeableConcept                            `bson:"countryOfOrigin,omitempty" json:"countryOfOrigin,omitempty"`
+	GeographicalLocation        []*string                                    `bson:"geographicalLocation,omitempty" json:"geographicalLocation,omitempty"`
+	GeographicalLocationElement []*Element                                   `bson:"_geographicalLocation,omitempty" json:"_geographicalLocation,omitempty"`
+	DevelopmentStage            *CodeableConcept                             `bson:"developmentStage,omitempty" json:"developmentStage,omitempty"`
+	FractionDescription         []SubstanceSourceMaterialFractionDescription `bson:"fractionDescription,omitempty" json:"fractionDescription,omitempty"`
+	Organism                    *SubstanceSourceMaterialOrganism             `bson:"organism,omitempty" json:"organism,omitempty"`
+	PartDescription             []SubstanceSourceMaterialPartDescription     `bson:"partDescription,omitempty" json:"partDescription,omitempty"`
+	UnknownFields               map[string]json.RawMessage                   `bson:"-" json:"-"`
 }
 type SubstanceSourceMaterialFractionDescription struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Fraction          *string          `bson:"fraction,omitempty" json:"fraction,omitempty"`
-	MaterialType      *CodeableConcept `bson:"materialType,omitempty" json:"materialType,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Fraction          *string                    `bson:"fraction,omitempty" json:"fraction,omitempty"`
+	FractionElement   *Element                   `bson:"_fraction,omitempty" json:"_fraction,omitempty"`
+	MaterialType      *CodeableConcept           `bson:"materialType,omitempty" json:"materialType,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceSourceMaterialFractionDescription with its unknown fields.
+func (r SubstanceSourceMaterialFractionDescription) MarshalJSON() ([]byte, error) {
+	type other SubstanceSourceMaterialFractionDescription
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSourceMaterialOrganism struct {
-	ID                       *string                                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Family                   *CodeableConcept                                `bson:"family,omitempty" json:"family,omitempty"`
-	Genus                    *CodeableConcept                                `bson:"genus,omitempty" json:"genus,omitempty"`
-	Species                  *CodeableConcept                                `bson:"species,omitempty" json:"species,omitempty"`
-	IntraspecificType        *CodeableConcept                                `bson:"intraspecificType,omitempty" json:"intraspecificType,omitempty"`
-	IntraspecificDescription *string                                         `bson:"intraspecificDescription,omitempty" json:"intraspecificDescription,omitempty"`
-	Author                   []SubstanceSourceMaterialOrganismAuthor         `bson:"author,omitempty" json:"author,omitempty"`
-	Hybrid                   *SubstanceSourceMaterialOrganismHybrid          `bson:"hybrid,omitempty" json:"hybrid,omitempty"`
-	OrganismGeneral          *SubstanceSourceMaterialOrganismOrganismGeneral `bson:"organismGeneral,omitempty" json:"organismGeneral,omitempty"`
+	ID                              *string                                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                       []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Family                          *CodeableConcept                                `bson:"family,omitempty" json:"family,omitempty"`
+	Genus                           *CodeableConcept                                `bson:"genus,omitempty" json:"genus,omitempty"`
+	Species                         *CodeableConcept                                `bson:"species,omitempty" json:"species,omitempty"`
+	IntraspecificType               *CodeableConcept                                `bson:"intraspecificType,omitempty" json:"intraspecificType,omitempty"`
+	IntraspecificDescription        *string                                         `bson:"intraspecificDescription,omitempty" json:"intraspecificDescription,omitempty"`
+	IntraspecificDescriptionElement *Element                                        `bson:"_intraspecificDescription,omitempty" json:"_intraspecificDescription,omitempty"`
+	Author                          []SubstanceSourceMaterialOrganismAuthor         `bson:"author,omitempty" json:"author,omitempty"`
+	Hybrid                          *SubstanceSourceMaterialOrganismHybrid          `bson:"hybrid,omitempty" json:"hybrid,omitempty"`
+	OrganismGeneral                 *SubstanceSourceMaterialOrganismOrganismGeneral `bson:"organismGeneral,omitempty" json:"organismGeneral,omitempty"`
+	UnknownFields                   map[string]json.RawMessage                      `bson:"-" json:"-"`
 }
 type SubstanceSourceMaterialOrganismAuthor struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AuthorType        *CodeableConcept `bson:"authorType,omitempty" json:"authorType,omitempty"`
-	AuthorDescription *string          `bson:"authorDescription,omitempty" json:"authorDescription,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AuthorType               *CodeableConcept           `bson:"authorType,omitempty" json:"authorType,omitempty"`
+	AuthorDescription        *string                    `bson:"authorDescription,omitempty" json:"authorDescription,omitempty"`
+	AuthorDescriptionElement *Element                   `bson:"_authorDescription,omitempty" json:"_authorDescription,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceSourceMaterialOrganismAuthor with its unknown fields.
+func (r SubstanceSourceMaterialOrganismAuthor) MarshalJSON() ([]byte, error) {
+	type other SubstanceSourceMaterialOrganismAuthor
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSourceMaterialOrganismHybrid struct {
-	ID                   *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	MaternalOrganismId   *string          `bson:"maternalOrganismId,omitempty" json:"maternalOrganismId,omitempty"`
-	MaternalOrganismName *string          `bson:"maternalOrganismName,omitempty" json:"maternalOrganismName,omitempty"`
-	PaternalOrganismId   *string          `bson:"paternalOrganismId,omitempty" json:"paternalOrganismId,omitempty"`
-	PaternalOrganismName *string          `bson:"paternalOrganismName,omitempty" json:"paternalOrganismName,omitempty"`
-	HybridType           *CodeableConcept `bson:"hybridType,omitempty" json:"hybridType,omitempty"`
+	ID                          *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                   []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MaternalOrganismId          *string                    `bson:"maternalOrganismId,omitempty" json:"maternalOrganismId,omitempty"`
+	MaternalOrganismIdElement   *Element                   `bson:"_maternalOrganismId,omitempty" json:"_maternalOrganismId,omitempty"`
+	MaternalOrganismName        *string                    `bson:"maternalOrganismName,omitempty" json:"maternalOrganismName,omitempty"`
+	MaternalOrganismNameElement *Element                   `bson:"_maternalOrganismName,omitempty" json:"_maternalOrganismName,omitempty"`
+	PaternalOrganismId          *string                    `bson:"paternalOrganismId,omitempty" json:"paternalOrganismId,omitempty"`
+	PaternalOrganismIdElement   *Element                   `bson:"_paternalOrganismId,omitempty" json:"_paternalOrganismId,omitempty"`
+	PaternalOrganismName        *string                    `bson:"paternalOrganismName,omitempty" json:"paternalOrganismName,omitempty"`
+	PaternalOrganismNameElement *Element                   `bson:"_paternalOrganismName,omitempty" json:"_paternalOrganismName,omitempty"`
+	HybridType                  *CodeableConcept           `bson:"hybridType,omitempty" json:"hybridType,omitempty"`
+	UnknownFields               map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceSourceMaterialOrganismHybrid with its unknown fields.
+func (r SubstanceSourceMaterialOrganismHybrid) MarshalJSON() ([]byte, error) {
+	type other SubstanceSourceMaterialOrganismHybrid
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSourceMaterialOrganismOrganismGeneral struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Kingdom           *CodeableConcept `bson:"kingdom,omitempty" json:"kingdom,omitempty"`
-	Phylum            *CodeableConcept `bson:"phylum,omitempty" json:"phylum,omitempty"`
-	Class             *CodeableConcept `bson:"class,omitempty" json:"class,omitempty"`
-	Order             *CodeableConcept `bson:"order,omitempty" json:"order,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Kingdom           *CodeableConcept           `bson:"kingdom,omitempty" json:"kingdom,omitempty"`
+	Phylum            *CodeableConcept           `bson:"phylum,omitempty" json:"phylum,omitempty"`
+	Class             *CodeableConcept           `bson:"class,omitempty" json:"class,omitempty"`
+	Order             *CodeableConcept           `bson:"order,omitempty" json:"order,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the SubstanceSourceMaterialOrganismOrganismGeneral with its unknown fields.
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) MarshalJSON() ([]byte, error) {
+	type other SubstanceSourceMaterialOrganismOrganismGeneral
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the SubstanceSourceMaterialOrganism with its unknown fields.
+func (r SubstanceSourceMaterialOrganism) MarshalJSON() ([]byte, error) {
+	type other SubstanceSourceMaterialOrganism
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSourceMaterialPartDescription struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Part              *CodeableConcept `bson:"part,omitempty" json:"part,omitempty"`
-	PartLocation      *CodeableConcept `bson:"partLocation,omitempty" json:"partLocation,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Part              *CodeableConcept           `bson:"part,omitempty" json:"part,omitempty"`
+	PartLocation      *CodeableConcept           `bson:"partLocation,omitempty" json:"partLocation,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the SubstanceSourceMaterialPartDescription with its unknown fields.
+func (r SubstanceSourceMaterialPartDescription) MarshalJSON() ([]byte, error) {
+	type other SubstanceSourceMaterialPartDescription
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the SubstanceSourceMaterial and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *SubstanceSourceMaterial) UnmarshalJSON(b []byte) error {
+	type other SubstanceSourceMaterial
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("SubstanceSourceMaterial.parentSubstanceName", len(r.ParentSubstanceName), len(r.ParentSubstanceNameElement)); err != nil {
+		return err
+	}
+	if err := checkElements("SubstanceSourceMaterial.geographicalLocation", len(r.GeographicalLocation), len(r.GeographicalLocationElement)); err != nil {
+		return err
+	}
+	return checkCodes("SubstanceSourceMaterial", r)
+}
+
 type OtherSubstanceSourceMaterial SubstanceSourceMaterial
 
 // MarshalJSON marshals the given SubstanceSourceMaterial as JSON into a byte slice
 func (r SubstanceSourceMaterial) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstanceSourceMaterial
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstanceSourceMaterial: OtherSubstanceSourceMaterial(r),
 		ResourceType:                 "SubstanceSourceMaterial",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstanceSourceMaterial unmarshals a SubstanceSourceMaterial.
@@ -25,8 +25,11 @@ type SubstanceSpecification struct {
 	ID                   *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
 	Meta                 *Meta                                                   `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                                                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                                `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                                      `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           *Identifier                                             `bson:"identifier,omitempty" json:"identifier,omitempty"`
@@ -34,8 +37,10 @@ type SubstanceSpecification struct {
 	Status               *CodeableConcept                                        `bson:"status,omitempty" json:"status,omitempty"`
 	Domain               *CodeableConcept                                        `bson:"domain,omitempty" json:"domain,omitempty"`
 	Description          *string                                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                                                `bson:"_description,omitempty" json:"_description,omitempty"`
 	Source               []Reference                                             `bson:"source,omitempty" json:"source,omitempty"`
 	Comment              *string                                                 `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                                                `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	Moiety               []SubstanceSpecificationMoiety                          `bson:"moiety,omitempty" json:"moiety,omitempty"`
 	Property             []SubstanceSpecificationProperty                        `bson:"property,omitempty" json:"property,omitempty"`
 	ReferenceInformation *Reference                                              `bson:"referenceInformation,omitempty" json:"referenceInformation,omitempty"`
@@ -48,38 +53,199 @@ type SubstanceSpecification struct {
 	Polymer              *Reference                                              `bson:"polymer,omitempty" json:"polymer,omitempty"`
 	Protein              *Reference                                              `bson:"protein,omitempty" json:"protein,omitempty"`
 	SourceMaterial       *Reference                                              `bson:"sourceMaterial,omitempty" json:"sourceMaterial,omitempty"`
+	UnknownFields        map[string]json.RawMessage                              `bson:"-" json:"-"`
 }
 type SubstanceSpecificationMoiety struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Identifier        *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string          `bson:"name,omitempty" json:"name,omitempty"`
-	Stereochemistry   *CodeableConcept `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
-	OpticalActivity   *CodeableConcept `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
-	MolecularFormula  *string          `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
+	ID                      *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Role                    *CodeableConcept           `bson:"role,omitempty" json:"role,omitempty"`
+	Identifier              *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                    *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Stereochemistry         *CodeableConcept           `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
+	OpticalActivity         *CodeableConcept           `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
+	MolecularFormula        *string                    `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *Element                   `bson:"_molecularFormula,omitempty" json:"_molecularFormula,omitempty"`
+	AmountQuantity          *Quantity                  `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountString            *string                    `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement     *Element                   `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	UnknownFields           map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Amount returns SubstanceSpecification.moiety.amount[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *SubstanceSpecificationMoiety) Amount() interface{} {
+	switch {
+	case r.AmountQuantity != nil:
+		return r.AmountQuantity
+	case r.AmountString != nil:
+		return r.AmountString
+	}
+	return nil
+}
+
+// SetAmountQuantity sets SubstanceSpecification.moiety.amount[x] to the Quantity and clears the other types.
+func (r *SubstanceSpecificationMoiety) SetAmountQuantity(v Quantity) {
+	r.clearAmount()
+	r.AmountQuantity = &v
+}
+
+// SetAmountString sets SubstanceSpecification.moiety.amount[x] to the string and clears the other types.
+func (r *SubstanceSpecificationMoiety) SetAmountString(v string) {
+	r.clearAmount()
+	r.AmountString = &v
+}
+func (r *SubstanceSpecificationMoiety) clearAmount() {
+	r.AmountQuantity = nil
+	r.AmountString = nil
+	r.AmountStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the SubstanceSpecificationMoiety and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SubstanceSpecificationMoiety) UnmarshalJSON(b []byte) error {
+	type other SubstanceSpecificationMoiety
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceSpecification.moiety.amount[x]",
+		r.AmountQuantity != nil,
+		r.AmountString != nil || r.AmountStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SubstanceSpecificationMoiety with its unknown fields.
+func (r SubstanceSpecificationMoiety) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationMoiety
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSpecificationProperty struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          *CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Parameters        *string          `bson:"parameters,omitempty" json:"parameters,omitempty"`
+	ID                               *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category                         *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	Code                             *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Parameters                       *string                    `bson:"parameters,omitempty" json:"parameters,omitempty"`
+	ParametersElement                *Element                   `bson:"_parameters,omitempty" json:"_parameters,omitempty"`
+	DefiningSubstanceReference       *Reference                 `bson:"definingSubstanceReference,omitempty" json:"definingSubstanceReference,omitempty"`
+	DefiningSubstanceCodeableConcept *CodeableConcept           `bson:"definingSubstanceCodeableConcept,omitempty" json:"definingSubstanceCodeableConcept,omitempty"`
+	AmountQuantity                   *Quantity                  `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountString                     *string                    `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement              *Element                   `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	UnknownFields                    map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// DefiningSubstance returns SubstanceSpecification.property.definingSubstance[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *SubstanceSpecificationProperty) DefiningSubstance() interface{} {
+	switch {
+	case r.DefiningSubstanceReference != nil:
+		return r.DefiningSubstanceReference
+	case r.DefiningSubstanceCodeableConcept != nil:
+		return r.DefiningSubstanceCodeableConcept
+	}
+	return nil
+}
+
+// SetDefiningSubstanceReference sets SubstanceSpecification.property.definingSubstance[x] to the Reference and clears the other types.
+func (r *SubstanceSpecificationProperty) SetDefiningSubstanceReference(v Reference) {
+	r.clearDefiningSubstance()
+	r.DefiningSubstanceReference = &v
+}
+
+// SetDefiningSubstanceCodeableConcept sets SubstanceSpecification.property.definingSubstance[x] to the CodeableConcept and clears the other types.
+func (r *SubstanceSpecificationProperty) SetDefiningSubstanceCodeableConcept(v CodeableConcept) {
+	r.clearDefiningSubstance()
+	r.DefiningSubstanceCodeableConcept = &v
+}
+func (r *SubstanceSpecificationProperty) clearDefiningSubstance() {
+	r.DefiningSubstanceReference = nil
+	r.DefiningSubstanceCodeableConcept = nil
+}
+
+// Amount returns SubstanceSpecification.property.amount[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *SubstanceSpecificationProperty) Amount() interface{} {
+	switch {
+	case r.AmountQuantity != nil:
+		return r.AmountQuantity
+	case r.AmountString != nil:
+		return r.AmountString
+	}
+	return nil
+}
+
+// SetAmountQuantity sets SubstanceSpecification.property.amount[x] to the Quantity and clears the other types.
+func (r *SubstanceSpecificationProperty) SetAmountQuantity(v Quantity) {
+	r.clearAmount()
+	r.AmountQuantity = &v
+}
+
+// SetAmountString sets SubstanceSpecification.property.amount[x] to the string and clears the other types.
+func (r *SubstanceSpecificationProperty) SetAmountString(v string) {
+	r.clearAmount()
+	r.AmountString = &v
+}
+func (r *SubstanceSpecificationProperty) clearAmount() {
+	r.AmountQuantity = nil
+	r.AmountString = nil
+	r.AmountStringElement = nil
 }
+
+// UnmarshalJSON unmarshals the SubstanceSpecificationProperty and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SubstanceSpecificationProperty) UnmarshalJSON(b []byte) error {
+	type other SubstanceSpecificationProperty
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceSpecification.property.definingSubstance[x]",
+		r.DefiningSubstanceReference != nil,
+		r.DefiningSubstanceCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceSpecification.property.amount[x]",
+		r.AmountQuantity != nil,
+		r.AmountString != nil || r.AmountStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SubstanceSpecificationProperty with its unknown fields.
+func (r SubstanceSpecificationProperty) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationProperty
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSpecificationStructure struct {
-	ID                       *string                                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension                                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension                                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Stereochemistry          *CodeableConcept                                       `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
-	OpticalActivity          *CodeableConcept                                       `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
-	MolecularFormula         *string                                                `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
-	MolecularFormulaByMoiety *string                                                `bson:"molecularFormulaByMoiety,omitempty" json:"molecularFormulaByMoiety,omitempty"`
-	Isotope                  []SubstanceSpecificationStructureIsotope               `bson:"isotope,omitempty" json:"isotope,omitempty"`
-	MolecularWeight          *SubstanceSpecificationStructureIsotopeMolecularWeight `bson:"molecularWeight,omitempty" json:"molecularWeight,omitempty"`
-	Source                   []Reference                                            `bson:"source,omitempty" json:"source,omitempty"`
-	Representation           []SubstanceSpecificationStructureRepresentation        `bson:"representation,omitempty" json:"representation,omitempty"`
+	ID                              *string                                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                       []Extension                                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Stereochemistry                 *CodeableConcept                                       `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
+	OpticalActivity                 *CodeableConcept                                       `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
+	MolecularFormula                *string                                                `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
+	MolecularFormulaElement         *Element                                               `bson:"_molecularFormula,omitempty" json:"_molecularFormula,omitempty"`
+	MolecularFormulaByMoiety        *string                                                `bson:"molecularFormulaByMoiety,omitempty" json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoietyElement *Element                                               `bson:"_molecularFormulaByMoiety,omitempty" json:"_molecularFormulaByMoiety,omitempty"`
+	Isotope                         []SubstanceSpecificationStructureIsotope               `bson:"isotope,omitempty" json:"isotope,omitempty"`
+	MolecularWeight                 *SubstanceSpecificationStructureIsotopeMolecularWeight `bson:"molecularWeight,omitempty" json:"molecularWeight,omitempty"`
+	Source                          []Reference                                            `bson:"source,omitempty" json:"source,omitempty"`
+	Representation                  []SubstanceSpecificationStructureRepresentation        `bson:"representation,omitempty" json:"representation,omitempty"`
+	UnknownFields                   map[string]json.RawMessage                             `bson:"-" json:"-"`
 }
 type SubstanceSpecificationStructureIsotope struct {
 	ID                *string                                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -90,41 +256,103 @@ type SubstanceSpecificationStructureIsotope struct {
 	Substitution      *CodeableConcept                                       `bson:"substitution,omitempty" json:"substitution,omitempty"`
 	HalfLife          *Quantity                                              `bson:"halfLife,omitempty" json:"halfLife,omitempty"`
 	MolecularWeight   *SubstanceSpecificationStructureIsotopeMolecularWeight `bson:"molecularWeight,omitempty" json:"molecularWeight,omitempty"`
+	UnknownFields     map[string]json.RawMessage                             `bson:"-" json:"-"`
 }
 type SubstanceSpecificationStructureIsotopeMolecularWeight struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Method            *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Amount            *Quantity        `bson:"amount,omitempty" json:"amount,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Method            *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
+	Type              *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Amount            *Quantity                  `bson:"amount,omitempty" json:"amount,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the SubstanceSpecificationStructureIsotopeMolecularWeight with its unknown fields.
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationStructureIsotopeMolecularWeight
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the SubstanceSpecificationStructureIsotope with its unknown fields.
+func (r SubstanceSpecificationStructureIsotope) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationStructureIsotope
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSpecificationStructureRepresentation struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Representation    *string          `bson:"representation,omitempty" json:"representation,omitempty"`
-	Attachment        *Attachment      `bson:"attachment,omitempty" json:"attachment,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                  *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
+	Representation        *string                    `bson:"representation,omitempty" json:"representation,omitempty"`
+	RepresentationElement *Element                   `bson:"_representation,omitempty" json:"_representation,omitempty"`
+	Attachment            *Attachment                `bson:"attachment,omitempty" json:"attachment,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceSpecificationStructureRepresentation with its unknown fields.
+func (r SubstanceSpecificationStructureRepresentation) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationStructureRepresentation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the SubstanceSpecificationStructure with its unknown fields.
+func (r SubstanceSpecificationStructure) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationStructure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSpecificationCode struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Status            *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
-	StatusDate        *DateTime        `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	Comment           *string          `bson:"comment,omitempty" json:"comment,omitempty"`
-	Source            []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Status            *CodeableConcept           `bson:"status,omitempty" json:"status,omitempty"`
+	StatusDate        *DateTime                  `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement *Element                   `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	Comment           *string                    `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element                   `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Source            []Reference                `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the SubstanceSpecificationCode with its unknown fields.
+func (r SubstanceSpecificationCode) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationCode
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSpecificationName struct {
 	ID                *string                              `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string                               `bson:"name" json:"name"`
+	NameElement       *Element                             `bson:"_name,omitempty" json:"_name,omitempty"`
 	Type              *CodeableConcept                     `bson:"type,omitempty" json:"type,omitempty"`
 	Status            *CodeableConcept                     `bson:"status,omitempty" json:"status,omitempty"`
 	Preferred         *bool                                `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element                             `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
 	Language          []CodeableConcept                    `bson:"language,omitempty" json:"language,omitempty"`
 	Domain            []CodeableConcept                    `bson:"domain,omitempty" json:"domain,omitempty"`
 	Jurisdiction      []CodeableConcept                    `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
@@ -132,36 +360,191 @@ type SubstanceSpecificationName struct {
 	Translation       []SubstanceSpecificationName         `bson:"translation,omitempty" json:"translation,omitempty"`
 	Official          []SubstanceSpecificationNameOfficial `bson:"official,omitempty" json:"official,omitempty"`
 	Source            []Reference                          `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields     map[string]json.RawMessage           `bson:"-" json:"-"`
 }
 type SubstanceSpecificationNameOfficial struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Authority         *CodeableConcept `bson:"authority,omitempty" json:"authority,omitempty"`
-	Status            *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
-	Date              *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Authority         *CodeableConcept           `bson:"authority,omitempty" json:"authority,omitempty"`
+	Status            *CodeableConcept           `bson:"status,omitempty" json:"status,omitempty"`
+	Date              *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the SubstanceSpecificationNameOfficial with its unknown fields.
+func (r SubstanceSpecificationNameOfficial) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationNameOfficial
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the SubstanceSpecificationName with its unknown fields.
+func (r SubstanceSpecificationName) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationName
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type SubstanceSpecificationRelationship struct {
-	ID                  *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Relationship        *CodeableConcept `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	IsDefining          *bool            `bson:"isDefining,omitempty" json:"isDefining,omitempty"`
-	AmountRatioLowLimit *Ratio           `bson:"amountRatioLowLimit,omitempty" json:"amountRatioLowLimit,omitempty"`
-	AmountType          *CodeableConcept `bson:"amountType,omitempty" json:"amountType,omitempty"`
-	Source              []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SubstanceReference       *Reference                 `bson:"substanceReference,omitempty" json:"substanceReference,omitempty"`
+	SubstanceCodeableConcept *CodeableConcept           `bson:"substanceCodeableConcept,omitempty" json:"substanceCodeableConcept,omitempty"`
+	Relationship             *CodeableConcept           `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	IsDefining               *bool                      `bson:"isDefining,omitempty" json:"isDefining,omitempty"`
+	IsDefiningElement        *Element                   `bson:"_isDefining,omitempty" json:"_isDefining,omitempty"`
+	AmountQuantity           *Quantity                  `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountRange              *Range                     `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
+	AmountRatio              *Ratio                     `bson:"amountRatio,omitempty" json:"amountRatio,omitempty"`
+	AmountString             *string                    `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement      *Element                   `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	AmountRatioLowLimit      *Ratio                     `bson:"amountRatioLowLimit,omitempty" json:"amountRatioLowLimit,omitempty"`
+	AmountType               *CodeableConcept           `bson:"amountType,omitempty" json:"amountType,omitempty"`
+	Source                   []Reference                `bson:"source,omitempty" json:"source,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Substance returns SubstanceSpecification.relationship.substance[x] of the set type, such as *Reference, or nil when it is not set.
+func (r *SubstanceSpecificationRelationship) Substance() interface{} {
+	switch {
+	case r.SubstanceReference != nil:
+		return r.SubstanceReference
+	case r.SubstanceCodeableConcept != nil:
+		return r.SubstanceCodeableConcept
+	}
+	return nil
 }
+
+// SetSubstanceReference sets SubstanceSpecification.relationship.substance[x] to the Reference and clears the other types.
+func (r *SubstanceSpecificationRelationship) SetSubstanceReference(v Reference) {
+	r.clearSubstance()
+	r.SubstanceReference = &v
+}
+
+// SetSubstanceCodeableConcept sets SubstanceSpecification.relationship.substance[x] to the CodeableConcept and clears the other types.
+func (r *SubstanceSpecificationRelationship) SetSubstanceCodeableConcept(v CodeableConcept) {
+	r.clearSubstance()
+	r.SubstanceCodeableConcept = &v
+}
+func (r *SubstanceSpecificationRelationship) clearSubstance() {
+	r.SubstanceReference = nil
+	r.SubstanceCodeableConcept = nil
+}
+
+// Amount returns SubstanceSpecification.relationship.amount[x] of the set type, such as *Quantity, or nil when it is not set.
+func (r *SubstanceSpecificationRelationship) Amount() interface{} {
+	switch {
+	case r.AmountQuantity != nil:
+		return r.AmountQuantity
+	case r.AmountRange != nil:
+		return r.AmountRange
+	case r.AmountRatio != nil:
+		return r.AmountRatio
+	case r.AmountString != nil:
+		return r.AmountString
+	}
+	return nil
+}
+
+// SetAmountQuantity sets SubstanceSpecification.relationship.amount[x] to the Quantity and clears the other types.
+func (r *SubstanceSpecificationRelationship) SetAmountQuantity(v Quantity) {
+	r.clearAmount()
+	r.AmountQuantity = &v
+}
+
+// SetAmountRange sets SubstanceSpecification.relationship.amount[x] to the Range and clears the other types.
+func (r *SubstanceSpecificationRelationship) SetAmountRange(v Range) {
+	r.clearAmount()
+	r.AmountRange = &v
+}
+
+// SetAmountRatio sets SubstanceSpecification.relationship.amount[x] to the Ratio and clears the other types.
+func (r *SubstanceSpecificationRelationship) SetAmountRatio(v Ratio) {
+	r.clearAmount()
+	r.AmountRatio = &v
+}
+
+// SetAmountString sets SubstanceSpecification.relationship.amount[x] to the string and clears the other types.
+func (r *SubstanceSpecificationRelationship) SetAmountString(v string) {
+	r.clearAmount()
+	r.AmountString = &v
+}
+func (r *SubstanceSpecificationRelationship) clearAmount() {
+	r.AmountQuantity = nil
+	r.AmountRange = nil
+	r.AmountRatio = nil
+	r.AmountString = nil
+	r.AmountStringElement = nil
+}
+
+// UnmarshalJSON unmarshals the SubstanceSpecificationRelationship and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SubstanceSpecificationRelationship) UnmarshalJSON(b []byte) error {
+	type other SubstanceSpecificationRelationship
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceSpecification.relationship.substance[x]",
+		r.SubstanceReference != nil,
+		r.SubstanceCodeableConcept != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SubstanceSpecification.relationship.amount[x]",
+		r.AmountQuantity != nil,
+		r.AmountRange != nil,
+		r.AmountRatio != nil,
+		r.AmountString != nil || r.AmountStringElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SubstanceSpecificationRelationship with its unknown fields.
+func (r SubstanceSpecificationRelationship) MarshalJSON() ([]byte, error) {
+	type other SubstanceSpecificationRelationship
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the SubstanceSpecification and fails with CodeError when a coded element has an unknown code.
+func (r *SubstanceSpecification) UnmarshalJSON(b []byte) error {
+	type other SubstanceSpecification
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("SubstanceSpecification", r)
+}
+
 type OtherSubstanceSpecification SubstanceSpecification
 
 // MarshalJSON marshals the given SubstanceSpecification as JSON into a byte slice
 func (r SubstanceSpecification) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSubstanceSpecification
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSubstanceSpecification: OtherSubstanceSpecification(r),
 		ResourceType:                "SubstanceSpecification",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSubstanceSpecification unmarshals a SubstanceSpecification.
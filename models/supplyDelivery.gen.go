@@ -22,41 +22,165 @@ import "encoding/json"
 
 // SupplyDelivery is documented here http://hl7.org/fhir/StructureDefinition/SupplyDelivery
 type SupplyDelivery struct {
-	ID                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf            []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status            *SupplyDeliveryStatus       `bson:"status,omitempty" json:"status,omitempty"`
-	Patient           *Reference                  `bson:"patient,omitempty" json:"patient,omitempty"`
-	Type              *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
-	SuppliedItem      *SupplyDeliverySuppliedItem `bson:"suppliedItem,omitempty" json:"suppliedItem,omitempty"`
-	Supplier          *Reference                  `bson:"supplier,omitempty" json:"supplier,omitempty"`
-	Destination       *Reference                  `bson:"destination,omitempty" json:"destination,omitempty"`
-	Receiver          []Reference                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	ID                        *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                    []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                    *SupplyDeliveryStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement             *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Patient                   *Reference                  `bson:"patient,omitempty" json:"patient,omitempty"`
+	Type                      *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
+	SuppliedItem              *SupplyDeliverySuppliedItem `bson:"suppliedItem,omitempty" json:"suppliedItem,omitempty"`
+	OccurrenceDateTime        *DateTime                   `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                    `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                     `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing                     `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	Supplier                  *Reference                  `bson:"supplier,omitempty" json:"supplier,omitempty"`
+	Destination               *Reference                  `bson:"destination,omitempty" json:"destination,omitempty"`
+	Receiver                  []Reference                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	UnknownFields             map[string]json.RawMessage  `bson:"-" json:"-"`
 }
 type SupplyDeliverySuppliedItem struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Quantity          *Quantity   `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Quantity            *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ItemCodeableConcept *CodeableConcept           `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference       *Reference                 `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Item returns SupplyDelivery.suppliedItem.item[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SupplyDeliverySuppliedItem) Item() interface{} {
+	switch {
+	case r.ItemCodeableConcept != nil:
+		return r.ItemCodeableConcept
+	case r.ItemReference != nil:
+		return r.ItemReference
+	}
+	return nil
+}
+
+// SetItemCodeableConcept sets SupplyDelivery.suppliedItem.item[x] to the CodeableConcept and clears the other types.
+func (r *SupplyDeliverySuppliedItem) SetItemCodeableConcept(v CodeableConcept) {
+	r.clearItem()
+	r.ItemCodeableConcept = &v
+}
+
+// SetItemReference sets SupplyDelivery.suppliedItem.item[x] to the Reference and clears the other types.
+func (r *SupplyDeliverySuppliedItem) SetItemReference(v Reference) {
+	r.clearItem()
+	r.ItemReference = &v
+}
+func (r *SupplyDeliverySuppliedItem) clearItem() {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+}
+
+// UnmarshalJSON unmarshals the SupplyDeliverySuppliedItem and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SupplyDeliverySuppliedItem) UnmarshalJSON(b []byte) error {
+	type other SupplyDeliverySuppliedItem
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SupplyDelivery.suppliedItem.item[x]",
+		r.ItemCodeableConcept != nil,
+		r.ItemReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SupplyDeliverySuppliedItem with its unknown fields.
+func (r SupplyDeliverySuppliedItem) MarshalJSON() ([]byte, error) {
+	type other SupplyDeliverySuppliedItem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Occurrence returns SupplyDelivery.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *SupplyDelivery) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	case r.OccurrenceTiming != nil:
+		return r.OccurrenceTiming
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets SupplyDelivery.occurrence[x] to the dateTime and clears the other types.
+func (r *SupplyDelivery) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets SupplyDelivery.occurrence[x] to the Period and clears the other types.
+func (r *SupplyDelivery) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+
+// SetOccurrenceTiming sets SupplyDelivery.occurrence[x] to the Timing and clears the other types.
+func (r *SupplyDelivery) SetOccurrenceTiming(v Timing) {
+	r.clearOccurrence()
+	r.OccurrenceTiming = &v
+}
+func (r *SupplyDelivery) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+}
+
+// UnmarshalJSON unmarshals the SupplyDelivery and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *SupplyDelivery) UnmarshalJSON(b []byte) error {
+	type other SupplyDelivery
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SupplyDelivery.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+		r.OccurrenceTiming != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("SupplyDelivery", r)
+}
+
 type OtherSupplyDelivery SupplyDelivery
 
 // MarshalJSON marshals the given SupplyDelivery as JSON into a byte slice
 func (r SupplyDelivery) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSupplyDelivery
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSupplyDelivery: OtherSupplyDelivery(r),
 		ResourceType:        "SupplyDelivery",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSupplyDelivery unmarshals a SupplyDelivery.
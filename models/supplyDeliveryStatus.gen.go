@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.202871 +0000 UTC
+// 2026-10-19 02:55:18.953701822 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SupplyDeliveryStatus is documented here http://hl7.org/fhir/ValueSet/supplydelivery-status
-type SupplyDeliveryStatus int
+// The empty SupplyDeliveryStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SupplyDeliveryStatus string
 
 const (
-	SupplyDeliveryStatusInProgress SupplyDeliveryStatus = iota
-	SupplyDeliveryStatusCompleted
-	SupplyDeliveryStatusAbandoned
-	SupplyDeliveryStatusEnteredInError
+	SupplyDeliveryStatusInProgress     SupplyDeliveryStatus = "in-progress"
+	SupplyDeliveryStatusCompleted      SupplyDeliveryStatus = "completed"
+	SupplyDeliveryStatusAbandoned      SupplyDeliveryStatus = "abandoned"
+	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
 )
 
 func (code SupplyDeliveryStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SupplyDeliveryStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SupplyDeliveryStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "in-progress":
-		*code = SupplyDeliveryStatusInProgress
-	case "completed":
-		*code = SupplyDeliveryStatusCompleted
-	case "abandoned":
-		*code = SupplyDeliveryStatusAbandoned
-	case "entered-in-error":
-		*code = SupplyDeliveryStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown SupplyDeliveryStatus code `%s`", s)
+func (code *SupplyDeliveryStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SupplyDeliveryStatus(s)
 	return nil
 }
 func (code SupplyDeliveryStatus) String() string {
 	return code.Code()
 }
 func (code SupplyDeliveryStatus) Code() string {
+	return string(code)
+}
+func (code SupplyDeliveryStatus) Known() bool {
 	switch code {
-	case SupplyDeliveryStatusInProgress:
-		return "in-progress"
-	case SupplyDeliveryStatusCompleted:
-		return "completed"
-	case SupplyDeliveryStatusAbandoned:
-		return "abandoned"
-	case SupplyDeliveryStatusEnteredInError:
-		return "entered-in-error"
+	case SupplyDeliveryStatusInProgress, SupplyDeliveryStatusCompleted, SupplyDeliveryStatusAbandoned, SupplyDeliveryStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SupplyDeliveryStatus) Display() string {
 	switch code {
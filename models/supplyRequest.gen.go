@@ -22,44 +22,230 @@ import "encoding/json"
 
 // SupplyRequest is documented here http://hl7.org/fhir/StructureDefinition/SupplyRequest
 type SupplyRequest struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *SupplyRequestStatus     `bson:"status,omitempty" json:"status,omitempty"`
-	Category          *CodeableConcept         `bson:"category,omitempty" json:"category,omitempty"`
-	Priority          *RequestPriority         `bson:"priority,omitempty" json:"priority,omitempty"`
-	Quantity          Quantity                 `bson:"quantity" json:"quantity"`
-	Parameter         []SupplyRequestParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	AuthoredOn        *DateTime                `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester         *Reference               `bson:"requester,omitempty" json:"requester,omitempty"`
-	Supplier          []Reference              `bson:"supplier,omitempty" json:"supplier,omitempty"`
-	ReasonCode        []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	DeliverFrom       *Reference               `bson:"deliverFrom,omitempty" json:"deliverFrom,omitempty"`
-	DeliverTo         *Reference               `bson:"deliverTo,omitempty" json:"deliverTo,omitempty"`
+	ID                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    *SupplyRequestStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category                  *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                  *RequestPriority           `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement           *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	ItemCodeableConcept       *CodeableConcept           `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference             *Reference                 `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	Quantity                  Quantity                   `bson:"quantity" json:"quantity"`
+	Parameter                 []SupplyRequestParameter   `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	OccurrenceDateTime        *DateTime                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing                    `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	AuthoredOn                *DateTime                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement         *Element                   `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                 *Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
+	Supplier                  []Reference                `bson:"supplier,omitempty" json:"supplier,omitempty"`
+	ReasonCode                []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	DeliverFrom               *Reference                 `bson:"deliverFrom,omitempty" json:"deliverFrom,omitempty"`
+	DeliverTo                 *Reference                 `bson:"deliverTo,omitempty" json:"deliverTo,omitempty"`
+	UnknownFields             map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type SupplyRequestParameter struct {
-	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	ValueCodeableConcept *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueQuantity        *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange           *Range                     `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Value returns SupplyRequest.parameter.value[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SupplyRequestParameter) Value() interface{} {
+	switch {
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	}
+	return nil
+}
+
+// SetValueCodeableConcept sets SupplyRequest.parameter.value[x] to the CodeableConcept and clears the other types.
+func (r *SupplyRequestParameter) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueQuantity sets SupplyRequest.parameter.value[x] to the Quantity and clears the other types.
+func (r *SupplyRequestParameter) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets SupplyRequest.parameter.value[x] to the Range and clears the other types.
+func (r *SupplyRequestParameter) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueBoolean sets SupplyRequest.parameter.value[x] to the boolean and clears the other types.
+func (r *SupplyRequestParameter) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+func (r *SupplyRequestParameter) clearValue() {
+	r.ValueCodeableConcept = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+}
+
+// UnmarshalJSON unmarshals the SupplyRequestParameter and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *SupplyRequestParameter) UnmarshalJSON(b []byte) error {
+	type other SupplyRequestParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SupplyRequest.parameter.value[x]",
+		r.ValueCodeableConcept != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the SupplyRequestParameter with its unknown fields.
+func (r SupplyRequestParameter) MarshalJSON() ([]byte, error) {
+	type other SupplyRequestParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// Item returns SupplyRequest.item[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *SupplyRequest) Item() interface{} {
+	switch {
+	case r.ItemCodeableConcept != nil:
+		return r.ItemCodeableConcept
+	case r.ItemReference != nil:
+		return r.ItemReference
+	}
+	return nil
+}
+
+// SetItemCodeableConcept sets SupplyRequest.item[x] to the CodeableConcept and clears the other types.
+func (r *SupplyRequest) SetItemCodeableConcept(v CodeableConcept) {
+	r.clearItem()
+	r.ItemCodeableConcept = &v
+}
+
+// SetItemReference sets SupplyRequest.item[x] to the Reference and clears the other types.
+func (r *SupplyRequest) SetItemReference(v Reference) {
+	r.clearItem()
+	r.ItemReference = &v
+}
+func (r *SupplyRequest) clearItem() {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+}
+
+// Occurrence returns SupplyRequest.occurrence[x] of the set type, such as *DateTime, or nil when it is not set.
+func (r *SupplyRequest) Occurrence() interface{} {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	case r.OccurrenceTiming != nil:
+		return r.OccurrenceTiming
+	}
+	return nil
+}
+
+// SetOccurrenceDateTime sets SupplyRequest.occurrence[x] to the dateTime and clears the other types.
+func (r *SupplyRequest) SetOccurrenceDateTime(v DateTime) {
+	r.clearOccurrence()
+	r.OccurrenceDateTime = &v
+}
+
+// SetOccurrencePeriod sets SupplyRequest.occurrence[x] to the Period and clears the other types.
+func (r *SupplyRequest) SetOccurrencePeriod(v Period) {
+	r.clearOccurrence()
+	r.OccurrencePeriod = &v
+}
+
+// SetOccurrenceTiming sets SupplyRequest.occurrence[x] to the Timing and clears the other types.
+func (r *SupplyRequest) SetOccurrenceTiming(v Timing) {
+	r.clearOccurrence()
+	r.OccurrenceTiming = &v
+}
+func (r *SupplyRequest) clearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeElement = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+}
+
+// UnmarshalJSON unmarshals the SupplyRequest and fails with ChoiceError when a polymorphic element has more than one type and with CodeError when a coded element has an unknown code.
+func (r *SupplyRequest) UnmarshalJSON(b []byte) error {
+	type other SupplyRequest
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SupplyRequest.item[x]",
+		r.ItemCodeableConcept != nil,
+		r.ItemReference != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"SupplyRequest.occurrence[x]",
+		r.OccurrenceDateTime != nil || r.OccurrenceDateTimeElement != nil,
+		r.OccurrencePeriod != nil,
+		r.OccurrenceTiming != nil,
+	); err != nil {
+		return err
+	}
+	return checkCodes("SupplyRequest", r)
+}
+
 type OtherSupplyRequest SupplyRequest
 
 // MarshalJSON marshals the given SupplyRequest as JSON into a byte slice
 func (r SupplyRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherSupplyRequest
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherSupplyRequest: OtherSupplyRequest(r),
 		ResourceType:       "SupplyRequest",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalSupplyRequest unmarshals a SupplyRequest.
@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.235285 +0000 UTC
+// 2026-10-19 02:55:18.954696362 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SupplyRequestStatus is documented here http://hl7.org/fhir/ValueSet/supplyrequest-status
-type SupplyRequestStatus int
+// The empty SupplyRequestStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SupplyRequestStatus string
 
 const (
-	SupplyRequestStatusDraft SupplyRequestStatus = iota
-	SupplyRequestStatusActive
-	SupplyRequestStatusSuspended
-	SupplyRequestStatusCancelled
-	SupplyRequestStatusCompleted
-	SupplyRequestStatusEnteredInError
-	SupplyRequestStatusUnknown
+	SupplyRequestStatusDraft          SupplyRequestStatus = "draft"
+	SupplyRequestStatusActive         SupplyRequestStatus = "active"
+	SupplyRequestStatusSuspended      SupplyRequestStatus = "suspended"
+	SupplyRequestStatusCancelled      SupplyRequestStatus = "cancelled"
+	SupplyRequestStatusCompleted      SupplyRequestStatus = "completed"
+	SupplyRequestStatusEnteredInError SupplyRequestStatus = "entered-in-error"
+	SupplyRequestStatusUnknown        SupplyRequestStatus = "unknown"
 )
 
 func (code SupplyRequestStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SupplyRequestStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SupplyRequestStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "draft":
-		*code = SupplyRequestStatusDraft
-	case "active":
-		*code = SupplyRequestStatusActive
-	case "suspended":
-		*code = SupplyRequestStatusSuspended
-	case "cancelled":
-		*code = SupplyRequestStatusCancelled
-	case "completed":
-		*code = SupplyRequestStatusCompleted
-	case "entered-in-error":
-		*code = SupplyRequestStatusEnteredInError
-	case "unknown":
-		*code = SupplyRequestStatusUnknown
-	default:
-		return fmt.Errorf("unknown SupplyRequestStatus code `%s`", s)
+func (code *SupplyRequestStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SupplyRequestStatus(s)
 	return nil
 }
 func (code SupplyRequestStatus) String() string {
 	return code.Code()
 }
 func (code SupplyRequestStatus) Code() string {
+	return string(code)
+}
+func (code SupplyRequestStatus) Known() bool {
 	switch code {
-	case SupplyRequestStatusDraft:
-		return "draft"
-	case SupplyRequestStatusActive:
-		return "active"
-	case SupplyRequestStatusSuspended:
-		return "suspended"
-	case SupplyRequestStatusCancelled:
-		return "cancelled"
-	case SupplyRequestStatusCompleted:
-		return "completed"
-	case SupplyRequestStatusEnteredInError:
-		return "entered-in-error"
-	case SupplyRequestStatusUnknown:
-		return "unknown"
+	case SupplyRequestStatusDraft, SupplyRequestStatusActive, SupplyRequestStatusSuspended, SupplyRequestStatusCancelled, SupplyRequestStatusCompleted, SupplyRequestStatusEnteredInError, SupplyRequestStatusUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SupplyRequestStatus) Display() string {
 	switch code {
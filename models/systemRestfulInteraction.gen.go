@@ -14,125 +14,66 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:02.994921 +0000 UTC
+// 2026-10-19 02:55:18.95602048 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // SystemRestfulInteraction is documented here http://hl7.org/fhir/ValueSet/system-restful-interaction
-type SystemRestfulInteraction int
+// The empty SystemRestfulInteraction is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type SystemRestfulInteraction string
 
 const (
-	SystemRestfulInteractionRead SystemRestfulInteraction = iota
-	SystemRestfulInteractionVread
-	SystemRestfulInteractionUpdate
-	SystemRestfulInteractionPatch
-	SystemRestfulInteractionDelete
-	SystemRestfulInteractionHistory
-	SystemRestfulInteractionHistoryInstance
-	SystemRestfulInteractionHistoryType
-	SystemRestfulInteractionHistorySystem
-	SystemRestfulInteractionCreate
-	SystemRestfulInteractionSearch
-	SystemRestfulInteractionSearchType
-	SystemRestfulInteractionSearchSystem
-	SystemRestfulInteractionCapabilities
-	SystemRestfulInteractionTransaction
-	SystemRestfulInteractionBatch
-	SystemRestfulInteractionOperation
+	SystemRestfulInteractionRead            SystemRestfulInteraction = "read"
+	SystemRestfulInteractionVread           SystemRestfulInteraction = "vread"
+	SystemRestfulInteractionUpdate          SystemRestfulInteraction = "update"
+	SystemRestfulInteractionPatch           SystemRestfulInteraction = "patch"
+	SystemRestfulInteractionDelete          SystemRestfulInteraction = "delete"
+	SystemRestfulInteractionHistory         SystemRestfulInteraction = "history"
+	SystemRestfulInteractionHistoryInstance SystemRestfulInteraction = "history-instance"
+	SystemRestfulInteractionHistoryType     SystemRestfulInteraction = "history-type"
+	SystemRestfulInteractionHistorySystem   SystemRestfulInteraction = "history-system"
+	SystemRestfulInteractionCreate          SystemRestfulInteraction = "create"
+	SystemRestfulInteractionSearch          SystemRestfulInteraction = "search"
+	SystemRestfulInteractionSearchType      SystemRestfulInteraction = "search-type"
+	SystemRestfulInteractionSearchSystem    SystemRestfulInteraction = "search-system"
+	SystemRestfulInteractionCapabilities    SystemRestfulInteraction = "capabilities"
+	SystemRestfulInteractionTransaction     SystemRestfulInteraction = "transaction"
+	SystemRestfulInteractionBatch           SystemRestfulInteraction = "batch"
+	SystemRestfulInteractionOperation       SystemRestfulInteraction = "operation"
 )
 
 func (code SystemRestfulInteraction) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "SystemRestfulInteraction"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *SystemRestfulInteraction) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "read":
-		*code = SystemRestfulInteractionRead
-	case "vread":
-		*code = SystemRestfulInteractionVread
-	case "update":
-		*code = SystemRestfulInteractionUpdate
-	case "patch":
-		*code = SystemRestfulInteractionPatch
-	case "delete":
-		*code = SystemRestfulInteractionDelete
-	case "history":
-		*code = SystemRestfulInteractionHistory
-	case "history-instance":
-		*code = SystemRestfulInteractionHistoryInstance
-	case "history-type":
-		*code = SystemRestfulInteractionHistoryType
-	case "history-system":
-		*code = SystemRestfulInteractionHistorySystem
-	case "create":
-		*code = SystemRestfulInteractionCreate
-	case "search":
-		*code = SystemRestfulInteractionSearch
-	case "search-type":
-		*code = SystemRestfulInteractionSearchType
-	case "search-system":
-		*code = SystemRestfulInteractionSearchSystem
-	case "capabilities":
-		*code = SystemRestfulInteractionCapabilities
-	case "transaction":
-		*code = SystemRestfulInteractionTransaction
-	case "batch":
-		*code = SystemRestfulInteractionBatch
-	case "operation":
-		*code = SystemRestfulInteractionOperation
-	default:
-		return fmt.Errorf("unknown SystemRestfulInteraction code `%s`", s)
+func (code *SystemRestfulInteraction) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = SystemRestfulInteraction(s)
 	return nil
 }
 func (code SystemRestfulInteraction) String() string {
 	return code.Code()
 }
 func (code SystemRestfulInteraction) Code() string {
+	return string(code)
+}
+func (code SystemRestfulInteraction) Known() bool {
 	switch code {
-	case SystemRestfulInteractionRead:
-		return "read"
-	case SystemRestfulInteractionVread:
-		return "vread"
-	case SystemRestfulInteractionUpdate:
-		return "update"
-	case SystemRestfulInteractionPatch:
-		return "patch"
-	case SystemRestfulInteractionDelete:
-		return "delete"
-	case SystemRestfulInteractionHistory:
-		return "history"
-	case SystemRestfulInteractionHistoryInstance:
-		return "history-instance"
-	case SystemRestfulInteractionHistoryType:
-		return "history-type"
-	case SystemRestfulInteractionHistorySystem:
-		return "history-system"
-	case SystemRestfulInteractionCreate:
-		return "create"
-	case SystemRestfulInteractionSearch:
-		return "search"
-	case SystemRestfulInteractionSearchType:
-		return "search-type"
-	case SystemRestfulInteractionSearchSystem:
-		return "search-system"
-	case SystemRestfulInteractionCapabilities:
-		return "capabilities"
-	case SystemRestfulInteractionTransaction:
-		return "transaction"
-	case SystemRestfulInteractionBatch:
-		return "batch"
-	case SystemRestfulInteractionOperation:
-		return "operation"
+	case SystemRestfulInteractionRead, SystemRestfulInteractionVread, SystemRestfulInteractionUpdate, SystemRestfulInteractionPatch, SystemRestfulInteractionDelete, SystemRestfulInteractionHistory, SystemRestfulInteractionHistoryInstance, SystemRestfulInteractionHistoryType, SystemRestfulInteractionHistorySystem, SystemRestfulInteractionCreate, SystemRestfulInteractionSearch, SystemRestfulInteractionSearchType, SystemRestfulInteractionSearchSystem, SystemRestfulInteractionCapabilities, SystemRestfulInteractionTransaction, SystemRestfulInteractionBatch, SystemRestfulInteractionOperation:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code SystemRestfulInteraction) Display() string {
 	switch code {
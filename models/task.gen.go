@@ -22,76 +22,1360 @@ import "encoding/json"
 
 // Task is documented here http://hl7.org/fhir/StructureDefinition/Task
 type Task struct {
-	ID                    *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical *string           `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       *string           `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	GroupIdentifier       *Identifier       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	PartOf                []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                TaskStatus        `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	BusinessStatus        *CodeableConcept  `bson:"businessStatus,omitempty" json:"businessStatus,omitempty"`
-	Intent                string            `bson:"intent" json:"intent"`
-	Priority              *RequestPriority  `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                  *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
-	Description           *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Focus                 *Reference        `bson:"focus,omitempty" json:"focus,omitempty"`
-	For                   *Reference        `bson:"for,omitempty" json:"for,omitempty"`
-	Encounter             *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	ExecutionPeriod       *Period           `bson:"executionPeriod,omitempty" json:"executionPeriod,omitempty"`
-	AuthoredOn            *DateTime         `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	LastModified          *DateTime         `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
-	Requester             *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
-	PerformerType         []CodeableConcept `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Owner                 *Reference        `bson:"owner,omitempty" json:"owner,omitempty"`
-	Location              *Reference        `bson:"location,omitempty" json:"location,omitempty"`
-	ReasonCode            *CodeableConcept  `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       *Reference        `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Insurance             []Reference       `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	Note                  []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-	RelevantHistory       []Reference       `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
-	Restriction           *TaskRestriction  `bson:"restriction,omitempty" json:"restriction,omitempty"`
-	Input                 []TaskInput       `bson:"input,omitempty" json:"input,omitempty"`
-	Output                []TaskOutput      `bson:"output,omitempty" json:"output,omitempty"`
+	ID                           *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                         *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        *string                    `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *Element                   `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              *string                    `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       *Element                   `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	GroupIdentifier              *Identifier                `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	PartOf                       []Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                       TaskStatus                 `bson:"status" json:"status"`
+	StatusElement                *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept           `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	BusinessStatus               *CodeableConcept           `bson:"businessStatus,omitempty" json:"businessStatus,omitempty"`
+	Intent                       string                     `bson:"intent" json:"intent"`
+	IntentElement                *Element                   `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Priority                     *RequestPriority           `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                   `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                         *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Description                  *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Focus                        *Reference                 `bson:"focus,omitempty" json:"focus,omitempty"`
+	For                          *Reference                 `bson:"for,omitempty" json:"for,omitempty"`
+	Encounter                    *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	ExecutionPeriod              *Period                    `bson:"executionPeriod,omitempty" json:"executionPeriod,omitempty"`
+	AuthoredOn                   *DateTime                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                   `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	LastModified                 *DateTime                  `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
+	LastModifiedElement          *Element                   `bson:"_lastModified,omitempty" json:"_lastModified,omitempty"`
+	Requester                    *Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
+	PerformerType                []CodeableConcept          `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Owner                        *Reference                 `bson:"owner,omitempty" json:"owner,omitempty"`
+	Location                     *Reference                 `bson:"location,omitempty" json:"location,omitempty"`
+	ReasonCode                   *CodeableConcept           `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              *Reference                 `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Insurance                    []Reference                `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	Note                         []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	RelevantHistory              []Reference                `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	Restriction                  *TaskRestriction           `bson:"restriction,omitempty" json:"restriction,omitempty"`
+	Input                        []TaskInput                `bson:"input,omitempty" json:"input,omitempty"`
+	Output                       []TaskOutput               `bson:"output,omitempty" json:"output,omitempty"`
+	UnknownFields                map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TaskRestriction struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Repetitions       *int        `bson:"repetitions,omitempty" json:"repetitions,omitempty"`
-	Period            *Period     `bson:"period,omitempty" json:"period,omitempty"`
-	Recipient         []Reference `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Repetitions        *int                       `bson:"repetitions,omitempty" json:"repetitions,omitempty"`
+	RepetitionsElement *Element                   `bson:"_repetitions,omitempty" json:"_repetitions,omitempty"`
+	Period             *Period                    `bson:"period,omitempty" json:"period,omitempty"`
+	Recipient          []Reference                `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TaskRestriction with its unknown fields.
+func (r TaskRestriction) MarshalJSON() ([]byte, error) {
+	type other TaskRestriction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TaskInput struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     CodeableConcept            `bson:"type" json:"type"`
+	ValueBase64Binary        *string                    `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element                   `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string                    `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element                   `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string                    `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element                   `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *DateTime                  `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element                   `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string                    `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element                   `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *Instant                   `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element                   `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                    `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element                   `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                    `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element                   `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                       `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element                   `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *Time                      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element                   `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                       `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element                   `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                    `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element                   `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string                    `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element                   `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string                    `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element                   `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address                   `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                       `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation                `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint              `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                     `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance                  `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration                  `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName                 `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier                `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money                     `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period                    `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range                     `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                     `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData               `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature                 `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                    `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail             `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor               `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement           `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression                `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition       `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact           `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition         `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext              `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage                    `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                      `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns Task.input.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *TaskInput) Value() interface{} {
+	switch {
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueCanonical != nil:
+		return r.ValueCanonical
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueId != nil:
+		return r.ValueId
+	case r.ValueInstant != nil:
+		return r.ValueInstant
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueMarkdown != nil:
+		return r.ValueMarkdown
+	case r.ValueOid != nil:
+		return r.ValueOid
+	case r.ValuePositiveInt != nil:
+		return r.ValuePositiveInt
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueUnsignedInt != nil:
+		return r.ValueUnsignedInt
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueUrl != nil:
+		return r.ValueUrl
+	case r.ValueUuid != nil:
+		return r.ValueUuid
+	case r.ValueAddress != nil:
+		return r.ValueAddress
+	case r.ValueAge != nil:
+		return r.ValueAge
+	case r.ValueAnnotation != nil:
+		return r.ValueAnnotation
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueContactPoint != nil:
+		return r.ValueContactPoint
+	case r.ValueCount != nil:
+		return r.ValueCount
+	case r.ValueDistance != nil:
+		return r.ValueDistance
+	case r.ValueDuration != nil:
+		return r.ValueDuration
+	case r.ValueHumanName != nil:
+		return r.ValueHumanName
+	case r.ValueIdentifier != nil:
+		return r.ValueIdentifier
+	case r.ValueMoney != nil:
+		return r.ValueMoney
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueReference != nil:
+		return r.ValueReference
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueSignature != nil:
+		return r.ValueSignature
+	case r.ValueTiming != nil:
+		return r.ValueTiming
+	case r.ValueContactDetail != nil:
+		return r.ValueContactDetail
+	case r.ValueContributor != nil:
+		return r.ValueContributor
+	case r.ValueDataRequirement != nil:
+		return r.ValueDataRequirement
+	case r.ValueExpression != nil:
+		return r.ValueExpression
+	case r.ValueParameterDefinition != nil:
+		return r.ValueParameterDefinition
+	case r.ValueRelatedArtifact != nil:
+		return r.ValueRelatedArtifact
+	case r.ValueTriggerDefinition != nil:
+		return r.ValueTriggerDefinition
+	case r.ValueUsageContext != nil:
+		return r.ValueUsageContext
+	case r.ValueDosage != nil:
+		return r.ValueDosage
+	case r.ValueMeta != nil:
+		return r.ValueMeta
+	}
+	return nil
+}
+
+// SetValueBase64Binary sets Task.input.value[x] to the base64Binary and clears the other types.
+func (r *TaskInput) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+
+// SetValueBoolean sets Task.input.value[x] to the boolean and clears the other types.
+func (r *TaskInput) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueCanonical sets Task.input.value[x] to the canonical and clears the other types.
+func (r *TaskInput) SetValueCanonical(v string) {
+	r.clearValue()
+	r.ValueCanonical = &v
+}
+
+// SetValueCode sets Task.input.value[x] to the code and clears the other types.
+func (r *TaskInput) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
+}
+
+// SetValueDate sets Task.input.value[x] to the date and clears the other types.
+func (r *TaskInput) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets Task.input.value[x] to the dateTime and clears the other types.
+func (r *TaskInput) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueDecimal sets Task.input.value[x] to the decimal and clears the other types.
+func (r *TaskInput) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueId sets Task.input.value[x] to the id and clears the other types.
+func (r *TaskInput) SetValueId(v string) {
+	r.clearValue()
+	r.ValueId = &v
+}
+
+// SetValueInstant sets Task.input.value[x] to the instant and clears the other types.
+func (r *TaskInput) SetValueInstant(v Instant) {
+	r.clearValue()
+	r.ValueInstant = &v
+}
+
+// SetValueInteger sets Task.input.value[x] to the integer and clears the other types.
+func (r *TaskInput) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueMarkdown sets Task.input.value[x] to the markdown and clears the other types.
+func (r *TaskInput) SetValueMarkdown(v string) {
+	r.clearValue()
+	r.ValueMarkdown = &v
+}
+
+// SetValueOid sets Task.input.value[x] to the oid and clears the other types.
+func (r *TaskInput) SetValueOid(v string) {
+	r.clearValue()
+	r.ValueOid = &v
+}
+
+// SetValuePositiveInt sets Task.input.value[x] to the positiveInt and clears the other types.
+func (r *TaskInput) SetValuePositiveInt(v int) {
+	r.clearValue()
+	r.ValuePositiveInt = &v
+}
+
+// SetValueString sets Task.input.value[x] to the string and clears the other types.
+func (r *TaskInput) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueTime sets Task.input.value[x] to the time and clears the other types.
+func (r *TaskInput) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueUnsignedInt sets Task.input.value[x] to the unsignedInt and clears the other types.
+func (r *TaskInput) SetValueUnsignedInt(v int) {
+	r.clearValue()
+	r.ValueUnsignedInt = &v
+}
+
+// SetValueUri sets Task.input.value[x] to the uri and clears the other types.
+func (r *TaskInput) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueUrl sets Task.input.value[x] to the url and clears the other types.
+func (r *TaskInput) SetValueUrl(v string) {
+	r.clearValue()
+	r.ValueUrl = &v
+}
+
+// SetValueUuid sets Task.input.value[x] to the uuid and clears the other types.
+func (r *TaskInput) SetValueUuid(v string) {
+	r.clearValue()
+	r.ValueUuid = &v
+}
+
+// SetValueAddress sets Task.input.value[x] to the Address and clears the other types.
+func (r *TaskInput) SetValueAddress(v Address) {
+	r.clearValue()
+	r.ValueAddress = &v
+}
+
+// SetValueAge sets Task.input.value[x] to the Age and clears the other types.
+func (r *TaskInput) SetValueAge(v Age) {
+	r.clearValue()
+	r.ValueAge = &v
+}
+
+// SetValueAnnotation sets Task.input.value[x] to the Annotation and clears the other types.
+func (r *TaskInput) SetValueAnnotation(v Annotation) {
+	r.clearValue()
+	r.ValueAnnotation = &v
+}
+
+// SetValueAttachment sets Task.input.value[x] to the Attachment and clears the other types.
+func (r *TaskInput) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCodeableConcept sets Task.input.value[x] to the CodeableConcept and clears the other types.
+func (r *TaskInput) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueCoding sets Task.input.value[x] to the Coding and clears the other types.
+func (r *TaskInput) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueContactPoint sets Task.input.value[x] to the ContactPoint and clears the other types.
+func (r *TaskInput) SetValueContactPoint(v ContactPoint) {
+	r.clearValue()
+	r.ValueContactPoint = &v
+}
+
+// SetValueCount sets Task.input.value[x] to the Count and clears the other types.
+func (r *TaskInput) SetValueCount(v Count) {
+	r.clearValue()
+	r.ValueCount = &v
+}
+
+// SetValueDistance sets Task.input.value[x] to the Distance and clears the other types.
+func (r *TaskInput) SetValueDistance(v Distance) {
+	r.clearValue()
+	r.ValueDistance = &v
+}
+
+// SetValueDuration sets Task.input.value[x] to the Duration and clears the other types.
+func (r *TaskInput) SetValueDuration(v Duration) {
+	r.clearValue()
+	r.ValueDuration = &v
+}
+
+// SetValueHumanName sets Task.input.value[x] to the HumanName and clears the other types.
+func (r *TaskInput) SetValueHumanName(v HumanName) {
+	r.clearValue()
+	r.ValueHumanName = &v
+}
+
+// SetValueIdentifier sets Task.input.value[x] to the Identifier and clears the other types.
+func (r *TaskInput) SetValueIdentifier(v Identifier) {
+	r.clearValue()
+	r.ValueIdentifier = &v
+}
+
+// SetValueMoney sets Task.input.value[x] to the Money and clears the other types.
+func (r *TaskInput) SetValueMoney(v Money) {
+	r.clearValue()
+	r.ValueMoney = &v
+}
+
+// SetValuePeriod sets Task.input.value[x] to the Period and clears the other types.
+func (r *TaskInput) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+
+// SetValueQuantity sets Task.input.value[x] to the Quantity and clears the other types.
+func (r *TaskInput) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets Task.input.value[x] to the Range and clears the other types.
+func (r *TaskInput) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueRatio sets Task.input.value[x] to the Ratio and clears the other types.
+func (r *TaskInput) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
+
+// SetValueReference sets Task.input.value[x] to the Reference and clears the other types.
+func (r *TaskInput) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+
+// SetValueSampledData sets Task.input.value[x] to the SampledData and clears the other types.
+func (r *TaskInput) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
+
+// SetValueSignature sets Task.input.value[x] to the Signature and clears the other types.
+func (r *TaskInput) SetValueSignature(v Signature) {
+	r.clearValue()
+	r.ValueSignature = &v
+}
+
+// SetValueTiming sets Task.input.value[x] to the Timing and clears the other types.
+func (r *TaskInput) SetValueTiming(v Timing) {
+	r.clearValue()
+	r.ValueTiming = &v
 }
+
+// SetValueContactDetail sets Task.input.value[x] to the ContactDetail and clears the other types.
+func (r *TaskInput) SetValueContactDetail(v ContactDetail) {
+	r.clearValue()
+	r.ValueContactDetail = &v
+}
+
+// SetValueContributor sets Task.input.value[x] to the Contributor and clears the other types.
+func (r *TaskInput) SetValueContributor(v Contributor) {
+	r.clearValue()
+	r.ValueContributor = &v
+}
+
+// SetValueDataRequirement sets Task.input.value[x] to the DataRequirement and clears the other types.
+func (r *TaskInput) SetValueDataRequirement(v DataRequirement) {
+	r.clearValue()
+	r.ValueDataRequirement = &v
+}
+
+// SetValueExpression sets Task.input.value[x] to the Expression and clears the other types.
+func (r *TaskInput) SetValueExpression(v Expression) {
+	r.clearValue()
+	r.ValueExpression = &v
+}
+
+// SetValueParameterDefinition sets Task.input.value[x] to the ParameterDefinition and clears the other types.
+func (r *TaskInput) SetValueParameterDefinition(v ParameterDefinition) {
+	r.clearValue()
+	r.ValueParameterDefinition = &v
+}
+
+// SetValueRelatedArtifact sets Task.input.value[x] to the RelatedArtifact and clears the other types.
+func (r *TaskInput) SetValueRelatedArtifact(v RelatedArtifact) {
+	r.clearValue()
+	r.ValueRelatedArtifact = &v
+}
+
+// SetValueTriggerDefinition sets Task.input.value[x] to the TriggerDefinition and clears the other types.
+func (r *TaskInput) SetValueTriggerDefinition(v TriggerDefinition) {
+	r.clearValue()
+	r.ValueTriggerDefinition = &v
+}
+
+// SetValueUsageContext sets Task.input.value[x] to the UsageContext and clears the other types.
+func (r *TaskInput) SetValueUsageContext(v UsageContext) {
+	r.clearValue()
+	r.ValueUsageContext = &v
+}
+
+// SetValueDosage sets Task.input.value[x] to the Dosage and clears the other types.
+func (r *TaskInput) SetValueDosage(v Dosage) {
+	r.clearValue()
+	r.ValueDosage = &v
+}
+
+// SetValueMeta sets Task.input.value[x] to the Meta and clears the other types.
+func (r *TaskInput) SetValueMeta(v Meta) {
+	r.clearValue()
+	r.ValueMeta = &v
+}
+func (r *TaskInput) clearValue() {
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueCanonical = nil
+	r.ValueCanonicalElement = nil
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueId = nil
+	r.ValueIdElement = nil
+	r.ValueInstant = nil
+	r.ValueInstantElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueMarkdown = nil
+	r.ValueMarkdownElement = nil
+	r.ValueOid = nil
+	r.ValueOidElement = nil
+	r.ValuePositiveInt = nil
+	r.ValuePositiveIntElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUnsignedIntElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueUrl = nil
+	r.ValueUrlElement = nil
+	r.ValueUuid = nil
+	r.ValueUuidElement = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+}
+
+// UnmarshalJSON unmarshals the TaskInput and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *TaskInput) UnmarshalJSON(b []byte) error {
+	type other TaskInput
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Task.input.value[x]",
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueCanonical != nil || r.ValueCanonicalElement != nil,
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueId != nil || r.ValueIdElement != nil,
+		r.ValueInstant != nil || r.ValueInstantElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueMarkdown != nil || r.ValueMarkdownElement != nil,
+		r.ValueOid != nil || r.ValueOidElement != nil,
+		r.ValuePositiveInt != nil || r.ValuePositiveIntElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueUnsignedInt != nil || r.ValueUnsignedIntElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueUrl != nil || r.ValueUrlElement != nil,
+		r.ValueUuid != nil || r.ValueUuidElement != nil,
+		r.ValueAddress != nil,
+		r.ValueAge != nil,
+		r.ValueAnnotation != nil,
+		r.ValueAttachment != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueCoding != nil,
+		r.ValueContactPoint != nil,
+		r.ValueCount != nil,
+		r.ValueDistance != nil,
+		r.ValueDuration != nil,
+		r.ValueHumanName != nil,
+		r.ValueIdentifier != nil,
+		r.ValueMoney != nil,
+		r.ValuePeriod != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueReference != nil,
+		r.ValueSampledData != nil,
+		r.ValueSignature != nil,
+		r.ValueTiming != nil,
+		r.ValueContactDetail != nil,
+		r.ValueContributor != nil,
+		r.ValueDataRequirement != nil,
+		r.ValueExpression != nil,
+		r.ValueParameterDefinition != nil,
+		r.ValueRelatedArtifact != nil,
+		r.ValueTriggerDefinition != nil,
+		r.ValueUsageContext != nil,
+		r.ValueDosage != nil,
+		r.ValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the TaskInput with its unknown fields.
+func (r TaskInput) MarshalJSON() ([]byte, error) {
+	type other TaskInput
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TaskOutput struct {
-	ID                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept `bson:"type" json:"type"`
+	ID                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     CodeableConcept            `bson:"type" json:"type"`
+	ValueBase64Binary        *string                    `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element                   `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string                    `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element                   `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string                    `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element                   `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *DateTime                  `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element                   `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string                    `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element                   `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *Instant                   `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element                   `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                    `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element                   `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                    `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element                   `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                       `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element                   `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *Time                      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element                   `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                       `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element                   `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                    `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element                   `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string                    `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element                   `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string                    `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element                   `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address                   `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                       `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation                `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment                `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept           `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding                    `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint              `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                     `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance                  `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration                  `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName                 `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier                `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money                     `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period                    `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity                  `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range                     `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                     `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference                 `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData               `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature                 `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                    `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail             `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor               `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement           `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression                `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition       `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact           `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition         `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext              `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage                    `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                      `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+	UnknownFields            map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// Value returns Task.output.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *TaskOutput) Value() interface{} {
+	switch {
+	case r.ValueBase64Binary != nil:
+		return r.ValueBase64Binary
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueCanonical != nil:
+		return r.ValueCanonical
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueDate != nil:
+		return r.ValueDate
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueId != nil:
+		return r.ValueId
+	case r.ValueInstant != nil:
+		return r.ValueInstant
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueMarkdown != nil:
+		return r.ValueMarkdown
+	case r.ValueOid != nil:
+		return r.ValueOid
+	case r.ValuePositiveInt != nil:
+		return r.ValuePositiveInt
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueTime != nil:
+		return r.ValueTime
+	case r.ValueUnsignedInt != nil:
+		return r.ValueUnsignedInt
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueUrl != nil:
+		return r.ValueUrl
+	case r.ValueUuid != nil:
+		return r.ValueUuid
+	case r.ValueAddress != nil:
+		return r.ValueAddress
+	case r.ValueAge != nil:
+		return r.ValueAge
+	case r.ValueAnnotation != nil:
+		return r.ValueAnnotation
+	case r.ValueAttachment != nil:
+		return r.ValueAttachment
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueCoding != nil:
+		return r.ValueCoding
+	case r.ValueContactPoint != nil:
+		return r.ValueContactPoint
+	case r.ValueCount != nil:
+		return r.ValueCount
+	case r.ValueDistance != nil:
+		return r.ValueDistance
+	case r.ValueDuration != nil:
+		return r.ValueDuration
+	case r.ValueHumanName != nil:
+		return r.ValueHumanName
+	case r.ValueIdentifier != nil:
+		return r.ValueIdentifier
+	case r.ValueMoney != nil:
+		return r.ValueMoney
+	case r.ValuePeriod != nil:
+		return r.ValuePeriod
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueRatio != nil:
+		return r.ValueRatio
+	case r.ValueReference != nil:
+		return r.ValueReference
+	case r.ValueSampledData != nil:
+		return r.ValueSampledData
+	case r.ValueSignature != nil:
+		return r.ValueSignature
+	case r.ValueTiming != nil:
+		return r.ValueTiming
+	case r.ValueContactDetail != nil:
+		return r.ValueContactDetail
+	case r.ValueContributor != nil:
+		return r.ValueContributor
+	case r.ValueDataRequirement != nil:
+		return r.ValueDataRequirement
+	case r.ValueExpression != nil:
+		return r.ValueExpression
+	case r.ValueParameterDefinition != nil:
+		return r.ValueParameterDefinition
+	case r.ValueRelatedArtifact != nil:
+		return r.ValueRelatedArtifact
+	case r.ValueTriggerDefinition != nil:
+		return r.ValueTriggerDefinition
+	case r.ValueUsageContext != nil:
+		return r.ValueUsageContext
+	case r.ValueDosage != nil:
+		return r.ValueDosage
+	case r.ValueMeta != nil:
+		return r.ValueMeta
+	}
+	return nil
+}
+
+// SetValueBase64Binary sets Task.output.value[x] to the base64Binary and clears the other types.
+func (r *TaskOutput) SetValueBase64Binary(v string) {
+	r.clearValue()
+	r.ValueBase64Binary = &v
+}
+
+// SetValueBoolean sets Task.output.value[x] to the boolean and clears the other types.
+func (r *TaskOutput) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueCanonical sets Task.output.value[x] to the canonical and clears the other types.
+func (r *TaskOutput) SetValueCanonical(v string) {
+	r.clearValue()
+	r.ValueCanonical = &v
+}
+
+// SetValueCode sets Task.output.value[x] to the code and clears the other types.
+func (r *TaskOutput) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
+}
+
+// SetValueDate sets Task.output.value[x] to the date and clears the other types.
+func (r *TaskOutput) SetValueDate(v DateTime) {
+	r.clearValue()
+	r.ValueDate = &v
+}
+
+// SetValueDateTime sets Task.output.value[x] to the dateTime and clears the other types.
+func (r *TaskOutput) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+
+// SetValueDecimal sets Task.output.value[x] to the decimal and clears the other types.
+func (r *TaskOutput) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueId sets Task.output.value[x] to the id and clears the other types.
+func (r *TaskOutput) SetValueId(v string) {
+	r.clearValue()
+	r.ValueId = &v
+}
+
+// SetValueInstant sets Task.output.value[x] to the instant and clears the other types.
+func (r *TaskOutput) SetValueInstant(v Instant) {
+	r.clearValue()
+	r.ValueInstant = &v
+}
+
+// SetValueInteger sets Task.output.value[x] to the integer and clears the other types.
+func (r *TaskOutput) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueMarkdown sets Task.output.value[x] to the markdown and clears the other types.
+func (r *TaskOutput) SetValueMarkdown(v string) {
+	r.clearValue()
+	r.ValueMarkdown = &v
+}
+
+// SetValueOid sets Task.output.value[x] to the oid and clears the other types.
+func (r *TaskOutput) SetValueOid(v string) {
+	r.clearValue()
+	r.ValueOid = &v
+}
+
+// SetValuePositiveInt sets Task.output.value[x] to the positiveInt and clears the other types.
+func (r *TaskOutput) SetValuePositiveInt(v int) {
+	r.clearValue()
+	r.ValuePositiveInt = &v
+}
+
+// SetValueString sets Task.output.value[x] to the string and clears the other types.
+func (r *TaskOutput) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueTime sets Task.output.value[x] to the time and clears the other types.
+func (r *TaskOutput) SetValueTime(v Time) {
+	r.clearValue()
+	r.ValueTime = &v
+}
+
+// SetValueUnsignedInt sets Task.output.value[x] to the unsignedInt and clears the other types.
+func (r *TaskOutput) SetValueUnsignedInt(v int) {
+	r.clearValue()
+	r.ValueUnsignedInt = &v
+}
+
+// SetValueUri sets Task.output.value[x] to the uri and clears the other types.
+func (r *TaskOutput) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueUrl sets Task.output.value[x] to the url and clears the other types.
+func (r *TaskOutput) SetValueUrl(v string) {
+	r.clearValue()
+	r.ValueUrl = &v
+}
+
+// SetValueUuid sets Task.output.value[x] to the uuid and clears the other types.
+func (r *TaskOutput) SetValueUuid(v string) {
+	r.clearValue()
+	r.ValueUuid = &v
+}
+
+// SetValueAddress sets Task.output.value[x] to the Address and clears the other types.
+func (r *TaskOutput) SetValueAddress(v Address) {
+	r.clearValue()
+	r.ValueAddress = &v
+}
+
+// SetValueAge sets Task.output.value[x] to the Age and clears the other types.
+func (r *TaskOutput) SetValueAge(v Age) {
+	r.clearValue()
+	r.ValueAge = &v
+}
+
+// SetValueAnnotation sets Task.output.value[x] to the Annotation and clears the other types.
+func (r *TaskOutput) SetValueAnnotation(v Annotation) {
+	r.clearValue()
+	r.ValueAnnotation = &v
+}
+
+// SetValueAttachment sets Task.output.value[x] to the Attachment and clears the other types.
+func (r *TaskOutput) SetValueAttachment(v Attachment) {
+	r.clearValue()
+	r.ValueAttachment = &v
+}
+
+// SetValueCodeableConcept sets Task.output.value[x] to the CodeableConcept and clears the other types.
+func (r *TaskOutput) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueCoding sets Task.output.value[x] to the Coding and clears the other types.
+func (r *TaskOutput) SetValueCoding(v Coding) {
+	r.clearValue()
+	r.ValueCoding = &v
+}
+
+// SetValueContactPoint sets Task.output.value[x] to the ContactPoint and clears the other types.
+func (r *TaskOutput) SetValueContactPoint(v ContactPoint) {
+	r.clearValue()
+	r.ValueContactPoint = &v
+}
+
+// SetValueCount sets Task.output.value[x] to the Count and clears the other types.
+func (r *TaskOutput) SetValueCount(v Count) {
+	r.clearValue()
+	r.ValueCount = &v
+}
+
+// SetValueDistance sets Task.output.value[x] to the Distance and clears the other types.
+func (r *TaskOutput) SetValueDistance(v Distance) {
+	r.clearValue()
+	r.ValueDistance = &v
+}
+
+// SetValueDuration sets Task.output.value[x] to the Duration and clears the other types.
+func (r *TaskOutput) SetValueDuration(v Duration) {
+	r.clearValue()
+	r.ValueDuration = &v
+}
+
+// SetValueHumanName sets Task.output.value[x] to the HumanName and clears the other types.
+func (r *TaskOutput) SetValueHumanName(v HumanName) {
+	r.clearValue()
+	r.ValueHumanName = &v
+}
+
+// SetValueIdentifier sets Task.output.value[x] to the Identifier and clears the other types.
+func (r *TaskOutput) SetValueIdentifier(v Identifier) {
+	r.clearValue()
+	r.ValueIdentifier = &v
+}
+
+// SetValueMoney sets Task.output.value[x] to the Money and clears the other types.
+func (r *TaskOutput) SetValueMoney(v Money) {
+	r.clearValue()
+	r.ValueMoney = &v
+}
+
+// SetValuePeriod sets Task.output.value[x] to the Period and clears the other types.
+func (r *TaskOutput) SetValuePeriod(v Period) {
+	r.clearValue()
+	r.ValuePeriod = &v
+}
+
+// SetValueQuantity sets Task.output.value[x] to the Quantity and clears the other types.
+func (r *TaskOutput) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets Task.output.value[x] to the Range and clears the other types.
+func (r *TaskOutput) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueRatio sets Task.output.value[x] to the Ratio and clears the other types.
+func (r *TaskOutput) SetValueRatio(v Ratio) {
+	r.clearValue()
+	r.ValueRatio = &v
+}
+
+// SetValueReference sets Task.output.value[x] to the Reference and clears the other types.
+func (r *TaskOutput) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+
+// SetValueSampledData sets Task.output.value[x] to the SampledData and clears the other types.
+func (r *TaskOutput) SetValueSampledData(v SampledData) {
+	r.clearValue()
+	r.ValueSampledData = &v
+}
+
+// SetValueSignature sets Task.output.value[x] to the Signature and clears the other types.
+func (r *TaskOutput) SetValueSignature(v Signature) {
+	r.clearValue()
+	r.ValueSignature = &v
+}
+
+// SetValueTiming sets Task.output.value[x] to the Timing and clears the other types.
+func (r *TaskOutput) SetValueTiming(v Timing) {
+	r.clearValue()
+	r.ValueTiming = &v
+}
+
+// SetValueContactDetail sets Task.output.value[x] to the ContactDetail and clears the other types.
+func (r *TaskOutput) SetValueContactDetail(v ContactDetail) {
+	r.clearValue()
+	r.ValueContactDetail = &v
+}
+
+// SetValueContributor sets Task.output.value[x] to the Contributor and clears the other types.
+func (r *TaskOutput) SetValueContributor(v Contributor) {
+	r.clearValue()
+	r.ValueContributor = &v
+}
+
+// SetValueDataRequirement sets Task.output.value[x] to the DataRequirement and clears the other types.
+func (r *TaskOutput) SetValueDataRequirement(v DataRequirement) {
+	r.clearValue()
+	r.ValueDataRequirement = &v
+}
+
+// SetValueExpression sets Task.output.value[x] to the Expression and clears the other types.
+func (r *TaskOutput) SetValueExpression(v Expression) {
+	r.clearValue()
+	r.ValueExpression = &v
+}
+
+// SetValueParameterDefinition sets Task.output.value[x] to the ParameterDefinition and clears the other types.
+func (r *TaskOutput) SetValueParameterDefinition(v ParameterDefinition) {
+	r.clearValue()
+	r.ValueParameterDefinition = &v
+}
+
+// SetValueRelatedArtifact sets Task.output.value[x] to the RelatedArtifact and clears the other types.
+func (r *TaskOutput) SetValueRelatedArtifact(v RelatedArtifact) {
+	r.clearValue()
+	r.ValueRelatedArtifact = &v
+}
+
+// SetValueTriggerDefinition sets Task.output.value[x] to the TriggerDefinition and clears the other types.
+func (r *TaskOutput) SetValueTriggerDefinition(v TriggerDefinition) {
+	r.clearValue()
+	r.ValueTriggerDefinition = &v
+}
+
+// SetValueUsageContext sets Task.output.value[x] to the UsageContext and clears the other types.
+func (r *TaskOutput) SetValueUsageContext(v UsageContext) {
+	r.clearValue()
+	r.ValueUsageContext = &v
+}
+
+// SetValueDosage sets Task.output.value[x] to the Dosage and clears the other types.
+func (r *TaskOutput) SetValueDosage(v Dosage) {
+	r.clearValue()
+	r.ValueDosage = &v
+}
+
+// SetValueMeta sets Task.output.value[x] to the Meta and clears the other types.
+func (r *TaskOutput) SetValueMeta(v Meta) {
+	r.clearValue()
+	r.ValueMeta = &v
+}
+func (r *TaskOutput) clearValue() {
+	r.ValueBase64Binary = nil
+	r.ValueBase64BinaryElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueCanonical = nil
+	r.ValueCanonicalElement = nil
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueDate = nil
+	r.ValueDateElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueId = nil
+	r.ValueIdElement = nil
+	r.ValueInstant = nil
+	r.ValueInstantElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueMarkdown = nil
+	r.ValueMarkdownElement = nil
+	r.ValueOid = nil
+	r.ValueOidElement = nil
+	r.ValuePositiveInt = nil
+	r.ValuePositiveIntElement = nil
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueTime = nil
+	r.ValueTimeElement = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUnsignedIntElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueUrl = nil
+	r.ValueUrlElement = nil
+	r.ValueUuid = nil
+	r.ValueUuidElement = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+}
+
+// UnmarshalJSON unmarshals the TaskOutput and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *TaskOutput) UnmarshalJSON(b []byte) error {
+	type other TaskOutput
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Task.output.value[x]",
+		r.ValueBase64Binary != nil || r.ValueBase64BinaryElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueCanonical != nil || r.ValueCanonicalElement != nil,
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueDate != nil || r.ValueDateElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueId != nil || r.ValueIdElement != nil,
+		r.ValueInstant != nil || r.ValueInstantElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueMarkdown != nil || r.ValueMarkdownElement != nil,
+		r.ValueOid != nil || r.ValueOidElement != nil,
+		r.ValuePositiveInt != nil || r.ValuePositiveIntElement != nil,
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueTime != nil || r.ValueTimeElement != nil,
+		r.ValueUnsignedInt != nil || r.ValueUnsignedIntElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueUrl != nil || r.ValueUrlElement != nil,
+		r.ValueUuid != nil || r.ValueUuidElement != nil,
+		r.ValueAddress != nil,
+		r.ValueAge != nil,
+		r.ValueAnnotation != nil,
+		r.ValueAttachment != nil,
+		r.ValueCodeableConcept != nil,
+		r.ValueCoding != nil,
+		r.ValueContactPoint != nil,
+		r.ValueCount != nil,
+		r.ValueDistance != nil,
+		r.ValueDuration != nil,
+		r.ValueHumanName != nil,
+		r.ValueIdentifier != nil,
+		r.ValueMoney != nil,
+		r.ValuePeriod != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueRatio != nil,
+		r.ValueReference != nil,
+		r.ValueSampledData != nil,
+		r.ValueSignature != nil,
+		r.ValueTiming != nil,
+		r.ValueContactDetail != nil,
+		r.ValueContributor != nil,
+		r.ValueDataRequirement != nil,
+		r.ValueExpression != nil,
+		r.ValueParameterDefinition != nil,
+		r.ValueRelatedArtifact != nil,
+		r.ValueTriggerDefinition != nil,
+		r.ValueUsageContext != nil,
+		r.ValueDosage != nil,
+		r.ValueMeta != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the TaskOutput with its unknown fields.
+func (r TaskOutput) MarshalJSON() ([]byte, error) {
+	type other TaskOutput
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the Task and fails with CodeError when a coded element has an unknown code.
+func (r *Task) UnmarshalJSON(b []byte) error {
+	type other Task
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("Task", r)
+}
+
 type OtherTask Task
 
 // MarshalJSON marshals the given Task as JSON into a byte slice
 func (r Task) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherTask
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherTask:    OtherTask(r),
 		ResourceType: "Task",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalTask unmarshals a Task.
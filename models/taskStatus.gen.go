@@ -14,100 +14,61 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.061228 +0000 UTC
+// 2026-10-19 02:55:18.957606183 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TaskStatus is documented here http://hl7.org/fhir/ValueSet/task-status
-type TaskStatus int
+// The empty TaskStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TaskStatus string
 
 const (
-	TaskStatusDraft TaskStatus = iota
-	TaskStatusRequested
-	TaskStatusReceived
-	TaskStatusAccepted
-	TaskStatusRejected
-	TaskStatusReady
-	TaskStatusCancelled
-	TaskStatusInProgress
-	TaskStatusOnHold
-	TaskStatusFailed
-	TaskStatusCompleted
-	TaskStatusEnteredInError
+	TaskStatusDraft          TaskStatus = "draft"
+	TaskStatusRequested      TaskStatus = "requested"
+	TaskStatusReceived       TaskStatus = "received"
+	TaskStatusAccepted       TaskStatus = "accepted"
+	TaskStatusRejected       TaskStatus = "rejected"
+	TaskStatusReady          TaskStatus = "ready"
+	TaskStatusCancelled      TaskStatus = "cancelled"
+	TaskStatusInProgress     TaskStatus = "in-progress"
+	TaskStatusOnHold         TaskStatus = "on-hold"
+	TaskStatusFailed         TaskStatus = "failed"
+	TaskStatusCompleted      TaskStatus = "completed"
+	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
 func (code TaskStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TaskStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TaskStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "draft":
-		*code = TaskStatusDraft
-	case "requested":
-		*code = TaskStatusRequested
-	case "received":
-		*code = TaskStatusReceived
-	case "accepted":
-		*code = TaskStatusAccepted
-	case "rejected":
-		*code = TaskStatusRejected
-	case "ready":
-		*code = TaskStatusReady
-	case "cancelled":
-		*code = TaskStatusCancelled
-	case "in-progress":
-		*code = TaskStatusInProgress
-	case "on-hold":
-		*code = TaskStatusOnHold
-	case "failed":
-		*code = TaskStatusFailed
-	case "completed":
-		*code = TaskStatusCompleted
-	case "entered-in-error":
-		*code = TaskStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown TaskStatus code `%s`", s)
+func (code *TaskStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = TaskStatus(s)
 	return nil
 }
 func (code TaskStatus) String() string {
 	return code.Code()
 }
 func (code TaskStatus) Code() string {
+	return string(code)
+}
+func (code TaskStatus) Known() bool {
 	switch code {
-	case TaskStatusDraft:
-		return "draft"
-	case TaskStatusRequested:
-		return "requested"
-	case TaskStatusReceived:
-		return "received"
-	case TaskStatusAccepted:
-		return "accepted"
-	case TaskStatusRejected:
-		return "rejected"
-	case TaskStatusReady:
-		return "ready"
-	case TaskStatusCancelled:
-		return "cancelled"
-	case TaskStatusInProgress:
-		return "in-progress"
-	case TaskStatusOnHold:
-		return "on-hold"
-	case TaskStatusFailed:
-		return "failed"
-	case TaskStatusCompleted:
-		return "completed"
-	case TaskStatusEnteredInError:
-		return "entered-in-error"
+	case TaskStatusDraft, TaskStatusRequested, TaskStatusReceived, TaskStatusAccepted, TaskStatusRejected, TaskStatusReady, TaskStatusCancelled, TaskStatusInProgress, TaskStatusOnHold, TaskStatusFailed, TaskStatusCompleted, TaskStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TaskStatus) Display() string {
 	switch code {
@@ -22,124 +22,321 @@ import "encoding/json"
 
 // TerminologyCapabilities is documented here http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities
 type TerminologyCapabilities struct {
-	ID                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string                                `bson:"url,omitempty" json:"url,omitempty"`
-	Version           *string                                `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                                `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                                `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus                      `bson:"status" json:"status"`
-	Experimental      *bool                                  `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              DateTime                               `bson:"date" json:"date"`
-	Publisher         *string                                `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                        `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                                `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                         `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                                `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Kind              CapabilityStatementKind                `bson:"kind" json:"kind"`
-	Software          *TerminologyCapabilitiesSoftware       `bson:"software,omitempty" json:"software,omitempty"`
-	Implementation    *TerminologyCapabilitiesImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
-	LockedDate        *bool                                  `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
-	CodeSystem        []TerminologyCapabilitiesCodeSystem    `bson:"codeSystem,omitempty" json:"codeSystem,omitempty"`
-	Expansion         *TerminologyCapabilitiesExpansion      `bson:"expansion,omitempty" json:"expansion,omitempty"`
-	CodeSearch        *CodeSearchSupport                     `bson:"codeSearch,omitempty" json:"codeSearch,omitempty"`
-	ValidateCode      *TerminologyCapabilitiesValidateCode   `bson:"validateCode,omitempty" json:"validateCode,omitempty"`
-	Translation       *TerminologyCapabilitiesTranslation    `bson:"translation,omitempty" json:"translation,omitempty"`
-	Closure           *TerminologyCapabilitiesClosure        `bson:"closure,omitempty" json:"closure,omitempty"`
+	ID                   *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  *string                                `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement           *Element                               `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                                `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                               `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 *string                                `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                               `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                                `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                               `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus                      `bson:"status" json:"status"`
+	StatusElement        *Element                               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                                  `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                               `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 DateTime                               `bson:"date" json:"date"`
+	DateElement          *Element                               `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                                `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                               `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                        `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                                `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                               `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                         `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept                      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                                `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                               `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                               `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Kind                 CapabilityStatementKind                `bson:"kind" json:"kind"`
+	KindElement          *Element                               `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Software             *TerminologyCapabilitiesSoftware       `bson:"software,omitempty" json:"software,omitempty"`
+	Implementation       *TerminologyCapabilitiesImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
+	LockedDate           *bool                                  `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
+	LockedDateElement    *Element                               `bson:"_lockedDate,omitempty" json:"_lockedDate,omitempty"`
+	CodeSystem           []TerminologyCapabilitiesCodeSystem    `bson:"codeSystem,omitempty" json:"codeSystem,omitempty"`
+	Expansion            *TerminologyCapabilitiesExpansion      `bson:"expansion,omitempty" json:"expansion,omitempty"`
+	CodeSearch           *CodeSearchSupport                     `bson:"codeSearch,omitempty" json:"codeSearch,omitempty"`
+	CodeSearchElement    *Element                               `bson:"_codeSearch,omitempty" json:"_codeSearch,omitempty"`
+	ValidateCode         *TerminologyCapabilitiesValidateCode   `bson:"validateCode,omitempty" json:"validateCode,omitempty"`
+	Translation          *TerminologyCapabilitiesTranslation    `bson:"translation,omitempty" json:"translation,omitempty"`
+	Closure              *TerminologyCapabilitiesClosure        `bson:"closure,omitempty" json:"closure,omitempty"`
+	UnknownFields        map[string]json.RawMessage             `bson:"-" json:"-"`
 }
 type TerminologyCapabilitiesSoftware struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              string                     `bson:"name" json:"name"`
+	NameElement       *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TerminologyCapabilitiesSoftware with its unknown fields.
+func (r TerminologyCapabilitiesSoftware) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesSoftware
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TerminologyCapabilitiesImplementation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	URL               *string     `bson:"url,omitempty" json:"url,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string                     `bson:"description" json:"description"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	URL                *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement         *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesImplementation with its unknown fields.
+func (r TerminologyCapabilitiesImplementation) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesImplementation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TerminologyCapabilitiesCodeSystem struct {
-	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URI               *string                                    `bson:"uri,omitempty" json:"uri,omitempty"`
-	Version           []TerminologyCapabilitiesCodeSystemVersion `bson:"version,omitempty" json:"version,omitempty"`
-	Subsumption       *bool                                      `bson:"subsumption,omitempty" json:"subsumption,omitempty"`
+	ID                 *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URI                *string                                    `bson:"uri,omitempty" json:"uri,omitempty"`
+	URIElement         *Element                                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	Version            []TerminologyCapabilitiesCodeSystemVersion `bson:"version,omitempty" json:"version,omitempty"`
+	Subsumption        *bool                                      `bson:"subsumption,omitempty" json:"subsumption,omitempty"`
+	SubsumptionElement *Element                                   `bson:"_subsumption,omitempty" json:"_subsumption,omitempty"`
+	UnknownFields      map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type TerminologyCapabilitiesCodeSystemVersion struct {
-	ID                *string                                          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *string                                          `bson:"code,omitempty" json:"code,omitempty"`
-	IsDefault         *bool                                            `bson:"isDefault,omitempty" json:"isDefault,omitempty"`
-	Compositional     *bool                                            `bson:"compositional,omitempty" json:"compositional,omitempty"`
-	Language          []string                                         `bson:"language,omitempty" json:"language,omitempty"`
-	Filter            []TerminologyCapabilitiesCodeSystemVersionFilter `bson:"filter,omitempty" json:"filter,omitempty"`
-	Property          []string                                         `bson:"property,omitempty" json:"property,omitempty"`
+	ID                   *string                                          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 *string                                          `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement          *Element                                         `bson:"_code,omitempty" json:"_code,omitempty"`
+	IsDefault            *bool                                            `bson:"isDefault,omitempty" json:"isDefault,omitempty"`
+	IsDefaultElement     *Element                                         `bson:"_isDefault,omitempty" json:"_isDefault,omitempty"`
+	Compositional        *bool                                            `bson:"compositional,omitempty" json:"compositional,omitempty"`
+	CompositionalElement *Element                                         `bson:"_compositional,omitempty" json:"_compositional,omitempty"`
+	Language             []*string                                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      []*Element                                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Filter               []TerminologyCapabilitiesCodeSystemVersionFilter `bson:"filter,omitempty" json:"filter,omitempty"`
+	Property             []*string                                        `bson:"property,omitempty" json:"property,omitempty"`
+	PropertyElement      []*Element                                       `bson:"_property,omitempty" json:"_property,omitempty"`
+	UnknownFields        map[string]json.RawMessage                       `bson:"-" json:"-"`
 }
 type TerminologyCapabilitiesCodeSystemVersionFilter struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string      `bson:"code" json:"code"`
-	Op                []string    `bson:"op" json:"op"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              string                     `bson:"code" json:"code"`
+	CodeElement       *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Op                []*string                  `bson:"op" json:"op"`
+	OpElement         []*Element                 `bson:"_op,omitempty" json:"_op,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// UnmarshalJSON unmarshals the TerminologyCapabilitiesCodeSystemVersionFilter and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalJSON(b []byte) error {
+	type other TerminologyCapabilitiesCodeSystemVersionFilter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("TerminologyCapabilities.codeSystem.version.filter.op", len(r.Op), len(r.OpElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesCodeSystemVersionFilter with its unknown fields.
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesCodeSystemVersionFilter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the TerminologyCapabilitiesCodeSystemVersion and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalJSON(b []byte) error {
+	type other TerminologyCapabilitiesCodeSystemVersion
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("TerminologyCapabilities.codeSystem.version.language", len(r.Language), len(r.LanguageElement)); err != nil {
+		return err
+	}
+	if err := checkElements("TerminologyCapabilities.codeSystem.version.property", len(r.Property), len(r.PropertyElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesCodeSystemVersion with its unknown fields.
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesCodeSystemVersion
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesCodeSystem with its unknown fields.
+func (r TerminologyCapabilitiesCodeSystem) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesCodeSystem
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TerminologyCapabilitiesExpansion struct {
-	ID                *string                                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Hierarchical      *bool                                       `bson:"hierarchical,omitempty" json:"hierarchical,omitempty"`
-	Paging            *bool                                       `bson:"paging,omitempty" json:"paging,omitempty"`
-	Incomplete        *bool                                       `bson:"incomplete,omitempty" json:"incomplete,omitempty"`
-	Parameter         []TerminologyCapabilitiesExpansionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	TextFilter        *string                                     `bson:"textFilter,omitempty" json:"textFilter,omitempty"`
+	ID                  *string                                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Hierarchical        *bool                                       `bson:"hierarchical,omitempty" json:"hierarchical,omitempty"`
+	HierarchicalElement *Element                                    `bson:"_hierarchical,omitempty" json:"_hierarchical,omitempty"`
+	Paging              *bool                                       `bson:"paging,omitempty" json:"paging,omitempty"`
+	PagingElement       *Element                                    `bson:"_paging,omitempty" json:"_paging,omitempty"`
+	Incomplete          *bool                                       `bson:"incomplete,omitempty" json:"incomplete,omitempty"`
+	IncompleteElement   *Element                                    `bson:"_incomplete,omitempty" json:"_incomplete,omitempty"`
+	Parameter           []TerminologyCapabilitiesExpansionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	TextFilter          *string                                     `bson:"textFilter,omitempty" json:"textFilter,omitempty"`
+	TextFilterElement   *Element                                    `bson:"_textFilter,omitempty" json:"_textFilter,omitempty"`
+	UnknownFields       map[string]json.RawMessage                  `bson:"-" json:"-"`
 }
 type TerminologyCapabilitiesExpansionParameter struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Documentation     *string     `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Documentation        *string                    `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                   `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TerminologyCapabilitiesExpansionParameter with its unknown fields.
+func (r TerminologyCapabilitiesExpansionParameter) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesExpansionParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesExpansion with its unknown fields.
+func (r TerminologyCapabilitiesExpansion) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesExpansion
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TerminologyCapabilitiesValidateCode struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Translations      bool        `bson:"translations" json:"translations"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Translations        bool                       `bson:"translations" json:"translations"`
+	TranslationsElement *Element                   `bson:"_translations,omitempty" json:"_translations,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesValidateCode with its unknown fields.
+func (r TerminologyCapabilitiesValidateCode) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesValidateCode
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TerminologyCapabilitiesTranslation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	NeedsMap          bool        `bson:"needsMap" json:"needsMap"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	NeedsMap          bool                       `bson:"needsMap" json:"needsMap"`
+	NeedsMapElement   *Element                   `bson:"_needsMap,omitempty" json:"_needsMap,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TerminologyCapabilitiesTranslation with its unknown fields.
+func (r TerminologyCapabilitiesTranslation) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesTranslation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TerminologyCapabilitiesClosure struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Translation       *bool       `bson:"translation,omitempty" json:"translation,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Translation        *bool                      `bson:"translation,omitempty" json:"translation,omitempty"`
+	TranslationElement *Element                   `bson:"_translation,omitempty" json:"_translation,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TerminologyCapabilitiesClosure with its unknown fields.
+func (r TerminologyCapabilitiesClosure) MarshalJSON() ([]byte, error) {
+	type other TerminologyCapabilitiesClosure
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the TerminologyCapabilities and fails with CodeError when a coded element has an unknown code.
+func (r *TerminologyCapabilities) UnmarshalJSON(b []byte) error {
+	type other TerminologyCapabilities
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("TerminologyCapabilities", r)
 }
+
 type OtherTerminologyCapabilities TerminologyCapabilities
 
 // MarshalJSON marshals the given TerminologyCapabilities as JSON into a byte slice
 func (r TerminologyCapabilities) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherTerminologyCapabilities
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherTerminologyCapabilities: OtherTerminologyCapabilities(r),
 		ResourceType:                 "TerminologyCapabilities",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalTerminologyCapabilities unmarshals a TerminologyCapabilities.
@@ -22,39 +22,65 @@ import "encoding/json"
 
 // TestReport is documented here http://hl7.org/fhir/StructureDefinition/TestReport
 type TestReport struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string                 `bson:"name,omitempty" json:"name,omitempty"`
-	Status            TestReportStatus        `bson:"status" json:"status"`
-	TestScript        Reference               `bson:"testScript" json:"testScript"`
-	Result            TestReportResult        `bson:"result" json:"result"`
-	Score             *Decimal                `bson:"score,omitempty" json:"score,omitempty"`
-	Tester            *string                 `bson:"tester,omitempty" json:"tester,omitempty"`
-	Issued            *DateTime               `bson:"issued,omitempty" json:"issued,omitempty"`
-	Participant       []TestReportParticipant `bson:"participant,omitempty" json:"participant,omitempty"`
-	Setup             *TestReportSetup        `bson:"setup,omitempty" json:"setup,omitempty"`
-	Test              []TestReportTest        `bson:"test,omitempty" json:"test,omitempty"`
-	Teardown          *TestReportTeardown     `bson:"teardown,omitempty" json:"teardown,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               TestReportStatus           `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	TestScript           Reference                  `bson:"testScript" json:"testScript"`
+	Result               TestReportResult           `bson:"result" json:"result"`
+	ResultElement        *Element                   `bson:"_result,omitempty" json:"_result,omitempty"`
+	Score                *Decimal                   `bson:"score,omitempty" json:"score,omitempty"`
+	ScoreElement         *Element                   `bson:"_score,omitempty" json:"_score,omitempty"`
+	Tester               *string                    `bson:"tester,omitempty" json:"tester,omitempty"`
+	TesterElement        *Element                   `bson:"_tester,omitempty" json:"_tester,omitempty"`
+	Issued               *DateTime                  `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement        *Element                   `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Participant          []TestReportParticipant    `bson:"participant,omitempty" json:"participant,omitempty"`
+	Setup                *TestReportSetup           `bson:"setup,omitempty" json:"setup,omitempty"`
+	Test                 []TestReportTest           `bson:"test,omitempty" json:"test,omitempty"`
+	Teardown             *TestReportTeardown        `bson:"teardown,omitempty" json:"teardown,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestReportParticipant struct {
-	ID                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              TestReportParticipantType `bson:"type" json:"type"`
-	URI               string                    `bson:"uri" json:"uri"`
-	Display           *string                   `bson:"display,omitempty" json:"display,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              TestReportParticipantType  `bson:"type" json:"type"`
+	TypeElement       *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	URI               string                     `bson:"uri" json:"uri"`
+	URIElement        *Element                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	Display           *string                    `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                   `bson:"_display,omitempty" json:"_display,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestReportParticipant with its unknown fields.
+func (r TestReportParticipant) MarshalJSON() ([]byte, error) {
+	type other TestReportParticipant
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestReportSetup struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Action            []TestReportSetupAction `bson:"action" json:"action"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Action            []TestReportSetupAction    `bson:"action" json:"action"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestReportSetupAction struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -62,30 +88,84 @@ type TestReportSetupAction struct {
 	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         *TestReportSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
 	Assert            *TestReportSetupActionAssert    `bson:"assert,omitempty" json:"assert,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type TestReportSetupActionOperation struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Result            TestReportActionResult `bson:"result" json:"result"`
-	Message           *string                `bson:"message,omitempty" json:"message,omitempty"`
-	Detail            *string                `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Result            TestReportActionResult     `bson:"result" json:"result"`
+	ResultElement     *Element                   `bson:"_result,omitempty" json:"_result,omitempty"`
+	Message           *string                    `bson:"message,omitempty" json:"message,omitempty"`
+	MessageElement    *Element                   `bson:"_message,omitempty" json:"_message,omitempty"`
+	Detail            *string                    `bson:"detail,omitempty" json:"detail,omitempty"`
+	DetailElement     *Element                   `bson:"_detail,omitempty" json:"_detail,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestReportSetupActionOperation with its unknown fields.
+func (r TestReportSetupActionOperation) MarshalJSON() ([]byte, error) {
+	type other TestReportSetupActionOperation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestReportSetupActionAssert struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Result            TestReportActionResult `bson:"result" json:"result"`
-	Message           *string                `bson:"message,omitempty" json:"message,omitempty"`
-	Detail            *string                `bson:"detail,omitempty" json:"detail,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Result            TestReportActionResult     `bson:"result" json:"result"`
+	ResultElement     *Element                   `bson:"_result,omitempty" json:"_result,omitempty"`
+	Message           *string                    `bson:"message,omitempty" json:"message,omitempty"`
+	MessageElement    *Element                   `bson:"_message,omitempty" json:"_message,omitempty"`
+	Detail            *string                    `bson:"detail,omitempty" json:"detail,omitempty"`
+	DetailElement     *Element                   `bson:"_detail,omitempty" json:"_detail,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestReportSetupActionAssert with its unknown fields.
+func (r TestReportSetupActionAssert) MarshalJSON() ([]byte, error) {
+	type other TestReportSetupActionAssert
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestReportSetupAction with its unknown fields.
+func (r TestReportSetupAction) MarshalJSON() ([]byte, error) {
+	type other TestReportSetupAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestReportSetup with its unknown fields.
+func (r TestReportSetup) MarshalJSON() ([]byte, error) {
+	type other TestReportSetup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestReportTest struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string                `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                `bson:"description,omitempty" json:"description,omitempty"`
-	Action            []TestReportTestAction `bson:"action" json:"action"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Action             []TestReportTestAction     `bson:"action" json:"action"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestReportTestAction struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -93,30 +173,88 @@ type TestReportTestAction struct {
 	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         *TestReportSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
 	Assert            *TestReportSetupActionAssert    `bson:"assert,omitempty" json:"assert,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestReportTestAction with its unknown fields.
+func (r TestReportTestAction) MarshalJSON() ([]byte, error) {
+	type other TestReportTestAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestReportTest with its unknown fields.
+func (r TestReportTest) MarshalJSON() ([]byte, error) {
+	type other TestReportTest
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestReportTeardown struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Action            []TestReportTeardownAction `bson:"action" json:"action"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestReportTeardownAction struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         TestReportSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestReportTeardownAction with its unknown fields.
+func (r TestReportTeardownAction) MarshalJSON() ([]byte, error) {
+	type other TestReportTeardownAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestReportTeardown with its unknown fields.
+func (r TestReportTeardown) MarshalJSON() ([]byte, error) {
+	type other TestReportTeardown
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the TestReport and fails with CodeError when a coded element has an unknown code.
+func (r *TestReport) UnmarshalJSON(b []byte) error {
+	type other TestReport
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("TestReport", r)
 }
+
 type OtherTestReport TestReport
 
 // MarshalJSON marshals the given TestReport as JSON into a byte slice
 func (r TestReport) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherTestReport
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherTestReport: OtherTestReport(r),
 		ResourceType:    "TestReport",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalTestReport unmarshals a TestReport.
@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.123448 +0000 UTC
+// 2026-10-19 02:55:18.958832733 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TestReportActionResult is documented here http://hl7.org/fhir/ValueSet/report-action-result-codes
-type TestReportActionResult int
+// The empty TestReportActionResult is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TestReportActionResult string
 
 const (
-	TestReportActionResultPass TestReportActionResult = iota
-	TestReportActionResultSkip
-	TestReportActionResultFail
-	TestReportActionResultWarning
-	TestReportActionResultError
+	TestReportActionResultPass    TestReportActionResult = "pass"
+	TestReportActionResultSkip    TestReportActionResult = "skip"
+	TestReportActionResultFail    TestReportActionResult = "fail"
+	TestReportActionResultWarning TestReportActionResult = "warning"
+	TestReportActionResultError   TestReportActionResult = "error"
 )
 
 func (code TestReportActionResult) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TestReportActionResult"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TestReportActionResult) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "pass":
-		*code = TestReportActionResultPass
-	case "skip":
-		*code = TestReportActionResultSkip
-	case "fail":
-		*code = TestReportActionResultFail
-	case "warning":
-		*code = TestReportActionResultWarning
-	case "error":
-		*code = TestReportActionResultError
-	default:
-		return fmt.Errorf("unknown TestReportActionResult code `%s`", s)
+func (code *TestReportActionResult) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = TestReportActionResult(s)
 	return nil
 }
 func (code TestReportActionResult) String() string {
 	return code.Code()
 }
 func (code TestReportActionResult) Code() string {
+	return string(code)
+}
+func (code TestReportActionResult) Known() bool {
 	switch code {
-	case TestReportActionResultPass:
-		return "pass"
-	case TestReportActionResultSkip:
-		return "skip"
-	case TestReportActionResultFail:
-		return "fail"
-	case TestReportActionResultWarning:
-		return "warning"
-	case TestReportActionResultError:
-		return "error"
+	case TestReportActionResultPass, TestReportActionResultSkip, TestReportActionResultFail, TestReportActionResultWarning, TestReportActionResultError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TestReportActionResult) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.27108 +0000 UTC
+// 2026-10-19 02:55:18.959857142 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TestReportParticipantType is documented here http://hl7.org/fhir/ValueSet/report-participant-type
-type TestReportParticipantType int
+// The empty TestReportParticipantType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TestReportParticipantType string
 
 const (
-	TestReportParticipantTypeTestEngine TestReportParticipantType = iota
-	TestReportParticipantTypeClient
-	TestReportParticipantTypeServer
+	TestReportParticipantTypeTestEngine TestReportParticipantType = "test-engine"
+	TestReportParticipantTypeClient     TestReportParticipantType = "client"
+	TestReportParticipantTypeServer     TestReportParticipantType = "server"
 )
 
 func (code TestReportParticipantType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TestReportParticipantType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TestReportParticipantType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "test-engine":
-		*code = TestReportParticipantTypeTestEngine
-	case "client":
-		*code = TestReportParticipantTypeClient
-	case "server":
-		*code = TestReportParticipantTypeServer
-	default:
-		return fmt.Errorf("unknown TestReportParticipantType code `%s`", s)
+func (code *TestReportParticipantType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = TestReportParticipantType(s)
 	return nil
 }
 func (code TestReportParticipantType) String() string {
 	return code.Code()
 }
 func (code TestReportParticipantType) Code() string {
+	return string(code)
+}
+func (code TestReportParticipantType) Known() bool {
 	switch code {
-	case TestReportParticipantTypeTestEngine:
-		return "test-engine"
-	case TestReportParticipantTypeClient:
-		return "client"
-	case TestReportParticipantTypeServer:
-		return "server"
+	case TestReportParticipantTypeTestEngine, TestReportParticipantTypeClient, TestReportParticipantTypeServer:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TestReportParticipantType) Display() string {
 	switch code {
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.191581 +0000 UTC
+// 2026-10-19 02:55:18.960993303 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TestReportResult is documented here http://hl7.org/fhir/ValueSet/report-result-codes
-type TestReportResult int
+// The empty TestReportResult is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TestReportResult string
 
 const (
-	TestReportResultPass TestReportResult = iota
-	TestReportResultFail
-	TestReportResultPending
+	TestReportResultPass    TestReportResult = "pass"
+	TestReportResultFail    TestReportResult = "fail"
+	TestReportResultPending TestReportResult = "pending"
 )
 
 func (code TestReportResult) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TestReportResult"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TestReportResult) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "pass":
-		*code = TestReportResultPass
-	case "fail":
-		*code = TestReportResultFail
-	case "pending":
-		*code = TestReportResultPending
-	default:
-		return fmt.Errorf("unknown TestReportResult code `%s`", s)
+func (code *TestReportResult) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = TestReportResult(s)
 	return nil
 }
 func (code TestReportResult) String() string {
 	return code.Code()
 }
 func (code TestReportResult) Code() string {
+	return string(code)
+}
+func (code TestReportResult) Known() bool {
 	switch code {
-	case TestReportResultPass:
-		return "pass"
-	case TestReportResultFail:
-		return "fail"
-	case TestReportResultPending:
-		return "pending"
+	case TestReportResultPass, TestReportResultFail, TestReportResultPending:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TestReportResult) Display() string {
 	switch code {
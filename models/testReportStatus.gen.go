@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.264036 +0000 UTC
+// 2026-10-19 02:55:18.962267913 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TestReportStatus is documented here http://hl7.org/fhir/ValueSet/report-status-codes
-type TestReportStatus int
+// The empty TestReportStatus is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TestReportStatus string
 
 const (
-	TestReportStatusCompleted TestReportStatus = iota
-	TestReportStatusInProgress
-	TestReportStatusWaiting
-	TestReportStatusStopped
-	TestReportStatusEnteredInError
+	TestReportStatusCompleted      TestReportStatus = "completed"
+	TestReportStatusInProgress     TestReportStatus = "in-progress"
+	TestReportStatusWaiting        TestReportStatus = "waiting"
+	TestReportStatusStopped        TestReportStatus = "stopped"
+	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
 )
 
 func (code TestReportStatus) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TestReportStatus"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TestReportStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "completed":
-		*code = TestReportStatusCompleted
-	case "in-progress":
-		*code = TestReportStatusInProgress
-	case "waiting":
-		*code = TestReportStatusWaiting
-	case "stopped":
-		*code = TestReportStatusStopped
-	case "entered-in-error":
-		*code = TestReportStatusEnteredInError
-	default:
-		return fmt.Errorf("unknown TestReportStatus code `%s`", s)
+func (code *TestReportStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = TestReportStatus(s)
 	return nil
 }
 func (code TestReportStatus) String() string {
 	return code.Code()
 }
 func (code TestReportStatus) Code() string {
+	return string(code)
+}
+func (code TestReportStatus) Known() bool {
 	switch code {
-	case TestReportStatusCompleted:
-		return "completed"
-	case TestReportStatusInProgress:
-		return "in-progress"
-	case TestReportStatusWaiting:
-		return "waiting"
-	case TestReportStatusStopped:
-		return "stopped"
-	case TestReportStatusEnteredInError:
-		return "entered-in-error"
+	case TestReportStatusCompleted, TestReportStatusInProgress, TestReportStatusWaiting, TestReportStatusStopped, TestReportStatusEnteredInError:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TestReportStatus) Display() string {
 	switch code {
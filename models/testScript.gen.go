@@ -22,104 +22,239 @@ import "encoding/json"
 
 // TestScript is documented here http://hl7.org/fhir/StructureDefinition/TestScript
 type TestScript struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string                  `bson:"url" json:"url"`
-	Identifier        *Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                 `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                  `bson:"name" json:"name"`
-	Title             *string                 `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus       `bson:"status" json:"status"`
-	Experimental      *bool                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime               `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                 `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail         `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                 `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext          `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                 `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                 `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Origin            []TestScriptOrigin      `bson:"origin,omitempty" json:"origin,omitempty"`
-	Destination       []TestScriptDestination `bson:"destination,omitempty" json:"destination,omitempty"`
-	Metadata          *TestScriptMetadata     `bson:"metadata,omitempty" json:"metadata,omitempty"`
-	Fixture           []TestScriptFixture     `bson:"fixture,omitempty" json:"fixture,omitempty"`
-	Profile           []Reference             `bson:"profile,omitempty" json:"profile,omitempty"`
-	Variable          []TestScriptVariable    `bson:"variable,omitempty" json:"variable,omitempty"`
-	Setup             *TestScriptSetup        `bson:"setup,omitempty" json:"setup,omitempty"`
-	Test              []TestScriptTest        `bson:"test,omitempty" json:"test,omitempty"`
-	Teardown          *TestScriptTeardown     `bson:"teardown,omitempty" json:"teardown,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  string                     `bson:"url" json:"url"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           *Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Origin               []TestScriptOrigin         `bson:"origin,omitempty" json:"origin,omitempty"`
+	Destination          []TestScriptDestination    `bson:"destination,omitempty" json:"destination,omitempty"`
+	Metadata             *TestScriptMetadata        `bson:"metadata,omitempty" json:"metadata,omitempty"`
+	Fixture              []TestScriptFixture        `bson:"fixture,omitempty" json:"fixture,omitempty"`
+	Profile              []Reference                `bson:"profile,omitempty" json:"profile,omitempty"`
+	Variable             []TestScriptVariable       `bson:"variable,omitempty" json:"variable,omitempty"`
+	Setup                *TestScriptSetup           `bson:"setup,omitempty" json:"setup,omitempty"`
+	Test                 []TestScriptTest           `bson:"test,omitempty" json:"test,omitempty"`
+	Teardown             *TestScriptTeardown        `bson:"teardown,omitempty" json:"teardown,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestScriptOrigin struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Index             int         `bson:"index" json:"index"`
-	Profile           Coding      `bson:"profile" json:"profile"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Index             int                        `bson:"index" json:"index"`
+	IndexElement      *Element                   `bson:"_index,omitempty" json:"_index,omitempty"`
+	Profile           Coding                     `bson:"profile" json:"profile"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestScriptOrigin with its unknown fields.
+func (r TestScriptOrigin) MarshalJSON() ([]byte, error) {
+	type other TestScriptOrigin
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestScriptDestination struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Index             int         `bson:"index" json:"index"`
-	Profile           Coding      `bson:"profile" json:"profile"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Index             int                        `bson:"index" json:"index"`
+	IndexElement      *Element                   `bson:"_index,omitempty" json:"_index,omitempty"`
+	Profile           Coding                     `bson:"profile" json:"profile"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TestScriptDestination with its unknown fields.
+func (r TestScriptDestination) MarshalJSON() ([]byte, error) {
+	type other TestScriptDestination
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TestScriptMetadata struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Link              []TestScriptMetadataLink       `bson:"link,omitempty" json:"link,omitempty"`
 	Capability        []TestScriptMetadataCapability `bson:"capability" json:"capability"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
 }
 type TestScriptMetadataLink struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               string      `bson:"url" json:"url"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                string                     `bson:"url" json:"url"`
+	URLElement         *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestScriptMetadataLink with its unknown fields.
+func (r TestScriptMetadataLink) MarshalJSON() ([]byte, error) {
+	type other TestScriptMetadataLink
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestScriptMetadataCapability struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Required          bool        `bson:"required" json:"required"`
-	Validated         bool        `bson:"validated" json:"validated"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Origin            []int       `bson:"origin,omitempty" json:"origin,omitempty"`
-	Destination       *int        `bson:"destination,omitempty" json:"destination,omitempty"`
-	Link              []string    `bson:"link,omitempty" json:"link,omitempty"`
-	Capabilities      string      `bson:"capabilities" json:"capabilities"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Required            bool                       `bson:"required" json:"required"`
+	RequiredElement     *Element                   `bson:"_required,omitempty" json:"_required,omitempty"`
+	Validated           bool                       `bson:"validated" json:"validated"`
+	ValidatedElement    *Element                   `bson:"_validated,omitempty" json:"_validated,omitempty"`
+	Description         *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Origin              []*int                     `bson:"origin,omitempty" json:"origin,omitempty"`
+	OriginElement       []*Element                 `bson:"_origin,omitempty" json:"_origin,omitempty"`
+	Destination         *int                       `bson:"destination,omitempty" json:"destination,omitempty"`
+	DestinationElement  *Element                   `bson:"_destination,omitempty" json:"_destination,omitempty"`
+	Link                []*string                  `bson:"link,omitempty" json:"link,omitempty"`
+	LinkElement         []*Element                 `bson:"_link,omitempty" json:"_link,omitempty"`
+	Capabilities        string                     `bson:"capabilities" json:"capabilities"`
+	CapabilitiesElement *Element                   `bson:"_capabilities,omitempty" json:"_capabilities,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// UnmarshalJSON unmarshals the TestScriptMetadataCapability and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *TestScriptMetadataCapability) UnmarshalJSON(b []byte) error {
+	type other TestScriptMetadataCapability
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("TestScript.metadata.capability.origin", len(r.Origin), len(r.OriginElement)); err != nil {
+		return err
+	}
+	if err := checkElements("TestScript.metadata.capability.link", len(r.Link), len(r.LinkElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the TestScriptMetadataCapability with its unknown fields.
+func (r TestScriptMetadataCapability) MarshalJSON() ([]byte, error) {
+	type other TestScriptMetadataCapability
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestScriptMetadata with its unknown fields.
+func (r TestScriptMetadata) MarshalJSON() ([]byte, error) {
+	type other TestScriptMetadata
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TestScriptFixture struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Autocreate        bool        `bson:"autocreate" json:"autocreate"`
-	Autodelete        bool        `bson:"autodelete" json:"autodelete"`
-	Resource          *Reference  `bson:"resource,omitempty" json:"resource,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Autocreate        bool                       `bson:"autocreate" json:"autocreate"`
+	AutocreateElement *Element                   `bson:"_autocreate,omitempty" json:"_autocreate,omitempty"`
+	Autodelete        bool                       `bson:"autodelete" json:"autodelete"`
+	AutodeleteElement *Element                   `bson:"_autodelete,omitempty" json:"_autodelete,omitempty"`
+	Resource          *Reference                 `bson:"resource,omitempty" json:"resource,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestScriptFixture with its unknown fields.
+func (r TestScriptFixture) MarshalJSON() ([]byte, error) {
+	type other TestScriptFixture
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
 type TestScriptVariable struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	DefaultValue      *string     `bson:"defaultValue,omitempty" json:"defaultValue,omitempty"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Expression        *string     `bson:"expression,omitempty" json:"expression,omitempty"`
-	HeaderField       *string     `bson:"headerField,omitempty" json:"headerField,omitempty"`
-	Hint              *string     `bson:"hint,omitempty" json:"hint,omitempty"`
-	Path              *string     `bson:"path,omitempty" json:"path,omitempty"`
-	SourceId          *string     `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	ID                  *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                string                     `bson:"name" json:"name"`
+	NameElement         *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	DefaultValue        *string                    `bson:"defaultValue,omitempty" json:"defaultValue,omitempty"`
+	DefaultValueElement *Element                   `bson:"_defaultValue,omitempty" json:"_defaultValue,omitempty"`
+	Description         *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Expression          *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement   *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	HeaderField         *string                    `bson:"headerField,omitempty" json:"headerField,omitempty"`
+	HeaderFieldElement  *Element                   `bson:"_headerField,omitempty" json:"_headerField,omitempty"`
+	Hint                *string                    `bson:"hint,omitempty" json:"hint,omitempty"`
+	HintElement         *Element                   `bson:"_hint,omitempty" json:"_hint,omitempty"`
+	Path                *string                    `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement         *Element                   `bson:"_path,omitempty" json:"_path,omitempty"`
+	SourceId            *string                    `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement     *Element                   `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
+	UnknownFields       map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TestScriptVariable with its unknown fields.
+func (r TestScriptVariable) MarshalJSON() ([]byte, error) {
+	type other TestScriptVariable
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TestScriptSetup struct {
-	ID                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Action            []TestScriptSetupAction `bson:"action" json:"action"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Action            []TestScriptSetupAction    `bson:"action" json:"action"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestScriptSetupAction struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -127,70 +262,168 @@ type TestScriptSetupAction struct {
 	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         *TestScriptSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
 	Assert            *TestScriptSetupActionAssert    `bson:"assert,omitempty" json:"assert,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type TestScriptSetupActionOperation struct {
-	ID                *string                                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *Coding                                       `bson:"type,omitempty" json:"type,omitempty"`
-	Resource          *string                                       `bson:"resource,omitempty" json:"resource,omitempty"`
-	Label             *string                                       `bson:"label,omitempty" json:"label,omitempty"`
-	Description       *string                                       `bson:"description,omitempty" json:"description,omitempty"`
-	Accept            *string                                       `bson:"accept,omitempty" json:"accept,omitempty"`
-	ContentType       *string                                       `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Destination       *int                                          `bson:"destination,omitempty" json:"destination,omitempty"`
-	EncodeRequestUrl  bool                                          `bson:"encodeRequestUrl" json:"encodeRequestUrl"`
-	Method            *TestScriptRequestMethodCode                  `bson:"method,omitempty" json:"method,omitempty"`
-	Origin            *int                                          `bson:"origin,omitempty" json:"origin,omitempty"`
-	Params            *string                                       `bson:"params,omitempty" json:"params,omitempty"`
-	RequestHeader     []TestScriptSetupActionOperationRequestHeader `bson:"requestHeader,omitempty" json:"requestHeader,omitempty"`
-	RequestId         *string                                       `bson:"requestId,omitempty" json:"requestId,omitempty"`
-	ResponseId        *string                                       `bson:"responseId,omitempty" json:"responseId,omitempty"`
-	SourceId          *string                                       `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
-	TargetId          *string                                       `bson:"targetId,omitempty" json:"targetId,omitempty"`
-	URL               *string                                       `bson:"url,omitempty" json:"url,omitempty"`
+	ID                      *string                                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                    *Coding                                       `bson:"type,omitempty" json:"type,omitempty"`
+	Resource                *FHIRDefinedType                              `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement         *Element                                      `bson:"_resource,omitempty" json:"_resource,omitempty"`
+	Label                   *string                                       `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement            *Element                                      `bson:"_label,omitempty" json:"_label,omitempty"`
+	Description             *string                                       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                                      `bson:"_description,omitempty" json:"_description,omitempty"`
+	Accept                  *string                                       `bson:"accept,omitempty" json:"accept,omitempty"`
+	AcceptElement           *Element                                      `bson:"_accept,omitempty" json:"_accept,omitempty"`
+	ContentType             *string                                       `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	ContentTypeElement      *Element                                      `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	Destination             *int                                          `bson:"destination,omitempty" json:"destination,omitempty"`
+	DestinationElement      *Element                                      `bson:"_destination,omitempty" json:"_destination,omitempty"`
+	EncodeRequestUrl        bool                                          `bson:"encodeRequestUrl" json:"encodeRequestUrl"`
+	EncodeRequestUrlElement *Element                                      `bson:"_encodeRequestUrl,omitempty" json:"_encodeRequestUrl,omitempty"`
+	Method                  *TestScriptRequestMethodCode                  `bson:"method,omitempty" json:"method,omitempty"`
+	MethodElement           *Element                                      `bson:"_method,omitempty" json:"_method,omitempty"`
+	Origin                  *int                                          `bson:"origin,omitempty" json:"origin,omitempty"`
+	OriginElement           *Element                                      `bson:"_origin,omitempty" json:"_origin,omitempty"`
+	Params                  *string                                       `bson:"params,omitempty" json:"params,omitempty"`
+	ParamsElement           *Element                                      `bson:"_params,omitempty" json:"_params,omitempty"`
+	RequestHeader           []TestScriptSetupActionOperationRequestHeader `bson:"requestHeader,omitempty" json:"requestHeader,omitempty"`
+	RequestId               *string                                       `bson:"requestId,omitempty" json:"requestId,omitempty"`
+	RequestIdElement        *Element                                      `bson:"_requestId,omitempty" json:"_requestId,omitempty"`
+	ResponseId              *string                                       `bson:"responseId,omitempty" json:"responseId,omitempty"`
+	ResponseIdElement       *Element                                      `bson:"_responseId,omitempty" json:"_responseId,omitempty"`
+	SourceId                *string                                       `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement         *Element                                      `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
+	TargetId                *string                                       `bson:"targetId,omitempty" json:"targetId,omitempty"`
+	TargetIdElement         *Element                                      `bson:"_targetId,omitempty" json:"_targetId,omitempty"`
+	URL                     *string                                       `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement              *Element                                      `bson:"_url,omitempty" json:"_url,omitempty"`
+	UnknownFields           map[string]json.RawMessage                    `bson:"-" json:"-"`
 }
 type TestScriptSetupActionOperationRequestHeader struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Field             string      `bson:"field" json:"field"`
-	Value             string      `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Field             string                     `bson:"field" json:"field"`
+	FieldElement      *Element                   `bson:"_field,omitempty" json:"_field,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the TestScriptSetupActionOperationRequestHeader with its unknown fields.
+func (r TestScriptSetupActionOperationRequestHeader) MarshalJSON() ([]byte, error) {
+	type other TestScriptSetupActionOperationRequestHeader
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestScriptSetupActionOperation with its unknown fields.
+func (r TestScriptSetupActionOperation) MarshalJSON() ([]byte, error) {
+	type other TestScriptSetupActionOperation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TestScriptSetupActionAssert struct {
-	ID                        *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Label                     *string                      `bson:"label,omitempty" json:"label,omitempty"`
-	Description               *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	Direction                 *AssertionDirectionType      `bson:"direction,omitempty" json:"direction,omitempty"`
-	CompareToSourceId         *string                      `bson:"compareToSourceId,omitempty" json:"compareToSourceId,omitempty"`
-	CompareToSourceExpression *string                      `bson:"compareToSourceExpression,omitempty" json:"compareToSourceExpression,omitempty"`
-	CompareToSourcePath       *string                      `bson:"compareToSourcePath,omitempty" json:"compareToSourcePath,omitempty"`
-	ContentType               *string                      `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Expression                *string                      `bson:"expression,omitempty" json:"expression,omitempty"`
-	HeaderField               *string                      `bson:"headerField,omitempty" json:"headerField,omitempty"`
-	MinimumId                 *string                      `bson:"minimumId,omitempty" json:"minimumId,omitempty"`
-	NavigationLinks           *bool                        `bson:"navigationLinks,omitempty" json:"navigationLinks,omitempty"`
-	Operator                  *AssertionOperatorType       `bson:"operator,omitempty" json:"operator,omitempty"`
-	Path                      *string                      `bson:"path,omitempty" json:"path,omitempty"`
-	RequestMethod             *TestScriptRequestMethodCode `bson:"requestMethod,omitempty" json:"requestMethod,omitempty"`
-	RequestURL                *string                      `bson:"requestURL,omitempty" json:"requestURL,omitempty"`
-	Resource                  *string                      `bson:"resource,omitempty" json:"resource,omitempty"`
-	Response                  *AssertionResponseTypes      `bson:"response,omitempty" json:"response,omitempty"`
-	ResponseCode              *string                      `bson:"responseCode,omitempty" json:"responseCode,omitempty"`
-	SourceId                  *string                      `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
-	ValidateProfileId         *string                      `bson:"validateProfileId,omitempty" json:"validateProfileId,omitempty"`
-	Value                     *string                      `bson:"value,omitempty" json:"value,omitempty"`
-	WarningOnly               bool                         `bson:"warningOnly" json:"warningOnly"`
+	ID                               *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Label                            *string                      `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement                     *Element                     `bson:"_label,omitempty" json:"_label,omitempty"`
+	Description                      *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement               *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	Direction                        *AssertionDirectionType      `bson:"direction,omitempty" json:"direction,omitempty"`
+	DirectionElement                 *Element                     `bson:"_direction,omitempty" json:"_direction,omitempty"`
+	CompareToSourceId                *string                      `bson:"compareToSourceId,omitempty" json:"compareToSourceId,omitempty"`
+	CompareToSourceIdElement         *Element                     `bson:"_compareToSourceId,omitempty" json:"_compareToSourceId,omitempty"`
+	CompareToSourceExpression        *string                      `bson:"compareToSourceExpression,omitempty" json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpressionElement *Element                     `bson:"_compareToSourceExpression,omitempty" json:"_compareToSourceExpression,omitempty"`
+	CompareToSourcePath              *string                      `bson:"compareToSourcePath,omitempty" json:"compareToSourcePath,omitempty"`
+	CompareToSourcePathElement       *Element                     `bson:"_compareToSourcePath,omitempty" json:"_compareToSourcePath,omitempty"`
+	ContentType                      *string                      `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	ContentTypeElement               *Element                     `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	Expression                       *string                      `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement                *Element                     `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	HeaderField                      *string                      `bson:"headerField,omitempty" json:"headerField,omitempty"`
+	HeaderFieldElement               *Element                     `bson:"_headerField,omitempty" json:"_headerField,omitempty"`
+	MinimumId                        *string                      `bson:"minimumId,omitempty" json:"minimumId,omitempty"`
+	MinimumIdElement                 *Element                     `bson:"_minimumId,omitempty" json:"_minimumId,omitempty"`
+	NavigationLinks                  *bool                        `bson:"navigationLinks,omitempty" json:"navigationLinks,omitempty"`
+	NavigationLinksElement           *Element                     `bson:"_navigationLinks,omitempty" json:"_navigationLinks,omitempty"`
+	Operator                         *AssertionOperatorType       `bson:"operator,omitempty" json:"operator,omitempty"`
+	OperatorElement                  *Element                     `bson:"_operator,omitempty" json:"_operator,omitempty"`
+	Path                             *string                      `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement                      *Element                     `bson:"_path,omitempty" json:"_path,omitempty"`
+	RequestMethod                    *TestScriptRequestMethodCode `bson:"requestMethod,omitempty" json:"requestMethod,omitempty"`
+	RequestMethodElement             *Element                     `bson:"_requestMethod,omitempty" json:"_requestMethod,omitempty"`
+	RequestURL                       *string                      `bson:"requestURL,omitempty" json:"requestURL,omitempty"`
+	RequestURLElement                *Element                     `bson:"_requestURL,omitempty" json:"_requestURL,omitempty"`
+	Resource                         *FHIRDefinedType             `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement                  *Element                     `bson:"_resource,omitempty" json:"_resource,omitempty"`
+	Response                         *AssertionResponseTypes      `bson:"response,omitempty" json:"response,omitempty"`
+	ResponseElement                  *Element                     `bson:"_response,omitempty" json:"_response,omitempty"`
+	ResponseCode                     *string                      `bson:"responseCode,omitempty" json:"responseCode,omitempty"`
+	ResponseCodeElement              *Element                     `bson:"_responseCode,omitempty" json:"_responseCode,omitempty"`
+	SourceId                         *string                      `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement                  *Element                     `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
+	ValidateProfileId                *string                      `bson:"validateProfileId,omitempty" json:"validateProfileId,omitempty"`
+	ValidateProfileIdElement         *Element                     `bson:"_validateProfileId,omitempty" json:"_validateProfileId,omitempty"`
+	Value                            *string                      `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement                     *Element                     `bson:"_value,omitempty" json:"_value,omitempty"`
+	WarningOnly                      bool                         `bson:"warningOnly" json:"warningOnly"`
+	WarningOnlyElement               *Element                     `bson:"_warningOnly,omitempty" json:"_warningOnly,omitempty"`
+	UnknownFields                    map[string]json.RawMessage   `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestScriptSetupActionAssert with its unknown fields.
+func (r TestScriptSetupActionAssert) MarshalJSON() ([]byte, error) {
+	type other TestScriptSetupActionAssert
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the TestScriptSetupAction with its unknown fields.
+func (r TestScriptSetupAction) MarshalJSON() ([]byte, error) {
+	type other TestScriptSetupAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestScriptSetup with its unknown fields.
+func (r TestScriptSetup) MarshalJSON() ([]byte, error) {
+	type other TestScriptSetup
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TestScriptTest struct {
-	ID                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string                `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                `bson:"description,omitempty" json:"description,omitempty"`
-	Action            []TestScriptTestAction `bson:"action" json:"action"`
+	ID                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Action             []TestScriptTestAction     `bson:"action" json:"action"`
+	UnknownFields      map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestScriptTestAction struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -198,30 +431,88 @@ type TestScriptTestAction struct {
 	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         *TestScriptSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
 	Assert            *TestScriptSetupActionAssert    `bson:"assert,omitempty" json:"assert,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestScriptTestAction with its unknown fields.
+func (r TestScriptTestAction) MarshalJSON() ([]byte, error) {
+	type other TestScriptTestAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// MarshalJSON marshals the TestScriptTest with its unknown fields.
+func (r TestScriptTest) MarshalJSON() ([]byte, error) {
+	type other TestScriptTest
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type TestScriptTeardown struct {
 	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Action            []TestScriptTeardownAction `bson:"action" json:"action"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type TestScriptTeardownAction struct {
 	ID                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         TestScriptSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
+	UnknownFields     map[string]json.RawMessage     `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the TestScriptTeardownAction with its unknown fields.
+func (r TestScriptTeardownAction) MarshalJSON() ([]byte, error) {
+	type other TestScriptTeardownAction
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the TestScriptTeardown with its unknown fields.
+func (r TestScriptTeardown) MarshalJSON() ([]byte, error) {
+	type other TestScriptTeardown
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the TestScript and fails with CodeError when a coded element has an unknown code.
+func (r *TestScript) UnmarshalJSON(b []byte) error {
+	type other TestScript
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("TestScript", r)
+}
+
 type OtherTestScript TestScript
 
 // MarshalJSON marshals the given TestScript as JSON into a byte slice
 func (r TestScript) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherTestScript
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherTestScript: OtherTestScript(r),
 		ResourceType:    "TestScript",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalTestScript unmarshals a TestScript.
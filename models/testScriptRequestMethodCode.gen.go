@@ -14,75 +14,56 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.139469 +0000 UTC
+// 2026-10-19 02:55:18.963531873 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TestScriptRequestMethodCode is documented here http://hl7.org/fhir/ValueSet/http-operations
-type TestScriptRequestMethodCode int
+// The empty TestScriptRequestMethodCode is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TestScriptRequestMethodCode string
 
 const (
-	TestScriptRequestMethodCodeDelete TestScriptRequestMethodCode = iota
-	TestScriptRequestMethodCodeGet
-	TestScriptRequestMethodCodeOptions
-	TestScriptRequestMethodCodePatch
-	TestScriptRequestMethodCodePost
-	TestScriptRequestMethodCodePut
-	TestScriptRequestMethodCodeHead
+	TestScriptRequestMethodCodeDelete  TestScriptRequestMethodCode = "delete"
+	TestScriptRequestMethodCodeGet     TestScriptRequestMethodCode = "get"
+	TestScriptRequestMethodCodeOptions TestScriptRequestMethodCode = "options"
+	TestScriptRequestMethodCodePatch   TestScriptRequestMethodCode = "patch"
+	TestScriptRequestMethodCodePost    TestScriptRequestMethodCode = "post"
+	TestScriptRequestMethodCodePut     TestScriptRequestMethodCode = "put"
+	TestScriptRequestMethodCodeHead    TestScriptRequestMethodCode = "head"
 )
 
 func (code TestScriptRequestMethodCode) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TestScriptRequestMethodCode"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TestScriptRequestMethodCode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "delete":
-		*code = TestScriptRequestMethodCodeDelete
-	case "get":
-		*code = TestScriptRequestMethodCodeGet
-	case "options":
-		*code = TestScriptRequestMethodCodeOptions
-	case "patch":
-		*code = TestScriptRequestMethodCodePatch
-	case "post":
-		*code = TestScriptRequestMethodCodePost
-	case "put":
-		*code = TestScriptRequestMethodCodePut
-	case "head":
-		*code = TestScriptRequestMethodCodeHead
-	default:
-		return fmt.Errorf("unknown TestScriptRequestMethodCode code `%s`", s)
+func (code *TestScriptRequestMethodCode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = TestScriptRequestMethodCode(s)
 	return nil
 }
 func (code TestScriptRequestMethodCode) String() string {
 	return code.Code()
 }
 func (code TestScriptRequestMethodCode) Code() string {
+	return string(code)
+}
+func (code TestScriptRequestMethodCode) Known() bool {
 	switch code {
-	case TestScriptRequestMethodCodeDelete:
-		return "delete"
-	case TestScriptRequestMethodCodeGet:
-		return "get"
-	case TestScriptRequestMethodCodeOptions:
-		return "options"
-	case TestScriptRequestMethodCodePatch:
-		return "patch"
-	case TestScriptRequestMethodCodePost:
-		return "post"
-	case TestScriptRequestMethodCodePut:
-		return "put"
-	case TestScriptRequestMethodCodeHead:
-		return "head"
+	case TestScriptRequestMethodCodeDelete, TestScriptRequestMethodCodeGet, TestScriptRequestMethodCodeOptions, TestScriptRequestMethodCodePatch, TestScriptRequestMethodCodePost, TestScriptRequestMethodCodePut, TestScriptRequestMethodCodeHead:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TestScriptRequestMethodCode) Display() string {
 	switch code {
@@ -18,30 +18,124 @@
 
 package models
 
+import "encoding/json"
+
 // Timing is documented here http://hl7.org/fhir/StructureDefinition/Timing
 type Timing struct {
 	ID                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Event             []DateTime       `bson:"event,omitempty" json:"event,omitempty"`
+	Event             []*DateTime      `bson:"event,omitempty" json:"event,omitempty"`
+	EventElement      []*Element       `bson:"_event,omitempty" json:"_event,omitempty"`
 	Repeat            *TimingRepeat    `bson:"repeat,omitempty" json:"repeat,omitempty"`
 	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 }
 type TimingRepeat struct {
-	ID           *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	Count        *int         `bson:"count,omitempty" json:"count,omitempty"`
-	CountMax     *int         `bson:"countMax,omitempty" json:"countMax,omitempty"`
-	Duration     *Decimal     `bson:"duration,omitempty" json:"duration,omitempty"`
-	DurationMax  *Decimal     `bson:"durationMax,omitempty" json:"durationMax,omitempty"`
-	DurationUnit *string      `bson:"durationUnit,omitempty" json:"durationUnit,omitempty"`
-	Frequency    *int         `bson:"frequency,omitempty" json:"frequency,omitempty"`
-	FrequencyMax *int         `bson:"frequencyMax,omitempty" json:"frequencyMax,omitempty"`
-	Period       *Decimal     `bson:"period,omitempty" json:"period,omitempty"`
-	PeriodMax    *Decimal     `bson:"periodMax,omitempty" json:"periodMax,omitempty"`
-	PeriodUnit   *string      `bson:"periodUnit,omitempty" json:"periodUnit,omitempty"`
-	DayOfWeek    []DaysOfWeek `bson:"dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
-	TimeOfDay    []Time       `bson:"timeOfDay,omitempty" json:"timeOfDay,omitempty"`
-	When         []string     `bson:"when,omitempty" json:"when,omitempty"`
-	Offset       *int         `bson:"offset,omitempty" json:"offset,omitempty"`
+	ID                  *string       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
+	BoundsDuration      *Duration     `bson:"boundsDuration,omitempty" json:"boundsDuration,omitempty"`
+	BoundsRange         *Range        `bson:"boundsRange,omitempty" json:"boundsRange,omitempty"`
+	BoundsPeriod        *Period       `bson:"boundsPeriod,omitempty" json:"boundsPeriod,omitempty"`
+	Count               *int          `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement        *Element      `bson:"_count,omitempty" json:"_count,omitempty"`
+	CountMax            *int          `bson:"countMax,omitempty" json:"countMax,omitempty"`
+	CountMaxElement     *Element      `bson:"_countMax,omitempty" json:"_countMax,omitempty"`
+	Duration            *Decimal      `bson:"duration,omitempty" json:"duration,omitempty"`
+	DurationElement     *Element      `bson:"_duration,omitempty" json:"_duration,omitempty"`
+	DurationMax         *Decimal      `bson:"durationMax,omitempty" json:"durationMax,omitempty"`
+	DurationMaxElement  *Element      `bson:"_durationMax,omitempty" json:"_durationMax,omitempty"`
+	DurationUnit        *string       `bson:"durationUnit,omitempty" json:"durationUnit,omitempty"`
+	DurationUnitElement *Element      `bson:"_durationUnit,omitempty" json:"_durationUnit,omitempty"`
+	Frequency           *int          `bson:"frequency,omitempty" json:"frequency,omitempty"`
+	FrequencyElement    *Element      `bson:"_frequency,omitempty" json:"_frequency,omitempty"`
+	FrequencyMax        *int          `bson:"frequencyMax,omitempty" json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *Element      `bson:"_frequencyMax,omitempty" json:"_frequencyMax,omitempty"`
+	Period              *Decimal      `bson:"period,omitempty" json:"period,omitempty"`
+	PeriodElement       *Element      `bson:"_period,omitempty" json:"_period,omitempty"`
+	PeriodMax           *Decimal      `bson:"periodMax,omitempty" json:"periodMax,omitempty"`
+	PeriodMaxElement    *Element      `bson:"_periodMax,omitempty" json:"_periodMax,omitempty"`
+	PeriodUnit          *string       `bson:"periodUnit,omitempty" json:"periodUnit,omitempty"`
+	PeriodUnitElement   *Element      `bson:"_periodUnit,omitempty" json:"_periodUnit,omitempty"`
+	DayOfWeek           []*DaysOfWeek `bson:"dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
+	DayOfWeekElement    []*Element    `bson:"_dayOfWeek,omitempty" json:"_dayOfWeek,omitempty"`
+	TimeOfDay           []*Time       `bson:"timeOfDay,omitempty" json:"timeOfDay,omitempty"`
+	TimeOfDayElement    []*Element    `bson:"_timeOfDay,omitempty" json:"_timeOfDay,omitempty"`
+	When                []*string     `bson:"when,omitempty" json:"when,omitempty"`
+	WhenElement         []*Element    `bson:"_when,omitempty" json:"_when,omitempty"`
+	Offset              *int          `bson:"offset,omitempty" json:"offset,omitempty"`
+	OffsetElement       *Element      `bson:"_offset,omitempty" json:"_offset,omitempty"`
+}
+
+// Bounds returns Timing.repeat.bounds[x] of the set type, such as *Duration, or nil when it is not set.
+func (r *TimingRepeat) Bounds() interface{} {
+	switch {
+	case r.BoundsDuration != nil:
+		return r.BoundsDuration
+	case r.BoundsRange != nil:
+		return r.BoundsRange
+	case r.BoundsPeriod != nil:
+		return r.BoundsPeriod
+	}
+	return nil
+}
+
+// SetBoundsDuration sets Timing.repeat.bounds[x] to the Duration and clears the other types.
+func (r *TimingRepeat) SetBoundsDuration(v Duration) {
+	r.clearBounds()
+	r.BoundsDuration = &v
+}
+
+// SetBoundsRange sets Timing.repeat.bounds[x] to the Range and clears the other types.
+func (r *TimingRepeat) SetBoundsRange(v Range) {
+	r.clearBounds()
+	r.BoundsRange = &v
+}
+
+// SetBoundsPeriod sets Timing.repeat.bounds[x] to the Period and clears the other types.
+func (r *TimingRepeat) SetBoundsPeriod(v Period) {
+	r.clearBounds()
+	r.BoundsPeriod = &v
+}
+func (r *TimingRepeat) clearBounds() {
+	r.BoundsDuration = nil
+	r.BoundsRange = nil
+	r.BoundsPeriod = nil
+}
+
+// UnmarshalJSON unmarshals the TimingRepeat and fails with ChoiceError when a polymorphic element has more than one type and with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *TimingRepeat) UnmarshalJSON(b []byte) error {
+	type other TimingRepeat
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"Timing.repeat.bounds[x]",
+		r.BoundsDuration != nil,
+		r.BoundsRange != nil,
+		r.BoundsPeriod != nil,
+	); err != nil {
+		return err
+	}
+	if err := checkElements("Timing.repeat.dayOfWeek", len(r.DayOfWeek), len(r.DayOfWeekElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Timing.repeat.timeOfDay", len(r.TimeOfDay), len(r.TimeOfDayElement)); err != nil {
+		return err
+	}
+	if err := checkElements("Timing.repeat.when", len(r.When), len(r.WhenElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Timing and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *Timing) UnmarshalJSON(b []byte) error {
+	type other Timing
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("Timing.event", len(r.Event), len(r.EventElement)); err != nil {
+		return err
+	}
+	return nil
 }
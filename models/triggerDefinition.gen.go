@@ -18,12 +18,87 @@
 
 package models
 
+import "encoding/json"
+
 // TriggerDefinition is documented here http://hl7.org/fhir/StructureDefinition/TriggerDefinition
 type TriggerDefinition struct {
-	ID        *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type      TriggerType       `bson:"type" json:"type"`
-	Name      *string           `bson:"name,omitempty" json:"name,omitempty"`
-	Data      []DataRequirement `bson:"data,omitempty" json:"data,omitempty"`
-	Condition *Expression       `bson:"condition,omitempty" json:"condition,omitempty"`
+	ID                    *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type                  TriggerType       `bson:"type" json:"type"`
+	TypeElement           *Element          `bson:"_type,omitempty" json:"_type,omitempty"`
+	Name                  *string           `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element          `bson:"_name,omitempty" json:"_name,omitempty"`
+	TimingTiming          *Timing           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingReference       *Reference        `bson:"timingReference,omitempty" json:"timingReference,omitempty"`
+	TimingDate            *DateTime         `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
+	TimingDateElement     *Element          `bson:"_timingDate,omitempty" json:"_timingDate,omitempty"`
+	TimingDateTime        *DateTime         `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *Element          `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	Data                  []DataRequirement `bson:"data,omitempty" json:"data,omitempty"`
+	Condition             *Expression       `bson:"condition,omitempty" json:"condition,omitempty"`
+}
+
+// Timing returns TriggerDefinition.timing[x] of the set type, such as *Timing, or nil when it is not set.
+func (r *TriggerDefinition) Timing() interface{} {
+	switch {
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	case r.TimingReference != nil:
+		return r.TimingReference
+	case r.TimingDate != nil:
+		return r.TimingDate
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	}
+	return nil
+}
+
+// SetTimingTiming sets TriggerDefinition.timing[x] to the Timing and clears the other types.
+func (r *TriggerDefinition) SetTimingTiming(v Timing) {
+	r.clearTiming()
+	r.TimingTiming = &v
+}
+
+// SetTimingReference sets TriggerDefinition.timing[x] to the Reference and clears the other types.
+func (r *TriggerDefinition) SetTimingReference(v Reference) {
+	r.clearTiming()
+	r.TimingReference = &v
+}
+
+// SetTimingDate sets TriggerDefinition.timing[x] to the date and clears the other types.
+func (r *TriggerDefinition) SetTimingDate(v DateTime) {
+	r.clearTiming()
+	r.TimingDate = &v
+}
+
+// SetTimingDateTime sets TriggerDefinition.timing[x] to the dateTime and clears the other types.
+func (r *TriggerDefinition) SetTimingDateTime(v DateTime) {
+	r.clearTiming()
+	r.TimingDateTime = &v
+}
+func (r *TriggerDefinition) clearTiming() {
+	r.TimingTiming = nil
+	r.TimingReference = nil
+	r.TimingDate = nil
+	r.TimingDateElement = nil
+	r.TimingDateTime = nil
+	r.TimingDateTimeElement = nil
+}
+
+// UnmarshalJSON unmarshals the TriggerDefinition and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *TriggerDefinition) UnmarshalJSON(b []byte) error {
+	type other TriggerDefinition
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"TriggerDefinition.timing[x]",
+		r.TimingTiming != nil,
+		r.TimingReference != nil,
+		r.TimingDate != nil || r.TimingDateElement != nil,
+		r.TimingDateTime != nil || r.TimingDateTimeElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
@@ -14,80 +14,57 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.07252 +0000 UTC
+// 2026-10-19 02:55:18.964624391 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TriggerType is documented here http://hl7.org/fhir/ValueSet/trigger-type
-type TriggerType int
+// The empty TriggerType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TriggerType string
 
 const (
-	TriggerTypeNamedEvent TriggerType = iota
-	TriggerTypePeriodic
-	TriggerTypeDataChanged
-	TriggerTypeDataAdded
-	TriggerTypeDataModified
-	TriggerTypeDataRemoved
-	TriggerTypeDataAccessed
-	TriggerTypeDataAccessEnded
+	TriggerTypeNamedEvent      TriggerType = "named-event"
+	TriggerTypePeriodic        TriggerType = "periodic"
+	TriggerTypeDataChanged     TriggerType = "data-changed"
+	TriggerTypeDataAdded       TriggerType = "data-added"
+	TriggerTypeDataModified    TriggerType = "data-modified"
+	TriggerTypeDataRemoved     TriggerType = "data-removed"
+	TriggerTypeDataAccessed    TriggerType = "data-accessed"
+	TriggerTypeDataAccessEnded TriggerType = "data-access-ended"
 )
 
 func (code TriggerType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TriggerType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TriggerType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "named-event":
-		*code = TriggerTypeNamedEvent
-	case "periodic":
-		*code = TriggerTypePeriodic
-	case "data-changed":
-		*code = TriggerTypeDataChanged
-	case "data-added":
-		*code = TriggerTypeDataAdded
-	case "data-modified":
-		*code = TriggerTypeDataModified
-	case "data-removed":
-		*code = TriggerTypeDataRemoved
-	case "data-accessed":
-		*code = TriggerTypeDataAccessed
-	case "data-access-ended":
-		*code = TriggerTypeDataAccessEnded
-	default:
-		return fmt.Errorf("unknown TriggerType code `%s`", s)
+func (code *TriggerType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = TriggerType(s)
 	return nil
 }
 func (code TriggerType) String() string {
 	return code.Code()
 }
 func (code TriggerType) Code() string {
+	return string(code)
+}
+func (code TriggerType) Known() bool {
 	switch code {
-	case TriggerTypeNamedEvent:
-		return "named-event"
-	case TriggerTypePeriodic:
-		return "periodic"
-	case TriggerTypeDataChanged:
-		return "data-changed"
-	case TriggerTypeDataAdded:
-		return "data-added"
-	case TriggerTypeDataModified:
-		return "data-modified"
-	case TriggerTypeDataRemoved:
-		return "data-removed"
-	case TriggerTypeDataAccessed:
-		return "data-accessed"
-	case TriggerTypeDataAccessEnded:
-		return "data-access-ended"
+	case TriggerTypeNamedEvent, TriggerTypePeriodic, TriggerTypeDataChanged, TriggerTypeDataAdded, TriggerTypeDataModified, TriggerTypeDataRemoved, TriggerTypeDataAccessed, TriggerTypeDataAccessEnded:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TriggerType) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.108178 +0000 UTC
+// 2026-10-19 02:55:18.965920502 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TypeDerivationRule is documented here http://hl7.org/fhir/ValueSet/type-derivation-rule
-type TypeDerivationRule int
+// The empty TypeDerivationRule is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TypeDerivationRule string
 
 const (
-	TypeDerivationRuleSpecialization TypeDerivationRule = iota
-	TypeDerivationRuleConstraint
+	TypeDerivationRuleSpecialization TypeDerivationRule = "specialization"
+	TypeDerivationRuleConstraint     TypeDerivationRule = "constraint"
 )
 
 func (code TypeDerivationRule) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TypeDerivationRule"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TypeDerivationRule) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "specialization":
-		*code = TypeDerivationRuleSpecialization
-	case "constraint":
-		*code = TypeDerivationRuleConstraint
-	default:
-		return fmt.Errorf("unknown TypeDerivationRule code `%s`", s)
+func (code *TypeDerivationRule) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = TypeDerivationRule(s)
 	return nil
 }
 func (code TypeDerivationRule) String() string {
 	return code.Code()
 }
 func (code TypeDerivationRule) Code() string {
+	return string(code)
+}
+func (code TypeDerivationRule) Known() bool {
 	switch code {
-	case TypeDerivationRuleSpecialization:
-		return "specialization"
-	case TypeDerivationRuleConstraint:
-		return "constraint"
+	case TypeDerivationRuleSpecialization, TypeDerivationRuleConstraint:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TypeDerivationRule) Display() string {
 	switch code {
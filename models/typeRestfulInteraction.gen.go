@@ -14,125 +14,66 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.253546 +0000 UTC
+// 2026-10-19 02:55:18.96717114 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // TypeRestfulInteraction is documented here http://hl7.org/fhir/ValueSet/type-restful-interaction
-type TypeRestfulInteraction int
+// The empty TypeRestfulInteraction is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type TypeRestfulInteraction string
 
 const (
-	TypeRestfulInteractionRead TypeRestfulInteraction = iota
-	TypeRestfulInteractionVread
-	TypeRestfulInteractionUpdate
-	TypeRestfulInteractionPatch
-	TypeRestfulInteractionDelete
-	TypeRestfulInteractionHistory
-	TypeRestfulInteractionHistoryInstance
-	TypeRestfulInteractionHistoryType
-	TypeRestfulInteractionHistorySystem
-	TypeRestfulInteractionCreate
-	TypeRestfulInteractionSearch
-	TypeRestfulInteractionSearchType
-	TypeRestfulInteractionSearchSystem
-	TypeRestfulInteractionCapabilities
-	TypeRestfulInteractionTransaction
-	TypeRestfulInteractionBatch
-	TypeRestfulInteractionOperation
+	TypeRestfulInteractionRead            TypeRestfulInteraction = "read"
+	TypeRestfulInteractionVread           TypeRestfulInteraction = "vread"
+	TypeRestfulInteractionUpdate          TypeRestfulInteraction = "update"
+	TypeRestfulInteractionPatch           TypeRestfulInteraction = "patch"
+	TypeRestfulInteractionDelete          TypeRestfulInteraction = "delete"
+	TypeRestfulInteractionHistory         TypeRestfulInteraction = "history"
+	TypeRestfulInteractionHistoryInstance TypeRestfulInteraction = "history-instance"
+	TypeRestfulInteractionHistoryType     TypeRestfulInteraction = "history-type"
+	TypeRestfulInteractionHistorySystem   TypeRestfulInteraction = "history-system"
+	TypeRestfulInteractionCreate          TypeRestfulInteraction = "create"
+	TypeRestfulInteractionSearch          TypeRestfulInteraction = "search"
+	TypeRestfulInteractionSearchType      TypeRestfulInteraction = "search-type"
+	TypeRestfulInteractionSearchSystem    TypeRestfulInteraction = "search-system"
+	TypeRestfulInteractionCapabilities    TypeRestfulInteraction = "capabilities"
+	TypeRestfulInteractionTransaction     TypeRestfulInteraction = "transaction"
+	TypeRestfulInteractionBatch           TypeRestfulInteraction = "batch"
+	TypeRestfulInteractionOperation       TypeRestfulInteraction = "operation"
 )
 
 func (code TypeRestfulInteraction) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "TypeRestfulInteraction"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *TypeRestfulInteraction) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "read":
-		*code = TypeRestfulInteractionRead
-	case "vread":
-		*code = TypeRestfulInteractionVread
-	case "update":
-		*code = TypeRestfulInteractionUpdate
-	case "patch":
-		*code = TypeRestfulInteractionPatch
-	case "delete":
-		*code = TypeRestfulInteractionDelete
-	case "history":
-		*code = TypeRestfulInteractionHistory
-	case "history-instance":
-		*code = TypeRestfulInteractionHistoryInstance
-	case "history-type":
-		*code = TypeRestfulInteractionHistoryType
-	case "history-system":
-		*code = TypeRestfulInteractionHistorySystem
-	case "create":
-		*code = TypeRestfulInteractionCreate
-	case "search":
-		*code = TypeRestfulInteractionSearch
-	case "search-type":
-		*code = TypeRestfulInteractionSearchType
-	case "search-system":
-		*code = TypeRestfulInteractionSearchSystem
-	case "capabilities":
-		*code = TypeRestfulInteractionCapabilities
-	case "transaction":
-		*code = TypeRestfulInteractionTransaction
-	case "batch":
-		*code = TypeRestfulInteractionBatch
-	case "operation":
-		*code = TypeRestfulInteractionOperation
-	default:
-		return fmt.Errorf("unknown TypeRestfulInteraction code `%s`", s)
+func (code *TypeRestfulInteraction) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = TypeRestfulInteraction(s)
 	return nil
 }
 func (code TypeRestfulInteraction) String() string {
 	return code.Code()
 }
 func (code TypeRestfulInteraction) Code() string {
+	return string(code)
+}
+func (code TypeRestfulInteraction) Known() bool {
 	switch code {
-	case TypeRestfulInteractionRead:
-		return "read"
-	case TypeRestfulInteractionVread:
-		return "vread"
-	case TypeRestfulInteractionUpdate:
-		return "update"
-	case TypeRestfulInteractionPatch:
-		return "patch"
-	case TypeRestfulInteractionDelete:
-		return "delete"
-	case TypeRestfulInteractionHistory:
-		return "history"
-	case TypeRestfulInteractionHistoryInstance:
-		return "history-instance"
-	case TypeRestfulInteractionHistoryType:
-		return "history-type"
-	case TypeRestfulInteractionHistorySystem:
-		return "history-system"
-	case TypeRestfulInteractionCreate:
-		return "create"
-	case TypeRestfulInteractionSearch:
-		return "search"
-	case TypeRestfulInteractionSearchType:
-		return "search-type"
-	case TypeRestfulInteractionSearchSystem:
-		return "search-system"
-	case TypeRestfulInteractionCapabilities:
-		return "capabilities"
-	case TypeRestfulInteractionTransaction:
-		return "transaction"
-	case TypeRestfulInteractionBatch:
-		return "batch"
-	case TypeRestfulInteractionOperation:
-		return "operation"
+	case TypeRestfulInteractionRead, TypeRestfulInteractionVread, TypeRestfulInteractionUpdate, TypeRestfulInteractionPatch, TypeRestfulInteractionDelete, TypeRestfulInteractionHistory, TypeRestfulInteractionHistoryInstance, TypeRestfulInteractionHistoryType, TypeRestfulInteractionHistorySystem, TypeRestfulInteractionCreate, TypeRestfulInteractionSearch, TypeRestfulInteractionSearchType, TypeRestfulInteractionSearchSystem, TypeRestfulInteractionCapabilities, TypeRestfulInteractionTransaction, TypeRestfulInteractionBatch, TypeRestfulInteractionOperation:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code TypeRestfulInteraction) Display() string {
 	switch code {
@@ -14,70 +14,55 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.219528 +0000 UTC
+// 2026-10-19 02:55:18.97037391 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // UDIEntryType is documented here http://hl7.org/fhir/ValueSet/udi-entry-type
-type UDIEntryType int
+// The empty UDIEntryType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type UDIEntryType string
 
 const (
-	UDIEntryTypeBarcode UDIEntryType = iota
-	UDIEntryTypeRfid
-	UDIEntryTypeManual
-	UDIEntryTypeCard
-	UDIEntryTypeSelfReported
-	UDIEntryTypeUnknown
+	UDIEntryTypeBarcode      UDIEntryType = "barcode"
+	UDIEntryTypeRfid         UDIEntryType = "rfid"
+	UDIEntryTypeManual       UDIEntryType = "manual"
+	UDIEntryTypeCard         UDIEntryType = "card"
+	UDIEntryTypeSelfReported UDIEntryType = "self-reported"
+	UDIEntryTypeUnknown      UDIEntryType = "unknown"
 )
 
 func (code UDIEntryType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "UDIEntryType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *UDIEntryType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "barcode":
-		*code = UDIEntryTypeBarcode
-	case "rfid":
-		*code = UDIEntryTypeRfid
-	case "manual":
-		*code = UDIEntryTypeManual
-	case "card":
-		*code = UDIEntryTypeCard
-	case "self-reported":
-		*code = UDIEntryTypeSelfReported
-	case "unknown":
-		*code = UDIEntryTypeUnknown
-	default:
-		return fmt.Errorf("unknown UDIEntryType code `%s`", s)
+func (code *UDIEntryType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = UDIEntryType(s)
 	return nil
 }
 func (code UDIEntryType) String() string {
 	return code.Code()
 }
 func (code UDIEntryType) Code() string {
+	return string(code)
+}
+func (code UDIEntryType) Known() bool {
 	switch code {
-	case UDIEntryTypeBarcode:
-		return "barcode"
-	case UDIEntryTypeRfid:
-		return "rfid"
-	case UDIEntryTypeManual:
-		return "manual"
-	case UDIEntryTypeCard:
-		return "card"
-	case UDIEntryTypeSelfReported:
-		return "self-reported"
-	case UDIEntryTypeUnknown:
-		return "unknown"
+	case UDIEntryTypeBarcode, UDIEntryTypeRfid, UDIEntryTypeManual, UDIEntryTypeCard, UDIEntryTypeSelfReported, UDIEntryTypeUnknown:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code UDIEntryType) Display() string {
 	switch code {
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUnknownFields(t *testing.T) {
+	data := `{"resourceType":"Encounter","status":"finished","class":{"code":"AMB"},"vendorScore":1.50,` +
+		`"statusHistory":[{"status":"arrived","period":{},"reason":{"text":"R5"}}],` +
+		`"contained":[{"resourceType":"Patient","id":"p","vendorFlag":true}]}`
+
+	var encounter Encounter
+	if err := (Decoder{}).Unmarshal([]byte(data), &encounter); err != nil || encounter.UnknownFields != nil {
+		t.Fatalf("expected the unknown fields dropped, got %v, %v", encounter.UnknownFields, err)
+	}
+
+	encounter = Encounter{}
+	if err := (Decoder{UnknownFields: KeepUnknownFields}).Unmarshal([]byte(data), &encounter); err != nil {
+		t.Fatal(err)
+	}
+	if string(encounter.UnknownFields["vendorScore"]) != "1.50" || string(encounter.StatusHistory[0].UnknownFields["reason"]) != `{"text":"R5"}` {
+		t.Errorf("unexpected unknown fields: %v, %v", encounter.UnknownFields, encounter.StatusHistory[0].UnknownFields)
+	}
+	b, err := json.Marshal(encounter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded, expected interface{}
+	_ = json.Unmarshal(b, &decoded)
+	_ = json.Unmarshal([]byte(data), &expected)
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Errorf("expected the unknown fields written back %s, got %s", data, b)
+	}
+
+	var unknownErr UnknownFieldError
+	err = (Decoder{UnknownFields: RejectUnknownFields}).Unmarshal([]byte(data), &Encounter{})
+	if !errors.As(err, &unknownErr) || unknownErr.Path != "Encounter.vendorScore" {
+		t.Errorf("expected unknown field Encounter.vendorScore, got %v", err)
+	}
+
+	err = (Decoder{UnknownFields: RejectUnknownFields}).Unmarshal([]byte(`{"resourceType":"Encounter","status":"finished","class":{},`+
+		`"statusHistory":[{"status":"arrived","period":{},"reason":{}}]}`), &Encounter{})
+	if !errors.As(err, &unknownErr) || unknownErr.Path != "Encounter.statusHistory[0].reason" {
+		t.Errorf("expected unknown field Encounter.statusHistory[0].reason, got %v", err)
+	}
+}
@@ -18,9 +18,78 @@
 
 package models
 
+import "encoding/json"
+
 // UsageContext is documented here http://hl7.org/fhir/StructureDefinition/UsageContext
 type UsageContext struct {
-	ID        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Code      Coding      `bson:"code" json:"code"`
+	ID                   *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	Code                 Coding           `bson:"code" json:"code"`
+	ValueCodeableConcept *CodeableConcept `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueQuantity        *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange           *Range           `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueReference       *Reference       `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+}
+
+// Value returns UsageContext.value[x] of the set type, such as *CodeableConcept, or nil when it is not set.
+func (r *UsageContext) Value() interface{} {
+	switch {
+	case r.ValueCodeableConcept != nil:
+		return r.ValueCodeableConcept
+	case r.ValueQuantity != nil:
+		return r.ValueQuantity
+	case r.ValueRange != nil:
+		return r.ValueRange
+	case r.ValueReference != nil:
+		return r.ValueReference
+	}
+	return nil
+}
+
+// SetValueCodeableConcept sets UsageContext.value[x] to the CodeableConcept and clears the other types.
+func (r *UsageContext) SetValueCodeableConcept(v CodeableConcept) {
+	r.clearValue()
+	r.ValueCodeableConcept = &v
+}
+
+// SetValueQuantity sets UsageContext.value[x] to the Quantity and clears the other types.
+func (r *UsageContext) SetValueQuantity(v Quantity) {
+	r.clearValue()
+	r.ValueQuantity = &v
+}
+
+// SetValueRange sets UsageContext.value[x] to the Range and clears the other types.
+func (r *UsageContext) SetValueRange(v Range) {
+	r.clearValue()
+	r.ValueRange = &v
+}
+
+// SetValueReference sets UsageContext.value[x] to the Reference and clears the other types.
+func (r *UsageContext) SetValueReference(v Reference) {
+	r.clearValue()
+	r.ValueReference = &v
+}
+func (r *UsageContext) clearValue() {
+	r.ValueCodeableConcept = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueReference = nil
+}
+
+// UnmarshalJSON unmarshals the UsageContext and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *UsageContext) UnmarshalJSON(b []byte) error {
+	type other UsageContext
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"UsageContext.value[x]",
+		r.ValueCodeableConcept != nil,
+		r.ValueQuantity != nil,
+		r.ValueRange != nil,
+		r.ValueReference != nil,
+	); err != nil {
+		return err
+	}
+	return nil
 }
@@ -14,55 +14,52 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.1047 +0000 UTC
+// 2026-10-19 02:55:18.971717181 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // Use is documented here http://hl7.org/fhir/ValueSet/claim-use
-type Use int
+// The empty Use is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type Use string
 
 const (
-	UseClaim Use = iota
-	UsePreauthorization
-	UsePredetermination
+	UseClaim            Use = "claim"
+	UsePreauthorization Use = "preauthorization"
+	UsePredetermination Use = "predetermination"
 )
 
 func (code Use) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "Use"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *Use) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "claim":
-		*code = UseClaim
-	case "preauthorization":
-		*code = UsePreauthorization
-	case "predetermination":
-		*code = UsePredetermination
-	default:
-		return fmt.Errorf("unknown Use code `%s`", s)
+func (code *Use) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = Use(s)
 	return nil
 }
 func (code Use) String() string {
 	return code.Code()
 }
 func (code Use) Code() string {
+	return string(code)
+}
+func (code Use) Known() bool {
 	switch code {
-	case UseClaim:
-		return "claim"
-	case UsePreauthorization:
-		return "preauthorization"
-	case UsePredetermination:
-		return "predetermination"
+	case UseClaim, UsePreauthorization, UsePredetermination:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code Use) Display() string {
 	switch code {
@@ -22,116 +22,386 @@ import "encoding/json"
 
 // ValueSet is documented here http://hl7.org/fhir/StructureDefinition/ValueSet
 type ValueSet struct {
-	ID                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	URL               *string            `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string            `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string            `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string            `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus  `bson:"status" json:"status"`
-	Experimental      *bool              `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *DateTime          `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string            `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Immutable         *bool              `bson:"immutable,omitempty" json:"immutable,omitempty"`
-	Purpose           *string            `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Compose           *ValueSetCompose   `bson:"compose,omitempty" json:"compose,omitempty"`
-	Expansion         *ValueSetExpansion `bson:"expansion,omitempty" json:"expansion,omitempty"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	URL                  *string                    `bson:"url,omitempty" json:"url,omitempty"`
+	URLElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                    `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                   `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Immutable            *bool                      `bson:"immutable,omitempty" json:"immutable,omitempty"`
+	ImmutableElement     *Element                   `bson:"_immutable,omitempty" json:"_immutable,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                   `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Compose              *ValueSetCompose           `bson:"compose,omitempty" json:"compose,omitempty"`
+	Expansion            *ValueSetExpansion         `bson:"expansion,omitempty" json:"expansion,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ValueSetCompose struct {
-	ID                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	LockedDate        *DateTime                `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
-	Inactive          *bool                    `bson:"inactive,omitempty" json:"inactive,omitempty"`
-	Include           []ValueSetComposeInclude `bson:"include" json:"include"`
-	Exclude           []ValueSetComposeInclude `bson:"exclude,omitempty" json:"exclude,omitempty"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	LockedDate        *DateTime                  `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
+	LockedDateElement *Element                   `bson:"_lockedDate,omitempty" json:"_lockedDate,omitempty"`
+	Inactive          *bool                      `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	InactiveElement   *Element                   `bson:"_inactive,omitempty" json:"_inactive,omitempty"`
+	Include           []ValueSetComposeInclude   `bson:"include" json:"include"`
+	Exclude           []ValueSetComposeInclude   `bson:"exclude,omitempty" json:"exclude,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
 type ValueSetComposeInclude struct {
 	ID                *string                         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	System            *string                         `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element                        `bson:"_system,omitempty" json:"_system,omitempty"`
 	Version           *string                         `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                        `bson:"_version,omitempty" json:"_version,omitempty"`
 	Concept           []ValueSetComposeIncludeConcept `bson:"concept,omitempty" json:"concept,omitempty"`
 	Filter            []ValueSetComposeIncludeFilter  `bson:"filter,omitempty" json:"filter,omitempty"`
-	ValueSet          []string                        `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSet          []*string                       `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement   []*Element                      `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
+	UnknownFields     map[string]json.RawMessage      `bson:"-" json:"-"`
 }
 type ValueSetComposeIncludeConcept struct {
 	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              string                                     `bson:"code" json:"code"`
+	CodeElement       *Element                                   `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                                    `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                                   `bson:"_display,omitempty" json:"_display,omitempty"`
 	Designation       []ValueSetComposeIncludeConceptDesignation `bson:"designation,omitempty" json:"designation,omitempty"`
+	UnknownFields     map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type ValueSetComposeIncludeConceptDesignation struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Use               *Coding     `bson:"use,omitempty" json:"use,omitempty"`
-	Value             string      `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement   *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Use               *Coding                    `bson:"use,omitempty" json:"use,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ValueSetComposeIncludeConceptDesignation with its unknown fields.
+func (r ValueSetComposeIncludeConceptDesignation) MarshalJSON() ([]byte, error) {
+	type other ValueSetComposeIncludeConceptDesignation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ValueSetComposeIncludeConcept with its unknown fields.
+func (r ValueSetComposeIncludeConcept) MarshalJSON() ([]byte, error) {
+	type other ValueSetComposeIncludeConcept
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ValueSetComposeIncludeFilter struct {
-	ID                *string        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Property          string         `bson:"property" json:"property"`
-	Op                FilterOperator `bson:"op" json:"op"`
-	Value             string         `bson:"value" json:"value"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Property          string                     `bson:"property" json:"property"`
+	PropertyElement   *Element                   `bson:"_property,omitempty" json:"_property,omitempty"`
+	Op                FilterOperator             `bson:"op" json:"op"`
+	OpElement         *Element                   `bson:"_op,omitempty" json:"_op,omitempty"`
+	Value             string                     `bson:"value" json:"value"`
+	ValueElement      *Element                   `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the ValueSetComposeIncludeFilter with its unknown fields.
+func (r ValueSetComposeIncludeFilter) MarshalJSON() ([]byte, error) {
+	type other ValueSetComposeIncludeFilter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the ValueSetComposeInclude and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values.
+func (r *ValueSetComposeInclude) UnmarshalJSON(b []byte) error {
+	type other ValueSetComposeInclude
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("ValueSet.compose.include.valueSet", len(r.ValueSet), len(r.ValueSetElement)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ValueSetComposeInclude with its unknown fields.
+func (r ValueSetComposeInclude) MarshalJSON() ([]byte, error) {
+	type other ValueSetComposeInclude
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ValueSetCompose with its unknown fields.
+func (r ValueSetCompose) MarshalJSON() ([]byte, error) {
+	type other ValueSetCompose
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ValueSetExpansion struct {
 	ID                *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier        *string                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	IdentifierElement *Element                     `bson:"_identifier,omitempty" json:"_identifier,omitempty"`
 	Timestamp         DateTime                     `bson:"timestamp" json:"timestamp"`
+	TimestampElement  *Element                     `bson:"_timestamp,omitempty" json:"_timestamp,omitempty"`
 	Total             *int                         `bson:"total,omitempty" json:"total,omitempty"`
+	TotalElement      *Element                     `bson:"_total,omitempty" json:"_total,omitempty"`
 	Offset            *int                         `bson:"offset,omitempty" json:"offset,omitempty"`
+	OffsetElement     *Element                     `bson:"_offset,omitempty" json:"_offset,omitempty"`
 	Parameter         []ValueSetExpansionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
 	Contains          []ValueSetExpansionContains  `bson:"contains,omitempty" json:"contains,omitempty"`
+	UnknownFields     map[string]json.RawMessage   `bson:"-" json:"-"`
 }
 type ValueSetExpansionParameter struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
+	ID                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	ValueString          *string                    `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                   `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean         *bool                      `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                   `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger         *int                       `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                   `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDecimal         *Decimal                   `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element                   `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueUri             *string                    `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element                   `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueCode            *string                    `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement     *Element                   `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDateTime        *DateTime                  `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                   `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	UnknownFields        map[string]json.RawMessage `bson:"-" json:"-"`
+}
+
+// Value returns ValueSet.expansion.parameter.value[x] of the set type, such as *string, or nil when it is not set.
+func (r *ValueSetExpansionParameter) Value() interface{} {
+	switch {
+	case r.ValueString != nil:
+		return r.ValueString
+	case r.ValueBoolean != nil:
+		return r.ValueBoolean
+	case r.ValueInteger != nil:
+		return r.ValueInteger
+	case r.ValueDecimal != nil:
+		return r.ValueDecimal
+	case r.ValueUri != nil:
+		return r.ValueUri
+	case r.ValueCode != nil:
+		return r.ValueCode
+	case r.ValueDateTime != nil:
+		return r.ValueDateTime
+	}
+	return nil
+}
+
+// SetValueString sets ValueSet.expansion.parameter.value[x] to the string and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueString(v string) {
+	r.clearValue()
+	r.ValueString = &v
+}
+
+// SetValueBoolean sets ValueSet.expansion.parameter.value[x] to the boolean and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueBoolean(v bool) {
+	r.clearValue()
+	r.ValueBoolean = &v
+}
+
+// SetValueInteger sets ValueSet.expansion.parameter.value[x] to the integer and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueInteger(v int) {
+	r.clearValue()
+	r.ValueInteger = &v
+}
+
+// SetValueDecimal sets ValueSet.expansion.parameter.value[x] to the decimal and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueDecimal(v Decimal) {
+	r.clearValue()
+	r.ValueDecimal = &v
+}
+
+// SetValueUri sets ValueSet.expansion.parameter.value[x] to the uri and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueUri(v string) {
+	r.clearValue()
+	r.ValueUri = &v
+}
+
+// SetValueCode sets ValueSet.expansion.parameter.value[x] to the code and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueCode(v string) {
+	r.clearValue()
+	r.ValueCode = &v
 }
+
+// SetValueDateTime sets ValueSet.expansion.parameter.value[x] to the dateTime and clears the other types.
+func (r *ValueSetExpansionParameter) SetValueDateTime(v DateTime) {
+	r.clearValue()
+	r.ValueDateTime = &v
+}
+func (r *ValueSetExpansionParameter) clearValue() {
+	r.ValueString = nil
+	r.ValueStringElement = nil
+	r.ValueBoolean = nil
+	r.ValueBooleanElement = nil
+	r.ValueInteger = nil
+	r.ValueIntegerElement = nil
+	r.ValueDecimal = nil
+	r.ValueDecimalElement = nil
+	r.ValueUri = nil
+	r.ValueUriElement = nil
+	r.ValueCode = nil
+	r.ValueCodeElement = nil
+	r.ValueDateTime = nil
+	r.ValueDateTimeElement = nil
+}
+
+// UnmarshalJSON unmarshals the ValueSetExpansionParameter and fails with ChoiceError when a polymorphic element has more than one type.
+func (r *ValueSetExpansionParameter) UnmarshalJSON(b []byte) error {
+	type other ValueSetExpansionParameter
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkChoice(
+		"ValueSet.expansion.parameter.value[x]",
+		r.ValueString != nil || r.ValueStringElement != nil,
+		r.ValueBoolean != nil || r.ValueBooleanElement != nil,
+		r.ValueInteger != nil || r.ValueIntegerElement != nil,
+		r.ValueDecimal != nil || r.ValueDecimalElement != nil,
+		r.ValueUri != nil || r.ValueUriElement != nil,
+		r.ValueCode != nil || r.ValueCodeElement != nil,
+		r.ValueDateTime != nil || r.ValueDateTimeElement != nil,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON marshals the ValueSetExpansionParameter with its unknown fields.
+func (r ValueSetExpansionParameter) MarshalJSON() ([]byte, error) {
+	type other ValueSetExpansionParameter
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type ValueSetExpansionContains struct {
 	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	System            *string                                    `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element                                   `bson:"_system,omitempty" json:"_system,omitempty"`
 	Abstract          *bool                                      `bson:"abstract,omitempty" json:"abstract,omitempty"`
+	AbstractElement   *Element                                   `bson:"_abstract,omitempty" json:"_abstract,omitempty"`
 	Inactive          *bool                                      `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	InactiveElement   *Element                                   `bson:"_inactive,omitempty" json:"_inactive,omitempty"`
 	Version           *string                                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                                   `bson:"_version,omitempty" json:"_version,omitempty"`
 	Code              *string                                    `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element                                   `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                                    `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                                   `bson:"_display,omitempty" json:"_display,omitempty"`
 	Designation       []ValueSetComposeIncludeConceptDesignation `bson:"designation,omitempty" json:"designation,omitempty"`
 	Contains          []ValueSetExpansionContains                `bson:"contains,omitempty" json:"contains,omitempty"`
+	UnknownFields     map[string]json.RawMessage                 `bson:"-" json:"-"`
+}
+
+// MarshalJSON marshals the ValueSetExpansionContains with its unknown fields.
+func (r ValueSetExpansionContains) MarshalJSON() ([]byte, error) {
+	type other ValueSetExpansionContains
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the ValueSetExpansion with its unknown fields.
+func (r ValueSetExpansion) MarshalJSON() ([]byte, error) {
+	type other ValueSetExpansion
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
+
+// UnmarshalJSON unmarshals the ValueSet and fails with CodeError when a coded element has an unknown code.
+func (r *ValueSet) UnmarshalJSON(b []byte) error {
+	type other ValueSet
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("ValueSet", r)
+}
+
 type OtherValueSet ValueSet
 
 // MarshalJSON marshals the given ValueSet as JSON into a byte slice
 func (r ValueSet) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherValueSet
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherValueSet: OtherValueSet(r),
 		ResourceType:  "ValueSet",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalValueSet unmarshals a ValueSet.
@@ -22,72 +22,138 @@ import "encoding/json"
 
 // VerificationResult is documented here http://hl7.org/fhir/StructureDefinition/VerificationResult
 type VerificationResult struct {
-	ID                *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            []Reference                       `bson:"target,omitempty" json:"target,omitempty"`
-	TargetLocation    []string                          `bson:"targetLocation,omitempty" json:"targetLocation,omitempty"`
-	Need              *CodeableConcept                  `bson:"need,omitempty" json:"need,omitempty"`
-	Status            string                            `bson:"status" json:"status"`
-	StatusDate        *DateTime                         `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	ValidationType    *CodeableConcept                  `bson:"validationType,omitempty" json:"validationType,omitempty"`
-	ValidationProcess []CodeableConcept                 `bson:"validationProcess,omitempty" json:"validationProcess,omitempty"`
-	Frequency         *Timing                           `bson:"frequency,omitempty" json:"frequency,omitempty"`
-	LastPerformed     *DateTime                         `bson:"lastPerformed,omitempty" json:"lastPerformed,omitempty"`
-	NextScheduled     *DateTime                         `bson:"nextScheduled,omitempty" json:"nextScheduled,omitempty"`
-	FailureAction     *CodeableConcept                  `bson:"failureAction,omitempty" json:"failureAction,omitempty"`
-	PrimarySource     []VerificationResultPrimarySource `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
-	Attestation       *VerificationResultAttestation    `bson:"attestation,omitempty" json:"attestation,omitempty"`
-	Validator         []VerificationResultValidator     `bson:"validator,omitempty" json:"validator,omitempty"`
+	ID                    *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                  *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target                []Reference                       `bson:"target,omitempty" json:"target,omitempty"`
+	TargetLocation        []*string                         `bson:"targetLocation,omitempty" json:"targetLocation,omitempty"`
+	TargetLocationElement []*Element                        `bson:"_targetLocation,omitempty" json:"_targetLocation,omitempty"`
+	Need                  *CodeableConcept                  `bson:"need,omitempty" json:"need,omitempty"`
+	Status                string                            `bson:"status" json:"status"`
+	StatusElement         *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusDate            *DateTime                         `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement     *Element                          `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	ValidationType        *CodeableConcept                  `bson:"validationType,omitempty" json:"validationType,omitempty"`
+	ValidationProcess     []CodeableConcept                 `bson:"validationProcess,omitempty" json:"validationProcess,omitempty"`
+	Frequency             *Timing                           `bson:"frequency,omitempty" json:"frequency,omitempty"`
+	LastPerformed         *DateTime                         `bson:"lastPerformed,omitempty" json:"lastPerformed,omitempty"`
+	LastPerformedElement  *Element                          `bson:"_lastPerformed,omitempty" json:"_lastPerformed,omitempty"`
+	NextScheduled         *DateTime                         `bson:"nextScheduled,omitempty" json:"nextScheduled,omitempty"`
+	NextScheduledElement  *Element                          `bson:"_nextScheduled,omitempty" json:"_nextScheduled,omitempty"`
+	FailureAction         *CodeableConcept                  `bson:"failureAction,omitempty" json:"failureAction,omitempty"`
+	PrimarySource         []VerificationResultPrimarySource `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
+	Attestation           *VerificationResultAttestation    `bson:"attestation,omitempty" json:"attestation,omitempty"`
+	Validator             []VerificationResultValidator     `bson:"validator,omitempty" json:"validator,omitempty"`
+	UnknownFields         map[string]json.RawMessage        `bson:"-" json:"-"`
 }
 type VerificationResultPrimarySource struct {
-	ID                  *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Who                 *Reference        `bson:"who,omitempty" json:"who,omitempty"`
-	Type                []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	CommunicationMethod []CodeableConcept `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
-	ValidationStatus    *CodeableConcept  `bson:"validationStatus,omitempty" json:"validationStatus,omitempty"`
-	ValidationDate      *DateTime         `bson:"validationDate,omitempty" json:"validationDate,omitempty"`
-	CanPushUpdates      *CodeableConcept  `bson:"canPushUpdates,omitempty" json:"canPushUpdates,omitempty"`
-	PushTypeAvailable   []CodeableConcept `bson:"pushTypeAvailable,omitempty" json:"pushTypeAvailable,omitempty"`
+	ID                    *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Who                   *Reference                 `bson:"who,omitempty" json:"who,omitempty"`
+	Type                  []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	CommunicationMethod   []CodeableConcept          `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
+	ValidationStatus      *CodeableConcept           `bson:"validationStatus,omitempty" json:"validationStatus,omitempty"`
+	ValidationDate        *DateTime                  `bson:"validationDate,omitempty" json:"validationDate,omitempty"`
+	ValidationDateElement *Element                   `bson:"_validationDate,omitempty" json:"_validationDate,omitempty"`
+	CanPushUpdates        *CodeableConcept           `bson:"canPushUpdates,omitempty" json:"canPushUpdates,omitempty"`
+	PushTypeAvailable     []CodeableConcept          `bson:"pushTypeAvailable,omitempty" json:"pushTypeAvailable,omitempty"`
+	UnknownFields         map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the VerificationResultPrimarySource with its unknown fields.
+func (r VerificationResultPrimarySource) MarshalJSON() ([]byte, error) {
+	type other VerificationResultPrimarySource
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type VerificationResultAttestation struct {
-	ID                        *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Who                       *Reference       `bson:"who,omitempty" json:"who,omitempty"`
-	OnBehalfOf                *Reference       `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
-	CommunicationMethod       *CodeableConcept `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
-	Date                      *DateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	SourceIdentityCertificate *string          `bson:"sourceIdentityCertificate,omitempty" json:"sourceIdentityCertificate,omitempty"`
-	ProxyIdentityCertificate  *string          `bson:"proxyIdentityCertificate,omitempty" json:"proxyIdentityCertificate,omitempty"`
-	ProxySignature            *Signature       `bson:"proxySignature,omitempty" json:"proxySignature,omitempty"`
-	SourceSignature           *Signature       `bson:"sourceSignature,omitempty" json:"sourceSignature,omitempty"`
+	ID                               *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Who                              *Reference                 `bson:"who,omitempty" json:"who,omitempty"`
+	OnBehalfOf                       *Reference                 `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	CommunicationMethod              *CodeableConcept           `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
+	Date                             *DateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                      *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	SourceIdentityCertificate        *string                    `bson:"sourceIdentityCertificate,omitempty" json:"sourceIdentityCertificate,omitempty"`
+	SourceIdentityCertificateElement *Element                   `bson:"_sourceIdentityCertificate,omitempty" json:"_sourceIdentityCertificate,omitempty"`
+	ProxyIdentityCertificate         *string                    `bson:"proxyIdentityCertificate,omitempty" json:"proxyIdentityCertificate,omitempty"`
+	ProxyIdentityCertificateElement  *Element                   `bson:"_proxyIdentityCertificate,omitempty" json:"_proxyIdentityCertificate,omitempty"`
+	ProxySignature                   *Signature                 `bson:"proxySignature,omitempty" json:"proxySignature,omitempty"`
+	SourceSignature                  *Signature                 `bson:"sourceSignature,omitempty" json:"sourceSignature,omitempty"`
+	UnknownFields                    map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the VerificationResultAttestation with its unknown fields.
+func (r VerificationResultAttestation) MarshalJSON() ([]byte, error) {
+	type other VerificationResultAttestation
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
 type VerificationResultValidator struct {
-	ID                   *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Organization         Reference   `bson:"organization" json:"organization"`
-	IdentityCertificate  *string     `bson:"identityCertificate,omitempty" json:"identityCertificate,omitempty"`
-	AttestationSignature *Signature  `bson:"attestationSignature,omitempty" json:"attestationSignature,omitempty"`
+	ID                         *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Organization               Reference                  `bson:"organization" json:"organization"`
+	IdentityCertificate        *string                    `bson:"identityCertificate,omitempty" json:"identityCertificate,omitempty"`
+	IdentityCertificateElement *Element                   `bson:"_identityCertificate,omitempty" json:"_identityCertificate,omitempty"`
+	AttestationSignature       *Signature                 `bson:"attestationSignature,omitempty" json:"attestationSignature,omitempty"`
+	UnknownFields              map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the VerificationResultValidator with its unknown fields.
+func (r VerificationResultValidator) MarshalJSON() ([]byte, error) {
+	type other VerificationResultValidator
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the VerificationResult and fails with PrimitiveElementError when the extensions of a repeating primitive element are not aligned with its values and with CodeError when a coded element has an unknown code.
+func (r *VerificationResult) UnmarshalJSON(b []byte) error {
+	type other VerificationResult
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	if err := checkElements("VerificationResult.targetLocation", len(r.TargetLocation), len(r.TargetLocationElement)); err != nil {
+		return err
+	}
+	return checkCodes("VerificationResult", r)
+}
+
 type OtherVerificationResult VerificationResult
 
 // MarshalJSON marshals the given VerificationResult as JSON into a byte slice
 func (r VerificationResult) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherVerificationResult
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherVerificationResult: OtherVerificationResult(r),
 		ResourceType:            "VerificationResult",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalVerificationResult unmarshals a VerificationResult.
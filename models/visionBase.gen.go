@@ -14,60 +14,53 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.178064 +0000 UTC
+// 2026-10-19 02:55:18.972878214 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // VisionBase is documented here http://hl7.org/fhir/ValueSet/vision-base-codes
-type VisionBase int
+// The empty VisionBase is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type VisionBase string
 
 const (
-	VisionBaseUp VisionBase = iota
-	VisionBaseDown
-	VisionBaseIn
-	VisionBaseOut
+	VisionBaseUp   VisionBase = "up"
+	VisionBaseDown VisionBase = "down"
+	VisionBaseIn   VisionBase = "in"
+	VisionBaseOut  VisionBase = "out"
 )
 
 func (code VisionBase) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "VisionBase"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *VisionBase) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "up":
-		*code = VisionBaseUp
-	case "down":
-		*code = VisionBaseDown
-	case "in":
-		*code = VisionBaseIn
-	case "out":
-		*code = VisionBaseOut
-	default:
-		return fmt.Errorf("unknown VisionBase code `%s`", s)
+func (code *VisionBase) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = VisionBase(s)
 	return nil
 }
 func (code VisionBase) String() string {
 	return code.Code()
 }
 func (code VisionBase) Code() string {
+	return string(code)
+}
+func (code VisionBase) Known() bool {
 	switch code {
-	case VisionBaseUp:
-		return "up"
-	case VisionBaseDown:
-		return "down"
-	case VisionBaseIn:
-		return "in"
-	case VisionBaseOut:
-		return "out"
+	case VisionBaseUp, VisionBaseDown, VisionBaseIn, VisionBaseOut:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code VisionBase) Display() string {
 	switch code {
@@ -14,50 +14,51 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.005269 +0000 UTC
+// 2026-10-19 02:55:18.973964386 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // VisionEyes is documented here http://hl7.org/fhir/ValueSet/vision-eye-codes
-type VisionEyes int
+// The empty VisionEyes is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type VisionEyes string
 
 const (
-	VisionEyesRight VisionEyes = iota
-	VisionEyesLeft
+	VisionEyesRight VisionEyes = "right"
+	VisionEyesLeft  VisionEyes = "left"
 )
 
 func (code VisionEyes) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "VisionEyes"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *VisionEyes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "right":
-		*code = VisionEyesRight
-	case "left":
-		*code = VisionEyesLeft
-	default:
-		return fmt.Errorf("unknown VisionEyes code `%s`", s)
+func (code *VisionEyes) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
 	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*code = VisionEyes(s)
 	return nil
 }
 func (code VisionEyes) String() string {
 	return code.Code()
 }
 func (code VisionEyes) Code() string {
+	return string(code)
+}
+func (code VisionEyes) Known() bool {
 	switch code {
-	case VisionEyesRight:
-		return "right"
-	case VisionEyesLeft:
-		return "left"
+	case VisionEyesRight, VisionEyesLeft:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code VisionEyes) Display() string {
 	switch code {
@@ -22,21 +22,28 @@ import "encoding/json"
 
 // VisionPrescription is documented here http://hl7.org/fhir/StructureDefinition/VisionPrescription
 type VisionPrescription struct {
-	ID                *string                               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes          `bson:"status" json:"status"`
-	Created           DateTime                              `bson:"created" json:"created"`
-	Patient           Reference                             `bson:"patient" json:"patient"`
-	Encounter         *Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	DateWritten       DateTime                              `bson:"dateWritten" json:"dateWritten"`
-	Prescriber        Reference                             `bson:"prescriber" json:"prescriber"`
-	LensSpecification []VisionPrescriptionLensSpecification `bson:"lensSpecification" json:"lensSpecification"`
+	ID                   *string                               `bson:"id,omitempty" json:"id,omitempty"`
+	Meta                 *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes          `bson:"status" json:"status"`
+	StatusElement        *Element                              `bson:"_status,omitempty" json:"_status,omitempty"`
+	Created              DateTime                              `bson:"created" json:"created"`
+	CreatedElement       *Element                              `bson:"_created,omitempty" json:"_created,omitempty"`
+	Patient              Reference                             `bson:"patient" json:"patient"`
+	Encounter            *Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	DateWritten          DateTime                              `bson:"dateWritten" json:"dateWritten"`
+	DateWrittenElement   *Element                              `bson:"_dateWritten,omitempty" json:"_dateWritten,omitempty"`
+	Prescriber           Reference                             `bson:"prescriber" json:"prescriber"`
+	LensSpecification    []VisionPrescriptionLensSpecification `bson:"lensSpecification" json:"lensSpecification"`
+	UnknownFields        map[string]json.RawMessage            `bson:"-" json:"-"`
 }
 type VisionPrescriptionLensSpecification struct {
 	ID                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -44,37 +51,85 @@ type VisionPrescriptionLensSpecification struct {
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Product           CodeableConcept                            `bson:"product" json:"product"`
 	Eye               VisionEyes                                 `bson:"eye" json:"eye"`
+	EyeElement        *Element                                   `bson:"_eye,omitempty" json:"_eye,omitempty"`
 	Sphere            *Decimal                                   `bson:"sphere,omitempty" json:"sphere,omitempty"`
+	SphereElement     *Element                                   `bson:"_sphere,omitempty" json:"_sphere,omitempty"`
 	Cylinder          *Decimal                                   `bson:"cylinder,omitempty" json:"cylinder,omitempty"`
+	CylinderElement   *Element                                   `bson:"_cylinder,omitempty" json:"_cylinder,omitempty"`
 	Axis              *int                                       `bson:"axis,omitempty" json:"axis,omitempty"`
+	AxisElement       *Element                                   `bson:"_axis,omitempty" json:"_axis,omitempty"`
 	Prism             []VisionPrescriptionLensSpecificationPrism `bson:"prism,omitempty" json:"prism,omitempty"`
 	Add               *Decimal                                   `bson:"add,omitempty" json:"add,omitempty"`
+	AddElement        *Element                                   `bson:"_add,omitempty" json:"_add,omitempty"`
 	Power             *Decimal                                   `bson:"power,omitempty" json:"power,omitempty"`
+	PowerElement      *Element                                   `bson:"_power,omitempty" json:"_power,omitempty"`
 	BackCurve         *Decimal                                   `bson:"backCurve,omitempty" json:"backCurve,omitempty"`
+	BackCurveElement  *Element                                   `bson:"_backCurve,omitempty" json:"_backCurve,omitempty"`
 	Diameter          *Decimal                                   `bson:"diameter,omitempty" json:"diameter,omitempty"`
+	DiameterElement   *Element                                   `bson:"_diameter,omitempty" json:"_diameter,omitempty"`
 	Duration          *Quantity                                  `bson:"duration,omitempty" json:"duration,omitempty"`
 	Color             *string                                    `bson:"color,omitempty" json:"color,omitempty"`
+	ColorElement      *Element                                   `bson:"_color,omitempty" json:"_color,omitempty"`
 	Brand             *string                                    `bson:"brand,omitempty" json:"brand,omitempty"`
+	BrandElement      *Element                                   `bson:"_brand,omitempty" json:"_brand,omitempty"`
 	Note              []Annotation                               `bson:"note,omitempty" json:"note,omitempty"`
+	UnknownFields     map[string]json.RawMessage                 `bson:"-" json:"-"`
 }
 type VisionPrescriptionLensSpecificationPrism struct {
-	ID                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Amount            Decimal     `bson:"amount" json:"amount"`
-	Base              VisionBase  `bson:"base" json:"base"`
+	ID                *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Amount            Decimal                    `bson:"amount" json:"amount"`
+	AmountElement     *Element                   `bson:"_amount,omitempty" json:"_amount,omitempty"`
+	Base              VisionBase                 `bson:"base" json:"base"`
+	BaseElement       *Element                   `bson:"_base,omitempty" json:"_base,omitempty"`
+	UnknownFields     map[string]json.RawMessage `bson:"-" json:"-"`
 }
+
+// MarshalJSON marshals the VisionPrescriptionLensSpecificationPrism with its unknown fields.
+func (r VisionPrescriptionLensSpecificationPrism) MarshalJSON() ([]byte, error) {
+	type other VisionPrescriptionLensSpecificationPrism
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// MarshalJSON marshals the VisionPrescriptionLensSpecification with its unknown fields.
+func (r VisionPrescriptionLensSpecification) MarshalJSON() ([]byte, error) {
+	type other VisionPrescriptionLensSpecification
+	b, err := json.Marshal(other(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
+}
+
+// UnmarshalJSON unmarshals the VisionPrescription and fails with CodeError when a coded element has an unknown code.
+func (r *VisionPrescription) UnmarshalJSON(b []byte) error {
+	type other VisionPrescription
+	if err := json.Unmarshal(b, (*other)(r)); err != nil {
+		return err
+	}
+	return checkCodes("VisionPrescription", r)
+}
+
 type OtherVisionPrescription VisionPrescription
 
 // MarshalJSON marshals the given VisionPrescription as JSON into a byte slice
 func (r VisionPrescription) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	b, err := json.Marshal(struct {
 		OtherVisionPrescription
 		ResourceType string `json:"resourceType"`
 	}{
 		OtherVisionPrescription: OtherVisionPrescription(r),
 		ResourceType:            "VisionPrescription",
 	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalUnknownFields(b, r.UnknownFields)
 }
 
 // UnmarshalVisionPrescription unmarshals a VisionPrescription.
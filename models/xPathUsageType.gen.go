@@ -14,65 +14,54 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2021-03-08 09:41:03.146422 +0000 UTC
+// 2026-10-19 02:55:18.974978502 +0000 UTC
 
 package models
 
-import (
-	"encoding/json"
-	"fmt"
-	"strings"
-)
+import "encoding/json"
 
 // XPathUsageType is documented here http://hl7.org/fhir/ValueSet/search-xpath-usage
-type XPathUsageType int
+// The empty XPathUsageType is unset, it fails to marshal with UnsetCodeError. The unknown codes are kept as they are, Known reports whether the code belongs to the ValueSet.
+// UnmarshalJSON accepts any code, the resources report the unknown ones with CodeError. The data types and backbone elements decoded on their own are not checked, Decoder.CheckCodes checks them.
+type XPathUsageType string
 
 const (
-	XPathUsageTypeNormal XPathUsageType = iota
-	XPathUsageTypePhonetic
-	XPathUsageTypeNearby
-	XPathUsageTypeDistance
-	XPathUsageTypeOther
+	XPathUsageTypeNormal   XPathUsageType = "normal"
+	XPathUsageTypePhonetic XPathUsageType = "phonetic"
+	XPathUsageTypeNearby   XPathUsageType = "nearby"
+	XPathUsageTypeDistance XPathUsageType = "distance"
+	XPathUsageTypeOther    XPathUsageType = "other"
 )
 
 func (code XPathUsageType) MarshalJSON() ([]byte, error) {
+	if code == "" {
+		return nil, UnsetCodeError{Type: "XPathUsageType"}
+	}
 	return json.Marshal(code.Code())
 }
-func (code *XPathUsageType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
-	switch s {
-	case "normal":
-		*code = XPathUsageTypeNormal
-	case "phonetic":
-		*code = XPathUsageTypePhonetic
-	case "nearby":
-		*code = XPathUsageTypeNearby
-	case "distance":
-		*code = XPathUsageTypeDistance
-	case "other":
-		*code = XPathUsageTypeOther
-	default:
-		return fmt.Errorf("unknown XPathUsageType code `%s`", s)
+func (code *XPathUsageType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	*code = XPathUsageType(s)
 	return nil
 }
 func (code XPathUsageType) String() string {
 	return code.Code()
 }
 func (code XPathUsageType) Code() string {
+	return string(code)
+}
+func (code XPathUsageType) Known() bool {
 	switch code {
-	case XPathUsageTypeNormal:
-		return "normal"
-	case XPathUsageTypePhonetic:
-		return "phonetic"
-	case XPathUsageTypeNearby:
-		return "nearby"
-	case XPathUsageTypeDistance:
-		return "distance"
-	case XPathUsageTypeOther:
-		return "other"
+	case XPathUsageTypeNormal, XPathUsageTypePhonetic, XPathUsageTypeNearby, XPathUsageTypeDistance, XPathUsageTypeOther:
+		return true
 	}
-	return "<unknown>"
+	return false
 }
 func (code XPathUsageType) Display() string {
 	switch code {
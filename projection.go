@@ -0,0 +1,96 @@
+package fhir
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gotidy/fhir-client/models"
+	"github.com/tidwall/gjson"
+)
+
+// SummaryMode is the value of the _summary parameter.
+type SummaryMode string
+
+const (
+	// SummaryTrue returns the elements marked as summary in the definitions.
+	SummaryTrue SummaryMode = "true"
+	// SummaryText returns the text, id, meta and the mandatory elements.
+	SummaryText SummaryMode = "text"
+	// SummaryData returns all elements but the text.
+	SummaryData SummaryMode = "data"
+	// SummaryCount returns only the total of the search.
+	SummaryCount SummaryMode = "count"
+	// SummaryFalse returns all elements.
+	SummaryFalse SummaryMode = "false"
+)
+
+// WithSummary returns the parameters with _summary set to the mode.
+// The resources returned with the mode other than false are SUBSETTED and can't be updated.
+func WithSummary(params Parameters, mode SummaryMode) Parameters {
+	return withParam(params, "_summary", string(mode))
+}
+
+// WithElements returns the parameters with _elements set to the elements.
+// The returned resources are SUBSETTED and can't be updated.
+func WithElements(params Parameters, elements ...string) Parameters {
+	return withParam(params, "_elements", strings.Join(elements, ","))
+}
+
+// SubsettedError is returned when the resource tagged as SUBSETTED is updated.
+// Such resources miss the elements which would be removed by the update.
+type SubsettedError struct {
+	Resource ResourceType
+	ID       string
+}
+
+func (e SubsettedError) Error() string {
+	return fmt.Sprintf("resource \"%s\" with ID \"%s\" is SUBSETTED, updating it removes the missing elements", e.Resource, e.ID)
+}
+
+type subsettedKey struct{}
+
+// AllowSubsettedUpdate returns the context for the updates of the resources tagged as SUBSETTED,
+// when the missing elements are intended to be removed.
+func AllowSubsettedUpdate(ctx context.Context) context.Context {
+	return context.WithValue(ctx, subsettedKey{}, true)
+}
+
+func subsettedAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(subsettedKey{}).(bool)
+	return allowed
+}
+
+// isSubsetted reports whether the encoded resource is tagged as SUBSETTED.
+func isSubsetted(data []byte) bool {
+	for _, tag := range gjson.GetBytes(data, "meta.tag").Array() {
+		if tag.Get("code").String() == models.SubsettedCode && tag.Get("system").String() == models.SubsettedSystem {
+			return true
+		}
+	}
+	return false
+}
+
+// update sends the resource with PUT. The resources tagged as SUBSETTED are refused with SubsettedError,
+// unless the context is made with AllowSubsettedUpdate.
+func (c *Client) update(ctx context.Context, resource ResourceType, id string, params Parameters, body interface{}) (*FhirResponse, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	if isSubsetted(data) && !subsettedAllowed(ctx) {
+		if id == "" {
+			id = gjson.GetBytes(data, "id").String()
+		}
+		return nil, SubsettedError{Resource: resource, ID: id}
+	}
+
+	path := string(resource)
+	if id != "" {
+		path = Path(path, id)
+	}
+	return c.RequestWithBodyReader(ctx, http.MethodPut, path, params, bytes.NewReader(data))
+}
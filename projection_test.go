@@ -0,0 +1,64 @@
+package fhir_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestSubsetted(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	patient := newPatient("Smith", "John")
+	patient.Gender = genderPtr(models.AdministrativeGenderMale)
+	if err := srv.Seed(patient); err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, params := range []fhir.Parameters{
+		fhir.PatientSearch().Elements("name"),
+		fhir.WithSummary(nil, fhir.SummaryData),
+	} {
+		patients, err := client.GetPatient(ctx, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(patients) != 1 || !patients[0].Meta.IsSubsetted() || len(patients[0].Name) != 1 {
+			t.Fatalf("unexpected patients for %s: %s", params.Encode(), mustJSON(patients))
+		}
+		if params.Encode() == "_elements=name" && patients[0].Gender != nil {
+			t.Errorf("gender is not removed: %s", mustJSON(patients))
+		}
+
+		partial := patients[0]
+		_, err = client.UpdatePatient(ctx, nil, partial)
+		var e fhir.SubsettedError
+		if !errors.As(err, &e) || e.ID != models.ToString(partial.ID) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	patients, err := client.GetPatient(ctx, fhir.PatientSearch().Elements("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UpdatePatientByID(fhir.AllowSubsettedUpdate(ctx), models.ToString(patients[0].ID), nil, patients[0]); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := client.GetPatientByID(ctx, models.ToString(patients[0].ID), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Gender != nil {
+		t.Errorf("allowed update is not applied: %s", mustJSON(stored))
+	}
+}
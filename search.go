@@ -0,0 +1,57 @@
+package fhir
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultMaxQueryLength is the default length of the encoded search query after which the search is sent with POST.
+const DefaultMaxQueryLength = 2048
+
+type postSearch struct {
+	Parameters
+}
+
+// PostSearch makes the search with the parameters to be sent with POST to _search regardless of the query length.
+func PostSearch(params Parameters) Parameters {
+	if params == nil {
+		params = url.Values{}
+	}
+	return postSearch{params}
+}
+
+// search sends the search with GET, or with POST to _search and the parameters in the form body
+// when the query is longer than MaxQueryLength or the parameters are wrapped with PostSearch.
+func (c *Client) search(ctx context.Context, path string, params Parameters) (*FhirResponse, error) {
+	if err := queryErr(params); err != nil {
+		return nil, err
+	}
+	var query string
+	if params != nil {
+		query = params.Encode()
+	}
+	if _, post := params.(postSearch); !post && (c.MaxQueryLength <= 0 || len(query) <= c.MaxQueryLength) {
+		return c.Request(ctx, http.MethodGet, path, params)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, Path(path, "_search"), nil, strings.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	return c.DoRequest(ctx, req)
+}
+
+// queryErr returns the error of the query building, such as QueryError.
+func queryErr(params Parameters) error {
+	if p, ok := params.(postSearch); ok {
+		params = p.Parameters
+	}
+	if q, ok := params.(interface{ Err() error }); ok {
+		return q.Err()
+	}
+	return nil
+}
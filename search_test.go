@@ -0,0 +1,47 @@
+package fhir_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+// Long queries and PostSearch are sent with POST to _search.
+func TestPostSearch(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	if err := srv.Seed(&models.Patient{ID: models.NewString("a")}, &models.Patient{ID: models.NewString("b")}); err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	long, err := srv.Client(fhir.WithMaxQueryLength(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, params := range []fhir.Parameters{url.Values{"_id": {"a,b,unknown"}}, fhir.PostSearch(url.Values{"_id": {"a"}})} {
+		client := client
+		if _, ok := params.(url.Values); ok {
+			client = long
+		}
+		patients, err := client.GetPatient(context.Background(), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		requests := srv.Requests()
+		last := requests[len(requests)-1]
+		if last.Method != http.MethodPost || last.URL.Path != "/Patient/_search" || string(last.Body) != params.Encode() {
+			t.Errorf("unexpected request %s %s: %s", last.Method, last.URL, last.Body)
+		}
+		if len(patients) == 0 {
+			t.Errorf("no patients found for %s", params.Encode())
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package fhir
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// Prefix is the comparison prefix of the number, date and quantity search values.
+type Prefix string
+
+const (
+	PrefixEq Prefix = "eq"
+	PrefixNe Prefix = "ne"
+	PrefixGt Prefix = "gt"
+	PrefixLt Prefix = "lt"
+	PrefixGe Prefix = "ge"
+	PrefixLe Prefix = "le"
+	PrefixSa Prefix = "sa"
+	PrefixEb Prefix = "eb"
+	PrefixAp Prefix = "ap"
+)
+
+// Query is the search query built with the typed search parameters, it implements Parameters.
+// The parameter added several times is matched with AND, the values passed at once are matched with OR.
+type Query struct {
+	resource ResourceType
+	values   url.Values
+	err      error
+}
+
+// NewQuery returns the empty query.
+func NewQuery() Query {
+	return Query{values: url.Values{}}
+}
+
+// NewResourceQuery returns the empty query of the resource type, it may be used in the chained and _has parameters.
+func NewResourceQuery(resource ResourceType) Query {
+	return Query{resource: resource, values: url.Values{}}
+}
+
+// Resource returns the resource type of the query, it is empty for the query created with NewQuery.
+func (q *Query) Resource() ResourceType {
+	return q.resource
+}
+
+// Err returns the first error of the query building, such as QueryError for the invalid chain.
+// The query with the error is not sent by the client.
+func (q *Query) Err() error {
+	return q.err
+}
+
+// Encode encodes the query.
+func (q *Query) Encode() string {
+	return q.values.Encode()
+}
+
+// Values returns the query values.
+func (q *Query) Values() url.Values {
+	return q.values
+}
+
+// Add adds the parameter with the values joined with OR. The values are added as is, without escaping.
+func (q *Query) Add(name string, values ...string) {
+	if q.values == nil {
+		q.values = url.Values{}
+	}
+	q.values.Add(name, strings.Join(values, ","))
+}
+
+// EscapeValue escapes the characters which separate the search values: "\", ",", "$" and "|".
+func EscapeValue(value string) string {
+	return valueEscaper.Replace(value)
+}
+
+var valueEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `$`, `\$`, `|`, `\|`)
+
+func escapeValues(values []string) []string {
+	escaped := make([]string, 0, len(values))
+	for _, value := range values {
+		escaped = append(escaped, EscapeValue(value))
+	}
+	return escaped
+}
+
+// TokenValue returns the token value "system|code", for example as a component of the composite parameter.
+func TokenValue(system, code string) string {
+	return EscapeValue(system) + "|" + EscapeValue(code)
+}
+
+// QuantityValue returns the quantity value "[prefix]value|system|code", for example as a component of the composite parameter.
+// The system and code may be empty.
+func QuantityValue(prefix Prefix, value models.Decimal, system, code string) string {
+	s := string(prefix) + value.String()
+	if system != "" || code != "" {
+		s += "|" + EscapeValue(system) + "|" + EscapeValue(code)
+	}
+	return s
+}
+
+// DateValue returns the date value "[prefix]date", for example as a component of the composite parameter.
+func DateValue(prefix Prefix, date models.DateTime) string {
+	return string(prefix) + date.String()
+}
+
+func dateValues(prefix Prefix, dates []models.DateTime) []string {
+	values := make([]string, 0, len(dates))
+	for _, date := range dates {
+		values = append(values, DateValue(prefix, date))
+	}
+	return values
+}
+
+// missing adds the :missing modifier.
+func missing(q *Query, name string, missing bool) {
+	q.Add(name+":missing", strconv.FormatBool(missing))
+}
+
+// TokenParam is the token search parameter.
+type TokenParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches any of the codes.
+func (p TokenParam) Eq(codes ...string) { p.query.Add(p.name, escapeValues(codes)...) }
+
+// Code matches the code in the system.
+func (p TokenParam) Code(system, code string) { p.query.Add(p.name, TokenValue(system, code)) }
+
+// System matches any code in the system.
+func (p TokenParam) System(system string) { p.query.Add(p.name, EscapeValue(system)+"|") }
+
+// Not matches the resources without any of the codes.
+func (p TokenParam) Not(codes ...string) { p.query.Add(p.name+":not", escapeValues(codes)...) }
+
+// Text matches the text or display of the code.
+func (p TokenParam) Text(text string) { p.query.Add(p.name+":text", EscapeValue(text)) }
+
+// In matches the codes in the value set.
+func (p TokenParam) In(valueSet string) { p.query.Add(p.name+":in", EscapeValue(valueSet)) }
+
+// NotIn matches the codes not in the value set.
+func (p TokenParam) NotIn(valueSet string) { p.query.Add(p.name+":not-in", EscapeValue(valueSet)) }
+
+// Above matches the codes subsuming the codes.
+func (p TokenParam) Above(codes ...string) { p.query.Add(p.name+":above", escapeValues(codes)...) }
+
+// Below matches the codes subsumed by the codes.
+func (p TokenParam) Below(codes ...string) { p.query.Add(p.name+":below", escapeValues(codes)...) }
+
+// Missing matches the resources with the element missing or present.
+func (p TokenParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// StringParam is the string search parameter.
+type StringParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches the strings starting with any of the values, case and accent insensitive.
+func (p StringParam) Eq(values ...string) { p.query.Add(p.name, escapeValues(values)...) }
+
+// Exact matches the strings equal to any of the values.
+func (p StringParam) Exact(values ...string) { p.query.Add(p.name+":exact", escapeValues(values)...) }
+
+// Contains matches the strings containing any of the values.
+func (p StringParam) Contains(values ...string) {
+	p.query.Add(p.name+":contains", escapeValues(values)...)
+}
+
+// Missing matches the resources with the element missing or present.
+func (p StringParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// DateParam is the date search parameter.
+type DateParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches the dates within any of the dates.
+func (p DateParam) Eq(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixEq, dates)...) }
+
+// Ne matches the dates not within the dates.
+func (p DateParam) Ne(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixNe, dates)...) }
+
+// Gt matches the dates after the dates.
+func (p DateParam) Gt(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixGt, dates)...) }
+
+// Lt matches the dates before the dates.
+func (p DateParam) Lt(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixLt, dates)...) }
+
+// Ge matches the dates within or after the dates.
+func (p DateParam) Ge(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixGe, dates)...) }
+
+// Le matches the dates within or before the dates.
+func (p DateParam) Le(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixLe, dates)...) }
+
+// Sa matches the periods starting after the dates.
+func (p DateParam) Sa(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixSa, dates)...) }
+
+// Eb matches the periods ending before the dates.
+func (p DateParam) Eb(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixEb, dates)...) }
+
+// Ap matches the dates approximately equal to the dates.
+func (p DateParam) Ap(dates ...models.DateTime) { p.query.Add(p.name, dateValues(PrefixAp, dates)...) }
+
+// Missing matches the resources with the element missing or present.
+func (p DateParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// NumberParam is the number search parameter.
+type NumberParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches any of the values. The values are sent as written, so their significant figures set the implicit range.
+func (p NumberParam) Eq(values ...models.Decimal) {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, value.String())
+	}
+	p.query.Add(p.name, encoded...)
+}
+
+// Compare matches the numbers compared to the value with the prefix.
+func (p NumberParam) Compare(prefix Prefix, value models.Decimal) {
+	p.query.Add(p.name, string(prefix)+value.String())
+}
+
+// Missing matches the resources with the element missing or present.
+func (p NumberParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// QuantityParam is the quantity search parameter. The system and code of the unit may be empty. The value is sent
+// as written, so its significant figures set the implicit range.
+type QuantityParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches the quantities equal to the value.
+func (p QuantityParam) Eq(value models.Decimal, system, code string) {
+	p.query.Add(p.name, QuantityValue("", value, system, code))
+}
+
+// Gt matches the quantities greater than the value.
+func (p QuantityParam) Gt(value models.Decimal, system, code string) {
+	p.query.Add(p.name, QuantityValue(PrefixGt, value, system, code))
+}
+
+// Lt matches the quantities less than the value.
+func (p QuantityParam) Lt(value models.Decimal, system, code string) {
+	p.query.Add(p.name, QuantityValue(PrefixLt, value, system, code))
+}
+
+// Ge matches the quantities greater than or equal to the value.
+func (p QuantityParam) Ge(value models.Decimal, system, code string) {
+	p.query.Add(p.name, QuantityValue(PrefixGe, value, system, code))
+}
+
+// Le matches the quantities less than or equal to the value.
+func (p QuantityParam) Le(value models.Decimal, system, code string) {
+	p.query.Add(p.name, QuantityValue(PrefixLe, value, system, code))
+}
+
+// Compare matches the quantities compared to the value with the prefix.
+func (p QuantityParam) Compare(prefix Prefix, value models.Decimal, system, code string) {
+	p.query.Add(p.name, QuantityValue(prefix, value, system, code))
+}
+
+// Missing matches the resources with the element missing or present.
+func (p QuantityParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// ReferenceParam is the reference search parameter.
+type ReferenceParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches any of the references, such as "Patient/123", an ID or an absolute URL.
+func (p ReferenceParam) Eq(references ...string) { p.query.Add(p.name, escapeValues(references)...) }
+
+// ID matches the references to the resources of the type with any of the IDs.
+func (p ReferenceParam) ID(resource ResourceType, ids ...string) {
+	p.query.Add(p.name+":"+string(resource), escapeValues(ids)...)
+}
+
+// Identifier matches the references by the identifier of the referenced resource.
+func (p ReferenceParam) Identifier(system, value string) {
+	p.query.Add(p.name+":identifier", TokenValue(system, value))
+}
+
+// Missing matches the resources with the element missing or present.
+func (p ReferenceParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// Where matches the references to the resources found with the target query, such as subject:Patient.name=peter.
+func (p ReferenceParam) Where(target ResourceQuery) { p.query.Chain(p.name, target) }
+
+// URIParam is the uri search parameter.
+type URIParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches any of the URIs.
+func (p URIParam) Eq(uris ...string) { p.query.Add(p.name, escapeValues(uris)...) }
+
+// Above matches the URIs which are the prefixes of the URI.
+func (p URIParam) Above(uri string) { p.query.Add(p.name+":above", EscapeValue(uri)) }
+
+// Below matches the URIs starting with the URI.
+func (p URIParam) Below(uri string) { p.query.Add(p.name+":below", EscapeValue(uri)) }
+
+// Missing matches the resources with the element missing or present.
+func (p URIParam) Missing(value bool) { missing(p.query, p.name, value) }
+
+// CompositeParam is the composite search parameter.
+type CompositeParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches the components, which are the encoded values of the component parameters,
+// such as TokenValue or QuantityValue. The components are joined with "$".
+func (p CompositeParam) Eq(components ...string) { p.query.Add(p.name, strings.Join(components, "$")) }
+
+// SpecialParam is the search parameter with the special processing, such as near of Location.
+type SpecialParam struct {
+	query *Query
+	name  string
+}
+
+// Eq matches any of the values. The values are added as is.
+func (p SpecialParam) Eq(values ...string) { p.query.Add(p.name, values...) }
@@ -0,0 +1,172 @@
+package fhir
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestSearchQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Parameters
+		want  string
+	}{
+		{
+			name:  "chain",
+			query: PatientSearch().Birthdate().Ge(models.Date(1980, 1, 2)).Name().Contains("smi"),
+			want:  "birthdate=ge1980-01-02&name:contains=smi",
+		},
+		{
+			name:  "or",
+			query: PatientSearch().Gender().Eq("male", "female"),
+			want:  "gender=male,female",
+		},
+		{
+			name:  "and",
+			query: PatientSearch().Birthdate().Ge(models.Year(1980)).Birthdate().Lt(models.Year(1990)),
+			want:  "birthdate=ge1980&birthdate=lt1990",
+		},
+		{
+			name:  "escape",
+			query: PatientSearch().Family().Exact("a,b").Identifier().Code("urn:sys", "1|2"),
+			want:  `family:exact=a\,b&identifier=urn:sys|1\|2`,
+		},
+		{
+			name:  "token modifiers",
+			query: ObservationSearch().Code().Not("x").Category().Text("vital").Status().Missing(false),
+			want:  "category:text=vital&code:not=x&status:missing=false",
+		},
+		{
+			name:  "reference",
+			query: ObservationSearch().Subject().ID(PatientResource, "1", "2").Performer().Identifier("urn:npi", "123"),
+			want:  "performer:identifier=urn:npi|123&subject:Patient=1,2",
+		},
+		{
+			name:  "quantity",
+			query: ObservationSearch().ValueQuantity().Le(decimal("5.40"), "http://unitsofmeasure.org", "mg").Count(10),
+			want:  "_count=10&value-quantity=le5.40|http://unitsofmeasure.org|mg",
+		},
+		{
+			name:  "number",
+			query: RiskAssessmentSearch().Probability().Compare(PrefixGt, decimal("0.50")).Probability().Eq(decimal("1e-2")),
+			want:  "probability=gt0.50&probability=1e-2",
+		},
+		{
+			name:  "composite",
+			query: ObservationSearch().ComponentCodeValueQuantity().Eq(TokenValue("http://loinc.org", "8480-6"), QuantityValue(PrefixLt, decimal("60"), "", "")),
+			want:  "component-code-value-quantity=http://loinc.org|8480-6$lt60",
+		},
+		{
+			name:  "uri",
+			query: PatientSearch().Profile().Below("http://example.org/fhir").Sort("-birthdate", "name"),
+			want:  "_profile:below=http://example.org/fhir&_sort=-birthdate,name",
+		},
+		{
+			name:  "common parameter of the same name",
+			query: ListSearch().Source().ID(PractitionerResource, "1").SourceParam().Eq("http://example.org/app"),
+			want:  "_source=http://example.org/app&source:Practitioner=1",
+		},
+		{
+			name:  "chain",
+			query: ObservationSearch().Subject().Where(PatientSearch().Name().Eq("peter")).Code().Eq("1234-5"),
+			want:  "code=1234-5&subject:Patient.name=peter",
+		},
+		{
+			name: "nested chain",
+			query: EncounterSearch().Subject().Where(
+				PatientSearch().Organization().Where(OrganizationSearch().Name().Exact("Health")),
+			),
+			want: "subject:Patient.organization:Organization.name:exact=Health",
+		},
+		{
+			name:  "has",
+			query: PatientSearch().Has(EncounterSearch().Location().Eq("Location/y"), "patient"),
+			want:  "_has:Encounter:patient:location=Location/y",
+		},
+		{
+			name: "nested has",
+			query: PatientSearch().Has(ObservationSearch().Code().Eq("1234-5").Has(
+				DiagnosticReportSearch().Status().Eq("final"), "result",
+			), "patient"),
+			want: "_has:Observation:patient:_has:DiagnosticReport:result:status=final&_has:Observation:patient:code=1234-5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := queryErr(tt.query); err != nil {
+				t.Fatal(err)
+			}
+			got, err := url.QueryUnescape(tt.query.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchQueryErrors(t *testing.T) {
+	custom := NewResourceQuery("Unknown")
+	custom.Add("name", "x")
+	tests := []struct {
+		name  string
+		query Parameters
+		param string
+	}{
+		{
+			name:  "target",
+			query: ObservationSearch().Encounter().Where(PatientSearch().Name().Eq("x")),
+			param: "encounter:Patient",
+		},
+		{
+			name:  "has reference",
+			query: PatientSearch().Has(EncounterSearch().Status().Eq("finished"), "location"),
+			param: "_has:Encounter:location",
+		},
+		{
+			name:  "unknown type",
+			query: ObservationSearch().Focus().Where(&custom),
+			param: "focus:Unknown",
+		},
+		{
+			name:  "result parameter",
+			query: ObservationSearch().Subject().Where(PatientSearch().Count(1)),
+			param: "subject:Patient._count",
+		},
+		{
+			name:  "nested",
+			query: PatientSearch().Has(ObservationSearch().Subject().Where(EncounterSearch()), "patient"),
+			param: "subject:Encounter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e QueryError
+			if err := queryErr(tt.query); !errors.As(err, &e) || e.Param != tt.param {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	client, err := New("http://localhost")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(context.Background(), PatientResource, tests[1].query); err == nil {
+		t.Error("invalid query is sent")
+	}
+}
+
+func decimal(s string) models.Decimal {
+	d, err := models.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
@@ -0,0 +1,188 @@
+package fhir
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// TotalMode is the value of the _total parameter, it asks the server to report the total number of matches.
+type TotalMode string
+
+const (
+	TotalNone     TotalMode = "none"
+	TotalEstimate TotalMode = "estimate"
+	TotalAccurate TotalMode = "accurate"
+)
+
+// WithTotal returns the parameters with _total set to the mode.
+func WithTotal(params Parameters, mode TotalMode) Parameters {
+	return withParam(params, "_total", string(mode))
+}
+
+// withParam returns the copy of the parameters with the parameter set to the value.
+// The query error and PostSearch are kept.
+func withParam(params Parameters, name, value string) Parameters {
+	_, post := params.(postSearch)
+	var values url.Values
+	if params != nil {
+		values, _ = url.ParseQuery(params.Encode())
+	}
+	if values == nil {
+		values = url.Values{}
+	}
+	values.Set(name, value)
+
+	var result Parameters = values
+	if err := queryErr(params); err != nil {
+		result = &Query{values: values, err: err}
+	}
+	if post {
+		result = PostSearch(result)
+	}
+	return result
+}
+
+// SearchResult is the page of the searchset Bundle with its metadata.
+type SearchResult struct {
+	Bundle *models.Bundle
+	// Total is the number of all matches, -1 when the server didn't report it.
+	Total   int
+	Entries []SearchEntry
+}
+
+// SearchEntry is the entry of the searchset Bundle.
+type SearchEntry struct {
+	FullURL string
+	// Mode is match, include or outcome, nil when the server didn't report it.
+	Mode  *models.SearchEntryMode
+	Score *models.Decimal
+	// Resource is *models.<Type> for the known types and ResourceData for the unknown ones.
+	Resource interface{}
+}
+
+// IsMatch reports whether the entry matches the search, rather than is included with _include or _revinclude.
+func (e SearchEntry) IsMatch() bool {
+	return e.Mode == nil || *e.Mode == models.SearchEntryModeMatch
+}
+
+// NewSearchResult decodes the searchset Bundle.
+func NewSearchResult(bundle *models.Bundle) (*SearchResult, error) {
+	return newSearchResult(bundle, models.Decoder{})
+}
+
+func newSearchResult(bundle *models.Bundle, decoder models.Decoder) (*SearchResult, error) {
+	result := &SearchResult{Bundle: bundle, Total: -1}
+	if bundle == nil {
+		return result, nil
+	}
+	if bundle.Total != nil {
+		result.Total = *bundle.Total
+	}
+	for _, entry := range bundle.Entry {
+		e := SearchEntry{FullURL: models.ToString(entry.FullUrl)}
+		if entry.Search != nil {
+			e.Mode, e.Score = entry.Search.Mode, entry.Search.Score
+		}
+		if len(entry.Resource) != 0 {
+			resource, err := decodeResource(ResourceData(entry.Resource), decoder)
+			if err != nil {
+				return nil, err
+			}
+			e.Resource = resource
+		}
+		result.Entries = append(result.Entries, e)
+	}
+	return result, nil
+}
+
+// decodeResource decodes the resource of the known type, the resources of the unknown types are returned as is.
+func decodeResource(data ResourceData, decoder models.Decoder) (interface{}, error) {
+	resource := GetDataResourceType(data)
+	if TypeOf(resource) == nil {
+		return data, nil
+	}
+	entity := NewResource(resource)
+	if err := decoder.Unmarshal(data, entity); err != nil {
+		return nil, NewUnmarshalError("resource parsing", resource, data, err)
+	}
+	return entity, nil
+}
+
+// Link returns the URL of the link with the relation, or the empty string.
+func (r *SearchResult) Link(relation string) string {
+	if r.Bundle == nil {
+		return ""
+	}
+	for _, link := range r.Bundle.Link {
+		if link.Relation == relation {
+			return link.URL
+		}
+	}
+	return ""
+}
+
+// Self returns the URL of the page, it shows the parameters the server applied.
+func (r *SearchResult) Self() string { return r.Link("self") }
+
+// Next returns the URL of the next page, or the empty string on the last page.
+func (r *SearchResult) Next() string { return r.Link("next") }
+
+// Previous returns the URL of the previous page, or the empty string on the first page.
+func (r *SearchResult) Previous() string { return r.Link("previous") }
+
+// Matches returns the entries matching the search.
+func (r *SearchResult) Matches() []SearchEntry {
+	var entries []SearchEntry
+	for _, entry := range r.Entries {
+		if entry.IsMatch() {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Included returns the entries included with _include and _revinclude.
+func (r *SearchResult) Included() []SearchEntry {
+	var entries []SearchEntry
+	for _, entry := range r.Entries {
+		if entry.Mode != nil && *entry.Mode == models.SearchEntryModeInclude {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Search searches the resources and returns the page with the total, links, full URLs and scores.
+func (c *Client) Search(ctx context.Context, resource ResourceType, params Parameters) (*SearchResult, error) {
+	return searchResult(c.Get(ctx, resource, params))
+}
+
+// SearchPage reads the page by its link URL, such as SearchResult.Next.
+func (c *Client) SearchPage(ctx context.Context, url string) (*SearchResult, error) {
+	return searchResult(c.Request(ctx, http.MethodGet, url, nil))
+}
+
+func searchResult(resp *FhirResponse, err error) (*SearchResult, error) {
+	bundle, err := ExpectedBundle(resp, err)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchResult(bundle, resp.decoder)
+}
+
+// Count returns the number of the matching resources. It searches with _summary=count,
+// so the resources are not fetched.
+func (c *Client) Count(ctx context.Context, resource ResourceType, params Parameters) (int, error) {
+	bundle, err := ExpectedBundle(c.Get(ctx, resource, WithSummary(params, SummaryCount)))
+	if err != nil {
+		return 0, err
+	}
+	if bundle.Total == nil {
+		return 0, fmt.Errorf("server didn't report the total of \"%s\"", resource)
+	}
+	return *bundle.Total, nil
+}
@@ -0,0 +1,64 @@
+package fhir_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	fhir "github.com/gotidy/fhir-client"
+	"github.com/gotidy/fhir-client/fhirtest"
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestSearchResult(t *testing.T) {
+	srv := fhirtest.NewServer()
+	defer srv.Close()
+	err := srv.Seed(newPatient("Smith"), newPatient("Smithson"), newPatient("Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	result, err := client.SearchPatient(ctx, fhir.PatientSearch().Count(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 3 || len(result.Resources) != 2 || result.Self() == "" || result.Next() == "" || result.Previous() != "" {
+		t.Errorf("unexpected first page: %s", mustJSON(result.Bundle))
+	}
+	if entry := result.Entries[0]; !strings.HasSuffix(entry.FullURL, "Patient/"+models.ToString(result.Resources[0].ID)) || !entry.IsMatch() {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+
+	next, err := client.SearchPatientPage(ctx, result.Next())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(next.Resources) != 1 || next.Next() != "" || next.Previous() == "" {
+		t.Errorf("unexpected last page: %s", mustJSON(next.Bundle))
+	}
+
+	result, err = client.SearchPatient(ctx, fhir.PatientSearch().Total(fhir.TotalNone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != -1 || len(result.Resources) != 3 {
+		t.Errorf("unexpected result without total: %s", mustJSON(result.Bundle))
+	}
+
+	count, err := client.Count(ctx, fhir.PatientResource, fhir.PatientSearch().Family().Eq("smi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("got count %d, want 2", count)
+	}
+	last := srv.Requests()[len(srv.Requests())-1]
+	if last.URL.Query().Get("_summary") != "count" {
+		t.Errorf("count is requested without _summary: %s", last.URL)
+	}
+}
@@ -0,0 +1,562 @@
+package fhir
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+// parameterValue returns the value of the parameter: string, bool, int, models.Decimal, *models.Coding or *models.CodeableConcept.
+// The date and time values are returned as strings.
+func parameterValue(p models.ParametersParameter) interface{} {
+	switch v := p.Value().(type) {
+	case *string:
+		return *v
+	case *bool:
+		return *v
+	case *int:
+		return *v
+	case *models.Decimal:
+		return *v
+	case *models.DateTime:
+		return v.String()
+	case *models.Instant:
+		return v.String()
+	case *models.Time:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// parameterText returns the value of the string, code, uri or dateTime parameter.
+func parameterText(p models.ParametersParameter) string {
+	if s, ok := parameterValue(p).(string); ok {
+		return s
+	}
+	return ""
+}
+
+// parametersBuilder skips empty values, so the optional parameters can be added unconditionally.
+type parametersBuilder struct {
+	models.Parameters
+}
+
+func newParametersBuilder() *parametersBuilder {
+	return &parametersBuilder{}
+}
+
+func (b *parametersBuilder) uri(name, value string) *parametersBuilder {
+	if value != "" {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueUri: &value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) code(name, value string) *parametersBuilder {
+	if value != "" {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueCode: &value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) string(name, value string) *parametersBuilder {
+	if value != "" {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueString: &value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) dateTime(name string, value *models.DateTime) *parametersBuilder {
+	if value != nil {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueDateTime: value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) boolean(name string, value *bool) *parametersBuilder {
+	if value != nil {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueBoolean: value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) integer(name string, value *int) *parametersBuilder {
+	if value != nil {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueInteger: value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) coding(name string, value *models.Coding) *parametersBuilder {
+	if value != nil {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueCoding: value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) codeableConcept(name string, value *models.CodeableConcept) *parametersBuilder {
+	if value != nil {
+		b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, ValueCodeableConcept: value})
+	}
+	return b
+}
+
+func (b *parametersBuilder) resource(name string, value interface{}) (*parametersBuilder, error) {
+	if v := reflect.ValueOf(value); !v.IsValid() || v.Kind() == reflect.Ptr && v.IsNil() {
+		return b, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	b.Parameter = append(b.Parameter, models.ParametersParameter{Name: name, Resource: data})
+	return b, nil
+}
+
+func decodeParameters(resp *FhirResponse) ([]models.ParametersParameter, error) {
+	if resp.ResourceType != "Parameters" {
+		return nil, fmt.Errorf("expected Parameters data in the response, but have: %s", resp.ResourceType)
+	}
+	var params models.Parameters
+	if err := resp.decoder.Unmarshal(resp.Body, &params); err != nil {
+		return nil, NewUnmarshalError("response parsing", resp.ResourceType, resp.Body, err)
+	}
+	return params.Parameter, nil
+}
+
+// Terminology calls the terminology service operations.
+type Terminology struct {
+	client *Client
+}
+
+// Terminology returns the terminology service client.
+func (c *Client) Terminology() *Terminology {
+	return &Terminology{client: c}
+}
+
+// operation calls the operation on the resource type or on the resource instance when the ID is not empty.
+func (t *Terminology) operation(ctx context.Context, resource ResourceType, id, name string, params *parametersBuilder) (*FhirResponse, error) {
+	path := Path(string(resource), name)
+	if id != "" {
+		path = Path(string(resource), id, name)
+	}
+	resp, err := t.client.RequestWithBody(ctx, http.MethodPost, path, nil, params.Parameters)
+	if id != "" {
+		err = withResource(err, resource, id)
+	}
+	return resp, err
+}
+
+// ExpandParams are the parameters of the ValueSet $expand operation.
+type ExpandParams struct {
+	// ID expands the ValueSet instance on the server.
+	ID string
+	// URL and ValueSetVersion refer to the ValueSet known by the server.
+	URL             string
+	ValueSetVersion string
+	// ValueSet is expanded when the server doesn't know it.
+	ValueSet *models.ValueSet
+	// Filter is the text filter applied to the codes.
+	Filter string
+	// Offset and Count page the expansion.
+	Offset              *int
+	Count               *int
+	Date                *models.DateTime
+	DisplayLanguage     string
+	IncludeDesignations *bool
+	ActiveOnly          *bool
+}
+
+// Expansion is the result of the ValueSet $expand operation.
+type Expansion struct {
+	ValueSet *models.ValueSet
+	// Total is the total number of codes, -1 when the server doesn't report it.
+	Total    int
+	Offset   int
+	Contains []models.ValueSetExpansionContains
+}
+
+// More reports whether there are codes after the page.
+func (e *Expansion) More() bool {
+	return e.Total >= 0 && e.Offset+len(e.Contains) < e.Total
+}
+
+// NextOffset returns the offset of the next page.
+func (e *Expansion) NextOffset() int {
+	return e.Offset + len(e.Contains)
+}
+
+// Expand expands the ValueSet.
+func (t *Terminology) Expand(ctx context.Context, params ExpandParams) (*Expansion, error) {
+	b, err := newParametersBuilder().
+		uri("url", params.URL).
+		string("valueSetVersion", params.ValueSetVersion).
+		string("filter", params.Filter).
+		integer("offset", params.Offset).
+		integer("count", params.Count).
+		dateTime("date", params.Date).
+		code("displayLanguage", params.DisplayLanguage).
+		boolean("includeDesignations", params.IncludeDesignations).
+		boolean("activeOnly", params.ActiveOnly).
+		resource("valueSet", params.ValueSet)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.operation(ctx, ValueSetResource, params.ID, "$expand", b)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ResourceType != ValueSetResource {
+		return nil, fmt.Errorf("expected ValueSet data in the response, but have: %s", resp.ResourceType)
+	}
+
+	var valueSet models.ValueSet
+	if err := resp.decoder.Unmarshal(resp.Body, &valueSet); err != nil {
+		return nil, NewUnmarshalError("response parsing", resp.ResourceType, resp.Body, err)
+	}
+	expansion := &Expansion{ValueSet: &valueSet, Total: -1}
+	if valueSet.Expansion != nil {
+		if valueSet.Expansion.Total != nil {
+			expansion.Total = *valueSet.Expansion.Total
+		}
+		expansion.Offset = models.ToInt(valueSet.Expansion.Offset)
+		expansion.Contains = valueSet.Expansion.Contains
+	}
+	return expansion, nil
+}
+
+// ExpandAll expands the ValueSet page by page and returns all codes.
+func (t *Terminology) ExpandAll(ctx context.Context, params ExpandParams, pageSize int) ([]models.ValueSetExpansionContains, error) {
+	var contains []models.ValueSetExpansionContains
+	offset := 0
+	for {
+		params.Offset, params.Count = &offset, &pageSize
+		expansion, err := t.Expand(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		contains = append(contains, expansion.Contains...)
+		if !expansion.More() || len(expansion.Contains) == 0 {
+			return contains, nil
+		}
+		offset = expansion.NextOffset()
+	}
+}
+
+// LookupParams are the parameters of the CodeSystem $lookup operation.
+type LookupParams struct {
+	System  string
+	Code    string
+	Version string
+	// Coding is looked up instead of System, Code and Version.
+	Coding          *models.Coding
+	Date            *models.DateTime
+	DisplayLanguage string
+	// Properties are the properties to return, all when empty.
+	Properties []string
+}
+
+// Lookup is the result of the CodeSystem $lookup operation.
+type Lookup struct {
+	Name         string
+	Version      string
+	Display      string
+	Designations []Designation
+	Properties   []Property
+}
+
+// Designation is the additional representation of the concept.
+type Designation struct {
+	Language string
+	Use      *models.Coding
+	Value    string
+}
+
+// Property is the property of the concept. Value is string, bool, int, models.Decimal or *models.Coding.
+type Property struct {
+	Code          string
+	Value         interface{}
+	Description   string
+	Subproperties []Property
+}
+
+// Lookup returns the details of the concept.
+func (t *Terminology) Lookup(ctx context.Context, params LookupParams) (*Lookup, error) {
+	b := newParametersBuilder().
+		code("code", params.Code).
+		uri("system", params.System).
+		string("version", params.Version).
+		coding("coding", params.Coding).
+		dateTime("date", params.Date).
+		code("displayLanguage", params.DisplayLanguage)
+	for _, property := range params.Properties {
+		b.code("property", property)
+	}
+	resp, err := t.operation(ctx, CodeSystemResource, "", "$lookup", b)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeParameters(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := &Lookup{}
+	for _, p := range result {
+		switch p.Name {
+		case "name":
+			lookup.Name = parameterText(p)
+		case "version":
+			lookup.Version = parameterText(p)
+		case "display":
+			lookup.Display = parameterText(p)
+		case "designation":
+			var designation Designation
+			for _, part := range p.Part {
+				switch part.Name {
+				case "language":
+					designation.Language = parameterText(part)
+				case "use":
+					designation.Use = part.ValueCoding
+				case "value":
+					designation.Value = parameterText(part)
+				}
+			}
+			lookup.Designations = append(lookup.Designations, designation)
+		case "property":
+			lookup.Properties = append(lookup.Properties, newProperty(p.Part))
+		}
+	}
+	return lookup, nil
+}
+
+func newProperty(parts []models.ParametersParameter) Property {
+	var property Property
+	for _, part := range parts {
+		switch part.Name {
+		case "code":
+			property.Code = parameterText(part)
+		case "value":
+			property.Value = parameterValue(part)
+		case "description":
+			property.Description = parameterText(part)
+		case "subproperty":
+			property.Subproperties = append(property.Subproperties, newProperty(part.Part))
+		}
+	}
+	return property
+}
+
+// ValidateCodeParams are the parameters of the ValueSet $validate-code operation.
+type ValidateCodeParams struct {
+	// ID validates against the ValueSet instance on the server.
+	ID string
+	// URL and ValueSetVersion refer to the ValueSet known by the server.
+	URL             string
+	ValueSetVersion string
+	// ValueSet is used when the server doesn't know it.
+	ValueSet *models.ValueSet
+	Code     string
+	System   string
+	Version  string
+	Display  string
+	// Coding or CodeableConcept are validated instead of Code, System and Version.
+	Coding          *models.Coding
+	CodeableConcept *models.CodeableConcept
+	Date            *models.DateTime
+	DisplayLanguage string
+	Abstract        *bool
+}
+
+// ValidateCode is the result of the $validate-code operation.
+type ValidateCode struct {
+	Result  bool
+	Message string
+	// Display is the correct display of the code.
+	Display string
+}
+
+// ValidateCode validates that the code is in the ValueSet.
+func (t *Terminology) ValidateCode(ctx context.Context, params ValidateCodeParams) (*ValidateCode, error) {
+	b, err := newParametersBuilder().
+		uri("url", params.URL).
+		string("valueSetVersion", params.ValueSetVersion).
+		code("code", params.Code).
+		uri("system", params.System).
+		string("systemVersion", params.Version).
+		string("display", params.Display).
+		coding("coding", params.Coding).
+		codeableConcept("codeableConcept", params.CodeableConcept).
+		dateTime("date", params.Date).
+		code("displayLanguage", params.DisplayLanguage).
+		boolean("abstract", params.Abstract).
+		resource("valueSet", params.ValueSet)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.operation(ctx, ValueSetResource, params.ID, "$validate-code", b)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeParameters(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	validation := &ValidateCode{}
+	for _, p := range result {
+		switch p.Name {
+		case "result":
+			validation.Result = models.ToBool(p.ValueBoolean)
+		case "message":
+			validation.Message = parameterText(p)
+		case "display":
+			validation.Display = parameterText(p)
+		}
+	}
+	return validation, nil
+}
+
+// TranslateParams are the parameters of the ConceptMap $translate operation.
+type TranslateParams struct {
+	// ID translates with the ConceptMap instance on the server.
+	ID string
+	// URL and ConceptMapVersion refer to the ConceptMap known by the server.
+	URL               string
+	ConceptMapVersion string
+	ConceptMap        *models.ConceptMap
+	Code              string
+	System            string
+	Version           string
+	// Coding or CodeableConcept are translated instead of Code, System and Version.
+	Coding          *models.Coding
+	CodeableConcept *models.CodeableConcept
+	// Source and Target are the ValueSets of the concepts.
+	Source       string
+	Target       string
+	TargetSystem string
+	// Reverse translates from the target to the source.
+	Reverse *bool
+}
+
+// Translation is the result of the ConceptMap $translate operation.
+type Translation struct {
+	Result  bool
+	Message string
+	Matches []TranslationMatch
+}
+
+// TranslationMatch is the concept the code is translated to.
+type TranslationMatch struct {
+	Equivalence models.ConceptMapEquivalence
+	Concept     *models.Coding
+	// Source is the canonical URL of the ConceptMap.
+	Source string
+}
+
+// Translate translates the code from one ValueSet to another.
+func (t *Terminology) Translate(ctx context.Context, params TranslateParams) (*Translation, error) {
+	b, err := newParametersBuilder().
+		uri("url", params.URL).
+		string("conceptMapVersion", params.ConceptMapVersion).
+		code("code", params.Code).
+		uri("system", params.System).
+		string("version", params.Version).
+		uri("source", params.Source).
+		coding("coding", params.Coding).
+		codeableConcept("codeableConcept", params.CodeableConcept).
+		uri("target", params.Target).
+		uri("targetsystem", params.TargetSystem).
+		boolean("reverse", params.Reverse).
+		resource("conceptMap", params.ConceptMap)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.operation(ctx, ConceptMapResource, params.ID, "$translate", b)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeParameters(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	translation := &Translation{}
+	for i, p := range result {
+		switch p.Name {
+		case "result":
+			translation.Result = models.ToBool(p.ValueBoolean)
+		case "message":
+			translation.Message = parameterText(p)
+		case "match":
+			var match TranslationMatch
+			for j, part := range p.Part {
+				switch part.Name {
+				case "equivalence":
+					match.Equivalence = models.ConceptMapEquivalence(parameterText(part))
+					path := fmt.Sprintf("Parameters.parameter[%d].part[%d].valueCode", i, j)
+					if err := resp.decoder.CheckCodes(&match.Equivalence, path); err != nil {
+						return nil, NewUnmarshalError("response parsing", resp.ResourceType, resp.Body, err)
+					}
+				case "concept":
+					match.Concept = part.ValueCoding
+				case "source":
+					match.Source = parameterText(part)
+				}
+			}
+			translation.Matches = append(translation.Matches, match)
+		}
+	}
+	return translation, nil
+}
+
+// SubsumptionOutcome is the relationship between the codes.
+type SubsumptionOutcome string
+
+const (
+	SubsumptionEquivalent  SubsumptionOutcome = "equivalent"
+	SubsumptionSubsumes    SubsumptionOutcome = "subsumes"
+	SubsumptionSubsumedBy  SubsumptionOutcome = "subsumed-by"
+	SubsumptionNotSubsumed SubsumptionOutcome = "not-subsumed"
+)
+
+// SubsumesParams are the parameters of the CodeSystem $subsumes operation.
+type SubsumesParams struct {
+	System  string
+	Version string
+	CodeA   string
+	CodeB   string
+	// CodingA and CodingB are tested instead of CodeA and CodeB.
+	CodingA *models.Coding
+	CodingB *models.Coding
+}
+
+// Subsumes tests the subsumption relationship between the code A and the code B.
+func (t *Terminology) Subsumes(ctx context.Context, params SubsumesParams) (SubsumptionOutcome, error) {
+	b := newParametersBuilder().
+		code("codeA", params.CodeA).
+		code("codeB", params.CodeB).
+		uri("system", params.System).
+		string("version", params.Version).
+		coding("codingA", params.CodingA).
+		coding("codingB", params.CodingB)
+	resp, err := t.operation(ctx, CodeSystemResource, "", "$subsumes", b)
+	if err != nil {
+		return "", err
+	}
+	result, err := decodeParameters(resp)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range result {
+		if p.Name == "outcome" {
+			return SubsumptionOutcome(parameterText(p)), nil
+		}
+	}
+	return "", fmt.Errorf("no outcome in the $subsumes response")
+}
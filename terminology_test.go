@@ -0,0 +1,116 @@
+package fhir
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gotidy/fhir-client/models"
+)
+
+func TestTerminology(t *testing.T) {
+	var path string
+	var request models.Parameters
+	var response string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		body, _ := ioutil.ReadAll(r.Body)
+		request = models.Parameters{}
+		_ = json.Unmarshal(body, &request)
+		w.Header().Set("Content-Type", "application/fhir+json")
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+	client, err := New(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	terminology := client.Terminology()
+	ctx := context.Background()
+
+	response = `{"resourceType": "ValueSet", "status": "active", "expansion": {"timestamp": "2021-03-08", "total": 3, "offset": 0,
+		"contains": [{"system": "http://loinc.org", "code": "8867-4"}, {"system": "http://loinc.org", "code": "8310-5"}]}}`
+	expansion, err := terminology.Expand(ctx, ExpandParams{URL: "http://example.org/vs", Filter: "heart", Count: models.NewInt(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/ValueSet/$expand" || len(request.Parameter) != 3 || models.ToString(request.Parameter[0].ValueUri) != "http://example.org/vs" {
+		t.Errorf("unexpected request %s: %+v", path, request)
+	}
+	if expansion.Total != 3 || len(expansion.Contains) != 2 || !expansion.More() || expansion.NextOffset() != 2 {
+		t.Errorf("unexpected expansion: %+v", expansion)
+	}
+
+	response = `{"resourceType": "Parameters", "parameter": [
+		{"name": "name", "valueString": "LOINC"},
+		{"name": "display", "valueString": "Heart rate"},
+		{"name": "designation", "part": [{"name": "language", "valueCode": "de"}, {"name": "value", "valueString": "Herzfrequenz"}]},
+		{"name": "property", "part": [{"name": "code", "valueCode": "COMPONENT"}, {"name": "value", "valueCoding": {"code": "LP415671-9"}}]},
+		{"name": "property", "part": [{"name": "code", "valueCode": "effective"}, {"name": "value", "valueInstant": "2021-03-08T10:00:00.100Z"}]}
+	]}`
+	lookup, err := terminology.Lookup(ctx, LookupParams{System: "http://loinc.org", Code: "8867-4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/CodeSystem/$lookup" || lookup.Display != "Heart rate" || len(lookup.Designations) != 1 || lookup.Designations[0].Value != "Herzfrequenz" {
+		t.Errorf("unexpected lookup: %+v", lookup)
+	}
+	if coding, ok := lookup.Properties[0].Value.(*models.Coding); !ok || models.ToString(coding.Code) != "LP415671-9" {
+		t.Errorf("unexpected property: %+v", lookup.Properties[0])
+	}
+	if value := lookup.Properties[1].Value; value != "2021-03-08T10:00:00.100Z" {
+		t.Errorf("unexpected instant property: %#v", value)
+	}
+
+	response = `{"resourceType": "Parameters", "parameter": [{"name": "result", "valueBoolean": false}, {"name": "message", "valueString": "Unknown code"}]}`
+	validation, err := terminology.ValidateCode(ctx, ValidateCodeParams{ID: "vs1", Code: "x", System: "http://loinc.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/ValueSet/vs1/$validate-code" || validation.Result || validation.Message != "Unknown code" {
+		t.Errorf("unexpected validation: %+v", validation)
+	}
+
+	response = `{"resourceType": "Parameters", "parameter": [{"name": "result", "valueBoolean": true},
+		{"name": "match", "part": [{"name": "equivalence", "valueCode": "wider"}, {"name": "concept", "valueCoding": {"code": "B"}}]}]}`
+	translation, err := terminology.Translate(ctx, TranslateParams{URL: "http://example.org/cm", Code: "A", System: "http://example.org/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !translation.Result || len(translation.Matches) != 1 || translation.Matches[0].Equivalence != models.ConceptMapEquivalenceWider {
+		t.Errorf("unexpected translation: %+v", translation)
+	}
+
+	response = `{"resourceType": "Parameters", "parameter": [{"name": "result", "valueBoolean": true},
+		{"name": "match", "part": [{"name": "equivalence", "valueCode": "broader"}]}]}`
+	_, err = terminology.Translate(ctx, TranslateParams{URL: "http://example.org/cm", Code: "A", System: "http://example.org/a"})
+	var codeErr models.CodeError
+	if !errors.As(err, &codeErr) || codeErr.Code != "broader" || codeErr.Path != "Parameters.parameter[1].part[0].valueCode" {
+		t.Errorf("expected CodeError, got %v", err)
+	}
+	var warnings []models.CodeError
+	lenient, err := New(srv.URL, WithDecoder(models.Decoder{Lenient: true, Warn: func(w models.CodeError) { warnings = append(warnings, w) }}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	translation, err = lenient.Terminology().Translate(ctx, TranslateParams{URL: "http://example.org/cm", Code: "A", System: "http://example.org/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if translation.Matches[0].Equivalence.Code() != "broader" || len(warnings) != 1 {
+		t.Errorf("expected the kept code with a warning, got %+v, %v", translation, warnings)
+	}
+
+	response = `{"resourceType": "Parameters", "parameter": [{"name": "outcome", "valueCode": "subsumed-by"}]}`
+	outcome, err := terminology.Subsumes(ctx, SubsumesParams{System: "http://snomed.info/sct", CodeA: "1", CodeB: "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outcome != SubsumptionSubsumedBy {
+		t.Errorf("unexpected outcome: %s", outcome)
+	}
+}
@@ -311,6 +311,160 @@ var resources = map[ResourceType]struct {
 	VisionPrescriptionResource:                {new: func() interface{} { return &models.VisionPrescription{} }, typ: reflect.TypeOf(models.VisionPrescription{})},
 }
 
+// ResourceTypes returns all the resource types.
+func ResourceTypes() []ResourceType {
+	return []ResourceType{
+		AccountResource,
+		ActivityDefinitionResource,
+		AdverseEventResource,
+		AllergyIntoleranceResource,
+		AppointmentResource,
+		AppointmentResponseResource,
+		AuditEventResource,
+		BasicResource,
+		BinaryResource,
+		BiologicallyDerivedProductResource,
+		BodyStructureResource,
+		BundleResource,
+		CapabilityStatementResource,
+		CarePlanResource,
+		CareTeamResource,
+		CatalogEntryResource,
+		ChargeItemResource,
+		ChargeItemDefinitionResource,
+		ClaimResource,
+		ClaimResponseResource,
+		ClinicalImpressionResource,
+		CodeSystemResource,
+		CommunicationResource,
+		CommunicationRequestResource,
+		CompartmentDefinitionResource,
+		CompositionResource,
+		ConceptMapResource,
+		ConditionResource,
+		ConsentResource,
+		ContractResource,
+		CoverageResource,
+		CoverageEligibilityRequestResource,
+		CoverageEligibilityResponseResource,
+		DetectedIssueResource,
+		DeviceResource,
+		DeviceDefinitionResource,
+		DeviceMetricResource,
+		DeviceRequestResource,
+		DeviceUseStatementResource,
+		DiagnosticReportResource,
+		DocumentManifestResource,
+		DocumentReferenceResource,
+		DomainResourceResource,
+		EffectEvidenceSynthesisResource,
+		EncounterResource,
+		EndpointResource,
+		EnrollmentRequestResource,
+		EnrollmentResponseResource,
+		EpisodeOfCareResource,
+		EventDefinitionResource,
+		EvidenceResource,
+		EvidenceVariableResource,
+		ExampleScenarioResource,
+		ExplanationOfBenefitResource,
+		FamilyMemberHistoryResource,
+		FlagResource,
+		GoalResource,
+		GraphDefinitionResource,
+		GroupResource,
+		GuidanceResponseResource,
+		HealthcareServiceResource,
+		ImagingStudyResource,
+		ImmunizationResource,
+		ImmunizationEvaluationResource,
+		ImmunizationRecommendationResource,
+		ImplementationGuideResource,
+		InsurancePlanResource,
+		InvoiceResource,
+		LibraryResource,
+		LinkageResource,
+		ListResource,
+		LocationResource,
+		MeasureResource,
+		MeasureReportResource,
+		MediaResource,
+		MedicationResource,
+		MedicationAdministrationResource,
+		MedicationDispenseResource,
+		MedicationKnowledgeResource,
+		MedicationRequestResource,
+		MedicationStatementResource,
+		MedicinalProductResource,
+		MedicinalProductAuthorizationResource,
+		MedicinalProductContraindicationResource,
+		MedicinalProductIndicationResource,
+		MedicinalProductIngredientResource,
+		MedicinalProductInteractionResource,
+		MedicinalProductManufacturedResource,
+		MedicinalProductPackagedResource,
+		MedicinalProductPharmaceuticalResource,
+		MedicinalProductUndesirableEffectResource,
+		MessageDefinitionResource,
+		MessageHeaderResource,
+		MolecularSequenceResource,
+		NamingSystemResource,
+		NutritionOrderResource,
+		ObservationResource,
+		ObservationDefinitionResource,
+		OperationDefinitionResource,
+		OperationOutcomeResource,
+		OrganizationResource,
+		OrganizationAffiliationResource,
+		ParametersResource,
+		PatientResource,
+		PaymentNoticeResource,
+		PaymentReconciliationResource,
+		PersonResource,
+		PlanDefinitionResource,
+		PractitionerResource,
+		PractitionerRoleResource,
+		ProcedureResource,
+		ProvenanceResource,
+		QuestionnaireResource,
+		QuestionnaireResponseResource,
+		RelatedPersonResource,
+		RequestGroupResource,
+		ResearchDefinitionResource,
+		ResearchElementDefinitionResource,
+		ResearchStudyResource,
+		ResearchSubjectResource,
+		ResourceResource,
+		RiskAssessmentResource,
+		RiskEvidenceSynthesisResource,
+		ScheduleResource,
+		SearchParameterResource,
+		ServiceRequestResource,
+		SlotResource,
+		SpecimenResource,
+		SpecimenDefinitionResource,
+		StructureDefinitionResource,
+		StructureMapResource,
+		SubscriptionResource,
+		SubstanceResource,
+		SubstanceNucleicAcidResource,
+		SubstancePolymerResource,
+		SubstanceProteinResource,
+		SubstanceReferenceInformationResource,
+		SubstanceSourceMaterialResource,
+		SubstanceSpecificationResource,
+		SupplyDeliveryResource,
+		SupplyRequestResource,
+		TaskResource,
+		TerminologyCapabilitiesResource,
+		TestReportResource,
+		TestScriptResource,
+		ValueSetResource,
+		VerificationResultResource,
+		VisionPrescriptionResource,
+	}
+}
+
 // NewResource creates the new resource.
 func NewResource(resource ResourceType) interface{} {
 	return resources[resource].new()
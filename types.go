@@ -37,11 +37,19 @@ type FhirResponse struct {
 	Bundle           *models.Bundle
 	OperationOutcome *models.OperationOutcome
 	ResourceType     ResourceType
+
+	// decoder unmarshals the resources of the response.
+	decoder models.Decoder
 }
 
 func NewFhirResponse(resp *http.Response) (*FhirResponse, error) {
+	return newFhirResponse(resp, models.Decoder{})
+}
+
+func newFhirResponse(resp *http.Response, decoder models.Decoder) (*FhirResponse, error) {
 	fresp := &FhirResponse{
 		StatusCode: resp.StatusCode,
+		decoder:    decoder,
 	}
 
 	var err error
@@ -51,38 +59,55 @@ func NewFhirResponse(resp *http.Response) (*FhirResponse, error) {
 		return fresp, err
 	}
 
+	if resp.StatusCode >= 400 {
+		return fresp, newStatusError(resp, fresp, decoder)
+	}
+
 	if contentType := resp.Header.Get("Content-Type"); !strings.Contains(contentType, "json") {
 		return fresp, NewResponseError(resp, fmt.Sprintf("Content-Type is \"%s\" but expected \"application/json\"", contentType))
 	}
 
-	var message string
-
 	fresp.ResourceType = GetDataResourceType(fresp.Body)
 	switch fresp.ResourceType {
 	case "Bundle":
 		var dest models.Bundle
-		if err := json.Unmarshal(fresp.Body, &dest); err != nil {
+		if err := decoder.Unmarshal(fresp.Body, &dest); err != nil {
 			return fresp, NewUnmarshalError("response parsing", fresp.ResourceType, fresp.Body, err)
 		}
 		fresp.Bundle = &dest
 	case "OperationOutcome":
 		var dest models.OperationOutcome
-		if err := json.Unmarshal(fresp.Body, &dest); err != nil {
+		if err := decoder.Unmarshal(fresp.Body, &dest); err != nil {
 			return fresp, NewUnmarshalError("response parsing", fresp.ResourceType, fresp.Body, err)
 		}
 		fresp.OperationOutcome = &dest
-	case "":
-		message = gjson.GetBytes(fresp.Body, "message").String()
 	}
 
-	switch {
-	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return fresp, nil
-	case resp.StatusCode >= 400 && resp.StatusCode < 500:
-		return fresp, NewFhirError(resp, fresp.OperationOutcome, message)
-	default:
-		return fresp, NewResponseError(resp, fmt.Sprintf("unexpected respose status: %d %s", resp.StatusCode, http.StatusText(resp.StatusCode)))
 	}
+	return fresp, NewResponseError(resp, fmt.Sprintf("unexpected respose status: %d %s", resp.StatusCode, http.StatusText(resp.StatusCode)))
+}
+
+// newStatusError returns FhirError for the error status with the OperationOutcome or the message of the JSON body.
+// The OperationOutcome which can't be decoded, such as one with an unknown issue code, is dropped, so it doesn't hide
+// the status.
+func newStatusError(resp *http.Response, fresp *FhirResponse, decoder models.Decoder) error {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return NewFhirError(resp, nil, "")
+	}
+	var message string
+	fresp.ResourceType = GetDataResourceType(fresp.Body)
+	switch fresp.ResourceType {
+	case "OperationOutcome":
+		var dest models.OperationOutcome
+		if decoder.Unmarshal(fresp.Body, &dest) == nil {
+			fresp.OperationOutcome = &dest
+		}
+	case "":
+		message = gjson.GetBytes(fresp.Body, "message").String()
+	}
+	return NewFhirError(resp, fresp.OperationOutcome, message)
 }
 
 func (r *FhirResponse) MustBundle() error {